@@ -0,0 +1,64 @@
+package client
+
+import (
+	"context"
+	"iter"
+	"net/http"
+	"net/url"
+
+	"github.com/hydr0g3nz/mini_bank/internal/application/dto"
+)
+
+// AccountFilter narrows the accounts listed; zero fields match everything
+type AccountFilter struct {
+	Status     string // ACTIVE, INACTIVE or SUSPENDED
+	CustomerID string
+	Search     string // Matches the account name
+	PageSize   int    // Accounts fetched per request; defaults to 50, at most 100
+}
+
+// CreateAccount opens an account
+func (c *Client) CreateAccount(ctx context.Context, req AccountRequest) (*AccountResponse, error) {
+	var account AccountResponse
+	if err := c.do(ctx, request{method: http.MethodPost, path: "/accounts", body: req}, &account); err != nil {
+		return nil, err
+	}
+	return &account, nil
+}
+
+// GetAccount retrieves an account
+func (c *Client) GetAccount(ctx context.Context, id string) (*AccountResponse, error) {
+	var account AccountResponse
+	if err := c.do(ctx, request{method: http.MethodGet, path: "/accounts/" + url.PathEscape(id), idempotent: true}, &account); err != nil {
+		return nil, err
+	}
+	return &account, nil
+}
+
+// Accounts ranges over the accounts matching filter, newest first:
+//
+//	for account, err := range bank.Accounts(ctx, client.AccountFilter{Status: "ACTIVE"}) {
+//		if err != nil {
+//			return err
+//		}
+//		...
+//	}
+func (c *Client) Accounts(ctx context.Context, filter AccountFilter) iter.Seq2[AccountResponse, error] {
+	query := url.Values{}
+	setQuery(query, "status", filter.Status)
+	setQuery(query, "customer_id", filter.CustomerID)
+	setQuery(query, "search", filter.Search)
+
+	return paginate(ctx, "/accounts", query, filter.PageSize, func(ctx context.Context, req request) (page[AccountResponse], error) {
+		var list dto.AccountListResponse
+		err := c.do(ctx, req, &list)
+		return page[AccountResponse]{items: list.Accounts, pagination: list.Pagination}, err
+	})
+}
+
+// setQuery sets a query parameter unless its value is empty
+func setQuery(query url.Values, key, value string) {
+	if value != "" {
+		query.Set(key, value)
+	}
+}
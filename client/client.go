@@ -0,0 +1,292 @@
+// Package client is a typed Go client of the mini_bank REST API for the services that call it.
+//
+// Requests and responses are mini_bank's own DTOs, error responses come back as *APIError matching
+// the sentinel errors of this package with errors.Is, and list endpoints are ranged over page by page:
+//
+//	bank := client.New("https://bank.internal", apiKey)
+//	transfer, err := bank.Transfer(ctx, from, to, client.NewDecimalStringFromFloat(40), "Rent")
+//	if err != nil {
+//		return err
+//	}
+//	_, err = bank.ConfirmTransaction(ctx, transfer.ID)
+//	if errors.Is(err, client.ErrInsufficientBalance) {
+//		...
+//	}
+//
+// Clients whose API key must sign its requests pass WithRequestSigning; every attempt of a request
+// that changes anything is then signed with its own timestamp and nonce.
+//
+// Requests the server turned away without acting on them are retried. So are requests that may have
+// reached it, as long as repeating them is safe: reads, confirmations, and creations, which carry an
+// Idempotency-Key so a retried creation is carried out once.
+package client
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// apiPrefix is the path the API is served under
+	apiPrefix = "/api/v1"
+	// defaultTimeout bounds each attempt when no HTTP client is given
+	defaultTimeout = 30 * time.Second
+)
+
+// RetryPolicy tells how often and how patiently failed requests are retried
+type RetryPolicy struct {
+	MaxAttempts int           // Attempts per request, the first included; 1 disables retries
+	BaseDelay   time.Duration // Wait before the first retry, doubled for each further one
+	MaxDelay    time.Duration // Cap on the wait, also applied to the server's Retry-After
+}
+
+// DefaultRetryPolicy makes three attempts, waiting 200ms and then 400ms
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts: 3,
+	BaseDelay:   200 * time.Millisecond,
+	MaxDelay:    5 * time.Second,
+}
+
+// Client calls the mini_bank API. It is safe for concurrent use.
+type Client struct {
+	baseURL    string
+	apiKey     string
+	httpClient *http.Client
+	retry      RetryPolicy
+	signingKey string
+}
+
+// Option configures a client
+type Option func(*Client)
+
+// WithHTTPClient sends requests through httpClient, e.g. one with its own transport or timeout
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(c *Client) {
+		c.httpClient = httpClient
+	}
+}
+
+// WithRetryPolicy replaces DefaultRetryPolicy
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return func(c *Client) {
+		c.retry = policy
+	}
+}
+
+// WithRequestSigning signs the requests that change anything with the signing secret the server
+// holds for the client's API key
+func WithRequestSigning(secret string) Option {
+	return func(c *Client) {
+		c.signingKey = secret
+	}
+}
+
+// New creates a client of the API served at baseURL, such as https://bank.internal, authenticating
+// with apiKey
+func New(baseURL, apiKey string, options ...Option) *Client {
+	c := &Client{
+		baseURL:    strings.TrimRight(baseURL, "/"),
+		apiKey:     apiKey,
+		httpClient: &http.Client{Timeout: defaultTimeout},
+		retry:      DefaultRetryPolicy,
+	}
+	for _, option := range options {
+		option(c)
+	}
+	if c.retry.MaxAttempts < 1 {
+		c.retry.MaxAttempts = 1
+	}
+	return c
+}
+
+// request is one API call, sent as often as the retry policy allows
+type request struct {
+	method     string
+	path       string
+	query      url.Values
+	body       interface{}
+	idempotent bool // Repeating it after it may have reached the server is safe
+}
+
+// do sends a request and decodes the response into out, which may be nil. Creations get an
+// Idempotency-Key that every attempt repeats, which makes them safe to retry.
+func (c *Client) do(ctx context.Context, req request, out interface{}) error {
+	var payload []byte
+	if req.body != nil {
+		var err error
+		if payload, err = json.Marshal(req.body); err != nil {
+			return fmt.Errorf("encode request: %w", err)
+		}
+	}
+
+	idempotencyKey := ""
+	if req.method == http.MethodPost {
+		idempotencyKey = newIdempotencyKey()
+		req.idempotent = true
+	}
+
+	target := c.baseURL + apiPrefix + req.path
+	if len(req.query) > 0 {
+		target += "?" + req.query.Encode()
+	}
+
+	var lastErr error
+	for attempt := 1; ; attempt++ {
+		retryAfter, err := c.attempt(ctx, req, target, payload, idempotencyKey, out)
+		if err == nil {
+			return nil
+		}
+		lastErr = err
+
+		if attempt >= c.retry.MaxAttempts || !retryable(err, req.idempotent) {
+			return lastErr
+		}
+		if err := sleep(ctx, c.backoff(attempt, retryAfter)); err != nil {
+			return lastErr
+		}
+	}
+}
+
+// attempt sends a request once, returning how long the server asked to wait when it failed
+func (c *Client) attempt(ctx context.Context, req request, target string, payload []byte, idempotencyKey string, out interface{}) (time.Duration, error) {
+	var body io.Reader
+	if payload != nil {
+		body = bytes.NewReader(payload)
+	}
+	httpReq, err := http.NewRequestWithContext(ctx, req.method, target, body)
+	if err != nil {
+		return 0, err
+	}
+	httpReq.Header.Set("x-api-key", c.apiKey)
+	httpReq.Header.Set("Accept", "application/json")
+	// Bare resources decode straight into the DTOs
+	httpReq.Header.Set("X-Response-Envelope", "false")
+	if payload != nil {
+		httpReq.Header.Set("Content-Type", "application/json")
+	}
+	if idempotencyKey != "" {
+		httpReq.Header.Set("Idempotency-Key", idempotencyKey)
+	}
+	if c.signingKey != "" && req.method != http.MethodGet {
+		c.sign(httpReq, payload)
+	}
+
+	resp, err := c.httpClient.Do(httpReq)
+	if err != nil {
+		return 0, &transportError{err: err}
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		return retryAfter(resp), newAPIError(resp)
+	}
+	if out == nil || resp.StatusCode == http.StatusNoContent {
+		return 0, nil
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return 0, fmt.Errorf("decode %s %s response: %w", req.method, req.path, err)
+	}
+	return 0, nil
+}
+
+// sign sets the signature headers of an attempt. The server remembers nonces, so each attempt
+// gets a fresh one; the Idempotency-Key still keeps a retried creation from being carried out twice.
+func (c *Client) sign(httpReq *http.Request, payload []byte) {
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	nonce := newIdempotencyKey()
+	bodyHash := sha256.Sum256(payload)
+	signingString := strings.Join([]string{httpReq.Method, httpReq.URL.RequestURI(), timestamp, nonce, hex.EncodeToString(bodyHash[:])}, "\n")
+
+	mac := hmac.New(sha256.New, []byte(c.signingKey))
+	mac.Write([]byte(signingString))
+	httpReq.Header.Set("X-Signature", hex.EncodeToString(mac.Sum(nil)))
+	httpReq.Header.Set("X-Signature-Timestamp", timestamp)
+	httpReq.Header.Set("X-Signature-Nonce", nonce)
+}
+
+// backoff returns the wait before retrying after the given attempt, preferring the server's Retry-After
+func (c *Client) backoff(attempt int, retryAfter time.Duration) time.Duration {
+	delay := c.retry.BaseDelay << (attempt - 1)
+	if retryAfter > 0 {
+		delay = retryAfter
+	}
+	if c.retry.MaxDelay > 0 && delay > c.retry.MaxDelay {
+		delay = c.retry.MaxDelay
+	}
+	return delay
+}
+
+// transportError is a request that failed without a response; the server may or may not have acted on it
+type transportError struct {
+	err error
+}
+
+func (e *transportError) Error() string { return e.err.Error() }
+func (e *transportError) Unwrap() error { return e.err }
+
+// retryable tells whether a failed request may be sent again. Requests the server turned away unhandled
+// always may; those that may have been handled only when repeating them is safe.
+func retryable(err error, idempotent bool) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+
+	var transport *transportError
+	if errors.As(err, &transport) {
+		return idempotent
+	}
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	switch apiErr.StatusCode {
+	case http.StatusServiceUnavailable, http.StatusTooManyRequests:
+		return true
+	case http.StatusBadGateway, http.StatusGatewayTimeout:
+		return idempotent
+	}
+	// Another confirmation of the same transaction is running; a retry gets its outcome
+	return idempotent && apiErr.Code == "TRANSACTION_IN_PROGRESS"
+}
+
+// retryAfter reads the Retry-After seconds of a response
+func retryAfter(resp *http.Response) time.Duration {
+	seconds, err := strconv.Atoi(resp.Header.Get("Retry-After"))
+	if err != nil || seconds < 0 {
+		return 0
+	}
+	return time.Duration(seconds) * time.Second
+}
+
+// sleep waits for d unless ctx is done first
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// newIdempotencyKey returns a random key identifying one logical request across its attempts
+func newIdempotencyKey() string {
+	key := make([]byte, 16)
+	_, _ = rand.Read(key)
+	return hex.EncodeToString(key)
+}
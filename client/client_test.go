@@ -0,0 +1,243 @@
+package client_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/client"
+	"github.com/hydr0g3nz/mini_bank/stubserver"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// lostResponses forwards requests but reports the first n POSTs as failed after the server handled
+// them, as when a connection drops before the response arrives
+type lostResponses struct {
+	next http.RoundTripper
+	lost atomic.Int32
+	keys []string
+}
+
+func (t *lostResponses) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method == http.MethodPost {
+		t.keys = append(t.keys, req.Header.Get("Idempotency-Key"))
+	}
+	resp, err := t.next.RoundTrip(req)
+	if err == nil && req.Method == http.MethodPost && t.lost.Add(-1) >= 0 {
+		resp.Body.Close()
+		return nil, errors.New("connection reset by peer")
+	}
+	return resp, err
+}
+
+// newClient starts a stub and returns a client of it retrying without delay
+func newClient(t *testing.T, options ...client.Option) (*client.Client, *stubserver.Server) {
+	server, err := stubserver.Start(stubserver.Config{})
+	require.NoError(t, err)
+	t.Cleanup(server.Close)
+
+	options = append([]client.Option{client.WithRetryPolicy(client.RetryPolicy{MaxAttempts: 3})}, options...)
+	return client.New(server.URL, server.APIKey, options...), server
+}
+
+// openAccount opens an account holding balance
+func openAccount(t *testing.T, bank *client.Client, name string, balance float64) *client.AccountResponse {
+	initialBalance := client.NewDecimalStringFromFloat(balance)
+	account, err := bank.CreateAccount(context.Background(), client.AccountRequest{AccountName: name, InitialBalance: &initialBalance})
+	require.NoError(t, err)
+	return account
+}
+
+func TestClient_TransferAndConfirm(t *testing.T) {
+	bank, _ := newClient(t)
+	ctx := context.Background()
+	from := openAccount(t, bank, "From", 100)
+	to := openAccount(t, bank, "To", 0)
+
+	transfer, err := bank.Transfer(ctx, from.ID, to.ID, client.NewDecimalStringFromFloat(40), "Rent")
+	require.NoError(t, err)
+	assert.Equal(t, "PENDING", transfer.Status)
+
+	confirmed, err := bank.ConfirmTransaction(ctx, transfer.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "COMPLETED", confirmed.Status)
+
+	account, err := bank.GetAccount(ctx, to.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 40.0, account.Balance)
+
+	reversal, err := bank.ReverseTransaction(ctx, transfer.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "REVERSAL", reversal.Reversal.TransactionType)
+	assert.Equal(t, transfer.ID, *reversal.Reversal.ReversedTransactionID)
+	account, err = bank.GetAccount(ctx, to.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 0.0, account.Balance)
+
+	_, err = bank.ReverseTransaction(ctx, transfer.ID)
+	assert.ErrorIs(t, err, client.ErrTransactionNotReversible)
+}
+
+func TestClient_Errors(t *testing.T) {
+	bank, server := newClient(t)
+	ctx := context.Background()
+	customer := openAccount(t, bank, "Customer", 100)
+
+	_, err := bank.GetAccount(ctx, "2024010112345678")
+	assert.ErrorIs(t, err, client.ErrAccountNotFound)
+	var apiErr *client.APIError
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, http.StatusNotFound, apiErr.StatusCode)
+	assert.Equal(t, "ACCOUNT_NOT_FOUND", apiErr.Code)
+
+	transfer, err := bank.Transfer(ctx, stubserver.InsufficientFundsAccountID, customer.ID, client.NewDecimalStringFromFloat(1), "")
+	require.NoError(t, err)
+	_, err = bank.ConfirmTransaction(ctx, transfer.ID)
+	assert.ErrorIs(t, err, client.ErrInsufficientBalance)
+
+	_, err = client.New(server.URL, "wrong-key").GetAccount(ctx, customer.ID)
+	assert.ErrorIs(t, err, client.ErrUnauthorized)
+}
+
+func TestClient_RetriesCreationOnce(t *testing.T) {
+	transport := &lostResponses{next: http.DefaultTransport}
+	bank, _ := newClient(t, client.WithHTTPClient(&http.Client{Transport: transport}))
+	ctx := context.Background()
+	from := openAccount(t, bank, "From", 100)
+	to := openAccount(t, bank, "To", 0)
+
+	// The server creates the transfer, but the response is lost twice
+	transport.keys = nil
+	transport.lost.Store(2)
+	transfer, err := bank.Transfer(ctx, from.ID, to.ID, client.NewDecimalStringFromFloat(10), "")
+
+	require.NoError(t, err)
+	require.Len(t, transport.keys, 3)
+	assert.Equal(t, transport.keys[0], transport.keys[2])
+	var transactions []client.TransactionResponse
+	for transaction, err := range bank.AccountTransactions(ctx, from.ID, 0) {
+		require.NoError(t, err)
+		transactions = append(transactions, transaction)
+	}
+	require.Len(t, transactions, 1)
+	assert.Equal(t, transfer.ID, transactions[0].ID)
+}
+
+func TestClient_GivesUpAfterMaxAttempts(t *testing.T) {
+	transport := &lostResponses{next: http.DefaultTransport}
+	bank, _ := newClient(t, client.WithHTTPClient(&http.Client{Transport: transport}))
+	transport.lost.Store(5)
+
+	initialBalance := client.NewDecimalStringFromFloat(1)
+	_, err := bank.CreateAccount(context.Background(), client.AccountRequest{AccountName: "Lost", InitialBalance: &initialBalance})
+
+	assert.Error(t, err)
+	assert.Len(t, transport.keys, 3)
+}
+
+// replayer sends every signed POST a second time, as an attacker who captured it would
+type replayer struct {
+	next     http.RoundTripper
+	replayed []*http.Response
+}
+
+func (t *replayer) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		body, _ = io.ReadAll(req.Body)
+		req.Body = io.NopCloser(bytes.NewReader(body))
+	}
+	resp, err := t.next.RoundTrip(req)
+	if err == nil && req.Method == http.MethodPost && req.Header.Get("X-Signature") != "" {
+		replay := req.Clone(req.Context())
+		replay.Body = io.NopCloser(bytes.NewReader(body))
+		if replayed, err := t.next.RoundTrip(replay); err == nil {
+			t.replayed = append(t.replayed, replayed)
+		}
+	}
+	return resp, err
+}
+
+func TestClient_RequestSigning(t *testing.T) {
+	server, err := stubserver.Start(stubserver.Config{SigningSecret: "signing-secret"})
+	require.NoError(t, err)
+	t.Cleanup(server.Close)
+	ctx := context.Background()
+
+	transport := &replayer{next: http.DefaultTransport}
+	bank := client.New(server.URL, server.APIKey, client.WithRequestSigning("signing-secret"), client.WithHTTPClient(&http.Client{Transport: transport}))
+	from := openAccount(t, bank, "From", 100)
+	to := openAccount(t, bank, "To", 0)
+	transfer, err := bank.Transfer(ctx, from.ID, to.ID, client.NewDecimalStringFromFloat(40), "Rent")
+	require.NoError(t, err)
+	_, err = bank.ConfirmTransaction(ctx, transfer.ID)
+	require.NoError(t, err)
+
+	// Every captured creation was turned away when sent again
+	require.Len(t, transport.replayed, 3)
+	for _, resp := range transport.replayed {
+		assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+		resp.Body.Close()
+	}
+	account, err := bank.GetAccount(ctx, from.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 60.0, account.Balance)
+
+	// Reads need no signature, changes do
+	unsigned := client.New(server.URL, server.APIKey)
+	_, err = unsigned.GetAccount(ctx, from.ID)
+	require.NoError(t, err)
+	_, err = unsigned.Transfer(ctx, from.ID, to.ID, client.NewDecimalStringFromFloat(1), "")
+	assert.ErrorIs(t, err, client.ErrInvalidSignature)
+
+	_, err = client.New(server.URL, server.APIKey, client.WithRequestSigning("wrong-secret")).Transfer(ctx, from.ID, to.ID, client.NewDecimalStringFromFloat(1), "")
+	var apiErr *client.APIError
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, "INVALID_SIGNATURE", apiErr.Code)
+}
+
+func TestClient_Accounts(t *testing.T) {
+	bank, _ := newClient(t)
+	for _, name := range []string{"One", "Two", "Three", "Four", "Five"} {
+		openAccount(t, bank, name, 1)
+	}
+
+	// Five accounts plus the three sandbox accounts, two per page
+	var names []string
+	for account, err := range bank.Accounts(context.Background(), client.AccountFilter{PageSize: 2}) {
+		require.NoError(t, err)
+		names = append(names, account.AccountName)
+	}
+	assert.Len(t, names, 8)
+	assert.Subset(t, names, []string{"One", "Two", "Three", "Four", "Five"})
+
+	// Stopping early fetches no further pages
+	count := 0
+	for range bank.Accounts(context.Background(), client.AccountFilter{PageSize: 2, Search: "o"}) {
+		count++
+		break
+	}
+	assert.Equal(t, 1, count)
+}
+
+func TestClient_StopsRetryingWhenCancelled(t *testing.T) {
+	transport := &lostResponses{next: http.DefaultTransport}
+	bank, _ := newClient(t,
+		client.WithHTTPClient(&http.Client{Transport: transport}),
+		client.WithRetryPolicy(client.RetryPolicy{MaxAttempts: 5, BaseDelay: time.Hour}))
+	transport.lost.Store(5)
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	initialBalance := client.NewDecimalStringFromFloat(1)
+	_, err := bank.CreateAccount(ctx, client.AccountRequest{AccountName: "Lost", InitialBalance: &initialBalance})
+
+	assert.Error(t, err)
+	assert.Len(t, transport.keys, 1)
+}
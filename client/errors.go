@@ -0,0 +1,108 @@
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// Sentinel errors for the error codes callers commonly handle; errors.Is matches an *APIError
+// against them. Codes without a sentinel are still available in APIError.Code.
+var (
+	ErrUnauthorized              = errors.New("unauthorized")
+	ErrValidation                = errors.New("request failed validation")
+	ErrAccountNotFound           = errors.New("account not found")
+	ErrAccountCannotTransact     = errors.New("account cannot transact")
+	ErrTransactionNotFound       = errors.New("transaction not found")
+	ErrInsufficientBalance       = errors.New("insufficient balance")
+	ErrLimitExceeded             = errors.New("limit exceeded")
+	ErrTransactionNotConfirmable = errors.New("transaction cannot be confirmed")
+	ErrTransactionNotCancellable = errors.New("transaction cannot be cancelled")
+	ErrTransactionNotReversible  = errors.New("transaction cannot be reversed")
+	ErrTransactionInProgress     = errors.New("transaction in progress")
+	ErrIdempotencyKeyReused      = errors.New("idempotency key reused")
+	ErrInvalidSignature          = errors.New("request signature not accepted")
+	ErrUnavailable               = errors.New("service unavailable")
+)
+
+// codeErrors maps the API's error codes to the sentinel errors they match
+var codeErrors = map[string]error{
+	"MISSING_API_KEY":                 ErrUnauthorized,
+	"INVALID_API_KEY":                 ErrUnauthorized,
+	"UNAUTHORIZED":                    ErrUnauthorized,
+	"VALIDATION_ERROR":                ErrValidation,
+	"DOMAIN_VALIDATION_ERROR":         ErrValidation,
+	"INVALID_JSON":                    ErrValidation,
+	"INVALID_INPUT":                   ErrValidation,
+	"INVALID_AMOUNT":                  ErrValidation,
+	"INVALID_TRANSACTION_AMOUNT":      ErrValidation,
+	"INVALID_ACCOUNT_ID":              ErrValidation,
+	"INVALID_TRANSACTION_ID":          ErrValidation,
+	"MISSING_ACCOUNT_ID":              ErrValidation,
+	"MISSING_REQUIRED_FIELD":          ErrValidation,
+	"SAME_ACCOUNT_TRANSFER":           ErrValidation,
+	"ACCOUNT_NOT_FOUND":               ErrAccountNotFound,
+	"ACCOUNT_CANNOT_TRANSACT":         ErrAccountCannotTransact,
+	"TRANSACTION_NOT_FOUND":           ErrTransactionNotFound,
+	"INSUFFICIENT_BALANCE":            ErrInsufficientBalance,
+	"SPENDING_LIMIT_EXCEEDED":         ErrLimitExceeded,
+	"CHANNEL_LIMIT_EXCEEDED":          ErrLimitExceeded,
+	"PRODUCT_LIMIT_EXCEEDED":          ErrLimitExceeded,
+	"TRANSACTION_CANNOT_BE_CONFIRMED": ErrTransactionNotConfirmable,
+	"TRANSACTION_NOT_DUE":             ErrTransactionNotConfirmable,
+	"TRANSACTION_CANNOT_BE_CANCELLED": ErrTransactionNotCancellable,
+	"TRANSACTION_NOT_REVERSIBLE":      ErrTransactionNotReversible,
+	"TRANSACTION_ALREADY_REVERSED":    ErrTransactionNotReversible,
+	"TRANSACTION_IN_PROGRESS":         ErrTransactionInProgress,
+	"IDEMPOTENCY_KEY_REUSED":          ErrIdempotencyKeyReused,
+	"MISSING_SIGNATURE":               ErrInvalidSignature,
+	"INVALID_SIGNATURE":               ErrInvalidSignature,
+	"SIGNATURE_EXPIRED":               ErrInvalidSignature,
+	"REPLAYED_REQUEST":                ErrInvalidSignature,
+	"REQUEST_SIGNING_UNAVAILABLE":     ErrUnavailable,
+	"SERVICE_READ_ONLY":               ErrUnavailable,
+	"SERVICE_OVERLOADED":              ErrUnavailable,
+	"SERVICE_UNAVAILABLE":             ErrUnavailable,
+	"REQUEST_TIMEOUT":                 ErrUnavailable,
+}
+
+// APIError is an error response of the API
+type APIError struct {
+	StatusCode int               // HTTP status of the response
+	Code       string            // Error code, e.g. INSUFFICIENT_BALANCE
+	Message    string            // Human-readable message
+	Details    map[string]string // Per-field details of validation errors
+}
+
+// Error describes the error with its code
+func (e *APIError) Error() string {
+	return fmt.Sprintf("mini_bank: %s (%d): %s", e.Code, e.StatusCode, e.Message)
+}
+
+// Unwrap returns the sentinel error of the code, so errors.Is(err, ErrInsufficientBalance) works
+func (e *APIError) Unwrap() error {
+	return codeErrors[e.Code]
+}
+
+// newAPIError reads an error response. A body that is not an error response, e.g. from a proxy,
+// keeps the status with an empty code.
+func newAPIError(resp *http.Response) *APIError {
+	apiErr := &APIError{StatusCode: resp.StatusCode}
+
+	var body ErrorResponse
+	data, _ := io.ReadAll(resp.Body)
+	if err := json.Unmarshal(data, &body); err == nil && body.Code != "" {
+		apiErr.Code = body.Code
+		apiErr.Message = body.Message
+		apiErr.Details = body.Details
+		return apiErr
+	}
+
+	apiErr.Message = http.StatusText(resp.StatusCode)
+	if resp.StatusCode == http.StatusServiceUnavailable {
+		apiErr.Code = "SERVICE_UNAVAILABLE"
+	}
+	return apiErr
+}
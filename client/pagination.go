@@ -0,0 +1,57 @@
+package client
+
+import (
+	"context"
+	"iter"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// defaultPageSize is how many items a list iterator fetches per request unless told otherwise
+const defaultPageSize = 50
+
+// maxPageSize is the largest page the API serves
+const maxPageSize = 100
+
+// page is one page of a list response
+type page[T any] struct {
+	items      []T
+	pagination PaginationInfo
+}
+
+// paginate ranges over a list endpoint page by page, fetching the next page once the items of the
+// current one are consumed. An error is yielded once, as the last element.
+func paginate[T any](ctx context.Context, path string, query url.Values, pageSize int,
+	fetch func(ctx context.Context, req request) (page[T], error)) iter.Seq2[T, error] {
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
+	}
+	pageSize = min(pageSize, maxPageSize)
+
+	return func(yield func(T, error) bool) {
+		for number := 1; ; number++ {
+			pageQuery := url.Values{}
+			for key, values := range query {
+				pageQuery[key] = values
+			}
+			pageQuery.Set("page", strconv.Itoa(number))
+			pageQuery.Set("page_size", strconv.Itoa(pageSize))
+
+			current, err := fetch(ctx, request{method: http.MethodGet, path: path, query: pageQuery, idempotent: true})
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+			for _, item := range current.items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			if !current.pagination.HasNext {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,81 @@
+package client
+
+import (
+	"context"
+	"iter"
+	"net/http"
+	"net/url"
+
+	"github.com/hydr0g3nz/mini_bank/internal/application/dto"
+)
+
+// CreateTransaction creates a pending debit, credit or transfer; nothing moves until it is confirmed
+func (c *Client) CreateTransaction(ctx context.Context, req CreateTransactionRequest) (*TransactionResponse, error) {
+	var transaction TransactionResponse
+	if err := c.do(ctx, request{method: http.MethodPost, path: "/transactions", body: req}, &transaction); err != nil {
+		return nil, err
+	}
+	return &transaction, nil
+}
+
+// Transfer creates a pending transfer of amount between two accounts
+func (c *Client) Transfer(ctx context.Context, fromAccountID, toAccountID string, amount DecimalString, description string) (*TransactionResponse, error) {
+	return c.CreateTransaction(ctx, CreateTransactionRequest{
+		FromAccountID:   &fromAccountID,
+		ToAccountID:     &toAccountID,
+		TransactionType: "TRANSFER",
+		Amount:          amount,
+		Description:     description,
+	})
+}
+
+// ConfirmTransaction carries out a pending transaction. The fraud rules may hold it for review
+// instead, which is not an error: the transaction comes back with status REVIEW. Confirming is
+// idempotent, so a confirmation is retried like a read.
+func (c *Client) ConfirmTransaction(ctx context.Context, id string) (*TransactionResponse, error) {
+	var transaction TransactionResponse
+	req := request{method: http.MethodPatch, path: "/transactions/" + url.PathEscape(id) + "/confirm", idempotent: true}
+	if err := c.do(ctx, req, &transaction); err != nil {
+		return nil, err
+	}
+	return &transaction, nil
+}
+
+// GetTransaction retrieves a transaction
+func (c *Client) GetTransaction(ctx context.Context, id string) (*TransactionResponse, error) {
+	var transaction TransactionResponse
+	if err := c.do(ctx, request{method: http.MethodGet, path: "/transactions/" + url.PathEscape(id), idempotent: true}, &transaction); err != nil {
+		return nil, err
+	}
+	return &transaction, nil
+}
+
+// CancelTransaction cancels a transaction or withdraws it from review; the outcome tells what was done
+func (c *Client) CancelTransaction(ctx context.Context, id string) (*CancelTransactionResponse, error) {
+	var cancellation CancelTransactionResponse
+	if err := c.do(ctx, request{method: http.MethodPatch, path: "/transactions/" + url.PathEscape(id) + "/cancel"}, &cancellation); err != nil {
+		return nil, err
+	}
+	return &cancellation, nil
+}
+
+// ReverseTransaction moves the money of a completed transaction back and refunds its fee; a
+// transaction is reversed once
+func (c *Client) ReverseTransaction(ctx context.Context, id string) (*ReverseTransactionResponse, error) {
+	var reversal ReverseTransactionResponse
+	if err := c.do(ctx, request{method: http.MethodPost, path: "/transactions/" + url.PathEscape(id) + "/reverse"}, &reversal); err != nil {
+		return nil, err
+	}
+	return &reversal, nil
+}
+
+// AccountTransactions ranges over an account's transactions, newest first, fetching pageSize per
+// request (50 when 0, at most 100)
+func (c *Client) AccountTransactions(ctx context.Context, accountID string, pageSize int) iter.Seq2[TransactionResponse, error] {
+	path := "/accounts/" + url.PathEscape(accountID) + "/transactions"
+	return paginate(ctx, path, nil, pageSize, func(ctx context.Context, req request) (page[TransactionResponse], error) {
+		var list dto.TransactionListResponse
+		err := c.do(ctx, req, &list)
+		return page[TransactionResponse]{items: list.Transactions, pagination: list.Pagination}, err
+	})
+}
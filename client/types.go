@@ -0,0 +1,27 @@
+package client
+
+import "github.com/hydr0g3nz/mini_bank/internal/application/dto"
+
+// The request and response bodies of the API. They are mini_bank's own DTOs, so a change to the API
+// shows up here as a change to the client's types.
+type (
+	AccountRequest             = dto.AccountRequest
+	AccountResponse            = dto.AccountResponse
+	CreateTransactionRequest   = dto.CreateTransactionRequest
+	TransactionResponse        = dto.TransactionResponse
+	CancelTransactionResponse  = dto.CancelTransactionResponse
+	ReverseTransactionResponse = dto.ReverseTransactionResponse
+	PaginationInfo             = dto.PaginationInfo
+	DecimalString              = dto.DecimalString
+	ErrorResponse              = dto.ErrorResponse
+)
+
+// NewDecimalStringFromFloat creates an amount for a request body
+func NewDecimalStringFromFloat(amount float64) DecimalString {
+	return dto.NewDecimalStringFromFloat(amount)
+}
+
+// ParseDecimalString parses an exact decimal amount such as "19.99"
+func ParseDecimalString(amount string) (DecimalString, error) {
+	return dto.ParseDecimalString(amount)
+}
@@ -0,0 +1,83 @@
+// Command anonymize copies the database into an empty staging database with names, customer IDs,
+// descriptions and references pseudonymized and amounts perturbed, for realistic performance testing.
+package main
+
+import (
+	"context"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/hydr0g3nz/mini_bank/config"
+	infra "github.com/hydr0g3nz/mini_bank/internal/infrastructure"
+)
+
+func main() {
+	cfg := config.LoadFromEnv()
+
+	if err := cfg.ValidateSecrets(); err != nil {
+		log.Fatal("Configuration validation failed:", err)
+	}
+
+	if err := cfg.ValidateAnonymizer(); err != nil {
+		log.Fatal("Configuration validation failed:", err)
+	}
+
+	logger, err := infra.NewSimpleLogger(cfg.IsProduction())
+	if err != nil {
+		log.Fatal("Failed to initialize logger:", err)
+	}
+	defer logger.Sync()
+
+	// A single run needs the secrets once, so they are not refreshed
+	secretsClientConfig := cfg.HTTPClient
+	secretsClientConfig.Timeout = cfg.Secrets.Timeout
+	secretsProvider, err := infra.NewSecretsProvider(cfg.Secrets, infra.NewHTTPClient("secrets", secretsClientConfig))
+	if err != nil {
+		log.Fatal("Failed to create secrets provider:", err)
+	}
+	if err := cfg.LoadSecrets(context.Background(), infra.NewSecretStore(secretsProvider, 0, logger)); err != nil {
+		log.Fatal("Failed to load secrets:", err)
+	}
+
+	queryMetrics := infra.NewQueryMetrics()
+	source, err := infra.ConnectDB(&cfg.Database, logger, queryMetrics)
+	if err != nil {
+		logger.Fatal("Failed to connect to source database", "error", err)
+	}
+
+	target, err := infra.ConnectDB(&cfg.Anonymizer.Target, logger, queryMetrics)
+	if err != nil {
+		logger.Fatal("Failed to connect to target database", "error", err)
+	}
+
+	if err := infra.MigrateDB(target); err != nil {
+		logger.Fatal("Failed to migrate target database", "error", err)
+	}
+
+	anonymizer, err := infra.NewAnonymizer(source, target, cfg.Anonymizer, logger)
+	if err != nil {
+		logger.Fatal("Failed to create anonymizer", "error", err)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	logger.Info("Anonymizing database",
+		"source", cfg.Database.DBName,
+		"target", cfg.Anonymizer.Target.DBName,
+		"amountJitter", cfg.Anonymizer.AmountJitter,
+	)
+
+	report, err := anonymizer.Run(ctx)
+	if err != nil {
+		logger.Fatal("Anonymization failed", "error", err)
+	}
+
+	var rows int64
+	for _, table := range report {
+		rows += table.Rows
+	}
+	logger.Info("Anonymization completed", "tables", len(report), "rows", rows)
+}
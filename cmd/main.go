@@ -2,6 +2,8 @@ package main
 
 import (
 	"context"
+	"errors"
+	"expvar"
 	"log"
 	"net/http"
 	"os"
@@ -12,8 +14,15 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/hydr0g3nz/mini_bank/config"
 	"github.com/hydr0g3nz/mini_bank/internal/adapter/controller"
+	"github.com/hydr0g3nz/mini_bank/internal/adapter/messaging"
 	"github.com/hydr0g3nz/mini_bank/internal/adapter/repository/gorm/repository"
 	usecase "github.com/hydr0g3nz/mini_bank/internal/application"
+	"github.com/hydr0g3nz/mini_bank/internal/application/dto"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	domainInfra "github.com/hydr0g3nz/mini_bank/internal/domain/infra"
+	domainRepository "github.com/hydr0g3nz/mini_bank/internal/domain/repository"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
 	infra "github.com/hydr0g3nz/mini_bank/internal/infrastructure"
 	"go.uber.org/zap"
 )
@@ -22,8 +31,7 @@ func main() {
 	// Load configuration
 	cfg := config.LoadFromEnv()
 
-	// Validate configuration
-	if err := cfg.Validate(); err != nil {
+	if err := cfg.ValidateSecrets(); err != nil {
 		log.Fatal("Configuration validation failed:", err)
 	}
 
@@ -34,14 +42,38 @@ func main() {
 	}
 	defer logger.Sync()
 
+	// Load the secrets kept in a secret store, then keep them current as they rotate
+	secretsClientConfig := cfg.HTTPClient
+	secretsClientConfig.Timeout = cfg.Secrets.Timeout
+	secretsClient := infra.NewHTTPClient("secrets", secretsClientConfig)
+	expvar.Publish("http_client_secrets", secretsClient.Metrics())
+	secretsProvider, err := infra.NewSecretsProvider(cfg.Secrets, secretsClient)
+	if err != nil {
+		log.Fatal("Failed to create secrets provider:", err)
+	}
+	secretStore := infra.NewSecretStore(secretsProvider, cfg.Secrets.RefreshInterval, logger)
+	if err := cfg.LoadSecrets(context.Background(), secretStore); err != nil {
+		log.Fatal("Failed to load secrets:", err)
+	}
+	secretsCtx, stopSecrets := context.WithCancel(context.Background())
+	defer stopSecrets()
+	go secretStore.Run(secretsCtx)
+
+	// Validate configuration
+	if err := cfg.Validate(); err != nil {
+		log.Fatal("Configuration validation failed:", err)
+	}
+
 	logger.Info("Starting Mini Bank API server",
 		"environment", cfg.Server.Environment,
 		"port", cfg.Server.Port,
 	)
 
 	// Connect to database using GORM
-	// Connect to database
-	db, err := infra.ConnectDB(&cfg.Database)
+	// Connect to database, logging queries through the application logger
+	queryMetrics := infra.NewQueryMetrics()
+	expvar.Publish("db_queries", queryMetrics)
+	db, err := infra.ConnectDB(&cfg.Database, logger, queryMetrics)
 	if err != nil {
 		logger.Fatal("Failed to connect to database", zap.Error(err))
 	}
@@ -51,8 +83,36 @@ func main() {
 		logger.Fatal("Failed to run database migrations", zap.Error(err))
 	}
 
+	// Account names are unique within the configured scope
+	nameScope, err := vo.NewAccountNameScope(cfg.NameScope)
+	if err != nil {
+		logger.Fatal("Invalid account name scope", zap.Error(err))
+	}
+	if err := infra.MigrateAccountNameIndex(db, nameScope); err != nil {
+		logger.Fatal("Failed to migrate the account name index", zap.Error(err))
+	}
+
+	// Accounts and transactions stored before accounts carried a currency were in the service currency
+	currency, err := vo.NewCurrency(cfg.Currency)
+	if err != nil {
+		logger.Fatal("Invalid currency", zap.Error(err))
+	}
+	if err := infra.MigrateCurrency(db, currency); err != nil {
+		logger.Fatal("Failed to backfill the currency of accounts and transactions", zap.Error(err))
+	}
+
 	logger.Info("Database connected successfully")
 
+	// Degrade to read-only while the database rejects writes, e.g. during a failover
+	readOnlyMode := infra.NewReadOnlyMode(cfg.Database.ReadOnlyProbe, logger)
+	if err := readOnlyMode.Register(db); err != nil {
+		logger.Fatal("Failed to register read-only detection", "error", err)
+	}
+	expvar.Publish("db_read_only", readOnlyMode)
+	probeCtx, stopProbe := context.WithCancel(context.Background())
+	defer stopProbe()
+	go readOnlyMode.Run(probeCtx, db)
+
 	// Auto-migrate database tables (optional - you might want to use proper migrations)
 	// if err := db.AutoMigrate(&model.Account{}, &model.Transaction{}); err != nil {
 	// 	logger.Fatal("Failed to migrate database", "error", err)
@@ -64,19 +124,392 @@ func main() {
 		Port:     cfg.Cache.Port,
 		Password: cfg.Cache.Password,
 		Db:       cfg.Cache.DB,
+
+		CompressionThreshold: cfg.Cache.CompressionThreshold,
 	})
 	logger.Info("Redis cache connected successfully")
+	expvar.Publish("cache_compression", cache.CompressionMetrics())
+
+	// Optionally layer an in-process LRU in front of Redis for hot reads
+	var cacheService domainInfra.CacheService = cache
+	if cfg.LocalCache.Enabled {
+		localCache := infra.NewLayeredCache(cache, cache, cfg.LocalCache, logger)
+		defer localCache.Close()
+		cacheService = localCache
+		logger.Info("Local cache enabled", "size", cfg.LocalCache.Size, "ttl", cfg.LocalCache.TTL)
+	}
+
+	// Count hits, misses and failures so a failing Redis does not pass for a cold cache
+	cacheMetrics := infra.NewCacheMetrics()
+	expvar.Publish("cache", cacheMetrics)
+	cacheService = infra.NewMeteredCache(cacheService, cacheMetrics, logger)
+
+	// Initialize event bus (Redis fans events out to every instance)
+	var eventBus domainInfra.EventBus
+	switch cfg.EventBus.Driver {
+	case "redis":
+		redisEventBus := infra.NewRedisEventBus(cache, logger)
+		defer redisEventBus.Close()
+		eventBus = redisEventBus
+	default:
+		eventBus = infra.NewInMemoryEventBus(logger)
+	}
+	logger.Info("Event bus initialized", "driver", cfg.EventBus.Driver)
 
 	// Initialize repositories
-	accountRepo := repository.NewAccountRepository(db)
-	transactionRepo := repository.NewTransactionRepository(db)
+	// Writes to the contended account and transaction rows are retried when they deadlock
+	retrier := repository.NewRetrier(repository.RetryPolicy{
+		MaxAttempts: cfg.Database.RetryMaxAttempts,
+		BaseDelay:   cfg.Database.RetryBaseDelay,
+		MaxDelay:    cfg.Database.RetryMaxDelay,
+	})
+	expvar.Publish("db_retries", retrier.Metrics())
+	accountRepo := repository.NewRetryingAccountRepository(repository.NewAccountRepository(db), retrier)
+	transactionRepo := repository.NewRetryingTransactionRepository(repository.NewTransactionRepository(db), retrier)
+	backupRepo := repository.NewBackupRepository(db)
+	outboxRepo := repository.NewOutboxRepository(db)
+	sagaRepo := repository.NewSagaRepository(db)
+	unitOfWork := repository.NewUnitOfWork(db)
+	holidayRepo := repository.NewHolidayRepository(db)
+	categoryRepo := repository.NewCategoryRepository(db)
+	categoryOverrideRepo := repository.NewCategoryOverrideRepository(db)
+	businessRuleRepo := repository.NewBusinessRuleRepository(db)
+	productRepo := repository.NewProductRepository(db)
+	productMigrationRepo := repository.NewProductMigrationRepository(db)
+	cashbackRepo := repository.NewCashbackRepository(db)
+	referralRepo := repository.NewReferralRepository(db)
+	transferTemplateRepo := repository.NewTransferTemplateRepository(db)
+	scheduledTransactionRepo := repository.NewScheduledTransactionRepository(db)
+	notificationTemplateRepo := repository.NewNotificationTemplateRepository(db)
+	notificationPreferenceRepo := repository.NewNotificationPreferenceRepository(db)
+	accountingPeriodRepo := repository.NewAccountingPeriodRepository(db)
+	generalLedgerRepo := repository.NewGeneralLedgerRepository(db)
+	budgetRepo := repository.NewBudgetRepository(db)
+	customerRepo := repository.NewCustomerRepository(db)
+	webhookRepo := repository.NewWebhookRepository(db)
+	virtualAccountRepo := repository.NewVirtualAccountRepository(db)
+	auditRepo := repository.NewAuditRepository(db)
+	adminUserRepo := repository.NewAdminUserRepository(db)
+	adminActivityRepo := repository.NewAdminActivityRepository(db)
+	historyRepo := repository.NewTransactionHistoryRepository(db)
+	transactionTagRepo := repository.NewTransactionTagRepository(db)
+	savedFilterRepo := repository.NewSavedFilterRepository(db)
+	attachmentRepo := repository.NewAttachmentRepository(db)
+	aggregateRepo := repository.NewDailyAggregateRepository(db)
+	exportRepo := repository.NewExportRepository(db)
+	jobRepo := repository.NewJobRepository(db)
+	ownershipTransferRepo := repository.NewOwnershipTransferRepository(db)
+	transactionBlockRepo := repository.NewTransactionBlockRepository(db)
 	logger.Info("Repositories initialized")
 
+	// Optionally stream events to Kafka through the outbox (at-least-once delivery)
+	var eventPublisher domainInfra.EventPublisher = eventBus
+	var transactionOutbox domainRepository.OutboxRepository
+	relayCtx, stopRelay := context.WithCancel(context.Background())
+	defer stopRelay()
+	if cfg.Kafka.Enabled {
+		kafkaPublisher := infra.NewKafkaEventPublisher(cfg.Kafka)
+		defer kafkaPublisher.Close()
+		eventPublisher = infra.NewOutboxEventPublisher(outboxRepo, eventBus)
+		// Transaction events are stored in the unit of work of the change they report
+		transactionOutbox = outboxRepo
+
+		relay := usecase.NewOutboxRelay(outboxRepo, unitOfWork, kafkaPublisher, cfg.Kafka.OutboxBatchSize, cfg.Kafka.OutboxPollInterval, logger)
+		go relay.Run(relayCtx)
+		logger.Info("Kafka event publishing enabled", "brokers", cfg.Kafka.Brokers, "topicPrefix", cfg.Kafka.TopicPrefix)
+	}
+
+	// Keep every domain event in the audit log, including those raised by the decorators below
+	eventPublisher = usecase.NewAuditRecorder(auditRepo, eventPublisher, logger)
+
+	// Project transactions into the history read model, including those raised by the decorators below
+	eventPublisher = usecase.NewTransactionHistoryProjector(historyRepo, accountRepo, transactionRepo, eventPublisher, logger)
+
+	// Add completed transactions to the daily aggregates behind reports
+	eventPublisher = usecase.NewDailyAggregator(aggregateRepo, transactionRepo, eventPublisher, logger)
+
+	// Book completed transactions into the general ledger behind the trial balance
+	eventPublisher = usecase.NewGeneralLedgerPoster(generalLedgerRepo, transactionRepo, accountRepo, eventPublisher, logger)
+
+	// Sandboxes run on a test clock admins can advance; value dating, reviews and jobs read the time from it
+	var clock domainInfra.Clock = infra.SystemClock{}
+	var clockUseCase usecase.ClockUseCase
+	if cfg.Sandbox {
+		testClock := infra.NewOffsetClock()
+		clock = testClock
+		clockUseCase = usecase.NewClockUseCase(testClock, logger)
+		logger.Warn("Sandbox mode: running on a test clock admins can advance")
+	}
+
+	// Initialize processing window and business calendar for value dating
+	processingWindow, err := vo.NewProcessingWindow(cfg.Processing.Cutoff, cfg.Processing.Timezone, cfg.Processing.CutoffTransactionTypes)
+	if err != nil {
+		logger.Fatal("Invalid processing window", "error", err)
+	}
+	calendar := usecase.NewHolidayCalendar(holidayRepo, cfg.Processing.Region)
+	valueDating := usecase.NewValueDatingPolicy(calendar, processingWindow, clock)
+	calendarUseCase := usecase.NewCalendarUseCase(holidayRepo, valueDating, logger)
+	for _, holiday := range cfg.Processing.Holidays {
+		_, err := calendarUseCase.AddHoliday(context.Background(), dto.CreateHolidayRequest{
+			Region: cfg.Processing.Region,
+			Date:   holiday,
+			Name:   "Holiday",
+		})
+		if err != nil && !errors.Is(err, errs.ErrHolidayAlreadyExists) {
+			logger.Fatal("Failed to seed holiday", "error", err, "date", holiday)
+		}
+	}
+	categorizer := usecase.NewCategorizer(categoryRepo, categoryOverrideRepo, logger)
+
+	// Evaluate the admin-defined validation and fee rules on new transactions
+	ruleEngine, err := infra.NewCELRuleEngine(cfg.Rules, usecase.TransactionRuleFacts)
+	if err != nil {
+		logger.Fatal("Failed to create rule engine", "error", err)
+	}
+	businessRules := usecase.NewBusinessRules(businessRuleRepo, ruleEngine, cfg.Currency, logger)
+	productPolicy := usecase.NewProductPolicy(accountRepo, productRepo, logger)
+
+	// Hold confirmations flagged by the fraud rules for an admin to review
+	var fraudRules []usecase.FraudRule
+	if cfg.Review.AmountThreshold > 0 {
+		fraudRules = append(fraudRules, usecase.LargeAmountRule{Threshold: vo.NewMoneyFromFloat(cfg.Review.AmountThreshold)})
+	}
+
+	// In sandbox mode the magic test accounts fail, slow down or hold the transactions they take part in
+	var sandbox *usecase.Sandbox
+	if cfg.Sandbox {
+		sandbox = usecase.NewSandbox(accountRepo, cfg.SandboxDelay, logger)
+		if err := sandbox.SeedAccounts(context.Background()); err != nil {
+			logger.Fatal("Failed to open sandbox accounts", "error", err)
+		}
+		fraudRules = append(fraudRules, usecase.SandboxScreeningRule{})
+	}
+	reviewPolicy := usecase.NewReviewPolicy(usecase.NewFraudEngine(logger, fraudRules...), cfg.Review.SLA, cfg.Review.ClaimTTL)
+
+	// Cap single transactions per channel
+	channelLimits, err := vo.NewChannelLimits(cfg.Limits.Channel)
+	if err != nil {
+		logger.Fatal("Invalid channel limits", "error", err)
+	}
+
+	// Cap what accounts send out per day and hour, counted in Redis across instances
+	velocityLimits, err := vo.NewVelocityLimits(cfg.Limits.DailyDebit, cfg.Limits.HourlyTransactions, cfg.Limits.WarningPercent)
+	if err != nil {
+		logger.Fatal("Invalid velocity limits", "error", err)
+	}
+
+	// Clean up the free text entered with transactions
+	normalizers, err := vo.NewTextNormalizers(cfg.Limits.DescriptionMaxLength, cfg.Limits.DescriptionFilters, cfg.Limits.ProfaneWords, cfg.Limits.DescriptionChannels)
+	if err != nil {
+		logger.Fatal("Invalid description normalization", "error", err)
+	}
+
+	// Write credits to hot accounts in batches
+	var creditBatcher *usecase.CreditBatcher
+	if len(cfg.Batching.Accounts) > 0 {
+		hotAccounts := make([]vo.AccountID, len(cfg.Batching.Accounts))
+		for i, account := range cfg.Batching.Accounts {
+			hotAccounts[i], _ = vo.NewAccountIDFromString(account) // Validated with the configuration
+		}
+		creditBatcher = usecase.NewCreditBatcher(accountRepo, usecase.CreditBatchConfig{
+			Accounts: hotAccounts,
+			MaxSize:  cfg.Batching.MaxSize,
+			MaxWait:  cfg.Batching.MaxWait,
+		}, logger)
+	}
+
+	// Track budgets as payments complete, alerting through the same event pipeline
+	eventPublisher = usecase.NewBudgetTracker(budgetRepo, transactionRepo, categorizer, eventPublisher, logger)
+
+	// Deliver account webhooks as transactions complete
+	webhookClientConfig := cfg.HTTPClient
+	webhookClientConfig.Timeout = cfg.Webhook.Timeout
+	webhookClient := infra.NewHTTPClient("webhooks", webhookClientConfig)
+	expvar.Publish("http_client_webhooks", webhookClient.Metrics())
+	webhookSender := infra.NewHTTPWebhookSender(webhookClient)
+	eventPublisher = usecase.NewWebhookNotifier(webhookRepo, accountRepo, webhookSender, cfg.Webhook.MaxFailures, eventPublisher, logger)
+
+	// Notify customers of the events they chose; notifications are written to the log until an
+	// email, SMS or push provider is configured
+	notificationSender := infra.NewLogNotificationSender(logger)
+	eventPublisher = usecase.NewNotificationDispatcher(notificationPreferenceRepo, notificationTemplateRepo, accountRepo, notificationSender, eventPublisher, logger)
+
+	// Pay cashback as payments complete; the credits go out through the webhook and budget pipeline
+	eventPublisher = usecase.NewCashbackEngine(cashbackRepo, accountRepo, transactionRepo, cacheService, eventPublisher, valueDating, logger)
+
+	// Pay referral bonuses on the referee's first qualifying payment
+	referralProgram := usecase.ReferralProgram{
+		ReferrerBonus:       vo.NewMoneyFromFloat(cfg.Referral.ReferrerBonus),
+		RefereeBonus:        vo.NewMoneyFromFloat(cfg.Referral.RefereeBonus),
+		MinQualifyingAmount: vo.NewMoneyFromFloat(cfg.Referral.MinQualifyingAmount),
+		DailyLimit:          cfg.Referral.DailyLimit,
+	}
+	eventPublisher = usecase.NewReferralRewarder(referralRepo, accountRepo, transactionRepo, cacheService, referralProgram, eventPublisher, valueDating, logger)
+
+	// Serve list pages stale while they refresh in the background
+	listPolicies, err := usecase.ParseListCachePolicies(cfg.Cache.ListPolicies)
+	if err != nil {
+		logger.Fatal("Invalid list cache policies", "error", err)
+	}
+	listCache := usecase.NewListCache(cacheService, listPolicies, logger)
+	expvar.Publish("list_cache", listCache.Metrics())
+
+	// Run backups, exports and recurring maintenance as persisted jobs with retries
+	jobQueue := usecase.NewJobQueue(jobRepo, usecase.JobQueueConfig{
+		Workers:        cfg.Jobs.Workers,
+		PollInterval:   cfg.Jobs.PollInterval,
+		Lease:          cfg.Jobs.Lease,
+		MaxAttempts:    cfg.Jobs.MaxAttempts,
+		RetryBaseDelay: cfg.Jobs.RetryBaseDelay,
+		RetryMaxDelay:  cfg.Jobs.RetryMaxDelay,
+		Clock:          clock,
+	}, logger)
+	expvar.Publish("jobs", jobQueue.Metrics())
+
 	// Initialize use cases
-	accountUseCase := usecase.NewAccountUseCase(accountRepo, cache, logger)
-	transactionUseCase := usecase.NewTransactionUseCase(transactionRepo, accountRepo, cache, logger)
+	accountUseCase := usecase.NewAccountUseCase(accountRepo, productRepo, cacheService, listCache, eventPublisher, nameScope, cfg.Currency, logger)
+	periodLock := usecase.NewPeriodLock(accountingPeriodRepo)
+	transactionBlocks := usecase.NewTransactionBlocks(transactionBlockRepo)
+	velocity := usecase.NewVelocityLimits(cache, velocityLimits, valueDating, logger)
+
+	// Convert FX transfers at the configured provider's rates
+	ratesClientConfig := cfg.HTTPClient
+	ratesClientConfig.Timeout = cfg.Rates.Timeout
+	ratesClient := infra.NewHTTPClient("exchange_rates", ratesClientConfig)
+	expvar.Publish("http_client_exchange_rates", ratesClient.Metrics())
+	exchangeRates, err := infra.NewExchangeRateService(cfg.Rates, ratesClient)
+	if err != nil {
+		logger.Fatal("Failed to create exchange rate provider", "error", err)
+	}
+
+	transactionUseCase := usecase.NewTransactionUseCase(transactionRepo, accountRepo, virtualAccountRepo, sagaRepo, unitOfWork, cacheService, cache, listCache, eventPublisher, transactionOutbox, valueDating, reviewPolicy, categorizer, businessRules, productPolicy, creditBatcher, periodLock, transactionBlocks, velocity, sandbox, exchangeRates, channelLimits, normalizers, cfg.Currency, logger)
+	backupService := infra.NewPgDumpBackupService(&cfg.Database, cfg.Backup)
+	backupUseCase := usecase.NewBackupUseCase(backupRepo, accountRepo, transactionRepo, backupService, jobQueue, logger)
+	sagaUseCase := usecase.NewSagaUseCase(sagaRepo, logger)
+	commandUseCase := usecase.NewCommandUseCase(transactionUseCase, cacheService, logger)
+	categoryUseCase := usecase.NewCategoryUseCase(categoryRepo, categoryOverrideRepo, transactionRepo, valueDating, logger)
+	budgetUseCase := usecase.NewBudgetUseCase(budgetRepo, accountRepo, categoryRepo, transactionRepo, categorizer, valueDating, logger)
+	businessRuleUseCase := usecase.NewBusinessRuleUseCase(businessRuleRepo, ruleEngine, cfg.Currency, logger)
+	productUseCase := usecase.NewProductUseCase(productRepo, cfg.Currency, logger)
+	productMigrationUseCase := usecase.NewProductMigrationUseCase(productMigrationRepo, productRepo, accountRepo, cacheService, eventPublisher, valueDating, logger)
+	cashbackUseCase := usecase.NewCashbackUseCase(cashbackRepo, accountRepo, logger)
+	referralUseCase := usecase.NewReferralUseCase(referralRepo, accountRepo, referralProgram, valueDating, logger)
+	transferTemplateUseCase := usecase.NewTransferTemplateUseCase(transferTemplateRepo, accountRepo, transactionUseCase, valueDating, logger)
+	scheduledTransactionUseCase := usecase.NewScheduledTransactionUseCase(scheduledTransactionRepo, accountRepo, transactionUseCase, valueDating, logger)
+	notificationTemplateUseCase := usecase.NewNotificationTemplateUseCase(notificationTemplateRepo, notificationSender, logger)
+	notificationPreferenceUseCase := usecase.NewNotificationPreferenceUseCase(notificationPreferenceRepo, customerRepo, logger)
+	accountingPeriodUseCase := usecase.NewAccountingPeriodUseCase(accountingPeriodRepo, valueDating, logger)
+	generalLedgerUseCase := usecase.NewGeneralLedgerUseCase(generalLedgerRepo, transactionRepo, productRepo, valueDating, logger)
+	cacheUseCase := usecase.NewCacheUseCase(cacheService, eventPublisher, logger)
+	historyUseCase := usecase.NewTransactionHistoryUseCase(historyRepo, accountRepo, transactionRepo, transactionTagRepo, savedFilterRepo, categorizer, logger)
+	transactionTagUseCase := usecase.NewTransactionTagUseCase(transactionTagRepo, savedFilterRepo, accountRepo, transactionRepo, logger)
+	aggregateUseCase := usecase.NewDailyAggregateUseCase(aggregateRepo, accountRepo, transactionRepo, valueDating, logger)
+	customerUseCase := usecase.NewCustomerUseCase(customerRepo, cfg.Currency, valueDating, logger)
+	ownershipTransferUseCase := usecase.NewOwnershipTransferUseCase(ownershipTransferRepo, accountRepo, cacheService, eventPublisher, valueDating, nameScope, logger)
+	transactionBlockUseCase := usecase.NewTransactionBlockUseCase(transactionBlockRepo, accountRepo, eventPublisher, valueDating, logger)
+	velocityUseCase := usecase.NewVelocityUseCase(velocity, accountRepo, logger)
+	monitorUseCase := usecase.NewTransactionMonitorUseCase(eventBus, logger)
+	webhookUseCase := usecase.NewWebhookUseCase(webhookRepo, accountRepo, webhookSender, valueDating, logger)
+	adminUserUseCase := usecase.NewAdminUserUseCase(adminUserRepo, eventPublisher, logger)
+	exchangeRateUseCase := usecase.NewExchangeRateUseCase(exchangeRates, cfg.Currency, logger)
+	statementUseCase := usecase.NewStatementUseCase(transactionRepo, accountRepo, valueDating, logger)
+
+	// Every admin request is recorded; new IPs, requests outside working hours and bursts of changes alert security
+	adminWorkingHours, err := vo.NewAdminWorkingHours(cfg.Security.AdminWorkingHours, cfg.Security.AdminTimezone)
+	if err != nil {
+		logger.Fatal("Invalid admin working hours", "error", err)
+	}
+	adminSecurityUseCase := usecase.NewAdminSecurityUseCase(adminActivityRepo, notificationSender, eventPublisher, usecase.AdminSecurityPolicy{
+		WorkingHours:           adminWorkingHours,
+		MassOperationThreshold: cfg.Security.MassOperationThreshold,
+		MassOperationWindow:    cfg.Security.MassOperationWindow,
+		AlertRecipients:        cfg.Security.AlertEmails,
+	}, logger)
+	virtualAccountUseCase := usecase.NewVirtualAccountUseCase(virtualAccountRepo, accountRepo, transactionRepo, logger)
+	auditUseCase := usecase.NewAuditUseCase(auditRepo, cfg.Audit.SigningKey, cfg.Audit.Retention, valueDating, logger)
+
+	// Exports are written to blob storage and downloaded through signed links until they expire
+	blobStorage := infra.NewLocalBlobStorage(cfg.Blobs)
+	downloadLinks := usecase.NewDownloadLinks(cfg.Export.SigningKey, cfg.Export.PublicURL, cfg.Export.LinkTTL, valueDating)
+	exportUseCase := usecase.NewExportUseCase(exportRepo, accountRepo, auditUseCase, historyUseCase, blobStorage, downloadLinks, cfg.Export.Retention, jobQueue, webhookSender, valueDating, logger)
+	attachmentUseCase := usecase.NewAttachmentUseCase(attachmentRepo, accountRepo, transactionRepo, blobStorage, logger)
+	jobUseCase := usecase.NewJobUseCase(jobRepo, cfg.Jobs.Retention, valueDating, logger)
 	logger.Info("Use cases initialized")
 
+	// Decline reviews left undecided past their SLA, and purge audit entries, exports and jobs past
+	// their retention, once per interval across all instances
+	jobQueue.Schedule(usecase.JobTypeReviewSweep, cfg.Review.SweepInterval, func(ctx context.Context, job *entity.Job) error {
+		_, err := transactionUseCase.DeclineOverdueReviews(ctx)
+		return err
+	})
+	if cfg.Audit.Retention > 0 {
+		jobQueue.Schedule(usecase.JobTypeAuditPurge, cfg.Audit.PurgeInterval, func(ctx context.Context, job *entity.Job) error {
+			_, err := auditUseCase.PurgeExpired(ctx)
+			return err
+		})
+	}
+	jobQueue.Schedule(usecase.JobTypeExportPurge, cfg.Export.PurgeInterval, func(ctx context.Context, job *entity.Job) error {
+		_, err := exportUseCase.PurgeExpired(ctx)
+		return err
+	})
+	jobQueue.Schedule(usecase.JobTypeJobPurge, cfg.Jobs.PurgeInterval, func(ctx context.Context, job *entity.Job) error {
+		_, err := jobUseCase.PurgeFinished(ctx)
+		return err
+	})
+	jobQueue.Schedule(usecase.JobTypeAttachmentPurge, cfg.AttachmentPurgeInterval, func(ctx context.Context, job *entity.Job) error {
+		_, err := attachmentUseCase.PurgeArchived(ctx)
+		return err
+	})
+
+	// Hand accounts to their new owners once approved ownership transfers reach their effective date
+	jobQueue.Schedule(usecase.JobTypeOwnershipTransfers, cfg.OwnershipTransferInterval, func(ctx context.Context, job *entity.Job) error {
+		_, err := ownershipTransferUseCase.ApplyDueTransfers(ctx)
+		return err
+	})
+
+	// Move accounts to their new products once scheduled product migrations reach their effective date
+	jobQueue.Schedule(usecase.JobTypeProductMigrations, cfg.ProductMigrationInterval, func(ctx context.Context, job *entity.Job) error {
+		_, err := productMigrationUseCase.ApplyDueMigrations(ctx)
+		return err
+	})
+
+	// Make the transfers of scheduled transactions once their next run is due
+	jobQueue.Schedule(usecase.JobTypeScheduledTransactions, cfg.ScheduledTransactionInterval, func(ctx context.Context, job *entity.Job) error {
+		_, err := scheduledTransactionUseCase.RunDueTransactions(ctx)
+		return err
+	})
+
+	jobCtx, stopJobs := context.WithCancel(context.Background())
+	defer stopJobs()
+	jobsDone := make(chan struct{})
+	go func() {
+		jobQueue.Run(jobCtx)
+		close(jobsDone)
+	}()
+	logger.Info("Job queue started", "workers", cfg.Jobs.Workers)
+
+	// Optionally accept transfer commands from NATS JetStream
+	if cfg.NATS.Enabled {
+		natsConn, js, err := infra.ConnectJetStream(context.Background(), cfg.NATS)
+		if err != nil {
+			logger.Fatal("Failed to connect to NATS JetStream", "error", err)
+		}
+		defer natsConn.Drain()
+
+		transferConsumer := messaging.NewTransferCommandConsumer(js, commandUseCase, messaging.TransferConsumerConfig{
+			Stream:         cfg.NATS.Stream,
+			CommandSubject: cfg.NATS.CommandSubject,
+			ResultSubject:  cfg.NATS.ResultSubject,
+			Durable:        cfg.NATS.Durable,
+			MaxDeliver:     cfg.NATS.MaxDeliver,
+		}, logger)
+		if err := transferConsumer.Start(context.Background()); err != nil {
+			logger.Fatal("Failed to start transfer command consumer", "error", err)
+		}
+		defer transferConsumer.Stop()
+		logger.Info("Transfer command consumer started", "subject", cfg.NATS.CommandSubject)
+	}
+
 	// Set Gin mode based on environment
 	gin.SetMode(cfg.Server.Environment)
 
@@ -85,11 +518,27 @@ func main() {
 
 	// Setup routes
 	routerConfig := controller.RouterConfig{
-		APIKey: cfg.API.Key,
-		Logger: logger,
+		APIKey:      cfg.API.Key,
+		Envelope:    cfg.API.Envelope,
+		FieldNaming: cfg.API.FieldNaming,
+		Logger:      logger,
+
+		DatabaseMode: readOnlyMode,
+		RouteLimits:  cfg.Routes,
+		Idempotency:  cacheService,
+		Admins:       adminUserUseCase,
+
+		AdminActivity: adminSecurityUseCase,
+		GeoHeader:     cfg.Security.GeoHeader,
+
+		RequestSigning: controller.RequestSigning{
+			Keys:      cfg.API.SigningKeys,
+			Tolerance: cfg.API.SigningTolerance,
+			Nonces:    cache,
+		},
 	}
 
-	controller.SetupRoutes(router, accountUseCase, transactionUseCase, routerConfig)
+	controller.SetupRoutes(router, accountUseCase, transactionUseCase, backupUseCase, sagaUseCase, calendarUseCase, categoryUseCase, budgetUseCase, customerUseCase, ownershipTransferUseCase, transactionBlockUseCase, velocityUseCase, monitorUseCase, webhookUseCase, virtualAccountUseCase, auditUseCase, businessRuleUseCase, productUseCase, productMigrationUseCase, cashbackUseCase, referralUseCase, transferTemplateUseCase, scheduledTransactionUseCase, notificationTemplateUseCase, notificationPreferenceUseCase, accountingPeriodUseCase, generalLedgerUseCase, cacheUseCase, historyUseCase, transactionTagUseCase, attachmentUseCase, aggregateUseCase, exportUseCase, jobUseCase, clockUseCase, adminUserUseCase, adminSecurityUseCase, exchangeRateUseCase, statementUseCase, routerConfig)
 	logger.Info("Routes configured")
 
 	// HTTP Server configuration
@@ -112,6 +561,25 @@ func main() {
 		}
 	}()
 
+	// Optionally serve pprof, expvar and goroutine dumps on a separate port, behind the API key
+	var debugServer *http.Server
+	if cfg.Server.DebugPort != "" {
+		debugRouter := gin.New()
+		controller.SetupDebugRoutes(debugRouter, routerConfig)
+		debugServer = &http.Server{
+			Addr:              cfg.GetDebugAddress(),
+			Handler:           debugRouter,
+			ReadHeaderTimeout: 10 * time.Second, // No write timeout: CPU profiles and traces run for their requested duration
+		}
+
+		go func() {
+			logger.Info("Debug server starting", "address", debugServer.Addr)
+			if err := debugServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+				logger.Error("Debug server failed", "error", err)
+			}
+		}()
+	}
+
 	// Wait for interrupt signal to gracefully shutdown the server
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
@@ -130,6 +598,19 @@ func main() {
 		logger.Info("Server shutdown completed")
 	}
 
+	if debugServer != nil {
+		if err := debugServer.Shutdown(ctx); err != nil {
+			logger.Error("Debug server forced to shutdown", "error", err)
+		}
+	}
+
+	// Stop relaying the outbox before closing its database
+	stopRelay()
+
+	// Return running jobs to the queue before closing its database
+	stopJobs()
+	<-jobsDone
+
 	// Close database connection
 	if sqlDB, err := db.DB(); err == nil {
 		if err := sqlDB.Close(); err != nil {
@@ -1,21 +1,59 @@
 package config
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"strconv"
+	"strings"
+	"time"
 
+	"github.com/hydr0g3nz/mini_bank/internal/adapter/controller"
+	usecase "github.com/hydr0g3nz/mini_bank/internal/application"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/infra"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
 	"github.com/hydr0g3nz/mini_bank/internal/infrastructure"
 	"github.com/joho/godotenv"
 )
 
 // Config holds application configuration
 type Config struct {
-	Server   ServerConfig
-	Database infrastructure.DBConfig
-	Cache    CacheConfig
-	API      APIConfig
-	LogLevel string
+	Server       ServerConfig
+	Database     infrastructure.DBConfig
+	Cache        CacheConfig
+	LocalCache   infrastructure.LocalCacheConfig
+	EventBus     infrastructure.EventBusConfig
+	Kafka        infrastructure.KafkaConfig
+	NATS         infrastructure.NATSConfig
+	API          APIConfig
+	Routes       controller.RouteLimits
+	Backup       infrastructure.BackupConfig
+	Webhook      infrastructure.WebhookConfig
+	HTTPClient   infrastructure.HTTPClientConfig // Shared by outbound integrations; each sets its own timeout
+	Secrets      infrastructure.SecretsConfig
+	Rates        infrastructure.ExchangeRateConfig
+	Processing   ProcessingConfig
+	Review       ReviewConfig
+	Limits       LimitsConfig
+	Batching     BatchingConfig
+	Audit        AuditConfig
+	Security     SecurityConfig
+	Export       ExportConfig
+	Blobs        infrastructure.BlobStorageConfig
+	Jobs         JobsConfig
+	Rules        infrastructure.RuleEngineConfig
+	Referral     ReferralConfig
+	Anonymizer   infrastructure.AnonymizerConfig
+	Currency     string        // Currency all account balances are held in
+	NameScope    string        // Among which accounts an account name must be unique: GLOBAL, CUSTOMER or NONE
+	Sandbox      bool          // Run on a test clock admins can advance and open the magic test accounts; for integration tests and sandbox tenants only
+	SandboxDelay time.Duration // How long transactions of the slow sandbox test account take to confirm
+	LogLevel     string
+
+	OwnershipTransferInterval    time.Duration // How often approved ownership transfers that reached their effective date are applied
+	ProductMigrationInterval     time.Duration // How often scheduled product migrations that reached their effective date are applied
+	AttachmentPurgeInterval      time.Duration // How often the attachments of deleted accounts are purged
+	ScheduledTransactionInterval time.Duration // How often the transfers of scheduled transactions that are due are made
 }
 
 // ServerConfig holds server configuration
@@ -26,6 +64,8 @@ type ServerConfig struct {
 	ReadTimeout  int // in seconds
 	WriteTimeout int // in seconds
 	IdleTimeout  int // in seconds
+	DebugHost    string
+	DebugPort    string // Port of the pprof/expvar diagnostics server; empty disables it
 }
 
 // CacheConfig holds Redis cache configuration
@@ -34,11 +74,105 @@ type CacheConfig struct {
 	Port     int
 	Password string
 	DB       int
+
+	CompressionThreshold int      // Values whose JSON is at least this many bytes are stored gzipped; 0 disables it
+	ListPolicies         []string // ENDPOINT:SOFT_SECONDS:HARD_SECONDS overrides of the list page stale-while-revalidate TTLs
 }
 
 // APIConfig holds API configuration
 type APIConfig struct {
-	Key string
+	Key         infra.SecretValue
+	Envelope    bool                   // Wrap success responses in {message, data} by default
+	FieldNaming controller.FieldNaming // Field names of JSON responses by default: snake_case or camelCase
+
+	SigningKeys      infra.SecretValue // KEY_ID:SECRET signing secrets of the API keys that must sign the requests changing anything
+	SigningTolerance time.Duration     // How far a signed request's timestamp may be from the server's clock
+}
+
+// ProcessingConfig holds processing window and business calendar configuration
+type ProcessingConfig struct {
+	Cutoff                 string // HH:MM in Timezone; empty disables the cutoff
+	Timezone               string
+	CutoffTransactionTypes []string
+	Region                 string   // Calendar region used for value dating
+	Holidays               []string // YYYY-MM-DD; seeded into Region at startup
+}
+
+// ReviewConfig holds fraud review configuration
+type ReviewConfig struct {
+	AmountThreshold float64       // Confirmations of at least this amount are held for review; 0 disables it
+	SLA             time.Duration // Time admins have to decide before a review is declined automatically
+	SweepInterval   time.Duration
+	ClaimTTL        time.Duration // How long an admin's claim on a review holds unless renewed
+}
+
+// LimitsConfig holds transaction limit configuration
+type LimitsConfig struct {
+	Channel []string // CHANNEL:AMOUNT caps on a single transaction; channels not listed are unlimited
+
+	// Velocity limits on what an account sends out, counted in Redis
+	DailyDebit         float64 // Most debited per business day; 0 is unlimited
+	HourlyTransactions int     // Most debits and transfers out per hour; 0 is unlimited
+	WarningPercent     int     // Share of a velocity limit from which confirmations warn; 0 never warns
+
+	// Normalization of transaction descriptions and references
+	DescriptionMaxLength int      // 0 leaves the length alone
+	DescriptionFilters   []string // EMOJI and/or PROFANITY
+	ProfaneWords         []string // Words masked by the PROFANITY filter
+	DescriptionChannels  []string // CHANNEL:MAX_LENGTH[:FILTER+FILTER] overrides of the above
+}
+
+// BatchingConfig holds hot account credit batching configuration
+type BatchingConfig struct {
+	Accounts []string      // Hot accounts whose credits are batched; empty disables batching
+	MaxSize  int           // Most credits applied in one write
+	MaxWait  time.Duration // How long the first credit of a batch waits for others to join it
+}
+
+// ReferralConfig holds referral program configuration
+type ReferralConfig struct {
+	ReferrerBonus       float64 // Paid to the referrer; 0 pays nothing
+	RefereeBonus        float64 // Paid to the referee; 0 pays nothing
+	MinQualifyingAmount float64 // Smallest first payment of the referee that earns the bonuses
+	DailyLimit          int     // Most redemptions of one referral code in 24 hours
+}
+
+// AuditConfig holds audit log configuration
+type AuditConfig struct {
+	SigningKey    infra.SecretValue // HMAC key audit exports are signed with
+	Retention     time.Duration     // Entries older than this are purged; 0 keeps them forever
+	PurgeInterval time.Duration
+}
+
+// SecurityConfig holds the configuration of admin activity monitoring
+type SecurityConfig struct {
+	AlertEmails            []string // Security team addresses alerted of anomalous admin activity; empty alerts no one
+	AdminWorkingHours      string   // HH:MM-HH:MM window admin activity is expected in; empty makes every hour usual
+	AdminTimezone          string   // IANA time zone the working hours are in
+	MassOperationThreshold int      // Changes an admin may make within the window before it is a mass operation; 0 never flags one
+	MassOperationWindow    time.Duration
+	GeoHeader              string // Header the edge proxy reports the client's country in; empty records none
+}
+
+// ExportConfig holds asynchronous export configuration
+type ExportConfig struct {
+	SigningKey    infra.SecretValue // HMAC key download links are signed with
+	PublicURL     string            // External address of the service download links start with; empty gives relative links
+	LinkTTL       time.Duration     // How long a download link is valid
+	Retention     time.Duration     // How long export files are kept
+	PurgeInterval time.Duration
+}
+
+// JobsConfig holds background job queue configuration
+type JobsConfig struct {
+	Workers        int           // Jobs run concurrently by each instance
+	PollInterval   time.Duration // How often idle workers look for due jobs
+	Lease          time.Duration // How long a worker's claim on a job holds without renewal
+	MaxAttempts    int
+	RetryBaseDelay time.Duration // Delay before the first retry, doubled on every further one
+	RetryMaxDelay  time.Duration
+	Retention      time.Duration // Finished jobs are deleted after this
+	PurgeInterval  time.Duration
 }
 
 // LoadFromEnv loads configuration from environment variables
@@ -55,28 +189,252 @@ func LoadFromEnv() *Config {
 			ReadTimeout:  getEnvAsInt("SERVER_READ_TIMEOUT", 30),  // 30 seconds
 			WriteTimeout: getEnvAsInt("SERVER_WRITE_TIMEOUT", 30), // 30 seconds
 			IdleTimeout:  getEnvAsInt("SERVER_IDLE_TIMEOUT", 60),  // 60 seconds
+			DebugHost:    getEnv("DEBUG_HOST", "127.0.0.1"),
+			DebugPort:    getEnv("DEBUG_PORT", ""),
 		},
 		Database: infrastructure.DBConfig{
 			Host:     getEnv("DB_HOST", "localhost"),
 			Port:     getEnv("DB_PORT", "5432"),
 			User:     getEnv("DB_USER", "postgres"),
-			Password: getEnv("DB_PASSWORD", "password"),
+			Password: infra.StaticSecret(getEnv("DB_PASSWORD", "password")),
 			DBName:   getEnv("DB_NAME", "mini_bank"),
 			SSLMode:  getEnv("DB_SSLMODE", "disable"),
+
+			LogLevel:           getEnv("DB_LOG_LEVEL", "warn"),
+			SlowQueryThreshold: time.Duration(getEnvAsInt("DB_SLOW_QUERY_MS", 200)) * time.Millisecond,
+			ReadOnlyProbe:      time.Duration(getEnvAsInt("DB_READ_ONLY_PROBE_SECONDS", 5)) * time.Second,
+
+			RetryMaxAttempts: getEnvAsInt("DB_RETRY_MAX_ATTEMPTS", 3),
+			RetryBaseDelay:   time.Duration(getEnvAsInt("DB_RETRY_BASE_DELAY_MS", 20)) * time.Millisecond,
+			RetryMaxDelay:    time.Duration(getEnvAsInt("DB_RETRY_MAX_DELAY_MS", 500)) * time.Millisecond,
 		},
 		Cache: CacheConfig{
 			Host:     getEnv("REDIS_HOST", "localhost"),
 			Port:     getEnvAsInt("REDIS_PORT", 6379),
 			Password: getEnv("REDIS_PASSWORD", ""),
 			DB:       getEnvAsInt("REDIS_DB", 0),
+
+			CompressionThreshold: getEnvAsInt("CACHE_COMPRESSION_THRESHOLD_BYTES", 1024),
+			ListPolicies:         getEnvAsList("LIST_CACHE_POLICIES", nil),
+		},
+		LocalCache: infrastructure.LocalCacheConfig{
+			Enabled:  getEnvAsBool("LOCAL_CACHE_ENABLED", false),
+			Size:     getEnvAsInt("LOCAL_CACHE_SIZE", 1000),
+			TTL:      time.Duration(getEnvAsInt("LOCAL_CACHE_TTL_SECONDS", 5)) * time.Second,
+			Prefixes: []string{"account:"},
+		},
+		EventBus: infrastructure.EventBusConfig{
+			Driver: getEnv("EVENT_BUS_DRIVER", "memory"),
+		},
+		Kafka: infrastructure.KafkaConfig{
+			Enabled:            getEnvAsBool("KAFKA_ENABLED", false),
+			Brokers:            getEnvAsList("KAFKA_BROKERS", []string{"localhost:9092"}),
+			TopicPrefix:        getEnv("KAFKA_TOPIC_PREFIX", "minibank."),
+			ClientID:           getEnv("KAFKA_CLIENT_ID", "mini-bank"),
+			OutboxPollInterval: time.Duration(getEnvAsInt("OUTBOX_POLL_INTERVAL_MS", 1000)) * time.Millisecond,
+			OutboxBatchSize:    getEnvAsInt("OUTBOX_BATCH_SIZE", 100),
+		},
+		NATS: infrastructure.NATSConfig{
+			Enabled:        getEnvAsBool("NATS_ENABLED", false),
+			URL:            getEnv("NATS_URL", "nats://localhost:4222"),
+			Stream:         getEnv("NATS_STREAM", "MINIBANK_COMMANDS"),
+			CommandSubject: getEnv("NATS_COMMAND_SUBJECT", "minibank.commands.transfer"),
+			ResultSubject:  getEnv("NATS_RESULT_SUBJECT", "minibank.results.transfer"),
+			Durable:        getEnv("NATS_DURABLE", "mini-bank-transfers"),
+			MaxDeliver:     getEnvAsInt("NATS_MAX_DELIVER", 10),
 		},
 		API: APIConfig{
-			Key: getEnv("API_KEY", "your-secret-api-key-change-in-production"),
+			Key:         infra.StaticSecret(getEnv("API_KEY", "your-secret-api-key-change-in-production")),
+			Envelope:    getEnvAsBool("RESPONSE_ENVELOPE", true),
+			FieldNaming: controller.FieldNaming(getEnv("RESPONSE_FIELD_NAMING", string(controller.FieldNamingSnakeCase))),
+
+			SigningKeys:      infra.StaticSecret(getEnv("REQUEST_SIGNING_KEYS", "")),
+			SigningTolerance: time.Duration(getEnvAsInt("REQUEST_SIGNING_TOLERANCE_SECONDS", 300)) * time.Second,
+		},
+		Routes: controller.RouteLimits{
+			Default: controller.RouteLimit{
+				Timeout:     time.Duration(getEnvAsInt("ROUTE_DEFAULT_TIMEOUT_MS", 10000)) * time.Millisecond,
+				MaxInFlight: getEnvAsInt("ROUTE_DEFAULT_MAX_IN_FLIGHT", 200),
+			},
+			Admin: controller.RouteLimit{
+				Timeout:     time.Duration(getEnvAsInt("ROUTE_ADMIN_TIMEOUT_MS", 20000)) * time.Millisecond,
+				MaxInFlight: getEnvAsInt("ROUTE_ADMIN_MAX_IN_FLIGHT", 20),
+			},
+			Export: controller.RouteLimit{
+				Timeout:     time.Duration(getEnvAsInt("ROUTE_EXPORT_TIMEOUT_MS", 25000)) * time.Millisecond,
+				MaxInFlight: getEnvAsInt("ROUTE_EXPORT_MAX_IN_FLIGHT", 2),
+			},
+			Stream: controller.RouteLimit{
+				MaxInFlight: getEnvAsInt("ROUTE_STREAM_MAX_IN_FLIGHT", 20),
+			},
+		},
+		Backup: infrastructure.BackupConfig{
+			Dir:        getEnv("BACKUP_DIR", "backups"),
+			PgDumpPath: getEnv("PG_DUMP_PATH", "pg_dump"),
+		},
+		Webhook: infrastructure.WebhookConfig{
+			Timeout:     time.Duration(getEnvAsInt("WEBHOOK_TIMEOUT_SECONDS", 5)) * time.Second,
+			MaxFailures: getEnvAsInt("WEBHOOK_MAX_FAILURES", 5),
+		},
+		HTTPClient: infrastructure.HTTPClientConfig{
+			MaxAttempts:      getEnvAsInt("HTTP_CLIENT_MAX_ATTEMPTS", 3),
+			RetryBaseDelay:   time.Duration(getEnvAsInt("HTTP_CLIENT_RETRY_BASE_DELAY_MS", 100)) * time.Millisecond,
+			RetryMaxDelay:    time.Duration(getEnvAsInt("HTTP_CLIENT_RETRY_MAX_DELAY_MS", 2000)) * time.Millisecond,
+			RetryBudget:      getEnvAsFloat("HTTP_CLIENT_RETRY_BUDGET", 0.2),
+			BreakerThreshold: getEnvAsInt("HTTP_CLIENT_BREAKER_THRESHOLD", 5),
+			BreakerCooldown:  time.Duration(getEnvAsInt("HTTP_CLIENT_BREAKER_COOLDOWN_SECONDS", 30)) * time.Second,
+			UserAgent:        "mini-bank",
+		},
+		Secrets: infrastructure.SecretsConfig{
+			Provider:        getEnv("SECRETS_PROVIDER", infrastructure.SecretsProviderEnv),
+			RefreshInterval: time.Duration(getEnvAsInt("SECRETS_REFRESH_SECONDS", 300)) * time.Second,
+			Timeout:         time.Duration(getEnvAsInt("SECRETS_TIMEOUT_SECONDS", 5)) * time.Second,
+			FileDir:         getEnv("SECRETS_FILE_DIR", "/run/secrets"),
+			VaultAddr:       getEnv("VAULT_ADDR", "http://localhost:8200"),
+			VaultToken:      getEnv("VAULT_TOKEN", ""),
+			VaultMount:      getEnv("VAULT_MOUNT", "secret"),
+			AWSRegion:       getEnv("AWS_REGION", ""),
+			AWS: infrastructure.AWSCredentials{
+				AccessKeyID:     getEnv("AWS_ACCESS_KEY_ID", ""),
+				SecretAccessKey: getEnv("AWS_SECRET_ACCESS_KEY", ""),
+				SessionToken:    getEnv("AWS_SESSION_TOKEN", ""),
+			},
+		},
+		Rates: infrastructure.ExchangeRateConfig{
+			Provider: getEnv("EXCHANGE_RATE_PROVIDER", infrastructure.ExchangeRateProviderStatic),
+			Rates:    getEnvAsList("EXCHANGE_RATES", nil),
+			URL:      getEnv("EXCHANGE_RATE_URL", ""),
+			APIKey:   getEnv("EXCHANGE_RATE_API_KEY", ""),
+			CacheTTL: time.Duration(getEnvAsInt("EXCHANGE_RATE_CACHE_SECONDS", 300)) * time.Second,
+			Timeout:  time.Duration(getEnvAsInt("EXCHANGE_RATE_TIMEOUT_SECONDS", 5)) * time.Second,
+		},
+		Processing: ProcessingConfig{
+			Cutoff:                 getEnv("PROCESSING_CUTOFF", "17:00"),
+			Timezone:               getEnv("PROCESSING_TIMEZONE", "UTC"),
+			CutoffTransactionTypes: getEnvAsList("CUTOFF_TRANSACTION_TYPES", []string{"TRANSFER"}),
+			Region:                 getEnv("CALENDAR_REGION", "DEFAULT"),
+			Holidays:               getEnvAsList("HOLIDAYS", nil),
+		},
+		Review: ReviewConfig{
+			AmountThreshold: getEnvAsFloat("FRAUD_REVIEW_AMOUNT", 0),
+			SLA:             time.Duration(getEnvAsInt("REVIEW_SLA_MINUTES", 1440)) * time.Minute,
+			SweepInterval:   time.Duration(getEnvAsInt("REVIEW_SWEEP_INTERVAL_SECONDS", 60)) * time.Second,
+			ClaimTTL:        time.Duration(getEnvAsInt("REVIEW_CLAIM_TTL_SECONDS", 300)) * time.Second,
+		},
+		Limits: LimitsConfig{
+			Channel:              getEnvAsList("CHANNEL_LIMITS", nil),
+			DailyDebit:           getEnvAsFloat("VELOCITY_DAILY_DEBIT_LIMIT", 0),
+			HourlyTransactions:   getEnvAsInt("VELOCITY_HOURLY_TRANSACTION_LIMIT", 0),
+			WarningPercent:       getEnvAsInt("VELOCITY_WARNING_PERCENT", 80),
+			DescriptionMaxLength: getEnvAsInt("DESCRIPTION_MAX_LENGTH", 500),
+			DescriptionFilters:   getEnvAsList("DESCRIPTION_FILTERS", nil),
+			ProfaneWords:         getEnvAsList("PROFANE_WORDS", nil),
+			DescriptionChannels:  getEnvAsList("DESCRIPTION_CHANNELS", nil),
+		},
+		Batching: BatchingConfig{
+			Accounts: getEnvAsList("CREDIT_BATCH_ACCOUNTS", nil),
+			MaxSize:  getEnvAsInt("CREDIT_BATCH_MAX_SIZE", 50),
+			MaxWait:  time.Duration(getEnvAsInt("CREDIT_BATCH_MAX_WAIT_MS", 5)) * time.Millisecond,
 		},
-		LogLevel: getEnv("LOG_LEVEL", "info"),
+		Audit: AuditConfig{
+			SigningKey:    infra.StaticSecret(getEnv("AUDIT_SIGNING_KEY", "your-audit-signing-key-change-in-production")),
+			Retention:     time.Duration(getEnvAsInt("AUDIT_RETENTION_DAYS", 365)) * 24 * time.Hour,
+			PurgeInterval: time.Duration(getEnvAsInt("AUDIT_PURGE_INTERVAL_MINUTES", 60)) * time.Minute,
+		},
+		Security: SecurityConfig{
+			AlertEmails:            getEnvAsList("SECURITY_ALERT_EMAILS", nil),
+			AdminWorkingHours:      getEnv("ADMIN_WORKING_HOURS", ""),
+			AdminTimezone:          getEnv("ADMIN_TIMEZONE", "UTC"),
+			MassOperationThreshold: getEnvAsInt("ADMIN_MASS_OPERATION_THRESHOLD", 50),
+			MassOperationWindow:    time.Duration(getEnvAsInt("ADMIN_MASS_OPERATION_WINDOW_MINUTES", 10)) * time.Minute,
+			GeoHeader:              getEnv("ADMIN_GEO_HEADER", "CF-IPCountry"),
+		},
+		Export: ExportConfig{
+			SigningKey:    infra.StaticSecret(getEnv("EXPORT_SIGNING_KEY", "your-export-signing-key-change-in-production")),
+			PublicURL:     strings.TrimRight(getEnv("PUBLIC_URL", ""), "/"),
+			LinkTTL:       time.Duration(getEnvAsInt("EXPORT_LINK_TTL_MINUTES", 15)) * time.Minute,
+			Retention:     time.Duration(getEnvAsInt("EXPORT_RETENTION_HOURS", 24)) * time.Hour,
+			PurgeInterval: time.Duration(getEnvAsInt("EXPORT_PURGE_INTERVAL_MINUTES", 60)) * time.Minute,
+		},
+		Blobs: infrastructure.BlobStorageConfig{
+			Dir: getEnv("BLOB_STORAGE_DIR", "blobs"),
+		},
+		Jobs: JobsConfig{
+			Workers:        getEnvAsInt("JOB_WORKERS", 4),
+			PollInterval:   time.Duration(getEnvAsInt("JOB_POLL_INTERVAL_MS", 1000)) * time.Millisecond,
+			Lease:          time.Duration(getEnvAsInt("JOB_LEASE_SECONDS", 60)) * time.Second,
+			MaxAttempts:    getEnvAsInt("JOB_MAX_ATTEMPTS", 3),
+			RetryBaseDelay: time.Duration(getEnvAsInt("JOB_RETRY_BASE_DELAY_SECONDS", 10)) * time.Second,
+			RetryMaxDelay:  time.Duration(getEnvAsInt("JOB_RETRY_MAX_DELAY_SECONDS", 600)) * time.Second,
+			Retention:      time.Duration(getEnvAsInt("JOB_RETENTION_HOURS", 168)) * time.Hour,
+			PurgeInterval:  time.Duration(getEnvAsInt("JOB_PURGE_INTERVAL_MINUTES", 60)) * time.Minute,
+		},
+		Rules: infrastructure.RuleEngineConfig{
+			Timeout:   time.Duration(getEnvAsInt("RULE_TIMEOUT_MS", 50)) * time.Millisecond,
+			CostLimit: uint64(getEnvAsInt("RULE_COST_LIMIT", 10000)),
+		},
+		Referral: ReferralConfig{
+			ReferrerBonus:       getEnvAsFloat("REFERRAL_REFERRER_BONUS", 100),
+			RefereeBonus:        getEnvAsFloat("REFERRAL_REFEREE_BONUS", 50),
+			MinQualifyingAmount: getEnvAsFloat("REFERRAL_MIN_QUALIFYING_AMOUNT", 100),
+			DailyLimit:          getEnvAsInt("REFERRAL_DAILY_LIMIT", 5),
+		},
+		Anonymizer: infrastructure.AnonymizerConfig{
+			Target: infrastructure.DBConfig{
+				Host:     getEnv("ANONYMIZE_TARGET_DB_HOST", ""),
+				Port:     getEnv("ANONYMIZE_TARGET_DB_PORT", "5432"),
+				User:     getEnv("ANONYMIZE_TARGET_DB_USER", "postgres"),
+				Password: infra.StaticSecret(getEnv("ANONYMIZE_TARGET_DB_PASSWORD", "")),
+				DBName:   getEnv("ANONYMIZE_TARGET_DB_NAME", ""),
+				SSLMode:  getEnv("ANONYMIZE_TARGET_DB_SSLMODE", "disable"),
+				LogLevel: getEnv("DB_LOG_LEVEL", "warn"),
+			},
+			Secret:       getEnv("ANONYMIZE_SECRET", ""),
+			AmountJitter: getEnvAsFloat("ANONYMIZE_AMOUNT_JITTER", 0.1),
+			BatchSize:    getEnvAsInt("ANONYMIZE_BATCH_SIZE", 500),
+		},
+		Currency:     getEnv("CURRENCY", "THB"),
+		NameScope:    getEnv("ACCOUNT_NAME_SCOPE", string(vo.AccountNameScopeCustomer)),
+		Sandbox:      getEnvAsBool("SANDBOX_MODE", false),
+		SandboxDelay: time.Duration(getEnvAsInt("SANDBOX_SLOW_SECONDS", 5)) * time.Second,
+		LogLevel:     getEnv("LOG_LEVEL", "info"),
+
+		OwnershipTransferInterval:    time.Duration(getEnvAsInt("OWNERSHIP_TRANSFER_INTERVAL_SECONDS", 300)) * time.Second,
+		ProductMigrationInterval:     time.Duration(getEnvAsInt("PRODUCT_MIGRATION_INTERVAL_SECONDS", 300)) * time.Second,
+		AttachmentPurgeInterval:      time.Duration(getEnvAsInt("ATTACHMENT_PURGE_INTERVAL_MINUTES", 60)) * time.Minute,
+		ScheduledTransactionInterval: time.Duration(getEnvAsInt("SCHEDULED_TRANSACTION_INTERVAL_SECONDS", 60)) * time.Second,
 	}
 }
 
+// LoadSecrets replaces the secrets that have a reference set, in the variable named after the secret
+// with a _SECRET suffix, with the values loaded from store. Secrets without a reference keep the
+// value of their plain variable.
+func (c *Config) LoadSecrets(ctx context.Context, store *infrastructure.SecretStore) error {
+	secrets := []struct {
+		name   string
+		target *infra.SecretValue
+	}{
+		{"DB_PASSWORD", &c.Database.Password},
+		{"ANONYMIZE_TARGET_DB_PASSWORD", &c.Anonymizer.Target.Password},
+		{"API_KEY", &c.API.Key},
+		{"AUDIT_SIGNING_KEY", &c.Audit.SigningKey},
+		{"EXPORT_SIGNING_KEY", &c.Export.SigningKey},
+		{"REQUEST_SIGNING_KEYS", &c.API.SigningKeys},
+	}
+	for _, secret := range secrets {
+		ref := getEnv(secret.name+"_SECRET", "")
+		if ref == "" {
+			continue
+		}
+		value, err := store.Load(ctx, ref)
+		if err != nil {
+			return fmt.Errorf("failed to load %s from %s: %w", secret.name, ref, err)
+		}
+		*secret.target = value
+	}
+	return nil
+}
+
 // IsProduction returns true if the environment is production
 func (c *Config) IsProduction() bool {
 	return c.Server.Environment == "release"
@@ -95,14 +453,262 @@ func (c *Config) GetServerAddress() string {
 	return c.Server.Host + ":" + c.Server.Port
 }
 
+// GetDebugAddress returns the address of the diagnostics server
+func (c *Config) GetDebugAddress() string {
+	return c.Server.DebugHost + ":" + c.Server.DebugPort
+}
+
 // Validate validates the configuration
 func (c *Config) Validate() error {
-	if c.API.Key == "" || c.API.Key == "your-secret-api-key-change-in-production" {
+	if key := c.API.Key.Value(); key == "" || key == "your-secret-api-key-change-in-production" {
 		if c.IsProduction() {
 			return fmt.Errorf("API_KEY must be set in production environment")
 		}
 	}
 
+	if !c.API.FieldNaming.Valid() {
+		return fmt.Errorf("RESPONSE_FIELD_NAMING must be one of: snake_case, camelCase")
+	}
+
+	if _, err := controller.ParseSigningKeys(c.API.SigningKeys.Value()); err != nil {
+		return fmt.Errorf("invalid REQUEST_SIGNING_KEYS: %w", err)
+	}
+
+	if c.API.SigningTolerance <= 0 {
+		return fmt.Errorf("REQUEST_SIGNING_TOLERANCE_SECONDS must be positive")
+	}
+
+	if c.Server.DebugPort != "" && c.Server.DebugPort == c.Server.Port {
+		return fmt.Errorf("DEBUG_PORT must differ from PORT")
+	}
+
+	writeTimeout := time.Duration(c.Server.WriteTimeout) * time.Second
+	routeLimits := []struct {
+		group string
+		limit controller.RouteLimit
+	}{{"DEFAULT", c.Routes.Default}, {"ADMIN", c.Routes.Admin}, {"EXPORT", c.Routes.Export}}
+	for _, route := range routeLimits {
+		group, limit := route.group, route.limit
+		if limit.Timeout < 0 || limit.MaxInFlight < 0 {
+			return fmt.Errorf("ROUTE_%s_TIMEOUT_MS and ROUTE_%s_MAX_IN_FLIGHT cannot be negative", group, group)
+		}
+		// The server drops responses written after its write timeout, so the handler's deadline must come first
+		if writeTimeout > 0 && limit.Timeout > writeTimeout {
+			return fmt.Errorf("ROUTE_%s_TIMEOUT_MS cannot exceed SERVER_WRITE_TIMEOUT", group)
+		}
+	}
+
+	if c.Routes.Stream.MaxInFlight < 0 {
+		return fmt.Errorf("ROUTE_STREAM_MAX_IN_FLIGHT cannot be negative")
+	}
+
+	if c.EventBus.Driver != "memory" && c.EventBus.Driver != "redis" {
+		return fmt.Errorf("EVENT_BUS_DRIVER must be one of: memory, redis")
+	}
+
+	if c.Kafka.Enabled && len(c.Kafka.Brokers) == 0 {
+		return fmt.Errorf("KAFKA_BROKERS is required when KAFKA_ENABLED is true")
+	}
+
+	if c.NATS.Enabled && c.NATS.CommandSubject == c.NATS.ResultSubject {
+		return fmt.Errorf("NATS_COMMAND_SUBJECT and NATS_RESULT_SUBJECT must differ")
+	}
+
+	if _, err := vo.NewProcessingWindow(c.Processing.Cutoff, c.Processing.Timezone, c.Processing.CutoffTransactionTypes); err != nil {
+		return fmt.Errorf("invalid processing window: %w", err)
+	}
+
+	for _, holiday := range c.Processing.Holidays {
+		if _, err := time.Parse("2006-01-02", holiday); err != nil {
+			return fmt.Errorf("invalid HOLIDAYS entry %q, expected YYYY-MM-DD", holiday)
+		}
+	}
+
+	if c.Webhook.MaxFailures < 1 {
+		return fmt.Errorf("WEBHOOK_MAX_FAILURES must be at least 1")
+	}
+
+	if c.HTTPClient.MaxAttempts < 1 {
+		return fmt.Errorf("HTTP_CLIENT_MAX_ATTEMPTS must be at least 1")
+	}
+
+	if c.HTTPClient.RetryBaseDelay <= 0 || c.HTTPClient.RetryMaxDelay < c.HTTPClient.RetryBaseDelay {
+		return fmt.Errorf("HTTP_CLIENT_RETRY_BASE_DELAY_MS must be positive and at most HTTP_CLIENT_RETRY_MAX_DELAY_MS")
+	}
+
+	if c.HTTPClient.RetryBudget < 0 {
+		return fmt.Errorf("HTTP_CLIENT_RETRY_BUDGET cannot be negative")
+	}
+
+	if c.HTTPClient.BreakerThreshold < 1 || c.HTTPClient.BreakerCooldown <= 0 {
+		return fmt.Errorf("HTTP_CLIENT_BREAKER_THRESHOLD must be at least 1 and HTTP_CLIENT_BREAKER_COOLDOWN_SECONDS positive")
+	}
+
+	if c.Review.AmountThreshold < 0 {
+		return fmt.Errorf("FRAUD_REVIEW_AMOUNT cannot be negative")
+	}
+
+	if c.Review.SLA <= 0 || c.Review.SweepInterval <= 0 || c.Review.ClaimTTL <= 0 {
+		return fmt.Errorf("REVIEW_SLA_MINUTES, REVIEW_SWEEP_INTERVAL_SECONDS and REVIEW_CLAIM_TTL_SECONDS must be positive")
+	}
+
+	if c.OwnershipTransferInterval <= 0 {
+		return fmt.Errorf("OWNERSHIP_TRANSFER_INTERVAL_SECONDS must be positive")
+	}
+
+	if c.ProductMigrationInterval <= 0 {
+		return fmt.Errorf("PRODUCT_MIGRATION_INTERVAL_SECONDS must be positive")
+	}
+
+	if c.AttachmentPurgeInterval <= 0 {
+		return fmt.Errorf("ATTACHMENT_PURGE_INTERVAL_MINUTES must be positive")
+	}
+
+	if c.ScheduledTransactionInterval <= 0 {
+		return fmt.Errorf("SCHEDULED_TRANSACTION_INTERVAL_SECONDS must be positive")
+	}
+
+	if _, err := vo.NewChannelLimits(c.Limits.Channel); err != nil {
+		return fmt.Errorf("invalid CHANNEL_LIMITS: %w", err)
+	}
+
+	if _, err := vo.NewVelocityLimits(c.Limits.DailyDebit, c.Limits.HourlyTransactions, c.Limits.WarningPercent); err != nil {
+		return fmt.Errorf("invalid VELOCITY_DAILY_DEBIT_LIMIT, VELOCITY_HOURLY_TRANSACTION_LIMIT or VELOCITY_WARNING_PERCENT: %w", err)
+	}
+
+	if _, err := vo.NewTextNormalizers(c.Limits.DescriptionMaxLength, c.Limits.DescriptionFilters, c.Limits.ProfaneWords, c.Limits.DescriptionChannels); err != nil {
+		return fmt.Errorf("invalid DESCRIPTION_MAX_LENGTH, DESCRIPTION_FILTERS or DESCRIPTION_CHANNELS: %w", err)
+	}
+
+	for _, account := range c.Batching.Accounts {
+		if _, err := vo.NewAccountIDFromString(account); err != nil {
+			return fmt.Errorf("invalid CREDIT_BATCH_ACCOUNTS: %s: %w", account, err)
+		}
+	}
+
+	if c.Batching.MaxSize < 1 || c.Batching.MaxWait < 0 {
+		return fmt.Errorf("CREDIT_BATCH_MAX_SIZE must be at least 1 and CREDIT_BATCH_MAX_WAIT_MS cannot be negative")
+	}
+
+	if key := c.Audit.SigningKey.Value(); key == "" || key == "your-audit-signing-key-change-in-production" {
+		if c.IsProduction() {
+			return fmt.Errorf("AUDIT_SIGNING_KEY must be set in production environment")
+		}
+	}
+
+	if _, err := vo.NewAdminWorkingHours(c.Security.AdminWorkingHours, c.Security.AdminTimezone); err != nil {
+		return fmt.Errorf("invalid ADMIN_WORKING_HOURS or ADMIN_TIMEZONE: %w", err)
+	}
+
+	if c.Security.MassOperationThreshold < 0 || c.Security.MassOperationWindow <= 0 {
+		return fmt.Errorf("ADMIN_MASS_OPERATION_THRESHOLD cannot be negative and ADMIN_MASS_OPERATION_WINDOW_MINUTES must be positive")
+	}
+
+	if key := c.Export.SigningKey.Value(); key == "" || key == "your-export-signing-key-change-in-production" {
+		if c.IsProduction() {
+			return fmt.Errorf("EXPORT_SIGNING_KEY must be set in production environment")
+		}
+	}
+
+	if c.Export.LinkTTL <= 0 || c.Export.PurgeInterval <= 0 {
+		return fmt.Errorf("EXPORT_LINK_TTL_MINUTES and EXPORT_PURGE_INTERVAL_MINUTES must be positive")
+	}
+
+	// A running export must not be purged before it completes
+	if c.Export.Retention < time.Hour {
+		return fmt.Errorf("EXPORT_RETENTION_HOURS must be at least 1")
+	}
+
+	if c.Jobs.Workers < 1 || c.Jobs.MaxAttempts < 1 {
+		return fmt.Errorf("JOB_WORKERS and JOB_MAX_ATTEMPTS must be at least 1")
+	}
+
+	if c.Jobs.PollInterval <= 0 || c.Jobs.Retention <= 0 || c.Jobs.PurgeInterval <= 0 {
+		return fmt.Errorf("JOB_POLL_INTERVAL_MS, JOB_RETENTION_HOURS and JOB_PURGE_INTERVAL_MINUTES must be positive")
+	}
+
+	// Running jobs renew their lease at a third of it
+	if c.Jobs.Lease < 3*time.Second {
+		return fmt.Errorf("JOB_LEASE_SECONDS must be at least 3")
+	}
+
+	if c.Jobs.RetryBaseDelay <= 0 || c.Jobs.RetryMaxDelay < c.Jobs.RetryBaseDelay {
+		return fmt.Errorf("JOB_RETRY_BASE_DELAY_SECONDS must be positive and at most JOB_RETRY_MAX_DELAY_SECONDS")
+	}
+
+	if c.Rules.Timeout <= 0 || c.Rules.CostLimit == 0 {
+		return fmt.Errorf("RULE_TIMEOUT_MS and RULE_COST_LIMIT must be positive")
+	}
+
+	if c.Referral.ReferrerBonus < 0 || c.Referral.RefereeBonus < 0 || c.Referral.MinQualifyingAmount < 0 {
+		return fmt.Errorf("REFERRAL_REFERRER_BONUS, REFERRAL_REFEREE_BONUS and REFERRAL_MIN_QUALIFYING_AMOUNT cannot be negative")
+	}
+
+	if c.Referral.DailyLimit < 1 {
+		return fmt.Errorf("REFERRAL_DAILY_LIMIT must be at least 1")
+	}
+
+	if c.Audit.Retention < 0 {
+		return fmt.Errorf("AUDIT_RETENTION_DAYS cannot be negative")
+	}
+
+	if c.Audit.PurgeInterval <= 0 {
+		return fmt.Errorf("AUDIT_PURGE_INTERVAL_MINUTES must be positive")
+	}
+
+	if currency, err := vo.NewCurrency(c.Currency); err != nil || currency.String() != c.Currency {
+		return fmt.Errorf("CURRENCY must be an uppercase ISO 4217 code")
+	}
+
+	switch c.Rates.Provider {
+	case infrastructure.ExchangeRateProviderStatic:
+		if _, err := infrastructure.ParseExchangeRates(c.Rates.Rates, time.Now()); err != nil {
+			return fmt.Errorf("invalid EXCHANGE_RATES: %w", err)
+		}
+	case infrastructure.ExchangeRateProviderHTTP:
+		if c.Rates.URL == "" {
+			return fmt.Errorf("EXCHANGE_RATE_URL is required when EXCHANGE_RATE_PROVIDER is http")
+		}
+	default:
+		return fmt.Errorf("EXCHANGE_RATE_PROVIDER must be one of: static, http")
+	}
+
+	if c.Rates.CacheTTL < 0 || c.Rates.Timeout <= 0 {
+		return fmt.Errorf("EXCHANGE_RATE_CACHE_SECONDS cannot be negative and EXCHANGE_RATE_TIMEOUT_SECONDS must be positive")
+	}
+
+	if _, err := vo.NewAccountNameScope(c.NameScope); err != nil {
+		return fmt.Errorf("invalid ACCOUNT_NAME_SCOPE: %w", err)
+	}
+
+	if _, err := infrastructure.ParseGormLogLevel(c.Database.LogLevel); err != nil {
+		return fmt.Errorf("invalid DB_LOG_LEVEL: %w", err)
+	}
+
+	if c.Cache.CompressionThreshold < 0 {
+		return fmt.Errorf("CACHE_COMPRESSION_THRESHOLD_BYTES cannot be negative")
+	}
+
+	if _, err := usecase.ParseListCachePolicies(c.Cache.ListPolicies); err != nil {
+		return fmt.Errorf("invalid LIST_CACHE_POLICIES: %w", err)
+	}
+
+	if c.Database.SlowQueryThreshold < 0 {
+		return fmt.Errorf("DB_SLOW_QUERY_MS cannot be negative")
+	}
+
+	if c.Database.ReadOnlyProbe <= 0 {
+		return fmt.Errorf("DB_READ_ONLY_PROBE_SECONDS must be positive")
+	}
+
+	if c.Database.RetryMaxAttempts < 1 {
+		return fmt.Errorf("DB_RETRY_MAX_ATTEMPTS must be at least 1")
+	}
+
+	if c.Database.RetryBaseDelay < 0 || c.Database.RetryMaxDelay < c.Database.RetryBaseDelay {
+		return fmt.Errorf("DB_RETRY_BASE_DELAY_MS cannot be negative or above DB_RETRY_MAX_DELAY_MS")
+	}
+
 	if c.Database.Host == "" {
 		return fmt.Errorf("DB_HOST is required")
 	}
@@ -118,6 +724,47 @@ func (c *Config) Validate() error {
 	return nil
 }
 
+// ValidateSecrets validates the secret store configuration, before any secret is loaded from it
+func (c *Config) ValidateSecrets() error {
+	switch c.Secrets.Provider {
+	case infrastructure.SecretsProviderEnv, infrastructure.SecretsProviderFile:
+	case infrastructure.SecretsProviderVault:
+		if c.Secrets.VaultAddr == "" || c.Secrets.VaultToken == "" {
+			return fmt.Errorf("VAULT_ADDR and VAULT_TOKEN are required when SECRETS_PROVIDER is vault")
+		}
+	case infrastructure.SecretsProviderAWS:
+		if c.Secrets.AWSRegion == "" || c.Secrets.AWS.AccessKeyID == "" || c.Secrets.AWS.SecretAccessKey == "" {
+			return fmt.Errorf("AWS_REGION, AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY are required when SECRETS_PROVIDER is aws-secrets-manager")
+		}
+	default:
+		return fmt.Errorf("SECRETS_PROVIDER must be one of: env, file, vault, aws-secrets-manager")
+	}
+
+	if c.Secrets.RefreshInterval < 0 || c.Secrets.Timeout <= 0 {
+		return fmt.Errorf("SECRETS_REFRESH_SECONDS cannot be negative and SECRETS_TIMEOUT_SECONDS must be positive")
+	}
+
+	return nil
+}
+
+// ValidateAnonymizer validates the configuration of the anonymizer tool
+func (c *Config) ValidateAnonymizer() error {
+	target := c.Anonymizer.Target
+	if target.Host == "" || target.DBName == "" {
+		return fmt.Errorf("ANONYMIZE_TARGET_DB_HOST and ANONYMIZE_TARGET_DB_NAME are required")
+	}
+
+	if target.Host == c.Database.Host && target.Port == c.Database.Port && target.DBName == c.Database.DBName {
+		return fmt.Errorf("the anonymizer target must differ from the source database")
+	}
+
+	if c.Anonymizer.AmountJitter < 0 || c.Anonymizer.AmountJitter >= 1 {
+		return fmt.Errorf("ANONYMIZE_AMOUNT_JITTER must be at least 0 and below 1")
+	}
+
+	return nil
+}
+
 // getEnvAsInt gets an environment variable as an integer
 func getEnvAsInt(key string, defaultValue int) int {
 	if value, exists := os.LookupEnv(key); exists {
@@ -129,6 +776,42 @@ func getEnvAsInt(key string, defaultValue int) int {
 	return defaultValue
 }
 
+// getEnvAsFloat gets an environment variable as a float
+func getEnvAsFloat(key string, defaultValue float64) float64 {
+	if value, exists := os.LookupEnv(key); exists {
+		floatValue, err := strconv.ParseFloat(value, 64)
+		if err == nil {
+			return floatValue
+		}
+	}
+	return defaultValue
+}
+
+// getEnvAsBool gets an environment variable as a boolean
+func getEnvAsBool(key string, defaultValue bool) bool {
+	if value, exists := os.LookupEnv(key); exists {
+		boolValue, err := strconv.ParseBool(value)
+		if err == nil {
+			return boolValue
+		}
+	}
+	return defaultValue
+}
+
+// getEnvAsList gets a comma-separated environment variable as a list
+func getEnvAsList(key string, defaultValue []string) []string {
+	if value, exists := os.LookupEnv(key); exists {
+		var items []string
+		for _, item := range strings.Split(value, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				items = append(items, item)
+			}
+		}
+		return items
+	}
+	return defaultValue
+}
+
 // getEnv gets an environment variable as a string
 func getEnv(key, defaultValue string) string {
 	if value, exists := os.LookupEnv(key); exists {
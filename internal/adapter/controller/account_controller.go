@@ -3,11 +3,14 @@ package controller
 import (
 	"net/http"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	usecase "github.com/hydr0g3nz/mini_bank/internal/application"
 	"github.com/hydr0g3nz/mini_bank/internal/application/dto"
 	"github.com/hydr0g3nz/mini_bank/internal/domain/infra"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
 )
 
 type AccountController struct {
@@ -22,9 +25,29 @@ func NewAccountController(accountUseCase usecase.AccountUseCase, logger infra.Lo
 	}
 }
 
+// Routes declares the account routes
+func (c *AccountController) Routes() []Route {
+	return []Route{
+		{Method: http.MethodPost, Path: "/accounts", Handler: c.CreateAccount, Summary: "Create an account", Permission: vo.AdminPermissionMutateAccounts},
+		{Method: http.MethodGet, Path: "/accounts", Handler: c.ListAccounts, Summary: "List accounts", Permission: vo.AdminPermissionViewAccounts},
+		{Method: http.MethodPost, Path: "/accounts/batch-get", Handler: c.BatchGetAccounts, Summary: "Get up to 100 accounts by ID", Permission: vo.AdminPermissionViewAccounts},
+		{Method: http.MethodGet, Path: "/accounts/:id", Handler: c.GetAccount, Summary: "Get an account", Permission: vo.AdminPermissionViewAccounts},
+		{Method: http.MethodPut, Path: "/accounts/:id", Handler: c.UpdateAccount, Summary: "Update an account", Permission: vo.AdminPermissionMutateAccounts},
+		{Method: http.MethodDelete, Path: "/accounts/:id", Handler: c.DeleteAccount, Summary: "Delete an account", Permission: vo.AdminPermissionMutateAccounts},
+		{Method: http.MethodPatch, Path: "/accounts/:id/suspend", Handler: c.SuspendAccount, Summary: "Suspend an account", Permission: vo.AdminPermissionMutateAccounts},
+		{Method: http.MethodPatch, Path: "/accounts/:id/activate", Handler: c.ActivateAccount, Summary: "Activate an account", Permission: vo.AdminPermissionMutateAccounts},
+		{Method: http.MethodGet, Path: "/accounts/:id/children", Handler: c.GetAccountGroup, Summary: "Get a parent account with its children", Permission: vo.AdminPermissionViewAccounts},
+		{Method: http.MethodPost, Path: "/accounts/:id/children", Handler: c.AddChildAccount, Summary: "Attach a child account", Permission: vo.AdminPermissionMutateAccounts},
+		{Method: http.MethodDelete, Path: "/accounts/:id/children/:child_id", Handler: c.RemoveChildAccount, Summary: "Detach a child account", Permission: vo.AdminPermissionMutateAccounts},
+		{Method: http.MethodPut, Path: "/accounts/:id/children/:child_id/spending-limit", Handler: c.SetSpendingLimit, Summary: "Set a child account's monthly spending limit", Permission: vo.AdminPermissionMutateAccounts},
+		{Method: http.MethodDelete, Path: "/accounts/:id/children/:child_id/spending-limit", Handler: c.RemoveSpendingLimit, Summary: "Remove a child account's spending limit", Permission: vo.AdminPermissionMutateAccounts},
+		{Method: http.MethodGet, Path: "/admin/accounts/:id", Handler: c.GetAccountWithNameHistory, Summary: "Get an account with its name history", Limit: LimitAdmin, Permission: vo.AdminPermissionViewAccounts},
+	}
+}
+
 // CreateAccount creates a new account
 func (c *AccountController) CreateAccount(ctx *gin.Context) {
-	var req dto.CreateAccountRequest
+	var req dto.AccountRequest
 	if err := ctx.ShouldBindJSON(&req); err != nil {
 		c.logger.Error("Failed to bind JSON", "error", err)
 		HandleError(ctx, err)
@@ -32,7 +55,7 @@ func (c *AccountController) CreateAccount(ctx *gin.Context) {
 	}
 
 	// Validate request
-	if err := ValidateStruct(req); err != nil {
+	if err := ValidateStructFor(ProfileCreate, req); err != nil {
 		c.logger.Error("Validation failed", "error", err)
 		HandleError(ctx, err)
 		return
@@ -46,10 +69,7 @@ func (c *AccountController) CreateAccount(ctx *gin.Context) {
 	}
 
 	c.logger.Info("Account created successfully", "accountID", response.ID)
-	ctx.JSON(http.StatusCreated, dto.SuccessResponse{
-		Message: "Account created successfully",
-		Data:    response,
-	})
+	Respond(ctx, http.StatusCreated, MsgAccountCreated, response)
 }
 
 // GetAccount retrieves an account by ID
@@ -69,10 +89,47 @@ func (c *AccountController) GetAccount(ctx *gin.Context) {
 	}
 
 	c.logger.Debug("Account retrieved successfully", "accountID", id)
-	ctx.JSON(http.StatusOK, dto.SuccessResponse{
-		Message: "Account retrieved successfully",
-		Data:    response,
-	})
+	Respond(ctx, http.StatusOK, MsgAccountRetrieved, response)
+}
+
+// BatchGetAccounts retrieves accounts by ID, reporting the IDs that were not found
+func (c *AccountController) BatchGetAccounts(ctx *gin.Context) {
+	var req dto.BatchGetRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		c.logger.Error("Failed to bind JSON", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+
+	if err := ValidateStruct(req); err != nil {
+		c.logger.Error("Validation failed", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+
+	response, err := c.accountUseCase.BatchGetAccounts(ctx.Request.Context(), req)
+	if err != nil {
+		c.logger.Error("Failed to batch get accounts", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+
+	c.logger.Debug("Accounts batch retrieved successfully", "found", len(response.Found), "missing", len(response.Missing))
+	Respond(ctx, http.StatusOK, MsgAccountsBatchRetrieved, response)
+}
+
+// GetAccountWithNameHistory retrieves an account with its previous names
+func (c *AccountController) GetAccountWithNameHistory(ctx *gin.Context) {
+	id := ctx.Param("id")
+
+	response, err := c.accountUseCase.GetAccountWithNameHistory(ctx.Request.Context(), id)
+	if err != nil {
+		c.logger.Error("Failed to get account with name history", "error", err, "accountID", id)
+		HandleError(ctx, err)
+		return
+	}
+
+	Respond(ctx, http.StatusOK, MsgAccountRetrieved, response)
 }
 
 // UpdateAccount updates an existing account
@@ -84,7 +141,7 @@ func (c *AccountController) UpdateAccount(ctx *gin.Context) {
 		return
 	}
 
-	var req dto.UpdateAccountRequest
+	var req dto.AccountRequest
 	if err := ctx.ShouldBindJSON(&req); err != nil {
 		c.logger.Error("Failed to bind JSON", "error", err)
 		HandleError(ctx, err)
@@ -95,7 +152,7 @@ func (c *AccountController) UpdateAccount(ctx *gin.Context) {
 	req.ID = id
 
 	// Validate request
-	if err := ValidateStruct(req); err != nil {
+	if err := ValidateStructFor(ProfileUpdate, req); err != nil {
 		c.logger.Error("Validation failed", "error", err)
 		HandleError(ctx, err)
 		return
@@ -109,10 +166,7 @@ func (c *AccountController) UpdateAccount(ctx *gin.Context) {
 	}
 
 	c.logger.Info("Account updated successfully", "accountID", id)
-	ctx.JSON(http.StatusOK, dto.SuccessResponse{
-		Message: "Account updated successfully",
-		Data:    response,
-	})
+	Respond(ctx, http.StatusOK, MsgAccountUpdated, response)
 }
 
 // DeleteAccount deletes an account
@@ -132,12 +186,11 @@ func (c *AccountController) DeleteAccount(ctx *gin.Context) {
 	}
 
 	c.logger.Info("Account deleted successfully", "accountID", id)
-	ctx.JSON(http.StatusOK, dto.SuccessResponse{
-		Message: "Account deleted successfully",
-	})
+	Respond(ctx, http.StatusOK, MsgAccountDeleted, nil)
 }
 
-// ListAccounts retrieves accounts with pagination
+// ListAccounts retrieves accounts with pagination, filtered by the "status", "customer_id", "min_balance",
+// "max_balance", "created_from" and "created_to" (YYYY-MM-DD, inclusive) query parameters
 func (c *AccountController) ListAccounts(ctx *gin.Context) {
 	// Parse query parameters
 	page, _ := strconv.Atoi(ctx.DefaultQuery("page", "1"))
@@ -146,12 +199,21 @@ func (c *AccountController) ListAccounts(ctx *gin.Context) {
 	sortBy := ctx.DefaultQuery("sort_by", "created_at")
 	sortDir := ctx.DefaultQuery("sort_dir", "desc")
 
-	req := dto.ListRequest{
-		Page:     page,
-		PageSize: pageSize,
-		Search:   search,
-		SortBy:   sortBy,
-		SortDir:  sortDir,
+	req := dto.AccountListRequest{
+		ListRequest: dto.ListRequest{
+			Page:     page,
+			PageSize: pageSize,
+			Search:   search,
+			SortBy:   sortBy,
+			SortDir:  sortDir,
+		},
+		Status:     strings.ToUpper(ctx.Query("status")),
+		CustomerID: ctx.Query("customer_id"),
+	}
+	if err := parseAccountListFilters(ctx, &req); err != nil {
+		c.logger.Error("Invalid account list filters", "error", err)
+		HandleError(ctx, err)
+		return
 	}
 
 	// Validate request
@@ -169,10 +231,50 @@ func (c *AccountController) ListAccounts(ctx *gin.Context) {
 	}
 
 	c.logger.Debug("Accounts listed successfully", "count", len(response.Accounts))
-	ctx.JSON(http.StatusOK, dto.SuccessResponse{
-		Message: "Accounts retrieved successfully",
-		Data:    response,
-	})
+	SetPaginationHeaders(ctx, response.Pagination)
+	Respond(ctx, http.StatusOK, MsgAccountsRetrieved, response)
+}
+
+// parseAccountListFilters parses the balance and created date filters of an account list and checks each range
+func parseAccountListFilters(ctx *gin.Context, req *dto.AccountListRequest) error {
+	balances := []struct {
+		name   string
+		target **dto.DecimalString
+	}{{"min_balance", &req.MinBalance}, {"max_balance", &req.MaxBalance}}
+	for _, balance := range balances {
+		name, value := balance.name, ctx.Query(balance.name)
+		if value == "" {
+			continue
+		}
+		amount, err := dto.ParseDecimalString(value)
+		if err != nil {
+			return &ValidationError{Field: name, Message: name + " must be a decimal number"}
+		}
+		*balance.target = &amount
+	}
+	if req.MinBalance != nil && req.MaxBalance != nil && req.MinBalance.GreaterThan(req.MaxBalance.Decimal) {
+		return &ValidationError{Field: "min_balance", Message: "min_balance must not be greater than max_balance"}
+	}
+
+	dates := []struct {
+		name   string
+		target **time.Time
+	}{{"created_from", &req.CreatedFrom}, {"created_to", &req.CreatedTo}}
+	for _, date := range dates {
+		name, value := date.name, ctx.Query(date.name)
+		if value == "" {
+			continue
+		}
+		day, err := time.Parse("2006-01-02", value)
+		if err != nil {
+			return &ValidationError{Field: name, Message: name + " must be a date (YYYY-MM-DD)"}
+		}
+		*date.target = &day
+	}
+	if req.CreatedFrom != nil && req.CreatedTo != nil && req.CreatedFrom.After(*req.CreatedTo) {
+		return &ValidationError{Field: "created_from", Message: "created_from must not be after created_to"}
+	}
+	return nil
 }
 
 // SuspendAccount suspends an account
@@ -192,9 +294,7 @@ func (c *AccountController) SuspendAccount(ctx *gin.Context) {
 	}
 
 	c.logger.Info("Account suspended successfully", "accountID", id)
-	ctx.JSON(http.StatusOK, dto.SuccessResponse{
-		Message: "Account suspended successfully",
-	})
+	Respond(ctx, http.StatusOK, MsgAccountSuspended, nil)
 }
 
 // ActivateAccount activates an account
@@ -214,7 +314,114 @@ func (c *AccountController) ActivateAccount(ctx *gin.Context) {
 	}
 
 	c.logger.Info("Account activated successfully", "accountID", id)
-	ctx.JSON(http.StatusOK, dto.SuccessResponse{
-		Message: "Account activated successfully",
-	})
+	Respond(ctx, http.StatusOK, MsgAccountActivated, nil)
+}
+
+// AddChildAccount attaches an account to the parent account in the path
+func (c *AccountController) AddChildAccount(ctx *gin.Context) {
+	var req dto.AddChildAccountRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		c.logger.Error("Failed to bind JSON", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+
+	// Set parent ID from URL parameter
+	req.ParentID = ctx.Param("id")
+
+	// Validate request
+	if err := ValidateStruct(req); err != nil {
+		c.logger.Error("Validation failed", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+
+	response, err := c.accountUseCase.AddChildAccount(ctx.Request.Context(), req)
+	if err != nil {
+		c.logger.Error("Failed to add child account", "error", err, "accountID", req.ParentID)
+		HandleError(ctx, err)
+		return
+	}
+
+	Respond(ctx, http.StatusOK, MsgChildAccountAdded, response)
+}
+
+// RemoveChildAccount detaches a child account from the parent account in the path
+func (c *AccountController) RemoveChildAccount(ctx *gin.Context) {
+	parentID := ctx.Param("id")
+	childID := ctx.Param("child_id")
+
+	if err := c.accountUseCase.RemoveChildAccount(ctx.Request.Context(), parentID, childID); err != nil {
+		c.logger.Error("Failed to remove child account", "error", err, "accountID", parentID, "childAccountID", childID)
+		HandleError(ctx, err)
+		return
+	}
+
+	Respond(ctx, http.StatusOK, MsgChildAccountRemoved, nil)
+}
+
+// GetAccountGroup retrieves a parent account with its children and roll-up balances
+func (c *AccountController) GetAccountGroup(ctx *gin.Context) {
+	id := ctx.Param("id")
+
+	response, err := c.accountUseCase.GetAccountGroup(ctx.Request.Context(), id)
+	if err != nil {
+		c.logger.Error("Failed to get account group", "error", err, "accountID", id)
+		HandleError(ctx, err)
+		return
+	}
+
+	Respond(ctx, http.StatusOK, MsgAccountGroupRetrieved, response)
+}
+
+// SetSpendingLimit sets the monthly spending limit of a child account
+func (c *AccountController) SetSpendingLimit(ctx *gin.Context) {
+	var req dto.SpendingLimitRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		c.logger.Error("Failed to bind JSON", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+
+	// Set IDs from URL parameters
+	req.ParentID = ctx.Param("id")
+	req.ChildID = ctx.Param("child_id")
+
+	if req.MonthlyLimit == nil {
+		HandleError(ctx, &ValidationError{Field: "monthly_limit", Message: "monthly limit is required"})
+		return
+	}
+
+	// Validate request
+	if err := ValidateStruct(req); err != nil {
+		c.logger.Error("Validation failed", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+
+	response, err := c.accountUseCase.SetSpendingLimit(ctx.Request.Context(), req)
+	if err != nil {
+		c.logger.Error("Failed to set spending limit", "error", err, "accountID", req.ParentID, "childAccountID", req.ChildID)
+		HandleError(ctx, err)
+		return
+	}
+
+	Respond(ctx, http.StatusOK, MsgSpendingLimitSet, response)
+}
+
+// RemoveSpendingLimit removes the monthly spending limit of a child account
+func (c *AccountController) RemoveSpendingLimit(ctx *gin.Context) {
+	req := dto.SpendingLimitRequest{
+		ParentID: ctx.Param("id"),
+		ChildID:  ctx.Param("child_id"),
+	}
+
+	response, err := c.accountUseCase.SetSpendingLimit(ctx.Request.Context(), req)
+	if err != nil {
+		c.logger.Error("Failed to remove spending limit", "error", err, "accountID", req.ParentID, "childAccountID", req.ChildID)
+		HandleError(ctx, err)
+		return
+	}
+
+	Respond(ctx, http.StatusOK, MsgSpendingLimitRemoved, response)
 }
@@ -0,0 +1,84 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	usecase "github.com/hydr0g3nz/mini_bank/internal/application"
+	"github.com/hydr0g3nz/mini_bank/internal/application/dto"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/infra"
+)
+
+type AccountingPeriodController struct {
+	periodUseCase usecase.AccountingPeriodUseCase
+	logger        infra.Logger
+}
+
+func NewAccountingPeriodController(periodUseCase usecase.AccountingPeriodUseCase, logger infra.Logger) *AccountingPeriodController {
+	return &AccountingPeriodController{
+		periodUseCase: periodUseCase,
+		logger:        logger,
+	}
+}
+
+// Routes declares the accounting period routes
+func (c *AccountingPeriodController) Routes() []Route {
+	return []Route{
+		{Method: http.MethodGet, Path: "/admin/periods", Handler: c.ListPeriods, Summary: "List the closed accounting periods", Limit: LimitAdmin},
+		{Method: http.MethodGet, Path: "/admin/periods/:period", Handler: c.GetPeriod, Summary: "Get the status of an accounting period", Limit: LimitAdmin},
+		{Method: http.MethodPost, Path: "/admin/periods/:period/close", Handler: c.ClosePeriod, Summary: "Close an accounting period", Limit: LimitAdmin},
+	}
+}
+
+// ClosePeriod closes the books for a month that has ended
+func (c *AccountingPeriodController) ClosePeriod(ctx *gin.Context) {
+	var req dto.ClosePeriodRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		c.logger.Error("Failed to bind JSON", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+	req.Period = ctx.Param("period")
+
+	// Validate request
+	if err := ValidateStruct(req); err != nil {
+		c.logger.Error("Validation failed", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+
+	response, err := c.periodUseCase.ClosePeriod(ctx.Request.Context(), req)
+	if err != nil {
+		c.logger.Error("Failed to close accounting period", "error", err, "period", req.Period)
+		HandleError(ctx, err)
+		return
+	}
+
+	Respond(ctx, http.StatusOK, MsgAccountingPeriodClosed, response)
+}
+
+// GetPeriod retrieves whether an accounting period is open or closed
+func (c *AccountingPeriodController) GetPeriod(ctx *gin.Context) {
+	period := ctx.Param("period")
+
+	response, err := c.periodUseCase.GetPeriod(ctx.Request.Context(), period)
+	if err != nil {
+		c.logger.Error("Failed to get accounting period", "error", err, "period", period)
+		HandleError(ctx, err)
+		return
+	}
+
+	Respond(ctx, http.StatusOK, MsgAccountingPeriodRetrieved, response)
+}
+
+// ListPeriods retrieves the closed accounting periods, latest first
+func (c *AccountingPeriodController) ListPeriods(ctx *gin.Context) {
+	response, err := c.periodUseCase.ListPeriods(ctx.Request.Context())
+	if err != nil {
+		c.logger.Error("Failed to list accounting periods", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+
+	Respond(ctx, http.StatusOK, MsgAccountingPeriodsRetrieved, response)
+}
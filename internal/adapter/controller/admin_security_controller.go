@@ -0,0 +1,88 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	usecase "github.com/hydr0g3nz/mini_bank/internal/application"
+	"github.com/hydr0g3nz/mini_bank/internal/application/dto"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/infra"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+)
+
+type AdminSecurityController struct {
+	securityUseCase usecase.AdminSecurityUseCase
+	logger          infra.Logger
+}
+
+func NewAdminSecurityController(securityUseCase usecase.AdminSecurityUseCase, logger infra.Logger) *AdminSecurityController {
+	return &AdminSecurityController{
+		securityUseCase: securityUseCase,
+		logger:          logger,
+	}
+}
+
+// Routes declares the admin security routes
+func (c *AdminSecurityController) Routes() []Route {
+	return []Route{
+		{Method: http.MethodGet, Path: "/admin/security/dashboard", Handler: c.GetSecurityDashboard, Summary: "Sum up admin activity and its anomalies", Limit: LimitAdmin, Permission: vo.AdminPermissionViewAudit},
+		{Method: http.MethodGet, Path: "/admin/security/activity", Handler: c.ListActivity, Summary: "List the recorded requests of admin users", Limit: LimitAdmin, Permission: vo.AdminPermissionViewAudit},
+	}
+}
+
+// GetSecurityDashboard sums up admin activity and its anomalies over the last hours
+func (c *AdminSecurityController) GetSecurityDashboard(ctx *gin.Context) {
+	hours, _ := strconv.Atoi(ctx.DefaultQuery("hours", "24"))
+
+	req := dto.SecurityDashboardRequest{Hours: hours}
+
+	// Validate request
+	if err := ValidateStruct(req); err != nil {
+		c.logger.Error("Validation failed", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+
+	response, err := c.securityUseCase.GetSecurityDashboard(ctx.Request.Context(), req)
+	if err != nil {
+		c.logger.Error("Failed to get security dashboard", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+
+	Respond(ctx, http.StatusOK, MsgSecurityDashboardRetrieved, response)
+}
+
+// ListActivity lists the recorded requests of admin users, newest first
+func (c *AdminSecurityController) ListActivity(ctx *gin.Context) {
+	// Parse query parameters
+	page, _ := strconv.Atoi(ctx.DefaultQuery("page", "1"))
+	pageSize, _ := strconv.Atoi(ctx.DefaultQuery("page_size", "10"))
+	anomalous, _ := strconv.ParseBool(ctx.DefaultQuery("anomalous", "false"))
+
+	req := dto.AdminActivityListRequest{
+		AdminID:   ctx.Query("admin_id"),
+		Anomalous: anomalous,
+		Anomaly:   ctx.Query("anomaly"),
+		Page:      page,
+		PageSize:  pageSize,
+	}
+
+	// Validate request
+	if err := ValidateStruct(req); err != nil {
+		c.logger.Error("Validation failed", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+
+	response, err := c.securityUseCase.ListActivity(ctx.Request.Context(), req)
+	if err != nil {
+		c.logger.Error("Failed to list admin activity", "error", err, "adminID", req.AdminID)
+		HandleError(ctx, err)
+		return
+	}
+
+	SetPaginationHeaders(ctx, response.Pagination)
+	Respond(ctx, http.StatusOK, MsgAdminActivityRetrieved, response)
+}
@@ -0,0 +1,185 @@
+package controller
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	usecase "github.com/hydr0g3nz/mini_bank/internal/application"
+	"github.com/hydr0g3nz/mini_bank/internal/application/dto"
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/infra"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+)
+
+type AdminUserController struct {
+	adminUseCase usecase.AdminUserUseCase
+	logger       infra.Logger
+}
+
+func NewAdminUserController(adminUseCase usecase.AdminUserUseCase, logger infra.Logger) *AdminUserController {
+	return &AdminUserController{
+		adminUseCase: adminUseCase,
+		logger:       logger,
+	}
+}
+
+// Routes declares the admin user routes
+func (c *AdminUserController) Routes() []Route {
+	return []Route{
+		{Method: http.MethodGet, Path: "/admin/roles", Handler: c.ListRoles, Summary: "List the admin roles and the permissions they grant", Limit: LimitAdmin, Permission: vo.AdminPermissionManageAdmins},
+		{Method: http.MethodPost, Path: "/admin/users", Handler: c.CreateAdmin, Summary: "Add an admin user and issue their API key", Limit: LimitAdmin, Permission: vo.AdminPermissionManageAdmins},
+		{Method: http.MethodGet, Path: "/admin/users", Handler: c.ListAdmins, Summary: "List admin users", Limit: LimitAdmin, Permission: vo.AdminPermissionManageAdmins},
+		{Method: http.MethodGet, Path: "/admin/users/:id", Handler: c.GetAdmin, Summary: "Get an admin user", Limit: LimitAdmin, Permission: vo.AdminPermissionManageAdmins},
+		{Method: http.MethodPatch, Path: "/admin/users/:id", Handler: c.UpdateAdmin, Summary: "Change an admin user's roles or status", Limit: LimitAdmin, Permission: vo.AdminPermissionManageAdmins},
+		{Method: http.MethodPost, Path: "/admin/users/:id/rotate-key", Handler: c.RotateAdminKey, Summary: "Issue a new API key to an admin user", Limit: LimitAdmin, Permission: vo.AdminPermissionManageAdmins},
+	}
+}
+
+// CreateAdmin adds an admin user and returns their API key
+func (c *AdminUserController) CreateAdmin(ctx *gin.Context) {
+	var req dto.CreateAdminUserRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		c.logger.Error("Failed to bind JSON", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+	actor, err := requestedBy(ctx, req.RequestedBy)
+	if err != nil {
+		c.logger.Error("Request names another admin", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+	req.RequestedBy = actor
+
+	// Validate request
+	if err := ValidateStruct(req); err != nil {
+		c.logger.Error("Validation failed", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+
+	response, err := c.adminUseCase.CreateAdmin(ctx.Request.Context(), req)
+	if err != nil {
+		c.logger.Error("Failed to create admin user", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+
+	Respond(ctx, http.StatusCreated, MsgAdminUserCreated, response)
+}
+
+// GetAdmin retrieves an admin user
+func (c *AdminUserController) GetAdmin(ctx *gin.Context) {
+	id := ctx.Param("id")
+
+	response, err := c.adminUseCase.GetAdmin(ctx.Request.Context(), id)
+	if err != nil {
+		c.logger.Error("Failed to get admin user", "error", err, "adminID", id)
+		HandleError(ctx, err)
+		return
+	}
+
+	Respond(ctx, http.StatusOK, MsgAdminUserRetrieved, response)
+}
+
+// ListAdmins retrieves every admin user
+func (c *AdminUserController) ListAdmins(ctx *gin.Context) {
+	response, err := c.adminUseCase.ListAdmins(ctx.Request.Context())
+	if err != nil {
+		c.logger.Error("Failed to list admin users", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+
+	Respond(ctx, http.StatusOK, MsgAdminUsersRetrieved, response)
+}
+
+// UpdateAdmin changes an admin user's roles or status
+func (c *AdminUserController) UpdateAdmin(ctx *gin.Context) {
+	var req dto.UpdateAdminUserRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		c.logger.Error("Failed to bind JSON", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+	req.ID = ctx.Param("id")
+	actor, err := requestedBy(ctx, req.RequestedBy)
+	if err != nil {
+		c.logger.Error("Request names another admin", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+	req.RequestedBy = actor
+
+	// Validate request
+	if err := ValidateStruct(req); err != nil {
+		c.logger.Error("Validation failed", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+
+	response, err := c.adminUseCase.UpdateAdmin(ctx.Request.Context(), req)
+	if err != nil {
+		c.logger.Error("Failed to update admin user", "error", err, "adminID", req.ID)
+		HandleError(ctx, err)
+		return
+	}
+
+	Respond(ctx, http.StatusOK, MsgAdminUserUpdated, response)
+}
+
+// RotateAdminKey issues a new API key to an admin user; the body is optional for admin callers
+func (c *AdminUserController) RotateAdminKey(ctx *gin.Context) {
+	var req dto.RotateAdminKeyRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil && !errors.Is(err, io.EOF) {
+		c.logger.Error("Failed to bind JSON", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+	req.ID = ctx.Param("id")
+	actor, err := requestedBy(ctx, req.RequestedBy)
+	if err != nil {
+		c.logger.Error("Request names another admin", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+	req.RequestedBy = actor
+
+	// Validate request
+	if err := ValidateStruct(req); err != nil {
+		c.logger.Error("Validation failed", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+
+	response, err := c.adminUseCase.RotateAdminKey(ctx.Request.Context(), req)
+	if err != nil {
+		c.logger.Error("Failed to rotate admin API key", "error", err, "adminID", req.ID)
+		HandleError(ctx, err)
+		return
+	}
+
+	Respond(ctx, http.StatusOK, MsgAdminKeyRotated, response)
+}
+
+// ListRoles retrieves the permissions matrix
+func (c *AdminUserController) ListRoles(ctx *gin.Context) {
+	Respond(ctx, http.StatusOK, MsgAdminRolesRetrieved, c.adminUseCase.ListRoles(ctx.Request.Context()))
+}
+
+// requestedBy names the caller making a change: the admin user the request was authenticated as, or
+// the name the body gives when the service API key is used. An admin key naming anyone else in the
+// body is rejected, so one admin cannot stand in for another, e.g. to pass dual approval alone.
+func requestedBy(ctx *gin.Context, given string) (string, error) {
+	admin := AdminFromContext(ctx)
+	if admin == nil {
+		return given, nil
+	}
+	if given != "" && !strings.EqualFold(given, admin.Email) {
+		return "", errs.ErrActorMismatch
+	}
+	return admin.Email, nil
+}
@@ -0,0 +1,170 @@
+package controller
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	usecase "github.com/hydr0g3nz/mini_bank/internal/application"
+	"github.com/hydr0g3nz/mini_bank/internal/application/dto"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/infra"
+)
+
+// maxAttachmentBodySize bounds an upload's request body: the largest attachment plus room for the
+// multipart framing around it
+const maxAttachmentBodySize = entity.MaxAttachmentSize + 64<<10
+
+type AttachmentController struct {
+	attachmentUseCase usecase.AttachmentUseCase
+	logger            infra.Logger
+}
+
+func NewAttachmentController(attachmentUseCase usecase.AttachmentUseCase, logger infra.Logger) *AttachmentController {
+	return &AttachmentController{
+		attachmentUseCase: attachmentUseCase,
+		logger:            logger,
+	}
+}
+
+// Routes declares the transaction attachment routes
+func (c *AttachmentController) Routes() []Route {
+	return []Route{
+		{Method: http.MethodPost, Path: "/accounts/:id/transactions/:transaction_id/attachments", Handler: c.UploadAttachment, Summary: "Attach a file to a transaction"},
+		{Method: http.MethodGet, Path: "/accounts/:id/transactions/:transaction_id/attachments", Handler: c.ListAttachments, Summary: "List an account's attachments of a transaction"},
+		{Method: http.MethodGet, Path: "/accounts/:id/transactions/:transaction_id/attachments/:attachment_id", Handler: c.GetAttachment, Summary: "Get an attachment"},
+		{Method: http.MethodGet, Path: "/accounts/:id/transactions/:transaction_id/attachments/:attachment_id/content", Handler: c.DownloadAttachment, Summary: "Download the file of an attachment"},
+		{Method: http.MethodDelete, Path: "/accounts/:id/transactions/:transaction_id/attachments/:attachment_id", Handler: c.DeleteAttachment, Summary: "Remove an attachment"},
+	}
+}
+
+// UploadAttachment attaches the file of the multipart "file" field to one of an account's transactions
+func (c *AttachmentController) UploadAttachment(ctx *gin.Context) {
+	ctx.Request.Body = http.MaxBytesReader(ctx.Writer, ctx.Request.Body, maxAttachmentBodySize)
+
+	header, err := ctx.FormFile("file")
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			HandleError(ctx, errs.ErrAttachmentTooLarge)
+			return
+		}
+		c.logger.Error("Failed to read uploaded file", "error", err)
+		HandleError(ctx, &ValidationError{Field: "file", Message: "a file must be uploaded in the multipart field \"file\""})
+		return
+	}
+
+	file, err := header.Open()
+	if err != nil {
+		c.logger.Error("Failed to open uploaded file", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+	defer file.Close()
+
+	req := dto.UploadAttachmentRequest{
+		AccountID:     ctx.Param("id"),
+		TransactionID: ctx.Param("transaction_id"),
+		FileName:      header.Filename,
+		SizeBytes:     header.Size,
+		Content:       file,
+	}
+
+	// Validate request
+	if err := ValidateStruct(req); err != nil {
+		c.logger.Error("Validation failed", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+
+	response, err := c.attachmentUseCase.UploadAttachment(ctx.Request.Context(), req)
+	if err != nil {
+		c.logger.Error("Failed to upload attachment", "error", err, "accountID", req.AccountID, "transactionID", req.TransactionID)
+		HandleError(ctx, err)
+		return
+	}
+
+	Respond(ctx, http.StatusCreated, MsgAttachmentUploaded, response)
+}
+
+// ListAttachments retrieves an account's attachments of one of its transactions
+func (c *AttachmentController) ListAttachments(ctx *gin.Context) {
+	accountID := ctx.Param("id")
+	transactionID := ctx.Param("transaction_id")
+
+	response, err := c.attachmentUseCase.ListAttachments(ctx.Request.Context(), accountID, transactionID)
+	if err != nil {
+		c.logger.Error("Failed to list attachments", "error", err, "accountID", accountID, "transactionID", transactionID)
+		HandleError(ctx, err)
+		return
+	}
+
+	Respond(ctx, http.StatusOK, MsgAttachmentsRetrieved, response)
+}
+
+// GetAttachment retrieves an attachment of one of an account's transactions
+func (c *AttachmentController) GetAttachment(ctx *gin.Context) {
+	accountID := ctx.Param("id")
+	transactionID := ctx.Param("transaction_id")
+	attachmentID := ctx.Param("attachment_id")
+
+	response, err := c.attachmentUseCase.GetAttachment(ctx.Request.Context(), accountID, transactionID, attachmentID)
+	if err != nil {
+		c.logger.Error("Failed to get attachment", "error", err, "accountID", accountID, "attachmentID", attachmentID)
+		HandleError(ctx, err)
+		return
+	}
+
+	Respond(ctx, http.StatusOK, MsgAttachmentRetrieved, response)
+}
+
+// DownloadAttachment streams the file of an attachment
+func (c *AttachmentController) DownloadAttachment(ctx *gin.Context) {
+	accountID := ctx.Param("id")
+	transactionID := ctx.Param("transaction_id")
+	attachmentID := ctx.Param("attachment_id")
+
+	download, err := c.attachmentUseCase.OpenAttachment(ctx.Request.Context(), accountID, transactionID, attachmentID)
+	if err != nil {
+		c.logger.Error("Failed to open attachment", "error", err, "accountID", accountID, "attachmentID", attachmentID)
+		HandleError(ctx, err)
+		return
+	}
+	defer download.Content.Close()
+
+	// Slow clients may outlive the server's write timeout
+	if err := http.NewResponseController(ctx.Writer).SetWriteDeadline(time.Time{}); err != nil {
+		c.logger.Warn("Failed to clear write deadline for attachment download", "error", err)
+	}
+
+	ctx.Header("Content-Type", download.ContentType)
+	ctx.Header("Content-Disposition", fmt.Sprintf(`attachment; filename=%q`, download.FileName))
+	ctx.Header("Content-Length", strconv.FormatInt(download.SizeBytes, 10))
+	ctx.Header("Cache-Control", "private, no-store")
+	ctx.Header("X-Content-Type-Options", "nosniff")
+	ctx.Status(http.StatusOK)
+
+	if _, err := io.Copy(ctx.Writer, download.Content); err != nil {
+		c.logger.Warn("Attachment download interrupted", "error", err, "attachmentID", attachmentID)
+	}
+}
+
+// DeleteAttachment removes an attachment of one of an account's transactions
+func (c *AttachmentController) DeleteAttachment(ctx *gin.Context) {
+	accountID := ctx.Param("id")
+	transactionID := ctx.Param("transaction_id")
+	attachmentID := ctx.Param("attachment_id")
+
+	if err := c.attachmentUseCase.DeleteAttachment(ctx.Request.Context(), accountID, transactionID, attachmentID); err != nil {
+		c.logger.Error("Failed to delete attachment", "error", err, "accountID", accountID, "attachmentID", attachmentID)
+		HandleError(ctx, err)
+		return
+	}
+
+	Respond(ctx, http.StatusOK, MsgAttachmentDeleted, nil)
+}
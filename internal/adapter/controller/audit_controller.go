@@ -0,0 +1,90 @@
+package controller
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	usecase "github.com/hydr0g3nz/mini_bank/internal/application"
+	"github.com/hydr0g3nz/mini_bank/internal/application/dto"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/infra"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+)
+
+type AuditController struct {
+	auditUseCase usecase.AuditUseCase
+	logger       infra.Logger
+}
+
+func NewAuditController(auditUseCase usecase.AuditUseCase, logger infra.Logger) *AuditController {
+	return &AuditController{
+		auditUseCase: auditUseCase,
+		logger:       logger,
+	}
+}
+
+// Routes declares the audit routes
+func (c *AuditController) Routes() []Route {
+	return []Route{
+		{Method: http.MethodGet, Path: "/admin/audit/export", Handler: c.ExportAudit, Summary: "Export the audit log", Limit: LimitExport, Permission: vo.AdminPermissionViewAudit},
+	}
+}
+
+// ExportAudit streams the audit log as NDJSON, filtered by the "type", "account_id", "from"
+// and "to" (RFC 3339) query parameters. The last line carries the export's signature.
+func (c *AuditController) ExportAudit(ctx *gin.Context) {
+	req := dto.AuditExportRequest{
+		EventType: ctx.Query("type"),
+		AccountID: ctx.Query("account_id"),
+	}
+	var err error
+	if req.From, err = queryTime(ctx, "from"); err != nil {
+		HandleError(ctx, err)
+		return
+	}
+	if req.To, err = queryTime(ctx, "to"); err != nil {
+		HandleError(ctx, err)
+		return
+	}
+
+	// Validate request
+	if err := ValidateStruct(req); err != nil {
+		c.logger.Error("Validation failed", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+
+	// Large exports outlive the server's write timeout
+	if err := http.NewResponseController(ctx.Writer).SetWriteDeadline(time.Time{}); err != nil {
+		c.logger.Warn("Failed to clear write deadline for audit export", "error", err)
+	}
+
+	ctx.Header("Content-Type", "application/x-ndjson")
+	ctx.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="audit-%s.ndjson"`, time.Now().UTC().Format("20060102T150405Z")))
+	ctx.Header("Cache-Control", "no-store")
+
+	if _, err := c.auditUseCase.ExportAudit(ctx.Request.Context(), req, ctx.Writer); err != nil {
+		c.logger.Error("Failed to export audit log", "error", err)
+		// Once entries are streamed the status is sent; the missing signature line marks the export as incomplete
+		if !ctx.Writer.Written() {
+			ctx.Header("Content-Type", "")
+			ctx.Header("Content-Disposition", "")
+			HandleError(ctx, err)
+		}
+	}
+}
+
+// queryTime parses an optional RFC 3339 query parameter
+func queryTime(ctx *gin.Context, name string) (*time.Time, error) {
+	value := ctx.Query(name)
+	if value == "" {
+		return nil, nil
+	}
+
+	at, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, &ValidationError{Field: name, Message: name + " must be an RFC 3339 timestamp"}
+	}
+	return &at, nil
+}
@@ -0,0 +1,123 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	usecase "github.com/hydr0g3nz/mini_bank/internal/application"
+	"github.com/hydr0g3nz/mini_bank/internal/application/dto"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/infra"
+)
+
+type BackupController struct {
+	backupUseCase usecase.BackupUseCase
+	logger        infra.Logger
+}
+
+func NewBackupController(backupUseCase usecase.BackupUseCase, logger infra.Logger) *BackupController {
+	return &BackupController{
+		backupUseCase: backupUseCase,
+		logger:        logger,
+	}
+}
+
+// Routes declares the backup routes
+func (c *BackupController) Routes() []Route {
+	return []Route{
+		{Method: http.MethodPost, Path: "/admin/backups", Handler: c.TriggerBackup, Summary: "Trigger a database backup", Limit: LimitExport},
+		{Method: http.MethodGet, Path: "/admin/backups", Handler: c.ListBackups, Summary: "List backups", Limit: LimitAdmin},
+		{Method: http.MethodGet, Path: "/admin/backups/:id", Handler: c.GetBackup, Summary: "Get a backup", Limit: LimitAdmin},
+		{Method: http.MethodGet, Path: "/admin/accounts/:id/balance-at", Handler: c.GetBalanceAsOf, Summary: "Reconstruct an account's balance at a point in time", Limit: LimitAdmin},
+	}
+}
+
+// TriggerBackup starts a new logical database backup
+func (c *BackupController) TriggerBackup(ctx *gin.Context) {
+	response, err := c.backupUseCase.TriggerBackup(ctx.Request.Context(), ctx.ClientIP())
+	if err != nil {
+		c.logger.Error("Failed to trigger backup", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+
+	c.logger.Info("Backup triggered successfully", "backupID", response.ID)
+	Respond(ctx, http.StatusAccepted, MsgBackupStarted, response)
+}
+
+// GetBackup retrieves backup metadata by ID
+func (c *BackupController) GetBackup(ctx *gin.Context) {
+	id := ctx.Param("id")
+	if id == "" {
+		c.logger.Error("Backup ID is required")
+		HandleError(ctx, &ValidationError{Field: "id", Message: "backup ID is required"})
+		return
+	}
+
+	response, err := c.backupUseCase.GetBackup(ctx.Request.Context(), id)
+	if err != nil {
+		c.logger.Error("Failed to get backup", "error", err, "backupID", id)
+		HandleError(ctx, err)
+		return
+	}
+
+	Respond(ctx, http.StatusOK, MsgBackupRetrieved, response)
+}
+
+// ListBackups retrieves backup metadata with pagination
+func (c *BackupController) ListBackups(ctx *gin.Context) {
+	// Parse query parameters
+	page, _ := strconv.Atoi(ctx.DefaultQuery("page", "1"))
+	pageSize, _ := strconv.Atoi(ctx.DefaultQuery("page_size", "10"))
+
+	req := dto.ListRequest{
+		Page:     page,
+		PageSize: pageSize,
+	}
+
+	// Validate request
+	if err := ValidateStruct(req); err != nil {
+		c.logger.Error("Validation failed", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+
+	response, err := c.backupUseCase.ListBackups(ctx.Request.Context(), req)
+	if err != nil {
+		c.logger.Error("Failed to list backups", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+
+	SetPaginationHeaders(ctx, response.Pagination)
+	Respond(ctx, http.StatusOK, MsgBackupsRetrieved, response)
+}
+
+// GetBalanceAsOf reconstructs an account balance at the time given in the "at" query parameter (RFC 3339)
+func (c *BackupController) GetBalanceAsOf(ctx *gin.Context) {
+	accountID := ctx.Param("id")
+	if accountID == "" {
+		c.logger.Error("Account ID is required")
+		HandleError(ctx, &ValidationError{Field: "id", Message: "account ID is required"})
+		return
+	}
+
+	asOf, err := time.Parse(time.RFC3339, ctx.Query("at"))
+	if err != nil {
+		c.logger.Error("Invalid timestamp", "error", err, "at", ctx.Query("at"))
+		HandleError(ctx, &ValidationError{Field: "at", Message: "at must be an RFC 3339 timestamp"})
+		return
+	}
+
+	req := dto.BalanceAsOfRequest{AccountID: accountID, AsOf: asOf}
+
+	response, err := c.backupUseCase.ReconstructBalance(ctx.Request.Context(), req)
+	if err != nil {
+		c.logger.Error("Failed to reconstruct balance", "error", err, "accountID", accountID)
+		HandleError(ctx, err)
+		return
+	}
+
+	Respond(ctx, http.StatusOK, MsgBalanceReconstructed, response)
+}
@@ -0,0 +1,131 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	usecase "github.com/hydr0g3nz/mini_bank/internal/application"
+	"github.com/hydr0g3nz/mini_bank/internal/application/dto"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/infra"
+)
+
+type BudgetController struct {
+	budgetUseCase usecase.BudgetUseCase
+	logger        infra.Logger
+}
+
+func NewBudgetController(budgetUseCase usecase.BudgetUseCase, logger infra.Logger) *BudgetController {
+	return &BudgetController{
+		budgetUseCase: budgetUseCase,
+		logger:        logger,
+	}
+}
+
+// Routes declares the budget routes
+func (c *BudgetController) Routes() []Route {
+	return []Route{
+		{Method: http.MethodPost, Path: "/accounts/:id/budgets", Handler: c.CreateBudget, Summary: "Set a budget"},
+		{Method: http.MethodGet, Path: "/accounts/:id/budgets", Handler: c.ListBudgets, Summary: "List an account's budgets"},
+		{Method: http.MethodGet, Path: "/accounts/:id/budgets/:budget_id", Handler: c.GetBudget, Summary: "Get a budget"},
+		{Method: http.MethodPut, Path: "/accounts/:id/budgets/:budget_id", Handler: c.UpdateBudget, Summary: "Change a budget's amount"},
+		{Method: http.MethodDelete, Path: "/accounts/:id/budgets/:budget_id", Handler: c.DeleteBudget, Summary: "Remove a budget"},
+	}
+}
+
+// CreateBudget sets a monthly budget for a category of an account
+func (c *BudgetController) CreateBudget(ctx *gin.Context) {
+	var req dto.CreateBudgetRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		c.logger.Error("Failed to bind JSON", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+	req.AccountID = ctx.Param("id")
+
+	// Validate request
+	if err := ValidateStruct(req); err != nil {
+		c.logger.Error("Validation failed", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+
+	response, err := c.budgetUseCase.CreateBudget(ctx.Request.Context(), req)
+	if err != nil {
+		c.logger.Error("Failed to create budget", "error", err, "accountID", req.AccountID)
+		HandleError(ctx, err)
+		return
+	}
+
+	Respond(ctx, http.StatusCreated, MsgBudgetCreated, response)
+}
+
+// GetBudget retrieves a budget of an account with its current month spend
+func (c *BudgetController) GetBudget(ctx *gin.Context) {
+	accountID := ctx.Param("id")
+	budgetID := ctx.Param("budget_id")
+
+	response, err := c.budgetUseCase.GetBudget(ctx.Request.Context(), accountID, budgetID)
+	if err != nil {
+		c.logger.Error("Failed to get budget", "error", err, "accountID", accountID, "budgetID", budgetID)
+		HandleError(ctx, err)
+		return
+	}
+
+	Respond(ctx, http.StatusOK, MsgBudgetRetrieved, response)
+}
+
+// ListBudgets retrieves the budgets of an account with their current month spend
+func (c *BudgetController) ListBudgets(ctx *gin.Context) {
+	accountID := ctx.Param("id")
+
+	response, err := c.budgetUseCase.ListBudgets(ctx.Request.Context(), accountID)
+	if err != nil {
+		c.logger.Error("Failed to list budgets", "error", err, "accountID", accountID)
+		HandleError(ctx, err)
+		return
+	}
+
+	Respond(ctx, http.StatusOK, MsgBudgetsRetrieved, response)
+}
+
+// UpdateBudget changes a budget's monthly amount
+func (c *BudgetController) UpdateBudget(ctx *gin.Context) {
+	var req dto.UpdateBudgetRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		c.logger.Error("Failed to bind JSON", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+	req.AccountID = ctx.Param("id")
+	req.ID = ctx.Param("budget_id")
+
+	// Validate request
+	if err := ValidateStruct(req); err != nil {
+		c.logger.Error("Validation failed", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+
+	response, err := c.budgetUseCase.UpdateBudget(ctx.Request.Context(), req)
+	if err != nil {
+		c.logger.Error("Failed to update budget", "error", err, "budgetID", req.ID)
+		HandleError(ctx, err)
+		return
+	}
+
+	Respond(ctx, http.StatusOK, MsgBudgetUpdated, response)
+}
+
+// DeleteBudget removes a budget of an account
+func (c *BudgetController) DeleteBudget(ctx *gin.Context) {
+	accountID := ctx.Param("id")
+	budgetID := ctx.Param("budget_id")
+
+	if err := c.budgetUseCase.DeleteBudget(ctx.Request.Context(), accountID, budgetID); err != nil {
+		c.logger.Error("Failed to delete budget", "error", err, "accountID", accountID, "budgetID", budgetID)
+		HandleError(ctx, err)
+		return
+	}
+
+	Respond(ctx, http.StatusOK, MsgBudgetDeleted, nil)
+}
@@ -0,0 +1,137 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	usecase "github.com/hydr0g3nz/mini_bank/internal/application"
+	"github.com/hydr0g3nz/mini_bank/internal/application/dto"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/infra"
+)
+
+type BusinessRuleController struct {
+	businessRuleUseCase usecase.BusinessRuleUseCase
+	logger              infra.Logger
+}
+
+func NewBusinessRuleController(businessRuleUseCase usecase.BusinessRuleUseCase, logger infra.Logger) *BusinessRuleController {
+	return &BusinessRuleController{
+		businessRuleUseCase: businessRuleUseCase,
+		logger:              logger,
+	}
+}
+
+// Routes declares the business rule routes
+func (c *BusinessRuleController) Routes() []Route {
+	return []Route{
+		{Method: http.MethodGet, Path: "/admin/business-rules", Handler: c.ListRules, Summary: "List business rules", Limit: LimitAdmin},
+		{Method: http.MethodPost, Path: "/admin/business-rules", Handler: c.CreateRule, Summary: "Add a business rule", Limit: LimitAdmin},
+		{Method: http.MethodPost, Path: "/admin/business-rules/test", Handler: c.TestRule, Summary: "Evaluate a rule expression without saving it", Limit: LimitAdmin},
+		{Method: http.MethodPut, Path: "/admin/business-rules/:id", Handler: c.UpdateRule, Summary: "Update a business rule", Limit: LimitAdmin},
+		{Method: http.MethodDelete, Path: "/admin/business-rules/:id", Handler: c.DeleteRule, Summary: "Remove a business rule", Limit: LimitAdmin},
+	}
+}
+
+// CreateRule adds a validation or fee rule
+func (c *BusinessRuleController) CreateRule(ctx *gin.Context) {
+	var req dto.CreateBusinessRuleRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		c.logger.Error("Failed to bind JSON", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+
+	// Validate request
+	if err := ValidateStruct(req); err != nil {
+		c.logger.Error("Validation failed", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+
+	response, err := c.businessRuleUseCase.CreateRule(ctx.Request.Context(), req)
+	if err != nil {
+		c.logger.Error("Failed to create business rule", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+
+	Respond(ctx, http.StatusCreated, MsgBusinessRuleCreated, response)
+}
+
+// UpdateRule changes a business rule or switches it on or off
+func (c *BusinessRuleController) UpdateRule(ctx *gin.Context) {
+	var req dto.UpdateBusinessRuleRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		c.logger.Error("Failed to bind JSON", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+	req.ID = ctx.Param("id")
+
+	// Validate request
+	if err := ValidateStruct(req); err != nil {
+		c.logger.Error("Validation failed", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+
+	response, err := c.businessRuleUseCase.UpdateRule(ctx.Request.Context(), req)
+	if err != nil {
+		c.logger.Error("Failed to update business rule", "error", err, "ruleID", req.ID)
+		HandleError(ctx, err)
+		return
+	}
+
+	Respond(ctx, http.StatusOK, MsgBusinessRuleUpdated, response)
+}
+
+// DeleteRule removes a business rule
+func (c *BusinessRuleController) DeleteRule(ctx *gin.Context) {
+	id := ctx.Param("id")
+
+	if err := c.businessRuleUseCase.DeleteRule(ctx.Request.Context(), id); err != nil {
+		c.logger.Error("Failed to delete business rule", "error", err, "ruleID", id)
+		HandleError(ctx, err)
+		return
+	}
+
+	Respond(ctx, http.StatusOK, MsgBusinessRuleDeleted, nil)
+}
+
+// ListRules retrieves the business rules in evaluation order
+func (c *BusinessRuleController) ListRules(ctx *gin.Context) {
+	response, err := c.businessRuleUseCase.ListRules(ctx.Request.Context())
+	if err != nil {
+		c.logger.Error("Failed to list business rules", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+
+	Respond(ctx, http.StatusOK, MsgBusinessRulesRetrieved, response)
+}
+
+// TestRule evaluates an expression against a sample transaction without saving it
+func (c *BusinessRuleController) TestRule(ctx *gin.Context) {
+	var req dto.TestBusinessRuleRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		c.logger.Error("Failed to bind JSON", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+
+	// Validate request
+	if err := ValidateStruct(req); err != nil {
+		c.logger.Error("Validation failed", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+
+	response, err := c.businessRuleUseCase.TestRule(ctx.Request.Context(), req)
+	if err != nil {
+		c.logger.Error("Failed to test business rule", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+
+	Respond(ctx, http.StatusOK, MsgBusinessRuleTested, response)
+}
@@ -0,0 +1,63 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	usecase "github.com/hydr0g3nz/mini_bank/internal/application"
+	"github.com/hydr0g3nz/mini_bank/internal/application/dto"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/infra"
+)
+
+type CacheController struct {
+	cacheUseCase usecase.CacheUseCase
+	logger       infra.Logger
+}
+
+func NewCacheController(cacheUseCase usecase.CacheUseCase, logger infra.Logger) *CacheController {
+	return &CacheController{
+		cacheUseCase: cacheUseCase,
+		logger:       logger,
+	}
+}
+
+// Routes declares the cache routes
+func (c *CacheController) Routes() []Route {
+	return []Route{
+		{Method: http.MethodPost, Path: "/admin/cache/invalidate", Handler: c.InvalidateCache, Summary: "Remove cached entries by pattern or entity", Limit: LimitAdmin},
+	}
+}
+
+// InvalidateCache removes cached entries, e.g. after a manual database fix
+func (c *CacheController) InvalidateCache(ctx *gin.Context) {
+	var req dto.CacheInvalidationRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		c.logger.Error("Failed to bind JSON", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+
+	actor, err := requestedBy(ctx, req.RequestedBy)
+	if err != nil {
+		c.logger.Error("Request names another admin", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+	req.RequestedBy = actor
+
+	// Validate request
+	if err := ValidateStruct(req); err != nil {
+		c.logger.Error("Validation failed", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+
+	response, err := c.cacheUseCase.InvalidateCache(ctx.Request.Context(), req)
+	if err != nil {
+		c.logger.Error("Failed to invalidate cache", "error", err, "requestedBy", req.RequestedBy)
+		HandleError(ctx, err)
+		return
+	}
+
+	Respond(ctx, http.StatusOK, MsgCacheInvalidated, response)
+}
@@ -0,0 +1,146 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	usecase "github.com/hydr0g3nz/mini_bank/internal/application"
+	"github.com/hydr0g3nz/mini_bank/internal/application/dto"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/infra"
+)
+
+type CalendarController struct {
+	calendarUseCase usecase.CalendarUseCase
+	logger          infra.Logger
+}
+
+func NewCalendarController(calendarUseCase usecase.CalendarUseCase, logger infra.Logger) *CalendarController {
+	return &CalendarController{
+		calendarUseCase: calendarUseCase,
+		logger:          logger,
+	}
+}
+
+// Routes declares the calendar routes
+func (c *CalendarController) Routes() []Route {
+	return []Route{
+		{Method: http.MethodGet, Path: "/calendar/:region/holidays", Handler: c.ListHolidays, Summary: "List a region's holidays"},
+		{Method: http.MethodGet, Path: "/calendar/:region/business-days/:date", Handler: c.GetBusinessDay, Summary: "Check whether a date is a business day"},
+		{Method: http.MethodGet, Path: "/calendar/:region/settlement-date", Handler: c.GetSettlementDate, Summary: "Compute a settlement date"},
+		{Method: http.MethodPost, Path: "/admin/calendar/:region/holidays", Handler: c.AddHoliday, Summary: "Add a holiday", Limit: LimitAdmin},
+		{Method: http.MethodDelete, Path: "/admin/calendar/:region/holidays/:date", Handler: c.DeleteHoliday, Summary: "Remove a holiday", Limit: LimitAdmin},
+	}
+}
+
+// AddHoliday adds a holiday to a region's calendar
+func (c *CalendarController) AddHoliday(ctx *gin.Context) {
+	var req dto.CreateHolidayRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		c.logger.Error("Failed to bind JSON", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+	req.Region = ctx.Param("region")
+
+	// Validate request
+	if err := ValidateStruct(req); err != nil {
+		c.logger.Error("Validation failed", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+
+	response, err := c.calendarUseCase.AddHoliday(ctx.Request.Context(), req)
+	if err != nil {
+		c.logger.Error("Failed to add holiday", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+
+	Respond(ctx, http.StatusCreated, MsgHolidayAdded, response)
+}
+
+// DeleteHoliday removes a holiday from a region's calendar
+func (c *CalendarController) DeleteHoliday(ctx *gin.Context) {
+	region := ctx.Param("region")
+	date := ctx.Param("date")
+
+	if err := c.calendarUseCase.DeleteHoliday(ctx.Request.Context(), region, date); err != nil {
+		c.logger.Error("Failed to delete holiday", "error", err, "region", region, "date", date)
+		HandleError(ctx, err)
+		return
+	}
+
+	Respond(ctx, http.StatusOK, MsgHolidayDeleted, nil)
+}
+
+// ListHolidays retrieves a region's holidays within a date range
+func (c *CalendarController) ListHolidays(ctx *gin.Context) {
+	req := dto.ListHolidaysRequest{
+		Region: ctx.Param("region"),
+		From:   ctx.Query("from"),
+		To:     ctx.Query("to"),
+	}
+
+	// Validate request
+	if err := ValidateStruct(req); err != nil {
+		c.logger.Error("Validation failed", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+
+	response, err := c.calendarUseCase.ListHolidays(ctx.Request.Context(), req)
+	if err != nil {
+		c.logger.Error("Failed to list holidays", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+
+	Respond(ctx, http.StatusOK, MsgHolidaysRetrieved, response)
+}
+
+// GetBusinessDay reports whether a date is a business day in a region
+func (c *CalendarController) GetBusinessDay(ctx *gin.Context) {
+	region := ctx.Param("region")
+	date := ctx.Param("date")
+
+	response, err := c.calendarUseCase.GetBusinessDay(ctx.Request.Context(), region, date)
+	if err != nil {
+		c.logger.Error("Failed to get business day", "error", err, "region", region, "date", date)
+		HandleError(ctx, err)
+		return
+	}
+
+	Respond(ctx, http.StatusOK, MsgBusinessDayRetrieved, response)
+}
+
+// GetSettlementDate computes the date N business days after a trade date
+func (c *CalendarController) GetSettlementDate(ctx *gin.Context) {
+	days, err := strconv.Atoi(ctx.DefaultQuery("days", "0"))
+	if err != nil {
+		HandleError(ctx, &ValidationError{Field: "days", Message: "days must be an integer"})
+		return
+	}
+
+	req := dto.SettlementDateRequest{
+		Region:    ctx.Param("region"),
+		TradeDate: ctx.Query("trade_date"),
+		Days:      days,
+	}
+
+	// Validate request
+	if err := ValidateStruct(req); err != nil {
+		c.logger.Error("Validation failed", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+
+	response, err := c.calendarUseCase.GetSettlementDate(ctx.Request.Context(), req)
+	if err != nil {
+		c.logger.Error("Failed to compute settlement date", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+
+	Respond(ctx, http.StatusOK, MsgSettlementDateComputed, response)
+}
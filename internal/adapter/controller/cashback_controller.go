@@ -0,0 +1,128 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	usecase "github.com/hydr0g3nz/mini_bank/internal/application"
+	"github.com/hydr0g3nz/mini_bank/internal/application/dto"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/infra"
+)
+
+type CashbackController struct {
+	cashbackUseCase usecase.CashbackUseCase
+	logger          infra.Logger
+}
+
+func NewCashbackController(cashbackUseCase usecase.CashbackUseCase, logger infra.Logger) *CashbackController {
+	return &CashbackController{
+		cashbackUseCase: cashbackUseCase,
+		logger:          logger,
+	}
+}
+
+// Routes declares the cashback routes
+func (c *CashbackController) Routes() []Route {
+	return []Route{
+		{Method: http.MethodGet, Path: "/accounts/:id/cashback", Handler: c.GetAccountCashback, Summary: "List the cashback an account earned"},
+		{Method: http.MethodGet, Path: "/admin/cashback-campaigns", Handler: c.ListCampaigns, Summary: "List cashback campaigns", Limit: LimitAdmin},
+		{Method: http.MethodPost, Path: "/admin/cashback-campaigns", Handler: c.CreateCampaign, Summary: "Start a cashback campaign", Limit: LimitAdmin},
+		{Method: http.MethodPut, Path: "/admin/cashback-campaigns/:id", Handler: c.UpdateCampaign, Summary: "Update a cashback campaign", Limit: LimitAdmin},
+	}
+}
+
+// CreateCampaign starts a cashback campaign
+func (c *CashbackController) CreateCampaign(ctx *gin.Context) {
+	var req dto.CreateCashbackCampaignRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		c.logger.Error("Failed to bind JSON", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+
+	// Validate request
+	if err := ValidateStruct(req); err != nil {
+		c.logger.Error("Validation failed", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+
+	response, err := c.cashbackUseCase.CreateCampaign(ctx.Request.Context(), req)
+	if err != nil {
+		c.logger.Error("Failed to create cashback campaign", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+
+	Respond(ctx, http.StatusCreated, MsgCashbackCampaignCreated, response)
+}
+
+// UpdateCampaign changes a cashback campaign's terms or pauses it
+func (c *CashbackController) UpdateCampaign(ctx *gin.Context) {
+	var req dto.UpdateCashbackCampaignRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		c.logger.Error("Failed to bind JSON", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+	req.ID = ctx.Param("id")
+
+	// Validate request
+	if err := ValidateStruct(req); err != nil {
+		c.logger.Error("Validation failed", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+
+	response, err := c.cashbackUseCase.UpdateCampaign(ctx.Request.Context(), req)
+	if err != nil {
+		c.logger.Error("Failed to update cashback campaign", "error", err, "campaignID", req.ID)
+		HandleError(ctx, err)
+		return
+	}
+
+	Respond(ctx, http.StatusOK, MsgCashbackCampaignUpdated, response)
+}
+
+// ListCampaigns retrieves the cashback campaigns
+func (c *CashbackController) ListCampaigns(ctx *gin.Context) {
+	response, err := c.cashbackUseCase.ListCampaigns(ctx.Request.Context())
+	if err != nil {
+		c.logger.Error("Failed to list cashback campaigns", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+
+	Respond(ctx, http.StatusOK, MsgCashbackCampaignsRetrieved, response)
+}
+
+// GetAccountCashback retrieves the cashback an account earned
+func (c *CashbackController) GetAccountCashback(ctx *gin.Context) {
+	accountID := ctx.Param("id")
+
+	page, _ := strconv.Atoi(ctx.DefaultQuery("page", "1"))
+	pageSize, _ := strconv.Atoi(ctx.DefaultQuery("page_size", "10"))
+
+	req := dto.ListRequest{
+		Page:     page,
+		PageSize: pageSize,
+	}
+
+	// Validate request
+	if err := ValidateStruct(req); err != nil {
+		c.logger.Error("Validation failed", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+
+	response, err := c.cashbackUseCase.GetAccountCashback(ctx.Request.Context(), accountID, req)
+	if err != nil {
+		c.logger.Error("Failed to get account cashback", "error", err, "accountID", accountID)
+		HandleError(ctx, err)
+		return
+	}
+
+	SetPaginationHeaders(ctx, response.Pagination)
+	Respond(ctx, http.StatusOK, MsgAccountCashbackRetrieved, response)
+}
@@ -0,0 +1,233 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	usecase "github.com/hydr0g3nz/mini_bank/internal/application"
+	"github.com/hydr0g3nz/mini_bank/internal/application/dto"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/infra"
+)
+
+type CategoryController struct {
+	categoryUseCase usecase.CategoryUseCase
+	logger          infra.Logger
+}
+
+func NewCategoryController(categoryUseCase usecase.CategoryUseCase, logger infra.Logger) *CategoryController {
+	return &CategoryController{
+		categoryUseCase: categoryUseCase,
+		logger:          logger,
+	}
+}
+
+// Routes declares the category routes
+func (c *CategoryController) Routes() []Route {
+	return []Route{
+		{Method: http.MethodGet, Path: "/categories", Handler: c.ListCategories, Summary: "List the category taxonomy"},
+		{Method: http.MethodPut, Path: "/accounts/:id/transactions/:transaction_id/category", Handler: c.SetTransactionCategory, Summary: "Override a transaction's category for an account"},
+		{Method: http.MethodDelete, Path: "/accounts/:id/transactions/:transaction_id/category", Handler: c.ClearTransactionCategory, Summary: "Remove a transaction's category override"},
+		{Method: http.MethodGet, Path: "/accounts/:id/category-summary", Handler: c.GetCategorySummary, Summary: "Summarize an account's flows per category"},
+		{Method: http.MethodPost, Path: "/admin/categories", Handler: c.CreateCategory, Summary: "Add a category", Limit: LimitAdmin},
+		{Method: http.MethodPut, Path: "/admin/categories/:code", Handler: c.UpdateCategory, Summary: "Update a category", Limit: LimitAdmin},
+		{Method: http.MethodDelete, Path: "/admin/categories/:code", Handler: c.DeleteCategory, Summary: "Remove a category", Limit: LimitAdmin},
+		{Method: http.MethodGet, Path: "/admin/category-rules", Handler: c.ListRules, Summary: "List categorization rules", Limit: LimitAdmin},
+		{Method: http.MethodPost, Path: "/admin/category-rules", Handler: c.CreateRule, Summary: "Add a categorization rule", Limit: LimitAdmin},
+		{Method: http.MethodDelete, Path: "/admin/category-rules/:id", Handler: c.DeleteRule, Summary: "Remove a categorization rule", Limit: LimitAdmin},
+	}
+}
+
+// CreateCategory adds a category to the taxonomy
+func (c *CategoryController) CreateCategory(ctx *gin.Context) {
+	var req dto.CreateCategoryRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		c.logger.Error("Failed to bind JSON", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+
+	// Validate request
+	if err := ValidateStruct(req); err != nil {
+		c.logger.Error("Validation failed", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+
+	response, err := c.categoryUseCase.CreateCategory(ctx.Request.Context(), req)
+	if err != nil {
+		c.logger.Error("Failed to create category", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+
+	Respond(ctx, http.StatusCreated, MsgCategoryCreated, response)
+}
+
+// UpdateCategory renames a category or moves it under another parent
+func (c *CategoryController) UpdateCategory(ctx *gin.Context) {
+	var req dto.UpdateCategoryRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		c.logger.Error("Failed to bind JSON", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+	req.Code = ctx.Param("code")
+
+	// Validate request
+	if err := ValidateStruct(req); err != nil {
+		c.logger.Error("Validation failed", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+
+	response, err := c.categoryUseCase.UpdateCategory(ctx.Request.Context(), req)
+	if err != nil {
+		c.logger.Error("Failed to update category", "error", err, "code", req.Code)
+		HandleError(ctx, err)
+		return
+	}
+
+	Respond(ctx, http.StatusOK, MsgCategoryUpdated, response)
+}
+
+// DeleteCategory removes a category from the taxonomy
+func (c *CategoryController) DeleteCategory(ctx *gin.Context) {
+	code := ctx.Param("code")
+
+	if err := c.categoryUseCase.DeleteCategory(ctx.Request.Context(), code); err != nil {
+		c.logger.Error("Failed to delete category", "error", err, "code", code)
+		HandleError(ctx, err)
+		return
+	}
+
+	Respond(ctx, http.StatusOK, MsgCategoryDeleted, nil)
+}
+
+// ListCategories retrieves the category taxonomy
+func (c *CategoryController) ListCategories(ctx *gin.Context) {
+	response, err := c.categoryUseCase.ListCategories(ctx.Request.Context())
+	if err != nil {
+		c.logger.Error("Failed to list categories", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+
+	Respond(ctx, http.StatusOK, MsgCategoriesRetrieved, response)
+}
+
+// CreateRule adds a categorization rule
+func (c *CategoryController) CreateRule(ctx *gin.Context) {
+	var req dto.CreateCategoryRuleRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		c.logger.Error("Failed to bind JSON", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+
+	// Validate request
+	if err := ValidateStruct(req); err != nil {
+		c.logger.Error("Validation failed", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+
+	response, err := c.categoryUseCase.CreateRule(ctx.Request.Context(), req)
+	if err != nil {
+		c.logger.Error("Failed to create category rule", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+
+	Respond(ctx, http.StatusCreated, MsgCategoryRuleCreated, response)
+}
+
+// DeleteRule removes a categorization rule
+func (c *CategoryController) DeleteRule(ctx *gin.Context) {
+	id := ctx.Param("id")
+
+	if err := c.categoryUseCase.DeleteRule(ctx.Request.Context(), id); err != nil {
+		c.logger.Error("Failed to delete category rule", "error", err, "ruleID", id)
+		HandleError(ctx, err)
+		return
+	}
+
+	Respond(ctx, http.StatusOK, MsgCategoryRuleDeleted, nil)
+}
+
+// ListRules retrieves the categorization rules in evaluation order
+func (c *CategoryController) ListRules(ctx *gin.Context) {
+	response, err := c.categoryUseCase.ListRules(ctx.Request.Context())
+	if err != nil {
+		c.logger.Error("Failed to list category rules", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+
+	Respond(ctx, http.StatusOK, MsgCategoryRulesRetrieved, response)
+}
+
+// SetTransactionCategory overrides the category of one of an account's transactions
+func (c *CategoryController) SetTransactionCategory(ctx *gin.Context) {
+	var req dto.SetTransactionCategoryRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		c.logger.Error("Failed to bind JSON", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+	req.AccountID = ctx.Param("id")
+	req.TransactionID = ctx.Param("transaction_id")
+
+	// Validate request
+	if err := ValidateStruct(req); err != nil {
+		c.logger.Error("Validation failed", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+
+	if err := c.categoryUseCase.SetTransactionCategory(ctx.Request.Context(), req); err != nil {
+		c.logger.Error("Failed to set transaction category", "error", err, "accountID", req.AccountID, "transactionID", req.TransactionID)
+		HandleError(ctx, err)
+		return
+	}
+
+	Respond(ctx, http.StatusOK, MsgTransactionCategoryUpdated, nil)
+}
+
+// ClearTransactionCategory removes an account's category override of a transaction
+func (c *CategoryController) ClearTransactionCategory(ctx *gin.Context) {
+	accountID := ctx.Param("id")
+	transactionID := ctx.Param("transaction_id")
+
+	if err := c.categoryUseCase.ClearTransactionCategory(ctx.Request.Context(), accountID, transactionID); err != nil {
+		c.logger.Error("Failed to clear transaction category", "error", err, "accountID", accountID, "transactionID", transactionID)
+		HandleError(ctx, err)
+		return
+	}
+
+	Respond(ctx, http.StatusOK, MsgTransactionCategoryReset, nil)
+}
+
+// GetCategorySummary totals an account's completed money movement per category
+func (c *CategoryController) GetCategorySummary(ctx *gin.Context) {
+	req := dto.CategorySummaryRequest{
+		AccountID: ctx.Param("id"),
+		From:      ctx.Query("from"),
+		To:        ctx.Query("to"),
+	}
+
+	// Validate request
+	if err := ValidateStruct(req); err != nil {
+		c.logger.Error("Validation failed", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+
+	response, err := c.categoryUseCase.GetCategorySummary(ctx.Request.Context(), req)
+	if err != nil {
+		c.logger.Error("Failed to get category summary", "error", err, "accountID", req.AccountID)
+		HandleError(ctx, err)
+		return
+	}
+
+	Respond(ctx, http.StatusOK, MsgCategorySummaryRetrieved, response)
+}
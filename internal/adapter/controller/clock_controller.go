@@ -0,0 +1,81 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	usecase "github.com/hydr0g3nz/mini_bank/internal/application"
+	"github.com/hydr0g3nz/mini_bank/internal/application/dto"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/infra"
+)
+
+type ClockController struct {
+	clockUseCase usecase.ClockUseCase
+	logger       infra.Logger
+}
+
+// NewClockController creates a controller for a sandbox's test clock; clockUseCase is nil outside sandboxes
+func NewClockController(clockUseCase usecase.ClockUseCase, logger infra.Logger) *ClockController {
+	return &ClockController{
+		clockUseCase: clockUseCase,
+		logger:       logger,
+	}
+}
+
+// Routes declares the test clock routes, which only sandboxes serve
+func (c *ClockController) Routes() []Route {
+	if c.clockUseCase == nil {
+		return nil
+	}
+
+	return []Route{
+		{Method: http.MethodGet, Path: "/admin/clock", Handler: c.GetClock, Summary: "Get the time the sandbox's test clock shows", Limit: LimitAdmin},
+		{Method: http.MethodPost, Path: "/admin/clock/advance", Handler: c.AdvanceClock, Summary: "Move the sandbox's test clock forward", Limit: LimitAdmin},
+	}
+}
+
+// GetClock retrieves the time the test clock shows
+func (c *ClockController) GetClock(ctx *gin.Context) {
+	response, err := c.clockUseCase.GetClock(ctx.Request.Context())
+	if err != nil {
+		c.logger.Error("Failed to get clock", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+
+	Respond(ctx, http.StatusOK, MsgClockRetrieved, response)
+}
+
+// AdvanceClock moves the test clock forward
+func (c *ClockController) AdvanceClock(ctx *gin.Context) {
+	var req dto.AdvanceClockRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		c.logger.Error("Failed to bind JSON", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+
+	actor, err := requestedBy(ctx, req.RequestedBy)
+	if err != nil {
+		c.logger.Error("Request names another admin", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+	req.RequestedBy = actor
+
+	// Validate request
+	if err := ValidateStruct(req); err != nil {
+		c.logger.Error("Validation failed", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+
+	response, err := c.clockUseCase.AdvanceClock(ctx.Request.Context(), req)
+	if err != nil {
+		c.logger.Error("Failed to advance clock", "error", err, "requestedBy", req.RequestedBy)
+		HandleError(ctx, err)
+		return
+	}
+
+	Respond(ctx, http.StatusOK, MsgClockAdvanced, response)
+}
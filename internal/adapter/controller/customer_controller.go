@@ -0,0 +1,56 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	usecase "github.com/hydr0g3nz/mini_bank/internal/application"
+	"github.com/hydr0g3nz/mini_bank/internal/application/dto"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/infra"
+)
+
+type CustomerController struct {
+	customerUseCase usecase.CustomerUseCase
+	logger          infra.Logger
+}
+
+func NewCustomerController(customerUseCase usecase.CustomerUseCase, logger infra.Logger) *CustomerController {
+	return &CustomerController{
+		customerUseCase: customerUseCase,
+		logger:          logger,
+	}
+}
+
+// Routes declares the customer routes
+func (c *CustomerController) Routes() []Route {
+	return []Route{
+		{Method: http.MethodGet, Path: "/customers/:id/summary", Handler: c.GetCustomerSummary, Summary: "Summarize a customer's accounts"},
+	}
+}
+
+// GetCustomerSummary aggregates balances and activity across a customer's accounts
+func (c *CustomerController) GetCustomerSummary(ctx *gin.Context) {
+	limit, _ := strconv.Atoi(ctx.DefaultQuery("limit", "10"))
+
+	req := dto.CustomerSummaryRequest{
+		CustomerID: ctx.Param("id"),
+		Limit:      limit,
+	}
+
+	// Validate request
+	if err := ValidateStruct(req); err != nil {
+		c.logger.Error("Validation failed", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+
+	response, err := c.customerUseCase.GetCustomerSummary(ctx.Request.Context(), req)
+	if err != nil {
+		c.logger.Error("Failed to get customer summary", "error", err, "customerID", req.CustomerID)
+		HandleError(ctx, err)
+		return
+	}
+
+	Respond(ctx, http.StatusOK, MsgCustomerSummaryRetrieved, response)
+}
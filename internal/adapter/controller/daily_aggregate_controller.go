@@ -0,0 +1,69 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	usecase "github.com/hydr0g3nz/mini_bank/internal/application"
+	"github.com/hydr0g3nz/mini_bank/internal/application/dto"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/infra"
+)
+
+type DailyAggregateController struct {
+	aggregateUseCase usecase.DailyAggregateUseCase
+	logger           infra.Logger
+}
+
+func NewDailyAggregateController(aggregateUseCase usecase.DailyAggregateUseCase, logger infra.Logger) *DailyAggregateController {
+	return &DailyAggregateController{
+		aggregateUseCase: aggregateUseCase,
+		logger:           logger,
+	}
+}
+
+// Routes declares the daily totals routes
+func (c *DailyAggregateController) Routes() []Route {
+	return []Route{
+		{Method: http.MethodGet, Path: "/accounts/:id/daily-totals", Handler: c.GetDailyTotals, Summary: "List an account's inflow, outflow and count per day"},
+		{Method: http.MethodPost, Path: "/admin/accounts/:id/daily-totals/rebuild", Handler: c.RebuildDailyTotals, Summary: "Recompute an account's daily totals", Limit: LimitAdmin},
+	}
+}
+
+// GetDailyTotals retrieves an account's totals per day
+func (c *DailyAggregateController) GetDailyTotals(ctx *gin.Context) {
+	req := dto.DailyTotalsRequest{
+		AccountID: ctx.Param("id"),
+		From:      ctx.Query("from"),
+		To:        ctx.Query("to"),
+	}
+
+	// Validate request
+	if err := ValidateStruct(req); err != nil {
+		c.logger.Error("Validation failed", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+
+	response, err := c.aggregateUseCase.GetDailyTotals(ctx.Request.Context(), req)
+	if err != nil {
+		c.logger.Error("Failed to get daily totals", "error", err, "accountID", req.AccountID)
+		HandleError(ctx, err)
+		return
+	}
+
+	Respond(ctx, http.StatusOK, MsgDailyTotalsRetrieved, response)
+}
+
+// RebuildDailyTotals recomputes an account's daily totals from its completed transactions
+func (c *DailyAggregateController) RebuildDailyTotals(ctx *gin.Context) {
+	accountID := ctx.Param("id")
+
+	response, err := c.aggregateUseCase.RebuildDailyTotals(ctx.Request.Context(), accountID)
+	if err != nil {
+		c.logger.Error("Failed to rebuild daily totals", "error", err, "accountID", accountID)
+		HandleError(ctx, err)
+		return
+	}
+
+	Respond(ctx, http.StatusOK, MsgDailyTotalsRebuilt, response)
+}
@@ -0,0 +1,61 @@
+package controller
+
+import (
+	"expvar"
+	"net/http"
+	"net/http/pprof"
+	"runtime"
+	rpprof "runtime/pprof"
+
+	"github.com/gin-gonic/gin"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/infra"
+)
+
+type DebugController struct {
+	logger infra.Logger
+}
+
+func NewDebugController(logger infra.Logger) *DebugController {
+	return &DebugController{
+		logger: logger,
+	}
+}
+
+// DumpGoroutines writes the stack of every goroutine as plain text
+func (c *DebugController) DumpGoroutines(ctx *gin.Context) {
+	c.logger.Info("Goroutine dump requested", "goroutines", runtime.NumGoroutine(), "ip", ctx.ClientIP())
+
+	ctx.Header("Content-Type", "text/plain; charset=utf-8")
+	ctx.Status(http.StatusOK)
+	if err := rpprof.Lookup("goroutine").WriteTo(ctx.Writer, 2); err != nil {
+		c.logger.Error("Failed to dump goroutines", "error", err)
+	}
+}
+
+// SetupDebugRoutes configures the runtime diagnostics served on the debug port: pprof profiles,
+// expvar variables and a goroutine dump, all behind the service API key
+func SetupDebugRoutes(router *gin.Engine, config RouterConfig) {
+	debugController := NewDebugController(config.Logger)
+
+	router.Use(RequestIDMiddleware())
+	router.Use(LoggingMiddleware(config.Logger))
+	router.Use(RecoveryMiddleware(config.Logger))
+	router.Use(APIKeyMiddleware(config.APIKey, nil, config.Logger))
+
+	profiles := router.Group("/debug/pprof")
+	{
+		profiles.GET("/", gin.WrapF(pprof.Index))
+		profiles.GET("/cmdline", gin.WrapF(pprof.Cmdline))
+		profiles.GET("/profile", gin.WrapF(pprof.Profile))
+		profiles.GET("/symbol", gin.WrapF(pprof.Symbol))
+		profiles.POST("/symbol", gin.WrapF(pprof.Symbol))
+		profiles.GET("/trace", gin.WrapF(pprof.Trace))
+		// Named profiles: heap, goroutine, allocs, block, mutex, threadcreate
+		profiles.GET("/:profile", func(ctx *gin.Context) {
+			pprof.Handler(ctx.Param("profile")).ServeHTTP(ctx.Writer, ctx.Request)
+		})
+	}
+
+	router.GET("/debug/vars", gin.WrapH(expvar.Handler()))
+	router.GET("/admin/debug/goroutines", debugController.DumpGoroutines)
+}
@@ -1,14 +1,20 @@
 package controller
 
 import (
+	"context"
 	"errors"
+	"math"
 	"net/http"
+	"reflect"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/go-playground/validator/v10"
 	"github.com/hydr0g3nz/mini_bank/internal/application/dto"
 	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
 )
 
 // Custom validation error type
@@ -21,12 +27,80 @@ func (e *ValidationError) Error() string {
 	return e.Message
 }
 
+// ValidationProfile selects which profile-scoped rules of a request apply, so one request type
+// can be validated differently when it creates a resource and when it updates one
+type ValidationProfile string
+
+// Validation profiles named by required_on and excluded_on tags
+const (
+	ProfileCreate ValidationProfile = "create"
+	ProfileUpdate ValidationProfile = "update"
+)
+
+type validationProfileKey struct{}
+
 // Global validator instance
-var validate = validator.New()
+var validate = newValidator()
+
+// newValidator creates the validator with the profile-scoped tags: required_on=create requires a field
+// only under the create profile, excluded_on=update rejects it under the update profile. Both take a
+// space-separated list of profiles and are ignored when a struct is validated without one.
+func newValidator() *validator.Validate {
+	v := validator.New()
+	// Amounts are compared as numbers, so gt=0 and min=0 apply to them as they did to float64 fields
+	v.RegisterCustomTypeFunc(func(field reflect.Value) interface{} {
+		return field.Interface().(dto.DecimalString).InexactFloat64()
+	}, dto.DecimalString{})
+	_ = v.RegisterValidationCtx("required_on", func(ctx context.Context, fl validator.FieldLevel) bool {
+		return !inProfile(ctx, fl.Param()) || isFieldSet(fl)
+	}, true)
+	_ = v.RegisterValidationCtx("excluded_on", func(ctx context.Context, fl validator.FieldLevel) bool {
+		return !inProfile(ctx, fl.Param()) || !isFieldSet(fl)
+	}, true)
+	return v
+}
+
+// inProfile reports whether the profile being validated is one of profiles
+func inProfile(ctx context.Context, profiles string) bool {
+	profile, ok := ctx.Value(validationProfileKey{}).(ValidationProfile)
+	if !ok {
+		return false
+	}
+	for _, p := range strings.Fields(profiles) {
+		if ValidationProfile(p) == profile {
+			return true
+		}
+	}
+	return false
+}
+
+// isFieldSet reports whether a field was given. The field is read from its struct rather than from
+// fl.Field(), which dereferences pointers, so a pointer to a zero value still counts as given.
+func isFieldSet(fl validator.FieldLevel) bool {
+	field := reflect.Indirect(fl.Parent()).FieldByName(fl.StructFieldName())
+	switch field.Kind() {
+	case reflect.Invalid:
+		return false
+	case reflect.Ptr, reflect.Interface, reflect.Slice, reflect.Map:
+		return !field.IsNil()
+	default:
+		return !field.IsZero()
+	}
+}
 
 // ValidateStruct validates a struct using the validator package
 func ValidateStruct(s interface{}) error {
-	if err := validate.Struct(s); err != nil {
+	return validateStructCtx(context.Background(), s)
+}
+
+// ValidateStructFor validates a struct under a validation profile, which switches on the struct's
+// required_on and excluded_on rules for that profile
+func ValidateStructFor(profile ValidationProfile, s interface{}) error {
+	return validateStructCtx(context.WithValue(context.Background(), validationProfileKey{}, profile), s)
+}
+
+func validateStructCtx(ctx context.Context, s interface{}) error {
+	if err := validate.StructCtx(ctx, s); err != nil {
 		var validationErrors []string
 		for _, err := range err.(validator.ValidationErrors) {
 			validationErrors = append(validationErrors, formatValidationError(err))
@@ -45,8 +119,10 @@ func formatValidationError(err validator.FieldError) string {
 	tag := err.Tag()
 
 	switch tag {
-	case "required":
+	case "required", "required_on":
 		return field + " is required"
+	case "excluded_on":
+		return field + " must not be set on " + err.Param()
 	case "email":
 		return field + " must be a valid email address"
 	case "min":
@@ -68,10 +144,25 @@ func formatValidationError(err validator.FieldError) string {
 	}
 }
 
+// transientRetryAfter is how long clients should wait before retrying after a transient failure
+const transientRetryAfter = time.Second
+
 // HandleError handles different types of errors and returns appropriate HTTP responses
 func HandleError(ctx *gin.Context, err error) {
-	var errorResponse dto.ErrorResponse
-	var statusCode int
+	statusCode, errorResponse := MapError(err)
+	retryAfter := ctx.GetDuration(retryAfterKey)
+	if retryAfter == 0 && errors.Is(err, errs.ErrTransient) {
+		retryAfter = transientRetryAfter
+	}
+	if statusCode == http.StatusServiceUnavailable && retryAfter > 0 {
+		ctx.Header("Retry-After", strconv.Itoa(int(math.Ceil(retryAfter.Seconds()))))
+	}
+	renderJSON(ctx, statusCode, errorResponse)
+}
+
+// MapError maps an error to its HTTP status code and error response.
+// Non-HTTP entry points use the status code to tell client errors (4xx) from retryable failures (5xx).
+func MapError(err error) (statusCode int, errorResponse dto.ErrorResponse) {
 
 	switch {
 	// Domain-specific errors
@@ -89,6 +180,482 @@ func HandleError(ctx *gin.Context, err error) {
 			Message: "Account already exists",
 		}
 
+	case errors.Is(err, errs.ErrAccountNotOpenAt):
+		statusCode = http.StatusUnprocessableEntity
+		errorResponse = dto.ErrorResponse{
+			Code:    "ACCOUNT_NOT_OPEN_AT",
+			Message: "Account did not exist at the requested time",
+		}
+
+	case errors.Is(err, errs.ErrAccountHasChildren):
+		statusCode = http.StatusConflict
+		errorResponse = dto.ErrorResponse{
+			Code:    "ACCOUNT_HAS_CHILDREN",
+			Message: "Account has child accounts; detach them first",
+		}
+
+	case errors.Is(err, errs.ErrOpeningBalanceUnknown):
+		statusCode = http.StatusUnprocessableEntity
+		errorResponse = dto.ErrorResponse{
+			Code:    "OPENING_BALANCE_UNKNOWN",
+			Message: "The account was opened before opening balances were recorded; its balance cannot be recalculated",
+		}
+
+	case errors.Is(err, errs.ErrBalanceChanged):
+		statusCode = http.StatusConflict
+		errorResponse = dto.ErrorResponse{
+			Code:    "BALANCE_CHANGED",
+			Message: "The account balance changed during recalculation; recalculate again",
+		}
+
+	case errors.Is(err, errs.ErrCurrencyMismatch):
+		statusCode = http.StatusUnprocessableEntity
+		errorResponse = dto.ErrorResponse{
+			Code:    "CURRENCY_MISMATCH",
+			Message: "Amounts in different currencies cannot be combined",
+		}
+
+	case errors.Is(err, errs.ErrSameCurrencyFX):
+		statusCode = http.StatusUnprocessableEntity
+		errorResponse = dto.ErrorResponse{
+			Code:    "SAME_CURRENCY_FX",
+			Message: "FX transfers run between accounts held in different currencies; use TRANSFER instead",
+		}
+
+	case errors.Is(err, errs.ErrExchangeRateUnavailable):
+		statusCode = http.StatusUnprocessableEntity
+		errorResponse = dto.ErrorResponse{
+			Code:    "EXCHANGE_RATE_UNAVAILABLE",
+			Message: "No exchange rate is available between the currencies",
+		}
+
+	case errors.Is(err, errs.ErrAccountNotInGroup):
+		statusCode = http.StatusUnprocessableEntity
+		errorResponse = dto.ErrorResponse{
+			Code:    "ACCOUNT_NOT_IN_GROUP",
+			Message: "Account is not part of the parent account's group",
+		}
+
+	case errors.Is(err, errs.ErrVirtualAccountNotFound):
+		statusCode = http.StatusNotFound
+		errorResponse = dto.ErrorResponse{
+			Code:    "VIRTUAL_ACCOUNT_NOT_FOUND",
+			Message: "Virtual account not found",
+		}
+
+	case errors.Is(err, errs.ErrVirtualAccountClosed):
+		statusCode = http.StatusUnprocessableEntity
+		errorResponse = dto.ErrorResponse{
+			Code:    "VIRTUAL_ACCOUNT_CLOSED",
+			Message: "Virtual account is closed and cannot receive credits",
+		}
+
+	case errors.Is(err, errs.ErrBackupNotFound):
+		statusCode = http.StatusNotFound
+		errorResponse = dto.ErrorResponse{
+			Code:    "BACKUP_NOT_FOUND",
+			Message: "Backup not found",
+		}
+
+	case errors.Is(err, errs.ErrExportNotFound):
+		statusCode = http.StatusNotFound
+		errorResponse = dto.ErrorResponse{
+			Code:    "EXPORT_NOT_FOUND",
+			Message: "Export not found",
+		}
+
+	case errors.Is(err, errs.ErrExportNotReady):
+		statusCode = http.StatusConflict
+		errorResponse = dto.ErrorResponse{
+			Code:    "EXPORT_NOT_READY",
+			Message: "Export has not completed; check its status before downloading",
+		}
+
+	case errors.Is(err, errs.ErrExportExpired):
+		statusCode = http.StatusGone
+		errorResponse = dto.ErrorResponse{
+			Code:    "EXPORT_EXPIRED",
+			Message: "Export has expired and its file was deleted; request a new export",
+		}
+
+	case errors.Is(err, errs.ErrDownloadLinkInvalid):
+		statusCode = http.StatusForbidden
+		errorResponse = dto.ErrorResponse{
+			Code:    "DOWNLOAD_LINK_INVALID",
+			Message: "Download link is invalid or has expired; get a new one from the export",
+		}
+
+	case errors.Is(err, errs.ErrJobNotFound):
+		statusCode = http.StatusNotFound
+		errorResponse = dto.ErrorResponse{
+			Code:    "JOB_NOT_FOUND",
+			Message: "Job not found",
+		}
+
+	case errors.Is(err, errs.ErrJobNotRetryable):
+		statusCode = http.StatusConflict
+		errorResponse = dto.ErrorResponse{
+			Code:    "JOB_NOT_RETRYABLE",
+			Message: "Only failed jobs can be retried",
+		}
+
+	case errors.Is(err, errs.ErrHolidayNotFound):
+		statusCode = http.StatusNotFound
+		errorResponse = dto.ErrorResponse{
+			Code:    "HOLIDAY_NOT_FOUND",
+			Message: "Holiday not found",
+		}
+
+	case errors.Is(err, errs.ErrHolidayAlreadyExists):
+		statusCode = http.StatusConflict
+		errorResponse = dto.ErrorResponse{
+			Code:    "HOLIDAY_ALREADY_EXISTS",
+			Message: "A holiday already exists on this date",
+		}
+
+	case errors.Is(err, errs.ErrCategoryNotFound):
+		statusCode = http.StatusNotFound
+		errorResponse = dto.ErrorResponse{
+			Code:    "CATEGORY_NOT_FOUND",
+			Message: "Category not found",
+		}
+
+	case errors.Is(err, errs.ErrCategoryAlreadyExists):
+		statusCode = http.StatusConflict
+		errorResponse = dto.ErrorResponse{
+			Code:    "CATEGORY_ALREADY_EXISTS",
+			Message: "A category with this code already exists",
+		}
+
+	case errors.Is(err, errs.ErrCategoryInUse):
+		statusCode = http.StatusConflict
+		errorResponse = dto.ErrorResponse{
+			Code:    "CATEGORY_IN_USE",
+			Message: "Category still has subcategories or rules",
+		}
+
+	case errors.Is(err, errs.ErrCategoryRuleNotFound):
+		statusCode = http.StatusNotFound
+		errorResponse = dto.ErrorResponse{
+			Code:    "CATEGORY_RULE_NOT_FOUND",
+			Message: "Category rule not found",
+		}
+
+	case errors.Is(err, errs.ErrBusinessRuleNotFound):
+		statusCode = http.StatusNotFound
+		errorResponse = dto.ErrorResponse{
+			Code:    "BUSINESS_RULE_NOT_FOUND",
+			Message: "Business rule not found",
+		}
+
+	case errors.Is(err, errs.ErrBusinessRuleFailed):
+		statusCode = http.StatusInternalServerError
+		errorResponse = dto.ErrorResponse{
+			Code:    "BUSINESS_RULE_FAILED",
+			Message: "Transaction could not be checked against the business rules",
+		}
+
+	case errors.Is(err, errs.ErrProductNotFound):
+		statusCode = http.StatusNotFound
+		errorResponse = dto.ErrorResponse{
+			Code:    "PRODUCT_NOT_FOUND",
+			Message: "Product not found",
+		}
+
+	case errors.Is(err, errs.ErrProductRetired):
+		statusCode = http.StatusBadRequest
+		errorResponse = dto.ErrorResponse{
+			Code:    "PRODUCT_RETIRED",
+			Message: "Product no longer opens new accounts",
+		}
+
+	case errors.Is(err, errs.ErrProductLimitExceeded):
+		statusCode = http.StatusBadRequest
+		errorResponse = dto.ErrorResponse{
+			Code:    "PRODUCT_LIMIT_EXCEEDED",
+			Message: "Transaction exceeds the limit of the account's product",
+		}
+
+	case errors.Is(err, errs.ErrProductMigrationNotFound):
+		statusCode = http.StatusNotFound
+		errorResponse = dto.ErrorResponse{
+			Code:    "PRODUCT_MIGRATION_NOT_FOUND",
+			Message: "Product migration not found",
+		}
+
+	case errors.Is(err, errs.ErrCashbackCampaignNotFound):
+		statusCode = http.StatusNotFound
+		errorResponse = dto.ErrorResponse{
+			Code:    "CASHBACK_CAMPAIGN_NOT_FOUND",
+			Message: "Cashback campaign not found",
+		}
+
+	case errors.Is(err, errs.ErrReferralCodeNotFound):
+		statusCode = http.StatusNotFound
+		errorResponse = dto.ErrorResponse{
+			Code:    "REFERRAL_CODE_NOT_FOUND",
+			Message: "Referral code not found",
+		}
+
+	case errors.Is(err, errs.ErrReferralAlreadyRedeemed):
+		statusCode = http.StatusConflict
+		errorResponse = dto.ErrorResponse{
+			Code:    "REFERRAL_ALREADY_REDEEMED",
+			Message: "The account or its customer has already redeemed a referral code",
+		}
+
+	case errors.Is(err, errs.ErrSelfReferral):
+		statusCode = http.StatusBadRequest
+		errorResponse = dto.ErrorResponse{
+			Code:    "SELF_REFERRAL",
+			Message: "Accounts cannot refer themselves or accounts of the same customer",
+		}
+
+	case errors.Is(err, errs.ErrReferralLimitExceeded):
+		statusCode = http.StatusTooManyRequests
+		errorResponse = dto.ErrorResponse{
+			Code:    "REFERRAL_LIMIT_EXCEEDED",
+			Message: "Referral code has reached its daily redemption limit",
+		}
+
+	case errors.Is(err, errs.ErrSavedFilterNotFound):
+		statusCode = http.StatusNotFound
+		errorResponse = dto.ErrorResponse{
+			Code:    "SAVED_FILTER_NOT_FOUND",
+			Message: "Saved filter not found",
+		}
+
+	case errors.Is(err, errs.ErrSavedFilterAlreadyExists):
+		statusCode = http.StatusConflict
+		errorResponse = dto.ErrorResponse{
+			Code:    "SAVED_FILTER_ALREADY_EXISTS",
+			Message: "The account already has a saved filter with this name",
+		}
+
+	case errors.Is(err, errs.ErrSavedFilterLimitExceeded):
+		statusCode = http.StatusUnprocessableEntity
+		errorResponse = dto.ErrorResponse{
+			Code:    "SAVED_FILTER_LIMIT_EXCEEDED",
+			Message: "The account has reached its saved filter limit",
+		}
+
+	case errors.Is(err, errs.ErrAttachmentNotFound):
+		statusCode = http.StatusNotFound
+		errorResponse = dto.ErrorResponse{
+			Code:    "ATTACHMENT_NOT_FOUND",
+			Message: "Attachment not found",
+		}
+
+	case errors.Is(err, errs.ErrAttachmentTooLarge):
+		statusCode = http.StatusRequestEntityTooLarge
+		errorResponse = dto.ErrorResponse{
+			Code:    "ATTACHMENT_TOO_LARGE",
+			Message: "The attachment is larger than allowed",
+		}
+
+	case errors.Is(err, errs.ErrAttachmentTypeNotAllowed):
+		statusCode = http.StatusUnsupportedMediaType
+		errorResponse = dto.ErrorResponse{
+			Code:    "ATTACHMENT_TYPE_NOT_ALLOWED",
+			Message: "Only PDF, JPEG, PNG and WebP files can be attached",
+		}
+
+	case errors.Is(err, errs.ErrAttachmentLimitExceeded):
+		statusCode = http.StatusUnprocessableEntity
+		errorResponse = dto.ErrorResponse{
+			Code:    "ATTACHMENT_LIMIT_EXCEEDED",
+			Message: "The transaction has reached its attachment limit",
+		}
+
+	case errors.Is(err, errs.ErrTransferTemplateNotFound):
+		statusCode = http.StatusNotFound
+		errorResponse = dto.ErrorResponse{
+			Code:    "TRANSFER_TEMPLATE_NOT_FOUND",
+			Message: "Transfer template not found",
+		}
+
+	case errors.Is(err, errs.ErrScheduledTransactionNotFound):
+		statusCode = http.StatusNotFound
+		errorResponse = dto.ErrorResponse{
+			Code:    "SCHEDULED_TRANSACTION_NOT_FOUND",
+			Message: "Scheduled transaction not found",
+		}
+
+	case errors.Is(err, errs.ErrScheduledTransactionCompleted):
+		statusCode = http.StatusConflict
+		errorResponse = dto.ErrorResponse{
+			Code:    "SCHEDULED_TRANSACTION_COMPLETED",
+			Message: "The scheduled transaction has run for the last time and can no longer change",
+		}
+
+	case errors.Is(err, errs.ErrNotificationTemplateNotFound):
+		statusCode = http.StatusNotFound
+		errorResponse = dto.ErrorResponse{
+			Code:    "NOTIFICATION_TEMPLATE_NOT_FOUND",
+			Message: "Notification template not found",
+		}
+
+	case errors.Is(err, errs.ErrNotificationTemplateConflict):
+		statusCode = http.StatusConflict
+		errorResponse = dto.ErrorResponse{
+			Code:    "NOTIFICATION_TEMPLATE_CONFLICT",
+			Message: "The notification template was changed at the same time; retry",
+		}
+
+	case errors.Is(err, errs.ErrPeriodClosed):
+		statusCode = http.StatusConflict
+		errorResponse = dto.ErrorResponse{
+			Code:    "PERIOD_CLOSED",
+			Message: "The accounting period is closed; post an adjustment into the current period instead",
+		}
+
+	case errors.Is(err, errs.ErrPeriodAlreadyClosed):
+		statusCode = http.StatusConflict
+		errorResponse = dto.ErrorResponse{
+			Code:    "PERIOD_ALREADY_CLOSED",
+			Message: "The accounting period is already closed",
+		}
+
+	case errors.Is(err, errs.ErrPeriodNotClosed):
+		statusCode = http.StatusNotFound
+		errorResponse = dto.ErrorResponse{
+			Code:    "PERIOD_NOT_CLOSED",
+			Message: "The accounting period is not closed",
+		}
+
+	case errors.Is(err, errs.ErrBudgetNotFound):
+		statusCode = http.StatusNotFound
+		errorResponse = dto.ErrorResponse{
+			Code:    "BUDGET_NOT_FOUND",
+			Message: "Budget not found",
+		}
+
+	case errors.Is(err, errs.ErrBudgetAlreadyExists):
+		statusCode = http.StatusConflict
+		errorResponse = dto.ErrorResponse{
+			Code:    "BUDGET_ALREADY_EXISTS",
+			Message: "The account already has a budget for this category",
+		}
+
+	case errors.Is(err, errs.ErrCustomerNotFound):
+		statusCode = http.StatusNotFound
+		errorResponse = dto.ErrorResponse{
+			Code:    "CUSTOMER_NOT_FOUND",
+			Message: "No accounts found for the customer",
+		}
+
+	case errors.Is(err, errs.ErrOwnershipTransferNotFound):
+		statusCode = http.StatusNotFound
+		errorResponse = dto.ErrorResponse{
+			Code:    "OWNERSHIP_TRANSFER_NOT_FOUND",
+			Message: "Ownership transfer not found",
+		}
+
+	case errors.Is(err, errs.ErrOwnershipTransferNotPending):
+		statusCode = http.StatusConflict
+		errorResponse = dto.ErrorResponse{
+			Code:    "OWNERSHIP_TRANSFER_NOT_PENDING",
+			Message: "The ownership transfer has already been decided or has taken effect",
+		}
+
+	case errors.Is(err, errs.ErrOwnershipTransferOpen):
+		statusCode = http.StatusConflict
+		errorResponse = dto.ErrorResponse{
+			Code:    "OWNERSHIP_TRANSFER_IN_PROGRESS",
+			Message: "The account already has an ownership transfer in progress",
+		}
+
+	case errors.Is(err, errs.ErrTransactionBlocked):
+		statusCode = http.StatusForbidden
+		errorResponse = dto.ErrorResponse{
+			Code:    "TRANSACTION_BLOCKED",
+			Message: "Transactions of this type are temporarily blocked on the account",
+		}
+
+	case errors.Is(err, errs.ErrTransactionBlockNotFound):
+		statusCode = http.StatusNotFound
+		errorResponse = dto.ErrorResponse{
+			Code:    "TRANSACTION_BLOCK_NOT_FOUND",
+			Message: "Transaction block not found",
+		}
+
+	case errors.Is(err, errs.ErrTransactionBlockNotActive):
+		statusCode = http.StatusConflict
+		errorResponse = dto.ErrorResponse{
+			Code:    "TRANSACTION_BLOCK_NOT_ACTIVE",
+			Message: "The transaction block has already expired or been lifted",
+		}
+
+	case errors.Is(err, errs.ErrWebhookNotFound):
+		statusCode = http.StatusNotFound
+		errorResponse = dto.ErrorResponse{
+			Code:    "WEBHOOK_NOT_FOUND",
+			Message: "Webhook subscription not found",
+		}
+
+	case errors.Is(err, errs.ErrAdminUserNotFound):
+		statusCode = http.StatusNotFound
+		errorResponse = dto.ErrorResponse{
+			Code:    "ADMIN_USER_NOT_FOUND",
+			Message: "Admin user not found",
+		}
+
+	case errors.Is(err, errs.ErrAdminEmailTaken):
+		statusCode = http.StatusConflict
+		errorResponse = dto.ErrorResponse{
+			Code:    "ADMIN_EMAIL_TAKEN",
+			Message: "An admin user with this email already exists",
+		}
+
+	case errors.Is(err, errs.ErrActorMismatch):
+		statusCode = http.StatusForbidden
+		errorResponse = dto.ErrorResponse{
+			Code:    "ACTOR_MISMATCH",
+			Message: "An admin API key may only act as the admin it was issued to",
+		}
+
+	case errors.Is(err, errs.ErrSagaNotFound):
+		statusCode = http.StatusNotFound
+		errorResponse = dto.ErrorResponse{
+			Code:    "SAGA_NOT_FOUND",
+			Message: "Saga not found",
+		}
+
+	case errors.Is(err, errs.ErrDatabaseReadOnly):
+		statusCode = http.StatusServiceUnavailable
+		errorResponse = dto.ErrorResponse{
+			Code:    "SERVICE_READ_ONLY",
+			Message: "Service is temporarily read-only; retry later",
+		}
+
+	case errors.Is(err, errs.ErrStaleFencingToken):
+		statusCode = http.StatusConflict
+		errorResponse = dto.ErrorResponse{
+			Code:    "TRANSACTION_IN_PROGRESS",
+			Message: "Transaction was taken over by another confirmation; retry to get its outcome",
+		}
+
+	case errors.Is(err, errs.ErrServiceOverloaded):
+		statusCode = http.StatusServiceUnavailable
+		errorResponse = dto.ErrorResponse{
+			Code:    "SERVICE_OVERLOADED",
+			Message: "Too many requests are being served; retry later",
+		}
+
+	case errors.Is(err, context.DeadlineExceeded):
+		statusCode = http.StatusServiceUnavailable
+		errorResponse = dto.ErrorResponse{
+			Code:    "REQUEST_TIMEOUT",
+			Message: "The request did not finish in time; check its outcome before retrying",
+		}
+
+	case errors.Is(err, errs.ErrTransient):
+		statusCode = http.StatusServiceUnavailable
+		errorResponse = dto.ErrorResponse{
+			Code:    "SERVICE_UNAVAILABLE",
+			Message: "The request failed temporarily and changed nothing; retry later",
+		}
+
 	case errors.Is(err, errs.ErrInsufficientBalance):
 		statusCode = http.StatusBadRequest
 		errorResponse = dto.ErrorResponse{
@@ -96,6 +663,40 @@ func HandleError(ctx *gin.Context, err error) {
 			Message: "Insufficient balance for this transaction",
 		}
 
+	case errors.Is(err, errs.ErrSpendingLimitExceeded):
+		statusCode = http.StatusBadRequest
+		errorResponse = dto.ErrorResponse{
+			Code:    "SPENDING_LIMIT_EXCEEDED",
+			Message: "Transaction exceeds the account's monthly spending limit",
+		}
+
+	case errors.Is(err, errs.ErrVelocityLimitExceeded):
+		statusCode = http.StatusBadRequest
+		errorResponse = dto.ErrorResponse{
+			Code:    "VELOCITY_LIMIT_EXCEEDED",
+			Message: err.Error(),
+		}
+
+	case errors.Is(err, errs.ErrChannelLimitExceeded):
+		statusCode = http.StatusBadRequest
+		errorResponse = dto.ErrorResponse{
+			Code:    "CHANNEL_LIMIT_EXCEEDED",
+			Message: "Transaction exceeds the limit of its channel",
+		}
+
+	case errors.Is(err, errs.ErrInvalidReference):
+		statusCode = http.StatusBadRequest
+		errorResponse = dto.ErrorResponse{
+			Code:    "INVALID_REFERENCE",
+			Message: "Reference does not match its reference type",
+			Details: map[string]string{"field": "reference"},
+		}
+		var referenceErr *vo.ReferenceError
+		if errors.As(err, &referenceErr) {
+			errorResponse.Details["reference_type"] = string(referenceErr.Type)
+			errorResponse.Details["reason"] = referenceErr.Reason
+		}
+
 	case errors.Is(err, errs.ErrAccountCannotTransact):
 		statusCode = http.StatusBadRequest
 		errorResponse = dto.ErrorResponse{
@@ -138,6 +739,62 @@ func HandleError(ctx *gin.Context, err error) {
 			Message: "Transaction cannot be cancelled in its current state",
 		}
 
+	case errors.Is(err, errs.ErrTransactionNotDue):
+		statusCode = http.StatusConflict
+		errorResponse = dto.ErrorResponse{
+			Code:    "TRANSACTION_NOT_DUE",
+			Message: "Transaction is queued for its value date and cannot be confirmed yet",
+		}
+
+	case errors.Is(err, errs.ErrTransactionNotInReview):
+		statusCode = http.StatusConflict
+		errorResponse = dto.ErrorResponse{
+			Code:    "TRANSACTION_NOT_IN_REVIEW",
+			Message: "Transaction is not held for review",
+		}
+
+	case errors.Is(err, errs.ErrReviewClaimed):
+		statusCode = http.StatusConflict
+		errorResponse = dto.ErrorResponse{
+			Code:    "REVIEW_CLAIMED",
+			Message: "Review is claimed by another admin",
+		}
+
+	case errors.Is(err, errs.ErrReviewNotClaimed):
+		statusCode = http.StatusConflict
+		errorResponse = dto.ErrorResponse{
+			Code:    "REVIEW_NOT_CLAIMED",
+			Message: "Review is not claimed by this admin",
+		}
+
+	case errors.Is(err, errs.ErrSelfApproval):
+		statusCode = http.StatusForbidden
+		errorResponse = dto.ErrorResponse{
+			Code:    "SELF_APPROVAL",
+			Message: "The change must be approved by an admin other than the one who requested it",
+		}
+
+	case errors.Is(err, errs.ErrTransactionNotReversible):
+		statusCode = http.StatusUnprocessableEntity
+		errorResponse = dto.ErrorResponse{
+			Code:    "TRANSACTION_NOT_REVERSIBLE",
+			Message: err.Error(),
+		}
+
+	case errors.Is(err, errs.ErrTransactionAlreadyReversed):
+		statusCode = http.StatusConflict
+		errorResponse = dto.ErrorResponse{
+			Code:    "TRANSACTION_ALREADY_REVERSED",
+			Message: err.Error(),
+		}
+
+	case errors.Is(err, errs.ErrTransactionNotReplayable):
+		statusCode = http.StatusConflict
+		errorResponse = dto.ErrorResponse{
+			Code:    "TRANSACTION_NOT_REPLAYABLE",
+			Message: err.Error(),
+		}
+
 	case errors.Is(err, errs.ErrTransactionAlreadyInProgress):
 		statusCode = http.StatusConflict
 		errorResponse = dto.ErrorResponse{
@@ -192,6 +849,7 @@ func HandleError(ctx *gin.Context, err error) {
 		var validationErr *ValidationError
 		var businessErr errs.BusinessError
 		var domainValidationErr errs.ValidationError
+		var decimalErr *dto.InvalidDecimalError
 
 		switch {
 		case errors.As(err, &validationErr):
@@ -222,6 +880,13 @@ func HandleError(ctx *gin.Context, err error) {
 			}
 
 		// JSON binding errors
+		case errors.As(err, &decimalErr):
+			statusCode = http.StatusBadRequest
+			errorResponse = dto.ErrorResponse{
+				Code:    "INVALID_AMOUNT",
+				Message: decimalErr.Error(),
+			}
+
 		case strings.Contains(err.Error(), "cannot unmarshal"):
 			statusCode = http.StatusBadRequest
 			errorResponse = dto.ErrorResponse{
@@ -246,5 +911,5 @@ func HandleError(ctx *gin.Context, err error) {
 		}
 	}
 
-	ctx.JSON(statusCode, errorResponse)
+	return statusCode, errorResponse
 }
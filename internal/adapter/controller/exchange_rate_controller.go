@@ -0,0 +1,42 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	usecase "github.com/hydr0g3nz/mini_bank/internal/application"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/infra"
+)
+
+type ExchangeRateController struct {
+	exchangeRateUseCase usecase.ExchangeRateUseCase
+	logger              infra.Logger
+}
+
+func NewExchangeRateController(exchangeRateUseCase usecase.ExchangeRateUseCase, logger infra.Logger) *ExchangeRateController {
+	return &ExchangeRateController{
+		exchangeRateUseCase: exchangeRateUseCase,
+		logger:              logger,
+	}
+}
+
+// Routes declares the exchange rate routes
+func (c *ExchangeRateController) Routes() []Route {
+	return []Route{
+		{Method: http.MethodGet, Path: "/rates", Handler: c.ListRates, Summary: "List the exchange rates FX transfers are converted at"},
+	}
+}
+
+// ListRates retrieves the current rates from the base currency of the query, the service's currency by default
+func (c *ExchangeRateController) ListRates(ctx *gin.Context) {
+	base := ctx.Query("base")
+
+	response, err := c.exchangeRateUseCase.ListRates(ctx.Request.Context(), base)
+	if err != nil {
+		c.logger.Error("Failed to list exchange rates", "error", err, "base", base)
+		HandleError(ctx, err)
+		return
+	}
+
+	Respond(ctx, http.StatusOK, MsgExchangeRatesRetrieved, response)
+}
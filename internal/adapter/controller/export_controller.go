@@ -0,0 +1,149 @@
+package controller
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	usecase "github.com/hydr0g3nz/mini_bank/internal/application"
+	"github.com/hydr0g3nz/mini_bank/internal/application/dto"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/infra"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+)
+
+type ExportController struct {
+	exportUseCase usecase.ExportUseCase
+	logger        infra.Logger
+}
+
+func NewExportController(exportUseCase usecase.ExportUseCase, logger infra.Logger) *ExportController {
+	return &ExportController{
+		exportUseCase: exportUseCase,
+		logger:        logger,
+	}
+}
+
+// Routes declares the export routes. Downloads are authorized by the link's signature instead of
+// the API key, so the link can be handed to a browser or another system.
+func (c *ExportController) Routes() []Route {
+	return []Route{
+		{Method: http.MethodPost, Path: "/admin/exports/audit", Handler: c.ExportAuditLog, Summary: "Start an audit log export", Limit: LimitAdmin, Permission: vo.AdminPermissionViewAudit},
+		{Method: http.MethodPost, Path: "/accounts/:id/exports/history", Handler: c.ExportAccountHistory, Summary: "Start an export of an account's transaction history"},
+		{Method: http.MethodGet, Path: "/exports/:id", Handler: c.GetExport, Summary: "Get an export and its download link"},
+		{Method: http.MethodGet, Path: "/downloads/exports/:id", Handler: c.DownloadExport, Summary: "Download an export through a signed link", Auth: AuthPublic, Limit: LimitExport},
+	}
+}
+
+// ExportAuditLog starts exporting the audit log with the filters of the request body
+func (c *ExportController) ExportAuditLog(ctx *gin.Context) {
+	var req dto.AuditExportRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		c.logger.Error("Failed to bind JSON", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+
+	// Validate request
+	if err := ValidateStruct(req); err != nil {
+		c.logger.Error("Validation failed", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+
+	response, err := c.exportUseCase.ExportAuditLog(ctx.Request.Context(), req, ctx.ClientIP())
+	if err != nil {
+		c.logger.Error("Failed to start audit export", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+
+	c.logger.Info("Audit export started", "exportID", response.ID)
+	Respond(ctx, http.StatusAccepted, MsgExportStarted, response)
+}
+
+// ExportAccountHistory starts exporting an account's transaction history as CSV; the body is optional
+func (c *ExportController) ExportAccountHistory(ctx *gin.Context) {
+	accountID := ctx.Param("id")
+
+	var req dto.HistoryExportRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil && !errors.Is(err, io.EOF) {
+		c.logger.Error("Failed to bind JSON", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+
+	// Validate request
+	if err := ValidateStruct(req); err != nil {
+		c.logger.Error("Validation failed", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+
+	response, err := c.exportUseCase.ExportAccountHistory(ctx.Request.Context(), accountID, req, ctx.ClientIP())
+	if err != nil {
+		c.logger.Error("Failed to start history export", "error", err, "accountID", accountID)
+		HandleError(ctx, err)
+		return
+	}
+
+	c.logger.Info("History export started", "exportID", response.ID, "accountID", accountID)
+	Respond(ctx, http.StatusAccepted, MsgExportStarted, response)
+}
+
+// GetExport retrieves an export's status, with a download link once it completed
+func (c *ExportController) GetExport(ctx *gin.Context) {
+	id := ctx.Param("id")
+
+	response, err := c.exportUseCase.GetExport(ctx.Request.Context(), id)
+	if err != nil {
+		c.logger.Error("Failed to get export", "error", err, "exportID", id)
+		HandleError(ctx, err)
+		return
+	}
+
+	Respond(ctx, http.StatusOK, MsgExportRetrieved, response)
+}
+
+// DownloadExport streams an export's file to the holder of a signed link, given by the "expires" and
+// "signature" query parameters
+func (c *ExportController) DownloadExport(ctx *gin.Context) {
+	expires, _ := strconv.ParseInt(ctx.Query("expires"), 10, 64)
+	req := dto.DownloadRequest{
+		ExportID:  ctx.Param("id"),
+		Expires:   expires,
+		Signature: ctx.Query("signature"),
+	}
+
+	// Validate request
+	if err := ValidateStruct(req); err != nil {
+		c.logger.Error("Validation failed", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+
+	download, err := c.exportUseCase.OpenDownload(ctx.Request.Context(), req)
+	if err != nil {
+		HandleError(ctx, err)
+		return
+	}
+	defer download.Content.Close()
+
+	// Large files outlive the server's write timeout
+	if err := http.NewResponseController(ctx.Writer).SetWriteDeadline(time.Time{}); err != nil {
+		c.logger.Warn("Failed to clear write deadline for export download", "error", err)
+	}
+
+	ctx.Header("Content-Type", download.ContentType)
+	ctx.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, download.FileName))
+	ctx.Header("Content-Length", strconv.FormatInt(download.SizeBytes, 10))
+	ctx.Header("Cache-Control", "private, no-store")
+	ctx.Status(http.StatusOK)
+
+	if _, err := io.Copy(ctx.Writer, download.Content); err != nil {
+		c.logger.Warn("Export download interrupted", "error", err, "exportID", req.ExportID)
+	}
+}
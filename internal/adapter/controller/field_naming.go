@@ -0,0 +1,297 @@
+package controller
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/json"
+	"mime"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// FieldNaming is the case of the field names of JSON responses
+type FieldNaming string
+
+const (
+	// FieldNamingSnakeCase renders field names as the DTOs declare them, e.g. created_at
+	FieldNamingSnakeCase FieldNaming = "snake_case"
+	// FieldNamingCamelCase renders field names in camelCase, e.g. createdAt
+	FieldNamingCamelCase FieldNaming = "camelCase"
+
+	// fieldNamingProfile is the Accept media type parameter choosing the field naming of a request,
+	// e.g. "Accept: application/json; profile=camelCase"
+	fieldNamingProfile = "profile"
+
+	fieldNamingContextKey = "responseFieldNaming"
+)
+
+// Valid checks whether the field naming is one the API renders
+func (n FieldNaming) Valid() bool {
+	return n == FieldNamingSnakeCase || n == FieldNamingCamelCase
+}
+
+// FieldNamingMiddleware resolves the naming of the field names of JSON responses, honouring the
+// Accept header's profile over the configured default. The responses are rendered in it by renderJSON.
+func FieldNamingMiddleware(defaultNaming FieldNaming) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		ctx.Header("Vary", "Accept")
+
+		naming := defaultNaming
+		if requested, ok := acceptedFieldNaming(ctx.GetHeader("Accept")); ok {
+			naming = requested
+		}
+
+		ctx.Set(fieldNamingContextKey, naming)
+		ctx.Next()
+	}
+}
+
+// acceptedFieldNaming returns the field naming of the first Accept media range with a known profile
+func acceptedFieldNaming(accept string) (FieldNaming, bool) {
+	for _, mediaRange := range strings.Split(accept, ",") {
+		_, params, err := mime.ParseMediaType(strings.TrimSpace(mediaRange))
+		if err != nil {
+			continue
+		}
+		if naming := FieldNaming(params[fieldNamingProfile]); naming.Valid() {
+			return naming, true
+		}
+	}
+	return "", false
+}
+
+// renderJSON writes obj as the JSON response in the field naming of the request. The DTOs are
+// declared in snake_case, so only camelCase responses are converted.
+func renderJSON(ctx *gin.Context, status int, obj interface{}) {
+	if naming, _ := ctx.Get(fieldNamingContextKey); naming == FieldNamingCamelCase {
+		obj = camelCaseFields(obj)
+	}
+	ctx.JSON(status, obj)
+}
+
+// abortWithJSON stops the handler chain and writes obj as the JSON response, like renderJSON
+func abortWithJSON(ctx *gin.Context, status int, obj interface{}) {
+	ctx.Abort()
+	renderJSON(ctx, status, obj)
+}
+
+var (
+	jsonMarshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	textMarshalerType = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+)
+
+// camelCaseFields returns data to be encoded as encoding/json would encode it, but with the names of
+// its struct fields, as their JSON tags give them, in camelCase. Map keys are data, like account IDs
+// or currency codes, and are kept; values that encode themselves, like times and amounts, are kept whole.
+func camelCaseFields(data interface{}) interface{} {
+	return camelCaseValue(reflect.ValueOf(data))
+}
+
+func camelCaseValue(v reflect.Value) interface{} {
+	if !v.IsValid() {
+		return nil
+	}
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		if v.IsNil() {
+			return nil
+		}
+		if v.Kind() == reflect.Interface {
+			return camelCaseValue(v.Elem())
+		}
+	}
+	if marshalsItself(v.Type()) {
+		return v.Interface()
+	}
+
+	switch v.Kind() {
+	case reflect.Pointer:
+		return camelCaseValue(v.Elem())
+	case reflect.Struct:
+		return camelCaseStruct(v)
+	case reflect.Map:
+		if v.IsNil() {
+			return nil
+		}
+		values := make(map[string]interface{}, v.Len())
+		for entries := v.MapRange(); entries.Next(); {
+			key, ok := mapKey(entries.Key())
+			if !ok {
+				return v.Interface()
+			}
+			values[key] = camelCaseValue(entries.Value())
+		}
+		return values
+	case reflect.Slice:
+		// A nil slice encodes as null, and bytes as base64
+		if v.IsNil() || v.Type().Elem().Kind() == reflect.Uint8 {
+			return v.Interface()
+		}
+		return camelCaseElements(v)
+	case reflect.Array:
+		return camelCaseElements(v)
+	}
+	return v.Interface()
+}
+
+// marshalsItself checks whether encoding/json leaves the encoding of values of t to the values
+func marshalsItself(t reflect.Type) bool {
+	return t.Implements(jsonMarshalerType) || t.Implements(textMarshalerType)
+}
+
+func camelCaseElements(v reflect.Value) []interface{} {
+	elements := make([]interface{}, v.Len())
+	for i := range elements {
+		elements[i] = camelCaseValue(v.Index(i))
+	}
+	return elements
+}
+
+// mapKey formats a map key as encoding/json does, for the key kinds it accepts
+func mapKey(key reflect.Value) (string, bool) {
+	if key.Kind() == reflect.String {
+		return key.String(), true
+	}
+	if key.Type().Implements(textMarshalerType) {
+		text, err := key.Interface().(encoding.TextMarshaler).MarshalText()
+		return string(text), err == nil
+	}
+	switch key.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(key.Int(), 10), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.FormatUint(key.Uint(), 10), true
+	}
+	return "", false
+}
+
+// camelCaseField is an encoded struct field with its name in camelCase
+type camelCaseField struct {
+	name  string
+	value interface{}
+}
+
+// camelCaseObject is a struct with its fields renamed, encoded in the order they are declared
+type camelCaseObject []camelCaseField
+
+func (o camelCaseObject) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, field := range o {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		name, _ := json.Marshal(field.name)
+		buf.Write(name)
+		buf.WriteByte(':')
+		value, err := json.Marshal(field.value)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(value)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+func camelCaseStruct(v reflect.Value) camelCaseObject {
+	object := camelCaseObject{}
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, options, _ := strings.Cut(tag, ",")
+		value := v.Field(i)
+
+		// The fields of an untagged embedded struct are promoted into this one
+		if field.Anonymous && name == "" {
+			embedded := value
+			if embedded.Kind() == reflect.Pointer {
+				if embedded.IsNil() {
+					continue
+				}
+				embedded = embedded.Elem()
+			}
+			if embedded.Kind() == reflect.Struct && !marshalsItself(field.Type) {
+				object = append(object, camelCaseStruct(embedded)...)
+				continue
+			}
+		}
+		if !field.IsExported() {
+			continue
+		}
+		if strings.Contains(","+options+",", ",omitempty,") && isEmptyValue(value) {
+			continue
+		}
+
+		if name == "" {
+			name = field.Name
+		}
+		object = append(object, camelCaseField{name: camelCaseName(name), value: camelCaseValue(value)})
+	}
+	return object
+}
+
+// isEmptyValue checks whether omitempty leaves out v, as encoding/json decides it
+func isEmptyValue(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Array, reflect.Map, reflect.Slice, reflect.String:
+		return v.Len() == 0
+	case reflect.Bool:
+		return !v.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int() == 0
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return v.Uint() == 0
+	case reflect.Float32, reflect.Float64:
+		return v.Float() == 0
+	case reflect.Interface, reflect.Pointer:
+		return v.IsNil()
+	}
+	return false
+}
+
+// camelCaseName rewrites a snake_case field name such as created_at to createdAt. Names that are not
+// lower snake_case are kept.
+func camelCaseName(name string) string {
+	if !isSnakeCase(name) {
+		return name
+	}
+
+	var out strings.Builder
+	upper := false
+	for _, c := range name {
+		if c == '_' {
+			upper = true
+			continue
+		}
+		if upper && c >= 'a' && c <= 'z' {
+			c -= 'a' - 'A'
+		}
+		upper = false
+		out.WriteRune(c)
+	}
+	return out.String()
+}
+
+// isSnakeCase checks whether name is lower-case words joined by single underscores, with at least one
+func isSnakeCase(name string) bool {
+	if len(name) == 0 || name[0] < 'a' || name[0] > 'z' || name[len(name)-1] == '_' || !strings.Contains(name, "_") {
+		return false
+	}
+	for i := 0; i < len(name); i++ {
+		switch c := name[i]; {
+		case c >= 'a' && c <= 'z', c >= '0' && c <= '9':
+		case c == '_' && name[i-1] != '_':
+		default:
+			return false
+		}
+	}
+	return true
+}
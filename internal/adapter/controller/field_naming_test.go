@@ -0,0 +1,134 @@
+package controller
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/hydr0g3nz/mini_bank/internal/application/dto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type namingAudit struct {
+	CreatedBy string    `json:"created_by"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type namingAccount struct {
+	namingAudit
+	AccountID  string             `json:"account_id"`
+	Nickname   string             `json:"nick_name,omitempty"`
+	Balances   map[string]float64 `json:"balances_by_currency"`
+	Metadata   map[string]any     `json:"meta_data"`
+	Payload    json.RawMessage    `json:"event_payload"`
+	Tags       []string           `json:"tag_list"`
+	Raw        []byte             `json:"raw_bytes"`
+	Parent     *namingAccount     `json:"parent_account"`
+	Internal   string             `json:"-"`
+	Untagged   int
+	unexported string
+}
+
+func TestCamelCaseFields(t *testing.T) {
+	at := time.Date(2024, 5, 17, 9, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		data     any
+		expected string
+	}{
+		{
+			name:     "struct_fields_in_declared_order",
+			data:     dto.ErrorResponse{Code: "NOT_FOUND", Message: "missing"},
+			expected: `{"code":"NOT_FOUND","message":"missing"}`,
+		},
+		{
+			name: "nested_map_keys_are_kept",
+			data: namingAccount{
+				AccountID:  "ACC1",
+				Balances:   map[string]float64{"usd_cash": 1, "THB": 2},
+				Metadata:   map[string]any{"snake_key": map[string]any{"inner_key": "value"}},
+				unexported: "hidden",
+			},
+			expected: `{"createdBy":"","createdAt":"0001-01-01T00:00:00Z","accountId":"ACC1",` +
+				`"balancesByCurrency":{"THB":2,"usd_cash":1},"metaData":{"snake_key":{"inner_key":"value"}},` +
+				`"eventPayload":null,"tagList":null,"rawBytes":null,"parentAccount":null,"Untagged":0}`,
+		},
+		{
+			name: "string_values_and_raw_json_are_kept",
+			data: namingAccount{
+				namingAudit: namingAudit{CreatedBy: `ops "admin_user": \ x`, CreatedAt: at},
+				Nickname:    "key_like\":",
+				Payload:     json.RawMessage(`{"from_account":"ACC1"}`),
+				Tags:        []string{"first_tag"},
+				Raw:         []byte("a_b"),
+			},
+			expected: `{"createdBy":"ops \"admin_user\": \\ x","createdAt":"2024-05-17T09:30:00Z","accountId":"",` +
+				`"nickName":"key_like\":","balancesByCurrency":null,"metaData":null,"eventPayload":{"from_account":"ACC1"},` +
+				`"tagList":["first_tag"],"rawBytes":"YV9i","parentAccount":null,"Untagged":0}`,
+		},
+		{
+			name:     "nested_structs_in_slices_and_pointers",
+			data:     dto.SuccessResponse{Message: "ok", Data: []*namingAudit{{CreatedBy: "a", CreatedAt: at}, nil}},
+			expected: `{"message":"ok","data":[{"createdBy":"a","createdAt":"2024-05-17T09:30:00Z"},null]}`,
+		},
+		{
+			name:     "map_of_structs",
+			data:     map[int]namingAudit{7: {CreatedBy: "b", CreatedAt: at}},
+			expected: `{"7":{"createdBy":"b","createdAt":"2024-05-17T09:30:00Z"}}`,
+		},
+		{
+			name:     "nil",
+			data:     nil,
+			expected: `null`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			encoded, err := json.Marshal(camelCaseFields(tt.data))
+
+			require.NoError(t, err)
+			assert.JSONEq(t, tt.expected, string(encoded))
+		})
+	}
+}
+
+func TestRenderJSON_FieldNaming(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name     string
+		accept   string
+		fallback FieldNaming
+		expected string
+	}{
+		{name: "default_snake_case", fallback: FieldNamingSnakeCase, expected: `{"created_by":"a","created_at":"0001-01-01T00:00:00Z"}`},
+		{name: "default_camel_case", fallback: FieldNamingCamelCase, expected: `{"createdBy":"a","createdAt":"0001-01-01T00:00:00Z"}`},
+		{name: "profile_camel_case", accept: "application/json; profile=camelCase", fallback: FieldNamingSnakeCase, expected: `{"createdBy":"a","createdAt":"0001-01-01T00:00:00Z"}`},
+		{name: "profile_snake_case", accept: "application/json; profile=snake_case", fallback: FieldNamingCamelCase, expected: `{"created_by":"a","created_at":"0001-01-01T00:00:00Z"}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := gin.New()
+			router.Use(FieldNamingMiddleware(tt.fallback))
+			router.GET("/", func(ctx *gin.Context) {
+				renderJSON(ctx, http.StatusOK, namingAudit{CreatedBy: "a"})
+			})
+
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			req.Header.Set("Accept", tt.accept)
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, req)
+
+			assert.Equal(t, http.StatusOK, rec.Code)
+			assert.Equal(t, "Accept", rec.Header().Get("Vary"))
+			assert.JSONEq(t, tt.expected, rec.Body.String())
+		})
+	}
+}
@@ -0,0 +1,108 @@
+package controller
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	usecase "github.com/hydr0g3nz/mini_bank/internal/application"
+	"github.com/hydr0g3nz/mini_bank/internal/application/dto"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/infra"
+)
+
+type GeneralLedgerController struct {
+	ledgerUseCase usecase.GeneralLedgerUseCase
+	logger        infra.Logger
+}
+
+func NewGeneralLedgerController(ledgerUseCase usecase.GeneralLedgerUseCase, logger infra.Logger) *GeneralLedgerController {
+	return &GeneralLedgerController{
+		ledgerUseCase: ledgerUseCase,
+		logger:        logger,
+	}
+}
+
+// Routes declares the general ledger routes
+func (c *GeneralLedgerController) Routes() []Route {
+	return []Route{
+		{Method: http.MethodGet, Path: "/admin/gl-accounts", Handler: c.ListAccounts, Summary: "List the general ledger chart of accounts", Limit: LimitAdmin},
+		{Method: http.MethodGet, Path: "/admin/trial-balance", Handler: c.GetTrialBalance, Summary: "Get the trial balance of an accounting period", Limit: LimitAdmin},
+		{Method: http.MethodGet, Path: "/admin/reports/revenue", Handler: c.GetRevenueReport, Summary: "Report fees collected and interest paid per period and product", Limit: LimitAdmin},
+		{Method: http.MethodGet, Path: "/admin/transactions/:id/gl-postings", Handler: c.GetTransactionPostings, Summary: "Get the general ledger postings of a transaction", Limit: LimitAdmin},
+	}
+}
+
+// ListAccounts retrieves the chart of accounts
+func (c *GeneralLedgerController) ListAccounts(ctx *gin.Context) {
+	response, err := c.ledgerUseCase.ListAccounts(ctx.Request.Context())
+	if err != nil {
+		c.logger.Error("Failed to list GL accounts", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+
+	Respond(ctx, http.StatusOK, MsgGLAccountsRetrieved, response)
+}
+
+// GetTrialBalance retrieves the trial balance of the period query parameter, the current period by default
+func (c *GeneralLedgerController) GetTrialBalance(ctx *gin.Context) {
+	period := ctx.Query("period")
+
+	response, err := c.ledgerUseCase.GetTrialBalance(ctx.Request.Context(), period)
+	if err != nil {
+		c.logger.Error("Failed to get trial balance", "error", err, "period", period)
+		HandleError(ctx, err)
+		return
+	}
+
+	Respond(ctx, http.StatusOK, MsgTrialBalanceRetrieved, response)
+}
+
+// GetTransactionPostings retrieves the journal entry a transaction was booked as
+func (c *GeneralLedgerController) GetTransactionPostings(ctx *gin.Context) {
+	transactionID := ctx.Param("id")
+
+	response, err := c.ledgerUseCase.GetTransactionPostings(ctx.Request.Context(), transactionID)
+	if err != nil {
+		c.logger.Error("Failed to get GL postings", "error", err, "transactionID", transactionID)
+		HandleError(ctx, err)
+		return
+	}
+
+	Respond(ctx, http.StatusOK, MsgGLPostingsRetrieved, response)
+}
+
+// GetRevenueReport retrieves the fees collected and interest paid per period and product for the
+// periods in the "from" and "to" query parameters, as CSV when "format" is csv
+func (c *GeneralLedgerController) GetRevenueReport(ctx *gin.Context) {
+	req := dto.RevenueReportRequest{
+		From: ctx.Query("from"),
+		To:   ctx.Query("to"),
+	}
+
+	if ctx.Query("format") != "csv" {
+		response, err := c.ledgerUseCase.GetRevenueReport(ctx.Request.Context(), req)
+		if err != nil {
+			c.logger.Error("Failed to get revenue report", "error", err, "from", req.From, "to", req.To)
+			HandleError(ctx, err)
+			return
+		}
+
+		Respond(ctx, http.StatusOK, MsgRevenueReportRetrieved, response)
+		return
+	}
+
+	ctx.Header("Content-Type", "text/csv")
+	ctx.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="revenue-%s.csv"`, time.Now().UTC().Format("20060102T150405Z")))
+	ctx.Header("Cache-Control", "no-store")
+
+	if err := c.ledgerUseCase.ExportRevenueReport(ctx.Request.Context(), req, ctx.Writer); err != nil {
+		c.logger.Error("Failed to export revenue report", "error", err, "from", req.From, "to", req.To)
+		if !ctx.Writer.Written() {
+			ctx.Header("Content-Type", "")
+			ctx.Header("Content-Disposition", "")
+			HandleError(ctx, err)
+		}
+	}
+}
@@ -0,0 +1,124 @@
+package controller
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/hydr0g3nz/mini_bank/internal/application/dto"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/infra"
+)
+
+const (
+	// IdempotencyHeader carries a key the client picks per logical request and repeats on every
+	// retry of it, so a POST that timed out can be sent again without being carried out twice
+	IdempotencyHeader = "Idempotency-Key"
+
+	// idempotencyTTL is how long the response to a keyed request is replayed
+	idempotencyTTL = 24 * time.Hour
+	// maxIdempotencyKeyLength bounds the keys clients may send
+	maxIdempotencyKeyLength = 255
+)
+
+// idempotentResponse is the response to a keyed request, kept to be replayed to its retries
+type idempotentResponse struct {
+	RequestHash string `json:"request_hash"`
+	Status      int    `json:"status"`
+	ContentType string `json:"content_type"`
+	Body        []byte `json:"body"`
+}
+
+// responseRecorder copies the response body as it is written
+type responseRecorder struct {
+	gin.ResponseWriter
+	body bytes.Buffer
+}
+
+func (w *responseRecorder) Write(data []byte) (int, error) {
+	w.body.Write(data)
+	return w.ResponseWriter.Write(data)
+}
+
+func (w *responseRecorder) WriteString(data string) (int, error) {
+	w.body.WriteString(data)
+	return w.ResponseWriter.WriteString(data)
+}
+
+// IdempotencyMiddleware replays the response to an earlier request with the same Idempotency-Key
+// instead of handling the request again. Only successful responses are kept, so a request that
+// failed is carried out again when retried. Reusing a key for a different request body is rejected
+// with 422; requests without the header are handled as usual, and so is every request while the
+// cache fails.
+func IdempotencyMiddleware(cache infra.CacheService, logger infra.Logger) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		key := ctx.GetHeader(IdempotencyHeader)
+		if key == "" {
+			ctx.Next()
+			return
+		}
+		if len(key) > maxIdempotencyKeyLength {
+			abortWithJSON(ctx, http.StatusBadRequest, dto.ErrorResponse{
+				Code:    "INVALID_IDEMPOTENCY_KEY",
+				Message: fmt.Sprintf("Idempotency-Key must be at most %d characters", maxIdempotencyKeyLength),
+			})
+			return
+		}
+
+		body, err := io.ReadAll(ctx.Request.Body)
+		if err != nil {
+			HandleError(ctx, &ValidationError{Field: "body", Message: "request body could not be read"})
+			ctx.Abort()
+			return
+		}
+		ctx.Request.Body = io.NopCloser(bytes.NewReader(body))
+		hash := sha256.Sum256(body)
+		requestHash := hex.EncodeToString(hash[:])
+
+		// The handlers' deadline is over once they return, and keeping the response must outlive it
+		requestCtx := ctx.Request.Context()
+		cacheKey := fmt.Sprintf("idempotency:%s:%s:%s", ctx.Request.Method, ctx.Request.URL.Path, key)
+		var previous idempotentResponse
+		err = cache.Get(requestCtx, cacheKey, &previous)
+		switch {
+		case err == nil && previous.RequestHash != requestHash:
+			abortWithJSON(ctx, http.StatusUnprocessableEntity, dto.ErrorResponse{
+				Code:    "IDEMPOTENCY_KEY_REUSED",
+				Message: "Idempotency-Key was already used for a different request",
+			})
+			return
+		case err == nil:
+			ctx.Header("Idempotent-Replayed", "true")
+			ctx.Data(previous.Status, previous.ContentType, previous.Body)
+			ctx.Abort()
+			return
+		case !errors.Is(err, infra.ErrCacheMiss):
+			logger.Warn("Failed to look up idempotent response, handling request", "error", err, "path", ctx.Request.URL.Path)
+			ctx.Next()
+			return
+		}
+
+		recorder := &responseRecorder{ResponseWriter: ctx.Writer}
+		ctx.Writer = recorder
+		ctx.Next()
+
+		status := recorder.Status()
+		if status < http.StatusOK || status >= http.StatusMultipleChoices {
+			return
+		}
+		response := idempotentResponse{
+			RequestHash: requestHash,
+			Status:      status,
+			ContentType: recorder.Header().Get("Content-Type"),
+			Body:        recorder.body.Bytes(),
+		}
+		if err := cache.Set(requestCtx, cacheKey, response, idempotencyTTL); err != nil {
+			logger.Warn("Failed to keep idempotent response", "error", err, "path", ctx.Request.URL.Path)
+		}
+	}
+}
@@ -0,0 +1,93 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	usecase "github.com/hydr0g3nz/mini_bank/internal/application"
+	"github.com/hydr0g3nz/mini_bank/internal/application/dto"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/infra"
+)
+
+type JobController struct {
+	jobUseCase usecase.JobUseCase
+	logger     infra.Logger
+}
+
+func NewJobController(jobUseCase usecase.JobUseCase, logger infra.Logger) *JobController {
+	return &JobController{
+		jobUseCase: jobUseCase,
+		logger:     logger,
+	}
+}
+
+// Routes declares the background job routes
+func (c *JobController) Routes() []Route {
+	return []Route{
+		{Method: http.MethodGet, Path: "/admin/jobs", Handler: c.ListJobs, Summary: "List background jobs", Limit: LimitAdmin},
+		{Method: http.MethodGet, Path: "/admin/jobs/:id", Handler: c.GetJob, Summary: "Get a background job", Limit: LimitAdmin},
+		{Method: http.MethodPost, Path: "/admin/jobs/:id/retry", Handler: c.RetryJob, Summary: "Retry a failed background job", Limit: LimitAdmin},
+	}
+}
+
+// ListJobs retrieves jobs with pagination, filtered by the "type" and "status" query parameters
+func (c *JobController) ListJobs(ctx *gin.Context) {
+	// Parse query parameters
+	page, _ := strconv.Atoi(ctx.DefaultQuery("page", "1"))
+	pageSize, _ := strconv.Atoi(ctx.DefaultQuery("page_size", "10"))
+
+	req := dto.JobListRequest{
+		Page:     page,
+		PageSize: pageSize,
+		Type:     ctx.Query("type"),
+		Status:   strings.ToUpper(ctx.Query("status")),
+	}
+
+	// Validate request
+	if err := ValidateStruct(req); err != nil {
+		c.logger.Error("Validation failed", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+
+	response, err := c.jobUseCase.ListJobs(ctx.Request.Context(), req)
+	if err != nil {
+		c.logger.Error("Failed to list jobs", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+
+	SetPaginationHeaders(ctx, response.Pagination)
+	Respond(ctx, http.StatusOK, MsgJobsRetrieved, response)
+}
+
+// GetJob retrieves a job's status, attempts and last error
+func (c *JobController) GetJob(ctx *gin.Context) {
+	id := ctx.Param("id")
+
+	response, err := c.jobUseCase.GetJob(ctx.Request.Context(), id)
+	if err != nil {
+		c.logger.Error("Failed to get job", "error", err, "jobID", id)
+		HandleError(ctx, err)
+		return
+	}
+
+	Respond(ctx, http.StatusOK, MsgJobRetrieved, response)
+}
+
+// RetryJob queues a failed job again
+func (c *JobController) RetryJob(ctx *gin.Context) {
+	id := ctx.Param("id")
+
+	response, err := c.jobUseCase.RetryJob(ctx.Request.Context(), id)
+	if err != nil {
+		c.logger.Error("Failed to retry job", "error", err, "jobID", id)
+		HandleError(ctx, err)
+		return
+	}
+
+	c.logger.Info("Job queued for retry", "jobID", id)
+	Respond(ctx, http.StatusAccepted, MsgJobRetried, response)
+}
@@ -0,0 +1,456 @@
+package controller
+
+// MessageKey identifies a success message in the catalog
+type MessageKey string
+
+const (
+	// Accounts
+	MsgAccountCreated           MessageKey = "account.created"
+	MsgAccountRetrieved         MessageKey = "account.retrieved"
+	MsgAccountUpdated           MessageKey = "account.updated"
+	MsgAccountDeleted           MessageKey = "account.deleted"
+	MsgAccountsRetrieved        MessageKey = "accounts.retrieved"
+	MsgAccountsBatchRetrieved   MessageKey = "accounts.batch_retrieved"
+	MsgAccountSuspended         MessageKey = "account.suspended"
+	MsgAccountActivated         MessageKey = "account.activated"
+	MsgCustomerSummaryRetrieved MessageKey = "customer_summary.retrieved"
+
+	// Ownership transfers
+	MsgOwnershipTransferRequested  MessageKey = "ownership_transfer.requested"
+	MsgOwnershipTransferApproved   MessageKey = "ownership_transfer.approved"
+	MsgOwnershipTransferRejected   MessageKey = "ownership_transfer.rejected"
+	MsgOwnershipTransferRetrieved  MessageKey = "ownership_transfer.retrieved"
+	MsgOwnershipTransfersRetrieved MessageKey = "ownership_transfers.retrieved"
+
+	// Transaction blocks
+	MsgTransactionBlockCreated    MessageKey = "transaction_block.created"
+	MsgTransactionBlockLifted     MessageKey = "transaction_block.lifted"
+	MsgTransactionBlockRetrieved  MessageKey = "transaction_block.retrieved"
+	MsgTransactionBlocksRetrieved MessageKey = "transaction_blocks.retrieved"
+
+	// Velocity limits
+	MsgVelocityUsageRetrieved MessageKey = "velocity_usage.retrieved"
+
+	// Exchange rates
+	MsgExchangeRatesRetrieved MessageKey = "exchange_rates.retrieved"
+
+	// Virtual accounts
+	MsgVirtualAccountCreated               MessageKey = "virtual_account.created"
+	MsgVirtualAccountRetrieved             MessageKey = "virtual_account.retrieved"
+	MsgVirtualAccountsRetrieved            MessageKey = "virtual_accounts.retrieved"
+	MsgVirtualAccountUpdated               MessageKey = "virtual_account.updated"
+	MsgVirtualAccountDeleted               MessageKey = "virtual_account.deleted"
+	MsgVirtualAccountTransactionsRetrieved MessageKey = "virtual_account_transactions.retrieved"
+
+	// Account groups
+	MsgChildAccountAdded     MessageKey = "child_account.added"
+	MsgChildAccountRemoved   MessageKey = "child_account.removed"
+	MsgSpendingLimitSet      MessageKey = "spending_limit.set"
+	MsgSpendingLimitRemoved  MessageKey = "spending_limit.removed"
+	MsgAccountGroupRetrieved MessageKey = "account_group.retrieved"
+	MsgGroupTransferCreated  MessageKey = "group_transfer.created"
+
+	// Transactions
+	MsgTransactionCreated             MessageKey = "transaction.created"
+	MsgTransactionConfirmed           MessageKey = "transaction.confirmed"
+	MsgTransactionRetrieved           MessageKey = "transaction.retrieved"
+	MsgTransactionsRetrieved          MessageKey = "transactions.retrieved"
+	MsgTransactionsBatchRetrieved     MessageKey = "transactions.batch_retrieved"
+	MsgAccountTransactionsRetrieved   MessageKey = "account_transactions.retrieved"
+	MsgAccountTransactionsSynced      MessageKey = "account_transactions.synced"
+	MsgAccountActivityRetrieved       MessageKey = "account_activity.retrieved"
+	MsgAccountProjectionRetrieved     MessageKey = "account_projection.retrieved"
+	MsgStatementRetrieved             MessageKey = "statement.retrieved"
+	MsgTransactionCancelled           MessageKey = "transaction.cancelled"
+	MsgTransactionReversed            MessageKey = "transaction.reversed"
+	MsgTransactionReversalCreated     MessageKey = "transaction.reversal_created"
+	MsgTransactionsByStatusRetrieved  MessageKey = "transactions_by_status.retrieved"
+	MsgTransactionsByChannelRetrieved MessageKey = "transactions_by_channel.retrieved"
+	MsgTransferSimulated              MessageKey = "transfer.simulated"
+	MsgTransactionInReview            MessageKey = "transaction.in_review"
+	MsgSagaRetrieved                  MessageKey = "saga.retrieved"
+
+	// Reviews
+	MsgReviewsRetrieved            MessageKey = "reviews.retrieved"
+	MsgReviewApproved              MessageKey = "review.approved"
+	MsgReviewDeclined              MessageKey = "review.declined"
+	MsgReviewClaimed               MessageKey = "review.claimed"
+	MsgReviewReleased              MessageKey = "review.released"
+	MsgReviewQueueMetricsRetrieved MessageKey = "review_queue_metrics.retrieved"
+	MsgFailedTransactionsRetrieved MessageKey = "failed_transactions.retrieved"
+	MsgTransactionReplayed         MessageKey = "transaction.replayed"
+	MsgTransactionAdjusted         MessageKey = "transaction.adjusted"
+	MsgBalanceRecalculated         MessageKey = "account.balance_recalculated"
+	MsgAdjustmentCreated           MessageKey = "adjustment.created"
+	MsgAdjustmentReportRetrieved   MessageKey = "adjustment_report.retrieved"
+
+	// Categories
+	MsgCategoryCreated            MessageKey = "category.created"
+	MsgCategoryUpdated            MessageKey = "category.updated"
+	MsgCategoryDeleted            MessageKey = "category.deleted"
+	MsgCategoriesRetrieved        MessageKey = "categories.retrieved"
+	MsgCategoryRuleCreated        MessageKey = "category_rule.created"
+	MsgCategoryRuleDeleted        MessageKey = "category_rule.deleted"
+	MsgCategoryRulesRetrieved     MessageKey = "category_rules.retrieved"
+	MsgTransactionCategoryUpdated MessageKey = "transaction_category.updated"
+	MsgTransactionCategoryReset   MessageKey = "transaction_category.reset"
+	MsgCategorySummaryRetrieved   MessageKey = "category_summary.retrieved"
+
+	// Products
+	MsgProductCreated    MessageKey = "product.created"
+	MsgProductRetrieved  MessageKey = "product.retrieved"
+	MsgProductUpdated    MessageKey = "product.updated"
+	MsgProductsRetrieved MessageKey = "products.retrieved"
+
+	// Product migrations
+	MsgProductMigrationCreated    MessageKey = "product_migration.created"
+	MsgProductMigrationDryRun     MessageKey = "product_migration.dry_run"
+	MsgProductMigrationRetrieved  MessageKey = "product_migration.retrieved"
+	MsgProductMigrationsRetrieved MessageKey = "product_migrations.retrieved"
+
+	// Cashback
+	MsgCashbackCampaignCreated    MessageKey = "cashback_campaign.created"
+	MsgCashbackCampaignUpdated    MessageKey = "cashback_campaign.updated"
+	MsgCashbackCampaignsRetrieved MessageKey = "cashback_campaigns.retrieved"
+	MsgAccountCashbackRetrieved   MessageKey = "account_cashback.retrieved"
+
+	// Transaction history
+	MsgTransactionHistoryRetrieved MessageKey = "transaction_history.retrieved"
+	MsgTransactionHistoryRebuilt   MessageKey = "transaction_history.rebuilt"
+
+	// Daily totals
+	MsgDailyTotalsRetrieved MessageKey = "daily_totals.retrieved"
+	MsgDailyTotalsRebuilt   MessageKey = "daily_totals.rebuilt"
+
+	// Referrals
+	MsgReferralCodeRetrieved MessageKey = "referral_code.retrieved"
+	MsgReferralRedeemed      MessageKey = "referral.redeemed"
+	MsgReferralsRetrieved    MessageKey = "referrals.retrieved"
+
+	// Transfer templates
+	MsgTransferTemplateCreated    MessageKey = "transfer_template.created"
+	MsgTransferTemplateRetrieved  MessageKey = "transfer_template.retrieved"
+	MsgTransferTemplatesRetrieved MessageKey = "transfer_templates.retrieved"
+	MsgTransferTemplateUpdated    MessageKey = "transfer_template.updated"
+	MsgTransferTemplateDeleted    MessageKey = "transfer_template.deleted"
+	MsgTransferTemplateExecuted   MessageKey = "transfer_template.executed"
+
+	// Scheduled transactions
+	MsgScheduledTransactionCreated    MessageKey = "scheduled_transaction.created"
+	MsgScheduledTransactionRetrieved  MessageKey = "scheduled_transaction.retrieved"
+	MsgScheduledTransactionsRetrieved MessageKey = "scheduled_transactions.retrieved"
+	MsgScheduledTransactionUpdated    MessageKey = "scheduled_transaction.updated"
+	MsgScheduledTransactionDeleted    MessageKey = "scheduled_transaction.deleted"
+
+	// Transaction tags and saved history filters
+	MsgTransactionTagsSet    MessageKey = "transaction.tags_set"
+	MsgSavedFilterCreated    MessageKey = "saved_filter.created"
+	MsgSavedFilterRetrieved  MessageKey = "saved_filter.retrieved"
+	MsgSavedFiltersRetrieved MessageKey = "saved_filters.retrieved"
+	MsgSavedFilterDeleted    MessageKey = "saved_filter.deleted"
+
+	// Transaction attachments
+	MsgAttachmentUploaded   MessageKey = "attachment.uploaded"
+	MsgAttachmentRetrieved  MessageKey = "attachment.retrieved"
+	MsgAttachmentsRetrieved MessageKey = "attachments.retrieved"
+	MsgAttachmentDeleted    MessageKey = "attachment.deleted"
+
+	// Notification templates
+	MsgNotificationTemplatePublished  MessageKey = "notification_template.published"
+	MsgNotificationTemplateRetrieved  MessageKey = "notification_template.retrieved"
+	MsgNotificationTemplatesRetrieved MessageKey = "notification_templates.retrieved"
+	MsgNotificationTemplatePreviewed  MessageKey = "notification_template.previewed"
+	MsgNotificationTestSent           MessageKey = "notification_template.test_sent"
+
+	// Notification preferences
+	MsgNotificationPreferencesRetrieved MessageKey = "notification_preferences.retrieved"
+	MsgNotificationPreferencesUpdated   MessageKey = "notification_preferences.updated"
+	MsgNotificationPreferencesReset     MessageKey = "notification_preferences.reset"
+
+	// Accounting periods
+	MsgAccountingPeriodClosed     MessageKey = "accounting_period.closed"
+	MsgAccountingPeriodRetrieved  MessageKey = "accounting_period.retrieved"
+	MsgAccountingPeriodsRetrieved MessageKey = "accounting_periods.retrieved"
+
+	// General ledger
+	MsgGLAccountsRetrieved    MessageKey = "gl_accounts.retrieved"
+	MsgGLPostingsRetrieved    MessageKey = "gl_postings.retrieved"
+	MsgTrialBalanceRetrieved  MessageKey = "trial_balance.retrieved"
+	MsgRevenueReportRetrieved MessageKey = "revenue_report.retrieved"
+
+	// Cache
+	MsgCacheInvalidated MessageKey = "cache.invalidated"
+
+	// Admin users
+	MsgAdminUserCreated    MessageKey = "admin_user.created"
+	MsgAdminUserRetrieved  MessageKey = "admin_user.retrieved"
+	MsgAdminUsersRetrieved MessageKey = "admin_users.retrieved"
+	MsgAdminUserUpdated    MessageKey = "admin_user.updated"
+	MsgAdminKeyRotated     MessageKey = "admin_user.key_rotated"
+	MsgAdminRolesRetrieved MessageKey = "admin_roles.retrieved"
+
+	// Admin security
+	MsgAdminActivityRetrieved     MessageKey = "admin_activity.retrieved"
+	MsgSecurityDashboardRetrieved MessageKey = "security_dashboard.retrieved"
+
+	// Test clock
+	MsgClockRetrieved MessageKey = "clock.retrieved"
+	MsgClockAdvanced  MessageKey = "clock.advanced"
+
+	// Business rules
+	MsgBusinessRuleCreated    MessageKey = "business_rule.created"
+	MsgBusinessRuleUpdated    MessageKey = "business_rule.updated"
+	MsgBusinessRuleDeleted    MessageKey = "business_rule.deleted"
+	MsgBusinessRulesRetrieved MessageKey = "business_rules.retrieved"
+	MsgBusinessRuleTested     MessageKey = "business_rule.tested"
+
+	// Budgets
+	MsgBudgetCreated    MessageKey = "budget.created"
+	MsgBudgetRetrieved  MessageKey = "budget.retrieved"
+	MsgBudgetsRetrieved MessageKey = "budgets.retrieved"
+	MsgBudgetUpdated    MessageKey = "budget.updated"
+	MsgBudgetDeleted    MessageKey = "budget.deleted"
+
+	// Webhooks
+	MsgWebhookCreated    MessageKey = "webhook.created"
+	MsgWebhookRetrieved  MessageKey = "webhook.retrieved"
+	MsgWebhooksRetrieved MessageKey = "webhooks.retrieved"
+	MsgWebhookDeleted    MessageKey = "webhook.deleted"
+	MsgWebhookEnabled    MessageKey = "webhook.enabled"
+	MsgWebhookTested     MessageKey = "webhook.tested"
+
+	// Calendar
+	MsgHolidayAdded           MessageKey = "holiday.added"
+	MsgHolidayDeleted         MessageKey = "holiday.deleted"
+	MsgHolidaysRetrieved      MessageKey = "holidays.retrieved"
+	MsgBusinessDayRetrieved   MessageKey = "business_day.retrieved"
+	MsgSettlementDateComputed MessageKey = "settlement_date.computed"
+
+	// Backups
+	MsgBackupStarted        MessageKey = "backup.started"
+	MsgBackupRetrieved      MessageKey = "backup.retrieved"
+	MsgBackupsRetrieved     MessageKey = "backups.retrieved"
+	MsgBalanceReconstructed MessageKey = "balance.reconstructed"
+
+	// Exports
+	MsgExportStarted   MessageKey = "export.started"
+	MsgExportRetrieved MessageKey = "export.retrieved"
+
+	// Jobs
+	MsgJobRetrieved  MessageKey = "job.retrieved"
+	MsgJobsRetrieved MessageKey = "jobs.retrieved"
+	MsgJobRetried    MessageKey = "job.retried"
+)
+
+// messageCatalog holds the text of every success message returned by the API
+var messageCatalog = map[MessageKey]string{
+	MsgAccountCreated:           "Account created successfully",
+	MsgAccountRetrieved:         "Account retrieved successfully",
+	MsgAccountUpdated:           "Account updated successfully",
+	MsgAccountDeleted:           "Account deleted successfully",
+	MsgAccountsRetrieved:        "Accounts retrieved successfully",
+	MsgAccountsBatchRetrieved:   "Accounts batch retrieved successfully",
+	MsgAccountSuspended:         "Account suspended successfully",
+	MsgAccountActivated:         "Account activated successfully",
+	MsgCustomerSummaryRetrieved: "Customer summary retrieved successfully",
+
+	MsgOwnershipTransferRequested:  "Ownership transfer requested successfully",
+	MsgOwnershipTransferApproved:   "Ownership transfer approved successfully",
+	MsgOwnershipTransferRejected:   "Ownership transfer rejected successfully",
+	MsgOwnershipTransferRetrieved:  "Ownership transfer retrieved successfully",
+	MsgOwnershipTransfersRetrieved: "Ownership transfers retrieved successfully",
+
+	MsgTransactionBlockCreated:    "Transaction block created successfully",
+	MsgTransactionBlockLifted:     "Transaction block lifted successfully",
+	MsgTransactionBlockRetrieved:  "Transaction block retrieved successfully",
+	MsgTransactionBlocksRetrieved: "Transaction blocks retrieved successfully",
+
+	MsgVelocityUsageRetrieved: "Velocity usage retrieved successfully",
+
+	MsgExchangeRatesRetrieved: "Exchange rates retrieved successfully",
+
+	MsgVirtualAccountCreated:               "Virtual account created successfully",
+	MsgVirtualAccountRetrieved:             "Virtual account retrieved successfully",
+	MsgVirtualAccountsRetrieved:            "Virtual accounts retrieved successfully",
+	MsgVirtualAccountUpdated:               "Virtual account updated successfully",
+	MsgVirtualAccountDeleted:               "Virtual account deleted successfully",
+	MsgVirtualAccountTransactionsRetrieved: "Virtual account transactions retrieved successfully",
+
+	MsgChildAccountAdded:     "Child account added successfully",
+	MsgChildAccountRemoved:   "Child account removed successfully",
+	MsgSpendingLimitSet:      "Spending limit set successfully",
+	MsgSpendingLimitRemoved:  "Spending limit removed successfully",
+	MsgAccountGroupRetrieved: "Account group retrieved successfully",
+	MsgGroupTransferCreated:  "Group transfer processed successfully",
+
+	MsgTransactionCreated:             "Transaction created successfully",
+	MsgTransactionConfirmed:           "Transaction confirmed successfully",
+	MsgTransactionRetrieved:           "Transaction retrieved successfully",
+	MsgTransactionsRetrieved:          "Transactions retrieved successfully",
+	MsgTransactionsBatchRetrieved:     "Transactions batch retrieved successfully",
+	MsgAccountTransactionsRetrieved:   "Account transactions retrieved successfully",
+	MsgAccountTransactionsSynced:      "Account transactions synced successfully",
+	MsgAccountActivityRetrieved:       "Account activity retrieved successfully",
+	MsgAccountProjectionRetrieved:     "Account projection retrieved successfully",
+	MsgStatementRetrieved:             "Account statement retrieved successfully",
+	MsgTransactionCancelled:           "Transaction cancelled successfully",
+	MsgTransactionReversed:            "Transaction had already settled and was reversed",
+	MsgTransactionReversalCreated:     "Transaction reversed successfully",
+	MsgTransactionsByStatusRetrieved:  "Transactions by status retrieved successfully",
+	MsgTransactionsByChannelRetrieved: "Transactions by channel retrieved successfully",
+	MsgTransferSimulated:              "Transfer simulated successfully",
+	MsgTransactionInReview:            "Transaction is held for review",
+	MsgSagaRetrieved:                  "Saga retrieved successfully",
+
+	MsgReviewsRetrieved:            "Transactions in review retrieved successfully",
+	MsgReviewApproved:              "Transaction approved and processed",
+	MsgReviewDeclined:              "Transaction declined",
+	MsgReviewClaimed:               "Review claimed",
+	MsgReviewReleased:              "Review released",
+	MsgReviewQueueMetricsRetrieved: "Review queue metrics retrieved successfully",
+	MsgFailedTransactionsRetrieved: "Failed transactions retrieved successfully",
+	MsgTransactionReplayed:         "Transaction replayed successfully",
+	MsgTransactionAdjusted:         "Adjustment posted successfully",
+	MsgBalanceRecalculated:         "Account balance recalculated successfully",
+	MsgAdjustmentCreated:           "Adjustment posted and awaiting approval by a second admin",
+	MsgAdjustmentReportRetrieved:   "Adjustment report retrieved successfully",
+
+	MsgCategoryCreated:            "Category created successfully",
+	MsgCategoryUpdated:            "Category updated successfully",
+	MsgCategoryDeleted:            "Category deleted successfully",
+	MsgCategoriesRetrieved:        "Categories retrieved successfully",
+	MsgCategoryRuleCreated:        "Category rule created successfully",
+	MsgCategoryRuleDeleted:        "Category rule deleted successfully",
+	MsgCategoryRulesRetrieved:     "Category rules retrieved successfully",
+	MsgTransactionCategoryUpdated: "Transaction category updated successfully",
+	MsgTransactionCategoryReset:   "Transaction category reset successfully",
+	MsgCategorySummaryRetrieved:   "Category summary retrieved successfully",
+
+	MsgBusinessRuleCreated:    "Business rule created successfully",
+	MsgBusinessRuleUpdated:    "Business rule updated successfully",
+	MsgBusinessRuleDeleted:    "Business rule deleted successfully",
+	MsgBusinessRulesRetrieved: "Business rules retrieved successfully",
+	MsgBusinessRuleTested:     "Business rule evaluated successfully",
+
+	MsgProductCreated:    "Product created successfully",
+	MsgProductRetrieved:  "Product retrieved successfully",
+	MsgProductUpdated:    "Product updated successfully",
+	MsgProductsRetrieved: "Products retrieved successfully",
+
+	MsgProductMigrationCreated:    "Product migration scheduled successfully",
+	MsgProductMigrationDryRun:     "Product migration dry run completed; nothing was changed",
+	MsgProductMigrationRetrieved:  "Product migration retrieved successfully",
+	MsgProductMigrationsRetrieved: "Product migrations retrieved successfully",
+
+	MsgCashbackCampaignCreated:    "Cashback campaign created successfully",
+	MsgCashbackCampaignUpdated:    "Cashback campaign updated successfully",
+	MsgCashbackCampaignsRetrieved: "Cashback campaigns retrieved successfully",
+	MsgAccountCashbackRetrieved:   "Account cashback retrieved successfully",
+
+	MsgTransactionHistoryRetrieved: "Transaction history retrieved successfully",
+	MsgTransactionHistoryRebuilt:   "Transaction history rebuilt successfully",
+
+	MsgDailyTotalsRetrieved: "Daily totals retrieved successfully",
+	MsgDailyTotalsRebuilt:   "Daily totals rebuilt successfully",
+
+	MsgReferralCodeRetrieved: "Referral code retrieved successfully",
+	MsgReferralRedeemed:      "Referral code redeemed successfully",
+	MsgReferralsRetrieved:    "Referrals retrieved successfully",
+
+	MsgTransferTemplateCreated:    "Transfer template created successfully",
+	MsgTransferTemplateRetrieved:  "Transfer template retrieved successfully",
+	MsgTransferTemplatesRetrieved: "Transfer templates retrieved successfully",
+	MsgTransferTemplateUpdated:    "Transfer template updated successfully",
+	MsgTransferTemplateDeleted:    "Transfer template deleted successfully",
+	MsgTransferTemplateExecuted:   "Transfer processed successfully",
+
+	MsgScheduledTransactionCreated:    "Scheduled transaction created successfully",
+	MsgScheduledTransactionRetrieved:  "Scheduled transaction retrieved successfully",
+	MsgScheduledTransactionsRetrieved: "Scheduled transactions retrieved successfully",
+	MsgScheduledTransactionUpdated:    "Scheduled transaction updated successfully",
+	MsgScheduledTransactionDeleted:    "Scheduled transaction deleted successfully",
+
+	MsgTransactionTagsSet:    "Transaction tags saved successfully",
+	MsgSavedFilterCreated:    "Saved filter created successfully",
+	MsgSavedFilterRetrieved:  "Saved filter retrieved successfully",
+	MsgSavedFiltersRetrieved: "Saved filters retrieved successfully",
+	MsgSavedFilterDeleted:    "Saved filter deleted successfully",
+
+	MsgAttachmentUploaded:   "Attachment uploaded successfully",
+	MsgAttachmentRetrieved:  "Attachment retrieved successfully",
+	MsgAttachmentsRetrieved: "Attachments retrieved successfully",
+	MsgAttachmentDeleted:    "Attachment deleted successfully",
+
+	MsgNotificationTemplatePublished:  "Notification template published successfully",
+	MsgNotificationTemplateRetrieved:  "Notification template retrieved successfully",
+	MsgNotificationTemplatesRetrieved: "Notification templates retrieved successfully",
+	MsgNotificationTemplatePreviewed:  "Notification template rendered successfully",
+	MsgNotificationTestSent:           "Test notification sent successfully",
+
+	MsgNotificationPreferencesRetrieved: "Notification preferences retrieved successfully",
+	MsgNotificationPreferencesUpdated:   "Notification preferences updated successfully",
+	MsgNotificationPreferencesReset:     "Notification preferences reset successfully",
+
+	MsgAccountingPeriodClosed:     "Accounting period closed successfully",
+	MsgAccountingPeriodRetrieved:  "Accounting period retrieved successfully",
+	MsgAccountingPeriodsRetrieved: "Accounting periods retrieved successfully",
+
+	MsgGLAccountsRetrieved:    "GL accounts retrieved successfully",
+	MsgGLPostingsRetrieved:    "GL postings retrieved successfully",
+	MsgTrialBalanceRetrieved:  "Trial balance retrieved successfully",
+	MsgRevenueReportRetrieved: "Revenue report retrieved successfully",
+
+	MsgCacheInvalidated: "Cache invalidated successfully",
+
+	MsgAdminUserCreated:    "Admin user created successfully; store the API key, it is not shown again",
+	MsgAdminUserRetrieved:  "Admin user retrieved successfully",
+	MsgAdminUsersRetrieved: "Admin users retrieved successfully",
+	MsgAdminUserUpdated:    "Admin user updated successfully",
+	MsgAdminKeyRotated:     "API key rotated successfully; store the new key, it is not shown again",
+	MsgAdminRolesRetrieved: "Admin roles retrieved successfully",
+
+	MsgAdminActivityRetrieved:     "Admin activity retrieved successfully",
+	MsgSecurityDashboardRetrieved: "Security dashboard retrieved successfully",
+
+	MsgClockRetrieved: "Clock retrieved successfully",
+	MsgClockAdvanced:  "Clock advanced successfully",
+
+	MsgBudgetCreated:    "Budget created successfully",
+	MsgBudgetRetrieved:  "Budget retrieved successfully",
+	MsgBudgetsRetrieved: "Budgets retrieved successfully",
+	MsgBudgetUpdated:    "Budget updated successfully",
+	MsgBudgetDeleted:    "Budget deleted successfully",
+
+	MsgWebhookCreated:    "Webhook created successfully",
+	MsgWebhookRetrieved:  "Webhook retrieved successfully",
+	MsgWebhooksRetrieved: "Webhooks retrieved successfully",
+	MsgWebhookDeleted:    "Webhook deleted successfully",
+	MsgWebhookEnabled:    "Webhook enabled successfully",
+	MsgWebhookTested:     "Webhook test delivery completed",
+
+	MsgHolidayAdded:           "Holiday added successfully",
+	MsgHolidayDeleted:         "Holiday deleted successfully",
+	MsgHolidaysRetrieved:      "Holidays retrieved successfully",
+	MsgBusinessDayRetrieved:   "Business day retrieved successfully",
+	MsgSettlementDateComputed: "Settlement date computed successfully",
+
+	MsgBackupStarted:        "Backup started",
+	MsgBackupRetrieved:      "Backup retrieved successfully",
+	MsgBackupsRetrieved:     "Backups retrieved successfully",
+	MsgBalanceReconstructed: "Balance reconstructed successfully",
+
+	MsgExportStarted:   "Export started",
+	MsgExportRetrieved: "Export retrieved successfully",
+
+	MsgJobRetrieved:  "Job retrieved successfully",
+	MsgJobsRetrieved: "Jobs retrieved successfully",
+	MsgJobRetried:    "Job queued for retry",
+}
+
+// Message returns the text of a catalog message, falling back to its key
+func Message(key MessageKey) string {
+	if message, ok := messageCatalog[key]; ok {
+		return message
+	}
+	return string(key)
+}
@@ -1,17 +1,34 @@
 package controller
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"net/http"
+	"slices"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/hydr0g3nz/mini_bank/internal/application/dto"
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
 	"github.com/hydr0g3nz/mini_bank/internal/domain/infra"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
 )
 
-// APIKeyMiddleware creates a middleware that validates API key from x-api-key header
-func APIKeyMiddleware(validAPIKey string, logger infra.Logger) gin.HandlerFunc {
+// adminContextKey holds the admin user a request was authenticated as
+const adminContextKey = "adminUser"
+
+// AdminAuthenticator resolves the API key of an admin user; usecase.AdminUserUseCase implements it
+type AdminAuthenticator interface {
+	Authenticate(ctx context.Context, key string) (*dto.AdminUserResponse, error)
+}
+
+// APIKeyMiddleware creates a middleware that validates API key from x-api-key header against the
+// current value of validAPIKey, so a rotated key applies to the next request. Other keys are tried
+// as admin user keys when admins is set; the admin user is then kept for PermissionMiddleware.
+func APIKeyMiddleware(validAPIKey infra.SecretValue, admins AdminAuthenticator, logger infra.Logger) gin.HandlerFunc {
 	return func(ctx *gin.Context) {
 		// Get API key from header
 		apiKey := ctx.GetHeader("x-api-key")
@@ -24,7 +41,7 @@ func APIKeyMiddleware(validAPIKey string, logger infra.Logger) gin.HandlerFunc {
 				"ip", ctx.ClientIP(),
 			)
 
-			ctx.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			renderJSON(ctx, http.StatusUnauthorized, dto.ErrorResponse{
 				Code:    "MISSING_API_KEY",
 				Message: "API key is required. Please provide x-api-key header",
 			})
@@ -33,7 +50,22 @@ func APIKeyMiddleware(validAPIKey string, logger infra.Logger) gin.HandlerFunc {
 		}
 
 		// Validate API key
-		if strings.TrimSpace(apiKey) != validAPIKey {
+		if strings.TrimSpace(apiKey) != validAPIKey.Value() {
+			if admins != nil {
+				admin, err := admins.Authenticate(ctx.Request.Context(), apiKey)
+				if err == nil {
+					ctx.Set(adminContextKey, admin)
+					ctx.Next()
+					return
+				}
+				if !errors.Is(err, errs.ErrUnauthorized) {
+					logger.Error("Failed to authenticate admin user", "error", err, "path", ctx.Request.URL.Path)
+					HandleError(ctx, err)
+					ctx.Abort()
+					return
+				}
+			}
+
 			logger.Warn("Invalid API key provided",
 				"path", ctx.Request.URL.Path,
 				"method", ctx.Request.Method,
@@ -41,7 +73,7 @@ func APIKeyMiddleware(validAPIKey string, logger infra.Logger) gin.HandlerFunc {
 				"providedKey", apiKey[:min(len(apiKey), 8)]+"...", // Log only first 8 chars for security
 			)
 
-			ctx.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			renderJSON(ctx, http.StatusUnauthorized, dto.ErrorResponse{
 				Code:    "INVALID_API_KEY",
 				Message: "Invalid API key provided",
 			})
@@ -61,13 +93,89 @@ func APIKeyMiddleware(validAPIKey string, logger infra.Logger) gin.HandlerFunc {
 	}
 }
 
+// AdminFromContext returns the admin user the request was authenticated as, nil for the service API key
+func AdminFromContext(ctx *gin.Context) *dto.AdminUserResponse {
+	value, _ := ctx.Get(adminContextKey)
+	admin, _ := value.(*dto.AdminUserResponse)
+	return admin
+}
+
+// PermissionMiddleware lets admin users through only when one of their roles grants the permission;
+// routes without a permission are left to the service API key, which may call every route
+func PermissionMiddleware(permission vo.AdminPermission, logger infra.Logger) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		admin := AdminFromContext(ctx)
+		if admin == nil || (permission != "" && slices.Contains(admin.Permissions, string(permission))) {
+			ctx.Next()
+			return
+		}
+
+		logger.Warn("Admin user lacks the permission of the route",
+			"adminID", admin.ID,
+			"permission", permission,
+			"path", ctx.Request.URL.Path,
+			"method", ctx.Request.Method,
+		)
+
+		message := "This endpoint requires the service API key"
+		if permission != "" {
+			message = fmt.Sprintf("This endpoint requires the %s permission", permission)
+		}
+		renderJSON(ctx, http.StatusForbidden, dto.ErrorResponse{
+			Code:    "PERMISSION_DENIED",
+			Message: message,
+		})
+		ctx.Abort()
+	}
+}
+
+// AdminActivityRecorder records the requests of admin users; usecase.AdminSecurityUseCase implements it
+type AdminActivityRecorder interface {
+	RecordActivity(ctx context.Context, record dto.AdminActivityRecord) error
+}
+
+// AdminActivityMiddleware records every request an admin user makes once it is answered, denied ones
+// included, with the client IP and the country geoHeader reports; service API key requests are not
+// recorded. Recording outlives the request so a client hanging up does not drop the audit.
+func AdminActivityMiddleware(recorder AdminActivityRecorder, permission vo.AdminPermission, geoHeader string, logger infra.Logger) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		ctx.Next()
+
+		admin := AdminFromContext(ctx)
+		if admin == nil {
+			return
+		}
+
+		var country string
+		if geoHeader != "" {
+			country = ctx.GetHeader(geoHeader)
+		}
+		err := recorder.RecordActivity(context.WithoutCancel(ctx.Request.Context()), dto.AdminActivityRecord{
+			AdminID:    admin.ID,
+			AdminEmail: admin.Email,
+			Method:     ctx.Request.Method,
+			Route:      ctx.FullPath(),
+			Path:       ctx.Request.URL.Path,
+			Permission: string(permission),
+			StatusCode: ctx.Writer.Status(),
+			IP:         ctx.ClientIP(),
+			Country:    country,
+			UserAgent:  ctx.Request.UserAgent(),
+			OccurredAt: time.Now(),
+		})
+		if err != nil {
+			logger.Error("Failed to record admin activity", "error", err, "adminID", admin.ID, "path", ctx.Request.URL.Path)
+		}
+	}
+}
+
 // CORSMiddleware handles CORS headers
 func CORSMiddleware() gin.HandlerFunc {
 	return func(ctx *gin.Context) {
 		ctx.Header("Access-Control-Allow-Origin", "*")
 		ctx.Header("Access-Control-Allow-Methods", "GET, POST, PUT, PATCH, DELETE, OPTIONS")
-		ctx.Header("Access-Control-Allow-Headers", "Origin, Content-Type, Content-Length, Accept-Encoding, X-CSRF-Token, Authorization, x-api-key")
-		ctx.Header("Access-Control-Expose-Headers", "Content-Length")
+		ctx.Header("Access-Control-Allow-Headers", "Origin, Content-Type, Content-Length, Accept-Encoding, X-CSRF-Token, Authorization, x-api-key, X-Response-Envelope, Idempotency-Key, X-Signature, X-Signature-Timestamp, X-Signature-Nonce")
+		ctx.Header("Access-Control-Expose-Headers", "Content-Length, Link, X-Total-Count")
 		ctx.Header("Access-Control-Allow-Credentials", "true")
 
 		if ctx.Request.Method == "OPTIONS" {
@@ -106,13 +214,138 @@ func RecoveryMiddleware(logger infra.Logger) gin.HandlerFunc {
 			"ip", ctx.ClientIP(),
 		)
 
-		ctx.JSON(http.StatusInternalServerError, dto.ErrorResponse{
+		renderJSON(ctx, http.StatusInternalServerError, dto.ErrorResponse{
 			Code:    "INTERNAL_ERROR",
 			Message: "Internal server error occurred",
 		})
 	})
 }
 
+// retryAfterKey holds how long a rejected request should wait before retrying, for HandleError
+const retryAfterKey = "retryAfter"
+
+// ReadOnlyMiddleware rejects writes with 503 and Retry-After while the database is read-only;
+// reads keep working
+func ReadOnlyMiddleware(mode infra.DatabaseMode) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		if mode == nil {
+			ctx.Next()
+			return
+		}
+
+		ctx.Set(retryAfterKey, mode.RetryAfter())
+
+		switch ctx.Request.Method {
+		case http.MethodGet, http.MethodHead, http.MethodOptions:
+		default:
+			if _, readOnly := mode.ReadOnlySince(); readOnly {
+				HandleError(ctx, errs.ErrDatabaseReadOnly)
+				ctx.Abort()
+				return
+			}
+		}
+
+		ctx.Next()
+	}
+}
+
+// CacheAgeMiddleware lets reads bound the age of the cached data they are served with the max_age
+// query parameter, in seconds: cached values older than that are read from the database instead,
+// and max_age=0 bypasses the cache. Writes ignore it.
+func CacheAgeMiddleware() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		raw, ok := ctx.GetQuery("max_age")
+		if !ok || (ctx.Request.Method != http.MethodGet && ctx.Request.Method != http.MethodHead) {
+			ctx.Next()
+			return
+		}
+
+		seconds, err := strconv.Atoi(raw)
+		if err != nil || seconds < 0 {
+			HandleError(ctx, &ValidationError{Field: "max_age", Message: "max_age must be a number of seconds, 0 or more"})
+			ctx.Abort()
+			return
+		}
+
+		ctx.Request = ctx.Request.WithContext(infra.WithMaxCacheAge(ctx.Request.Context(), time.Duration(seconds)*time.Second))
+		ctx.Next()
+	}
+}
+
+// overloadRetryAfter is how long clients should wait before retrying a request rejected at capacity
+const overloadRetryAfter = time.Second
+
+// RouteLimit bounds the requests of a route group; a zero field leaves that bound off
+type RouteLimit struct {
+	Timeout     time.Duration // Deadline of the request context; handlers stop at their next database or cache call
+	MaxInFlight int           // Requests of the group served at once; more are rejected with 503
+}
+
+// RouteLimits holds the limits of each route group
+type RouteLimits struct {
+	Default RouteLimit // Account, transaction and other customer-facing routes
+	Admin   RouteLimit // Admin routes other than exports and streams
+	Export  RouteLimit // Audit exports and backups, which scan whole tables
+	Stream  RouteLimit // Server-sent event streams; Timeout is ignored, as streams stay open
+}
+
+// ConcurrencyLimitMiddleware caps the requests a route group serves at once, so a burst of slow
+// requests queues in clients rather than on database connections. Requests over the cap are rejected
+// straight away with 503 SERVICE_OVERLOADED and Retry-After; a cap of 0 is unlimited.
+func ConcurrencyLimitMiddleware(group string, maxInFlight int, logger infra.Logger) gin.HandlerFunc {
+	if maxInFlight <= 0 {
+		return func(ctx *gin.Context) { ctx.Next() }
+	}
+
+	slots := make(chan struct{}, maxInFlight)
+	return func(ctx *gin.Context) {
+		select {
+		case slots <- struct{}{}:
+			defer func() { <-slots }()
+		default:
+			logger.Warn("Route group at capacity, request rejected",
+				"group", group,
+				"maxInFlight", maxInFlight,
+				"path", ctx.Request.URL.Path,
+				"method", ctx.Request.Method,
+			)
+			ctx.Set(retryAfterKey, overloadRetryAfter)
+			HandleError(ctx, errs.ErrServiceOverloaded)
+			ctx.Abort()
+			return
+		}
+
+		ctx.Next()
+	}
+}
+
+// TimeoutMiddleware puts a deadline on the request context; requests that run out of time fail with
+// 503 REQUEST_TIMEOUT. A timeout of 0 leaves requests without a deadline.
+func TimeoutMiddleware(group string, timeout time.Duration, logger infra.Logger) gin.HandlerFunc {
+	if timeout <= 0 {
+		return func(ctx *gin.Context) { ctx.Next() }
+	}
+
+	return func(ctx *gin.Context) {
+		timeoutCtx, cancel := context.WithTimeout(ctx.Request.Context(), timeout)
+		defer cancel()
+		ctx.Request = ctx.Request.WithContext(timeoutCtx)
+
+		ctx.Next()
+
+		// A handler that gave up on the deadline without responding still owes the client an answer
+		if !ctx.Writer.Written() && errors.Is(timeoutCtx.Err(), context.DeadlineExceeded) {
+			logger.Warn("Request timed out",
+				"group", group,
+				"timeout", timeout,
+				"path", ctx.Request.URL.Path,
+				"method", ctx.Request.Method,
+			)
+			HandleError(ctx, context.DeadlineExceeded)
+		}
+	}
+}
+
 // RequestIDMiddleware adds a unique request ID to each request
 func RequestIDMiddleware() gin.HandlerFunc {
 	return func(ctx *gin.Context) {
@@ -124,6 +357,15 @@ func RequestIDMiddleware() gin.HandlerFunc {
 
 		ctx.Set("requestID", requestID)
 		ctx.Header("X-Request-ID", requestID)
+
+		// Carry the ID into use cases and repositories, e.g. for query logs
+		requestCtx := infra.WithRequestID(ctx.Request.Context(), requestID)
+
+		// Outbound calls made for the request join the caller's trace, if it sent one
+		if traceParent := ctx.GetHeader("traceparent"); traceParent != "" {
+			requestCtx = infra.WithTraceParent(requestCtx, traceParent)
+		}
+		ctx.Request = ctx.Request.WithContext(requestCtx)
 		ctx.Next()
 	}
 }
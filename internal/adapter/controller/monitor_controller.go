@@ -0,0 +1,92 @@
+package controller
+
+import (
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	usecase "github.com/hydr0g3nz/mini_bank/internal/application"
+	"github.com/hydr0g3nz/mini_bank/internal/application/dto"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/infra"
+)
+
+// liveHeartbeatInterval keeps idle streams open through proxies
+const liveHeartbeatInterval = 15 * time.Second
+
+type MonitorController struct {
+	monitorUseCase usecase.TransactionMonitorUseCase
+	logger         infra.Logger
+}
+
+func NewMonitorController(monitorUseCase usecase.TransactionMonitorUseCase, logger infra.Logger) *MonitorController {
+	return &MonitorController{
+		monitorUseCase: monitorUseCase,
+		logger:         logger,
+	}
+}
+
+// Routes declares the monitor routes
+func (c *MonitorController) Routes() []Route {
+	return []Route{
+		{Method: http.MethodGet, Path: "/admin/transactions/live", Handler: c.StreamLiveTransactions, Summary: "Stream new and completed transactions", Limit: LimitStream},
+	}
+}
+
+// StreamLiveTransactions streams newly created and completed transactions as server-sent events
+func (c *MonitorController) StreamLiveTransactions(ctx *gin.Context) {
+	req := dto.LiveTransactionRequest{
+		Status:          ctx.Query("status"),
+		TransactionType: ctx.Query("type"),
+	}
+	if value := ctx.Query("min_amount"); value != "" {
+		minAmount, err := dto.ParseDecimalString(value)
+		if err != nil {
+			HandleError(ctx, &ValidationError{Field: "min_amount", Message: "min_amount must be a decimal number"})
+			return
+		}
+		req.MinAmount = &minAmount
+	}
+
+	// Validate request
+	if err := ValidateStruct(req); err != nil {
+		c.logger.Error("Validation failed", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+
+	stream, err := c.monitorUseCase.WatchTransactions(ctx.Request.Context(), req)
+	if err != nil {
+		c.logger.Error("Failed to watch transactions", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+
+	// The stream outlives the server's write timeout
+	if err := http.NewResponseController(ctx.Writer).SetWriteDeadline(time.Time{}); err != nil {
+		c.logger.Warn("Failed to clear write deadline for live stream", "error", err)
+	}
+
+	ctx.Header("Cache-Control", "no-cache")
+	ctx.Header("Connection", "keep-alive")
+	ctx.Header("X-Accel-Buffering", "no")
+
+	heartbeat := time.NewTicker(liveHeartbeatInterval)
+	defer heartbeat.Stop()
+
+	c.logger.Info("Live transaction stream opened", "status", req.Status, "type", req.TransactionType, "ip", ctx.ClientIP())
+	ctx.Stream(func(w io.Writer) bool {
+		select {
+		case response, ok := <-stream:
+			if !ok {
+				return false
+			}
+			ctx.SSEvent(response.Event, response)
+			return true
+		case <-heartbeat.C:
+			_, err := io.WriteString(w, ": heartbeat\n\n")
+			return err == nil
+		}
+	})
+	c.logger.Info("Live transaction stream closed", "ip", ctx.ClientIP())
+}
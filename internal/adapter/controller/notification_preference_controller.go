@@ -0,0 +1,86 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	usecase "github.com/hydr0g3nz/mini_bank/internal/application"
+	"github.com/hydr0g3nz/mini_bank/internal/application/dto"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/infra"
+)
+
+type NotificationPreferenceController struct {
+	preferenceUseCase usecase.NotificationPreferenceUseCase
+	logger            infra.Logger
+}
+
+func NewNotificationPreferenceController(preferenceUseCase usecase.NotificationPreferenceUseCase, logger infra.Logger) *NotificationPreferenceController {
+	return &NotificationPreferenceController{
+		preferenceUseCase: preferenceUseCase,
+		logger:            logger,
+	}
+}
+
+// Routes declares the notification preference routes
+func (c *NotificationPreferenceController) Routes() []Route {
+	const path = "/customers/:id/notification-preferences"
+	return []Route{
+		{Method: http.MethodGet, Path: path, Handler: c.GetPreferences, Summary: "Get a customer's notification preferences"},
+		{Method: http.MethodPut, Path: path, Handler: c.UpdatePreferences, Summary: "Replace a customer's notification preferences"},
+		{Method: http.MethodDelete, Path: path, Handler: c.ResetPreferences, Summary: "Turn a customer's notifications off"},
+	}
+}
+
+// GetPreferences retrieves a customer's notification preferences
+func (c *NotificationPreferenceController) GetPreferences(ctx *gin.Context) {
+	customerID := ctx.Param("id")
+
+	response, err := c.preferenceUseCase.GetPreferences(ctx.Request.Context(), customerID)
+	if err != nil {
+		c.logger.Error("Failed to get notification preferences", "error", err, "customerID", customerID)
+		HandleError(ctx, err)
+		return
+	}
+
+	Respond(ctx, http.StatusOK, MsgNotificationPreferencesRetrieved, response)
+}
+
+// UpdatePreferences replaces a customer's notification preferences
+func (c *NotificationPreferenceController) UpdatePreferences(ctx *gin.Context) {
+	var req dto.NotificationPreferenceRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		c.logger.Error("Failed to bind JSON", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+	req.CustomerID = ctx.Param("id")
+
+	// Validate request
+	if err := ValidateStruct(req); err != nil {
+		c.logger.Error("Validation failed", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+
+	response, err := c.preferenceUseCase.UpdatePreferences(ctx.Request.Context(), req)
+	if err != nil {
+		c.logger.Error("Failed to update notification preferences", "error", err, "customerID", req.CustomerID)
+		HandleError(ctx, err)
+		return
+	}
+
+	Respond(ctx, http.StatusOK, MsgNotificationPreferencesUpdated, response)
+}
+
+// ResetPreferences removes a customer's notification preferences
+func (c *NotificationPreferenceController) ResetPreferences(ctx *gin.Context) {
+	customerID := ctx.Param("id")
+
+	if err := c.preferenceUseCase.ResetPreferences(ctx.Request.Context(), customerID); err != nil {
+		c.logger.Error("Failed to reset notification preferences", "error", err, "customerID", customerID)
+		HandleError(ctx, err)
+		return
+	}
+
+	Respond(ctx, http.StatusOK, MsgNotificationPreferencesReset, nil)
+}
@@ -0,0 +1,212 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	usecase "github.com/hydr0g3nz/mini_bank/internal/application"
+	"github.com/hydr0g3nz/mini_bank/internal/application/dto"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/infra"
+)
+
+type NotificationTemplateController struct {
+	templateUseCase usecase.NotificationTemplateUseCase
+	logger          infra.Logger
+}
+
+func NewNotificationTemplateController(templateUseCase usecase.NotificationTemplateUseCase, logger infra.Logger) *NotificationTemplateController {
+	return &NotificationTemplateController{
+		templateUseCase: templateUseCase,
+		logger:          logger,
+	}
+}
+
+// Routes declares the notification template routes
+func (c *NotificationTemplateController) Routes() []Route {
+	const path = "/admin/notification-templates/:event/:channel/:locale"
+	return []Route{
+		{Method: http.MethodGet, Path: "/admin/notification-templates", Handler: c.ListTemplates, Summary: "List the latest version of every notification template", Limit: LimitAdmin},
+		{Method: http.MethodPut, Path: path, Handler: c.PublishTemplate, Summary: "Publish the next version of a notification template", Limit: LimitAdmin},
+		{Method: http.MethodGet, Path: path, Handler: c.GetTemplate, Summary: "Get a version of a notification template", Limit: LimitAdmin},
+		{Method: http.MethodGet, Path: path + "/versions", Handler: c.ListVersions, Summary: "List the versions of a notification template", Limit: LimitAdmin},
+		{Method: http.MethodPost, Path: path + "/rollback", Handler: c.RollbackTemplate, Summary: "Republish an earlier version of a notification template", Limit: LimitAdmin},
+		{Method: http.MethodPost, Path: path + "/preview", Handler: c.PreviewTemplate, Summary: "Render a notification template with sample data", Limit: LimitAdmin},
+		{Method: http.MethodPost, Path: path + "/test-send", Handler: c.TestSend, Summary: "Send a rendered notification template to one recipient", Limit: LimitAdmin},
+	}
+}
+
+// PublishTemplate saves the next version of a notification template
+func (c *NotificationTemplateController) PublishTemplate(ctx *gin.Context) {
+	var req dto.PublishNotificationTemplateRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		c.logger.Error("Failed to bind JSON", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+	req.NotificationTemplateKey = templateKey(ctx)
+
+	actor, err := requestedBy(ctx, req.CreatedBy)
+	if err != nil {
+		c.logger.Error("Request names another admin", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+	req.CreatedBy = actor
+
+	// Validate request
+	if err := ValidateStruct(req); err != nil {
+		c.logger.Error("Validation failed", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+
+	response, err := c.templateUseCase.PublishTemplate(ctx.Request.Context(), req)
+	if err != nil {
+		c.logger.Error("Failed to publish notification template", "error", err, "event", req.Event, "channel", req.Channel, "locale", req.Locale)
+		HandleError(ctx, err)
+		return
+	}
+
+	Respond(ctx, http.StatusOK, MsgNotificationTemplatePublished, response)
+}
+
+// GetTemplate retrieves the version of a notification template in the version query parameter, the latest by default
+func (c *NotificationTemplateController) GetTemplate(ctx *gin.Context) {
+	key := templateKey(ctx)
+	version, _ := strconv.Atoi(ctx.DefaultQuery("version", "0"))
+
+	response, err := c.templateUseCase.GetTemplate(ctx.Request.Context(), key, version)
+	if err != nil {
+		c.logger.Error("Failed to get notification template", "error", err, "event", key.Event, "channel", key.Channel, "locale", key.Locale)
+		HandleError(ctx, err)
+		return
+	}
+
+	Respond(ctx, http.StatusOK, MsgNotificationTemplateRetrieved, response)
+}
+
+// ListTemplates retrieves the latest version of every notification template
+func (c *NotificationTemplateController) ListTemplates(ctx *gin.Context) {
+	response, err := c.templateUseCase.ListTemplates(ctx.Request.Context())
+	if err != nil {
+		c.logger.Error("Failed to list notification templates", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+
+	Respond(ctx, http.StatusOK, MsgNotificationTemplatesRetrieved, response)
+}
+
+// ListVersions retrieves every version of a notification template, newest first
+func (c *NotificationTemplateController) ListVersions(ctx *gin.Context) {
+	key := templateKey(ctx)
+
+	response, err := c.templateUseCase.ListVersions(ctx.Request.Context(), key)
+	if err != nil {
+		c.logger.Error("Failed to list notification template versions", "error", err, "event", key.Event, "channel", key.Channel, "locale", key.Locale)
+		HandleError(ctx, err)
+		return
+	}
+
+	Respond(ctx, http.StatusOK, MsgNotificationTemplatesRetrieved, response)
+}
+
+// RollbackTemplate republishes the content of an earlier version as the next version
+func (c *NotificationTemplateController) RollbackTemplate(ctx *gin.Context) {
+	var req dto.RollbackNotificationTemplateRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		c.logger.Error("Failed to bind JSON", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+	req.NotificationTemplateKey = templateKey(ctx)
+
+	actor, err := requestedBy(ctx, req.CreatedBy)
+	if err != nil {
+		c.logger.Error("Request names another admin", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+	req.CreatedBy = actor
+
+	// Validate request
+	if err := ValidateStruct(req); err != nil {
+		c.logger.Error("Validation failed", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+
+	response, err := c.templateUseCase.RollbackTemplate(ctx.Request.Context(), req)
+	if err != nil {
+		c.logger.Error("Failed to roll back notification template", "error", err, "event", req.Event, "channel", req.Channel, "locale", req.Locale, "version", req.Version)
+		HandleError(ctx, err)
+		return
+	}
+
+	Respond(ctx, http.StatusOK, MsgNotificationTemplatePublished, response)
+}
+
+// PreviewTemplate renders a notification template with sample data
+func (c *NotificationTemplateController) PreviewTemplate(ctx *gin.Context) {
+	var req dto.PreviewNotificationTemplateRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		c.logger.Error("Failed to bind JSON", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+	req.NotificationTemplateKey = templateKey(ctx)
+
+	// Validate request
+	if err := ValidateStruct(req); err != nil {
+		c.logger.Error("Validation failed", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+
+	response, err := c.templateUseCase.PreviewTemplate(ctx.Request.Context(), req)
+	if err != nil {
+		c.logger.Error("Failed to preview notification template", "error", err, "event", req.Event, "channel", req.Channel, "locale", req.Locale)
+		HandleError(ctx, err)
+		return
+	}
+
+	Respond(ctx, http.StatusOK, MsgNotificationTemplatePreviewed, response)
+}
+
+// TestSend renders a notification template and sends it to one recipient
+func (c *NotificationTemplateController) TestSend(ctx *gin.Context) {
+	var req dto.TestSendNotificationRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		c.logger.Error("Failed to bind JSON", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+	req.NotificationTemplateKey = templateKey(ctx)
+
+	// Validate request
+	if err := ValidateStruct(req); err != nil {
+		c.logger.Error("Validation failed", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+
+	response, err := c.templateUseCase.TestSend(ctx.Request.Context(), req)
+	if err != nil {
+		c.logger.Error("Failed to send test notification", "error", err, "event", req.Event, "channel", req.Channel, "locale", req.Locale)
+		HandleError(ctx, err)
+		return
+	}
+
+	Respond(ctx, http.StatusOK, MsgNotificationTestSent, response)
+}
+
+// templateKey reads the notification template key from the path
+func templateKey(ctx *gin.Context) dto.NotificationTemplateKey {
+	return dto.NotificationTemplateKey{
+		Event:   ctx.Param("event"),
+		Channel: strings.ToUpper(ctx.Param("channel")),
+		Locale:  ctx.Param("locale"),
+	}
+}
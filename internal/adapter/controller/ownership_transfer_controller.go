@@ -0,0 +1,179 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	usecase "github.com/hydr0g3nz/mini_bank/internal/application"
+	"github.com/hydr0g3nz/mini_bank/internal/application/dto"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/infra"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+)
+
+type OwnershipTransferController struct {
+	transferUseCase usecase.OwnershipTransferUseCase
+	logger          infra.Logger
+}
+
+func NewOwnershipTransferController(transferUseCase usecase.OwnershipTransferUseCase, logger infra.Logger) *OwnershipTransferController {
+	return &OwnershipTransferController{
+		transferUseCase: transferUseCase,
+		logger:          logger,
+	}
+}
+
+// Routes declares the ownership transfer routes
+func (c *OwnershipTransferController) Routes() []Route {
+	return []Route{
+		{Method: http.MethodPost, Path: "/admin/accounts/:id/ownership-transfers", Handler: c.RequestOwnershipTransfer, Summary: "Request moving an account to another customer", Limit: LimitAdmin, Permission: vo.AdminPermissionMutateAccounts},
+		{Method: http.MethodGet, Path: "/admin/ownership-transfers", Handler: c.ListOwnershipTransfers, Summary: "List ownership transfers", Limit: LimitAdmin, Permission: vo.AdminPermissionViewAccounts},
+		{Method: http.MethodGet, Path: "/admin/ownership-transfers/:id", Handler: c.GetOwnershipTransfer, Summary: "Get an ownership transfer", Limit: LimitAdmin, Permission: vo.AdminPermissionViewAccounts},
+		{Method: http.MethodPost, Path: "/admin/ownership-transfers/:id/approve", Handler: c.ApproveOwnershipTransfer, Summary: "Approve an ownership transfer requested by another admin", Limit: LimitAdmin, Permission: vo.AdminPermissionMutateAccounts},
+		{Method: http.MethodPost, Path: "/admin/ownership-transfers/:id/reject", Handler: c.RejectOwnershipTransfer, Summary: "Reject or withdraw an ownership transfer", Limit: LimitAdmin, Permission: vo.AdminPermissionMutateAccounts},
+	}
+}
+
+// RequestOwnershipTransfer requests moving an account to another customer, pending a second admin's approval
+func (c *OwnershipTransferController) RequestOwnershipTransfer(ctx *gin.Context) {
+	var req dto.CreateOwnershipTransferRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		c.logger.Error("Failed to bind JSON", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+	req.AccountID = ctx.Param("id")
+
+	actor, err := requestedBy(ctx, req.RequestedBy)
+	if err != nil {
+		c.logger.Error("Request names another admin", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+	req.RequestedBy = actor
+
+	// Validate request
+	if err := ValidateStruct(req); err != nil {
+		c.logger.Error("Validation failed", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+
+	response, err := c.transferUseCase.RequestOwnershipTransfer(ctx.Request.Context(), req)
+	if err != nil {
+		c.logger.Error("Failed to request ownership transfer", "error", err, "accountID", req.AccountID)
+		HandleError(ctx, err)
+		return
+	}
+
+	Respond(ctx, http.StatusCreated, MsgOwnershipTransferRequested, response)
+}
+
+// ListOwnershipTransfers retrieves ownership transfers, optionally of an account, a customer or a status
+func (c *OwnershipTransferController) ListOwnershipTransfers(ctx *gin.Context) {
+	// Parse query parameters
+	page, _ := strconv.Atoi(ctx.DefaultQuery("page", "1"))
+	pageSize, _ := strconv.Atoi(ctx.DefaultQuery("page_size", "10"))
+
+	req := dto.OwnershipTransferListRequest{
+		Page:       page,
+		PageSize:   pageSize,
+		AccountID:  ctx.Query("account_id"),
+		CustomerID: ctx.Query("customer_id"),
+		Status:     strings.ToUpper(ctx.Query("status")),
+	}
+
+	// Validate request
+	if err := ValidateStruct(req); err != nil {
+		c.logger.Error("Validation failed", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+
+	response, err := c.transferUseCase.ListOwnershipTransfers(ctx.Request.Context(), req)
+	if err != nil {
+		c.logger.Error("Failed to list ownership transfers", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+
+	SetPaginationHeaders(ctx, response.Pagination)
+	Respond(ctx, http.StatusOK, MsgOwnershipTransfersRetrieved, response)
+}
+
+// GetOwnershipTransfer retrieves an ownership transfer
+func (c *OwnershipTransferController) GetOwnershipTransfer(ctx *gin.Context) {
+	id := ctx.Param("id")
+
+	response, err := c.transferUseCase.GetOwnershipTransfer(ctx.Request.Context(), id)
+	if err != nil {
+		c.logger.Error("Failed to get ownership transfer", "error", err, "transferID", id)
+		HandleError(ctx, err)
+		return
+	}
+
+	Respond(ctx, http.StatusOK, MsgOwnershipTransferRetrieved, response)
+}
+
+// ApproveOwnershipTransfer approves an ownership transfer; one already effective is applied at once
+func (c *OwnershipTransferController) ApproveOwnershipTransfer(ctx *gin.Context) {
+	req, ok := c.bindDecision(ctx)
+	if !ok {
+		return
+	}
+
+	response, err := c.transferUseCase.ApproveOwnershipTransfer(ctx.Request.Context(), req)
+	if err != nil {
+		c.logger.Error("Failed to approve ownership transfer", "error", err, "transferID", req.ID)
+		HandleError(ctx, err)
+		return
+	}
+
+	Respond(ctx, http.StatusOK, MsgOwnershipTransferApproved, response)
+}
+
+// RejectOwnershipTransfer rejects a pending ownership transfer or withdraws an approved one
+func (c *OwnershipTransferController) RejectOwnershipTransfer(ctx *gin.Context) {
+	req, ok := c.bindDecision(ctx)
+	if !ok {
+		return
+	}
+
+	response, err := c.transferUseCase.RejectOwnershipTransfer(ctx.Request.Context(), req)
+	if err != nil {
+		c.logger.Error("Failed to reject ownership transfer", "error", err, "transferID", req.ID)
+		HandleError(ctx, err)
+		return
+	}
+
+	Respond(ctx, http.StatusOK, MsgOwnershipTransferRejected, response)
+}
+
+// bindDecision binds and validates an admin's decision on the ownership transfer in the path
+func (c *OwnershipTransferController) bindDecision(ctx *gin.Context) (dto.OwnershipTransferDecisionRequest, bool) {
+	var req dto.OwnershipTransferDecisionRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		c.logger.Error("Failed to bind JSON", "error", err)
+		HandleError(ctx, err)
+		return req, false
+	}
+	req.ID = ctx.Param("id")
+
+	actor, err := requestedBy(ctx, req.Admin)
+	if err != nil {
+		c.logger.Error("Request names another admin", "error", err)
+		HandleError(ctx, err)
+		return req, false
+	}
+	req.Admin = actor
+
+	// Validate request
+	if err := ValidateStruct(req); err != nil {
+		c.logger.Error("Validation failed", "error", err)
+		HandleError(ctx, err)
+		return req, false
+	}
+
+	return req, true
+}
@@ -0,0 +1,111 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	usecase "github.com/hydr0g3nz/mini_bank/internal/application"
+	"github.com/hydr0g3nz/mini_bank/internal/application/dto"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/infra"
+)
+
+type ProductController struct {
+	productUseCase usecase.ProductUseCase
+	logger         infra.Logger
+}
+
+func NewProductController(productUseCase usecase.ProductUseCase, logger infra.Logger) *ProductController {
+	return &ProductController{
+		productUseCase: productUseCase,
+		logger:         logger,
+	}
+}
+
+// Routes declares the product routes
+func (c *ProductController) Routes() []Route {
+	return []Route{
+		{Method: http.MethodGet, Path: "/products", Handler: c.ListProducts, Summary: "List the product catalog"},
+		{Method: http.MethodGet, Path: "/products/:id", Handler: c.GetProduct, Summary: "Get a product"},
+		{Method: http.MethodPost, Path: "/admin/products", Handler: c.CreateProduct, Summary: "Add a product", Limit: LimitAdmin},
+		{Method: http.MethodPut, Path: "/admin/products/:id", Handler: c.UpdateProduct, Summary: "Update a product", Limit: LimitAdmin},
+	}
+}
+
+// CreateProduct adds a product to the catalog
+func (c *ProductController) CreateProduct(ctx *gin.Context) {
+	var req dto.CreateProductRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		c.logger.Error("Failed to bind JSON", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+
+	// Validate request
+	if err := ValidateStruct(req); err != nil {
+		c.logger.Error("Validation failed", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+
+	response, err := c.productUseCase.CreateProduct(ctx.Request.Context(), req)
+	if err != nil {
+		c.logger.Error("Failed to create product", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+
+	Respond(ctx, http.StatusCreated, MsgProductCreated, response)
+}
+
+// GetProduct retrieves a product by ID
+func (c *ProductController) GetProduct(ctx *gin.Context) {
+	id := ctx.Param("id")
+
+	response, err := c.productUseCase.GetProduct(ctx.Request.Context(), id)
+	if err != nil {
+		c.logger.Error("Failed to get product", "error", err, "productID", id)
+		HandleError(ctx, err)
+		return
+	}
+
+	Respond(ctx, http.StatusOK, MsgProductRetrieved, response)
+}
+
+// UpdateProduct changes a product's terms or retires it
+func (c *ProductController) UpdateProduct(ctx *gin.Context) {
+	var req dto.UpdateProductRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		c.logger.Error("Failed to bind JSON", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+	req.ID = ctx.Param("id")
+
+	// Validate request
+	if err := ValidateStruct(req); err != nil {
+		c.logger.Error("Validation failed", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+
+	response, err := c.productUseCase.UpdateProduct(ctx.Request.Context(), req)
+	if err != nil {
+		c.logger.Error("Failed to update product", "error", err, "productID", req.ID)
+		HandleError(ctx, err)
+		return
+	}
+
+	Respond(ctx, http.StatusOK, MsgProductUpdated, response)
+}
+
+// ListProducts retrieves the product catalog
+func (c *ProductController) ListProducts(ctx *gin.Context) {
+	response, err := c.productUseCase.ListProducts(ctx.Request.Context())
+	if err != nil {
+		c.logger.Error("Failed to list products", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+
+	Respond(ctx, http.StatusOK, MsgProductsRetrieved, response)
+}
@@ -0,0 +1,116 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	usecase "github.com/hydr0g3nz/mini_bank/internal/application"
+	"github.com/hydr0g3nz/mini_bank/internal/application/dto"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/infra"
+)
+
+type ProductMigrationController struct {
+	migrationUseCase usecase.ProductMigrationUseCase
+	logger           infra.Logger
+}
+
+func NewProductMigrationController(migrationUseCase usecase.ProductMigrationUseCase, logger infra.Logger) *ProductMigrationController {
+	return &ProductMigrationController{
+		migrationUseCase: migrationUseCase,
+		logger:           logger,
+	}
+}
+
+// Routes declares the product migration routes
+func (c *ProductMigrationController) Routes() []Route {
+	return []Route{
+		{Method: http.MethodPost, Path: "/admin/product-migrations", Handler: c.CreateProductMigration, Summary: "Move accounts in bulk to another product, or dry-run it", Limit: LimitAdmin},
+		{Method: http.MethodGet, Path: "/admin/product-migrations", Handler: c.ListProductMigrations, Summary: "List product migrations", Limit: LimitAdmin},
+		{Method: http.MethodGet, Path: "/admin/product-migrations/:id", Handler: c.GetProductMigration, Summary: "Get a product migration with its per-account report", Limit: LimitAdmin},
+	}
+}
+
+// CreateProductMigration schedules a product migration, or reports what it would do for a dry run
+func (c *ProductMigrationController) CreateProductMigration(ctx *gin.Context) {
+	var req dto.CreateProductMigrationRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		c.logger.Error("Failed to bind JSON", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+
+	actor, err := requestedBy(ctx, req.RequestedBy)
+	if err != nil {
+		c.logger.Error("Request names another admin", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+	req.RequestedBy = actor
+
+	// Validate request
+	if err := ValidateStruct(req); err != nil {
+		c.logger.Error("Validation failed", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+
+	response, err := c.migrationUseCase.CreateProductMigration(ctx.Request.Context(), req)
+	if err != nil {
+		c.logger.Error("Failed to create product migration", "error", err, "fromProductID", req.FromProductID, "toProductID", req.ToProductID)
+		HandleError(ctx, err)
+		return
+	}
+
+	if req.DryRun {
+		Respond(ctx, http.StatusOK, MsgProductMigrationDryRun, response)
+		return
+	}
+	Respond(ctx, http.StatusCreated, MsgProductMigrationCreated, response)
+}
+
+// ListProductMigrations retrieves product migrations, optionally of a product or a status
+func (c *ProductMigrationController) ListProductMigrations(ctx *gin.Context) {
+	// Parse query parameters
+	page, _ := strconv.Atoi(ctx.DefaultQuery("page", "1"))
+	pageSize, _ := strconv.Atoi(ctx.DefaultQuery("page_size", "10"))
+
+	req := dto.ProductMigrationListRequest{
+		Page:      page,
+		PageSize:  pageSize,
+		ProductID: ctx.Query("product_id"),
+		Status:    strings.ToUpper(ctx.Query("status")),
+	}
+
+	// Validate request
+	if err := ValidateStruct(req); err != nil {
+		c.logger.Error("Validation failed", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+
+	response, err := c.migrationUseCase.ListProductMigrations(ctx.Request.Context(), req)
+	if err != nil {
+		c.logger.Error("Failed to list product migrations", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+
+	SetPaginationHeaders(ctx, response.Pagination)
+	Respond(ctx, http.StatusOK, MsgProductMigrationsRetrieved, response)
+}
+
+// GetProductMigration retrieves a product migration with its per-account report
+func (c *ProductMigrationController) GetProductMigration(ctx *gin.Context) {
+	id := ctx.Param("id")
+
+	response, err := c.migrationUseCase.GetProductMigration(ctx.Request.Context(), id)
+	if err != nil {
+		c.logger.Error("Failed to get product migration", "error", err, "migrationID", id)
+		HandleError(ctx, err)
+		return
+	}
+
+	Respond(ctx, http.StatusOK, MsgProductMigrationRetrieved, response)
+}
@@ -0,0 +1,102 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	usecase "github.com/hydr0g3nz/mini_bank/internal/application"
+	"github.com/hydr0g3nz/mini_bank/internal/application/dto"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/infra"
+)
+
+type ReferralController struct {
+	referralUseCase usecase.ReferralUseCase
+	logger          infra.Logger
+}
+
+func NewReferralController(referralUseCase usecase.ReferralUseCase, logger infra.Logger) *ReferralController {
+	return &ReferralController{
+		referralUseCase: referralUseCase,
+		logger:          logger,
+	}
+}
+
+// Routes declares the referral routes
+func (c *ReferralController) Routes() []Route {
+	return []Route{
+		{Method: http.MethodPost, Path: "/accounts/:id/referral-code", Handler: c.GetReferralCode, Summary: "Get an account's referral code"},
+		{Method: http.MethodGet, Path: "/accounts/:id/referrals", Handler: c.ListReferrals, Summary: "List the referrals an account made"},
+		{Method: http.MethodPost, Path: "/referrals/redeem", Handler: c.RedeemReferral, Summary: "Redeem a referral code"},
+	}
+}
+
+// GetReferralCode retrieves the referral code of an account, creating it on first use
+func (c *ReferralController) GetReferralCode(ctx *gin.Context) {
+	accountID := ctx.Param("id")
+
+	response, err := c.referralUseCase.GetReferralCode(ctx.Request.Context(), accountID)
+	if err != nil {
+		c.logger.Error("Failed to get referral code", "error", err, "accountID", accountID)
+		HandleError(ctx, err)
+		return
+	}
+
+	Respond(ctx, http.StatusOK, MsgReferralCodeRetrieved, response)
+}
+
+// RedeemReferral redeems a referral code for an account
+func (c *ReferralController) RedeemReferral(ctx *gin.Context) {
+	var req dto.RedeemReferralRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		c.logger.Error("Failed to bind JSON", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+
+	// Validate request
+	if err := ValidateStruct(req); err != nil {
+		c.logger.Error("Validation failed", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+
+	response, err := c.referralUseCase.RedeemReferral(ctx.Request.Context(), req)
+	if err != nil {
+		c.logger.Error("Failed to redeem referral code", "error", err, "accountID", req.AccountID)
+		HandleError(ctx, err)
+		return
+	}
+
+	Respond(ctx, http.StatusCreated, MsgReferralRedeemed, response)
+}
+
+// ListReferrals retrieves the referrals an account made
+func (c *ReferralController) ListReferrals(ctx *gin.Context) {
+	accountID := ctx.Param("id")
+
+	page, _ := strconv.Atoi(ctx.DefaultQuery("page", "1"))
+	pageSize, _ := strconv.Atoi(ctx.DefaultQuery("page_size", "10"))
+
+	req := dto.ListRequest{
+		Page:     page,
+		PageSize: pageSize,
+	}
+
+	// Validate request
+	if err := ValidateStruct(req); err != nil {
+		c.logger.Error("Validation failed", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+
+	response, err := c.referralUseCase.ListReferrals(ctx.Request.Context(), accountID, req)
+	if err != nil {
+		c.logger.Error("Failed to list referrals", "error", err, "accountID", accountID)
+		HandleError(ctx, err)
+		return
+	}
+
+	SetPaginationHeaders(ctx, response.Pagination)
+	Respond(ctx, http.StatusOK, MsgReferralsRetrieved, response)
+}
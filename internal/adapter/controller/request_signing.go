@@ -0,0 +1,201 @@
+package controller
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/hydr0g3nz/mini_bank/internal/application/dto"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/infra"
+)
+
+const (
+	// SignatureHeader carries the hex HMAC-SHA256 of the request's canonical string
+	SignatureHeader = "X-Signature"
+	// SignatureTimestampHeader carries the Unix time in seconds the request was signed at
+	SignatureTimestampHeader = "X-Signature-Timestamp"
+	// SignatureNonceHeader carries a value the client picks at random for every signed request
+	SignatureNonceHeader = "X-Signature-Nonce"
+
+	// ServiceSigningKeyID names the service API key in the signing keys; admin keys are named by the
+	// admin user's ID
+	ServiceSigningKeyID = "service"
+
+	// minNonceLength and maxNonceLength bound the nonces clients may send
+	minNonceLength = 16
+	maxNonceLength = 128
+
+	// maxSignedBodySize bounds the body read into memory before its signature is checked: the largest
+	// body any route accepts, an attachment upload
+	maxSignedBodySize = maxAttachmentBodySize
+)
+
+// RequestSigning holds the signing secrets of the API keys that must sign their requests and how
+// old a signed request may be
+type RequestSigning struct {
+	Keys      infra.SecretValue  // Comma-separated KEY_ID:SECRET entries, see ParseSigningKeys; read on every request, so a rotation applies to the next one
+	Tolerance time.Duration      // How far a request's timestamp may be from the server's clock
+	Nonces    infra.CounterStore // Remembers the nonces used within the tolerance; nil turns signing off
+}
+
+// ParseSigningKeys parses comma-separated KEY_ID:SECRET entries into the signing secret of each API
+// key, where the key ID is ServiceSigningKeyID or an admin user's ID
+func ParseSigningKeys(value string) (map[string]string, error) {
+	keys := make(map[string]string)
+	for _, entry := range strings.Split(value, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		keyID, secret, ok := strings.Cut(entry, ":")
+		keyID, secret = strings.TrimSpace(keyID), strings.TrimSpace(secret)
+		if !ok || keyID == "" || secret == "" {
+			return nil, fmt.Errorf("signing key %q must be KEY_ID:SECRET", entry)
+		}
+		if _, seen := keys[keyID]; seen {
+			return nil, fmt.Errorf("signing key %s is listed twice", keyID)
+		}
+		keys[keyID] = secret
+	}
+	return keys, nil
+}
+
+// SigningString returns the canonical string a request is signed over: the method, the path with
+// its query, the timestamp, the nonce and the hex SHA-256 of the body, one per line
+func SigningString(method, requestURI, timestamp, nonce string, body []byte) string {
+	hash := sha256.Sum256(body)
+	return strings.Join([]string{method, requestURI, timestamp, nonce, hex.EncodeToString(hash[:])}, "\n")
+}
+
+// SignRequest returns the signature of a request under secret, as sent in X-Signature
+func SignRequest(secret, method, requestURI, timestamp, nonce string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(SigningString(method, requestURI, timestamp, nonce, body)))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// RequestSigningMiddleware makes the API keys listed in the signing keys sign the requests that
+// change anything, so a captured money-movement request cannot be sent again. It runs after
+// APIKeyMiddleware to know which key called. A signed request must carry a timestamp within the
+// tolerance and a nonce that key has not used within it; nonces are remembered in the counter store,
+// and requests are rejected while it fails. Reads and keys without a signing secret are let through.
+func RequestSigningMiddleware(signing RequestSigning, logger infra.Logger) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		switch ctx.Request.Method {
+		case http.MethodGet, http.MethodHead, http.MethodOptions:
+			ctx.Next()
+			return
+		}
+
+		keyID := ServiceSigningKeyID
+		if admin := AdminFromContext(ctx); admin != nil {
+			keyID = admin.ID
+		}
+		keys, err := ParseSigningKeys(signing.Keys.Value())
+		if err != nil {
+			// Validated at startup, so only a rotated value can be malformed
+			logger.Error("Failed to parse request signing keys", "error", err)
+			abortSigning(ctx, http.StatusServiceUnavailable, "REQUEST_SIGNING_UNAVAILABLE", "Request signatures cannot be verified right now")
+			return
+		}
+		secret, ok := keys[keyID]
+		if !ok {
+			ctx.Next()
+			return
+		}
+
+		signature := ctx.GetHeader(SignatureHeader)
+		timestamp := ctx.GetHeader(SignatureTimestampHeader)
+		nonce := ctx.GetHeader(SignatureNonceHeader)
+		if signature == "" || timestamp == "" || nonce == "" {
+			abortSigning(ctx, http.StatusUnauthorized, "MISSING_SIGNATURE",
+				fmt.Sprintf("This API key must sign its requests with the %s, %s and %s headers", SignatureHeader, SignatureTimestampHeader, SignatureNonceHeader))
+			return
+		}
+		if len(nonce) < minNonceLength || len(nonce) > maxNonceLength {
+			abortSigning(ctx, http.StatusUnauthorized, "INVALID_SIGNATURE",
+				fmt.Sprintf("%s must be %d to %d characters", SignatureNonceHeader, minNonceLength, maxNonceLength))
+			return
+		}
+
+		seconds, err := strconv.ParseInt(timestamp, 10, 64)
+		if err != nil {
+			abortSigning(ctx, http.StatusUnauthorized, "INVALID_SIGNATURE", fmt.Sprintf("%s must be a Unix time in seconds", SignatureTimestampHeader))
+			return
+		}
+		if skew := time.Since(time.Unix(seconds, 0)); skew > signing.Tolerance || skew < -signing.Tolerance {
+			logger.Warn("Signed request outside the timestamp tolerance",
+				"keyID", keyID,
+				"skew", skew,
+				"path", ctx.Request.URL.Path,
+				"method", ctx.Request.Method,
+			)
+			abortSigning(ctx, http.StatusUnauthorized, "SIGNATURE_EXPIRED",
+				fmt.Sprintf("The request was signed more than %s from the server's clock", signing.Tolerance))
+			return
+		}
+
+		body, err := io.ReadAll(http.MaxBytesReader(ctx.Writer, ctx.Request.Body, maxSignedBodySize))
+		if err != nil {
+			var maxBytesErr *http.MaxBytesError
+			if errors.As(err, &maxBytesErr) {
+				abortSigning(ctx, http.StatusRequestEntityTooLarge, "REQUEST_TOO_LARGE",
+					fmt.Sprintf("The request body must not exceed %d bytes", maxSignedBodySize))
+				return
+			}
+			HandleError(ctx, &ValidationError{Field: "body", Message: "request body could not be read"})
+			ctx.Abort()
+			return
+		}
+		ctx.Request.Body = io.NopCloser(bytes.NewReader(body))
+
+		expected := SignRequest(secret, ctx.Request.Method, ctx.Request.URL.RequestURI(), timestamp, nonce, body)
+		if !hmac.Equal([]byte(strings.ToLower(signature)), []byte(expected)) {
+			logger.Warn("Invalid request signature",
+				"keyID", keyID,
+				"path", ctx.Request.URL.Path,
+				"method", ctx.Request.Method,
+				"ip", ctx.ClientIP(),
+			)
+			abortSigning(ctx, http.StatusUnauthorized, "INVALID_SIGNATURE", "The request signature does not match")
+			return
+		}
+
+		// A nonce is kept for twice the tolerance, as long as a timestamp it was signed with is accepted
+		uses, err := signing.Nonces.IncrementBy(ctx.Request.Context(), fmt.Sprintf("request_nonce:%s:%s", keyID, nonce), 1, 2*signing.Tolerance)
+		if err != nil {
+			logger.Error("Failed to record request nonce", "error", err, "keyID", keyID, "path", ctx.Request.URL.Path)
+			abortSigning(ctx, http.StatusServiceUnavailable, "REQUEST_SIGNING_UNAVAILABLE", "Request signatures cannot be verified right now")
+			return
+		}
+		if uses > 1 {
+			logger.Warn("Replayed signed request rejected",
+				"keyID", keyID,
+				"path", ctx.Request.URL.Path,
+				"method", ctx.Request.Method,
+				"ip", ctx.ClientIP(),
+			)
+			abortSigning(ctx, http.StatusUnauthorized, "REPLAYED_REQUEST", fmt.Sprintf("%s was already used", SignatureNonceHeader))
+			return
+		}
+
+		ctx.Next()
+	}
+}
+
+// abortSigning answers a request whose signature was not accepted
+func abortSigning(ctx *gin.Context, status int, code, message string) {
+	abortWithJSON(ctx, status, dto.ErrorResponse{
+		Code:    code,
+		Message: message,
+	})
+}
@@ -0,0 +1,86 @@
+package controller
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/hydr0g3nz/mini_bank/internal/application/dto"
+)
+
+const (
+	// EnvelopeHeader lets a client choose per request whether success responses
+	// are wrapped in dto.SuccessResponse ("true") or returned bare ("false")
+	EnvelopeHeader = "X-Response-Envelope"
+
+	envelopeContextKey = "responseEnvelope"
+)
+
+// EnvelopeMiddleware resolves whether success responses are enveloped, honouring
+// the request header over the configured default
+func EnvelopeMiddleware(enabled bool) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		envelope := enabled
+		if value := ctx.GetHeader(EnvelopeHeader); value != "" {
+			if parsed, err := strconv.ParseBool(value); err == nil {
+				envelope = parsed
+			}
+		}
+
+		ctx.Set(envelopeContextKey, envelope)
+		ctx.Next()
+	}
+}
+
+// Respond writes a success response. Enveloped responses carry the catalog message
+// and the data; bare responses carry only the data, or no content when there is none.
+func Respond(ctx *gin.Context, status int, key MessageKey, data interface{}) {
+	if envelope, exists := ctx.Get(envelopeContextKey); exists && !envelope.(bool) {
+		if data == nil {
+			ctx.Status(http.StatusNoContent)
+			return
+		}
+		renderJSON(ctx, status, data)
+		return
+	}
+
+	renderJSON(ctx, status, dto.SuccessResponse{
+		Message: Message(key),
+		Data:    data,
+	})
+}
+
+// SetPaginationHeaders emits X-Total-Count and RFC 5988 Link headers (first, prev,
+// next, last) for a page of a list, keeping the request's other query parameters
+func SetPaginationHeaders(ctx *gin.Context, pagination dto.PaginationInfo) {
+	ctx.Header("X-Total-Count", strconv.FormatInt(pagination.TotalItems, 10))
+
+	lastPage := pagination.TotalPages
+	if lastPage < 1 {
+		lastPage = 1
+	}
+
+	links := []string{pageLink(ctx, 1, pagination.PageSize, "first")}
+	if pagination.HasPrev {
+		links = append(links, pageLink(ctx, min(pagination.Page-1, lastPage), pagination.PageSize, "prev"))
+	}
+	if pagination.HasNext {
+		links = append(links, pageLink(ctx, pagination.Page+1, pagination.PageSize, "next"))
+	}
+	links = append(links, pageLink(ctx, lastPage, pagination.PageSize, "last"))
+
+	ctx.Header("Link", strings.Join(links, ", "))
+}
+
+// pageLink formats a Link header entry pointing at a page of the current request
+func pageLink(ctx *gin.Context, page, pageSize int, rel string) string {
+	target := *ctx.Request.URL
+	query := target.Query()
+	query.Set("page", strconv.Itoa(page))
+	query.Set("page_size", strconv.Itoa(pageSize))
+	target.RawQuery = query.Encode()
+
+	return fmt.Sprintf("<%s>; rel=\"%s\"", target.RequestURI(), rel)
+}
@@ -0,0 +1,209 @@
+package controller
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+)
+
+// apiPrefix is the path every API key route is mounted under
+const apiPrefix = "/api/v1"
+
+// AuthScope tells what a route requires of its caller
+type AuthScope string
+
+const (
+	AuthAPIKey AuthScope = "api_key" // Requires the x-api-key header
+	AuthPublic AuthScope = "public"  // Open to anyone, e.g. health checks
+)
+
+// LimitClass picks the route group limits a route is served under
+type LimitClass string
+
+const (
+	LimitDefault LimitClass = "default"
+	LimitAdmin   LimitClass = "admin"
+	LimitExport  LimitClass = "export"
+	LimitStream  LimitClass = "stream"
+	LimitNone    LimitClass = "none" // Neither capped nor timed out, e.g. health checks
+)
+
+// Route declares an endpoint and the cross-cutting middleware it needs; the registry applies the
+// middleware, so every route of a class is treated alike
+type Route struct {
+	Method  string
+	Path    string // Gin path under /api/v1 for API key routes, from the root for public ones
+	Handler gin.HandlerFunc
+	Summary string        // One line for the OpenAPI path list
+	Auth    AuthScope     // Defaults to AuthAPIKey
+	Limit   LimitClass    // Defaults to LimitDefault
+	Timeout time.Duration // Replaces the class timeout for this route; 0 keeps it
+
+	// Permission lets admin users with a role granting it call an API key route; without one, only
+	// the service API key may call it
+	Permission vo.AdminPermission
+}
+
+// RouteProvider is implemented by controllers that declare their own routes
+type RouteProvider interface {
+	Routes() []Route
+}
+
+// RouteRegistry collects the routes of every controller and mounts them with their middleware
+type RouteRegistry struct {
+	routes []Route
+}
+
+// NewRouteRegistry creates a registry holding the routes of the given providers
+func NewRouteRegistry(providers ...RouteProvider) *RouteRegistry {
+	registry := &RouteRegistry{}
+	for _, provider := range providers {
+		registry.Add(provider.Routes()...)
+	}
+	return registry
+}
+
+// Add declares routes, filling in the default auth scope and limit class
+func (r *RouteRegistry) Add(routes ...Route) {
+	for _, route := range routes {
+		if route.Auth == "" {
+			route.Auth = AuthAPIKey
+		}
+		if route.Limit == "" {
+			route.Limit = LimitDefault
+		}
+		r.routes = append(r.routes, route)
+	}
+}
+
+// Routes returns the declared routes in declaration order
+func (r *RouteRegistry) Routes() []Route {
+	return r.routes
+}
+
+// Mount registers every route on router. Routes of one limit class share a single in-flight cap;
+// the timeout is applied per route.
+func (r *RouteRegistry) Mount(router *gin.Engine, config RouterConfig) {
+	classLimits := map[LimitClass]RouteLimit{
+		LimitDefault: config.RouteLimits.Default,
+		LimitAdmin:   config.RouteLimits.Admin,
+		LimitExport:  config.RouteLimits.Export,
+		// Streams stay open for as long as the client listens
+		LimitStream: {MaxInFlight: config.RouteLimits.Stream.MaxInFlight},
+	}
+	caps := make(map[LimitClass]gin.HandlerFunc, len(classLimits))
+	for class, limit := range classLimits {
+		caps[class] = ConcurrencyLimitMiddleware(string(class), limit.MaxInFlight, config.Logger)
+	}
+
+	apiKey := APIKeyMiddleware(config.APIKey, config.Admins, config.Logger)
+	var idempotency gin.HandlerFunc
+	if config.Idempotency != nil {
+		idempotency = IdempotencyMiddleware(config.Idempotency, config.Logger)
+	}
+	var signing gin.HandlerFunc
+	if config.RequestSigning.Keys != nil && config.RequestSigning.Nonces != nil {
+		signing = RequestSigningMiddleware(config.RequestSigning, config.Logger)
+	}
+	for _, route := range r.routes {
+		path := route.Path
+		var handlers []gin.HandlerFunc
+		if route.Auth == AuthAPIKey {
+			path = apiPrefix + path
+			handlers = append(handlers, apiKey)
+			// Recorded before the permission check so denied attempts are audited too
+			if config.AdminActivity != nil {
+				handlers = append(handlers, AdminActivityMiddleware(config.AdminActivity, route.Permission, config.GeoHeader, config.Logger))
+			}
+			handlers = append(handlers, PermissionMiddleware(route.Permission, config.Logger))
+			// Verified before the idempotency cache, so a replayed request is not answered from it
+			if signing != nil {
+				handlers = append(handlers, signing)
+			}
+		}
+
+		// Creations are the requests a retry could carry out twice; keyed ones are replayed instead
+		if route.Method == http.MethodPost && idempotency != nil {
+			handlers = append(handlers, idempotency)
+		}
+
+		if route.Limit != LimitNone {
+			timeout := classLimits[route.Limit].Timeout
+			if route.Timeout > 0 {
+				timeout = route.Timeout
+			}
+			handlers = append(handlers, caps[route.Limit], TimeoutMiddleware(string(route.Limit), timeout, config.Logger))
+		}
+
+		router.Handle(route.Method, path, append(handlers, route.Handler)...)
+	}
+}
+
+// OpenAPIOperation describes one route in the OpenAPI path list
+type OpenAPIOperation struct {
+	Summary    string                `json:"summary,omitempty"`
+	Security   []map[string][]string `json:"security"`
+	LimitClass LimitClass            `json:"x-limit-class,omitempty"`
+	Permission vo.AdminPermission    `json:"x-permission,omitempty"`
+}
+
+// OpenAPIPaths returns the OpenAPI paths object of the declared routes, with Gin parameters such as
+// :id written as {id}
+func (r *RouteRegistry) OpenAPIPaths() map[string]map[string]OpenAPIOperation {
+	paths := make(map[string]map[string]OpenAPIOperation)
+	for _, route := range r.routes {
+		path := route.Path
+		security := []map[string][]string{}
+		if route.Auth == AuthAPIKey {
+			path = apiPrefix + path
+			security = []map[string][]string{{"apiKey": {}}}
+		}
+		path = openAPIPath(path)
+
+		if paths[path] == nil {
+			paths[path] = make(map[string]OpenAPIOperation)
+		}
+		operation := OpenAPIOperation{Summary: route.Summary, Security: security, Permission: route.Permission}
+		if route.Limit != LimitNone {
+			operation.LimitClass = route.Limit
+		}
+		paths[path][strings.ToLower(route.Method)] = operation
+	}
+	return paths
+}
+
+// OpenAPIDocument returns an OpenAPI 3 document listing the declared paths
+func (r *RouteRegistry) OpenAPIDocument() gin.H {
+	return gin.H{
+		"openapi": "3.0.3",
+		"info": gin.H{
+			"title":   "Mini Bank API",
+			"version": "v1",
+		},
+		"paths": r.OpenAPIPaths(),
+		"components": gin.H{
+			"securitySchemes": gin.H{
+				"apiKey": gin.H{"type": "apiKey", "in": "header", "name": "x-api-key"},
+			},
+		},
+	}
+}
+
+// ServeOpenAPI returns the OpenAPI document of the registry
+func (r *RouteRegistry) ServeOpenAPI(ctx *gin.Context) {
+	ctx.JSON(http.StatusOK, r.OpenAPIDocument())
+}
+
+// openAPIPath turns Gin path parameters into OpenAPI ones
+func openAPIPath(path string) string {
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		if strings.HasPrefix(segment, ":") || strings.HasPrefix(segment, "*") {
+			segments[i] = "{" + segment[1:] + "}"
+		}
+	}
+	return strings.Join(segments, "/")
+}
@@ -1,14 +1,29 @@
 package controller
 
 import (
+	"net/http"
+	"time"
+
 	"github.com/gin-gonic/gin"
 	usecase "github.com/hydr0g3nz/mini_bank/internal/application"
 	"github.com/hydr0g3nz/mini_bank/internal/domain/infra"
 )
 
 type RouterConfig struct {
-	APIKey string
-	Logger infra.Logger
+	APIKey      infra.SecretValue
+	Envelope    bool        // Wrap success responses in dto.SuccessResponse unless the request opts out
+	FieldNaming FieldNaming // Field names of JSON responses unless the Accept profile asks otherwise; empty keeps snake_case
+	Logger      infra.Logger
+
+	DatabaseMode infra.DatabaseMode // Writes are rejected while the database is read-only; nil never rejects
+	RouteLimits  RouteLimits        // Timeouts and in-flight caps per route group
+	Idempotency  infra.CacheService // Keeps the responses to POSTs sent with an Idempotency-Key; nil ignores the header
+	Admins       AdminAuthenticator // Accepts admin user keys on routes declaring a permission; nil accepts only APIKey
+
+	AdminActivity AdminActivityRecorder // Records the requests of admin users; nil records none
+	GeoHeader     string                // Header the edge proxy reports the client's country in, e.g. CF-IPCountry
+
+	RequestSigning RequestSigning // API keys that must sign the requests changing anything; nil Keys or Nonces signs none
 }
 
 // SetupRoutes configures all routes for the application
@@ -16,66 +31,192 @@ func SetupRoutes(
 	router *gin.Engine,
 	accountUseCase usecase.AccountUseCase,
 	transactionUseCase usecase.TransactionUseCase,
+	backupUseCase usecase.BackupUseCase,
+	sagaUseCase usecase.SagaUseCase,
+	calendarUseCase usecase.CalendarUseCase,
+	categoryUseCase usecase.CategoryUseCase,
+	budgetUseCase usecase.BudgetUseCase,
+	customerUseCase usecase.CustomerUseCase,
+	ownershipTransferUseCase usecase.OwnershipTransferUseCase,
+	transactionBlockUseCase usecase.TransactionBlockUseCase,
+	velocityUseCase usecase.VelocityUseCase,
+	monitorUseCase usecase.TransactionMonitorUseCase,
+	webhookUseCase usecase.WebhookUseCase,
+	virtualAccountUseCase usecase.VirtualAccountUseCase,
+	auditUseCase usecase.AuditUseCase,
+	businessRuleUseCase usecase.BusinessRuleUseCase,
+	productUseCase usecase.ProductUseCase,
+	productMigrationUseCase usecase.ProductMigrationUseCase,
+	cashbackUseCase usecase.CashbackUseCase,
+	referralUseCase usecase.ReferralUseCase,
+	transferTemplateUseCase usecase.TransferTemplateUseCase,
+	scheduledTransactionUseCase usecase.ScheduledTransactionUseCase,
+	notificationTemplateUseCase usecase.NotificationTemplateUseCase,
+	notificationPreferenceUseCase usecase.NotificationPreferenceUseCase,
+	accountingPeriodUseCase usecase.AccountingPeriodUseCase,
+	generalLedgerUseCase usecase.GeneralLedgerUseCase,
+	cacheUseCase usecase.CacheUseCase,
+	historyUseCase usecase.TransactionHistoryUseCase,
+	tagUseCase usecase.TransactionTagUseCase,
+	attachmentUseCase usecase.AttachmentUseCase,
+	aggregateUseCase usecase.DailyAggregateUseCase,
+	exportUseCase usecase.ExportUseCase,
+	jobUseCase usecase.JobUseCase,
+	clockUseCase usecase.ClockUseCase,
+	adminUserUseCase usecase.AdminUserUseCase,
+	adminSecurityUseCase usecase.AdminSecurityUseCase,
+	exchangeRateUseCase usecase.ExchangeRateUseCase,
+	statementUseCase usecase.StatementUseCase,
 	config RouterConfig,
 ) {
 	// Initialize controllers
 	accountController := NewAccountController(accountUseCase, config.Logger)
 	transactionController := NewTransactionController(transactionUseCase, config.Logger)
+	backupController := NewBackupController(backupUseCase, config.Logger)
+	sagaController := NewSagaController(sagaUseCase, config.Logger)
+	calendarController := NewCalendarController(calendarUseCase, config.Logger)
+	categoryController := NewCategoryController(categoryUseCase, config.Logger)
+	budgetController := NewBudgetController(budgetUseCase, config.Logger)
+	customerController := NewCustomerController(customerUseCase, config.Logger)
+	ownershipTransferController := NewOwnershipTransferController(ownershipTransferUseCase, config.Logger)
+	transactionBlockController := NewTransactionBlockController(transactionBlockUseCase, config.Logger)
+	velocityController := NewVelocityController(velocityUseCase, config.Logger)
+	monitorController := NewMonitorController(monitorUseCase, config.Logger)
+	webhookController := NewWebhookController(webhookUseCase, config.Logger)
+	virtualAccountController := NewVirtualAccountController(virtualAccountUseCase, config.Logger)
+	auditController := NewAuditController(auditUseCase, config.Logger)
+	businessRuleController := NewBusinessRuleController(businessRuleUseCase, config.Logger)
+	productController := NewProductController(productUseCase, config.Logger)
+	productMigrationController := NewProductMigrationController(productMigrationUseCase, config.Logger)
+	cashbackController := NewCashbackController(cashbackUseCase, config.Logger)
+	referralController := NewReferralController(referralUseCase, config.Logger)
+	transferTemplateController := NewTransferTemplateController(transferTemplateUseCase, config.Logger)
+	scheduledTransactionController := NewScheduledTransactionController(scheduledTransactionUseCase, config.Logger)
+	notificationTemplateController := NewNotificationTemplateController(notificationTemplateUseCase, config.Logger)
+	notificationPreferenceController := NewNotificationPreferenceController(notificationPreferenceUseCase, config.Logger)
+	accountingPeriodController := NewAccountingPeriodController(accountingPeriodUseCase, config.Logger)
+	generalLedgerController := NewGeneralLedgerController(generalLedgerUseCase, config.Logger)
+	cacheController := NewCacheController(cacheUseCase, config.Logger)
+	historyController := NewTransactionHistoryController(historyUseCase, config.Logger)
+	tagController := NewTransactionTagController(tagUseCase, config.Logger)
+	attachmentController := NewAttachmentController(attachmentUseCase, config.Logger)
+	aggregateController := NewDailyAggregateController(aggregateUseCase, config.Logger)
+	exportController := NewExportController(exportUseCase, config.Logger)
+	jobController := NewJobController(jobUseCase, config.Logger)
+	clockController := NewClockController(clockUseCase, config.Logger)
+	adminUserController := NewAdminUserController(adminUserUseCase, config.Logger)
+	adminSecurityController := NewAdminSecurityController(adminSecurityUseCase, config.Logger)
+	exchangeRateController := NewExchangeRateController(exchangeRateUseCase, config.Logger)
+	statementController := NewStatementController(statementUseCase, config.Logger)
 
+	// Every controller declares its routes; the registry applies auth, limits and timeouts per route
+	registry := NewRouteRegistry(
+		accountController,
+		transactionController,
+		backupController,
+		sagaController,
+		calendarController,
+		categoryController,
+		budgetController,
+		customerController,
+		ownershipTransferController,
+		transactionBlockController,
+		velocityController,
+		monitorController,
+		webhookController,
+		virtualAccountController,
+		auditController,
+		businessRuleController,
+		productController,
+		productMigrationController,
+		cashbackController,
+		referralController,
+		transferTemplateController,
+		scheduledTransactionController,
+		notificationTemplateController,
+		notificationPreferenceController,
+		accountingPeriodController,
+		generalLedgerController,
+		cacheController,
+		historyController,
+		tagController,
+		attachmentController,
+		aggregateController,
+		exportController,
+		jobController,
+		clockController,
+		adminUserController,
+		adminSecurityController,
+		exchangeRateController,
+		statementController,
+	)
+
+	// Health checks need no API key and are never capped, so load balancers see the service as up
+	registry.Add(
+		Route{Method: http.MethodGet, Path: "/health", Handler: health, Summary: "Health check", Auth: AuthPublic, Limit: LimitNone},
+		Route{Method: http.MethodGet, Path: "/healthz", Handler: healthWithDatabaseMode(config.DatabaseMode), Summary: "Health check with the database mode", Auth: AuthPublic, Limit: LimitNone},
+	)
+	registry.Add(Route{Method: http.MethodGet, Path: "/openapi.json", Handler: registry.ServeOpenAPI, Summary: "List the API paths as an OpenAPI document"})
+
+	Serve(router, registry, config)
+}
+
+// Serve applies the global middlewares to router and mounts the registry's routes on it, answering
+// undefined endpoints with 404
+func Serve(router *gin.Engine, registry *RouteRegistry, config RouterConfig) {
 	// Apply global middlewares
 	router.Use(CORSMiddleware())
 	router.Use(RequestIDMiddleware())
+	router.Use(FieldNamingMiddleware(config.FieldNaming))
 	router.Use(LoggingMiddleware(config.Logger))
 	router.Use(RecoveryMiddleware(config.Logger))
+	router.Use(ReadOnlyMiddleware(config.DatabaseMode))
+	router.Use(CacheAgeMiddleware())
+	router.Use(EnvelopeMiddleware(config.Envelope))
 
-	// Health check endpoint (no API key required)
-	router.GET("/health", func(ctx *gin.Context) {
-		ctx.JSON(200, gin.H{
-			"status":  "ok",
-			"service": "mini-bank-api",
-		})
-	})
-
-	// API v1 routes with API key middleware
-	v1 := router.Group("/api/v1")
-	v1.Use(APIKeyMiddleware(config.APIKey, config.Logger))
-	{
-		// Account routes
-		accounts := v1.Group("/accounts")
-		{
-			// Account-specific transaction routes
-			accounts.GET("/:id/transactions", transactionController.GetTransactionsByAccount)
-
-			accounts.POST("", accountController.CreateAccount)
-			accounts.GET("", accountController.ListAccounts)
-			accounts.GET("/:id", accountController.GetAccount)
-			accounts.PUT("/:id", accountController.UpdateAccount)
-			accounts.DELETE("/:id", accountController.DeleteAccount)
-			accounts.PATCH("/:id/suspend", accountController.SuspendAccount)
-			accounts.PATCH("/:id/activate", accountController.ActivateAccount)
-
-		}
-
-		// Transaction routes
-		transactions := v1.Group("/transactions")
-		{
-			transactions.POST("", transactionController.CreateTransaction)
-			transactions.GET("", transactionController.ListTransactions)
-			transactions.GET("/:id", transactionController.GetTransaction)
-			transactions.PATCH("/:id/confirm", transactionController.ConfirmTransaction)
-			transactions.PATCH("/:id/cancel", transactionController.CancelTransaction)
-
-			// Transaction status routes
-			transactions.GET("/status/:status", transactionController.GetTransactionsByStatus)
-		}
-	}
+	registry.Mount(router, config)
 
 	// Add a catch-all route for undefined endpoints
 	router.NoRoute(func(ctx *gin.Context) {
-		ctx.JSON(404, gin.H{
+		renderJSON(ctx, 404, gin.H{
 			"error":   "Not Found",
 			"message": "The requested endpoint does not exist",
 			"path":    ctx.Request.URL.Path,
 		})
 	})
 }
+
+// healthResponse reports whether the service is up and, when it knows, whether it accepts writes
+type healthResponse struct {
+	Status        string     `json:"status"`
+	Service       string     `json:"service"`
+	DatabaseMode  string     `json:"database_mode,omitempty"`
+	ReadOnlySince *time.Time `json:"read_only_since,omitempty"`
+}
+
+// health reports the service as up
+func health(ctx *gin.Context) {
+	renderJSON(ctx, 200, healthResponse{
+		Status:  "ok",
+		Service: "mini-bank-api",
+	})
+}
+
+// healthWithDatabaseMode reports whether writes are accepted; a read-only service is degraded but up
+func healthWithDatabaseMode(mode infra.DatabaseMode) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		health := healthResponse{
+			Status:       "ok",
+			Service:      "mini-bank-api",
+			DatabaseMode: "read_write",
+		}
+		if mode != nil {
+			if since, readOnly := mode.ReadOnlySince(); readOnly {
+				health.Status = "degraded"
+				health.DatabaseMode = "read_only"
+				health.ReadOnlySince = &since
+			}
+		}
+		renderJSON(ctx, 200, health)
+	}
+}
@@ -0,0 +1,67 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	usecase "github.com/hydr0g3nz/mini_bank/internal/application"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/infra"
+)
+
+type SagaController struct {
+	sagaUseCase usecase.SagaUseCase
+	logger      infra.Logger
+}
+
+func NewSagaController(sagaUseCase usecase.SagaUseCase, logger infra.Logger) *SagaController {
+	return &SagaController{
+		sagaUseCase: sagaUseCase,
+		logger:      logger,
+	}
+}
+
+// Routes declares the saga routes
+func (c *SagaController) Routes() []Route {
+	return []Route{
+		{Method: http.MethodGet, Path: "/sagas/:id", Handler: c.GetSaga, Summary: "Get a saga"},
+		{Method: http.MethodGet, Path: "/transactions/:id/saga", Handler: c.GetSagaByTransaction, Summary: "Get a transfer's saga"},
+	}
+}
+
+// GetSaga retrieves saga status by ID
+func (c *SagaController) GetSaga(ctx *gin.Context) {
+	id := ctx.Param("id")
+	if id == "" {
+		c.logger.Error("Saga ID is required")
+		HandleError(ctx, &ValidationError{Field: "id", Message: "saga ID is required"})
+		return
+	}
+
+	response, err := c.sagaUseCase.GetSaga(ctx.Request.Context(), id)
+	if err != nil {
+		c.logger.Error("Failed to get saga", "error", err, "sagaID", id)
+		HandleError(ctx, err)
+		return
+	}
+
+	Respond(ctx, http.StatusOK, MsgSagaRetrieved, response)
+}
+
+// GetSagaByTransaction retrieves the saga status of a transaction
+func (c *SagaController) GetSagaByTransaction(ctx *gin.Context) {
+	id := ctx.Param("id")
+	if id == "" {
+		c.logger.Error("Transaction ID is required")
+		HandleError(ctx, &ValidationError{Field: "id", Message: "transaction ID is required"})
+		return
+	}
+
+	response, err := c.sagaUseCase.GetSagaByTransaction(ctx.Request.Context(), id)
+	if err != nil {
+		c.logger.Error("Failed to get saga for transaction", "error", err, "transactionID", id)
+		HandleError(ctx, err)
+		return
+	}
+
+	Respond(ctx, http.StatusOK, MsgSagaRetrieved, response)
+}
@@ -0,0 +1,147 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	usecase "github.com/hydr0g3nz/mini_bank/internal/application"
+	"github.com/hydr0g3nz/mini_bank/internal/application/dto"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/infra"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+)
+
+type ScheduledTransactionController struct {
+	scheduledUseCase usecase.ScheduledTransactionUseCase
+	logger           infra.Logger
+}
+
+func NewScheduledTransactionController(scheduledUseCase usecase.ScheduledTransactionUseCase, logger infra.Logger) *ScheduledTransactionController {
+	return &ScheduledTransactionController{
+		scheduledUseCase: scheduledUseCase,
+		logger:           logger,
+	}
+}
+
+// Routes declares the scheduled transaction routes
+func (c *ScheduledTransactionController) Routes() []Route {
+	return []Route{
+		{Method: http.MethodPost, Path: "/scheduled-transactions", Handler: c.CreateScheduledTransaction, Summary: "Schedule a one-time or recurring transfer"},
+		{Method: http.MethodGet, Path: "/scheduled-transactions", Handler: c.ListScheduledTransactions, Summary: "List scheduled transactions", Permission: vo.AdminPermissionViewAccounts},
+		{Method: http.MethodGet, Path: "/scheduled-transactions/:id", Handler: c.GetScheduledTransaction, Summary: "Get a scheduled transaction", Permission: vo.AdminPermissionViewAccounts},
+		{Method: http.MethodPut, Path: "/scheduled-transactions/:id", Handler: c.UpdateScheduledTransaction, Summary: "Change a scheduled transaction"},
+		{Method: http.MethodDelete, Path: "/scheduled-transactions/:id", Handler: c.DeleteScheduledTransaction, Summary: "Remove a scheduled transaction"},
+	}
+}
+
+// CreateScheduledTransaction schedules a transfer between two accounts
+func (c *ScheduledTransactionController) CreateScheduledTransaction(ctx *gin.Context) {
+	var req dto.ScheduledTransactionRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		c.logger.Error("Failed to bind JSON", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+
+	// Validate request
+	if err := ValidateStructFor(ProfileCreate, req); err != nil {
+		c.logger.Error("Validation failed", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+
+	response, err := c.scheduledUseCase.CreateScheduledTransaction(ctx.Request.Context(), req)
+	if err != nil {
+		c.logger.Error("Failed to create scheduled transaction", "error", err, "fromAccountID", req.FromAccountID)
+		HandleError(ctx, err)
+		return
+	}
+
+	Respond(ctx, http.StatusCreated, MsgScheduledTransactionCreated, response)
+}
+
+// ListScheduledTransactions retrieves scheduled transactions, optionally of an account or a status
+func (c *ScheduledTransactionController) ListScheduledTransactions(ctx *gin.Context) {
+	// Parse query parameters
+	page, _ := strconv.Atoi(ctx.DefaultQuery("page", "1"))
+	pageSize, _ := strconv.Atoi(ctx.DefaultQuery("page_size", "10"))
+
+	req := dto.ScheduledTransactionListRequest{
+		Page:      page,
+		PageSize:  pageSize,
+		AccountID: ctx.Query("account_id"),
+		Status:    strings.ToUpper(ctx.Query("status")),
+	}
+
+	// Validate request
+	if err := ValidateStruct(req); err != nil {
+		c.logger.Error("Validation failed", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+
+	response, err := c.scheduledUseCase.ListScheduledTransactions(ctx.Request.Context(), req)
+	if err != nil {
+		c.logger.Error("Failed to list scheduled transactions", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+
+	SetPaginationHeaders(ctx, response.Pagination)
+	Respond(ctx, http.StatusOK, MsgScheduledTransactionsRetrieved, response)
+}
+
+// GetScheduledTransaction retrieves a scheduled transaction
+func (c *ScheduledTransactionController) GetScheduledTransaction(ctx *gin.Context) {
+	id := ctx.Param("id")
+
+	response, err := c.scheduledUseCase.GetScheduledTransaction(ctx.Request.Context(), id)
+	if err != nil {
+		c.logger.Error("Failed to get scheduled transaction", "error", err, "scheduleID", id)
+		HandleError(ctx, err)
+		return
+	}
+
+	Respond(ctx, http.StatusOK, MsgScheduledTransactionRetrieved, response)
+}
+
+// UpdateScheduledTransaction replaces the transfer and schedule of a scheduled transaction
+func (c *ScheduledTransactionController) UpdateScheduledTransaction(ctx *gin.Context) {
+	var req dto.ScheduledTransactionRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		c.logger.Error("Failed to bind JSON", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+	req.ID = ctx.Param("id")
+
+	// Validate request
+	if err := ValidateStructFor(ProfileUpdate, req); err != nil {
+		c.logger.Error("Validation failed", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+
+	response, err := c.scheduledUseCase.UpdateScheduledTransaction(ctx.Request.Context(), req)
+	if err != nil {
+		c.logger.Error("Failed to update scheduled transaction", "error", err, "scheduleID", req.ID)
+		HandleError(ctx, err)
+		return
+	}
+
+	Respond(ctx, http.StatusOK, MsgScheduledTransactionUpdated, response)
+}
+
+// DeleteScheduledTransaction removes a scheduled transaction
+func (c *ScheduledTransactionController) DeleteScheduledTransaction(ctx *gin.Context) {
+	id := ctx.Param("id")
+
+	if err := c.scheduledUseCase.DeleteScheduledTransaction(ctx.Request.Context(), id); err != nil {
+		c.logger.Error("Failed to delete scheduled transaction", "error", err, "scheduleID", id)
+		HandleError(ctx, err)
+		return
+	}
+
+	Respond(ctx, http.StatusOK, MsgScheduledTransactionDeleted, nil)
+}
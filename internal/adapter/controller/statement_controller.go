@@ -0,0 +1,55 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	usecase "github.com/hydr0g3nz/mini_bank/internal/application"
+	"github.com/hydr0g3nz/mini_bank/internal/application/dto"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/infra"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+)
+
+type StatementController struct {
+	statementUseCase usecase.StatementUseCase
+	logger           infra.Logger
+}
+
+func NewStatementController(statementUseCase usecase.StatementUseCase, logger infra.Logger) *StatementController {
+	return &StatementController{
+		statementUseCase: statementUseCase,
+		logger:           logger,
+	}
+}
+
+// Routes declares the statement routes
+func (c *StatementController) Routes() []Route {
+	return []Route{
+		{Method: http.MethodGet, Path: "/accounts/:id/statement", Handler: c.GetStatement, Summary: "Get an account's statement for a period with running balances", Permission: vo.AdminPermissionViewAccounts},
+	}
+}
+
+// GetStatement retrieves an account's transactions within a period with opening, running and closing balances
+func (c *StatementController) GetStatement(ctx *gin.Context) {
+	req := dto.StatementRequest{
+		AccountID: ctx.Param("id"),
+		From:      ctx.Query("from"),
+		To:        ctx.Query("to"),
+	}
+
+	// Validate request
+	if err := ValidateStruct(req); err != nil {
+		c.logger.Error("Validation failed", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+
+	response, err := c.statementUseCase.GetStatement(ctx.Request.Context(), req)
+	if err != nil {
+		c.logger.Error("Failed to get account statement", "error", err, "accountID", req.AccountID)
+		HandleError(ctx, err)
+		return
+	}
+
+	Respond(ctx, http.StatusOK, MsgStatementRetrieved, response)
+}
@@ -0,0 +1,152 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	usecase "github.com/hydr0g3nz/mini_bank/internal/application"
+	"github.com/hydr0g3nz/mini_bank/internal/application/dto"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/infra"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+)
+
+type TransactionBlockController struct {
+	blockUseCase usecase.TransactionBlockUseCase
+	logger       infra.Logger
+}
+
+func NewTransactionBlockController(blockUseCase usecase.TransactionBlockUseCase, logger infra.Logger) *TransactionBlockController {
+	return &TransactionBlockController{
+		blockUseCase: blockUseCase,
+		logger:       logger,
+	}
+}
+
+// Routes declares the transaction block routes
+func (c *TransactionBlockController) Routes() []Route {
+	return []Route{
+		{Method: http.MethodPost, Path: "/admin/accounts/:id/transaction-blocks", Handler: c.CreateTransactionBlock, Summary: "Block a type of transaction on an account for a while", Limit: LimitAdmin, Permission: vo.AdminPermissionMutateAccounts},
+		{Method: http.MethodGet, Path: "/admin/accounts/:id/transaction-blocks", Handler: c.ListTransactionBlocks, Summary: "List an account's transaction blocks", Limit: LimitAdmin, Permission: vo.AdminPermissionViewAccounts},
+		{Method: http.MethodGet, Path: "/admin/accounts/:id/transaction-blocks/:block_id", Handler: c.GetTransactionBlock, Summary: "Get a transaction block", Limit: LimitAdmin, Permission: vo.AdminPermissionViewAccounts},
+		{Method: http.MethodPost, Path: "/admin/accounts/:id/transaction-blocks/:block_id/lift", Handler: c.LiftTransactionBlock, Summary: "Lift a transaction block before it expires", Limit: LimitAdmin, Permission: vo.AdminPermissionMutateAccounts},
+	}
+}
+
+// CreateTransactionBlock blocks a type of transaction on an account until the block expires
+func (c *TransactionBlockController) CreateTransactionBlock(ctx *gin.Context) {
+	var req dto.CreateTransactionBlockRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		c.logger.Error("Failed to bind JSON", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+	req.AccountID = ctx.Param("id")
+
+	actor, err := requestedBy(ctx, req.CreatedBy)
+	if err != nil {
+		c.logger.Error("Request names another admin", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+	req.CreatedBy = actor
+
+	// Validate request
+	if err := ValidateStruct(req); err != nil {
+		c.logger.Error("Validation failed", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+
+	response, err := c.blockUseCase.CreateTransactionBlock(ctx.Request.Context(), req)
+	if err != nil {
+		c.logger.Error("Failed to create transaction block", "error", err, "accountID", req.AccountID)
+		HandleError(ctx, err)
+		return
+	}
+
+	Respond(ctx, http.StatusCreated, MsgTransactionBlockCreated, response)
+}
+
+// ListTransactionBlocks retrieves an account's transaction blocks, optionally only those in force
+func (c *TransactionBlockController) ListTransactionBlocks(ctx *gin.Context) {
+	// Parse query parameters
+	page, _ := strconv.Atoi(ctx.DefaultQuery("page", "1"))
+	pageSize, _ := strconv.Atoi(ctx.DefaultQuery("page_size", "10"))
+	active, _ := strconv.ParseBool(ctx.DefaultQuery("active", "false"))
+
+	req := dto.TransactionBlockListRequest{
+		AccountID: ctx.Param("id"),
+		Page:      page,
+		PageSize:  pageSize,
+		Active:    active,
+	}
+
+	// Validate request
+	if err := ValidateStruct(req); err != nil {
+		c.logger.Error("Validation failed", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+
+	response, err := c.blockUseCase.ListTransactionBlocks(ctx.Request.Context(), req)
+	if err != nil {
+		c.logger.Error("Failed to list transaction blocks", "error", err, "accountID", req.AccountID)
+		HandleError(ctx, err)
+		return
+	}
+
+	SetPaginationHeaders(ctx, response.Pagination)
+	Respond(ctx, http.StatusOK, MsgTransactionBlocksRetrieved, response)
+}
+
+// GetTransactionBlock retrieves a transaction block of an account
+func (c *TransactionBlockController) GetTransactionBlock(ctx *gin.Context) {
+	accountID := ctx.Param("id")
+	blockID := ctx.Param("block_id")
+
+	response, err := c.blockUseCase.GetTransactionBlock(ctx.Request.Context(), accountID, blockID)
+	if err != nil {
+		c.logger.Error("Failed to get transaction block", "error", err, "accountID", accountID, "blockID", blockID)
+		HandleError(ctx, err)
+		return
+	}
+
+	Respond(ctx, http.StatusOK, MsgTransactionBlockRetrieved, response)
+}
+
+// LiftTransactionBlock ends a transaction block before it expires
+func (c *TransactionBlockController) LiftTransactionBlock(ctx *gin.Context) {
+	var req dto.LiftTransactionBlockRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		c.logger.Error("Failed to bind JSON", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+	req.AccountID = ctx.Param("id")
+	req.ID = ctx.Param("block_id")
+
+	actor, err := requestedBy(ctx, req.Admin)
+	if err != nil {
+		c.logger.Error("Request names another admin", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+	req.Admin = actor
+
+	// Validate request
+	if err := ValidateStruct(req); err != nil {
+		c.logger.Error("Validation failed", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+
+	response, err := c.blockUseCase.LiftTransactionBlock(ctx.Request.Context(), req)
+	if err != nil {
+		c.logger.Error("Failed to lift transaction block", "error", err, "accountID", req.AccountID, "blockID", req.ID)
+		HandleError(ctx, err)
+		return
+	}
+
+	Respond(ctx, http.StatusOK, MsgTransactionBlockLifted, response)
+}
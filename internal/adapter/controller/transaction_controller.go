@@ -1,13 +1,16 @@
 package controller
 
 import (
+	"fmt"
 	"net/http"
 	"strconv"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 	usecase "github.com/hydr0g3nz/mini_bank/internal/application"
 	"github.com/hydr0g3nz/mini_bank/internal/application/dto"
 	"github.com/hydr0g3nz/mini_bank/internal/domain/infra"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
 )
 
 type TransactionController struct {
@@ -22,6 +25,39 @@ func NewTransactionController(transactionUseCase usecase.TransactionUseCase, log
 	}
 }
 
+// Routes declares the transaction routes
+func (c *TransactionController) Routes() []Route {
+	return []Route{
+		{Method: http.MethodPost, Path: "/transactions", Handler: c.CreateTransaction, Summary: "Create a transaction"},
+		{Method: http.MethodGet, Path: "/transactions", Handler: c.ListTransactions, Summary: "List transactions", Permission: vo.AdminPermissionViewAccounts},
+		{Method: http.MethodPost, Path: "/transactions/batch-get", Handler: c.BatchGetTransactions, Summary: "Get up to 100 transactions by ID", Permission: vo.AdminPermissionViewAccounts},
+		{Method: http.MethodGet, Path: "/transactions/:id", Handler: c.GetTransaction, Summary: "Get a transaction", Permission: vo.AdminPermissionViewAccounts},
+		{Method: http.MethodPatch, Path: "/transactions/:id/confirm", Handler: c.ConfirmTransaction, Summary: "Confirm a pending transaction"},
+		{Method: http.MethodPatch, Path: "/transactions/:id/cancel", Handler: c.CancelTransaction, Summary: "Cancel a pending transaction or withdraw one held for review"},
+		{Method: http.MethodPost, Path: "/transactions/:id/reverse", Handler: c.ReverseTransaction, Summary: "Reverse a completed transaction and refund its fee", Permission: vo.AdminPermissionApproveTransactions},
+		{Method: http.MethodGet, Path: "/transactions/status/:status", Handler: c.GetTransactionsByStatus, Summary: "List transactions by status", Permission: vo.AdminPermissionViewAccounts},
+		{Method: http.MethodGet, Path: "/transactions/channel/:channel", Handler: c.GetTransactionsByChannel, Summary: "List transactions by channel", Permission: vo.AdminPermissionViewAccounts},
+		{Method: http.MethodGet, Path: "/accounts/:id/transactions", Handler: c.GetTransactionsByAccount, Summary: "List an account's transactions", Permission: vo.AdminPermissionViewAccounts},
+		{Method: http.MethodGet, Path: "/accounts/:id/transactions/sync", Handler: c.SyncAccountTransactions, Summary: "List an account's transactions changed after a sequence number", Permission: vo.AdminPermissionViewAccounts},
+		{Method: http.MethodGet, Path: "/accounts/:id/activity", Handler: c.GetAccountActivity, Summary: "List an account's completed transactions by day with running balances", Permission: vo.AdminPermissionViewAccounts},
+		{Method: http.MethodGet, Path: "/accounts/:id/projections", Handler: c.GetAccountProjection, Summary: "Project an account's interest earnings and fees over the next period", Permission: vo.AdminPermissionViewAccounts},
+		{Method: http.MethodPost, Path: "/accounts/:id/group-transfers", Handler: c.GroupTransfer, Summary: "Transfer between accounts of a group"},
+		{Method: http.MethodPost, Path: "/transfers/simulate", Handler: c.SimulateTransfer, Summary: "Dry-run a transfer"},
+		{Method: http.MethodGet, Path: "/admin/transactions/failed", Handler: c.ListFailedTransactions, Summary: "List failed transactions by failure kind", Limit: LimitAdmin},
+		{Method: http.MethodPost, Path: "/admin/transactions/:id/replay", Handler: c.ReplayTransaction, Summary: "Replay a transaction that failed on infrastructure", Limit: LimitAdmin},
+		{Method: http.MethodPost, Path: "/admin/transactions/:id/adjustments", Handler: c.AdjustTransaction, Summary: "Post an adjustment correcting a transaction", Limit: LimitAdmin},
+		{Method: http.MethodPost, Path: "/admin/adjustments", Handler: c.CreateAdjustment, Summary: "Post an adjustment of an account's balance for approval by a second admin", Limit: LimitAdmin},
+		{Method: http.MethodGet, Path: "/admin/reports/adjustments", Handler: c.GetAdjustmentReport, Summary: "Report completed adjustments per period and reason code", Limit: LimitAdmin},
+		{Method: http.MethodPost, Path: "/admin/accounts/:id/recalculate", Handler: c.RecalculateBalance, Summary: "Recalculate an account's balance from its transactions and optionally repair it", Limit: LimitAdmin},
+		{Method: http.MethodGet, Path: "/admin/reviews", Handler: c.ListReviews, Summary: "List transactions held for review", Limit: LimitAdmin, Permission: vo.AdminPermissionApproveTransactions},
+		{Method: http.MethodGet, Path: "/admin/reviews/metrics", Handler: c.GetReviewQueueMetrics, Summary: "Get review queue metrics", Limit: LimitAdmin, Permission: vo.AdminPermissionApproveTransactions},
+		{Method: http.MethodPost, Path: "/admin/reviews/:id/claim", Handler: c.ClaimReview, Summary: "Claim a review", Limit: LimitAdmin, Permission: vo.AdminPermissionApproveTransactions},
+		{Method: http.MethodPost, Path: "/admin/reviews/:id/release", Handler: c.ReleaseReview, Summary: "Release a claimed review", Limit: LimitAdmin, Permission: vo.AdminPermissionApproveTransactions},
+		{Method: http.MethodPost, Path: "/admin/reviews/:id/approve", Handler: c.ApproveTransaction, Summary: "Approve a held transaction", Limit: LimitAdmin, Permission: vo.AdminPermissionApproveTransactions},
+		{Method: http.MethodPost, Path: "/admin/reviews/:id/decline", Handler: c.DeclineTransaction, Summary: "Decline a held transaction", Limit: LimitAdmin, Permission: vo.AdminPermissionApproveTransactions},
+	}
+}
+
 // CreateTransaction creates a new transaction
 func (c *TransactionController) CreateTransaction(ctx *gin.Context) {
 	var req dto.CreateTransactionRequest
@@ -46,10 +82,67 @@ func (c *TransactionController) CreateTransaction(ctx *gin.Context) {
 	}
 
 	c.logger.Info("Transaction created successfully", "transactionID", response.ID)
-	ctx.JSON(http.StatusCreated, dto.SuccessResponse{
-		Message: "Transaction created successfully",
-		Data:    response,
-	})
+	Respond(ctx, http.StatusCreated, MsgTransactionCreated, response)
+}
+
+// SimulateTransfer dry-runs a transfer; problems are reported in the body with the API's error codes
+func (c *TransactionController) SimulateTransfer(ctx *gin.Context) {
+	var req dto.SimulateTransferRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		c.logger.Error("Failed to bind JSON", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+
+	// Validate request
+	if err := ValidateStruct(req); err != nil {
+		c.logger.Error("Validation failed", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+
+	response, err := c.transactionUseCase.SimulateTransfer(ctx.Request.Context(), req)
+	if err != nil {
+		c.logger.Error("Failed to simulate transfer", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+
+	for _, problem := range response.Problems {
+		_, errorResponse := MapError(problem)
+		response.Errors = append(response.Errors, errorResponse)
+	}
+
+	Respond(ctx, http.StatusOK, MsgTransferSimulated, response)
+}
+
+// GroupTransfer creates and confirms a transfer between accounts of the parent account's group
+func (c *TransactionController) GroupTransfer(ctx *gin.Context) {
+	var req dto.GroupTransferRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		c.logger.Error("Failed to bind JSON", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+
+	// Set parent ID from URL parameter
+	req.ParentID = ctx.Param("id")
+
+	// Validate request
+	if err := ValidateStruct(req); err != nil {
+		c.logger.Error("Validation failed", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+
+	response, err := c.transactionUseCase.GroupTransfer(ctx.Request.Context(), req)
+	if err != nil {
+		c.logger.Error("Failed to process group transfer", "error", err, "accountID", req.ParentID)
+		HandleError(ctx, err)
+		return
+	}
+
+	Respond(ctx, http.StatusCreated, MsgGroupTransferCreated, response)
 }
 
 // ConfirmTransaction confirms and processes a transaction
@@ -70,11 +163,363 @@ func (c *TransactionController) ConfirmTransaction(ctx *gin.Context) {
 		return
 	}
 
+	if response.Status == string(vo.TransactionStatusReview) {
+		c.logger.Info("Transaction held for review", "transactionID", id)
+		Respond(ctx, http.StatusAccepted, MsgTransactionInReview, response)
+		return
+	}
+
 	c.logger.Info("Transaction confirmed successfully", "transactionID", id)
-	ctx.JSON(http.StatusOK, dto.SuccessResponse{
-		Message: "Transaction confirmed successfully",
-		Data:    response,
-	})
+	Respond(ctx, http.StatusOK, MsgTransactionConfirmed, response)
+}
+
+// ListReviews retrieves the transactions held for review
+func (c *TransactionController) ListReviews(ctx *gin.Context) {
+	page, _ := strconv.Atoi(ctx.DefaultQuery("page", "1"))
+	pageSize, _ := strconv.Atoi(ctx.DefaultQuery("page_size", "10"))
+
+	req := dto.ListRequest{
+		Page:     page,
+		PageSize: pageSize,
+	}
+
+	// Validate request
+	if err := ValidateStruct(req); err != nil {
+		c.logger.Error("Validation failed", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+
+	response, err := c.transactionUseCase.ListReviews(ctx.Request.Context(), req)
+	if err != nil {
+		c.logger.Error("Failed to list reviews", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+
+	SetPaginationHeaders(ctx, response.Pagination)
+	Respond(ctx, http.StatusOK, MsgReviewsRetrieved, response)
+}
+
+// ApproveTransaction releases a transaction held for review and processes it
+func (c *TransactionController) ApproveTransaction(ctx *gin.Context) {
+	req, ok := c.bindReviewDecision(ctx)
+	if !ok {
+		return
+	}
+
+	response, err := c.transactionUseCase.ApproveTransaction(ctx.Request.Context(), req)
+	if err != nil {
+		c.logger.Error("Failed to approve transaction", "error", err, "transactionID", req.ID)
+		HandleError(ctx, err)
+		return
+	}
+
+	Respond(ctx, http.StatusOK, MsgReviewApproved, response)
+}
+
+// DeclineTransaction fails a transaction held for review
+func (c *TransactionController) DeclineTransaction(ctx *gin.Context) {
+	req, ok := c.bindReviewDecision(ctx)
+	if !ok {
+		return
+	}
+
+	response, err := c.transactionUseCase.DeclineTransaction(ctx.Request.Context(), req)
+	if err != nil {
+		c.logger.Error("Failed to decline transaction", "error", err, "transactionID", req.ID)
+		HandleError(ctx, err)
+		return
+	}
+
+	Respond(ctx, http.StatusOK, MsgReviewDeclined, response)
+}
+
+// ClaimReview locks a transaction held for review to the claiming admin
+func (c *TransactionController) ClaimReview(ctx *gin.Context) {
+	req, ok := c.bindReviewClaim(ctx)
+	if !ok {
+		return
+	}
+
+	response, err := c.transactionUseCase.ClaimReview(ctx.Request.Context(), req)
+	if err != nil {
+		c.logger.Error("Failed to claim review", "error", err, "transactionID", req.ID)
+		HandleError(ctx, err)
+		return
+	}
+
+	Respond(ctx, http.StatusOK, MsgReviewClaimed, response)
+}
+
+// ReleaseReview gives up the admin's claim on a transaction held for review
+func (c *TransactionController) ReleaseReview(ctx *gin.Context) {
+	req, ok := c.bindReviewClaim(ctx)
+	if !ok {
+		return
+	}
+
+	if err := c.transactionUseCase.ReleaseReview(ctx.Request.Context(), req); err != nil {
+		c.logger.Error("Failed to release review", "error", err, "transactionID", req.ID)
+		HandleError(ctx, err)
+		return
+	}
+
+	Respond(ctx, http.StatusOK, MsgReviewReleased, nil)
+}
+
+// GetReviewQueueMetrics reports the size and age of the review queue
+func (c *TransactionController) GetReviewQueueMetrics(ctx *gin.Context) {
+	response, err := c.transactionUseCase.GetReviewQueueMetrics(ctx.Request.Context())
+	if err != nil {
+		c.logger.Error("Failed to get review queue metrics", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+
+	Respond(ctx, http.StatusOK, MsgReviewQueueMetricsRetrieved, response)
+}
+
+// ListFailedTransactions retrieves failed transactions by the "kind" query parameter, INFRASTRUCTURE by default
+func (c *TransactionController) ListFailedTransactions(ctx *gin.Context) {
+	page, _ := strconv.Atoi(ctx.DefaultQuery("page", "1"))
+	pageSize, _ := strconv.Atoi(ctx.DefaultQuery("page_size", "10"))
+
+	req := dto.ListRequest{
+		Page:     page,
+		PageSize: pageSize,
+	}
+
+	// Validate request
+	if err := ValidateStruct(req); err != nil {
+		c.logger.Error("Validation failed", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+
+	response, err := c.transactionUseCase.ListFailedTransactions(ctx.Request.Context(), ctx.Query("kind"), req)
+	if err != nil {
+		c.logger.Error("Failed to list failed transactions", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+
+	SetPaginationHeaders(ctx, response.Pagination)
+	Respond(ctx, http.StatusOK, MsgFailedTransactionsRetrieved, response)
+}
+
+// ReplayTransaction processes a transaction that failed on infrastructure again
+func (c *TransactionController) ReplayTransaction(ctx *gin.Context) {
+	var req dto.ReplayTransactionRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		c.logger.Error("Failed to bind JSON", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+	req.ID = ctx.Param("id")
+
+	actor, err := requestedBy(ctx, req.RequestedBy)
+	if err != nil {
+		c.logger.Error("Request names another admin", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+	req.RequestedBy = actor
+
+	// Validate request
+	if err := ValidateStruct(req); err != nil {
+		c.logger.Error("Validation failed", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+
+	response, err := c.transactionUseCase.ReplayTransaction(ctx.Request.Context(), req)
+	if err != nil {
+		c.logger.Error("Failed to replay transaction", "error", err, "transactionID", req.ID)
+		HandleError(ctx, err)
+		return
+	}
+
+	Respond(ctx, http.StatusOK, MsgTransactionReplayed, response)
+}
+
+// AdjustTransaction posts an adjustment in the current period correcting a transaction
+func (c *TransactionController) AdjustTransaction(ctx *gin.Context) {
+	var req dto.AdjustTransactionRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		c.logger.Error("Failed to bind JSON", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+	req.ID = ctx.Param("id")
+
+	actor, err := requestedBy(ctx, req.RequestedBy)
+	if err != nil {
+		c.logger.Error("Request names another admin", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+	req.RequestedBy = actor
+
+	// Validate request
+	if err := ValidateStruct(req); err != nil {
+		c.logger.Error("Validation failed", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+
+	response, err := c.transactionUseCase.AdjustTransaction(ctx.Request.Context(), req)
+	if err != nil {
+		c.logger.Error("Failed to adjust transaction", "error", err, "transactionID", req.ID)
+		HandleError(ctx, err)
+		return
+	}
+
+	Respond(ctx, http.StatusCreated, MsgTransactionAdjusted, response)
+}
+
+// CreateAdjustment posts an adjustment of an account's balance, held until a second admin approves it
+func (c *TransactionController) CreateAdjustment(ctx *gin.Context) {
+	var req dto.CreateAdjustmentRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		c.logger.Error("Failed to bind JSON", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+
+	actor, err := requestedBy(ctx, req.RequestedBy)
+	if err != nil {
+		c.logger.Error("Request names another admin", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+	req.RequestedBy = actor
+
+	// Validate request
+	if err := ValidateStruct(req); err != nil {
+		c.logger.Error("Validation failed", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+
+	response, err := c.transactionUseCase.CreateAdjustment(ctx.Request.Context(), req)
+	if err != nil {
+		c.logger.Error("Failed to create adjustment", "error", err, "accountID", req.AccountID)
+		HandleError(ctx, err)
+		return
+	}
+
+	Respond(ctx, http.StatusCreated, MsgAdjustmentCreated, response)
+}
+
+// GetAdjustmentReport retrieves the completed adjustments per period and reason code for the periods
+// in the "from" and "to" query parameters
+func (c *TransactionController) GetAdjustmentReport(ctx *gin.Context) {
+	req := dto.AdjustmentReportRequest{
+		From: ctx.Query("from"),
+		To:   ctx.Query("to"),
+	}
+
+	response, err := c.transactionUseCase.GetAdjustmentReport(ctx.Request.Context(), req)
+	if err != nil {
+		c.logger.Error("Failed to get adjustment report", "error", err, "from", req.From, "to", req.To)
+		HandleError(ctx, err)
+		return
+	}
+
+	Respond(ctx, http.StatusOK, MsgAdjustmentReportRetrieved, response)
+}
+
+// RecalculateBalance recalculates an account's balance from its completed transactions and, when
+// confirmed, repairs the stored balance
+func (c *TransactionController) RecalculateBalance(ctx *gin.Context) {
+	var req dto.RecalculateBalanceRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		c.logger.Error("Failed to bind JSON", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+	req.AccountID = ctx.Param("id")
+
+	actor, err := requestedBy(ctx, req.RequestedBy)
+	if err != nil {
+		c.logger.Error("Request names another admin", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+	req.RequestedBy = actor
+
+	// Validate request
+	if err := ValidateStruct(req); err != nil {
+		c.logger.Error("Validation failed", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+
+	response, err := c.transactionUseCase.RecalculateBalance(ctx.Request.Context(), req)
+	if err != nil {
+		c.logger.Error("Failed to recalculate balance", "error", err, "accountID", req.AccountID)
+		HandleError(ctx, err)
+		return
+	}
+
+	Respond(ctx, http.StatusOK, MsgBalanceRecalculated, response)
+}
+
+// bindReviewClaim binds and validates a review claim; on failure the error response is already written
+func (c *TransactionController) bindReviewClaim(ctx *gin.Context) (dto.ReviewClaimRequest, bool) {
+	var req dto.ReviewClaimRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		c.logger.Error("Failed to bind JSON", "error", err)
+		HandleError(ctx, err)
+		return req, false
+	}
+	req.ID = ctx.Param("id")
+
+	actor, err := requestedBy(ctx, req.Reviewer)
+	if err != nil {
+		c.logger.Error("Request names another admin", "error", err)
+		HandleError(ctx, err)
+		return req, false
+	}
+	req.Reviewer = actor
+
+	// Validate request
+	if err := ValidateStruct(req); err != nil {
+		c.logger.Error("Validation failed", "error", err)
+		HandleError(ctx, err)
+		return req, false
+	}
+
+	return req, true
+}
+
+// bindReviewDecision binds and validates a review decision; on failure the error response is already written
+func (c *TransactionController) bindReviewDecision(ctx *gin.Context) (dto.ReviewDecisionRequest, bool) {
+	var req dto.ReviewDecisionRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		c.logger.Error("Failed to bind JSON", "error", err)
+		HandleError(ctx, err)
+		return req, false
+	}
+	req.ID = ctx.Param("id")
+
+	actor, err := requestedBy(ctx, req.Reviewer)
+	if err != nil {
+		c.logger.Error("Request names another admin", "error", err)
+		HandleError(ctx, err)
+		return req, false
+	}
+	req.Reviewer = actor
+
+	// Validate request
+	if err := ValidateStruct(req); err != nil {
+		c.logger.Error("Validation failed", "error", err)
+		HandleError(ctx, err)
+		return req, false
+	}
+
+	return req, true
 }
 
 // GetTransaction retrieves a transaction by ID
@@ -93,11 +538,40 @@ func (c *TransactionController) GetTransaction(ctx *gin.Context) {
 		return
 	}
 
+	responses := []dto.TransactionResponse{*response}
+	if !c.includeRelated(ctx, responses) {
+		return
+	}
+	response = &responses[0]
+
 	c.logger.Debug("Transaction retrieved successfully", "transactionID", id)
-	ctx.JSON(http.StatusOK, dto.SuccessResponse{
-		Message: "Transaction retrieved successfully",
-		Data:    response,
-	})
+	Respond(ctx, http.StatusOK, MsgTransactionRetrieved, response)
+}
+
+// BatchGetTransactions retrieves transactions by ID, reporting the IDs that were not found
+func (c *TransactionController) BatchGetTransactions(ctx *gin.Context) {
+	var req dto.BatchGetRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		c.logger.Error("Failed to bind JSON", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+
+	if err := ValidateStruct(req); err != nil {
+		c.logger.Error("Validation failed", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+
+	response, err := c.transactionUseCase.BatchGetTransactions(ctx.Request.Context(), req)
+	if err != nil {
+		c.logger.Error("Failed to batch get transactions", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+
+	c.logger.Debug("Transactions batch retrieved successfully", "found", len(response.Found), "missing", len(response.Missing))
+	Respond(ctx, http.StatusOK, MsgTransactionsBatchRetrieved, response)
 }
 
 // ListTransactions retrieves transactions with pagination
@@ -131,11 +605,13 @@ func (c *TransactionController) ListTransactions(ctx *gin.Context) {
 		return
 	}
 
+	if !c.includeRelated(ctx, response.Transactions) {
+		return
+	}
+
 	c.logger.Debug("Transactions listed successfully", "count", len(response.Transactions))
-	ctx.JSON(http.StatusOK, dto.SuccessResponse{
-		Message: "Transactions retrieved successfully",
-		Data:    response,
-	})
+	SetPaginationHeaders(ctx, response.Pagination)
+	Respond(ctx, http.StatusOK, MsgTransactionsRetrieved, response)
 }
 
 // GetTransactionsByAccount retrieves transactions for a specific account
@@ -176,11 +652,91 @@ func (c *TransactionController) GetTransactionsByAccount(ctx *gin.Context) {
 		return
 	}
 
+	if !c.includeRelated(ctx, response.Transactions) {
+		return
+	}
+
 	c.logger.Debug("Account transactions retrieved successfully", "accountID", accountID, "count", len(response.Transactions))
-	ctx.JSON(http.StatusOK, dto.SuccessResponse{
-		Message: "Account transactions retrieved successfully",
-		Data:    response,
-	})
+	SetPaginationHeaders(ctx, response.Pagination)
+	Respond(ctx, http.StatusOK, MsgAccountTransactionsRetrieved, response)
+}
+
+// SyncAccountTransactions retrieves an account's transactions changed after the sequence number in since_seq
+func (c *TransactionController) SyncAccountTransactions(ctx *gin.Context) {
+	sinceSequence, _ := strconv.ParseInt(ctx.DefaultQuery("since_seq", "0"), 10, 64)
+	limit, _ := strconv.Atoi(ctx.DefaultQuery("limit", "100"))
+
+	req := dto.TransactionSyncRequest{
+		AccountID:     ctx.Param("id"),
+		SinceSequence: sinceSequence,
+		Limit:         limit,
+	}
+
+	// Validate request
+	if err := ValidateStruct(req); err != nil {
+		c.logger.Error("Validation failed", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+
+	response, err := c.transactionUseCase.SyncAccountTransactions(ctx.Request.Context(), req)
+	if err != nil {
+		c.logger.Error("Failed to sync account transactions", "error", err, "accountID", req.AccountID)
+		HandleError(ctx, err)
+		return
+	}
+
+	Respond(ctx, http.StatusOK, MsgAccountTransactionsSynced, response)
+}
+
+// GetAccountActivity retrieves an account's completed transactions grouped by day with running balances
+func (c *TransactionController) GetAccountActivity(ctx *gin.Context) {
+	req := dto.AccountActivityRequest{
+		AccountID: ctx.Param("id"),
+		From:      ctx.Query("from"),
+		To:        ctx.Query("to"),
+	}
+
+	// Validate request
+	if err := ValidateStruct(req); err != nil {
+		c.logger.Error("Validation failed", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+
+	response, err := c.transactionUseCase.GetAccountActivity(ctx.Request.Context(), req)
+	if err != nil {
+		c.logger.Error("Failed to get account activity", "error", err, "accountID", req.AccountID)
+		HandleError(ctx, err)
+		return
+	}
+
+	Respond(ctx, http.StatusOK, MsgAccountActivityRetrieved, response)
+}
+
+// GetAccountProjection retrieves an account's projected interest earnings and expected fees
+func (c *TransactionController) GetAccountProjection(ctx *gin.Context) {
+	days, _ := strconv.Atoi(ctx.DefaultQuery("days", "0"))
+	req := dto.AccountProjectionRequest{
+		AccountID: ctx.Param("id"),
+		Days:      days,
+	}
+
+	// Validate request
+	if err := ValidateStruct(req); err != nil {
+		c.logger.Error("Validation failed", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+
+	response, err := c.transactionUseCase.GetAccountProjection(ctx.Request.Context(), req)
+	if err != nil {
+		c.logger.Error("Failed to get account projection", "error", err, "accountID", req.AccountID)
+		HandleError(ctx, err)
+		return
+	}
+
+	Respond(ctx, http.StatusOK, MsgAccountProjectionRetrieved, response)
 }
 
 // CancelTransaction cancels a transaction
@@ -194,17 +750,41 @@ func (c *TransactionController) CancelTransaction(ctx *gin.Context) {
 
 	req := dto.CancelTransactionRequest{ID: id}
 
-	err := c.transactionUseCase.CancelTransaction(ctx.Request.Context(), req)
+	response, err := c.transactionUseCase.CancelTransaction(ctx.Request.Context(), req)
 	if err != nil {
 		c.logger.Error("Failed to cancel transaction", "error", err, "transactionID", id)
 		HandleError(ctx, err)
 		return
 	}
 
-	c.logger.Info("Transaction cancelled successfully", "transactionID", id)
-	ctx.JSON(http.StatusOK, dto.SuccessResponse{
-		Message: "Transaction cancelled successfully",
-	})
+	c.logger.Info("Transaction cancelled successfully", "transactionID", id, "outcome", response.Outcome)
+	if response.Outcome == dto.CancellationOutcomeReversed {
+		Respond(ctx, http.StatusOK, MsgTransactionReversed, response)
+		return
+	}
+	Respond(ctx, http.StatusOK, MsgTransactionCancelled, response)
+}
+
+// ReverseTransaction moves the money of a completed transaction back
+func (c *TransactionController) ReverseTransaction(ctx *gin.Context) {
+	id := ctx.Param("id")
+	if id == "" {
+		c.logger.Error("Transaction ID is required")
+		HandleError(ctx, &ValidationError{Field: "id", Message: "transaction ID is required"})
+		return
+	}
+
+	req := dto.ReverseTransactionRequest{ID: id}
+
+	response, err := c.transactionUseCase.ReverseTransaction(ctx.Request.Context(), req)
+	if err != nil {
+		c.logger.Error("Failed to reverse transaction", "error", err, "transactionID", id)
+		HandleError(ctx, err)
+		return
+	}
+
+	c.logger.Info("Transaction reversed successfully", "transactionID", id, "reversalID", response.Reversal.ID)
+	Respond(ctx, http.StatusCreated, MsgTransactionReversalCreated, response)
 }
 
 // GetTransactionsByStatus retrieves transactions by status
@@ -245,9 +825,68 @@ func (c *TransactionController) GetTransactionsByStatus(ctx *gin.Context) {
 		return
 	}
 
+	if !c.includeRelated(ctx, response.Transactions) {
+		return
+	}
+
 	c.logger.Debug("Transactions by status retrieved successfully", "status", status, "count", len(response.Transactions))
-	ctx.JSON(http.StatusOK, dto.SuccessResponse{
-		Message: "Transactions by status retrieved successfully",
-		Data:    response,
-	})
+	SetPaginationHeaders(ctx, response.Pagination)
+	Respond(ctx, http.StatusOK, MsgTransactionsByStatusRetrieved, response)
+}
+
+// GetTransactionsByChannel retrieves transactions initiated through a channel
+func (c *TransactionController) GetTransactionsByChannel(ctx *gin.Context) {
+	channel := ctx.Param("channel")
+
+	page, _ := strconv.Atoi(ctx.DefaultQuery("page", "1"))
+	pageSize, _ := strconv.Atoi(ctx.DefaultQuery("page_size", "10"))
+
+	req := dto.ListRequest{
+		Page:     page,
+		PageSize: pageSize,
+	}
+
+	// Validate request
+	if err := ValidateStruct(req); err != nil {
+		c.logger.Error("Validation failed", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+
+	response, err := c.transactionUseCase.GetTransactionsByChannel(ctx.Request.Context(), channel, req)
+	if err != nil {
+		c.logger.Error("Failed to get transactions by channel", "error", err, "channel", channel)
+		HandleError(ctx, err)
+		return
+	}
+
+	if !c.includeRelated(ctx, response.Transactions) {
+		return
+	}
+
+	SetPaginationHeaders(ctx, response.Pagination)
+	Respond(ctx, http.StatusOK, MsgTransactionsByChannelRetrieved, response)
+}
+
+// includeRelated embeds the related resources named by the include query parameter in transaction
+// responses; only include=accounts is supported. It reports whether the request may proceed.
+func (c *TransactionController) includeRelated(ctx *gin.Context, responses []dto.TransactionResponse) bool {
+	include := ctx.Query("include")
+	if include == "" {
+		return true
+	}
+
+	for _, related := range strings.Split(include, ",") {
+		if strings.TrimSpace(related) != "accounts" {
+			HandleError(ctx, &ValidationError{Field: "include", Message: fmt.Sprintf("unsupported include: %s", related)})
+			return false
+		}
+	}
+
+	if err := c.transactionUseCase.IncludeAccounts(ctx.Request.Context(), responses); err != nil {
+		c.logger.Error("Failed to include accounts in transactions", "error", err)
+		HandleError(ctx, err)
+		return false
+	}
+	return true
 }
@@ -0,0 +1,113 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	usecase "github.com/hydr0g3nz/mini_bank/internal/application"
+	"github.com/hydr0g3nz/mini_bank/internal/application/dto"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/infra"
+)
+
+type TransactionHistoryController struct {
+	historyUseCase usecase.TransactionHistoryUseCase
+	logger         infra.Logger
+}
+
+func NewTransactionHistoryController(historyUseCase usecase.TransactionHistoryUseCase, logger infra.Logger) *TransactionHistoryController {
+	return &TransactionHistoryController{
+		historyUseCase: historyUseCase,
+		logger:         logger,
+	}
+}
+
+// Routes declares the transaction history routes
+func (c *TransactionHistoryController) Routes() []Route {
+	return []Route{
+		{Method: http.MethodGet, Path: "/accounts/:id/history", Handler: c.GetAccountHistory, Summary: "List an account's transaction history"},
+		{Method: http.MethodGet, Path: "/accounts/:id/history/sync", Handler: c.SyncAccountHistory, Summary: "List an account's completed transactions after a sequence number"},
+		{Method: http.MethodPost, Path: "/admin/accounts/:id/history/rebuild", Handler: c.RebuildAccountHistory, Summary: "Rebuild an account's transaction history", Limit: LimitAdmin},
+	}
+}
+
+// GetAccountHistory retrieves an account's transaction history, optionally narrowed by tag (repeatable,
+// any of them matches), direction, type and a saved filter
+func (c *TransactionHistoryController) GetAccountHistory(ctx *gin.Context) {
+	accountID := ctx.Param("id")
+
+	page, _ := strconv.Atoi(ctx.DefaultQuery("page", "1"))
+	pageSize, _ := strconv.Atoi(ctx.DefaultQuery("page_size", "10"))
+
+	req := dto.HistoryRequest{
+		ListRequest: dto.ListRequest{
+			Page:     page,
+			PageSize: pageSize,
+		},
+		Tags:            ctx.QueryArray("tag"),
+		Direction:       strings.ToUpper(ctx.Query("direction")),
+		TransactionType: strings.ToUpper(ctx.Query("type")),
+		FilterID:        ctx.Query("filter_id"),
+	}
+
+	// Validate request
+	if err := ValidateStruct(req); err != nil {
+		c.logger.Error("Validation failed", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+
+	response, err := c.historyUseCase.GetAccountHistory(ctx.Request.Context(), accountID, req)
+	if err != nil {
+		c.logger.Error("Failed to get transaction history", "error", err, "accountID", accountID)
+		HandleError(ctx, err)
+		return
+	}
+
+	SetPaginationHeaders(ctx, response.Pagination)
+	Respond(ctx, http.StatusOK, MsgTransactionHistoryRetrieved, response)
+}
+
+// SyncAccountHistory retrieves an account's completed transactions after the sequence number in after_seq
+func (c *TransactionHistoryController) SyncAccountHistory(ctx *gin.Context) {
+	accountID := ctx.Param("id")
+
+	afterSequence, _ := strconv.ParseInt(ctx.DefaultQuery("after_seq", "0"), 10, 64)
+	limit, _ := strconv.Atoi(ctx.DefaultQuery("limit", "100"))
+
+	req := dto.HistorySyncRequest{
+		AfterSequence: afterSequence,
+		Limit:         limit,
+	}
+
+	// Validate request
+	if err := ValidateStruct(req); err != nil {
+		c.logger.Error("Validation failed", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+
+	response, err := c.historyUseCase.SyncAccountHistory(ctx.Request.Context(), accountID, req)
+	if err != nil {
+		c.logger.Error("Failed to sync transaction history", "error", err, "accountID", accountID)
+		HandleError(ctx, err)
+		return
+	}
+
+	Respond(ctx, http.StatusOK, MsgTransactionHistoryRetrieved, response)
+}
+
+// RebuildAccountHistory projects every transaction of an account into its history again
+func (c *TransactionHistoryController) RebuildAccountHistory(ctx *gin.Context) {
+	accountID := ctx.Param("id")
+
+	response, err := c.historyUseCase.RebuildAccountHistory(ctx.Request.Context(), accountID)
+	if err != nil {
+		c.logger.Error("Failed to rebuild transaction history", "error", err, "accountID", accountID)
+		HandleError(ctx, err)
+		return
+	}
+
+	Respond(ctx, http.StatusOK, MsgTransactionHistoryRebuilt, response)
+}
@@ -0,0 +1,131 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	usecase "github.com/hydr0g3nz/mini_bank/internal/application"
+	"github.com/hydr0g3nz/mini_bank/internal/application/dto"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/infra"
+)
+
+type TransactionTagController struct {
+	tagUseCase usecase.TransactionTagUseCase
+	logger     infra.Logger
+}
+
+func NewTransactionTagController(tagUseCase usecase.TransactionTagUseCase, logger infra.Logger) *TransactionTagController {
+	return &TransactionTagController{
+		tagUseCase: tagUseCase,
+		logger:     logger,
+	}
+}
+
+// Routes declares the transaction tag and saved history filter routes
+func (c *TransactionTagController) Routes() []Route {
+	return []Route{
+		{Method: http.MethodPut, Path: "/accounts/:id/transactions/:transaction_id/tags", Handler: c.SetTransactionTags, Summary: "Replace an account's tags of a transaction"},
+		{Method: http.MethodPost, Path: "/accounts/:id/history-filters", Handler: c.CreateSavedFilter, Summary: "Save a history filter"},
+		{Method: http.MethodGet, Path: "/accounts/:id/history-filters", Handler: c.ListSavedFilters, Summary: "List an account's saved history filters"},
+		{Method: http.MethodGet, Path: "/accounts/:id/history-filters/:filter_id", Handler: c.GetSavedFilter, Summary: "Get a saved history filter"},
+		{Method: http.MethodDelete, Path: "/accounts/:id/history-filters/:filter_id", Handler: c.DeleteSavedFilter, Summary: "Remove a saved history filter"},
+	}
+}
+
+// SetTransactionTags replaces the tags an account put on one of its transactions
+func (c *TransactionTagController) SetTransactionTags(ctx *gin.Context) {
+	var req dto.SetTransactionTagsRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		c.logger.Error("Failed to bind JSON", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+	req.AccountID = ctx.Param("id")
+	req.TransactionID = ctx.Param("transaction_id")
+
+	// Validate request
+	if err := ValidateStruct(req); err != nil {
+		c.logger.Error("Validation failed", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+
+	response, err := c.tagUseCase.SetTransactionTags(ctx.Request.Context(), req)
+	if err != nil {
+		c.logger.Error("Failed to set transaction tags", "error", err, "accountID", req.AccountID, "transactionID", req.TransactionID)
+		HandleError(ctx, err)
+		return
+	}
+
+	Respond(ctx, http.StatusOK, MsgTransactionTagsSet, response)
+}
+
+// CreateSavedFilter saves a named history filter for an account
+func (c *TransactionTagController) CreateSavedFilter(ctx *gin.Context) {
+	var req dto.SavedFilterRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		c.logger.Error("Failed to bind JSON", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+	req.AccountID = ctx.Param("id")
+
+	// Validate request
+	if err := ValidateStruct(req); err != nil {
+		c.logger.Error("Validation failed", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+
+	response, err := c.tagUseCase.CreateSavedFilter(ctx.Request.Context(), req)
+	if err != nil {
+		c.logger.Error("Failed to create saved filter", "error", err, "accountID", req.AccountID)
+		HandleError(ctx, err)
+		return
+	}
+
+	Respond(ctx, http.StatusCreated, MsgSavedFilterCreated, response)
+}
+
+// GetSavedFilter retrieves a saved history filter of an account
+func (c *TransactionTagController) GetSavedFilter(ctx *gin.Context) {
+	accountID := ctx.Param("id")
+	filterID := ctx.Param("filter_id")
+
+	response, err := c.tagUseCase.GetSavedFilter(ctx.Request.Context(), accountID, filterID)
+	if err != nil {
+		c.logger.Error("Failed to get saved filter", "error", err, "accountID", accountID, "filterID", filterID)
+		HandleError(ctx, err)
+		return
+	}
+
+	Respond(ctx, http.StatusOK, MsgSavedFilterRetrieved, response)
+}
+
+// ListSavedFilters retrieves the saved history filters of an account
+func (c *TransactionTagController) ListSavedFilters(ctx *gin.Context) {
+	accountID := ctx.Param("id")
+
+	response, err := c.tagUseCase.ListSavedFilters(ctx.Request.Context(), accountID)
+	if err != nil {
+		c.logger.Error("Failed to list saved filters", "error", err, "accountID", accountID)
+		HandleError(ctx, err)
+		return
+	}
+
+	Respond(ctx, http.StatusOK, MsgSavedFiltersRetrieved, response)
+}
+
+// DeleteSavedFilter removes a saved history filter of an account
+func (c *TransactionTagController) DeleteSavedFilter(ctx *gin.Context) {
+	accountID := ctx.Param("id")
+	filterID := ctx.Param("filter_id")
+
+	if err := c.tagUseCase.DeleteSavedFilter(ctx.Request.Context(), accountID, filterID); err != nil {
+		c.logger.Error("Failed to delete saved filter", "error", err, "accountID", accountID, "filterID", filterID)
+		HandleError(ctx, err)
+		return
+	}
+
+	Respond(ctx, http.StatusOK, MsgSavedFilterDeleted, nil)
+}
@@ -0,0 +1,162 @@
+package controller
+
+import (
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	usecase "github.com/hydr0g3nz/mini_bank/internal/application"
+	"github.com/hydr0g3nz/mini_bank/internal/application/dto"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/infra"
+)
+
+type TransferTemplateController struct {
+	templateUseCase usecase.TransferTemplateUseCase
+	logger          infra.Logger
+}
+
+func NewTransferTemplateController(templateUseCase usecase.TransferTemplateUseCase, logger infra.Logger) *TransferTemplateController {
+	return &TransferTemplateController{
+		templateUseCase: templateUseCase,
+		logger:          logger,
+	}
+}
+
+// Routes declares the transfer template routes
+func (c *TransferTemplateController) Routes() []Route {
+	return []Route{
+		{Method: http.MethodPost, Path: "/accounts/:id/transfer-templates", Handler: c.CreateTemplate, Summary: "Save a transfer template"},
+		{Method: http.MethodGet, Path: "/accounts/:id/transfer-templates", Handler: c.ListTemplates, Summary: "List an account's transfer templates"},
+		{Method: http.MethodGet, Path: "/accounts/:id/transfer-templates/:template_id", Handler: c.GetTemplate, Summary: "Get a transfer template"},
+		{Method: http.MethodPut, Path: "/accounts/:id/transfer-templates/:template_id", Handler: c.UpdateTemplate, Summary: "Update a transfer template"},
+		{Method: http.MethodDelete, Path: "/accounts/:id/transfer-templates/:template_id", Handler: c.DeleteTemplate, Summary: "Remove a transfer template"},
+		{Method: http.MethodPost, Path: "/accounts/:id/transfer-templates/:template_id/execute", Handler: c.ExecuteTemplate, Summary: "Execute a transfer template"},
+	}
+}
+
+// CreateTemplate saves a transfer template for an account
+func (c *TransferTemplateController) CreateTemplate(ctx *gin.Context) {
+	var req dto.TransferTemplateRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		c.logger.Error("Failed to bind JSON", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+	req.AccountID = ctx.Param("id")
+
+	// Validate request
+	if err := ValidateStructFor(ProfileCreate, req); err != nil {
+		c.logger.Error("Validation failed", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+
+	response, err := c.templateUseCase.CreateTemplate(ctx.Request.Context(), req)
+	if err != nil {
+		c.logger.Error("Failed to create transfer template", "error", err, "accountID", req.AccountID)
+		HandleError(ctx, err)
+		return
+	}
+
+	Respond(ctx, http.StatusCreated, MsgTransferTemplateCreated, response)
+}
+
+// GetTemplate retrieves a transfer template of an account
+func (c *TransferTemplateController) GetTemplate(ctx *gin.Context) {
+	accountID := ctx.Param("id")
+	templateID := ctx.Param("template_id")
+
+	response, err := c.templateUseCase.GetTemplate(ctx.Request.Context(), accountID, templateID)
+	if err != nil {
+		c.logger.Error("Failed to get transfer template", "error", err, "accountID", accountID, "templateID", templateID)
+		HandleError(ctx, err)
+		return
+	}
+
+	Respond(ctx, http.StatusOK, MsgTransferTemplateRetrieved, response)
+}
+
+// ListTemplates retrieves the transfer templates of an account
+func (c *TransferTemplateController) ListTemplates(ctx *gin.Context) {
+	accountID := ctx.Param("id")
+
+	response, err := c.templateUseCase.ListTemplates(ctx.Request.Context(), accountID)
+	if err != nil {
+		c.logger.Error("Failed to list transfer templates", "error", err, "accountID", accountID)
+		HandleError(ctx, err)
+		return
+	}
+
+	Respond(ctx, http.StatusOK, MsgTransferTemplatesRetrieved, response)
+}
+
+// UpdateTemplate changes a transfer template
+func (c *TransferTemplateController) UpdateTemplate(ctx *gin.Context) {
+	var req dto.TransferTemplateRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		c.logger.Error("Failed to bind JSON", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+	req.AccountID = ctx.Param("id")
+	req.ID = ctx.Param("template_id")
+
+	// Validate request
+	if err := ValidateStructFor(ProfileUpdate, req); err != nil {
+		c.logger.Error("Validation failed", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+
+	response, err := c.templateUseCase.UpdateTemplate(ctx.Request.Context(), req)
+	if err != nil {
+		c.logger.Error("Failed to update transfer template", "error", err, "templateID", req.ID)
+		HandleError(ctx, err)
+		return
+	}
+
+	Respond(ctx, http.StatusOK, MsgTransferTemplateUpdated, response)
+}
+
+// DeleteTemplate removes a transfer template of an account
+func (c *TransferTemplateController) DeleteTemplate(ctx *gin.Context) {
+	accountID := ctx.Param("id")
+	templateID := ctx.Param("template_id")
+
+	if err := c.templateUseCase.DeleteTemplate(ctx.Request.Context(), accountID, templateID); err != nil {
+		c.logger.Error("Failed to delete transfer template", "error", err, "accountID", accountID, "templateID", templateID)
+		HandleError(ctx, err)
+		return
+	}
+
+	Respond(ctx, http.StatusOK, MsgTransferTemplateDeleted, nil)
+}
+
+// ExecuteTemplate creates and confirms a transfer from a template; the body is optional
+func (c *TransferTemplateController) ExecuteTemplate(ctx *gin.Context) {
+	var req dto.ExecuteTransferTemplateRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil && !errors.Is(err, io.EOF) {
+		c.logger.Error("Failed to bind JSON", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+	req.AccountID = ctx.Param("id")
+	req.ID = ctx.Param("template_id")
+
+	// Validate request
+	if err := ValidateStruct(req); err != nil {
+		c.logger.Error("Validation failed", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+
+	response, err := c.templateUseCase.ExecuteTemplate(ctx.Request.Context(), req)
+	if err != nil {
+		c.logger.Error("Failed to execute transfer template", "error", err, "templateID", req.ID)
+		HandleError(ctx, err)
+		return
+	}
+
+	Respond(ctx, http.StatusCreated, MsgTransferTemplateExecuted, response)
+}
@@ -0,0 +1,42 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	usecase "github.com/hydr0g3nz/mini_bank/internal/application"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/infra"
+)
+
+type VelocityController struct {
+	velocityUseCase usecase.VelocityUseCase
+	logger          infra.Logger
+}
+
+func NewVelocityController(velocityUseCase usecase.VelocityUseCase, logger infra.Logger) *VelocityController {
+	return &VelocityController{
+		velocityUseCase: velocityUseCase,
+		logger:          logger,
+	}
+}
+
+// Routes declares the velocity routes
+func (c *VelocityController) Routes() []Route {
+	return []Route{
+		{Method: http.MethodGet, Path: "/accounts/:id/velocity", Handler: c.GetVelocityUsage, Summary: "Get an account's usage of its velocity limits"},
+	}
+}
+
+// GetVelocityUsage retrieves what an account has sent out today and this hour against its velocity limits
+func (c *VelocityController) GetVelocityUsage(ctx *gin.Context) {
+	accountID := ctx.Param("id")
+
+	response, err := c.velocityUseCase.GetVelocityUsage(ctx.Request.Context(), accountID)
+	if err != nil {
+		c.logger.Error("Failed to get velocity usage", "error", err, "accountID", accountID)
+		HandleError(ctx, err)
+		return
+	}
+
+	Respond(ctx, http.StatusOK, MsgVelocityUsageRetrieved, response)
+}
@@ -0,0 +1,178 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	usecase "github.com/hydr0g3nz/mini_bank/internal/application"
+	"github.com/hydr0g3nz/mini_bank/internal/application/dto"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/infra"
+)
+
+type VirtualAccountController struct {
+	virtualAccountUseCase usecase.VirtualAccountUseCase
+	logger                infra.Logger
+}
+
+func NewVirtualAccountController(virtualAccountUseCase usecase.VirtualAccountUseCase, logger infra.Logger) *VirtualAccountController {
+	return &VirtualAccountController{
+		virtualAccountUseCase: virtualAccountUseCase,
+		logger:                logger,
+	}
+}
+
+// Routes declares the virtual account routes
+func (c *VirtualAccountController) Routes() []Route {
+	return []Route{
+		{Method: http.MethodPost, Path: "/accounts/:id/virtual-accounts", Handler: c.CreateVirtualAccount, Summary: "Issue a virtual account number"},
+		{Method: http.MethodGet, Path: "/accounts/:id/virtual-accounts", Handler: c.ListVirtualAccounts, Summary: "List an account's virtual accounts"},
+		{Method: http.MethodPut, Path: "/accounts/:id/virtual-accounts/:virtual_id", Handler: c.UpdateVirtualAccount, Summary: "Relabel, close or reopen a virtual account"},
+		{Method: http.MethodDelete, Path: "/accounts/:id/virtual-accounts/:virtual_id", Handler: c.DeleteVirtualAccount, Summary: "Remove a virtual account"},
+		{Method: http.MethodGet, Path: "/virtual-accounts/:virtual_id", Handler: c.GetVirtualAccount, Summary: "Look up a virtual account"},
+		{Method: http.MethodGet, Path: "/virtual-accounts/:virtual_id/transactions", Handler: c.GetVirtualAccountTransactions, Summary: "List the transactions paid to a virtual account"},
+	}
+}
+
+// CreateVirtualAccount issues a virtual account number for the settlement account in the path
+func (c *VirtualAccountController) CreateVirtualAccount(ctx *gin.Context) {
+	var req dto.CreateVirtualAccountRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		c.logger.Error("Failed to bind JSON", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+	req.AccountID = ctx.Param("id")
+
+	// Validate request
+	if err := ValidateStruct(req); err != nil {
+		c.logger.Error("Validation failed", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+
+	response, err := c.virtualAccountUseCase.CreateVirtualAccount(ctx.Request.Context(), req)
+	if err != nil {
+		c.logger.Error("Failed to create virtual account", "error", err, "accountID", req.AccountID)
+		HandleError(ctx, err)
+		return
+	}
+
+	Respond(ctx, http.StatusCreated, MsgVirtualAccountCreated, response)
+}
+
+// GetVirtualAccount looks up a virtual account number
+func (c *VirtualAccountController) GetVirtualAccount(ctx *gin.Context) {
+	id := ctx.Param("virtual_id")
+
+	response, err := c.virtualAccountUseCase.GetVirtualAccount(ctx.Request.Context(), id)
+	if err != nil {
+		c.logger.Error("Failed to get virtual account", "error", err, "virtualAccountID", id)
+		HandleError(ctx, err)
+		return
+	}
+
+	Respond(ctx, http.StatusOK, MsgVirtualAccountRetrieved, response)
+}
+
+// ListVirtualAccounts retrieves the virtual accounts of the settlement account in the path
+func (c *VirtualAccountController) ListVirtualAccounts(ctx *gin.Context) {
+	accountID := ctx.Param("id")
+
+	req, ok := c.bindListRequest(ctx)
+	if !ok {
+		return
+	}
+
+	response, err := c.virtualAccountUseCase.ListVirtualAccounts(ctx.Request.Context(), accountID, req)
+	if err != nil {
+		c.logger.Error("Failed to list virtual accounts", "error", err, "accountID", accountID)
+		HandleError(ctx, err)
+		return
+	}
+
+	SetPaginationHeaders(ctx, response.Pagination)
+	Respond(ctx, http.StatusOK, MsgVirtualAccountsRetrieved, response)
+}
+
+// UpdateVirtualAccount relabels, closes or reopens a virtual account
+func (c *VirtualAccountController) UpdateVirtualAccount(ctx *gin.Context) {
+	var req dto.UpdateVirtualAccountRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		c.logger.Error("Failed to bind JSON", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+	req.AccountID = ctx.Param("id")
+	req.ID = ctx.Param("virtual_id")
+
+	// Validate request
+	if err := ValidateStruct(req); err != nil {
+		c.logger.Error("Validation failed", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+
+	response, err := c.virtualAccountUseCase.UpdateVirtualAccount(ctx.Request.Context(), req)
+	if err != nil {
+		c.logger.Error("Failed to update virtual account", "error", err, "virtualAccountID", req.ID)
+		HandleError(ctx, err)
+		return
+	}
+
+	Respond(ctx, http.StatusOK, MsgVirtualAccountUpdated, response)
+}
+
+// DeleteVirtualAccount removes a virtual account
+func (c *VirtualAccountController) DeleteVirtualAccount(ctx *gin.Context) {
+	accountID := ctx.Param("id")
+	id := ctx.Param("virtual_id")
+
+	if err := c.virtualAccountUseCase.DeleteVirtualAccount(ctx.Request.Context(), accountID, id); err != nil {
+		c.logger.Error("Failed to delete virtual account", "error", err, "accountID", accountID, "virtualAccountID", id)
+		HandleError(ctx, err)
+		return
+	}
+
+	Respond(ctx, http.StatusOK, MsgVirtualAccountDeleted, nil)
+}
+
+// GetVirtualAccountTransactions retrieves the transactions paid to a virtual account number
+func (c *VirtualAccountController) GetVirtualAccountTransactions(ctx *gin.Context) {
+	id := ctx.Param("virtual_id")
+
+	req, ok := c.bindListRequest(ctx)
+	if !ok {
+		return
+	}
+
+	response, err := c.virtualAccountUseCase.GetVirtualAccountTransactions(ctx.Request.Context(), id, req)
+	if err != nil {
+		c.logger.Error("Failed to get virtual account transactions", "error", err, "virtualAccountID", id)
+		HandleError(ctx, err)
+		return
+	}
+
+	SetPaginationHeaders(ctx, response.Pagination)
+	Respond(ctx, http.StatusOK, MsgVirtualAccountTransactionsRetrieved, response)
+}
+
+// bindListRequest parses and validates the pagination query parameters, writing the error response if invalid
+func (c *VirtualAccountController) bindListRequest(ctx *gin.Context) (dto.ListRequest, bool) {
+	page, _ := strconv.Atoi(ctx.DefaultQuery("page", "1"))
+	pageSize, _ := strconv.Atoi(ctx.DefaultQuery("page_size", "10"))
+
+	req := dto.ListRequest{
+		Page:     page,
+		PageSize: pageSize,
+	}
+
+	// Validate request
+	if err := ValidateStruct(req); err != nil {
+		c.logger.Error("Validation failed", "error", err)
+		HandleError(ctx, err)
+		return req, false
+	}
+
+	return req, true
+}
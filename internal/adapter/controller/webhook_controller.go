@@ -0,0 +1,135 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	usecase "github.com/hydr0g3nz/mini_bank/internal/application"
+	"github.com/hydr0g3nz/mini_bank/internal/application/dto"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/infra"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+)
+
+type WebhookController struct {
+	webhookUseCase usecase.WebhookUseCase
+	logger         infra.Logger
+}
+
+func NewWebhookController(webhookUseCase usecase.WebhookUseCase, logger infra.Logger) *WebhookController {
+	return &WebhookController{
+		webhookUseCase: webhookUseCase,
+		logger:         logger,
+	}
+}
+
+// Routes declares the webhook routes
+func (c *WebhookController) Routes() []Route {
+	return []Route{
+		{Method: http.MethodPost, Path: "/accounts/:id/webhooks", Handler: c.CreateWebhook, Summary: "Subscribe to account events", Permission: vo.AdminPermissionManageWebhooks},
+		{Method: http.MethodGet, Path: "/accounts/:id/webhooks", Handler: c.ListWebhooks, Summary: "List an account's webhook subscriptions", Permission: vo.AdminPermissionManageWebhooks},
+		{Method: http.MethodGet, Path: "/accounts/:id/webhooks/:webhook_id", Handler: c.GetWebhook, Summary: "Get a webhook subscription", Permission: vo.AdminPermissionManageWebhooks},
+		{Method: http.MethodDelete, Path: "/accounts/:id/webhooks/:webhook_id", Handler: c.DeleteWebhook, Summary: "Remove a webhook subscription", Permission: vo.AdminPermissionManageWebhooks},
+		{Method: http.MethodPatch, Path: "/accounts/:id/webhooks/:webhook_id/enable", Handler: c.EnableWebhook, Summary: "Re-enable a webhook subscription", Permission: vo.AdminPermissionManageWebhooks},
+		{Method: http.MethodPost, Path: "/accounts/:id/webhooks/:webhook_id/test", Handler: c.TestWebhook, Summary: "Send a test webhook", Permission: vo.AdminPermissionManageWebhooks},
+	}
+}
+
+// CreateWebhook subscribes a URL to an account's events
+func (c *WebhookController) CreateWebhook(ctx *gin.Context) {
+	var req dto.CreateWebhookRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		c.logger.Error("Failed to bind JSON", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+	req.AccountID = ctx.Param("id")
+
+	// Validate request
+	if err := ValidateStruct(req); err != nil {
+		c.logger.Error("Validation failed", "error", err)
+		HandleError(ctx, err)
+		return
+	}
+
+	response, err := c.webhookUseCase.CreateWebhook(ctx.Request.Context(), req)
+	if err != nil {
+		c.logger.Error("Failed to create webhook", "error", err, "accountID", req.AccountID)
+		HandleError(ctx, err)
+		return
+	}
+
+	Respond(ctx, http.StatusCreated, MsgWebhookCreated, response)
+}
+
+// GetWebhook retrieves a webhook subscription of an account
+func (c *WebhookController) GetWebhook(ctx *gin.Context) {
+	accountID := ctx.Param("id")
+	webhookID := ctx.Param("webhook_id")
+
+	response, err := c.webhookUseCase.GetWebhook(ctx.Request.Context(), accountID, webhookID)
+	if err != nil {
+		c.logger.Error("Failed to get webhook", "error", err, "accountID", accountID, "webhookID", webhookID)
+		HandleError(ctx, err)
+		return
+	}
+
+	Respond(ctx, http.StatusOK, MsgWebhookRetrieved, response)
+}
+
+// ListWebhooks retrieves the webhook subscriptions of an account
+func (c *WebhookController) ListWebhooks(ctx *gin.Context) {
+	accountID := ctx.Param("id")
+
+	response, err := c.webhookUseCase.ListWebhooks(ctx.Request.Context(), accountID)
+	if err != nil {
+		c.logger.Error("Failed to list webhooks", "error", err, "accountID", accountID)
+		HandleError(ctx, err)
+		return
+	}
+
+	Respond(ctx, http.StatusOK, MsgWebhooksRetrieved, response)
+}
+
+// DeleteWebhook removes a webhook subscription of an account
+func (c *WebhookController) DeleteWebhook(ctx *gin.Context) {
+	accountID := ctx.Param("id")
+	webhookID := ctx.Param("webhook_id")
+
+	if err := c.webhookUseCase.DeleteWebhook(ctx.Request.Context(), accountID, webhookID); err != nil {
+		c.logger.Error("Failed to delete webhook", "error", err, "accountID", accountID, "webhookID", webhookID)
+		HandleError(ctx, err)
+		return
+	}
+
+	Respond(ctx, http.StatusOK, MsgWebhookDeleted, nil)
+}
+
+// EnableWebhook re-activates a webhook subscription disabled after failed deliveries
+func (c *WebhookController) EnableWebhook(ctx *gin.Context) {
+	accountID := ctx.Param("id")
+	webhookID := ctx.Param("webhook_id")
+
+	response, err := c.webhookUseCase.EnableWebhook(ctx.Request.Context(), accountID, webhookID)
+	if err != nil {
+		c.logger.Error("Failed to enable webhook", "error", err, "accountID", accountID, "webhookID", webhookID)
+		HandleError(ctx, err)
+		return
+	}
+
+	Respond(ctx, http.StatusOK, MsgWebhookEnabled, response)
+}
+
+// TestWebhook sends a test event to the subscription URL
+func (c *WebhookController) TestWebhook(ctx *gin.Context) {
+	accountID := ctx.Param("id")
+	webhookID := ctx.Param("webhook_id")
+
+	response, err := c.webhookUseCase.TestWebhook(ctx.Request.Context(), accountID, webhookID)
+	if err != nil {
+		c.logger.Error("Failed to test webhook", "error", err, "accountID", accountID, "webhookID", webhookID)
+		HandleError(ctx, err)
+		return
+	}
+
+	Respond(ctx, http.StatusOK, MsgWebhookTested, response)
+}
@@ -0,0 +1,163 @@
+package messaging
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/adapter/controller"
+	usecase "github.com/hydr0g3nz/mini_bank/internal/application"
+	"github.com/hydr0g3nz/mini_bank/internal/application/dto"
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/infra"
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+// retryDelay is how long a command waits before redelivery after a transient failure
+const retryDelay = 5 * time.Second
+
+// TransferConsumerConfig holds transfer command consumer configuration
+type TransferConsumerConfig struct {
+	Stream         string
+	CommandSubject string
+	ResultSubject  string
+	Durable        string
+	MaxDeliver     int
+}
+
+// TransferCommandConsumer consumes transfer commands from JetStream and publishes their results
+type TransferCommandConsumer struct {
+	js             jetstream.JetStream
+	commandUseCase usecase.CommandUseCase
+	config         TransferConsumerConfig
+	logger         infra.Logger
+	consumeCtx     jetstream.ConsumeContext
+}
+
+// NewTransferCommandConsumer creates a new transfer command consumer
+func NewTransferCommandConsumer(
+	js jetstream.JetStream,
+	commandUseCase usecase.CommandUseCase,
+	config TransferConsumerConfig,
+	logger infra.Logger,
+) *TransferCommandConsumer {
+	return &TransferCommandConsumer{
+		js:             js,
+		commandUseCase: commandUseCase,
+		config:         config,
+		logger:         logger,
+	}
+}
+
+// Start attaches the durable consumer and begins processing commands
+func (c *TransferCommandConsumer) Start(ctx context.Context) error {
+	consumer, err := c.js.CreateOrUpdateConsumer(ctx, c.config.Stream, jetstream.ConsumerConfig{
+		Durable:       c.config.Durable,
+		FilterSubject: c.config.CommandSubject,
+		AckPolicy:     jetstream.AckExplicitPolicy,
+		MaxDeliver:    c.config.MaxDeliver,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create consumer: %w", err)
+	}
+
+	c.consumeCtx, err = consumer.Consume(c.handle)
+	if err != nil {
+		return fmt.Errorf("failed to start consuming: %w", err)
+	}
+
+	return nil
+}
+
+// Stop stops consuming new commands
+func (c *TransferCommandConsumer) Stop() {
+	if c.consumeCtx != nil {
+		c.consumeCtx.Stop()
+	}
+}
+
+// handle processes a single command message.
+// Rejected commands are acknowledged with a REJECTED result; transient failures are redelivered.
+func (c *TransferCommandConsumer) handle(msg jetstream.Msg) {
+	ctx := context.Background()
+
+	messageID := msg.Headers().Get(nats.MsgIdHdr)
+	if messageID == "" {
+		c.logger.Warn("Discarding transfer command without message ID", "subject", msg.Subject())
+		if err := msg.Term(); err != nil {
+			c.logger.Warn("Failed to terminate message", "error", err)
+		}
+		return
+	}
+
+	var cmd dto.TransferCommand
+	if err := json.Unmarshal(msg.Data(), &cmd); err != nil {
+		c.complete(ctx, msg, c.rejection(messageID, fmt.Errorf("%w: %v", errs.ErrInvalidInput, err)))
+		return
+	}
+	cmd.CommandID = messageID
+
+	if err := controller.ValidateStruct(cmd); err != nil {
+		c.complete(ctx, msg, c.rejection(messageID, err))
+		return
+	}
+
+	response, err := c.commandUseCase.ExecuteTransfer(ctx, cmd)
+	if err != nil {
+		if statusCode, _ := controller.MapError(err); statusCode >= http.StatusInternalServerError {
+			c.logger.Warn("Transfer command failed, scheduling redelivery", "error", err, "commandID", messageID)
+			if nakErr := msg.NakWithDelay(retryDelay); nakErr != nil {
+				c.logger.Warn("Failed to nak message", "error", nakErr, "commandID", messageID)
+			}
+			return
+		}
+		c.complete(ctx, msg, c.rejection(messageID, err))
+		return
+	}
+
+	c.complete(ctx, msg, dto.CommandResult{
+		CommandID:   messageID,
+		Status:      dto.CommandStatusCompleted,
+		Transaction: response,
+		ProcessedAt: time.Now(),
+	})
+}
+
+// rejection builds a REJECTED result using the same error codes as the HTTP API
+func (c *TransferCommandConsumer) rejection(commandID string, err error) dto.CommandResult {
+	_, errorResponse := controller.MapError(err)
+	c.logger.Info("Transfer command rejected", "commandID", commandID, "code", errorResponse.Code)
+
+	return dto.CommandResult{
+		CommandID:   commandID,
+		Status:      dto.CommandStatusRejected,
+		Error:       &errorResponse,
+		ProcessedAt: time.Now(),
+	}
+}
+
+// complete publishes the result and acknowledges the command; if the result
+// cannot be published the command is redelivered and the idempotent replay republishes it
+func (c *TransferCommandConsumer) complete(ctx context.Context, msg jetstream.Msg, result dto.CommandResult) {
+	data, err := json.Marshal(result)
+	if err != nil {
+		c.logger.Error("Failed to marshal command result", "error", err, "commandID", result.CommandID)
+		return
+	}
+
+	_, err = c.js.Publish(ctx, c.config.ResultSubject, data, jetstream.WithMsgID(result.CommandID+":result"))
+	if err != nil {
+		c.logger.Warn("Failed to publish command result", "error", err, "commandID", result.CommandID)
+		if nakErr := msg.NakWithDelay(retryDelay); nakErr != nil {
+			c.logger.Warn("Failed to nak message", "error", nakErr, "commandID", result.CommandID)
+		}
+		return
+	}
+
+	if err := msg.Ack(); err != nil {
+		c.logger.Warn("Failed to ack message", "error", err, "commandID", result.CommandID)
+	}
+}
@@ -11,12 +11,18 @@ import (
 
 type Account struct {
 	gorm.Model
-	AccountID   string          `gorm:"size:16;uniqueIndex;not null"` // Format: YYYYMMDD + 8 digits
-	AccountName string          `gorm:"size:100;not null"`
-	Balance     decimal.Decimal `gorm:"type:decimal(20,2);not null;default:0"`
-	Status      string          `gorm:"size:20;not null;default:'ACTIVE'"` // ACTIVE, INACTIVE, SUSPENDED
-	CreatedAt   time.Time       `gorm:"not null"`
-	UpdatedAt   time.Time       `gorm:"not null"`
+	AccountID       string           `gorm:"size:16;uniqueIndex;not null"` // Format: YYYYMMDD + 8 digits
+	AccountName     string           `gorm:"size:100;not null"`
+	CustomerID      string           `gorm:"size:50;index"`
+	ProductID       string           `gorm:"size:32;index"`
+	ParentAccountID *string          `gorm:"size:16;index"` // Parent of a corporate child account
+	SpendingLimit   *decimal.Decimal `gorm:"type:decimal(20,2)"`
+	Balance         decimal.Decimal  `gorm:"type:decimal(20,2);not null;default:0"`
+	Currency        string           `gorm:"size:3;not null;default:''"`        // ISO 4217 code the account is held in
+	OpeningBalance  *decimal.Decimal `gorm:"type:decimal(20,2)"`                // NULL for accounts opened before it was recorded
+	Status          string           `gorm:"size:20;not null;default:'ACTIVE'"` // ACTIVE, INACTIVE, SUSPENDED
+	CreatedAt       time.Time        `gorm:"not null"`
+	UpdatedAt       time.Time        `gorm:"not null"`
 }
 
 // TableName specifies the table name for the Account model
@@ -31,16 +37,44 @@ func (a *Account) ToDomainAccount() (*entity.Account, error) {
 		return nil, err
 	}
 
-	money := vo.NewMoney(a.Balance)
+	var parentAccountID *vo.AccountID
+	if a.ParentAccountID != nil {
+		parentID, err := vo.NewAccountIDFromString(*a.ParentAccountID)
+		if err != nil {
+			return nil, err
+		}
+		parentAccountID = &parentID
+	}
+
+	currency := vo.Currency(a.Currency)
+
+	var spendingLimit *vo.Money
+	if a.SpendingLimit != nil {
+		limit := vo.NewMoneyIn(*a.SpendingLimit, currency)
+		spendingLimit = &limit
+	}
+
+	var openingBalance *vo.Money
+	if a.OpeningBalance != nil {
+		balance := vo.NewMoneyIn(*a.OpeningBalance, currency)
+		openingBalance = &balance
+	}
+
+	money := vo.NewMoneyIn(a.Balance, currency)
 	status := vo.AccountStatus(a.Status)
 
 	return &entity.Account{
-		ID:          accountID,
-		AccountName: a.AccountName,
-		Balance:     money,
-		Status:      status,
-		CreatedAt:   a.CreatedAt,
-		UpdatedAt:   a.UpdatedAt,
+		ID:              accountID,
+		AccountName:     a.AccountName,
+		CustomerID:      a.CustomerID,
+		ProductID:       a.ProductID,
+		ParentAccountID: parentAccountID,
+		SpendingLimit:   spendingLimit,
+		Balance:         money,
+		OpeningBalance:  openingBalance,
+		Status:          status,
+		CreatedAt:       a.CreatedAt,
+		UpdatedAt:       a.UpdatedAt,
 	}, nil
 }
 
@@ -52,10 +86,16 @@ func FromDomainAccount(domainAccount *entity.Account) *Account {
 			CreatedAt: domainAccount.CreatedAt,
 			UpdatedAt: domainAccount.UpdatedAt,
 		},
-		AccountID:   domainAccount.ID.String(),
-		AccountName: domainAccount.AccountName,
-		Balance:     domainAccount.Balance.Amount(),
-		Status:      string(domainAccount.Status),
+		AccountID:       domainAccount.ID.String(),
+		AccountName:     domainAccount.AccountName,
+		CustomerID:      domainAccount.CustomerID,
+		ProductID:       domainAccount.ProductID,
+		ParentAccountID: parentAccountIDOf(domainAccount),
+		SpendingLimit:   spendingLimitOf(domainAccount),
+		Balance:         domainAccount.Balance.Amount(),
+		Currency:        domainAccount.Currency().String(),
+		OpeningBalance:  openingBalanceOf(domainAccount),
+		Status:          string(domainAccount.Status),
 	}
 }
 
@@ -63,7 +103,40 @@ func FromDomainAccount(domainAccount *entity.Account) *Account {
 func (a *Account) UpdateFromDomain(domainAccount *entity.Account) {
 	a.AccountID = domainAccount.ID.String()
 	a.AccountName = domainAccount.AccountName
+	a.CustomerID = domainAccount.CustomerID
+	a.ProductID = domainAccount.ProductID
+	a.ParentAccountID = parentAccountIDOf(domainAccount)
+	a.SpendingLimit = spendingLimitOf(domainAccount)
 	a.Balance = domainAccount.Balance.Amount()
+	a.Currency = domainAccount.Currency().String()
+	a.OpeningBalance = openingBalanceOf(domainAccount)
 	a.Status = string(domainAccount.Status)
 	a.UpdatedAt = domainAccount.UpdatedAt
 }
+
+// parentAccountIDOf returns the stored form of an account's parent ID
+func parentAccountIDOf(domainAccount *entity.Account) *string {
+	if domainAccount.ParentAccountID == nil {
+		return nil
+	}
+	id := domainAccount.ParentAccountID.String()
+	return &id
+}
+
+// spendingLimitOf returns the stored form of an account's spending limit
+func spendingLimitOf(domainAccount *entity.Account) *decimal.Decimal {
+	if domainAccount.SpendingLimit == nil {
+		return nil
+	}
+	limit := domainAccount.SpendingLimit.Amount()
+	return &limit
+}
+
+// openingBalanceOf returns the stored form of an account's opening balance
+func openingBalanceOf(domainAccount *entity.Account) *decimal.Decimal {
+	if domainAccount.OpeningBalance == nil {
+		return nil
+	}
+	balance := domainAccount.OpeningBalance.Amount()
+	return &balance
+}
@@ -0,0 +1,37 @@
+package model
+
+import (
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+)
+
+// AccountNameHistory is a previous name of an account; rows are only ever inserted
+type AccountNameHistory struct {
+	ID           uint      `gorm:"primaryKey"`
+	AccountID    string    `gorm:"size:16;not null;index:idx_account_name_history_account,priority:1"`
+	PreviousName string    `gorm:"size:100;not null"`
+	NewName      string    `gorm:"size:100;not null"`
+	ChangedAt    time.Time `gorm:"not null;index:idx_account_name_history_account,priority:2"`
+}
+
+// TableName specifies the table name for the AccountNameHistory model
+func (AccountNameHistory) TableName() string {
+	return "account_name_history"
+}
+
+// ToDomainAccountNameChange converts GORM model to domain entity
+func (h *AccountNameHistory) ToDomainAccountNameChange() (*entity.AccountNameChange, error) {
+	accountID, err := vo.NewAccountIDFromString(h.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &entity.AccountNameChange{
+		AccountID:    accountID,
+		PreviousName: h.PreviousName,
+		NewName:      h.NewName,
+		ChangedAt:    h.ChangedAt,
+	}, nil
+}
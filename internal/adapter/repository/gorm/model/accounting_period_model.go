@@ -0,0 +1,41 @@
+package model
+
+import (
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	"gorm.io/gorm"
+)
+
+type AccountingPeriod struct {
+	gorm.Model
+	Period   string    `gorm:"size:7;uniqueIndex;not null"` // YYYY-MM
+	ClosedBy string    `gorm:"size:100;not null"`
+	Reason   string    `gorm:"size:500"`
+	ClosedAt time.Time `gorm:"not null"`
+}
+
+// TableName specifies the table name for the AccountingPeriod model
+func (AccountingPeriod) TableName() string {
+	return "accounting_periods"
+}
+
+// ToDomainAccountingPeriod converts GORM model to domain entity
+func (p *AccountingPeriod) ToDomainAccountingPeriod() *entity.AccountingPeriod {
+	return &entity.AccountingPeriod{
+		Period:   p.Period,
+		ClosedBy: p.ClosedBy,
+		Reason:   p.Reason,
+		ClosedAt: p.ClosedAt,
+	}
+}
+
+// FromDomainAccountingPeriod converts domain entity to GORM model
+func FromDomainAccountingPeriod(domainPeriod *entity.AccountingPeriod) *AccountingPeriod {
+	return &AccountingPeriod{
+		Period:   domainPeriod.Period,
+		ClosedBy: domainPeriod.ClosedBy,
+		Reason:   domainPeriod.Reason,
+		ClosedAt: domainPeriod.ClosedAt,
+	}
+}
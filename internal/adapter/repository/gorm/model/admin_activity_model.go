@@ -0,0 +1,82 @@
+package model
+
+import (
+	"strings"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+)
+
+// AdminActivity is one request of an admin user; rows are only ever inserted
+type AdminActivity struct {
+	ID         uint      `gorm:"primaryKey"`
+	ActivityID string    `gorm:"size:32;uniqueIndex;not null"` // Format: AAC + ULID
+	AdminID    string    `gorm:"size:32;not null;index:idx_admin_activities_admin_occurred,priority:1"`
+	AdminEmail string    `gorm:"size:254;not null"`
+	Method     string    `gorm:"size:10;not null"`
+	Route      string    `gorm:"size:200;not null"`
+	Path       string    `gorm:"size:500;not null"`
+	Permission string    `gorm:"size:50"`
+	StatusCode int       `gorm:"not null"`
+	IP         string    `gorm:"size:45;not null"`
+	Country    string    `gorm:"size:2"`
+	UserAgent  string    `gorm:"size:300"`
+	Anomalies  string    `gorm:"size:100;not null;default:''"` // Comma-separated
+	OccurredAt time.Time `gorm:"not null;index;index:idx_admin_activities_admin_occurred,priority:2"`
+}
+
+// TableName specifies the table name for the AdminActivity model
+func (AdminActivity) TableName() string {
+	return "admin_activities"
+}
+
+// ToDomainAdminActivity converts GORM model to domain entity
+func (a *AdminActivity) ToDomainAdminActivity() *entity.AdminActivity {
+	var anomalies []vo.AdminAnomaly
+	for _, anomaly := range strings.Split(a.Anomalies, ",") {
+		if anomaly != "" {
+			anomalies = append(anomalies, vo.AdminAnomaly(anomaly))
+		}
+	}
+
+	return &entity.AdminActivity{
+		ID:         a.ActivityID,
+		AdminID:    a.AdminID,
+		AdminEmail: a.AdminEmail,
+		Method:     a.Method,
+		Route:      a.Route,
+		Path:       a.Path,
+		Permission: a.Permission,
+		StatusCode: a.StatusCode,
+		IP:         a.IP,
+		Country:    a.Country,
+		UserAgent:  a.UserAgent,
+		Anomalies:  anomalies,
+		OccurredAt: a.OccurredAt,
+	}
+}
+
+// FromDomainAdminActivity converts domain entity to GORM model
+func FromDomainAdminActivity(domainActivity *entity.AdminActivity) *AdminActivity {
+	anomalies := make([]string, len(domainActivity.Anomalies))
+	for i, anomaly := range domainActivity.Anomalies {
+		anomalies[i] = string(anomaly)
+	}
+
+	return &AdminActivity{
+		ActivityID: domainActivity.ID,
+		AdminID:    domainActivity.AdminID,
+		AdminEmail: domainActivity.AdminEmail,
+		Method:     domainActivity.Method,
+		Route:      domainActivity.Route,
+		Path:       domainActivity.Path,
+		Permission: domainActivity.Permission,
+		StatusCode: domainActivity.StatusCode,
+		IP:         domainActivity.IP,
+		Country:    domainActivity.Country,
+		UserAgent:  domainActivity.UserAgent,
+		Anomalies:  strings.Join(anomalies, ","),
+		OccurredAt: domainActivity.OccurredAt,
+	}
+}
@@ -0,0 +1,72 @@
+package model
+
+import (
+	"strings"
+
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"gorm.io/gorm"
+)
+
+type AdminUser struct {
+	gorm.Model
+	AdminID string `gorm:"size:32;uniqueIndex;not null"` // Format: ADM + ULID
+	Email   string `gorm:"size:254;uniqueIndex;not null"`
+	Name    string `gorm:"size:100;not null"`
+	Roles   string `gorm:"size:200;not null"` // Comma-separated
+	KeyHash string `gorm:"size:64;uniqueIndex;not null"`
+	Status  string `gorm:"size:20;not null;default:'ACTIVE'"`
+}
+
+// TableName specifies the table name for the AdminUser model
+func (AdminUser) TableName() string {
+	return "admin_users"
+}
+
+// ToDomainAdminUser converts GORM model to domain entity
+func (a *AdminUser) ToDomainAdminUser() *entity.AdminUser {
+	var roles []vo.AdminRole
+	for _, role := range strings.Split(a.Roles, ",") {
+		if role != "" {
+			roles = append(roles, vo.AdminRole(role))
+		}
+	}
+
+	return &entity.AdminUser{
+		ID:        a.AdminID,
+		Email:     a.Email,
+		Name:      a.Name,
+		Roles:     roles,
+		KeyHash:   a.KeyHash,
+		Status:    entity.AdminUserStatus(a.Status),
+		CreatedAt: a.CreatedAt,
+		UpdatedAt: a.UpdatedAt,
+	}
+}
+
+// FromDomainAdminUser converts domain entity to GORM model
+func FromDomainAdminUser(domainAdmin *entity.AdminUser) *AdminUser {
+	a := &AdminUser{
+		Model: gorm.Model{
+			CreatedAt: domainAdmin.CreatedAt,
+		},
+		AdminID: domainAdmin.ID,
+		Email:   domainAdmin.Email,
+	}
+	a.UpdateFromDomain(domainAdmin)
+	return a
+}
+
+// UpdateFromDomain updates the GORM model with domain entity data (preserves GORM ID)
+func (a *AdminUser) UpdateFromDomain(domainAdmin *entity.AdminUser) {
+	roles := make([]string, len(domainAdmin.Roles))
+	for i, role := range domainAdmin.Roles {
+		roles[i] = string(role)
+	}
+
+	a.Name = domainAdmin.Name
+	a.Roles = strings.Join(roles, ",")
+	a.KeyHash = domainAdmin.KeyHash
+	a.Status = string(domainAdmin.Status)
+	a.UpdatedAt = domainAdmin.UpdatedAt
+}
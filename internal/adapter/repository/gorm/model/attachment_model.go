@@ -0,0 +1,63 @@
+package model
+
+import (
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"gorm.io/gorm"
+)
+
+type Attachment struct {
+	gorm.Model
+	AttachmentID  string `gorm:"size:32;uniqueIndex;not null"` // Format: ATT + ULID
+	AccountID     string `gorm:"size:16;not null;index:idx_attachments_account_txn,priority:1"`
+	TransactionID string `gorm:"size:25;not null;index:idx_attachments_account_txn,priority:2"`
+	FileName      string `gorm:"size:255;not null"`
+	ContentType   string `gorm:"size:100;not null"`
+	SizeBytes     int64  `gorm:"not null"`
+	ObjectKey     string `gorm:"size:500;not null"`
+}
+
+// TableName specifies the table name for the Attachment model
+func (Attachment) TableName() string {
+	return "attachments"
+}
+
+// ToDomainAttachment converts GORM model to domain entity
+func (a *Attachment) ToDomainAttachment() (*entity.Attachment, error) {
+	accountID, err := vo.NewAccountIDFromString(a.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	transactionID, err := vo.NewTransactionIDFromString(a.TransactionID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &entity.Attachment{
+		ID:            a.AttachmentID,
+		AccountID:     accountID,
+		TransactionID: transactionID,
+		FileName:      a.FileName,
+		ContentType:   a.ContentType,
+		SizeBytes:     a.SizeBytes,
+		ObjectKey:     a.ObjectKey,
+		CreatedAt:     a.CreatedAt,
+	}, nil
+}
+
+// FromDomainAttachment converts domain entity to GORM model
+func FromDomainAttachment(domainAttachment *entity.Attachment) *Attachment {
+	return &Attachment{
+		Model: gorm.Model{
+			CreatedAt: domainAttachment.CreatedAt,
+		},
+		AttachmentID:  domainAttachment.ID,
+		AccountID:     domainAttachment.AccountID.String(),
+		TransactionID: domainAttachment.TransactionID.String(),
+		FileName:      domainAttachment.FileName,
+		ContentType:   domainAttachment.ContentType,
+		SizeBytes:     domainAttachment.SizeBytes,
+		ObjectKey:     domainAttachment.ObjectKey,
+	}
+}
@@ -0,0 +1,51 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/domain/event"
+)
+
+// AuditEntry is append-only: entries are never updated and leave only through retention
+type AuditEntry struct {
+	ID         uint      `gorm:"primarykey"`
+	EventID    string    `gorm:"size:32;uniqueIndex;not null"` // Format: EVT + ULID
+	EventType  string    `gorm:"size:50;not null;index"`
+	EventKey   string    `gorm:"size:50;index"`
+	Payload    string    `gorm:"type:text;not null"`
+	OccurredAt time.Time `gorm:"not null;index"`
+	RecordedAt time.Time `gorm:"not null"`
+}
+
+// TableName specifies the table name for the AuditEntry model
+func (AuditEntry) TableName() string {
+	return "audit_entries"
+}
+
+// ToDomainAuditEntry converts GORM model to domain audit entry
+func (a *AuditEntry) ToDomainAuditEntry() *event.AuditEntry {
+	return &event.AuditEntry{
+		Sequence: uint64(a.ID),
+		Event: event.Event{
+			ID:         a.EventID,
+			Type:       event.Type(a.EventType),
+			Key:        a.EventKey,
+			OccurredAt: a.OccurredAt,
+			Data:       json.RawMessage(a.Payload),
+		},
+		RecordedAt: a.RecordedAt,
+	}
+}
+
+// FromDomainAuditEvent converts a domain event to an audit GORM model
+func FromDomainAuditEvent(evt event.Event) *AuditEntry {
+	return &AuditEntry{
+		EventID:    evt.ID,
+		EventType:  string(evt.Type),
+		EventKey:   evt.Key,
+		Payload:    string(evt.Data),
+		OccurredAt: evt.OccurredAt,
+		RecordedAt: time.Now(),
+	}
+}
@@ -0,0 +1,63 @@
+package model
+
+import (
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"gorm.io/gorm"
+)
+
+type Backup struct {
+	gorm.Model
+	BackupID    string    `gorm:"size:32;uniqueIndex;not null"` // Format: BKP + ULID
+	Location    string    `gorm:"size:500"`
+	SizeBytes   int64     `gorm:"not null;default:0"`
+	Status      string    `gorm:"size:20;not null;index"` // RUNNING, COMPLETED, FAILED
+	Error       string    `gorm:"size:1000"`
+	RequestedBy string    `gorm:"size:100"`
+	StartedAt   time.Time `gorm:"not null;index"`
+	CompletedAt *time.Time
+}
+
+// TableName specifies the table name for the Backup model
+func (Backup) TableName() string {
+	return "backups"
+}
+
+// ToDomainBackup converts GORM model to domain entity
+func (b *Backup) ToDomainBackup() *entity.Backup {
+	return &entity.Backup{
+		ID:          b.BackupID,
+		Location:    b.Location,
+		SizeBytes:   b.SizeBytes,
+		Status:      vo.BackupStatus(b.Status),
+		Error:       b.Error,
+		RequestedBy: b.RequestedBy,
+		StartedAt:   b.StartedAt,
+		CompletedAt: b.CompletedAt,
+	}
+}
+
+// FromDomainBackup converts domain entity to GORM model
+func FromDomainBackup(domainBackup *entity.Backup) *Backup {
+	return &Backup{
+		BackupID:    domainBackup.ID,
+		Location:    domainBackup.Location,
+		SizeBytes:   domainBackup.SizeBytes,
+		Status:      string(domainBackup.Status),
+		Error:       domainBackup.Error,
+		RequestedBy: domainBackup.RequestedBy,
+		StartedAt:   domainBackup.StartedAt,
+		CompletedAt: domainBackup.CompletedAt,
+	}
+}
+
+// UpdateFromDomain updates the GORM model with domain entity data (preserves GORM ID)
+func (b *Backup) UpdateFromDomain(domainBackup *entity.Backup) {
+	b.Location = domainBackup.Location
+	b.SizeBytes = domainBackup.SizeBytes
+	b.Status = string(domainBackup.Status)
+	b.Error = domainBackup.Error
+	b.CompletedAt = domainBackup.CompletedAt
+}
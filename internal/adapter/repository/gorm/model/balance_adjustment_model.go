@@ -0,0 +1,36 @@
+package model
+
+import (
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	"github.com/shopspring/decimal"
+)
+
+// BalanceAdjustment is a repair of an account's stored balance; rows are only ever inserted
+type BalanceAdjustment struct {
+	ID              uint            `gorm:"primaryKey"`
+	AccountID       string          `gorm:"size:16;not null;index:idx_balance_adjustments_account,priority:1"`
+	PreviousBalance decimal.Decimal `gorm:"type:decimal(20,2);not null"`
+	NewBalance      decimal.Decimal `gorm:"type:decimal(20,2);not null"`
+	RequestedBy     string          `gorm:"size:100;not null"`
+	Reason          string          `gorm:"size:500;not null"`
+	CreatedAt       time.Time       `gorm:"not null;index:idx_balance_adjustments_account,priority:2"`
+}
+
+// TableName specifies the table name for the BalanceAdjustment model
+func (BalanceAdjustment) TableName() string {
+	return "balance_adjustments"
+}
+
+// FromDomainBalanceAdjustment converts domain entity to GORM model
+func FromDomainBalanceAdjustment(adjustment *entity.BalanceAdjustment) *BalanceAdjustment {
+	return &BalanceAdjustment{
+		AccountID:       adjustment.AccountID.String(),
+		PreviousBalance: adjustment.PreviousBalance.Amount(),
+		NewBalance:      adjustment.NewBalance.Amount(),
+		RequestedBy:     adjustment.RequestedBy,
+		Reason:          adjustment.Reason,
+		CreatedAt:       adjustment.CreatedAt,
+	}
+}
@@ -0,0 +1,68 @@
+package model
+
+import (
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+)
+
+type Budget struct {
+	gorm.Model
+	BudgetID         string          `gorm:"size:32;uniqueIndex;not null"` // Format: BGT + ULID
+	AccountID        string          `gorm:"size:16;not null;uniqueIndex:idx_budgets_account_category"`
+	CategoryCode     string          `gorm:"size:30;not null;uniqueIndex:idx_budgets_account_category"`
+	Amount           decimal.Decimal `gorm:"type:decimal(20,2);not null"`
+	AlertedPeriod    string          `gorm:"size:7"` // YYYY-MM
+	AlertedThreshold int             `gorm:"not null;default:0"`
+}
+
+// TableName specifies the table name for the Budget model
+func (Budget) TableName() string {
+	return "budgets"
+}
+
+// ToDomainBudget converts GORM model to domain entity
+func (b *Budget) ToDomainBudget() (*entity.Budget, error) {
+	accountID, err := vo.NewAccountIDFromString(b.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &entity.Budget{
+		ID:               b.BudgetID,
+		AccountID:        accountID,
+		CategoryCode:     b.CategoryCode,
+		Amount:           vo.NewMoney(b.Amount),
+		AlertedPeriod:    b.AlertedPeriod,
+		AlertedThreshold: b.AlertedThreshold,
+		CreatedAt:        b.CreatedAt,
+		UpdatedAt:        b.UpdatedAt,
+	}, nil
+}
+
+// FromDomainBudget converts domain entity to GORM model
+func FromDomainBudget(domainBudget *entity.Budget) *Budget {
+	return &Budget{
+		Model: gorm.Model{
+			CreatedAt: domainBudget.CreatedAt,
+			UpdatedAt: domainBudget.UpdatedAt,
+		},
+		BudgetID:         domainBudget.ID,
+		AccountID:        domainBudget.AccountID.String(),
+		CategoryCode:     domainBudget.CategoryCode,
+		Amount:           domainBudget.Amount.Amount(),
+		AlertedPeriod:    domainBudget.AlertedPeriod,
+		AlertedThreshold: domainBudget.AlertedThreshold,
+	}
+}
+
+// UpdateFromDomain updates the GORM model with domain entity data (preserves GORM ID)
+func (b *Budget) UpdateFromDomain(domainBudget *entity.Budget) {
+	b.Amount = domainBudget.Amount.Amount()
+	b.AlertedPeriod = domainBudget.AlertedPeriod
+	b.AlertedThreshold = domainBudget.AlertedThreshold
+	b.UpdatedAt = time.Now()
+}
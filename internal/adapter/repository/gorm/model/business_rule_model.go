@@ -0,0 +1,64 @@
+package model
+
+import (
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	"gorm.io/gorm"
+)
+
+type BusinessRule struct {
+	gorm.Model
+	RuleID     string `gorm:"size:32;uniqueIndex;not null"` // Format: BRL + ULID
+	Name       string `gorm:"size:100;not null"`
+	Kind       string `gorm:"size:20;not null"` // VALIDATION, FEE
+	Expression string `gorm:"size:2000;not null"`
+	Message    string `gorm:"size:200"`
+	Priority   int    `gorm:"not null;default:0"`
+	Enabled    bool   `gorm:"not null;default:true;index"`
+}
+
+// TableName specifies the table name for the BusinessRule model
+func (BusinessRule) TableName() string {
+	return "business_rules"
+}
+
+// ToDomainBusinessRule converts GORM model to domain entity
+func (r *BusinessRule) ToDomainBusinessRule() *entity.BusinessRule {
+	return &entity.BusinessRule{
+		ID:         r.RuleID,
+		Name:       r.Name,
+		Kind:       entity.BusinessRuleKind(r.Kind),
+		Expression: r.Expression,
+		Message:    r.Message,
+		Priority:   r.Priority,
+		Enabled:    r.Enabled,
+		CreatedAt:  r.CreatedAt,
+		UpdatedAt:  r.UpdatedAt,
+	}
+}
+
+// FromDomainBusinessRule converts domain entity to GORM model
+func FromDomainBusinessRule(domainRule *entity.BusinessRule) *BusinessRule {
+	return &BusinessRule{
+		Model: gorm.Model{
+			CreatedAt: domainRule.CreatedAt,
+			UpdatedAt: domainRule.UpdatedAt,
+		},
+		RuleID:     domainRule.ID,
+		Name:       domainRule.Name,
+		Kind:       string(domainRule.Kind),
+		Expression: domainRule.Expression,
+		Message:    domainRule.Message,
+		Priority:   domainRule.Priority,
+		Enabled:    domainRule.Enabled,
+	}
+}
+
+// UpdateFromDomain updates the GORM model with domain entity data (preserves GORM ID)
+func (r *BusinessRule) UpdateFromDomain(domainRule *entity.BusinessRule) {
+	r.Name = domainRule.Name
+	r.Expression = domainRule.Expression
+	r.Message = domainRule.Message
+	r.Priority = domainRule.Priority
+	r.Enabled = domainRule.Enabled
+	r.UpdatedAt = domainRule.UpdatedAt
+}
@@ -0,0 +1,137 @@
+package model
+
+import (
+	"strings"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+)
+
+type CashbackCampaign struct {
+	gorm.Model
+	CampaignID       string          `gorm:"size:32;uniqueIndex;not null"` // Format: CBC + ULID
+	Name             string          `gorm:"size:100;not null"`
+	Percentage       decimal.Decimal `gorm:"type:decimal(7,4);not null"`
+	Cap              decimal.Decimal `gorm:"type:decimal(20,2);not null"`
+	TransactionTypes string          `gorm:"size:50;not null"` // Comma-separated
+	StartsAt         time.Time       `gorm:"not null;index"`
+	EndsAt           time.Time       `gorm:"not null;index"`
+	Active           bool            `gorm:"not null"`
+}
+
+// TableName specifies the table name for the CashbackCampaign model
+func (CashbackCampaign) TableName() string {
+	return "cashback_campaigns"
+}
+
+// ToDomainCashbackCampaign converts GORM model to domain entity
+func (c *CashbackCampaign) ToDomainCashbackCampaign() *entity.CashbackCampaign {
+	var transactionTypes []vo.TransactionType
+	for _, transactionType := range strings.Split(c.TransactionTypes, ",") {
+		if transactionType != "" {
+			transactionTypes = append(transactionTypes, vo.TransactionType(transactionType))
+		}
+	}
+
+	return &entity.CashbackCampaign{
+		ID:               c.CampaignID,
+		Name:             c.Name,
+		Percentage:       c.Percentage,
+		Cap:              vo.NewMoney(c.Cap),
+		TransactionTypes: transactionTypes,
+		StartsAt:         c.StartsAt,
+		EndsAt:           c.EndsAt,
+		Active:           c.Active,
+		CreatedAt:        c.CreatedAt,
+		UpdatedAt:        c.UpdatedAt,
+	}
+}
+
+// FromDomainCashbackCampaign converts domain entity to GORM model
+func FromDomainCashbackCampaign(domainCampaign *entity.CashbackCampaign) *CashbackCampaign {
+	c := &CashbackCampaign{
+		Model: gorm.Model{
+			CreatedAt: domainCampaign.CreatedAt,
+		},
+		CampaignID: domainCampaign.ID,
+	}
+	c.UpdateFromDomain(domainCampaign)
+	return c
+}
+
+// UpdateFromDomain updates the GORM model with domain entity data (preserves GORM ID)
+func (c *CashbackCampaign) UpdateFromDomain(domainCampaign *entity.CashbackCampaign) {
+	names := make([]string, len(domainCampaign.TransactionTypes))
+	for i, transactionType := range domainCampaign.TransactionTypes {
+		names[i] = string(transactionType)
+	}
+
+	c.Name = domainCampaign.Name
+	c.Percentage = domainCampaign.Percentage
+	c.Cap = domainCampaign.Cap.Amount()
+	c.TransactionTypes = strings.Join(names, ",")
+	c.StartsAt = domainCampaign.StartsAt
+	c.EndsAt = domainCampaign.EndsAt
+	c.Active = domainCampaign.Active
+	c.UpdatedAt = domainCampaign.UpdatedAt
+}
+
+type CashbackReward struct {
+	gorm.Model
+	RewardID            string          `gorm:"size:32;uniqueIndex;not null"` // Format: CBR + ULID
+	CampaignID          string          `gorm:"size:32;not null;uniqueIndex:idx_cashback_rewards_campaign_transaction"`
+	AccountID           string          `gorm:"size:16;not null;index"`
+	TransactionID       string          `gorm:"size:25;not null;uniqueIndex:idx_cashback_rewards_campaign_transaction"`
+	CreditTransactionID string          `gorm:"size:25;not null"`
+	Amount              decimal.Decimal `gorm:"type:decimal(20,2);not null"`
+}
+
+// TableName specifies the table name for the CashbackReward model
+func (CashbackReward) TableName() string {
+	return "cashback_rewards"
+}
+
+// ToDomainCashbackReward converts GORM model to domain entity
+func (r *CashbackReward) ToDomainCashbackReward() (*entity.CashbackReward, error) {
+	accountID, err := vo.NewAccountIDFromString(r.AccountID)
+	if err != nil {
+		return nil, err
+	}
+	transactionID, err := vo.NewTransactionIDFromString(r.TransactionID)
+	if err != nil {
+		return nil, err
+	}
+	creditTransactionID, err := vo.NewTransactionIDFromString(r.CreditTransactionID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &entity.CashbackReward{
+		ID:                  r.RewardID,
+		CampaignID:          r.CampaignID,
+		AccountID:           accountID,
+		TransactionID:       transactionID,
+		CreditTransactionID: creditTransactionID,
+		Amount:              vo.NewMoney(r.Amount),
+		CreatedAt:           r.CreatedAt,
+	}, nil
+}
+
+// FromDomainCashbackReward converts domain entity to GORM model
+func FromDomainCashbackReward(domainReward *entity.CashbackReward) *CashbackReward {
+	return &CashbackReward{
+		Model: gorm.Model{
+			CreatedAt: domainReward.CreatedAt,
+			UpdatedAt: domainReward.CreatedAt,
+		},
+		RewardID:            domainReward.ID,
+		CampaignID:          domainReward.CampaignID,
+		AccountID:           domainReward.AccountID.String(),
+		TransactionID:       domainReward.TransactionID.String(),
+		CreditTransactionID: domainReward.CreditTransactionID.String(),
+		Amount:              domainReward.Amount.Amount(),
+	}
+}
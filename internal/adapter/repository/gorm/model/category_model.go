@@ -0,0 +1,142 @@
+package model
+
+import (
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"gorm.io/gorm"
+)
+
+type Category struct {
+	gorm.Model
+	Code       string `gorm:"size:30;uniqueIndex;not null"`
+	Name       string `gorm:"size:100;not null"`
+	ParentCode string `gorm:"size:30;index"`
+}
+
+// TableName specifies the table name for the Category model
+func (Category) TableName() string {
+	return "categories"
+}
+
+// ToDomainCategory converts GORM model to domain entity
+func (c *Category) ToDomainCategory() *entity.Category {
+	return &entity.Category{
+		Code:       c.Code,
+		Name:       c.Name,
+		ParentCode: c.ParentCode,
+		CreatedAt:  c.CreatedAt,
+		UpdatedAt:  c.UpdatedAt,
+	}
+}
+
+// FromDomainCategory converts domain entity to GORM model
+func FromDomainCategory(domainCategory *entity.Category) *Category {
+	return &Category{
+		Model: gorm.Model{
+			CreatedAt: domainCategory.CreatedAt,
+			UpdatedAt: domainCategory.UpdatedAt,
+		},
+		Code:       domainCategory.Code,
+		Name:       domainCategory.Name,
+		ParentCode: domainCategory.ParentCode,
+	}
+}
+
+// UpdateFromDomain updates the GORM model with domain entity data (preserves GORM ID)
+func (c *Category) UpdateFromDomain(domainCategory *entity.Category) {
+	c.Name = domainCategory.Name
+	c.ParentCode = domainCategory.ParentCode
+	c.UpdatedAt = domainCategory.UpdatedAt
+}
+
+type CategoryRule struct {
+	gorm.Model
+	RuleID       string `gorm:"size:32;uniqueIndex;not null"` // Format: CRL + ULID
+	CategoryCode string `gorm:"size:30;index;not null"`
+	Field        string `gorm:"size:20;not null"` // DESCRIPTION, REFERENCE, MERCHANT
+	Pattern      string `gorm:"size:100;not null"`
+	Priority     int    `gorm:"not null;default:0"`
+}
+
+// TableName specifies the table name for the CategoryRule model
+func (CategoryRule) TableName() string {
+	return "category_rules"
+}
+
+// ToDomainCategoryRule converts GORM model to domain entity
+func (r *CategoryRule) ToDomainCategoryRule() *entity.CategoryRule {
+	return &entity.CategoryRule{
+		ID:           r.RuleID,
+		CategoryCode: r.CategoryCode,
+		Field:        entity.CategoryRuleField(r.Field),
+		Pattern:      r.Pattern,
+		Priority:     r.Priority,
+		CreatedAt:    r.CreatedAt,
+	}
+}
+
+// FromDomainCategoryRule converts domain entity to GORM model
+func FromDomainCategoryRule(domainRule *entity.CategoryRule) *CategoryRule {
+	return &CategoryRule{
+		Model: gorm.Model{
+			CreatedAt: domainRule.CreatedAt,
+		},
+		RuleID:       domainRule.ID,
+		CategoryCode: domainRule.CategoryCode,
+		Field:        string(domainRule.Field),
+		Pattern:      domainRule.Pattern,
+		Priority:     domainRule.Priority,
+	}
+}
+
+type CategoryOverride struct {
+	gorm.Model
+	AccountID     string `gorm:"size:16;not null;uniqueIndex:idx_category_overrides_account_txn"`
+	TransactionID string `gorm:"size:25;not null;uniqueIndex:idx_category_overrides_account_txn"`
+	CategoryCode  string `gorm:"size:30;not null"`
+}
+
+// TableName specifies the table name for the CategoryOverride model
+func (CategoryOverride) TableName() string {
+	return "category_overrides"
+}
+
+// ToDomainCategoryOverride converts GORM model to domain entity
+func (o *CategoryOverride) ToDomainCategoryOverride() (*entity.CategoryOverride, error) {
+	accountID, err := vo.NewAccountIDFromString(o.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	transactionID, err := vo.NewTransactionIDFromString(o.TransactionID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &entity.CategoryOverride{
+		AccountID:     accountID,
+		TransactionID: transactionID,
+		CategoryCode:  o.CategoryCode,
+		UpdatedAt:     o.UpdatedAt,
+	}, nil
+}
+
+// FromDomainCategoryOverride converts domain entity to GORM model
+func FromDomainCategoryOverride(domainOverride *entity.CategoryOverride) *CategoryOverride {
+	return &CategoryOverride{
+		Model: gorm.Model{
+			UpdatedAt: domainOverride.UpdatedAt,
+		},
+		AccountID:     domainOverride.AccountID.String(),
+		TransactionID: domainOverride.TransactionID.String(),
+		CategoryCode:  domainOverride.CategoryCode,
+	}
+}
+
+// UpdateFromDomain updates the GORM model with domain entity data (preserves GORM ID)
+func (o *CategoryOverride) UpdateFromDomain(domainOverride *entity.CategoryOverride) {
+	o.CategoryCode = domainOverride.CategoryCode
+	o.UpdatedAt = time.Now()
+}
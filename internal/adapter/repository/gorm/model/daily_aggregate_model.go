@@ -0,0 +1,67 @@
+package model
+
+import (
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"github.com/shopspring/decimal"
+)
+
+// DailyAggregate is an account's completed money movement on one UTC day
+type DailyAggregate struct {
+	AccountID string          `gorm:"size:16;primaryKey"`
+	Date      time.Time       `gorm:"type:date;primaryKey"`
+	Inflow    decimal.Decimal `gorm:"type:decimal(20,2);not null;default:0"`
+	Outflow   decimal.Decimal `gorm:"type:decimal(20,2);not null;default:0"`
+	Count     int             `gorm:"not null;default:0"`
+	UpdatedAt time.Time
+}
+
+// TableName specifies the table name for the DailyAggregate model
+func (DailyAggregate) TableName() string {
+	return "daily_aggregates"
+}
+
+// DailyAggregateTransaction marks a transaction as counted in an account's daily aggregate, so a
+// completion delivered twice is not counted twice
+type DailyAggregateTransaction struct {
+	TransactionID string `gorm:"size:25;primaryKey"`
+	AccountID     string `gorm:"size:16;primaryKey"`
+	CreatedAt     time.Time
+}
+
+// TableName specifies the table name for the DailyAggregateTransaction model
+func (DailyAggregateTransaction) TableName() string {
+	return "daily_aggregate_transactions"
+}
+
+// ToDomainDailyAggregate converts GORM model to domain entity
+func (a *DailyAggregate) ToDomainDailyAggregate() (*entity.DailyAggregate, error) {
+	accountID, err := vo.NewAccountIDFromString(a.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	date := a.Date.UTC()
+	return &entity.DailyAggregate{
+		AccountID: accountID,
+		Date:      time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC),
+		Inflow:    vo.NewMoney(a.Inflow),
+		Outflow:   vo.NewMoney(a.Outflow),
+		Count:     a.Count,
+		UpdatedAt: a.UpdatedAt,
+	}, nil
+}
+
+// FromDomainDailyAggregate converts domain entity to GORM model
+func FromDomainDailyAggregate(aggregate *entity.DailyAggregate) *DailyAggregate {
+	return &DailyAggregate{
+		AccountID: aggregate.AccountID.String(),
+		Date:      aggregate.Date,
+		Inflow:    aggregate.Inflow.Amount(),
+		Outflow:   aggregate.Outflow.Amount(),
+		Count:     aggregate.Count,
+		UpdatedAt: aggregate.UpdatedAt,
+	}
+}
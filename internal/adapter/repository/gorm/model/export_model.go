@@ -0,0 +1,105 @@
+package model
+
+import (
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"gorm.io/gorm"
+)
+
+type Export struct {
+	gorm.Model
+	ExportID    string `gorm:"size:32;uniqueIndex;not null"` // Format: EXP + ULID
+	Kind        string `gorm:"size:30;not null"`             // AUDIT, ACCOUNT_HISTORY
+	AccountID   string `gorm:"size:50;index"`
+	EventType   string `gorm:"size:50"`
+	From        *time.Time
+	To          *time.Time
+	Status      string `gorm:"size:20;not null;index"` // RUNNING, COMPLETED, FAILED
+	FileName    string `gorm:"size:200;not null"`
+	ContentType string `gorm:"size:100;not null"`
+	ObjectKey   string `gorm:"size:500"`
+	SizeBytes   int64  `gorm:"not null;default:0"`
+	Error       string `gorm:"size:1000"`
+	RequestedBy string `gorm:"size:100"`
+	CompletedAt *time.Time
+	ExpiresAt   time.Time `gorm:"not null;index"`
+
+	CallbackURL         string `gorm:"size:500"`
+	CallbackSecret      string `gorm:"size:100"`
+	CallbackDeliveredAt *time.Time
+	CallbackError       string `gorm:"size:1000"`
+}
+
+// TableName specifies the table name for the Export model
+func (Export) TableName() string {
+	return "exports"
+}
+
+// ToDomainExport converts GORM model to domain entity
+func (e *Export) ToDomainExport() *entity.Export {
+	return &entity.Export{
+		ID:          e.ExportID,
+		Kind:        entity.ExportKind(e.Kind),
+		AccountID:   e.AccountID,
+		EventType:   e.EventType,
+		From:        e.From,
+		To:          e.To,
+		Status:      vo.ExportStatus(e.Status),
+		FileName:    e.FileName,
+		ContentType: e.ContentType,
+		ObjectKey:   e.ObjectKey,
+		SizeBytes:   e.SizeBytes,
+		Error:       e.Error,
+		RequestedBy: e.RequestedBy,
+		CreatedAt:   e.CreatedAt,
+		CompletedAt: e.CompletedAt,
+		ExpiresAt:   e.ExpiresAt,
+
+		CallbackURL:         e.CallbackURL,
+		CallbackSecret:      e.CallbackSecret,
+		CallbackDeliveredAt: e.CallbackDeliveredAt,
+		CallbackError:       e.CallbackError,
+	}
+}
+
+// FromDomainExport converts domain entity to GORM model
+func FromDomainExport(domainExport *entity.Export) *Export {
+	return &Export{
+		Model: gorm.Model{
+			CreatedAt: domainExport.CreatedAt,
+		},
+		ExportID:    domainExport.ID,
+		Kind:        string(domainExport.Kind),
+		AccountID:   domainExport.AccountID,
+		EventType:   domainExport.EventType,
+		From:        domainExport.From,
+		To:          domainExport.To,
+		Status:      string(domainExport.Status),
+		FileName:    domainExport.FileName,
+		ContentType: domainExport.ContentType,
+		ObjectKey:   domainExport.ObjectKey,
+		SizeBytes:   domainExport.SizeBytes,
+		Error:       domainExport.Error,
+		RequestedBy: domainExport.RequestedBy,
+		CompletedAt: domainExport.CompletedAt,
+		ExpiresAt:   domainExport.ExpiresAt,
+
+		CallbackURL:         domainExport.CallbackURL,
+		CallbackSecret:      domainExport.CallbackSecret,
+		CallbackDeliveredAt: domainExport.CallbackDeliveredAt,
+		CallbackError:       domainExport.CallbackError,
+	}
+}
+
+// UpdateFromDomain updates the GORM model with domain entity data (preserves GORM ID)
+func (e *Export) UpdateFromDomain(domainExport *entity.Export) {
+	e.Status = string(domainExport.Status)
+	e.ObjectKey = domainExport.ObjectKey
+	e.SizeBytes = domainExport.SizeBytes
+	e.Error = domainExport.Error
+	e.CompletedAt = domainExport.CompletedAt
+	e.CallbackDeliveredAt = domainExport.CallbackDeliveredAt
+	e.CallbackError = domainExport.CallbackError
+}
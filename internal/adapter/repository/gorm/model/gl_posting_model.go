@@ -0,0 +1,78 @@
+package model
+
+import (
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"github.com/shopspring/decimal"
+)
+
+// GLPosting is one line of a transaction's journal entry in the general ledger
+type GLPosting struct {
+	TransactionID     string          `gorm:"size:25;primaryKey"`
+	Line              int             `gorm:"primaryKey"`
+	Account           string          `gorm:"size:10;not null;index:idx_gl_postings_period_account,priority:2"`
+	Side              string          `gorm:"size:10;not null"`
+	Amount            decimal.Decimal `gorm:"type:decimal(20,2);not null"`
+	Currency          string          `gorm:"size:3;not null;default:''"` // ISO 4217 code of the amount
+	CustomerAccountID *string         `gorm:"size:16;index"`
+	ProductID         string          `gorm:"size:32;index"`
+	Period            string          `gorm:"size:7;not null;index:idx_gl_postings_period_account,priority:1"`
+	ValueDate         time.Time       `gorm:"type:date;not null"`
+	CreatedAt         time.Time
+}
+
+// TableName specifies the table name for the GLPosting model
+func (GLPosting) TableName() string {
+	return "gl_postings"
+}
+
+// ToDomainGLPosting converts GORM model to domain entity
+func (p *GLPosting) ToDomainGLPosting() (*entity.GLPosting, error) {
+	transactionID, err := vo.NewTransactionIDFromString(p.TransactionID)
+	if err != nil {
+		return nil, err
+	}
+
+	posting := &entity.GLPosting{
+		TransactionID: transactionID,
+		Line:          p.Line,
+		Account:       p.Account,
+		Side:          entity.GLSide(p.Side),
+		Amount:        vo.NewMoneyIn(p.Amount, vo.Currency(p.Currency)),
+		ProductID:     p.ProductID,
+		Period:        p.Period,
+		ValueDate:     p.ValueDate,
+		CreatedAt:     p.CreatedAt,
+	}
+	if p.CustomerAccountID != nil {
+		customerAccountID, err := vo.NewAccountIDFromString(*p.CustomerAccountID)
+		if err != nil {
+			return nil, err
+		}
+		posting.CustomerAccountID = &customerAccountID
+	}
+	return posting, nil
+}
+
+// FromDomainGLPosting converts domain entity to GORM model
+func FromDomainGLPosting(posting *entity.GLPosting) *GLPosting {
+	postingModel := &GLPosting{
+		TransactionID: posting.TransactionID.String(),
+		Line:          posting.Line,
+		Account:       posting.Account,
+		Side:          string(posting.Side),
+		Amount:        posting.Amount.Amount(),
+		Currency:      posting.Amount.Currency().String(),
+		ProductID:     posting.ProductID,
+		Period:        posting.Period,
+		ValueDate:     posting.ValueDate,
+		CreatedAt:     posting.CreatedAt,
+	}
+	if posting.CustomerAccountID != nil {
+		customerAccountID := posting.CustomerAccountID.String()
+		postingModel.CustomerAccountID = &customerAccountID
+	}
+	return postingModel
+}
@@ -0,0 +1,42 @@
+package model
+
+import (
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	"gorm.io/gorm"
+)
+
+type Holiday struct {
+	gorm.Model
+	Region string    `gorm:"size:10;not null;uniqueIndex:idx_holidays_region_date"`
+	Date   time.Time `gorm:"type:date;not null;uniqueIndex:idx_holidays_region_date"`
+	Name   string    `gorm:"size:100;not null"`
+}
+
+// TableName specifies the table name for the Holiday model
+func (Holiday) TableName() string {
+	return "holidays"
+}
+
+// ToDomainHoliday converts GORM model to domain entity
+func (h *Holiday) ToDomainHoliday() *entity.Holiday {
+	return &entity.Holiday{
+		Region:    h.Region,
+		Date:      h.Date,
+		Name:      h.Name,
+		CreatedAt: h.CreatedAt,
+	}
+}
+
+// FromDomainHoliday converts domain entity to GORM model
+func FromDomainHoliday(domainHoliday *entity.Holiday) *Holiday {
+	return &Holiday{
+		Model: gorm.Model{
+			CreatedAt: domainHoliday.CreatedAt,
+		},
+		Region: domainHoliday.Region,
+		Date:   domainHoliday.Date,
+		Name:   domainHoliday.Name,
+	}
+}
@@ -0,0 +1,94 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"gorm.io/gorm"
+)
+
+type Job struct {
+	gorm.Model
+	JobID       string    `gorm:"size:32;uniqueIndex;not null"` // Format: JOB + ULID
+	JobType     string    `gorm:"size:50;not null;index:idx_jobs_claim"`
+	Payload     string    `gorm:"type:text"`
+	UniqueKey   *string   `gorm:"size:150;uniqueIndex"`                  // NULL for jobs that need no deduplication
+	Status      string    `gorm:"size:20;not null;index:idx_jobs_claim"` // QUEUED, RUNNING, SUCCEEDED, FAILED
+	Attempts    int       `gorm:"not null;default:0"`
+	MaxAttempts int       `gorm:"not null"`
+	RunAt       time.Time `gorm:"not null;index:idx_jobs_claim"`
+	LockedBy    string    `gorm:"size:100"`
+	LockedUntil *time.Time
+	LastError   string `gorm:"size:1000"`
+	StartedAt   *time.Time
+	FinishedAt  *time.Time `gorm:"index"`
+}
+
+// TableName specifies the table name for the Job model
+func (Job) TableName() string {
+	return "jobs"
+}
+
+// ToDomainJob converts GORM model to domain entity
+func (j *Job) ToDomainJob() *entity.Job {
+	job := &entity.Job{
+		ID:          j.JobID,
+		Type:        j.JobType,
+		Status:      vo.JobStatus(j.Status),
+		Attempts:    j.Attempts,
+		MaxAttempts: j.MaxAttempts,
+		RunAt:       j.RunAt,
+		LockedBy:    j.LockedBy,
+		LockedUntil: j.LockedUntil,
+		LastError:   j.LastError,
+		CreatedAt:   j.CreatedAt,
+		StartedAt:   j.StartedAt,
+		FinishedAt:  j.FinishedAt,
+	}
+	if j.Payload != "" {
+		job.Payload = json.RawMessage(j.Payload)
+	}
+	if j.UniqueKey != nil {
+		job.UniqueKey = *j.UniqueKey
+	}
+	return job
+}
+
+// FromDomainJob converts domain entity to GORM model
+func FromDomainJob(domainJob *entity.Job) *Job {
+	jobModel := &Job{
+		Model: gorm.Model{
+			CreatedAt: domainJob.CreatedAt,
+		},
+		JobID:       domainJob.ID,
+		JobType:     domainJob.Type,
+		Payload:     string(domainJob.Payload),
+		Status:      string(domainJob.Status),
+		Attempts:    domainJob.Attempts,
+		MaxAttempts: domainJob.MaxAttempts,
+		RunAt:       domainJob.RunAt,
+		LockedBy:    domainJob.LockedBy,
+		LockedUntil: domainJob.LockedUntil,
+		LastError:   domainJob.LastError,
+		StartedAt:   domainJob.StartedAt,
+		FinishedAt:  domainJob.FinishedAt,
+	}
+	if domainJob.UniqueKey != "" {
+		jobModel.UniqueKey = &domainJob.UniqueKey
+	}
+	return jobModel
+}
+
+// UpdateFromDomain updates the GORM model with domain entity data (preserves GORM ID)
+func (j *Job) UpdateFromDomain(domainJob *entity.Job) {
+	j.Status = string(domainJob.Status)
+	j.Attempts = domainJob.Attempts
+	j.RunAt = domainJob.RunAt
+	j.LockedBy = domainJob.LockedBy
+	j.LockedUntil = domainJob.LockedUntil
+	j.LastError = domainJob.LastError
+	j.StartedAt = domainJob.StartedAt
+	j.FinishedAt = domainJob.FinishedAt
+}
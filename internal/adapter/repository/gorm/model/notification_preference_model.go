@@ -0,0 +1,83 @@
+package model
+
+import (
+	"encoding/json"
+
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+)
+
+type NotificationPreference struct {
+	gorm.Model
+	CustomerID string           `gorm:"size:50;uniqueIndex;not null"`
+	Locale     string           `gorm:"size:5;not null"`
+	Timezone   string           `gorm:"size:50;not null"`
+	Recipients string           `gorm:"type:text;not null"` // JSON-encoded recipient per channel
+	Events     string           `gorm:"type:text;not null"` // JSON-encoded channels per event type
+	QuietStart string           `gorm:"size:5"`             // HH:MM; empty without quiet hours
+	QuietEnd   string           `gorm:"size:5"`
+	MinAmount  *decimal.Decimal `gorm:"type:decimal(20,2)"`
+}
+
+// TableName specifies the table name for the NotificationPreference model
+func (NotificationPreference) TableName() string {
+	return "notification_preferences"
+}
+
+// ToDomainNotificationPreference converts GORM model to domain entity
+func (p *NotificationPreference) ToDomainNotificationPreference() (*entity.NotificationPreference, error) {
+	recipients := make(map[vo.NotificationChannel]string)
+	if err := json.Unmarshal([]byte(p.Recipients), &recipients); err != nil {
+		return nil, err
+	}
+
+	events := make(map[string][]vo.NotificationChannel)
+	if err := json.Unmarshal([]byte(p.Events), &events); err != nil {
+		return nil, err
+	}
+
+	preference := &entity.NotificationPreference{
+		CustomerID: p.CustomerID,
+		Locale:     p.Locale,
+		Timezone:   p.Timezone,
+		Recipients: recipients,
+		Events:     events,
+		UpdatedAt:  p.UpdatedAt,
+	}
+	if p.QuietStart != "" {
+		preference.QuietHours = &entity.QuietHours{Start: p.QuietStart, End: p.QuietEnd}
+	}
+	if p.MinAmount != nil {
+		minAmount := vo.NewMoney(*p.MinAmount)
+		preference.MinAmount = &minAmount
+	}
+
+	return preference, nil
+}
+
+// FromDomainNotificationPreference converts domain entity to GORM model
+func FromDomainNotificationPreference(domainPreference *entity.NotificationPreference) *NotificationPreference {
+	recipients, _ := json.Marshal(domainPreference.Recipients)
+	events, _ := json.Marshal(domainPreference.Events)
+
+	preference := &NotificationPreference{
+		CustomerID: domainPreference.CustomerID,
+		Locale:     domainPreference.Locale,
+		Timezone:   domainPreference.Timezone,
+		Recipients: string(recipients),
+		Events:     string(events),
+	}
+	preference.UpdatedAt = domainPreference.UpdatedAt
+	if domainPreference.QuietHours != nil {
+		preference.QuietStart = domainPreference.QuietHours.Start
+		preference.QuietEnd = domainPreference.QuietHours.End
+	}
+	if domainPreference.MinAmount != nil {
+		minAmount := domainPreference.MinAmount.Amount()
+		preference.MinAmount = &minAmount
+	}
+
+	return preference
+}
@@ -0,0 +1,56 @@
+package model
+
+import (
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"gorm.io/gorm"
+)
+
+type NotificationTemplate struct {
+	gorm.Model
+	TemplateID string `gorm:"size:32;uniqueIndex;not null"` // Format: NTP + ULID
+	Event      string `gorm:"size:50;not null;uniqueIndex:idx_notification_template_version,priority:1"`
+	Channel    string `gorm:"size:10;not null;uniqueIndex:idx_notification_template_version,priority:2"` // EMAIL, SMS, PUSH
+	Locale     string `gorm:"size:5;not null;uniqueIndex:idx_notification_template_version,priority:3"`
+	Version    int    `gorm:"not null;uniqueIndex:idx_notification_template_version,priority:4"`
+	Subject    string `gorm:"size:200"`
+	Body       string `gorm:"size:5000;not null"`
+	CreatedBy  string `gorm:"size:100"`
+}
+
+// TableName specifies the table name for the NotificationTemplate model
+func (NotificationTemplate) TableName() string {
+	return "notification_templates"
+}
+
+// ToDomainNotificationTemplate converts GORM model to domain entity
+func (t *NotificationTemplate) ToDomainNotificationTemplate() *entity.NotificationTemplate {
+	return &entity.NotificationTemplate{
+		ID:        t.TemplateID,
+		Event:     t.Event,
+		Channel:   vo.NotificationChannel(t.Channel),
+		Locale:    t.Locale,
+		Version:   t.Version,
+		Subject:   t.Subject,
+		Body:      t.Body,
+		CreatedBy: t.CreatedBy,
+		CreatedAt: t.CreatedAt,
+	}
+}
+
+// FromDomainNotificationTemplate converts domain entity to GORM model
+func FromDomainNotificationTemplate(domainTemplate *entity.NotificationTemplate) *NotificationTemplate {
+	return &NotificationTemplate{
+		Model: gorm.Model{
+			CreatedAt: domainTemplate.CreatedAt,
+		},
+		TemplateID: domainTemplate.ID,
+		Event:      domainTemplate.Event,
+		Channel:    string(domainTemplate.Channel),
+		Locale:     domainTemplate.Locale,
+		Version:    domainTemplate.Version,
+		Subject:    domainTemplate.Subject,
+		Body:       domainTemplate.Body,
+		CreatedBy:  domainTemplate.CreatedBy,
+	}
+}
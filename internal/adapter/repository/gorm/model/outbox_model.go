@@ -0,0 +1,52 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/domain/event"
+	"gorm.io/gorm"
+)
+
+type OutboxEvent struct {
+	gorm.Model
+	EventID     string     `gorm:"size:32;uniqueIndex;not null"` // Format: EVT + ULID
+	EventType   string     `gorm:"size:50;not null"`
+	EventKey    string     `gorm:"size:50"`
+	Payload     string     `gorm:"type:text;not null"`
+	OccurredAt  time.Time  `gorm:"not null"`
+	PublishedAt *time.Time `gorm:"index"`
+	Attempts    int        `gorm:"not null;default:0"`
+	LastError   string     `gorm:"size:1000"`
+}
+
+// TableName specifies the table name for the OutboxEvent model
+func (OutboxEvent) TableName() string {
+	return "outbox_events"
+}
+
+// ToDomainOutboxEntry converts GORM model to domain outbox entry
+func (o *OutboxEvent) ToDomainOutboxEntry() *event.OutboxEntry {
+	return &event.OutboxEntry{
+		Sequence: uint64(o.ID),
+		Event: event.Event{
+			ID:         o.EventID,
+			Type:       event.Type(o.EventType),
+			Key:        o.EventKey,
+			OccurredAt: o.OccurredAt,
+			Data:       json.RawMessage(o.Payload),
+		},
+		Attempts: o.Attempts,
+	}
+}
+
+// FromDomainEvent converts a domain event to GORM model
+func FromDomainEvent(evt event.Event) *OutboxEvent {
+	return &OutboxEvent{
+		EventID:    evt.ID,
+		EventType:  string(evt.Type),
+		EventKey:   evt.Key,
+		Payload:    string(evt.Data),
+		OccurredAt: evt.OccurredAt,
+	}
+}
@@ -0,0 +1,87 @@
+package model
+
+import (
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"gorm.io/gorm"
+)
+
+type OwnershipTransfer struct {
+	gorm.Model
+	TransferID     string    `gorm:"size:32;uniqueIndex;not null"` // Format: OWN + ULID
+	AccountID      string    `gorm:"size:16;not null;index"`
+	FromCustomerID string    `gorm:"size:50;not null;index"`
+	ToCustomerID   string    `gorm:"size:50;not null;index"`
+	ReasonCode     string    `gorm:"size:30;not null"`
+	Note           string    `gorm:"size:500"`
+	EffectiveDate  time.Time `gorm:"type:date;not null;index"`
+	Status         string    `gorm:"size:20;not null;index"` // PENDING, APPROVED, COMPLETED, REJECTED
+	RequestedBy    string    `gorm:"size:100;not null"`
+	DecidedBy      string    `gorm:"size:100"`
+	DecisionReason string    `gorm:"size:500"`
+	DecidedAt      *time.Time
+	CompletedAt    *time.Time
+}
+
+// TableName specifies the table name for the OwnershipTransfer model
+func (OwnershipTransfer) TableName() string {
+	return "ownership_transfers"
+}
+
+// ToDomainOwnershipTransfer converts GORM model to domain entity
+func (t *OwnershipTransfer) ToDomainOwnershipTransfer() (*entity.OwnershipTransfer, error) {
+	accountID, err := vo.NewAccountIDFromString(t.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &entity.OwnershipTransfer{
+		ID:             t.TransferID,
+		AccountID:      accountID,
+		FromCustomerID: t.FromCustomerID,
+		ToCustomerID:   t.ToCustomerID,
+		ReasonCode:     vo.OwnershipTransferReason(t.ReasonCode),
+		Note:           t.Note,
+		EffectiveDate:  vo.DateOf(t.EffectiveDate),
+		Status:         vo.OwnershipTransferStatus(t.Status),
+		RequestedBy:    t.RequestedBy,
+		DecidedBy:      t.DecidedBy,
+		DecisionReason: t.DecisionReason,
+		CreatedAt:      t.CreatedAt,
+		DecidedAt:      t.DecidedAt,
+		CompletedAt:    t.CompletedAt,
+	}, nil
+}
+
+// FromDomainOwnershipTransfer converts domain entity to GORM model
+func FromDomainOwnershipTransfer(domainTransfer *entity.OwnershipTransfer) *OwnershipTransfer {
+	return &OwnershipTransfer{
+		Model: gorm.Model{
+			CreatedAt: domainTransfer.CreatedAt,
+		},
+		TransferID:     domainTransfer.ID,
+		AccountID:      domainTransfer.AccountID.String(),
+		FromCustomerID: domainTransfer.FromCustomerID,
+		ToCustomerID:   domainTransfer.ToCustomerID,
+		ReasonCode:     string(domainTransfer.ReasonCode),
+		Note:           domainTransfer.Note,
+		EffectiveDate:  domainTransfer.EffectiveDate,
+		Status:         string(domainTransfer.Status),
+		RequestedBy:    domainTransfer.RequestedBy,
+		DecidedBy:      domainTransfer.DecidedBy,
+		DecisionReason: domainTransfer.DecisionReason,
+		DecidedAt:      domainTransfer.DecidedAt,
+		CompletedAt:    domainTransfer.CompletedAt,
+	}
+}
+
+// UpdateFromDomain updates the GORM model with domain entity data (preserves GORM ID)
+func (t *OwnershipTransfer) UpdateFromDomain(domainTransfer *entity.OwnershipTransfer) {
+	t.Status = string(domainTransfer.Status)
+	t.DecidedBy = domainTransfer.DecidedBy
+	t.DecisionReason = domainTransfer.DecisionReason
+	t.DecidedAt = domainTransfer.DecidedAt
+	t.CompletedAt = domainTransfer.CompletedAt
+}
@@ -0,0 +1,116 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"gorm.io/gorm"
+)
+
+type ProductMigration struct {
+	gorm.Model
+	MigrationID   string    `gorm:"size:32;uniqueIndex;not null"` // Format: PMG + ULID
+	FromProductID string    `gorm:"size:32;not null;index"`
+	ToProductID   string    `gorm:"size:32;not null;index"`
+	EffectiveDate time.Time `gorm:"type:date;not null;index"`
+	Status        string    `gorm:"size:20;not null;index"` // SCHEDULED, COMPLETED
+	Note          string    `gorm:"size:500"`
+	RequestedBy   string    `gorm:"size:100;not null"`
+	Accounts      string    `gorm:"type:text;not null"` // JSON-encoded per-account outcomes
+	CompletedAt   *time.Time
+}
+
+// productMigrationAccount is the stored form of one account's outcome
+type productMigrationAccount struct {
+	AccountID  string     `json:"account_id"`
+	Status     string     `json:"status"`
+	Reason     string     `json:"reason,omitempty"`
+	MigratedAt *time.Time `json:"migrated_at,omitempty"`
+}
+
+// TableName specifies the table name for the ProductMigration model
+func (ProductMigration) TableName() string {
+	return "product_migrations"
+}
+
+// ToDomainProductMigration converts GORM model to domain entity
+func (m *ProductMigration) ToDomainProductMigration() (*entity.ProductMigration, error) {
+	var stored []productMigrationAccount
+	if err := json.Unmarshal([]byte(m.Accounts), &stored); err != nil {
+		return nil, err
+	}
+
+	accounts := make([]entity.ProductMigrationAccount, len(stored))
+	for i, account := range stored {
+		accountID, err := vo.NewAccountIDFromString(account.AccountID)
+		if err != nil {
+			return nil, err
+		}
+		accounts[i] = entity.ProductMigrationAccount{
+			AccountID:  accountID,
+			Status:     vo.ProductMigrationAccountStatus(account.Status),
+			Reason:     account.Reason,
+			MigratedAt: account.MigratedAt,
+		}
+	}
+
+	return &entity.ProductMigration{
+		ID:            m.MigrationID,
+		FromProductID: m.FromProductID,
+		ToProductID:   m.ToProductID,
+		EffectiveDate: vo.DateOf(m.EffectiveDate),
+		Status:        vo.ProductMigrationStatus(m.Status),
+		Note:          m.Note,
+		RequestedBy:   m.RequestedBy,
+		Accounts:      accounts,
+		CreatedAt:     m.CreatedAt,
+		CompletedAt:   m.CompletedAt,
+	}, nil
+}
+
+// FromDomainProductMigration converts domain entity to GORM model
+func FromDomainProductMigration(domainMigration *entity.ProductMigration) *ProductMigration {
+	accounts := encodeProductMigrationAccounts(domainMigration.Accounts)
+
+	return &ProductMigration{
+		Model: gorm.Model{
+			CreatedAt: domainMigration.CreatedAt,
+		},
+		MigrationID:   domainMigration.ID,
+		FromProductID: domainMigration.FromProductID,
+		ToProductID:   domainMigration.ToProductID,
+		EffectiveDate: domainMigration.EffectiveDate,
+		Status:        string(domainMigration.Status),
+		Note:          domainMigration.Note,
+		RequestedBy:   domainMigration.RequestedBy,
+		Accounts:      accounts,
+		CompletedAt:   domainMigration.CompletedAt,
+	}
+}
+
+// UpdateFromDomain updates the GORM model with domain entity data (preserves GORM ID)
+func (m *ProductMigration) UpdateFromDomain(domainMigration *entity.ProductMigration) {
+	accounts := encodeProductMigrationAccounts(domainMigration.Accounts)
+
+	m.Status = string(domainMigration.Status)
+	m.Accounts = accounts
+	m.CompletedAt = domainMigration.CompletedAt
+}
+
+// encodeProductMigrationAccounts JSON-encodes the per-account outcomes for storage
+func encodeProductMigrationAccounts(accounts []entity.ProductMigrationAccount) string {
+	stored := make([]productMigrationAccount, len(accounts))
+	for i, account := range accounts {
+		stored[i] = productMigrationAccount{
+			AccountID:  account.AccountID.String(),
+			Status:     string(account.Status),
+			Reason:     account.Reason,
+			MigratedAt: account.MigratedAt,
+		}
+	}
+
+	encoded, _ := json.Marshal(stored)
+	return string(encoded)
+}
@@ -0,0 +1,85 @@
+package model
+
+import (
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+)
+
+type Product struct {
+	gorm.Model
+	ProductID            string           `gorm:"size:32;uniqueIndex;not null"` // Format: PRD + ULID
+	Name                 string           `gorm:"size:100;not null"`
+	Type                 string           `gorm:"size:20;not null"` // SAVINGS, CURRENT, BUSINESS
+	Currency             string           `gorm:"size:3;not null"`
+	InterestRate         decimal.Decimal  `gorm:"type:decimal(7,4);not null;default:0"` // Annual percentage
+	DebitFee             decimal.Decimal  `gorm:"type:decimal(20,2);not null;default:0"`
+	TransferFee          decimal.Decimal  `gorm:"type:decimal(20,2);not null;default:0"`
+	MinOpeningBalance    decimal.Decimal  `gorm:"type:decimal(20,2);not null;default:0"`
+	MaxTransactionAmount *decimal.Decimal `gorm:"type:decimal(20,2)"`
+	Active               bool             `gorm:"not null;default:true"`
+}
+
+// TableName specifies the table name for the Product model
+func (Product) TableName() string {
+	return "products"
+}
+
+// ToDomainProduct converts GORM model to domain entity
+func (p *Product) ToDomainProduct() *entity.Product {
+	var maxTransactionAmount *vo.Money
+	if p.MaxTransactionAmount != nil {
+		limit := vo.NewMoney(*p.MaxTransactionAmount)
+		maxTransactionAmount = &limit
+	}
+
+	return &entity.Product{
+		ID:           p.ProductID,
+		Name:         p.Name,
+		Type:         entity.ProductType(p.Type),
+		Currency:     p.Currency,
+		InterestRate: p.InterestRate,
+		Fees: entity.ProductFees{
+			DebitFee:    vo.NewMoney(p.DebitFee),
+			TransferFee: vo.NewMoney(p.TransferFee),
+		},
+		Limits: entity.ProductLimits{
+			MinOpeningBalance:    vo.NewMoney(p.MinOpeningBalance),
+			MaxTransactionAmount: maxTransactionAmount,
+		},
+		Active:    p.Active,
+		CreatedAt: p.CreatedAt,
+		UpdatedAt: p.UpdatedAt,
+	}
+}
+
+// FromDomainProduct converts domain entity to GORM model
+func FromDomainProduct(domainProduct *entity.Product) *Product {
+	p := &Product{
+		Model: gorm.Model{
+			CreatedAt: domainProduct.CreatedAt,
+		},
+		ProductID: domainProduct.ID,
+		Type:      string(domainProduct.Type),
+		Currency:  domainProduct.Currency,
+	}
+	p.UpdateFromDomain(domainProduct)
+	return p
+}
+
+// UpdateFromDomain updates the GORM model with domain entity data (preserves GORM ID)
+func (p *Product) UpdateFromDomain(domainProduct *entity.Product) {
+	p.Name = domainProduct.Name
+	p.InterestRate = domainProduct.InterestRate
+	p.DebitFee = domainProduct.Fees.DebitFee.Amount()
+	p.TransferFee = domainProduct.Fees.TransferFee.Amount()
+	p.MinOpeningBalance = domainProduct.Limits.MinOpeningBalance.Amount()
+	p.MaxTransactionAmount = nil
+	if domainProduct.Limits.MaxTransactionAmount != nil {
+		limit := domainProduct.Limits.MaxTransactionAmount.Amount()
+		p.MaxTransactionAmount = &limit
+	}
+	p.Active = domainProduct.Active
+	p.UpdatedAt = domainProduct.UpdatedAt
+}
@@ -0,0 +1,149 @@
+package model
+
+import (
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"gorm.io/gorm"
+)
+
+type ReferralCode struct {
+	gorm.Model
+	Code      string `gorm:"size:8;uniqueIndex;not null"`
+	AccountID string `gorm:"size:16;uniqueIndex;not null"` // One code per account
+}
+
+// TableName specifies the table name for the ReferralCode model
+func (ReferralCode) TableName() string {
+	return "referral_codes"
+}
+
+// ToDomainReferralCode converts GORM model to domain entity
+func (c *ReferralCode) ToDomainReferralCode() (*entity.ReferralCode, error) {
+	accountID, err := vo.NewAccountIDFromString(c.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &entity.ReferralCode{
+		Code:      c.Code,
+		AccountID: accountID,
+		CreatedAt: c.CreatedAt,
+	}, nil
+}
+
+// FromDomainReferralCode converts domain entity to GORM model
+func FromDomainReferralCode(domainCode *entity.ReferralCode) *ReferralCode {
+	return &ReferralCode{
+		Model: gorm.Model{
+			CreatedAt: domainCode.CreatedAt,
+			UpdatedAt: domainCode.CreatedAt,
+		},
+		Code:      domainCode.Code,
+		AccountID: domainCode.AccountID.String(),
+	}
+}
+
+type Referral struct {
+	gorm.Model
+	ReferralID              string  `gorm:"size:32;uniqueIndex;not null"` // Format: RFL + ULID
+	Code                    string  `gorm:"size:8;not null"`
+	ReferrerAccountID       string  `gorm:"size:16;not null;index"`
+	RefereeAccountID        string  `gorm:"size:16;uniqueIndex;not null"` // An account is referred once
+	RefereeCustomerID       string  `gorm:"size:50;index"`
+	Status                  string  `gorm:"size:20;not null"`
+	QualifyingTransactionID *string `gorm:"size:25"`
+	ReferrerCreditID        *string `gorm:"size:25"`
+	RefereeCreditID         *string `gorm:"size:25"`
+	RewardedAt              *time.Time
+}
+
+// TableName specifies the table name for the Referral model
+func (Referral) TableName() string {
+	return "referrals"
+}
+
+// ToDomainReferral converts GORM model to domain entity
+func (r *Referral) ToDomainReferral() (*entity.Referral, error) {
+	referrerAccountID, err := vo.NewAccountIDFromString(r.ReferrerAccountID)
+	if err != nil {
+		return nil, err
+	}
+	refereeAccountID, err := vo.NewAccountIDFromString(r.RefereeAccountID)
+	if err != nil {
+		return nil, err
+	}
+	qualifyingTransactionID, err := optionalTransactionID(r.QualifyingTransactionID)
+	if err != nil {
+		return nil, err
+	}
+	referrerCreditID, err := optionalTransactionID(r.ReferrerCreditID)
+	if err != nil {
+		return nil, err
+	}
+	refereeCreditID, err := optionalTransactionID(r.RefereeCreditID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &entity.Referral{
+		ID:                      r.ReferralID,
+		Code:                    r.Code,
+		ReferrerAccountID:       referrerAccountID,
+		RefereeAccountID:        refereeAccountID,
+		RefereeCustomerID:       r.RefereeCustomerID,
+		Status:                  entity.ReferralStatus(r.Status),
+		QualifyingTransactionID: qualifyingTransactionID,
+		ReferrerCreditID:        referrerCreditID,
+		RefereeCreditID:         refereeCreditID,
+		CreatedAt:               r.CreatedAt,
+		RewardedAt:              r.RewardedAt,
+	}, nil
+}
+
+// FromDomainReferral converts domain entity to GORM model
+func FromDomainReferral(domainReferral *entity.Referral) *Referral {
+	r := &Referral{
+		Model: gorm.Model{
+			CreatedAt: domainReferral.CreatedAt,
+		},
+		ReferralID:        domainReferral.ID,
+		Code:              domainReferral.Code,
+		ReferrerAccountID: domainReferral.ReferrerAccountID.String(),
+		RefereeAccountID:  domainReferral.RefereeAccountID.String(),
+		RefereeCustomerID: domainReferral.RefereeCustomerID,
+	}
+	r.UpdateFromDomain(domainReferral)
+	return r
+}
+
+// UpdateFromDomain updates the GORM model with domain entity data (preserves GORM ID)
+func (r *Referral) UpdateFromDomain(domainReferral *entity.Referral) {
+	r.Status = string(domainReferral.Status)
+	r.QualifyingTransactionID = transactionIDString(domainReferral.QualifyingTransactionID)
+	r.ReferrerCreditID = transactionIDString(domainReferral.ReferrerCreditID)
+	r.RefereeCreditID = transactionIDString(domainReferral.RefereeCreditID)
+	r.RewardedAt = domainReferral.RewardedAt
+}
+
+func optionalTransactionID(value *string) (*vo.TransactionID, error) {
+	if value == nil {
+		return nil, nil
+	}
+
+	transactionID, err := vo.NewTransactionIDFromString(*value)
+	if err != nil {
+		return nil, err
+	}
+	return &transactionID, nil
+}
+
+func transactionIDString(transactionID *vo.TransactionID) *string {
+	if transactionID == nil {
+		return nil
+	}
+
+	value := transactionID.String()
+	return &value
+}
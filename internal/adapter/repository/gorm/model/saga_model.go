@@ -0,0 +1,74 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"gorm.io/gorm"
+)
+
+type Saga struct {
+	gorm.Model
+	SagaID        string    `gorm:"size:32;uniqueIndex;not null"` // Format: SGA + ULID
+	Type          string    `gorm:"size:30;not null"`
+	TransactionID string    `gorm:"size:25;not null;index"`
+	Status        string    `gorm:"size:20;not null;index"` // RUNNING, COMPLETED, COMPENSATING, COMPENSATED, FAILED
+	Steps         string    `gorm:"type:text;not null"`     // JSON-encoded step list
+	Error         string    `gorm:"size:1000"`
+	StartedAt     time.Time `gorm:"not null"`
+}
+
+// TableName specifies the table name for the Saga model
+func (Saga) TableName() string {
+	return "sagas"
+}
+
+// ToDomainSaga converts GORM model to domain entity
+func (s *Saga) ToDomainSaga() (*entity.Saga, error) {
+	transactionID, err := vo.NewTransactionIDFromString(s.TransactionID)
+	if err != nil {
+		return nil, err
+	}
+
+	var steps []entity.SagaStep
+	if err := json.Unmarshal([]byte(s.Steps), &steps); err != nil {
+		return nil, err
+	}
+
+	return &entity.Saga{
+		ID:            s.SagaID,
+		Type:          s.Type,
+		TransactionID: transactionID,
+		Status:        vo.SagaStatus(s.Status),
+		Steps:         steps,
+		Error:         s.Error,
+		CreatedAt:     s.StartedAt,
+		UpdatedAt:     s.UpdatedAt,
+	}, nil
+}
+
+// FromDomainSaga converts domain entity to GORM model
+func FromDomainSaga(domainSaga *entity.Saga) *Saga {
+	steps, _ := json.Marshal(domainSaga.Steps)
+
+	return &Saga{
+		SagaID:        domainSaga.ID,
+		Type:          domainSaga.Type,
+		TransactionID: domainSaga.TransactionID.String(),
+		Status:        string(domainSaga.Status),
+		Steps:         string(steps),
+		Error:         domainSaga.Error,
+		StartedAt:     domainSaga.CreatedAt,
+	}
+}
+
+// UpdateFromDomain updates the GORM model with domain entity data (preserves GORM ID)
+func (s *Saga) UpdateFromDomain(domainSaga *entity.Saga) {
+	steps, _ := json.Marshal(domainSaga.Steps)
+
+	s.Status = string(domainSaga.Status)
+	s.Steps = string(steps)
+	s.Error = domainSaga.Error
+}
@@ -0,0 +1,103 @@
+package model
+
+import (
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+)
+
+type ScheduledTransaction struct {
+	gorm.Model
+	ScheduleID           string          `gorm:"size:32;uniqueIndex;not null"` // Format: SCH + ULID
+	FromAccountID        string          `gorm:"size:16;not null;index"`
+	ToAccountID          string          `gorm:"size:16;not null;index"`
+	Amount               decimal.Decimal `gorm:"type:decimal(20,2);not null"`
+	Description          string          `gorm:"size:500"`
+	Reference            string          `gorm:"size:100"`
+	Cron                 string          `gorm:"size:100"`
+	StartAt              *time.Time
+	EndAt                *time.Time
+	NextRunAt            *time.Time `gorm:"index:idx_scheduled_transactions_due,priority:2"`
+	Status               string     `gorm:"size:20;not null;index:idx_scheduled_transactions_due,priority:1"` // ACTIVE, COMPLETED
+	RunCount             int        `gorm:"not null;default:0"`
+	FailureCount         int        `gorm:"not null;default:0"`
+	LastRunAt            *time.Time
+	LastTransactionID    string `gorm:"size:50"`
+	LastError            string `gorm:"size:500"`
+	PendingTransactionID string `gorm:"size:50"`
+}
+
+// TableName specifies the table name for the ScheduledTransaction model
+func (ScheduledTransaction) TableName() string {
+	return "scheduled_transactions"
+}
+
+// ToDomainScheduledTransaction converts GORM model to domain entity
+func (s *ScheduledTransaction) ToDomainScheduledTransaction() (*entity.ScheduledTransaction, error) {
+	fromAccountID, err := vo.NewAccountIDFromString(s.FromAccountID)
+	if err != nil {
+		return nil, err
+	}
+	toAccountID, err := vo.NewAccountIDFromString(s.ToAccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &entity.ScheduledTransaction{
+		ID:                   s.ScheduleID,
+		FromAccountID:        fromAccountID,
+		ToAccountID:          toAccountID,
+		Amount:               vo.NewMoney(s.Amount),
+		Description:          s.Description,
+		Reference:            s.Reference,
+		Cron:                 s.Cron,
+		StartAt:              s.StartAt,
+		EndAt:                s.EndAt,
+		NextRunAt:            s.NextRunAt,
+		Status:               vo.ScheduledTransactionStatus(s.Status),
+		RunCount:             s.RunCount,
+		FailureCount:         s.FailureCount,
+		LastRunAt:            s.LastRunAt,
+		LastTransactionID:    s.LastTransactionID,
+		LastError:            s.LastError,
+		PendingTransactionID: s.PendingTransactionID,
+		CreatedAt:            s.CreatedAt,
+		UpdatedAt:            s.UpdatedAt,
+	}, nil
+}
+
+// FromDomainScheduledTransaction converts domain entity to GORM model
+func FromDomainScheduledTransaction(domainScheduled *entity.ScheduledTransaction) *ScheduledTransaction {
+	s := &ScheduledTransaction{
+		Model: gorm.Model{
+			CreatedAt: domainScheduled.CreatedAt,
+		},
+		ScheduleID:    domainScheduled.ID,
+		FromAccountID: domainScheduled.FromAccountID.String(),
+	}
+	s.UpdateFromDomain(domainScheduled)
+	return s
+}
+
+// UpdateFromDomain updates the GORM model with domain entity data (preserves GORM ID)
+func (s *ScheduledTransaction) UpdateFromDomain(domainScheduled *entity.ScheduledTransaction) {
+	s.ToAccountID = domainScheduled.ToAccountID.String()
+	s.Amount = domainScheduled.Amount.Amount()
+	s.Description = domainScheduled.Description
+	s.Reference = domainScheduled.Reference
+	s.Cron = domainScheduled.Cron
+	s.StartAt = domainScheduled.StartAt
+	s.EndAt = domainScheduled.EndAt
+	s.NextRunAt = domainScheduled.NextRunAt
+	s.Status = string(domainScheduled.Status)
+	s.RunCount = domainScheduled.RunCount
+	s.FailureCount = domainScheduled.FailureCount
+	s.LastRunAt = domainScheduled.LastRunAt
+	s.LastTransactionID = domainScheduled.LastTransactionID
+	s.LastError = domainScheduled.LastError
+	s.PendingTransactionID = domainScheduled.PendingTransactionID
+	s.UpdatedAt = domainScheduled.UpdatedAt
+}
@@ -0,0 +1,73 @@
+package model
+
+import (
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"gorm.io/gorm"
+)
+
+type TransactionBlock struct {
+	gorm.Model
+	BlockID         string    `gorm:"size:32;uniqueIndex;not null"` // Format: BLK + ULID
+	AccountID       string    `gorm:"size:16;not null;index"`
+	TransactionType string    `gorm:"size:20;not null"`
+	ReasonCode      string    `gorm:"size:30;not null"`
+	Note            string    `gorm:"size:500"`
+	CreatedBy       string    `gorm:"size:100;not null"`
+	ExpiresAt       time.Time `gorm:"not null;index"`
+	LiftedBy        string    `gorm:"size:100"`
+	LiftedAt        *time.Time
+}
+
+// TableName specifies the table name for the TransactionBlock model
+func (TransactionBlock) TableName() string {
+	return "transaction_blocks"
+}
+
+// ToDomainTransactionBlock converts GORM model to domain entity
+func (b *TransactionBlock) ToDomainTransactionBlock() (*entity.TransactionBlock, error) {
+	accountID, err := vo.NewAccountIDFromString(b.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &entity.TransactionBlock{
+		ID:              b.BlockID,
+		AccountID:       accountID,
+		TransactionType: vo.TransactionType(b.TransactionType),
+		ReasonCode:      vo.TransactionBlockReason(b.ReasonCode),
+		Note:            b.Note,
+		CreatedBy:       b.CreatedBy,
+		CreatedAt:       b.CreatedAt,
+		ExpiresAt:       b.ExpiresAt,
+		LiftedBy:        b.LiftedBy,
+		LiftedAt:        b.LiftedAt,
+	}, nil
+}
+
+// FromDomainTransactionBlock converts domain entity to GORM model
+func FromDomainTransactionBlock(domainBlock *entity.TransactionBlock) *TransactionBlock {
+	return &TransactionBlock{
+		Model: gorm.Model{
+			CreatedAt: domainBlock.CreatedAt,
+		},
+		BlockID:         domainBlock.ID,
+		AccountID:       domainBlock.AccountID.String(),
+		TransactionType: string(domainBlock.TransactionType),
+		ReasonCode:      string(domainBlock.ReasonCode),
+		Note:            domainBlock.Note,
+		CreatedBy:       domainBlock.CreatedBy,
+		ExpiresAt:       domainBlock.ExpiresAt,
+		LiftedBy:        domainBlock.LiftedBy,
+		LiftedAt:        domainBlock.LiftedAt,
+	}
+}
+
+// UpdateFromDomain updates the GORM model with domain entity data (preserves GORM ID)
+func (b *TransactionBlock) UpdateFromDomain(domainBlock *entity.TransactionBlock) {
+	b.ExpiresAt = domainBlock.ExpiresAt
+	b.LiftedBy = domainBlock.LiftedBy
+	b.LiftedAt = domainBlock.LiftedAt
+}
@@ -0,0 +1,118 @@
+package model
+
+import (
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"github.com/shopspring/decimal"
+)
+
+// TransactionHistory is a row of the transaction history read model: one account's side of a
+// transaction, denormalized so it can be listed without joins
+type TransactionHistory struct {
+	TransactionID    string           `gorm:"size:25;primaryKey"`
+	AccountID        string           `gorm:"size:16;primaryKey;index:idx_transaction_history_account_created,priority:1;index:idx_transaction_history_account_sequence,priority:1"`
+	Sequence         int64            `gorm:"not null;default:0;index:idx_transaction_history_account_sequence,priority:2"` // 0 until completed
+	TransactionType  string           `gorm:"size:20;not null"`
+	Direction        string           `gorm:"size:3;not null"` // IN, OUT
+	CounterpartyID   *string          `gorm:"size:16"`
+	CounterpartyName string           `gorm:"size:100"`
+	Amount           decimal.Decimal  `gorm:"type:decimal(20,2);not null"`
+	Fee              decimal.Decimal  `gorm:"type:decimal(20,2);not null;default:0"`
+	Change           decimal.Decimal  `gorm:"type:decimal(20,2);not null;default:0"`
+	BalanceAfter     *decimal.Decimal `gorm:"type:decimal(20,2)"` // Set once the transaction completed
+	Category         string           `gorm:"size:30"`
+	Status           string           `gorm:"size:20;not null"`
+	Description      string           `gorm:"size:500"`
+	Reference        string           `gorm:"size:100"`
+	CreatedAt        time.Time        `gorm:"not null;index:idx_transaction_history_account_created,priority:2"`
+	CompletedAt      *time.Time
+	ProjectedAt      time.Time `gorm:"not null"`
+}
+
+// TableName specifies the table name for the TransactionHistory model
+func (TransactionHistory) TableName() string {
+	return "transaction_history"
+}
+
+// ToDomainHistoryEntry converts GORM model to domain entity
+func (h *TransactionHistory) ToDomainHistoryEntry() (*entity.HistoryEntry, error) {
+	transactionID, err := vo.NewTransactionIDFromString(h.TransactionID)
+	if err != nil {
+		return nil, err
+	}
+	accountID, err := vo.NewAccountIDFromString(h.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	var counterpartyID *vo.AccountID
+	if h.CounterpartyID != nil {
+		id, err := vo.NewAccountIDFromString(*h.CounterpartyID)
+		if err != nil {
+			return nil, err
+		}
+		counterpartyID = &id
+	}
+
+	var balanceAfter *vo.Money
+	if h.BalanceAfter != nil {
+		balance := vo.NewMoney(*h.BalanceAfter)
+		balanceAfter = &balance
+	}
+
+	return &entity.HistoryEntry{
+		AccountID:        accountID,
+		TransactionID:    transactionID,
+		Sequence:         h.Sequence,
+		TransactionType:  vo.TransactionType(h.TransactionType),
+		Direction:        entity.HistoryDirection(h.Direction),
+		CounterpartyID:   counterpartyID,
+		CounterpartyName: h.CounterpartyName,
+		Amount:           vo.NewMoney(h.Amount),
+		Fee:              vo.NewMoney(h.Fee),
+		Change:           vo.NewMoney(h.Change),
+		BalanceAfter:     balanceAfter,
+		Category:         h.Category,
+		Status:           vo.TransactionStatus(h.Status),
+		Description:      h.Description,
+		Reference:        h.Reference,
+		CreatedAt:        h.CreatedAt,
+		CompletedAt:      h.CompletedAt,
+		ProjectedAt:      h.ProjectedAt,
+	}, nil
+}
+
+// FromDomainHistoryEntry converts domain entity to GORM model
+func FromDomainHistoryEntry(entry *entity.HistoryEntry) *TransactionHistory {
+	history := &TransactionHistory{
+		TransactionID:    entry.TransactionID.String(),
+		AccountID:        entry.AccountID.String(),
+		Sequence:         entry.Sequence,
+		TransactionType:  string(entry.TransactionType),
+		Direction:        string(entry.Direction),
+		CounterpartyName: entry.CounterpartyName,
+		Amount:           entry.Amount.Amount(),
+		Fee:              entry.Fee.Amount(),
+		Change:           entry.Change.Amount(),
+		Category:         entry.Category,
+		Status:           string(entry.Status),
+		Description:      entry.Description,
+		Reference:        entry.Reference,
+		CreatedAt:        entry.CreatedAt,
+		CompletedAt:      entry.CompletedAt,
+		ProjectedAt:      entry.ProjectedAt,
+	}
+
+	if entry.CounterpartyID != nil {
+		counterpartyID := entry.CounterpartyID.String()
+		history.CounterpartyID = &counterpartyID
+	}
+	if entry.BalanceAfter != nil {
+		balance := entry.BalanceAfter.Amount()
+		history.BalanceAfter = &balance
+	}
+
+	return history
+}
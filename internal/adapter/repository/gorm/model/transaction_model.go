@@ -11,16 +11,60 @@ import (
 
 type Transaction struct {
 	gorm.Model
-	TransactionID   string          `gorm:"size:25;uniqueIndex;not null"` // Format: TXN + timestamp + random
-	FromAccountID   *string         `gorm:"size:16;index"`                // Foreign key to accounts.account_id
-	ToAccountID     *string         `gorm:"size:16;index"`                // Foreign key to accounts.account_id
-	TransactionType string          `gorm:"size:20;not null"`             // DEBIT, CREDIT, TRANSFER
-	Amount          decimal.Decimal `gorm:"type:decimal(20,2);not null"`
-	Description     string          `gorm:"size:500"`
-	Reference       string          `gorm:"size:100"`
-	Status          string          `gorm:"size:20;not null;default:'PENDING'"` // PENDING, COMPLETED, FAILED, CANCELLED
-	CreatedAt       time.Time       `gorm:"not null"`
-	CompletedAt     *time.Time      `gorm:"index"`
+	TransactionID    string          `gorm:"size:25;uniqueIndex;not null"` // Format: TXN + timestamp + random
+	FromAccountID    *string         `gorm:"size:16;index"`                // Foreign key to accounts.account_id
+	ToAccountID      *string         `gorm:"size:16;index"`                // Foreign key to accounts.account_id
+	VirtualAccountID string          `gorm:"size:18;index"`                // Virtual number the credit was paid to
+	TransactionType  string          `gorm:"size:20;not null"`             // DEBIT, CREDIT, TRANSFER, FX_TRANSFER, ADJUSTMENT
+	Channel          string          `gorm:"size:10;index"`                // API, BRANCH, ATM, MOBILE
+	Amount           decimal.Decimal `gorm:"type:decimal(20,2);not null"`
+	Fee              decimal.Decimal `gorm:"type:decimal(20,2);not null;default:0"`
+	Currency         string          `gorm:"size:3;not null;default:''"` // ISO 4217 code of the amount and fee
+
+	// Conversion of an FX_TRANSFER; null and empty on other transactions
+	ExchangeRate      *decimal.Decimal `gorm:"type:decimal(20,10)"`
+	ExchangeRateAsOf  *time.Time
+	ConvertedAmount   decimal.Decimal `gorm:"type:decimal(20,2);not null;default:0"`
+	ConvertedCurrency string          `gorm:"size:3;not null;default:''"`
+
+	Description           string     `gorm:"size:500"`
+	Reference             string     `gorm:"size:100"`
+	ReferenceType         string     `gorm:"size:10"` // FREE, RF
+	Merchant              string     `gorm:"size:100"`
+	Category              string     `gorm:"size:30;index"`
+	Status                string     `gorm:"size:20;not null;default:'PENDING'"` // PENDING, REVIEW, COMPLETED, FAILED, CANCELLED
+	CreatedAt             time.Time  `gorm:"not null"`
+	ValueDate             *time.Time `gorm:"type:date;index"` // Business date the transaction is booked for
+	CompletedAt           *time.Time `gorm:"index"`
+	ReviewReason          string     `gorm:"size:500"`
+	ReviewHeldAt          *time.Time `gorm:"index"` // When the confirmation was held for review
+	ReviewDueAt           *time.Time `gorm:"index"`
+	ReviewedBy            string     `gorm:"size:100"`
+	FailureKind           string     `gorm:"size:20;index"` // BUSINESS, INFRASTRUCTURE; empty unless FAILED
+	FailureReason         string     `gorm:"size:500"`
+	ReplayCount           int        `gorm:"not null;default:0"`
+	AdjustsID             *string    `gorm:"size:25;index"`      // Transaction this adjustment corrects
+	ReversedTransactionID *string    `gorm:"size:25;index"`      // Transaction a REVERSAL moves back
+	AdjustmentReason      string     `gorm:"size:30;index"`      // Reason code of an ADJUSTMENT
+	RequestedBy           string     `gorm:"size:100"`           // Admin who posted an ADJUSTMENT
+	ProcessingToken       int64      `gorm:"not null;default:0"` // Fencing token of the last claim; only written by ClaimProcessing
+	FromSequence          int64      `gorm:"not null;default:0"` // Assigned by the repository when the transaction completes
+	ToSequence            int64      `gorm:"not null;default:0"`
+	FromChange            int64      `gorm:"not null;default:0;index"` // Assigned by the repository on every write
+	ToChange              int64      `gorm:"not null;default:0;index"`
+}
+
+// AccountSequence holds the last sequence number given to a completed transaction of an account and the
+// last change number given to any write of one of its transactions
+type AccountSequence struct {
+	AccountID    string `gorm:"size:16;primaryKey"`
+	LastSequence int64  `gorm:"not null;default:0"`
+	LastChange   int64  `gorm:"not null;default:0"`
+}
+
+// TableName specifies the table name for the AccountSequence model
+func (AccountSequence) TableName() string {
+	return "account_sequences"
 }
 
 // TableName specifies the table name for the Transaction model
@@ -53,21 +97,98 @@ func (t *Transaction) ToDomainTransaction() (*entity.Transaction, error) {
 		toAccountID = &toID
 	}
 
-	money := vo.NewMoney(t.Amount)
+	currency := vo.Currency(t.Currency)
+	money := vo.NewMoneyIn(t.Amount, currency)
 	transactionType := vo.TransactionType(t.TransactionType)
 	status := vo.TransactionStatus(t.Status)
 
+	var adjustsID *vo.TransactionID
+	if t.AdjustsID != nil {
+		originalID, err := vo.NewTransactionIDFromString(*t.AdjustsID)
+		if err != nil {
+			return nil, err
+		}
+		adjustsID = &originalID
+	}
+
+	var reversedID *vo.TransactionID
+	if t.ReversedTransactionID != nil {
+		originalID, err := vo.NewTransactionIDFromString(*t.ReversedTransactionID)
+		if err != nil {
+			return nil, err
+		}
+		reversedID = &originalID
+	}
+
+	// Rows created before value dating are booked on their creation date
+	valueDate := vo.DateOf(t.CreatedAt)
+	if t.ValueDate != nil {
+		valueDate = *t.ValueDate
+	}
+
+	// Rows created before channel tagging came in through the API
+	channel := vo.TransactionChannel(t.Channel)
+	if channel == "" {
+		channel = vo.TransactionChannelAPI
+	}
+
+	// Rows created before reference types have free text references
+	referenceType := vo.ReferenceType(t.ReferenceType)
+	if referenceType == "" {
+		referenceType = vo.ReferenceTypeFree
+	}
+
+	var exchangeRate *vo.ExchangeRate
+	if t.ExchangeRate != nil {
+		rate := vo.ExchangeRate{From: currency, To: vo.Currency(t.ConvertedCurrency), Rate: *t.ExchangeRate}
+		if t.ExchangeRateAsOf != nil {
+			rate.AsOf = *t.ExchangeRateAsOf
+		}
+		exchangeRate = &rate
+	}
+
+	// Rows created before categorization have no category
+	category := t.Category
+	if category == "" {
+		category = entity.CategoryUncategorized
+	}
+
 	return &entity.Transaction{
-		ID:              transactionID,
-		FromAccountID:   fromAccountID,
-		ToAccountID:     toAccountID,
-		TransactionType: transactionType,
-		Amount:          money,
-		Description:     t.Description,
-		Reference:       t.Reference,
-		Status:          status,
-		CreatedAt:       t.CreatedAt,
-		CompletedAt:     t.CompletedAt,
+		ID:                    transactionID,
+		FromAccountID:         fromAccountID,
+		ToAccountID:           toAccountID,
+		TransactionType:       transactionType,
+		Channel:               channel,
+		Amount:                money,
+		Fee:                   vo.NewMoneyIn(t.Fee, currency),
+		ExchangeRate:          exchangeRate,
+		ConvertedAmount:       vo.NewMoneyIn(t.ConvertedAmount, vo.Currency(t.ConvertedCurrency)),
+		Description:           t.Description,
+		Reference:             t.Reference,
+		ReferenceType:         referenceType,
+		VirtualAccountID:      t.VirtualAccountID,
+		Merchant:              t.Merchant,
+		Category:              category,
+		Status:                status,
+		CreatedAt:             t.CreatedAt,
+		ValueDate:             valueDate,
+		CompletedAt:           t.CompletedAt,
+		ReviewReason:          t.ReviewReason,
+		ReviewHeldAt:          t.ReviewHeldAt,
+		ReviewDueAt:           t.ReviewDueAt,
+		ReviewedBy:            t.ReviewedBy,
+		FailureKind:           vo.FailureKind(t.FailureKind),
+		FailureReason:         t.FailureReason,
+		ReplayCount:           t.ReplayCount,
+		AdjustsID:             adjustsID,
+		ReversedTransactionID: reversedID,
+		AdjustmentReason:      vo.AdjustmentReason(t.AdjustmentReason),
+		RequestedBy:           t.RequestedBy,
+		ProcessingToken:       t.ProcessingToken,
+		FromSequence:          t.FromSequence,
+		ToSequence:            t.ToSequence,
+		FromChange:            t.FromChange,
+		ToChange:              t.ToChange,
 	}, nil
 }
 
@@ -85,20 +206,64 @@ func FromDomainTransaction(domainTransaction *entity.Transaction) *Transaction {
 		toAccountID = &id
 	}
 
+	valueDate := domainTransaction.ValueDate
+
+	var adjustsID *string
+	if domainTransaction.AdjustsID != nil {
+		id := domainTransaction.AdjustsID.String()
+		adjustsID = &id
+	}
+
+	var reversedID *string
+	if domainTransaction.ReversedTransactionID != nil {
+		id := domainTransaction.ReversedTransactionID.String()
+		reversedID = &id
+	}
+
+	var exchangeRate *decimal.Decimal
+	var exchangeRateAsOf *time.Time
+	if domainTransaction.ExchangeRate != nil {
+		rate, asOf := domainTransaction.ExchangeRate.Rate, domainTransaction.ExchangeRate.AsOf
+		exchangeRate, exchangeRateAsOf = &rate, &asOf
+	}
+
 	return &Transaction{
 		Model: gorm.Model{
 			ID:        uint(0), // Will be auto-generated
 			CreatedAt: domainTransaction.CreatedAt,
 		},
-		TransactionID:   domainTransaction.ID.String(),
-		FromAccountID:   fromAccountID,
-		ToAccountID:     toAccountID,
-		TransactionType: string(domainTransaction.TransactionType),
-		Amount:          domainTransaction.Amount.Amount(),
-		Description:     domainTransaction.Description,
-		Reference:       domainTransaction.Reference,
-		Status:          string(domainTransaction.Status),
-		CompletedAt:     domainTransaction.CompletedAt,
+		TransactionID:         domainTransaction.ID.String(),
+		FromAccountID:         fromAccountID,
+		ToAccountID:           toAccountID,
+		TransactionType:       string(domainTransaction.TransactionType),
+		Channel:               string(domainTransaction.Channel),
+		Amount:                domainTransaction.Amount.Amount(),
+		Fee:                   domainTransaction.Fee.Amount(),
+		Currency:              domainTransaction.Currency().String(),
+		ExchangeRate:          exchangeRate,
+		ExchangeRateAsOf:      exchangeRateAsOf,
+		ConvertedAmount:       domainTransaction.ConvertedAmount.Amount(),
+		ConvertedCurrency:     domainTransaction.ConvertedAmount.Currency().String(),
+		Description:           domainTransaction.Description,
+		Reference:             domainTransaction.Reference,
+		ReferenceType:         string(domainTransaction.ReferenceType),
+		VirtualAccountID:      domainTransaction.VirtualAccountID,
+		Merchant:              domainTransaction.Merchant,
+		Category:              domainTransaction.Category,
+		Status:                string(domainTransaction.Status),
+		ValueDate:             &valueDate,
+		CompletedAt:           domainTransaction.CompletedAt,
+		ReviewReason:          domainTransaction.ReviewReason,
+		ReviewHeldAt:          domainTransaction.ReviewHeldAt,
+		ReviewDueAt:           domainTransaction.ReviewDueAt,
+		ReviewedBy:            domainTransaction.ReviewedBy,
+		FailureKind:           string(domainTransaction.FailureKind),
+		FailureReason:         domainTransaction.FailureReason,
+		ReplayCount:           domainTransaction.ReplayCount,
+		AdjustsID:             adjustsID,
+		ReversedTransactionID: reversedID,
+		AdjustmentReason:      string(domainTransaction.AdjustmentReason),
+		RequestedBy:           domainTransaction.RequestedBy,
 	}
 }
 
@@ -121,10 +286,25 @@ func (t *Transaction) UpdateFromDomain(domainTransaction *entity.Transaction) {
 	t.ToAccountID = toAccountID
 
 	t.TransactionType = string(domainTransaction.TransactionType)
+	t.Channel = string(domainTransaction.Channel)
 	t.Amount = domainTransaction.Amount.Amount()
+	t.Currency = domainTransaction.Currency().String()
 	t.Description = domainTransaction.Description
 	t.Reference = domainTransaction.Reference
+	t.ReferenceType = string(domainTransaction.ReferenceType)
+	t.VirtualAccountID = domainTransaction.VirtualAccountID
+	t.Merchant = domainTransaction.Merchant
+	t.Category = domainTransaction.Category
 	t.Status = string(domainTransaction.Status)
+	valueDate := domainTransaction.ValueDate
+	t.ValueDate = &valueDate
 	t.CompletedAt = domainTransaction.CompletedAt
+	t.ReviewReason = domainTransaction.ReviewReason
+	t.ReviewHeldAt = domainTransaction.ReviewHeldAt
+	t.ReviewDueAt = domainTransaction.ReviewDueAt
+	t.ReviewedBy = domainTransaction.ReviewedBy
+	t.FailureKind = string(domainTransaction.FailureKind)
+	t.FailureReason = domainTransaction.FailureReason
+	t.ReplayCount = domainTransaction.ReplayCount
 	t.UpdatedAt = time.Now()
 }
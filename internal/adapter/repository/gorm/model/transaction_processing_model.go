@@ -0,0 +1,18 @@
+package model
+
+import "time"
+
+// TransactionProcessing records that a transaction's balance change was applied to an account. It is
+// written in the same database transaction as the balance, so a replayed confirmation finds it even
+// when the idempotency cache was flushed.
+type TransactionProcessing struct {
+	TransactionID   string `gorm:"size:25;primaryKey"`
+	AccountID       string `gorm:"size:16;primaryKey"`
+	ProcessingToken int64  `gorm:"not null"`
+	CreatedAt       time.Time
+}
+
+// TableName specifies the table name for the TransactionProcessing model
+func (TransactionProcessing) TableName() string {
+	return "transaction_processings"
+}
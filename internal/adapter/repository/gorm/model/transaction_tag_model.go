@@ -0,0 +1,111 @@
+package model
+
+import (
+	"strings"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"gorm.io/gorm"
+)
+
+// TransactionTag is one tag an account put on a transaction, keyed by all three. The account and tag
+// index serves the history tag filter.
+type TransactionTag struct {
+	AccountID     string `gorm:"size:16;primaryKey;index:idx_transaction_tags_account_tag,priority:1"`
+	TransactionID string `gorm:"size:25;primaryKey"`
+	Tag           string `gorm:"size:30;primaryKey;index:idx_transaction_tags_account_tag,priority:2"`
+	CreatedAt     time.Time
+}
+
+// TableName specifies the table name for the TransactionTag model
+func (TransactionTag) TableName() string {
+	return "transaction_tags"
+}
+
+// ToDomainTransactionTag converts GORM model to domain entity
+func (t *TransactionTag) ToDomainTransactionTag() (*entity.TransactionTag, error) {
+	accountID, err := vo.NewAccountIDFromString(t.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	transactionID, err := vo.NewTransactionIDFromString(t.TransactionID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &entity.TransactionTag{
+		AccountID:     accountID,
+		TransactionID: transactionID,
+		Tag:           t.Tag,
+		CreatedAt:     t.CreatedAt,
+	}, nil
+}
+
+// FromDomainTransactionTag converts domain entity to GORM model
+func FromDomainTransactionTag(domainTag *entity.TransactionTag) *TransactionTag {
+	return &TransactionTag{
+		AccountID:     domainTag.AccountID.String(),
+		TransactionID: domainTag.TransactionID.String(),
+		Tag:           domainTag.Tag,
+		CreatedAt:     domainTag.CreatedAt,
+	}
+}
+
+type SavedFilter struct {
+	gorm.Model
+	FilterID        string `gorm:"size:32;uniqueIndex;not null"` // Format: FLT + ULID
+	AccountID       string `gorm:"size:16;not null;uniqueIndex:idx_saved_filters_account_name"`
+	Name            string `gorm:"size:100;not null;uniqueIndex:idx_saved_filters_account_name"`
+	Tags            string `gorm:"size:400"` // Comma-separated
+	Direction       string `gorm:"size:3"`
+	TransactionType string `gorm:"size:20"`
+}
+
+// TableName specifies the table name for the SavedFilter model
+func (SavedFilter) TableName() string {
+	return "saved_filters"
+}
+
+// ToDomainSavedFilter converts GORM model to domain entity
+func (f *SavedFilter) ToDomainSavedFilter() (*entity.SavedFilter, error) {
+	accountID, err := vo.NewAccountIDFromString(f.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	var tags []string
+	for _, tag := range strings.Split(f.Tags, ",") {
+		if tag != "" {
+			tags = append(tags, tag)
+		}
+	}
+
+	return &entity.SavedFilter{
+		ID:              f.FilterID,
+		AccountID:       accountID,
+		Name:            f.Name,
+		Tags:            tags,
+		Direction:       entity.HistoryDirection(f.Direction),
+		TransactionType: vo.TransactionType(f.TransactionType),
+		CreatedAt:       f.CreatedAt,
+		UpdatedAt:       f.UpdatedAt,
+	}, nil
+}
+
+// FromDomainSavedFilter converts domain entity to GORM model
+func FromDomainSavedFilter(domainFilter *entity.SavedFilter) *SavedFilter {
+	return &SavedFilter{
+		Model: gorm.Model{
+			CreatedAt: domainFilter.CreatedAt,
+			UpdatedAt: domainFilter.UpdatedAt,
+		},
+		FilterID:        domainFilter.ID,
+		AccountID:       domainFilter.AccountID.String(),
+		Name:            domainFilter.Name,
+		Tags:            strings.Join(domainFilter.Tags, ","),
+		Direction:       string(domainFilter.Direction),
+		TransactionType: string(domainFilter.TransactionType),
+	}
+}
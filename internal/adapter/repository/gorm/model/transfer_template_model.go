@@ -0,0 +1,79 @@
+package model
+
+import (
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+)
+
+type TransferTemplate struct {
+	gorm.Model
+	TemplateID  string          `gorm:"size:32;uniqueIndex;not null"` // Format: TPL + ULID
+	AccountID   string          `gorm:"size:16;not null;index"`
+	Name        string          `gorm:"size:100;not null"`
+	ToAccountID string          `gorm:"size:16;not null"`
+	Amount      decimal.Decimal `gorm:"type:decimal(20,2);not null"`
+	Description string          `gorm:"size:500"`
+	Reference   string          `gorm:"size:100"`
+	UseCount    int             `gorm:"not null;default:0"`
+	LastUsedAt  *time.Time
+}
+
+// TableName specifies the table name for the TransferTemplate model
+func (TransferTemplate) TableName() string {
+	return "transfer_templates"
+}
+
+// ToDomainTransferTemplate converts GORM model to domain entity
+func (t *TransferTemplate) ToDomainTransferTemplate() (*entity.TransferTemplate, error) {
+	accountID, err := vo.NewAccountIDFromString(t.AccountID)
+	if err != nil {
+		return nil, err
+	}
+	toAccountID, err := vo.NewAccountIDFromString(t.ToAccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &entity.TransferTemplate{
+		ID:          t.TemplateID,
+		AccountID:   accountID,
+		Name:        t.Name,
+		ToAccountID: toAccountID,
+		Amount:      vo.NewMoney(t.Amount),
+		Description: t.Description,
+		Reference:   t.Reference,
+		UseCount:    t.UseCount,
+		LastUsedAt:  t.LastUsedAt,
+		CreatedAt:   t.CreatedAt,
+		UpdatedAt:   t.UpdatedAt,
+	}, nil
+}
+
+// FromDomainTransferTemplate converts domain entity to GORM model
+func FromDomainTransferTemplate(domainTemplate *entity.TransferTemplate) *TransferTemplate {
+	t := &TransferTemplate{
+		Model: gorm.Model{
+			CreatedAt: domainTemplate.CreatedAt,
+		},
+		TemplateID: domainTemplate.ID,
+		AccountID:  domainTemplate.AccountID.String(),
+	}
+	t.UpdateFromDomain(domainTemplate)
+	return t
+}
+
+// UpdateFromDomain updates the GORM model with domain entity data (preserves GORM ID)
+func (t *TransferTemplate) UpdateFromDomain(domainTemplate *entity.TransferTemplate) {
+	t.Name = domainTemplate.Name
+	t.ToAccountID = domainTemplate.ToAccountID.String()
+	t.Amount = domainTemplate.Amount.Amount()
+	t.Description = domainTemplate.Description
+	t.Reference = domainTemplate.Reference
+	t.UseCount = domainTemplate.UseCount
+	t.LastUsedAt = domainTemplate.LastUsedAt
+	t.UpdatedAt = domainTemplate.UpdatedAt
+}
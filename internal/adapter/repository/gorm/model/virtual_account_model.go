@@ -0,0 +1,58 @@
+package model
+
+import (
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"gorm.io/gorm"
+)
+
+type VirtualAccount struct {
+	gorm.Model
+	VirtualAccountID string `gorm:"size:18;uniqueIndex;not null"` // Format: VA + YYYYMMDD + 8 digits
+	AccountID        string `gorm:"size:16;not null;index"`       // Settlement account credited
+	Label            string `gorm:"size:100"`
+	Status           string `gorm:"size:20;not null;default:'ACTIVE'"` // ACTIVE, CLOSED
+}
+
+// TableName specifies the table name for the VirtualAccount model
+func (VirtualAccount) TableName() string {
+	return "virtual_accounts"
+}
+
+// ToDomainVirtualAccount converts GORM model to domain entity
+func (v *VirtualAccount) ToDomainVirtualAccount() (*entity.VirtualAccount, error) {
+	accountID, err := vo.NewAccountIDFromString(v.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &entity.VirtualAccount{
+		ID:        v.VirtualAccountID,
+		AccountID: accountID,
+		Label:     v.Label,
+		Status:    entity.VirtualAccountStatus(v.Status),
+		CreatedAt: v.CreatedAt,
+		UpdatedAt: v.UpdatedAt,
+	}, nil
+}
+
+// FromDomainVirtualAccount converts domain entity to GORM model
+func FromDomainVirtualAccount(domainVirtualAccount *entity.VirtualAccount) *VirtualAccount {
+	virtualAccount := &VirtualAccount{
+		Model: gorm.Model{
+			CreatedAt: domainVirtualAccount.CreatedAt,
+		},
+		VirtualAccountID: domainVirtualAccount.ID,
+		AccountID:        domainVirtualAccount.AccountID.String(),
+	}
+	virtualAccount.UpdateFromDomain(domainVirtualAccount)
+
+	return virtualAccount
+}
+
+// UpdateFromDomain updates the GORM model with domain entity data (preserves GORM ID)
+func (v *VirtualAccount) UpdateFromDomain(domainVirtualAccount *entity.VirtualAccount) {
+	v.Label = domainVirtualAccount.Label
+	v.Status = string(domainVirtualAccount.Status)
+	v.UpdatedAt = domainVirtualAccount.UpdatedAt
+}
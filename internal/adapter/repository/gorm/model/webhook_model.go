@@ -0,0 +1,109 @@
+package model
+
+import (
+	"strings"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+)
+
+type WebhookSubscription struct {
+	gorm.Model
+	WebhookID           string           `gorm:"size:32;uniqueIndex;not null"` // Format: WHK + ULID
+	AccountID           string           `gorm:"size:16;not null;index"`
+	URL                 string           `gorm:"size:500;not null"`
+	Secret              string           `gorm:"size:100;not null"`
+	Events              string           `gorm:"size:200;not null"` // Comma-separated
+	Threshold           *decimal.Decimal `gorm:"type:decimal(20,2)"`
+	Status              string           `gorm:"size:20;not null;default:'ACTIVE'"`
+	ConsecutiveFailures int              `gorm:"not null;default:0"`
+	LastError           string           `gorm:"size:500"`
+	LastDeliveryAt      *time.Time
+	DisabledAt          *time.Time
+}
+
+// TableName specifies the table name for the WebhookSubscription model
+func (WebhookSubscription) TableName() string {
+	return "webhook_subscriptions"
+}
+
+// ToDomainWebhook converts GORM model to domain entity
+func (w *WebhookSubscription) ToDomainWebhook() (*entity.WebhookSubscription, error) {
+	accountID, err := vo.NewAccountIDFromString(w.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	var events []entity.WebhookEvent
+	for _, evt := range strings.Split(w.Events, ",") {
+		if evt != "" {
+			events = append(events, entity.WebhookEvent(evt))
+		}
+	}
+
+	webhook := &entity.WebhookSubscription{
+		ID:                  w.WebhookID,
+		AccountID:           accountID,
+		URL:                 w.URL,
+		Secret:              w.Secret,
+		Events:              events,
+		Status:              entity.WebhookStatus(w.Status),
+		ConsecutiveFailures: w.ConsecutiveFailures,
+		LastError:           w.LastError,
+		LastDeliveryAt:      w.LastDeliveryAt,
+		DisabledAt:          w.DisabledAt,
+		CreatedAt:           w.CreatedAt,
+		UpdatedAt:           w.UpdatedAt,
+	}
+	if w.Threshold != nil {
+		threshold := vo.NewMoney(*w.Threshold)
+		webhook.Threshold = &threshold
+	}
+
+	return webhook, nil
+}
+
+// FromDomainWebhook converts domain entity to GORM model
+func FromDomainWebhook(domainWebhook *entity.WebhookSubscription) *WebhookSubscription {
+	webhook := &WebhookSubscription{
+		Model: gorm.Model{
+			CreatedAt: domainWebhook.CreatedAt,
+			UpdatedAt: domainWebhook.UpdatedAt,
+		},
+		WebhookID: domainWebhook.ID,
+		AccountID: domainWebhook.AccountID.String(),
+		URL:       domainWebhook.URL,
+		Secret:    domainWebhook.Secret,
+		Events:    joinWebhookEvents(domainWebhook.Events),
+	}
+	webhook.UpdateFromDomain(domainWebhook)
+	webhook.UpdatedAt = domainWebhook.UpdatedAt
+
+	return webhook
+}
+
+// UpdateFromDomain updates the GORM model with domain entity data (preserves GORM ID)
+func (w *WebhookSubscription) UpdateFromDomain(domainWebhook *entity.WebhookSubscription) {
+	w.Status = string(domainWebhook.Status)
+	w.ConsecutiveFailures = domainWebhook.ConsecutiveFailures
+	w.LastError = domainWebhook.LastError
+	w.LastDeliveryAt = domainWebhook.LastDeliveryAt
+	w.DisabledAt = domainWebhook.DisabledAt
+	w.Threshold = nil
+	if domainWebhook.Threshold != nil {
+		threshold := domainWebhook.Threshold.Amount()
+		w.Threshold = &threshold
+	}
+	w.UpdatedAt = time.Now()
+}
+
+func joinWebhookEvents(events []entity.WebhookEvent) string {
+	names := make([]string, len(events))
+	for i, evt := range events {
+		names[i] = string(evt)
+	}
+	return strings.Join(names, ",")
+}
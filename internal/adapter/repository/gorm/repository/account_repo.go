@@ -3,13 +3,16 @@ package repository
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/hydr0g3nz/mini_bank/internal/adapter/repository/gorm/model"
 	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
 	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
 	"github.com/hydr0g3nz/mini_bank/internal/domain/repository"
 	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"github.com/shopspring/decimal"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type AccountRepositoryImpl struct {
@@ -25,7 +28,7 @@ func NewAccountRepository(db *gorm.DB) repository.AccountRepository {
 func (r *AccountRepositoryImpl) Create(ctx context.Context, account *entity.Account) error {
 	accountModel := model.FromDomainAccount(account)
 
-	if err := r.db.WithContext(ctx).Create(accountModel).Error; err != nil {
+	if err := conn(ctx, r.db).Create(accountModel).Error; err != nil {
 		// Handle duplicate key constraint
 		if errors.Is(err, gorm.ErrDuplicatedKey) {
 			return errs.ErrAccountAlreadyExists
@@ -40,7 +43,7 @@ func (r *AccountRepositoryImpl) Create(ctx context.Context, account *entity.Acco
 func (r *AccountRepositoryImpl) GetByID(ctx context.Context, id vo.AccountID) (*entity.Account, error) {
 	var accountModel model.Account
 
-	err := r.db.WithContext(ctx).
+	err := conn(ctx, r.db).
 		Where("account_id = ?", id.String()).
 		First(&accountModel).Error
 
@@ -54,12 +57,64 @@ func (r *AccountRepositoryImpl) GetByID(ctx context.Context, id vo.AccountID) (*
 	return accountModel.ToDomainAccount()
 }
 
+// GetByIDForUpdate retrieves an account by ID with SELECT ... FOR UPDATE, locking its row until the
+// database transaction ends
+func (r *AccountRepositoryImpl) GetByIDForUpdate(ctx context.Context, id vo.AccountID) (*entity.Account, error) {
+	var accountModel model.Account
+
+	err := conn(ctx, r.db).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("account_id = ?", id.String()).
+		First(&accountModel).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.ErrAccountNotFound
+		}
+		return nil, err
+	}
+
+	return accountModel.ToDomainAccount()
+}
+
+// GetByIDs retrieves several accounts in one query, keyed by ID; IDs without an account are left out
+func (r *AccountRepositoryImpl) GetByIDs(ctx context.Context, ids []vo.AccountID) (map[vo.AccountID]*entity.Account, error) {
+	accounts := make(map[vo.AccountID]*entity.Account, len(ids))
+	if len(ids) == 0 {
+		return accounts, nil
+	}
+
+	values := make([]string, len(ids))
+	for i, id := range ids {
+		values[i] = id.String()
+	}
+
+	var accountModels []model.Account
+	err := conn(ctx, r.db).
+		Where("account_id IN ?", values).
+		Find(&accountModels).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, accountModel := range accountModels {
+		domainAccount, err := accountModel.ToDomainAccount()
+		if err != nil {
+			return nil, err
+		}
+		accounts[domainAccount.ID] = domainAccount
+	}
+
+	return accounts, nil
+}
+
 // Update updates an existing account
 func (r *AccountRepositoryImpl) Update(ctx context.Context, account *entity.Account) error {
 	var existingModel model.Account
 
 	// First, find the existing record by account_id
-	err := r.db.WithContext(ctx).
+	err := conn(ctx, r.db).
 		Where("account_id = ?", account.ID.String()).
 		First(&existingModel).Error
 
@@ -70,20 +125,246 @@ func (r *AccountRepositoryImpl) Update(ctx context.Context, account *entity.Acco
 		return err
 	}
 
+	// A rename is recorded in the account's name history in the same database transaction
+	previousName := existingModel.AccountName
+
 	// Update the existing model with domain data
 	existingModel.UpdateFromDomain(account)
 
 	// Save the updates
-	if err := r.db.WithContext(ctx).Save(&existingModel).Error; err != nil {
+	err = conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Save(&existingModel).Error; err != nil {
+			return err
+		}
+		if previousName == existingModel.AccountName {
+			return nil
+		}
+		return tx.Create(&model.AccountNameHistory{
+			AccountID:    existingModel.AccountID,
+			PreviousName: previousName,
+			NewName:      existingModel.AccountName,
+			ChangedAt:    account.UpdatedAt,
+		}).Error
+	})
+	if err != nil {
+		// A rename may collide with the unique account name index
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return errs.ErrAccountAlreadyExists
+		}
 		return err
 	}
 
 	return nil
 }
 
+// GetNameHistory retrieves the renames of an account, oldest first
+func (r *AccountRepositoryImpl) GetNameHistory(ctx context.Context, id vo.AccountID) ([]*entity.AccountNameChange, error) {
+	var historyModels []model.AccountNameHistory
+	err := conn(ctx, r.db).
+		Where("account_id = ?", id.String()).
+		Order("changed_at ASC, id ASC").
+		Find(&historyModels).Error
+	if err != nil {
+		return nil, err
+	}
+
+	changes := make([]*entity.AccountNameChange, 0, len(historyModels))
+	for i := range historyModels {
+		change, err := historyModels[i].ToDomainAccountNameChange()
+		if err != nil {
+			return nil, err
+		}
+		changes = append(changes, change)
+	}
+	return changes, nil
+}
+
+// UpdateFenced updates an existing account in the same statement that checks the transaction is still
+// claimed with the token. The transaction row is share-locked so a newer claim waits for the update
+// to commit and an update racing a newer claim sees it. The processing record is inserted in the same
+// database transaction, so its primary key rejects a second application of the transaction even when
+// the balance was read before the first one committed.
+func (r *AccountRepositoryImpl) UpdateFenced(ctx context.Context, account *entity.Account, transactionID vo.TransactionID, token int64) error {
+	var existingModel model.Account
+
+	err := conn(ctx, r.db).
+		Where("account_id = ?", account.ID.String()).
+		First(&existingModel).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errs.ErrAccountNotFound
+		}
+		return err
+	}
+
+	existingModel.UpdateFromDomain(account)
+
+	return conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		fence := tx.
+			Model(&model.Transaction{}).
+			Select("1").
+			Where("transaction_id = ? AND processing_token = ?", transactionID.String(), token).
+			Clauses(clause.Locking{Strength: "SHARE"})
+
+		result := tx.
+			Model(&existingModel).
+			Where("EXISTS (?)", fence).
+			Select("*").
+			Updates(&existingModel)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return errs.ErrStaleFencingToken
+		}
+
+		processing := &model.TransactionProcessing{
+			TransactionID:   transactionID.String(),
+			AccountID:       account.ID.String(),
+			ProcessingToken: token,
+		}
+		result = tx.Clauses(clause.OnConflict{DoNothing: true}).Create(processing)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return errs.ErrTransactionAlreadyApplied
+		}
+
+		return nil
+	})
+}
+
+// IsApplied reports whether a transaction's balance change was applied to an account
+func (r *AccountRepositoryImpl) IsApplied(ctx context.Context, transactionID vo.TransactionID, accountID vo.AccountID) (bool, error) {
+	var count int64
+	err := conn(ctx, r.db).
+		Model(&model.TransactionProcessing{}).
+		Where("transaction_id = ? AND account_id = ?", transactionID.String(), accountID.String()).
+		Count(&count).Error
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// ApplyCredits checks each credit's fence and records it as applied, then adds the accepted credits to
+// the balance with a single relative update, so a hot account's row is written once per batch
+func (r *AccountRepositoryImpl) ApplyCredits(ctx context.Context, accountID vo.AccountID, credits []repository.BatchedCredit) ([]error, error) {
+	outcomes := make([]error, len(credits))
+	err := conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		total := decimal.Zero
+		for i, credit := range credits {
+			var claimed []string
+			err := tx.
+				Model(&model.Transaction{}).
+				Where("transaction_id = ? AND processing_token = ?", credit.TransactionID.String(), credit.Token).
+				Clauses(clause.Locking{Strength: "SHARE"}).
+				Pluck("transaction_id", &claimed).Error
+			if err != nil {
+				return err
+			}
+			if len(claimed) == 0 {
+				outcomes[i] = errs.ErrStaleFencingToken
+				continue
+			}
+
+			processing := &model.TransactionProcessing{
+				TransactionID:   credit.TransactionID.String(),
+				AccountID:       accountID.String(),
+				ProcessingToken: credit.Token,
+			}
+			result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(processing)
+			if result.Error != nil {
+				return result.Error
+			}
+			if result.RowsAffected == 0 {
+				outcomes[i] = errs.ErrTransactionAlreadyApplied
+				continue
+			}
+
+			outcomes[i] = nil
+			total = total.Add(credit.Amount.Amount())
+		}
+
+		if total.IsZero() {
+			return nil
+		}
+
+		result := tx.
+			Model(&model.Account{}).
+			Where("account_id = ?", accountID.String()).
+			Updates(map[string]interface{}{
+				"balance":    gorm.Expr("balance + ?", total),
+				"updated_at": time.Now(),
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return errs.ErrAccountNotFound
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return outcomes, nil
+}
+
+// Revert updates an existing account and deletes the transaction's processing record for it in one
+// database transaction
+func (r *AccountRepositoryImpl) Revert(ctx context.Context, account *entity.Account, transactionID vo.TransactionID) error {
+	var existingModel model.Account
+
+	err := conn(ctx, r.db).
+		Where("account_id = ?", account.ID.String()).
+		First(&existingModel).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errs.ErrAccountNotFound
+		}
+		return err
+	}
+
+	existingModel.UpdateFromDomain(account)
+
+	return conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Save(&existingModel).Error; err != nil {
+			return err
+		}
+		return tx.
+			Where("transaction_id = ? AND account_id = ?", transactionID.String(), account.ID.String()).
+			Delete(&model.TransactionProcessing{}).Error
+	})
+}
+
+// RepairBalance sets an account's balance to the adjustment's new balance, as long as it is still the
+// adjustment's previous balance, and records the adjustment in the same database transaction
+func (r *AccountRepositoryImpl) RepairBalance(ctx context.Context, adjustment *entity.BalanceAdjustment) error {
+	return conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		result := tx.
+			Model(&model.Account{}).
+			Where("account_id = ? AND balance = ?", adjustment.AccountID.String(), adjustment.PreviousBalance.Amount()).
+			Updates(map[string]interface{}{
+				"balance":    adjustment.NewBalance.Amount(),
+				"updated_at": adjustment.CreatedAt,
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return errs.ErrBalanceChanged
+		}
+		return tx.Create(model.FromDomainBalanceAdjustment(adjustment)).Error
+	})
+}
+
 // Delete deletes an account by ID (soft delete)
 func (r *AccountRepositoryImpl) Delete(ctx context.Context, id vo.AccountID) error {
-	result := r.db.WithContext(ctx).
+	result := conn(ctx, r.db).
 		Where("account_id = ?", id.String()).
 		Delete(&model.Account{})
 
@@ -99,10 +380,10 @@ func (r *AccountRepositoryImpl) Delete(ctx context.Context, id vo.AccountID) err
 }
 
 // List retrieves accounts with pagination
-func (r *AccountRepositoryImpl) List(ctx context.Context, limit, offset int) ([]*entity.Account, error) {
+func (r *AccountRepositoryImpl) List(ctx context.Context, filter repository.AccountFilter, limit, offset int) ([]*entity.Account, error) {
 	var accountModels []model.Account
 
-	err := r.db.WithContext(ctx).
+	err := r.filtered(ctx, filter).
 		Limit(limit).
 		Offset(offset).
 		Order("created_at DESC").
@@ -125,11 +406,47 @@ func (r *AccountRepositoryImpl) List(ctx context.Context, limit, offset int) ([]
 	return accounts, nil
 }
 
+// Count returns the number of matching accounts
+func (r *AccountRepositoryImpl) Count(ctx context.Context, filter repository.AccountFilter) (int64, error) {
+	var count int64
+	err := r.filtered(ctx, filter).
+		Model(&model.Account{}).
+		Count(&count).Error
+	return count, err
+}
+
+// filtered applies an account filter to a query
+func (r *AccountRepositoryImpl) filtered(ctx context.Context, filter repository.AccountFilter) *gorm.DB {
+	query := conn(ctx, r.db)
+	if filter.Status != "" {
+		query = query.Where("status = ?", string(filter.Status))
+	}
+	if filter.CustomerID != "" {
+		query = query.Where("customer_id = ?", filter.CustomerID)
+	}
+	if filter.ProductID != "" {
+		query = query.Where("product_id = ?", filter.ProductID)
+	}
+	if filter.MinBalance != nil {
+		query = query.Where("balance >= ?", filter.MinBalance.Amount())
+	}
+	if filter.MaxBalance != nil {
+		query = query.Where("balance <= ?", filter.MaxBalance.Amount())
+	}
+	if !filter.CreatedFrom.IsZero() {
+		query = query.Where("created_at >= ?", filter.CreatedFrom)
+	}
+	if !filter.CreatedBefore.IsZero() {
+		query = query.Where("created_at < ?", filter.CreatedBefore)
+	}
+	return query
+}
+
 // GetByAccountName retrieves an account by account name
 func (r *AccountRepositoryImpl) GetByAccountName(ctx context.Context, accountName string) (*entity.Account, error) {
 	var accountModel model.Account
 
-	err := r.db.WithContext(ctx).
+	err := conn(ctx, r.db).
 		Where("account_name = ?", accountName).
 		First(&accountModel).Error
 
@@ -142,3 +459,46 @@ func (r *AccountRepositoryImpl) GetByAccountName(ctx context.Context, accountNam
 
 	return accountModel.ToDomainAccount()
 }
+
+// GetByCustomerAccountName retrieves a customer's account by account name
+func (r *AccountRepositoryImpl) GetByCustomerAccountName(ctx context.Context, customerID, accountName string) (*entity.Account, error) {
+	var accountModel model.Account
+
+	err := conn(ctx, r.db).
+		Where("customer_id = ? AND account_name = ?", customerID, accountName).
+		First(&accountModel).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.ErrAccountNotFound
+		}
+		return nil, err
+	}
+
+	return accountModel.ToDomainAccount()
+}
+
+// GetChildren retrieves the child accounts of a parent account, oldest first
+func (r *AccountRepositoryImpl) GetChildren(ctx context.Context, parentID vo.AccountID) ([]*entity.Account, error) {
+	var accountModels []model.Account
+
+	err := conn(ctx, r.db).
+		Where("parent_account_id = ?", parentID.String()).
+		Order("created_at ASC").
+		Find(&accountModels).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	accounts := make([]*entity.Account, len(accountModels))
+	for i, accountModel := range accountModels {
+		domainAccount, err := accountModel.ToDomainAccount()
+		if err != nil {
+			return nil, err
+		}
+		accounts[i] = domainAccount
+	}
+
+	return accounts, nil
+}
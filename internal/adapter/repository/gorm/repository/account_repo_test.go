@@ -12,6 +12,7 @@ import (
 	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
 	repo "github.com/hydr0g3nz/mini_bank/internal/domain/repository"
 	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"github.com/hydr0g3nz/mini_bank/internal/infrastructure"
 	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -24,12 +25,15 @@ func setupTestDB(t *testing.T) *gorm.DB {
 	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
 	require.NoError(t, err)
 
-	err = db.AutoMigrate(&model.Account{})
+	err = db.AutoMigrate(&model.Account{}, &model.AccountNameHistory{}, &model.BalanceAdjustment{})
 	require.NoError(t, err)
 
 	return db
 }
 
+// noAccountFilter lists every account
+var noAccountFilter = repo.AccountFilter{}
+
 func createTestAccount() *entity.Account {
 	money := vo.NewMoney(decimal.NewFromFloat(1000.50))
 	account, _ := entity.NewAccount("Test Account", money)
@@ -209,6 +213,75 @@ func TestAccountRepository_Update(t *testing.T) {
 	}
 }
 
+func TestAccountRepository_GetNameHistory(t *testing.T) {
+	db := setupTestDB(t)
+	repo := repository.NewAccountRepository(db)
+	ctx := context.Background()
+
+	account := createTestAccount()
+	require.NoError(t, repo.Create(ctx, account))
+
+	// Balance changes leave the history alone
+	account.Balance = vo.NewMoneyFromFloat(2000)
+	require.NoError(t, repo.Update(ctx, account))
+
+	require.NoError(t, account.Rename("Holiday Fund"))
+	require.NoError(t, repo.Update(ctx, account))
+	require.NoError(t, account.Rename("Travel"))
+	account.UpdatedAt = account.UpdatedAt.Add(time.Minute)
+	require.NoError(t, repo.Update(ctx, account))
+
+	history, err := repo.GetNameHistory(ctx, account.ID)
+	require.NoError(t, err)
+	require.Len(t, history, 2)
+	assert.Equal(t, "Test Account", history[0].PreviousName)
+	assert.Equal(t, "Holiday Fund", history[0].NewName)
+	assert.Equal(t, "Holiday Fund", history[1].PreviousName)
+	assert.Equal(t, "Travel", history[1].NewName)
+	assert.True(t, history[1].ChangedAt.After(history[0].ChangedAt))
+
+	other, err := repo.GetNameHistory(ctx, vo.NewAccountID())
+	require.NoError(t, err)
+	assert.Empty(t, other)
+}
+
+func TestAccountRepository_RepairBalance(t *testing.T) {
+	db := setupTestDB(t)
+	repo := repository.NewAccountRepository(db)
+	ctx := context.Background()
+
+	account := createTestAccount()
+	require.NoError(t, repo.Create(ctx, account))
+
+	// The account's opening balance is kept
+	stored, err := repo.GetByID(ctx, account.ID)
+	require.NoError(t, err)
+	require.NotNil(t, stored.OpeningBalance)
+	assert.True(t, stored.OpeningBalance.Equal(vo.NewMoneyFromFloat(1000.50)))
+
+	// A transaction applied after the recalculation fails the repair
+	stale := *stored
+	stale.Balance = vo.NewMoneyFromFloat(999)
+	err = repo.RepairBalance(ctx, stale.RepairBalance(vo.NewMoneyFromFloat(1200), "ops@bank", "ticket 42"))
+	assert.ErrorIs(t, err, errs.ErrBalanceChanged)
+
+	adjustment := stored.RepairBalance(vo.NewMoneyFromFloat(1200), "ops@bank", "ticket 42")
+	require.NoError(t, repo.RepairBalance(ctx, adjustment))
+
+	repaired, err := repo.GetByID(ctx, account.ID)
+	require.NoError(t, err)
+	assert.True(t, repaired.Balance.Equal(vo.NewMoneyFromFloat(1200)))
+	assert.True(t, repaired.OpeningBalance.Equal(vo.NewMoneyFromFloat(1000.50)))
+
+	var adjustments []model.BalanceAdjustment
+	require.NoError(t, db.Find(&adjustments).Error)
+	require.Len(t, adjustments, 1)
+	assert.Equal(t, account.ID.String(), adjustments[0].AccountID)
+	assert.True(t, adjustments[0].PreviousBalance.Equal(decimal.NewFromFloat(1000.50)))
+	assert.True(t, adjustments[0].NewBalance.Equal(decimal.NewFromInt(1200)))
+	assert.Equal(t, "ticket 42", adjustments[0].Reason)
+}
+
 func TestAccountRepository_Delete(t *testing.T) {
 	tests := []struct {
 		name      string
@@ -314,11 +387,16 @@ func TestAccountRepository_List(t *testing.T) {
 				require.NoError(t, err)
 			}
 
-			accounts, err := repo.List(ctx, tt.limit, tt.offset)
+			accounts, err := repo.List(ctx, noAccountFilter, tt.limit, tt.offset)
 
 			assert.NoError(t, err)
 			assert.Len(t, accounts, tt.wantCount)
 
+			// The total ignores pagination
+			total, err := repo.Count(ctx, noAccountFilter)
+			assert.NoError(t, err)
+			assert.Equal(t, int64(tt.setupCount), total)
+
 			// Verify accounts are ordered by created_at DESC
 			if len(accounts) > 1 {
 				for i := 0; i < len(accounts)-1; i++ {
@@ -330,6 +408,64 @@ func TestAccountRepository_List(t *testing.T) {
 	}
 }
 
+func TestAccountRepository_ListFiltered(t *testing.T) {
+	db := setupTestDB(t)
+	accounts := repository.NewAccountRepository(db)
+	ctx := context.Background()
+
+	jan := time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
+	seed := []struct {
+		balance   float64
+		customer  string
+		suspended bool
+		createdAt time.Time
+	}{
+		{balance: 100, customer: "CUST-1", createdAt: jan},
+		{balance: 500, customer: "CUST-1", suspended: true, createdAt: jan.AddDate(0, 0, 1)},
+		{balance: 1000, customer: "CUST-2", createdAt: jan.AddDate(0, 1, 0)},
+	}
+	for i, s := range seed {
+		account, err := entity.NewAccount(fmt.Sprintf("Account %d", i), vo.NewMoneyFromFloat(s.balance))
+		require.NoError(t, err)
+		require.NoError(t, account.AssignCustomer(s.customer))
+		if s.suspended {
+			require.NoError(t, account.Suspend())
+		}
+		require.NoError(t, accounts.Create(ctx, account))
+		require.NoError(t, db.Model(&model.Account{}).Where("account_id = ?", account.ID.String()).Update("created_at", s.createdAt).Error)
+	}
+
+	minBalance, maxBalance := vo.NewMoneyFromFloat(500), vo.NewMoneyFromFloat(1000)
+	tests := []struct {
+		name   string
+		filter repo.AccountFilter
+		want   []string
+	}{
+		{name: "no filter", filter: noAccountFilter, want: []string{"Account 2", "Account 1", "Account 0"}},
+		{name: "status", filter: repo.AccountFilter{Status: vo.AccountStatusSuspended}, want: []string{"Account 1"}},
+		{name: "customer", filter: repo.AccountFilter{CustomerID: "CUST-1"}, want: []string{"Account 1", "Account 0"}},
+		{name: "balance range", filter: repo.AccountFilter{MinBalance: &minBalance, MaxBalance: &maxBalance}, want: []string{"Account 2", "Account 1"}},
+		{name: "created range", filter: repo.AccountFilter{CreatedFrom: vo.DateOf(jan), CreatedBefore: vo.DateOf(jan).AddDate(0, 0, 1)}, want: []string{"Account 0"}},
+		{name: "combined", filter: repo.AccountFilter{CustomerID: "CUST-1", Status: vo.AccountStatusActive, MaxBalance: &minBalance}, want: []string{"Account 0"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			listed, err := accounts.List(ctx, tt.filter, 10, 0)
+			require.NoError(t, err)
+			names := make([]string, len(listed))
+			for i, account := range listed {
+				names[i] = account.AccountName
+			}
+			assert.Equal(t, tt.want, names)
+
+			count, err := accounts.Count(ctx, tt.filter)
+			require.NoError(t, err)
+			assert.Equal(t, int64(len(tt.want)), count)
+		})
+	}
+}
+
 func TestAccountRepository_GetByAccountName(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -384,3 +520,173 @@ func TestAccountRepository_GetByAccountName(t *testing.T) {
 		})
 	}
 }
+
+func TestAccountRepository_GetByCustomerAccountName(t *testing.T) {
+	db := setupTestDB(t)
+	require.NoError(t, infrastructure.MigrateAccountNameIndex(db, vo.AccountNameScopeCustomer))
+	repo := repository.NewAccountRepository(db)
+	ctx := context.Background()
+
+	alice, _ := entity.NewAccount("Savings", vo.NewMoneyFromFloat(100))
+	require.NoError(t, alice.AssignCustomer("CUST-1"))
+	bob, _ := entity.NewAccount("Savings", vo.NewMoneyFromFloat(200))
+	require.NoError(t, bob.AssignCustomer("CUST-2"))
+	require.NoError(t, repo.Create(ctx, alice))
+	require.NoError(t, repo.Create(ctx, bob))
+
+	account, err := repo.GetByCustomerAccountName(ctx, "CUST-2", "Savings")
+	require.NoError(t, err)
+	assert.Equal(t, bob.ID, account.ID)
+
+	_, err = repo.GetByCustomerAccountName(ctx, "CUST-3", "Savings")
+	assert.ErrorIs(t, err, errs.ErrAccountNotFound)
+
+	// The unique index of the scope rejects a second account of one customer with the name
+	duplicate, _ := entity.NewAccount("Savings", vo.NewMoneyFromFloat(300))
+	require.NoError(t, duplicate.AssignCustomer("CUST-1"))
+	assert.Error(t, repo.Create(ctx, duplicate))
+
+	// Widening the scope fails while names are shared, narrowing it to none drops the index
+	assert.Error(t, infrastructure.MigrateAccountNameIndex(db, vo.AccountNameScopeGlobal))
+	require.NoError(t, infrastructure.MigrateAccountNameIndex(db, vo.AccountNameScopeNone))
+	assert.NoError(t, repo.Create(ctx, duplicate))
+}
+
+func TestAccountRepository_Currency(t *testing.T) {
+	db := setupTestDB(t)
+	require.NoError(t, db.AutoMigrate(&model.Transaction{}, &model.AccountSequence{}, &model.GLPosting{}))
+	repo := repository.NewAccountRepository(db)
+	transactionRepo := repository.NewTransactionRepository(db)
+	ctx := context.Background()
+
+	dollars, err := entity.NewAccount("Dollar Savings", vo.NewMoneyFromFloat(100).In("USD"))
+	require.NoError(t, err)
+	require.NoError(t, repo.Create(ctx, dollars))
+	withdrawal, err := entity.NewDebitTransaction(dollars.ID, vo.NewMoneyFromFloat(10).In("USD"), "Withdrawal", "", time.Now())
+	require.NoError(t, err)
+	require.NoError(t, transactionRepo.Create(ctx, withdrawal))
+
+	// Rows stored before accounts carried a currency are backfilled with the service currency
+	legacy := createTestAccount()
+	require.NoError(t, repo.Create(ctx, legacy))
+	deposit, err := entity.NewCreditTransaction(legacy.ID, vo.NewMoneyFromFloat(10), "Deposit", "", time.Now())
+	require.NoError(t, err)
+	require.NoError(t, transactionRepo.Create(ctx, deposit))
+	require.NoError(t, infrastructure.MigrateCurrency(db, "THB"))
+
+	account, err := repo.GetByID(ctx, dollars.ID)
+	require.NoError(t, err)
+	assert.Equal(t, vo.Currency("USD"), account.Currency())
+	require.NotNil(t, account.OpeningBalance)
+	assert.Equal(t, vo.Currency("USD"), account.OpeningBalance.Currency())
+	transaction, err := transactionRepo.GetByID(ctx, withdrawal.ID)
+	require.NoError(t, err)
+	assert.Equal(t, vo.Currency("USD"), transaction.Currency())
+	assert.Equal(t, vo.Currency("USD"), transaction.Fee.Currency())
+
+	account, err = repo.GetByID(ctx, legacy.ID)
+	require.NoError(t, err)
+	assert.Equal(t, vo.Currency("THB"), account.Currency())
+	transaction, err = transactionRepo.GetByID(ctx, deposit.ID)
+	require.NoError(t, err)
+	assert.Equal(t, vo.Currency("THB"), transaction.Currency())
+}
+
+func TestAccountRepository_GetChildren(t *testing.T) {
+	db := setupTestDB(t)
+	repo := repository.NewAccountRepository(db)
+	ctx := context.Background()
+
+	parent := createTestAccount()
+	require.NoError(t, repo.Create(ctx, parent))
+
+	child, err := entity.NewAccount("Child Account", vo.NewMoneyFromFloat(50))
+	require.NoError(t, err)
+	require.NoError(t, child.AttachToParent(parent))
+	limit := vo.NewMoneyFromFloat(250)
+	require.NoError(t, child.SetSpendingLimit(&limit))
+	require.NoError(t, repo.Create(ctx, child))
+
+	standalone, err := entity.NewAccount("Standalone Account", vo.NewMoneyFromFloat(50))
+	require.NoError(t, err)
+	require.NoError(t, repo.Create(ctx, standalone))
+
+	children, err := repo.GetChildren(ctx, parent.ID)
+	require.NoError(t, err)
+	require.Len(t, children, 1)
+	assert.Equal(t, child.ID, children[0].ID)
+	require.NotNil(t, children[0].ParentAccountID)
+	assert.Equal(t, parent.ID, *children[0].ParentAccountID)
+	require.NotNil(t, children[0].SpendingLimit)
+	assert.True(t, children[0].SpendingLimit.Equal(limit))
+
+	// Detaching clears both columns
+	children[0].DetachFromParent()
+	require.NoError(t, repo.Update(ctx, children[0]))
+
+	children, err = repo.GetChildren(ctx, parent.ID)
+	require.NoError(t, err)
+	assert.Empty(t, children)
+
+	stored, err := repo.GetByID(ctx, child.ID)
+	require.NoError(t, err)
+	assert.Nil(t, stored.ParentAccountID)
+	assert.Nil(t, stored.SpendingLimit)
+}
+
+func TestAccountRepository_GetByIDForUpdate(t *testing.T) {
+	db := setupTestDB(t)
+	repo := repository.NewAccountRepository(db)
+	units := repository.NewUnitOfWork(db)
+	ctx := context.Background()
+
+	testAccount := createTestAccount()
+	require.NoError(t, repo.Create(ctx, testAccount))
+	expected, err := testAccount.Balance.Add(vo.NewMoneyFromFloat(50))
+	require.NoError(t, err)
+
+	// The row stays locked for the rest of the unit of work, which updates it
+	err = units.Do(ctx, func(ctx context.Context) error {
+		account, err := repo.GetByIDForUpdate(ctx, testAccount.ID)
+		if err != nil {
+			return err
+		}
+		if err := account.Credit(vo.NewMoneyFromFloat(50)); err != nil {
+			return err
+		}
+		return repo.Update(ctx, account)
+	})
+	require.NoError(t, err)
+
+	result, err := repo.GetByID(ctx, testAccount.ID)
+	require.NoError(t, err)
+	assert.True(t, result.Balance.Equal(expected), "balance %s", result.Balance)
+
+	_, err = repo.GetByIDForUpdate(ctx, vo.NewAccountID())
+	assert.ErrorIs(t, err, errs.ErrAccountNotFound)
+}
+
+func TestAccountRepository_GetByIDs(t *testing.T) {
+	db := setupTestDB(t)
+	repo := repository.NewAccountRepository(db)
+	ctx := context.Background()
+
+	first := createTestAccount()
+	require.NoError(t, repo.Create(ctx, first))
+	second, err := entity.NewAccount("Second Account", vo.NewMoneyFromFloat(75))
+	require.NoError(t, err)
+	require.NoError(t, repo.Create(ctx, second))
+	unknown := vo.NewAccountID()
+
+	accounts, err := repo.GetByIDs(ctx, []vo.AccountID{first.ID, second.ID, unknown})
+
+	require.NoError(t, err)
+	require.Len(t, accounts, 2)
+	assert.Equal(t, "Test Account", accounts[first.ID].AccountName)
+	assert.True(t, accounts[second.ID].Balance.Equal(vo.NewMoneyFromFloat(75)))
+	assert.NotContains(t, accounts, unknown)
+
+	empty, err := repo.GetByIDs(ctx, nil)
+	require.NoError(t, err)
+	assert.Empty(t, empty)
+}
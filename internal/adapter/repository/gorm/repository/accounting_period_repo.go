@@ -0,0 +1,101 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hydr0g3nz/mini_bank/internal/adapter/repository/gorm/model"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/repository"
+	"gorm.io/gorm"
+)
+
+type AccountingPeriodRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewAccountingPeriodRepository creates a new instance of AccountingPeriodRepositoryImpl
+func NewAccountingPeriodRepository(db *gorm.DB) repository.AccountingPeriodRepository {
+	return &AccountingPeriodRepositoryImpl{db: db}
+}
+
+// Create records a period close; closing a period twice is rejected
+func (r *AccountingPeriodRepositoryImpl) Create(ctx context.Context, period *entity.AccountingPeriod) error {
+	var count int64
+	err := r.db.WithContext(ctx).
+		Model(&model.AccountingPeriod{}).
+		Where("period >= ?", period.Period).
+		Count(&count).Error
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		return errs.ErrPeriodAlreadyClosed
+	}
+
+	if err := r.db.WithContext(ctx).Create(model.FromDomainAccountingPeriod(period)).Error; err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return errs.ErrPeriodAlreadyClosed
+		}
+		return err
+	}
+
+	return nil
+}
+
+// GetLatest retrieves the latest closed period
+func (r *AccountingPeriodRepositoryImpl) GetLatest(ctx context.Context) (*entity.AccountingPeriod, error) {
+	var periodModel model.AccountingPeriod
+
+	err := r.db.WithContext(ctx).
+		Order("period DESC").
+		First(&periodModel).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.ErrPeriodNotClosed
+		}
+		return nil, err
+	}
+
+	return periodModel.ToDomainAccountingPeriod(), nil
+}
+
+// GetClosing retrieves the close that locked a period: its own, or the earliest of a later month
+func (r *AccountingPeriodRepositoryImpl) GetClosing(ctx context.Context, period string) (*entity.AccountingPeriod, error) {
+	var periodModel model.AccountingPeriod
+
+	err := r.db.WithContext(ctx).
+		Where("period >= ?", period).
+		Order("period ASC").
+		First(&periodModel).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.ErrPeriodNotClosed
+		}
+		return nil, err
+	}
+
+	return periodModel.ToDomainAccountingPeriod(), nil
+}
+
+// List retrieves every closed period, latest first
+func (r *AccountingPeriodRepositoryImpl) List(ctx context.Context) ([]*entity.AccountingPeriod, error) {
+	var periodModels []model.AccountingPeriod
+
+	err := r.db.WithContext(ctx).
+		Order("period DESC").
+		Find(&periodModels).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	periods := make([]*entity.AccountingPeriod, len(periodModels))
+	for i := range periodModels {
+		periods[i] = periodModels[i].ToDomainAccountingPeriod()
+	}
+	return periods, nil
+}
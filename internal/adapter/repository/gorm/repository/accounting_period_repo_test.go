@@ -0,0 +1,58 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/adapter/repository/gorm/model"
+	"github.com/hydr0g3nz/mini_bank/internal/adapter/repository/gorm/repository"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccountingPeriodRepository(t *testing.T) {
+	db := setupTestDB(t)
+	require.NoError(t, db.AutoMigrate(&model.AccountingPeriod{}))
+
+	repo := repository.NewAccountingPeriodRepository(db)
+	ctx := context.Background()
+
+	_, err := repo.GetLatest(ctx)
+	assert.ErrorIs(t, err, errs.ErrPeriodNotClosed)
+
+	today := time.Date(2024, 4, 10, 0, 0, 0, 0, time.UTC)
+	january, err := entity.CloseAccountingPeriod("2024-01", today, "ops", "month end", nil)
+	require.NoError(t, err)
+	require.NoError(t, repo.Create(ctx, january))
+	march, err := entity.CloseAccountingPeriod("2024-03", today, "ops", "", january)
+	require.NoError(t, err)
+	require.NoError(t, repo.Create(ctx, march))
+
+	// A period at or before the latest close cannot be closed again
+	assert.ErrorIs(t, repo.Create(ctx, &entity.AccountingPeriod{Period: "2024-02", ClosedBy: "ops"}), errs.ErrPeriodAlreadyClosed)
+
+	latest, err := repo.GetLatest(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "2024-03", latest.Period)
+
+	// February was locked by the March close
+	closing, err := repo.GetClosing(ctx, "2024-02")
+	require.NoError(t, err)
+	assert.Equal(t, "2024-03", closing.Period)
+
+	closing, err = repo.GetClosing(ctx, "2024-01")
+	require.NoError(t, err)
+	assert.Equal(t, "month end", closing.Reason)
+
+	_, err = repo.GetClosing(ctx, "2024-04")
+	assert.ErrorIs(t, err, errs.ErrPeriodNotClosed)
+
+	periods, err := repo.List(ctx)
+	require.NoError(t, err)
+	require.Len(t, periods, 2)
+	assert.Equal(t, "2024-03", periods[0].Period)
+	assert.Equal(t, "2024-01", periods[1].Period)
+}
@@ -0,0 +1,145 @@
+package repository
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/adapter/repository/gorm/model"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/repository"
+	"gorm.io/gorm"
+)
+
+// readMethods are the HTTP methods that cannot change anything
+var readMethods = []string{http.MethodGet, http.MethodHead, http.MethodOptions}
+
+type AdminActivityRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewAdminActivityRepository creates a new instance of AdminActivityRepositoryImpl
+func NewAdminActivityRepository(db *gorm.DB) repository.AdminActivityRepository {
+	return &AdminActivityRepositoryImpl{db: db}
+}
+
+// Create records an admin request
+func (r *AdminActivityRepositoryImpl) Create(ctx context.Context, activity *entity.AdminActivity) error {
+	return r.db.WithContext(ctx).Create(model.FromDomainAdminActivity(activity)).Error
+}
+
+// List retrieves the matching activity, newest first
+func (r *AdminActivityRepositoryImpl) List(ctx context.Context, filter repository.AdminActivityFilter, limit, offset int) ([]*entity.AdminActivity, error) {
+	var activityModels []model.AdminActivity
+
+	err := r.filtered(ctx, filter).
+		Order("occurred_at DESC, id DESC").
+		Limit(limit).
+		Offset(offset).
+		Find(&activityModels).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	activities := make([]*entity.AdminActivity, len(activityModels))
+	for i := range activityModels {
+		activities[i] = activityModels[i].ToDomainAdminActivity()
+	}
+	return activities, nil
+}
+
+// Count counts the matching activity
+func (r *AdminActivityRepositoryImpl) Count(ctx context.Context, filter repository.AdminActivityFilter) (int64, error) {
+	var count int64
+	err := r.filtered(ctx, filter).
+		Model(&model.AdminActivity{}).
+		Count(&count).Error
+	return count, err
+}
+
+// Summarize sums up the matching activity per admin user, most active first
+func (r *AdminActivityRepositoryImpl) Summarize(ctx context.Context, filter repository.AdminActivityFilter) ([]repository.AdminActivitySummary, error) {
+	var rows []struct {
+		AdminID     string
+		AdminEmail  string
+		Actions     int64
+		Mutations   int64
+		Denied      int64
+		Anomalous   int64
+		DistinctIPs int64 `gorm:"column:distinct_ips"`
+	}
+
+	err := r.filtered(ctx, filter).
+		Model(&model.AdminActivity{}).
+		Select("admin_id, MAX(admin_email) AS admin_email, COUNT(*) AS actions, "+
+			"COALESCE(SUM(CASE WHEN method NOT IN ? THEN 1 ELSE 0 END), 0) AS mutations, "+
+			"COALESCE(SUM(CASE WHEN status_code = ? THEN 1 ELSE 0 END), 0) AS denied, "+
+			"COALESCE(SUM(CASE WHEN anomalies <> '' THEN 1 ELSE 0 END), 0) AS anomalous, "+
+			"COUNT(DISTINCT ip) AS distinct_ips",
+			readMethods, http.StatusForbidden).
+		Group("admin_id").
+		Order("actions DESC, admin_id ASC").
+		Scan(&rows).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	summaries := make([]repository.AdminActivitySummary, len(rows))
+	for i, row := range rows {
+		lastSeenAt, err := r.lastSeenAt(ctx, filter, row.AdminID)
+		if err != nil {
+			return nil, err
+		}
+		summaries[i] = repository.AdminActivitySummary{
+			AdminID:     row.AdminID,
+			AdminEmail:  row.AdminEmail,
+			Actions:     row.Actions,
+			Mutations:   row.Mutations,
+			Denied:      row.Denied,
+			Anomalous:   row.Anomalous,
+			DistinctIPs: row.DistinctIPs,
+			LastSeenAt:  lastSeenAt,
+		}
+	}
+	return summaries, nil
+}
+
+// lastSeenAt finds when the admin user last made a matching request. It is read as a row rather than
+// with MAX so the driver hands back a time, not the text SQLite aggregates timestamps into.
+func (r *AdminActivityRepositoryImpl) lastSeenAt(ctx context.Context, filter repository.AdminActivityFilter, adminID string) (time.Time, error) {
+	filter.AdminID = adminID
+
+	var latest model.AdminActivity
+	err := r.filtered(ctx, filter).
+		Select("occurred_at").
+		Order("occurred_at DESC").
+		Limit(1).
+		Find(&latest).Error
+	return latest.OccurredAt, err
+}
+
+// filtered scopes a query to the activity matching the filter
+func (r *AdminActivityRepositoryImpl) filtered(ctx context.Context, filter repository.AdminActivityFilter) *gorm.DB {
+	query := r.db.WithContext(ctx)
+	if filter.AdminID != "" {
+		query = query.Where("admin_id = ?", filter.AdminID)
+	}
+	if filter.IP != "" {
+		query = query.Where("ip = ?", filter.IP)
+	}
+	if filter.MutationsOnly {
+		query = query.Where("method NOT IN ?", readMethods)
+	}
+	if filter.AnomalousOnly {
+		query = query.Where("anomalies <> ''")
+	}
+	if filter.Anomaly != "" {
+		query = query.Where("anomalies LIKE ?", "%"+string(filter.Anomaly)+"%")
+	}
+	if !filter.From.IsZero() {
+		query = query.Where("occurred_at >= ?", filter.From)
+	}
+	return query
+}
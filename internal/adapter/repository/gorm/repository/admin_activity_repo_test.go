@@ -0,0 +1,79 @@
+package repository_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/adapter/repository/gorm/model"
+	"github.com/hydr0g3nz/mini_bank/internal/adapter/repository/gorm/repository"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	domainRepo "github.com/hydr0g3nz/mini_bank/internal/domain/repository"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAdminActivityRepository(t *testing.T) {
+	db := setupTestDB(t)
+	require.NoError(t, db.AutoMigrate(&model.AdminActivity{}))
+
+	repo := repository.NewAdminActivityRepository(db)
+	ctx := context.Background()
+
+	now := time.Date(2026, 3, 2, 10, 0, 0, 0, time.UTC)
+	record := func(adminID, method string, statusCode int, ip string, at time.Time, anomalies ...vo.AdminAnomaly) *entity.AdminActivity {
+		activity := entity.NewAdminActivity(adminID, adminID+"@bank.test", method, "/api/v1/accounts/:id",
+			"/api/v1/accounts/ACC1", "accounts:write", statusCode, ip, "th", "curl/8", at)
+		activity.Anomalies = anomalies
+		require.NoError(t, repo.Create(ctx, activity))
+		return activity
+	}
+
+	record("ADM1", http.MethodGet, http.StatusOK, "10.0.0.1", now.Add(-48*time.Hour))
+	record("ADM1", http.MethodPost, http.StatusCreated, "10.0.0.1", now)
+	newIP := record("ADM1", http.MethodDelete, http.StatusForbidden, "10.0.0.9", now.Add(time.Minute),
+		vo.AdminAnomalyNewIP, vo.AdminAnomalyUnusualHours)
+	record("ADM2", http.MethodGet, http.StatusOK, "10.0.0.2", now)
+
+	activities, err := repo.List(ctx, domainRepo.AdminActivityFilter{AdminID: "ADM1"}, 10, 0)
+	require.NoError(t, err)
+	require.Len(t, activities, 3)
+	assert.Equal(t, newIP.ID, activities[0].ID)
+	assert.Equal(t, "TH", activities[0].Country)
+	assert.Equal(t, []vo.AdminAnomaly{vo.AdminAnomalyNewIP, vo.AdminAnomalyUnusualHours}, activities[0].Anomalies)
+
+	count, err := repo.Count(ctx, domainRepo.AdminActivityFilter{AdminID: "ADM1", IP: "10.0.0.1"})
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), count)
+
+	count, err = repo.Count(ctx, domainRepo.AdminActivityFilter{MutationsOnly: true, From: now})
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), count)
+
+	count, err = repo.Count(ctx, domainRepo.AdminActivityFilter{Anomaly: vo.AdminAnomalyUnusualHours})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+
+	count, err = repo.Count(ctx, domainRepo.AdminActivityFilter{Anomaly: vo.AdminAnomalyMassOperations})
+	require.NoError(t, err)
+	assert.Zero(t, count)
+
+	summaries, err := repo.Summarize(ctx, domainRepo.AdminActivityFilter{From: now.Add(-time.Hour)})
+	require.NoError(t, err)
+	require.Len(t, summaries, 2)
+	assert.Equal(t, domainRepo.AdminActivitySummary{
+		AdminID:     "ADM1",
+		AdminEmail:  "ADM1@bank.test",
+		Actions:     2,
+		Mutations:   2,
+		Denied:      1,
+		Anomalous:   1,
+		DistinctIPs: 2,
+		LastSeenAt:  summaries[0].LastSeenAt,
+	}, summaries[0])
+	assert.True(t, summaries[0].LastSeenAt.Equal(now.Add(time.Minute)))
+	assert.Equal(t, "ADM2", summaries[1].AdminID)
+	assert.Equal(t, int64(1), summaries[1].Actions)
+}
@@ -0,0 +1,112 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hydr0g3nz/mini_bank/internal/adapter/repository/gorm/model"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/repository"
+	"gorm.io/gorm"
+)
+
+type AdminUserRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewAdminUserRepository creates a new instance of AdminUserRepositoryImpl
+func NewAdminUserRepository(db *gorm.DB) repository.AdminUserRepository {
+	return &AdminUserRepositoryImpl{db: db}
+}
+
+// Create creates a new admin user
+func (r *AdminUserRepositoryImpl) Create(ctx context.Context, admin *entity.AdminUser) error {
+	var count int64
+	err := r.db.WithContext(ctx).
+		Model(&model.AdminUser{}).
+		Where("email = ?", admin.Email).
+		Count(&count).Error
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		return errs.ErrAdminEmailTaken
+	}
+
+	if err := r.db.WithContext(ctx).Create(model.FromDomainAdminUser(admin)).Error; err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return errs.ErrAdminEmailTaken
+		}
+		return err
+	}
+	return nil
+}
+
+// GetByID retrieves an admin user by ID
+func (r *AdminUserRepositoryImpl) GetByID(ctx context.Context, id string) (*entity.AdminUser, error) {
+	return r.first(ctx, "admin_id = ?", id)
+}
+
+// GetByEmail retrieves an admin user by email
+func (r *AdminUserRepositoryImpl) GetByEmail(ctx context.Context, email string) (*entity.AdminUser, error) {
+	return r.first(ctx, "email = ?", email)
+}
+
+// GetByKeyHash retrieves the admin user whose API key has the hash
+func (r *AdminUserRepositoryImpl) GetByKeyHash(ctx context.Context, keyHash string) (*entity.AdminUser, error) {
+	return r.first(ctx, "key_hash = ?", keyHash)
+}
+
+// Update updates an existing admin user
+func (r *AdminUserRepositoryImpl) Update(ctx context.Context, admin *entity.AdminUser) error {
+	var existingModel model.AdminUser
+
+	err := r.db.WithContext(ctx).
+		Where("admin_id = ?", admin.ID).
+		First(&existingModel).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errs.ErrAdminUserNotFound
+		}
+		return err
+	}
+
+	existingModel.UpdateFromDomain(admin)
+	return r.db.WithContext(ctx).Save(&existingModel).Error
+}
+
+// List retrieves every admin user by email
+func (r *AdminUserRepositoryImpl) List(ctx context.Context) ([]*entity.AdminUser, error) {
+	var adminModels []model.AdminUser
+
+	if err := r.db.WithContext(ctx).Order("email ASC").Find(&adminModels).Error; err != nil {
+		return nil, err
+	}
+
+	admins := make([]*entity.AdminUser, len(adminModels))
+	for i, adminModel := range adminModels {
+		admins[i] = adminModel.ToDomainAdminUser()
+	}
+
+	return admins, nil
+}
+
+// first retrieves the admin user matching the condition
+func (r *AdminUserRepositoryImpl) first(ctx context.Context, query string, arg interface{}) (*entity.AdminUser, error) {
+	var adminModel model.AdminUser
+
+	err := r.db.WithContext(ctx).
+		Where(query, arg).
+		First(&adminModel).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.ErrAdminUserNotFound
+		}
+		return nil, err
+	}
+
+	return adminModel.ToDomainAdminUser(), nil
+}
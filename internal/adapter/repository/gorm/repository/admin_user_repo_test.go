@@ -0,0 +1,61 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hydr0g3nz/mini_bank/internal/adapter/repository/gorm/model"
+	"github.com/hydr0g3nz/mini_bank/internal/adapter/repository/gorm/repository"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAdminUserRepository(t *testing.T) {
+	db := setupTestDB(t)
+	require.NoError(t, db.AutoMigrate(&model.AdminUser{}))
+
+	repo := repository.NewAdminUserRepository(db)
+	ctx := context.Background()
+
+	bob, _, err := entity.NewAdminUser("bob@example.com", "Bob", []vo.AdminRole{vo.AdminRoleViewer})
+	require.NoError(t, err)
+	require.NoError(t, repo.Create(ctx, bob))
+
+	alice, key, err := entity.NewAdminUser("alice@example.com", "Alice", []vo.AdminRole{vo.AdminRoleApprover, vo.AdminRoleAuditor})
+	require.NoError(t, err)
+	require.NoError(t, repo.Create(ctx, alice))
+
+	duplicate, _, err := entity.NewAdminUser("alice@example.com", "Another Alice", []vo.AdminRole{vo.AdminRoleViewer})
+	require.NoError(t, err)
+	assert.ErrorIs(t, repo.Create(ctx, duplicate), errs.ErrAdminEmailTaken)
+
+	found, err := repo.GetByKeyHash(ctx, entity.HashAdminKey(key))
+	require.NoError(t, err)
+	assert.Equal(t, alice.ID, found.ID)
+	assert.Equal(t, []vo.AdminRole{vo.AdminRoleApprover, vo.AdminRoleAuditor}, found.Roles)
+
+	// A rotated key replaces the old one
+	rotated, err := found.RotateKey()
+	require.NoError(t, err)
+	found.Disable()
+	require.NoError(t, repo.Update(ctx, found))
+
+	_, err = repo.GetByKeyHash(ctx, entity.HashAdminKey(key))
+	assert.ErrorIs(t, err, errs.ErrAdminUserNotFound)
+	found, err = repo.GetByEmail(ctx, "alice@example.com")
+	require.NoError(t, err)
+	assert.Equal(t, entity.HashAdminKey(rotated), found.KeyHash)
+	assert.Equal(t, entity.AdminUserStatusDisabled, found.Status)
+
+	admins, err := repo.List(ctx)
+	require.NoError(t, err)
+	require.Len(t, admins, 2)
+	assert.Equal(t, alice.ID, admins[0].ID)
+	assert.Equal(t, bob.ID, admins[1].ID)
+
+	_, err = repo.GetByID(ctx, "ADM-missing")
+	assert.ErrorIs(t, err, errs.ErrAdminUserNotFound)
+}
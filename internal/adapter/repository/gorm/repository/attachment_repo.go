@@ -0,0 +1,125 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hydr0g3nz/mini_bank/internal/adapter/repository/gorm/model"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/repository"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"gorm.io/gorm"
+)
+
+type AttachmentRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewAttachmentRepository creates a new instance of AttachmentRepositoryImpl
+func NewAttachmentRepository(db *gorm.DB) repository.AttachmentRepository {
+	return &AttachmentRepositoryImpl{db: db}
+}
+
+// Create creates a new attachment
+func (r *AttachmentRepositoryImpl) Create(ctx context.Context, attachment *entity.Attachment) error {
+	return r.db.WithContext(ctx).Create(model.FromDomainAttachment(attachment)).Error
+}
+
+// GetByID retrieves an attachment by ID
+func (r *AttachmentRepositoryImpl) GetByID(ctx context.Context, id string) (*entity.Attachment, error) {
+	var attachmentModel model.Attachment
+
+	err := r.db.WithContext(ctx).
+		Where("attachment_id = ?", id).
+		First(&attachmentModel).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.ErrAttachmentNotFound
+		}
+		return nil, err
+	}
+
+	return attachmentModel.ToDomainAttachment()
+}
+
+// Delete removes an attachment
+func (r *AttachmentRepositoryImpl) Delete(ctx context.Context, id string) error {
+	// Hard delete, as the file is gone
+	result := r.db.WithContext(ctx).
+		Unscoped().
+		Where("attachment_id = ?", id).
+		Delete(&model.Attachment{})
+
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return errs.ErrAttachmentNotFound
+	}
+
+	return nil
+}
+
+// ListByTransactionID retrieves an account's attachments of a transaction, oldest first
+func (r *AttachmentRepositoryImpl) ListByTransactionID(ctx context.Context, accountID vo.AccountID, transactionID vo.TransactionID) ([]*entity.Attachment, error) {
+	var attachmentModels []model.Attachment
+
+	err := r.db.WithContext(ctx).
+		Where("account_id = ? AND transaction_id = ?", accountID.String(), transactionID.String()).
+		Order("created_at ASC, id ASC").
+		Find(&attachmentModels).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return toDomainAttachments(attachmentModels)
+}
+
+// CountByTransactionID returns the number of files an account attached to a transaction
+func (r *AttachmentRepositoryImpl) CountByTransactionID(ctx context.Context, accountID vo.AccountID, transactionID vo.TransactionID) (int64, error) {
+	var count int64
+	err := r.db.WithContext(ctx).
+		Model(&model.Attachment{}).
+		Where("account_id = ? AND transaction_id = ?", accountID.String(), transactionID.String()).
+		Count(&count).Error
+	return count, err
+}
+
+// ListOfDeletedAccounts retrieves up to limit attachments of accounts that were deleted
+func (r *AttachmentRepositoryImpl) ListOfDeletedAccounts(ctx context.Context, limit int) ([]*entity.Attachment, error) {
+	var attachmentModels []model.Attachment
+
+	deleted := r.db.Unscoped().
+		Model(&model.Account{}).
+		Select("account_id").
+		Where("deleted_at IS NOT NULL")
+
+	err := r.db.WithContext(ctx).
+		Where("account_id IN (?)", deleted).
+		Order("id ASC").
+		Limit(limit).
+		Find(&attachmentModels).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return toDomainAttachments(attachmentModels)
+}
+
+// toDomainAttachments converts attachment models to domain entities
+func toDomainAttachments(attachmentModels []model.Attachment) ([]*entity.Attachment, error) {
+	attachments := make([]*entity.Attachment, len(attachmentModels))
+	for i, attachmentModel := range attachmentModels {
+		attachment, err := attachmentModel.ToDomainAttachment()
+		if err != nil {
+			return nil, err
+		}
+		attachments[i] = attachment
+	}
+	return attachments, nil
+}
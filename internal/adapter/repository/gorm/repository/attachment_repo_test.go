@@ -0,0 +1,75 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hydr0g3nz/mini_bank/internal/adapter/repository/gorm/model"
+	"github.com/hydr0g3nz/mini_bank/internal/adapter/repository/gorm/repository"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAttachmentRepository(t *testing.T) {
+	db := setupTestDB(t)
+	require.NoError(t, db.AutoMigrate(&model.Attachment{}))
+
+	repo := repository.NewAttachmentRepository(db)
+	accountRepo := repository.NewAccountRepository(db)
+	ctx := context.Background()
+
+	account := createTestAccount()
+	require.NoError(t, accountRepo.Create(ctx, account))
+	closed := createTestAccount()
+	closed.AccountName = "Closed Account"
+	require.NoError(t, accountRepo.Create(ctx, closed))
+	transactionID := vo.NewTransactionID()
+
+	receipt, err := entity.NewAttachment(account.ID, transactionID, "receipt.pdf", "application/pdf")
+	require.NoError(t, err)
+	receipt.SizeBytes = 1024
+	require.NoError(t, repo.Create(ctx, receipt))
+
+	invoice, err := entity.NewAttachment(account.ID, transactionID, "invoice.png", "image/png")
+	require.NoError(t, err)
+	require.NoError(t, repo.Create(ctx, invoice))
+
+	old, err := entity.NewAttachment(closed.ID, transactionID, "old.jpg", "image/jpeg")
+	require.NoError(t, err)
+	require.NoError(t, repo.Create(ctx, old))
+
+	found, err := repo.GetByID(ctx, receipt.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "receipt.pdf", found.FileName)
+	assert.Equal(t, int64(1024), found.SizeBytes)
+	assert.Equal(t, receipt.ObjectKey, found.ObjectKey)
+
+	// Each account lists its own attachments
+	attachments, err := repo.ListByTransactionID(ctx, account.ID, transactionID)
+	require.NoError(t, err)
+	require.Len(t, attachments, 2)
+	assert.Equal(t, receipt.ID, attachments[0].ID)
+
+	count, err := repo.CountByTransactionID(ctx, closed.ID, transactionID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+
+	// Only attachments of deleted accounts are listed for the purge
+	attachments, err = repo.ListOfDeletedAccounts(ctx, 10)
+	require.NoError(t, err)
+	assert.Empty(t, attachments)
+
+	require.NoError(t, accountRepo.Delete(ctx, closed.ID))
+	attachments, err = repo.ListOfDeletedAccounts(ctx, 10)
+	require.NoError(t, err)
+	require.Len(t, attachments, 1)
+	assert.Equal(t, old.ID, attachments[0].ID)
+
+	require.NoError(t, repo.Delete(ctx, old.ID))
+	_, err = repo.GetByID(ctx, old.ID)
+	assert.ErrorIs(t, err, errs.ErrAttachmentNotFound)
+	assert.ErrorIs(t, repo.Delete(ctx, old.ID), errs.ErrAttachmentNotFound)
+}
@@ -0,0 +1,75 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/adapter/repository/gorm/model"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/event"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/repository"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type AuditRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewAuditRepository creates a new instance of AuditRepositoryImpl
+func NewAuditRepository(db *gorm.DB) repository.AuditRepository {
+	return &AuditRepositoryImpl{db: db}
+}
+
+// Record stores an event in the audit log; recording the same event again is a no-op
+func (r *AuditRepositoryImpl) Record(ctx context.Context, evt event.Event) error {
+	return r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "event_id"}},
+			DoNothing: true,
+		}).
+		Create(model.FromDomainAuditEvent(evt)).Error
+}
+
+// ListAfter retrieves matching entries with a sequence above afterSequence, in log order
+func (r *AuditRepositoryImpl) ListAfter(ctx context.Context, filter repository.AuditFilter, afterSequence uint64, limit int) ([]*event.AuditEntry, error) {
+	var auditModels []model.AuditEntry
+
+	query := r.db.WithContext(ctx).Where("id > ?", afterSequence)
+	if filter.EventType != "" {
+		query = query.Where("event_type = ?", string(filter.EventType))
+	}
+	if filter.Key != "" {
+		query = query.Where("event_key = ?", filter.Key)
+	}
+	if filter.From != nil {
+		query = query.Where("occurred_at >= ?", *filter.From)
+	}
+	if filter.To != nil {
+		query = query.Where("occurred_at < ?", *filter.To)
+	}
+
+	err := query.
+		Order("id ASC").
+		Limit(limit).
+		Find(&auditModels).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]*event.AuditEntry, len(auditModels))
+	for i, auditModel := range auditModels {
+		entries[i] = auditModel.ToDomainAuditEntry()
+	}
+
+	return entries, nil
+}
+
+// DeleteOccurredBefore removes the entries of events that occurred before cutoff and returns how many were removed
+func (r *AuditRepositoryImpl) DeleteOccurredBefore(ctx context.Context, cutoff time.Time) (int64, error) {
+	result := r.db.WithContext(ctx).
+		Where("occurred_at < ?", cutoff).
+		Delete(&model.AuditEntry{})
+
+	return result.RowsAffected, result.Error
+}
@@ -0,0 +1,71 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/adapter/repository/gorm/model"
+	"github.com/hydr0g3nz/mini_bank/internal/adapter/repository/gorm/repository"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/event"
+	repo "github.com/hydr0g3nz/mini_bank/internal/domain/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuditRepository_RecordAndFilter(t *testing.T) {
+	db := setupTestDB(t)
+	require.NoError(t, db.AutoMigrate(&model.AuditEntry{}))
+
+	auditRepo := repository.NewAuditRepository(db)
+	ctx := context.Background()
+
+	account := createTestAccount()
+	other := createTestAccount()
+	created := event.NewAccountEvent(event.AccountCreated, account)
+	created.OccurredAt = time.Now().Add(-48 * time.Hour)
+	updated := event.NewAccountEvent(event.AccountUpdated, account)
+	updated.ID = created.ID + "2"
+	otherCreated := event.NewAccountEvent(event.AccountCreated, other)
+	otherCreated.ID = created.ID + "3"
+	for _, evt := range []event.Event{created, updated, otherCreated} {
+		require.NoError(t, auditRepo.Record(ctx, evt))
+	}
+
+	// Recording an event twice keeps one entry
+	require.NoError(t, auditRepo.Record(ctx, created))
+
+	all, err := auditRepo.ListAfter(ctx, repo.AuditFilter{}, 0, 10)
+	require.NoError(t, err)
+	require.Len(t, all, 3)
+	assert.Equal(t, created.ID, all[0].Event.ID)
+	assert.JSONEq(t, string(created.Data), string(all[0].Event.Data))
+
+	page, err := auditRepo.ListAfter(ctx, repo.AuditFilter{}, all[0].Sequence, 1)
+	require.NoError(t, err)
+	require.Len(t, page, 1)
+	assert.Equal(t, updated.ID, page[0].Event.ID)
+
+	byAccount, err := auditRepo.ListAfter(ctx, repo.AuditFilter{Key: account.ID.String()}, 0, 10)
+	require.NoError(t, err)
+	assert.Len(t, byAccount, 2)
+
+	byType, err := auditRepo.ListAfter(ctx, repo.AuditFilter{EventType: event.AccountCreated}, 0, 10)
+	require.NoError(t, err)
+	assert.Len(t, byType, 2)
+
+	since := time.Now().Add(-time.Hour)
+	recent, err := auditRepo.ListAfter(ctx, repo.AuditFilter{Key: account.ID.String(), From: &since}, 0, 10)
+	require.NoError(t, err)
+	require.Len(t, recent, 1)
+	assert.Equal(t, updated.ID, recent[0].Event.ID)
+
+	// Retention removes only the entries that occurred before the cutoff
+	purged, err := auditRepo.DeleteOccurredBefore(ctx, time.Now().Add(-24*time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), purged)
+
+	all, err = auditRepo.ListAfter(ctx, repo.AuditFilter{}, 0, 10)
+	require.NoError(t, err)
+	assert.Len(t, all, 2)
+}
@@ -0,0 +1,95 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hydr0g3nz/mini_bank/internal/adapter/repository/gorm/model"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/repository"
+	"gorm.io/gorm"
+)
+
+type BackupRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewBackupRepository creates a new instance of BackupRepositoryImpl
+func NewBackupRepository(db *gorm.DB) repository.BackupRepository {
+	return &BackupRepositoryImpl{db: db}
+}
+
+// Create records a new backup
+func (r *BackupRepositoryImpl) Create(ctx context.Context, backup *entity.Backup) error {
+	return r.db.WithContext(ctx).Create(model.FromDomainBackup(backup)).Error
+}
+
+// GetByID retrieves a backup by ID
+func (r *BackupRepositoryImpl) GetByID(ctx context.Context, id string) (*entity.Backup, error) {
+	var backupModel model.Backup
+
+	err := r.db.WithContext(ctx).
+		Where("backup_id = ?", id).
+		First(&backupModel).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.ErrBackupNotFound
+		}
+		return nil, err
+	}
+
+	return backupModel.ToDomainBackup(), nil
+}
+
+// Update updates an existing backup record
+func (r *BackupRepositoryImpl) Update(ctx context.Context, backup *entity.Backup) error {
+	var existingModel model.Backup
+
+	err := r.db.WithContext(ctx).
+		Where("backup_id = ?", backup.ID).
+		First(&existingModel).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errs.ErrBackupNotFound
+		}
+		return err
+	}
+
+	existingModel.UpdateFromDomain(backup)
+
+	return r.db.WithContext(ctx).Save(&existingModel).Error
+}
+
+// List retrieves backups with pagination, newest first
+func (r *BackupRepositoryImpl) List(ctx context.Context, limit, offset int) ([]*entity.Backup, error) {
+	var backupModels []model.Backup
+
+	err := r.db.WithContext(ctx).
+		Limit(limit).
+		Offset(offset).
+		Order("started_at DESC").
+		Find(&backupModels).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	backups := make([]*entity.Backup, len(backupModels))
+	for i, backupModel := range backupModels {
+		backups[i] = backupModel.ToDomainBackup()
+	}
+
+	return backups, nil
+}
+
+// Count returns the total number of backups
+func (r *BackupRepositoryImpl) Count(ctx context.Context) (int64, error) {
+	var count int64
+	err := r.db.WithContext(ctx).
+		Model(&model.Backup{}).
+		Count(&count).Error
+	return count, err
+}
@@ -0,0 +1,147 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/adapter/repository/gorm/model"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/repository"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"gorm.io/gorm"
+)
+
+type BudgetRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewBudgetRepository creates a new instance of BudgetRepositoryImpl
+func NewBudgetRepository(db *gorm.DB) repository.BudgetRepository {
+	return &BudgetRepositoryImpl{db: db}
+}
+
+// Create creates a new budget
+func (r *BudgetRepositoryImpl) Create(ctx context.Context, budget *entity.Budget) error {
+	var count int64
+	err := r.db.WithContext(ctx).
+		Model(&model.Budget{}).
+		Where("account_id = ? AND category_code = ?", budget.AccountID.String(), budget.CategoryCode).
+		Count(&count).Error
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		return errs.ErrBudgetAlreadyExists
+	}
+
+	if err := r.db.WithContext(ctx).Create(model.FromDomainBudget(budget)).Error; err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return errs.ErrBudgetAlreadyExists
+		}
+		return err
+	}
+
+	return nil
+}
+
+// GetByID retrieves a budget by ID
+func (r *BudgetRepositoryImpl) GetByID(ctx context.Context, id string) (*entity.Budget, error) {
+	var budgetModel model.Budget
+
+	err := r.db.WithContext(ctx).
+		Where("budget_id = ?", id).
+		First(&budgetModel).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.ErrBudgetNotFound
+		}
+		return nil, err
+	}
+
+	return budgetModel.ToDomainBudget()
+}
+
+// Update updates an existing budget
+func (r *BudgetRepositoryImpl) Update(ctx context.Context, budget *entity.Budget) error {
+	var existingModel model.Budget
+
+	err := r.db.WithContext(ctx).
+		Where("budget_id = ?", budget.ID).
+		First(&existingModel).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errs.ErrBudgetNotFound
+		}
+		return err
+	}
+
+	existingModel.UpdateFromDomain(budget)
+	return r.db.WithContext(ctx).Save(&existingModel).Error
+}
+
+// Delete removes a budget
+func (r *BudgetRepositoryImpl) Delete(ctx context.Context, id string) error {
+	// Hard delete so the category can be budgeted again
+	result := r.db.WithContext(ctx).
+		Unscoped().
+		Where("budget_id = ?", id).
+		Delete(&model.Budget{})
+
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return errs.ErrBudgetNotFound
+	}
+
+	return nil
+}
+
+// ListByAccountID retrieves the budgets of an account ordered by category
+func (r *BudgetRepositoryImpl) ListByAccountID(ctx context.Context, accountID vo.AccountID) ([]*entity.Budget, error) {
+	var budgetModels []model.Budget
+
+	err := r.db.WithContext(ctx).
+		Where("account_id = ?", accountID.String()).
+		Order("category_code ASC").
+		Find(&budgetModels).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	budgets := make([]*entity.Budget, len(budgetModels))
+	for i, budgetModel := range budgetModels {
+		budget, err := budgetModel.ToDomainBudget()
+		if err != nil {
+			return nil, err
+		}
+		budgets[i] = budget
+	}
+
+	return budgets, nil
+}
+
+// RecordAlert records that a threshold was alerted in a period, once per period and threshold
+func (r *BudgetRepositoryImpl) RecordAlert(ctx context.Context, id string, period string, threshold int) (bool, error) {
+	// Conditional update so only one of several concurrent completions wins
+	result := r.db.WithContext(ctx).
+		Model(&model.Budget{}).
+		Where("budget_id = ? AND (alerted_period IS NULL OR alerted_period <> ? OR alerted_threshold < ?)", id, period, threshold).
+		Updates(map[string]interface{}{
+			"alerted_period":    period,
+			"alerted_threshold": threshold,
+			"updated_at":        time.Now(),
+		})
+
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	return result.RowsAffected == 1, nil
+}
@@ -0,0 +1,75 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hydr0g3nz/mini_bank/internal/adapter/repository/gorm/model"
+	"github.com/hydr0g3nz/mini_bank/internal/adapter/repository/gorm/repository"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBudgetRepository(t *testing.T) {
+	db := setupTestDB(t)
+	require.NoError(t, db.AutoMigrate(&model.Budget{}))
+
+	repo := repository.NewBudgetRepository(db)
+	ctx := context.Background()
+	accountID := vo.NewAccountID()
+
+	groceries, err := entity.NewBudget(accountID, "GROCERIES", vo.NewMoneyFromFloat(500))
+	require.NoError(t, err)
+	require.NoError(t, repo.Create(ctx, groceries))
+
+	dining, err := entity.NewBudget(accountID, "DINING", vo.NewMoneyFromFloat(200))
+	require.NoError(t, err)
+	require.NoError(t, repo.Create(ctx, dining))
+
+	duplicate, err := entity.NewBudget(accountID, "GROCERIES", vo.NewMoneyFromFloat(100))
+	require.NoError(t, err)
+	assert.ErrorIs(t, repo.Create(ctx, duplicate), errs.ErrBudgetAlreadyExists)
+
+	budgets, err := repo.ListByAccountID(ctx, accountID)
+	require.NoError(t, err)
+	require.Len(t, budgets, 2)
+	assert.Equal(t, "DINING", budgets[0].CategoryCode)
+
+	// Each threshold is recorded once per period
+	recorded, err := repo.RecordAlert(ctx, groceries.ID, "2025-07", 80)
+	require.NoError(t, err)
+	assert.True(t, recorded)
+
+	recorded, err = repo.RecordAlert(ctx, groceries.ID, "2025-07", 80)
+	require.NoError(t, err)
+	assert.False(t, recorded)
+
+	recorded, err = repo.RecordAlert(ctx, groceries.ID, "2025-07", 100)
+	require.NoError(t, err)
+	assert.True(t, recorded)
+
+	recorded, err = repo.RecordAlert(ctx, groceries.ID, "2025-08", 80)
+	require.NoError(t, err)
+	assert.True(t, recorded)
+
+	found, err := repo.GetByID(ctx, groceries.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "2025-08", found.AlertedPeriod)
+	assert.Equal(t, 80, found.AlertedThreshold)
+
+	// Changing the amount re-arms the alerts
+	require.NoError(t, found.UpdateAmount(vo.NewMoneyFromFloat(800)))
+	require.NoError(t, repo.Update(ctx, found))
+	found, err = repo.GetByID(ctx, groceries.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "800", found.Amount.String())
+	assert.Equal(t, 0, found.AlertedThreshold)
+
+	require.NoError(t, repo.Delete(ctx, groceries.ID))
+	_, err = repo.GetByID(ctx, groceries.ID)
+	assert.ErrorIs(t, err, errs.ErrBudgetNotFound)
+	assert.ErrorIs(t, repo.Delete(ctx, groceries.ID), errs.ErrBudgetNotFound)
+}
@@ -0,0 +1,111 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hydr0g3nz/mini_bank/internal/adapter/repository/gorm/model"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/repository"
+	"gorm.io/gorm"
+)
+
+type BusinessRuleRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewBusinessRuleRepository creates a new instance of BusinessRuleRepositoryImpl
+func NewBusinessRuleRepository(db *gorm.DB) repository.BusinessRuleRepository {
+	return &BusinessRuleRepositoryImpl{db: db}
+}
+
+// Create creates a new business rule
+func (r *BusinessRuleRepositoryImpl) Create(ctx context.Context, rule *entity.BusinessRule) error {
+	return r.db.WithContext(ctx).Create(model.FromDomainBusinessRule(rule)).Error
+}
+
+// GetByID retrieves a business rule by ID
+func (r *BusinessRuleRepositoryImpl) GetByID(ctx context.Context, id string) (*entity.BusinessRule, error) {
+	var ruleModel model.BusinessRule
+
+	err := r.db.WithContext(ctx).
+		Where("rule_id = ?", id).
+		First(&ruleModel).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.ErrBusinessRuleNotFound
+		}
+		return nil, err
+	}
+
+	return ruleModel.ToDomainBusinessRule(), nil
+}
+
+// Update updates an existing business rule
+func (r *BusinessRuleRepositoryImpl) Update(ctx context.Context, rule *entity.BusinessRule) error {
+	var existingModel model.BusinessRule
+
+	err := r.db.WithContext(ctx).
+		Where("rule_id = ?", rule.ID).
+		First(&existingModel).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errs.ErrBusinessRuleNotFound
+		}
+		return err
+	}
+
+	existingModel.UpdateFromDomain(rule)
+	return r.db.WithContext(ctx).Save(&existingModel).Error
+}
+
+// Delete removes a business rule
+func (r *BusinessRuleRepositoryImpl) Delete(ctx context.Context, id string) error {
+	result := r.db.WithContext(ctx).
+		Unscoped().
+		Where("rule_id = ?", id).
+		Delete(&model.BusinessRule{})
+
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return errs.ErrBusinessRuleNotFound
+	}
+
+	return nil
+}
+
+// List retrieves all business rules in evaluation order
+func (r *BusinessRuleRepositoryImpl) List(ctx context.Context) ([]*entity.BusinessRule, error) {
+	return r.list(r.db.WithContext(ctx))
+}
+
+// ListEnabled retrieves the enabled business rules in evaluation order
+func (r *BusinessRuleRepositoryImpl) ListEnabled(ctx context.Context) ([]*entity.BusinessRule, error) {
+	return r.list(r.db.WithContext(ctx).Where("enabled = ?", true))
+}
+
+func (r *BusinessRuleRepositoryImpl) list(query *gorm.DB) ([]*entity.BusinessRule, error) {
+	var ruleModels []model.BusinessRule
+
+	err := query.
+		Order("priority ASC").
+		Order("id ASC").
+		Find(&ruleModels).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	rules := make([]*entity.BusinessRule, len(ruleModels))
+	for i, ruleModel := range ruleModels {
+		rules[i] = ruleModel.ToDomainBusinessRule()
+	}
+
+	return rules, nil
+}
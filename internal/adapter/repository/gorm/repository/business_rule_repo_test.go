@@ -0,0 +1,54 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hydr0g3nz/mini_bank/internal/adapter/repository/gorm/model"
+	"github.com/hydr0g3nz/mini_bank/internal/adapter/repository/gorm/repository"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBusinessRuleRepository(t *testing.T) {
+	db := setupTestDB(t)
+	require.NoError(t, db.AutoMigrate(&model.BusinessRule{}))
+
+	repo := repository.NewBusinessRuleRepository(db)
+	ctx := context.Background()
+
+	fee, err := entity.NewBusinessRule("ATM fee", entity.BusinessRuleKindFee, `channel == "ATM" ? 20.0 : 0.0`, "", 5)
+	require.NoError(t, err)
+	limit, err := entity.NewBusinessRule("Night limit", entity.BusinessRuleKindValidation, `amount > 50000`, "Amount too large", 1)
+	require.NoError(t, err)
+	require.NoError(t, repo.Create(ctx, fee))
+	require.NoError(t, repo.Create(ctx, limit))
+
+	// Rules are listed in evaluation order
+	rules, err := repo.List(ctx)
+	require.NoError(t, err)
+	require.Len(t, rules, 2)
+	assert.Equal(t, limit.ID, rules[0].ID)
+	assert.Equal(t, entity.BusinessRuleKindValidation, rules[0].Kind)
+	assert.True(t, rules[0].Enabled)
+
+	limit.SetEnabled(false)
+	require.NoError(t, repo.Update(ctx, limit))
+
+	enabled, err := repo.ListEnabled(ctx)
+	require.NoError(t, err)
+	require.Len(t, enabled, 1)
+	assert.Equal(t, fee.ID, enabled[0].ID)
+
+	found, err := repo.GetByID(ctx, limit.ID)
+	require.NoError(t, err)
+	assert.False(t, found.Enabled)
+	assert.Equal(t, "Amount too large", found.Message)
+
+	require.NoError(t, repo.Delete(ctx, limit.ID))
+	assert.ErrorIs(t, repo.Delete(ctx, limit.ID), errs.ErrBusinessRuleNotFound)
+	_, err = repo.GetByID(ctx, limit.ID)
+	assert.ErrorIs(t, err, errs.ErrBusinessRuleNotFound)
+}
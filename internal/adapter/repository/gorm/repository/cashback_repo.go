@@ -0,0 +1,165 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/adapter/repository/gorm/model"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/repository"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type CashbackRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewCashbackRepository creates a new instance of CashbackRepositoryImpl
+func NewCashbackRepository(db *gorm.DB) repository.CashbackRepository {
+	return &CashbackRepositoryImpl{db: db}
+}
+
+// CreateCampaign creates a new cashback campaign
+func (r *CashbackRepositoryImpl) CreateCampaign(ctx context.Context, campaign *entity.CashbackCampaign) error {
+	return r.db.WithContext(ctx).Create(model.FromDomainCashbackCampaign(campaign)).Error
+}
+
+// GetCampaign retrieves a cashback campaign by ID
+func (r *CashbackRepositoryImpl) GetCampaign(ctx context.Context, id string) (*entity.CashbackCampaign, error) {
+	var campaignModel model.CashbackCampaign
+
+	err := r.db.WithContext(ctx).
+		Where("campaign_id = ?", id).
+		First(&campaignModel).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.ErrCashbackCampaignNotFound
+		}
+		return nil, err
+	}
+
+	return campaignModel.ToDomainCashbackCampaign(), nil
+}
+
+// UpdateCampaign updates an existing cashback campaign
+func (r *CashbackRepositoryImpl) UpdateCampaign(ctx context.Context, campaign *entity.CashbackCampaign) error {
+	var existingModel model.CashbackCampaign
+
+	err := r.db.WithContext(ctx).
+		Where("campaign_id = ?", campaign.ID).
+		First(&existingModel).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errs.ErrCashbackCampaignNotFound
+		}
+		return err
+	}
+
+	existingModel.UpdateFromDomain(campaign)
+	return r.db.WithContext(ctx).Save(&existingModel).Error
+}
+
+// ListCampaigns retrieves all cashback campaigns, newest first
+func (r *CashbackRepositoryImpl) ListCampaigns(ctx context.Context) ([]*entity.CashbackCampaign, error) {
+	return r.listCampaigns(r.db.WithContext(ctx))
+}
+
+// ListRunningCampaigns retrieves the active campaigns whose date range covers the time
+func (r *CashbackRepositoryImpl) ListRunningCampaigns(ctx context.Context, at time.Time) ([]*entity.CashbackCampaign, error) {
+	return r.listCampaigns(r.db.WithContext(ctx).
+		Where("active = ? AND starts_at <= ? AND ends_at > ?", true, at, at))
+}
+
+func (r *CashbackRepositoryImpl) listCampaigns(query *gorm.DB) ([]*entity.CashbackCampaign, error) {
+	var campaignModels []model.CashbackCampaign
+
+	if err := query.Order("id DESC").Find(&campaignModels).Error; err != nil {
+		return nil, err
+	}
+
+	campaigns := make([]*entity.CashbackCampaign, len(campaignModels))
+	for i, campaignModel := range campaignModels {
+		campaigns[i] = campaignModel.ToDomainCashbackCampaign()
+	}
+
+	return campaigns, nil
+}
+
+// CreateReward records a cashback reward unless the campaign already rewarded the payment
+func (r *CashbackRepositoryImpl) CreateReward(ctx context.Context, reward *entity.CashbackReward) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "campaign_id"}, {Name: "transaction_id"}},
+			DoNothing: true,
+		}).
+		Create(model.FromDomainCashbackReward(reward))
+
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	return result.RowsAffected == 1, nil
+}
+
+// ListRewardsByAccountID retrieves the cashback rewards of an account, newest first
+func (r *CashbackRepositoryImpl) ListRewardsByAccountID(ctx context.Context, accountID vo.AccountID, limit, offset int) ([]*entity.CashbackReward, error) {
+	var rewardModels []model.CashbackReward
+
+	err := r.db.WithContext(ctx).
+		Where("account_id = ?", accountID.String()).
+		Order("id DESC").
+		Limit(limit).
+		Offset(offset).
+		Find(&rewardModels).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	rewards := make([]*entity.CashbackReward, len(rewardModels))
+	for i, rewardModel := range rewardModels {
+		reward, err := rewardModel.ToDomainCashbackReward()
+		if err != nil {
+			return nil, err
+		}
+		rewards[i] = reward
+	}
+
+	return rewards, nil
+}
+
+// CountRewardsByAccountID counts the cashback rewards of an account
+func (r *CashbackRepositoryImpl) CountRewardsByAccountID(ctx context.Context, accountID vo.AccountID) (int64, error) {
+	var count int64
+
+	err := r.db.WithContext(ctx).
+		Model(&model.CashbackReward{}).
+		Where("account_id = ?", accountID.String()).
+		Count(&count).Error
+
+	return count, err
+}
+
+// SumRewardsByAccountID totals the cashback rewards of an account
+func (r *CashbackRepositoryImpl) SumRewardsByAccountID(ctx context.Context, accountID vo.AccountID) (vo.Money, error) {
+	var total decimal.NullDecimal
+
+	err := r.db.WithContext(ctx).
+		Model(&model.CashbackReward{}).
+		Select("SUM(amount)").
+		Where("account_id = ?", accountID.String()).
+		Scan(&total).Error
+
+	if err != nil {
+		return vo.Money{}, err
+	}
+
+	return vo.NewMoney(total.Decimal), nil
+}
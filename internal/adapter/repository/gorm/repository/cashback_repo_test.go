@@ -0,0 +1,95 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/adapter/repository/gorm/model"
+	"github.com/hydr0g3nz/mini_bank/internal/adapter/repository/gorm/repository"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCashbackRepository(t *testing.T) {
+	db := setupTestDB(t)
+	require.NoError(t, db.AutoMigrate(&model.CashbackCampaign{}, &model.CashbackReward{}))
+
+	repo := repository.NewCashbackRepository(db)
+	ctx := context.Background()
+	now := time.Now()
+
+	running, err := entity.NewCashbackCampaign("Summer", decimal.NewFromInt(5), vo.NewMoneyFromFloat(10),
+		[]vo.TransactionType{vo.TransactionTypeDebit, vo.TransactionTypeTransfer}, now.Add(-time.Hour), now.Add(time.Hour))
+	require.NoError(t, err)
+	upcoming, err := entity.NewCashbackCampaign("Winter", decimal.NewFromInt(2), vo.NewMoneyFromFloat(5),
+		[]vo.TransactionType{vo.TransactionTypeDebit}, now.Add(time.Hour), now.Add(2*time.Hour))
+	require.NoError(t, err)
+	paused, err := entity.NewCashbackCampaign("Paused", decimal.NewFromInt(2), vo.NewMoneyFromFloat(5),
+		[]vo.TransactionType{vo.TransactionTypeDebit}, now.Add(-time.Hour), now.Add(time.Hour))
+	require.NoError(t, err)
+	paused.SetActive(false)
+
+	for _, campaign := range []*entity.CashbackCampaign{running, upcoming, paused} {
+		require.NoError(t, repo.CreateCampaign(ctx, campaign))
+	}
+
+	found, err := repo.GetCampaign(ctx, running.ID)
+	require.NoError(t, err)
+	assert.True(t, found.Percentage.Equal(decimal.NewFromInt(5)))
+	assert.Equal(t, running.TransactionTypes, found.TransactionTypes)
+
+	campaigns, err := repo.ListRunningCampaigns(ctx, now)
+	require.NoError(t, err)
+	require.Len(t, campaigns, 1)
+	assert.Equal(t, running.ID, campaigns[0].ID)
+
+	all, err := repo.ListCampaigns(ctx)
+	require.NoError(t, err)
+	assert.Len(t, all, 3)
+
+	_, err = repo.GetCampaign(ctx, "CBC0")
+	assert.ErrorIs(t, err, errs.ErrCashbackCampaignNotFound)
+
+	// A payment earns from each campaign once
+	accountID := vo.NewAccountID()
+	paymentID := vo.NewTransactionID()
+	reward := func(amount float64) *entity.CashbackReward {
+		credit, err := entity.NewCreditTransaction(accountID, vo.NewMoneyFromFloat(amount), "Cashback: Summer", running.Reference(), time.Now())
+		require.NoError(t, err)
+		return entity.NewCashbackReward(running, paymentID, credit)
+	}
+
+	recorded, err := repo.CreateReward(ctx, reward(5))
+	require.NoError(t, err)
+	assert.True(t, recorded)
+
+	recorded, err = repo.CreateReward(ctx, reward(5))
+	require.NoError(t, err)
+	assert.False(t, recorded)
+
+	paymentID = vo.NewTransactionID()
+	recorded, err = repo.CreateReward(ctx, reward(2.5))
+	require.NoError(t, err)
+	assert.True(t, recorded)
+
+	rewards, err := repo.ListRewardsByAccountID(ctx, accountID, 10, 0)
+	require.NoError(t, err)
+	assert.Len(t, rewards, 2)
+
+	count, err := repo.CountRewardsByAccountID(ctx, accountID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), count)
+
+	total, err := repo.SumRewardsByAccountID(ctx, accountID)
+	require.NoError(t, err)
+	assert.True(t, total.Equal(vo.NewMoneyFromFloat(7.5)), "got %s", total)
+
+	total, err = repo.SumRewardsByAccountID(ctx, vo.NewAccountID())
+	require.NoError(t, err)
+	assert.True(t, total.IsZero())
+}
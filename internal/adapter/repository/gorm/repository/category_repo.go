@@ -0,0 +1,214 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hydr0g3nz/mini_bank/internal/adapter/repository/gorm/model"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/repository"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type CategoryRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewCategoryRepository creates a new instance of CategoryRepositoryImpl
+func NewCategoryRepository(db *gorm.DB) repository.CategoryRepository {
+	return &CategoryRepositoryImpl{db: db}
+}
+
+// Create creates a new category
+func (r *CategoryRepositoryImpl) Create(ctx context.Context, category *entity.Category) error {
+	if _, err := r.GetByCode(ctx, category.Code); err == nil {
+		return errs.ErrCategoryAlreadyExists
+	}
+
+	if err := r.db.WithContext(ctx).Create(model.FromDomainCategory(category)).Error; err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return errs.ErrCategoryAlreadyExists
+		}
+		return err
+	}
+
+	return nil
+}
+
+// GetByCode retrieves a category by code
+func (r *CategoryRepositoryImpl) GetByCode(ctx context.Context, code string) (*entity.Category, error) {
+	var categoryModel model.Category
+
+	err := r.db.WithContext(ctx).
+		Where("code = ?", code).
+		First(&categoryModel).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.ErrCategoryNotFound
+		}
+		return nil, err
+	}
+
+	return categoryModel.ToDomainCategory(), nil
+}
+
+// Update updates an existing category
+func (r *CategoryRepositoryImpl) Update(ctx context.Context, category *entity.Category) error {
+	var existingModel model.Category
+
+	err := r.db.WithContext(ctx).
+		Where("code = ?", category.Code).
+		First(&existingModel).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errs.ErrCategoryNotFound
+		}
+		return err
+	}
+
+	existingModel.UpdateFromDomain(category)
+	return r.db.WithContext(ctx).Save(&existingModel).Error
+}
+
+// Delete removes a category
+func (r *CategoryRepositoryImpl) Delete(ctx context.Context, code string) error {
+	// Hard delete so the code can be reused
+	result := r.db.WithContext(ctx).
+		Unscoped().
+		Where("code = ?", code).
+		Delete(&model.Category{})
+
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return errs.ErrCategoryNotFound
+	}
+
+	return nil
+}
+
+// List retrieves all categories ordered by code
+func (r *CategoryRepositoryImpl) List(ctx context.Context) ([]*entity.Category, error) {
+	var categoryModels []model.Category
+
+	if err := r.db.WithContext(ctx).Order("code ASC").Find(&categoryModels).Error; err != nil {
+		return nil, err
+	}
+
+	categories := make([]*entity.Category, len(categoryModels))
+	for i, categoryModel := range categoryModels {
+		categories[i] = categoryModel.ToDomainCategory()
+	}
+
+	return categories, nil
+}
+
+// CreateRule creates a new categorization rule
+func (r *CategoryRepositoryImpl) CreateRule(ctx context.Context, rule *entity.CategoryRule) error {
+	return r.db.WithContext(ctx).Create(model.FromDomainCategoryRule(rule)).Error
+}
+
+// DeleteRule removes a categorization rule
+func (r *CategoryRepositoryImpl) DeleteRule(ctx context.Context, id string) error {
+	result := r.db.WithContext(ctx).
+		Unscoped().
+		Where("rule_id = ?", id).
+		Delete(&model.CategoryRule{})
+
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return errs.ErrCategoryRuleNotFound
+	}
+
+	return nil
+}
+
+// ListRules retrieves all categorization rules in evaluation order
+func (r *CategoryRepositoryImpl) ListRules(ctx context.Context) ([]*entity.CategoryRule, error) {
+	var ruleModels []model.CategoryRule
+
+	err := r.db.WithContext(ctx).
+		Order("priority ASC").
+		Order("id ASC").
+		Find(&ruleModels).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	rules := make([]*entity.CategoryRule, len(ruleModels))
+	for i, ruleModel := range ruleModels {
+		rules[i] = ruleModel.ToDomainCategoryRule()
+	}
+
+	return rules, nil
+}
+
+type CategoryOverrideRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewCategoryOverrideRepository creates a new instance of CategoryOverrideRepositoryImpl
+func NewCategoryOverrideRepository(db *gorm.DB) repository.CategoryOverrideRepository {
+	return &CategoryOverrideRepositoryImpl{db: db}
+}
+
+// Save creates or replaces the override of a transaction for an account
+func (r *CategoryOverrideRepositoryImpl) Save(ctx context.Context, override *entity.CategoryOverride) error {
+	return r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "account_id"}, {Name: "transaction_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"category_code", "updated_at"}),
+		}).
+		Create(model.FromDomainCategoryOverride(override)).Error
+}
+
+// Delete removes the override of a transaction for an account; removing a missing override is a no-op
+func (r *CategoryOverrideRepositoryImpl) Delete(ctx context.Context, accountID vo.AccountID, transactionID vo.TransactionID) error {
+	return r.db.WithContext(ctx).
+		Unscoped().
+		Where("account_id = ? AND transaction_id = ?", accountID.String(), transactionID.String()).
+		Delete(&model.CategoryOverride{}).Error
+}
+
+// ListByTransactionIDs retrieves an account's overrides for the given transactions
+func (r *CategoryOverrideRepositoryImpl) ListByTransactionIDs(ctx context.Context, accountID vo.AccountID, transactionIDs []vo.TransactionID) ([]*entity.CategoryOverride, error) {
+	if len(transactionIDs) == 0 {
+		return []*entity.CategoryOverride{}, nil
+	}
+
+	ids := make([]string, len(transactionIDs))
+	for i, transactionID := range transactionIDs {
+		ids[i] = transactionID.String()
+	}
+
+	var overrideModels []model.CategoryOverride
+	err := r.db.WithContext(ctx).
+		Where("account_id = ? AND transaction_id IN ?", accountID.String(), ids).
+		Find(&overrideModels).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	overrides := make([]*entity.CategoryOverride, len(overrideModels))
+	for i, overrideModel := range overrideModels {
+		override, err := overrideModel.ToDomainCategoryOverride()
+		if err != nil {
+			return nil, err
+		}
+		overrides[i] = override
+	}
+
+	return overrides, nil
+}
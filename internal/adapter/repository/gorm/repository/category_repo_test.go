@@ -0,0 +1,98 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hydr0g3nz/mini_bank/internal/adapter/repository/gorm/model"
+	"github.com/hydr0g3nz/mini_bank/internal/adapter/repository/gorm/repository"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCategoryRepository(t *testing.T) {
+	db := setupTestDB(t)
+	require.NoError(t, db.AutoMigrate(&model.Category{}, &model.CategoryRule{}))
+
+	repo := repository.NewCategoryRepository(db)
+	ctx := context.Background()
+
+	shopping, err := entity.NewCategory("SHOPPING", "Shopping", "")
+	require.NoError(t, err)
+	require.NoError(t, repo.Create(ctx, shopping))
+
+	groceries, err := entity.NewCategory("GROCERIES", "Groceries", "SHOPPING")
+	require.NoError(t, err)
+	require.NoError(t, repo.Create(ctx, groceries))
+	assert.ErrorIs(t, repo.Create(ctx, groceries), errs.ErrCategoryAlreadyExists)
+
+	require.NoError(t, groceries.Update("Food & Groceries", ""))
+	require.NoError(t, repo.Update(ctx, groceries))
+
+	found, err := repo.GetByCode(ctx, "GROCERIES")
+	require.NoError(t, err)
+	assert.Equal(t, "Food & Groceries", found.Name)
+	assert.Empty(t, found.ParentCode)
+
+	categories, err := repo.List(ctx)
+	require.NoError(t, err)
+	require.Len(t, categories, 2)
+	assert.Equal(t, "GROCERIES", categories[0].Code)
+
+	// Rules are listed in evaluation order
+	late, err := entity.NewCategoryRule("SHOPPING", entity.CategoryRuleFieldMerchant, "mall", 5)
+	require.NoError(t, err)
+	early, err := entity.NewCategoryRule("GROCERIES", entity.CategoryRuleFieldDescription, "market", 1)
+	require.NoError(t, err)
+	require.NoError(t, repo.CreateRule(ctx, late))
+	require.NoError(t, repo.CreateRule(ctx, early))
+
+	rules, err := repo.ListRules(ctx)
+	require.NoError(t, err)
+	require.Len(t, rules, 2)
+	assert.Equal(t, early.ID, rules[0].ID)
+	assert.Equal(t, entity.CategoryRuleFieldDescription, rules[0].Field)
+
+	require.NoError(t, repo.DeleteRule(ctx, early.ID))
+	assert.ErrorIs(t, repo.DeleteRule(ctx, early.ID), errs.ErrCategoryRuleNotFound)
+
+	// Deleted codes can be reused
+	require.NoError(t, repo.Delete(ctx, "GROCERIES"))
+	_, err = repo.GetByCode(ctx, "GROCERIES")
+	assert.ErrorIs(t, err, errs.ErrCategoryNotFound)
+	assert.ErrorIs(t, repo.Delete(ctx, "GROCERIES"), errs.ErrCategoryNotFound)
+	require.NoError(t, repo.Create(ctx, groceries))
+}
+
+func TestCategoryOverrideRepository(t *testing.T) {
+	db := setupTestDB(t)
+	require.NoError(t, db.AutoMigrate(&model.CategoryOverride{}))
+
+	repo := repository.NewCategoryOverrideRepository(db)
+	ctx := context.Background()
+
+	accountID := vo.NewAccountID()
+	first := vo.NewTransactionID()
+	second := vo.NewTransactionID()
+
+	require.NoError(t, repo.Save(ctx, entity.NewCategoryOverride(accountID, first, "DINING")))
+	require.NoError(t, repo.Save(ctx, entity.NewCategoryOverride(vo.NewAccountID(), second, "RENT")))
+
+	// Saving again replaces the account's override
+	require.NoError(t, repo.Save(ctx, entity.NewCategoryOverride(accountID, first, "GROCERIES")))
+
+	overrides, err := repo.ListByTransactionIDs(ctx, accountID, []vo.TransactionID{first, second})
+	require.NoError(t, err)
+	require.Len(t, overrides, 1)
+	assert.Equal(t, "GROCERIES", overrides[0].CategoryCode)
+
+	require.NoError(t, repo.Delete(ctx, accountID, first))
+	require.NoError(t, repo.Delete(ctx, accountID, first))
+
+	overrides, err = repo.ListByTransactionIDs(ctx, accountID, []vo.TransactionID{first})
+	require.NoError(t, err)
+	assert.Empty(t, overrides)
+}
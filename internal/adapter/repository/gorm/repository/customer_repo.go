@@ -0,0 +1,124 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/hydr0g3nz/mini_bank/internal/adapter/repository/gorm/model"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/repository"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+)
+
+type CustomerRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewCustomerRepository creates a new instance of CustomerRepositoryImpl
+func NewCustomerRepository(db *gorm.DB) repository.CustomerRepository {
+	return &CustomerRepositoryImpl{db: db}
+}
+
+// GetSummary aggregates a customer's accounts in a fixed number of queries,
+// however many accounts the customer holds
+func (r *CustomerRepositoryImpl) GetSummary(ctx context.Context, customerID string, limit int) (*entity.CustomerSummary, error) {
+	var totals []struct {
+		Currency     string
+		AccountCount int64
+		TotalBalance decimal.NullDecimal
+	}
+
+	err := r.db.WithContext(ctx).
+		Model(&model.Account{}).
+		Select("currency, COUNT(*) AS account_count, SUM(balance) AS total_balance").
+		Where("customer_id = ?", customerID).
+		Group("currency").
+		Order("currency").
+		Scan(&totals).Error
+	if err != nil {
+		return nil, err
+	}
+	if len(totals) == 0 {
+		return nil, errs.ErrCustomerNotFound
+	}
+
+	accountCount := 0
+	balances := make([]entity.CustomerBalance, len(totals))
+	for i, total := range totals {
+		accountCount += int(total.AccountCount)
+		balances[i] = entity.CustomerBalance{
+			Total:        vo.NewMoneyIn(total.TotalBalance.Decimal, vo.Currency(total.Currency)),
+			AccountCount: int(total.AccountCount),
+		}
+	}
+
+	recent, err := r.customerTransactions(ctx, customerID, limit, "created_at DESC", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	pending := vo.TransactionStatusPending
+	pendingTransactions, err := r.customerTransactions(ctx, customerID, limit, "created_at ASC", &pending)
+	if err != nil {
+		return nil, err
+	}
+
+	return &entity.CustomerSummary{
+		CustomerID:          customerID,
+		AccountCount:        accountCount,
+		Balances:            balances,
+		RecentTransactions:  recent,
+		PendingTransactions: pendingTransactions,
+	}, nil
+}
+
+// Exists checks if the customer holds any account
+func (r *CustomerRepositoryImpl) Exists(ctx context.Context, customerID string) (bool, error) {
+	var count int64
+	err := r.db.WithContext(ctx).
+		Model(&model.Account{}).
+		Where("customer_id = ?", customerID).
+		Count(&count).Error
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// customerTransactions retrieves the transactions touching any of a customer's
+// accounts in one query, resolving the accounts with a subquery
+func (r *CustomerRepositoryImpl) customerTransactions(ctx context.Context, customerID string, limit int, order string, status *vo.TransactionStatus) ([]*entity.Transaction, error) {
+	var transactionModels []model.Transaction
+
+	accountIDs := r.db.WithContext(ctx).
+		Model(&model.Account{}).
+		Select("account_id").
+		Where("customer_id = ?", customerID)
+
+	query := r.db.WithContext(ctx).
+		Where("from_account_id IN (?) OR to_account_id IN (?)", accountIDs, accountIDs)
+	if status != nil {
+		query = query.Where("status = ?", string(*status))
+	}
+
+	err := query.
+		Order(order).
+		Limit(limit).
+		Find(&transactionModels).Error
+	if err != nil {
+		return nil, err
+	}
+
+	transactions := make([]*entity.Transaction, len(transactionModels))
+	for i, transactionModel := range transactionModels {
+		domainTransaction, err := transactionModel.ToDomainTransaction()
+		if err != nil {
+			return nil, err
+		}
+		transactions[i] = domainTransaction
+	}
+
+	return transactions, nil
+}
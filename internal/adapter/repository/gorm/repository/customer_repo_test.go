@@ -0,0 +1,110 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/adapter/repository/gorm/model"
+	"github.com/hydr0g3nz/mini_bank/internal/adapter/repository/gorm/repository"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCustomerRepository_GetSummary(t *testing.T) {
+	db := setupTestDB(t)
+	require.NoError(t, db.AutoMigrate(&model.Transaction{}, &model.AccountSequence{}))
+
+	accountRepo := repository.NewAccountRepository(db)
+	transactionRepo := repository.NewTransactionRepository(db)
+	repo := repository.NewCustomerRepository(db)
+	ctx := context.Background()
+
+	newAccount := func(customerID string, balance float64, currency vo.Currency) *entity.Account {
+		account, err := entity.NewAccount("Account", vo.NewMoneyFromFloat(balance).In(currency))
+		require.NoError(t, err)
+		if customerID != "" {
+			require.NoError(t, account.AssignCustomer(customerID))
+		}
+		require.NoError(t, accountRepo.Create(ctx, account))
+		return account
+	}
+
+	savings := newAccount("CUST001", 1000, "THB")
+	checking := newAccount("CUST001", 250.50, "THB")
+	newAccount("CUST001", 80, "USD")
+	other := newAccount("CUST002", 5000, "THB")
+
+	base := time.Now().Add(-time.Hour)
+	save := func(txn *entity.Transaction, offset time.Duration, completed bool) *entity.Transaction {
+		txn.CreatedAt = base.Add(offset)
+		if completed {
+			require.NoError(t, txn.MarkAsCompleted(time.Now()), time.Now(), time.Now())
+		}
+		require.NoError(t, transactionRepo.Create(ctx, txn))
+		return txn
+	}
+
+	deposit, err := entity.NewCreditTransaction(savings.ID, vo.NewMoneyFromFloat(100), "Deposit", "", time.Now())
+	require.NoError(t, err)
+	save(deposit, time.Minute, true)
+
+	transfer, err := entity.NewTransferTransaction(savings.ID, checking.ID, vo.NewMoneyFromFloat(50), "Move", "", time.Now())
+	require.NoError(t, err)
+	save(transfer, 2*time.Minute, false)
+
+	incoming, err := entity.NewTransferTransaction(other.ID, checking.ID, vo.NewMoneyFromFloat(20), "Gift", "", time.Now())
+	require.NoError(t, err)
+	save(incoming, 3*time.Minute, false)
+
+	unrelated, err := entity.NewDebitTransaction(other.ID, vo.NewMoneyFromFloat(10), "Coffee", "", time.Now())
+	require.NoError(t, err)
+	save(unrelated, 4*time.Minute, false)
+
+	summary, err := repo.GetSummary(ctx, "CUST001", 10)
+	require.NoError(t, err)
+
+	assert.Equal(t, "CUST001", summary.CustomerID)
+	assert.Equal(t, 3, summary.AccountCount)
+
+	// Balances are summed per currency
+	require.Len(t, summary.Balances, 2)
+	assert.Equal(t, vo.Currency("THB"), summary.Balances[0].Total.Currency())
+	assert.Equal(t, "1250.5", summary.Balances[0].Total.Amount().String())
+	assert.Equal(t, 2, summary.Balances[0].AccountCount)
+	assert.Equal(t, vo.Currency("USD"), summary.Balances[1].Total.Currency())
+	assert.Equal(t, "80", summary.Balances[1].Total.Amount().String())
+	assert.Equal(t, 1, summary.Balances[1].AccountCount)
+
+	// A transfer between the customer's own accounts is listed once
+	require.Len(t, summary.RecentTransactions, 3)
+	assert.Equal(t, incoming.ID, summary.RecentTransactions[0].ID)
+	assert.Equal(t, transfer.ID, summary.RecentTransactions[1].ID)
+	assert.Equal(t, deposit.ID, summary.RecentTransactions[2].ID)
+
+	require.Len(t, summary.PendingTransactions, 2)
+	assert.Equal(t, transfer.ID, summary.PendingTransactions[0].ID)
+	assert.Equal(t, incoming.ID, summary.PendingTransactions[1].ID)
+
+	// The limit caps each list
+	summary, err = repo.GetSummary(ctx, "CUST001", 1)
+	require.NoError(t, err)
+	require.Len(t, summary.RecentTransactions, 1)
+	assert.Equal(t, incoming.ID, summary.RecentTransactions[0].ID)
+	require.Len(t, summary.PendingTransactions, 1)
+	assert.Equal(t, transfer.ID, summary.PendingTransactions[0].ID)
+
+	_, err = repo.GetSummary(ctx, "UNKNOWN", 10)
+	assert.ErrorIs(t, err, errs.ErrCustomerNotFound)
+
+	exists, err := repo.Exists(ctx, "CUST001")
+	require.NoError(t, err)
+	assert.True(t, exists)
+
+	exists, err = repo.Exists(ctx, "UNKNOWN")
+	require.NoError(t, err)
+	assert.False(t, exists)
+}
@@ -0,0 +1,115 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/adapter/repository/gorm/model"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/repository"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type DailyAggregateRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewDailyAggregateRepository creates a new instance of DailyAggregateRepositoryImpl
+func NewDailyAggregateRepository(db *gorm.DB) repository.DailyAggregateRepository {
+	return &DailyAggregateRepositoryImpl{db: db}
+}
+
+// Record adds a transaction's contribution to its account's day unless the transaction was already recorded
+func (r *DailyAggregateRepositoryImpl) Record(ctx context.Context, transactionID vo.TransactionID, contribution *entity.DailyAggregate) (bool, error) {
+	recorded := false
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		marker := &model.DailyAggregateTransaction{
+			TransactionID: transactionID.String(),
+			AccountID:     contribution.AccountID.String(),
+		}
+		result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(marker)
+		if result.Error != nil || result.RowsAffected == 0 {
+			return result.Error
+		}
+
+		err := tx.Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "account_id"}, {Name: "date"}},
+			DoUpdates: clause.Assignments(map[string]interface{}{
+				"inflow":     gorm.Expr("daily_aggregates.inflow + excluded.inflow"),
+				"outflow":    gorm.Expr("daily_aggregates.outflow + excluded.outflow"),
+				"count":      gorm.Expr("daily_aggregates.count + excluded.count"),
+				"updated_at": gorm.Expr("excluded.updated_at"),
+			}),
+		}).Create(model.FromDomainDailyAggregate(contribution)).Error
+		if err != nil {
+			return err
+		}
+
+		recorded = true
+		return nil
+	})
+	return recorded, err
+}
+
+// ReplaceByAccountID replaces an account's aggregates and the transactions recorded in them in one transaction
+func (r *DailyAggregateRepositoryImpl) ReplaceByAccountID(ctx context.Context, accountID vo.AccountID, aggregates []*entity.DailyAggregate, transactionIDs []vo.TransactionID) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("account_id = ?", accountID.String()).Delete(&model.DailyAggregate{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("account_id = ?", accountID.String()).Delete(&model.DailyAggregateTransaction{}).Error; err != nil {
+			return err
+		}
+
+		if len(aggregates) > 0 {
+			aggregateModels := make([]*model.DailyAggregate, len(aggregates))
+			for i, aggregate := range aggregates {
+				aggregateModels[i] = model.FromDomainDailyAggregate(aggregate)
+			}
+			if err := tx.CreateInBatches(aggregateModels, 100).Error; err != nil {
+				return err
+			}
+		}
+
+		if len(transactionIDs) > 0 {
+			markers := make([]*model.DailyAggregateTransaction, len(transactionIDs))
+			for i, transactionID := range transactionIDs {
+				markers[i] = &model.DailyAggregateTransaction{
+					TransactionID: transactionID.String(),
+					AccountID:     accountID.String(),
+				}
+			}
+			if err := tx.CreateInBatches(markers, 100).Error; err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// ListByAccountID retrieves an account's aggregates for the days from from to to inclusive, oldest first
+func (r *DailyAggregateRepositoryImpl) ListByAccountID(ctx context.Context, accountID vo.AccountID, from, to time.Time) ([]*entity.DailyAggregate, error) {
+	var aggregateModels []model.DailyAggregate
+
+	err := r.db.WithContext(ctx).
+		Where("account_id = ? AND date >= ? AND date <= ?", accountID.String(), from, to).
+		Order("date ASC").
+		Find(&aggregateModels).Error
+	if err != nil {
+		return nil, err
+	}
+
+	aggregates := make([]*entity.DailyAggregate, len(aggregateModels))
+	for i, aggregateModel := range aggregateModels {
+		aggregate, err := aggregateModel.ToDomainDailyAggregate()
+		if err != nil {
+			return nil, err
+		}
+		aggregates[i] = aggregate
+	}
+
+	return aggregates, nil
+}
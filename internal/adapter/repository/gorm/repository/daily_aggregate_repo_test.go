@@ -0,0 +1,65 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/adapter/repository/gorm/model"
+	"github.com/hydr0g3nz/mini_bank/internal/adapter/repository/gorm/repository"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDailyAggregateRepository(t *testing.T) {
+	db := setupTestDB(t)
+	require.NoError(t, db.AutoMigrate(&model.DailyAggregate{}, &model.DailyAggregateTransaction{}))
+
+	repo := repository.NewDailyAggregateRepository(db)
+	ctx := context.Background()
+	accountID := vo.NewAccountID()
+	day := time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC)
+
+	contribution := func(change float64, at time.Time) *entity.DailyAggregate {
+		aggregate := entity.NewDailyAggregate(accountID, at)
+		aggregate.Add(vo.NewMoneyFromFloat(change))
+		return aggregate
+	}
+
+	salary, coffee := vo.NewTransactionID(), vo.NewTransactionID()
+	recorded, err := repo.Record(ctx, salary, contribution(300, day.Add(9*time.Hour)))
+	require.NoError(t, err)
+	assert.True(t, recorded)
+	recorded, err = repo.Record(ctx, coffee, contribution(-50, day.Add(12*time.Hour)))
+	require.NoError(t, err)
+	assert.True(t, recorded)
+
+	// A completion delivered twice is counted once
+	recorded, err = repo.Record(ctx, coffee, contribution(-50, day.Add(12*time.Hour)))
+	require.NoError(t, err)
+	assert.False(t, recorded)
+
+	_, err = repo.Record(ctx, vo.NewTransactionID(), contribution(20, day.AddDate(0, 0, 1)))
+	require.NoError(t, err)
+
+	aggregates, err := repo.ListByAccountID(ctx, accountID, day, day)
+	require.NoError(t, err)
+	require.Len(t, aggregates, 1)
+	assert.Equal(t, day, aggregates[0].Date)
+	assert.Equal(t, "300", aggregates[0].Inflow.String())
+	assert.Equal(t, "50", aggregates[0].Outflow.String())
+	assert.Equal(t, 2, aggregates[0].Count)
+
+	// Replacing the aggregates also forgets which transactions were recorded
+	require.NoError(t, repo.ReplaceByAccountID(ctx, accountID, []*entity.DailyAggregate{contribution(300, day)}, []vo.TransactionID{salary}))
+	recorded, err = repo.Record(ctx, coffee, contribution(-50, day))
+	require.NoError(t, err)
+	assert.True(t, recorded)
+
+	aggregates, err = repo.ListByAccountID(ctx, accountID, day, day.AddDate(0, 0, 1))
+	require.NoError(t, err)
+	require.Len(t, aggregates, 1)
+	assert.Equal(t, "250", aggregates[0].Net().String())
+}
@@ -0,0 +1,95 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/adapter/repository/gorm/model"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/repository"
+	"gorm.io/gorm"
+)
+
+type ExportRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewExportRepository creates a new instance of ExportRepositoryImpl
+func NewExportRepository(db *gorm.DB) repository.ExportRepository {
+	return &ExportRepositoryImpl{db: db}
+}
+
+// Create records a new export
+func (r *ExportRepositoryImpl) Create(ctx context.Context, export *entity.Export) error {
+	return r.db.WithContext(ctx).Create(model.FromDomainExport(export)).Error
+}
+
+// GetByID retrieves an export by ID
+func (r *ExportRepositoryImpl) GetByID(ctx context.Context, id string) (*entity.Export, error) {
+	var exportModel model.Export
+
+	err := r.db.WithContext(ctx).
+		Where("export_id = ?", id).
+		First(&exportModel).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.ErrExportNotFound
+		}
+		return nil, err
+	}
+
+	return exportModel.ToDomainExport(), nil
+}
+
+// Update updates an existing export record
+func (r *ExportRepositoryImpl) Update(ctx context.Context, export *entity.Export) error {
+	var existingModel model.Export
+
+	err := r.db.WithContext(ctx).
+		Where("export_id = ?", export.ID).
+		First(&existingModel).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errs.ErrExportNotFound
+		}
+		return err
+	}
+
+	existingModel.UpdateFromDomain(export)
+
+	return r.db.WithContext(ctx).Save(&existingModel).Error
+}
+
+// ListExpired retrieves up to limit exports that expired before the given time, oldest first
+func (r *ExportRepositoryImpl) ListExpired(ctx context.Context, before time.Time, limit int) ([]*entity.Export, error) {
+	var exportModels []model.Export
+
+	err := r.db.WithContext(ctx).
+		Where("expires_at <= ?", before).
+		Order("expires_at ASC").
+		Limit(limit).
+		Find(&exportModels).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	exports := make([]*entity.Export, len(exportModels))
+	for i, exportModel := range exportModels {
+		exports[i] = exportModel.ToDomainExport()
+	}
+
+	return exports, nil
+}
+
+// Delete removes an export record
+func (r *ExportRepositoryImpl) Delete(ctx context.Context, id string) error {
+	return r.db.WithContext(ctx).
+		Unscoped().
+		Where("export_id = ?", id).
+		Delete(&model.Export{}).Error
+}
@@ -0,0 +1,73 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/adapter/repository/gorm/model"
+	"github.com/hydr0g3nz/mini_bank/internal/adapter/repository/gorm/repository"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExportRepository_CreateAndUpdate(t *testing.T) {
+	db := setupTestDB(t)
+	require.NoError(t, db.AutoMigrate(&model.Export{}))
+
+	repo := repository.NewExportRepository(db)
+	ctx := context.Background()
+
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	export := entity.NewExport(entity.ExportKindAudit, "audit.ndjson", "application/x-ndjson", "admin", time.Hour, time.Now())
+	export.EventType = "transaction.completed"
+	export.From = &from
+	require.NoError(t, repo.Create(ctx, export))
+
+	require.NoError(t, export.MarkAsCompleted("exports/audit.ndjson", 512))
+	require.NoError(t, repo.Update(ctx, export))
+
+	found, err := repo.GetByID(ctx, export.ID)
+	require.NoError(t, err)
+	assert.Equal(t, entity.ExportKindAudit, found.Kind)
+	assert.Equal(t, vo.ExportStatusCompleted, found.Status)
+	assert.Equal(t, "exports/audit.ndjson", found.ObjectKey)
+	assert.Equal(t, int64(512), found.SizeBytes)
+	assert.Equal(t, "transaction.completed", found.EventType)
+	require.NotNil(t, found.From)
+	assert.True(t, found.From.Equal(from))
+	assert.WithinDuration(t, export.ExpiresAt, found.ExpiresAt, time.Second)
+
+	_, err = repo.GetByID(ctx, "EXP20240101000000000000")
+	assert.ErrorIs(t, err, errs.ErrExportNotFound)
+	assert.ErrorIs(t, repo.Update(ctx, entity.NewExport(entity.ExportKindAudit, "a", "b", "admin", time.Hour, time.Now())), errs.ErrExportNotFound)
+}
+
+func TestExportRepository_ListExpiredAndDelete(t *testing.T) {
+	db := setupTestDB(t)
+	require.NoError(t, db.AutoMigrate(&model.Export{}))
+
+	repo := repository.NewExportRepository(db)
+	ctx := context.Background()
+
+	expired := entity.NewExport(entity.ExportKindAccountHistory, "history.csv", "text/csv", "admin", -time.Minute, time.Now())
+	current := entity.NewExport(entity.ExportKindAccountHistory, "history.csv", "text/csv", "admin", time.Hour, time.Now())
+	require.NoError(t, repo.Create(ctx, expired))
+	require.NoError(t, repo.Create(ctx, current))
+
+	found, err := repo.ListExpired(ctx, time.Now(), 10)
+	require.NoError(t, err)
+	require.Len(t, found, 1)
+	assert.Equal(t, expired.ID, found[0].ID)
+
+	require.NoError(t, repo.Delete(ctx, expired.ID))
+	_, err = repo.GetByID(ctx, expired.ID)
+	assert.ErrorIs(t, err, errs.ErrExportNotFound)
+
+	found, err = repo.ListExpired(ctx, time.Now(), 10)
+	require.NoError(t, err)
+	assert.Empty(t, found)
+}
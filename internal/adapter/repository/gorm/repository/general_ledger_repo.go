@@ -0,0 +1,149 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/hydr0g3nz/mini_bank/internal/adapter/repository/gorm/model"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/repository"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type GeneralLedgerRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewGeneralLedgerRepository creates a new instance of GeneralLedgerRepositoryImpl
+func NewGeneralLedgerRepository(db *gorm.DB) repository.GeneralLedgerRepository {
+	return &GeneralLedgerRepositoryImpl{db: db}
+}
+
+// Record saves a journal entry unless its transaction was already posted
+func (r *GeneralLedgerRepositoryImpl) Record(ctx context.Context, postings []*entity.GLPosting) (bool, error) {
+	if len(postings) == 0 {
+		return false, nil
+	}
+
+	recorded := false
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var count int64
+		err := tx.Model(&model.GLPosting{}).
+			Where("transaction_id = ?", postings[0].TransactionID.String()).
+			Count(&count).Error
+		if err != nil || count > 0 {
+			return err
+		}
+
+		postingModels := make([]*model.GLPosting, len(postings))
+		for i, posting := range postings {
+			postingModels[i] = model.FromDomainGLPosting(posting)
+		}
+		result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(postingModels)
+		if result.Error != nil {
+			return result.Error
+		}
+
+		recorded = result.RowsAffected > 0
+		return nil
+	})
+	return recorded, err
+}
+
+// ListByTransactionID retrieves the postings of a transaction by line
+func (r *GeneralLedgerRepositoryImpl) ListByTransactionID(ctx context.Context, transactionID vo.TransactionID) ([]*entity.GLPosting, error) {
+	var postingModels []model.GLPosting
+
+	err := r.db.WithContext(ctx).
+		Where("transaction_id = ?", transactionID.String()).
+		Order("line ASC").
+		Find(&postingModels).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	postings := make([]*entity.GLPosting, len(postingModels))
+	for i := range postingModels {
+		posting, err := postingModels[i].ToDomainGLPosting()
+		if err != nil {
+			return nil, err
+		}
+		postings[i] = posting
+	}
+	return postings, nil
+}
+
+// SumByPeriod totals the debits and credits of every GL account posted to in a period, by code
+func (r *GeneralLedgerRepositoryImpl) SumByPeriod(ctx context.Context, period string) ([]*entity.GLBalance, error) {
+	var rows []struct {
+		Account string
+		Debit   decimal.Decimal
+		Credit  decimal.Decimal
+	}
+
+	err := r.db.WithContext(ctx).
+		Model(&model.GLPosting{}).
+		Select("account, "+
+			"COALESCE(SUM(CASE WHEN side = ? THEN amount ELSE 0 END), 0) AS debit, "+
+			"COALESCE(SUM(CASE WHEN side = ? THEN amount ELSE 0 END), 0) AS credit",
+			string(entity.GLSideDebit), string(entity.GLSideCredit)).
+		Where("period = ?", period).
+		Group("account").
+		Order("account ASC").
+		Scan(&rows).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	balances := make([]*entity.GLBalance, len(rows))
+	for i, row := range rows {
+		balances[i] = &entity.GLBalance{
+			Account: row.Account,
+			Debit:   vo.NewMoney(row.Debit),
+			Credit:  vo.NewMoney(row.Credit),
+		}
+	}
+	return balances, nil
+}
+
+// SumByProduct totals the debits and credits of GL accounts per period and product
+func (r *GeneralLedgerRepositoryImpl) SumByProduct(ctx context.Context, accounts []string, from, to string) ([]*entity.GLProductTotal, error) {
+	var rows []struct {
+		Period    string
+		ProductID string
+		Account   string
+		Debit     decimal.Decimal
+		Credit    decimal.Decimal
+	}
+
+	err := r.db.WithContext(ctx).
+		Model(&model.GLPosting{}).
+		Select("period, product_id, account, "+
+			"COALESCE(SUM(CASE WHEN side = ? THEN amount ELSE 0 END), 0) AS debit, "+
+			"COALESCE(SUM(CASE WHEN side = ? THEN amount ELSE 0 END), 0) AS credit",
+			string(entity.GLSideDebit), string(entity.GLSideCredit)).
+		Where("account IN ? AND period BETWEEN ? AND ?", accounts, from, to).
+		Group("period, product_id, account").
+		Order("period ASC, product_id ASC, account ASC").
+		Scan(&rows).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	totals := make([]*entity.GLProductTotal, len(rows))
+	for i, row := range rows {
+		totals[i] = &entity.GLProductTotal{
+			Period:    row.Period,
+			ProductID: row.ProductID,
+			Account:   row.Account,
+			Debit:     vo.NewMoney(row.Debit),
+			Credit:    vo.NewMoney(row.Credit),
+		}
+	}
+	return totals, nil
+}
@@ -0,0 +1,100 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/adapter/repository/gorm/model"
+	"github.com/hydr0g3nz/mini_bank/internal/adapter/repository/gorm/repository"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGeneralLedgerRepository(t *testing.T) {
+	db := setupTestDB(t)
+	require.NoError(t, db.AutoMigrate(&model.GLPosting{}))
+
+	repo := repository.NewGeneralLedgerRepository(db)
+	ctx := context.Background()
+
+	post := func(transaction *entity.Transaction, valueDate time.Time) []*entity.GLPosting {
+		transaction.ValueDate = valueDate
+		require.NoError(t, transaction.MarkAsCompleted(time.Now()), time.Now(), time.Now())
+		postings, err := entity.NewGLPostings(transaction)
+		require.NoError(t, err)
+		return postings
+	}
+	march := time.Date(2024, 3, 10, 0, 0, 0, 0, time.UTC)
+
+	accountID := vo.NewAccountID()
+	salary, err := entity.NewCreditTransaction(accountID, vo.NewMoneyFromFloat(200), "Salary", "", time.Now())
+	require.NoError(t, err)
+	withdrawal, err := entity.NewDebitTransaction(accountID, vo.NewMoneyFromFloat(50), "ATM", "", time.Now())
+	require.NoError(t, err)
+	withdrawal.Fee = vo.NewMoneyFromFloat(5)
+	refund, err := entity.NewCreditTransaction(accountID, vo.NewMoneyFromFloat(70), "Refund", "", time.Now())
+	require.NoError(t, err)
+
+	deposit := post(salary, march)
+	withdrawalPostings := post(withdrawal, march)
+	for _, posting := range withdrawalPostings {
+		posting.ProductID = "PRD1"
+	}
+	april := post(refund, march.AddDate(0, 1, 0))
+
+	recorded, err := repo.Record(ctx, deposit)
+	require.NoError(t, err)
+	assert.True(t, recorded)
+	recorded, err = repo.Record(ctx, withdrawalPostings)
+	require.NoError(t, err)
+	assert.True(t, recorded)
+	recorded, err = repo.Record(ctx, april)
+	require.NoError(t, err)
+	assert.True(t, recorded)
+
+	// A transaction is posted once
+	recorded, err = repo.Record(ctx, deposit)
+	require.NoError(t, err)
+	assert.False(t, recorded)
+
+	postings, err := repo.ListByTransactionID(ctx, withdrawal.ID)
+	require.NoError(t, err)
+	require.Len(t, postings, 3)
+	assert.Equal(t, entity.GLCodeCustomerDeposits, postings[0].Account)
+	assert.Equal(t, "55", postings[0].Amount.String())
+	assert.Equal(t, "2024-03", postings[0].Period)
+	assert.Equal(t, "PRD1", postings[0].ProductID)
+	assert.Equal(t, accountID, *postings[0].CustomerAccountID)
+
+	balances, err := repo.SumByPeriod(ctx, "2024-03")
+	require.NoError(t, err)
+	totals := make(map[string][2]string)
+	for _, balance := range balances {
+		totals[balance.Account] = [2]string{balance.Debit.String(), balance.Credit.String()}
+	}
+	assert.Equal(t, map[string][2]string{
+		entity.GLCodeCash:             {"200", "50"},
+		entity.GLCodeCustomerDeposits: {"55", "200"},
+		entity.GLCodeFeeIncome:        {"0", "5"},
+	}, totals)
+
+	balances, err = repo.SumByPeriod(ctx, "2024-05")
+	require.NoError(t, err)
+	assert.Empty(t, balances)
+
+	productTotals, err := repo.SumByProduct(ctx, []string{entity.GLCodeFeeIncome, entity.GLCodeCash}, "2024-03", "2024-04")
+	require.NoError(t, err)
+	require.Len(t, productTotals, 4)
+	assert.Equal(t, "", productTotals[0].ProductID)
+	assert.Equal(t, entity.GLCodeCash, productTotals[0].Account)
+	assert.Equal(t, "200", productTotals[0].Debit.String())
+	assert.Equal(t, "PRD1", productTotals[1].ProductID)
+	assert.Equal(t, entity.GLCodeCash, productTotals[1].Account)
+	assert.Equal(t, "50", productTotals[1].Credit.String())
+	assert.Equal(t, entity.GLCodeFeeIncome, productTotals[2].Account)
+	assert.Equal(t, "5", productTotals[2].Credit.String())
+	assert.Equal(t, "2024-04", productTotals[3].Period)
+}
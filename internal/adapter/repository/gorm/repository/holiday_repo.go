@@ -0,0 +1,104 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/adapter/repository/gorm/model"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/repository"
+	"gorm.io/gorm"
+)
+
+type HolidayRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewHolidayRepository creates a new instance of HolidayRepositoryImpl
+func NewHolidayRepository(db *gorm.DB) repository.HolidayRepository {
+	return &HolidayRepositoryImpl{db: db}
+}
+
+// Create records a new holiday
+func (r *HolidayRepositoryImpl) Create(ctx context.Context, holiday *entity.Holiday) error {
+	if _, err := r.GetByDate(ctx, holiday.Region, holiday.Date); err == nil {
+		return errs.ErrHolidayAlreadyExists
+	}
+
+	holidayModel := model.FromDomainHoliday(holiday)
+	holidayModel.Date = storedDate(holiday.Date)
+
+	if err := r.db.WithContext(ctx).Create(holidayModel).Error; err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return errs.ErrHolidayAlreadyExists
+		}
+		return err
+	}
+
+	return nil
+}
+
+// GetByDate retrieves the holiday of a region on a date
+func (r *HolidayRepositoryImpl) GetByDate(ctx context.Context, region string, date time.Time) (*entity.Holiday, error) {
+	var holidayModel model.Holiday
+
+	err := r.db.WithContext(ctx).
+		Where("region = ? AND date = ?", region, storedDate(date)).
+		First(&holidayModel).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.ErrHolidayNotFound
+		}
+		return nil, err
+	}
+
+	return holidayModel.ToDomainHoliday(), nil
+}
+
+// Delete removes the holiday of a region on a date
+func (r *HolidayRepositoryImpl) Delete(ctx context.Context, region string, date time.Time) error {
+	result := r.db.WithContext(ctx).
+		Unscoped().
+		Where("region = ? AND date = ?", region, storedDate(date)).
+		Delete(&model.Holiday{})
+
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return errs.ErrHolidayNotFound
+	}
+
+	return nil
+}
+
+// ListByRegion retrieves the holidays of a region within a date range (inclusive), in date order
+func (r *HolidayRepositoryImpl) ListByRegion(ctx context.Context, region string, from, to time.Time) ([]*entity.Holiday, error) {
+	var holidayModels []model.Holiday
+
+	err := r.db.WithContext(ctx).
+		Where("region = ? AND date >= ? AND date <= ?", region, storedDate(from), storedDate(to)).
+		Order("date ASC").
+		Find(&holidayModels).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	holidays := make([]*entity.Holiday, len(holidayModels))
+	for i, holidayModel := range holidayModels {
+		holidays[i] = holidayModel.ToDomainHoliday()
+	}
+
+	return holidays, nil
+}
+
+// storedDate normalizes a calendar date to UTC midnight so lookups match regardless of time zone
+func storedDate(date time.Time) time.Time {
+	year, month, day := date.Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
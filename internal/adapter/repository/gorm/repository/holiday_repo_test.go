@@ -0,0 +1,59 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/adapter/repository/gorm/model"
+	"github.com/hydr0g3nz/mini_bank/internal/adapter/repository/gorm/repository"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHolidayRepository(t *testing.T) {
+	db := setupTestDB(t)
+	require.NoError(t, db.AutoMigrate(&model.Holiday{}))
+
+	repo := repository.NewHolidayRepository(db)
+	ctx := context.Background()
+
+	for _, date := range []time.Time{
+		time.Date(2025, 12, 31, 0, 0, 0, 0, time.UTC),
+		time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+	} {
+		holiday, err := entity.NewHoliday("TH", date, "Holiday")
+		require.NoError(t, err)
+		require.NoError(t, repo.Create(ctx, holiday))
+	}
+
+	// Another region does not see TH holidays
+	other, err := entity.NewHoliday("SG", time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), "New Year")
+	require.NoError(t, err)
+	require.NoError(t, repo.Create(ctx, other))
+
+	duplicate, err := entity.NewHoliday("TH", time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), "Duplicate")
+	require.NoError(t, err)
+	assert.ErrorIs(t, repo.Create(ctx, duplicate), errs.ErrHolidayAlreadyExists)
+
+	// Lookups match on the calendar date regardless of time zone
+	bangkok, err := time.LoadLocation("Asia/Bangkok")
+	require.NoError(t, err)
+	found, err := repo.GetByDate(ctx, "TH", time.Date(2025, 12, 31, 0, 0, 0, 0, bangkok))
+	require.NoError(t, err)
+	assert.Equal(t, "Holiday", found.Name)
+
+	holidays, err := repo.ListByRegion(ctx, "TH",
+		time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2025, 12, 31, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	require.Len(t, holidays, 2)
+	assert.Equal(t, "2025-01-01", holidays[0].Date.Format("2006-01-02"))
+
+	require.NoError(t, repo.Delete(ctx, "TH", time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)))
+	_, err = repo.GetByDate(ctx, "TH", time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	assert.ErrorIs(t, err, errs.ErrHolidayNotFound)
+	assert.ErrorIs(t, repo.Delete(ctx, "TH", time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)), errs.ErrHolidayNotFound)
+}
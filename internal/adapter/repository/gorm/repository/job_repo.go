@@ -0,0 +1,226 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/adapter/repository/gorm/model"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/repository"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// jobClaimAttempts bounds how many candidates a claim tries when other workers keep claiming them first
+const jobClaimAttempts = 3
+
+type JobRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewJobRepository creates a new instance of JobRepositoryImpl
+func NewJobRepository(db *gorm.DB) repository.JobRepository {
+	return &JobRepositoryImpl{db: db}
+}
+
+// Enqueue stores a new job. It returns false, storing nothing, when a job with the same unique key exists.
+func (r *JobRepositoryImpl) Enqueue(ctx context.Context, job *entity.Job) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(model.FromDomainJob(job))
+
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected == 1, nil
+}
+
+// ClaimNext claims the next job of the given types that is claimable at now for workerID, leased
+// until leaseUntil. It returns nil when no job is claimable.
+func (r *JobRepositoryImpl) ClaimNext(ctx context.Context, types []string, workerID string, now, leaseUntil time.Time) (*entity.Job, error) {
+	for attempt := 0; attempt < jobClaimAttempts; attempt++ {
+		var jobModel model.Job
+
+		err := r.db.WithContext(ctx).
+			Where("job_type IN ?", types).
+			Where("(status = ? AND run_at <= ?) OR (status = ? AND locked_until <= ?)",
+				vo.JobStatusQueued, now, vo.JobStatusRunning, now).
+			Order("run_at ASC").
+			First(&jobModel).Error
+
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		job := jobModel.ToDomainJob()
+		if err := job.Start(workerID, now, leaseUntil); err != nil {
+			return nil, err
+		}
+
+		// The status and attempts the job was read with fence the claim against a worker claiming it meanwhile
+		result := r.db.WithContext(ctx).
+			Model(&model.Job{}).
+			Where("id = ? AND status = ? AND attempts = ?", jobModel.ID, jobModel.Status, jobModel.Attempts).
+			Updates(jobOutcomeColumns(job))
+
+		if result.Error != nil {
+			return nil, result.Error
+		}
+		if result.RowsAffected == 1 {
+			return job, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// ExtendLease renews a worker's lease on a running job; ErrJobLeaseLost when the worker no longer holds it
+func (r *JobRepositoryImpl) ExtendLease(ctx context.Context, id, workerID string, leaseUntil time.Time) error {
+	result := r.db.WithContext(ctx).
+		Model(&model.Job{}).
+		Where("job_id = ? AND locked_by = ? AND status = ?", id, workerID, vo.JobStatusRunning).
+		Update("locked_until", leaseUntil)
+
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return errs.ErrJobLeaseLost
+	}
+	return nil
+}
+
+// Finish stores the outcome of an attempt by the worker in job.LockedBy; ErrJobLeaseLost when
+// another worker claimed the job since
+func (r *JobRepositoryImpl) Finish(ctx context.Context, job *entity.Job) error {
+	result := r.db.WithContext(ctx).
+		Model(&model.Job{}).
+		Where("job_id = ? AND locked_by = ? AND status = ?", job.ID, job.LockedBy, vo.JobStatusRunning).
+		Updates(jobOutcomeColumns(job))
+
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return errs.ErrJobLeaseLost
+	}
+	return nil
+}
+
+// Update updates an existing job that is not running
+func (r *JobRepositoryImpl) Update(ctx context.Context, job *entity.Job) error {
+	var existingModel model.Job
+
+	err := r.db.WithContext(ctx).
+		Where("job_id = ?", job.ID).
+		First(&existingModel).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errs.ErrJobNotFound
+		}
+		return err
+	}
+
+	existingModel.UpdateFromDomain(job)
+
+	return r.db.WithContext(ctx).Save(&existingModel).Error
+}
+
+// GetByID retrieves a job by ID
+func (r *JobRepositoryImpl) GetByID(ctx context.Context, id string) (*entity.Job, error) {
+	var jobModel model.Job
+
+	err := r.db.WithContext(ctx).
+		Where("job_id = ?", id).
+		First(&jobModel).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.ErrJobNotFound
+		}
+		return nil, err
+	}
+
+	return jobModel.ToDomainJob(), nil
+}
+
+// List retrieves matching jobs with pagination, newest first
+func (r *JobRepositoryImpl) List(ctx context.Context, filter repository.JobFilter, limit, offset int) ([]*entity.Job, error) {
+	var jobModels []model.Job
+
+	err := r.filtered(ctx, filter).
+		Order("created_at DESC").
+		Limit(limit).
+		Offset(offset).
+		Find(&jobModels).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	jobs := make([]*entity.Job, len(jobModels))
+	for i, jobModel := range jobModels {
+		jobs[i] = jobModel.ToDomainJob()
+	}
+
+	return jobs, nil
+}
+
+// Count counts matching jobs
+func (r *JobRepositoryImpl) Count(ctx context.Context, filter repository.JobFilter) (int64, error) {
+	var count int64
+
+	err := r.filtered(ctx, filter).
+		Model(&model.Job{}).
+		Count(&count).Error
+
+	return count, err
+}
+
+// DeleteFinishedBefore removes the jobs that finished before cutoff and returns how many were removed
+func (r *JobRepositoryImpl) DeleteFinishedBefore(ctx context.Context, cutoff time.Time) (int64, error) {
+	result := r.db.WithContext(ctx).
+		Unscoped().
+		Where("finished_at < ?", cutoff).
+		Delete(&model.Job{})
+
+	return result.RowsAffected, result.Error
+}
+
+// filtered applies a job filter to a query
+func (r *JobRepositoryImpl) filtered(ctx context.Context, filter repository.JobFilter) *gorm.DB {
+	query := r.db.WithContext(ctx)
+	if filter.Type != "" {
+		query = query.Where("job_type = ?", filter.Type)
+	}
+	if filter.Status != "" {
+		query = query.Where("status = ?", filter.Status)
+	}
+	return query
+}
+
+// jobOutcomeColumns returns the columns a claim or an attempt's outcome changes
+func jobOutcomeColumns(job *entity.Job) map[string]interface{} {
+	lastError := job.LastError
+	if len(lastError) > 1000 {
+		lastError = lastError[:1000]
+	}
+
+	return map[string]interface{}{
+		"status":       string(job.Status),
+		"attempts":     job.Attempts,
+		"run_at":       job.RunAt,
+		"locked_by":    job.LockedBy,
+		"locked_until": job.LockedUntil,
+		"last_error":   lastError,
+		"started_at":   job.StartedAt,
+		"finished_at":  job.FinishedAt,
+	}
+}
@@ -0,0 +1,138 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/adapter/repository/gorm/model"
+	"github.com/hydr0g3nz/mini_bank/internal/adapter/repository/gorm/repository"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	domainRepo "github.com/hydr0g3nz/mini_bank/internal/domain/repository"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJobRepository_EnqueueDeduplicatesUniqueKeys(t *testing.T) {
+	db := setupTestDB(t)
+	require.NoError(t, db.AutoMigrate(&model.Job{}))
+
+	repo := repository.NewJobRepository(db)
+	ctx := context.Background()
+
+	first, err := entity.NewJob("review.sweep", nil, 1, time.Now())
+	require.NoError(t, err)
+	first.UniqueKey = "review.sweep@2024-01-01T00:00:00Z"
+	created, err := repo.Enqueue(ctx, first)
+	require.NoError(t, err)
+	assert.True(t, created)
+
+	second, err := entity.NewJob("review.sweep", nil, 1, time.Now())
+	require.NoError(t, err)
+	second.UniqueKey = first.UniqueKey
+	created, err = repo.Enqueue(ctx, second)
+	require.NoError(t, err)
+	assert.False(t, created)
+
+	// Jobs without a unique key are never deduplicated
+	for i := 0; i < 2; i++ {
+		job, err := entity.NewJob("backup.run", map[string]string{"backup_id": "BKP1"}, 3, time.Now())
+		require.NoError(t, err)
+		created, err := repo.Enqueue(ctx, job)
+		require.NoError(t, err)
+		assert.True(t, created)
+	}
+
+	count, err := repo.Count(ctx, domainRepo.JobFilter{})
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), count)
+
+	_, err = repo.GetByID(ctx, "JOB20240101000000000000")
+	assert.ErrorIs(t, err, errs.ErrJobNotFound)
+}
+
+func TestJobRepository_ClaimAndFinish(t *testing.T) {
+	db := setupTestDB(t)
+	require.NoError(t, db.AutoMigrate(&model.Job{}))
+
+	repo := repository.NewJobRepository(db)
+	ctx := context.Background()
+	now := time.Now()
+
+	due, err := entity.NewJob("backup.run", map[string]string{"backup_id": "BKP1"}, 2, now.Add(-time.Minute))
+	require.NoError(t, err)
+	later, err := entity.NewJob("backup.run", nil, 2, now.Add(time.Hour))
+	require.NoError(t, err)
+	other, err := entity.NewJob("export.run", nil, 2, now.Add(-time.Minute))
+	require.NoError(t, err)
+	for _, job := range []*entity.Job{due, later, other} {
+		_, err := repo.Enqueue(ctx, job)
+		require.NoError(t, err)
+	}
+
+	claimed, err := repo.ClaimNext(ctx, []string{"backup.run"}, "worker-1", now, now.Add(time.Minute))
+	require.NoError(t, err)
+	require.NotNil(t, claimed)
+	assert.Equal(t, due.ID, claimed.ID)
+	assert.Equal(t, vo.JobStatusRunning, claimed.Status)
+	assert.Equal(t, 1, claimed.Attempts)
+	assert.JSONEq(t, `{"backup_id":"BKP1"}`, string(claimed.Payload))
+
+	// Nothing else of the type is due, and the claimed job is leased
+	none, err := repo.ClaimNext(ctx, []string{"backup.run"}, "worker-2", now, now.Add(time.Minute))
+	require.NoError(t, err)
+	assert.Nil(t, none)
+
+	require.NoError(t, repo.ExtendLease(ctx, claimed.ID, "worker-1", now.Add(2*time.Minute)))
+	assert.ErrorIs(t, repo.ExtendLease(ctx, claimed.ID, "worker-2", now.Add(2*time.Minute)), errs.ErrJobLeaseLost)
+
+	require.NoError(t, claimed.MarkFailed("pg_dump exited with status 1", now.Add(time.Second)))
+	require.NoError(t, repo.Finish(ctx, claimed))
+
+	found, err := repo.GetByID(ctx, claimed.ID)
+	require.NoError(t, err)
+	assert.Equal(t, vo.JobStatusQueued, found.Status)
+	assert.Equal(t, "pg_dump exited with status 1", found.LastError)
+	assert.Nil(t, found.LockedUntil)
+
+	queued, err := repo.List(ctx, domainRepo.JobFilter{Type: "backup.run", Status: vo.JobStatusQueued}, 10, 0)
+	require.NoError(t, err)
+	assert.Len(t, queued, 2)
+}
+
+func TestJobRepository_ClaimsJobsWithLapsedLeases(t *testing.T) {
+	db := setupTestDB(t)
+	require.NoError(t, db.AutoMigrate(&model.Job{}))
+
+	repo := repository.NewJobRepository(db)
+	ctx := context.Background()
+	now := time.Now()
+
+	job, err := entity.NewJob("export.run", nil, 3, now)
+	require.NoError(t, err)
+	_, err = repo.Enqueue(ctx, job)
+	require.NoError(t, err)
+
+	abandoned, err := repo.ClaimNext(ctx, []string{"export.run"}, "worker-1", now, now.Add(time.Minute))
+	require.NoError(t, err)
+	require.NotNil(t, abandoned)
+
+	// Worker 1 died; once its lease lapses worker 2 takes the job over and worker 1 is fenced off
+	takenOver, err := repo.ClaimNext(ctx, []string{"export.run"}, "worker-2", now.Add(time.Minute), now.Add(2*time.Minute))
+	require.NoError(t, err)
+	require.NotNil(t, takenOver)
+	assert.Equal(t, 2, takenOver.Attempts)
+	assert.Equal(t, "worker-2", takenOver.LockedBy)
+
+	require.NoError(t, abandoned.MarkSucceeded())
+	assert.ErrorIs(t, repo.Finish(ctx, abandoned), errs.ErrJobLeaseLost)
+
+	require.NoError(t, takenOver.MarkSucceeded())
+	require.NoError(t, repo.Finish(ctx, takenOver))
+
+	purged, err := repo.DeleteFinishedBefore(ctx, time.Now().Add(time.Second))
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), purged)
+}
@@ -0,0 +1,60 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hydr0g3nz/mini_bank/internal/adapter/repository/gorm/model"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/repository"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type NotificationPreferenceRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewNotificationPreferenceRepository creates a new instance of NotificationPreferenceRepositoryImpl
+func NewNotificationPreferenceRepository(db *gorm.DB) repository.NotificationPreferenceRepository {
+	return &NotificationPreferenceRepositoryImpl{db: db}
+}
+
+// Save creates or replaces the notification preferences of a customer
+func (r *NotificationPreferenceRepositoryImpl) Save(ctx context.Context, preference *entity.NotificationPreference) error {
+	return r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "customer_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{
+				"locale", "timezone", "recipients", "events", "quiet_start", "quiet_end", "min_amount", "updated_at",
+			}),
+		}).
+		Create(model.FromDomainNotificationPreference(preference)).Error
+}
+
+// GetByCustomerID retrieves the notification preferences of a customer
+func (r *NotificationPreferenceRepositoryImpl) GetByCustomerID(ctx context.Context, customerID string) (*entity.NotificationPreference, error) {
+	var preferenceModel model.NotificationPreference
+
+	err := r.db.WithContext(ctx).
+		Where("customer_id = ?", customerID).
+		First(&preferenceModel).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.ErrNotificationPreferenceNotFound
+		}
+		return nil, err
+	}
+
+	return preferenceModel.ToDomainNotificationPreference()
+}
+
+// Delete removes the notification preferences of a customer; removing missing preferences is a no-op
+func (r *NotificationPreferenceRepositoryImpl) Delete(ctx context.Context, customerID string) error {
+	return r.db.WithContext(ctx).
+		Unscoped().
+		Where("customer_id = ?", customerID).
+		Delete(&model.NotificationPreference{}).Error
+}
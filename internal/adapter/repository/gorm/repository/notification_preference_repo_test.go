@@ -0,0 +1,71 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hydr0g3nz/mini_bank/internal/adapter/repository/gorm/model"
+	"github.com/hydr0g3nz/mini_bank/internal/adapter/repository/gorm/repository"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNotificationPreferenceRepository(t *testing.T) {
+	db := setupTestDB(t)
+	require.NoError(t, db.AutoMigrate(&model.NotificationPreference{}))
+
+	repo := repository.NewNotificationPreferenceRepository(db)
+	ctx := context.Background()
+
+	_, err := repo.GetByCustomerID(ctx, "CUST001")
+	assert.ErrorIs(t, err, errs.ErrNotificationPreferenceNotFound)
+
+	minAmount := vo.NewMoneyFromFloat(100)
+	preference, err := entity.NewNotificationPreference("CUST001", "th", "Asia/Bangkok",
+		map[vo.NotificationChannel]string{vo.NotificationChannelSMS: "+66800000000"},
+		map[string][]vo.NotificationChannel{"transaction.completed": {vo.NotificationChannelSMS}},
+		&entity.QuietHours{Start: "22:00", End: "07:00"},
+		&minAmount,
+	)
+	require.NoError(t, err)
+	require.NoError(t, repo.Save(ctx, preference))
+
+	found, err := repo.GetByCustomerID(ctx, "CUST001")
+	require.NoError(t, err)
+	assert.Equal(t, "th", found.Locale)
+	assert.Equal(t, "Asia/Bangkok", found.Timezone)
+	assert.Equal(t, "+66800000000", found.Recipients[vo.NotificationChannelSMS])
+	assert.Equal(t, []vo.NotificationChannel{vo.NotificationChannelSMS}, found.Events["transaction.completed"])
+	assert.Equal(t, &entity.QuietHours{Start: "22:00", End: "07:00"}, found.QuietHours)
+	require.NotNil(t, found.MinAmount)
+	assert.True(t, found.MinAmount.Equal(minAmount))
+
+	// Saving again replaces the preferences
+	replacement, err := entity.NewNotificationPreference("CUST001", "en", "",
+		map[vo.NotificationChannel]string{vo.NotificationChannelEmail: "alice@example.com"},
+		map[string][]vo.NotificationChannel{"transaction.failed": {vo.NotificationChannelEmail}},
+		nil, nil,
+	)
+	require.NoError(t, err)
+	require.NoError(t, repo.Save(ctx, replacement))
+
+	found, err = repo.GetByCustomerID(ctx, "CUST001")
+	require.NoError(t, err)
+	assert.Equal(t, "en", found.Locale)
+	assert.NotContains(t, found.Recipients, vo.NotificationChannelSMS)
+	assert.NotContains(t, found.Events, "transaction.completed")
+	assert.Nil(t, found.QuietHours)
+	assert.Nil(t, found.MinAmount)
+
+	require.NoError(t, repo.Delete(ctx, "CUST001"))
+	_, err = repo.GetByCustomerID(ctx, "CUST001")
+	assert.ErrorIs(t, err, errs.ErrNotificationPreferenceNotFound)
+
+	// Preferences can be saved again after a reset
+	require.NoError(t, repo.Save(ctx, preference))
+	_, err = repo.GetByCustomerID(ctx, "CUST001")
+	require.NoError(t, err)
+}
@@ -0,0 +1,130 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hydr0g3nz/mini_bank/internal/adapter/repository/gorm/model"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/repository"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"gorm.io/gorm"
+)
+
+type NotificationTemplateRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewNotificationTemplateRepository creates a new instance of NotificationTemplateRepositoryImpl
+func NewNotificationTemplateRepository(db *gorm.DB) repository.NotificationTemplateRepository {
+	return &NotificationTemplateRepositoryImpl{db: db}
+}
+
+// Create saves a new version of a notification template
+func (r *NotificationTemplateRepositoryImpl) Create(ctx context.Context, template *entity.NotificationTemplate) error {
+	var count int64
+	err := r.db.WithContext(ctx).
+		Model(&model.NotificationTemplate{}).
+		Where("event = ? AND channel = ? AND locale = ? AND version = ?",
+			template.Event, string(template.Channel), template.Locale, template.Version).
+		Count(&count).Error
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		return errs.ErrNotificationTemplateConflict
+	}
+
+	if err := r.db.WithContext(ctx).Create(model.FromDomainNotificationTemplate(template)).Error; err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return errs.ErrNotificationTemplateConflict
+		}
+		return err
+	}
+
+	return nil
+}
+
+// GetLatest retrieves the latest version of the template for an event, channel and locale
+func (r *NotificationTemplateRepositoryImpl) GetLatest(ctx context.Context, event string, channel vo.NotificationChannel, locale string) (*entity.NotificationTemplate, error) {
+	var templateModel model.NotificationTemplate
+
+	err := r.db.WithContext(ctx).
+		Where("event = ? AND channel = ? AND locale = ?", event, string(channel), locale).
+		Order("version DESC").
+		First(&templateModel).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.ErrNotificationTemplateNotFound
+		}
+		return nil, err
+	}
+
+	return templateModel.ToDomainNotificationTemplate(), nil
+}
+
+// GetVersion retrieves one version of the template for an event, channel and locale
+func (r *NotificationTemplateRepositoryImpl) GetVersion(ctx context.Context, event string, channel vo.NotificationChannel, locale string, version int) (*entity.NotificationTemplate, error) {
+	var templateModel model.NotificationTemplate
+
+	err := r.db.WithContext(ctx).
+		Where("event = ? AND channel = ? AND locale = ? AND version = ?", event, string(channel), locale, version).
+		First(&templateModel).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.ErrNotificationTemplateNotFound
+		}
+		return nil, err
+	}
+
+	return templateModel.ToDomainNotificationTemplate(), nil
+}
+
+// ListVersions retrieves every version of the template for an event, channel and locale, newest first
+func (r *NotificationTemplateRepositoryImpl) ListVersions(ctx context.Context, event string, channel vo.NotificationChannel, locale string) ([]*entity.NotificationTemplate, error) {
+	var templateModels []model.NotificationTemplate
+
+	err := r.db.WithContext(ctx).
+		Where("event = ? AND channel = ? AND locale = ?", event, string(channel), locale).
+		Order("version DESC").
+		Find(&templateModels).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return toDomainNotificationTemplates(templateModels), nil
+}
+
+// ListLatest retrieves the latest version of every template, by event, channel and locale
+func (r *NotificationTemplateRepositoryImpl) ListLatest(ctx context.Context) ([]*entity.NotificationTemplate, error) {
+	var templateModels []model.NotificationTemplate
+
+	latest := r.db.
+		Model(&model.NotificationTemplate{}).
+		Select("event, channel, locale, MAX(version) AS version").
+		Group("event, channel, locale")
+
+	err := r.db.WithContext(ctx).
+		Joins("JOIN (?) AS latest ON latest.event = notification_templates.event AND latest.channel = notification_templates.channel AND latest.locale = notification_templates.locale AND latest.version = notification_templates.version", latest).
+		Order("notification_templates.event ASC, notification_templates.channel ASC, notification_templates.locale ASC").
+		Find(&templateModels).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return toDomainNotificationTemplates(templateModels), nil
+}
+
+// toDomainNotificationTemplates converts notification template models to domain entities
+func toDomainNotificationTemplates(templateModels []model.NotificationTemplate) []*entity.NotificationTemplate {
+	templates := make([]*entity.NotificationTemplate, len(templateModels))
+	for i := range templateModels {
+		templates[i] = templateModels[i].ToDomainNotificationTemplate()
+	}
+	return templates
+}
@@ -0,0 +1,73 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hydr0g3nz/mini_bank/internal/adapter/repository/gorm/model"
+	"github.com/hydr0g3nz/mini_bank/internal/adapter/repository/gorm/repository"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNotificationTemplateRepository(t *testing.T) {
+	db := setupTestDB(t)
+	require.NoError(t, db.AutoMigrate(&model.NotificationTemplate{}))
+
+	repo := repository.NewNotificationTemplateRepository(db)
+	ctx := context.Background()
+
+	const completed = "transaction.completed"
+
+	_, err := repo.GetLatest(ctx, completed, vo.NotificationChannelSMS, "en")
+	assert.ErrorIs(t, err, errs.ErrNotificationTemplateNotFound)
+
+	first, err := entity.NewNotificationTemplate(completed, vo.NotificationChannelSMS, "en", "", "Sent {{.amount}}", "ops", nil)
+	require.NoError(t, err)
+	require.NoError(t, repo.Create(ctx, first))
+
+	second, err := entity.NewNotificationTemplate(completed, vo.NotificationChannelSMS, "en", "", "You sent {{.amount}}", "ops", first)
+	require.NoError(t, err)
+	require.NoError(t, repo.Create(ctx, second))
+
+	// A concurrent publish computing the same version is rejected
+	duplicate, err := entity.NewNotificationTemplate(completed, vo.NotificationChannelSMS, "en", "", "Other", "ops", first)
+	require.NoError(t, err)
+	assert.ErrorIs(t, repo.Create(ctx, duplicate), errs.ErrNotificationTemplateConflict)
+
+	thai, err := entity.NewNotificationTemplate(completed, vo.NotificationChannelSMS, "th", "", "โอน {{.amount}}", "ops", nil)
+	require.NoError(t, err)
+	require.NoError(t, repo.Create(ctx, thai))
+
+	latest, err := repo.GetLatest(ctx, completed, vo.NotificationChannelSMS, "en")
+	require.NoError(t, err)
+	assert.Equal(t, 2, latest.Version)
+	assert.Equal(t, "You sent {{.amount}}", latest.Body)
+
+	found, err := repo.GetVersion(ctx, completed, vo.NotificationChannelSMS, "en", 1)
+	require.NoError(t, err)
+	assert.Equal(t, first.ID, found.ID)
+
+	_, err = repo.GetVersion(ctx, completed, vo.NotificationChannelSMS, "en", 3)
+	assert.ErrorIs(t, err, errs.ErrNotificationTemplateNotFound)
+
+	versions, err := repo.ListVersions(ctx, completed, vo.NotificationChannelSMS, "en")
+	require.NoError(t, err)
+	require.Len(t, versions, 2)
+	assert.Equal(t, 2, versions[0].Version)
+	assert.Equal(t, 1, versions[1].Version)
+
+	all, err := repo.ListLatest(ctx)
+	require.NoError(t, err)
+	require.Len(t, all, 2)
+	for _, template := range all {
+		if template.Locale == "en" {
+			assert.Equal(t, 2, template.Version)
+		} else {
+			assert.Equal(t, thai.ID, template.ID)
+		}
+	}
+}
@@ -0,0 +1,78 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/adapter/repository/gorm/model"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/event"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/repository"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type OutboxRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewOutboxRepository creates a new instance of OutboxRepositoryImpl
+func NewOutboxRepository(db *gorm.DB) repository.OutboxRepository {
+	return &OutboxRepositoryImpl{db: db}
+}
+
+// Append stores an event for later delivery; an event already stored is left as it is
+func (r *OutboxRepositoryImpl) Append(ctx context.Context, evt event.Event) error {
+	return conn(ctx, r.db).
+		Clauses(clause.OnConflict{Columns: []clause.Column{{Name: "event_id"}}, DoNothing: true}).
+		Create(model.FromDomainEvent(evt)).Error
+}
+
+// ListPending retrieves undelivered entries in outbox order, skipping those another relay has locked
+func (r *OutboxRepositoryImpl) ListPending(ctx context.Context, limit int) ([]*event.OutboxEntry, error) {
+	var outboxModels []model.OutboxEvent
+
+	err := conn(ctx, r.db).
+		Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+		Where("published_at IS NULL").
+		Order("id ASC").
+		Limit(limit).
+		Find(&outboxModels).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]*event.OutboxEntry, len(outboxModels))
+	for i, outboxModel := range outboxModels {
+		entries[i] = outboxModel.ToDomainOutboxEntry()
+	}
+
+	return entries, nil
+}
+
+// MarkPublished records that an entry was delivered
+func (r *OutboxRepositoryImpl) MarkPublished(ctx context.Context, sequence uint64) error {
+	return conn(ctx, r.db).
+		Model(&model.OutboxEvent{}).
+		Where("id = ?", sequence).
+		Updates(map[string]interface{}{
+			"published_at": time.Now(),
+			"attempts":     gorm.Expr("attempts + 1"),
+			"last_error":   "",
+		}).Error
+}
+
+// MarkFailed records a failed delivery attempt
+func (r *OutboxRepositoryImpl) MarkFailed(ctx context.Context, sequence uint64, reason string) error {
+	if len(reason) > 1000 {
+		reason = reason[:1000]
+	}
+
+	return conn(ctx, r.db).
+		Model(&model.OutboxEvent{}).
+		Where("id = ?", sequence).
+		Updates(map[string]interface{}{
+			"attempts":   gorm.Expr("attempts + 1"),
+			"last_error": reason,
+		}).Error
+}
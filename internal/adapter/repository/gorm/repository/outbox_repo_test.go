@@ -0,0 +1,80 @@
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hydr0g3nz/mini_bank/internal/adapter/repository/gorm/model"
+	"github.com/hydr0g3nz/mini_bank/internal/adapter/repository/gorm/repository"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/event"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOutboxRepository_RelayLifecycle(t *testing.T) {
+	db := setupTestDB(t)
+	require.NoError(t, db.AutoMigrate(&model.OutboxEvent{}))
+
+	repo := repository.NewOutboxRepository(db)
+	ctx := context.Background()
+
+	account := createTestAccount()
+	first := event.NewAccountEvent(event.AccountCreated, account)
+	second := event.NewAccountEvent(event.AccountUpdated, account)
+	second.ID = first.ID + "2"
+	require.NoError(t, repo.Append(ctx, first))
+	require.NoError(t, repo.Append(ctx, second))
+
+	pending, err := repo.ListPending(ctx, 10)
+	require.NoError(t, err)
+	require.Len(t, pending, 2)
+	assert.Equal(t, first.ID, pending[0].Event.ID)
+	assert.Equal(t, event.AccountCreated, pending[0].Event.Type)
+	assert.Equal(t, account.ID.String(), pending[0].Event.Key)
+	assert.JSONEq(t, string(first.Data), string(pending[0].Event.Data))
+
+	// A failed attempt keeps the entry pending
+	require.NoError(t, repo.MarkFailed(ctx, pending[0].Sequence, "broker unavailable"))
+	pending, err = repo.ListPending(ctx, 10)
+	require.NoError(t, err)
+	require.Len(t, pending, 2)
+	assert.Equal(t, 1, pending[0].Attempts)
+
+	require.NoError(t, repo.MarkPublished(ctx, pending[0].Sequence))
+	pending, err = repo.ListPending(ctx, 10)
+	require.NoError(t, err)
+	require.Len(t, pending, 1)
+	assert.Equal(t, second.ID, pending[0].Event.ID)
+}
+
+func TestOutboxRepository_AppendInUnitOfWork(t *testing.T) {
+	db := setupTestDB(t)
+	require.NoError(t, db.AutoMigrate(&model.OutboxEvent{}))
+
+	repo := repository.NewOutboxRepository(db)
+	units := repository.NewUnitOfWork(db)
+	ctx := context.Background()
+
+	account := createTestAccount()
+	kept := event.NewAccountEvent(event.AccountCreated, account)
+	dropped := event.NewAccountEvent(event.AccountUpdated, account)
+	dropped.ID = kept.ID + "2"
+
+	// An event stored with a change that rolls back is dropped with it
+	require.NoError(t, units.Do(ctx, func(ctx context.Context) error {
+		return repo.Append(ctx, kept)
+	}))
+	require.Error(t, units.Do(ctx, func(ctx context.Context) error {
+		require.NoError(t, repo.Append(ctx, dropped))
+		return errors.New("change failed")
+	}))
+
+	// Publishing an event already stored leaves it as it is
+	require.NoError(t, repo.Append(ctx, kept))
+
+	pending, err := repo.ListPending(ctx, 10)
+	require.NoError(t, err)
+	require.Len(t, pending, 1)
+	assert.Equal(t, kept.ID, pending[0].Event.ID)
+}
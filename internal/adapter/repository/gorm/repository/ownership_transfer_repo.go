@@ -0,0 +1,157 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/adapter/repository/gorm/model"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/repository"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"gorm.io/gorm"
+)
+
+type OwnershipTransferRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewOwnershipTransferRepository creates a new instance of OwnershipTransferRepositoryImpl
+func NewOwnershipTransferRepository(db *gorm.DB) repository.OwnershipTransferRepository {
+	return &OwnershipTransferRepositoryImpl{db: db}
+}
+
+// Create records a new ownership transfer
+func (r *OwnershipTransferRepositoryImpl) Create(ctx context.Context, transfer *entity.OwnershipTransfer) error {
+	return r.db.WithContext(ctx).Create(model.FromDomainOwnershipTransfer(transfer)).Error
+}
+
+// GetByID retrieves an ownership transfer by ID
+func (r *OwnershipTransferRepositoryImpl) GetByID(ctx context.Context, id string) (*entity.OwnershipTransfer, error) {
+	var transferModel model.OwnershipTransfer
+
+	err := r.db.WithContext(ctx).
+		Where("transfer_id = ?", id).
+		First(&transferModel).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.ErrOwnershipTransferNotFound
+		}
+		return nil, err
+	}
+
+	return transferModel.ToDomainOwnershipTransfer()
+}
+
+// GetOpenByAccount retrieves the account's transfer that is pending or approved
+func (r *OwnershipTransferRepositoryImpl) GetOpenByAccount(ctx context.Context, accountID vo.AccountID) (*entity.OwnershipTransfer, error) {
+	var transferModel model.OwnershipTransfer
+
+	err := r.db.WithContext(ctx).
+		Where("account_id = ? AND status IN ?", accountID.String(),
+			[]string{string(vo.OwnershipTransferStatusPending), string(vo.OwnershipTransferStatusApproved)}).
+		Order("created_at DESC").
+		First(&transferModel).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.ErrOwnershipTransferNotFound
+		}
+		return nil, err
+	}
+
+	return transferModel.ToDomainOwnershipTransfer()
+}
+
+// Update updates an existing ownership transfer
+func (r *OwnershipTransferRepositoryImpl) Update(ctx context.Context, transfer *entity.OwnershipTransfer) error {
+	var existingModel model.OwnershipTransfer
+
+	err := r.db.WithContext(ctx).
+		Where("transfer_id = ?", transfer.ID).
+		First(&existingModel).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errs.ErrOwnershipTransferNotFound
+		}
+		return err
+	}
+
+	existingModel.UpdateFromDomain(transfer)
+
+	return r.db.WithContext(ctx).Save(&existingModel).Error
+}
+
+// List retrieves the matching transfers, newest first
+func (r *OwnershipTransferRepositoryImpl) List(ctx context.Context, filter repository.OwnershipTransferFilter, limit, offset int) ([]*entity.OwnershipTransfer, error) {
+	var transferModels []model.OwnershipTransfer
+
+	err := r.filtered(ctx, filter).
+		Order("created_at DESC").
+		Limit(limit).
+		Offset(offset).
+		Find(&transferModels).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return toDomainOwnershipTransfers(transferModels)
+}
+
+// Count counts the matching transfers
+func (r *OwnershipTransferRepositoryImpl) Count(ctx context.Context, filter repository.OwnershipTransferFilter) (int64, error) {
+	var count int64
+	err := r.filtered(ctx, filter).
+		Model(&model.OwnershipTransfer{}).
+		Count(&count).Error
+	return count, err
+}
+
+// ListDue retrieves up to limit approved transfers effective on or before the date, earliest first
+func (r *OwnershipTransferRepositoryImpl) ListDue(ctx context.Context, date time.Time, limit int) ([]*entity.OwnershipTransfer, error) {
+	var transferModels []model.OwnershipTransfer
+
+	err := r.db.WithContext(ctx).
+		Where("status = ? AND effective_date <= ?", string(vo.OwnershipTransferStatusApproved), date).
+		Order("effective_date ASC, created_at ASC").
+		Limit(limit).
+		Find(&transferModels).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return toDomainOwnershipTransfers(transferModels)
+}
+
+// filtered scopes a query to the transfers matching the filter
+func (r *OwnershipTransferRepositoryImpl) filtered(ctx context.Context, filter repository.OwnershipTransferFilter) *gorm.DB {
+	query := r.db.WithContext(ctx)
+	if filter.AccountID != "" {
+		query = query.Where("account_id = ?", filter.AccountID)
+	}
+	if filter.CustomerID != "" {
+		query = query.Where("from_customer_id = ? OR to_customer_id = ?", filter.CustomerID, filter.CustomerID)
+	}
+	if filter.Status != "" {
+		query = query.Where("status = ?", string(filter.Status))
+	}
+	return query
+}
+
+// toDomainOwnershipTransfers converts GORM models to domain entities
+func toDomainOwnershipTransfers(transferModels []model.OwnershipTransfer) ([]*entity.OwnershipTransfer, error) {
+	transfers := make([]*entity.OwnershipTransfer, len(transferModels))
+	for i := range transferModels {
+		transfer, err := transferModels[i].ToDomainOwnershipTransfer()
+		if err != nil {
+			return nil, err
+		}
+		transfers[i] = transfer
+	}
+	return transfers, nil
+}
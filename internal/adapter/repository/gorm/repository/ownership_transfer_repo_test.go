@@ -0,0 +1,115 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/adapter/repository/gorm/model"
+	"github.com/hydr0g3nz/mini_bank/internal/adapter/repository/gorm/repository"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	domainRepo "github.com/hydr0g3nz/mini_bank/internal/domain/repository"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newOwnershipTransfer requests moving a fresh account of from to the customer to on effectiveDate
+func newOwnershipTransfer(t *testing.T, from, to string, effectiveDate time.Time) *entity.OwnershipTransfer {
+	account, err := entity.NewAccount("Savings", vo.NewMoneyFromFloat(100))
+	require.NoError(t, err)
+	require.NoError(t, account.AssignCustomer(from))
+
+	transfer, err := entity.NewOwnershipTransfer(account, to, vo.OwnershipTransferReasonInheritance, "estate of the owner",
+		effectiveDate, effectiveDate, "admin-1")
+	require.NoError(t, err)
+	return transfer
+}
+
+func TestOwnershipTransferRepository_CreateAndUpdate(t *testing.T) {
+	db := setupTestDB(t)
+	require.NoError(t, db.AutoMigrate(&model.OwnershipTransfer{}))
+
+	repo := repository.NewOwnershipTransferRepository(db)
+	ctx := context.Background()
+
+	effectiveDate := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	transfer := newOwnershipTransfer(t, "CUST-1", "CUST-2", effectiveDate)
+	require.NoError(t, repo.Create(ctx, transfer))
+
+	open, err := repo.GetOpenByAccount(ctx, transfer.AccountID)
+	require.NoError(t, err)
+	assert.Equal(t, transfer.ID, open.ID)
+
+	require.NoError(t, transfer.Approve("admin-2"))
+	require.NoError(t, repo.Update(ctx, transfer))
+
+	found, err := repo.GetByID(ctx, transfer.ID)
+	require.NoError(t, err)
+	assert.Equal(t, vo.OwnershipTransferStatusApproved, found.Status)
+	assert.Equal(t, "CUST-1", found.FromCustomerID)
+	assert.Equal(t, "CUST-2", found.ToCustomerID)
+	assert.Equal(t, vo.OwnershipTransferReasonInheritance, found.ReasonCode)
+	assert.Equal(t, "admin-2", found.DecidedBy)
+	assert.Equal(t, "2024-03-01", found.EffectiveDate.Format("2006-01-02"))
+	require.NotNil(t, found.DecidedAt)
+
+	require.NoError(t, transfer.Reject("admin-3", "heir declined"))
+	require.NoError(t, repo.Update(ctx, transfer))
+	_, err = repo.GetOpenByAccount(ctx, transfer.AccountID)
+	assert.ErrorIs(t, err, errs.ErrOwnershipTransferNotFound)
+
+	_, err = repo.GetByID(ctx, "OWN20240101000000000000")
+	assert.ErrorIs(t, err, errs.ErrOwnershipTransferNotFound)
+	assert.ErrorIs(t, repo.Update(ctx, newOwnershipTransfer(t, "CUST-1", "CUST-2", effectiveDate)), errs.ErrOwnershipTransferNotFound)
+}
+
+func TestOwnershipTransferRepository_ListAndListDue(t *testing.T) {
+	db := setupTestDB(t)
+	require.NoError(t, db.AutoMigrate(&model.OwnershipTransfer{}))
+
+	repo := repository.NewOwnershipTransferRepository(db)
+	ctx := context.Background()
+
+	march, april := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)
+	dueNow := newOwnershipTransfer(t, "CUST-1", "CUST-2", march)
+	dueLater := newOwnershipTransfer(t, "CUST-3", "CUST-1", april)
+	pending := newOwnershipTransfer(t, "CUST-4", "CUST-5", march)
+	for _, transfer := range []*entity.OwnershipTransfer{dueNow, dueLater, pending} {
+		require.NoError(t, repo.Create(ctx, transfer))
+	}
+	for _, transfer := range []*entity.OwnershipTransfer{dueNow, dueLater} {
+		require.NoError(t, transfer.Approve("admin-2"))
+		require.NoError(t, repo.Update(ctx, transfer))
+	}
+
+	due, err := repo.ListDue(ctx, march, 10)
+	require.NoError(t, err)
+	require.Len(t, due, 1)
+	assert.Equal(t, dueNow.ID, due[0].ID)
+
+	due, err = repo.ListDue(ctx, april, 10)
+	require.NoError(t, err)
+	assert.Len(t, due, 2)
+
+	// CUST-1 gives one account away and receives another
+	filter := domainRepo.OwnershipTransferFilter{CustomerID: "CUST-1"}
+	transfers, err := repo.List(ctx, filter, 10, 0)
+	require.NoError(t, err)
+	assert.Len(t, transfers, 2)
+	count, err := repo.Count(ctx, filter)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), count)
+
+	filter = domainRepo.OwnershipTransferFilter{Status: vo.OwnershipTransferStatusPending}
+	transfers, err = repo.List(ctx, filter, 10, 0)
+	require.NoError(t, err)
+	require.Len(t, transfers, 1)
+	assert.Equal(t, pending.ID, transfers[0].ID)
+
+	transfers, err = repo.List(ctx, domainRepo.OwnershipTransferFilter{AccountID: dueLater.AccountID.String()}, 10, 0)
+	require.NoError(t, err)
+	require.Len(t, transfers, 1)
+	assert.Equal(t, dueLater.ID, transfers[0].ID)
+}
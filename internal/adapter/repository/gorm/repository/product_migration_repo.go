@@ -0,0 +1,134 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/adapter/repository/gorm/model"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/repository"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"gorm.io/gorm"
+)
+
+type ProductMigrationRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewProductMigrationRepository creates a new instance of ProductMigrationRepositoryImpl
+func NewProductMigrationRepository(db *gorm.DB) repository.ProductMigrationRepository {
+	return &ProductMigrationRepositoryImpl{db: db}
+}
+
+// Create records a new product migration
+func (r *ProductMigrationRepositoryImpl) Create(ctx context.Context, migration *entity.ProductMigration) error {
+	return r.db.WithContext(ctx).Create(model.FromDomainProductMigration(migration)).Error
+}
+
+// GetByID retrieves a product migration by ID
+func (r *ProductMigrationRepositoryImpl) GetByID(ctx context.Context, id string) (*entity.ProductMigration, error) {
+	var migrationModel model.ProductMigration
+
+	err := r.db.WithContext(ctx).
+		Where("migration_id = ?", id).
+		First(&migrationModel).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.ErrProductMigrationNotFound
+		}
+		return nil, err
+	}
+
+	return migrationModel.ToDomainProductMigration()
+}
+
+// Update updates an existing product migration
+func (r *ProductMigrationRepositoryImpl) Update(ctx context.Context, migration *entity.ProductMigration) error {
+	var existingModel model.ProductMigration
+
+	err := r.db.WithContext(ctx).
+		Where("migration_id = ?", migration.ID).
+		First(&existingModel).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errs.ErrProductMigrationNotFound
+		}
+		return err
+	}
+
+	existingModel.UpdateFromDomain(migration)
+
+	return r.db.WithContext(ctx).Save(&existingModel).Error
+}
+
+// List retrieves the matching migrations, newest first
+func (r *ProductMigrationRepositoryImpl) List(ctx context.Context, filter repository.ProductMigrationFilter, limit, offset int) ([]*entity.ProductMigration, error) {
+	var migrationModels []model.ProductMigration
+
+	err := r.filtered(ctx, filter).
+		Order("created_at DESC").
+		Limit(limit).
+		Offset(offset).
+		Find(&migrationModels).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return toDomainProductMigrations(migrationModels)
+}
+
+// Count counts the matching migrations
+func (r *ProductMigrationRepositoryImpl) Count(ctx context.Context, filter repository.ProductMigrationFilter) (int64, error) {
+	var count int64
+	err := r.filtered(ctx, filter).
+		Model(&model.ProductMigration{}).
+		Count(&count).Error
+	return count, err
+}
+
+// ListDue retrieves up to limit scheduled migrations effective on or before the date, earliest first
+func (r *ProductMigrationRepositoryImpl) ListDue(ctx context.Context, date time.Time, limit int) ([]*entity.ProductMigration, error) {
+	var migrationModels []model.ProductMigration
+
+	err := r.db.WithContext(ctx).
+		Where("status = ? AND effective_date <= ?", string(vo.ProductMigrationStatusScheduled), date).
+		Order("effective_date ASC, created_at ASC").
+		Limit(limit).
+		Find(&migrationModels).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return toDomainProductMigrations(migrationModels)
+}
+
+// filtered scopes a query to the migrations matching the filter
+func (r *ProductMigrationRepositoryImpl) filtered(ctx context.Context, filter repository.ProductMigrationFilter) *gorm.DB {
+	query := r.db.WithContext(ctx)
+	if filter.ProductID != "" {
+		query = query.Where("(from_product_id = ? OR to_product_id = ?)", filter.ProductID, filter.ProductID)
+	}
+	if filter.Status != "" {
+		query = query.Where("status = ?", string(filter.Status))
+	}
+	return query
+}
+
+// toDomainProductMigrations converts GORM models to domain entities
+func toDomainProductMigrations(migrationModels []model.ProductMigration) ([]*entity.ProductMigration, error) {
+	migrations := make([]*entity.ProductMigration, len(migrationModels))
+	for i := range migrationModels {
+		migration, err := migrationModels[i].ToDomainProductMigration()
+		if err != nil {
+			return nil, err
+		}
+		migrations[i] = migration
+	}
+	return migrations, nil
+}
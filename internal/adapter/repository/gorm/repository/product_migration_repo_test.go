@@ -0,0 +1,105 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/adapter/repository/gorm/model"
+	"github.com/hydr0g3nz/mini_bank/internal/adapter/repository/gorm/repository"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	domainRepo "github.com/hydr0g3nz/mini_bank/internal/domain/repository"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newProductMigration plans moving a fresh account from one new savings product to another on effectiveDate
+func newProductMigration(t *testing.T, effectiveDate time.Time) *entity.ProductMigration {
+	from, err := entity.NewProduct("Savings 2023", entity.ProductTypeSavings, "THB", decimal.Zero, entity.ProductFees{}, entity.ProductLimits{})
+	require.NoError(t, err)
+	to, err := entity.NewProduct("Savings 2024", entity.ProductTypeSavings, "THB", decimal.NewFromFloat(1.5), entity.ProductFees{}, entity.ProductLimits{})
+	require.NoError(t, err)
+
+	account, err := entity.NewAccount("Savings", vo.NewMoneyFromFloat(100))
+	require.NoError(t, err)
+	require.NoError(t, account.OpenWithProduct(from))
+
+	migration, err := entity.NewProductMigration(from, to, []*entity.Account{account}, "fee schedule change",
+		effectiveDate, effectiveDate, "admin-1")
+	require.NoError(t, err)
+	return migration
+}
+
+func TestProductMigrationRepository_CreateAndUpdate(t *testing.T) {
+	db := setupTestDB(t)
+	require.NoError(t, db.AutoMigrate(&model.ProductMigration{}))
+
+	repo := repository.NewProductMigrationRepository(db)
+	ctx := context.Background()
+
+	effectiveDate := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	migration := newProductMigration(t, effectiveDate)
+	require.NoError(t, repo.Create(ctx, migration))
+
+	migratedAt := effectiveDate.Add(time.Hour)
+	migration.Accounts[0].Status = vo.ProductMigrationAccountMigrated
+	migration.Accounts[0].MigratedAt = &migratedAt
+	require.NoError(t, migration.Complete(migratedAt))
+	require.NoError(t, repo.Update(ctx, migration))
+
+	found, err := repo.GetByID(ctx, migration.ID)
+	require.NoError(t, err)
+	assert.Equal(t, vo.ProductMigrationStatusCompleted, found.Status)
+	assert.Equal(t, migration.FromProductID, found.FromProductID)
+	assert.Equal(t, migration.ToProductID, found.ToProductID)
+	assert.Equal(t, "fee schedule change", found.Note)
+	assert.Equal(t, "2024-03-01", found.EffectiveDate.Format("2006-01-02"))
+	require.Len(t, found.Accounts, 1)
+	assert.Equal(t, migration.Accounts[0].AccountID, found.Accounts[0].AccountID)
+	assert.Equal(t, vo.ProductMigrationAccountMigrated, found.Accounts[0].Status)
+	require.NotNil(t, found.CompletedAt)
+
+	_, err = repo.GetByID(ctx, "PMG20240101000000000000")
+	assert.ErrorIs(t, err, errs.ErrProductMigrationNotFound)
+	assert.ErrorIs(t, repo.Update(ctx, newProductMigration(t, effectiveDate)), errs.ErrProductMigrationNotFound)
+}
+
+func TestProductMigrationRepository_ListAndListDue(t *testing.T) {
+	db := setupTestDB(t)
+	require.NoError(t, db.AutoMigrate(&model.ProductMigration{}))
+
+	repo := repository.NewProductMigrationRepository(db)
+	ctx := context.Background()
+
+	march, april := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)
+	dueNow := newProductMigration(t, march)
+	dueLater := newProductMigration(t, april)
+	for _, migration := range []*entity.ProductMigration{dueNow, dueLater} {
+		require.NoError(t, repo.Create(ctx, migration))
+	}
+
+	due, err := repo.ListDue(ctx, march, 10)
+	require.NoError(t, err)
+	require.Len(t, due, 1)
+	assert.Equal(t, dueNow.ID, due[0].ID)
+
+	due, err = repo.ListDue(ctx, april, 10)
+	require.NoError(t, err)
+	assert.Len(t, due, 2)
+
+	filter := domainRepo.ProductMigrationFilter{ProductID: dueLater.ToProductID}
+	migrations, err := repo.List(ctx, filter, 10, 0)
+	require.NoError(t, err)
+	require.Len(t, migrations, 1)
+	assert.Equal(t, dueLater.ID, migrations[0].ID)
+	count, err := repo.Count(ctx, filter)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+
+	count, err = repo.Count(ctx, domainRepo.ProductMigrationFilter{Status: vo.ProductMigrationStatusScheduled})
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), count)
+}
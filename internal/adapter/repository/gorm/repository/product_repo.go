@@ -0,0 +1,83 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hydr0g3nz/mini_bank/internal/adapter/repository/gorm/model"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/repository"
+	"gorm.io/gorm"
+)
+
+type ProductRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewProductRepository creates a new instance of ProductRepositoryImpl
+func NewProductRepository(db *gorm.DB) repository.ProductRepository {
+	return &ProductRepositoryImpl{db: db}
+}
+
+// Create creates a new product
+func (r *ProductRepositoryImpl) Create(ctx context.Context, product *entity.Product) error {
+	return r.db.WithContext(ctx).Create(model.FromDomainProduct(product)).Error
+}
+
+// GetByID retrieves a product by ID
+func (r *ProductRepositoryImpl) GetByID(ctx context.Context, id string) (*entity.Product, error) {
+	var productModel model.Product
+
+	err := r.db.WithContext(ctx).
+		Where("product_id = ?", id).
+		First(&productModel).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.ErrProductNotFound
+		}
+		return nil, err
+	}
+
+	return productModel.ToDomainProduct(), nil
+}
+
+// Update updates an existing product
+func (r *ProductRepositoryImpl) Update(ctx context.Context, product *entity.Product) error {
+	var existingModel model.Product
+
+	err := r.db.WithContext(ctx).
+		Where("product_id = ?", product.ID).
+		First(&existingModel).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errs.ErrProductNotFound
+		}
+		return err
+	}
+
+	existingModel.UpdateFromDomain(product)
+	return r.db.WithContext(ctx).Save(&existingModel).Error
+}
+
+// List retrieves all products, oldest first
+func (r *ProductRepositoryImpl) List(ctx context.Context) ([]*entity.Product, error) {
+	var productModels []model.Product
+
+	err := r.db.WithContext(ctx).
+		Order("id ASC").
+		Find(&productModels).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	products := make([]*entity.Product, len(productModels))
+	for i, productModel := range productModels {
+		products[i] = productModel.ToDomainProduct()
+	}
+
+	return products, nil
+}
@@ -0,0 +1,60 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hydr0g3nz/mini_bank/internal/adapter/repository/gorm/model"
+	"github.com/hydr0g3nz/mini_bank/internal/adapter/repository/gorm/repository"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProductRepository(t *testing.T) {
+	db := setupTestDB(t)
+	require.NoError(t, db.AutoMigrate(&model.Product{}))
+
+	repo := repository.NewProductRepository(db)
+	ctx := context.Background()
+
+	maxAmount := vo.NewMoneyFromFloat(5000)
+	savings, err := entity.NewProduct("Everyday Savings", entity.ProductTypeSavings, "THB", decimal.NewFromFloat(1.25),
+		entity.ProductFees{DebitFee: vo.NewMoneyFromFloat(5), TransferFee: vo.NewMoneyFromFloat(10)},
+		entity.ProductLimits{MinOpeningBalance: vo.NewMoneyFromFloat(500), MaxTransactionAmount: &maxAmount})
+	require.NoError(t, err)
+	current, err := entity.NewProduct("Current", entity.ProductTypeCurrent, "THB", decimal.Zero, entity.ProductFees{}, entity.ProductLimits{})
+	require.NoError(t, err)
+	require.NoError(t, repo.Create(ctx, savings))
+	require.NoError(t, repo.Create(ctx, current))
+
+	found, err := repo.GetByID(ctx, savings.ID)
+	require.NoError(t, err)
+	assert.Equal(t, entity.ProductTypeSavings, found.Type)
+	assert.True(t, found.InterestRate.Equal(decimal.NewFromFloat(1.25)))
+	assert.True(t, found.Fees.TransferFee.Equal(vo.NewMoneyFromFloat(10)))
+	require.NotNil(t, found.Limits.MaxTransactionAmount)
+	assert.True(t, found.Limits.MaxTransactionAmount.Equal(maxAmount))
+
+	// Lifting the limit and retiring the product are both stored
+	require.NoError(t, found.Update(found.Name, found.InterestRate, found.Fees, entity.ProductLimits{MinOpeningBalance: found.Limits.MinOpeningBalance}))
+	found.SetActive(false)
+	require.NoError(t, repo.Update(ctx, found))
+
+	found, err = repo.GetByID(ctx, savings.ID)
+	require.NoError(t, err)
+	assert.Nil(t, found.Limits.MaxTransactionAmount)
+	assert.False(t, found.Active)
+
+	products, err := repo.List(ctx)
+	require.NoError(t, err)
+	require.Len(t, products, 2)
+	assert.Equal(t, savings.ID, products[0].ID)
+	assert.True(t, products[1].Active)
+
+	_, err = repo.GetByID(ctx, "PRD0")
+	assert.ErrorIs(t, err, errs.ErrProductNotFound)
+}
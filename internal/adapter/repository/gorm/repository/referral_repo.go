@@ -0,0 +1,180 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/adapter/repository/gorm/model"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/repository"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type ReferralRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewReferralRepository creates a new instance of ReferralRepositoryImpl
+func NewReferralRepository(db *gorm.DB) repository.ReferralRepository {
+	return &ReferralRepositoryImpl{db: db}
+}
+
+// CreateCode saves a referral code unless the account already has one or the code is taken
+func (r *ReferralRepositoryImpl) CreateCode(ctx context.Context, code *entity.ReferralCode) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(model.FromDomainReferralCode(code))
+
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	return result.RowsAffected == 1, nil
+}
+
+// GetCode retrieves a referral code
+func (r *ReferralRepositoryImpl) GetCode(ctx context.Context, code string) (*entity.ReferralCode, error) {
+	return r.getCode(r.db.WithContext(ctx).Where("code = ?", code))
+}
+
+// GetCodeByAccountID retrieves the referral code of an account
+func (r *ReferralRepositoryImpl) GetCodeByAccountID(ctx context.Context, accountID vo.AccountID) (*entity.ReferralCode, error) {
+	return r.getCode(r.db.WithContext(ctx).Where("account_id = ?", accountID.String()))
+}
+
+func (r *ReferralRepositoryImpl) getCode(query *gorm.DB) (*entity.ReferralCode, error) {
+	var codeModel model.ReferralCode
+
+	if err := query.First(&codeModel).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.ErrReferralCodeNotFound
+		}
+		return nil, err
+	}
+
+	return codeModel.ToDomainReferralCode()
+}
+
+// Create saves a redeemed referral unless the referee account was already referred
+func (r *ReferralRepositoryImpl) Create(ctx context.Context, referral *entity.Referral) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "referee_account_id"}},
+			DoNothing: true,
+		}).
+		Create(model.FromDomainReferral(referral))
+
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	return result.RowsAffected == 1, nil
+}
+
+// GetPendingByRefereeAccountID retrieves the pending referral of a referee account
+func (r *ReferralRepositoryImpl) GetPendingByRefereeAccountID(ctx context.Context, accountID vo.AccountID) (*entity.Referral, error) {
+	var referralModel model.Referral
+
+	err := r.db.WithContext(ctx).
+		Where("referee_account_id = ? AND status = ?", accountID.String(), string(entity.ReferralStatusPending)).
+		First(&referralModel).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.ErrReferralNotFound
+		}
+		return nil, err
+	}
+
+	return referralModel.ToDomainReferral()
+}
+
+// MarkRewarded saves a rewarded referral if it is still pending
+func (r *ReferralRepositoryImpl) MarkRewarded(ctx context.Context, referral *entity.Referral) (bool, error) {
+	var rewarded model.Referral
+	rewarded.UpdateFromDomain(referral)
+
+	result := r.db.WithContext(ctx).
+		Model(&model.Referral{}).
+		Where("referral_id = ? AND status = ?", referral.ID, string(entity.ReferralStatusPending)).
+		Updates(map[string]interface{}{
+			"status":                    rewarded.Status,
+			"qualifying_transaction_id": rewarded.QualifyingTransactionID,
+			"referrer_credit_id":        rewarded.ReferrerCreditID,
+			"referee_credit_id":         rewarded.RefereeCreditID,
+			"rewarded_at":               rewarded.RewardedAt,
+		})
+
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	return result.RowsAffected == 1, nil
+}
+
+// CountByRefereeCustomerID counts the referrals redeemed by accounts of a customer
+func (r *ReferralRepositoryImpl) CountByRefereeCustomerID(ctx context.Context, customerID string) (int64, error) {
+	var count int64
+
+	err := r.db.WithContext(ctx).
+		Model(&model.Referral{}).
+		Where("referee_customer_id = ?", customerID).
+		Count(&count).Error
+
+	return count, err
+}
+
+// CountByReferrerSince counts the referrals of a referrer redeemed since the time
+func (r *ReferralRepositoryImpl) CountByReferrerSince(ctx context.Context, accountID vo.AccountID, since time.Time) (int64, error) {
+	var count int64
+
+	err := r.db.WithContext(ctx).
+		Model(&model.Referral{}).
+		Where("referrer_account_id = ? AND created_at >= ?", accountID.String(), since).
+		Count(&count).Error
+
+	return count, err
+}
+
+// ListByReferrerAccountID retrieves the referrals an account made, newest first
+func (r *ReferralRepositoryImpl) ListByReferrerAccountID(ctx context.Context, accountID vo.AccountID, limit, offset int) ([]*entity.Referral, error) {
+	var referralModels []model.Referral
+
+	err := r.db.WithContext(ctx).
+		Where("referrer_account_id = ?", accountID.String()).
+		Order("id DESC").
+		Limit(limit).
+		Offset(offset).
+		Find(&referralModels).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	referrals := make([]*entity.Referral, len(referralModels))
+	for i, referralModel := range referralModels {
+		referral, err := referralModel.ToDomainReferral()
+		if err != nil {
+			return nil, err
+		}
+		referrals[i] = referral
+	}
+
+	return referrals, nil
+}
+
+// CountByReferrerAccountID counts the referrals an account made
+func (r *ReferralRepositoryImpl) CountByReferrerAccountID(ctx context.Context, accountID vo.AccountID) (int64, error) {
+	var count int64
+
+	err := r.db.WithContext(ctx).
+		Model(&model.Referral{}).
+		Where("referrer_account_id = ?", accountID.String()).
+		Count(&count).Error
+
+	return count, err
+}
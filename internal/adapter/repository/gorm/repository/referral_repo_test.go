@@ -0,0 +1,103 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/adapter/repository/gorm/model"
+	"github.com/hydr0g3nz/mini_bank/internal/adapter/repository/gorm/repository"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReferralRepository(t *testing.T) {
+	db := setupTestDB(t)
+	require.NoError(t, db.AutoMigrate(&model.ReferralCode{}, &model.Referral{}))
+
+	repo := repository.NewReferralRepository(db)
+	ctx := context.Background()
+
+	referrer := createTestAccount()
+	referee := createTestAccount()
+	referee.CustomerID = "CUST-1"
+
+	// An account has one code
+	code := entity.NewReferralCode(referrer.ID)
+	created, err := repo.CreateCode(ctx, code)
+	require.NoError(t, err)
+	assert.True(t, created)
+
+	created, err = repo.CreateCode(ctx, entity.NewReferralCode(referrer.ID))
+	require.NoError(t, err)
+	assert.False(t, created)
+
+	found, err := repo.GetCodeByAccountID(ctx, referrer.ID)
+	require.NoError(t, err)
+	assert.Equal(t, code.Code, found.Code)
+
+	found, err = repo.GetCode(ctx, code.Code)
+	require.NoError(t, err)
+	assert.Equal(t, referrer.ID, found.AccountID)
+
+	_, err = repo.GetCode(ctx, "ZZZZZZZZ")
+	assert.ErrorIs(t, err, errs.ErrReferralCodeNotFound)
+
+	// An account is referred once
+	referral, err := entity.NewReferral(code, referrer, referee, time.Now())
+	require.NoError(t, err)
+	created, err = repo.Create(ctx, referral)
+	require.NoError(t, err)
+	assert.True(t, created)
+
+	again, err := entity.NewReferral(code, referrer, referee, time.Now())
+	require.NoError(t, err)
+	created, err = repo.Create(ctx, again)
+	require.NoError(t, err)
+	assert.False(t, created)
+
+	count, err := repo.CountByRefereeCustomerID(ctx, "CUST-1")
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+
+	count, err = repo.CountByReferrerSince(ctx, referrer.ID, time.Now().Add(-time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+
+	count, err = repo.CountByReferrerSince(ctx, referrer.ID, time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), count)
+
+	// The bonuses are paid once
+	pending, err := repo.GetPendingByRefereeAccountID(ctx, referee.ID)
+	require.NoError(t, err)
+	credit, err := entity.NewCreditTransaction(referee.ID, vo.NewMoneyFromFloat(50), "Referral bonus", pending.Reference(), time.Now())
+	require.NoError(t, err)
+	require.NoError(t, pending.Reward(vo.NewTransactionID(), nil, credit))
+
+	rewarded, err := repo.MarkRewarded(ctx, pending)
+	require.NoError(t, err)
+	assert.True(t, rewarded)
+
+	rewarded, err = repo.MarkRewarded(ctx, pending)
+	require.NoError(t, err)
+	assert.False(t, rewarded)
+
+	_, err = repo.GetPendingByRefereeAccountID(ctx, referee.ID)
+	assert.ErrorIs(t, err, errs.ErrReferralNotFound)
+
+	referrals, err := repo.ListByReferrerAccountID(ctx, referrer.ID, 10, 0)
+	require.NoError(t, err)
+	require.Len(t, referrals, 1)
+	assert.Equal(t, entity.ReferralStatusRewarded, referrals[0].Status)
+	assert.Nil(t, referrals[0].ReferrerCreditID)
+	require.NotNil(t, referrals[0].RefereeCreditID)
+	assert.Equal(t, credit.ID, *referrals[0].RefereeCreditID)
+
+	total, err := repo.CountByReferrerAccountID(ctx, referrer.ID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), total)
+}
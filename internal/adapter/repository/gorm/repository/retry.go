@@ -0,0 +1,260 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math/rand/v2"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/repository"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// conflictSQLStates are the PostgreSQL error codes of statements rolled back because they lost a race
+// with a concurrent transaction; running them again is safe
+var conflictSQLStates = map[string]bool{
+	"40001": true, // serialization_failure
+	"40P01": true, // deadlock_detected
+}
+
+// IsConflictError checks if a database error is a deadlock or serialization failure
+func IsConflictError(err error) bool {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		return conflictSQLStates[pgErr.Code]
+	}
+
+	// SQLite reports lock contention as "database is locked"
+	return strings.Contains(strings.ToLower(err.Error()), "database is locked")
+}
+
+// RetryPolicy tells how often a write that lost a race is attempted again
+type RetryPolicy struct {
+	MaxAttempts int           // Attempts in total, the first included; 1 disables retries
+	BaseDelay   time.Duration // Delay before the first retry, doubled before each further one
+	MaxDelay    time.Duration // Longest delay between two attempts
+}
+
+// DefaultRetryPolicy returns the retry policy of repository writes
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{MaxAttempts: 3, BaseDelay: 20 * time.Millisecond, MaxDelay: 500 * time.Millisecond}
+}
+
+// backoff returns the delay before the given retry, counted from 1, with full jitter so writers that
+// collided do not collide again
+func (p RetryPolicy) backoff(retry int) time.Duration {
+	delay := p.BaseDelay << (retry - 1)
+	if delay <= 0 || delay > p.MaxDelay {
+		delay = p.MaxDelay
+	}
+	if delay <= 0 {
+		return 0
+	}
+	return rand.N(delay) + 1
+}
+
+// Retrier runs repository writes again when they fail with a deadlock or serialization failure
+type Retrier struct {
+	policy  RetryPolicy
+	metrics *RetryMetrics
+}
+
+// NewRetrier creates a retrier; a policy without attempts is replaced by the default one
+func NewRetrier(policy RetryPolicy) *Retrier {
+	if policy.MaxAttempts <= 0 {
+		policy = DefaultRetryPolicy()
+	}
+	return &Retrier{policy: policy, metrics: NewRetryMetrics()}
+}
+
+// Metrics returns the retry metrics
+func (r *Retrier) Metrics() *RetryMetrics {
+	return r.metrics
+}
+
+// Do runs write, and runs it again after a backoff while it fails with a conflict and attempts are
+// left. Other errors are returned at once. A write in a unit of work runs once: the conflict rolled
+// back the whole database transaction, which only its unit can run again.
+func (r *Retrier) Do(ctx context.Context, operation string, write func() error) error {
+	if inUnitOfWork(ctx) {
+		return write()
+	}
+	metrics := r.metrics.operation(operation)
+
+	var err error
+	for attempt := 1; ; attempt++ {
+		if err = write(); err == nil {
+			if attempt > 1 {
+				metrics.recovered.Add(1)
+			}
+			return nil
+		}
+		if !IsConflictError(err) {
+			return err
+		}
+		if attempt >= r.policy.MaxAttempts {
+			metrics.exhausted.Add(1)
+			return err
+		}
+
+		metrics.retries.Add(1)
+		timer := time.NewTimer(r.policy.backoff(attempt))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return err
+		case <-timer.C:
+		}
+	}
+}
+
+// RetryMetrics counts retried repository writes per operation. It is an expvar.Var, so it can be
+// published as-is.
+type RetryMetrics struct {
+	mu         sync.Mutex
+	operations map[string]*retryOperationMetrics
+}
+
+type retryOperationMetrics struct {
+	retries   atomic.Int64
+	recovered atomic.Int64
+	exhausted atomic.Int64
+}
+
+// RetryOperationSnapshot is a point-in-time copy of one operation's retry metrics
+type RetryOperationSnapshot struct {
+	Retries   int64 `json:"retries"`   // Attempts made after a conflict
+	Recovered int64 `json:"recovered"` // Writes that succeeded after at least one retry
+	Exhausted int64 `json:"exhausted"` // Writes that still conflicted on their last attempt
+}
+
+// NewRetryMetrics creates empty retry metrics
+func NewRetryMetrics() *RetryMetrics {
+	return &RetryMetrics{operations: make(map[string]*retryOperationMetrics)}
+}
+
+// Snapshot returns the current metrics per operation
+func (m *RetryMetrics) Snapshot() map[string]RetryOperationSnapshot {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	snapshots := make(map[string]RetryOperationSnapshot, len(m.operations))
+	for operation, metrics := range m.operations {
+		snapshots[operation] = RetryOperationSnapshot{
+			Retries:   metrics.retries.Load(),
+			Recovered: metrics.recovered.Load(),
+			Exhausted: metrics.exhausted.Load(),
+		}
+	}
+	return snapshots
+}
+
+// String renders the metrics as JSON for expvar
+func (m *RetryMetrics) String() string {
+	data, _ := json.Marshal(m.Snapshot())
+	return string(data)
+}
+
+func (m *RetryMetrics) operation(operation string) *retryOperationMetrics {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	metrics, ok := m.operations[operation]
+	if !ok {
+		metrics = &retryOperationMetrics{}
+		m.operations[operation] = metrics
+	}
+	return metrics
+}
+
+// retryingAccountRepository retries the writes of the account repository it wraps; reads pass through
+type retryingAccountRepository struct {
+	repository.AccountRepository
+	retrier *Retrier
+}
+
+// NewRetryingAccountRepository wraps an account repository so its writes are retried on deadlocks and
+// serialization failures
+func NewRetryingAccountRepository(next repository.AccountRepository, retrier *Retrier) repository.AccountRepository {
+	return &retryingAccountRepository{AccountRepository: next, retrier: retrier}
+}
+
+func (r *retryingAccountRepository) Create(ctx context.Context, account *entity.Account) error {
+	return r.retrier.Do(ctx, "accounts.create", func() error {
+		return r.AccountRepository.Create(ctx, account)
+	})
+}
+
+func (r *retryingAccountRepository) Update(ctx context.Context, account *entity.Account) error {
+	return r.retrier.Do(ctx, "accounts.update", func() error {
+		return r.AccountRepository.Update(ctx, account)
+	})
+}
+
+func (r *retryingAccountRepository) UpdateFenced(ctx context.Context, account *entity.Account, transactionID vo.TransactionID, token int64) error {
+	return r.retrier.Do(ctx, "accounts.update_fenced", func() error {
+		return r.AccountRepository.UpdateFenced(ctx, account, transactionID, token)
+	})
+}
+
+func (r *retryingAccountRepository) ApplyCredits(ctx context.Context, accountID vo.AccountID, credits []repository.BatchedCredit) ([]error, error) {
+	var outcomes []error
+	err := r.retrier.Do(ctx, "accounts.apply_credits", func() error {
+		var err error
+		outcomes, err = r.AccountRepository.ApplyCredits(ctx, accountID, credits)
+		return err
+	})
+	return outcomes, err
+}
+
+func (r *retryingAccountRepository) Revert(ctx context.Context, account *entity.Account, transactionID vo.TransactionID) error {
+	return r.retrier.Do(ctx, "accounts.revert", func() error {
+		return r.AccountRepository.Revert(ctx, account, transactionID)
+	})
+}
+
+func (r *retryingAccountRepository) Delete(ctx context.Context, id vo.AccountID) error {
+	return r.retrier.Do(ctx, "accounts.delete", func() error {
+		return r.AccountRepository.Delete(ctx, id)
+	})
+}
+
+// retryingTransactionRepository retries the writes of the transaction repository it wraps; reads pass through
+type retryingTransactionRepository struct {
+	repository.TransactionRepository
+	retrier *Retrier
+}
+
+// NewRetryingTransactionRepository wraps a transaction repository so its writes are retried on deadlocks
+// and serialization failures
+func NewRetryingTransactionRepository(next repository.TransactionRepository, retrier *Retrier) repository.TransactionRepository {
+	return &retryingTransactionRepository{TransactionRepository: next, retrier: retrier}
+}
+
+func (r *retryingTransactionRepository) Create(ctx context.Context, transaction *entity.Transaction) error {
+	return r.retrier.Do(ctx, "transactions.create", func() error {
+		return r.TransactionRepository.Create(ctx, transaction)
+	})
+}
+
+func (r *retryingTransactionRepository) Update(ctx context.Context, transaction *entity.Transaction) error {
+	return r.retrier.Do(ctx, "transactions.update", func() error {
+		return r.TransactionRepository.Update(ctx, transaction)
+	})
+}
+
+func (r *retryingTransactionRepository) ClaimProcessing(ctx context.Context, transaction *entity.Transaction, token int64) (bool, error) {
+	var claimed bool
+	err := r.retrier.Do(ctx, "transactions.claim_processing", func() error {
+		var err error
+		claimed, err = r.TransactionRepository.ClaimProcessing(ctx, transaction, token)
+		return err
+	})
+	return claimed, err
+}
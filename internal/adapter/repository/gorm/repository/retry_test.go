@@ -0,0 +1,102 @@
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/adapter/repository/gorm/repository"
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRetrier_Do(t *testing.T) {
+	deadlock := fmt.Errorf("%w: %w", errs.ErrTransient, &pgconn.PgError{Code: "40P01"})
+	policy := repository.RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond, MaxDelay: 5 * time.Millisecond}
+
+	tests := []struct {
+		name          string
+		failures      []error
+		expectedCalls int
+		expectedErr   bool
+		expected      repository.RetryOperationSnapshot
+	}{
+		{
+			name:          "conflict resolved by a retry",
+			failures:      []error{deadlock},
+			expectedCalls: 2,
+			expected:      repository.RetryOperationSnapshot{Retries: 1, Recovered: 1},
+		},
+		{
+			name:          "conflicts on every attempt",
+			failures:      []error{deadlock, &pgconn.PgError{Code: "40001"}, deadlock},
+			expectedCalls: 3,
+			expectedErr:   true,
+			expected:      repository.RetryOperationSnapshot{Retries: 2, Exhausted: 1},
+		},
+		{
+			name:          "other errors are not retried",
+			failures:      []error{errors.New("duplicate key")},
+			expectedCalls: 1,
+			expectedErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			retrier := repository.NewRetrier(policy)
+
+			calls := 0
+			err := retrier.Do(context.Background(), "accounts.update", func() error {
+				calls++
+				if calls <= len(tt.failures) {
+					return tt.failures[calls-1]
+				}
+				return nil
+			})
+
+			assert.Equal(t, tt.expectedCalls, calls)
+			if tt.expectedErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expected, retrier.Metrics().Snapshot()["accounts.update"])
+		})
+	}
+}
+
+func TestRetrier_Do_StopsWhenCancelled(t *testing.T) {
+	retrier := repository.NewRetrier(repository.RetryPolicy{MaxAttempts: 5, BaseDelay: time.Hour, MaxDelay: time.Hour})
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	calls := 0
+	err := retrier.Do(ctx, "transactions.update", func() error {
+		calls++
+		return &pgconn.PgError{Code: "40001"}
+	})
+
+	assert.Error(t, err)
+	assert.Equal(t, 1, calls)
+}
+
+func TestRetrier_Do_RunsOnceInUnitOfWork(t *testing.T) {
+	db := setupTestDB(t)
+	retrier := repository.NewRetrier(repository.RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond, MaxDelay: time.Millisecond})
+
+	// The conflict rolled back the unit's database transaction, so retrying the write alone is futile
+	calls := 0
+	err := repository.NewUnitOfWork(db).Do(context.Background(), func(ctx context.Context) error {
+		return retrier.Do(ctx, "accounts.update", func() error {
+			calls++
+			return &pgconn.PgError{Code: "40P01"}
+		})
+	})
+
+	assert.Error(t, err)
+	assert.Equal(t, 1, calls)
+}
@@ -0,0 +1,84 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hydr0g3nz/mini_bank/internal/adapter/repository/gorm/model"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/repository"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"gorm.io/gorm"
+)
+
+type SagaRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewSagaRepository creates a new instance of SagaRepositoryImpl
+func NewSagaRepository(db *gorm.DB) repository.SagaRepository {
+	return &SagaRepositoryImpl{db: db}
+}
+
+// Create records a new saga
+func (r *SagaRepositoryImpl) Create(ctx context.Context, saga *entity.Saga) error {
+	return r.db.WithContext(ctx).Create(model.FromDomainSaga(saga)).Error
+}
+
+// GetByID retrieves a saga by ID
+func (r *SagaRepositoryImpl) GetByID(ctx context.Context, id string) (*entity.Saga, error) {
+	var sagaModel model.Saga
+
+	err := r.db.WithContext(ctx).
+		Where("saga_id = ?", id).
+		First(&sagaModel).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.ErrSagaNotFound
+		}
+		return nil, err
+	}
+
+	return sagaModel.ToDomainSaga()
+}
+
+// GetByTransactionID retrieves the most recent saga for a transaction
+func (r *SagaRepositoryImpl) GetByTransactionID(ctx context.Context, transactionID vo.TransactionID) (*entity.Saga, error) {
+	var sagaModel model.Saga
+
+	err := r.db.WithContext(ctx).
+		Where("transaction_id = ?", transactionID.String()).
+		Order("id DESC").
+		First(&sagaModel).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.ErrSagaNotFound
+		}
+		return nil, err
+	}
+
+	return sagaModel.ToDomainSaga()
+}
+
+// Update updates an existing saga
+func (r *SagaRepositoryImpl) Update(ctx context.Context, saga *entity.Saga) error {
+	var existingModel model.Saga
+
+	err := r.db.WithContext(ctx).
+		Where("saga_id = ?", saga.ID).
+		First(&existingModel).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errs.ErrSagaNotFound
+		}
+		return err
+	}
+
+	existingModel.UpdateFromDomain(saga)
+
+	return r.db.WithContext(ctx).Save(&existingModel).Error
+}
@@ -0,0 +1,62 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hydr0g3nz/mini_bank/internal/adapter/repository/gorm/model"
+	"github.com/hydr0g3nz/mini_bank/internal/adapter/repository/gorm/repository"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSagaRepository_CreateAndUpdate(t *testing.T) {
+	db := setupTestDB(t)
+	require.NoError(t, db.AutoMigrate(&model.Saga{}))
+
+	repo := repository.NewSagaRepository(db)
+	ctx := context.Background()
+
+	transactionID := vo.NewTransactionID()
+	saga := entity.NewSaga(entity.SagaTypeTransfer, transactionID, "debit_source", "credit_destination")
+	require.NoError(t, repo.Create(ctx, saga))
+
+	require.NoError(t, saga.MarkStepCompleted(0))
+	require.NoError(t, saga.MarkStepFailed(1, "account not found"))
+	require.NoError(t, saga.MarkStepCompensated(0))
+	require.NoError(t, saga.MarkAsCompensated())
+	require.NoError(t, repo.Update(ctx, saga))
+
+	found, err := repo.GetByTransactionID(ctx, transactionID)
+	require.NoError(t, err)
+	assert.Equal(t, saga.ID, found.ID)
+	assert.Equal(t, vo.SagaStatusCompensated, found.Status)
+	assert.Equal(t, "account not found", found.Error)
+	require.Len(t, found.Steps, 2)
+	assert.Equal(t, vo.SagaStepStatusCompensated, found.Steps[0].Status)
+	assert.Equal(t, vo.SagaStepStatusFailed, found.Steps[1].Status)
+
+	byID, err := repo.GetByID(ctx, saga.ID)
+	require.NoError(t, err)
+	assert.Equal(t, transactionID, byID.TransactionID)
+}
+
+func TestSagaRepository_NotFound(t *testing.T) {
+	db := setupTestDB(t)
+	require.NoError(t, db.AutoMigrate(&model.Saga{}))
+
+	repo := repository.NewSagaRepository(db)
+	ctx := context.Background()
+
+	_, err := repo.GetByID(ctx, "SGA20240729120000123456")
+	assert.ErrorIs(t, err, errs.ErrSagaNotFound)
+
+	_, err = repo.GetByTransactionID(ctx, vo.NewTransactionID())
+	assert.ErrorIs(t, err, errs.ErrSagaNotFound)
+
+	err = repo.Update(ctx, entity.NewSaga(entity.SagaTypeTransfer, vo.NewTransactionID()))
+	assert.ErrorIs(t, err, errs.ErrSagaNotFound)
+}
@@ -0,0 +1,151 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/adapter/repository/gorm/model"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/repository"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"gorm.io/gorm"
+)
+
+type ScheduledTransactionRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewScheduledTransactionRepository creates a new instance of ScheduledTransactionRepositoryImpl
+func NewScheduledTransactionRepository(db *gorm.DB) repository.ScheduledTransactionRepository {
+	return &ScheduledTransactionRepositoryImpl{db: db}
+}
+
+// Create creates a new scheduled transaction
+func (r *ScheduledTransactionRepositoryImpl) Create(ctx context.Context, scheduled *entity.ScheduledTransaction) error {
+	return r.db.WithContext(ctx).Create(model.FromDomainScheduledTransaction(scheduled)).Error
+}
+
+// GetByID retrieves a scheduled transaction by ID
+func (r *ScheduledTransactionRepositoryImpl) GetByID(ctx context.Context, id string) (*entity.ScheduledTransaction, error) {
+	var scheduledModel model.ScheduledTransaction
+
+	err := r.db.WithContext(ctx).
+		Where("schedule_id = ?", id).
+		First(&scheduledModel).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.ErrScheduledTransactionNotFound
+		}
+		return nil, err
+	}
+
+	return scheduledModel.ToDomainScheduledTransaction()
+}
+
+// Update updates an existing scheduled transaction
+func (r *ScheduledTransactionRepositoryImpl) Update(ctx context.Context, scheduled *entity.ScheduledTransaction) error {
+	var existingModel model.ScheduledTransaction
+
+	err := r.db.WithContext(ctx).
+		Where("schedule_id = ?", scheduled.ID).
+		First(&existingModel).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errs.ErrScheduledTransactionNotFound
+		}
+		return err
+	}
+
+	existingModel.UpdateFromDomain(scheduled)
+	return r.db.WithContext(ctx).Save(&existingModel).Error
+}
+
+// Delete removes a scheduled transaction
+func (r *ScheduledTransactionRepositoryImpl) Delete(ctx context.Context, id string) error {
+	result := r.db.WithContext(ctx).
+		Where("schedule_id = ?", id).
+		Delete(&model.ScheduledTransaction{})
+
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return errs.ErrScheduledTransactionNotFound
+	}
+
+	return nil
+}
+
+// List retrieves the matching scheduled transactions, newest first
+func (r *ScheduledTransactionRepositoryImpl) List(ctx context.Context, filter repository.ScheduledTransactionFilter, limit, offset int) ([]*entity.ScheduledTransaction, error) {
+	var scheduledModels []model.ScheduledTransaction
+
+	err := r.filtered(ctx, filter).
+		Order("created_at DESC").
+		Limit(limit).
+		Offset(offset).
+		Find(&scheduledModels).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return toDomainScheduledTransactions(scheduledModels)
+}
+
+// Count counts the matching scheduled transactions
+func (r *ScheduledTransactionRepositoryImpl) Count(ctx context.Context, filter repository.ScheduledTransactionFilter) (int64, error) {
+	var count int64
+	err := r.filtered(ctx, filter).
+		Model(&model.ScheduledTransaction{}).
+		Count(&count).Error
+	return count, err
+}
+
+// ListDue retrieves up to limit active scheduled transactions whose next run is at or before the
+// given time, earliest first
+func (r *ScheduledTransactionRepositoryImpl) ListDue(ctx context.Context, now time.Time, limit int) ([]*entity.ScheduledTransaction, error) {
+	var scheduledModels []model.ScheduledTransaction
+
+	err := r.db.WithContext(ctx).
+		Where("status = ? AND next_run_at <= ?", string(vo.ScheduledTransactionStatusActive), now).
+		Order("next_run_at ASC, created_at ASC").
+		Limit(limit).
+		Find(&scheduledModels).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return toDomainScheduledTransactions(scheduledModels)
+}
+
+// filtered scopes a query to the scheduled transactions matching the filter
+func (r *ScheduledTransactionRepositoryImpl) filtered(ctx context.Context, filter repository.ScheduledTransactionFilter) *gorm.DB {
+	query := r.db.WithContext(ctx)
+	if filter.AccountID != "" {
+		query = query.Where("from_account_id = ? OR to_account_id = ?", filter.AccountID, filter.AccountID)
+	}
+	if filter.Status != "" {
+		query = query.Where("status = ?", string(filter.Status))
+	}
+	return query
+}
+
+// toDomainScheduledTransactions converts GORM models to domain entities
+func toDomainScheduledTransactions(scheduledModels []model.ScheduledTransaction) ([]*entity.ScheduledTransaction, error) {
+	scheduled := make([]*entity.ScheduledTransaction, len(scheduledModels))
+	for i := range scheduledModels {
+		s, err := scheduledModels[i].ToDomainScheduledTransaction()
+		if err != nil {
+			return nil, err
+		}
+		scheduled[i] = s
+	}
+	return scheduled, nil
+}
@@ -0,0 +1,74 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/adapter/repository/gorm/model"
+	"github.com/hydr0g3nz/mini_bank/internal/adapter/repository/gorm/repository"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	domainRepo "github.com/hydr0g3nz/mini_bank/internal/domain/repository"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScheduledTransactionRepository(t *testing.T) {
+	db := setupTestDB(t)
+	require.NoError(t, db.AutoMigrate(&model.ScheduledTransaction{}))
+
+	repo := repository.NewScheduledTransactionRepository(db)
+	ctx := context.Background()
+
+	now := time.Date(2026, time.March, 10, 8, 0, 0, 0, time.UTC)
+	from := vo.NewAccountID()
+	to := vo.NewAccountID()
+
+	monthly, err := entity.NewScheduledTransaction(from, to, vo.NewMoneyFromFloat(500), "Rent", "", "0 9 1 * *", nil, nil, now)
+	require.NoError(t, err)
+	require.NoError(t, repo.Create(ctx, monthly))
+
+	runAt := now.Add(time.Hour)
+	once, err := entity.NewScheduledTransaction(to, vo.NewAccountID(), vo.NewMoneyFromFloat(20), "Gift", "", "", &runAt, nil, now)
+	require.NoError(t, err)
+	require.NoError(t, repo.Create(ctx, once))
+
+	found, err := repo.GetByID(ctx, monthly.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "0 9 1 * *", found.Cron)
+	assert.True(t, found.Amount.Equal(vo.NewMoneyFromFloat(500)))
+	require.NotNil(t, found.NextRunAt)
+	assert.True(t, found.NextRunAt.Equal(time.Date(2026, time.April, 1, 9, 0, 0, 0, time.UTC)))
+
+	due, err := repo.ListDue(ctx, runAt, 10)
+	require.NoError(t, err)
+	require.Len(t, due, 1)
+	assert.Equal(t, once.ID, due[0].ID)
+
+	once.PendingTransactionID = "TXN1"
+	require.NoError(t, repo.Update(ctx, once))
+	found, err = repo.GetByID(ctx, once.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "TXN1", found.PendingTransactionID)
+
+	once.RecordRun("TXN1", nil, runAt)
+	require.NoError(t, repo.Update(ctx, once))
+	due, err = repo.ListDue(ctx, runAt, 10)
+	require.NoError(t, err)
+	assert.Empty(t, due)
+
+	// Schedules paying from or to the account
+	listed, err := repo.List(ctx, domainRepo.ScheduledTransactionFilter{AccountID: to.String()}, 10, 0)
+	require.NoError(t, err)
+	assert.Len(t, listed, 2)
+	count, err := repo.Count(ctx, domainRepo.ScheduledTransactionFilter{AccountID: to.String(), Status: vo.ScheduledTransactionStatusActive})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+
+	require.NoError(t, repo.Delete(ctx, monthly.ID))
+	_, err = repo.GetByID(ctx, monthly.ID)
+	assert.ErrorIs(t, err, errs.ErrScheduledTransactionNotFound)
+	assert.ErrorIs(t, repo.Delete(ctx, monthly.ID), errs.ErrScheduledTransactionNotFound)
+}
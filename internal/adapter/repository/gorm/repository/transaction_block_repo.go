@@ -0,0 +1,141 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/adapter/repository/gorm/model"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/repository"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"gorm.io/gorm"
+)
+
+type TransactionBlockRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewTransactionBlockRepository creates a new instance of TransactionBlockRepositoryImpl
+func NewTransactionBlockRepository(db *gorm.DB) repository.TransactionBlockRepository {
+	return &TransactionBlockRepositoryImpl{db: db}
+}
+
+// Create records a new transaction block
+func (r *TransactionBlockRepositoryImpl) Create(ctx context.Context, block *entity.TransactionBlock) error {
+	return r.db.WithContext(ctx).Create(model.FromDomainTransactionBlock(block)).Error
+}
+
+// GetByID retrieves a transaction block by ID
+func (r *TransactionBlockRepositoryImpl) GetByID(ctx context.Context, id string) (*entity.TransactionBlock, error) {
+	var blockModel model.TransactionBlock
+
+	err := r.db.WithContext(ctx).
+		Where("block_id = ?", id).
+		First(&blockModel).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.ErrTransactionBlockNotFound
+		}
+		return nil, err
+	}
+
+	return blockModel.ToDomainTransactionBlock()
+}
+
+// Update updates an existing transaction block
+func (r *TransactionBlockRepositoryImpl) Update(ctx context.Context, block *entity.TransactionBlock) error {
+	var existingModel model.TransactionBlock
+
+	err := r.db.WithContext(ctx).
+		Where("block_id = ?", block.ID).
+		First(&existingModel).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errs.ErrTransactionBlockNotFound
+		}
+		return err
+	}
+
+	existingModel.UpdateFromDomain(block)
+
+	return r.db.WithContext(ctx).Save(&existingModel).Error
+}
+
+// List retrieves the matching blocks, newest first
+func (r *TransactionBlockRepositoryImpl) List(ctx context.Context, filter repository.TransactionBlockFilter, limit, offset int) ([]*entity.TransactionBlock, error) {
+	var blockModels []model.TransactionBlock
+
+	err := r.filtered(ctx, filter).
+		Order("created_at DESC").
+		Limit(limit).
+		Offset(offset).
+		Find(&blockModels).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return toDomainTransactionBlocks(blockModels)
+}
+
+// Count counts the matching blocks
+func (r *TransactionBlockRepositoryImpl) Count(ctx context.Context, filter repository.TransactionBlockFilter) (int64, error) {
+	var count int64
+	err := r.filtered(ctx, filter).
+		Model(&model.TransactionBlock{}).
+		Count(&count).Error
+	return count, err
+}
+
+// ListActive retrieves every block in force on the accounts at the given time
+func (r *TransactionBlockRepositoryImpl) ListActive(ctx context.Context, accountIDs []vo.AccountID, now time.Time) ([]*entity.TransactionBlock, error) {
+	if len(accountIDs) == 0 {
+		return []*entity.TransactionBlock{}, nil
+	}
+
+	ids := make([]string, len(accountIDs))
+	for i, accountID := range accountIDs {
+		ids[i] = accountID.String()
+	}
+
+	var blockModels []model.TransactionBlock
+	err := r.db.WithContext(ctx).
+		Where("account_id IN ? AND lifted_at IS NULL AND expires_at > ?", ids, now).
+		Order("created_at ASC").
+		Find(&blockModels).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return toDomainTransactionBlocks(blockModels)
+}
+
+// filtered scopes a query to the blocks matching the filter
+func (r *TransactionBlockRepositoryImpl) filtered(ctx context.Context, filter repository.TransactionBlockFilter) *gorm.DB {
+	query := r.db.WithContext(ctx)
+	if filter.AccountID != "" {
+		query = query.Where("account_id = ?", filter.AccountID)
+	}
+	if filter.ActiveAt != nil {
+		query = query.Where("lifted_at IS NULL AND expires_at > ?", *filter.ActiveAt)
+	}
+	return query
+}
+
+// toDomainTransactionBlocks converts GORM models to domain entities
+func toDomainTransactionBlocks(blockModels []model.TransactionBlock) ([]*entity.TransactionBlock, error) {
+	blocks := make([]*entity.TransactionBlock, len(blockModels))
+	for i := range blockModels {
+		block, err := blockModels[i].ToDomainTransactionBlock()
+		if err != nil {
+			return nil, err
+		}
+		blocks[i] = block
+	}
+	return blocks, nil
+}
@@ -0,0 +1,73 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/adapter/repository/gorm/model"
+	"github.com/hydr0g3nz/mini_bank/internal/adapter/repository/gorm/repository"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	domainRepo "github.com/hydr0g3nz/mini_bank/internal/domain/repository"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransactionBlockRepository(t *testing.T) {
+	db := setupTestDB(t)
+	require.NoError(t, db.AutoMigrate(&model.TransactionBlock{}))
+
+	repo := repository.NewTransactionBlockRepository(db)
+	ctx := context.Background()
+
+	now := time.Date(2024, 3, 15, 10, 0, 0, 0, time.UTC)
+	accountID, otherID := vo.NewAccountID(), vo.NewAccountID()
+	newBlock := func(accountID vo.AccountID, transactionType vo.TransactionType, duration time.Duration) *entity.TransactionBlock {
+		block, err := entity.NewTransactionBlock(accountID, transactionType, vo.TransactionBlockReasonCredentialChange, "",
+			"alice", now, now.Add(duration))
+		require.NoError(t, err)
+		require.NoError(t, repo.Create(ctx, block))
+		return block
+	}
+
+	transfers := newBlock(accountID, vo.TransactionTypeTransfer, 24*time.Hour)
+	debits := newBlock(accountID, vo.TransactionTypeDebit, time.Hour)
+	newBlock(otherID, vo.TransactionTypeCredit, time.Hour)
+
+	found, err := repo.GetByID(ctx, transfers.ID)
+	require.NoError(t, err)
+	assert.Equal(t, vo.TransactionTypeTransfer, found.TransactionType)
+	assert.Equal(t, vo.TransactionBlockReasonCredentialChange, found.ReasonCode)
+	assert.True(t, found.ExpiresAt.Equal(now.Add(24*time.Hour)))
+
+	active, err := repo.ListActive(ctx, []vo.AccountID{accountID}, now.Add(2*time.Hour))
+	require.NoError(t, err)
+	require.Len(t, active, 1)
+	assert.Equal(t, transfers.ID, active[0].ID)
+
+	active, err = repo.ListActive(ctx, []vo.AccountID{accountID, otherID}, now)
+	require.NoError(t, err)
+	assert.Len(t, active, 3)
+
+	require.NoError(t, transfers.Lift("bob", now.Add(time.Minute)))
+	require.NoError(t, repo.Update(ctx, transfers))
+	active, err = repo.ListActive(ctx, []vo.AccountID{accountID}, now.Add(2*time.Minute))
+	require.NoError(t, err)
+	require.Len(t, active, 1)
+	assert.Equal(t, debits.ID, active[0].ID)
+
+	filter := domainRepo.TransactionBlockFilter{AccountID: accountID.String()}
+	blocks, err := repo.List(ctx, filter, 10, 0)
+	require.NoError(t, err)
+	assert.Len(t, blocks, 2)
+	activeAt := now.Add(2 * time.Minute)
+	filter.ActiveAt = &activeAt
+	count, err := repo.Count(ctx, filter)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+
+	_, err = repo.GetByID(ctx, "BLK20240101000000000000")
+	assert.ErrorIs(t, err, errs.ErrTransactionBlockNotFound)
+}
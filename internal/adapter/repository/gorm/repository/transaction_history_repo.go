@@ -0,0 +1,139 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/hydr0g3nz/mini_bank/internal/adapter/repository/gorm/model"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/repository"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type TransactionHistoryRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewTransactionHistoryRepository creates a new instance of TransactionHistoryRepositoryImpl
+func NewTransactionHistoryRepository(db *gorm.DB) repository.TransactionHistoryRepository {
+	return &TransactionHistoryRepositoryImpl{db: db}
+}
+
+// Upsert inserts history entries or replaces the ones already projected, keeping a recorded balance
+func (r *TransactionHistoryRepositoryImpl) Upsert(ctx context.Context, entries []*entity.HistoryEntry) error {
+	if len(entries) == 0 {
+		return nil
+	}
+
+	historyModels := make([]*model.TransactionHistory, len(entries))
+	for i, entry := range entries {
+		historyModels[i] = model.FromDomainHistoryEntry(entry)
+	}
+
+	updates := clause.AssignmentColumns([]string{
+		"counterparty_id", "counterparty_name", "fee", "change", "category",
+		"status", "description", "reference", "sequence", "completed_at", "projected_at",
+	})
+	updates = append(updates, clause.Assignment{
+		Column: clause.Column{Name: "balance_after"},
+		Value:  gorm.Expr("COALESCE(transaction_history.balance_after, excluded.balance_after)"),
+	})
+
+	return r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "transaction_id"}, {Name: "account_id"}},
+			DoUpdates: updates,
+		}).
+		Create(historyModels).Error
+}
+
+// ReplaceByAccountID replaces an account's whole history, recorded balances included, in one transaction
+func (r *TransactionHistoryRepositoryImpl) ReplaceByAccountID(ctx context.Context, accountID vo.AccountID, entries []*entity.HistoryEntry) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("account_id = ?", accountID.String()).Delete(&model.TransactionHistory{}).Error; err != nil {
+			return err
+		}
+		if len(entries) == 0 {
+			return nil
+		}
+
+		historyModels := make([]*model.TransactionHistory, len(entries))
+		for i, entry := range entries {
+			historyModels[i] = model.FromDomainHistoryEntry(entry)
+		}
+		return tx.CreateInBatches(historyModels, 100).Error
+	})
+}
+
+// ListByAccountID retrieves the matching history of an account, newest first
+func (r *TransactionHistoryRepositoryImpl) ListByAccountID(ctx context.Context, accountID vo.AccountID, filter repository.HistoryFilter, limit, offset int) ([]*entity.HistoryEntry, error) {
+	var historyModels []model.TransactionHistory
+
+	err := r.filtered(ctx, accountID, filter).
+		Order("created_at DESC, sequence DESC, transaction_id DESC").
+		Limit(limit).
+		Offset(offset).
+		Find(&historyModels).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return toDomainHistoryEntries(historyModels)
+}
+
+// ListByAccountIDAfterSequence retrieves completed history entries of an account after a sequence number, in sequence order
+func (r *TransactionHistoryRepositoryImpl) ListByAccountIDAfterSequence(ctx context.Context, accountID vo.AccountID, afterSequence int64, limit int) ([]*entity.HistoryEntry, error) {
+	var historyModels []model.TransactionHistory
+
+	err := r.db.WithContext(ctx).
+		Where("account_id = ? AND sequence > ?", accountID.String(), afterSequence).
+		Order("sequence ASC").
+		Limit(limit).
+		Find(&historyModels).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return toDomainHistoryEntries(historyModels)
+}
+
+// CountByAccountID returns the number of matching history entries of an account
+func (r *TransactionHistoryRepositoryImpl) CountByAccountID(ctx context.Context, accountID vo.AccountID, filter repository.HistoryFilter) (int64, error) {
+	var count int64
+	err := r.filtered(ctx, accountID, filter).
+		Model(&model.TransactionHistory{}).
+		Count(&count).Error
+	return count, err
+}
+
+// filtered scopes a query to the history entries of an account that match the filter
+func (r *TransactionHistoryRepositoryImpl) filtered(ctx context.Context, accountID vo.AccountID, filter repository.HistoryFilter) *gorm.DB {
+	query := r.db.WithContext(ctx).Where("account_id = ?", accountID.String())
+	if len(filter.Tags) > 0 {
+		tagged := r.db.Model(&model.TransactionTag{}).
+			Select("transaction_id").
+			Where("account_id = ? AND tag IN ?", accountID.String(), filter.Tags)
+		query = query.Where("transaction_id IN (?)", tagged)
+	}
+	if filter.Direction != "" {
+		query = query.Where("direction = ?", string(filter.Direction))
+	}
+	if filter.TransactionType != "" {
+		query = query.Where("transaction_type = ?", string(filter.TransactionType))
+	}
+	return query
+}
+
+// toDomainHistoryEntries converts history models to domain entries
+func toDomainHistoryEntries(historyModels []model.TransactionHistory) ([]*entity.HistoryEntry, error) {
+	entries := make([]*entity.HistoryEntry, len(historyModels))
+	for i, historyModel := range historyModels {
+		entry, err := historyModel.ToDomainHistoryEntry()
+		if err != nil {
+			return nil, err
+		}
+		entries[i] = entry
+	}
+	return entries, nil
+}
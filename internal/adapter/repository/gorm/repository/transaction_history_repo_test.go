@@ -0,0 +1,131 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/adapter/repository/gorm/model"
+	"github.com/hydr0g3nz/mini_bank/internal/adapter/repository/gorm/repository"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	domainRepo "github.com/hydr0g3nz/mini_bank/internal/domain/repository"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransactionHistoryRepository(t *testing.T) {
+	db := setupTestDB(t)
+	require.NoError(t, db.AutoMigrate(&model.TransactionHistory{}))
+
+	repo := repository.NewTransactionHistoryRepository(db)
+	ctx := context.Background()
+	fromID, toID := vo.NewAccountID(), vo.NewAccountID()
+
+	transfer, err := entity.NewTransferTransaction(fromID, toID, vo.NewMoneyFromFloat(100), "Rent", "REF-1", time.Now())
+	require.NoError(t, err)
+
+	entries := entity.NewHistoryEntries(transfer)
+	entries[0].CounterpartyName = "Landlord"
+	require.NoError(t, repo.Upsert(ctx, entries))
+
+	// Completing the transfer replaces the entries, recording the balance after it
+	require.NoError(t, transfer.MarkAsCompleted(time.Now()), time.Now(), time.Now())
+	entries = entity.NewHistoryEntries(transfer)
+	entries[0].CounterpartyName = "Landlord"
+	balance := vo.NewMoneyFromFloat(400)
+	entries[0].BalanceAfter = &balance
+	require.NoError(t, repo.Upsert(ctx, entries))
+
+	// Projecting the entry again later keeps the recorded balance
+	later := vo.NewMoneyFromFloat(250)
+	entries[0].BalanceAfter = &later
+	require.NoError(t, repo.Upsert(ctx, entries[:1]))
+
+	history, err := repo.ListByAccountID(ctx, fromID, domainRepo.HistoryFilter{}, 10, 0)
+	require.NoError(t, err)
+	require.Len(t, history, 1)
+	assert.Equal(t, entity.HistoryDirectionOut, history[0].Direction)
+	assert.Equal(t, "Landlord", history[0].CounterpartyName)
+	assert.Equal(t, vo.TransactionStatusCompleted, history[0].Status)
+	assert.Equal(t, "-100", history[0].Change.String())
+	require.NotNil(t, history[0].BalanceAfter)
+	assert.Equal(t, "400", history[0].BalanceAfter.String())
+	assert.Equal(t, toID, *history[0].CounterpartyID)
+
+	count, err := repo.CountByAccountID(ctx, toID, domainRepo.HistoryFilter{})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+
+	// A rebuild replaces the recorded balance and leaves the other account alone
+	entries[0].BalanceAfter = &later
+	require.NoError(t, repo.ReplaceByAccountID(ctx, fromID, entries[:1]))
+
+	history, err = repo.ListByAccountID(ctx, fromID, domainRepo.HistoryFilter{}, 10, 0)
+	require.NoError(t, err)
+	require.Len(t, history, 1)
+	assert.Equal(t, "250", history[0].BalanceAfter.String())
+
+	count, err = repo.CountByAccountID(ctx, toID, domainRepo.HistoryFilter{})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+
+	// Syncing lists completed entries after a sequence number, in sequence order
+	for _, sequence := range []int64{3, 1, 2} {
+		deposit, err := entity.NewCreditTransaction(toID, vo.NewMoneyFromFloat(10), "Deposit", "", time.Now())
+		require.NoError(t, err)
+		require.NoError(t, deposit.MarkAsCompleted(time.Now()), time.Now(), time.Now())
+		deposit.ToSequence = sequence
+		require.NoError(t, repo.Upsert(ctx, entity.NewHistoryEntries(deposit)))
+	}
+
+	synced, err := repo.ListByAccountIDAfterSequence(ctx, toID, 1, 10)
+	require.NoError(t, err)
+	require.Len(t, synced, 2)
+	assert.Equal(t, int64(2), synced[0].Sequence)
+	assert.Equal(t, int64(3), synced[1].Sequence)
+}
+
+func TestTransactionHistoryRepository_Filter(t *testing.T) {
+	db := setupTestDB(t)
+	require.NoError(t, db.AutoMigrate(&model.TransactionHistory{}, &model.TransactionTag{}))
+
+	repo := repository.NewTransactionHistoryRepository(db)
+	tagRepo := repository.NewTransactionTagRepository(db)
+	ctx := context.Background()
+	accountID, otherID := vo.NewAccountID(), vo.NewAccountID()
+
+	deposit, err := entity.NewCreditTransaction(accountID, vo.NewMoneyFromFloat(100), "Salary", "", time.Now())
+	require.NoError(t, err)
+	transfer, err := entity.NewTransferTransaction(accountID, otherID, vo.NewMoneyFromFloat(40), "Dinner", "", time.Now())
+	require.NoError(t, err)
+	require.NoError(t, repo.Upsert(ctx, entity.NewHistoryEntries(deposit)))
+	require.NoError(t, repo.Upsert(ctx, entity.NewHistoryEntries(transfer)))
+
+	tags, err := entity.NewTransactionTags(accountID, transfer.ID, []string{"food"})
+	require.NoError(t, err)
+	require.NoError(t, tagRepo.ReplaceTags(ctx, accountID, transfer.ID, tags))
+
+	// The other side of the transfer is tagged separately
+	otherTags, err := entity.NewTransactionTags(otherID, transfer.ID, []string{"income"})
+	require.NoError(t, err)
+	require.NoError(t, tagRepo.ReplaceTags(ctx, otherID, transfer.ID, otherTags))
+
+	history, err := repo.ListByAccountID(ctx, accountID, domainRepo.HistoryFilter{Tags: []string{"food", "travel"}}, 10, 0)
+	require.NoError(t, err)
+	require.Len(t, history, 1)
+	assert.Equal(t, transfer.ID, history[0].TransactionID)
+
+	count, err := repo.CountByAccountID(ctx, accountID, domainRepo.HistoryFilter{Tags: []string{"income"}})
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), count)
+
+	history, err = repo.ListByAccountID(ctx, accountID, domainRepo.HistoryFilter{Direction: entity.HistoryDirectionIn}, 10, 0)
+	require.NoError(t, err)
+	require.Len(t, history, 1)
+	assert.Equal(t, deposit.ID, history[0].TransactionID)
+
+	count, err = repo.CountByAccountID(ctx, accountID, domainRepo.HistoryFilter{TransactionType: vo.TransactionTypeTransfer, Direction: entity.HistoryDirectionIn})
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), count)
+}
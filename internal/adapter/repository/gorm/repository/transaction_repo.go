@@ -3,13 +3,16 @@ package repository
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/hydr0g3nz/mini_bank/internal/adapter/repository/gorm/model"
 	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
 	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
 	"github.com/hydr0g3nz/mini_bank/internal/domain/repository"
 	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"github.com/shopspring/decimal"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type TransactionRepositoryImpl struct {
@@ -25,7 +28,13 @@ func NewTransactionRepository(db *gorm.DB) repository.TransactionRepository {
 func (r *TransactionRepositoryImpl) Create(ctx context.Context, transaction *entity.Transaction) error {
 	transactionModel := model.FromDomainTransaction(transaction)
 
-	if err := r.db.WithContext(ctx).Create(transactionModel).Error; err != nil {
+	err := conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if err := assignChanges(tx, transaction, transactionModel); err != nil {
+			return err
+		}
+		return tx.Create(transactionModel).Error
+	})
+	if err != nil {
 		// Handle duplicate key constraint
 		if errors.Is(err, gorm.ErrDuplicatedKey) {
 			return errors.New("transaction with same ID already exists")
@@ -33,6 +42,8 @@ func (r *TransactionRepositoryImpl) Create(ctx context.Context, transaction *ent
 		return err
 	}
 
+	transaction.FromChange = transactionModel.FromChange
+	transaction.ToChange = transactionModel.ToChange
 	return nil
 }
 
@@ -40,7 +51,7 @@ func (r *TransactionRepositoryImpl) Create(ctx context.Context, transaction *ent
 func (r *TransactionRepositoryImpl) GetByID(ctx context.Context, id vo.TransactionID) (*entity.Transaction, error) {
 	var transactionModel model.Transaction
 
-	err := r.db.WithContext(ctx).
+	err := conn(ctx, r.db).
 		Where("transaction_id = ?", id.String()).
 		First(&transactionModel).Error
 
@@ -54,12 +65,44 @@ func (r *TransactionRepositoryImpl) GetByID(ctx context.Context, id vo.Transacti
 	return transactionModel.ToDomainTransaction()
 }
 
+// GetByIDs retrieves several transactions in one query, keyed by ID; IDs without a transaction are left out
+func (r *TransactionRepositoryImpl) GetByIDs(ctx context.Context, ids []vo.TransactionID) (map[vo.TransactionID]*entity.Transaction, error) {
+	transactions := make(map[vo.TransactionID]*entity.Transaction, len(ids))
+	if len(ids) == 0 {
+		return transactions, nil
+	}
+
+	values := make([]string, len(ids))
+	for i, id := range ids {
+		values[i] = id.String()
+	}
+
+	var transactionModels []model.Transaction
+	err := conn(ctx, r.db).
+		Where("transaction_id IN ?", values).
+		Find(&transactionModels).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, transactionModel := range transactionModels {
+		domainTransaction, err := transactionModel.ToDomainTransaction()
+		if err != nil {
+			return nil, err
+		}
+		transactions[domainTransaction.ID] = domainTransaction
+	}
+
+	return transactions, nil
+}
+
 // Update updates an existing transaction
 func (r *TransactionRepositoryImpl) Update(ctx context.Context, transaction *entity.Transaction) error {
 	var existingModel model.Transaction
 
 	// First, find the existing record by transaction_id
-	err := r.db.WithContext(ctx).
+	err := conn(ctx, r.db).
 		Where("transaction_id = ?", transaction.ID.String()).
 		First(&existingModel).Error
 
@@ -70,22 +113,121 @@ func (r *TransactionRepositoryImpl) Update(ctx context.Context, transaction *ent
 		return err
 	}
 
+	completing := existingModel.Status != string(vo.TransactionStatusCompleted) && transaction.Status.IsCompleted()
+
 	// Update the existing model with domain data
 	existingModel.UpdateFromDomain(transaction)
 
-	// Save the updates
-	if err := r.db.WithContext(ctx).Save(&existingModel).Error; err != nil {
+	err = conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		// A completing transaction takes the next sequence number of each of its accounts, in the same
+		// database transaction as its status so the numbers have no gaps
+		if completing {
+			if transaction.FromAccountID != nil {
+				if existingModel.FromSequence, err = nextAccountCounter(tx, *transaction.FromAccountID, "last_sequence"); err != nil {
+					return err
+				}
+			}
+			if transaction.ToAccountID != nil {
+				if existingModel.ToSequence, err = nextAccountCounter(tx, *transaction.ToAccountID, "last_sequence"); err != nil {
+					return err
+				}
+			}
+		}
+		if err := assignChanges(tx, transaction, &existingModel); err != nil {
+			return err
+		}
+
+		// Save the updates unless a newer claim took the transaction over; the token itself is only
+		// written by ClaimProcessing
+		result := tx.
+			Model(&existingModel).
+			Where("processing_token = ?", transaction.ProcessingToken).
+			Select("*").
+			Omit("processing_token").
+			Updates(&existingModel)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return errs.ErrStaleFencingToken
+		}
+		return nil
+	})
+	if err != nil {
 		return err
 	}
 
+	transaction.FromSequence = existingModel.FromSequence
+	transaction.ToSequence = existingModel.ToSequence
+	transaction.FromChange = existingModel.FromChange
+	transaction.ToChange = existingModel.ToChange
+	return nil
+}
+
+// assignChanges gives a write of a transaction the next change number of each of its accounts
+func assignChanges(tx *gorm.DB, transaction *entity.Transaction, transactionModel *model.Transaction) error {
+	var err error
+	if transaction.FromAccountID != nil {
+		if transactionModel.FromChange, err = nextAccountCounter(tx, *transaction.FromAccountID, "last_change"); err != nil {
+			return err
+		}
+	}
+	if transaction.ToAccountID != nil {
+		if transactionModel.ToChange, err = nextAccountCounter(tx, *transaction.ToAccountID, "last_change"); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
+// nextAccountCounter increments one of an account's counters and returns the new value. The counter row stays
+// locked until the database transaction ends, so writes on the same account are numbered in turn.
+func nextAccountCounter(tx *gorm.DB, accountID vo.AccountID, column string) (int64, error) {
+	err := tx.Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&model.AccountSequence{AccountID: accountID.String()}).Error
+	if err != nil {
+		return 0, err
+	}
+
+	err = tx.Model(&model.AccountSequence{}).
+		Where("account_id = ?", accountID.String()).
+		Update(column, gorm.Expr(column+" + 1")).Error
+	if err != nil {
+		return 0, err
+	}
+
+	var value []int64
+	if err := tx.Model(&model.AccountSequence{}).Where("account_id = ?", accountID.String()).Pluck(column, &value).Error; err != nil {
+		return 0, err
+	}
+	if len(value) == 0 {
+		return 0, gorm.ErrRecordNotFound
+	}
+	return value[0], nil
+}
+
+// ClaimProcessing records the fencing token of the worker about to process a transaction
+func (r *TransactionRepositoryImpl) ClaimProcessing(ctx context.Context, transaction *entity.Transaction, token int64) (bool, error) {
+	result := conn(ctx, r.db).
+		Model(&model.Transaction{}).
+		Where("transaction_id = ? AND processing_token < ?", transaction.ID.String(), token).
+		Update("processing_token", token)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return false, nil
+	}
+
+	transaction.ProcessingToken = token
+	return true, nil
+}
+
 // List retrieves transactions with pagination
 func (r *TransactionRepositoryImpl) List(ctx context.Context, limit, offset int) ([]*entity.Transaction, error) {
 	var transactionModels []model.Transaction
 
-	err := r.db.WithContext(ctx).
+	err := conn(ctx, r.db).
 		Limit(limit).
 		Offset(offset).
 		Order("created_at DESC").
@@ -113,7 +255,7 @@ func (r *TransactionRepositoryImpl) GetByAccountID(ctx context.Context, accountI
 	var transactionModels []model.Transaction
 
 	accountIDStr := accountID.String()
-	err := r.db.WithContext(ctx).
+	err := conn(ctx, r.db).
 		Where("from_account_id = ? OR to_account_id = ?", accountIDStr, accountIDStr).
 		Limit(limit).
 		Offset(offset).
@@ -141,7 +283,7 @@ func (r *TransactionRepositoryImpl) GetByAccountID(ctx context.Context, accountI
 func (r *TransactionRepositoryImpl) GetByStatus(ctx context.Context, status vo.TransactionStatus, limit, offset int) ([]*entity.Transaction, error) {
 	var transactionModels []model.Transaction
 
-	err := r.db.WithContext(ctx).
+	err := conn(ctx, r.db).
 		Where("status = ?", string(status)).
 		Limit(limit).
 		Offset(offset).
@@ -164,3 +306,360 @@ func (r *TransactionRepositoryImpl) GetByStatus(ctx context.Context, status vo.T
 
 	return transactions, nil
 }
+
+// GetByChannel retrieves transactions initiated through a channel
+func (r *TransactionRepositoryImpl) GetByChannel(ctx context.Context, channel vo.TransactionChannel, limit, offset int) ([]*entity.Transaction, error) {
+	var transactionModels []model.Transaction
+
+	query := conn(ctx, r.db)
+	// Rows created before channel tagging have no channel and came in through the API
+	if channel == vo.TransactionChannelAPI {
+		query = query.Where("channel = ? OR channel = '' OR channel IS NULL", string(channel))
+	} else {
+		query = query.Where("channel = ?", string(channel))
+	}
+
+	err := query.
+		Limit(limit).
+		Offset(offset).
+		Order("created_at DESC").
+		Find(&transactionModels).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Convert models to domain entities
+	transactions := make([]*entity.Transaction, len(transactionModels))
+	for i, transactionModel := range transactionModels {
+		domainTransaction, err := transactionModel.ToDomainTransaction()
+		if err != nil {
+			return nil, err
+		}
+		transactions[i] = domainTransaction
+	}
+
+	return transactions, nil
+}
+
+// GetOverdueReviews retrieves transactions still in review whose review deadline is before the given time
+func (r *TransactionRepositoryImpl) GetOverdueReviews(ctx context.Context, before time.Time, limit int) ([]*entity.Transaction, error) {
+	var transactionModels []model.Transaction
+
+	err := conn(ctx, r.db).
+		Where("status = ? AND review_due_at < ?", string(vo.TransactionStatusReview), before).
+		Order("review_due_at ASC").
+		Limit(limit).
+		Find(&transactionModels).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	transactions := make([]*entity.Transaction, len(transactionModels))
+	for i, transactionModel := range transactionModels {
+		domainTransaction, err := transactionModel.ToDomainTransaction()
+		if err != nil {
+			return nil, err
+		}
+		transactions[i] = domainTransaction
+	}
+
+	return transactions, nil
+}
+
+// Count returns the total number of transactions
+func (r *TransactionRepositoryImpl) Count(ctx context.Context) (int64, error) {
+	var count int64
+	err := conn(ctx, r.db).
+		Model(&model.Transaction{}).
+		Count(&count).Error
+	return count, err
+}
+
+// CountByAccountID returns the number of transactions for a specific account
+func (r *TransactionRepositoryImpl) CountByAccountID(ctx context.Context, accountID vo.AccountID) (int64, error) {
+	var count int64
+	accountIDStr := accountID.String()
+	err := conn(ctx, r.db).
+		Model(&model.Transaction{}).
+		Where("from_account_id = ? OR to_account_id = ?", accountIDStr, accountIDStr).
+		Count(&count).Error
+	return count, err
+}
+
+// GetByVirtualAccountID retrieves the transactions paid to a virtual account number, newest first
+func (r *TransactionRepositoryImpl) GetByVirtualAccountID(ctx context.Context, virtualAccountID string, limit, offset int) ([]*entity.Transaction, error) {
+	var transactionModels []model.Transaction
+
+	err := conn(ctx, r.db).
+		Where("virtual_account_id = ?", virtualAccountID).
+		Limit(limit).
+		Offset(offset).
+		Order("created_at DESC").
+		Find(&transactionModels).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	transactions := make([]*entity.Transaction, len(transactionModels))
+	for i, transactionModel := range transactionModels {
+		domainTransaction, err := transactionModel.ToDomainTransaction()
+		if err != nil {
+			return nil, err
+		}
+		transactions[i] = domainTransaction
+	}
+
+	return transactions, nil
+}
+
+// CountByVirtualAccountID returns the number of transactions paid to a virtual account number
+func (r *TransactionRepositoryImpl) CountByVirtualAccountID(ctx context.Context, virtualAccountID string) (int64, error) {
+	var count int64
+	err := conn(ctx, r.db).
+		Model(&model.Transaction{}).
+		Where("virtual_account_id = ?", virtualAccountID).
+		Count(&count).Error
+	return count, err
+}
+
+// GetFailedByKind retrieves failed transactions by why they failed, oldest first
+func (r *TransactionRepositoryImpl) GetFailedByKind(ctx context.Context, kind vo.FailureKind, limit, offset int) ([]*entity.Transaction, error) {
+	var transactionModels []model.Transaction
+
+	err := conn(ctx, r.db).
+		Where("status = ? AND failure_kind = ?", string(vo.TransactionStatusFailed), string(kind)).
+		Limit(limit).
+		Offset(offset).
+		Order("created_at ASC").
+		Find(&transactionModels).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Convert models to domain entities
+	transactions := make([]*entity.Transaction, len(transactionModels))
+	for i, transactionModel := range transactionModels {
+		domainTransaction, err := transactionModel.ToDomainTransaction()
+		if err != nil {
+			return nil, err
+		}
+		transactions[i] = domainTransaction
+	}
+
+	return transactions, nil
+}
+
+// CountFailedByKind returns the number of failed transactions that failed for a reason
+func (r *TransactionRepositoryImpl) CountFailedByKind(ctx context.Context, kind vo.FailureKind) (int64, error) {
+	var count int64
+	err := conn(ctx, r.db).
+		Model(&model.Transaction{}).
+		Where("status = ? AND failure_kind = ?", string(vo.TransactionStatusFailed), string(kind)).
+		Count(&count).Error
+	return count, err
+}
+
+// CountByStatus returns the number of transactions with a status
+func (r *TransactionRepositoryImpl) CountByStatus(ctx context.Context, status vo.TransactionStatus) (int64, error) {
+	var count int64
+	err := conn(ctx, r.db).
+		Model(&model.Transaction{}).
+		Where("status = ?", string(status)).
+		Count(&count).Error
+	return count, err
+}
+
+// CountByChannel returns the number of transactions initiated through a channel
+func (r *TransactionRepositoryImpl) CountByChannel(ctx context.Context, channel vo.TransactionChannel) (int64, error) {
+	query := conn(ctx, r.db).Model(&model.Transaction{})
+	if channel == vo.TransactionChannelAPI {
+		query = query.Where("channel = ? OR channel = '' OR channel IS NULL", string(channel))
+	} else {
+		query = query.Where("channel = ?", string(channel))
+	}
+
+	var count int64
+	err := query.Count(&count).Error
+	return count, err
+}
+
+// GetCompletedAdjustments retrieves completed adjustments with a value date in [from, until), by value date
+func (r *TransactionRepositoryImpl) GetCompletedAdjustments(ctx context.Context, from, until time.Time) ([]*entity.Transaction, error) {
+	var transactionModels []model.Transaction
+
+	err := conn(ctx, r.db).
+		Where("transaction_type = ? AND status = ? AND value_date >= ? AND value_date < ?",
+			string(vo.TransactionTypeAdjustment), string(vo.TransactionStatusCompleted), from, until).
+		Order("value_date ASC, id ASC").
+		Find(&transactionModels).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	transactions := make([]*entity.Transaction, len(transactionModels))
+	for i, transactionModel := range transactionModels {
+		domainTransaction, err := transactionModel.ToDomainTransaction()
+		if err != nil {
+			return nil, err
+		}
+		transactions[i] = domainTransaction
+	}
+
+	return transactions, nil
+}
+
+// GetByReversedTransactionID retrieves the reversals recorded against a transaction, oldest first
+func (r *TransactionRepositoryImpl) GetByReversedTransactionID(ctx context.Context, id vo.TransactionID) ([]*entity.Transaction, error) {
+	var transactionModels []model.Transaction
+
+	err := conn(ctx, r.db).
+		Where("reversed_transaction_id = ?", id.String()).
+		Order("created_at ASC, id ASC").
+		Find(&transactionModels).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	transactions := make([]*entity.Transaction, len(transactionModels))
+	for i, transactionModel := range transactionModels {
+		domainTransaction, err := transactionModel.ToDomainTransaction()
+		if err != nil {
+			return nil, err
+		}
+		transactions[i] = domainTransaction
+	}
+
+	return transactions, nil
+}
+
+// GetCompletedByAccountIDSince retrieves completed transactions of an account completed after the given time
+func (r *TransactionRepositoryImpl) GetCompletedByAccountIDSince(ctx context.Context, accountID vo.AccountID, since time.Time) ([]*entity.Transaction, error) {
+	var transactionModels []model.Transaction
+
+	accountIDStr := accountID.String()
+	err := conn(ctx, r.db).
+		Where("(from_account_id = ? OR to_account_id = ?) AND status = ? AND completed_at > ?",
+			accountIDStr, accountIDStr, string(vo.TransactionStatusCompleted), since).
+		Order("completed_at ASC").
+		Find(&transactionModels).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Convert models to domain entities
+	transactions := make([]*entity.Transaction, len(transactionModels))
+	for i, transactionModel := range transactionModels {
+		domainTransaction, err := transactionModel.ToDomainTransaction()
+		if err != nil {
+			return nil, err
+		}
+		transactions[i] = domainTransaction
+	}
+
+	return transactions, nil
+}
+
+// GetCompletedByAccountIDBetween retrieves completed transactions of an account completed from from up to,
+// not including, until, oldest first
+func (r *TransactionRepositoryImpl) GetCompletedByAccountIDBetween(ctx context.Context, accountID vo.AccountID, from, until time.Time) ([]*entity.Transaction, error) {
+	var transactionModels []model.Transaction
+
+	accountIDStr := accountID.String()
+	err := conn(ctx, r.db).
+		Where("(from_account_id = ? OR to_account_id = ?) AND status = ? AND completed_at >= ? AND completed_at < ?",
+			accountIDStr, accountIDStr, string(vo.TransactionStatusCompleted), from, until).
+		Order("completed_at ASC, id ASC").
+		Find(&transactionModels).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Convert models to domain entities
+	transactions := make([]*entity.Transaction, len(transactionModels))
+	for i, transactionModel := range transactionModels {
+		domainTransaction, err := transactionModel.ToDomainTransaction()
+		if err != nil {
+			return nil, err
+		}
+		transactions[i] = domainTransaction
+	}
+
+	return transactions, nil
+}
+
+// SumBalanceEffectSince totals what the transactions of an account completed from the given time on did
+// to its balance, crediting the converted amount of an FX_TRANSFER
+func (r *TransactionRepositoryImpl) SumBalanceEffectSince(ctx context.Context, accountID vo.AccountID, since time.Time) (vo.Money, error) {
+	var total decimal.NullDecimal
+
+	accountIDStr := accountID.String()
+	err := conn(ctx, r.db).
+		Model(&model.Transaction{}).
+		Select("SUM("+
+			"CASE WHEN to_account_id = ? THEN CASE WHEN exchange_rate IS NULL THEN amount ELSE converted_amount END ELSE 0 END - "+
+			"CASE WHEN from_account_id = ? THEN amount + fee ELSE 0 END)",
+			accountIDStr, accountIDStr).
+		Where("(from_account_id = ? OR to_account_id = ?) AND status = ? AND completed_at >= ?",
+			accountIDStr, accountIDStr, string(vo.TransactionStatusCompleted), since).
+		Scan(&total).Error
+
+	if err != nil {
+		return vo.Money{}, err
+	}
+
+	return vo.NewMoney(total.Decimal), nil
+}
+
+// GetLastSequence returns the sequence number of the latest completed transaction of an account, 0 when there is none
+func (r *TransactionRepositoryImpl) GetLastSequence(ctx context.Context, accountID vo.AccountID) (int64, error) {
+	var sequence model.AccountSequence
+	err := conn(ctx, r.db).Where("account_id = ?", accountID.String()).First(&sequence).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	return sequence.LastSequence, nil
+}
+
+// GetChangedByAccountIDSince retrieves up to limit transactions of an account whose last write took one of
+// its change numbers above sinceChange, in change order
+func (r *TransactionRepositoryImpl) GetChangedByAccountIDSince(ctx context.Context, accountID vo.AccountID, sinceChange int64, limit int) ([]*entity.Transaction, error) {
+	var transactionModels []model.Transaction
+
+	accountIDStr := accountID.String()
+	err := conn(ctx, r.db).
+		Where("(from_account_id = ? AND from_change > ?) OR (to_account_id = ? AND to_change > ?)",
+			accountIDStr, sinceChange, accountIDStr, sinceChange).
+		Order(clause.OrderBy{Expression: clause.Expr{
+			SQL:  "CASE WHEN from_account_id = ? THEN from_change ELSE to_change END ASC",
+			Vars: []interface{}{accountIDStr},
+		}}).
+		Limit(limit).
+		Find(&transactionModels).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Convert models to domain entities
+	transactions := make([]*entity.Transaction, len(transactionModels))
+	for i, transactionModel := range transactionModels {
+		domainTransaction, err := transactionModel.ToDomainTransaction()
+		if err != nil {
+			return nil, err
+		}
+		transactions[i] = domainTransaction
+	}
+
+	return transactions, nil
+}
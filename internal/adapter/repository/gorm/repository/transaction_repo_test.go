@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/hydr0g3nz/mini_bank/internal/adapter/repository/gorm/model"
 	"github.com/hydr0g3nz/mini_bank/internal/adapter/repository/gorm/repository"
@@ -16,13 +17,14 @@ import (
 	"github.com/stretchr/testify/require"
 	"gorm.io/driver/sqlite"
 	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
 )
 
 func setupTransactionTestDB(t *testing.T) *gorm.DB {
 	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
 	require.NoError(t, err)
 
-	err = db.AutoMigrate(&model.Account{}, &model.Transaction{})
+	err = db.AutoMigrate(&model.Account{}, &model.Transaction{}, &model.TransactionProcessing{}, &model.AccountSequence{})
 	require.NoError(t, err)
 
 	return db
@@ -33,9 +35,9 @@ func createTestTransactions() (*entity.Transaction, *entity.Transaction, *entity
 	toAccountID := vo.NewAccountID()
 	amount := vo.NewMoney(decimal.NewFromFloat(100.50))
 
-	debitTxn, _ := entity.NewDebitTransaction(fromAccountID, amount, "Test debit", "REF001")
-	creditTxn, _ := entity.NewCreditTransaction(toAccountID, amount, "Test credit", "REF002")
-	transferTxn, _ := entity.NewTransferTransaction(fromAccountID, toAccountID, amount, "Test transfer", "REF003")
+	debitTxn, _ := entity.NewDebitTransaction(fromAccountID, amount, "Test debit", "REF001", time.Now())
+	creditTxn, _ := entity.NewCreditTransaction(toAccountID, amount, "Test credit", "REF002", time.Now())
+	transferTxn, _ := entity.NewTransferTransaction(fromAccountID, toAccountID, amount, "Test transfer", "REF003", time.Now())
 
 	return debitTxn, creditTxn, transferTxn
 }
@@ -157,6 +159,64 @@ func TestTransactionRepository_GetByID(t *testing.T) {
 	}
 }
 
+func TestTransactionRepository_GetByIDs(t *testing.T) {
+	db := setupTransactionTestDB(t)
+	repo := repository.NewTransactionRepository(db)
+	ctx := context.Background()
+
+	debit, credit, _ := createTestTransactions()
+	require.NoError(t, repo.Create(ctx, debit))
+	require.NoError(t, repo.Create(ctx, credit))
+	unknown, err := vo.NewTransactionIDFromString("TXN20240729143045123456")
+	require.NoError(t, err)
+
+	transactions, err := repo.GetByIDs(ctx, []vo.TransactionID{debit.ID, credit.ID, unknown})
+
+	require.NoError(t, err)
+	require.Len(t, transactions, 2)
+	assert.Equal(t, debit.TransactionType, transactions[debit.ID].TransactionType)
+	assert.True(t, credit.Amount.Equal(transactions[credit.ID].Amount))
+	assert.NotContains(t, transactions, unknown)
+
+	empty, err := repo.GetByIDs(ctx, nil)
+	require.NoError(t, err)
+	assert.Empty(t, empty)
+}
+
+func TestTransactionRepository_FXTransfer(t *testing.T) {
+	db := setupTransactionTestDB(t)
+	repo := repository.NewTransactionRepository(db)
+	ctx := context.Background()
+
+	asOf := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	rate, err := vo.NewExchangeRate("USD", "THB", decimal.RequireFromString("35.5"), asOf)
+	require.NoError(t, err)
+	transfer, err := entity.NewFXTransferTransaction(vo.NewAccountID(), vo.NewAccountID(), vo.NewMoneyFromInt(100).In("USD"), rate, "Tuition", "", time.Now())
+	require.NoError(t, err)
+	require.NoError(t, repo.Create(ctx, transfer))
+	_, _, debit := createTestTransactions()
+	require.NoError(t, repo.Create(ctx, debit))
+
+	stored, err := repo.GetByID(ctx, transfer.ID)
+
+	require.NoError(t, err)
+	assert.Equal(t, vo.TransactionTypeFXTransfer, stored.TransactionType)
+	assert.Equal(t, vo.Currency("USD"), stored.Currency())
+	require.NotNil(t, stored.ExchangeRate)
+	assert.Equal(t, vo.Currency("USD"), stored.ExchangeRate.From)
+	assert.Equal(t, vo.Currency("THB"), stored.ExchangeRate.To)
+	assert.True(t, rate.Rate.Equal(stored.ExchangeRate.Rate))
+	assert.True(t, asOf.Equal(stored.ExchangeRate.AsOf))
+	assert.Equal(t, "3550", stored.ConvertedAmount.String())
+	assert.Equal(t, vo.Currency("THB"), stored.ConvertedAmount.Currency())
+
+	// Other transactions carry no conversion
+	stored, err = repo.GetByID(ctx, debit.ID)
+	require.NoError(t, err)
+	assert.Nil(t, stored.ExchangeRate)
+	assert.True(t, stored.ConvertedAmount.IsZero())
+}
+
 func TestTransactionRepository_Update(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -172,7 +232,7 @@ func TestTransactionRepository_Update(t *testing.T) {
 				require.NoError(t, err)
 
 				// Mark as completed
-				err = transaction.MarkAsCompleted()
+				err = transaction.MarkAsCompleted(time.Now())
 				require.NoError(t, err)
 
 				return transaction
@@ -220,6 +280,279 @@ func TestTransactionRepository_Update(t *testing.T) {
 	}
 }
 
+func TestTransactionRepository_ClaimProcessing(t *testing.T) {
+	db := setupTransactionTestDB(t)
+	transactionRepo := repository.NewTransactionRepository(db)
+	accountRepo := repository.NewAccountRepository(db)
+	ctx := context.Background()
+
+	account := createTestAccount()
+	require.NoError(t, accountRepo.Create(ctx, account))
+	transaction, err := entity.NewDebitTransaction(account.ID, vo.NewMoneyFromFloat(100), "Groceries", "", time.Now())
+	require.NoError(t, err)
+	require.NoError(t, transactionRepo.Create(ctx, transaction))
+
+	// The first worker claims the transaction
+	first, err := transactionRepo.GetByID(ctx, transaction.ID)
+	require.NoError(t, err)
+	claimed, err := transactionRepo.ClaimProcessing(ctx, first, 5)
+	require.NoError(t, err)
+	assert.True(t, claimed)
+	assert.Equal(t, int64(5), first.ProcessingToken)
+
+	// Its lock expires and a second worker takes over with a newer token; older tokens cannot claim
+	second, err := transactionRepo.GetByID(ctx, transaction.ID)
+	require.NoError(t, err)
+	claimed, err = transactionRepo.ClaimProcessing(ctx, second, 4)
+	require.NoError(t, err)
+	assert.False(t, claimed)
+	claimed, err = transactionRepo.ClaimProcessing(ctx, second, 6)
+	require.NoError(t, err)
+	assert.True(t, claimed)
+
+	// The first worker's writes are rejected
+	require.NoError(t, account.Debit(vo.NewMoneyFromFloat(100)))
+	assert.ErrorIs(t, accountRepo.UpdateFenced(ctx, account, transaction.ID, first.ProcessingToken), errs.ErrStaleFencingToken)
+	require.NoError(t, first.MarkAsCompleted(time.Now()))
+	assert.ErrorIs(t, transactionRepo.Update(ctx, first), errs.ErrStaleFencingToken)
+
+	stored, err := accountRepo.GetByID(ctx, account.ID)
+	require.NoError(t, err)
+	assert.True(t, stored.Balance.Equal(vo.NewMoneyFromFloat(1000.50)), "balance %s", stored.Balance.String())
+
+	// The second worker's go through
+	require.NoError(t, accountRepo.UpdateFenced(ctx, account, transaction.ID, second.ProcessingToken))
+	require.NoError(t, second.MarkAsCompleted(time.Now()))
+	require.NoError(t, transactionRepo.Update(ctx, second))
+
+	stored, err = accountRepo.GetByID(ctx, account.ID)
+	require.NoError(t, err)
+	assert.True(t, stored.Balance.Equal(vo.NewMoneyFromFloat(900.50)), "balance %s", stored.Balance.String())
+
+	completed, err := transactionRepo.GetByID(ctx, transaction.ID)
+	require.NoError(t, err)
+	assert.Equal(t, vo.TransactionStatusCompleted, completed.Status)
+	assert.Equal(t, int64(6), completed.ProcessingToken)
+}
+
+func TestTransactionRepository_UpdateAssignsSequences(t *testing.T) {
+	db := setupTransactionTestDB(t)
+	transactionRepo := repository.NewTransactionRepository(db)
+	ctx := context.Background()
+	fromID, toID := vo.NewAccountID(), vo.NewAccountID()
+
+	complete := func(transaction *entity.Transaction, err error) *entity.Transaction {
+		require.NoError(t, err)
+		require.NoError(t, transactionRepo.Create(ctx, transaction))
+		require.NoError(t, transaction.MarkAsCompleted(time.Now()))
+		require.NoError(t, transactionRepo.Update(ctx, transaction))
+		return transaction
+	}
+
+	deposit := complete(entity.NewCreditTransaction(fromID, vo.NewMoneyFromFloat(500), "Salary", "", time.Now()))
+	transfer := complete(entity.NewTransferTransaction(fromID, toID, vo.NewMoneyFromFloat(100), "Rent", "", time.Now()))
+
+	assert.Equal(t, int64(1), deposit.ToSequence)
+	assert.Equal(t, int64(2), transfer.FromSequence)
+	assert.Equal(t, int64(1), transfer.ToSequence)
+
+	// Saving a completed transaction again keeps its numbers
+	transfer.Description = "Rent for May"
+	require.NoError(t, transactionRepo.Update(ctx, transfer))
+	stored, err := transactionRepo.GetByID(ctx, transfer.ID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), stored.FromSequence)
+	assert.Equal(t, int64(1), stored.ToSequence)
+
+	// A stale completion is rolled back with its numbers, leaving no gap
+	pending, err := entity.NewDebitTransaction(fromID, vo.NewMoneyFromFloat(50), "Coffee", "", time.Now())
+	require.NoError(t, err)
+	require.NoError(t, transactionRepo.Create(ctx, pending))
+	claimed, err := transactionRepo.ClaimProcessing(ctx, pending, 3)
+	require.NoError(t, err)
+	require.True(t, claimed)
+	pending.ProcessingToken = 2
+	require.NoError(t, pending.MarkAsCompleted(time.Now()))
+	assert.ErrorIs(t, transactionRepo.Update(ctx, pending), errs.ErrStaleFencingToken)
+
+	last, err := transactionRepo.GetLastSequence(ctx, fromID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), last)
+
+	last, err = transactionRepo.GetLastSequence(ctx, vo.NewAccountID())
+	require.NoError(t, err)
+	assert.Zero(t, last)
+}
+
+func TestTransactionRepository_GetChangedByAccountIDSince(t *testing.T) {
+	db := setupTransactionTestDB(t)
+	transactionRepo := repository.NewTransactionRepository(db)
+	ctx := context.Background()
+	accountID, otherID := vo.NewAccountID(), vo.NewAccountID()
+
+	deposit, err := entity.NewCreditTransaction(accountID, vo.NewMoneyFromFloat(500), "Salary", "", time.Now())
+	require.NoError(t, err)
+	require.NoError(t, transactionRepo.Create(ctx, deposit))
+	transfer, err := entity.NewTransferTransaction(otherID, accountID, vo.NewMoneyFromFloat(100), "Rent", "", time.Now())
+	require.NoError(t, err)
+	require.NoError(t, transactionRepo.Create(ctx, transfer))
+	assert.Equal(t, int64(1), deposit.ToChange)
+	assert.Equal(t, int64(2), transfer.ToChange)
+	assert.Equal(t, int64(1), transfer.FromChange)
+
+	// Completing the deposit moves it after the transfer
+	require.NoError(t, deposit.MarkAsCompleted(time.Now()))
+	require.NoError(t, transactionRepo.Update(ctx, deposit))
+	assert.Equal(t, int64(3), deposit.ToChange)
+
+	changed, err := transactionRepo.GetChangedByAccountIDSince(ctx, accountID, 0, 10)
+	require.NoError(t, err)
+	require.Len(t, changed, 2)
+	assert.Equal(t, transfer.ID, changed[0].ID)
+	assert.Equal(t, deposit.ID, changed[1].ID)
+	assert.Equal(t, vo.TransactionStatusCompleted, changed[1].Status)
+
+	changed, err = transactionRepo.GetChangedByAccountIDSince(ctx, accountID, 2, 10)
+	require.NoError(t, err)
+	require.Len(t, changed, 1)
+	assert.Equal(t, deposit.ID, changed[0].ID)
+
+	// The other account numbers its changes on its own
+	changed, err = transactionRepo.GetChangedByAccountIDSince(ctx, otherID, 0, 10)
+	require.NoError(t, err)
+	require.Len(t, changed, 1)
+	assert.Equal(t, int64(1), changed[0].FromChange)
+}
+
+func TestAccountRepository_UpdateFencedAppliesOnce(t *testing.T) {
+	db := setupTransactionTestDB(t)
+	transactionRepo := repository.NewTransactionRepository(db)
+	accountRepo := repository.NewAccountRepository(db)
+	ctx := context.Background()
+
+	account := createTestAccount()
+	require.NoError(t, accountRepo.Create(ctx, account))
+	transaction, err := entity.NewDebitTransaction(account.ID, vo.NewMoneyFromFloat(100), "Groceries", "", time.Now())
+	require.NoError(t, err)
+	require.NoError(t, transactionRepo.Create(ctx, transaction))
+	claimed, err := transactionRepo.ClaimProcessing(ctx, transaction, 5)
+	require.NoError(t, err)
+	require.True(t, claimed)
+
+	applied, err := accountRepo.IsApplied(ctx, transaction.ID, account.ID)
+	require.NoError(t, err)
+	assert.False(t, applied)
+
+	// Two confirmations read the same balance; only the first debit is persisted
+	first, err := accountRepo.GetByID(ctx, account.ID)
+	require.NoError(t, err)
+	second, err := accountRepo.GetByID(ctx, account.ID)
+	require.NoError(t, err)
+	require.NoError(t, first.Debit(vo.NewMoneyFromFloat(100)))
+	require.NoError(t, second.Debit(vo.NewMoneyFromFloat(100)))
+
+	require.NoError(t, accountRepo.UpdateFenced(ctx, first, transaction.ID, 5))
+	second.Balance = vo.NewMoneyFromFloat(1)
+	assert.ErrorIs(t, accountRepo.UpdateFenced(ctx, second, transaction.ID, 5), errs.ErrTransactionAlreadyApplied)
+
+	stored, err := accountRepo.GetByID(ctx, account.ID)
+	require.NoError(t, err)
+	assert.True(t, stored.Balance.Equal(vo.NewMoneyFromFloat(900.50)), "balance %s", stored.Balance.String())
+	applied, err = accountRepo.IsApplied(ctx, transaction.ID, account.ID)
+	require.NoError(t, err)
+	assert.True(t, applied)
+
+	// Reverting the debit removes the record, so a replay can apply it again
+	require.NoError(t, stored.Credit(vo.NewMoneyFromFloat(100)))
+	require.NoError(t, accountRepo.Revert(ctx, stored, transaction.ID))
+	applied, err = accountRepo.IsApplied(ctx, transaction.ID, account.ID)
+	require.NoError(t, err)
+	assert.False(t, applied)
+
+	require.NoError(t, stored.Debit(vo.NewMoneyFromFloat(100)))
+	require.NoError(t, accountRepo.UpdateFenced(ctx, stored, transaction.ID, 5))
+	stored, err = accountRepo.GetByID(ctx, account.ID)
+	require.NoError(t, err)
+	assert.True(t, stored.Balance.Equal(vo.NewMoneyFromFloat(900.50)), "balance %s", stored.Balance.String())
+}
+
+func TestAccountRepository_ApplyCredits(t *testing.T) {
+	db := setupTransactionTestDB(t)
+	transactionRepo := repository.NewTransactionRepository(db)
+	accountRepo := repository.NewAccountRepository(db)
+	ctx := context.Background()
+
+	account := createTestAccount()
+	require.NoError(t, accountRepo.Create(ctx, account))
+
+	credits := make([]repo.BatchedCredit, 3)
+	for i := range credits {
+		transaction, err := entity.NewCreditTransaction(account.ID, vo.NewMoneyFromFloat(float64(10*(i+1))), "Settlement", "", time.Now())
+		require.NoError(t, err)
+		require.NoError(t, transactionRepo.Create(ctx, transaction))
+		claimed, err := transactionRepo.ClaimProcessing(ctx, transaction, 5)
+		require.NoError(t, err)
+		require.True(t, claimed)
+		credits[i] = repo.BatchedCredit{TransactionID: transaction.ID, Token: 5, Amount: transaction.Amount}
+	}
+
+	// The first credit was applied before, and the second was taken over by a newer token
+	credits[1].Token = 4
+	_, err := accountRepo.ApplyCredits(ctx, account.ID, credits[:1])
+	require.NoError(t, err)
+
+	outcomes, err := accountRepo.ApplyCredits(ctx, account.ID, credits)
+	require.NoError(t, err)
+	require.Len(t, outcomes, 3)
+	assert.ErrorIs(t, outcomes[0], errs.ErrTransactionAlreadyApplied)
+	assert.ErrorIs(t, outcomes[1], errs.ErrStaleFencingToken)
+	assert.NoError(t, outcomes[2])
+
+	stored, err := accountRepo.GetByID(ctx, account.ID)
+	require.NoError(t, err)
+	assert.True(t, stored.Balance.Equal(vo.NewMoneyFromFloat(1040.50)), "balance %s", stored.Balance.String())
+	for i, applied := range []bool{true, false, true} {
+		isApplied, err := accountRepo.IsApplied(ctx, credits[i].TransactionID, account.ID)
+		require.NoError(t, err)
+		assert.Equal(t, applied, isApplied, "credit %d", i)
+	}
+}
+
+// BenchmarkAccountRepository_ApplyCredits compares writing a hot account's credits one per write with
+// writing them in batches
+func BenchmarkAccountRepository_ApplyCredits(b *testing.B) {
+	for _, size := range []int{1, 10, 50} {
+		b.Run(fmt.Sprintf("batch_%d", size), func(b *testing.B) {
+			db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{Logger: logger.Discard})
+			require.NoError(b, err)
+			require.NoError(b, db.AutoMigrate(&model.Account{}, &model.Transaction{}, &model.TransactionProcessing{}, &model.AccountSequence{}))
+			transactionRepo := repository.NewTransactionRepository(db)
+			accountRepo := repository.NewAccountRepository(db)
+			ctx := context.Background()
+
+			account := createTestAccount()
+			require.NoError(b, accountRepo.Create(ctx, account))
+			credits := make([]repo.BatchedCredit, b.N)
+			for i := range credits {
+				transaction, err := entity.NewCreditTransaction(account.ID, vo.NewMoneyFromFloat(1), "Settlement", "", time.Now())
+				require.NoError(b, err)
+				transaction.ID, err = vo.NewTransactionIDFromString(fmt.Sprintf("TXN20240729143045%06d", i))
+				require.NoError(b, err)
+				require.NoError(b, transactionRepo.Create(ctx, transaction))
+				credits[i] = repo.BatchedCredit{TransactionID: transaction.ID, Amount: transaction.Amount}
+			}
+
+			b.ResetTimer()
+			for start := 0; start < len(credits); start += size {
+				end := min(start+size, len(credits))
+				if _, err := accountRepo.ApplyCredits(ctx, account.ID, credits[start:end]); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
 func TestTransactionRepository_List(t *testing.T) {
 	tests := []struct {
 		name       string
@@ -266,6 +599,7 @@ func TestTransactionRepository_List(t *testing.T) {
 					amount,
 					fmt.Sprintf("Test transaction %d", i),
 					fmt.Sprintf("REF%03d", i),
+					time.Now(),
 				)
 				require.NoError(t, err)
 				err = repo.Create(ctx, transaction)
@@ -311,6 +645,7 @@ func TestTransactionRepository_GetByAccountID(t *testing.T) {
 						amount,
 						fmt.Sprintf("Transfer %d", i),
 						fmt.Sprintf("REF%d", i),
+						time.Now(),
 					)
 					require.NoError(t, err)
 					err = repo.Create(ctx, transaction)
@@ -338,6 +673,7 @@ func TestTransactionRepository_GetByAccountID(t *testing.T) {
 						amount,
 						fmt.Sprintf("Transfer %d", i),
 						fmt.Sprintf("REF%d", i),
+						time.Now(),
 					)
 					require.NoError(t, err)
 					err = repo.Create(ctx, transaction)
@@ -410,6 +746,7 @@ func TestTransactionRepository_GetByStatus(t *testing.T) {
 						amount,
 						fmt.Sprintf("Debit %d", i),
 						fmt.Sprintf("REF%d", i),
+						time.Now(),
 					)
 					require.NoError(t, err)
 					err = repo.Create(ctx, transaction)
@@ -422,9 +759,10 @@ func TestTransactionRepository_GetByStatus(t *testing.T) {
 					amount,
 					"Completed debit",
 					"REF999",
+					time.Now(),
 				)
 				require.NoError(t, err)
-				err = transaction.MarkAsCompleted()
+				err = transaction.MarkAsCompleted(time.Now())
 				require.NoError(t, err)
 				err = repo.Create(ctx, transaction)
 				require.NoError(t, err)
@@ -447,9 +785,10 @@ func TestTransactionRepository_GetByStatus(t *testing.T) {
 						amount,
 						fmt.Sprintf("Debit %d", i),
 						fmt.Sprintf("REF%d", i),
+						time.Now(),
 					)
 					require.NoError(t, err)
-					err = transaction.MarkAsCompleted()
+					err = transaction.MarkAsCompleted(time.Now())
 					require.NoError(t, err)
 					err = repo.Create(ctx, transaction)
 					require.NoError(t, err)
@@ -466,7 +805,7 @@ func TestTransactionRepository_GetByStatus(t *testing.T) {
 				// Create only pending transactions
 				fromAccountID := vo.NewAccountID()
 				amount := vo.NewMoney(decimal.NewFromFloat(100))
-				transaction, err := entity.NewDebitTransaction(fromAccountID, amount, "Debit", "REF001")
+				transaction, err := entity.NewDebitTransaction(fromAccountID, amount, "Debit", "REF001", time.Now())
 				require.NoError(t, err)
 				err = repo.Create(ctx, transaction)
 				require.NoError(t, err)
@@ -505,3 +844,312 @@ func TestTransactionRepository_GetByStatus(t *testing.T) {
 		})
 	}
 }
+
+func TestTransactionRepository_GetCompletedByAccountIDSince(t *testing.T) {
+	db := setupTransactionTestDB(t)
+	transactionRepo := repository.NewTransactionRepository(db)
+	ctx := context.Background()
+
+	debitTxn, creditTxn, transferTxn := createTestTransactions()
+	accountID := *debitTxn.FromAccountID
+	cutoff := time.Now().Add(-time.Hour)
+
+	// Completed before the cutoff - must be excluded
+	require.NoError(t, debitTxn.MarkAsCompleted(time.Now()))
+	earlier := cutoff.Add(-time.Hour)
+	debitTxn.CompletedAt = &earlier
+
+	// Completed after the cutoff - must be included
+	require.NoError(t, transferTxn.MarkAsCompleted(time.Now()))
+
+	// Pending and unrelated - must be excluded
+	for _, txn := range []*entity.Transaction{debitTxn, creditTxn, transferTxn} {
+		require.NoError(t, transactionRepo.Create(ctx, txn))
+	}
+
+	transactions, err := transactionRepo.GetCompletedByAccountIDSince(ctx, accountID, cutoff)
+
+	require.NoError(t, err)
+	require.Len(t, transactions, 1)
+	assert.Equal(t, transferTxn.ID.String(), transactions[0].ID.String())
+}
+
+func TestTransactionRepository_GetCompletedByAccountIDBetween(t *testing.T) {
+	db := setupTransactionTestDB(t)
+	transactionRepo := repository.NewTransactionRepository(db)
+	ctx := context.Background()
+
+	accountID := vo.NewAccountID()
+	from := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	until := from.AddDate(0, 1, 0)
+
+	complete := func(transaction *entity.Transaction, completedAt time.Time) *entity.Transaction {
+		require.NoError(t, transaction.MarkAsCompleted(time.Now()))
+		transaction.CompletedAt = &completedAt
+		require.NoError(t, transactionRepo.Create(ctx, transaction))
+		return transaction
+	}
+
+	salary, _ := entity.NewCreditTransaction(accountID, vo.NewMoneyFromFloat(1000), "Salary", "", time.Now())
+	withdrawal, _ := entity.NewDebitTransaction(accountID, vo.NewMoneyFromFloat(100), "ATM", "", time.Now())
+	require.NoError(t, withdrawal.SetFee(vo.NewMoneyFromFloat(5)))
+	incoming, _ := entity.NewTransferTransaction(vo.NewAccountID(), accountID, vo.NewMoneyFromFloat(250), "Refund", "", time.Now())
+	card, _ := entity.NewDebitTransaction(accountID, vo.NewMoneyFromFloat(40), "Card", "", time.Now())
+
+	// Before the range, in it from either side, and after it
+	complete(salary, from.Add(-time.Hour))
+	complete(withdrawal, from)
+	complete(incoming, from.Add(24*time.Hour))
+	complete(card, until)
+
+	// Pending - must be excluded
+	pending, err := entity.NewDebitTransaction(accountID, vo.NewMoneyFromFloat(70), "Card", "", time.Now())
+	require.NoError(t, err)
+	require.NoError(t, transactionRepo.Create(ctx, pending))
+
+	transactions, err := transactionRepo.GetCompletedByAccountIDBetween(ctx, accountID, from, until)
+
+	require.NoError(t, err)
+	require.Len(t, transactions, 2)
+	assert.Equal(t, withdrawal.ID.String(), transactions[0].ID.String())
+	assert.Equal(t, incoming.ID.String(), transactions[1].ID.String())
+
+	// -105 + 250 - 40 from the start of the range on
+	total, err := transactionRepo.SumBalanceEffectSince(ctx, accountID, from)
+	require.NoError(t, err)
+	assert.True(t, total.Equal(vo.NewMoneyFromFloat(105)), "total %s", total)
+
+	total, err = transactionRepo.SumBalanceEffectSince(ctx, vo.NewAccountID(), from)
+	require.NoError(t, err)
+	assert.True(t, total.IsZero())
+}
+
+func TestTransactionRepository_GetCompletedAdjustments(t *testing.T) {
+	db := setupTransactionTestDB(t)
+	transactionRepo := repository.NewTransactionRepository(db)
+	ctx := context.Background()
+
+	newAdjustment := func(valueDate time.Time, completed bool) *entity.Transaction {
+		adjustment, err := entity.NewAdjustmentTransaction(vo.NewAccountID(), vo.AdjustmentDirectionIncrease,
+			vo.NewMoneyFromFloat(10), vo.AdjustmentReasonGoodwill, "Goodwill", "alice", time.Now())
+		require.NoError(t, err)
+		adjustment.ValueDate = valueDate
+		if completed {
+			require.NoError(t, adjustment.MarkAsCompleted(time.Now()))
+		}
+		require.NoError(t, transactionRepo.Create(ctx, adjustment))
+		return adjustment
+	}
+	from := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	until := time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)
+
+	inRange := newAdjustment(time.Date(2024, 3, 31, 0, 0, 0, 0, time.UTC), true)
+	newAdjustment(time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC), false) // Awaiting approval
+	newAdjustment(until, true)                                         // Next period
+
+	// A completed transaction of another type
+	credit, err := entity.NewCreditTransaction(vo.NewAccountID(), vo.NewMoneyFromFloat(10), "Deposit", "", time.Now())
+	require.NoError(t, err)
+	credit.ValueDate = from
+	require.NoError(t, credit.MarkAsCompleted(time.Now()))
+	require.NoError(t, transactionRepo.Create(ctx, credit))
+
+	adjustments, err := transactionRepo.GetCompletedAdjustments(ctx, from, until)
+
+	require.NoError(t, err)
+	require.Len(t, adjustments, 1)
+	assert.Equal(t, inRange.ID.String(), adjustments[0].ID.String())
+	assert.Equal(t, vo.AdjustmentReasonGoodwill, adjustments[0].AdjustmentReason)
+	assert.Equal(t, "alice", adjustments[0].RequestedBy)
+}
+
+func TestTransactionRepository_GetByReversedTransactionID(t *testing.T) {
+	db := setupTransactionTestDB(t)
+	transactionRepo := repository.NewTransactionRepository(db)
+	ctx := context.Background()
+
+	debitTxn, creditTxn, _ := createTestTransactions()
+	for _, txn := range []*entity.Transaction{debitTxn, creditTxn} {
+		require.NoError(t, txn.MarkAsCompleted(time.Now()))
+		require.NoError(t, transactionRepo.Create(ctx, txn))
+	}
+	reversal, err := entity.NewReversalTransaction(debitTxn, time.Now())
+	require.NoError(t, err)
+	require.NoError(t, transactionRepo.Create(ctx, reversal))
+
+	reversals, err := transactionRepo.GetByReversedTransactionID(ctx, debitTxn.ID)
+
+	require.NoError(t, err)
+	require.Len(t, reversals, 1)
+	assert.Equal(t, reversal.ID.String(), reversals[0].ID.String())
+	assert.Equal(t, vo.TransactionTypeReversal, reversals[0].TransactionType)
+	assert.Equal(t, debitTxn.ID.String(), reversals[0].ReversedTransactionID.String())
+
+	// A transaction nothing reversed
+	reversals, err = transactionRepo.GetByReversedTransactionID(ctx, creditTxn.ID)
+	require.NoError(t, err)
+	assert.Empty(t, reversals)
+}
+
+func TestTransactionRepository_Count(t *testing.T) {
+	db := setupTransactionTestDB(t)
+	transactionRepo := repository.NewTransactionRepository(db)
+	ctx := context.Background()
+
+	debitTxn, creditTxn, transferTxn := createTestTransactions()
+	require.NoError(t, transferTxn.MarkAsCompleted(time.Now()))
+	for _, txn := range []*entity.Transaction{debitTxn, creditTxn, transferTxn} {
+		require.NoError(t, transactionRepo.Create(ctx, txn))
+	}
+
+	total, err := transactionRepo.Count(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), total)
+
+	// The transfer touches both accounts
+	byAccount, err := transactionRepo.CountByAccountID(ctx, *creditTxn.ToAccountID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), byAccount)
+
+	pending, err := transactionRepo.CountByStatus(ctx, vo.TransactionStatusPending)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), pending)
+
+	unknown, err := transactionRepo.CountByAccountID(ctx, vo.NewAccountID())
+	require.NoError(t, err)
+	assert.Zero(t, unknown)
+}
+
+func TestTransactionRepository_GetOverdueReviews(t *testing.T) {
+	db := setupTransactionTestDB(t)
+	transactionRepo := repository.NewTransactionRepository(db)
+	ctx := context.Background()
+	now := time.Now()
+
+	overdue, dueLater, pending := createTestTransactions()
+	require.NoError(t, overdue.PlaceInReview("large amount", now.Add(-time.Minute), time.Now()))
+	require.NoError(t, dueLater.PlaceInReview("large amount", now.Add(time.Hour), time.Now()))
+	for _, txn := range []*entity.Transaction{overdue, dueLater, pending} {
+		require.NoError(t, transactionRepo.Create(ctx, txn))
+	}
+
+	transactions, err := transactionRepo.GetOverdueReviews(ctx, now, 10)
+	require.NoError(t, err)
+	require.Len(t, transactions, 1)
+	assert.Equal(t, overdue.ID, transactions[0].ID)
+	assert.Equal(t, "large amount", transactions[0].ReviewReason)
+	require.NotNil(t, transactions[0].ReviewDueAt)
+
+	// Declined reviews are no longer overdue
+	require.NoError(t, overdue.DeclineReview("system", ""))
+	require.NoError(t, transactionRepo.Update(ctx, overdue))
+
+	transactions, err = transactionRepo.GetOverdueReviews(ctx, now.Add(2*time.Hour), 10)
+	require.NoError(t, err)
+	require.Len(t, transactions, 1)
+	assert.Equal(t, dueLater.ID, transactions[0].ID)
+}
+
+func TestTransactionRepository_GetByVirtualAccountID(t *testing.T) {
+	db := setupTransactionTestDB(t)
+	transactionRepo := repository.NewTransactionRepository(db)
+	ctx := context.Background()
+
+	settlementID := vo.NewAccountID()
+	virtualAccount, err := entity.NewVirtualAccount(settlementID, "Tenant 101")
+	require.NoError(t, err)
+
+	paid, err := entity.NewCreditTransaction(settlementID, vo.NewMoneyFromFloat(1200), "Rent", "INV-1", time.Now())
+	require.NoError(t, err)
+	paid.VirtualAccountID = virtualAccount.ID
+	direct, err := entity.NewCreditTransaction(settlementID, vo.NewMoneyFromFloat(50), "Direct", "", time.Now())
+	require.NoError(t, err)
+	for _, txn := range []*entity.Transaction{paid, direct} {
+		require.NoError(t, transactionRepo.Create(ctx, txn))
+	}
+
+	transactions, err := transactionRepo.GetByVirtualAccountID(ctx, virtualAccount.ID, 10, 0)
+	require.NoError(t, err)
+	require.Len(t, transactions, 1)
+	assert.Equal(t, paid.ID, transactions[0].ID)
+	assert.Equal(t, virtualAccount.ID, transactions[0].VirtualAccountID)
+
+	count, err := transactionRepo.CountByVirtualAccountID(ctx, virtualAccount.ID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+}
+
+func TestTransactionRepository_GetByChannel(t *testing.T) {
+	db := setupTransactionTestDB(t)
+	transactionRepo := repository.NewTransactionRepository(db)
+	ctx := context.Background()
+
+	accountID := vo.NewAccountID()
+	atm, err := entity.NewDebitTransaction(accountID, vo.NewMoneyFromFloat(500), "Cash withdrawal", "", time.Now())
+	require.NoError(t, err)
+	require.NoError(t, atm.SetChannel(vo.TransactionChannelATM))
+	api, err := entity.NewCreditTransaction(accountID, vo.NewMoneyFromFloat(50), "Top up", "", time.Now())
+	require.NoError(t, err)
+	for _, txn := range []*entity.Transaction{atm, api} {
+		require.NoError(t, transactionRepo.Create(ctx, txn))
+	}
+	// Rows written before channel tagging count as API
+	legacy, err := entity.NewCreditTransaction(accountID, vo.NewMoneyFromFloat(10), "Legacy", "", time.Now())
+	require.NoError(t, err)
+	require.NoError(t, transactionRepo.Create(ctx, legacy))
+	require.NoError(t, db.Model(&model.Transaction{}).Where("transaction_id = ?", legacy.ID.String()).Update("channel", "").Error)
+
+	transactions, err := transactionRepo.GetByChannel(ctx, vo.TransactionChannelATM, 10, 0)
+	require.NoError(t, err)
+	require.Len(t, transactions, 1)
+	assert.Equal(t, atm.ID, transactions[0].ID)
+	assert.Equal(t, vo.TransactionChannelATM, transactions[0].Channel)
+
+	count, err := transactionRepo.CountByChannel(ctx, vo.TransactionChannelAPI)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), count)
+
+	transactions, err = transactionRepo.GetByChannel(ctx, vo.TransactionChannelAPI, 10, 0)
+	require.NoError(t, err)
+	require.Len(t, transactions, 2)
+	for _, txn := range transactions {
+		assert.Equal(t, vo.TransactionChannelAPI, txn.Channel)
+	}
+}
+
+func TestTransactionRepository_GetFailedByKind(t *testing.T) {
+	db := setupTransactionTestDB(t)
+	transactionRepo := repository.NewTransactionRepository(db)
+	ctx := context.Background()
+
+	infrastructure, business, pending := createTestTransactions()
+	require.NoError(t, infrastructure.Fail(vo.FailureKindInfrastructure, "connection reset by peer"))
+	require.NoError(t, business.Fail(vo.FailureKindBusiness, "insufficient balance"))
+	for _, txn := range []*entity.Transaction{infrastructure, business, pending} {
+		require.NoError(t, transactionRepo.Create(ctx, txn))
+	}
+
+	transactions, err := transactionRepo.GetFailedByKind(ctx, vo.FailureKindInfrastructure, 10, 0)
+	require.NoError(t, err)
+	require.Len(t, transactions, 1)
+	assert.Equal(t, infrastructure.ID.String(), transactions[0].ID.String())
+	assert.Equal(t, vo.FailureKindInfrastructure, transactions[0].FailureKind)
+	assert.Equal(t, "connection reset by peer", transactions[0].FailureReason)
+
+	count, err := transactionRepo.CountFailedByKind(ctx, vo.FailureKindBusiness)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+
+	// A replayed transaction leaves the list
+	require.NoError(t, infrastructure.PrepareReplay())
+	require.NoError(t, transactionRepo.Update(ctx, infrastructure))
+
+	count, err = transactionRepo.CountFailedByKind(ctx, vo.FailureKindInfrastructure)
+	require.NoError(t, err)
+	assert.Zero(t, count)
+
+	replayed, err := transactionRepo.GetByID(ctx, infrastructure.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 1, replayed.ReplayCount)
+	assert.Empty(t, replayed.FailureKind)
+}
@@ -0,0 +1,179 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hydr0g3nz/mini_bank/internal/adapter/repository/gorm/model"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/repository"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"gorm.io/gorm"
+)
+
+type TransactionTagRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewTransactionTagRepository creates a new instance of TransactionTagRepositoryImpl
+func NewTransactionTagRepository(db *gorm.DB) repository.TransactionTagRepository {
+	return &TransactionTagRepositoryImpl{db: db}
+}
+
+// ReplaceTags replaces an account's tags of a transaction in one transaction
+func (r *TransactionTagRepositoryImpl) ReplaceTags(ctx context.Context, accountID vo.AccountID, transactionID vo.TransactionID, tags []*entity.TransactionTag) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Where("account_id = ? AND transaction_id = ?", accountID.String(), transactionID.String()).
+			Delete(&model.TransactionTag{}).Error
+		if err != nil {
+			return err
+		}
+		if len(tags) == 0 {
+			return nil
+		}
+
+		tagModels := make([]*model.TransactionTag, len(tags))
+		for i, tag := range tags {
+			tagModels[i] = model.FromDomainTransactionTag(tag)
+		}
+		return tx.Create(tagModels).Error
+	})
+}
+
+// ListByTransactionIDs retrieves an account's tags of the given transactions
+func (r *TransactionTagRepositoryImpl) ListByTransactionIDs(ctx context.Context, accountID vo.AccountID, transactionIDs []vo.TransactionID) ([]*entity.TransactionTag, error) {
+	if len(transactionIDs) == 0 {
+		return nil, nil
+	}
+
+	ids := make([]string, len(transactionIDs))
+	for i, id := range transactionIDs {
+		ids[i] = id.String()
+	}
+
+	var tagModels []model.TransactionTag
+	err := r.db.WithContext(ctx).
+		Where("account_id = ? AND transaction_id IN ?", accountID.String(), ids).
+		Order("transaction_id ASC, tag ASC").
+		Find(&tagModels).Error
+	if err != nil {
+		return nil, err
+	}
+
+	tags := make([]*entity.TransactionTag, len(tagModels))
+	for i, tagModel := range tagModels {
+		tag, err := tagModel.ToDomainTransactionTag()
+		if err != nil {
+			return nil, err
+		}
+		tags[i] = tag
+	}
+
+	return tags, nil
+}
+
+type SavedFilterRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewSavedFilterRepository creates a new instance of SavedFilterRepositoryImpl
+func NewSavedFilterRepository(db *gorm.DB) repository.SavedFilterRepository {
+	return &SavedFilterRepositoryImpl{db: db}
+}
+
+// Create creates a new saved filter
+func (r *SavedFilterRepositoryImpl) Create(ctx context.Context, filter *entity.SavedFilter) error {
+	var count int64
+	err := r.db.WithContext(ctx).
+		Model(&model.SavedFilter{}).
+		Where("account_id = ? AND name = ?", filter.AccountID.String(), filter.Name).
+		Count(&count).Error
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		return errs.ErrSavedFilterAlreadyExists
+	}
+
+	if err := r.db.WithContext(ctx).Create(model.FromDomainSavedFilter(filter)).Error; err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return errs.ErrSavedFilterAlreadyExists
+		}
+		return err
+	}
+
+	return nil
+}
+
+// GetByID retrieves a saved filter by ID
+func (r *SavedFilterRepositoryImpl) GetByID(ctx context.Context, id string) (*entity.SavedFilter, error) {
+	var filterModel model.SavedFilter
+
+	err := r.db.WithContext(ctx).
+		Where("filter_id = ?", id).
+		First(&filterModel).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.ErrSavedFilterNotFound
+		}
+		return nil, err
+	}
+
+	return filterModel.ToDomainSavedFilter()
+}
+
+// Delete removes a saved filter
+func (r *SavedFilterRepositoryImpl) Delete(ctx context.Context, id string) error {
+	// Hard delete so the name can be saved again
+	result := r.db.WithContext(ctx).
+		Unscoped().
+		Where("filter_id = ?", id).
+		Delete(&model.SavedFilter{})
+
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return errs.ErrSavedFilterNotFound
+	}
+
+	return nil
+}
+
+// ListByAccountID retrieves the saved filters of an account by name
+func (r *SavedFilterRepositoryImpl) ListByAccountID(ctx context.Context, accountID vo.AccountID) ([]*entity.SavedFilter, error) {
+	var filterModels []model.SavedFilter
+
+	err := r.db.WithContext(ctx).
+		Where("account_id = ?", accountID.String()).
+		Order("name ASC").
+		Find(&filterModels).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	filters := make([]*entity.SavedFilter, len(filterModels))
+	for i, filterModel := range filterModels {
+		filter, err := filterModel.ToDomainSavedFilter()
+		if err != nil {
+			return nil, err
+		}
+		filters[i] = filter
+	}
+
+	return filters, nil
+}
+
+// CountByAccountID returns the number of filters an account saved
+func (r *SavedFilterRepositoryImpl) CountByAccountID(ctx context.Context, accountID vo.AccountID) (int64, error) {
+	var count int64
+	err := r.db.WithContext(ctx).
+		Model(&model.SavedFilter{}).
+		Where("account_id = ?", accountID.String()).
+		Count(&count).Error
+	return count, err
+}
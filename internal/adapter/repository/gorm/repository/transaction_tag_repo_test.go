@@ -0,0 +1,99 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hydr0g3nz/mini_bank/internal/adapter/repository/gorm/model"
+	"github.com/hydr0g3nz/mini_bank/internal/adapter/repository/gorm/repository"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransactionTagRepository(t *testing.T) {
+	db := setupTestDB(t)
+	require.NoError(t, db.AutoMigrate(&model.TransactionTag{}))
+
+	repo := repository.NewTransactionTagRepository(db)
+	ctx := context.Background()
+	accountID := vo.NewAccountID()
+	rentID, dinnerID := vo.NewTransactionID(), vo.NewTransactionID()
+
+	tags, err := entity.NewTransactionTags(accountID, rentID, []string{"home", "monthly"})
+	require.NoError(t, err)
+	require.NoError(t, repo.ReplaceTags(ctx, accountID, rentID, tags))
+
+	tags, err = entity.NewTransactionTags(accountID, dinnerID, []string{"food"})
+	require.NoError(t, err)
+	require.NoError(t, repo.ReplaceTags(ctx, accountID, dinnerID, tags))
+
+	// Replacing drops the tags left out
+	tags, err = entity.NewTransactionTags(accountID, rentID, []string{"home"})
+	require.NoError(t, err)
+	require.NoError(t, repo.ReplaceTags(ctx, accountID, rentID, tags))
+
+	found, err := repo.ListByTransactionIDs(ctx, accountID, []vo.TransactionID{rentID, dinnerID})
+	require.NoError(t, err)
+	require.Len(t, found, 2)
+
+	// No tags clears them
+	require.NoError(t, repo.ReplaceTags(ctx, accountID, dinnerID, nil))
+	found, err = repo.ListByTransactionIDs(ctx, accountID, []vo.TransactionID{rentID, dinnerID})
+	require.NoError(t, err)
+	require.Len(t, found, 1)
+	assert.Equal(t, rentID, found[0].TransactionID)
+	assert.Equal(t, "home", found[0].Tag)
+
+	// Tags belong to the account that set them
+	found, err = repo.ListByTransactionIDs(ctx, vo.NewAccountID(), []vo.TransactionID{rentID})
+	require.NoError(t, err)
+	assert.Empty(t, found)
+}
+
+func TestSavedFilterRepository(t *testing.T) {
+	db := setupTestDB(t)
+	require.NoError(t, db.AutoMigrate(&model.SavedFilter{}))
+
+	repo := repository.NewSavedFilterRepository(db)
+	ctx := context.Background()
+	accountID := vo.NewAccountID()
+
+	trips, err := entity.NewSavedFilter(accountID, "Trips", []string{"travel", "hotel"}, entity.HistoryDirectionOut, "")
+	require.NoError(t, err)
+	require.NoError(t, repo.Create(ctx, trips))
+
+	deposits, err := entity.NewSavedFilter(accountID, "Deposits", nil, "", vo.TransactionTypeCredit)
+	require.NoError(t, err)
+	require.NoError(t, repo.Create(ctx, deposits))
+
+	duplicate, err := entity.NewSavedFilter(accountID, "Trips", nil, "", "")
+	require.NoError(t, err)
+	assert.ErrorIs(t, repo.Create(ctx, duplicate), errs.ErrSavedFilterAlreadyExists)
+
+	found, err := repo.GetByID(ctx, trips.ID)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"travel", "hotel"}, found.Tags)
+	assert.Equal(t, entity.HistoryDirectionOut, found.Direction)
+
+	// Listed by name
+	filters, err := repo.ListByAccountID(ctx, accountID)
+	require.NoError(t, err)
+	require.Len(t, filters, 2)
+	assert.Equal(t, "Deposits", filters[0].Name)
+	assert.Empty(t, filters[0].Tags)
+	assert.Equal(t, vo.TransactionTypeCredit, filters[0].TransactionType)
+
+	count, err := repo.CountByAccountID(ctx, accountID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), count)
+
+	// Deleting frees the name
+	require.NoError(t, repo.Delete(ctx, trips.ID))
+	_, err = repo.GetByID(ctx, trips.ID)
+	assert.ErrorIs(t, err, errs.ErrSavedFilterNotFound)
+	assert.ErrorIs(t, repo.Delete(ctx, trips.ID), errs.ErrSavedFilterNotFound)
+	require.NoError(t, repo.Create(ctx, duplicate))
+}
@@ -0,0 +1,106 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hydr0g3nz/mini_bank/internal/adapter/repository/gorm/model"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/repository"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"gorm.io/gorm"
+)
+
+type TransferTemplateRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewTransferTemplateRepository creates a new instance of TransferTemplateRepositoryImpl
+func NewTransferTemplateRepository(db *gorm.DB) repository.TransferTemplateRepository {
+	return &TransferTemplateRepositoryImpl{db: db}
+}
+
+// Create creates a new transfer template
+func (r *TransferTemplateRepositoryImpl) Create(ctx context.Context, template *entity.TransferTemplate) error {
+	return r.db.WithContext(ctx).Create(model.FromDomainTransferTemplate(template)).Error
+}
+
+// GetByID retrieves a transfer template by ID
+func (r *TransferTemplateRepositoryImpl) GetByID(ctx context.Context, id string) (*entity.TransferTemplate, error) {
+	var templateModel model.TransferTemplate
+
+	err := r.db.WithContext(ctx).
+		Where("template_id = ?", id).
+		First(&templateModel).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.ErrTransferTemplateNotFound
+		}
+		return nil, err
+	}
+
+	return templateModel.ToDomainTransferTemplate()
+}
+
+// Update updates an existing transfer template
+func (r *TransferTemplateRepositoryImpl) Update(ctx context.Context, template *entity.TransferTemplate) error {
+	var existingModel model.TransferTemplate
+
+	err := r.db.WithContext(ctx).
+		Where("template_id = ?", template.ID).
+		First(&existingModel).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errs.ErrTransferTemplateNotFound
+		}
+		return err
+	}
+
+	existingModel.UpdateFromDomain(template)
+	return r.db.WithContext(ctx).Save(&existingModel).Error
+}
+
+// Delete removes a transfer template
+func (r *TransferTemplateRepositoryImpl) Delete(ctx context.Context, id string) error {
+	result := r.db.WithContext(ctx).
+		Where("template_id = ?", id).
+		Delete(&model.TransferTemplate{})
+
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return errs.ErrTransferTemplateNotFound
+	}
+
+	return nil
+}
+
+// ListByAccountID retrieves the transfer templates of an account by name
+func (r *TransferTemplateRepositoryImpl) ListByAccountID(ctx context.Context, accountID vo.AccountID) ([]*entity.TransferTemplate, error) {
+	var templateModels []model.TransferTemplate
+
+	err := r.db.WithContext(ctx).
+		Where("account_id = ?", accountID.String()).
+		Order("name ASC").
+		Find(&templateModels).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	templates := make([]*entity.TransferTemplate, len(templateModels))
+	for i, templateModel := range templateModels {
+		template, err := templateModel.ToDomainTransferTemplate()
+		if err != nil {
+			return nil, err
+		}
+		templates[i] = template
+	}
+
+	return templates, nil
+}
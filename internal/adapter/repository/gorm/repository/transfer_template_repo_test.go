@@ -0,0 +1,60 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/adapter/repository/gorm/model"
+	"github.com/hydr0g3nz/mini_bank/internal/adapter/repository/gorm/repository"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransferTemplateRepository(t *testing.T) {
+	db := setupTestDB(t)
+	require.NoError(t, db.AutoMigrate(&model.TransferTemplate{}))
+
+	repo := repository.NewTransferTemplateRepository(db)
+	ctx := context.Background()
+
+	from := vo.NewAccountID()
+	to := vo.NewAccountID()
+
+	rent, err := entity.NewTransferTemplate(from, "Rent", to, vo.NewMoneyFromFloat(500), "Monthly rent", "")
+	require.NoError(t, err)
+	require.NoError(t, repo.Create(ctx, rent))
+
+	allowance, err := entity.NewTransferTemplate(from, "Allowance", to, vo.NewMoneyFromFloat(50.25), "", "WEEKLY")
+	require.NoError(t, err)
+	require.NoError(t, repo.Create(ctx, allowance))
+
+	found, err := repo.GetByID(ctx, rent.ID)
+	require.NoError(t, err)
+	assert.Equal(t, to, found.ToAccountID)
+	assert.True(t, found.Amount.Equal(vo.NewMoneyFromFloat(500)))
+	assert.Nil(t, found.LastUsedAt)
+
+	found.RecordUse(time.Now())
+	require.NoError(t, repo.Update(ctx, found))
+
+	found, err = repo.GetByID(ctx, rent.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 1, found.UseCount)
+	assert.NotNil(t, found.LastUsedAt)
+
+	// Listed by name
+	templates, err := repo.ListByAccountID(ctx, from)
+	require.NoError(t, err)
+	require.Len(t, templates, 2)
+	assert.Equal(t, "Allowance", templates[0].Name)
+	assert.Equal(t, "WEEKLY", templates[0].Reference)
+
+	require.NoError(t, repo.Delete(ctx, rent.ID))
+	_, err = repo.GetByID(ctx, rent.ID)
+	assert.ErrorIs(t, err, errs.ErrTransferTemplateNotFound)
+	assert.ErrorIs(t, repo.Delete(ctx, rent.ID), errs.ErrTransferTemplateNotFound)
+}
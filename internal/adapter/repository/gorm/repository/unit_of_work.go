@@ -0,0 +1,42 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/hydr0g3nz/mini_bank/internal/domain/repository"
+	"gorm.io/gorm"
+)
+
+type UnitOfWorkImpl struct {
+	db *gorm.DB
+}
+
+// NewUnitOfWork creates a unit of work running in database transactions of db
+func NewUnitOfWork(db *gorm.DB) repository.UnitOfWork {
+	return &UnitOfWorkImpl{db: db}
+}
+
+// Do runs fn in a database transaction, or in the one ctx already joins
+func (u *UnitOfWorkImpl) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	if inUnitOfWork(ctx) {
+		return fn(ctx)
+	}
+
+	return u.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return fn(repository.WithUnitOfWork(ctx, tx))
+	})
+}
+
+// conn returns the database transaction of the unit of work ctx runs in, or db outside one
+func conn(ctx context.Context, db *gorm.DB) *gorm.DB {
+	if tx, ok := repository.UnitOfWorkFromContext(ctx).(*gorm.DB); ok {
+		return tx.WithContext(ctx)
+	}
+	return db.WithContext(ctx)
+}
+
+// inUnitOfWork checks whether the repository calls of ctx join a database transaction
+func inUnitOfWork(ctx context.Context) bool {
+	_, ok := repository.UnitOfWorkFromContext(ctx).(*gorm.DB)
+	return ok
+}
@@ -0,0 +1,58 @@
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hydr0g3nz/mini_bank/internal/adapter/repository/gorm/repository"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnitOfWork_Do(t *testing.T) {
+	tests := []struct {
+		name            string
+		fail            error
+		expectedBalance float64
+	}{
+		{name: "commits when every call succeeds", expectedBalance: 900.50},
+		{name: "rolls back every call when one fails", fail: errors.New("credit failed"), expectedBalance: 1000.50},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := setupTestDB(t)
+			accounts := repository.NewAccountRepository(db)
+			units := repository.NewUnitOfWork(db)
+			ctx := context.Background()
+
+			account := createTestAccount()
+			require.NoError(t, accounts.Create(ctx, account))
+
+			err := units.Do(ctx, func(ctx context.Context) error {
+				loaded, err := accounts.GetByID(ctx, account.ID)
+				if err != nil {
+					return err
+				}
+				if err := loaded.Debit(vo.NewMoneyFromFloat(100)); err != nil {
+					return err
+				}
+				if err := accounts.Update(ctx, loaded); err != nil {
+					return err
+				}
+
+				// A nested unit joins the outer one
+				return units.Do(ctx, func(ctx context.Context) error {
+					return tt.fail
+				})
+			})
+
+			assert.ErrorIs(t, err, tt.fail)
+			stored, err := accounts.GetByID(ctx, account.ID)
+			require.NoError(t, err)
+			assert.True(t, stored.Balance.Equal(vo.NewMoneyFromFloat(tt.expectedBalance)), "balance %s", stored.Balance)
+		})
+	}
+}
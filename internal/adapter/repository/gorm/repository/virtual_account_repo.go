@@ -0,0 +1,119 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hydr0g3nz/mini_bank/internal/adapter/repository/gorm/model"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/repository"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"gorm.io/gorm"
+)
+
+type VirtualAccountRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewVirtualAccountRepository creates a new instance of VirtualAccountRepositoryImpl
+func NewVirtualAccountRepository(db *gorm.DB) repository.VirtualAccountRepository {
+	return &VirtualAccountRepositoryImpl{db: db}
+}
+
+// Create creates a new virtual account
+func (r *VirtualAccountRepositoryImpl) Create(ctx context.Context, virtualAccount *entity.VirtualAccount) error {
+	return r.db.WithContext(ctx).Create(model.FromDomainVirtualAccount(virtualAccount)).Error
+}
+
+// GetByID retrieves a virtual account by its virtual number
+func (r *VirtualAccountRepositoryImpl) GetByID(ctx context.Context, id string) (*entity.VirtualAccount, error) {
+	var virtualAccountModel model.VirtualAccount
+
+	err := r.db.WithContext(ctx).
+		Where("virtual_account_id = ?", id).
+		First(&virtualAccountModel).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.ErrVirtualAccountNotFound
+		}
+		return nil, err
+	}
+
+	return virtualAccountModel.ToDomainVirtualAccount()
+}
+
+// Update updates an existing virtual account
+func (r *VirtualAccountRepositoryImpl) Update(ctx context.Context, virtualAccount *entity.VirtualAccount) error {
+	var existingModel model.VirtualAccount
+
+	err := r.db.WithContext(ctx).
+		Where("virtual_account_id = ?", virtualAccount.ID).
+		First(&existingModel).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errs.ErrVirtualAccountNotFound
+		}
+		return err
+	}
+
+	existingModel.UpdateFromDomain(virtualAccount)
+	return r.db.WithContext(ctx).Save(&existingModel).Error
+}
+
+// Delete removes a virtual account
+func (r *VirtualAccountRepositoryImpl) Delete(ctx context.Context, id string) error {
+	result := r.db.WithContext(ctx).
+		Unscoped().
+		Where("virtual_account_id = ?", id).
+		Delete(&model.VirtualAccount{})
+
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return errs.ErrVirtualAccountNotFound
+	}
+
+	return nil
+}
+
+// ListByAccountID retrieves the virtual accounts of a settlement account, oldest first
+func (r *VirtualAccountRepositoryImpl) ListByAccountID(ctx context.Context, accountID vo.AccountID, limit, offset int) ([]*entity.VirtualAccount, error) {
+	var virtualAccountModels []model.VirtualAccount
+
+	err := r.db.WithContext(ctx).
+		Where("account_id = ?", accountID.String()).
+		Order("created_at ASC").
+		Limit(limit).
+		Offset(offset).
+		Find(&virtualAccountModels).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	virtualAccounts := make([]*entity.VirtualAccount, len(virtualAccountModels))
+	for i, virtualAccountModel := range virtualAccountModels {
+		virtualAccount, err := virtualAccountModel.ToDomainVirtualAccount()
+		if err != nil {
+			return nil, err
+		}
+		virtualAccounts[i] = virtualAccount
+	}
+
+	return virtualAccounts, nil
+}
+
+// CountByAccountID returns the number of virtual accounts of a settlement account
+func (r *VirtualAccountRepositoryImpl) CountByAccountID(ctx context.Context, accountID vo.AccountID) (int64, error) {
+	var count int64
+	err := r.db.WithContext(ctx).
+		Model(&model.VirtualAccount{}).
+		Where("account_id = ?", accountID.String()).
+		Count(&count).Error
+	return count, err
+}
@@ -0,0 +1,69 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hydr0g3nz/mini_bank/internal/adapter/repository/gorm/model"
+	"github.com/hydr0g3nz/mini_bank/internal/adapter/repository/gorm/repository"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVirtualAccountRepository(t *testing.T) {
+	db := setupTestDB(t)
+	require.NoError(t, db.AutoMigrate(&model.VirtualAccount{}))
+
+	repo := repository.NewVirtualAccountRepository(db)
+	ctx := context.Background()
+	accountID := vo.NewAccountID()
+
+	first, err := entity.NewVirtualAccount(accountID, "Tenant 101")
+	require.NoError(t, err)
+	require.NoError(t, repo.Create(ctx, first))
+
+	second, err := entity.NewVirtualAccount(accountID, "Tenant 102")
+	require.NoError(t, err)
+	require.NoError(t, repo.Create(ctx, second))
+
+	other, err := entity.NewVirtualAccount(vo.NewAccountID(), "")
+	require.NoError(t, err)
+	require.NoError(t, repo.Create(ctx, other))
+
+	found, err := repo.GetByID(ctx, first.ID)
+	require.NoError(t, err)
+	assert.Equal(t, accountID, found.AccountID)
+	assert.Equal(t, "Tenant 101", found.Label)
+	assert.True(t, found.CanReceive())
+
+	virtualAccounts, err := repo.ListByAccountID(ctx, accountID, 10, 0)
+	require.NoError(t, err)
+	require.Len(t, virtualAccounts, 2)
+	assert.Equal(t, first.ID, virtualAccounts[0].ID)
+
+	virtualAccounts, err = repo.ListByAccountID(ctx, accountID, 1, 1)
+	require.NoError(t, err)
+	require.Len(t, virtualAccounts, 1)
+	assert.Equal(t, second.ID, virtualAccounts[0].ID)
+
+	count, err := repo.CountByAccountID(ctx, accountID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), count)
+
+	// Closing is persisted
+	require.NoError(t, found.SetStatus(entity.VirtualAccountStatusClosed))
+	require.NoError(t, repo.Update(ctx, found))
+
+	found, err = repo.GetByID(ctx, first.ID)
+	require.NoError(t, err)
+	assert.False(t, found.CanReceive())
+
+	require.NoError(t, repo.Delete(ctx, second.ID))
+	assert.ErrorIs(t, repo.Delete(ctx, second.ID), errs.ErrVirtualAccountNotFound)
+
+	_, err = repo.GetByID(ctx, second.ID)
+	assert.ErrorIs(t, err, errs.ErrVirtualAccountNotFound)
+}
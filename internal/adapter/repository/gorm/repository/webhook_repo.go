@@ -0,0 +1,107 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hydr0g3nz/mini_bank/internal/adapter/repository/gorm/model"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/repository"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"gorm.io/gorm"
+)
+
+type WebhookRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewWebhookRepository creates a new instance of WebhookRepositoryImpl
+func NewWebhookRepository(db *gorm.DB) repository.WebhookRepository {
+	return &WebhookRepositoryImpl{db: db}
+}
+
+// Create creates a new webhook subscription
+func (r *WebhookRepositoryImpl) Create(ctx context.Context, webhook *entity.WebhookSubscription) error {
+	return r.db.WithContext(ctx).Create(model.FromDomainWebhook(webhook)).Error
+}
+
+// GetByID retrieves a webhook subscription by ID
+func (r *WebhookRepositoryImpl) GetByID(ctx context.Context, id string) (*entity.WebhookSubscription, error) {
+	var webhookModel model.WebhookSubscription
+
+	err := r.db.WithContext(ctx).
+		Where("webhook_id = ?", id).
+		First(&webhookModel).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.ErrWebhookNotFound
+		}
+		return nil, err
+	}
+
+	return webhookModel.ToDomainWebhook()
+}
+
+// Update updates an existing webhook subscription
+func (r *WebhookRepositoryImpl) Update(ctx context.Context, webhook *entity.WebhookSubscription) error {
+	var existingModel model.WebhookSubscription
+
+	err := r.db.WithContext(ctx).
+		Where("webhook_id = ?", webhook.ID).
+		First(&existingModel).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errs.ErrWebhookNotFound
+		}
+		return err
+	}
+
+	existingModel.UpdateFromDomain(webhook)
+	return r.db.WithContext(ctx).Save(&existingModel).Error
+}
+
+// Delete removes a webhook subscription
+func (r *WebhookRepositoryImpl) Delete(ctx context.Context, id string) error {
+	result := r.db.WithContext(ctx).
+		Unscoped().
+		Where("webhook_id = ?", id).
+		Delete(&model.WebhookSubscription{})
+
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return errs.ErrWebhookNotFound
+	}
+
+	return nil
+}
+
+// ListByAccountID retrieves the webhook subscriptions of an account, oldest first
+func (r *WebhookRepositoryImpl) ListByAccountID(ctx context.Context, accountID vo.AccountID) ([]*entity.WebhookSubscription, error) {
+	var webhookModels []model.WebhookSubscription
+
+	err := r.db.WithContext(ctx).
+		Where("account_id = ?", accountID.String()).
+		Order("created_at ASC").
+		Find(&webhookModels).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	webhooks := make([]*entity.WebhookSubscription, len(webhookModels))
+	for i, webhookModel := range webhookModels {
+		webhook, err := webhookModel.ToDomainWebhook()
+		if err != nil {
+			return nil, err
+		}
+		webhooks[i] = webhook
+	}
+
+	return webhooks, nil
+}
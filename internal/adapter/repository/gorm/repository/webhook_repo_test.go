@@ -0,0 +1,69 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hydr0g3nz/mini_bank/internal/adapter/repository/gorm/model"
+	"github.com/hydr0g3nz/mini_bank/internal/adapter/repository/gorm/repository"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebhookRepository(t *testing.T) {
+	db := setupTestDB(t)
+	require.NoError(t, db.AutoMigrate(&model.WebhookSubscription{}))
+
+	repo := repository.NewWebhookRepository(db)
+	ctx := context.Background()
+	accountID := vo.NewAccountID()
+	threshold := vo.NewMoneyFromFloat(250.75)
+
+	lowBalance, err := entity.NewWebhookSubscription(accountID, "https://example.com/low", []entity.WebhookEvent{
+		entity.WebhookEventBalanceBelowThreshold,
+		entity.WebhookEventCreditReceived,
+	}, &threshold)
+	require.NoError(t, err)
+	require.NoError(t, repo.Create(ctx, lowBalance))
+
+	credits, err := entity.NewWebhookSubscription(accountID, "https://example.com/credits", []entity.WebhookEvent{entity.WebhookEventCreditReceived}, nil)
+	require.NoError(t, err)
+	require.NoError(t, repo.Create(ctx, credits))
+
+	other, err := entity.NewWebhookSubscription(vo.NewAccountID(), "https://example.com/other", []entity.WebhookEvent{entity.WebhookEventCreditReceived}, nil)
+	require.NoError(t, err)
+	require.NoError(t, repo.Create(ctx, other))
+
+	found, err := repo.GetByID(ctx, lowBalance.ID)
+	require.NoError(t, err)
+	assert.Equal(t, lowBalance.Secret, found.Secret)
+	assert.Equal(t, lowBalance.Events, found.Events)
+	require.NotNil(t, found.Threshold)
+	assert.True(t, found.Threshold.Equal(threshold))
+
+	webhooks, err := repo.ListByAccountID(ctx, accountID)
+	require.NoError(t, err)
+	require.Len(t, webhooks, 2)
+	assert.Equal(t, lowBalance.ID, webhooks[0].ID)
+	assert.Nil(t, webhooks[1].Threshold)
+
+	// Failures and disabling are persisted
+	assert.True(t, found.RecordFailure("connection refused", 1))
+	require.NoError(t, repo.Update(ctx, found))
+
+	found, err = repo.GetByID(ctx, lowBalance.ID)
+	require.NoError(t, err)
+	assert.Equal(t, entity.WebhookStatusDisabled, found.Status)
+	assert.Equal(t, 1, found.ConsecutiveFailures)
+	assert.Equal(t, "connection refused", found.LastError)
+	assert.NotNil(t, found.DisabledAt)
+
+	require.NoError(t, repo.Delete(ctx, credits.ID))
+	assert.ErrorIs(t, repo.Delete(ctx, credits.ID), errs.ErrWebhookNotFound)
+
+	_, err = repo.GetByID(ctx, credits.ID)
+	assert.ErrorIs(t, err, errs.ErrWebhookNotFound)
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/hydr0g3nz/mini_bank/internal/application/dto"
 	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
 	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/event"
 	"github.com/hydr0g3nz/mini_bank/internal/domain/infra"
 	"github.com/hydr0g3nz/mini_bank/internal/domain/repository"
 	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
@@ -16,30 +17,48 @@ import (
 
 type accountUseCase struct {
 	accountRepo repository.AccountRepository
+	productRepo repository.ProductRepository
 	cache       infra.CacheService
+	lists       *ListCache
+	events      infra.EventPublisher
+	nameScope   vo.AccountNameScope
+	currency    string
 	logger      infra.Logger
 	mapper      *dto.AccountMapper
 }
 
-// NewAccountUseCase creates a new account use case
+// NewAccountUseCase creates a new account use case; a nil lists caches list pages with the default policies.
+// Account names must be unique within nameScope; accounts opened without a currency or a product are
+// held in currency, the service currency.
 func NewAccountUseCase(
 	accountRepo repository.AccountRepository,
+	productRepo repository.ProductRepository,
 	cache infra.CacheService,
+	lists *ListCache,
+	events infra.EventPublisher,
+	nameScope vo.AccountNameScope,
+	currency string,
 	logger infra.Logger,
 ) AccountUseCase {
+	if lists == nil {
+		lists = NewListCache(cache, nil, logger)
+	}
+
 	return &accountUseCase{
 		accountRepo: accountRepo,
+		productRepo: productRepo,
 		cache:       cache,
+		lists:       lists,
+		events:      events,
+		nameScope:   nameScope,
+		currency:    currency,
 		logger:      logger,
 		mapper:      &dto.AccountMapper{},
 	}
 }
 
 // CreateAccount creates a new account
-func (uc *accountUseCase) CreateAccount(ctx context.Context, req dto.CreateAccountRequest) (*dto.AccountResponse, error) {
-	// Log the operation
-	uc.logger.Info("Creating new account", "accountName", req.AccountName, "initialBalance", req.InitialBalance)
-
+func (uc *accountUseCase) CreateAccount(ctx context.Context, req dto.AccountRequest) (*dto.AccountResponse, error) {
 	// Convert DTO to domain values
 	accountName, money, err := uc.mapper.FromCreateRequest(req)
 	if err != nil {
@@ -47,20 +66,56 @@ func (uc *accountUseCase) CreateAccount(ctx context.Context, req dto.CreateAccou
 		return nil, err
 	}
 
+	// Log the operation
+	uc.logger.Info("Creating new account", "accountName", accountName, "initialBalance", money.InexactFloat64())
+
 	// Check if account with same name already exists
-	existingAccount, err := uc.accountRepo.GetByAccountName(ctx, accountName)
-	if err == nil && existingAccount != nil {
-		uc.logger.Warn("Account with same name already exists", "accountName", accountName)
-		return nil, errs.ErrAccountAlreadyExists
+	if err := uc.checkAccountName(ctx, nil, req.CustomerID, accountName); err != nil {
+		return nil, err
+	}
+
+	var product *entity.Product
+	if req.ProductID != "" {
+		product, err = uc.productRepo.GetByID(ctx, req.ProductID)
+		if err != nil {
+			uc.logger.Error("Failed to load product for new account", "error", err, "productID", req.ProductID)
+			return nil, err
+		}
+	}
+
+	// The account is held in the requested currency, else in the product's, else in the service currency
+	code := req.Currency
+	if code == "" && product != nil {
+		code = product.Currency
+	}
+	if code == "" {
+		code = uc.currency
+	}
+	currency, err := vo.NewCurrency(code)
+	if err != nil {
+		return nil, err
 	}
 
 	// Create new account entity
-	account, err := entity.NewAccount(accountName, money)
+	account, err := entity.NewAccount(accountName, money.In(currency))
 	if err != nil {
 		uc.logger.Error("Failed to create account entity", "error", err)
 		return nil, err
 	}
 
+	if req.CustomerID != "" {
+		if err := account.AssignCustomer(req.CustomerID); err != nil {
+			return nil, err
+		}
+	}
+
+	// Accounts opened from a product take on its terms
+	if product != nil {
+		if err := account.OpenWithProduct(product); err != nil {
+			return nil, err
+		}
+	}
+
 	// Save to repository
 	if err := uc.accountRepo.Create(ctx, account); err != nil {
 		uc.logger.Error("Failed to save account to repository", "error", err, "accountID", account.ID.String())
@@ -77,10 +132,33 @@ func (uc *accountUseCase) CreateAccount(ctx context.Context, req dto.CreateAccou
 
 	}
 
+	uc.publish(ctx, event.NewAccountEvent(event.AccountCreated, account))
+
 	uc.logger.Info("Account created successfully", "accountID", account.ID.String(), "accountName", accountName)
 	return &response, nil
 }
 
+// checkAccountName rejects a name another account already has within the account name scope;
+// a failed lookup lets the unique index of the scope decide
+func (uc *accountUseCase) checkAccountName(ctx context.Context, self *vo.AccountID, customerID, accountName string) error {
+	var existing *entity.Account
+	var err error
+	switch uc.nameScope {
+	case vo.AccountNameScopeNone:
+		return nil
+	case vo.AccountNameScopeCustomer:
+		existing, err = uc.accountRepo.GetByCustomerAccountName(ctx, customerID, accountName)
+	default:
+		existing, err = uc.accountRepo.GetByAccountName(ctx, accountName)
+	}
+
+	if err == nil && existing != nil && (self == nil || existing.ID != *self) {
+		uc.logger.Warn("Account with same name already exists", "accountName", accountName, "customerID", customerID, "scope", uc.nameScope)
+		return errs.ErrAccountAlreadyExists
+	}
+	return nil
+}
+
 // GetAccount retrieves an account by ID
 func (uc *accountUseCase) GetAccount(ctx context.Context, id string) (*dto.AccountResponse, error) {
 	uc.logger.Debug("Getting account", "accountID", id)
@@ -119,8 +197,135 @@ func (uc *accountUseCase) GetAccount(ctx context.Context, id string) (*dto.Accou
 	return &response, nil
 }
 
+// GetAccountWithNameHistory retrieves an account with its renames, oldest first. It bypasses the cache,
+// which only holds the account itself.
+func (uc *accountUseCase) GetAccountWithNameHistory(ctx context.Context, id string) (*dto.AccountResponse, error) {
+	accountID, err := vo.NewAccountIDFromString(id)
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := uc.accountRepo.GetByID(ctx, accountID)
+	if err != nil {
+		uc.logger.Error("Failed to get account from repository", "error", err, "accountID", id)
+		return nil, errs.ErrAccountNotFound
+	}
+
+	history, err := uc.accountRepo.GetNameHistory(ctx, accountID)
+	if err != nil {
+		uc.logger.Error("Failed to get account name history", "error", err, "accountID", id)
+		return nil, err
+	}
+
+	response := uc.mapper.ToResponse(account)
+	response.NameHistory = uc.mapper.ToNameHistory(history)
+	return &response, nil
+}
+
+// GetAccounts retrieves several accounts at once, in the order requested. Cached accounts are read
+// in one round trip and the rest are loaded in one query; unknown IDs are left out of the result.
+func (uc *accountUseCase) GetAccounts(ctx context.Context, ids []string) ([]dto.AccountResponse, error) {
+	uc.logger.Debug("Getting accounts", "count", len(ids))
+
+	cacheKeys := make([]string, len(ids))
+	cached := make([]dto.AccountResponse, len(ids))
+	dests := make([]interface{}, len(ids))
+	for i, id := range ids {
+		if _, err := vo.NewAccountIDFromString(id); err != nil {
+			uc.logger.Error("Invalid account ID format", "error", err, "accountID", id)
+			return nil, err
+		}
+		cacheKeys[i] = fmt.Sprintf("account:%s", id)
+		dests[i] = &cached[i]
+	}
+
+	found, err := uc.cache.GetMany(ctx, cacheKeys, dests)
+	if err != nil {
+		uc.logger.Warn("Failed to read accounts from cache", "error", err)
+		found = make([]bool, len(ids))
+	}
+
+	var missing []vo.AccountID
+	for i, id := range ids {
+		if !found[i] {
+			accountID, _ := vo.NewAccountIDFromString(id)
+			missing = append(missing, accountID)
+		}
+	}
+
+	if len(missing) > 0 {
+		accounts, err := uc.accountRepo.GetByIDs(ctx, missing)
+		if err != nil {
+			uc.logger.Error("Failed to get accounts from repository", "error", err)
+			return nil, err
+		}
+
+		for i, id := range ids {
+			if found[i] {
+				continue
+			}
+			accountID, _ := vo.NewAccountIDFromString(id)
+			account, ok := accounts[accountID]
+			if !ok {
+				continue
+			}
+
+			cached[i] = uc.mapper.ToResponse(account)
+			found[i] = true
+			if err := uc.cache.Set(ctx, cacheKeys[i], cached[i], 15*time.Minute); err != nil {
+				uc.logger.Warn("Failed to cache account", "error", err, "accountID", id)
+			}
+		}
+	}
+
+	responses := make([]dto.AccountResponse, 0, len(ids))
+	for i := range ids {
+		if found[i] {
+			responses = append(responses, cached[i])
+		}
+	}
+	return responses, nil
+}
+
+// BatchGetAccounts retrieves several accounts in the order requested, reading cached ones in one round
+// trip and the rest in one query, and lists the IDs without an account. Repeated IDs are looked up once.
+func (uc *accountUseCase) BatchGetAccounts(ctx context.Context, req dto.BatchGetRequest) (*dto.AccountBatchResponse, error) {
+	ids := distinctIDs(req.IDs)
+
+	accounts, err := uc.GetAccounts(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	found := make(map[string]bool, len(accounts))
+	for _, account := range accounts {
+		found[account.ID] = true
+	}
+	missing := make([]string, 0, len(ids)-len(accounts))
+	for _, id := range ids {
+		if !found[id] {
+			missing = append(missing, id)
+		}
+	}
+
+	return &dto.AccountBatchResponse{Found: accounts, Missing: missing}, nil
+}
+
+// distinctIDs returns the IDs without repeats, in the order they first appear
+func distinctIDs(ids []string) []string {
+	seen := make(map[string]bool, len(ids))
+	distinct := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			distinct = append(distinct, id)
+		}
+	}
+	return distinct
+}
+
 // UpdateAccount updates an existing account
-func (uc *accountUseCase) UpdateAccount(ctx context.Context, req dto.UpdateAccountRequest) (*dto.AccountResponse, error) {
+func (uc *accountUseCase) UpdateAccount(ctx context.Context, req dto.AccountRequest) (*dto.AccountResponse, error) {
 	uc.logger.Info("Updating account", "accountID", req.ID, "newName", req.AccountName)
 
 	// Parse account ID
@@ -138,8 +343,14 @@ func (uc *accountUseCase) UpdateAccount(ctx context.Context, req dto.UpdateAccou
 	}
 
 	// Update account name
-	account.AccountName = req.AccountName
-	account.UpdatedAt = time.Now()
+	if req.AccountName != account.AccountName {
+		if err := uc.checkAccountName(ctx, &account.ID, account.CustomerID, req.AccountName); err != nil {
+			return nil, err
+		}
+	}
+	if err := account.Rename(req.AccountName); err != nil {
+		return nil, err
+	}
 
 	// Save to repository
 	if err := uc.accountRepo.Update(ctx, account); err != nil {
@@ -156,6 +367,8 @@ func (uc *accountUseCase) UpdateAccount(ctx context.Context, req dto.UpdateAccou
 		uc.logger.Warn("Failed to update account cache", "error", err, "accountID", req.ID)
 	}
 
+	uc.publish(ctx, event.NewAccountEvent(event.AccountUpdated, account))
+
 	uc.logger.Info("Account updated successfully", "accountID", req.ID)
 	return &response, nil
 }
@@ -172,12 +385,23 @@ func (uc *accountUseCase) DeleteAccount(ctx context.Context, id string) error {
 	}
 
 	// Check if account exists
-	_, err = uc.accountRepo.GetByID(ctx, accountID)
+	account, err := uc.accountRepo.GetByID(ctx, accountID)
 	if err != nil {
 		uc.logger.Error("Account not found", "error", err, "accountID", id)
 		return errs.ErrAccountNotFound
 	}
 
+	// A parent keeps its children; they must be detached first
+	children, err := uc.accountRepo.GetChildren(ctx, accountID)
+	if err != nil {
+		uc.logger.Error("Failed to load child accounts", "error", err, "accountID", id)
+		return err
+	}
+	if len(children) > 0 {
+		uc.logger.Warn("Account has child accounts", "accountID", id, "children", len(children))
+		return errs.ErrAccountHasChildren
+	}
+
 	// Delete from repository
 	if err := uc.accountRepo.Delete(ctx, accountID); err != nil { // todo:soft delete
 		uc.logger.Error("Failed to delete account from repository", "error", err, "accountID", id)
@@ -190,52 +414,88 @@ func (uc *accountUseCase) DeleteAccount(ctx context.Context, id string) error {
 		uc.logger.Warn("Failed to delete account from cache", "error", err, "accountID", id)
 	}
 
+	uc.publish(ctx, event.NewAccountEvent(event.AccountDeleted, account))
+
 	uc.logger.Info("Account deleted successfully", "accountID", id)
 	return nil
 }
 
 // ListAccounts retrieves accounts with pagination
-func (uc *accountUseCase) ListAccounts(ctx context.Context, req dto.ListRequest) (*dto.AccountListResponse, error) {
+func (uc *accountUseCase) ListAccounts(ctx context.Context, req dto.AccountListRequest) (*dto.AccountListResponse, error) {
 	uc.logger.Debug("Listing accounts", "page", req.Page, "pageSize", req.PageSize)
 
 	// Calculate offset
 	offset := (req.Page - 1) * req.PageSize
-
-	// Try to get from cache first
-	cacheKey := fmt.Sprintf("accounts:list:page:%d:size:%d:search:%s", req.Page, req.PageSize, req.Search)
-	var cachedResponse dto.AccountListResponse
-	if err := uc.cache.Get(ctx, cacheKey, &cachedResponse); err == nil {
-		uc.logger.Debug("Account list found in cache")
-		return &cachedResponse, nil
-	}
-
-	// Get from repository
-	accounts, err := uc.accountRepo.List(ctx, req.PageSize, offset)
+	filter := accountFilter(req)
+
+	// Serve from cache, refreshing stale pages in the background
+	cacheKey := fmt.Sprintf("accounts:list:page:%d:size:%d:search:%s:filter:%s", req.Page, req.PageSize, req.Search, accountFilterKey(filter))
+	response, err := loadList(ctx, uc.lists, ListEndpointAccounts, cacheKey, func(ctx context.Context) (dto.AccountListResponse, error) {
+		accounts, err := uc.accountRepo.List(ctx, filter, req.PageSize, offset)
+		if err != nil {
+			uc.logger.Error("Failed to get accounts from repository", "error", err)
+			return dto.AccountListResponse{}, err
+		}
+
+		total, err := uc.accountRepo.Count(ctx, filter)
+		if err != nil {
+			uc.logger.Error("Failed to count accounts", "error", err)
+			return dto.AccountListResponse{}, err
+		}
+
+		// Create pagination info
+		pagination := dto.NewPaginationInfo(req.Page, req.PageSize, total)
+
+		// Convert to response DTO
+		return uc.mapper.ToResponseList(accounts, pagination), nil
+	})
 	if err != nil {
-		uc.logger.Error("Failed to get accounts from repository", "error", err)
 		return nil, err
 	}
 
-	// Create pagination info (simplified - in real implementation, you'd get total count)
-	pagination := dto.PaginationInfo{
-		Page:       req.Page,
-		PageSize:   req.PageSize,
-		TotalItems: int64(len(accounts)), // This should be actual total count from DB
-		TotalPages: (len(accounts) + req.PageSize - 1) / req.PageSize,
-		HasNext:    len(accounts) == req.PageSize,
-		HasPrev:    req.Page > 1,
-	}
-
-	// Convert to response DTO
-	response := uc.mapper.ToResponseList(accounts, pagination)
+	uc.logger.Debug("Account list retrieved successfully", "count", len(response.Accounts))
+	return &response, nil
+}
 
-	// Cache the result for shorter time since it's a list
-	if err := uc.cache.Set(ctx, cacheKey, response, 5*time.Minute); err != nil {
-		uc.logger.Warn("Failed to cache account list", "error", err)
+// accountFilter converts the filters of a list request; the last day created on is included
+func accountFilter(req dto.AccountListRequest) repository.AccountFilter {
+	filter := repository.AccountFilter{
+		Status:     vo.AccountStatus(req.Status),
+		CustomerID: req.CustomerID,
+	}
+	if req.MinBalance != nil {
+		minBalance := req.MinBalance.Money()
+		filter.MinBalance = &minBalance
+	}
+	if req.MaxBalance != nil {
+		maxBalance := req.MaxBalance.Money()
+		filter.MaxBalance = &maxBalance
 	}
+	if req.CreatedFrom != nil {
+		filter.CreatedFrom = *req.CreatedFrom
+	}
+	if req.CreatedTo != nil {
+		filter.CreatedBefore = req.CreatedTo.AddDate(0, 0, 1)
+	}
+	return filter
+}
 
-	uc.logger.Debug("Account list retrieved successfully", "count", len(accounts))
-	return &response, nil
+// accountFilterKey renders an account filter for list cache keys
+func accountFilterKey(filter repository.AccountFilter) string {
+	balance := func(m *vo.Money) string {
+		if m == nil {
+			return ""
+		}
+		return m.String()
+	}
+	date := func(t time.Time) string {
+		if t.IsZero() {
+			return ""
+		}
+		return t.Format("2006-01-02")
+	}
+	return fmt.Sprintf("%s|%q|%s|%s|%s|%s", filter.Status, filter.CustomerID,
+		balance(filter.MinBalance), balance(filter.MaxBalance), date(filter.CreatedFrom), date(filter.CreatedBefore))
 }
 
 // SuspendAccount suspends an account
@@ -275,6 +535,8 @@ func (uc *accountUseCase) SuspendAccount(ctx context.Context, id string) error {
 		uc.logger.Warn("Failed to update account cache", "error", err, "accountID", id)
 	}
 
+	uc.publish(ctx, event.NewAccountEvent(event.AccountStatusChanged, account))
+
 	uc.logger.Info("Account suspended successfully", "accountID", id)
 	return nil
 }
@@ -316,6 +578,15 @@ func (uc *accountUseCase) ActivateAccount(ctx context.Context, id string) error
 		uc.logger.Warn("Failed to update account cache", "error", err, "accountID", id)
 	}
 
+	uc.publish(ctx, event.NewAccountEvent(event.AccountStatusChanged, account))
+
 	uc.logger.Info("Account activated successfully", "accountID", id)
 	return nil
 }
+
+// publish publishes an account event; failures are logged and never fail the operation
+func (uc *accountUseCase) publish(ctx context.Context, evt event.Event) {
+	if err := uc.events.Publish(ctx, evt); err != nil {
+		uc.logger.Warn("Failed to publish account event", "error", err, "eventType", evt.Type, "accountID", evt.Key)
+	}
+}
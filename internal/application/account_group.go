@@ -0,0 +1,265 @@
+// internal/application/account_group.go
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/application/dto"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/event"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+)
+
+// AddChildAccount attaches an account to a corporate parent account
+func (uc *accountUseCase) AddChildAccount(ctx context.Context, req dto.AddChildAccountRequest) (*dto.AccountResponse, error) {
+	uc.logger.Info("Adding child account", "parentAccountID", req.ParentID, "childAccountID", req.ChildAccountID)
+
+	parent, err := uc.loadAccount(ctx, req.ParentID)
+	if err != nil {
+		return nil, err
+	}
+
+	child, err := uc.loadAccount(ctx, req.ChildAccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Hierarchies are one level deep, so a parent cannot become a child
+	children, err := uc.accountRepo.GetChildren(ctx, child.ID)
+	if err != nil {
+		uc.logger.Error("Failed to load child accounts", "error", err, "accountID", req.ChildAccountID)
+		return nil, err
+	}
+	if len(children) > 0 {
+		return nil, errs.ErrAccountHasChildren
+	}
+
+	if err := child.AttachToParent(parent); err != nil {
+		return nil, err
+	}
+
+	response, err := uc.storeAccount(ctx, child)
+	if err != nil {
+		return nil, err
+	}
+
+	uc.logger.Info("Child account added", "parentAccountID", req.ParentID, "childAccountID", req.ChildAccountID)
+	return response, nil
+}
+
+// RemoveChildAccount detaches a child account from its parent
+func (uc *accountUseCase) RemoveChildAccount(ctx context.Context, parentID, childID string) error {
+	uc.logger.Info("Removing child account", "parentAccountID", parentID, "childAccountID", childID)
+
+	child, err := uc.loadChild(ctx, parentID, childID)
+	if err != nil {
+		return err
+	}
+
+	child.DetachFromParent()
+
+	if _, err := uc.storeAccount(ctx, child); err != nil {
+		return err
+	}
+
+	uc.logger.Info("Child account removed", "parentAccountID", parentID, "childAccountID", childID)
+	return nil
+}
+
+// SetSpendingLimit sets or, when the limit is nil, removes the monthly spending limit of a child account
+func (uc *accountUseCase) SetSpendingLimit(ctx context.Context, req dto.SpendingLimitRequest) (*dto.AccountResponse, error) {
+	uc.logger.Info("Setting spending limit", "parentAccountID", req.ParentID, "childAccountID", req.ChildID, "monthlyLimit", req.MonthlyLimit)
+
+	child, err := uc.loadChild(ctx, req.ParentID, req.ChildID)
+	if err != nil {
+		return nil, err
+	}
+
+	var limit *vo.Money
+	if req.MonthlyLimit != nil {
+		amount := req.MonthlyLimit.Money()
+		limit = &amount
+	}
+
+	if err := child.SetSpendingLimit(limit); err != nil {
+		return nil, err
+	}
+
+	return uc.storeAccount(ctx, child)
+}
+
+// GetAccountGroup retrieves a parent account with its children and their rolled-up balance.
+// Balances move with every transaction, so the group is never served from cache.
+func (uc *accountUseCase) GetAccountGroup(ctx context.Context, parentID string) (*dto.AccountGroupResponse, error) {
+	parent, err := uc.loadAccount(ctx, parentID)
+	if err != nil {
+		return nil, err
+	}
+
+	children, err := uc.accountRepo.GetChildren(ctx, parent.ID)
+	if err != nil {
+		uc.logger.Error("Failed to load child accounts", "error", err, "accountID", parentID)
+		return nil, err
+	}
+
+	childrenBalance := vo.ZeroMoney()
+	childResponses := make([]dto.AccountResponse, len(children))
+	for i, child := range children {
+		if childrenBalance, err = childrenBalance.Add(child.Balance); err != nil {
+			return nil, err
+		}
+		childResponses[i] = uc.mapper.ToResponse(child)
+	}
+
+	totalBalance, err := parent.Balance.Add(childrenBalance)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.AccountGroupResponse{
+		Parent:          uc.mapper.ToResponse(parent),
+		Children:        childResponses,
+		ChildrenBalance: childrenBalance.InexactFloat64(),
+		TotalBalance:    totalBalance.InexactFloat64(),
+		Currency:        parent.Currency().String(),
+	}, nil
+}
+
+// loadAccount parses an account ID and loads the account
+func (uc *accountUseCase) loadAccount(ctx context.Context, id string) (*entity.Account, error) {
+	accountID, err := vo.NewAccountIDFromString(id)
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := uc.accountRepo.GetByID(ctx, accountID)
+	if err != nil {
+		uc.logger.Error("Account not found", "error", err, "accountID", id)
+		return nil, errs.ErrAccountNotFound
+	}
+
+	return account, nil
+}
+
+// loadChild loads an account that must be a child of the given parent
+func (uc *accountUseCase) loadChild(ctx context.Context, parentID, childID string) (*entity.Account, error) {
+	parentAccountID, err := vo.NewAccountIDFromString(parentID)
+	if err != nil {
+		return nil, err
+	}
+
+	child, err := uc.loadAccount(ctx, childID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !child.IsChildOf(parentAccountID) {
+		return nil, errs.ErrAccountNotInGroup
+	}
+
+	return child, nil
+}
+
+// storeAccount persists a changed account, refreshes its cache entry and publishes the update
+func (uc *accountUseCase) storeAccount(ctx context.Context, account *entity.Account) (*dto.AccountResponse, error) {
+	accountID := account.ID.String()
+
+	if err := uc.accountRepo.Update(ctx, account); err != nil {
+		uc.logger.Error("Failed to update account in repository", "error", err, "accountID", accountID)
+		return nil, err
+	}
+
+	response := uc.mapper.ToResponse(account)
+	cacheKey := fmt.Sprintf("account:%s", accountID)
+	if err := uc.cache.Set(ctx, cacheKey, response, 15*time.Minute); err != nil {
+		uc.logger.Warn("Failed to update account cache", "error", err, "accountID", accountID)
+	}
+
+	uc.publish(ctx, event.NewAccountEvent(event.AccountUpdated, account))
+	return &response, nil
+}
+
+// GroupTransfer creates and confirms a transfer between two accounts of a parent's group in one call.
+// A transfer queued for a later value date is returned pending instead of failing.
+func (uc *transactionUseCase) GroupTransfer(ctx context.Context, req dto.GroupTransferRequest) (*dto.TransactionResponse, error) {
+	parentID, err := vo.NewAccountIDFromString(req.ParentID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, id := range []string{req.FromAccountID, req.ToAccountID} {
+		if err := uc.validateGroupMember(ctx, parentID, id); err != nil {
+			return nil, err
+		}
+	}
+
+	created, err := uc.CreateTransaction(ctx, dto.CreateTransactionRequest{
+		FromAccountID:   &req.FromAccountID,
+		ToAccountID:     &req.ToAccountID,
+		TransactionType: string(vo.TransactionTypeTransfer),
+		Amount:          req.Amount,
+		Description:     req.Description,
+		Reference:       fmt.Sprintf("GROUP-%s", req.ParentID),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	confirmed, err := uc.ConfirmTransaction(ctx, dto.ConfirmTransactionRequest{ID: created.ID})
+	if errors.Is(err, errs.ErrTransactionNotDue) {
+		return created, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	uc.logger.Info("Group transfer processed", "parentAccountID", req.ParentID, "transactionID", created.ID, "status", confirmed.Status)
+	return confirmed, nil
+}
+
+// validateGroupMember checks that an account is the parent itself or one of its children
+func (uc *transactionUseCase) validateGroupMember(ctx context.Context, parentID vo.AccountID, id string) error {
+	accountID, err := vo.NewAccountIDFromString(id)
+	if err != nil {
+		return err
+	}
+
+	account, err := uc.accountRepo.GetByID(ctx, accountID)
+	if err != nil {
+		return errs.ErrAccountNotFound
+	}
+
+	if account.ID != parentID && !account.IsChildOf(parentID) {
+		return fmt.Errorf("%w: %s", errs.ErrAccountNotInGroup, id)
+	}
+	return nil
+}
+
+// checkSpendingLimit checks that debiting amount keeps a child account within its monthly spending limit
+func (uc *transactionUseCase) checkSpendingLimit(ctx context.Context, account *entity.Account, amount vo.Money) error {
+	if account.SpendingLimit == nil {
+		return nil
+	}
+
+	_, start := entity.BudgetPeriod(uc.valueDating.Now())
+	transactions, err := uc.transactionRepo.GetCompletedByAccountIDSince(ctx, account.ID, start)
+	if err != nil {
+		return fmt.Errorf("failed to load spending of account %s: %w", account.ID.String(), err)
+	}
+
+	spent := vo.ZeroMoney()
+	for _, transaction := range transactions {
+		if transaction.FromAccountID == nil || *transaction.FromAccountID != account.ID {
+			continue
+		}
+		if spent, err = spent.Add(transaction.TotalDebit()); err != nil {
+			return err
+		}
+	}
+
+	return account.CheckSpendingLimit(spent, amount)
+}
@@ -0,0 +1,168 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/application/dto"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/event"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// newAccountGroupFixture returns a use case over a parent account and one attached child
+func newAccountGroupFixture(t *testing.T) (*MockAccountRepository, *MockCacheService, *StubEventPublisher, AccountUseCase, *entity.Account, *entity.Account) {
+	parent, err := entity.NewAccount("Holding Co", vo.NewMoneyFromFloat(1000.0))
+	require.NoError(t, err)
+	child, err := entity.NewAccount("Subsidiary", vo.NewMoneyFromFloat(250.5))
+	require.NoError(t, err)
+	require.NoError(t, child.AttachToParent(parent))
+
+	mockRepo := new(MockAccountRepository)
+	mockCache := new(MockCacheService)
+	mockLogger := new(MockLogger)
+	events := &StubEventPublisher{}
+	mockLogger.On("Info", mock.Anything, mock.Anything).Maybe()
+	mockLogger.On("Error", mock.Anything, mock.Anything).Maybe()
+
+	mockRepo.On("GetByID", mock.Anything, parent.ID).Return(parent, nil).Maybe()
+	mockRepo.On("GetByID", mock.Anything, child.ID).Return(child, nil).Maybe()
+
+	return mockRepo, mockCache, events, NewAccountUseCase(mockRepo, nil, mockCache, nil, events, vo.AccountNameScopeGlobal, "THB", mockLogger), parent, child
+}
+
+func TestAccountUseCase_AddChildAccount(t *testing.T) {
+	mockRepo, mockCache, events, uc, parent, _ := newAccountGroupFixture(t)
+	standalone, err := entity.NewAccount("Branch", vo.NewMoneyFromFloat(0))
+	require.NoError(t, err)
+
+	mockRepo.On("GetByID", mock.Anything, standalone.ID).Return(standalone, nil)
+	mockRepo.On("GetChildren", mock.Anything, standalone.ID).Return([]*entity.Account{}, nil)
+	mockRepo.On("Update", mock.Anything, standalone).Return(nil)
+	mockCache.On("Set", mock.Anything, "account:"+standalone.ID.String(), mock.Anything, mock.Anything).Return(nil)
+
+	response, err := uc.AddChildAccount(context.Background(), dto.AddChildAccountRequest{
+		ParentID:       parent.ID.String(),
+		ChildAccountID: standalone.ID.String(),
+	})
+
+	require.NoError(t, err)
+	require.NotNil(t, response.ParentAccountID)
+	assert.Equal(t, parent.ID.String(), *response.ParentAccountID)
+	require.Len(t, events.Events, 1)
+	assert.Equal(t, event.AccountUpdated, events.Events[0].Type)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestAccountUseCase_AddChildAccount_RejectsParent(t *testing.T) {
+	mockRepo, _, _, uc, parent, child := newAccountGroupFixture(t)
+	other, err := entity.NewAccount("Other Holding", vo.NewMoneyFromFloat(0))
+	require.NoError(t, err)
+
+	mockRepo.On("GetByID", mock.Anything, other.ID).Return(other, nil)
+	mockRepo.On("GetChildren", mock.Anything, parent.ID).Return([]*entity.Account{child}, nil)
+
+	_, err = uc.AddChildAccount(context.Background(), dto.AddChildAccountRequest{
+		ParentID:       other.ID.String(),
+		ChildAccountID: parent.ID.String(),
+	})
+
+	assert.ErrorIs(t, err, errs.ErrAccountHasChildren)
+	mockRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+}
+
+func TestAccountUseCase_SetSpendingLimit(t *testing.T) {
+	mockRepo, mockCache, _, uc, parent, child := newAccountGroupFixture(t)
+	mockRepo.On("Update", mock.Anything, child).Return(nil)
+	mockCache.On("Set", mock.Anything, "account:"+child.ID.String(), mock.Anything, mock.Anything).Return(nil)
+
+	limit := dto.NewDecimalStringFromFloat(500)
+	response, err := uc.SetSpendingLimit(context.Background(), dto.SpendingLimitRequest{
+		ParentID:     parent.ID.String(),
+		ChildID:      child.ID.String(),
+		MonthlyLimit: &limit,
+	})
+	require.NoError(t, err)
+	require.NotNil(t, response.SpendingLimit)
+	assert.Equal(t, 500.0, *response.SpendingLimit)
+
+	response, err = uc.SetSpendingLimit(context.Background(), dto.SpendingLimitRequest{
+		ParentID: parent.ID.String(),
+		ChildID:  child.ID.String(),
+	})
+	require.NoError(t, err)
+	assert.Nil(t, response.SpendingLimit)
+
+	// Only the account's own parent manages its limit
+	_, err = uc.SetSpendingLimit(context.Background(), dto.SpendingLimitRequest{
+		ParentID:     vo.NewAccountID().String(),
+		ChildID:      child.ID.String(),
+		MonthlyLimit: &limit,
+	})
+	assert.ErrorIs(t, err, errs.ErrAccountNotInGroup)
+}
+
+func TestAccountUseCase_GetAccountGroup(t *testing.T) {
+	mockRepo, _, _, uc, parent, child := newAccountGroupFixture(t)
+	second, err := entity.NewAccount("Second Subsidiary", vo.NewMoneyFromFloat(49.5))
+	require.NoError(t, err)
+	require.NoError(t, second.AttachToParent(parent))
+	mockRepo.On("GetChildren", mock.Anything, parent.ID).Return([]*entity.Account{child, second}, nil)
+
+	group, err := uc.GetAccountGroup(context.Background(), parent.ID.String())
+
+	require.NoError(t, err)
+	assert.Equal(t, parent.ID.String(), group.Parent.ID)
+	assert.Len(t, group.Children, 2)
+	assert.Equal(t, 300.0, group.ChildrenBalance)
+	assert.Equal(t, 1300.0, group.TotalBalance)
+}
+
+func (suite *TransactionUseCaseTestSuite) TestGroupTransfer_RejectsAccountOutsideGroup() {
+	parent, _ := entity.NewAccount("Holding Co", vo.NewMoneyFromFloat(1000.0))
+	suite.mockAccountRepo.On("GetByID", suite.ctx, parent.ID).Return(parent, nil)
+	suite.mockAccountRepo.On("GetByID", suite.ctx, suite.testAccount.ID).Return(suite.testAccount, nil)
+
+	_, err := suite.usecase.GroupTransfer(suite.ctx, dto.GroupTransferRequest{
+		ParentID:      parent.ID.String(),
+		FromAccountID: parent.ID.String(),
+		ToAccountID:   suite.testAccount.ID.String(),
+		Amount:        dto.NewDecimalStringFromFloat(100),
+	})
+
+	assert.ErrorIs(suite.T(), err, errs.ErrAccountNotInGroup)
+	suite.mockTxnRepo.AssertNotCalled(suite.T(), "Create", mock.Anything, mock.Anything)
+}
+
+func (suite *TransactionUseCaseTestSuite) TestConfirmTransaction_SpendingLimitExceeded() {
+	parent, _ := entity.NewAccount("Holding Co", vo.NewMoneyFromFloat(1000.0))
+	suite.Require().NoError(suite.testAccount.AttachToParent(parent))
+	limit := vo.NewMoneyFromFloat(150)
+	suite.Require().NoError(suite.testAccount.SetSpendingLimit(&limit))
+
+	earlier, err := entity.NewDebitTransaction(suite.testAccount.ID, vo.NewMoneyFromFloat(60), "Earlier", "", time.Now())
+	suite.Require().NoError(err)
+	suite.Require().NoError(earlier.MarkAsCompleted(time.Now()))
+	incoming, err := entity.NewCreditTransaction(suite.testAccount.ID, vo.NewMoneyFromFloat(500), "Incoming", "", time.Now())
+	suite.Require().NoError(err)
+	suite.Require().NoError(incoming.MarkAsCompleted(time.Now()))
+
+	id := suite.expectConfirmationLock()
+	suite.mockTxnRepo.On("GetByID", suite.ctx, suite.testTransaction.ID).Return(suite.testTransaction, nil)
+	suite.mockTxnRepo.On("GetCompletedByAccountIDSince", suite.ctx, suite.testAccount.ID, mock.Anything).Return([]*entity.Transaction{earlier, incoming}, nil)
+	suite.mockTxnRepo.On("Update", suite.ctx, suite.testTransaction).Return(nil)
+	suite.mockAccountRepo.On("GetByIDForUpdate", suite.ctx, suite.testAccount.ID).Return(suite.testAccount, nil)
+	suite.mockAccountRepo.On("IsApplied", suite.ctx, suite.testTransaction.ID, suite.testAccount.ID).Return(false, nil)
+
+	_, err = suite.usecase.ConfirmTransaction(suite.ctx, dto.ConfirmTransactionRequest{ID: id})
+
+	assert.ErrorIs(suite.T(), err, errs.ErrSpendingLimitExceeded)
+	assert.Equal(suite.T(), vo.TransactionStatusFailed, suite.testTransaction.Status)
+	assert.True(suite.T(), suite.testAccount.Balance.Equal(vo.NewMoneyFromFloat(1000)))
+	suite.mockAccountRepo.AssertNotCalled(suite.T(), "Update", mock.Anything, mock.Anything)
+}
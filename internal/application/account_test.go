@@ -8,11 +8,14 @@ import (
 
 	"github.com/hydr0g3nz/mini_bank/internal/application/dto"
 	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/event"
 	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
 	"github.com/hydr0g3nz/mini_bank/internal/domain/infra"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/repository"
 	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 )
 
 // Mock structs
@@ -30,21 +33,67 @@ func (m *MockAccountRepository) GetByID(ctx context.Context, id vo.AccountID) (*
 	return args.Get(0).(*entity.Account), args.Error(1)
 }
 
+func (m *MockAccountRepository) GetByIDForUpdate(ctx context.Context, id vo.AccountID) (*entity.Account, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).(*entity.Account), args.Error(1)
+}
+
+func (m *MockAccountRepository) GetByIDs(ctx context.Context, ids []vo.AccountID) (map[vo.AccountID]*entity.Account, error) {
+	args := m.Called(ctx, ids)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[vo.AccountID]*entity.Account), args.Error(1)
+}
+
 func (m *MockAccountRepository) Update(ctx context.Context, account *entity.Account) error {
 	args := m.Called(ctx, account)
 	return args.Error(0)
 }
 
+func (m *MockAccountRepository) UpdateFenced(ctx context.Context, account *entity.Account, transactionID vo.TransactionID, token int64) error {
+	args := m.Called(ctx, account, transactionID, token)
+	return args.Error(0)
+}
+
+func (m *MockAccountRepository) IsApplied(ctx context.Context, transactionID vo.TransactionID, accountID vo.AccountID) (bool, error) {
+	args := m.Called(ctx, transactionID, accountID)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockAccountRepository) ApplyCredits(ctx context.Context, accountID vo.AccountID, credits []repository.BatchedCredit) ([]error, error) {
+	args := m.Called(ctx, accountID, credits)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]error), args.Error(1)
+}
+
+func (m *MockAccountRepository) Revert(ctx context.Context, account *entity.Account, transactionID vo.TransactionID) error {
+	args := m.Called(ctx, account, transactionID)
+	return args.Error(0)
+}
+
+func (m *MockAccountRepository) RepairBalance(ctx context.Context, adjustment *entity.BalanceAdjustment) error {
+	args := m.Called(ctx, adjustment)
+	return args.Error(0)
+}
+
 func (m *MockAccountRepository) Delete(ctx context.Context, id vo.AccountID) error {
 	args := m.Called(ctx, id)
 	return args.Error(0)
 }
 
-func (m *MockAccountRepository) List(ctx context.Context, limit, offset int) ([]*entity.Account, error) {
-	args := m.Called(ctx, limit, offset)
+func (m *MockAccountRepository) List(ctx context.Context, filter repository.AccountFilter, limit, offset int) ([]*entity.Account, error) {
+	args := m.Called(ctx, filter, limit, offset)
 	return args.Get(0).([]*entity.Account), args.Error(1)
 }
 
+func (m *MockAccountRepository) Count(ctx context.Context, filter repository.AccountFilter) (int64, error) {
+	args := m.Called(ctx, filter)
+	return args.Get(0).(int64), args.Error(1)
+}
+
 func (m *MockAccountRepository) GetByAccountName(ctx context.Context, accountName string) (*entity.Account, error) {
 	args := m.Called(ctx, accountName)
 	if args.Get(0) == nil {
@@ -53,6 +102,30 @@ func (m *MockAccountRepository) GetByAccountName(ctx context.Context, accountNam
 	return args.Get(0).(*entity.Account), args.Error(1)
 }
 
+func (m *MockAccountRepository) GetByCustomerAccountName(ctx context.Context, customerID, accountName string) (*entity.Account, error) {
+	args := m.Called(ctx, customerID, accountName)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.Account), args.Error(1)
+}
+
+func (m *MockAccountRepository) GetNameHistory(ctx context.Context, id vo.AccountID) ([]*entity.AccountNameChange, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.AccountNameChange), args.Error(1)
+}
+
+func (m *MockAccountRepository) GetChildren(ctx context.Context, parentID vo.AccountID) ([]*entity.Account, error) {
+	args := m.Called(ctx, parentID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.Account), args.Error(1)
+}
+
 type MockCacheService struct {
 	mock.Mock
 }
@@ -67,11 +140,34 @@ func (m *MockCacheService) Get(ctx context.Context, key string, dest interface{}
 	return args.Error(0)
 }
 
+func (m *MockCacheService) GetMany(ctx context.Context, keys []string, dests []interface{}) ([]bool, error) {
+	args := m.Called(ctx, keys, dests)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]bool), args.Error(1)
+}
+
 func (m *MockCacheService) Delete(ctx context.Context, key string) error {
 	args := m.Called(ctx, key)
 	return args.Error(0)
 }
 
+func (m *MockCacheService) DeleteMatching(ctx context.Context, pattern string) (int64, error) {
+	args := m.Called(ctx, pattern)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+// StubEventPublisher records published events
+type StubEventPublisher struct {
+	Events []event.Event
+}
+
+func (s *StubEventPublisher) Publish(ctx context.Context, evt event.Event) error {
+	s.Events = append(s.Events, evt)
+	return nil
+}
+
 type MockLogger struct {
 	mock.Mock
 }
@@ -132,19 +228,24 @@ func createTestAccount() *entity.Account {
 	return account
 }
 
+func initialBalance(amount float64) *dto.DecimalString {
+	balance := dto.NewDecimalStringFromFloat(amount)
+	return &balance
+}
+
 func TestAccountUseCase_CreateAccount(t *testing.T) {
 	tests := []struct {
 		name           string
-		request        dto.CreateAccountRequest
+		request        dto.AccountRequest
 		setupMocks     func(*MockAccountRepository, *MockCacheService, *MockLogger)
 		expectedError  error
 		validateResult func(*testing.T, *dto.AccountResponse)
 	}{
 		{
 			name: "success_create_account",
-			request: dto.CreateAccountRequest{
+			request: dto.AccountRequest{
 				AccountName:    "Test Account",
-				InitialBalance: 1000.0,
+				InitialBalance: initialBalance(1000),
 			},
 			setupMocks: func(repo *MockAccountRepository, cache *MockCacheService, logger *MockLogger) {
 				repo.On("GetByAccountName", mock.Anything, "Test Account").Return(nil, errs.ErrAccountNotFound)
@@ -157,14 +258,50 @@ func TestAccountUseCase_CreateAccount(t *testing.T) {
 			validateResult: func(t *testing.T, result *dto.AccountResponse) {
 				assert.Equal(t, "Test Account", result.AccountName)
 				assert.Equal(t, 1000.0, result.Balance)
+				assert.Equal(t, "THB", result.Currency)
 				assert.Equal(t, "ACTIVE", result.Status)
 			},
 		},
+		{
+			name: "success_create_account_in_requested_currency",
+			request: dto.AccountRequest{
+				AccountName:    "Dollar Account",
+				InitialBalance: initialBalance(250),
+				Currency:       "usd",
+			},
+			setupMocks: func(repo *MockAccountRepository, cache *MockCacheService, logger *MockLogger) {
+				repo.On("GetByAccountName", mock.Anything, "Dollar Account").Return(nil, errs.ErrAccountNotFound)
+				repo.On("Create", mock.Anything, mock.AnythingOfType("*entity.Account")).Return(nil)
+				cache.On("Set", mock.Anything, mock.AnythingOfType("string"), mock.Anything, 15*time.Minute).Return(nil)
+				logger.On("Info", mock.Anything, mock.Anything).Return()
+			},
+			expectedError: nil,
+			validateResult: func(t *testing.T, result *dto.AccountResponse) {
+				assert.Equal(t, 250.0, result.Balance)
+				assert.Equal(t, "USD", result.Currency)
+			},
+		},
+		{
+			name: "fail_unknown_currency",
+			request: dto.AccountRequest{
+				AccountName:    "Points Account",
+				InitialBalance: initialBalance(250),
+				Currency:       "PTS",
+			},
+			setupMocks: func(repo *MockAccountRepository, cache *MockCacheService, logger *MockLogger) {
+				repo.On("GetByAccountName", mock.Anything, "Points Account").Return(nil, errs.ErrAccountNotFound)
+				logger.On("Info", mock.Anything, mock.Anything).Return()
+			},
+			expectedError: errs.ValidationError{Field: "currency", Message: "currency must be an ISO 4217 code such as THB or USD"},
+			validateResult: func(t *testing.T, result *dto.AccountResponse) {
+				assert.Nil(t, result)
+			},
+		},
 		{
 			name: "fail_account_already_exists",
-			request: dto.CreateAccountRequest{
+			request: dto.AccountRequest{
 				AccountName:    "Existing Account",
-				InitialBalance: 500.0,
+				InitialBalance: initialBalance(500),
 			},
 			setupMocks: func(repo *MockAccountRepository, cache *MockCacheService, logger *MockLogger) {
 				existingAccount := createTestAccount()
@@ -179,9 +316,9 @@ func TestAccountUseCase_CreateAccount(t *testing.T) {
 		},
 		{
 			name: "fail_repository_error",
-			request: dto.CreateAccountRequest{
+			request: dto.AccountRequest{
 				AccountName:    "Test Account",
-				InitialBalance: 1000.0,
+				InitialBalance: initialBalance(1000),
 			},
 			setupMocks: func(repo *MockAccountRepository, cache *MockCacheService, logger *MockLogger) {
 				repo.On("GetByAccountName", mock.Anything, "Test Account").Return(nil, errs.ErrAccountNotFound)
@@ -206,7 +343,7 @@ func TestAccountUseCase_CreateAccount(t *testing.T) {
 			tt.setupMocks(mockRepo, mockCache, mockLogger)
 
 			// Create use case
-			uc := NewAccountUseCase(mockRepo, mockCache, mockLogger)
+			uc := NewAccountUseCase(mockRepo, nil, mockCache, nil, &StubEventPublisher{}, vo.AccountNameScopeGlobal, "THB", mockLogger)
 
 			// Execute
 			result, err := uc.CreateAccount(context.Background(), tt.request)
@@ -228,6 +365,153 @@ func TestAccountUseCase_CreateAccount(t *testing.T) {
 	}
 }
 
+func TestAccountUseCase_CreateAccount_NameScope(t *testing.T) {
+	existing := createTestAccount()
+	request := dto.AccountRequest{AccountName: "Test Account", CustomerID: "CUST-2", InitialBalance: initialBalance(100)}
+
+	tests := []struct {
+		name          string
+		scope         vo.AccountNameScope
+		setupMocks    func(*MockAccountRepository)
+		expectedError error
+	}{
+		{
+			name:  "customer_scope_allows_name_of_other_customer",
+			scope: vo.AccountNameScopeCustomer,
+			setupMocks: func(repo *MockAccountRepository) {
+				repo.On("GetByCustomerAccountName", mock.Anything, "CUST-2", "Test Account").Return(nil, errs.ErrAccountNotFound)
+				repo.On("Create", mock.Anything, mock.AnythingOfType("*entity.Account")).Return(nil)
+			},
+		},
+		{
+			name:  "customer_scope_rejects_name_of_same_customer",
+			scope: vo.AccountNameScopeCustomer,
+			setupMocks: func(repo *MockAccountRepository) {
+				repo.On("GetByCustomerAccountName", mock.Anything, "CUST-2", "Test Account").Return(existing, nil)
+			},
+			expectedError: errs.ErrAccountAlreadyExists,
+		},
+		{
+			name:  "global_scope_rejects_name_of_any_account",
+			scope: vo.AccountNameScopeGlobal,
+			setupMocks: func(repo *MockAccountRepository) {
+				repo.On("GetByAccountName", mock.Anything, "Test Account").Return(existing, nil)
+			},
+			expectedError: errs.ErrAccountAlreadyExists,
+		},
+		{
+			name:  "no_scope_allows_duplicates",
+			scope: vo.AccountNameScopeNone,
+			setupMocks: func(repo *MockAccountRepository) {
+				repo.On("Create", mock.Anything, mock.AnythingOfType("*entity.Account")).Return(nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(MockAccountRepository)
+			mockCache := new(MockCacheService)
+			mockLogger := new(MockLogger)
+			tt.setupMocks(mockRepo)
+			mockCache.On("Set", mock.Anything, mock.Anything, mock.Anything, 15*time.Minute).Return(nil).Maybe()
+			mockLogger.On("Info", mock.Anything, mock.Anything).Maybe()
+			mockLogger.On("Warn", mock.Anything, mock.Anything).Maybe()
+
+			uc := NewAccountUseCase(mockRepo, nil, mockCache, nil, &StubEventPublisher{}, tt.scope, "THB", mockLogger)
+			result, err := uc.CreateAccount(context.Background(), request)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				mockRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "CUST-2", result.CustomerID)
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestAccountUseCase_UpdateAccount_KeepsOwnName(t *testing.T) {
+	account := createTestAccount()
+	account.CustomerID = "CUST-1"
+	mockRepo := new(MockAccountRepository)
+	mockCache := new(MockCacheService)
+	mockLogger := new(MockLogger)
+	renamed := *account
+	renamed.AccountName = "Savings"
+
+	mockRepo.On("GetByID", mock.Anything, account.ID).Return(account, nil)
+	mockRepo.On("GetByCustomerAccountName", mock.Anything, "CUST-1", "Savings").Return(&renamed, nil)
+	mockRepo.On("Update", mock.Anything, account).Return(nil)
+	mockCache.On("Set", mock.Anything, mock.Anything, mock.Anything, 15*time.Minute).Return(nil)
+	mockLogger.On("Info", mock.Anything, mock.Anything).Maybe()
+
+	uc := NewAccountUseCase(mockRepo, nil, mockCache, nil, &StubEventPublisher{}, vo.AccountNameScopeCustomer, "THB", mockLogger)
+	result, err := uc.UpdateAccount(context.Background(), dto.AccountRequest{ID: account.ID.String(), AccountName: "Savings"})
+
+	require.NoError(t, err)
+	assert.Equal(t, "Savings", result.AccountName)
+}
+
+func TestAccountUseCase_GetAccountWithNameHistory(t *testing.T) {
+	account := createTestAccount()
+	changedAt := time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)
+	mockRepo := new(MockAccountRepository)
+	mockLogger := new(MockLogger)
+
+	mockRepo.On("GetByID", mock.Anything, account.ID).Return(account, nil)
+	mockRepo.On("GetNameHistory", mock.Anything, account.ID).Return([]*entity.AccountNameChange{
+		{AccountID: account.ID, PreviousName: "Savings", NewName: "Test Account", ChangedAt: changedAt},
+	}, nil)
+
+	uc := NewAccountUseCase(mockRepo, nil, new(MockCacheService), nil, &StubEventPublisher{}, vo.AccountNameScopeCustomer, "THB", mockLogger)
+	result, err := uc.GetAccountWithNameHistory(context.Background(), account.ID.String())
+
+	require.NoError(t, err)
+	assert.Equal(t, "Test Account", result.AccountName)
+	assert.Equal(t, []dto.AccountNameChangeResponse{{PreviousName: "Savings", NewName: "Test Account", ChangedAt: changedAt}}, result.NameHistory)
+}
+
+func TestAccountUseCase_ListAccounts_Filters(t *testing.T) {
+	from := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2024, 3, 31, 0, 0, 0, 0, time.UTC)
+	minBalance := vo.NewMoneyFromFloat(100)
+	req := dto.AccountListRequest{
+		ListRequest: dto.ListRequest{Page: 1, PageSize: 10},
+		Status:      "ACTIVE",
+		CustomerID:  "CUST-1",
+		MinBalance:  initialBalance(100),
+		CreatedFrom: &from,
+		CreatedTo:   &to,
+	}
+	expected := repository.AccountFilter{
+		Status:        vo.AccountStatusActive,
+		CustomerID:    "CUST-1",
+		MinBalance:    &minBalance,
+		CreatedFrom:   from,
+		CreatedBefore: time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	mockRepo := new(MockAccountRepository)
+	mockCache := new(MockCacheService)
+	mockLogger := new(MockLogger)
+	mockLogger.On("Debug", mock.Anything, mock.Anything).Maybe()
+	mockCache.On("Get", mock.Anything, mock.Anything, mock.Anything).Return(infra.ErrCacheMiss)
+	mockCache.On("Set", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	mockRepo.On("List", mock.Anything, expected, 10, 0).Return([]*entity.Account{createTestAccount()}, nil)
+	mockRepo.On("Count", mock.Anything, expected).Return(int64(1), nil)
+
+	lists := NewListCache(mockCache, nil, mockLogger)
+	uc := NewAccountUseCase(mockRepo, nil, mockCache, lists, &StubEventPublisher{}, vo.AccountNameScopeGlobal, "THB", mockLogger)
+	result, err := uc.ListAccounts(context.Background(), req)
+
+	require.NoError(t, err)
+	assert.Len(t, result.Accounts, 1)
+	mockRepo.AssertExpectations(t)
+}
+
 func TestAccountUseCase_GetAccount(t *testing.T) {
 	tests := []struct {
 		name           string
@@ -241,7 +525,7 @@ func TestAccountUseCase_GetAccount(t *testing.T) {
 			accountID: "2024072912345678",
 			setupMocks: func(repo *MockAccountRepository, cache *MockCacheService, logger *MockLogger) {
 				account := createTestAccount()
-				cache.On("Get", mock.Anything, "account:2024072912345678", mock.Anything).Return(errors.New("cache miss"))
+				cache.On("Get", mock.Anything, "account:2024072912345678", mock.Anything).Return(infra.ErrCacheMiss)
 				repo.On("GetByID", mock.Anything, mock.AnythingOfType("vo.AccountID")).Return(account, nil)
 				cache.On("Set", mock.Anything, "account:2024072912345678", mock.Anything, 15*time.Minute).Return(nil)
 				logger.On("Debug", mock.Anything, mock.Anything).Return()
@@ -269,7 +553,7 @@ func TestAccountUseCase_GetAccount(t *testing.T) {
 			name:      "fail_account_not_found",
 			accountID: "2024072912345678",
 			setupMocks: func(repo *MockAccountRepository, cache *MockCacheService, logger *MockLogger) {
-				cache.On("Get", mock.Anything, "account:2024072912345678", mock.Anything).Return(errors.New("cache miss"))
+				cache.On("Get", mock.Anything, "account:2024072912345678", mock.Anything).Return(infra.ErrCacheMiss)
 				repo.On("GetByID", mock.Anything, mock.AnythingOfType("vo.AccountID")).Return(&entity.Account{}, errs.ErrAccountNotFound)
 				logger.On("Debug", mock.Anything, mock.Anything).Return()
 				logger.On("Error", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return()
@@ -291,7 +575,7 @@ func TestAccountUseCase_GetAccount(t *testing.T) {
 			tt.setupMocks(mockRepo, mockCache, mockLogger)
 
 			// Create use case
-			uc := NewAccountUseCase(mockRepo, mockCache, mockLogger)
+			uc := NewAccountUseCase(mockRepo, nil, mockCache, nil, &StubEventPublisher{}, vo.AccountNameScopeGlobal, "THB", mockLogger)
 
 			// Execute
 			result, err := uc.GetAccount(context.Background(), tt.accountID)
@@ -313,23 +597,86 @@ func TestAccountUseCase_GetAccount(t *testing.T) {
 	}
 }
 
+func TestAccountUseCase_GetAccounts(t *testing.T) {
+	cachedID, loadedID, unknownID := "2024072912345678", "2024072987654321", "2024072900000000"
+	keys := []string{"account:" + cachedID, "account:" + loadedID, "account:" + unknownID}
+	loaded := createTestAccount()
+	loaded.ID, _ = vo.NewAccountIDFromString(loadedID)
+	loadedAccountID := loaded.ID
+	unknownAccountID, _ := vo.NewAccountIDFromString(unknownID)
+
+	mockRepo := new(MockAccountRepository)
+	mockCache := new(MockCacheService)
+	mockLogger := new(MockLogger)
+	mockLogger.On("Debug", mock.Anything, mock.Anything).Return()
+
+	mockCache.On("GetMany", mock.Anything, keys, mock.Anything).
+		Run(func(args mock.Arguments) {
+			*args.Get(2).([]interface{})[0].(*dto.AccountResponse) = dto.AccountResponse{ID: cachedID, AccountName: "Cached Account"}
+		}).
+		Return([]bool{true, false, false}, nil)
+	mockRepo.On("GetByIDs", mock.Anything, []vo.AccountID{loadedAccountID, unknownAccountID}).
+		Return(map[vo.AccountID]*entity.Account{loadedAccountID: loaded}, nil)
+	mockCache.On("Set", mock.Anything, "account:"+loadedID, mock.Anything, 15*time.Minute).Return(nil)
+
+	uc := NewAccountUseCase(mockRepo, nil, mockCache, nil, &StubEventPublisher{}, vo.AccountNameScopeGlobal, "THB", mockLogger)
+	result, err := uc.GetAccounts(context.Background(), []string{cachedID, loadedID, unknownID})
+
+	require.NoError(t, err)
+	require.Len(t, result, 2)
+	assert.Equal(t, "Cached Account", result[0].AccountName)
+	assert.Equal(t, loadedID, result[1].ID)
+	mockRepo.AssertExpectations(t)
+	mockCache.AssertExpectations(t)
+}
+
+func TestAccountUseCase_BatchGetAccounts(t *testing.T) {
+	foundID, unknownID := "2024072912345678", "2024072900000000"
+	account := createTestAccount()
+	account.ID, _ = vo.NewAccountIDFromString(foundID)
+	unknownAccountID, _ := vo.NewAccountIDFromString(unknownID)
+
+	mockRepo := new(MockAccountRepository)
+	mockCache := new(MockCacheService)
+	mockLogger := new(MockLogger)
+	mockLogger.On("Debug", mock.Anything, mock.Anything).Return()
+
+	mockCache.On("GetMany", mock.Anything, []string{"account:" + unknownID, "account:" + foundID}, mock.Anything).
+		Return([]bool{false, false}, nil)
+	mockRepo.On("GetByIDs", mock.Anything, []vo.AccountID{unknownAccountID, account.ID}).
+		Return(map[vo.AccountID]*entity.Account{account.ID: account}, nil)
+	mockCache.On("Set", mock.Anything, "account:"+foundID, mock.Anything, 15*time.Minute).Return(nil)
+
+	uc := NewAccountUseCase(mockRepo, nil, mockCache, nil, &StubEventPublisher{}, vo.AccountNameScopeGlobal, "THB", mockLogger)
+	result, err := uc.BatchGetAccounts(context.Background(), dto.BatchGetRequest{IDs: []string{unknownID, foundID, unknownID}})
+
+	// The repeated unknown ID is looked up and reported once
+	require.NoError(t, err)
+	require.Len(t, result.Found, 1)
+	assert.Equal(t, foundID, result.Found[0].ID)
+	assert.Equal(t, []string{unknownID}, result.Missing)
+	mockRepo.AssertExpectations(t)
+	mockCache.AssertExpectations(t)
+}
+
 func TestAccountUseCase_UpdateAccount(t *testing.T) {
 	tests := []struct {
 		name           string
-		request        dto.UpdateAccountRequest
+		request        dto.AccountRequest
 		setupMocks     func(*MockAccountRepository, *MockCacheService, *MockLogger)
 		expectedError  error
 		validateResult func(*testing.T, *dto.AccountResponse)
 	}{
 		{
 			name: "success_update_account",
-			request: dto.UpdateAccountRequest{
+			request: dto.AccountRequest{
 				ID:          "2024072912345678",
 				AccountName: "Updated Account Name",
 			},
 			setupMocks: func(repo *MockAccountRepository, cache *MockCacheService, logger *MockLogger) {
 				account := createTestAccount()
 				repo.On("GetByID", mock.Anything, mock.AnythingOfType("vo.AccountID")).Return(account, nil)
+				repo.On("GetByAccountName", mock.Anything, "Updated Account Name").Return(nil, errs.ErrAccountNotFound)
 				repo.On("Update", mock.Anything, mock.AnythingOfType("*entity.Account")).Return(nil)
 				cache.On("Set", mock.Anything, "account:2024072912345678", mock.Anything, 15*time.Minute).Return(nil)
 				logger.On("Info", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return()
@@ -342,7 +689,7 @@ func TestAccountUseCase_UpdateAccount(t *testing.T) {
 		},
 		{
 			name: "fail_account_not_found",
-			request: dto.UpdateAccountRequest{
+			request: dto.AccountRequest{
 				ID:          "2024072912345678",
 				AccountName: "Updated Account Name",
 			},
@@ -368,7 +715,7 @@ func TestAccountUseCase_UpdateAccount(t *testing.T) {
 			tt.setupMocks(mockRepo, mockCache, mockLogger)
 
 			// Create use case
-			uc := NewAccountUseCase(mockRepo, mockCache, mockLogger)
+			uc := NewAccountUseCase(mockRepo, nil, mockCache, nil, &StubEventPublisher{}, vo.AccountNameScopeGlobal, "THB", mockLogger)
 
 			// Execute
 			result, err := uc.UpdateAccount(context.Background(), tt.request)
@@ -403,6 +750,7 @@ func TestAccountUseCase_DeleteAccount(t *testing.T) {
 			setupMocks: func(repo *MockAccountRepository, cache *MockCacheService, logger *MockLogger) {
 				account := createTestAccount()
 				repo.On("GetByID", mock.Anything, mock.AnythingOfType("vo.AccountID")).Return(account, nil)
+				repo.On("GetChildren", mock.Anything, mock.AnythingOfType("vo.AccountID")).Return([]*entity.Account{}, nil)
 				repo.On("Delete", mock.Anything, mock.AnythingOfType("vo.AccountID")).Return(nil)
 				cache.On("Delete", mock.Anything, "account:2024072912345678").Return(nil)
 				logger.On("Info", mock.Anything, mock.Anything).Return()
@@ -420,6 +768,17 @@ func TestAccountUseCase_DeleteAccount(t *testing.T) {
 			},
 			expectedError: errs.ErrAccountNotFound,
 		},
+		{
+			name:      "fail_account_has_children",
+			accountID: "2024072912345678",
+			setupMocks: func(repo *MockAccountRepository, cache *MockCacheService, logger *MockLogger) {
+				repo.On("GetByID", mock.Anything, mock.AnythingOfType("vo.AccountID")).Return(createTestAccount(), nil)
+				repo.On("GetChildren", mock.Anything, mock.AnythingOfType("vo.AccountID")).Return([]*entity.Account{createTestAccount()}, nil)
+				logger.On("Info", mock.Anything, mock.Anything).Return()
+				logger.On("Warn", mock.Anything, mock.Anything).Return()
+			},
+			expectedError: errs.ErrAccountHasChildren,
+		},
 	}
 
 	for _, tt := range tests {
@@ -432,7 +791,7 @@ func TestAccountUseCase_DeleteAccount(t *testing.T) {
 			tt.setupMocks(mockRepo, mockCache, mockLogger)
 
 			// Create use case
-			uc := NewAccountUseCase(mockRepo, mockCache, mockLogger)
+			uc := NewAccountUseCase(mockRepo, nil, mockCache, nil, &StubEventPublisher{}, vo.AccountNameScopeGlobal, "THB", mockLogger)
 
 			// Execute
 			err := uc.DeleteAccount(context.Background(), tt.accountID)
@@ -494,7 +853,7 @@ func TestAccountUseCase_SuspendAccount(t *testing.T) {
 			tt.setupMocks(mockRepo, mockCache, mockLogger)
 
 			// Create use case
-			uc := NewAccountUseCase(mockRepo, mockCache, mockLogger)
+			uc := NewAccountUseCase(mockRepo, nil, mockCache, nil, &StubEventPublisher{}, vo.AccountNameScopeGlobal, "THB", mockLogger)
 
 			// Execute
 			err := uc.SuspendAccount(context.Background(), tt.accountID)
@@ -557,7 +916,7 @@ func TestAccountUseCase_ActivateAccount(t *testing.T) {
 			tt.setupMocks(mockRepo, mockCache, mockLogger)
 
 			// Create use case
-			uc := NewAccountUseCase(mockRepo, mockCache, mockLogger)
+			uc := NewAccountUseCase(mockRepo, nil, mockCache, nil, &StubEventPublisher{}, vo.AccountNameScopeGlobal, "THB", mockLogger)
 
 			// Execute
 			err := uc.ActivateAccount(context.Background(), tt.accountID)
@@ -0,0 +1,133 @@
+// internal/application/accounting_period.go
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/application/dto"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/infra"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/repository"
+)
+
+// PeriodLock rejects postings into closed accounting periods
+type PeriodLock struct {
+	periodRepo repository.AccountingPeriodRepository
+}
+
+// NewPeriodLock creates a period lock over the recorded period closes
+func NewPeriodLock(periodRepo repository.AccountingPeriodRepository) *PeriodLock {
+	return &PeriodLock{periodRepo: periodRepo}
+}
+
+// CheckOpen returns ErrPeriodClosed when the value date falls in a closed period. A nil lock
+// allows every posting.
+func (l *PeriodLock) CheckOpen(ctx context.Context, valueDate time.Time) error {
+	if l == nil {
+		return nil
+	}
+
+	latest, err := l.periodRepo.GetLatest(ctx)
+	if errors.Is(err, errs.ErrPeriodNotClosed) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	period := entity.AccountingPeriodOf(valueDate)
+	if latest.Locks(period) {
+		return fmt.Errorf("%w: %s is closed through %s, post an adjustment into the current period instead", errs.ErrPeriodClosed, period, latest.Period)
+	}
+	return nil
+}
+
+type accountingPeriodUseCase struct {
+	periodRepo  repository.AccountingPeriodRepository
+	valueDating *ValueDatingPolicy
+	logger      infra.Logger
+	mapper      *dto.AccountingPeriodMapper
+}
+
+// NewAccountingPeriodUseCase creates a new accounting period use case; the current period is the
+// month of the business date of valueDating
+func NewAccountingPeriodUseCase(periodRepo repository.AccountingPeriodRepository, valueDating *ValueDatingPolicy, logger infra.Logger) AccountingPeriodUseCase {
+	return &accountingPeriodUseCase{
+		periodRepo:  periodRepo,
+		valueDating: valueDating,
+		logger:      logger,
+		mapper:      &dto.AccountingPeriodMapper{},
+	}
+}
+
+// ClosePeriod closes the books for a month that has ended, locking it and every earlier month
+func (uc *accountingPeriodUseCase) ClosePeriod(ctx context.Context, req dto.ClosePeriodRequest) (*dto.AccountingPeriodResponse, error) {
+	latest, err := uc.periodRepo.GetLatest(ctx)
+	if errors.Is(err, errs.ErrPeriodNotClosed) {
+		latest, err = nil, nil
+	}
+	if err != nil {
+		uc.logger.Error("Failed to get latest closed period", "error", err)
+		return nil, err
+	}
+
+	period, err := entity.CloseAccountingPeriod(req.Period, uc.valueDating.Today(), req.ClosedBy, req.Reason, latest)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.periodRepo.Create(ctx, period); err != nil {
+		uc.logger.Error("Failed to close accounting period", "error", err, "period", period.Period)
+		return nil, err
+	}
+
+	uc.logger.Info("Accounting period closed", "period", period.Period, "closedBy", period.ClosedBy, "reason", period.Reason)
+	response := uc.mapper.ToResponse(period.Period, period)
+	return &response, nil
+}
+
+// GetPeriod retrieves the status of a period, with the close that locked it when closed
+func (uc *accountingPeriodUseCase) GetPeriod(ctx context.Context, period string) (*dto.AccountingPeriodResponse, error) {
+	start, err := entity.ParseAccountingPeriod(period)
+	if err != nil {
+		return nil, err
+	}
+	period = entity.AccountingPeriodOf(start)
+
+	closing, err := uc.periodRepo.GetClosing(ctx, period)
+	if errors.Is(err, errs.ErrPeriodNotClosed) {
+		closing, err = nil, nil
+	}
+	if err != nil {
+		uc.logger.Error("Failed to get accounting period", "error", err, "period", period)
+		return nil, err
+	}
+
+	response := uc.mapper.ToResponse(period, closing)
+	return &response, nil
+}
+
+// ListPeriods retrieves the period closes, latest first, with the current period
+func (uc *accountingPeriodUseCase) ListPeriods(ctx context.Context) (*dto.AccountingPeriodListResponse, error) {
+	periods, err := uc.periodRepo.List(ctx)
+	if err != nil {
+		uc.logger.Error("Failed to list accounting periods", "error", err)
+		return nil, err
+	}
+
+	response := &dto.AccountingPeriodListResponse{
+		CurrentPeriod: entity.AccountingPeriodOf(uc.valueDating.Today()),
+		Periods:       make([]dto.AccountingPeriodResponse, len(periods)),
+	}
+	for i, period := range periods {
+		response.Periods[i] = uc.mapper.ToResponse(period.Period, period)
+	}
+	if len(periods) > 0 {
+		response.ClosedThrough = periods[0].Period
+	}
+	return response, nil
+}
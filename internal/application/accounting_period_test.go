@@ -0,0 +1,208 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/application/dto"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/event"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockAccountingPeriodRepository mocks the AccountingPeriodRepository interface
+type MockAccountingPeriodRepository struct {
+	mock.Mock
+}
+
+func (m *MockAccountingPeriodRepository) Create(ctx context.Context, period *entity.AccountingPeriod) error {
+	args := m.Called(ctx, period)
+	return args.Error(0)
+}
+
+func (m *MockAccountingPeriodRepository) GetLatest(ctx context.Context) (*entity.AccountingPeriod, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.AccountingPeriod), args.Error(1)
+}
+
+func (m *MockAccountingPeriodRepository) GetClosing(ctx context.Context, period string) (*entity.AccountingPeriod, error) {
+	args := m.Called(ctx, period)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.AccountingPeriod), args.Error(1)
+}
+
+func (m *MockAccountingPeriodRepository) List(ctx context.Context) ([]*entity.AccountingPeriod, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.AccountingPeriod), args.Error(1)
+}
+
+// newTestAccountingPeriodUseCase creates an accounting period use case whose today is 2024-03-15
+func newTestAccountingPeriodUseCase(periodRepo *MockAccountingPeriodRepository) AccountingPeriodUseCase {
+	logger := new(MockLogger)
+	logger.On("Info", mock.Anything, mock.Anything).Maybe()
+	logger.On("Error", mock.Anything, mock.Anything).Maybe()
+
+	valueDating := NewValueDatingPolicy(&StubCalendar{}, vo.ProcessingWindow{}, nil)
+	valueDating.now = func() time.Time { return time.Date(2024, 3, 15, 10, 0, 0, 0, time.UTC) }
+	return NewAccountingPeriodUseCase(periodRepo, valueDating, logger)
+}
+
+func TestAccountingPeriodUseCase_ClosePeriod(t *testing.T) {
+	ctx := context.Background()
+	periodRepo := new(MockAccountingPeriodRepository)
+	uc := newTestAccountingPeriodUseCase(periodRepo)
+
+	periodRepo.On("GetLatest", ctx).Return(&entity.AccountingPeriod{Period: "2024-01"}, nil)
+	periodRepo.On("Create", ctx, mock.AnythingOfType("*entity.AccountingPeriod")).Return(nil)
+
+	result, err := uc.ClosePeriod(ctx, dto.ClosePeriodRequest{Period: "2024-02", ClosedBy: "ops", Reason: "month end"})
+
+	require.NoError(t, err)
+	assert.Equal(t, "2024-02", result.Period)
+	assert.Equal(t, "CLOSED", result.Status)
+	assert.Equal(t, "ops", result.ClosedBy)
+
+	// The current month has not ended
+	_, err = uc.ClosePeriod(ctx, dto.ClosePeriodRequest{Period: "2024-03", ClosedBy: "ops"})
+	assert.IsType(t, errs.ValidationError{}, err)
+
+	_, err = uc.ClosePeriod(ctx, dto.ClosePeriodRequest{Period: "2024-01", ClosedBy: "ops"})
+	assert.ErrorIs(t, err, errs.ErrPeriodAlreadyClosed)
+	periodRepo.AssertNumberOfCalls(t, "Create", 1)
+}
+
+func TestAccountingPeriodUseCase_GetPeriod(t *testing.T) {
+	ctx := context.Background()
+	periodRepo := new(MockAccountingPeriodRepository)
+	uc := newTestAccountingPeriodUseCase(periodRepo)
+
+	closing := &entity.AccountingPeriod{Period: "2024-02", ClosedBy: "ops", ClosedAt: time.Now()}
+	periodRepo.On("GetClosing", ctx, "2024-01").Return(closing, nil)
+	periodRepo.On("GetClosing", ctx, "2024-03").Return(nil, errs.ErrPeriodNotClosed)
+
+	// January was locked by the February close
+	result, err := uc.GetPeriod(ctx, "2024-01")
+	require.NoError(t, err)
+	assert.Equal(t, "2024-01", result.Period)
+	assert.Equal(t, "CLOSED", result.Status)
+	assert.Equal(t, "ops", result.ClosedBy)
+
+	result, err = uc.GetPeriod(ctx, "2024-03")
+	require.NoError(t, err)
+	assert.Equal(t, "OPEN", result.Status)
+	assert.Nil(t, result.ClosedAt)
+
+	_, err = uc.GetPeriod(ctx, "March")
+	assert.IsType(t, errs.ValidationError{}, err)
+}
+
+func TestAccountingPeriodUseCase_ListPeriods(t *testing.T) {
+	ctx := context.Background()
+	periodRepo := new(MockAccountingPeriodRepository)
+	uc := newTestAccountingPeriodUseCase(periodRepo)
+
+	periodRepo.On("List", ctx).Return([]*entity.AccountingPeriod{{Period: "2024-02"}, {Period: "2024-01"}}, nil)
+
+	result, err := uc.ListPeriods(ctx)
+
+	require.NoError(t, err)
+	assert.Equal(t, "2024-03", result.CurrentPeriod)
+	assert.Equal(t, "2024-02", result.ClosedThrough)
+	assert.Len(t, result.Periods, 2)
+}
+
+func TestPeriodLock_CheckOpen(t *testing.T) {
+	ctx := context.Background()
+	periodRepo := new(MockAccountingPeriodRepository)
+	lock := NewPeriodLock(periodRepo)
+
+	// A nil lock, as when no lock is configured, never rejects postings
+	var none *PeriodLock
+	assert.NoError(t, none.CheckOpen(ctx, time.Now()))
+
+	periodRepo.On("GetLatest", ctx).Return(&entity.AccountingPeriod{Period: "2024-02"}, nil)
+
+	err := lock.CheckOpen(ctx, time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC))
+	assert.ErrorIs(t, err, errs.ErrPeriodClosed)
+	assert.Equal(t, vo.FailureKindBusiness, failureKind(err))
+	assert.NoError(t, lock.CheckOpen(ctx, time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)))
+}
+
+func (suite *TransactionUseCaseTestSuite) TestConfirmTransaction_ClosedPeriod() {
+	id := suite.expectConfirmationLock()
+	periodRepo := new(MockAccountingPeriodRepository)
+	periodRepo.On("GetLatest", suite.ctx).Return(&entity.AccountingPeriod{Period: entity.AccountingPeriodOf(suite.testTransaction.ValueDate)}, nil)
+	suite.usecase.(*transactionUseCase).periods = NewPeriodLock(periodRepo)
+
+	suite.mockTxnRepo.On("GetByID", suite.ctx, suite.testTransaction.ID).Return(suite.testTransaction, nil)
+	suite.mockTxnRepo.On("Update", suite.ctx, suite.testTransaction).Return(nil)
+
+	_, err := suite.usecase.ConfirmTransaction(suite.ctx, dto.ConfirmTransactionRequest{ID: id})
+
+	// Nothing is posted and the failure cannot be replayed
+	assert.ErrorIs(suite.T(), err, errs.ErrPeriodClosed)
+	assert.Equal(suite.T(), vo.TransactionStatusFailed, suite.testTransaction.Status)
+	assert.Equal(suite.T(), vo.FailureKindBusiness, suite.testTransaction.FailureKind)
+	suite.mockAccountRepo.AssertNotCalled(suite.T(), "UpdateFenced", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *TransactionUseCaseTestSuite) TestAdjustTransaction() {
+	suite.Require().NoError(suite.testTransaction.MarkAsCompleted(time.Now()))
+	periodRepo := new(MockAccountingPeriodRepository)
+	periodRepo.On("GetLatest", suite.ctx).Return(nil, errs.ErrPeriodNotClosed)
+	suite.usecase.(*transactionUseCase).periods = NewPeriodLock(periodRepo)
+
+	suite.mockTxnRepo.On("GetByID", suite.ctx, suite.testTransaction.ID).Return(suite.testTransaction, nil)
+	suite.mockTxnRepo.On("Create", suite.ctx, mock.AnythingOfType("*entity.Transaction")).Return(nil)
+	suite.mockTxnRepo.On("Update", suite.ctx, mock.AnythingOfType("*entity.Transaction")).Return(nil)
+	suite.mockAccountRepo.On("GetByID", suite.ctx, suite.testAccount.ID).Return(suite.testAccount, nil)
+	suite.mockAccountRepo.On("GetByIDForUpdate", suite.ctx, suite.testAccount.ID).Return(suite.testAccount, nil)
+	suite.mockAccountRepo.On("UpdateFenced", suite.ctx, suite.testAccount, mock.Anything, mock.AnythingOfType("int64")).Return(nil)
+	suite.mockCache.On("Set", suite.ctx, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	suite.mockCache.On("Delete", suite.ctx, mock.Anything).Return(nil)
+
+	amount := dto.NewDecimalStringFromFloat(25)
+	result, err := suite.usecase.AdjustTransaction(suite.ctx, dto.AdjustTransactionRequest{
+		ID:          suite.testTransaction.ID.String(),
+		Amount:      &amount,
+		RequestedBy: "alice",
+		Reason:      "fee charged twice",
+	})
+
+	suite.Require().NoError(err)
+	assert.Equal(suite.T(), "COMPLETED", result.Status)
+	assert.Equal(suite.T(), "DEBIT", result.TransactionType)
+	suite.Require().NotNil(result.AdjustsID)
+	assert.Equal(suite.T(), suite.testTransaction.ID.String(), *result.AdjustsID)
+	assert.Equal(suite.T(), "ADJ-"+suite.testTransaction.ID.String(), result.Reference)
+	assert.True(suite.T(), suite.testAccount.Balance.Equal(vo.NewMoneyFromFloat(975)))
+	suite.Require().Len(suite.mockEvents.Events, 2)
+	assert.Equal(suite.T(), event.TransactionCreated, suite.mockEvents.Events[0].Type)
+	assert.Equal(suite.T(), event.TransactionCompleted, suite.mockEvents.Events[1].Type)
+}
+
+func (suite *TransactionUseCaseTestSuite) TestAdjustTransaction_NotFound() {
+	suite.mockTxnRepo.On("GetByID", suite.ctx, suite.testTransaction.ID).Return(nil, errs.ErrTransactionNotFound)
+
+	_, err := suite.usecase.AdjustTransaction(suite.ctx, dto.AdjustTransactionRequest{
+		ID:          suite.testTransaction.ID.String(),
+		RequestedBy: "alice",
+		Reason:      "typo",
+	})
+
+	assert.ErrorIs(suite.T(), err, errs.ErrTransactionNotFound)
+	suite.mockTxnRepo.AssertNotCalled(suite.T(), "Create", mock.Anything, mock.Anything)
+}
@@ -0,0 +1,239 @@
+// internal/application/adjustment.go
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hydr0g3nz/mini_bank/internal/application/dto"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/event"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+)
+
+const (
+	// adjustmentReviewReason is recorded on ADJUSTMENT transactions waiting for a second admin
+	adjustmentReviewReason = "adjustment awaiting approval by a second admin"
+	// maxAdjustmentReportPeriods bounds the number of periods an adjustment report covers
+	maxAdjustmentReportPeriods = 36
+)
+
+// AdjustTransaction posts an adjustment correcting a transaction. Postings into a closed period are
+// rejected, so corrections are booked today, in the current period, linked to the transaction they
+// correct. The type, accounts and amount default to the original's, reposting it. Adjustments are
+// admin postings: they are processed at once, without business rules, product fees or review.
+func (uc *transactionUseCase) AdjustTransaction(ctx context.Context, req dto.AdjustTransactionRequest) (*dto.TransactionResponse, error) {
+	originalID, err := vo.NewTransactionIDFromString(req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	original, err := uc.transactionRepo.GetByID(ctx, originalID)
+	if err != nil {
+		return nil, errs.ErrTransactionNotFound
+	}
+
+	createReq := adjustmentRequest(original, req)
+	fromAccountID, toAccountID, transactionType, amount, description, reference, err := uc.mapper.FromCreateRequest(createReq)
+	if err != nil {
+		return nil, err
+	}
+
+	currency, _, err := uc.validateAccountsForTransaction(ctx, fromAccountID, toAccountID, transactionType)
+	if err != nil {
+		return nil, err
+	}
+	amount = amount.In(currency)
+
+	var adjustment *entity.Transaction
+	switch transactionType {
+	case vo.TransactionTypeDebit:
+		adjustment, err = entity.NewDebitTransaction(*fromAccountID, amount, description, reference, uc.valueDating.Now())
+	case vo.TransactionTypeCredit:
+		adjustment, err = entity.NewCreditTransaction(*toAccountID, amount, description, reference, uc.valueDating.Now())
+	case vo.TransactionTypeTransfer:
+		adjustment, err = entity.NewTransferTransaction(*fromAccountID, *toAccountID, amount, description, reference, uc.valueDating.Now())
+	default:
+		return nil, errs.ErrInvalidInput
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := adjustment.AdjustFor(original); err != nil {
+		return nil, err
+	}
+	if err := adjustment.SetValueDate(uc.valueDating.Today()); err != nil {
+		return nil, err
+	}
+	uc.categorizer.Categorize(ctx, adjustment)
+
+	if err := uc.transactionRepo.Create(ctx, adjustment); err != nil {
+		uc.logger.Error("Failed to save adjustment", "error", err, "transactionID", adjustment.ID.String(), "adjustsID", req.ID)
+		return nil, err
+	}
+	uc.publish(ctx, event.NewTransactionEvent(event.TransactionCreated, adjustment))
+
+	uc.logger.Info("Posting adjustment", "transactionID", adjustment.ID.String(), "adjustsID", req.ID,
+		"requestedBy", req.RequestedBy, "reason", req.Reason)
+
+	response, err := uc.finalize(ctx, adjustment, fmt.Sprintf("confirm_transaction:%s", adjustment.ID.String()))
+	if err != nil {
+		return nil, err
+	}
+
+	uc.logger.Info("Adjustment posted successfully", "transactionID", adjustment.ID.String(), "adjustsID", req.ID)
+	return response, nil
+}
+
+// adjustmentRequest fills an adjustment's omitted type, accounts and amount with the original's
+func adjustmentRequest(original *entity.Transaction, req dto.AdjustTransactionRequest) dto.CreateTransactionRequest {
+	createReq := dto.CreateTransactionRequest{
+		FromAccountID:   req.FromAccountID,
+		ToAccountID:     req.ToAccountID,
+		TransactionType: req.TransactionType,
+		Description:     req.Description,
+		Reference:       "ADJ-" + original.ID.String(),
+	}
+
+	if createReq.TransactionType == "" {
+		createReq.TransactionType = string(original.TransactionType)
+		if createReq.FromAccountID == nil && original.FromAccountID != nil {
+			fromID := original.FromAccountID.String()
+			createReq.FromAccountID = &fromID
+		}
+		if createReq.ToAccountID == nil && original.ToAccountID != nil {
+			toID := original.ToAccountID.String()
+			createReq.ToAccountID = &toID
+		}
+	}
+
+	if req.Amount != nil {
+		createReq.Amount = *req.Amount
+	} else {
+		createReq.Amount = dto.DecimalString{Decimal: original.Amount.Amount()}
+	}
+
+	if createReq.Description == "" {
+		createReq.Description = fmt.Sprintf("Adjustment of %s: %s", original.ID.String(), req.Reason)
+	}
+	return createReq
+}
+
+// CreateAdjustment posts an admin's ADJUSTMENT of an account's balance. It is placed in the review queue
+// at once and processed only when an admin other than the one who posted it approves it; left undecided
+// past the review deadline it is declined. Once approved it applies without business rules, product fees
+// or spending limits, whatever the account's status.
+func (uc *transactionUseCase) CreateAdjustment(ctx context.Context, req dto.CreateAdjustmentRequest) (*dto.TransactionResponse, error) {
+	accountID, err := vo.NewAccountIDFromString(req.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := uc.accountRepo.GetByID(ctx, accountID); err != nil {
+		uc.logger.Error("Account not found", "error", err, "accountID", req.AccountID)
+		return nil, errs.ErrAccountNotFound
+	}
+
+	description := req.Description
+	if description == "" {
+		description = "Adjustment: " + req.ReasonCode
+	}
+	now := uc.valueDating.Now()
+	adjustment, err := entity.NewAdjustmentTransaction(accountID, vo.AdjustmentDirection(req.Direction), req.Amount.Money(),
+		vo.AdjustmentReason(req.ReasonCode), description, req.RequestedBy, now)
+	if err != nil {
+		return nil, err
+	}
+	if err := adjustment.SetValueDate(uc.valueDating.Today()); err != nil {
+		return nil, err
+	}
+	if err := adjustment.PlaceInReview(adjustmentReviewReason, uc.review.DueAt(now), now); err != nil {
+		return nil, err
+	}
+
+	if err := uc.transactionRepo.Create(ctx, adjustment); err != nil {
+		uc.logger.Error("Failed to save adjustment", "error", err, "transactionID", adjustment.ID.String(), "accountID", req.AccountID)
+		return nil, err
+	}
+	uc.publish(ctx, event.NewTransactionEvent(event.TransactionCreated, adjustment))
+	uc.publish(ctx, event.NewTransactionEvent(event.TransactionInReview, adjustment))
+
+	response := uc.mapper.ToResponse(adjustment)
+	uc.cacheTransaction(ctx, response)
+
+	uc.logger.Info("Adjustment awaiting approval", "transactionID", adjustment.ID.String(), "accountID", req.AccountID,
+		"direction", req.Direction, "amount", adjustment.Amount.String(), "reasonCode", req.ReasonCode, "requestedBy", req.RequestedBy)
+	return &response, nil
+}
+
+// GetAdjustmentReport totals the completed ADJUSTMENT transactions per period of their value date and reason code
+func (uc *transactionUseCase) GetAdjustmentReport(ctx context.Context, req dto.AdjustmentReportRequest) (*dto.AdjustmentReportResponse, error) {
+	from, to, err := reportPeriods(req.From, req.To, maxAdjustmentReportPeriods, uc.valueDating)
+	if err != nil {
+		return nil, err
+	}
+
+	start, _ := entity.ParseAccountingPeriod(from)
+	end, _ := entity.ParseAccountingPeriod(to)
+	adjustments, err := uc.transactionRepo.GetCompletedAdjustments(ctx, start, end.AddDate(0, 1, 0))
+	if err != nil {
+		uc.logger.Error("Failed to get completed adjustments", "error", err, "from", from, "to", to)
+		return nil, err
+	}
+
+	type totals struct {
+		count                int
+		increased, decreased vo.Money
+	}
+	byRow := make(map[[2]string]*totals)
+	var keys [][2]string
+	for _, adjustment := range adjustments {
+		key := [2]string{entity.AccountingPeriodOf(adjustment.ValueDate), string(adjustment.AdjustmentReason)}
+		row, ok := byRow[key]
+		if !ok {
+			row = &totals{increased: vo.ZeroMoney(), decreased: vo.ZeroMoney()}
+			byRow[key] = row
+			keys = append(keys, key)
+		}
+
+		row.count++
+		if adjustment.ToAccountID != nil {
+			row.increased, _ = row.increased.Add(adjustment.Amount)
+		} else {
+			row.decreased, _ = row.decreased.Add(adjustment.Amount)
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i][0] != keys[j][0] {
+			return keys[i][0] < keys[j][0]
+		}
+		return keys[i][1] < keys[j][1]
+	})
+
+	response := &dto.AdjustmentReportResponse{From: from, To: to, Rows: make([]dto.AdjustmentReportRow, 0, len(keys))}
+	increased, decreased := vo.ZeroMoney(), vo.ZeroMoney()
+	for _, key := range keys {
+		row := byRow[key]
+		net, _ := row.increased.Subtract(row.decreased)
+		response.Rows = append(response.Rows, dto.AdjustmentReportRow{
+			Period:     key[0],
+			ReasonCode: key[1],
+			Count:      row.count,
+			Increased:  row.increased.InexactFloat64(),
+			Decreased:  row.decreased.InexactFloat64(),
+			Net:        net.InexactFloat64(),
+		})
+		response.TotalCount += row.count
+		increased, _ = increased.Add(row.increased)
+		decreased, _ = decreased.Add(row.decreased)
+	}
+	net, _ := increased.Subtract(decreased)
+	response.TotalIncreased = increased.InexactFloat64()
+	response.TotalDecreased = decreased.InexactFloat64()
+	response.TotalNet = net.InexactFloat64()
+
+	return response, nil
+}
@@ -0,0 +1,143 @@
+package usecase
+
+import (
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/application/dto"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/event"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// useAdjustment makes the test transaction a decrease of the test account's balance by 40 posted by alice
+// and held for a second admin
+func (suite *TransactionUseCaseTestSuite) useAdjustment() {
+	adjustment, err := entity.NewAdjustmentTransaction(suite.testAccount.ID, vo.AdjustmentDirectionDecrease,
+		vo.NewMoneyFromFloat(40), vo.AdjustmentReasonFeeCorrection, "", "alice", time.Now())
+	suite.Require().NoError(err)
+	suite.Require().NoError(adjustment.PlaceInReview(adjustmentReviewReason, time.Now().Add(time.Hour), time.Now()))
+	suite.testTransaction = adjustment
+}
+
+func (suite *TransactionUseCaseTestSuite) TestCreateAdjustment() {
+	suite.mockAccountRepo.On("GetByID", suite.ctx, suite.testAccount.ID).Return(suite.testAccount, nil)
+	suite.mockTxnRepo.On("Create", suite.ctx, mock.AnythingOfType("*entity.Transaction")).Return(nil)
+	suite.mockCache.On("Set", suite.ctx, mock.Anything, mock.Anything, 30*time.Minute).Return(nil)
+
+	result, err := suite.usecase.CreateAdjustment(suite.ctx, dto.CreateAdjustmentRequest{
+		AccountID:   suite.testAccount.ID.String(),
+		Direction:   "INCREASE",
+		Amount:      dto.NewDecimalStringFromFloat(25),
+		ReasonCode:  "BOOKING_ERROR",
+		RequestedBy: "alice",
+	})
+
+	// Nothing moves until a second admin approves it
+	suite.Require().NoError(err)
+	assert.Equal(suite.T(), "ADJUSTMENT", result.TransactionType)
+	assert.Equal(suite.T(), "REVIEW", result.Status)
+	assert.Equal(suite.T(), "BOOKING_ERROR", result.AdjustmentReason)
+	assert.Equal(suite.T(), "alice", result.RequestedBy)
+	assert.Equal(suite.T(), "Adjustment: BOOKING_ERROR", result.Description)
+	suite.Require().NotNil(result.ToAccountID)
+	assert.Equal(suite.T(), suite.testAccount.ID.String(), *result.ToAccountID)
+	assert.True(suite.T(), suite.testAccount.Balance.Equal(vo.NewMoneyFromFloat(1000)))
+	suite.mockAccountRepo.AssertNotCalled(suite.T(), "UpdateFenced", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	suite.Require().Len(suite.mockEvents.Events, 2)
+	assert.Equal(suite.T(), event.TransactionCreated, suite.mockEvents.Events[0].Type)
+	assert.Equal(suite.T(), event.TransactionInReview, suite.mockEvents.Events[1].Type)
+}
+
+func (suite *TransactionUseCaseTestSuite) TestCreateAdjustment_AccountNotFound() {
+	suite.mockAccountRepo.On("GetByID", suite.ctx, suite.testAccount.ID).Return((*entity.Account)(nil), errs.ErrAccountNotFound)
+
+	_, err := suite.usecase.CreateAdjustment(suite.ctx, dto.CreateAdjustmentRequest{
+		AccountID:   suite.testAccount.ID.String(),
+		Direction:   "DECREASE",
+		Amount:      dto.NewDecimalStringFromFloat(25),
+		ReasonCode:  "GOODWILL",
+		RequestedBy: "alice",
+	})
+
+	assert.ErrorIs(suite.T(), err, errs.ErrAccountNotFound)
+	suite.mockTxnRepo.AssertNotCalled(suite.T(), "Create", mock.Anything, mock.Anything)
+}
+
+func (suite *TransactionUseCaseTestSuite) TestApproveAdjustment() {
+	suite.useAdjustment()
+	id := suite.expectConfirmationLock()
+	suite.Require().NoError(suite.testAccount.Suspend())
+
+	suite.mockTxnRepo.On("GetByID", suite.ctx, suite.testTransaction.ID).Return(suite.testTransaction, nil)
+	suite.mockTxnRepo.On("Update", suite.ctx, suite.testTransaction).Return(nil)
+	suite.mockAccountRepo.On("GetByIDForUpdate", suite.ctx, suite.testAccount.ID).Return(suite.testAccount, nil)
+	suite.mockAccountRepo.On("UpdateFenced", suite.ctx, suite.testAccount, suite.testTransaction.ID, mock.AnythingOfType("int64")).Return(nil)
+	suite.mockCache.On("Set", suite.ctx, "confirm_transaction:"+id, mock.Anything, 24*time.Hour).Return(nil)
+	suite.mockCache.On("Delete", suite.ctx, "account:"+suite.testAccount.ID.String()).Return(nil)
+
+	// The admin who posted it cannot approve it
+	_, err := suite.usecase.ApproveTransaction(suite.ctx, dto.ReviewDecisionRequest{ID: id, Reviewer: "alice"})
+	assert.ErrorIs(suite.T(), err, errs.ErrSelfApproval)
+	assert.Equal(suite.T(), vo.TransactionStatusReview, suite.testTransaction.Status)
+
+	// A second admin's approval applies it, even to an account that cannot transact
+	result, err := suite.usecase.ApproveTransaction(suite.ctx, dto.ReviewDecisionRequest{ID: id, Reviewer: "bob"})
+
+	suite.Require().NoError(err)
+	assert.Equal(suite.T(), "COMPLETED", result.Status)
+	assert.Equal(suite.T(), "bob", result.ReviewedBy)
+	assert.True(suite.T(), suite.testAccount.Balance.Equal(vo.NewMoneyFromFloat(960)))
+}
+
+func (suite *TransactionUseCaseTestSuite) TestCancelTransaction_Adjustment() {
+	suite.useAdjustment()
+	suite.mockTxnRepo.On("GetByID", suite.ctx, suite.testTransaction.ID).Return(suite.testTransaction, nil)
+
+	_, err := suite.usecase.CancelTransaction(suite.ctx, dto.CancelTransactionRequest{ID: suite.testTransaction.ID.String()})
+
+	assert.ErrorIs(suite.T(), err, errs.ErrTransactionCannotBeCancelled)
+	assert.Equal(suite.T(), vo.TransactionStatusReview, suite.testTransaction.Status)
+}
+
+func (suite *TransactionUseCaseTestSuite) TestGetAdjustmentReport() {
+	completedAdjustment := func(direction vo.AdjustmentDirection, amount float64, reason vo.AdjustmentReason, valueDate time.Time) *entity.Transaction {
+		adjustment, err := entity.NewAdjustmentTransaction(suite.testAccount.ID, direction, vo.NewMoneyFromFloat(amount), reason, "", "alice", time.Now())
+		suite.Require().NoError(err)
+		suite.Require().NoError(adjustment.MarkAsCompleted(time.Now()))
+		adjustment.ValueDate = valueDate
+		return adjustment
+	}
+	january := time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)
+	february := time.Date(2024, 2, 3, 0, 0, 0, 0, time.UTC)
+	suite.mockTxnRepo.On("GetCompletedAdjustments", suite.ctx,
+		time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)).
+		Return([]*entity.Transaction{
+			completedAdjustment(vo.AdjustmentDirectionIncrease, 30, vo.AdjustmentReasonSystemError, january),
+			completedAdjustment(vo.AdjustmentDirectionDecrease, 10, vo.AdjustmentReasonSystemError, january),
+			completedAdjustment(vo.AdjustmentDirectionIncrease, 5, vo.AdjustmentReasonGoodwill, january),
+			completedAdjustment(vo.AdjustmentDirectionDecrease, 20, vo.AdjustmentReasonFeeCorrection, february),
+		}, nil)
+
+	result, err := suite.usecase.GetAdjustmentReport(suite.ctx, dto.AdjustmentReportRequest{From: "2024-01", To: "2024-02"})
+
+	suite.Require().NoError(err)
+	assert.Equal(suite.T(), []dto.AdjustmentReportRow{
+		{Period: "2024-01", ReasonCode: "GOODWILL", Count: 1, Increased: 5, Net: 5},
+		{Period: "2024-01", ReasonCode: "SYSTEM_ERROR", Count: 2, Increased: 30, Decreased: 10, Net: 20},
+		{Period: "2024-02", ReasonCode: "FEE_CORRECTION", Count: 1, Decreased: 20, Net: -20},
+	}, result.Rows)
+	assert.Equal(suite.T(), 4, result.TotalCount)
+	assert.Equal(suite.T(), 35.0, result.TotalIncreased)
+	assert.Equal(suite.T(), 30.0, result.TotalDecreased)
+	assert.Equal(suite.T(), 5.0, result.TotalNet)
+}
+
+func (suite *TransactionUseCaseTestSuite) TestGetAdjustmentReport_InvalidRange() {
+	_, err := suite.usecase.GetAdjustmentReport(suite.ctx, dto.AdjustmentReportRequest{From: "2024-03", To: "2024-01"})
+
+	assert.IsType(suite.T(), errs.ValidationError{}, err)
+	suite.mockTxnRepo.AssertNotCalled(suite.T(), "GetCompletedAdjustments", mock.Anything, mock.Anything, mock.Anything)
+}
@@ -0,0 +1,247 @@
+// internal/application/admin_security.go
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/application/dto"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/event"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/infra"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/repository"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+)
+
+const (
+	// defaultDashboardPeriod is how far back the security dashboard looks unless asked otherwise
+	defaultDashboardPeriod = 24 * time.Hour
+
+	// dashboardRecentAnomalies is how many of the latest anomalous requests the dashboard shows
+	dashboardRecentAnomalies = 20
+)
+
+// AdminSecurityPolicy tells which admin activity is anomalous and who hears about it
+type AdminSecurityPolicy struct {
+	WorkingHours           vo.AdminWorkingHours // Requests outside these are unusual
+	MassOperationThreshold int                  // Changes an admin may make within the window before it is a mass operation; 0 never flags one
+	MassOperationWindow    time.Duration
+	AlertRecipients        []string // Security team email addresses alerted of anomalies
+}
+
+type adminSecurityUseCase struct {
+	activityRepo repository.AdminActivityRepository
+	sender       infra.NotificationSender
+	events       infra.EventPublisher
+	policy       AdminSecurityPolicy
+	logger       infra.Logger
+	mapper       *dto.AdminActivityMapper
+}
+
+// NewAdminSecurityUseCase creates a new admin security use case
+func NewAdminSecurityUseCase(
+	activityRepo repository.AdminActivityRepository,
+	sender infra.NotificationSender,
+	events infra.EventPublisher,
+	policy AdminSecurityPolicy,
+	logger infra.Logger,
+) AdminSecurityUseCase {
+	return &adminSecurityUseCase{
+		activityRepo: activityRepo,
+		sender:       sender,
+		events:       events,
+		policy:       policy,
+		logger:       logger,
+		mapper:       &dto.AdminActivityMapper{},
+	}
+}
+
+// RecordActivity records a request of an admin user with the anomalies it shows against their earlier
+// activity, alerting security when it shows any
+func (uc *adminSecurityUseCase) RecordActivity(ctx context.Context, record dto.AdminActivityRecord) error {
+	activity := entity.NewAdminActivity(record.AdminID, record.AdminEmail, record.Method, record.Route, record.Path,
+		record.Permission, record.StatusCode, record.IP, record.Country, record.UserAgent, record.OccurredAt)
+
+	anomalies, err := uc.detectAnomalies(ctx, activity)
+	if err != nil {
+		uc.logger.Error("Failed to check admin activity for anomalies", "error", err, "adminID", record.AdminID)
+		return err
+	}
+	activity.Anomalies = anomalies
+
+	if err := uc.activityRepo.Create(ctx, activity); err != nil {
+		uc.logger.Error("Failed to record admin activity", "error", err, "adminID", record.AdminID, "path", record.Path)
+		return err
+	}
+
+	if activity.IsAnomalous() {
+		uc.logger.Warn("Anomalous admin activity", "activityID", activity.ID, "adminID", activity.AdminID,
+			"ip", activity.IP, "anomalies", activity.Anomalies)
+		uc.alert(ctx, activity)
+	}
+	return nil
+}
+
+// ListActivity retrieves recorded admin activity, newest first
+func (uc *adminSecurityUseCase) ListActivity(ctx context.Context, req dto.AdminActivityListRequest) (*dto.AdminActivityListResponse, error) {
+	filter := repository.AdminActivityFilter{
+		AdminID:       req.AdminID,
+		AnomalousOnly: req.Anomalous,
+		Anomaly:       vo.AdminAnomaly(req.Anomaly),
+	}
+	offset := (req.Page - 1) * req.PageSize
+
+	activities, err := uc.activityRepo.List(ctx, filter, req.PageSize, offset)
+	if err != nil {
+		uc.logger.Error("Failed to list admin activity", "error", err, "adminID", req.AdminID)
+		return nil, err
+	}
+
+	total, err := uc.activityRepo.Count(ctx, filter)
+	if err != nil {
+		uc.logger.Error("Failed to count admin activity", "error", err, "adminID", req.AdminID)
+		return nil, err
+	}
+
+	return &dto.AdminActivityListResponse{
+		Activities: uc.mapper.ToResponses(activities),
+		Pagination: dto.NewPaginationInfo(req.Page, req.PageSize, total),
+	}, nil
+}
+
+// GetSecurityDashboard sums up admin activity and its anomalies over the last hours, per admin user
+func (uc *adminSecurityUseCase) GetSecurityDashboard(ctx context.Context, req dto.SecurityDashboardRequest) (*dto.SecurityDashboardResponse, error) {
+	period := defaultDashboardPeriod
+	if req.Hours > 0 {
+		period = time.Duration(req.Hours) * time.Hour
+	}
+	since := time.Now().Add(-period)
+	filter := repository.AdminActivityFilter{From: since}
+	anomalous := repository.AdminActivityFilter{From: since, AnomalousOnly: true}
+
+	response := &dto.SecurityDashboardResponse{
+		Since:         since,
+		AnomalyCounts: make(map[string]int64),
+	}
+
+	var err error
+	if response.Actions, err = uc.activityRepo.Count(ctx, filter); err != nil {
+		uc.logger.Error("Failed to count admin activity", "error", err)
+		return nil, err
+	}
+	if response.Anomalous, err = uc.activityRepo.Count(ctx, anomalous); err != nil {
+		uc.logger.Error("Failed to count anomalous admin activity", "error", err)
+		return nil, err
+	}
+	for _, anomaly := range vo.AdminAnomalies() {
+		count, err := uc.activityRepo.Count(ctx, repository.AdminActivityFilter{From: since, Anomaly: anomaly})
+		if err != nil {
+			uc.logger.Error("Failed to count admin anomalies", "error", err, "anomaly", anomaly)
+			return nil, err
+		}
+		response.AnomalyCounts[string(anomaly)] = count
+	}
+
+	summaries, err := uc.activityRepo.Summarize(ctx, filter)
+	if err != nil {
+		uc.logger.Error("Failed to summarize admin activity", "error", err)
+		return nil, err
+	}
+	response.Admins = make([]dto.AdminActivitySummaryResponse, len(summaries))
+	for i, summary := range summaries {
+		response.Admins[i] = dto.AdminActivitySummaryResponse{
+			AdminID:     summary.AdminID,
+			AdminEmail:  summary.AdminEmail,
+			Actions:     summary.Actions,
+			Mutations:   summary.Mutations,
+			Denied:      summary.Denied,
+			Anomalous:   summary.Anomalous,
+			DistinctIPs: summary.DistinctIPs,
+			LastSeenAt:  summary.LastSeenAt,
+		}
+	}
+
+	recent, err := uc.activityRepo.List(ctx, anomalous, dashboardRecentAnomalies, 0)
+	if err != nil {
+		uc.logger.Error("Failed to list anomalous admin activity", "error", err)
+		return nil, err
+	}
+	response.RecentAnomalies = uc.mapper.ToResponses(recent)
+
+	return response, nil
+}
+
+// detectAnomalies compares a request with the admin user's earlier activity. An admin's first request
+// sets their baseline rather than counting from a new IP, and a mass operation is flagged on the change
+// that crosses the threshold, so a burst is alerted once rather than on each change after it.
+func (uc *adminSecurityUseCase) detectAnomalies(ctx context.Context, activity *entity.AdminActivity) ([]vo.AdminAnomaly, error) {
+	var anomalies []vo.AdminAnomaly
+
+	if activity.IP != "" {
+		fromIP, err := uc.activityRepo.Count(ctx, repository.AdminActivityFilter{AdminID: activity.AdminID, IP: activity.IP})
+		if err != nil {
+			return nil, err
+		}
+		if fromIP == 0 {
+			earlier, err := uc.activityRepo.Count(ctx, repository.AdminActivityFilter{AdminID: activity.AdminID})
+			if err != nil {
+				return nil, err
+			}
+			if earlier > 0 {
+				anomalies = append(anomalies, vo.AdminAnomalyNewIP)
+			}
+		}
+	}
+
+	if !uc.policy.WorkingHours.Contains(activity.OccurredAt) {
+		anomalies = append(anomalies, vo.AdminAnomalyUnusualHours)
+	}
+
+	if activity.IsMutation() && uc.policy.MassOperationThreshold > 0 {
+		changes, err := uc.activityRepo.Count(ctx, repository.AdminActivityFilter{
+			AdminID:       activity.AdminID,
+			MutationsOnly: true,
+			From:          activity.OccurredAt.Add(-uc.policy.MassOperationWindow),
+		})
+		if err != nil {
+			return nil, err
+		}
+		if changes == int64(uc.policy.MassOperationThreshold) {
+			anomalies = append(anomalies, vo.AdminAnomalyMassOperations)
+		}
+	}
+
+	return anomalies, nil
+}
+
+// alert publishes an anomalous request and emails it to security; failures are logged, the request is
+// already recorded
+func (uc *adminSecurityUseCase) alert(ctx context.Context, activity *entity.AdminActivity) {
+	evt := event.NewAdminAnomalyEvent(activity)
+	if err := uc.events.Publish(ctx, evt); err != nil {
+		uc.logger.Warn("Failed to publish admin anomaly event", "error", err, "activityID", activity.ID)
+	}
+
+	anomalies := make([]string, len(activity.Anomalies))
+	for i, anomaly := range activity.Anomalies {
+		anomalies[i] = string(anomaly)
+	}
+	subject := fmt.Sprintf("Admin security alert: %s by %s", strings.Join(anomalies, ", "), activity.AdminEmail)
+	body := fmt.Sprintf("Admin %s (%s) made %s %s from %s (country %q, user agent %q) at %s, answered %d.\nAnomalies: %s\nActivity: %s",
+		activity.AdminEmail, activity.AdminID, activity.Method, activity.Path, activity.IP, activity.Country, activity.UserAgent,
+		activity.OccurredAt.UTC().Format(time.RFC3339), activity.StatusCode, strings.Join(anomalies, ", "), activity.ID)
+
+	for _, recipient := range uc.policy.AlertRecipients {
+		err := uc.sender.Send(ctx, infra.Notification{
+			Channel:   vo.NotificationChannelEmail,
+			Recipient: recipient,
+			Subject:   subject,
+			Body:      body,
+		})
+		if err != nil {
+			uc.logger.Warn("Failed to send admin security alert", "error", err, "activityID", activity.ID, "recipient", recipient)
+		}
+	}
+}
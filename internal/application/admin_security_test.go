@@ -0,0 +1,213 @@
+package usecase
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/application/dto"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/event"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/repository"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockAdminActivityRepository is a mock implementation of AdminActivityRepository
+type MockAdminActivityRepository struct {
+	mock.Mock
+}
+
+func (m *MockAdminActivityRepository) Create(ctx context.Context, activity *entity.AdminActivity) error {
+	args := m.Called(ctx, activity)
+	return args.Error(0)
+}
+
+func (m *MockAdminActivityRepository) List(ctx context.Context, filter repository.AdminActivityFilter, limit, offset int) ([]*entity.AdminActivity, error) {
+	args := m.Called(ctx, filter, limit, offset)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.AdminActivity), args.Error(1)
+}
+
+func (m *MockAdminActivityRepository) Count(ctx context.Context, filter repository.AdminActivityFilter) (int64, error) {
+	args := m.Called(ctx, filter)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockAdminActivityRepository) Summarize(ctx context.Context, filter repository.AdminActivityFilter) ([]repository.AdminActivitySummary, error) {
+	args := m.Called(ctx, filter)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]repository.AdminActivitySummary), args.Error(1)
+}
+
+func newAdminSecurityTestUseCase(t *testing.T) (AdminSecurityUseCase, *MockAdminActivityRepository, *StubNotificationSender, *StubEventPublisher) {
+	hours, err := vo.NewAdminWorkingHours("08:00-19:00", "UTC")
+	require.NoError(t, err)
+
+	mockActivityRepo := new(MockAdminActivityRepository)
+	mockLogger := new(MockLogger)
+	mockLogger.On("Warn", mock.Anything, mock.Anything).Maybe()
+	mockLogger.On("Error", mock.Anything, mock.Anything).Maybe()
+	sender := &StubNotificationSender{}
+	events := &StubEventPublisher{}
+	policy := AdminSecurityPolicy{
+		WorkingHours:           hours,
+		MassOperationThreshold: 3,
+		MassOperationWindow:    10 * time.Minute,
+		AlertRecipients:        []string{"security@bank.test", "ciso@bank.test"},
+	}
+	return NewAdminSecurityUseCase(mockActivityRepo, sender, events, policy, mockLogger), mockActivityRepo, sender, events
+}
+
+func adminActivityRecord(method, ip string, at time.Time) dto.AdminActivityRecord {
+	return dto.AdminActivityRecord{
+		AdminID:    "ADM1",
+		AdminEmail: "alice@bank.test",
+		Method:     method,
+		Route:      "/api/v1/accounts/:id",
+		Path:       "/api/v1/accounts/ACC1",
+		Permission: "accounts:write",
+		StatusCode: http.StatusOK,
+		IP:         ip,
+		Country:    "TH",
+		UserAgent:  "curl/8",
+		OccurredAt: at,
+	}
+}
+
+func TestAdminSecurityUseCase_RecordActivity(t *testing.T) {
+	office := time.Date(2026, 3, 2, 10, 0, 0, 0, time.UTC)
+	night := time.Date(2026, 3, 2, 3, 0, 0, 0, time.UTC)
+	byIP := func(ip string) repository.AdminActivityFilter {
+		return repository.AdminActivityFilter{AdminID: "ADM1", IP: ip}
+	}
+	changesSince := func(at time.Time) repository.AdminActivityFilter {
+		return repository.AdminActivityFilter{AdminID: "ADM1", MutationsOnly: true, From: at.Add(-10 * time.Minute)}
+	}
+
+	tests := []struct {
+		name   string
+		record dto.AdminActivityRecord
+		setup  func(*MockAdminActivityRepository)
+		want   []vo.AdminAnomaly
+	}{
+		{
+			name:   "usual read",
+			record: adminActivityRecord(http.MethodGet, "10.0.0.1", office),
+			setup: func(repo *MockAdminActivityRepository) {
+				repo.On("Count", mock.Anything, byIP("10.0.0.1")).Return(int64(12), nil)
+			},
+		},
+		{
+			name:   "first request sets the baseline",
+			record: adminActivityRecord(http.MethodGet, "10.0.0.1", office),
+			setup: func(repo *MockAdminActivityRepository) {
+				repo.On("Count", mock.Anything, byIP("10.0.0.1")).Return(int64(0), nil)
+				repo.On("Count", mock.Anything, repository.AdminActivityFilter{AdminID: "ADM1"}).Return(int64(0), nil)
+			},
+		},
+		{
+			name:   "new IP at night",
+			record: adminActivityRecord(http.MethodGet, "203.0.113.7", night),
+			setup: func(repo *MockAdminActivityRepository) {
+				repo.On("Count", mock.Anything, byIP("203.0.113.7")).Return(int64(0), nil)
+				repo.On("Count", mock.Anything, repository.AdminActivityFilter{AdminID: "ADM1"}).Return(int64(40), nil)
+			},
+			want: []vo.AdminAnomaly{vo.AdminAnomalyNewIP, vo.AdminAnomalyUnusualHours},
+		},
+		{
+			name:   "change crossing the mass operation threshold",
+			record: adminActivityRecord(http.MethodDelete, "10.0.0.1", office),
+			setup: func(repo *MockAdminActivityRepository) {
+				repo.On("Count", mock.Anything, byIP("10.0.0.1")).Return(int64(12), nil)
+				repo.On("Count", mock.Anything, changesSince(office)).Return(int64(3), nil)
+			},
+			want: []vo.AdminAnomaly{vo.AdminAnomalyMassOperations},
+		},
+		{
+			name:   "change past the threshold is not alerted again",
+			record: adminActivityRecord(http.MethodDelete, "10.0.0.1", office),
+			setup: func(repo *MockAdminActivityRepository) {
+				repo.On("Count", mock.Anything, byIP("10.0.0.1")).Return(int64(12), nil)
+				repo.On("Count", mock.Anything, changesSince(office)).Return(int64(4), nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc, mockActivityRepo, sender, events := newAdminSecurityTestUseCase(t)
+			tt.setup(mockActivityRepo)
+			var recorded *entity.AdminActivity
+			mockActivityRepo.On("Create", mock.Anything, mock.AnythingOfType("*entity.AdminActivity")).
+				Run(func(args mock.Arguments) { recorded = args.Get(1).(*entity.AdminActivity) }).
+				Return(nil)
+
+			require.NoError(t, uc.RecordActivity(context.Background(), tt.record))
+
+			require.NotNil(t, recorded)
+			assert.Equal(t, tt.want, recorded.Anomalies)
+			assert.Equal(t, tt.record.IP, recorded.IP)
+			if len(tt.want) == 0 {
+				assert.Empty(t, sender.Notifications)
+				assert.Empty(t, events.Events)
+				return
+			}
+
+			require.Len(t, events.Events, 1)
+			assert.Equal(t, event.AdminAnomalyDetected, events.Events[0].Type)
+			require.Len(t, sender.Notifications, 2)
+			assert.Equal(t, vo.NotificationChannelEmail, sender.Notifications[0].Channel)
+			assert.Equal(t, "security@bank.test", sender.Notifications[0].Recipient)
+			assert.Contains(t, sender.Notifications[0].Subject, string(tt.want[0]))
+			assert.Contains(t, sender.Notifications[1].Body, tt.record.IP)
+			mockActivityRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestAdminSecurityUseCase_GetSecurityDashboard(t *testing.T) {
+	uc, mockActivityRepo, _, _ := newAdminSecurityTestUseCase(t)
+	anomalous := entity.NewAdminActivity("ADM1", "alice@bank.test", http.MethodDelete, "/api/v1/accounts/:id",
+		"/api/v1/accounts/ACC1", "accounts:write", http.StatusOK, "203.0.113.7", "", "", time.Now())
+	anomalous.Anomalies = []vo.AdminAnomaly{vo.AdminAnomalyNewIP}
+
+	since := func(filter repository.AdminActivityFilter) bool {
+		return time.Since(filter.From) > 47*time.Hour && time.Since(filter.From) < 49*time.Hour
+	}
+	mockActivityRepo.On("Count", mock.Anything, mock.MatchedBy(func(filter repository.AdminActivityFilter) bool {
+		return since(filter) && !filter.AnomalousOnly && filter.Anomaly == ""
+	})).Return(int64(30), nil)
+	mockActivityRepo.On("Count", mock.Anything, mock.MatchedBy(func(filter repository.AdminActivityFilter) bool {
+		return since(filter) && filter.AnomalousOnly
+	})).Return(int64(1), nil)
+	mockActivityRepo.On("Count", mock.Anything, mock.MatchedBy(func(filter repository.AdminActivityFilter) bool {
+		return since(filter) && filter.Anomaly == vo.AdminAnomalyNewIP
+	})).Return(int64(1), nil)
+	mockActivityRepo.On("Count", mock.Anything, mock.MatchedBy(func(filter repository.AdminActivityFilter) bool {
+		return since(filter) && filter.Anomaly != "" && filter.Anomaly != vo.AdminAnomalyNewIP
+	})).Return(int64(0), nil)
+	mockActivityRepo.On("Summarize", mock.Anything, mock.MatchedBy(since)).Return([]repository.AdminActivitySummary{
+		{AdminID: "ADM1", AdminEmail: "alice@bank.test", Actions: 30, Mutations: 4, Anomalous: 1, DistinctIPs: 2},
+	}, nil)
+	mockActivityRepo.On("List", mock.Anything, mock.MatchedBy(since), dashboardRecentAnomalies, 0).
+		Return([]*entity.AdminActivity{anomalous}, nil)
+
+	response, err := uc.GetSecurityDashboard(context.Background(), dto.SecurityDashboardRequest{Hours: 48})
+	require.NoError(t, err)
+
+	assert.Equal(t, int64(30), response.Actions)
+	assert.Equal(t, int64(1), response.Anomalous)
+	assert.Equal(t, map[string]int64{"NEW_IP": 1, "UNUSUAL_HOURS": 0, "MASS_OPERATIONS": 0}, response.AnomalyCounts)
+	require.Len(t, response.Admins, 1)
+	assert.Equal(t, int64(2), response.Admins[0].DistinctIPs)
+	require.Len(t, response.RecentAnomalies, 1)
+	assert.Equal(t, []string{"NEW_IP"}, response.RecentAnomalies[0].Anomalies)
+}
@@ -0,0 +1,183 @@
+// internal/application/admin_user.go
+package usecase
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/hydr0g3nz/mini_bank/internal/application/dto"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/event"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/infra"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/repository"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+)
+
+type adminUserUseCase struct {
+	adminRepo repository.AdminUserRepository
+	events    infra.EventPublisher
+	logger    infra.Logger
+	mapper    *dto.AdminUserMapper
+}
+
+// NewAdminUserUseCase creates a new admin user use case
+func NewAdminUserUseCase(
+	adminRepo repository.AdminUserRepository,
+	events infra.EventPublisher,
+	logger infra.Logger,
+) AdminUserUseCase {
+	return &adminUserUseCase{
+		adminRepo: adminRepo,
+		events:    events,
+		logger:    logger,
+		mapper:    &dto.AdminUserMapper{},
+	}
+}
+
+// CreateAdmin adds an admin user and returns them with their API key, shown only this once
+func (uc *adminUserUseCase) CreateAdmin(ctx context.Context, req dto.CreateAdminUserRequest) (*dto.AdminUserResponse, error) {
+	admin, key, err := entity.NewAdminUser(req.Email, req.Name, adminRoles(req.Roles))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.adminRepo.Create(ctx, admin); err != nil {
+		if !errors.Is(err, errs.ErrAdminEmailTaken) {
+			uc.logger.Error("Failed to create admin user", "error", err, "email", admin.Email)
+		}
+		return nil, err
+	}
+
+	uc.logger.Info("Admin user created", "adminID", admin.ID, "roles", admin.Roles, "requestedBy", req.RequestedBy)
+	uc.publish(ctx, event.NewAdminUserEvent(event.AdminUserCreated, admin, req.RequestedBy))
+
+	response := uc.mapper.ToResponse(admin)
+	response.APIKey = key
+	return &response, nil
+}
+
+// GetAdmin retrieves an admin user
+func (uc *adminUserUseCase) GetAdmin(ctx context.Context, id string) (*dto.AdminUserResponse, error) {
+	admin, err := uc.adminRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	response := uc.mapper.ToResponse(admin)
+	return &response, nil
+}
+
+// ListAdmins retrieves every admin user by email
+func (uc *adminUserUseCase) ListAdmins(ctx context.Context) (*dto.AdminUserListResponse, error) {
+	admins, err := uc.adminRepo.List(ctx)
+	if err != nil {
+		uc.logger.Error("Failed to list admin users", "error", err)
+		return nil, err
+	}
+
+	responses := make([]dto.AdminUserResponse, len(admins))
+	for i, admin := range admins {
+		responses[i] = uc.mapper.ToResponse(admin)
+	}
+
+	return &dto.AdminUserListResponse{Admins: responses}, nil
+}
+
+// UpdateAdmin changes an admin user's roles or disables and re-enables them
+func (uc *adminUserUseCase) UpdateAdmin(ctx context.Context, req dto.UpdateAdminUserRequest) (*dto.AdminUserResponse, error) {
+	admin, err := uc.adminRepo.GetByID(ctx, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(req.Roles) > 0 {
+		if err := admin.AssignRoles(adminRoles(req.Roles)); err != nil {
+			return nil, err
+		}
+	}
+
+	switch entity.AdminUserStatus(req.Status) {
+	case entity.AdminUserStatusActive:
+		admin.Enable()
+	case entity.AdminUserStatusDisabled:
+		admin.Disable()
+	}
+
+	if err := uc.adminRepo.Update(ctx, admin); err != nil {
+		uc.logger.Error("Failed to update admin user", "error", err, "adminID", req.ID)
+		return nil, err
+	}
+
+	uc.logger.Info("Admin user changed", "adminID", admin.ID, "roles", admin.Roles, "status", admin.Status, "requestedBy", req.RequestedBy)
+	uc.publish(ctx, event.NewAdminUserEvent(event.AdminUserChanged, admin, req.RequestedBy))
+
+	response := uc.mapper.ToResponse(admin)
+	return &response, nil
+}
+
+// RotateAdminKey issues a new API key to an admin user, revoking the previous one
+func (uc *adminUserUseCase) RotateAdminKey(ctx context.Context, req dto.RotateAdminKeyRequest) (*dto.AdminUserResponse, error) {
+	admin, err := uc.adminRepo.GetByID(ctx, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := admin.RotateKey()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.adminRepo.Update(ctx, admin); err != nil {
+		uc.logger.Error("Failed to rotate admin API key", "error", err, "adminID", req.ID)
+		return nil, err
+	}
+
+	uc.logger.Info("Admin API key rotated", "adminID", admin.ID, "requestedBy", req.RequestedBy)
+	uc.publish(ctx, event.NewAdminUserEvent(event.AdminUserKeyRotated, admin, req.RequestedBy))
+
+	response := uc.mapper.ToResponse(admin)
+	response.APIKey = key
+	return &response, nil
+}
+
+// ListRoles retrieves the permissions matrix of the admin roles
+func (uc *adminUserUseCase) ListRoles(ctx context.Context) *dto.AdminRoleListResponse {
+	response := uc.mapper.ToRoleList()
+	return &response
+}
+
+// Authenticate resolves an admin API key to its active admin user
+func (uc *adminUserUseCase) Authenticate(ctx context.Context, key string) (*dto.AdminUserResponse, error) {
+	admin, err := uc.adminRepo.GetByKeyHash(ctx, entity.HashAdminKey(strings.TrimSpace(key)))
+	if err != nil {
+		if errors.Is(err, errs.ErrAdminUserNotFound) {
+			return nil, errs.ErrUnauthorized
+		}
+		return nil, err
+	}
+
+	if !admin.IsActive() {
+		return nil, errs.ErrUnauthorized
+	}
+
+	response := uc.mapper.ToResponse(admin)
+	return &response, nil
+}
+
+// publish publishes an admin user event; failures are logged, the change is already saved
+func (uc *adminUserUseCase) publish(ctx context.Context, evt event.Event) {
+	if err := uc.events.Publish(ctx, evt); err != nil {
+		uc.logger.Warn("Failed to publish admin user event", "error", err, "eventType", evt.Type, "adminID", evt.Key)
+	}
+}
+
+// adminRoles converts role names to admin roles
+func adminRoles(names []string) []vo.AdminRole {
+	roles := make([]vo.AdminRole, len(names))
+	for i, name := range names {
+		roles[i] = vo.AdminRole(name)
+	}
+	return roles
+}
@@ -0,0 +1,150 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hydr0g3nz/mini_bank/internal/application/dto"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/event"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockAdminUserRepository is a mock implementation of AdminUserRepository
+type MockAdminUserRepository struct {
+	mock.Mock
+}
+
+func (m *MockAdminUserRepository) Create(ctx context.Context, admin *entity.AdminUser) error {
+	args := m.Called(ctx, admin)
+	return args.Error(0)
+}
+
+func (m *MockAdminUserRepository) GetByID(ctx context.Context, id string) (*entity.AdminUser, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.AdminUser), args.Error(1)
+}
+
+func (m *MockAdminUserRepository) GetByEmail(ctx context.Context, email string) (*entity.AdminUser, error) {
+	args := m.Called(ctx, email)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.AdminUser), args.Error(1)
+}
+
+func (m *MockAdminUserRepository) GetByKeyHash(ctx context.Context, keyHash string) (*entity.AdminUser, error) {
+	args := m.Called(ctx, keyHash)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.AdminUser), args.Error(1)
+}
+
+func (m *MockAdminUserRepository) Update(ctx context.Context, admin *entity.AdminUser) error {
+	args := m.Called(ctx, admin)
+	return args.Error(0)
+}
+
+func (m *MockAdminUserRepository) List(ctx context.Context) ([]*entity.AdminUser, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.AdminUser), args.Error(1)
+}
+
+func newAdminUserTestUseCase() (AdminUserUseCase, *MockAdminUserRepository, *StubEventPublisher) {
+	mockAdminRepo := new(MockAdminUserRepository)
+	mockLogger := new(MockLogger)
+	mockLogger.On("Info", mock.Anything, mock.Anything).Maybe()
+	mockLogger.On("Error", mock.Anything, mock.Anything).Maybe()
+	events := &StubEventPublisher{}
+	return NewAdminUserUseCase(mockAdminRepo, events, mockLogger), mockAdminRepo, events
+}
+
+func TestAdminUserUseCase_CreateAdmin(t *testing.T) {
+	uc, mockAdminRepo, events := newAdminUserTestUseCase()
+	mockAdminRepo.On("Create", mock.Anything, mock.AnythingOfType("*entity.AdminUser")).Return(nil)
+
+	response, err := uc.CreateAdmin(context.Background(), dto.CreateAdminUserRequest{
+		Email:       "alice@example.com",
+		Name:        "Alice",
+		Roles:       []string{"APPROVER"},
+		RequestedBy: "root@example.com",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"APPROVER"}, response.Roles)
+	assert.Equal(t, []string{"accounts:view", "transactions:approve"}, response.Permissions)
+	require.NotEmpty(t, response.APIKey)
+
+	// Only the hash of the returned key is stored
+	created := mockAdminRepo.Calls[0].Arguments.Get(1).(*entity.AdminUser)
+	assert.Equal(t, entity.HashAdminKey(response.APIKey), created.KeyHash)
+
+	require.Len(t, events.Events, 1)
+	assert.Equal(t, event.AdminUserCreated, events.Events[0].Type)
+}
+
+func TestAdminUserUseCase_UpdateAdmin(t *testing.T) {
+	uc, mockAdminRepo, events := newAdminUserTestUseCase()
+	admin, _, err := entity.NewAdminUser("alice@example.com", "Alice", []vo.AdminRole{vo.AdminRoleViewer})
+	require.NoError(t, err)
+	mockAdminRepo.On("GetByID", mock.Anything, admin.ID).Return(admin, nil)
+	mockAdminRepo.On("Update", mock.Anything, admin).Return(nil)
+
+	response, err := uc.UpdateAdmin(context.Background(), dto.UpdateAdminUserRequest{
+		ID:          admin.ID,
+		Roles:       []string{"AUDITOR"},
+		Status:      "DISABLED",
+		RequestedBy: "root@example.com",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"AUDITOR"}, response.Roles)
+	assert.Equal(t, "DISABLED", response.Status)
+	assert.Empty(t, response.APIKey)
+	require.Len(t, events.Events, 1)
+	assert.Equal(t, event.AdminUserChanged, events.Events[0].Type)
+}
+
+func TestAdminUserUseCase_Authenticate(t *testing.T) {
+	uc, mockAdminRepo, _ := newAdminUserTestUseCase()
+	active, activeKey, err := entity.NewAdminUser("alice@example.com", "Alice", []vo.AdminRole{vo.AdminRoleViewer})
+	require.NoError(t, err)
+	disabled, disabledKey, err := entity.NewAdminUser("bob@example.com", "Bob", []vo.AdminRole{vo.AdminRoleViewer})
+	require.NoError(t, err)
+	disabled.Disable()
+
+	mockAdminRepo.On("GetByKeyHash", mock.Anything, entity.HashAdminKey(activeKey)).Return(active, nil)
+	mockAdminRepo.On("GetByKeyHash", mock.Anything, entity.HashAdminKey(disabledKey)).Return(disabled, nil)
+	mockAdminRepo.On("GetByKeyHash", mock.Anything, mock.Anything).Return(nil, errs.ErrAdminUserNotFound)
+
+	response, err := uc.Authenticate(context.Background(), activeKey)
+	require.NoError(t, err)
+	assert.Equal(t, active.ID, response.ID)
+
+	_, err = uc.Authenticate(context.Background(), disabledKey)
+	assert.ErrorIs(t, err, errs.ErrUnauthorized)
+
+	_, err = uc.Authenticate(context.Background(), "adm_unknown")
+	assert.ErrorIs(t, err, errs.ErrUnauthorized)
+}
+
+func TestAdminUserUseCase_ListRoles(t *testing.T) {
+	uc, _, _ := newAdminUserTestUseCase()
+
+	response := uc.ListRoles(context.Background())
+
+	require.Len(t, response.Roles, len(vo.AdminRoles()))
+	assert.Equal(t, dto.AdminRoleResponse{Role: "VIEWER", Permissions: []string{"accounts:view"}}, response.Roles[0])
+	assert.Equal(t, response.Permissions, response.Roles[len(response.Roles)-1].Permissions)
+}
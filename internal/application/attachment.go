@@ -0,0 +1,255 @@
+// internal/application/attachment.go
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+
+	"github.com/hydr0g3nz/mini_bank/internal/application/dto"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/infra"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/repository"
+)
+
+const (
+	// attachmentSniffSize is the number of leading bytes an attachment's content type is detected from
+	attachmentSniffSize = 512
+	// archivedAttachmentBatchSize caps how many attachments of deleted accounts are loaded at a time when purging them
+	archivedAttachmentBatchSize = 100
+)
+
+type attachmentUseCase struct {
+	attachmentRepo  repository.AttachmentRepository
+	accountRepo     repository.AccountRepository
+	transactionRepo repository.TransactionRepository
+	storage         infra.BlobStorage
+	logger          infra.Logger
+	mapper          *dto.AttachmentMapper
+}
+
+// NewAttachmentUseCase creates a new attachment use case keeping files in storage
+func NewAttachmentUseCase(
+	attachmentRepo repository.AttachmentRepository,
+	accountRepo repository.AccountRepository,
+	transactionRepo repository.TransactionRepository,
+	storage infra.BlobStorage,
+	logger infra.Logger,
+) AttachmentUseCase {
+	return &attachmentUseCase{
+		attachmentRepo:  attachmentRepo,
+		accountRepo:     accountRepo,
+		transactionRepo: transactionRepo,
+		storage:         storage,
+		logger:          logger,
+		mapper:          &dto.AttachmentMapper{},
+	}
+}
+
+// UploadAttachment stores a file attached to one of an account's transactions. The content type is
+// detected from the file itself, and the size is counted while writing it, whatever the client declared.
+func (uc *attachmentUseCase) UploadAttachment(ctx context.Context, req dto.UploadAttachmentRequest) (*dto.AttachmentResponse, error) {
+	accountID, transactionID, err := ownTransaction(ctx, uc.transactionRepo, req.AccountID, req.TransactionID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Deleted accounts take no new files
+	if _, err := uc.accountRepo.GetByID(ctx, accountID); err != nil {
+		return nil, errs.ErrAccountNotFound
+	}
+
+	if req.SizeBytes > entity.MaxAttachmentSize {
+		return nil, errs.ErrAttachmentTooLarge
+	}
+
+	count, err := uc.attachmentRepo.CountByTransactionID(ctx, accountID, transactionID)
+	if err != nil {
+		uc.logger.Error("Failed to count attachments", "error", err, "transactionID", req.TransactionID)
+		return nil, err
+	}
+	if count >= entity.MaxAttachmentsPerTransaction {
+		return nil, errs.ErrAttachmentLimitExceeded
+	}
+
+	head := make([]byte, attachmentSniffSize)
+	n, err := io.ReadFull(req.Content, head)
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) && !errors.Is(err, io.EOF) {
+		return nil, err
+	}
+	head = head[:n]
+	if len(head) == 0 {
+		return nil, errs.ValidationError{Field: "file", Message: "file is empty"}
+	}
+
+	contentType, err := entity.DetectAttachmentContentType(head)
+	if err != nil {
+		return nil, err
+	}
+
+	attachment, err := entity.NewAttachment(accountID, transactionID, req.FileName, contentType)
+	if err != nil {
+		return nil, err
+	}
+
+	// One byte past the limit is enough to tell the file is too large
+	content := io.MultiReader(bytes.NewReader(head), req.Content)
+	size, err := uc.storage.Put(ctx, attachment.ObjectKey, io.LimitReader(content, entity.MaxAttachmentSize+1))
+	if err != nil {
+		uc.logger.Error("Failed to store attachment", "error", err, "attachmentID", attachment.ID)
+		return nil, err
+	}
+	if size > entity.MaxAttachmentSize {
+		uc.deleteFile(ctx, attachment)
+		return nil, errs.ErrAttachmentTooLarge
+	}
+	attachment.SizeBytes = size
+
+	if err := uc.attachmentRepo.Create(ctx, attachment); err != nil {
+		uc.logger.Error("Failed to create attachment", "error", err, "attachmentID", attachment.ID)
+		uc.deleteFile(ctx, attachment)
+		return nil, err
+	}
+
+	uc.logger.Info("Attachment uploaded", "attachmentID", attachment.ID, "accountID", req.AccountID, "transactionID", req.TransactionID, "sizeBytes", size)
+	response := uc.mapper.ToResponse(attachment)
+	return &response, nil
+}
+
+// ListAttachments retrieves an account's attachments of one of its transactions, oldest first
+func (uc *attachmentUseCase) ListAttachments(ctx context.Context, accountID, transactionID string) (*dto.AttachmentListResponse, error) {
+	parsedAccountID, parsedTransactionID, err := ownTransaction(ctx, uc.transactionRepo, accountID, transactionID)
+	if err != nil {
+		return nil, err
+	}
+
+	attachments, err := uc.attachmentRepo.ListByTransactionID(ctx, parsedAccountID, parsedTransactionID)
+	if err != nil {
+		uc.logger.Error("Failed to list attachments", "error", err, "transactionID", transactionID)
+		return nil, err
+	}
+
+	responses := make([]dto.AttachmentResponse, len(attachments))
+	for i, attachment := range attachments {
+		responses[i] = uc.mapper.ToResponse(attachment)
+	}
+
+	return &dto.AttachmentListResponse{Attachments: responses}, nil
+}
+
+// GetAttachment retrieves an attachment of one of an account's transactions
+func (uc *attachmentUseCase) GetAttachment(ctx context.Context, accountID, transactionID, id string) (*dto.AttachmentResponse, error) {
+	attachment, err := uc.accountAttachment(ctx, accountID, transactionID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	response := uc.mapper.ToResponse(attachment)
+	return &response, nil
+}
+
+// OpenAttachment opens the file of an attachment for download
+func (uc *attachmentUseCase) OpenAttachment(ctx context.Context, accountID, transactionID, id string) (*dto.AttachmentDownload, error) {
+	attachment, err := uc.accountAttachment(ctx, accountID, transactionID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := uc.storage.Open(ctx, attachment.ObjectKey)
+	if err != nil {
+		uc.logger.Error("Failed to open attachment file", "error", err, "attachmentID", id, "objectKey", attachment.ObjectKey)
+		if errors.Is(err, infra.ErrBlobNotFound) {
+			return nil, errs.ErrAttachmentNotFound
+		}
+		return nil, err
+	}
+
+	return &dto.AttachmentDownload{
+		Content:     content,
+		FileName:    attachment.FileName,
+		ContentType: attachment.ContentType,
+		SizeBytes:   attachment.SizeBytes,
+	}, nil
+}
+
+// DeleteAttachment removes an attachment and its file, file first, so no record is left pointing to a
+// deleted file
+func (uc *attachmentUseCase) DeleteAttachment(ctx context.Context, accountID, transactionID, id string) error {
+	attachment, err := uc.accountAttachment(ctx, accountID, transactionID, id)
+	if err != nil {
+		return err
+	}
+
+	if err := uc.remove(ctx, attachment); err != nil {
+		return err
+	}
+
+	uc.logger.Info("Attachment deleted", "attachmentID", id, "accountID", accountID)
+	return nil
+}
+
+// PurgeArchived deletes the attachments of deleted accounts and their files. Deleting an account only
+// archives it, so its attachments are removed here rather than with it.
+func (uc *attachmentUseCase) PurgeArchived(ctx context.Context) (int64, error) {
+	var purged int64
+	for {
+		attachments, err := uc.attachmentRepo.ListOfDeletedAccounts(ctx, archivedAttachmentBatchSize)
+		if err != nil {
+			uc.logger.Error("Failed to list attachments of deleted accounts", "error", err)
+			return purged, err
+		}
+
+		for _, attachment := range attachments {
+			if err := uc.remove(ctx, attachment); err != nil {
+				return purged, err
+			}
+			purged++
+		}
+
+		if len(attachments) < archivedAttachmentBatchSize {
+			break
+		}
+	}
+
+	if purged > 0 {
+		uc.logger.Info("Attachments of deleted accounts purged", "count", purged)
+	}
+	return purged, nil
+}
+
+// accountAttachment retrieves an attachment, hiding attachments of other accounts and transactions
+func (uc *attachmentUseCase) accountAttachment(ctx context.Context, accountID, transactionID, id string) (*entity.Attachment, error) {
+	attachment, err := uc.attachmentRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if attachment.AccountID.String() != accountID || attachment.TransactionID.String() != transactionID {
+		return nil, errs.ErrAttachmentNotFound
+	}
+
+	return attachment, nil
+}
+
+// remove deletes an attachment's file, then its record
+func (uc *attachmentUseCase) remove(ctx context.Context, attachment *entity.Attachment) error {
+	if err := uc.storage.Delete(ctx, attachment.ObjectKey); err != nil {
+		uc.logger.Error("Failed to delete attachment file", "error", err, "attachmentID", attachment.ID, "objectKey", attachment.ObjectKey)
+		return err
+	}
+	if err := uc.attachmentRepo.Delete(ctx, attachment.ID); err != nil {
+		uc.logger.Error("Failed to delete attachment", "error", err, "attachmentID", attachment.ID)
+		return err
+	}
+	return nil
+}
+
+// deleteFile removes the file of an attachment that was not recorded; a failure leaves an orphan file
+// that is only logged
+func (uc *attachmentUseCase) deleteFile(ctx context.Context, attachment *entity.Attachment) {
+	if err := uc.storage.Delete(ctx, attachment.ObjectKey); err != nil {
+		uc.logger.Warn("Failed to delete unrecorded attachment file", "error", err, "objectKey", attachment.ObjectKey)
+	}
+}
@@ -0,0 +1,185 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/application/dto"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type MockAttachmentRepository struct {
+	mock.Mock
+}
+
+func (m *MockAttachmentRepository) Create(ctx context.Context, attachment *entity.Attachment) error {
+	args := m.Called(ctx, attachment)
+	return args.Error(0)
+}
+
+func (m *MockAttachmentRepository) GetByID(ctx context.Context, id string) (*entity.Attachment, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.Attachment), args.Error(1)
+}
+
+func (m *MockAttachmentRepository) Delete(ctx context.Context, id string) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockAttachmentRepository) ListByTransactionID(ctx context.Context, accountID vo.AccountID, transactionID vo.TransactionID) ([]*entity.Attachment, error) {
+	args := m.Called(ctx, accountID, transactionID)
+	return args.Get(0).([]*entity.Attachment), args.Error(1)
+}
+
+func (m *MockAttachmentRepository) CountByTransactionID(ctx context.Context, accountID vo.AccountID, transactionID vo.TransactionID) (int64, error) {
+	args := m.Called(ctx, accountID, transactionID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockAttachmentRepository) ListOfDeletedAccounts(ctx context.Context, limit int) ([]*entity.Attachment, error) {
+	args := m.Called(ctx, limit)
+	return args.Get(0).([]*entity.Attachment), args.Error(1)
+}
+
+// pngContent returns PNG file content of the given size
+func pngContent(size int) []byte {
+	content := make([]byte, size)
+	copy(content, "\x89PNG\r\n\x1a\n")
+	return content
+}
+
+func TestAttachmentUseCase_UploadAttachment(t *testing.T) {
+	account := createTestAccount()
+	transaction, err := entity.NewDebitTransaction(account.ID, vo.NewMoneyFromFloat(10), "Card payment", "", time.Now())
+	require.NoError(t, err)
+
+	tests := []struct {
+		name          string
+		accountID     vo.AccountID
+		content       []byte
+		declaredSize  int64
+		count         int64
+		expectedType  string
+		expectedError error
+	}{
+		{name: "success_png", accountID: account.ID, content: pngContent(2048), expectedType: "image/png"},
+		{name: "success_pdf", accountID: account.ID, content: []byte("%PDF-1.7\n%receipt"), expectedType: "application/pdf"},
+		{name: "fail_type_not_allowed", accountID: account.ID, content: []byte("#!/bin/sh\nrm -rf /\n"), expectedError: errs.ErrAttachmentTypeNotAllowed},
+		{name: "fail_declared_too_large", accountID: account.ID, content: pngContent(16), declaredSize: entity.MaxAttachmentSize + 1, expectedError: errs.ErrAttachmentTooLarge},
+		{name: "fail_content_too_large", accountID: account.ID, content: pngContent(entity.MaxAttachmentSize + 1), expectedError: errs.ErrAttachmentTooLarge},
+		{name: "fail_limit_reached", accountID: account.ID, content: pngContent(16), count: entity.MaxAttachmentsPerTransaction, expectedError: errs.ErrAttachmentLimitExceeded},
+		{name: "fail_transaction_of_another_account", accountID: vo.NewAccountID(), content: pngContent(16), expectedError: errs.ErrTransactionNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockAttachmentRepo := new(MockAttachmentRepository)
+			mockAccountRepo := new(MockAccountRepository)
+			mockTxnRepo := new(MockTransactionRepository)
+			mockLogger := new(MockLogger)
+			mockLogger.On("Info", mock.Anything, mock.Anything).Maybe()
+			mockTxnRepo.On("GetByID", mock.Anything, transaction.ID).Return(transaction, nil)
+			mockAccountRepo.On("GetByID", mock.Anything, account.ID).Return(account, nil).Maybe()
+			mockAttachmentRepo.On("CountByTransactionID", mock.Anything, account.ID, transaction.ID).Return(tt.count, nil).Maybe()
+			mockAttachmentRepo.On("Create", mock.Anything, mock.Anything).Return(nil).Maybe()
+			storage := newMemoryBlobStorage()
+
+			uc := NewAttachmentUseCase(mockAttachmentRepo, mockAccountRepo, mockTxnRepo, storage, mockLogger)
+			result, err := uc.UploadAttachment(context.Background(), dto.UploadAttachmentRequest{
+				AccountID:     tt.accountID.String(),
+				TransactionID: transaction.ID.String(),
+				FileName:      "../receipt",
+				SizeBytes:     tt.declaredSize,
+				Content:       bytes.NewReader(tt.content),
+			})
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Empty(t, storage.blobs)
+				mockAttachmentRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "receipt", result.FileName)
+			assert.Equal(t, tt.expectedType, result.ContentType)
+			assert.Equal(t, int64(len(tt.content)), result.SizeBytes)
+
+			attachment := mockAttachmentRepo.Calls[len(mockAttachmentRepo.Calls)-1].Arguments.Get(1).(*entity.Attachment)
+			assert.Equal(t, tt.content, storage.blobs[attachment.ObjectKey])
+		})
+	}
+}
+
+func TestAttachmentUseCase_OpenAttachment(t *testing.T) {
+	account := createTestAccount()
+	transaction, err := entity.NewDebitTransaction(account.ID, vo.NewMoneyFromFloat(10), "Card payment", "", time.Now())
+	require.NoError(t, err)
+	attachment, err := entity.NewAttachment(account.ID, transaction.ID, "receipt.png", "image/png")
+	require.NoError(t, err)
+
+	mockAttachmentRepo := new(MockAttachmentRepository)
+	mockAttachmentRepo.On("GetByID", mock.Anything, attachment.ID).Return(attachment, nil)
+	mockLogger := new(MockLogger)
+	mockLogger.On("Error", mock.Anything, mock.Anything).Maybe()
+	storage := newMemoryBlobStorage()
+
+	uc := NewAttachmentUseCase(mockAttachmentRepo, nil, nil, storage, mockLogger)
+
+	// Another account's attachment is hidden
+	_, err = uc.OpenAttachment(context.Background(), vo.NewAccountID().String(), transaction.ID.String(), attachment.ID)
+	assert.ErrorIs(t, err, errs.ErrAttachmentNotFound)
+
+	// A missing file reads as a missing attachment
+	_, err = uc.OpenAttachment(context.Background(), account.ID.String(), transaction.ID.String(), attachment.ID)
+	assert.ErrorIs(t, err, errs.ErrAttachmentNotFound)
+
+	storage.blobs[attachment.ObjectKey] = pngContent(64)
+	download, err := uc.OpenAttachment(context.Background(), account.ID.String(), transaction.ID.String(), attachment.ID)
+	require.NoError(t, err)
+	defer download.Content.Close()
+	content, err := io.ReadAll(download.Content)
+	require.NoError(t, err)
+	assert.Equal(t, pngContent(64), content)
+	assert.Equal(t, "receipt.png", download.FileName)
+}
+
+func TestAttachmentUseCase_PurgeArchived(t *testing.T) {
+	account := createTestAccount()
+	transaction, err := entity.NewDebitTransaction(account.ID, vo.NewMoneyFromFloat(10), "Card payment", "", time.Now())
+	require.NoError(t, err)
+
+	storage := newMemoryBlobStorage()
+	attachments := make([]*entity.Attachment, 2)
+	for i := range attachments {
+		attachments[i], err = entity.NewAttachment(account.ID, transaction.ID, "receipt.pdf", "application/pdf")
+		require.NoError(t, err)
+		attachments[i].ObjectKey += string(rune('a' + i))
+		storage.blobs[attachments[i].ObjectKey] = []byte("%PDF-1.7")
+	}
+
+	mockAttachmentRepo := new(MockAttachmentRepository)
+	mockAttachmentRepo.On("ListOfDeletedAccounts", mock.Anything, archivedAttachmentBatchSize).Return(attachments, nil)
+	mockAttachmentRepo.On("Delete", mock.Anything, mock.Anything).Return(nil)
+	mockLogger := new(MockLogger)
+	mockLogger.On("Info", mock.Anything, mock.Anything).Maybe()
+
+	uc := NewAttachmentUseCase(mockAttachmentRepo, nil, nil, storage, mockLogger)
+	purged, err := uc.PurgeArchived(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), purged)
+	assert.Empty(t, storage.blobs)
+	mockAttachmentRepo.AssertNumberOfCalls(t, "Delete", 2)
+}
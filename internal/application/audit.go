@@ -0,0 +1,164 @@
+// internal/application/audit.go
+package usecase
+
+import (
+	"bufio"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/application/dto"
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/event"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/infra"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/repository"
+)
+
+const (
+	// auditExportPageSize is how many entries an export loads per page
+	auditExportPageSize = 500
+	// auditSignatureAlgorithm names how exports are signed
+	auditSignatureAlgorithm = "HMAC-SHA256"
+)
+
+// AuditRecorder keeps every domain event in the audit log before passing it on.
+// It wraps the event publisher so each event is recorded once, by the instance that raised it.
+type AuditRecorder struct {
+	auditRepo repository.AuditRepository
+	next      infra.EventPublisher
+	logger    infra.Logger
+}
+
+// NewAuditRecorder creates an audit recorder publishing through next
+func NewAuditRecorder(auditRepo repository.AuditRepository, next infra.EventPublisher, logger infra.Logger) *AuditRecorder {
+	return &AuditRecorder{
+		auditRepo: auditRepo,
+		next:      next,
+		logger:    logger,
+	}
+}
+
+// Publish records the event and forwards it; a failed recording does not hold the event back
+func (r *AuditRecorder) Publish(ctx context.Context, evt event.Event) error {
+	if err := r.auditRepo.Record(ctx, evt); err != nil {
+		r.logger.Error("Failed to record audit entry", "error", err, "eventID", evt.ID, "type", evt.Type)
+	}
+
+	return r.next.Publish(ctx, evt)
+}
+
+type auditUseCase struct {
+	auditRepo   repository.AuditRepository
+	signingKey  infra.SecretValue
+	retention   time.Duration
+	valueDating *ValueDatingPolicy
+	logger      infra.Logger
+}
+
+// NewAuditUseCase creates a new audit use case signing exports with the current value of signingKey
+// and keeping entries for retention; a zero retention keeps them forever
+func NewAuditUseCase(auditRepo repository.AuditRepository, signingKey infra.SecretValue, retention time.Duration, valueDating *ValueDatingPolicy, logger infra.Logger) AuditUseCase {
+	return &auditUseCase{
+		auditRepo:   auditRepo,
+		signingKey:  signingKey,
+		retention:   retention,
+		valueDating: valueDating,
+		logger:      logger,
+	}
+}
+
+// ExportAudit writes the matching audit entries to w as NDJSON in log order, closed by a
+// signature line. Nothing is written when the request is invalid; an export that fails
+// midway ends without its signature line.
+func (uc *auditUseCase) ExportAudit(ctx context.Context, req dto.AuditExportRequest, w io.Writer) (*dto.AuditSignatureLine, error) {
+	if req.From != nil && req.To != nil && !req.From.Before(*req.To) {
+		return nil, errs.ValidationError{Field: "to", Message: "to must be after from"}
+	}
+
+	filter := repository.AuditFilter{
+		EventType: event.Type(req.EventType),
+		Key:       req.AccountID,
+		From:      req.From,
+		To:        req.To,
+	}
+
+	buffered := bufio.NewWriter(w)
+	mac := hmac.New(sha256.New, []byte(uc.signingKey.Value()))
+	encoder := json.NewEncoder(io.MultiWriter(buffered, mac))
+
+	entries := 0
+	var after uint64
+	for {
+		page, err := uc.auditRepo.ListAfter(ctx, filter, after, auditExportPageSize)
+		if err != nil {
+			uc.logger.Error("Failed to load audit entries", "error", err, "afterSequence", after)
+			return nil, err
+		}
+
+		for _, entry := range page {
+			if err := encoder.Encode(toAuditEntryLine(entry)); err != nil {
+				return nil, err
+			}
+			after = entry.Sequence
+			entries++
+		}
+
+		if len(page) < auditExportPageSize {
+			break
+		}
+	}
+
+	// The signature line itself is not signed
+	signature := &dto.AuditSignatureLine{
+		Type:       dto.AuditLineSignature,
+		Algorithm:  auditSignatureAlgorithm,
+		Entries:    entries,
+		ExportedAt: uc.valueDating.Now(),
+		Signature:  hex.EncodeToString(mac.Sum(nil)),
+	}
+	if err := json.NewEncoder(buffered).Encode(signature); err != nil {
+		return nil, err
+	}
+	if err := buffered.Flush(); err != nil {
+		return nil, err
+	}
+
+	uc.logger.Info("Audit log exported", "entries", entries, "eventType", req.EventType, "accountID", req.AccountID)
+	return signature, nil
+}
+
+// PurgeExpired removes the entries older than the retention period
+func (uc *auditUseCase) PurgeExpired(ctx context.Context) (int64, error) {
+	if uc.retention <= 0 {
+		return 0, nil
+	}
+
+	cutoff := uc.valueDating.Now().Add(-uc.retention)
+	purged, err := uc.auditRepo.DeleteOccurredBefore(ctx, cutoff)
+	if err != nil {
+		uc.logger.Error("Failed to purge audit entries", "error", err, "cutoff", cutoff)
+		return 0, err
+	}
+
+	if purged > 0 {
+		uc.logger.Info("Expired audit entries purged", "count", purged, "cutoff", cutoff)
+	}
+	return purged, nil
+}
+
+func toAuditEntryLine(entry *event.AuditEntry) dto.AuditEntryLine {
+	return dto.AuditEntryLine{
+		Type:       dto.AuditLineEntry,
+		Sequence:   entry.Sequence,
+		EventID:    entry.Event.ID,
+		EventType:  string(entry.Event.Type),
+		Key:        entry.Event.Key,
+		OccurredAt: entry.Event.OccurredAt,
+		RecordedAt: entry.RecordedAt,
+		Data:       entry.Event.Data,
+	}
+}
@@ -0,0 +1,172 @@
+package usecase
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/application/dto"
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/event"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/infra"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/repository"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type MockAuditRepository struct {
+	mock.Mock
+}
+
+func (m *MockAuditRepository) Record(ctx context.Context, evt event.Event) error {
+	args := m.Called(ctx, evt)
+	return args.Error(0)
+}
+
+func (m *MockAuditRepository) ListAfter(ctx context.Context, filter repository.AuditFilter, afterSequence uint64, limit int) ([]*event.AuditEntry, error) {
+	args := m.Called(ctx, filter, afterSequence, limit)
+	return args.Get(0).([]*event.AuditEntry), args.Error(1)
+}
+
+func (m *MockAuditRepository) DeleteOccurredBefore(ctx context.Context, cutoff time.Time) (int64, error) {
+	args := m.Called(ctx, cutoff)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func newAuditFixture() (*MockAuditRepository, AuditUseCase) {
+	auditRepo := new(MockAuditRepository)
+	mockLogger := new(MockLogger)
+	mockLogger.On("Info", mock.Anything, mock.Anything).Maybe()
+	mockLogger.On("Error", mock.Anything, mock.Anything).Maybe()
+
+	return auditRepo, NewAuditUseCase(auditRepo, infra.StaticSecret("audit-secret"), 30*24*time.Hour, NewValueDatingPolicy(&StubCalendar{}, vo.ProcessingWindow{}, nil), mockLogger)
+}
+
+func auditEntries(from uint64, n int) []*event.AuditEntry {
+	entries := make([]*event.AuditEntry, n)
+	for i := range entries {
+		entries[i] = &event.AuditEntry{
+			Sequence:   from + uint64(i),
+			Event:      event.NewAccountEvent(event.AccountCreated, createTestAccount()),
+			RecordedAt: time.Now(),
+		}
+	}
+	return entries
+}
+
+func TestAuditUseCase_ExportAudit(t *testing.T) {
+	auditRepo, uc := newAuditFixture()
+	accountID := "ACC1234567890123"
+	filter := repository.AuditFilter{EventType: event.AccountCreated, Key: accountID}
+
+	// A full page is followed by another read after its last sequence
+	auditRepo.On("ListAfter", mock.Anything, filter, uint64(0), auditExportPageSize).Return(auditEntries(1, auditExportPageSize), nil)
+	auditRepo.On("ListAfter", mock.Anything, filter, uint64(auditExportPageSize), auditExportPageSize).Return(auditEntries(auditExportPageSize+1, 2), nil)
+
+	var out bytes.Buffer
+	signature, err := uc.ExportAudit(context.Background(), dto.AuditExportRequest{
+		EventType: string(event.AccountCreated),
+		AccountID: accountID,
+	}, &out)
+	require.NoError(t, err)
+	assert.Equal(t, auditExportPageSize+2, signature.Entries)
+
+	var lines [][]byte
+	scanner := bufio.NewScanner(&out)
+	for scanner.Scan() {
+		lines = append(lines, append([]byte(nil), scanner.Bytes()...))
+	}
+	require.Len(t, lines, auditExportPageSize+3)
+
+	var first dto.AuditEntryLine
+	require.NoError(t, json.Unmarshal(lines[0], &first))
+	assert.Equal(t, dto.AuditLineEntry, first.Type)
+	assert.Equal(t, uint64(1), first.Sequence)
+	assert.Equal(t, string(event.AccountCreated), first.EventType)
+
+	// The signature covers every byte before the signature line
+	var last dto.AuditSignatureLine
+	require.NoError(t, json.Unmarshal(lines[len(lines)-1], &last))
+	assert.Equal(t, dto.AuditLineSignature, last.Type)
+	assert.Equal(t, "HMAC-SHA256", last.Algorithm)
+
+	mac := hmac.New(sha256.New, []byte("audit-secret"))
+	for _, line := range lines[:len(lines)-1] {
+		mac.Write(line)
+		mac.Write([]byte("\n"))
+	}
+	assert.Equal(t, hex.EncodeToString(mac.Sum(nil)), last.Signature)
+	assert.Equal(t, signature.Signature, last.Signature)
+}
+
+func TestAuditUseCase_ExportAudit_InvalidRange(t *testing.T) {
+	auditRepo, uc := newAuditFixture()
+	from := time.Now()
+	to := from.Add(-time.Hour)
+
+	var out bytes.Buffer
+	_, err := uc.ExportAudit(context.Background(), dto.AuditExportRequest{From: &from, To: &to}, &out)
+
+	assert.IsType(t, errs.ValidationError{}, err)
+	assert.Zero(t, out.Len())
+	auditRepo.AssertNotCalled(t, "ListAfter", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestAuditUseCase_ExportAudit_RepositoryError(t *testing.T) {
+	auditRepo, uc := newAuditFixture()
+	auditRepo.On("ListAfter", mock.Anything, repository.AuditFilter{}, uint64(0), auditExportPageSize).Return([]*event.AuditEntry(nil), errors.New("database unavailable"))
+
+	var out bytes.Buffer
+	_, err := uc.ExportAudit(context.Background(), dto.AuditExportRequest{}, &out)
+
+	assert.Error(t, err)
+	assert.Zero(t, out.Len())
+}
+
+func TestAuditUseCase_PurgeExpired(t *testing.T) {
+	auditRepo, uc := newAuditFixture()
+	auditRepo.On("DeleteOccurredBefore", mock.Anything, mock.MatchedBy(func(cutoff time.Time) bool {
+		return time.Since(cutoff) > 29*24*time.Hour && time.Since(cutoff) < 31*24*time.Hour
+	})).Return(int64(3), nil)
+
+	purged, err := uc.PurgeExpired(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), purged)
+
+	// A zero retention keeps entries forever
+	keepAll := NewAuditUseCase(auditRepo, infra.StaticSecret("audit-secret"), 0, NewValueDatingPolicy(&StubCalendar{}, vo.ProcessingWindow{}, nil), new(MockLogger))
+	purged, err = keepAll.PurgeExpired(context.Background())
+	require.NoError(t, err)
+	assert.Zero(t, purged)
+	auditRepo.AssertNumberOfCalls(t, "DeleteOccurredBefore", 1)
+}
+
+func TestAuditRecorder_Publish(t *testing.T) {
+	auditRepo := new(MockAuditRepository)
+	mockLogger := new(MockLogger)
+	mockLogger.On("Error", mock.Anything, mock.Anything).Maybe()
+	next := &StubEventPublisher{}
+	recorder := NewAuditRecorder(auditRepo, next, mockLogger)
+
+	recorded := event.NewAccountEvent(event.AccountCreated, createTestAccount())
+	failed := event.NewAccountEvent(event.AccountUpdated, createTestAccount())
+	auditRepo.On("Record", mock.Anything, recorded).Return(nil)
+	auditRepo.On("Record", mock.Anything, failed).Return(errors.New("database unavailable"))
+
+	require.NoError(t, recorder.Publish(context.Background(), recorded))
+	// Events are still delivered when they cannot be recorded
+	require.NoError(t, recorder.Publish(context.Background(), failed))
+
+	assert.Len(t, next.Events, 2)
+	auditRepo.AssertExpectations(t)
+}
@@ -0,0 +1,189 @@
+// internal/application/backup.go
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/application/dto"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/infra"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/repository"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+)
+
+// backupTimeout bounds how long a single pg_dump run may take
+const backupTimeout = 30 * time.Minute
+
+// backupJob is the payload of a backup job
+type backupJob struct {
+	BackupID string `json:"backup_id"`
+}
+
+type backupUseCase struct {
+	backupRepo      repository.BackupRepository
+	accountRepo     repository.AccountRepository
+	transactionRepo repository.TransactionRepository
+	backupService   infra.BackupService
+	jobs            *JobQueue
+	logger          infra.Logger
+	mapper          *dto.BackupMapper
+}
+
+// NewBackupUseCase creates a new backup use case running dumps as jobs on jobs
+func NewBackupUseCase(
+	backupRepo repository.BackupRepository,
+	accountRepo repository.AccountRepository,
+	transactionRepo repository.TransactionRepository,
+	backupService infra.BackupService,
+	jobs *JobQueue,
+	logger infra.Logger,
+) BackupUseCase {
+	uc := &backupUseCase{
+		backupRepo:      backupRepo,
+		accountRepo:     accountRepo,
+		transactionRepo: transactionRepo,
+		backupService:   backupService,
+		jobs:            jobs,
+		logger:          logger,
+		mapper:          &dto.BackupMapper{},
+	}
+	jobs.Register(JobTypeBackup, uc.runBackup, JobOptions{Timeout: backupTimeout})
+	return uc
+}
+
+// TriggerBackup records a new backup and queues a job running the dump
+func (uc *backupUseCase) TriggerBackup(ctx context.Context, requestedBy string) (*dto.BackupResponse, error) {
+	uc.logger.Info("Triggering database backup", "requestedBy", requestedBy)
+
+	backup := entity.NewBackup(requestedBy)
+	if err := uc.backupRepo.Create(ctx, backup); err != nil {
+		uc.logger.Error("Failed to record backup", "error", err, "backupID", backup.ID)
+		return nil, err
+	}
+
+	if _, err := uc.jobs.Enqueue(ctx, JobTypeBackup, backupJob{BackupID: backup.ID}, time.Now()); err != nil {
+		backup.MarkAsFailed("backup could not be queued")
+		if updateErr := uc.backupRepo.Update(ctx, backup); updateErr != nil {
+			uc.logger.Error("Failed to update backup record", "error", updateErr, "backupID", backup.ID)
+		}
+		return nil, err
+	}
+
+	response := uc.mapper.ToResponse(backup)
+	return &response, nil
+}
+
+// GetBackup retrieves backup metadata by ID
+func (uc *backupUseCase) GetBackup(ctx context.Context, id string) (*dto.BackupResponse, error) {
+	backup, err := uc.backupRepo.GetByID(ctx, id)
+	if err != nil {
+		uc.logger.Error("Failed to get backup", "error", err, "backupID", id)
+		return nil, err
+	}
+
+	response := uc.mapper.ToResponse(backup)
+	return &response, nil
+}
+
+// ListBackups retrieves backup metadata with pagination
+func (uc *backupUseCase) ListBackups(ctx context.Context, req dto.ListRequest) (*dto.BackupListResponse, error) {
+	offset := (req.Page - 1) * req.PageSize
+
+	backups, err := uc.backupRepo.List(ctx, req.PageSize, offset)
+	if err != nil {
+		uc.logger.Error("Failed to list backups", "error", err)
+		return nil, err
+	}
+
+	total, err := uc.backupRepo.Count(ctx)
+	if err != nil {
+		uc.logger.Error("Failed to count backups", "error", err)
+		return nil, err
+	}
+
+	pagination := dto.NewPaginationInfo(req.Page, req.PageSize, total)
+
+	response := uc.mapper.ToResponseList(backups, pagination)
+	return &response, nil
+}
+
+// ReconstructBalance rebuilds an account balance as of a point in time from the transaction log.
+// It starts from the stored balance and rolls back every completed transaction after AsOf,
+// so comparing the result with a restored snapshot validates the recovery.
+func (uc *backupUseCase) ReconstructBalance(ctx context.Context, req dto.BalanceAsOfRequest) (*dto.BalanceAsOfResponse, error) {
+	uc.logger.Info("Reconstructing account balance", "accountID", req.AccountID, "asOf", req.AsOf)
+
+	accountID, err := vo.NewAccountIDFromString(req.AccountID)
+	if err != nil {
+		uc.logger.Error("Invalid account ID format", "error", err, "accountID", req.AccountID)
+		return nil, err
+	}
+
+	account, err := uc.accountRepo.GetByID(ctx, accountID)
+	if err != nil {
+		uc.logger.Error("Account not found", "error", err, "accountID", req.AccountID)
+		return nil, errs.ErrAccountNotFound
+	}
+
+	if account.CreatedAt.After(req.AsOf) {
+		return nil, errs.ErrAccountNotOpenAt
+	}
+
+	transactions, err := uc.transactionRepo.GetCompletedByAccountIDSince(ctx, accountID, req.AsOf)
+	if err != nil {
+		uc.logger.Error("Failed to load transaction log", "error", err, "accountID", req.AccountID)
+		return nil, err
+	}
+
+	balance := account.Balance
+	for _, transaction := range transactions {
+		balance, _ = balance.Subtract(transaction.BalanceEffect(accountID))
+	}
+
+	return &dto.BalanceAsOfResponse{
+		AccountID:            accountID.String(),
+		AsOf:                 req.AsOf,
+		Balance:              balance.Amount().InexactFloat64(),
+		CurrentBalance:       account.Balance.Amount().InexactFloat64(),
+		TransactionsReplayed: len(transactions),
+	}, nil
+}
+
+// runBackup executes the dump of a backup job and records the outcome. A failed dump is retried,
+// and only recorded on the backup once the job is out of attempts.
+func (uc *backupUseCase) runBackup(ctx context.Context, job *entity.Job) error {
+	var payload backupJob
+	if err := job.DecodePayload(&payload); err != nil {
+		return err
+	}
+
+	backup, err := uc.backupRepo.GetByID(ctx, payload.BackupID)
+	if err != nil {
+		return err
+	}
+	// A job run again after its worker died may find the backup already recorded
+	if backup.Status.IsFinished() {
+		return nil
+	}
+
+	result, dumpErr := uc.backupService.Dump(ctx, backup.ID)
+	if dumpErr != nil {
+		uc.logger.Error("Database backup failed", "error", dumpErr, "backupID", backup.ID, "attempt", job.Attempts)
+		if !job.IsLastAttempt() {
+			return dumpErr
+		}
+		backup.MarkAsFailed(dumpErr.Error())
+	} else {
+		backup.MarkAsCompleted(result.Location, result.SizeBytes)
+		uc.logger.Info("Database backup completed", "backupID", backup.ID, "location", result.Location, "sizeBytes", result.SizeBytes)
+	}
+
+	// The outcome is recorded even when the dump ran out of time
+	if err := uc.backupRepo.Update(context.WithoutCancel(ctx), backup); err != nil {
+		uc.logger.Error("Failed to update backup record", "error", err, "backupID", backup.ID)
+		return err
+	}
+	return dumpErr
+}
@@ -0,0 +1,98 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/application/dto"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func completedTransaction(t *testing.T, txn *entity.Transaction, err error) *entity.Transaction {
+	require.NoError(t, err)
+	require.NoError(t, txn.MarkAsCompleted(time.Now()))
+	return txn
+}
+
+func TestBackupUseCase_ReconstructBalance(t *testing.T) {
+	account := createTestAccount()
+	account.CreatedAt = time.Now().Add(-48 * time.Hour)
+	asOf := time.Now().Add(-24 * time.Hour)
+	other := vo.NewAccountID()
+
+	tests := []struct {
+		name            string
+		request         dto.BalanceAsOfRequest
+		setupMocks      func(*MockAccountRepository, *MockTransactionRepository, *MockLogger)
+		expectedError   error
+		expectedBalance float64
+	}{
+		{
+			name:    "success_rolls_back_later_transactions",
+			request: dto.BalanceAsOfRequest{AccountID: account.ID.String(), AsOf: asOf},
+			setupMocks: func(accountRepo *MockAccountRepository, txnRepo *MockTransactionRepository, logger *MockLogger) {
+				credit, err := entity.NewCreditTransaction(account.ID, vo.NewMoneyFromFloat(300), "Deposit", "", time.Now())
+				debit, err2 := entity.NewTransferTransaction(account.ID, other, vo.NewMoneyFromFloat(50), "Rent", "", time.Now())
+				accountRepo.On("GetByID", mock.Anything, account.ID).Return(account, nil)
+				txnRepo.On("GetCompletedByAccountIDSince", mock.Anything, account.ID, asOf).Return([]*entity.Transaction{
+					completedTransaction(t, credit, err),
+					completedTransaction(t, debit, err2),
+				}, nil)
+				logger.On("Info", mock.Anything, mock.Anything).Return()
+			},
+			// 1000 current - 300 credit + 50 transfer out
+			expectedBalance: 750,
+		},
+		{
+			name:    "fail_account_created_after_timestamp",
+			request: dto.BalanceAsOfRequest{AccountID: account.ID.String(), AsOf: account.CreatedAt.Add(-time.Hour)},
+			setupMocks: func(accountRepo *MockAccountRepository, txnRepo *MockTransactionRepository, logger *MockLogger) {
+				accountRepo.On("GetByID", mock.Anything, account.ID).Return(account, nil)
+				logger.On("Info", mock.Anything, mock.Anything).Return()
+			},
+			expectedError: errs.ErrAccountNotOpenAt,
+		},
+		{
+			name:    "fail_invalid_account_id",
+			request: dto.BalanceAsOfRequest{AccountID: "invalid", AsOf: asOf},
+			setupMocks: func(accountRepo *MockAccountRepository, txnRepo *MockTransactionRepository, logger *MockLogger) {
+				logger.On("Info", mock.Anything, mock.Anything).Return()
+				logger.On("Error", mock.Anything, mock.Anything).Return()
+			},
+			expectedError: errs.ErrInvalidAccountID,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockAccountRepo := new(MockAccountRepository)
+			mockTxnRepo := new(MockTransactionRepository)
+			mockLogger := new(MockLogger)
+
+			tt.setupMocks(mockAccountRepo, mockTxnRepo, mockLogger)
+
+			uc := NewBackupUseCase(nil, mockAccountRepo, mockTxnRepo, nil, NewJobQueue(nil, testJobQueueConfig, mockLogger), mockLogger)
+
+			result, err := uc.ReconstructBalance(context.Background(), tt.request)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectedBalance, result.Balance)
+				assert.Equal(t, 1000.0, result.CurrentBalance)
+				assert.Equal(t, 2, result.TransactionsReplayed)
+			}
+
+			mockAccountRepo.AssertExpectations(t)
+			mockTxnRepo.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,78 @@
+// internal/application/balance_recalculation.go
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/application/dto"
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/event"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+)
+
+// RecalculateBalance replays an account's completed transactions over its opening balance and compares
+// the result with the stored balance. With Confirm set, a stored balance that differs is repaired and the
+// adjustment recorded; a transaction applied between the replay and the repair fails the repair with
+// errs.ErrBalanceChanged rather than being overwritten, so the recalculation can simply be run again.
+func (uc *transactionUseCase) RecalculateBalance(ctx context.Context, req dto.RecalculateBalanceRequest) (*dto.BalanceRecalculationResponse, error) {
+	accountID, err := vo.NewAccountIDFromString(req.AccountID)
+	if err != nil {
+		uc.logger.Error("Invalid account ID format", "error", err, "accountID", req.AccountID)
+		return nil, err
+	}
+
+	account, err := uc.accountRepo.GetByID(ctx, accountID)
+	if err != nil {
+		uc.logger.Error("Account not found", "error", err, "accountID", req.AccountID)
+		return nil, errs.ErrAccountNotFound
+	}
+
+	transactions, err := uc.transactionRepo.GetCompletedByAccountIDSince(ctx, accountID, time.Time{})
+	if err != nil {
+		uc.logger.Error("Failed to get completed transactions", "error", err, "accountID", req.AccountID)
+		return nil, err
+	}
+
+	recalculated, err := account.RecalculateBalance(transactions)
+	if err != nil {
+		if !errors.Is(err, errs.ErrOpeningBalanceUnknown) {
+			uc.logger.Error("Failed to recalculate balance", "error", err, "accountID", req.AccountID)
+		}
+		return nil, err
+	}
+
+	delta, _ := recalculated.Subtract(account.Balance)
+	response := &dto.BalanceRecalculationResponse{
+		AccountID:           accountID.String(),
+		OpeningBalance:      account.OpeningBalance.InexactFloat64(),
+		TransactionCount:    len(transactions),
+		StoredBalance:       account.Balance.InexactFloat64(),
+		RecalculatedBalance: recalculated.InexactFloat64(),
+		Delta:               delta.InexactFloat64(),
+		Currency:            account.Currency().String(),
+	}
+	if !req.Confirm || delta.IsZero() {
+		return response, nil
+	}
+
+	adjustment := account.RepairBalance(recalculated, req.RequestedBy, req.Reason)
+	if err := uc.accountRepo.RepairBalance(ctx, adjustment); err != nil {
+		uc.logger.Error("Failed to repair balance", "error", err, "accountID", req.AccountID)
+		return nil, err
+	}
+	response.Repaired = true
+
+	cacheKey := fmt.Sprintf("account:%s", accountID.String())
+	if err := uc.cache.Delete(ctx, cacheKey); err != nil {
+		uc.logger.Warn("Failed to invalidate account cache", "error", err, "accountID", req.AccountID)
+	}
+	uc.publish(ctx, event.NewAccountEvent(event.AccountUpdated, account))
+
+	uc.logger.Info("Account balance repaired", "accountID", req.AccountID,
+		"previousBalance", adjustment.PreviousBalance.String(), "newBalance", adjustment.NewBalance.String(),
+		"requestedBy", req.RequestedBy, "reason", req.Reason)
+	return response, nil
+}
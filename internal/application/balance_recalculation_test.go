@@ -0,0 +1,111 @@
+package usecase
+
+import (
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/application/dto"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// completedTransactions returns a completed debit of 100 and a completed credit of 250 of the test account
+func (suite *TransactionUseCaseTestSuite) completedTransactions() []*entity.Transaction {
+	debit, err := entity.NewDebitTransaction(suite.testAccount.ID, vo.NewMoneyFromFloat(100), "Debit", "", time.Now())
+	suite.Require().NoError(err)
+	suite.Require().NoError(debit.MarkAsCompleted(time.Now()))
+	credit, err := entity.NewCreditTransaction(suite.testAccount.ID, vo.NewMoneyFromFloat(250), "Credit", "", time.Now())
+	suite.Require().NoError(err)
+	suite.Require().NoError(credit.MarkAsCompleted(time.Now()))
+	return []*entity.Transaction{debit, credit}
+}
+
+func (suite *TransactionUseCaseTestSuite) TestRecalculateBalance_ShowsDelta() {
+	// The stored balance missed the credit
+	suite.testAccount.Balance = vo.NewMoneyFromFloat(900)
+	suite.mockAccountRepo.On("GetByID", suite.ctx, suite.testAccount.ID).Return(suite.testAccount, nil)
+	suite.mockTxnRepo.On("GetCompletedByAccountIDSince", suite.ctx, suite.testAccount.ID, time.Time{}).Return(suite.completedTransactions(), nil)
+
+	result, err := suite.usecase.RecalculateBalance(suite.ctx, dto.RecalculateBalanceRequest{AccountID: suite.testAccount.ID.String()})
+
+	suite.Require().NoError(err)
+	assert.Equal(suite.T(), 1000.0, result.OpeningBalance)
+	assert.Equal(suite.T(), 2, result.TransactionCount)
+	assert.Equal(suite.T(), 900.0, result.StoredBalance)
+	assert.Equal(suite.T(), 1150.0, result.RecalculatedBalance)
+	assert.Equal(suite.T(), 250.0, result.Delta)
+	assert.False(suite.T(), result.Repaired)
+	suite.mockAccountRepo.AssertNotCalled(suite.T(), "RepairBalance", mock.Anything, mock.Anything)
+}
+
+func (suite *TransactionUseCaseTestSuite) TestRecalculateBalance_Repairs() {
+	suite.testAccount.Balance = vo.NewMoneyFromFloat(900)
+	suite.mockAccountRepo.On("GetByID", suite.ctx, suite.testAccount.ID).Return(suite.testAccount, nil)
+	suite.mockTxnRepo.On("GetCompletedByAccountIDSince", suite.ctx, suite.testAccount.ID, time.Time{}).Return(suite.completedTransactions(), nil)
+	suite.mockAccountRepo.On("RepairBalance", suite.ctx, mock.MatchedBy(func(adjustment *entity.BalanceAdjustment) bool {
+		return adjustment.PreviousBalance.Equal(vo.NewMoneyFromFloat(900)) &&
+			adjustment.NewBalance.Equal(vo.NewMoneyFromFloat(1150)) &&
+			adjustment.RequestedBy == "ops@bank" && adjustment.Reason == "ticket 42"
+	})).Return(nil)
+	suite.mockCache.On("Delete", suite.ctx, "account:"+suite.testAccount.ID.String()).Return(nil)
+
+	result, err := suite.usecase.RecalculateBalance(suite.ctx, dto.RecalculateBalanceRequest{
+		AccountID:   suite.testAccount.ID.String(),
+		Confirm:     true,
+		RequestedBy: "ops@bank",
+		Reason:      "ticket 42",
+	})
+
+	suite.Require().NoError(err)
+	assert.True(suite.T(), result.Repaired)
+	assert.Equal(suite.T(), 900.0, result.StoredBalance)
+	assert.True(suite.T(), suite.testAccount.Balance.Equal(vo.NewMoneyFromFloat(1150)))
+	suite.mockAccountRepo.AssertExpectations(suite.T())
+}
+
+func (suite *TransactionUseCaseTestSuite) TestRecalculateBalance_NothingToRepair() {
+	suite.testAccount.Balance = vo.NewMoneyFromFloat(1150)
+	suite.mockAccountRepo.On("GetByID", suite.ctx, suite.testAccount.ID).Return(suite.testAccount, nil)
+	suite.mockTxnRepo.On("GetCompletedByAccountIDSince", suite.ctx, suite.testAccount.ID, time.Time{}).Return(suite.completedTransactions(), nil)
+
+	result, err := suite.usecase.RecalculateBalance(suite.ctx, dto.RecalculateBalanceRequest{
+		AccountID:   suite.testAccount.ID.String(),
+		Confirm:     true,
+		RequestedBy: "ops@bank",
+		Reason:      "ticket 42",
+	})
+
+	suite.Require().NoError(err)
+	assert.Zero(suite.T(), result.Delta)
+	assert.False(suite.T(), result.Repaired)
+	suite.mockAccountRepo.AssertNotCalled(suite.T(), "RepairBalance", mock.Anything, mock.Anything)
+}
+
+func (suite *TransactionUseCaseTestSuite) TestRecalculateBalance_BalanceChanged() {
+	suite.testAccount.Balance = vo.NewMoneyFromFloat(900)
+	suite.mockAccountRepo.On("GetByID", suite.ctx, suite.testAccount.ID).Return(suite.testAccount, nil)
+	suite.mockTxnRepo.On("GetCompletedByAccountIDSince", suite.ctx, suite.testAccount.ID, time.Time{}).Return(suite.completedTransactions(), nil)
+	suite.mockAccountRepo.On("RepairBalance", suite.ctx, mock.Anything).Return(errs.ErrBalanceChanged)
+
+	_, err := suite.usecase.RecalculateBalance(suite.ctx, dto.RecalculateBalanceRequest{
+		AccountID:   suite.testAccount.ID.String(),
+		Confirm:     true,
+		RequestedBy: "ops@bank",
+		Reason:      "ticket 42",
+	})
+
+	assert.ErrorIs(suite.T(), err, errs.ErrBalanceChanged)
+	suite.mockCache.AssertNotCalled(suite.T(), "Delete", mock.Anything, mock.Anything)
+}
+
+func (suite *TransactionUseCaseTestSuite) TestRecalculateBalance_OpeningBalanceUnknown() {
+	suite.testAccount.OpeningBalance = nil
+	suite.mockAccountRepo.On("GetByID", suite.ctx, suite.testAccount.ID).Return(suite.testAccount, nil)
+	suite.mockTxnRepo.On("GetCompletedByAccountIDSince", suite.ctx, suite.testAccount.ID, time.Time{}).Return(suite.completedTransactions(), nil)
+
+	_, err := suite.usecase.RecalculateBalance(suite.ctx, dto.RecalculateBalanceRequest{AccountID: suite.testAccount.ID.String()})
+
+	assert.ErrorIs(suite.T(), err, errs.ErrOpeningBalanceUnknown)
+}
@@ -0,0 +1,88 @@
+// internal/application/bonus_credit.go
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/event"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/infra"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/repository"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+)
+
+// bonusCredits pays rewards such as cashback and referral bonuses into accounts as CREDIT
+// transactions. A credit is saved as PENDING before the reward is claimed, so a reward claimed
+// elsewhere first has its credit cancelled rather than paid twice.
+type bonusCredits struct {
+	accountRepo     repository.AccountRepository
+	transactionRepo repository.TransactionRepository
+	cache           infra.CacheService
+	events          infra.EventPublisher
+	valueDating     *ValueDatingPolicy
+	logger          infra.Logger
+}
+
+// create saves a pending credit of the amount into the account
+func (b *bonusCredits) create(ctx context.Context, accountID vo.AccountID, amount vo.Money, description, reference string) (*entity.Transaction, error) {
+	credit, err := entity.NewCreditTransaction(accountID, amount, description, reference, b.valueDating.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := b.transactionRepo.Create(ctx, credit); err != nil {
+		return nil, err
+	}
+
+	return credit, nil
+}
+
+// cancel cancels a pending credit whose reward was claimed elsewhere
+func (b *bonusCredits) cancel(ctx context.Context, credit *entity.Transaction) error {
+	if err := credit.MarkAsCancelled(); err != nil {
+		return err
+	}
+	return b.transactionRepo.Update(ctx, credit)
+}
+
+// settle credits the account and completes the credit. An account that cannot receive money keeps
+// the pending credit, to be confirmed once it can.
+func (b *bonusCredits) settle(ctx context.Context, credit *entity.Transaction) error {
+	accountID := *credit.ToAccountID
+
+	account, err := b.accountRepo.GetByID(ctx, accountID)
+	if err != nil {
+		return err
+	}
+	if !account.CanTransact() {
+		b.logger.Warn("Account cannot receive the credit, leaving it pending",
+			"accountID", accountID.String(),
+			"transactionID", credit.ID.String())
+		return nil
+	}
+
+	if err := account.Credit(credit.Amount); err != nil {
+		return err
+	}
+	if err := b.accountRepo.Update(ctx, account); err != nil {
+		return err
+	}
+
+	if err := credit.MarkAsCompleted(b.valueDating.Now()); err != nil {
+		return err
+	}
+	if err := b.transactionRepo.Update(ctx, credit); err != nil {
+		return err
+	}
+
+	cacheKey := fmt.Sprintf("account:%s", accountID.String())
+	if err := b.cache.Delete(ctx, cacheKey); err != nil {
+		b.logger.Warn("Failed to invalidate account cache", "error", err, "accountID", accountID.String())
+	}
+
+	if err := b.events.Publish(ctx, event.NewTransactionEvent(event.TransactionCompleted, credit)); err != nil {
+		b.logger.Warn("Failed to publish credit", "error", err, "transactionID", credit.ID.String())
+	}
+	return nil
+}
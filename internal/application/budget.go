@@ -0,0 +1,326 @@
+// internal/application/budget.go
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/application/dto"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/event"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/infra"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/repository"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+)
+
+// periodSpend returns the budget period containing at and the account's completed spend per category in it
+func periodSpend(
+	ctx context.Context,
+	transactionRepo repository.TransactionRepository,
+	categorizer *Categorizer,
+	accountID vo.AccountID,
+	at time.Time,
+) (string, map[string]*categoryTotals, error) {
+	period, start := entity.BudgetPeriod(at)
+
+	transactions, err := transactionRepo.GetCompletedByAccountIDSince(ctx, accountID, start)
+	if err != nil {
+		return "", nil, err
+	}
+
+	// Later periods do not count towards this one
+	end := start.AddDate(0, 1, 0)
+	inPeriod := make([]*entity.Transaction, 0, len(transactions))
+	for _, transaction := range transactions {
+		if transaction.CompletedAt != nil && transaction.CompletedAt.Before(end) {
+			inPeriod = append(inPeriod, transaction)
+		}
+	}
+
+	byCategory, err := categorizer.totals(ctx, accountID, inPeriod)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return period, byCategory, nil
+}
+
+// spentIn returns the outflow of a category, zero when there was none
+func spentIn(byCategory map[string]*categoryTotals, categoryCode string) vo.Money {
+	if total, ok := byCategory[categoryCode]; ok {
+		return total.outflow
+	}
+	return vo.ZeroMoney()
+}
+
+// BudgetTracker checks an account's budgets whenever one of its payments completes and
+// publishes a budget.threshold_reached event the first time a threshold is reached in a month.
+// It wraps the event publisher so tracking runs once, on the instance that completed the transaction.
+type BudgetTracker struct {
+	budgetRepo      repository.BudgetRepository
+	transactionRepo repository.TransactionRepository
+	categorizer     *Categorizer
+	next            infra.EventPublisher
+	logger          infra.Logger
+}
+
+// NewBudgetTracker creates a budget tracker publishing through next
+func NewBudgetTracker(
+	budgetRepo repository.BudgetRepository,
+	transactionRepo repository.TransactionRepository,
+	categorizer *Categorizer,
+	next infra.EventPublisher,
+	logger infra.Logger,
+) *BudgetTracker {
+	return &BudgetTracker{
+		budgetRepo:      budgetRepo,
+		transactionRepo: transactionRepo,
+		categorizer:     categorizer,
+		next:            next,
+		logger:          logger,
+	}
+}
+
+// Publish forwards the event and tracks budgets of completed payments
+func (t *BudgetTracker) Publish(ctx context.Context, evt event.Event) error {
+	err := t.next.Publish(ctx, evt)
+
+	if evt.Type == event.TransactionCompleted {
+		t.track(ctx, evt)
+	}
+
+	return err
+}
+
+// track alerts on the budgets of the paying account that reached a new threshold
+func (t *BudgetTracker) track(ctx context.Context, evt event.Event) {
+	payload, err := evt.DecodeTransaction()
+	if err != nil {
+		t.logger.Warn("Failed to decode transaction event", "error", err, "eventID", evt.ID)
+		return
+	}
+
+	// Only money leaving an account counts as spend
+	if payload.FromAccountID == nil {
+		return
+	}
+
+	accountID, err := vo.NewAccountIDFromString(*payload.FromAccountID)
+	if err != nil {
+		return
+	}
+
+	budgets, err := t.budgetRepo.ListByAccountID(ctx, accountID)
+	if err != nil {
+		t.logger.Warn("Failed to load budgets", "error", err, "accountID", accountID.String())
+		return
+	}
+	if len(budgets) == 0 {
+		return
+	}
+
+	completedAt := evt.OccurredAt
+	if payload.CompletedAt != nil {
+		completedAt = *payload.CompletedAt
+	}
+
+	period, byCategory, err := periodSpend(ctx, t.transactionRepo, t.categorizer, accountID, completedAt)
+	if err != nil {
+		t.logger.Warn("Failed to compute budget spend", "error", err, "accountID", accountID.String())
+		return
+	}
+
+	for _, budget := range budgets {
+		spent := spentIn(byCategory, budget.CategoryCode)
+
+		threshold := budget.ReachedThreshold(spent)
+		if threshold == 0 || budget.HasAlerted(period, threshold) {
+			continue
+		}
+
+		recorded, err := t.budgetRepo.RecordAlert(ctx, budget.ID, period, threshold)
+		if err != nil {
+			t.logger.Warn("Failed to record budget alert", "error", err, "budgetID", budget.ID)
+			continue
+		}
+		if !recorded {
+			continue
+		}
+
+		t.logger.Info("Budget threshold reached",
+			"budgetID", budget.ID,
+			"accountID", accountID.String(),
+			"categoryCode", budget.CategoryCode,
+			"threshold", threshold)
+
+		if err := t.next.Publish(ctx, event.NewBudgetAlertEvent(budget, period, threshold, spent)); err != nil {
+			t.logger.Warn("Failed to publish budget alert", "error", err, "budgetID", budget.ID)
+		}
+	}
+}
+
+// Ensure BudgetTracker can stand in for the event publisher it wraps
+var _ infra.EventPublisher = (*BudgetTracker)(nil)
+
+type budgetUseCase struct {
+	budgetRepo      repository.BudgetRepository
+	accountRepo     repository.AccountRepository
+	categoryRepo    repository.CategoryRepository
+	transactionRepo repository.TransactionRepository
+	categorizer     *Categorizer
+	valueDating     *ValueDatingPolicy
+	logger          infra.Logger
+	mapper          *dto.BudgetMapper
+}
+
+// NewBudgetUseCase creates a new budget use case
+func NewBudgetUseCase(
+	budgetRepo repository.BudgetRepository,
+	accountRepo repository.AccountRepository,
+	categoryRepo repository.CategoryRepository,
+	transactionRepo repository.TransactionRepository,
+	categorizer *Categorizer,
+	valueDating *ValueDatingPolicy,
+	logger infra.Logger,
+) BudgetUseCase {
+	return &budgetUseCase{
+		budgetRepo:      budgetRepo,
+		accountRepo:     accountRepo,
+		categoryRepo:    categoryRepo,
+		transactionRepo: transactionRepo,
+		categorizer:     categorizer,
+		valueDating:     valueDating,
+		logger:          logger,
+		mapper:          &dto.BudgetMapper{},
+	}
+}
+
+// CreateBudget sets a monthly budget for a category of an account
+func (uc *budgetUseCase) CreateBudget(ctx context.Context, req dto.CreateBudgetRequest) (*dto.BudgetResponse, error) {
+	accountID, err := vo.NewAccountIDFromString(req.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := uc.accountRepo.GetByID(ctx, accountID); err != nil {
+		return nil, err
+	}
+
+	budget, err := entity.NewBudget(accountID, req.CategoryCode, req.Amount.Money())
+	if err != nil {
+		return nil, err
+	}
+
+	if budget.CategoryCode != entity.CategoryUncategorized {
+		if _, err := uc.categoryRepo.GetByCode(ctx, budget.CategoryCode); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := uc.budgetRepo.Create(ctx, budget); err != nil {
+		uc.logger.Error("Failed to create budget", "error", err, "accountID", req.AccountID, "categoryCode", budget.CategoryCode)
+		return nil, err
+	}
+
+	uc.logger.Info("Budget created", "budgetID", budget.ID, "accountID", req.AccountID, "categoryCode", budget.CategoryCode)
+	return uc.toResponse(ctx, budget)
+}
+
+// GetBudget retrieves a budget of an account with its current month spend
+func (uc *budgetUseCase) GetBudget(ctx context.Context, accountID, id string) (*dto.BudgetResponse, error) {
+	budget, err := uc.accountBudget(ctx, accountID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.toResponse(ctx, budget)
+}
+
+// ListBudgets retrieves the budgets of an account with their current month spend
+func (uc *budgetUseCase) ListBudgets(ctx context.Context, accountID string) (*dto.BudgetListResponse, error) {
+	parsedAccountID, err := vo.NewAccountIDFromString(accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	budgets, err := uc.budgetRepo.ListByAccountID(ctx, parsedAccountID)
+	if err != nil {
+		uc.logger.Error("Failed to list budgets", "error", err, "accountID", accountID)
+		return nil, err
+	}
+
+	period, byCategory, err := periodSpend(ctx, uc.transactionRepo, uc.categorizer, parsedAccountID, uc.valueDating.Now())
+	if err != nil {
+		uc.logger.Error("Failed to compute budget spend", "error", err, "accountID", accountID)
+		return nil, err
+	}
+
+	responses := make([]dto.BudgetResponse, len(budgets))
+	for i, budget := range budgets {
+		responses[i] = uc.mapper.ToResponse(budget, period, spentIn(byCategory, budget.CategoryCode))
+	}
+
+	return &dto.BudgetListResponse{Budgets: responses}, nil
+}
+
+// UpdateBudget changes a budget's monthly amount
+func (uc *budgetUseCase) UpdateBudget(ctx context.Context, req dto.UpdateBudgetRequest) (*dto.BudgetResponse, error) {
+	budget, err := uc.accountBudget(ctx, req.AccountID, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := budget.UpdateAmount(req.Amount.Money()); err != nil {
+		return nil, err
+	}
+
+	if err := uc.budgetRepo.Update(ctx, budget); err != nil {
+		uc.logger.Error("Failed to update budget", "error", err, "budgetID", req.ID)
+		return nil, err
+	}
+
+	uc.logger.Info("Budget updated", "budgetID", req.ID, "amount", req.Amount)
+	return uc.toResponse(ctx, budget)
+}
+
+// DeleteBudget removes a budget of an account
+func (uc *budgetUseCase) DeleteBudget(ctx context.Context, accountID, id string) error {
+	if _, err := uc.accountBudget(ctx, accountID, id); err != nil {
+		return err
+	}
+
+	if err := uc.budgetRepo.Delete(ctx, id); err != nil {
+		uc.logger.Error("Failed to delete budget", "error", err, "budgetID", id)
+		return err
+	}
+
+	uc.logger.Info("Budget deleted", "budgetID", id, "accountID", accountID)
+	return nil
+}
+
+// accountBudget retrieves a budget, hiding budgets of other accounts
+func (uc *budgetUseCase) accountBudget(ctx context.Context, accountID, id string) (*entity.Budget, error) {
+	budget, err := uc.budgetRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if budget.AccountID.String() != accountID {
+		return nil, errs.ErrBudgetNotFound
+	}
+
+	return budget, nil
+}
+
+// toResponse maps a budget with its spend in the current month
+func (uc *budgetUseCase) toResponse(ctx context.Context, budget *entity.Budget) (*dto.BudgetResponse, error) {
+	period, byCategory, err := periodSpend(ctx, uc.transactionRepo, uc.categorizer, budget.AccountID, uc.valueDating.Now())
+	if err != nil {
+		uc.logger.Error("Failed to compute budget spend", "error", err, "budgetID", budget.ID)
+		return nil, err
+	}
+
+	response := uc.mapper.ToResponse(budget, period, spentIn(byCategory, budget.CategoryCode))
+	return &response, nil
+}
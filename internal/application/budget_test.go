@@ -0,0 +1,175 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/event"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type MockBudgetRepository struct {
+	mock.Mock
+}
+
+func (m *MockBudgetRepository) Create(ctx context.Context, budget *entity.Budget) error {
+	args := m.Called(ctx, budget)
+	return args.Error(0)
+}
+
+func (m *MockBudgetRepository) GetByID(ctx context.Context, id string) (*entity.Budget, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.Budget), args.Error(1)
+}
+
+func (m *MockBudgetRepository) Update(ctx context.Context, budget *entity.Budget) error {
+	args := m.Called(ctx, budget)
+	return args.Error(0)
+}
+
+func (m *MockBudgetRepository) Delete(ctx context.Context, id string) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockBudgetRepository) ListByAccountID(ctx context.Context, accountID vo.AccountID) ([]*entity.Budget, error) {
+	args := m.Called(ctx, accountID)
+	return args.Get(0).([]*entity.Budget), args.Error(1)
+}
+
+func (m *MockBudgetRepository) RecordAlert(ctx context.Context, id string, period string, threshold int) (bool, error) {
+	args := m.Called(ctx, id, period, threshold)
+	return args.Bool(0), args.Error(1)
+}
+
+func TestBudgetTracker_Publish(t *testing.T) {
+	account := createTestAccount()
+
+	spend := func(amount float64) *entity.Transaction {
+		transaction, err := entity.NewDebitTransaction(account.ID, vo.NewMoneyFromFloat(amount), "Supermarket", "", time.Now())
+		transaction.Categorize("GROCERIES")
+		return completedTransaction(t, transaction, err)
+	}
+
+	tests := []struct {
+		name              string
+		alerted           int // Threshold already alerted this period
+		recorded          bool
+		spent             []float64
+		expectedThreshold int
+	}{
+		{name: "below_threshold", spent: []float64{100, 200}},
+		{name: "reaches_80_percent", spent: []float64{300, 150}, recorded: true, expectedThreshold: 80},
+		{name: "jumps_to_100_percent", spent: []float64{600}, recorded: true, expectedThreshold: 100},
+		{name: "already_alerted", alerted: 80, spent: []float64{450}},
+		{name: "alerted_by_another_completion", spent: []float64{450}, recorded: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			budget, err := entity.NewBudget(account.ID, "GROCERIES", vo.NewMoneyFromFloat(500))
+			require.NoError(t, err)
+
+			transactions := make([]*entity.Transaction, len(tt.spent))
+			for i, amount := range tt.spent {
+				transactions[i] = spend(amount)
+			}
+			completed := transactions[len(transactions)-1]
+			period, _ := entity.BudgetPeriod(*completed.CompletedAt)
+			if tt.alerted > 0 {
+				budget.AlertedPeriod = period
+				budget.AlertedThreshold = tt.alerted
+			}
+
+			mockBudgetRepo := new(MockBudgetRepository)
+			mockTxnRepo := new(MockTransactionRepository)
+			mockOverrideRepo := new(MockCategoryOverrideRepository)
+			mockLogger := new(MockLogger)
+			mockLogger.On("Info", mock.Anything, mock.Anything).Maybe()
+			mockBudgetRepo.On("ListByAccountID", mock.Anything, account.ID).Return([]*entity.Budget{budget}, nil)
+			mockTxnRepo.On("GetCompletedByAccountIDSince", mock.Anything, account.ID, mock.Anything).Return(transactions, nil)
+			mockOverrideRepo.On("ListByTransactionIDs", mock.Anything, account.ID, mock.Anything).Return([]*entity.CategoryOverride{}, nil)
+			mockBudgetRepo.On("RecordAlert", mock.Anything, budget.ID, period, mock.Anything).Return(tt.recorded, nil)
+
+			next := &StubEventPublisher{}
+			tracker := NewBudgetTracker(mockBudgetRepo, mockTxnRepo, NewCategorizer(nil, mockOverrideRepo, mockLogger), next, mockLogger)
+
+			err = tracker.Publish(context.Background(), event.NewTransactionEvent(event.TransactionCompleted, completed))
+			require.NoError(t, err)
+
+			if tt.expectedThreshold == 0 {
+				assert.Len(t, next.Events, 1)
+				return
+			}
+
+			require.Len(t, next.Events, 2)
+			assert.Equal(t, event.TransactionCompleted, next.Events[0].Type)
+			assert.Equal(t, event.BudgetThresholdReached, next.Events[1].Type)
+
+			payload, err := next.Events[1].DecodeBudget()
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedThreshold, payload.Threshold)
+			assert.Equal(t, "GROCERIES", payload.CategoryCode)
+			assert.Equal(t, period, payload.Period)
+		})
+	}
+}
+
+func TestBudgetTracker_IgnoresIncomingPayments(t *testing.T) {
+	account := createTestAccount()
+	credit, err := entity.NewCreditTransaction(account.ID, vo.NewMoneyFromFloat(1000), "Salary", "", time.Now())
+	credit = completedTransaction(t, credit, err)
+
+	next := &StubEventPublisher{}
+	tracker := NewBudgetTracker(new(MockBudgetRepository), new(MockTransactionRepository), nil, next, new(MockLogger))
+
+	require.NoError(t, tracker.Publish(context.Background(), event.NewTransactionEvent(event.TransactionCompleted, credit)))
+	assert.Len(t, next.Events, 1)
+}
+
+func TestBudgetUseCase_GetBudget_OtherAccount(t *testing.T) {
+	budget, err := entity.NewBudget(vo.NewAccountID(), "GROCERIES", vo.NewMoneyFromFloat(500))
+	require.NoError(t, err)
+
+	mockBudgetRepo := new(MockBudgetRepository)
+	mockBudgetRepo.On("GetByID", mock.Anything, budget.ID).Return(budget, nil)
+
+	uc := NewBudgetUseCase(mockBudgetRepo, nil, nil, nil, nil, nil, new(MockLogger))
+	_, err = uc.GetBudget(context.Background(), vo.NewAccountID().String(), budget.ID)
+
+	assert.ErrorIs(t, err, errs.ErrBudgetNotFound)
+}
+
+func TestBudgetUseCase_ListBudgets_ClockPeriod(t *testing.T) {
+	account := createTestAccount()
+	budget, err := entity.NewBudget(account.ID, "GROCERIES", vo.NewMoneyFromFloat(500))
+	require.NoError(t, err)
+	clock := &StubClock{At: time.Date(2024, 5, 17, 15, 0, 0, 0, time.UTC)}
+
+	mockBudgetRepo := new(MockBudgetRepository)
+	mockTxnRepo := new(MockTransactionRepository)
+	mockOverrideRepo := new(MockCategoryOverrideRepository)
+	mockLogger := new(MockLogger)
+	mockBudgetRepo.On("ListByAccountID", mock.Anything, account.ID).Return([]*entity.Budget{budget}, nil)
+	mockTxnRepo.On("GetCompletedByAccountIDSince", mock.Anything, account.ID, time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)).
+		Return([]*entity.Transaction{}, nil)
+	mockOverrideRepo.On("ListByTransactionIDs", mock.Anything, account.ID, mock.Anything).Return([]*entity.CategoryOverride{}, nil)
+
+	valueDating := NewValueDatingPolicy(&StubCalendar{}, vo.ProcessingWindow{}, clock)
+	uc := NewBudgetUseCase(mockBudgetRepo, nil, nil, mockTxnRepo, NewCategorizer(nil, mockOverrideRepo, mockLogger), valueDating, mockLogger)
+	result, err := uc.ListBudgets(context.Background(), account.ID.String())
+
+	require.NoError(t, err)
+	require.Len(t, result.Budgets, 1)
+	// The month of the test clock, not of the system clock
+	assert.Equal(t, "2024-05", result.Budgets[0].Period)
+}
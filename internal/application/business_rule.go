@@ -0,0 +1,373 @@
+// internal/application/business_rule.go
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/hydr0g3nz/mini_bank/internal/application/dto"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/infra"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/repository"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+)
+
+// TransactionRuleFacts are the transaction fields business rule expressions can refer to.
+// Account IDs are empty when the transaction has no such side.
+var TransactionRuleFacts = map[string]infra.RuleFactType{
+	"transaction_type": infra.RuleFactString,
+	"channel":          infra.RuleFactString,
+	"amount":           infra.RuleFactNumber,
+	"currency":         infra.RuleFactString,
+	"description":      infra.RuleFactString,
+	"reference":        infra.RuleFactString,
+	"merchant":         infra.RuleFactString,
+	"category":         infra.RuleFactString,
+	"from_account_id":  infra.RuleFactString,
+	"to_account_id":    infra.RuleFactString,
+}
+
+// transactionFacts returns the facts of a transaction that business rules are evaluated against;
+// currency stands in for the currency of a transaction whose accounts were not resolved
+func transactionFacts(transaction *entity.Transaction, currency string) map[string]interface{} {
+	if !transaction.Currency().IsZero() {
+		currency = transaction.Currency().String()
+	}
+
+	facts := map[string]interface{}{
+		"transaction_type": string(transaction.TransactionType),
+		"channel":          string(transaction.Channel),
+		"amount":           transaction.Amount.InexactFloat64(),
+		"currency":         currency,
+		"description":      transaction.Description,
+		"reference":        transaction.Reference,
+		"merchant":         transaction.Merchant,
+		"category":         transaction.Category,
+		"from_account_id":  "",
+		"to_account_id":    "",
+	}
+	if transaction.FromAccountID != nil {
+		facts["from_account_id"] = transaction.FromAccountID.String()
+	}
+	if transaction.ToAccountID != nil {
+		facts["to_account_id"] = transaction.ToAccountID.String()
+	}
+	return facts
+}
+
+// BusinessRules evaluates the admin-defined rules on new transactions: validation rules may
+// reject a transaction and fee rules add up to the fee charged to its source account
+type BusinessRules struct {
+	ruleRepo repository.BusinessRuleRepository
+	engine   infra.RuleEngine
+	currency string
+	logger   infra.Logger
+}
+
+// NewBusinessRules creates a business rule evaluator
+func NewBusinessRules(ruleRepo repository.BusinessRuleRepository, engine infra.RuleEngine, currency string, logger infra.Logger) *BusinessRules {
+	return &BusinessRules{
+		ruleRepo: ruleRepo,
+		engine:   engine,
+		currency: currency,
+		logger:   logger,
+	}
+}
+
+// Apply evaluates the enabled rules in priority order, returning the violation of the first
+// validation rule that rejects the transaction, and sets the fee of the fee rules. Rules
+// guard payments, so a rule that cannot be evaluated rejects the transaction too.
+func (r *BusinessRules) Apply(ctx context.Context, transaction *entity.Transaction) error {
+	if r == nil {
+		return nil
+	}
+
+	rules, err := r.ruleRepo.ListEnabled(ctx)
+	if err != nil {
+		r.logger.Error("Failed to load business rules", "error", err, "transactionID", transaction.ID.String())
+		return err
+	}
+
+	facts := transactionFacts(transaction, r.currency)
+	fee := vo.ZeroMoney()
+	for _, rule := range rules {
+		switch rule.Kind {
+		case entity.BusinessRuleKindValidation:
+			rejected, err := r.engine.EvaluateBool(ctx, rule.Expression, facts)
+			if err != nil {
+				return r.failed(rule, transaction, err)
+			}
+			if rejected {
+				r.logger.Info("Transaction rejected by business rule", "ruleID", rule.ID, "rule", rule.Name, "transactionID", transaction.ID.String())
+				return rule.Violation()
+			}
+
+		case entity.BusinessRuleKindFee:
+			// Fees are charged to the source account; deposits carry none
+			if transaction.FromAccountID == nil {
+				continue
+			}
+			amount, err := evaluateFee(ctx, r.engine, rule.Expression, facts)
+			if err != nil {
+				return r.failed(rule, transaction, err)
+			}
+			fee, _ = fee.Add(amount)
+		}
+	}
+
+	return transaction.SetFee(fee)
+}
+
+// DryRunFees evaluates the enabled fee rules on transactions without changing them and returns the
+// fee each would be charged now. Validation rules are left out: a dry run projects charges, not
+// rejections.
+func (r *BusinessRules) DryRunFees(ctx context.Context, transactions []*entity.Transaction) ([]vo.Money, error) {
+	fees := make([]vo.Money, len(transactions))
+	for i := range fees {
+		fees[i] = vo.ZeroMoney()
+	}
+	if r == nil || len(transactions) == 0 {
+		return fees, nil
+	}
+
+	rules, err := r.ruleRepo.ListEnabled(ctx)
+	if err != nil {
+		r.logger.Error("Failed to load business rules", "error", err)
+		return nil, err
+	}
+
+	for i, transaction := range transactions {
+		// Fees are charged to the source account; deposits carry none
+		if transaction.FromAccountID == nil {
+			continue
+		}
+		facts := transactionFacts(transaction, r.currency)
+		for _, rule := range rules {
+			if rule.Kind != entity.BusinessRuleKindFee {
+				continue
+			}
+			amount, err := evaluateFee(ctx, r.engine, rule.Expression, facts)
+			if err != nil {
+				return nil, r.failed(rule, transaction, err)
+			}
+			fees[i], _ = fees[i].Add(amount)
+		}
+	}
+	return fees, nil
+}
+
+// failed logs a rule that could not be evaluated and returns the error rejecting the transaction
+func (r *BusinessRules) failed(rule *entity.BusinessRule, transaction *entity.Transaction, err error) error {
+	r.logger.Error("Business rule could not be evaluated", "error", err, "ruleID", rule.ID, "rule", rule.Name, "transactionID", transaction.ID.String())
+	return fmt.Errorf("%w: %s: %v", errs.ErrBusinessRuleFailed, rule.Name, err)
+}
+
+// evaluateFee evaluates a fee expression, rounded to cents; fees cannot be negative
+func evaluateFee(ctx context.Context, engine infra.RuleEngine, expression string, facts map[string]interface{}) (vo.Money, error) {
+	amount, err := engine.EvaluateNumber(ctx, expression, facts)
+	if err != nil {
+		return vo.Money{}, err
+	}
+	if math.IsNaN(amount) || math.IsInf(amount, 0) || amount < 0 {
+		return vo.Money{}, fmt.Errorf("fee must be a non-negative number, got %v", amount)
+	}
+	return vo.NewMoneyFromFloat(amount).Round(2), nil
+}
+
+type businessRuleUseCase struct {
+	ruleRepo repository.BusinessRuleRepository
+	engine   infra.RuleEngine
+	currency string
+	logger   infra.Logger
+	mapper   *dto.BusinessRuleMapper
+}
+
+// NewBusinessRuleUseCase creates a new business rule use case
+func NewBusinessRuleUseCase(
+	ruleRepo repository.BusinessRuleRepository,
+	engine infra.RuleEngine,
+	currency string,
+	logger infra.Logger,
+) BusinessRuleUseCase {
+	return &businessRuleUseCase{
+		ruleRepo: ruleRepo,
+		engine:   engine,
+		currency: currency,
+		logger:   logger,
+		mapper:   &dto.BusinessRuleMapper{},
+	}
+}
+
+// CreateRule adds a business rule once its expression compiles to the kind's result type
+func (uc *businessRuleUseCase) CreateRule(ctx context.Context, req dto.CreateBusinessRuleRequest) (*dto.BusinessRuleResponse, error) {
+	rule, err := entity.NewBusinessRule(req.Name, entity.BusinessRuleKind(req.Kind), req.Expression, req.Message, req.Priority)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.check(rule.Kind, rule.Expression); err != nil {
+		return nil, err
+	}
+
+	if err := uc.ruleRepo.Create(ctx, rule); err != nil {
+		uc.logger.Error("Failed to create business rule", "error", err, "rule", rule.Name)
+		return nil, err
+	}
+
+	uc.logger.Info("Business rule created", "ruleID", rule.ID, "rule", rule.Name, "kind", rule.Kind)
+	response := uc.mapper.ToResponse(rule)
+	return &response, nil
+}
+
+// UpdateRule changes a business rule's expression, message or priority, or switches it on or off
+func (uc *businessRuleUseCase) UpdateRule(ctx context.Context, req dto.UpdateBusinessRuleRequest) (*dto.BusinessRuleResponse, error) {
+	rule, err := uc.ruleRepo.GetByID(ctx, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := rule.Update(req.Name, req.Expression, req.Message, req.Priority); err != nil {
+		return nil, err
+	}
+	if req.Enabled != nil {
+		rule.SetEnabled(*req.Enabled)
+	}
+
+	if err := uc.check(rule.Kind, rule.Expression); err != nil {
+		return nil, err
+	}
+
+	if err := uc.ruleRepo.Update(ctx, rule); err != nil {
+		uc.logger.Error("Failed to update business rule", "error", err, "ruleID", rule.ID)
+		return nil, err
+	}
+
+	uc.logger.Info("Business rule updated", "ruleID", rule.ID, "enabled", rule.Enabled)
+	response := uc.mapper.ToResponse(rule)
+	return &response, nil
+}
+
+// DeleteRule removes a business rule
+func (uc *businessRuleUseCase) DeleteRule(ctx context.Context, id string) error {
+	if err := uc.ruleRepo.Delete(ctx, id); err != nil {
+		uc.logger.Error("Failed to delete business rule", "error", err, "ruleID", id)
+		return err
+	}
+
+	uc.logger.Info("Business rule deleted", "ruleID", id)
+	return nil
+}
+
+// ListRules retrieves the business rules in evaluation order
+func (uc *businessRuleUseCase) ListRules(ctx context.Context) (*dto.BusinessRuleListResponse, error) {
+	rules, err := uc.ruleRepo.List(ctx)
+	if err != nil {
+		uc.logger.Error("Failed to list business rules", "error", err)
+		return nil, err
+	}
+
+	responses := make([]dto.BusinessRuleResponse, len(rules))
+	for i, rule := range rules {
+		responses[i] = uc.mapper.ToResponse(rule)
+	}
+
+	return &dto.BusinessRuleListResponse{Rules: responses}, nil
+}
+
+// TestRule evaluates an expression against a sample transaction without saving anything.
+// Expressions that do not compile are rejected; evaluation errors are reported in the response.
+func (uc *businessRuleUseCase) TestRule(ctx context.Context, req dto.TestBusinessRuleRequest) (*dto.TestBusinessRuleResponse, error) {
+	kind := entity.BusinessRuleKind(req.Kind)
+	if err := uc.check(kind, req.Expression); err != nil {
+		return nil, err
+	}
+
+	transaction, err := sampleTransaction(req.Transaction)
+	if err != nil {
+		return nil, err
+	}
+
+	facts := transactionFacts(transaction, uc.currency)
+	response := &dto.TestBusinessRuleResponse{Kind: req.Kind}
+
+	switch kind {
+	case entity.BusinessRuleKindValidation:
+		rejected, err := uc.engine.EvaluateBool(ctx, req.Expression, facts)
+		if err != nil {
+			response.Error = err.Error()
+			break
+		}
+		response.Rejected = &rejected
+
+	case entity.BusinessRuleKindFee:
+		fee, err := evaluateFee(ctx, uc.engine, req.Expression, facts)
+		if err != nil {
+			response.Error = err.Error()
+			break
+		}
+		amount := fee.InexactFloat64()
+		response.Fee = &amount
+	}
+
+	return response, nil
+}
+
+// check verifies an expression compiles to the result type of the rule kind
+func (uc *businessRuleUseCase) check(kind entity.BusinessRuleKind, expression string) error {
+	var err error
+	switch kind {
+	case entity.BusinessRuleKindValidation:
+		err = uc.engine.CheckBool(expression)
+	case entity.BusinessRuleKindFee:
+		err = uc.engine.CheckNumber(expression)
+	default:
+		return errs.ValidationError{Field: "kind", Message: "invalid rule kind: " + string(kind)}
+	}
+
+	if err != nil {
+		return errs.ValidationError{Field: "expression", Message: err.Error()}
+	}
+	return nil
+}
+
+// sampleTransaction builds the transaction described by a rule test; account IDs are taken as given
+func sampleTransaction(sample dto.RuleSampleTransaction) (*entity.Transaction, error) {
+	channel, err := parseChannel(sample.Channel)
+	if err != nil {
+		return nil, err
+	}
+
+	category := entity.CategoryUncategorized
+	if sample.Category != "" {
+		category = entity.NormalizeCategoryCode(sample.Category)
+	}
+
+	transaction := &entity.Transaction{
+		TransactionType: vo.TransactionType(sample.TransactionType),
+		Channel:         channel,
+		Amount:          sample.Amount.Money(),
+		Description:     sample.Description,
+		Reference:       sample.Reference,
+		Merchant:        sample.Merchant,
+		Category:        category,
+	}
+
+	if sample.FromAccountID != "" {
+		fromAccountID, err := vo.NewAccountIDFromString(sample.FromAccountID)
+		if err != nil {
+			return nil, err
+		}
+		transaction.FromAccountID = &fromAccountID
+	}
+	if sample.ToAccountID != "" {
+		toAccountID, err := vo.NewAccountIDFromString(sample.ToAccountID)
+		if err != nil {
+			return nil, err
+		}
+		transaction.ToAccountID = &toAccountID
+	}
+
+	return transaction, nil
+}
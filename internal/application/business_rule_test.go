@@ -0,0 +1,272 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/application/dto"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type MockBusinessRuleRepository struct {
+	mock.Mock
+}
+
+func (m *MockBusinessRuleRepository) Create(ctx context.Context, rule *entity.BusinessRule) error {
+	args := m.Called(ctx, rule)
+	return args.Error(0)
+}
+
+func (m *MockBusinessRuleRepository) GetByID(ctx context.Context, id string) (*entity.BusinessRule, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.BusinessRule), args.Error(1)
+}
+
+func (m *MockBusinessRuleRepository) Update(ctx context.Context, rule *entity.BusinessRule) error {
+	args := m.Called(ctx, rule)
+	return args.Error(0)
+}
+
+func (m *MockBusinessRuleRepository) Delete(ctx context.Context, id string) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockBusinessRuleRepository) List(ctx context.Context) ([]*entity.BusinessRule, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.BusinessRule), args.Error(1)
+}
+
+func (m *MockBusinessRuleRepository) ListEnabled(ctx context.Context) ([]*entity.BusinessRule, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.BusinessRule), args.Error(1)
+}
+
+// StubRuleEngine evaluates expressions to preset results; expressions without one fail to compile
+type StubRuleEngine struct {
+	Results map[string]interface{} // A bool, a float64 or an error
+	Facts   map[string]interface{} // The facts of the last evaluation
+}
+
+func (s *StubRuleEngine) CheckBool(expression string) error {
+	if _, ok := s.Results[expression]; !ok {
+		return errors.New("invalid expression: undeclared reference")
+	}
+	return nil
+}
+
+func (s *StubRuleEngine) CheckNumber(expression string) error {
+	return s.CheckBool(expression)
+}
+
+func (s *StubRuleEngine) EvaluateBool(ctx context.Context, expression string, facts map[string]interface{}) (bool, error) {
+	s.Facts = facts
+	switch result := s.Results[expression].(type) {
+	case error:
+		return false, result
+	default:
+		return result.(bool), nil
+	}
+}
+
+func (s *StubRuleEngine) EvaluateNumber(ctx context.Context, expression string, facts map[string]interface{}) (float64, error) {
+	s.Facts = facts
+	switch result := s.Results[expression].(type) {
+	case error:
+		return 0, result
+	default:
+		return result.(float64), nil
+	}
+}
+
+func TestBusinessRules_Apply(t *testing.T) {
+	engine := &StubRuleEngine{Results: map[string]interface{}{
+		"amount > 50000":       true,
+		"channel == 'ATM'":     false,
+		"amount * 0.001":       0.105,
+		"2.0":                  2.0,
+		"-1.0":                 -1.0,
+		"int(description) > 0": errors.New("evaluation failed: no such overload"),
+	}}
+
+	newRule := func(name string, kind entity.BusinessRuleKind, expression string) *entity.BusinessRule {
+		rule, err := entity.NewBusinessRule(name, kind, expression, "", 0)
+		require.NoError(t, err)
+		return rule
+	}
+
+	tests := []struct {
+		name        string
+		credit      bool
+		rules       []*entity.BusinessRule
+		rulesErr    error
+		expectedFee float64
+		expectedErr error
+	}{
+		{
+			name:  "no_rules",
+			rules: []*entity.BusinessRule{},
+		},
+		{
+			name: "passing_validations_and_fees",
+			rules: []*entity.BusinessRule{
+				newRule("atm", entity.BusinessRuleKindValidation, "channel == 'ATM'"),
+				newRule("per-mille", entity.BusinessRuleKindFee, "amount * 0.001"),
+				newRule("flat", entity.BusinessRuleKindFee, "2.0"),
+			},
+			expectedFee: 2.11,
+		},
+		{
+			name:        "fees_skip_credits",
+			credit:      true,
+			rules:       []*entity.BusinessRule{newRule("flat", entity.BusinessRuleKindFee, "2.0")},
+			expectedFee: 0,
+		},
+		{
+			name: "rejected_by_validation",
+			rules: []*entity.BusinessRule{
+				newRule("flat", entity.BusinessRuleKindFee, "2.0"),
+				newRule("large", entity.BusinessRuleKindValidation, "amount > 50000"),
+			},
+			expectedErr: errs.BusinessError{Code: "BUSINESS_RULE_VIOLATION", Message: "transaction rejected by rule large"},
+		},
+		{
+			name:        "evaluation_error_rejects",
+			rules:       []*entity.BusinessRule{newRule("broken", entity.BusinessRuleKindValidation, "int(description) > 0")},
+			expectedErr: errs.ErrBusinessRuleFailed,
+		},
+		{
+			name:        "negative_fee_rejects",
+			rules:       []*entity.BusinessRule{newRule("refund", entity.BusinessRuleKindFee, "-1.0")},
+			expectedErr: errs.ErrBusinessRuleFailed,
+		},
+		{
+			name:        "rules_unavailable",
+			rulesErr:    errors.New("database unavailable"),
+			expectedErr: errors.New("database unavailable"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRuleRepo := new(MockBusinessRuleRepository)
+			mockLogger := new(MockLogger)
+			mockRuleRepo.On("ListEnabled", mock.Anything).Return(tt.rules, tt.rulesErr)
+			mockLogger.On("Info", mock.Anything, mock.Anything).Maybe()
+			mockLogger.On("Error", mock.Anything, mock.Anything).Maybe()
+
+			var transaction *entity.Transaction
+			var err error
+			if tt.credit {
+				transaction, err = entity.NewCreditTransaction(vo.NewAccountID(), vo.NewMoneyFromFloat(105), "Salary", "", time.Now())
+			} else {
+				transaction, err = entity.NewDebitTransaction(vo.NewAccountID(), vo.NewMoneyFromFloat(105), "Card payment", "", time.Now())
+			}
+			require.NoError(t, err)
+
+			err = NewBusinessRules(mockRuleRepo, engine, "THB", mockLogger).Apply(context.Background(), transaction)
+
+			switch {
+			case tt.expectedErr == nil:
+				require.NoError(t, err)
+				assert.True(t, transaction.Fee.Equal(vo.NewMoneyFromFloat(tt.expectedFee)), "fee %s", transaction.Fee)
+			case errors.Is(tt.expectedErr, errs.ErrBusinessRuleFailed):
+				assert.ErrorIs(t, err, errs.ErrBusinessRuleFailed)
+			default:
+				assert.Equal(t, tt.expectedErr, err)
+			}
+		})
+	}
+}
+
+func TestBusinessRules_Nil(t *testing.T) {
+	transaction, err := entity.NewDebitTransaction(vo.NewAccountID(), vo.NewMoneyFromFloat(10), "Card payment", "", time.Now())
+	require.NoError(t, err)
+
+	var rules *BusinessRules
+	assert.NoError(t, rules.Apply(context.Background(), transaction))
+	assert.True(t, transaction.Fee.IsZero())
+}
+
+func TestBusinessRuleUseCase_CreateRule(t *testing.T) {
+	engine := &StubRuleEngine{Results: map[string]interface{}{"amount > 50000": true}}
+
+	tests := []struct {
+		name        string
+		req         dto.CreateBusinessRuleRequest
+		expectedErr error
+	}{
+		{
+			name: "valid_rule",
+			req:  dto.CreateBusinessRuleRequest{Name: "large", Kind: "VALIDATION", Expression: "amount > 50000", Message: "Amount too large"},
+		},
+		{
+			name:        "expression_does_not_compile",
+			req:         dto.CreateBusinessRuleRequest{Name: "large", Kind: "VALIDATION", Expression: "amont > 50000"},
+			expectedErr: errs.ValidationError{Field: "expression", Message: "invalid expression: undeclared reference"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRuleRepo := new(MockBusinessRuleRepository)
+			mockLogger := new(MockLogger)
+			mockRuleRepo.On("Create", mock.Anything, mock.AnythingOfType("*entity.BusinessRule")).Return(nil)
+			mockLogger.On("Info", mock.Anything, mock.Anything).Maybe()
+
+			uc := NewBusinessRuleUseCase(mockRuleRepo, engine, "THB", mockLogger)
+			result, err := uc.CreateRule(context.Background(), tt.req)
+
+			if tt.expectedErr != nil {
+				assert.Equal(t, tt.expectedErr, err)
+				mockRuleRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "VALIDATION", result.Kind)
+			assert.True(t, result.Enabled)
+		})
+	}
+}
+
+func TestBusinessRuleUseCase_TestRule(t *testing.T) {
+	engine := &StubRuleEngine{Results: map[string]interface{}{
+		"amount * 0.001": 2.5,
+		"amount > 1000":  errors.New("evaluation failed: operation cancelled: actual cost limit exceeded"),
+	}}
+	uc := NewBusinessRuleUseCase(new(MockBusinessRuleRepository), engine, "THB", new(MockLogger))
+	sample := dto.RuleSampleTransaction{TransactionType: "TRANSFER", Amount: dto.NewDecimalStringFromFloat(2500), Merchant: "SuperMart", FromAccountID: vo.NewAccountID().String()}
+
+	result, err := uc.TestRule(context.Background(), dto.TestBusinessRuleRequest{Kind: "FEE", Expression: "amount * 0.001", Transaction: sample})
+	require.NoError(t, err)
+	require.NotNil(t, result.Fee)
+	assert.Equal(t, 2.5, *result.Fee)
+	assert.Equal(t, "API", engine.Facts["channel"])
+	assert.Equal(t, entity.CategoryUncategorized, engine.Facts["category"])
+	assert.Equal(t, "", engine.Facts["to_account_id"])
+
+	// Runtime failures are reported rather than returned
+	result, err = uc.TestRule(context.Background(), dto.TestBusinessRuleRequest{Kind: "VALIDATION", Expression: "amount > 1000", Transaction: sample})
+	require.NoError(t, err)
+	assert.Nil(t, result.Rejected)
+	assert.Contains(t, result.Error, "cost limit")
+
+	_, err = uc.TestRule(context.Background(), dto.TestBusinessRuleRequest{Kind: "FEE", Expression: "fee", Transaction: sample})
+	assert.IsType(t, errs.ValidationError{}, err)
+}
@@ -0,0 +1,136 @@
+// internal/application/cache.go
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hydr0g3nz/mini_bank/internal/application/dto"
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/event"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/infra"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+)
+
+// invalidatableCachePrefixes are the namespaces of cached responses. Patterns must start with one of
+// them, so an invalidation can never drop locks, idempotency keys or review claims.
+var invalidatableCachePrefixes = []string{"account:", "accounts:", "transaction:", "transactions:"}
+
+// cachedListPatterns cover every cached list page
+var cachedListPatterns = []string{
+	"accounts:list:*",
+	"transactions:list:*",
+	"transactions:account:*",
+	"transactions:status:*",
+}
+
+type cacheUseCase struct {
+	cache  infra.CacheService
+	events infra.EventPublisher
+	logger infra.Logger
+}
+
+// NewCacheUseCase creates a new cache use case recording invalidations through events
+func NewCacheUseCase(cache infra.CacheService, events infra.EventPublisher, logger infra.Logger) CacheUseCase {
+	return &cacheUseCase{
+		cache:  cache,
+		events: events,
+		logger: logger,
+	}
+}
+
+// InvalidateCache removes the cached entries matching the request's patterns and entity references.
+// Every invalidation, including one that failed partway, is published as a cache.invalidated event,
+// which puts who removed what in the audit log.
+func (uc *cacheUseCase) InvalidateCache(ctx context.Context, req dto.CacheInvalidationRequest) (*dto.CacheInvalidationResponse, error) {
+	patterns, err := cacheInvalidationPatterns(req)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.CacheInvalidationResponse{Patterns: make([]dto.InvalidatedCachePattern, 0, len(patterns))}
+	removed := make(map[string]int64, len(patterns))
+	var invalidateErr error
+	for _, pattern := range patterns {
+		deleted, err := uc.cache.DeleteMatching(ctx, pattern)
+		if err != nil {
+			invalidateErr = fmt.Errorf("failed to invalidate %s: %w", pattern, err)
+			break
+		}
+		removed[pattern] = deleted
+		response.Patterns = append(response.Patterns, dto.InvalidatedCachePattern{Pattern: pattern, Deleted: deleted})
+		response.Deleted += deleted
+	}
+
+	if err := uc.events.Publish(ctx, event.NewCacheInvalidatedEvent(req.RequestedBy, req.Reason, removed, invalidateErr)); err != nil {
+		uc.logger.Error("Failed to publish cache invalidation event", "error", err, "requestedBy", req.RequestedBy)
+	}
+
+	if invalidateErr != nil {
+		uc.logger.Error("Failed to invalidate cache", "error", invalidateErr, "requestedBy", req.RequestedBy)
+		return nil, invalidateErr
+	}
+
+	uc.logger.Info("Cache invalidated", "requestedBy", req.RequestedBy, "reason", req.Reason, "patterns", patterns, "deleted", response.Deleted)
+	return response, nil
+}
+
+// cacheInvalidationPatterns expands a request into the patterns to remove, without duplicates
+func cacheInvalidationPatterns(req dto.CacheInvalidationRequest) ([]string, error) {
+	var patterns []string
+	seen := make(map[string]bool)
+	add := func(pattern string) {
+		if !seen[pattern] {
+			seen[pattern] = true
+			patterns = append(patterns, pattern)
+		}
+	}
+
+	for _, pattern := range req.Patterns {
+		if !hasAnyPrefix(pattern, invalidatableCachePrefixes) {
+			return nil, errs.ValidationError{
+				Field:   "patterns",
+				Message: fmt.Sprintf("pattern %q must start with one of %s", pattern, strings.Join(invalidatableCachePrefixes, ", ")),
+			}
+		}
+		add(pattern)
+	}
+
+	for _, id := range req.AccountIDs {
+		accountID, err := vo.NewAccountIDFromString(id)
+		if err != nil {
+			return nil, errs.ValidationError{Field: "account_ids", Message: fmt.Sprintf("invalid account ID: %s", id)}
+		}
+		add(fmt.Sprintf("account:%s", accountID))
+		add(fmt.Sprintf("transactions:account:%s:*", accountID))
+	}
+
+	for _, id := range req.TransactionIDs {
+		transactionID, err := vo.NewTransactionIDFromString(id)
+		if err != nil {
+			return nil, errs.ValidationError{Field: "transaction_ids", Message: fmt.Sprintf("invalid transaction ID: %s", id)}
+		}
+		add(fmt.Sprintf("transaction:%s", transactionID))
+	}
+
+	if req.AllLists {
+		for _, pattern := range cachedListPatterns {
+			add(pattern)
+		}
+	}
+
+	if len(patterns) == 0 {
+		return nil, errs.ValidationError{Field: "patterns", Message: "give at least one pattern, account ID or transaction ID, or all_lists"}
+	}
+	return patterns, nil
+}
+
+func hasAnyPrefix(value string, prefixes []string) bool {
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(value, prefix) {
+			return true
+		}
+	}
+	return false
+}
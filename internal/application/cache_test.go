@@ -0,0 +1,104 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hydr0g3nz/mini_bank/internal/application/dto"
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/event"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCacheUseCase_InvalidateCache(t *testing.T) {
+	ctx := context.Background()
+	mockLogger := new(MockLogger)
+	mockLogger.On("Info", mock.Anything, mock.Anything).Maybe()
+	mockLogger.On("Error", mock.Anything, mock.Anything).Maybe()
+
+	accountID := vo.NewAccountID()
+	transactionID := vo.NewTransactionID()
+
+	cache := new(MockCacheService)
+	cache.On("DeleteMatching", ctx, "account:"+accountID.String()).Return(int64(1), nil)
+	cache.On("DeleteMatching", ctx, "transactions:account:"+accountID.String()+":*").Return(int64(3), nil)
+	cache.On("DeleteMatching", ctx, "transaction:"+transactionID.String()).Return(int64(0), nil)
+	cache.On("DeleteMatching", ctx, "accounts:list:*").Return(int64(2), nil)
+	events := &StubEventPublisher{}
+
+	uc := NewCacheUseCase(cache, events, mockLogger)
+
+	response, err := uc.InvalidateCache(ctx, dto.CacheInvalidationRequest{
+		RequestedBy:    "alice",
+		Reason:         "balance fixed by hand",
+		Patterns:       []string{"accounts:list:*"},
+		AccountIDs:     []string{accountID.String()},
+		TransactionIDs: []string{transactionID.String()},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(6), response.Deleted)
+	assert.Len(t, response.Patterns, 4)
+
+	// Who invalidated what goes to the audit log as an event
+	require.Len(t, events.Events, 1)
+	assert.Equal(t, event.CacheInvalidated, events.Events[0].Type)
+	assert.JSONEq(t, `{"requested_by": "alice", "reason": "balance fixed by hand", "patterns": {
+		"accounts:list:*": 2,
+		"account:`+accountID.String()+`": 1,
+		"transactions:account:`+accountID.String()+`:*": 3,
+		"transaction:`+transactionID.String()+`": 0
+	}}`, string(events.Events[0].Data))
+}
+
+func TestCacheUseCase_InvalidateCache_Rejected(t *testing.T) {
+	tests := []struct {
+		name string
+		req  dto.CacheInvalidationRequest
+	}{
+		{name: "nothing to invalidate", req: dto.CacheInvalidationRequest{RequestedBy: "alice"}},
+		{name: "lock keys", req: dto.CacheInvalidationRequest{RequestedBy: "alice", Patterns: []string{"lock:*"}}},
+		{name: "every key", req: dto.CacheInvalidationRequest{RequestedBy: "alice", Patterns: []string{"*"}}},
+		{name: "invalid account ID", req: dto.CacheInvalidationRequest{RequestedBy: "alice", AccountIDs: []string{"nope"}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cache := new(MockCacheService)
+			events := &StubEventPublisher{}
+			uc := NewCacheUseCase(cache, events, new(MockLogger))
+
+			_, err := uc.InvalidateCache(context.Background(), tt.req)
+
+			assert.IsType(t, errs.ValidationError{}, err)
+			cache.AssertNotCalled(t, "DeleteMatching", mock.Anything, mock.Anything)
+			assert.Empty(t, events.Events)
+		})
+	}
+}
+
+func TestCacheUseCase_InvalidateCache_Failure(t *testing.T) {
+	ctx := context.Background()
+	mockLogger := new(MockLogger)
+	mockLogger.On("Error", mock.Anything, mock.Anything).Maybe()
+
+	cache := new(MockCacheService)
+	cache.On("DeleteMatching", ctx, "accounts:list:*").Return(int64(2), nil)
+	cache.On("DeleteMatching", ctx, "transactions:list:*").Return(int64(0), errors.New("connection refused"))
+	events := &StubEventPublisher{}
+
+	uc := NewCacheUseCase(cache, events, mockLogger)
+
+	_, err := uc.InvalidateCache(ctx, dto.CacheInvalidationRequest{RequestedBy: "alice", AllLists: true})
+
+	require.Error(t, err)
+	cache.AssertNotCalled(t, "DeleteMatching", ctx, "transactions:account:*")
+
+	// The keys removed before the failure are audited with the error
+	require.Len(t, events.Events, 1)
+	assert.JSONEq(t, `{"requested_by": "alice", "patterns": {"accounts:list:*": 2}, "error": "failed to invalidate transactions:list:*: connection refused"}`, string(events.Events[0].Data))
+}
@@ -0,0 +1,267 @@
+// internal/application/calendar.go
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/application/dto"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/infra"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/repository"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+)
+
+const (
+	// dateLayout is the wire format of calendar dates
+	dateLayout = "2006-01-02"
+
+	// maxHolidayRun bounds the search for the next business day
+	maxHolidayRun = 366
+)
+
+// HolidayCalendar is the business-day calendar of one region: weekends and the
+// region's stored holidays are non-business days
+type HolidayCalendar struct {
+	holidayRepo repository.HolidayRepository
+	region      string
+}
+
+// NewHolidayCalendar creates the calendar of a region
+func NewHolidayCalendar(holidayRepo repository.HolidayRepository, region string) *HolidayCalendar {
+	return &HolidayCalendar{
+		holidayRepo: holidayRepo,
+		region:      entity.NormalizeRegion(region),
+	}
+}
+
+// IsBusinessDay checks if the date is neither a weekend nor a holiday
+func (c *HolidayCalendar) IsBusinessDay(ctx context.Context, date time.Time) (bool, error) {
+	holiday, err := c.holiday(ctx, date)
+	if err != nil {
+		return false, err
+	}
+	return holiday == nil && !isWeekend(date), nil
+}
+
+// NextBusinessDay returns the first business day strictly after the date
+func (c *HolidayCalendar) NextBusinessDay(ctx context.Context, date time.Time) (time.Time, error) {
+	next := vo.DateOf(date)
+	for i := 0; i < maxHolidayRun; i++ {
+		next = next.AddDate(0, 0, 1)
+		ok, err := c.IsBusinessDay(ctx, next)
+		if err != nil {
+			return time.Time{}, err
+		}
+		if ok {
+			return next, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("no business day within %d days after %s", maxHolidayRun, date.Format(dateLayout))
+}
+
+// SettlementDate returns the date n business days after the trade date; a trade
+// date that is not a business day rolls forward to the next one first
+func (c *HolidayCalendar) SettlementDate(ctx context.Context, tradeDate time.Time, n int) (time.Time, error) {
+	date := vo.DateOf(tradeDate)
+
+	ok, err := c.IsBusinessDay(ctx, date)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if !ok {
+		if date, err = c.NextBusinessDay(ctx, date); err != nil {
+			return time.Time{}, err
+		}
+	}
+
+	for i := 0; i < n; i++ {
+		if date, err = c.NextBusinessDay(ctx, date); err != nil {
+			return time.Time{}, err
+		}
+	}
+
+	return date, nil
+}
+
+// holiday returns the region's holiday on the date, or nil
+func (c *HolidayCalendar) holiday(ctx context.Context, date time.Time) (*entity.Holiday, error) {
+	holiday, err := c.holidayRepo.GetByDate(ctx, c.region, date)
+	if err != nil {
+		if errors.Is(err, errs.ErrHolidayNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return holiday, nil
+}
+
+func isWeekend(date time.Time) bool {
+	return date.Weekday() == time.Saturday || date.Weekday() == time.Sunday
+}
+
+// Ensure HolidayCalendar satisfies the calendar port used for value dating
+var _ infra.Calendar = (*HolidayCalendar)(nil)
+
+type calendarUseCase struct {
+	holidayRepo repository.HolidayRepository
+	valueDating *ValueDatingPolicy
+	logger      infra.Logger
+	mapper      *dto.HolidayMapper
+}
+
+// NewCalendarUseCase creates a new calendar use case
+func NewCalendarUseCase(holidayRepo repository.HolidayRepository, valueDating *ValueDatingPolicy, logger infra.Logger) CalendarUseCase {
+	return &calendarUseCase{
+		holidayRepo: holidayRepo,
+		valueDating: valueDating,
+		logger:      logger,
+		mapper:      &dto.HolidayMapper{},
+	}
+}
+
+// AddHoliday adds a holiday to a region's calendar
+func (uc *calendarUseCase) AddHoliday(ctx context.Context, req dto.CreateHolidayRequest) (*dto.HolidayResponse, error) {
+	date, err := parseDate("date", req.Date)
+	if err != nil {
+		return nil, err
+	}
+
+	holiday, err := entity.NewHoliday(req.Region, date, req.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.holidayRepo.Create(ctx, holiday); err != nil {
+		uc.logger.Error("Failed to add holiday", "error", err, "region", holiday.Region, "date", req.Date)
+		return nil, err
+	}
+
+	uc.logger.Info("Holiday added", "region", holiday.Region, "date", req.Date, "name", holiday.Name)
+	response := uc.mapper.ToResponse(holiday)
+	return &response, nil
+}
+
+// DeleteHoliday removes a holiday from a region's calendar
+func (uc *calendarUseCase) DeleteHoliday(ctx context.Context, region, date string) error {
+	parsed, err := parseDate("date", date)
+	if err != nil {
+		return err
+	}
+
+	region = entity.NormalizeRegion(region)
+	if err := uc.holidayRepo.Delete(ctx, region, parsed); err != nil {
+		uc.logger.Error("Failed to delete holiday", "error", err, "region", region, "date", date)
+		return err
+	}
+
+	uc.logger.Info("Holiday deleted", "region", region, "date", date)
+	return nil
+}
+
+// ListHolidays retrieves a region's holidays within a date range
+func (uc *calendarUseCase) ListHolidays(ctx context.Context, req dto.ListHolidaysRequest) (*dto.HolidayListResponse, error) {
+	year := uc.valueDating.Today().Year()
+	from := time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(year, time.December, 31, 0, 0, 0, 0, time.UTC)
+
+	var err error
+	if req.From != "" {
+		if from, err = parseDate("from", req.From); err != nil {
+			return nil, err
+		}
+	}
+	if req.To != "" {
+		if to, err = parseDate("to", req.To); err != nil {
+			return nil, err
+		}
+	}
+	if to.Before(from) {
+		return nil, errs.ValidationError{Field: "to", Message: "to must not be before from"}
+	}
+
+	region := entity.NormalizeRegion(req.Region)
+	holidays, err := uc.holidayRepo.ListByRegion(ctx, region, from, to)
+	if err != nil {
+		uc.logger.Error("Failed to list holidays", "error", err, "region", region)
+		return nil, err
+	}
+
+	responses := make([]dto.HolidayResponse, len(holidays))
+	for i, holiday := range holidays {
+		responses[i] = uc.mapper.ToResponse(holiday)
+	}
+
+	return &dto.HolidayListResponse{
+		Region:   region,
+		From:     from.Format(dateLayout),
+		To:       to.Format(dateLayout),
+		Holidays: responses,
+	}, nil
+}
+
+// GetBusinessDay reports whether a date is a business day in a region
+func (uc *calendarUseCase) GetBusinessDay(ctx context.Context, region, date string) (*dto.BusinessDayResponse, error) {
+	parsed, err := parseDate("date", date)
+	if err != nil {
+		return nil, err
+	}
+
+	calendar := NewHolidayCalendar(uc.holidayRepo, region)
+
+	holiday, err := calendar.holiday(ctx, parsed)
+	if err != nil {
+		return nil, err
+	}
+
+	next, err := calendar.NextBusinessDay(ctx, parsed)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.BusinessDayResponse{
+		Region:          calendar.region,
+		Date:            parsed.Format(dateLayout),
+		IsBusinessDay:   holiday == nil && !isWeekend(parsed),
+		NextBusinessDay: next.Format(dateLayout),
+	}
+	if holiday != nil {
+		response.Holiday = holiday.Name
+	}
+
+	return response, nil
+}
+
+// GetSettlementDate computes the date N business days after a trade date
+func (uc *calendarUseCase) GetSettlementDate(ctx context.Context, req dto.SettlementDateRequest) (*dto.SettlementDateResponse, error) {
+	tradeDate, err := parseDate("trade_date", req.TradeDate)
+	if err != nil {
+		return nil, err
+	}
+
+	calendar := NewHolidayCalendar(uc.holidayRepo, req.Region)
+	settlementDate, err := calendar.SettlementDate(ctx, tradeDate, req.Days)
+	if err != nil {
+		uc.logger.Error("Failed to compute settlement date", "error", err, "region", calendar.region)
+		return nil, err
+	}
+
+	return &dto.SettlementDateResponse{
+		Region:         calendar.region,
+		TradeDate:      tradeDate.Format(dateLayout),
+		Days:           req.Days,
+		SettlementDate: settlementDate.Format(dateLayout),
+	}, nil
+}
+
+// parseDate parses a YYYY-MM-DD date
+func parseDate(field, value string) (time.Time, error) {
+	date, err := time.Parse(dateLayout, value)
+	if err != nil {
+		return time.Time{}, errs.ValidationError{Field: field, Message: "must be a date in YYYY-MM-DD format"}
+	}
+	return date, nil
+}
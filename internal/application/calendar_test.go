@@ -0,0 +1,119 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/application/dto"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type MockHolidayRepository struct {
+	mock.Mock
+}
+
+func (m *MockHolidayRepository) Create(ctx context.Context, holiday *entity.Holiday) error {
+	args := m.Called(ctx, holiday)
+	return args.Error(0)
+}
+
+func (m *MockHolidayRepository) GetByDate(ctx context.Context, region string, date time.Time) (*entity.Holiday, error) {
+	args := m.Called(ctx, region, date)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.Holiday), args.Error(1)
+}
+
+func (m *MockHolidayRepository) Delete(ctx context.Context, region string, date time.Time) error {
+	args := m.Called(ctx, region, date)
+	return args.Error(0)
+}
+
+func (m *MockHolidayRepository) ListByRegion(ctx context.Context, region string, from, to time.Time) ([]*entity.Holiday, error) {
+	args := m.Called(ctx, region, from, to)
+	return args.Get(0).([]*entity.Holiday), args.Error(1)
+}
+
+// holidaysOn mocks a region whose only holidays are the given dates
+func holidaysOn(repo *MockHolidayRepository, region string, dates ...string) {
+	for _, date := range dates {
+		day, _ := time.Parse(dateLayout, date)
+		repo.On("GetByDate", mock.Anything, region, mock.MatchedBy(func(d time.Time) bool {
+			return d.Format(dateLayout) == date
+		})).Return(&entity.Holiday{Region: region, Date: day, Name: "Holiday"}, nil)
+	}
+	repo.On("GetByDate", mock.Anything, region, mock.Anything).Return(nil, errs.ErrHolidayNotFound)
+}
+
+func TestCalendarUseCase_GetSettlementDate(t *testing.T) {
+	tests := []struct {
+		name     string
+		request  dto.SettlementDateRequest
+		holidays []string
+		expected string
+	}{
+		{
+			name:     "t_plus_2_midweek",
+			request:  dto.SettlementDateRequest{Region: "th", TradeDate: "2025-07-01", Days: 2}, // Tuesday
+			expected: "2025-07-03",
+		},
+		{
+			name:     "t_plus_2_skips_weekend",
+			request:  dto.SettlementDateRequest{Region: "TH", TradeDate: "2025-07-03", Days: 2}, // Thursday
+			expected: "2025-07-07",
+		},
+		{
+			name:     "t_plus_1_skips_holiday",
+			request:  dto.SettlementDateRequest{Region: "TH", TradeDate: "2025-07-10", Days: 1},
+			holidays: []string{"2025-07-11"},
+			expected: "2025-07-14",
+		},
+		{
+			name:     "t_plus_0_on_weekend_rolls_forward",
+			request:  dto.SettlementDateRequest{Region: "TH", TradeDate: "2025-07-05", Days: 0}, // Saturday
+			expected: "2025-07-07",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockHolidayRepo := new(MockHolidayRepository)
+			holidaysOn(mockHolidayRepo, "TH", tt.holidays...)
+
+			uc := NewCalendarUseCase(mockHolidayRepo, NewValueDatingPolicy(&StubCalendar{}, vo.ProcessingWindow{}, nil), new(MockLogger))
+			response, err := uc.GetSettlementDate(context.Background(), tt.request)
+
+			require.NoError(t, err)
+			assert.Equal(t, "TH", response.Region)
+			assert.Equal(t, tt.expected, response.SettlementDate)
+		})
+	}
+}
+
+func TestCalendarUseCase_GetBusinessDay(t *testing.T) {
+	mockHolidayRepo := new(MockHolidayRepository)
+	holidaysOn(mockHolidayRepo, "TH", "2025-07-11")
+
+	uc := NewCalendarUseCase(mockHolidayRepo, NewValueDatingPolicy(&StubCalendar{}, vo.ProcessingWindow{}, nil), new(MockLogger))
+
+	response, err := uc.GetBusinessDay(context.Background(), "TH", "2025-07-11")
+	require.NoError(t, err)
+	assert.False(t, response.IsBusinessDay)
+	assert.Equal(t, "Holiday", response.Holiday)
+	assert.Equal(t, "2025-07-14", response.NextBusinessDay)
+
+	response, err = uc.GetBusinessDay(context.Background(), "TH", "2025-07-10")
+	require.NoError(t, err)
+	assert.True(t, response.IsBusinessDay)
+	assert.Empty(t, response.Holiday)
+
+	_, err = uc.GetBusinessDay(context.Background(), "TH", "10/07/2025")
+	assert.IsType(t, errs.ValidationError{}, err)
+}
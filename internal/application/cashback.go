@@ -0,0 +1,291 @@
+// internal/application/cashback.go
+package usecase
+
+import (
+	"context"
+
+	"github.com/hydr0g3nz/mini_bank/internal/application/dto"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/event"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/infra"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/repository"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"github.com/shopspring/decimal"
+)
+
+// CashbackEngine evaluates the running cashback campaigns whenever a payment completes and pays
+// the cashback earned into the paying account as a CREDIT transaction referencing the campaign.
+// It wraps the event publisher so evaluation runs once, on the instance that completed the payment.
+type CashbackEngine struct {
+	cashbackRepo repository.CashbackRepository
+	credits      *bonusCredits
+	next         infra.EventPublisher
+	logger       infra.Logger
+}
+
+// NewCashbackEngine creates a cashback engine publishing through next
+func NewCashbackEngine(
+	cashbackRepo repository.CashbackRepository,
+	accountRepo repository.AccountRepository,
+	transactionRepo repository.TransactionRepository,
+	cache infra.CacheService,
+	next infra.EventPublisher,
+	valueDating *ValueDatingPolicy,
+	logger infra.Logger,
+) *CashbackEngine {
+	return &CashbackEngine{
+		cashbackRepo: cashbackRepo,
+		credits: &bonusCredits{
+			accountRepo:     accountRepo,
+			transactionRepo: transactionRepo,
+			cache:           cache,
+			events:          next,
+			valueDating:     valueDating,
+			logger:          logger,
+		},
+		next:   next,
+		logger: logger,
+	}
+}
+
+// Publish forwards the event and pays cashback on completed payments
+func (e *CashbackEngine) Publish(ctx context.Context, evt event.Event) error {
+	err := e.next.Publish(ctx, evt)
+
+	if evt.Type == event.TransactionCompleted {
+		e.evaluate(ctx, evt)
+	}
+
+	return err
+}
+
+// evaluate pays the cashback each running campaign awards the completed payment
+func (e *CashbackEngine) evaluate(ctx context.Context, evt event.Event) {
+	payload, err := evt.DecodeTransaction()
+	if err != nil {
+		e.logger.Warn("Failed to decode transaction event", "error", err, "eventID", evt.ID)
+		return
+	}
+
+	// Only payments out of an account earn cashback
+	if payload.FromAccountID == nil {
+		return
+	}
+
+	accountID, err := vo.NewAccountIDFromString(*payload.FromAccountID)
+	if err != nil {
+		return
+	}
+	transactionID, err := vo.NewTransactionIDFromString(payload.TransactionID)
+	if err != nil {
+		return
+	}
+	amount, err := vo.NewMoneyFromString(payload.Amount)
+	if err != nil {
+		e.logger.Warn("Failed to parse transaction amount", "error", err, "transactionID", payload.TransactionID)
+		return
+	}
+
+	completedAt := evt.OccurredAt
+	if payload.CompletedAt != nil {
+		completedAt = *payload.CompletedAt
+	}
+
+	campaigns, err := e.cashbackRepo.ListRunningCampaigns(ctx, completedAt)
+	if err != nil {
+		e.logger.Warn("Failed to load cashback campaigns", "error", err, "transactionID", payload.TransactionID)
+		return
+	}
+
+	transactionType := vo.TransactionType(payload.TransactionType)
+	for _, campaign := range campaigns {
+		earned := campaign.Earned(transactionType, amount, completedAt)
+		if earned.IsZero() {
+			continue
+		}
+
+		if err := e.pay(ctx, campaign, accountID, transactionID, earned); err != nil {
+			e.logger.Warn("Failed to pay cashback",
+				"error", err,
+				"campaignID", campaign.ID,
+				"transactionID", payload.TransactionID)
+		}
+	}
+}
+
+// pay credits the cashback a campaign awards a payment, once per campaign and payment
+func (e *CashbackEngine) pay(ctx context.Context, campaign *entity.CashbackCampaign, accountID vo.AccountID, transactionID vo.TransactionID, earned vo.Money) error {
+	credit, err := e.credits.create(ctx, accountID, earned, "Cashback: "+campaign.Name, campaign.Reference())
+	if err != nil {
+		return err
+	}
+
+	recorded, err := e.cashbackRepo.CreateReward(ctx, entity.NewCashbackReward(campaign, transactionID, credit))
+	if err != nil {
+		return err
+	}
+	if !recorded {
+		return e.credits.cancel(ctx, credit)
+	}
+
+	if err := e.credits.settle(ctx, credit); err != nil {
+		return err
+	}
+
+	e.logger.Info("Cashback paid",
+		"campaignID", campaign.ID,
+		"accountID", accountID.String(),
+		"transactionID", transactionID.String(),
+		"creditTransactionID", credit.ID.String(),
+		"amount", earned.String())
+	return nil
+}
+
+// Ensure CashbackEngine can stand in for the event publisher it wraps
+var _ infra.EventPublisher = (*CashbackEngine)(nil)
+
+type cashbackUseCase struct {
+	cashbackRepo repository.CashbackRepository
+	accountRepo  repository.AccountRepository
+	logger       infra.Logger
+	mapper       *dto.CashbackMapper
+}
+
+// NewCashbackUseCase creates a new cashback use case
+func NewCashbackUseCase(
+	cashbackRepo repository.CashbackRepository,
+	accountRepo repository.AccountRepository,
+	logger infra.Logger,
+) CashbackUseCase {
+	return &cashbackUseCase{
+		cashbackRepo: cashbackRepo,
+		accountRepo:  accountRepo,
+		logger:       logger,
+		mapper:       &dto.CashbackMapper{},
+	}
+}
+
+// CreateCampaign starts a cashback campaign
+func (uc *cashbackUseCase) CreateCampaign(ctx context.Context, req dto.CreateCashbackCampaignRequest) (*dto.CashbackCampaignResponse, error) {
+	campaign, err := entity.NewCashbackCampaign(
+		req.Name,
+		decimal.NewFromFloat(req.Percentage),
+		req.Cap.Money(),
+		toTransactionTypes(req.TransactionTypes),
+		req.StartsAt,
+		req.EndsAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.cashbackRepo.CreateCampaign(ctx, campaign); err != nil {
+		uc.logger.Error("Failed to create cashback campaign", "error", err, "name", campaign.Name)
+		return nil, err
+	}
+
+	uc.logger.Info("Cashback campaign created", "campaignID", campaign.ID, "name", campaign.Name)
+	response := uc.mapper.ToCampaignResponse(campaign)
+	return &response, nil
+}
+
+// UpdateCampaign changes a campaign's terms for payments completed from then on, or pauses it
+func (uc *cashbackUseCase) UpdateCampaign(ctx context.Context, req dto.UpdateCashbackCampaignRequest) (*dto.CashbackCampaignResponse, error) {
+	campaign, err := uc.cashbackRepo.GetCampaign(ctx, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = campaign.Update(
+		req.Name,
+		decimal.NewFromFloat(req.Percentage),
+		req.Cap.Money(),
+		toTransactionTypes(req.TransactionTypes),
+		req.StartsAt,
+		req.EndsAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if req.Active != nil {
+		campaign.SetActive(*req.Active)
+	}
+
+	if err := uc.cashbackRepo.UpdateCampaign(ctx, campaign); err != nil {
+		uc.logger.Error("Failed to update cashback campaign", "error", err, "campaignID", campaign.ID)
+		return nil, err
+	}
+
+	uc.logger.Info("Cashback campaign updated", "campaignID", campaign.ID, "active", campaign.Active)
+	response := uc.mapper.ToCampaignResponse(campaign)
+	return &response, nil
+}
+
+// ListCampaigns retrieves the cashback campaigns, newest first
+func (uc *cashbackUseCase) ListCampaigns(ctx context.Context) (*dto.CashbackCampaignListResponse, error) {
+	campaigns, err := uc.cashbackRepo.ListCampaigns(ctx)
+	if err != nil {
+		uc.logger.Error("Failed to list cashback campaigns", "error", err)
+		return nil, err
+	}
+
+	responses := make([]dto.CashbackCampaignResponse, len(campaigns))
+	for i, campaign := range campaigns {
+		responses[i] = uc.mapper.ToCampaignResponse(campaign)
+	}
+
+	return &dto.CashbackCampaignListResponse{Campaigns: responses}, nil
+}
+
+// GetAccountCashback retrieves the cashback an account earned, newest first, with its total
+func (uc *cashbackUseCase) GetAccountCashback(ctx context.Context, accountID string, req dto.ListRequest) (*dto.CashbackHistoryResponse, error) {
+	parsedAccountID, err := vo.NewAccountIDFromString(accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := uc.accountRepo.GetByID(ctx, parsedAccountID); err != nil {
+		return nil, err
+	}
+
+	offset := (req.Page - 1) * req.PageSize
+
+	rewards, err := uc.cashbackRepo.ListRewardsByAccountID(ctx, parsedAccountID, req.PageSize, offset)
+	if err != nil {
+		uc.logger.Error("Failed to list cashback rewards", "error", err, "accountID", accountID)
+		return nil, err
+	}
+
+	count, err := uc.cashbackRepo.CountRewardsByAccountID(ctx, parsedAccountID)
+	if err != nil {
+		uc.logger.Error("Failed to count cashback rewards", "error", err, "accountID", accountID)
+		return nil, err
+	}
+
+	total, err := uc.cashbackRepo.SumRewardsByAccountID(ctx, parsedAccountID)
+	if err != nil {
+		uc.logger.Error("Failed to total cashback rewards", "error", err, "accountID", accountID)
+		return nil, err
+	}
+
+	responses := make([]dto.CashbackRewardResponse, len(rewards))
+	for i, reward := range rewards {
+		responses[i] = uc.mapper.ToRewardResponse(reward)
+	}
+
+	return &dto.CashbackHistoryResponse{
+		AccountID:  accountID,
+		Total:      total.InexactFloat64(),
+		Rewards:    responses,
+		Pagination: dto.NewPaginationInfo(req.Page, req.PageSize, count),
+	}, nil
+}
+
+// toTransactionTypes converts request transaction types, validated by the request binding
+func toTransactionTypes(values []string) []vo.TransactionType {
+	transactionTypes := make([]vo.TransactionType, len(values))
+	for i, value := range values {
+		transactionTypes[i] = vo.TransactionType(value)
+	}
+	return transactionTypes
+}
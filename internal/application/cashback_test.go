@@ -0,0 +1,201 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/application/dto"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/event"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type MockCashbackRepository struct {
+	mock.Mock
+}
+
+func (m *MockCashbackRepository) CreateCampaign(ctx context.Context, campaign *entity.CashbackCampaign) error {
+	args := m.Called(ctx, campaign)
+	return args.Error(0)
+}
+
+func (m *MockCashbackRepository) GetCampaign(ctx context.Context, id string) (*entity.CashbackCampaign, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.CashbackCampaign), args.Error(1)
+}
+
+func (m *MockCashbackRepository) UpdateCampaign(ctx context.Context, campaign *entity.CashbackCampaign) error {
+	args := m.Called(ctx, campaign)
+	return args.Error(0)
+}
+
+func (m *MockCashbackRepository) ListCampaigns(ctx context.Context) ([]*entity.CashbackCampaign, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]*entity.CashbackCampaign), args.Error(1)
+}
+
+func (m *MockCashbackRepository) ListRunningCampaigns(ctx context.Context, at time.Time) ([]*entity.CashbackCampaign, error) {
+	args := m.Called(ctx, at)
+	return args.Get(0).([]*entity.CashbackCampaign), args.Error(1)
+}
+
+func (m *MockCashbackRepository) CreateReward(ctx context.Context, reward *entity.CashbackReward) (bool, error) {
+	args := m.Called(ctx, reward)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockCashbackRepository) ListRewardsByAccountID(ctx context.Context, accountID vo.AccountID, limit, offset int) ([]*entity.CashbackReward, error) {
+	args := m.Called(ctx, accountID, limit, offset)
+	return args.Get(0).([]*entity.CashbackReward), args.Error(1)
+}
+
+func (m *MockCashbackRepository) CountRewardsByAccountID(ctx context.Context, accountID vo.AccountID) (int64, error) {
+	args := m.Called(ctx, accountID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockCashbackRepository) SumRewardsByAccountID(ctx context.Context, accountID vo.AccountID) (vo.Money, error) {
+	args := m.Called(ctx, accountID)
+	return args.Get(0).(vo.Money), args.Error(1)
+}
+
+func createTestCampaign(t *testing.T) *entity.CashbackCampaign {
+	campaign, err := entity.NewCashbackCampaign(
+		"Summer",
+		decimal.NewFromInt(5),
+		vo.NewMoneyFromFloat(10),
+		[]vo.TransactionType{vo.TransactionTypeDebit},
+		time.Now().Add(-time.Hour),
+		time.Now().Add(time.Hour),
+	)
+	require.NoError(t, err)
+	return campaign
+}
+
+func TestCashbackEngine_Publish(t *testing.T) {
+	tests := []struct {
+		name           string
+		amount         float64
+		recorded       bool
+		expectedCredit float64 // Zero when nothing is paid
+	}{
+		{name: "pays_percentage", amount: 100, recorded: true, expectedCredit: 5},
+		{name: "capped", amount: 1000, recorded: true, expectedCredit: 10},
+		{name: "already_rewarded"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			account := createTestAccount()
+			campaign := createTestCampaign(t)
+			amount := tt.amount
+			if amount == 0 {
+				amount = 100
+			}
+			payment, err := entity.NewDebitTransaction(account.ID, vo.NewMoneyFromFloat(amount), "Coffee", "", time.Now())
+			payment = completedTransaction(t, payment, err)
+
+			mockCashbackRepo := new(MockCashbackRepository)
+			mockAccountRepo := new(MockAccountRepository)
+			mockTxnRepo := new(MockTransactionRepository)
+			mockCache := new(MockCacheService)
+			mockLogger := new(MockLogger)
+			mockLogger.On("Info", mock.Anything, mock.Anything).Maybe()
+
+			var credit *entity.Transaction
+			mockCashbackRepo.On("ListRunningCampaigns", mock.Anything, mock.Anything).Return([]*entity.CashbackCampaign{campaign}, nil)
+			mockTxnRepo.On("Create", mock.Anything, mock.AnythingOfType("*entity.Transaction")).
+				Run(func(args mock.Arguments) { credit = args.Get(1).(*entity.Transaction) }).
+				Return(nil)
+			mockTxnRepo.On("Update", mock.Anything, mock.AnythingOfType("*entity.Transaction")).Return(nil)
+			mockCashbackRepo.On("CreateReward", mock.Anything, mock.MatchedBy(func(reward *entity.CashbackReward) bool {
+				return reward.CampaignID == campaign.ID && reward.TransactionID == payment.ID && reward.AccountID == account.ID
+			})).Return(tt.recorded, nil)
+			mockAccountRepo.On("GetByID", mock.Anything, account.ID).Return(account, nil)
+			mockAccountRepo.On("Update", mock.Anything, account).Return(nil)
+			mockCache.On("Delete", mock.Anything, "account:"+account.ID.String()).Return(nil)
+
+			next := &StubEventPublisher{}
+			engine := NewCashbackEngine(mockCashbackRepo, mockAccountRepo, mockTxnRepo, mockCache, next, NewValueDatingPolicy(&StubCalendar{}, vo.ProcessingWindow{}, nil), mockLogger)
+
+			require.NoError(t, engine.Publish(context.Background(), event.NewTransactionEvent(event.TransactionCompleted, payment)))
+			require.NotNil(t, credit)
+			assert.Equal(t, vo.TransactionTypeCredit, credit.TransactionType)
+			assert.Equal(t, campaign.Reference(), credit.Reference)
+
+			if tt.expectedCredit == 0 {
+				assert.Equal(t, vo.TransactionStatusCancelled, credit.Status)
+				assert.True(t, account.Balance.Equal(vo.NewMoneyFromFloat(1000)))
+				assert.Len(t, next.Events, 1)
+				return
+			}
+
+			assert.Equal(t, vo.TransactionStatusCompleted, credit.Status)
+			assert.True(t, credit.Amount.Equal(vo.NewMoneyFromFloat(tt.expectedCredit)))
+			assert.True(t, account.Balance.Equal(vo.NewMoneyFromFloat(1000+tt.expectedCredit)))
+
+			require.Len(t, next.Events, 2)
+			payload, err := next.Events[1].DecodeTransaction()
+			require.NoError(t, err)
+			assert.Equal(t, credit.ID.String(), payload.TransactionID)
+			assert.Equal(t, "COMPLETED", payload.Status)
+		})
+	}
+}
+
+func TestCashbackEngine_IgnoresIneligiblePayments(t *testing.T) {
+	account := createTestAccount()
+	campaign := createTestCampaign(t)
+
+	credit, err := entity.NewCreditTransaction(account.ID, vo.NewMoneyFromFloat(1000), "Salary", "", time.Now())
+	credit = completedTransaction(t, credit, err)
+	transfer, err := entity.NewTransferTransaction(account.ID, vo.NewAccountID(), vo.NewMoneyFromFloat(100), "Rent", "", time.Now())
+	transfer = completedTransaction(t, transfer, err)
+
+	mockCashbackRepo := new(MockCashbackRepository)
+	mockCashbackRepo.On("ListRunningCampaigns", mock.Anything, mock.Anything).Return([]*entity.CashbackCampaign{campaign}, nil)
+
+	next := &StubEventPublisher{}
+	engine := NewCashbackEngine(mockCashbackRepo, new(MockAccountRepository), new(MockTransactionRepository), new(MockCacheService), next, NewValueDatingPolicy(&StubCalendar{}, vo.ProcessingWindow{}, nil), new(MockLogger))
+
+	// Money coming in never earns cashback, and the campaign only covers DEBIT payments
+	require.NoError(t, engine.Publish(context.Background(), event.NewTransactionEvent(event.TransactionCompleted, credit)))
+	require.NoError(t, engine.Publish(context.Background(), event.NewTransactionEvent(event.TransactionCompleted, transfer)))
+
+	assert.Len(t, next.Events, 2)
+	mockCashbackRepo.AssertNotCalled(t, "CreateReward", mock.Anything, mock.Anything)
+}
+
+func TestCashbackUseCase_GetAccountCashback(t *testing.T) {
+	account := createTestAccount()
+	campaign := createTestCampaign(t)
+	credit, err := entity.NewCreditTransaction(account.ID, vo.NewMoneyFromFloat(5), "Cashback: Summer", campaign.Reference(), time.Now())
+	require.NoError(t, err)
+	reward := entity.NewCashbackReward(campaign, vo.NewTransactionID(), credit)
+
+	mockCashbackRepo := new(MockCashbackRepository)
+	mockAccountRepo := new(MockAccountRepository)
+	mockAccountRepo.On("GetByID", mock.Anything, account.ID).Return(account, nil)
+	mockCashbackRepo.On("ListRewardsByAccountID", mock.Anything, account.ID, 10, 10).Return([]*entity.CashbackReward{reward}, nil)
+	mockCashbackRepo.On("CountRewardsByAccountID", mock.Anything, account.ID).Return(int64(11), nil)
+	mockCashbackRepo.On("SumRewardsByAccountID", mock.Anything, account.ID).Return(vo.NewMoneyFromFloat(42.5), nil)
+
+	uc := NewCashbackUseCase(mockCashbackRepo, mockAccountRepo, new(MockLogger))
+
+	result, err := uc.GetAccountCashback(context.Background(), account.ID.String(), dto.ListRequest{Page: 2, PageSize: 10})
+
+	require.NoError(t, err)
+	assert.Equal(t, 42.5, result.Total)
+	require.Len(t, result.Rewards, 1)
+	assert.Equal(t, campaign.ID, result.Rewards[0].CampaignID)
+	assert.Equal(t, credit.ID.String(), result.Rewards[0].CreditTransactionID)
+	assert.Equal(t, int64(11), result.Pagination.TotalItems)
+}
@@ -0,0 +1,464 @@
+// internal/application/category.go
+package usecase
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/application/dto"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/infra"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/repository"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+)
+
+// maxCategoryDepth bounds how deep the category taxonomy may nest
+const maxCategoryDepth = 10
+
+// Categorizer assigns categories to transactions from the rule set and resolves
+// the per-account overrides account holders set on them
+type Categorizer struct {
+	categoryRepo repository.CategoryRepository
+	overrideRepo repository.CategoryOverrideRepository
+	logger       infra.Logger
+}
+
+// NewCategorizer creates a new transaction categorizer
+func NewCategorizer(
+	categoryRepo repository.CategoryRepository,
+	overrideRepo repository.CategoryOverrideRepository,
+	logger infra.Logger,
+) *Categorizer {
+	return &Categorizer{
+		categoryRepo: categoryRepo,
+		overrideRepo: overrideRepo,
+		logger:       logger,
+	}
+}
+
+// Categorize assigns the category of the first matching rule. Categorization never
+// blocks a payment: when rules cannot be loaded the transaction stays uncategorized.
+func (c *Categorizer) Categorize(ctx context.Context, transaction *entity.Transaction) {
+	rules, err := c.categoryRepo.ListRules(ctx)
+	if err != nil {
+		c.logger.Warn("Failed to load category rules", "error", err, "transactionID", transaction.ID.String())
+		transaction.Categorize(entity.CategoryUncategorized)
+		return
+	}
+
+	transaction.Categorize(entity.CategorizeTransaction(transaction, rules))
+}
+
+// Overrides returns the account's category overrides keyed by transaction ID
+func (c *Categorizer) Overrides(ctx context.Context, accountID vo.AccountID, transactionIDs []vo.TransactionID) (map[string]string, error) {
+	overrides, err := c.overrideRepo.ListByTransactionIDs(ctx, accountID, transactionIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	categories := make(map[string]string, len(overrides))
+	for _, override := range overrides {
+		categories[override.TransactionID.String()] = override.CategoryCode
+	}
+
+	return categories, nil
+}
+
+// ApplyOverrides replaces the categories of listed transactions with the account's overrides
+func (c *Categorizer) ApplyOverrides(ctx context.Context, accountID vo.AccountID, transactions []dto.TransactionResponse) {
+	transactionIDs := make([]vo.TransactionID, 0, len(transactions))
+	for _, transaction := range transactions {
+		transactionID, err := vo.NewTransactionIDFromString(transaction.ID)
+		if err != nil {
+			continue
+		}
+		transactionIDs = append(transactionIDs, transactionID)
+	}
+
+	overrides, err := c.Overrides(ctx, accountID, transactionIDs)
+	if err != nil {
+		c.logger.Warn("Failed to load category overrides", "error", err, "accountID", accountID.String())
+		return
+	}
+
+	for i := range transactions {
+		if category, ok := overrides[transactions[i].ID]; ok {
+			transactions[i].Category = category
+		}
+	}
+}
+
+// categoryTotals is the completed money movement of an account in one category
+type categoryTotals struct {
+	inflow  vo.Money
+	outflow vo.Money
+	count   int
+}
+
+// totals sums the transactions' effect on the account per effective category
+func (c *Categorizer) totals(ctx context.Context, accountID vo.AccountID, transactions []*entity.Transaction) (map[string]*categoryTotals, error) {
+	transactionIDs := make([]vo.TransactionID, len(transactions))
+	for i, transaction := range transactions {
+		transactionIDs[i] = transaction.ID
+	}
+
+	overrides, err := c.Overrides(ctx, accountID, transactionIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	byCategory := make(map[string]*categoryTotals)
+	for _, transaction := range transactions {
+		category := transaction.Category
+		if override, ok := overrides[transaction.ID.String()]; ok {
+			category = override
+		}
+
+		total, ok := byCategory[category]
+		if !ok {
+			total = &categoryTotals{inflow: vo.ZeroMoney(), outflow: vo.ZeroMoney()}
+			byCategory[category] = total
+		}
+
+		effect := transaction.BalanceEffect(accountID)
+		if effect.IsNegative() {
+			total.outflow, _ = total.outflow.Subtract(effect)
+		} else {
+			total.inflow, _ = total.inflow.Add(effect)
+		}
+		total.count++
+	}
+
+	return byCategory, nil
+}
+
+type categoryUseCase struct {
+	categoryRepo    repository.CategoryRepository
+	overrideRepo    repository.CategoryOverrideRepository
+	transactionRepo repository.TransactionRepository
+	categorizer     *Categorizer
+	valueDating     *ValueDatingPolicy
+	logger          infra.Logger
+	mapper          *dto.CategoryMapper
+}
+
+// NewCategoryUseCase creates a new category use case
+func NewCategoryUseCase(
+	categoryRepo repository.CategoryRepository,
+	overrideRepo repository.CategoryOverrideRepository,
+	transactionRepo repository.TransactionRepository,
+	valueDating *ValueDatingPolicy,
+	logger infra.Logger,
+) CategoryUseCase {
+	return &categoryUseCase{
+		categoryRepo:    categoryRepo,
+		overrideRepo:    overrideRepo,
+		transactionRepo: transactionRepo,
+		categorizer:     NewCategorizer(categoryRepo, overrideRepo, logger),
+		valueDating:     valueDating,
+		logger:          logger,
+		mapper:          &dto.CategoryMapper{},
+	}
+}
+
+// CreateCategory adds a category to the taxonomy
+func (uc *categoryUseCase) CreateCategory(ctx context.Context, req dto.CreateCategoryRequest) (*dto.CategoryResponse, error) {
+	category, err := entity.NewCategory(req.Code, req.Name, req.ParentCode)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.validateParent(ctx, category); err != nil {
+		return nil, err
+	}
+
+	if err := uc.categoryRepo.Create(ctx, category); err != nil {
+		uc.logger.Error("Failed to create category", "error", err, "code", category.Code)
+		return nil, err
+	}
+
+	uc.logger.Info("Category created", "code", category.Code, "parentCode", category.ParentCode)
+	response := uc.mapper.ToResponse(category)
+	return &response, nil
+}
+
+// UpdateCategory renames a category or moves it under another parent
+func (uc *categoryUseCase) UpdateCategory(ctx context.Context, req dto.UpdateCategoryRequest) (*dto.CategoryResponse, error) {
+	category, err := uc.categoryRepo.GetByCode(ctx, entity.NormalizeCategoryCode(req.Code))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := category.Update(req.Name, req.ParentCode); err != nil {
+		return nil, err
+	}
+
+	if err := uc.validateParent(ctx, category); err != nil {
+		return nil, err
+	}
+
+	if err := uc.categoryRepo.Update(ctx, category); err != nil {
+		uc.logger.Error("Failed to update category", "error", err, "code", category.Code)
+		return nil, err
+	}
+
+	uc.logger.Info("Category updated", "code", category.Code, "parentCode", category.ParentCode)
+	response := uc.mapper.ToResponse(category)
+	return &response, nil
+}
+
+// DeleteCategory removes a category that has no subcategories or rules
+func (uc *categoryUseCase) DeleteCategory(ctx context.Context, code string) error {
+	code = entity.NormalizeCategoryCode(code)
+	if _, err := uc.categoryRepo.GetByCode(ctx, code); err != nil {
+		return err
+	}
+
+	categories, err := uc.categoryRepo.List(ctx)
+	if err != nil {
+		return err
+	}
+	for _, category := range categories {
+		if category.ParentCode == code {
+			return errs.ErrCategoryInUse
+		}
+	}
+
+	rules, err := uc.categoryRepo.ListRules(ctx)
+	if err != nil {
+		return err
+	}
+	for _, rule := range rules {
+		if rule.CategoryCode == code {
+			return errs.ErrCategoryInUse
+		}
+	}
+
+	if err := uc.categoryRepo.Delete(ctx, code); err != nil {
+		uc.logger.Error("Failed to delete category", "error", err, "code", code)
+		return err
+	}
+
+	uc.logger.Info("Category deleted", "code", code)
+	return nil
+}
+
+// ListCategories retrieves the category taxonomy
+func (uc *categoryUseCase) ListCategories(ctx context.Context) (*dto.CategoryListResponse, error) {
+	categories, err := uc.categoryRepo.List(ctx)
+	if err != nil {
+		uc.logger.Error("Failed to list categories", "error", err)
+		return nil, err
+	}
+
+	responses := make([]dto.CategoryResponse, len(categories))
+	for i, category := range categories {
+		responses[i] = uc.mapper.ToResponse(category)
+	}
+
+	return &dto.CategoryListResponse{Categories: responses}, nil
+}
+
+// CreateRule adds a categorization rule
+func (uc *categoryUseCase) CreateRule(ctx context.Context, req dto.CreateCategoryRuleRequest) (*dto.CategoryRuleResponse, error) {
+	rule, err := entity.NewCategoryRule(req.CategoryCode, entity.CategoryRuleField(req.Field), req.Pattern, req.Priority)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := uc.categoryRepo.GetByCode(ctx, rule.CategoryCode); err != nil {
+		return nil, err
+	}
+
+	if err := uc.categoryRepo.CreateRule(ctx, rule); err != nil {
+		uc.logger.Error("Failed to create category rule", "error", err, "categoryCode", rule.CategoryCode)
+		return nil, err
+	}
+
+	uc.logger.Info("Category rule created", "ruleID", rule.ID, "categoryCode", rule.CategoryCode, "field", rule.Field)
+	response := uc.mapper.ToRuleResponse(rule)
+	return &response, nil
+}
+
+// DeleteRule removes a categorization rule
+func (uc *categoryUseCase) DeleteRule(ctx context.Context, id string) error {
+	if err := uc.categoryRepo.DeleteRule(ctx, id); err != nil {
+		uc.logger.Error("Failed to delete category rule", "error", err, "ruleID", id)
+		return err
+	}
+
+	uc.logger.Info("Category rule deleted", "ruleID", id)
+	return nil
+}
+
+// ListRules retrieves the categorization rules in evaluation order
+func (uc *categoryUseCase) ListRules(ctx context.Context) (*dto.CategoryRuleListResponse, error) {
+	rules, err := uc.categoryRepo.ListRules(ctx)
+	if err != nil {
+		uc.logger.Error("Failed to list category rules", "error", err)
+		return nil, err
+	}
+
+	responses := make([]dto.CategoryRuleResponse, len(rules))
+	for i, rule := range rules {
+		responses[i] = uc.mapper.ToRuleResponse(rule)
+	}
+
+	return &dto.CategoryRuleListResponse{Rules: responses}, nil
+}
+
+// SetTransactionCategory overrides the category of a transaction for one of its accounts
+func (uc *categoryUseCase) SetTransactionCategory(ctx context.Context, req dto.SetTransactionCategoryRequest) error {
+	accountID, transactionID, err := uc.accountTransaction(ctx, req.AccountID, req.TransactionID)
+	if err != nil {
+		return err
+	}
+
+	categoryCode := entity.NormalizeCategoryCode(req.CategoryCode)
+	if categoryCode != entity.CategoryUncategorized {
+		if _, err := uc.categoryRepo.GetByCode(ctx, categoryCode); err != nil {
+			return err
+		}
+	}
+
+	override := entity.NewCategoryOverride(accountID, transactionID, categoryCode)
+	if err := uc.overrideRepo.Save(ctx, override); err != nil {
+		uc.logger.Error("Failed to save category override", "error", err, "accountID", req.AccountID, "transactionID", req.TransactionID)
+		return err
+	}
+
+	uc.logger.Info("Transaction category overridden", "accountID", req.AccountID, "transactionID", req.TransactionID, "categoryCode", categoryCode)
+	return nil
+}
+
+// ClearTransactionCategory removes an account's category override so the rule-based category applies again
+func (uc *categoryUseCase) ClearTransactionCategory(ctx context.Context, accountID, transactionID string) error {
+	parsedAccountID, parsedTransactionID, err := uc.accountTransaction(ctx, accountID, transactionID)
+	if err != nil {
+		return err
+	}
+
+	if err := uc.overrideRepo.Delete(ctx, parsedAccountID, parsedTransactionID); err != nil {
+		uc.logger.Error("Failed to delete category override", "error", err, "accountID", accountID, "transactionID", transactionID)
+		return err
+	}
+
+	uc.logger.Info("Transaction category override cleared", "accountID", accountID, "transactionID", transactionID)
+	return nil
+}
+
+// GetCategorySummary totals an account's completed money movement per category
+func (uc *categoryUseCase) GetCategorySummary(ctx context.Context, req dto.CategorySummaryRequest) (*dto.CategorySummaryResponse, error) {
+	accountID, err := vo.NewAccountIDFromString(req.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	to := uc.valueDating.Today()
+	from := time.Date(to.Year(), to.Month(), 1, 0, 0, 0, 0, time.UTC)
+	if req.From != "" {
+		if from, err = parseDate("from", req.From); err != nil {
+			return nil, err
+		}
+	}
+	if req.To != "" {
+		if to, err = parseDate("to", req.To); err != nil {
+			return nil, err
+		}
+	}
+	if to.Before(from) {
+		return nil, errs.ValidationError{Field: "to", Message: "to must not be before from"}
+	}
+
+	transactions, err := uc.transactionRepo.GetCompletedByAccountIDSince(ctx, accountID, from)
+	if err != nil {
+		uc.logger.Error("Failed to get completed transactions", "error", err, "accountID", req.AccountID)
+		return nil, err
+	}
+
+	// Only transactions completed up to the end of the last day count
+	until := to.AddDate(0, 0, 1)
+	inRange := make([]*entity.Transaction, 0, len(transactions))
+	for _, transaction := range transactions {
+		if transaction.CompletedAt != nil && transaction.CompletedAt.Before(until) {
+			inRange = append(inRange, transaction)
+		}
+	}
+
+	byCategory, err := uc.categorizer.totals(ctx, accountID, inRange)
+	if err != nil {
+		uc.logger.Error("Failed to load category overrides", "error", err, "accountID", req.AccountID)
+		return nil, err
+	}
+
+	categories := make([]dto.CategoryTotal, 0, len(byCategory))
+	for category, total := range byCategory {
+		categories = append(categories, dto.CategoryTotal{
+			Category: category,
+			Inflow:   total.inflow.Amount().InexactFloat64(),
+			Outflow:  total.outflow.Amount().InexactFloat64(),
+			Count:    total.count,
+		})
+	}
+	sort.Slice(categories, func(i, j int) bool {
+		return categories[i].Category < categories[j].Category
+	})
+
+	return &dto.CategorySummaryResponse{
+		AccountID:  accountID.String(),
+		From:       from.Format(dateLayout),
+		To:         to.Format(dateLayout),
+		Categories: categories,
+		Channels:   channelTotals(accountID, inRange),
+	}, nil
+}
+
+// validateParent checks that a category's parent exists and that nesting it there does not form a cycle
+func (uc *categoryUseCase) validateParent(ctx context.Context, category *entity.Category) error {
+	parentCode := category.ParentCode
+	for depth := 0; parentCode != ""; depth++ {
+		if parentCode == category.Code || depth > maxCategoryDepth {
+			return errs.ValidationError{Field: "parentCode", Message: "category hierarchy cannot contain cycles"}
+		}
+
+		parent, err := uc.categoryRepo.GetByCode(ctx, parentCode)
+		if err != nil {
+			if errors.Is(err, errs.ErrCategoryNotFound) {
+				return errs.ValidationError{Field: "parentCode", Message: "parent category not found: " + parentCode}
+			}
+			return err
+		}
+		parentCode = parent.ParentCode
+	}
+
+	return nil
+}
+
+// accountTransaction parses the IDs and checks that the transaction involves the account
+func (uc *categoryUseCase) accountTransaction(ctx context.Context, accountID, transactionID string) (vo.AccountID, vo.TransactionID, error) {
+	parsedAccountID, err := vo.NewAccountIDFromString(accountID)
+	if err != nil {
+		return vo.AccountID{}, vo.TransactionID{}, err
+	}
+
+	parsedTransactionID, err := vo.NewTransactionIDFromString(transactionID)
+	if err != nil {
+		return vo.AccountID{}, vo.TransactionID{}, err
+	}
+
+	transaction, err := uc.transactionRepo.GetByID(ctx, parsedTransactionID)
+	if err != nil {
+		return vo.AccountID{}, vo.TransactionID{}, err
+	}
+
+	involved := (transaction.FromAccountID != nil && transaction.FromAccountID.String() == parsedAccountID.String()) ||
+		(transaction.ToAccountID != nil && transaction.ToAccountID.String() == parsedAccountID.String())
+	if !involved {
+		return vo.AccountID{}, vo.TransactionID{}, errs.ErrTransactionNotFound
+	}
+
+	return parsedAccountID, parsedTransactionID, nil
+}
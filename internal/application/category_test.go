@@ -0,0 +1,242 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/application/dto"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type MockCategoryRepository struct {
+	mock.Mock
+}
+
+func (m *MockCategoryRepository) Create(ctx context.Context, category *entity.Category) error {
+	args := m.Called(ctx, category)
+	return args.Error(0)
+}
+
+func (m *MockCategoryRepository) GetByCode(ctx context.Context, code string) (*entity.Category, error) {
+	args := m.Called(ctx, code)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.Category), args.Error(1)
+}
+
+func (m *MockCategoryRepository) Update(ctx context.Context, category *entity.Category) error {
+	args := m.Called(ctx, category)
+	return args.Error(0)
+}
+
+func (m *MockCategoryRepository) Delete(ctx context.Context, code string) error {
+	args := m.Called(ctx, code)
+	return args.Error(0)
+}
+
+func (m *MockCategoryRepository) List(ctx context.Context) ([]*entity.Category, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]*entity.Category), args.Error(1)
+}
+
+func (m *MockCategoryRepository) CreateRule(ctx context.Context, rule *entity.CategoryRule) error {
+	args := m.Called(ctx, rule)
+	return args.Error(0)
+}
+
+func (m *MockCategoryRepository) DeleteRule(ctx context.Context, id string) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockCategoryRepository) ListRules(ctx context.Context) ([]*entity.CategoryRule, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.CategoryRule), args.Error(1)
+}
+
+type MockCategoryOverrideRepository struct {
+	mock.Mock
+}
+
+func (m *MockCategoryOverrideRepository) Save(ctx context.Context, override *entity.CategoryOverride) error {
+	args := m.Called(ctx, override)
+	return args.Error(0)
+}
+
+func (m *MockCategoryOverrideRepository) Delete(ctx context.Context, accountID vo.AccountID, transactionID vo.TransactionID) error {
+	args := m.Called(ctx, accountID, transactionID)
+	return args.Error(0)
+}
+
+func (m *MockCategoryOverrideRepository) ListByTransactionIDs(ctx context.Context, accountID vo.AccountID, transactionIDs []vo.TransactionID) ([]*entity.CategoryOverride, error) {
+	args := m.Called(ctx, accountID, transactionIDs)
+	return args.Get(0).([]*entity.CategoryOverride), args.Error(1)
+}
+
+func TestCategorizer_Categorize(t *testing.T) {
+	rule, err := entity.NewCategoryRule("GROCERIES", entity.CategoryRuleFieldMerchant, "supermart", 0)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		merchant string
+		rules    []*entity.CategoryRule
+		rulesErr error
+		expected string
+	}{
+		{
+			name:     "matching_rule",
+			merchant: "SuperMart Bangkok",
+			rules:    []*entity.CategoryRule{rule},
+			expected: "GROCERIES",
+		},
+		{
+			name:     "no_matching_rule",
+			merchant: "Cinema",
+			rules:    []*entity.CategoryRule{rule},
+			expected: entity.CategoryUncategorized,
+		},
+		{
+			name:     "rules_unavailable",
+			merchant: "SuperMart Bangkok",
+			rulesErr: errors.New("database unavailable"),
+			expected: entity.CategoryUncategorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCategoryRepo := new(MockCategoryRepository)
+			mockLogger := new(MockLogger)
+			mockCategoryRepo.On("ListRules", mock.Anything).Return(tt.rules, tt.rulesErr)
+			mockLogger.On("Warn", mock.Anything, mock.Anything).Maybe()
+
+			transaction, err := entity.NewDebitTransaction(vo.NewAccountID(), vo.NewMoneyFromFloat(10), "Card payment", "", time.Now())
+			require.NoError(t, err)
+			transaction.Merchant = tt.merchant
+
+			NewCategorizer(mockCategoryRepo, nil, mockLogger).Categorize(context.Background(), transaction)
+
+			assert.Equal(t, tt.expected, transaction.Category)
+		})
+	}
+}
+
+func TestCategoryUseCase_SetTransactionCategory(t *testing.T) {
+	account := createTestAccount()
+	transaction, err := entity.NewDebitTransaction(account.ID, vo.NewMoneyFromFloat(10), "Card payment", "", time.Now())
+	require.NoError(t, err)
+
+	tests := []struct {
+		name          string
+		accountID     vo.AccountID
+		categoryCode  string
+		setupMocks    func(*MockCategoryRepository, *MockCategoryOverrideRepository)
+		expectedError error
+	}{
+		{
+			name:         "success",
+			accountID:    account.ID,
+			categoryCode: "dining",
+			setupMocks: func(categoryRepo *MockCategoryRepository, overrideRepo *MockCategoryOverrideRepository) {
+				categoryRepo.On("GetByCode", mock.Anything, "DINING").Return(&entity.Category{Code: "DINING"}, nil)
+				overrideRepo.On("Save", mock.Anything, mock.MatchedBy(func(override *entity.CategoryOverride) bool {
+					return override.CategoryCode == "DINING" && override.TransactionID.String() == transaction.ID.String()
+				})).Return(nil)
+			},
+		},
+		{
+			name:          "fail_transaction_of_another_account",
+			accountID:     vo.NewAccountID(),
+			categoryCode:  "DINING",
+			setupMocks:    func(*MockCategoryRepository, *MockCategoryOverrideRepository) {},
+			expectedError: errs.ErrTransactionNotFound,
+		},
+		{
+			name:         "fail_unknown_category",
+			accountID:    account.ID,
+			categoryCode: "UNKNOWN",
+			setupMocks: func(categoryRepo *MockCategoryRepository, overrideRepo *MockCategoryOverrideRepository) {
+				categoryRepo.On("GetByCode", mock.Anything, "UNKNOWN").Return(nil, errs.ErrCategoryNotFound)
+			},
+			expectedError: errs.ErrCategoryNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCategoryRepo := new(MockCategoryRepository)
+			mockOverrideRepo := new(MockCategoryOverrideRepository)
+			mockTxnRepo := new(MockTransactionRepository)
+			mockLogger := new(MockLogger)
+			mockLogger.On("Info", mock.Anything, mock.Anything).Maybe()
+			mockLogger.On("Error", mock.Anything, mock.Anything).Maybe()
+			mockTxnRepo.On("GetByID", mock.Anything, transaction.ID).Return(transaction, nil)
+			tt.setupMocks(mockCategoryRepo, mockOverrideRepo)
+
+			uc := NewCategoryUseCase(mockCategoryRepo, mockOverrideRepo, mockTxnRepo, NewValueDatingPolicy(&StubCalendar{}, vo.ProcessingWindow{}, nil), mockLogger)
+			err := uc.SetTransactionCategory(context.Background(), dto.SetTransactionCategoryRequest{
+				AccountID:     tt.accountID.String(),
+				TransactionID: transaction.ID.String(),
+				CategoryCode:  tt.categoryCode,
+			})
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+			mockOverrideRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestCategoryUseCase_GetCategorySummary(t *testing.T) {
+	account := createTestAccount()
+	other := vo.NewAccountID()
+
+	groceries, err := entity.NewDebitTransaction(account.ID, vo.NewMoneyFromFloat(40), "Supermarket", "", time.Now())
+	groceries.Categorize("GROCERIES")
+	groceries = completedTransaction(t, groceries, err)
+
+	moreGroceries, err := entity.NewDebitTransaction(account.ID, vo.NewMoneyFromFloat(60), "Supermarket", "", time.Now())
+	moreGroceries.Categorize("GROCERIES")
+	moreGroceries = completedTransaction(t, moreGroceries, err)
+
+	// Categorized by rules as a transfer, overridden by the account holder as rent
+	rent, err := entity.NewTransferTransaction(account.ID, other, vo.NewMoneyFromFloat(500), "Monthly", "", time.Now())
+	rent.Categorize("TRANSFERS")
+	rent = completedTransaction(t, rent, err)
+
+	salary, err := entity.NewCreditTransaction(account.ID, vo.NewMoneyFromFloat(2000), "Payroll", "", time.Now())
+	salary = completedTransaction(t, salary, err)
+
+	mockTxnRepo := new(MockTransactionRepository)
+	mockOverrideRepo := new(MockCategoryOverrideRepository)
+	mockLogger := new(MockLogger)
+	mockTxnRepo.On("GetCompletedByAccountIDSince", mock.Anything, account.ID, mock.Anything).
+		Return([]*entity.Transaction{groceries, moreGroceries, rent, salary}, nil)
+	mockOverrideRepo.On("ListByTransactionIDs", mock.Anything, account.ID, mock.Anything).
+		Return([]*entity.CategoryOverride{entity.NewCategoryOverride(account.ID, rent.ID, "RENT")}, nil)
+
+	uc := NewCategoryUseCase(new(MockCategoryRepository), mockOverrideRepo, mockTxnRepo, NewValueDatingPolicy(&StubCalendar{}, vo.ProcessingWindow{}, nil), mockLogger)
+	response, err := uc.GetCategorySummary(context.Background(), dto.CategorySummaryRequest{AccountID: account.ID.String()})
+
+	require.NoError(t, err)
+	assert.Equal(t, []dto.CategoryTotal{
+		{Category: "GROCERIES", Outflow: 100, Count: 2},
+		{Category: "RENT", Outflow: 500, Count: 1},
+		{Category: entity.CategoryUncategorized, Inflow: 2000, Count: 1},
+	}, response.Categories)
+}
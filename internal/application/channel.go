@@ -0,0 +1,92 @@
+// internal/application/channel.go
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hydr0g3nz/mini_bank/internal/application/dto"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+)
+
+// GetTransactionsByChannel retrieves the transactions initiated through a channel
+func (uc *transactionUseCase) GetTransactionsByChannel(ctx context.Context, channel string, req dto.ListRequest) (*dto.TransactionListResponse, error) {
+	transactionChannel, err := parseChannel(channel)
+	if err != nil {
+		return nil, err
+	}
+
+	offset := (req.Page - 1) * req.PageSize
+
+	transactions, err := uc.transactionRepo.GetByChannel(ctx, transactionChannel, req.PageSize, offset)
+	if err != nil {
+		uc.logger.Error("Failed to get transactions by channel from repository", "error", err, "channel", channel)
+		return nil, err
+	}
+
+	total, err := uc.transactionRepo.CountByChannel(ctx, transactionChannel)
+	if err != nil {
+		uc.logger.Error("Failed to count transactions by channel", "error", err, "channel", channel)
+		return nil, err
+	}
+
+	response := uc.mapper.ToResponseList(transactions, dto.NewPaginationInfo(req.Page, req.PageSize, total))
+	return &response, nil
+}
+
+// checkChannelLimit checks that a single transaction of the amount is within its channel's cap
+func (uc *transactionUseCase) checkChannelLimit(channel vo.TransactionChannel, amount vo.Money) error {
+	if uc.channelLimits.Allows(channel, amount) {
+		return nil
+	}
+
+	limit, _ := uc.channelLimits.Limit(channel)
+	return fmt.Errorf("%w: %s transactions are limited to %s", errs.ErrChannelLimitExceeded, channel, limit.StringFixed(2))
+}
+
+// parseChannel parses a request's channel, defaulting to API
+func parseChannel(channel string) (vo.TransactionChannel, error) {
+	transactionChannel, err := vo.NewTransactionChannel(channel)
+	if err != nil {
+		return "", errs.ValidationError{Field: "channel", Message: err.Error()}
+	}
+	return transactionChannel, nil
+}
+
+// channelTotals totals the money movement of an account per channel the transactions came in through
+func channelTotals(accountID vo.AccountID, transactions []*entity.Transaction) []dto.ChannelTotal {
+	byChannel := make(map[vo.TransactionChannel]*categoryTotals)
+	for _, transaction := range transactions {
+		total, ok := byChannel[transaction.Channel]
+		if !ok {
+			total = &categoryTotals{inflow: vo.ZeroMoney(), outflow: vo.ZeroMoney()}
+			byChannel[transaction.Channel] = total
+		}
+
+		effect := transaction.BalanceEffect(accountID)
+		if effect.IsNegative() {
+			total.outflow, _ = total.outflow.Subtract(effect)
+		} else {
+			total.inflow, _ = total.inflow.Add(effect)
+		}
+		total.count++
+	}
+
+	channels := make([]dto.ChannelTotal, 0, len(byChannel))
+	for channel, total := range byChannel {
+		channels = append(channels, dto.ChannelTotal{
+			Channel: string(channel),
+			Inflow:  total.inflow.Amount().InexactFloat64(),
+			Outflow: total.outflow.Amount().InexactFloat64(),
+			Count:   total.count,
+		})
+	}
+	sort.Slice(channels, func(i, j int) bool {
+		return channels[i].Channel < channels[j].Channel
+	})
+
+	return channels
+}
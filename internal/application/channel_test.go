@@ -0,0 +1,91 @@
+package usecase
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/application/dto"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChannelTotals(t *testing.T) {
+	accountID := vo.NewAccountID()
+
+	withdrawal, err := entity.NewDebitTransaction(accountID, vo.NewMoneyFromFloat(200), "Cash", "", time.Now())
+	require.NoError(t, err)
+	require.NoError(t, withdrawal.SetChannel(vo.TransactionChannelATM))
+	deposit, err := entity.NewCreditTransaction(accountID, vo.NewMoneyFromFloat(500), "Cash", "", time.Now())
+	require.NoError(t, err)
+	require.NoError(t, deposit.SetChannel(vo.TransactionChannelBranch))
+	topUp, err := entity.NewCreditTransaction(accountID, vo.NewMoneyFromFloat(50), "Top up", "", time.Now())
+	require.NoError(t, err)
+	for _, transaction := range []*entity.Transaction{withdrawal, deposit, topUp} {
+		require.NoError(t, transaction.MarkAsCompleted(time.Now()))
+	}
+
+	totals := channelTotals(accountID, []*entity.Transaction{withdrawal, deposit, topUp})
+
+	assert.Equal(t, []dto.ChannelTotal{
+		{Channel: "API", Inflow: 50, Count: 1},
+		{Channel: "ATM", Outflow: 200, Count: 1},
+		{Channel: "BRANCH", Inflow: 500, Count: 1},
+	}, totals)
+}
+
+func (suite *TransactionUseCaseTestSuite) TestCreateTransaction_Channel() {
+	limits, err := vo.NewChannelLimits([]string{"ATM:500"})
+	suite.Require().NoError(err)
+	suite.usecase.(*transactionUseCase).channelLimits = limits
+
+	fromAccountID := suite.testAccount.ID.String()
+	suite.mockAccountRepo.On("GetByID", suite.ctx, suite.testAccount.ID).Return(suite.testAccount, nil)
+	suite.mockTxnRepo.On("Create", suite.ctx, mock.AnythingOfType("*entity.Transaction")).Return(nil)
+	suite.mockCache.On("Set", suite.ctx, mock.AnythingOfType("string"), mock.Anything, 30*time.Minute).Return(nil)
+
+	result, err := suite.usecase.CreateTransaction(suite.ctx, dto.CreateTransactionRequest{
+		FromAccountID:   &fromAccountID,
+		TransactionType: "DEBIT",
+		Amount:          dto.NewDecimalStringFromFloat(500.0),
+		Channel:         "ATM",
+	})
+	suite.Require().NoError(err)
+	assert.Equal(suite.T(), "ATM", result.Channel)
+
+	// The cap applies per transaction on its channel only
+	_, err = suite.usecase.CreateTransaction(suite.ctx, dto.CreateTransactionRequest{
+		FromAccountID:   &fromAccountID,
+		TransactionType: "DEBIT",
+		Amount:          dto.NewDecimalStringFromFloat(500.01),
+		Channel:         "ATM",
+	})
+	assert.ErrorIs(suite.T(), err, errs.ErrChannelLimitExceeded)
+
+	result, err = suite.usecase.CreateTransaction(suite.ctx, dto.CreateTransactionRequest{
+		FromAccountID:   &fromAccountID,
+		TransactionType: "DEBIT",
+		Amount:          dto.NewDecimalStringFromFloat(800.0),
+	})
+	suite.Require().NoError(err)
+	assert.Equal(suite.T(), "API", result.Channel)
+	suite.mockTxnRepo.AssertNumberOfCalls(suite.T(), "Create", 2)
+}
+
+func (suite *TransactionUseCaseTestSuite) TestGetTransactionsByChannel() {
+	suite.Require().NoError(suite.testTransaction.SetChannel(vo.TransactionChannelMobile))
+	suite.mockTxnRepo.On("GetByChannel", suite.ctx, vo.TransactionChannelMobile, 10, 0).Return([]*entity.Transaction{suite.testTransaction}, nil)
+	suite.mockTxnRepo.On("CountByChannel", suite.ctx, vo.TransactionChannelMobile).Return(int64(1), nil)
+
+	result, err := suite.usecase.GetTransactionsByChannel(suite.ctx, "MOBILE", dto.ListRequest{Page: 1, PageSize: 10})
+
+	suite.Require().NoError(err)
+	suite.Require().Len(result.Transactions, 1)
+	assert.Equal(suite.T(), "MOBILE", result.Transactions[0].Channel)
+
+	_, err = suite.usecase.GetTransactionsByChannel(suite.ctx, "FAX", dto.ListRequest{Page: 1, PageSize: 10})
+	assert.IsType(suite.T(), errs.ValidationError{}, err)
+}
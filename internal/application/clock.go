@@ -0,0 +1,59 @@
+// internal/application/clock.go
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/application/dto"
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/infra"
+)
+
+type clockUseCase struct {
+	clock  infra.TestClock
+	logger infra.Logger
+}
+
+// NewClockUseCase creates a new clock use case over a sandbox's test clock
+func NewClockUseCase(clock infra.TestClock, logger infra.Logger) ClockUseCase {
+	return &clockUseCase{
+		clock:  clock,
+		logger: logger,
+	}
+}
+
+// GetClock retrieves the time the test clock shows
+func (uc *clockUseCase) GetClock(ctx context.Context) (*dto.ClockResponse, error) {
+	return uc.response(uc.clock.Now()), nil
+}
+
+// AdvanceClock moves the test clock forward. Value dates, review deadlines and job schedules follow
+// it, so future-dated transactions fall due and overdue reviews are declined by the next sweep.
+func (uc *clockUseCase) AdvanceClock(ctx context.Context, req dto.AdvanceClockRequest) (*dto.ClockResponse, error) {
+	duration, err := time.ParseDuration(req.Duration)
+	if err != nil || duration <= 0 {
+		return nil, errs.ValidationError{Field: "duration", Message: "duration must be positive, such as 36h or 90m"}
+	}
+
+	now, err := uc.clock.Advance(duration)
+	if err != nil {
+		uc.logger.Error("Failed to advance clock", "error", err, "duration", req.Duration)
+		return nil, err
+	}
+
+	uc.logger.Warn("Test clock advanced", "duration", duration.String(), "now", now,
+		"offset", uc.clock.Offset().String(), "requestedBy", req.RequestedBy, "reason", req.Reason)
+	return uc.response(now), nil
+}
+
+// response describes the clock at now
+func (uc *clockUseCase) response(now time.Time) *dto.ClockResponse {
+	offset := uc.clock.Offset()
+	return &dto.ClockResponse{
+		Now:           now,
+		RealNow:       now.Add(-offset),
+		Offset:        offset.String(),
+		OffsetSeconds: int64(offset / time.Second),
+	}
+}
@@ -0,0 +1,74 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/application/dto"
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// StubClock is a test clock stopped at a fixed time until it is advanced
+type StubClock struct {
+	At     time.Time
+	offset time.Duration
+}
+
+func (c *StubClock) Now() time.Time {
+	return c.At.Add(c.offset)
+}
+
+func (c *StubClock) Advance(d time.Duration) (time.Time, error) {
+	if d <= 0 {
+		return time.Time{}, errors.New("clock can only be advanced")
+	}
+	c.offset += d
+	return c.Now(), nil
+}
+
+func (c *StubClock) Offset() time.Duration {
+	return c.offset
+}
+
+func TestClockUseCase_AdvanceClock(t *testing.T) {
+	mockLogger := new(MockLogger)
+	mockLogger.On("Warn", mock.Anything, mock.Anything).Maybe()
+	clock := &StubClock{At: time.Date(2025, 7, 1, 9, 0, 0, 0, time.UTC)}
+	uc := NewClockUseCase(clock, mockLogger)
+
+	result, err := uc.AdvanceClock(context.Background(), dto.AdvanceClockRequest{Duration: "36h", RequestedBy: "alice"})
+
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2025, 7, 2, 21, 0, 0, 0, time.UTC), result.Now)
+	assert.Equal(t, clock.At, result.RealNow)
+	assert.Equal(t, "36h0m0s", result.Offset)
+	assert.Equal(t, int64(36*60*60), result.OffsetSeconds)
+
+	for _, duration := range []string{"tomorrow", "0s", "-1h"} {
+		_, err := uc.AdvanceClock(context.Background(), dto.AdvanceClockRequest{Duration: duration, RequestedBy: "alice"})
+		assert.IsType(t, errs.ValidationError{}, err, duration)
+	}
+	assert.Equal(t, 36*time.Hour, clock.Offset())
+}
+
+func TestValueDatingPolicy_Clock(t *testing.T) {
+	window, err := vo.NewProcessingWindow("17:00", "UTC", []string{"TRANSFER"})
+	require.NoError(t, err)
+	clock := &StubClock{At: time.Date(2025, 7, 1, 18, 0, 0, 0, time.UTC)} // Tuesday, past the cutoff
+	policy := NewValueDatingPolicy(&StubCalendar{}, window, clock)
+
+	valueDate, err := policy.ValueDate(context.Background(), vo.TransactionTypeTransfer)
+	require.NoError(t, err)
+	assert.Equal(t, "2025-07-02", valueDate.Format("2006-01-02"))
+
+	// A day later the transfer is due
+	_, err = clock.Advance(24 * time.Hour)
+	require.NoError(t, err)
+	assert.Equal(t, "2025-07-02", policy.Today().Format("2006-01-02"))
+}
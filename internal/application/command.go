@@ -0,0 +1,97 @@
+// internal/application/command.go
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/application/dto"
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/infra"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+)
+
+// commandIdempotencyTTL matches the confirmation idempotency window
+const commandIdempotencyTTL = 24 * time.Hour
+
+type commandUseCase struct {
+	transactionUseCase TransactionUseCase
+	cache              infra.CacheService
+	logger             infra.Logger
+}
+
+// NewCommandUseCase creates a new command use case
+func NewCommandUseCase(
+	transactionUseCase TransactionUseCase,
+	cache infra.CacheService,
+	logger infra.Logger,
+) CommandUseCase {
+	return &commandUseCase{
+		transactionUseCase: transactionUseCase,
+		cache:              cache,
+		logger:             logger,
+	}
+}
+
+// ExecuteTransfer creates and confirms a transfer (Idempotent on command ID)
+func (uc *commandUseCase) ExecuteTransfer(ctx context.Context, cmd dto.TransferCommand) (*dto.TransactionResponse, error) {
+	uc.logger.Info("Executing transfer command", "commandID", cmd.CommandID)
+
+	// Check if this command has already been processed (idempotency check)
+	resultKey := fmt.Sprintf("transfer_command:%s", cmd.CommandID)
+	var cachedResult dto.TransactionResponse
+	if err := uc.cache.Get(ctx, resultKey, &cachedResult); err == nil {
+		uc.logger.Info("Transfer command already processed (idempotent)", "commandID", cmd.CommandID)
+		return &cachedResult, nil
+	}
+
+	// A redelivered command resumes the transaction it created instead of creating another one
+	transactionKey := fmt.Sprintf("transfer_command:%s:transaction", cmd.CommandID)
+	var transactionID string
+	switch err := uc.cache.Get(ctx, transactionKey, &transactionID); {
+	case err == nil:
+		uc.logger.Info("Resuming transfer command", "commandID", cmd.CommandID, "transactionID", transactionID)
+	case errors.Is(err, infra.ErrCacheMiss):
+		created, err := uc.transactionUseCase.CreateTransaction(ctx, dto.CreateTransactionRequest{
+			FromAccountID:   &cmd.FromAccountID,
+			ToAccountID:     &cmd.ToAccountID,
+			TransactionType: string(vo.TransactionTypeTransfer),
+			Amount:          cmd.Amount,
+			Description:     cmd.Description,
+			Reference:       cmd.Reference,
+		})
+		if err != nil {
+			return nil, err
+		}
+		transactionID = created.ID
+
+		if err := uc.cache.Set(ctx, transactionKey, transactionID, commandIdempotencyTTL); err != nil {
+			uc.logger.Warn("Failed to record command transaction", "error", err, "commandID", cmd.CommandID)
+		}
+	default:
+		// Without knowing whether the command already created a transaction, creating one could transfer twice
+		uc.logger.Error("Failed to look up command transaction", "error", err, "commandID", cmd.CommandID)
+		return nil, fmt.Errorf("%w: %w", errs.ErrTransient, err)
+	}
+
+	// Confirmation is itself idempotent, so retrying it after a partial failure is safe
+	response, err := uc.transactionUseCase.ConfirmTransaction(ctx, dto.ConfirmTransactionRequest{ID: transactionID})
+	if err != nil {
+		return nil, err
+	}
+
+	// A transfer held for review is still undecided; redeliveries report its current state
+	if response.Status == string(vo.TransactionStatusReview) {
+		uc.logger.Info("Transfer command held for review", "commandID", cmd.CommandID, "transactionID", transactionID)
+		return response, nil
+	}
+
+	if err := uc.cache.Set(ctx, resultKey, response, commandIdempotencyTTL); err != nil {
+		uc.logger.Warn("Failed to cache command result", "error", err, "commandID", cmd.CommandID)
+	}
+
+	uc.logger.Info("Transfer command executed successfully", "commandID", cmd.CommandID, "transactionID", transactionID)
+	return response, nil
+}
@@ -0,0 +1,127 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hydr0g3nz/mini_bank/internal/application/dto"
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/infra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockTransactionUseCase struct {
+	TransactionUseCase
+	mock.Mock
+}
+
+func (m *MockTransactionUseCase) CreateTransaction(ctx context.Context, req dto.CreateTransactionRequest) (*dto.TransactionResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.TransactionResponse), args.Error(1)
+}
+
+func (m *MockTransactionUseCase) ConfirmTransaction(ctx context.Context, req dto.ConfirmTransactionRequest) (*dto.TransactionResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.TransactionResponse), args.Error(1)
+}
+
+func TestCommandUseCase_ExecuteTransfer(t *testing.T) {
+	cmd := dto.TransferCommand{
+		CommandID:     "cmd-1",
+		FromAccountID: "2024072912345678",
+		ToAccountID:   "2024072987654321",
+		Amount:        dto.NewDecimalStringFromFloat(100),
+	}
+	resultKey := "transfer_command:cmd-1"
+	transactionKey := "transfer_command:cmd-1:transaction"
+	confirmed := &dto.TransactionResponse{ID: "TXN20240729120000123456", Status: "COMPLETED"}
+
+	tests := []struct {
+		name          string
+		setupMocks    func(*MockTransactionUseCase, *MockCacheService)
+		expectedError error
+	}{
+		{
+			name: "success_new_command",
+			setupMocks: func(txnUC *MockTransactionUseCase, cache *MockCacheService) {
+				cache.On("Get", mock.Anything, resultKey, mock.Anything).Return(infra.ErrCacheMiss)
+				cache.On("Get", mock.Anything, transactionKey, mock.Anything).Return(infra.ErrCacheMiss)
+				txnUC.On("CreateTransaction", mock.Anything, mock.MatchedBy(func(req dto.CreateTransactionRequest) bool {
+					return req.TransactionType == "TRANSFER" && *req.FromAccountID == cmd.FromAccountID && req.Amount == cmd.Amount
+				})).Return(&dto.TransactionResponse{ID: confirmed.ID, Status: "PENDING"}, nil).Once()
+				cache.On("Set", mock.Anything, transactionKey, confirmed.ID, commandIdempotencyTTL).Return(nil)
+				txnUC.On("ConfirmTransaction", mock.Anything, dto.ConfirmTransactionRequest{ID: confirmed.ID}).Return(confirmed, nil).Once()
+				cache.On("Set", mock.Anything, resultKey, confirmed, commandIdempotencyTTL).Return(nil)
+			},
+		},
+		{
+			name: "success_already_processed",
+			setupMocks: func(txnUC *MockTransactionUseCase, cache *MockCacheService) {
+				cache.On("Get", mock.Anything, resultKey, mock.Anything).Return(nil)
+			},
+		},
+		{
+			name: "success_resumes_created_transaction",
+			setupMocks: func(txnUC *MockTransactionUseCase, cache *MockCacheService) {
+				cache.On("Get", mock.Anything, resultKey, mock.Anything).Return(infra.ErrCacheMiss)
+				cache.On("Get", mock.Anything, transactionKey, mock.Anything).
+					Run(func(args mock.Arguments) { *args.Get(2).(*string) = confirmed.ID }).
+					Return(nil)
+				txnUC.On("ConfirmTransaction", mock.Anything, dto.ConfirmTransactionRequest{ID: confirmed.ID}).Return(confirmed, nil).Once()
+				cache.On("Set", mock.Anything, resultKey, confirmed, commandIdempotencyTTL).Return(nil)
+			},
+		},
+		{
+			name: "fail_insufficient_balance",
+			setupMocks: func(txnUC *MockTransactionUseCase, cache *MockCacheService) {
+				cache.On("Get", mock.Anything, resultKey, mock.Anything).Return(infra.ErrCacheMiss)
+				cache.On("Get", mock.Anything, transactionKey, mock.Anything).Return(infra.ErrCacheMiss)
+				txnUC.On("CreateTransaction", mock.Anything, mock.Anything).Return(&dto.TransactionResponse{ID: confirmed.ID}, nil)
+				cache.On("Set", mock.Anything, transactionKey, confirmed.ID, commandIdempotencyTTL).Return(nil)
+				txnUC.On("ConfirmTransaction", mock.Anything, mock.Anything).Return(nil, errs.ErrInsufficientBalance)
+			},
+			expectedError: errs.ErrInsufficientBalance,
+		},
+		{
+			name: "fail_cache_unavailable_creates_nothing",
+			setupMocks: func(txnUC *MockTransactionUseCase, cache *MockCacheService) {
+				cache.On("Get", mock.Anything, resultKey, mock.Anything).Return(errors.New("connection refused"))
+				cache.On("Get", mock.Anything, transactionKey, mock.Anything).Return(errors.New("connection refused"))
+			},
+			expectedError: errs.ErrTransient,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockTxnUC := new(MockTransactionUseCase)
+			mockCache := new(MockCacheService)
+			mockLogger := new(MockLogger)
+			mockLogger.On("Info", mock.Anything, mock.Anything).Return()
+			mockLogger.On("Error", mock.Anything, mock.Anything).Return().Maybe()
+
+			tt.setupMocks(mockTxnUC, mockCache)
+
+			uc := NewCommandUseCase(mockTxnUC, mockCache, mockLogger)
+			response, err := uc.ExecuteTransfer(context.Background(), cmd)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, response)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, response)
+			}
+			mockTxnUC.AssertExpectations(t)
+			mockCache.AssertExpectations(t)
+		})
+	}
+}
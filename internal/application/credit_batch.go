@@ -0,0 +1,178 @@
+// internal/application/credit_batch.go
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/infra"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/repository"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+)
+
+// CreditBatchConfig holds the hot accounts whose credits are batched
+type CreditBatchConfig struct {
+	Accounts []vo.AccountID
+	MaxSize  int           // Most credits applied in one write
+	MaxWait  time.Duration // How long the first credit of a batch waits for others to join it
+}
+
+// CreditBatcher coalesces concurrent credits to hot accounts, such as a merchant's settlement account,
+// into one balance update per batch. Every hot account has its own queue served by one goroutine at a
+// time, so its row is written by a single writer instead of every confirmation contending for it. Each
+// credit is still recorded as applied for its own transaction, which completes as usual.
+type CreditBatcher struct {
+	accountRepo repository.AccountRepository
+	config      CreditBatchConfig
+	queues      map[vo.AccountID]*creditQueue
+	logger      infra.Logger
+}
+
+// creditQueue holds the credits waiting for a hot account
+type creditQueue struct {
+	mu      sync.Mutex
+	pending []*pendingCredit
+	serving bool // A goroutine is draining the queue
+}
+
+// pendingCredit is a credit waiting for its batch to be written
+type pendingCredit struct {
+	ctx         context.Context
+	transaction *entity.Transaction
+	amount      vo.Money
+	done        chan error
+}
+
+// NewCreditBatcher creates a credit batcher for the configured hot accounts
+func NewCreditBatcher(accountRepo repository.AccountRepository, config CreditBatchConfig, logger infra.Logger) *CreditBatcher {
+	if config.MaxSize < 1 {
+		config.MaxSize = 1
+	}
+
+	queues := make(map[vo.AccountID]*creditQueue, len(config.Accounts))
+	for _, accountID := range config.Accounts {
+		queues[accountID] = &creditQueue{}
+	}
+
+	return &CreditBatcher{
+		accountRepo: accountRepo,
+		config:      config,
+		queues:      queues,
+		logger:      logger,
+	}
+}
+
+// Batches reports whether credits to an account are batched
+func (b *CreditBatcher) Batches(accountID vo.AccountID) bool {
+	if b == nil {
+		return false
+	}
+	_, ok := b.queues[accountID]
+	return ok
+}
+
+// Credit queues a transaction's credit to a hot account and waits for the batch it joined to be written.
+// It returns the credit's own outcome; errs.ErrTransactionAlreadyApplied means an earlier attempt
+// applied it. A caller giving up before then gets a retryable error, and the credit may still be applied.
+func (b *CreditBatcher) Credit(ctx context.Context, transaction *entity.Transaction, accountID vo.AccountID, amount vo.Money) error {
+	queue, ok := b.queues[accountID]
+	if !ok {
+		return fmt.Errorf("account %s is not batched", accountID.String())
+	}
+
+	credit := &pendingCredit{ctx: ctx, transaction: transaction, amount: amount, done: make(chan error, 1)}
+
+	queue.mu.Lock()
+	queue.pending = append(queue.pending, credit)
+	if !queue.serving {
+		queue.serving = true
+		go b.serve(accountID, queue)
+	}
+	queue.mu.Unlock()
+
+	select {
+	case err := <-credit.done:
+		return err
+	case <-ctx.Done():
+		return fmt.Errorf("%w: %w", errs.ErrTransient, ctx.Err())
+	}
+}
+
+// serve writes the queue's credits in batches until it is empty
+func (b *CreditBatcher) serve(accountID vo.AccountID, queue *creditQueue) {
+	// Let the credits arriving together with the first one join its batch
+	if b.config.MaxWait > 0 {
+		time.Sleep(b.config.MaxWait)
+	}
+
+	for {
+		queue.mu.Lock()
+		size := min(len(queue.pending), b.config.MaxSize)
+		if size == 0 {
+			queue.serving = false
+			queue.mu.Unlock()
+			return
+		}
+		batch := queue.pending[:size:size]
+		queue.pending = append([]*pendingCredit(nil), queue.pending[size:]...)
+		queue.mu.Unlock()
+
+		b.apply(accountID, batch)
+	}
+}
+
+// apply checks a batch of credits against the account and writes the valid ones at once
+func (b *CreditBatcher) apply(accountID vo.AccountID, batch []*pendingCredit) {
+	// The batch is written on behalf of several callers, so none of them cancelling stops it, nor does
+	// it join the unit of work of any of them
+	ctx := repository.WithoutUnitOfWork(context.WithoutCancel(batch[0].ctx))
+
+	account, err := b.accountRepo.GetByID(ctx, accountID)
+	if err != nil {
+		err = loadAccountError(err)
+		for _, credit := range batch {
+			credit.done <- err
+		}
+		return
+	}
+
+	accepted := make([]*pendingCredit, 0, len(batch))
+	credits := make([]repository.BatchedCredit, 0, len(batch))
+	for _, credit := range batch {
+		if !account.CanTransact() {
+			credit.done <- errs.ErrAccountCannotTransact
+			continue
+		}
+		if err := account.Credit(credit.amount); err != nil {
+			credit.done <- err
+			continue
+		}
+		accepted = append(accepted, credit)
+		credits = append(credits, repository.BatchedCredit{
+			TransactionID: credit.transaction.ID,
+			Token:         credit.transaction.ProcessingToken,
+			Amount:        credit.amount,
+		})
+	}
+	if len(credits) == 0 {
+		return
+	}
+
+	outcomes, err := b.accountRepo.ApplyCredits(ctx, accountID, credits)
+	if err != nil {
+		b.logger.Error("Failed to apply credit batch", "error", err, "accountID", accountID.String(), "credits", len(credits))
+		for _, credit := range accepted {
+			credit.done <- fmt.Errorf("failed to update account %s: %w", accountID.String(), err)
+		}
+		return
+	}
+
+	for i, credit := range accepted {
+		credit.done <- outcomes[i]
+	}
+	b.logger.Debug("Credit batch applied", "accountID", accountID.String(), "credits", len(credits))
+}
@@ -0,0 +1,128 @@
+package usecase
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/repository"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestCreditBatcher(accountRepo *MockAccountRepository, account *entity.Account, maxSize int) *CreditBatcher {
+	mockLogger := new(MockLogger)
+	mockLogger.On("Debug", mock.Anything, mock.Anything).Maybe()
+	mockLogger.On("Error", mock.Anything, mock.Anything).Maybe()
+	return NewCreditBatcher(accountRepo, CreditBatchConfig{
+		Accounts: []vo.AccountID{account.ID},
+		MaxSize:  maxSize,
+		MaxWait:  100 * time.Millisecond,
+	}, mockLogger)
+}
+
+// creditConcurrently credits the account once per amount at the same time and returns each outcome
+func creditConcurrently(t *testing.T, batcher *CreditBatcher, account *entity.Account, amounts ...float64) []error {
+	outcomes := make([]error, len(amounts))
+	var wg sync.WaitGroup
+	for i, amount := range amounts {
+		transaction, err := entity.NewCreditTransaction(account.ID, vo.NewMoneyFromFloat(amount), "Settlement", "", time.Now())
+		require.NoError(t, err)
+		wg.Add(1)
+		go func(i int, transaction *entity.Transaction) {
+			defer wg.Done()
+			outcomes[i] = batcher.Credit(context.Background(), transaction, account.ID, transaction.Amount)
+		}(i, transaction)
+	}
+	wg.Wait()
+	return outcomes
+}
+
+func TestCreditBatcher_CoalescesConcurrentCredits(t *testing.T) {
+	account := createTestAccount()
+	mockAccountRepo := new(MockAccountRepository)
+	mockAccountRepo.On("GetByID", mock.Anything, account.ID).Return(account, nil)
+
+	var batches [][]repository.BatchedCredit
+	mockAccountRepo.On("ApplyCredits", mock.Anything, account.ID, mock.Anything).
+		Run(func(args mock.Arguments) { batches = append(batches, args.Get(2).([]repository.BatchedCredit)) }).
+		Return(make([]error, 5), nil)
+
+	outcomes := creditConcurrently(t, newTestCreditBatcher(mockAccountRepo, account, 50), account, 10, 20, 30, 40, 50)
+
+	for _, err := range outcomes {
+		assert.NoError(t, err)
+	}
+	require.Len(t, batches, 1, "credits arriving together share one write")
+	assert.Len(t, batches[0], 5)
+}
+
+func TestCreditBatcher_SplitsBatchesAtMaxSize(t *testing.T) {
+	account := createTestAccount()
+	mockAccountRepo := new(MockAccountRepository)
+	mockAccountRepo.On("GetByID", mock.Anything, account.ID).Return(account, nil)
+
+	var sizes []int
+	mockAccountRepo.On("ApplyCredits", mock.Anything, account.ID, mock.Anything).
+		Run(func(args mock.Arguments) { sizes = append(sizes, len(args.Get(2).([]repository.BatchedCredit))) }).
+		Return(make([]error, 2), nil).Once()
+	mockAccountRepo.On("ApplyCredits", mock.Anything, account.ID, mock.Anything).
+		Run(func(args mock.Arguments) { sizes = append(sizes, len(args.Get(2).([]repository.BatchedCredit))) }).
+		Return(make([]error, 1), nil).Once()
+
+	outcomes := creditConcurrently(t, newTestCreditBatcher(mockAccountRepo, account, 2), account, 10, 20, 30)
+
+	for _, err := range outcomes {
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, []int{2, 1}, sizes)
+}
+
+func TestCreditBatcher_ReturnsEachCreditsOutcome(t *testing.T) {
+	account := createTestAccount()
+	mockAccountRepo := new(MockAccountRepository)
+	mockAccountRepo.On("GetByID", mock.Anything, account.ID).Return(account, nil)
+	batcher := newTestCreditBatcher(mockAccountRepo, account, 50)
+
+	applied, err := entity.NewCreditTransaction(account.ID, vo.NewMoneyFromFloat(10), "Settlement", "", time.Now())
+	require.NoError(t, err)
+	fresh, err := entity.NewCreditTransaction(account.ID, vo.NewMoneyFromFloat(20), "Settlement", "", time.Now())
+	require.NoError(t, err)
+
+	// The repository reports the outcome of each credit in the order they were passed
+	mockAccountRepo.On("ApplyCredits", mock.Anything, account.ID, mock.Anything).
+		Return([]error{errs.ErrTransactionAlreadyApplied}, nil).Once()
+	assert.ErrorIs(t, batcher.Credit(context.Background(), applied, account.ID, applied.Amount), errs.ErrTransactionAlreadyApplied)
+
+	mockAccountRepo.On("ApplyCredits", mock.Anything, account.ID, mock.Anything).
+		Return([]error{nil}, nil).Once()
+	assert.NoError(t, batcher.Credit(context.Background(), fresh, account.ID, fresh.Amount))
+
+	// Credits to a suspended account are rejected without a write
+	require.NoError(t, account.Suspend())
+	assert.ErrorIs(t, batcher.Credit(context.Background(), fresh, account.ID, fresh.Amount), errs.ErrAccountCannotTransact)
+	mockAccountRepo.AssertNumberOfCalls(t, "ApplyCredits", 2)
+}
+
+func TestTransactionUseCase_CreditToHotAccountIsBatched(t *testing.T) {
+	account := createTestAccount()
+	mockAccountRepo := new(MockAccountRepository)
+	mockLogger := new(MockLogger)
+	mockLogger.On("Info", mock.Anything, mock.Anything).Maybe()
+	mockAccountRepo.On("GetByID", mock.Anything, account.ID).Return(account, nil)
+	mockAccountRepo.On("ApplyCredits", mock.Anything, account.ID, mock.Anything).Return([]error{errs.ErrTransactionAlreadyApplied}, nil)
+
+	batcher := newTestCreditBatcher(mockAccountRepo, account, 50)
+	uc := NewTransactionUseCase(nil, mockAccountRepo, nil, nil, nil, nil, nil, nil, &StubEventPublisher{}, nil, NewValueDatingPolicy(&StubCalendar{}, vo.ProcessingWindow{}, nil), nil, NewCategorizer(nil, nil, mockLogger), nil, nil, batcher, nil, nil, nil, nil, nil, nil, nil, "THB", mockLogger).(*transactionUseCase)
+	transaction, err := entity.NewCreditTransaction(account.ID, vo.NewMoneyFromFloat(100), "Settlement", "", time.Now())
+	require.NoError(t, err)
+
+	// A credit an earlier attempt applied completes without being applied again
+	require.NoError(t, uc.processCreditTransaction(context.Background(), transaction))
+	mockAccountRepo.AssertNotCalled(t, "UpdateFenced", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
@@ -0,0 +1,71 @@
+// internal/application/customer.go
+package usecase
+
+import (
+	"context"
+
+	"github.com/hydr0g3nz/mini_bank/internal/application/dto"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/infra"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/repository"
+)
+
+type customerUseCase struct {
+	customerRepo repository.CustomerRepository
+	currency     string
+	valueDating  *ValueDatingPolicy
+	logger       infra.Logger
+	mapper       *dto.TransactionMapper
+}
+
+// NewCustomerUseCase creates a new customer use case; balances of accounts stored without a
+// currency are reported in currency, the service currency
+func NewCustomerUseCase(customerRepo repository.CustomerRepository, currency string, valueDating *ValueDatingPolicy, logger infra.Logger) CustomerUseCase {
+	return &customerUseCase{
+		customerRepo: customerRepo,
+		currency:     currency,
+		valueDating:  valueDating,
+		logger:       logger,
+		mapper:       &dto.TransactionMapper{},
+	}
+}
+
+// GetCustomerSummary aggregates balances, recent activity and pending transactions
+// across all accounts of a customer
+func (uc *customerUseCase) GetCustomerSummary(ctx context.Context, req dto.CustomerSummaryRequest) (*dto.CustomerSummaryResponse, error) {
+	summary, err := uc.customerRepo.GetSummary(ctx, req.CustomerID, req.Limit)
+	if err != nil {
+		uc.logger.Error("Failed to get customer summary", "error", err, "customerID", req.CustomerID)
+		return nil, err
+	}
+
+	balances := make([]dto.CurrencyBalance, len(summary.Balances))
+	for i, balance := range summary.Balances {
+		currency := balance.Total.Currency().String()
+		if currency == "" {
+			currency = uc.currency
+		}
+		balances[i] = dto.CurrencyBalance{
+			Currency:     currency,
+			Total:        balance.Total.Amount().InexactFloat64(),
+			AccountCount: balance.AccountCount,
+		}
+	}
+
+	return &dto.CustomerSummaryResponse{
+		CustomerID:          summary.CustomerID,
+		AccountCount:        summary.AccountCount,
+		Balances:            balances,
+		RecentActivity:      uc.toResponses(summary.RecentTransactions),
+		PendingTransactions: uc.toResponses(summary.PendingTransactions),
+		GeneratedAt:         uc.valueDating.Now(),
+	}, nil
+}
+
+func (uc *customerUseCase) toResponses(transactions []*entity.Transaction) []dto.TransactionResponse {
+	responses := make([]dto.TransactionResponse, len(transactions))
+	for i, transaction := range transactions {
+		responses[i] = uc.mapper.ToResponse(transaction)
+	}
+	return responses
+}
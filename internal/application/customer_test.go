@@ -0,0 +1,73 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/application/dto"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type MockCustomerRepository struct {
+	mock.Mock
+}
+
+func (m *MockCustomerRepository) GetSummary(ctx context.Context, customerID string, limit int) (*entity.CustomerSummary, error) {
+	args := m.Called(ctx, customerID, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.CustomerSummary), args.Error(1)
+}
+
+func (m *MockCustomerRepository) Exists(ctx context.Context, customerID string) (bool, error) {
+	args := m.Called(ctx, customerID)
+	return args.Bool(0), args.Error(1)
+}
+
+func TestCustomerUseCase_GetCustomerSummary(t *testing.T) {
+	ctx := context.Background()
+	mockLogger := new(MockLogger)
+	mockLogger.On("Error", mock.Anything, mock.Anything).Maybe()
+
+	pending, err := entity.NewTransferTransaction(vo.NewAccountID(), vo.NewAccountID(), vo.NewMoneyFromFloat(50), "Move", "", time.Now())
+	require.NoError(t, err)
+
+	repo := new(MockCustomerRepository)
+	repo.On("GetSummary", ctx, "CUST001", 5).Return(&entity.CustomerSummary{
+		CustomerID:   "CUST001",
+		AccountCount: 3,
+		Balances: []entity.CustomerBalance{
+			{Total: vo.NewMoneyFromFloat(1250.50), AccountCount: 2}, // Stored before accounts carried a currency
+			{Total: vo.NewMoneyFromFloat(80).In("USD"), AccountCount: 1},
+		},
+		RecentTransactions:  []*entity.Transaction{pending},
+		PendingTransactions: []*entity.Transaction{pending},
+	}, nil)
+	repo.On("GetSummary", ctx, "UNKNOWN", 5).Return(nil, errs.ErrCustomerNotFound)
+
+	clock := &StubClock{At: time.Date(2024, 5, 17, 9, 0, 0, 0, time.UTC)}
+	uc := NewCustomerUseCase(repo, "THB", NewValueDatingPolicy(&StubCalendar{}, vo.ProcessingWindow{}, clock), mockLogger)
+
+	response, err := uc.GetCustomerSummary(ctx, dto.CustomerSummaryRequest{CustomerID: "CUST001", Limit: 5})
+	require.NoError(t, err)
+	assert.Equal(t, 3, response.AccountCount)
+	assert.Equal(t, clock.At, response.GeneratedAt)
+	assert.Equal(t, []dto.CurrencyBalance{
+		{Currency: "THB", Total: 1250.50, AccountCount: 2},
+		{Currency: "USD", Total: 80, AccountCount: 1},
+	}, response.Balances)
+	require.Len(t, response.RecentActivity, 1)
+	assert.Equal(t, pending.ID.String(), response.RecentActivity[0].ID)
+	require.Len(t, response.PendingTransactions, 1)
+	assert.Equal(t, "PENDING", response.PendingTransactions[0].Status)
+
+	_, err = uc.GetCustomerSummary(ctx, dto.CustomerSummaryRequest{CustomerID: "UNKNOWN", Limit: 5})
+	assert.ErrorIs(t, err, errs.ErrCustomerNotFound)
+}
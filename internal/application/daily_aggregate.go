@@ -0,0 +1,224 @@
+// internal/application/daily_aggregate.go
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/application/dto"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/event"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/infra"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/repository"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+)
+
+// maxDailyTotalsDays bounds the range of a daily totals request; it reads one row per day, so it can be wide
+const maxDailyTotalsDays = 366
+
+// DailyAggregator keeps the daily aggregates up to date by adding each completed transaction to the
+// day of every account it touched. It wraps the event publisher so each completion is counted once,
+// on the instance that completed the transaction.
+type DailyAggregator struct {
+	aggregateRepo   repository.DailyAggregateRepository
+	transactionRepo repository.TransactionRepository
+	next            infra.EventPublisher
+	logger          infra.Logger
+}
+
+// NewDailyAggregator creates a daily aggregator publishing through next
+func NewDailyAggregator(
+	aggregateRepo repository.DailyAggregateRepository,
+	transactionRepo repository.TransactionRepository,
+	next infra.EventPublisher,
+	logger infra.Logger,
+) *DailyAggregator {
+	return &DailyAggregator{
+		aggregateRepo:   aggregateRepo,
+		transactionRepo: transactionRepo,
+		next:            next,
+		logger:          logger,
+	}
+}
+
+// Publish forwards the event, then aggregates the transaction it completed
+func (a *DailyAggregator) Publish(ctx context.Context, evt event.Event) error {
+	err := a.next.Publish(ctx, evt)
+
+	if evt.Type == event.TransactionCompleted {
+		a.record(ctx, evt)
+	}
+
+	return err
+}
+
+// record adds the completed transaction to the days of its accounts. A failure is only logged: the
+// transaction itself succeeded, and a rebuild repairs the aggregates.
+func (a *DailyAggregator) record(ctx context.Context, evt event.Event) {
+	payload, err := evt.DecodeTransaction()
+	if err != nil {
+		a.logger.Warn("Failed to decode transaction event", "error", err, "eventID", evt.ID)
+		return
+	}
+	transactionID, err := vo.NewTransactionIDFromString(payload.TransactionID)
+	if err != nil {
+		return
+	}
+
+	transaction, err := a.transactionRepo.GetByID(ctx, transactionID)
+	if err != nil {
+		a.logger.Warn("Failed to load transaction for daily aggregates", "error", err, "transactionID", payload.TransactionID)
+		return
+	}
+
+	for _, accountID := range []*vo.AccountID{transaction.FromAccountID, transaction.ToAccountID} {
+		if accountID == nil {
+			continue
+		}
+		contribution := entity.NewDailyAggregateFor(transaction, *accountID)
+		if contribution == nil {
+			continue
+		}
+		if _, err := a.aggregateRepo.Record(ctx, transaction.ID, contribution); err != nil {
+			a.logger.Error("Failed to record daily aggregate", "error", err, "transactionID", payload.TransactionID, "accountID", accountID.String())
+		}
+	}
+}
+
+var _ infra.EventPublisher = (*DailyAggregator)(nil)
+
+type dailyAggregateUseCase struct {
+	aggregateRepo   repository.DailyAggregateRepository
+	accountRepo     repository.AccountRepository
+	transactionRepo repository.TransactionRepository
+	valueDating     *ValueDatingPolicy
+	logger          infra.Logger
+	mapper          *dto.DailyAggregateMapper
+}
+
+// NewDailyAggregateUseCase creates a new daily aggregate use case
+func NewDailyAggregateUseCase(
+	aggregateRepo repository.DailyAggregateRepository,
+	accountRepo repository.AccountRepository,
+	transactionRepo repository.TransactionRepository,
+	valueDating *ValueDatingPolicy,
+	logger infra.Logger,
+) DailyAggregateUseCase {
+	return &dailyAggregateUseCase{
+		aggregateRepo:   aggregateRepo,
+		accountRepo:     accountRepo,
+		transactionRepo: transactionRepo,
+		valueDating:     valueDating,
+		logger:          logger,
+		mapper:          &dto.DailyAggregateMapper{},
+	}
+}
+
+// GetDailyTotals retrieves an account's daily totals from the aggregates, without reading its transactions
+func (uc *dailyAggregateUseCase) GetDailyTotals(ctx context.Context, req dto.DailyTotalsRequest) (*dto.DailyTotalsResponse, error) {
+	accountID, err := vo.NewAccountIDFromString(req.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	// The range defaults to month-to-date of the current business date
+	today := uc.valueDating.Today()
+	from := time.Date(today.Year(), today.Month(), 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(today.Year(), today.Month(), today.Day(), 0, 0, 0, 0, time.UTC)
+	if req.From != "" {
+		if from, err = parseDate("from", req.From); err != nil {
+			return nil, err
+		}
+	}
+	if req.To != "" {
+		if to, err = parseDate("to", req.To); err != nil {
+			return nil, err
+		}
+	}
+	if to.Before(from) {
+		return nil, errs.ValidationError{Field: "to", Message: "to must not be before from"}
+	}
+	if to.Sub(from) >= maxDailyTotalsDays*24*time.Hour {
+		return nil, errs.ValidationError{Field: "to", Message: fmt.Sprintf("the range must not exceed %d days", maxDailyTotalsDays)}
+	}
+
+	if _, err := uc.accountRepo.GetByID(ctx, accountID); err != nil {
+		return nil, errs.ErrAccountNotFound
+	}
+
+	aggregates, err := uc.aggregateRepo.ListByAccountID(ctx, accountID, from, to)
+	if err != nil {
+		uc.logger.Error("Failed to list daily aggregates", "error", err, "accountID", req.AccountID)
+		return nil, err
+	}
+
+	response := &dto.DailyTotalsResponse{
+		AccountID: req.AccountID,
+		From:      from.Format(dateLayout),
+		To:        to.Format(dateLayout),
+		Days:      make([]dto.DailyTotal, len(aggregates)),
+	}
+	inflow, outflow := vo.ZeroMoney(), vo.ZeroMoney()
+	for i, aggregate := range aggregates {
+		response.Days[i] = uc.mapper.ToResponse(aggregate)
+		inflow, _ = inflow.Add(aggregate.Inflow)
+		outflow, _ = outflow.Add(aggregate.Outflow)
+		response.Count += aggregate.Count
+	}
+	net, _ := inflow.Subtract(outflow)
+	response.Inflow = inflow.InexactFloat64()
+	response.Outflow = outflow.InexactFloat64()
+	response.Net = net.InexactFloat64()
+
+	return response, nil
+}
+
+// RebuildDailyTotals recomputes an account's aggregates from its completed transactions, replacing the
+// incrementally maintained ones. Use it to backfill history or repair a missed completion.
+func (uc *dailyAggregateUseCase) RebuildDailyTotals(ctx context.Context, accountID string) (*dto.DailyTotalsRebuildResponse, error) {
+	parsedAccountID, err := vo.NewAccountIDFromString(accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := uc.accountRepo.GetByID(ctx, parsedAccountID); err != nil {
+		return nil, errs.ErrAccountNotFound
+	}
+
+	transactions, err := uc.transactionRepo.GetCompletedByAccountIDSince(ctx, parsedAccountID, time.Time{})
+	if err != nil {
+		uc.logger.Error("Failed to get completed transactions", "error", err, "accountID", accountID)
+		return nil, err
+	}
+
+	// Completed transactions come oldest first, so each day's aggregate is built in turn
+	var aggregates []*entity.DailyAggregate
+	var transactionIDs []vo.TransactionID
+	for _, transaction := range transactions {
+		contribution := entity.NewDailyAggregateFor(transaction, parsedAccountID)
+		if contribution == nil {
+			continue
+		}
+		transactionIDs = append(transactionIDs, transaction.ID)
+
+		if last := len(aggregates) - 1; last >= 0 && aggregates[last].Date.Equal(contribution.Date) {
+			aggregates[last].Add(transaction.BalanceEffect(parsedAccountID))
+			continue
+		}
+		aggregates = append(aggregates, contribution)
+	}
+
+	if err := uc.aggregateRepo.ReplaceByAccountID(ctx, parsedAccountID, aggregates, transactionIDs); err != nil {
+		uc.logger.Error("Failed to replace daily aggregates", "error", err, "accountID", accountID)
+		return nil, err
+	}
+
+	uc.logger.Info("Daily aggregates rebuilt", "accountID", accountID, "days", len(aggregates), "transactions", len(transactionIDs))
+	return &dto.DailyTotalsRebuildResponse{
+		AccountID:    accountID,
+		Days:         len(aggregates),
+		Transactions: len(transactionIDs),
+	}, nil
+}
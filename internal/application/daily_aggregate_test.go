@@ -0,0 +1,141 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/application/dto"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/event"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type MockDailyAggregateRepository struct {
+	mock.Mock
+}
+
+func (m *MockDailyAggregateRepository) Record(ctx context.Context, transactionID vo.TransactionID, contribution *entity.DailyAggregate) (bool, error) {
+	args := m.Called(ctx, transactionID, contribution)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockDailyAggregateRepository) ReplaceByAccountID(ctx context.Context, accountID vo.AccountID, aggregates []*entity.DailyAggregate, transactionIDs []vo.TransactionID) error {
+	args := m.Called(ctx, accountID, aggregates, transactionIDs)
+	return args.Error(0)
+}
+
+func (m *MockDailyAggregateRepository) ListByAccountID(ctx context.Context, accountID vo.AccountID, from, to time.Time) ([]*entity.DailyAggregate, error) {
+	args := m.Called(ctx, accountID, from, to)
+	return args.Get(0).([]*entity.DailyAggregate), args.Error(1)
+}
+
+func TestDailyAggregator_Publish(t *testing.T) {
+	fromID, toID := vo.NewAccountID(), vo.NewAccountID()
+	transfer, err := entity.NewTransferTransaction(fromID, toID, vo.NewMoneyFromFloat(100), "Rent", "", time.Now())
+	transfer = completedTransaction(t, transfer, err)
+
+	mockAggregateRepo := new(MockDailyAggregateRepository)
+	mockTxnRepo := new(MockTransactionRepository)
+	mockTxnRepo.On("GetByID", mock.Anything, transfer.ID).Return(transfer, nil)
+	mockAggregateRepo.On("Record", mock.Anything, transfer.ID, mock.MatchedBy(func(contribution *entity.DailyAggregate) bool {
+		return contribution.AccountID == fromID && contribution.Outflow.Equal(vo.NewMoneyFromFloat(100))
+	})).Return(true, nil)
+	mockAggregateRepo.On("Record", mock.Anything, transfer.ID, mock.MatchedBy(func(contribution *entity.DailyAggregate) bool {
+		return contribution.AccountID == toID && contribution.Inflow.Equal(vo.NewMoneyFromFloat(100))
+	})).Return(true, nil)
+
+	next := &StubEventPublisher{}
+	aggregator := NewDailyAggregator(mockAggregateRepo, mockTxnRepo, next, new(MockLogger))
+
+	require.NoError(t, aggregator.Publish(context.Background(), event.NewTransactionEvent(event.TransactionCompleted, transfer)))
+	assert.Len(t, next.Events, 1)
+	mockAggregateRepo.AssertNumberOfCalls(t, "Record", 2)
+
+	// Only completions are aggregated
+	require.NoError(t, aggregator.Publish(context.Background(), event.NewTransactionEvent(event.TransactionCreated, transfer)))
+	mockAggregateRepo.AssertNumberOfCalls(t, "Record", 2)
+}
+
+func TestDailyAggregateUseCase_GetDailyTotals(t *testing.T) {
+	account := createTestAccount()
+	from := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2024, 3, 31, 0, 0, 0, 0, time.UTC)
+
+	first := entity.NewDailyAggregate(account.ID, from.AddDate(0, 0, 2))
+	first.Add(vo.NewMoneyFromFloat(300))
+	first.Add(vo.NewMoneyFromFloat(-50))
+	second := entity.NewDailyAggregate(account.ID, from.AddDate(0, 0, 9))
+	second.Add(vo.NewMoneyFromFloat(-20))
+
+	mockAggregateRepo := new(MockDailyAggregateRepository)
+	mockAccountRepo := new(MockAccountRepository)
+	mockAccountRepo.On("GetByID", mock.Anything, account.ID).Return(account, nil)
+	mockAggregateRepo.On("ListByAccountID", mock.Anything, account.ID, from, to).Return([]*entity.DailyAggregate{first, second}, nil)
+
+	// Month-to-date of the test clock is the default range
+	clock := &StubClock{At: time.Date(2024, 3, 31, 15, 0, 0, 0, time.UTC)}
+	uc := NewDailyAggregateUseCase(mockAggregateRepo, mockAccountRepo, nil, NewValueDatingPolicy(&StubCalendar{}, vo.ProcessingWindow{}, clock), new(MockLogger))
+
+	result, err := uc.GetDailyTotals(context.Background(), dto.DailyTotalsRequest{AccountID: account.ID.String(), From: "2024-03-01", To: "2024-03-31"})
+	require.NoError(t, err)
+	require.Len(t, result.Days, 2)
+	assert.Equal(t, dto.DailyTotal{Date: "2024-03-03", Inflow: 300, Outflow: 50, Net: 250, Count: 2}, result.Days[0])
+	assert.Equal(t, 300.0, result.Inflow)
+	assert.Equal(t, 70.0, result.Outflow)
+	assert.Equal(t, 230.0, result.Net)
+	assert.Equal(t, 3, result.Count)
+
+	result, err = uc.GetDailyTotals(context.Background(), dto.DailyTotalsRequest{AccountID: account.ID.String()})
+	require.NoError(t, err)
+	assert.Equal(t, "2024-03-01", result.From)
+	assert.Equal(t, "2024-03-31", result.To)
+
+	_, err = uc.GetDailyTotals(context.Background(), dto.DailyTotalsRequest{AccountID: account.ID.String(), From: "2023-01-01", To: "2024-03-31"})
+	assert.IsType(t, errs.ValidationError{}, err)
+}
+
+func TestDailyAggregateUseCase_RebuildDailyTotals(t *testing.T) {
+	account := createTestAccount()
+	day := time.Date(2024, 3, 15, 9, 0, 0, 0, time.UTC)
+
+	completedAt := func(transaction *entity.Transaction, at time.Time) *entity.Transaction {
+		transaction.CompletedAt = &at
+		return transaction
+	}
+	salary, err := entity.NewCreditTransaction(account.ID, vo.NewMoneyFromFloat(300), "Salary", "", time.Now())
+	salary = completedAt(completedTransaction(t, salary, err), day)
+	coffee, err := entity.NewDebitTransaction(account.ID, vo.NewMoneyFromFloat(50), "Coffee", "", time.Now())
+	coffee = completedAt(completedTransaction(t, coffee, err), day.Add(3*time.Hour))
+	lunch, err := entity.NewDebitTransaction(account.ID, vo.NewMoneyFromFloat(20), "Lunch", "", time.Now())
+	lunch = completedAt(completedTransaction(t, lunch, err), day.AddDate(0, 0, 1))
+
+	mockAggregateRepo := new(MockDailyAggregateRepository)
+	mockAccountRepo := new(MockAccountRepository)
+	mockTxnRepo := new(MockTransactionRepository)
+	mockLogger := new(MockLogger)
+	mockLogger.On("Info", mock.Anything, mock.Anything).Maybe()
+	mockAccountRepo.On("GetByID", mock.Anything, account.ID).Return(account, nil)
+	mockTxnRepo.On("GetCompletedByAccountIDSince", mock.Anything, account.ID, time.Time{}).Return([]*entity.Transaction{salary, coffee, lunch}, nil)
+
+	var aggregates []*entity.DailyAggregate
+	mockAggregateRepo.On("ReplaceByAccountID", mock.Anything, account.ID, mock.Anything, []vo.TransactionID{salary.ID, coffee.ID, lunch.ID}).
+		Run(func(args mock.Arguments) { aggregates = args.Get(2).([]*entity.DailyAggregate) }).
+		Return(nil)
+
+	uc := NewDailyAggregateUseCase(mockAggregateRepo, mockAccountRepo, mockTxnRepo, nil, mockLogger)
+
+	result, err := uc.RebuildDailyTotals(context.Background(), account.ID.String())
+	require.NoError(t, err)
+	assert.Equal(t, 2, result.Days)
+	assert.Equal(t, 3, result.Transactions)
+
+	require.Len(t, aggregates, 2)
+	assert.Equal(t, "250", aggregates[0].Net().String())
+	assert.Equal(t, 2, aggregates[0].Count)
+	assert.Equal(t, "20", aggregates[1].Outflow.String())
+}
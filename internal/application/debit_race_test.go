@@ -0,0 +1,305 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/repository"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// raceTimeout bounds every wait of the race harness, so a schedule that cannot happen fails the test
+// instead of hanging it
+const raceTimeout = 5 * time.Second
+
+// raceActorKey is the context key naming the goroutine a repository call belongs to
+type raceActorKey struct{}
+
+// withRaceActor names the goroutine of the repository calls made with ctx
+func withRaceActor(ctx context.Context, actor string) context.Context {
+	return context.WithValue(ctx, raceActorKey{}, actor)
+}
+
+// raceHarness forces an interleaving of concurrent repository calls. Hooked calls report events such
+// as "first GetByID done"; an event can be held, so the goroutine reaching it waits until one of
+// another set of events happened.
+type raceHarness struct {
+	mu       sync.Mutex
+	happened map[string]chan struct{}
+	holds    map[string][]string
+	errs     []error
+}
+
+func newRaceHarness() *raceHarness {
+	return &raceHarness{happened: make(map[string]chan struct{}), holds: make(map[string][]string)}
+}
+
+// hold makes the goroutine reaching event wait until any of the until events happened
+func (h *raceHarness) hold(event string, until ...string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.holds[event] = until
+}
+
+// reach records that the actor of ctx reached the step, then waits as long as the step is held
+func (h *raceHarness) reach(ctx context.Context, step string) {
+	actor, _ := ctx.Value(raceActorKey{}).(string)
+	event := actor + " " + step
+
+	h.mu.Lock()
+	close(h.channel(event))
+	until := h.holds[event]
+	waits := make([]chan struct{}, len(until))
+	for i, other := range until {
+		waits[i] = h.channel(other)
+	}
+	h.mu.Unlock()
+
+	if len(waits) == 0 {
+		return
+	}
+
+	cases := make([]reflect.SelectCase, 0, len(waits)+1)
+	for _, wait := range waits {
+		cases = append(cases, reflect.SelectCase{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(wait)})
+	}
+	timeout := reflect.SelectCase{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(time.After(raceTimeout))}
+	if chosen, _, _ := reflect.Select(append(cases, timeout)); chosen == len(waits) {
+		h.mu.Lock()
+		h.errs = append(h.errs, fmt.Errorf("%s waited in vain for any of %v", event, until))
+		h.mu.Unlock()
+	}
+}
+
+// channel returns the channel closed when the event happens; the caller holds mu
+func (h *raceHarness) channel(event string) chan struct{} {
+	ch, ok := h.happened[event]
+	if !ok {
+		ch = make(chan struct{})
+		h.happened[event] = ch
+	}
+	return ch
+}
+
+// Errors returns the waits that timed out
+func (h *raceHarness) Errors() []error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.errs
+}
+
+// memoryUnit is a unit of work of memoryUnitOfWork, holding the account rows it locked
+type memoryUnit struct {
+	locked []vo.AccountID
+}
+
+// memoryUnitOfWork runs units of work against a memoryAccountRepository, releasing the row locks of
+// each when it ends
+type memoryUnitOfWork struct {
+	store *memoryAccountRepository
+}
+
+func (u *memoryUnitOfWork) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	if repository.UnitOfWorkFromContext(ctx) != nil {
+		return fn(ctx)
+	}
+
+	unit := &memoryUnit{}
+	defer u.store.release(unit)
+	return fn(repository.WithUnitOfWork(ctx, unit))
+}
+
+// memoryAccountRepository keeps accounts in memory, handing out copies as the database would; only
+// the methods the balance changes use are implemented. Rows locked for update stay locked until the
+// memoryUnitOfWork locking them ends; a caller finding a row locked reports it to waiting first.
+type memoryAccountRepository struct {
+	repository.AccountRepository
+
+	mu       sync.Mutex
+	accounts map[vo.AccountID]entity.Account
+	applied  map[string]bool
+	locks    map[vo.AccountID]chan struct{}
+	waiting  func(ctx context.Context)
+}
+
+func newMemoryAccountRepository(accounts ...*entity.Account) *memoryAccountRepository {
+	r := &memoryAccountRepository{
+		accounts: make(map[vo.AccountID]entity.Account),
+		applied:  make(map[string]bool),
+		locks:    make(map[vo.AccountID]chan struct{}),
+	}
+	for _, account := range accounts {
+		r.accounts[account.ID] = *account
+		r.locks[account.ID] = make(chan struct{}, 1)
+	}
+	return r
+}
+
+func (r *memoryAccountRepository) GetByIDForUpdate(ctx context.Context, id vo.AccountID) (*entity.Account, error) {
+	unit, _ := repository.UnitOfWorkFromContext(ctx).(*memoryUnit)
+	lock, ok := r.locks[id]
+	if unit == nil || !ok || unit.holds(id) {
+		return r.GetByID(ctx, id)
+	}
+
+	select {
+	case lock <- struct{}{}:
+	default:
+		if r.waiting != nil {
+			r.waiting(ctx)
+		}
+		lock <- struct{}{}
+	}
+	unit.locked = append(unit.locked, id)
+
+	return r.GetByID(ctx, id)
+}
+
+// release unlocks the rows a unit of work locked
+func (r *memoryAccountRepository) release(unit *memoryUnit) {
+	for _, id := range unit.locked {
+		<-r.locks[id]
+	}
+}
+
+// holds reports whether the unit locked the account row
+func (u *memoryUnit) holds(id vo.AccountID) bool {
+	for _, locked := range u.locked {
+		if locked == id {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *memoryAccountRepository) GetByID(ctx context.Context, id vo.AccountID) (*entity.Account, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	account, ok := r.accounts[id]
+	if !ok {
+		return nil, errs.ErrAccountNotFound
+	}
+	return &account, nil
+}
+
+func (r *memoryAccountRepository) GetByIDs(ctx context.Context, ids []vo.AccountID) (map[vo.AccountID]*entity.Account, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	accounts := make(map[vo.AccountID]*entity.Account, len(ids))
+	for _, id := range ids {
+		if account, ok := r.accounts[id]; ok {
+			accounts[id] = &account
+		}
+	}
+	return accounts, nil
+}
+
+func (r *memoryAccountRepository) UpdateFenced(ctx context.Context, account *entity.Account, transactionID vo.TransactionID, token int64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	key := transactionID.String() + "/" + account.ID.String()
+	if r.applied[key] {
+		return errs.ErrTransactionAlreadyApplied
+	}
+	r.applied[key] = true
+	r.accounts[account.ID] = *account
+	return nil
+}
+
+func (r *memoryAccountRepository) IsApplied(ctx context.Context, transactionID vo.TransactionID, accountID vo.AccountID) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.applied[transactionID.String()+"/"+accountID.String()], nil
+}
+
+// hookedAccountRepository reports the start and end of every balance read and write to the harness
+type hookedAccountRepository struct {
+	repository.AccountRepository
+	harness *raceHarness
+}
+
+func (r *hookedAccountRepository) GetByID(ctx context.Context, id vo.AccountID) (*entity.Account, error) {
+	r.harness.reach(ctx, "GetByID start")
+	defer r.harness.reach(ctx, "GetByID done")
+	return r.AccountRepository.GetByID(ctx, id)
+}
+
+func (r *hookedAccountRepository) GetByIDForUpdate(ctx context.Context, id vo.AccountID) (*entity.Account, error) {
+	r.harness.reach(ctx, "GetByIDForUpdate start")
+	defer r.harness.reach(ctx, "GetByIDForUpdate done")
+	return r.AccountRepository.GetByIDForUpdate(ctx, id)
+}
+
+func (r *hookedAccountRepository) UpdateFenced(ctx context.Context, account *entity.Account, transactionID vo.TransactionID, token int64) error {
+	r.harness.reach(ctx, "UpdateFenced start")
+	defer r.harness.reach(ctx, "UpdateFenced done")
+	return r.AccountRepository.UpdateFenced(ctx, account, transactionID, token)
+}
+
+// TestProcessDebit_ConcurrentDebitsCannotOverdraw debits 80 twice from an account holding 100, each in
+// a unit of work. The harness lets the first debit read the balance first, then holds it until the
+// second one either read the balance too or waits for the row lock. Exactly one debit may succeed;
+// a debit reading the balance without locking the row lets both succeed.
+func TestProcessDebit_ConcurrentDebitsCannotOverdraw(t *testing.T) {
+	account, err := entity.NewAccount("Savings", vo.NewMoneyFromFloat(100))
+	require.NoError(t, err)
+
+	store := newMemoryAccountRepository(account)
+	harness := newRaceHarness()
+	store.waiting = func(ctx context.Context) { harness.reach(ctx, "lock wait") }
+	harness.hold("second GetByID start", "first GetByID done")
+	harness.hold("first GetByID done", "second GetByID done")
+	harness.hold("second GetByIDForUpdate start", "first GetByIDForUpdate done")
+	harness.hold("first GetByIDForUpdate done", "second GetByIDForUpdate done", "second lock wait")
+
+	mockLogger := new(MockLogger)
+	mockLogger.On("Info", mock.Anything, mock.Anything).Maybe()
+	mockLogger.On("Warn", mock.Anything, mock.Anything).Maybe()
+	mockLogger.On("Error", mock.Anything, mock.Anything).Maybe()
+	uc := NewTransactionUseCase(nil, &hookedAccountRepository{AccountRepository: store, harness: harness}, nil, nil, &memoryUnitOfWork{store: store}, nil, nil, nil, &StubEventPublisher{}, nil,
+		NewValueDatingPolicy(&StubCalendar{}, vo.ProcessingWindow{}, nil), nil, NewCategorizer(nil, nil, mockLogger), nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, "THB", mockLogger).(*transactionUseCase)
+
+	actors := []string{"first", "second"}
+	results := make([]error, len(actors))
+	var wg sync.WaitGroup
+	for i, actor := range actors {
+		transaction, err := entity.NewDebitTransaction(account.ID, vo.NewMoneyFromFloat(80), "ATM withdrawal", "", time.Now())
+		require.NoError(t, err)
+
+		wg.Add(1)
+		go func(i int, actor string) {
+			defer wg.Done()
+			results[i] = uc.atomically(withRaceActor(context.Background(), actor), func(ctx context.Context) error {
+				return uc.processDebitTransaction(ctx, transaction)
+			})
+		}(i, actor)
+	}
+	wg.Wait()
+	require.Empty(t, harness.Errors())
+
+	succeeded := 0
+	for _, err := range results {
+		if err == nil {
+			succeeded++
+			continue
+		}
+		assert.ErrorIs(t, err, errs.ErrInsufficientBalance)
+	}
+	assert.Equal(t, 1, succeeded, "results: %v", results)
+
+	final, err := store.GetByID(context.Background(), account.ID)
+	require.NoError(t, err)
+	assert.True(t, final.Balance.Equal(vo.NewMoneyFromFloat(20)), "balance %s", final.Balance)
+}
@@ -5,26 +5,51 @@ import (
 	"time"
 )
 
-// CreateAccountRequest represents the request to create a new account
-type CreateAccountRequest struct {
-	AccountName    string  `json:"account_name" validate:"required,min=1,max=100"`
-	InitialBalance float64 `json:"initial_balance" validate:"min=0"`
-}
-
-// UpdateAccountRequest represents the request to update an account
-type UpdateAccountRequest struct {
-	ID          string `json:"id" validate:"required"`
-	AccountName string `json:"account_name" validate:"required,min=1,max=100"`
+// AccountRequest represents the request to create an account or to rename one. It is validated under
+// the create or update profile; fields only an opening can set are rejected on update.
+type AccountRequest struct {
+	ID             string         `json:"-" validate:"required_on=update"`
+	AccountName    string         `json:"account_name" validate:"required,min=1,max=100"`
+	InitialBalance *DecimalString `json:"initial_balance" validate:"required_on=create,excluded_on=update,omitempty,min=0"`
+	CustomerID     string         `json:"customer_id" validate:"excluded_on=update,max=50"`
+	ProductID      string         `json:"product_id" validate:"excluded_on=update,max=32"`        // Opens the account under a product's terms
+	Currency       string         `json:"currency" validate:"excluded_on=update,omitempty,len=3"` // ISO 4217; defaults to the product's currency, else the service currency
 }
 
 // AccountResponse represents the response structure for account data
 type AccountResponse struct {
-	ID          string    `json:"id"`
-	AccountName string    `json:"account_name"`
-	Balance     float64   `json:"balance"`
-	Status      string    `json:"status"`
-	CreatedAt   time.Time `json:"created_at"`
-	UpdatedAt   time.Time `json:"updated_at"`
+	ID              string    `json:"id"`
+	AccountName     string    `json:"account_name"`
+	CustomerID      string    `json:"customer_id,omitempty"`
+	ProductID       string    `json:"product_id,omitempty"`
+	ParentAccountID *string   `json:"parent_account_id,omitempty"`
+	SpendingLimit   *float64  `json:"spending_limit,omitempty"`
+	Balance         float64   `json:"balance"`
+	Currency        string    `json:"currency"`
+	Status          string    `json:"status"`
+	CreatedAt       time.Time `json:"created_at"`
+	UpdatedAt       time.Time `json:"updated_at"`
+
+	NameHistory []AccountNameChangeResponse `json:"name_history,omitempty"` // Only in admin responses
+}
+
+// AccountNameChangeResponse represents a rename of an account
+type AccountNameChangeResponse struct {
+	PreviousName string    `json:"previous_name"`
+	NewName      string    `json:"new_name"`
+	ChangedAt    time.Time `json:"changed_at"`
+}
+
+// AccountListRequest represents the request to list accounts, optionally narrowed by status, customer,
+// balance range and the dates they were created on
+type AccountListRequest struct {
+	ListRequest
+	Status      string         `json:"status" validate:"omitempty,oneof=ACTIVE INACTIVE SUSPENDED"`
+	CustomerID  string         `json:"customer_id" validate:"omitempty,max=50"`
+	MinBalance  *DecimalString `json:"min_balance"`
+	MaxBalance  *DecimalString `json:"max_balance"`
+	CreatedFrom *time.Time     `json:"created_from"` // First day, UTC
+	CreatedTo   *time.Time     `json:"created_to"`   // Last day, UTC
 }
 
 // AccountListResponse represents paginated account list response
@@ -32,3 +57,54 @@ type AccountListResponse struct {
 	Accounts   []AccountResponse `json:"accounts"`
 	Pagination PaginationInfo    `json:"pagination"`
 }
+
+// AccountBatchResponse represents the accounts found by a batch lookup and the IDs without one
+type AccountBatchResponse struct {
+	Found   []AccountResponse `json:"found"`   // In the order requested
+	Missing []string          `json:"missing"` // IDs without an account
+}
+
+// AddChildAccountRequest represents the request to attach an account to a parent account
+type AddChildAccountRequest struct {
+	ParentID       string `json:"-" validate:"required"`
+	ChildAccountID string `json:"child_account_id" validate:"required"`
+}
+
+// SpendingLimitRequest represents the request of a parent to cap a child account's monthly
+// outflow; a nil limit removes the cap
+type SpendingLimitRequest struct {
+	ParentID     string         `json:"-" validate:"required"`
+	ChildID      string         `json:"-" validate:"required"`
+	MonthlyLimit *DecimalString `json:"monthly_limit" validate:"omitempty,gt=0"`
+}
+
+// AccountGroupResponse represents a parent account with its children and roll-up balances
+type AccountGroupResponse struct {
+	Parent          AccountResponse   `json:"parent"`
+	Children        []AccountResponse `json:"children"`
+	ChildrenBalance float64           `json:"children_balance"`
+	TotalBalance    float64           `json:"total_balance"`
+	Currency        string            `json:"currency"` // Every account of a group is held in the parent's currency
+}
+
+// RecalculateBalanceRequest represents an admin's recalculation of an account's balance from its
+// completed transactions; with Confirm set, a stored balance that differs is repaired
+type RecalculateBalanceRequest struct {
+	AccountID   string `json:"-" validate:"required"`
+	Confirm     bool   `json:"confirm"`
+	RequestedBy string `json:"requested_by" validate:"required_if=Confirm true,max=100"`
+	Reason      string `json:"reason" validate:"required_if=Confirm true,max=500"`
+}
+
+// BalanceRecalculationResponse represents an account's stored balance next to the balance its
+// completed transactions add up to
+type BalanceRecalculationResponse struct {
+	AccountID           string  `json:"account_id"`
+	OpeningBalance      float64 `json:"opening_balance"`
+	TransactionCount    int     `json:"transaction_count"`
+	StoredBalance       float64 `json:"stored_balance"` // Before any repair
+	RecalculatedBalance float64 `json:"recalculated_balance"`
+	Delta               float64 `json:"delta"` // Recalculated minus stored
+	Currency            string  `json:"currency"`
+	Repaired            bool    `json:"repaired"`
+}
@@ -0,0 +1,27 @@
+// internal/application/dto/accounting_period.go
+package dto
+
+import "time"
+
+// ClosePeriodRequest represents an admin closing the books for a month
+type ClosePeriodRequest struct {
+	Period   string `json:"-" validate:"required,len=7"` // YYYY-MM
+	ClosedBy string `json:"closed_by" validate:"required,max=100"`
+	Reason   string `json:"reason" validate:"max=500"`
+}
+
+// AccountingPeriodResponse represents the status of an accounting period
+type AccountingPeriodResponse struct {
+	Period   string     `json:"period"`
+	Status   string     `json:"status"`              // OPEN or CLOSED
+	ClosedBy string     `json:"closed_by,omitempty"` // Of the close that locked the period, its own or a later month's
+	Reason   string     `json:"reason,omitempty"`
+	ClosedAt *time.Time `json:"closed_at,omitempty"`
+}
+
+// AccountingPeriodListResponse represents the closed periods and the current one
+type AccountingPeriodListResponse struct {
+	CurrentPeriod string                     `json:"current_period"`
+	ClosedThrough string                     `json:"closed_through,omitempty"` // Latest closed period; it and every earlier one are locked
+	Periods       []AccountingPeriodResponse `json:"periods"`                  // Closes, latest first
+}
@@ -0,0 +1,78 @@
+// internal/application/dto/admin_security.go
+package dto
+
+import "time"
+
+// AdminActivityRecord represents one request an admin user made, as seen by the API
+type AdminActivityRecord struct {
+	AdminID    string
+	AdminEmail string
+	Method     string
+	Route      string // Route pattern, e.g. /api/v1/accounts/:id
+	Path       string
+	Permission string // Permission the route requires, if any
+	StatusCode int
+	IP         string
+	Country    string // ISO 3166 code reported by the edge proxy, if any
+	UserAgent  string
+	OccurredAt time.Time
+}
+
+// AdminActivityListRequest represents the filters of the admin activity list
+type AdminActivityListRequest struct {
+	AdminID   string `json:"admin_id"`
+	Anomalous bool   `json:"anomalous"` // Only requests that showed an anomaly
+	Anomaly   string `json:"anomaly" validate:"omitempty,oneof=NEW_IP UNUSUAL_HOURS MASS_OPERATIONS"`
+	Page      int    `json:"page" validate:"min=1"`
+	PageSize  int    `json:"page_size" validate:"min=1,max=100"`
+}
+
+// AdminActivityResponse represents one recorded request of an admin user
+type AdminActivityResponse struct {
+	ID         string    `json:"id"`
+	AdminID    string    `json:"admin_id"`
+	AdminEmail string    `json:"admin_email"`
+	Method     string    `json:"method"`
+	Route      string    `json:"route"`
+	Path       string    `json:"path"`
+	Permission string    `json:"permission,omitempty"`
+	StatusCode int       `json:"status_code"`
+	IP         string    `json:"ip"`
+	Country    string    `json:"country,omitempty"`
+	UserAgent  string    `json:"user_agent,omitempty"`
+	Anomalies  []string  `json:"anomalies"`
+	OccurredAt time.Time `json:"occurred_at"`
+}
+
+// AdminActivityListResponse represents a page of admin activity
+type AdminActivityListResponse struct {
+	Activities []AdminActivityResponse `json:"activities"`
+	Pagination PaginationInfo          `json:"pagination"`
+}
+
+// SecurityDashboardRequest represents the period the security dashboard covers
+type SecurityDashboardRequest struct {
+	Hours int `json:"hours" validate:"omitempty,min=1,max=720"` // Defaults to the last 24 hours
+}
+
+// AdminActivitySummaryResponse represents one admin user's activity over the dashboard period
+type AdminActivitySummaryResponse struct {
+	AdminID     string    `json:"admin_id"`
+	AdminEmail  string    `json:"admin_email"`
+	Actions     int64     `json:"actions"`
+	Mutations   int64     `json:"mutations"`
+	Denied      int64     `json:"denied"`
+	Anomalous   int64     `json:"anomalous"`
+	DistinctIPs int64     `json:"distinct_ips"`
+	LastSeenAt  time.Time `json:"last_seen_at"`
+}
+
+// SecurityDashboardResponse represents admin activity and its anomalies over a period
+type SecurityDashboardResponse struct {
+	Since           time.Time                      `json:"since"`
+	Actions         int64                          `json:"actions"`
+	Anomalous       int64                          `json:"anomalous"`
+	AnomalyCounts   map[string]int64               `json:"anomaly_counts"`
+	Admins          []AdminActivitySummaryResponse `json:"admins"`
+	RecentAnomalies []AdminActivityResponse        `json:"recent_anomalies"`
+}
@@ -0,0 +1,56 @@
+// internal/application/dto/admin_user.go
+package dto
+
+import "time"
+
+// CreateAdminUserRequest represents the request to add an admin user
+type CreateAdminUserRequest struct {
+	Email       string   `json:"email" validate:"required,email,max=254"`
+	Name        string   `json:"name" validate:"required,max=100"`
+	Roles       []string `json:"roles" validate:"required,min=1,dive,oneof=VIEWER OPERATOR APPROVER AUDITOR SUPER_ADMIN"`
+	RequestedBy string   `json:"requested_by" validate:"required,max=254"` // The calling admin's email when an admin key is used
+}
+
+// UpdateAdminUserRequest represents the request to change an admin user's roles or status; omitted fields are kept
+type UpdateAdminUserRequest struct {
+	ID          string   `json:"-" validate:"required"`
+	Roles       []string `json:"roles" validate:"omitempty,min=1,dive,oneof=VIEWER OPERATOR APPROVER AUDITOR SUPER_ADMIN"`
+	Status      string   `json:"status" validate:"omitempty,oneof=ACTIVE DISABLED"`
+	RequestedBy string   `json:"requested_by" validate:"required,max=254"` // The calling admin's email when an admin key is used
+}
+
+// RotateAdminKeyRequest represents the request to issue a new API key to an admin user
+type RotateAdminKeyRequest struct {
+	ID          string `json:"-" validate:"required"`
+	RequestedBy string `json:"requested_by" validate:"required,max=254"` // The calling admin's email when an admin key is used
+}
+
+// AdminUserResponse represents an admin user with the permissions of their roles
+type AdminUserResponse struct {
+	ID          string    `json:"id"`
+	Email       string    `json:"email"`
+	Name        string    `json:"name"`
+	Roles       []string  `json:"roles"`
+	Permissions []string  `json:"permissions"`
+	Status      string    `json:"status"`
+	APIKey      string    `json:"api_key,omitempty"` // Only returned when a key is issued
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// AdminUserListResponse represents every admin user
+type AdminUserListResponse struct {
+	Admins []AdminUserResponse `json:"admins"`
+}
+
+// AdminRoleResponse represents a role and the permissions it grants
+type AdminRoleResponse struct {
+	Role        string   `json:"role"`
+	Permissions []string `json:"permissions"`
+}
+
+// AdminRoleListResponse represents the permissions matrix
+type AdminRoleListResponse struct {
+	Roles       []AdminRoleResponse `json:"roles"`
+	Permissions []string            `json:"permissions"`
+}
@@ -0,0 +1,41 @@
+// internal/application/dto/attachment.go
+package dto
+
+import (
+	"io"
+	"time"
+)
+
+// UploadAttachmentRequest represents a file an account holder attaches to one of their transactions.
+// SizeBytes is the size the client declared; the stored size is counted while the file is written.
+type UploadAttachmentRequest struct {
+	AccountID     string    `json:"-" validate:"required"`
+	TransactionID string    `json:"-" validate:"required"`
+	FileName      string    `json:"-" validate:"required,max=255"`
+	SizeBytes     int64     `json:"-" validate:"min=0"`
+	Content       io.Reader `json:"-"`
+}
+
+// AttachmentResponse represents the response structure for a transaction attachment
+type AttachmentResponse struct {
+	ID            string    `json:"id"`
+	AccountID     string    `json:"account_id"`
+	TransactionID string    `json:"transaction_id"`
+	FileName      string    `json:"file_name"`
+	ContentType   string    `json:"content_type"`
+	SizeBytes     int64     `json:"size_bytes"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+// AttachmentListResponse represents an account's attachments of a transaction
+type AttachmentListResponse struct {
+	Attachments []AttachmentResponse `json:"attachments"`
+}
+
+// AttachmentDownload is an attachment's file opened for download; the caller closes Content
+type AttachmentDownload struct {
+	Content     io.ReadCloser
+	FileName    string
+	ContentType string
+	SizeBytes   int64
+}
@@ -0,0 +1,45 @@
+// internal/application/dto/audit.go
+package dto
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// Audit export line types
+const (
+	AuditLineEntry     = "entry"
+	AuditLineSignature = "signature"
+)
+
+// AuditExportRequest represents the filters of an audit log export; empty filters match everything
+type AuditExportRequest struct {
+	EventType string     `json:"event_type" validate:"omitempty,max=50"`
+	AccountID string     `json:"account_id" validate:"omitempty,max=50"`
+	From      *time.Time `json:"from"` // Inclusive
+	To        *time.Time `json:"to"`   // Exclusive
+
+	CallbackURL string `json:"callback_url" validate:"omitempty,max=500"` // Notified once the export finishes
+}
+
+// AuditEntryLine is one audit entry of an NDJSON export
+type AuditEntryLine struct {
+	Type       string          `json:"type"`
+	Sequence   uint64          `json:"sequence"`
+	EventID    string          `json:"event_id"`
+	EventType  string          `json:"event_type"`
+	Key        string          `json:"key"`
+	OccurredAt time.Time       `json:"occurred_at"`
+	RecordedAt time.Time       `json:"recorded_at"`
+	Data       json.RawMessage `json:"data"`
+}
+
+// AuditSignatureLine closes an NDJSON export. Signature is the hex HMAC-SHA256, under the
+// audit signing key, of every byte of the export before this line.
+type AuditSignatureLine struct {
+	Type       string    `json:"type"`
+	Algorithm  string    `json:"algorithm"`
+	Entries    int       `json:"entries"`
+	ExportedAt time.Time `json:"exported_at"`
+	Signature  string    `json:"signature"`
+}
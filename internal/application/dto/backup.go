@@ -0,0 +1,39 @@
+// internal/application/dto/backup.go
+package dto
+
+import (
+	"time"
+)
+
+// BackupResponse represents the response structure for backup metadata
+type BackupResponse struct {
+	ID          string     `json:"id"`
+	Location    string     `json:"location,omitempty"`
+	SizeBytes   int64      `json:"size_bytes"`
+	Status      string     `json:"status"`
+	Error       string     `json:"error,omitempty"`
+	RequestedBy string     `json:"requested_by"`
+	StartedAt   time.Time  `json:"started_at"`
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+}
+
+// BackupListResponse represents paginated backup list response
+type BackupListResponse struct {
+	Backups    []BackupResponse `json:"backups"`
+	Pagination PaginationInfo   `json:"pagination"`
+}
+
+// BalanceAsOfRequest represents the request to reconstruct an account balance at a point in time
+type BalanceAsOfRequest struct {
+	AccountID string    `json:"account_id" validate:"required"`
+	AsOf      time.Time `json:"as_of" validate:"required"`
+}
+
+// BalanceAsOfResponse represents a reconstructed account balance
+type BalanceAsOfResponse struct {
+	AccountID            string    `json:"account_id"`
+	AsOf                 time.Time `json:"as_of"`
+	Balance              float64   `json:"balance"`
+	CurrentBalance       float64   `json:"current_balance"`
+	TransactionsReplayed int       `json:"transactions_replayed"`
+}
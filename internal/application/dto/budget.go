@@ -0,0 +1,37 @@
+// internal/application/dto/budget.go
+package dto
+
+import "time"
+
+// CreateBudgetRequest represents the request to set a monthly budget for a category of an account
+type CreateBudgetRequest struct {
+	AccountID    string        `json:"-" validate:"required"`
+	CategoryCode string        `json:"category_code" validate:"required,max=30"`
+	Amount       DecimalString `json:"amount" validate:"required,gt=0"`
+}
+
+// UpdateBudgetRequest represents the request to change a budget's monthly amount
+type UpdateBudgetRequest struct {
+	AccountID string        `json:"-" validate:"required"`
+	ID        string        `json:"-" validate:"required"`
+	Amount    DecimalString `json:"amount" validate:"required,gt=0"`
+}
+
+// BudgetResponse represents a budget and the spend against it in the current month
+type BudgetResponse struct {
+	ID           string    `json:"id"`
+	AccountID    string    `json:"account_id"`
+	CategoryCode string    `json:"category_code"`
+	Amount       float64   `json:"amount"`
+	Period       string    `json:"period"` // YYYY-MM
+	Spent        float64   `json:"spent"`
+	Remaining    float64   `json:"remaining"` // Negative when overspent
+	PercentUsed  float64   `json:"percent_used"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+// BudgetListResponse represents the budgets of an account
+type BudgetListResponse struct {
+	Budgets []BudgetResponse `json:"budgets"`
+}
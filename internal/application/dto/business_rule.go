@@ -0,0 +1,69 @@
+// internal/application/dto/business_rule.go
+package dto
+
+import "time"
+
+// CreateBusinessRuleRequest represents the request to add a scripted business rule
+type CreateBusinessRuleRequest struct {
+	Name       string `json:"name" validate:"required,max=100"`
+	Kind       string `json:"kind" validate:"required,oneof=VALIDATION FEE"`
+	Expression string `json:"expression" validate:"required,max=2000"` // CEL; a boolean for VALIDATION, a number for FEE
+	Message    string `json:"message" validate:"max=200"`              // Returned when a VALIDATION rule rejects a transaction
+	Priority   int    `json:"priority" validate:"min=0"`               // Lower priorities are evaluated first
+}
+
+// UpdateBusinessRuleRequest represents the request to change or switch off a business rule
+type UpdateBusinessRuleRequest struct {
+	ID         string `json:"-" validate:"required"`
+	Name       string `json:"name" validate:"required,max=100"`
+	Expression string `json:"expression" validate:"required,max=2000"`
+	Message    string `json:"message" validate:"max=200"`
+	Priority   int    `json:"priority" validate:"min=0"`
+	Enabled    *bool  `json:"enabled"` // Left unchanged when omitted
+}
+
+// BusinessRuleResponse represents the response structure for a business rule
+type BusinessRuleResponse struct {
+	ID         string    `json:"id"`
+	Name       string    `json:"name"`
+	Kind       string    `json:"kind"`
+	Expression string    `json:"expression"`
+	Message    string    `json:"message,omitempty"`
+	Priority   int       `json:"priority"`
+	Enabled    bool      `json:"enabled"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+// BusinessRuleListResponse represents the business rules in evaluation order
+type BusinessRuleListResponse struct {
+	Rules []BusinessRuleResponse `json:"rules"`
+}
+
+// RuleSampleTransaction represents the transaction a business rule expression is tested against
+type RuleSampleTransaction struct {
+	TransactionType string        `json:"transaction_type" validate:"required,oneof=DEBIT CREDIT TRANSFER FX_TRANSFER"`
+	Channel         string        `json:"channel" validate:"omitempty,oneof=API BRANCH ATM MOBILE"` // Defaults to API
+	Amount          DecimalString `json:"amount" validate:"gte=0"`
+	Description     string        `json:"description" validate:"max=500"`
+	Reference       string        `json:"reference" validate:"max=100"`
+	Merchant        string        `json:"merchant" validate:"max=100"`
+	Category        string        `json:"category" validate:"max=30"`
+	FromAccountID   string        `json:"from_account_id" validate:"max=16"`
+	ToAccountID     string        `json:"to_account_id" validate:"max=16"`
+}
+
+// TestBusinessRuleRequest represents an expression to evaluate against a sample transaction without saving it
+type TestBusinessRuleRequest struct {
+	Kind        string                `json:"kind" validate:"required,oneof=VALIDATION FEE"`
+	Expression  string                `json:"expression" validate:"required,max=2000"`
+	Transaction RuleSampleTransaction `json:"transaction"`
+}
+
+// TestBusinessRuleResponse represents the outcome of a business rule on a sample transaction
+type TestBusinessRuleResponse struct {
+	Kind     string   `json:"kind"`
+	Rejected *bool    `json:"rejected,omitempty"` // VALIDATION rules
+	Fee      *float64 `json:"fee,omitempty"`      // FEE rules
+	Error    string   `json:"error,omitempty"`    // Why the expression could not be evaluated
+}
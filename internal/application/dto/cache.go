@@ -0,0 +1,24 @@
+package dto
+
+// CacheInvalidationRequest represents an admin's request to drop cached entries, e.g. after a manual
+// database fix. Entity references are expanded to every cache key that holds the entity.
+type CacheInvalidationRequest struct {
+	RequestedBy    string   `json:"requested_by" validate:"required,max=100"`
+	Reason         string   `json:"reason" validate:"max=500"`
+	Patterns       []string `json:"patterns" validate:"max=50,dive,required,max=200"`
+	AccountIDs     []string `json:"account_ids" validate:"max=100,dive,required"`
+	TransactionIDs []string `json:"transaction_ids" validate:"max=100,dive,required"`
+	AllLists       bool     `json:"all_lists"` // Drop every cached account and transaction list page
+}
+
+// CacheInvalidationResponse reports the keys removed per pattern
+type CacheInvalidationResponse struct {
+	Patterns []InvalidatedCachePattern `json:"patterns"`
+	Deleted  int64                     `json:"deleted"`
+}
+
+// InvalidatedCachePattern reports the keys a pattern removed
+type InvalidatedCachePattern struct {
+	Pattern string `json:"pattern"`
+	Deleted int64  `json:"deleted"`
+}
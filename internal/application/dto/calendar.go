@@ -0,0 +1,55 @@
+// internal/application/dto/calendar.go
+package dto
+
+// CreateHolidayRequest represents the request to add a holiday to a region's calendar
+type CreateHolidayRequest struct {
+	Region string `json:"-" validate:"required,max=10"`
+	Date   string `json:"date" validate:"required"` // YYYY-MM-DD
+	Name   string `json:"name" validate:"required,max=100"`
+}
+
+// ListHolidaysRequest represents the request to list a region's holidays within a date range
+type ListHolidaysRequest struct {
+	Region string `json:"region" validate:"required,max=10"`
+	From   string `json:"from"` // YYYY-MM-DD, defaults to the start of the current year
+	To     string `json:"to"`   // YYYY-MM-DD, defaults to the end of the current year
+}
+
+// SettlementDateRequest represents the request to compute a T+N settlement date
+type SettlementDateRequest struct {
+	Region    string `json:"region" validate:"required,max=10"`
+	TradeDate string `json:"trade_date" validate:"required"` // YYYY-MM-DD
+	Days      int    `json:"days" validate:"min=0,max=30"`
+}
+
+// HolidayResponse represents the response structure for a holiday
+type HolidayResponse struct {
+	Region string `json:"region"`
+	Date   string `json:"date"`
+	Name   string `json:"name"`
+}
+
+// HolidayListResponse represents a region's holidays within a date range
+type HolidayListResponse struct {
+	Region   string            `json:"region"`
+	From     string            `json:"from"`
+	To       string            `json:"to"`
+	Holidays []HolidayResponse `json:"holidays"`
+}
+
+// BusinessDayResponse represents whether a date is a business day in a region
+type BusinessDayResponse struct {
+	Region          string `json:"region"`
+	Date            string `json:"date"`
+	IsBusinessDay   bool   `json:"is_business_day"`
+	Holiday         string `json:"holiday,omitempty"`
+	NextBusinessDay string `json:"next_business_day"`
+}
+
+// SettlementDateResponse represents a computed settlement date
+type SettlementDateResponse struct {
+	Region         string `json:"region"`
+	TradeDate      string `json:"trade_date"`
+	Days           int    `json:"days"`
+	SettlementDate string `json:"settlement_date"`
+}
@@ -0,0 +1,63 @@
+// internal/application/dto/cashback.go
+package dto
+
+import "time"
+
+// CreateCashbackCampaignRequest represents the request to start a cashback campaign
+type CreateCashbackCampaignRequest struct {
+	Name             string        `json:"name" validate:"required,max=100"`
+	Percentage       float64       `json:"percentage" validate:"gt=0,max=100"`
+	Cap              DecimalString `json:"cap" validate:"gt=0"` // Most paid back on a single payment
+	TransactionTypes []string      `json:"transaction_types" validate:"required,min=1,dive,oneof=DEBIT TRANSFER"`
+	StartsAt         time.Time     `json:"starts_at" validate:"required"`
+	EndsAt           time.Time     `json:"ends_at" validate:"required"`
+}
+
+// UpdateCashbackCampaignRequest represents the request to change or pause a cashback campaign
+type UpdateCashbackCampaignRequest struct {
+	ID               string        `json:"-" validate:"required"`
+	Name             string        `json:"name" validate:"required,max=100"`
+	Percentage       float64       `json:"percentage" validate:"gt=0,max=100"`
+	Cap              DecimalString `json:"cap" validate:"gt=0"`
+	TransactionTypes []string      `json:"transaction_types" validate:"required,min=1,dive,oneof=DEBIT TRANSFER"`
+	StartsAt         time.Time     `json:"starts_at" validate:"required"`
+	EndsAt           time.Time     `json:"ends_at" validate:"required"`
+	Active           *bool         `json:"active"` // Left unchanged when omitted
+}
+
+// CashbackCampaignResponse represents the response structure for a cashback campaign
+type CashbackCampaignResponse struct {
+	ID               string    `json:"id"`
+	Name             string    `json:"name"`
+	Percentage       float64   `json:"percentage"`
+	Cap              float64   `json:"cap"`
+	TransactionTypes []string  `json:"transaction_types"`
+	StartsAt         time.Time `json:"starts_at"`
+	EndsAt           time.Time `json:"ends_at"`
+	Active           bool      `json:"active"`
+	CreatedAt        time.Time `json:"created_at"`
+	UpdatedAt        time.Time `json:"updated_at"`
+}
+
+// CashbackCampaignListResponse represents the cashback campaigns, newest first
+type CashbackCampaignListResponse struct {
+	Campaigns []CashbackCampaignResponse `json:"campaigns"`
+}
+
+// CashbackRewardResponse represents cashback paid on a payment
+type CashbackRewardResponse struct {
+	ID                  string    `json:"id"`
+	CampaignID          string    `json:"campaign_id"`
+	TransactionID       string    `json:"transaction_id"`        // The payment that earned it
+	CreditTransactionID string    `json:"credit_transaction_id"` // The credit that paid it
+	Amount              float64   `json:"amount"`
+	CreatedAt           time.Time `json:"created_at"`
+}
+
+// CashbackHistoryResponse represents the cashback an account earned
+type CashbackHistoryResponse struct {
+	AccountID  string                   `json:"account_id"`
+	Total      float64                  `json:"total"`
+	Rewards    []CashbackRewardResponse `json:"rewards"`
+	Pagination PaginationInfo           `json:"pagination"`
+}
@@ -0,0 +1,94 @@
+// internal/application/dto/category.go
+package dto
+
+import "time"
+
+// CreateCategoryRequest represents the request to add a category to the taxonomy
+type CreateCategoryRequest struct {
+	Code       string `json:"code" validate:"required,max=30"`
+	Name       string `json:"name" validate:"required,max=100"`
+	ParentCode string `json:"parent_code" validate:"max=30"`
+}
+
+// UpdateCategoryRequest represents the request to rename or move a category
+type UpdateCategoryRequest struct {
+	Code       string `json:"-" validate:"required,max=30"`
+	Name       string `json:"name" validate:"required,max=100"`
+	ParentCode string `json:"parent_code" validate:"max=30"`
+}
+
+// CategoryResponse represents the response structure for a category
+type CategoryResponse struct {
+	Code       string    `json:"code"`
+	Name       string    `json:"name"`
+	ParentCode string    `json:"parent_code,omitempty"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+// CategoryListResponse represents the category taxonomy
+type CategoryListResponse struct {
+	Categories []CategoryResponse `json:"categories"`
+}
+
+// CreateCategoryRuleRequest represents the request to add a categorization rule
+type CreateCategoryRuleRequest struct {
+	CategoryCode string `json:"category_code" validate:"required,max=30"`
+	Field        string `json:"field" validate:"required,oneof=DESCRIPTION REFERENCE MERCHANT"`
+	Pattern      string `json:"pattern" validate:"required,max=100"` // Case-insensitive substring
+	Priority     int    `json:"priority" validate:"min=0"`           // Lower priorities are evaluated first
+}
+
+// CategoryRuleResponse represents the response structure for a categorization rule
+type CategoryRuleResponse struct {
+	ID           string    `json:"id"`
+	CategoryCode string    `json:"category_code"`
+	Field        string    `json:"field"`
+	Pattern      string    `json:"pattern"`
+	Priority     int       `json:"priority"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+// CategoryRuleListResponse represents the categorization rules in evaluation order
+type CategoryRuleListResponse struct {
+	Rules []CategoryRuleResponse `json:"rules"`
+}
+
+// SetTransactionCategoryRequest represents an account holder's category override for a transaction
+type SetTransactionCategoryRequest struct {
+	AccountID     string `json:"-" validate:"required"`
+	TransactionID string `json:"-" validate:"required"`
+	CategoryCode  string `json:"category_code" validate:"required,max=30"`
+}
+
+// CategorySummaryRequest represents the request for an account's totals per category
+type CategorySummaryRequest struct {
+	AccountID string `json:"account_id" validate:"required"`
+	From      string `json:"from"` // YYYY-MM-DD, defaults to the start of the current month
+	To        string `json:"to"`   // YYYY-MM-DD, defaults to today
+}
+
+// CategoryTotal represents the completed money movement of an account in one category
+type CategoryTotal struct {
+	Category string  `json:"category"`
+	Inflow   float64 `json:"inflow"`
+	Outflow  float64 `json:"outflow"`
+	Count    int     `json:"count"`
+}
+
+// ChannelTotal represents the completed money movement of an account through one channel
+type ChannelTotal struct {
+	Channel string  `json:"channel"`
+	Inflow  float64 `json:"inflow"`
+	Outflow float64 `json:"outflow"`
+	Count   int     `json:"count"`
+}
+
+// CategorySummaryResponse represents an account's totals per category and per channel within a date range
+type CategorySummaryResponse struct {
+	AccountID  string          `json:"account_id"`
+	From       string          `json:"from"`
+	To         string          `json:"to"`
+	Categories []CategoryTotal `json:"categories"`
+	Channels   []ChannelTotal  `json:"channels"`
+}
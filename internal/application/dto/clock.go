@@ -0,0 +1,18 @@
+package dto
+
+import "time"
+
+// AdvanceClockRequest represents an admin's request to move a sandbox's test clock forward
+type AdvanceClockRequest struct {
+	Duration    string `json:"duration" validate:"required,max=20"` // Go duration such as 36h or 90m
+	RequestedBy string `json:"requested_by" validate:"required,max=100"`
+	Reason      string `json:"reason" validate:"max=500"`
+}
+
+// ClockResponse represents the time a sandbox's test clock shows
+type ClockResponse struct {
+	Now           time.Time `json:"now"`
+	RealNow       time.Time `json:"real_now"`
+	Offset        string    `json:"offset"` // How far the clock is ahead of real time
+	OffsetSeconds int64     `json:"offset_seconds"`
+}
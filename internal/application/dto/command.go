@@ -0,0 +1,31 @@
+// internal/application/dto/command.go
+package dto
+
+import (
+	"time"
+)
+
+// Command result statuses
+const (
+	CommandStatusCompleted = "COMPLETED"
+	CommandStatusRejected  = "REJECTED"
+)
+
+// TransferCommand represents an asynchronous request to move funds between two accounts
+type TransferCommand struct {
+	CommandID     string        `json:"-" validate:"required,max=100"` // Taken from the message ID; used for idempotency
+	FromAccountID string        `json:"from_account_id" validate:"required"`
+	ToAccountID   string        `json:"to_account_id" validate:"required"`
+	Amount        DecimalString `json:"amount" validate:"required,gt=0"`
+	Description   string        `json:"description" validate:"max=500"`
+	Reference     string        `json:"reference" validate:"max=100"`
+}
+
+// CommandResult represents the outcome of an asynchronous command
+type CommandResult struct {
+	CommandID   string               `json:"command_id"`
+	Status      string               `json:"status"`
+	Transaction *TransactionResponse `json:"transaction,omitempty"`
+	Error       *ErrorResponse       `json:"error,omitempty"`
+	ProcessedAt time.Time            `json:"processed_at"`
+}
@@ -10,6 +10,11 @@ type ListRequest struct {
 	Search   string `json:"search" validate:"omitempty,max=100"`
 }
 
+// BatchGetRequest represents looking up several records by ID in one call
+type BatchGetRequest struct {
+	IDs []string `json:"ids" validate:"required,min=1,max=100,dive,required,max=30"`
+}
+
 // PaginationInfo represents pagination metadata
 type PaginationInfo struct {
 	Page       int   `json:"page"`
@@ -20,6 +25,20 @@ type PaginationInfo struct {
 	HasPrev    bool  `json:"has_prev"`
 }
 
+// NewPaginationInfo builds the metadata of one page of a result set holding totalItems
+func NewPaginationInfo(page, pageSize int, totalItems int64) PaginationInfo {
+	totalPages := int((totalItems + int64(pageSize) - 1) / int64(pageSize))
+
+	return PaginationInfo{
+		Page:       page,
+		PageSize:   pageSize,
+		TotalItems: totalItems,
+		TotalPages: totalPages,
+		HasNext:    page < totalPages,
+		HasPrev:    page > 1,
+	}
+}
+
 // ErrorResponse represents error response structure
 type ErrorResponse struct {
 	Code    string            `json:"code"`
@@ -0,0 +1,27 @@
+// internal/application/dto/customer.go
+package dto
+
+import "time"
+
+// CustomerSummaryRequest represents the request for a summary across a customer's accounts
+type CustomerSummaryRequest struct {
+	CustomerID string `json:"customer_id" validate:"required,max=50"`
+	Limit      int    `json:"limit" validate:"min=1,max=100"` // Maximum entries per transaction list
+}
+
+// CurrencyBalance represents the combined balance of a customer's accounts in one currency
+type CurrencyBalance struct {
+	Currency     string  `json:"currency"`
+	Total        float64 `json:"total"`
+	AccountCount int     `json:"account_count"`
+}
+
+// CustomerSummaryResponse represents balances and activity across a customer's accounts
+type CustomerSummaryResponse struct {
+	CustomerID          string                `json:"customer_id"`
+	AccountCount        int                   `json:"account_count"`
+	Balances            []CurrencyBalance     `json:"balances"`
+	RecentActivity      []TransactionResponse `json:"recent_activity"`
+	PendingTransactions []TransactionResponse `json:"pending_transactions"`
+	GeneratedAt         time.Time             `json:"generated_at"`
+}
@@ -0,0 +1,38 @@
+// internal/application/dto/daily_aggregate.go
+package dto
+
+// DailyTotalsRequest represents the request for an account's daily totals
+type DailyTotalsRequest struct {
+	AccountID string `json:"account_id" validate:"required"`
+	From      string `json:"from"` // YYYY-MM-DD, defaults to the start of the current month
+	To        string `json:"to"`   // YYYY-MM-DD, defaults to today
+}
+
+// DailyTotal represents an account's completed money movement on one day
+type DailyTotal struct {
+	Date    string  `json:"date"` // YYYY-MM-DD
+	Inflow  float64 `json:"inflow"`
+	Outflow float64 `json:"outflow"` // Fees included
+	Net     float64 `json:"net"`
+	Count   int     `json:"count"`
+}
+
+// DailyTotalsResponse represents an account's daily totals within a date range, oldest first, with the
+// range's totals. Days without completed transactions are left out.
+type DailyTotalsResponse struct {
+	AccountID string       `json:"account_id"`
+	From      string       `json:"from"`
+	To        string       `json:"to"`
+	Days      []DailyTotal `json:"days"`
+	Inflow    float64      `json:"inflow"`
+	Outflow   float64      `json:"outflow"`
+	Net       float64      `json:"net"`
+	Count     int          `json:"count"`
+}
+
+// DailyTotalsRebuildResponse represents the outcome of recomputing an account's daily totals
+type DailyTotalsRebuildResponse struct {
+	AccountID    string `json:"account_id"`
+	Days         int    `json:"days"`
+	Transactions int    `json:"transactions"`
+}
@@ -0,0 +1,73 @@
+// internal/application/dto/decimal.go
+package dto
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"github.com/shopspring/decimal"
+)
+
+// DecimalString is an amount in a request. It binds from a JSON number or a numeric string straight into
+// a decimal, so values such as 0.1 never pass through a float64 on their way to the use case.
+type DecimalString struct {
+	decimal.Decimal
+}
+
+// InvalidDecimalError is returned when a request amount is not a decimal number
+type InvalidDecimalError struct {
+	Input string
+}
+
+func (e *InvalidDecimalError) Error() string {
+	return fmt.Sprintf("%s is not a valid amount; use a decimal number such as 10.50 or \"10.50\"", e.Input)
+}
+
+// NewDecimalString wraps a decimal as a request amount
+func NewDecimalString(amount decimal.Decimal) DecimalString {
+	return DecimalString{Decimal: amount}
+}
+
+// NewDecimalStringFromFloat creates a request amount from a float64
+func NewDecimalStringFromFloat(amount float64) DecimalString {
+	return NewDecimalString(decimal.NewFromFloat(amount))
+}
+
+// ParseDecimalString parses a request amount given outside a JSON body, e.g. in a query parameter
+func ParseDecimalString(input string) (DecimalString, error) {
+	amount, err := decimal.NewFromString(input)
+	if err != nil {
+		return DecimalString{}, &InvalidDecimalError{Input: fmt.Sprintf("%q", input)}
+	}
+	return NewDecimalString(amount), nil
+}
+
+// UnmarshalJSON accepts 10.50 and "10.50" alike; null leaves the amount unchanged
+func (d *DecimalString) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+
+	input := data
+	if len(data) >= 2 && data[0] == '"' && data[len(data)-1] == '"' {
+		input = data[1 : len(data)-1]
+	}
+	amount, err := decimal.NewFromString(string(input))
+	if err != nil {
+		return &InvalidDecimalError{Input: string(data)}
+	}
+
+	d.Decimal = amount
+	return nil
+}
+
+// MarshalJSON renders the amount as a JSON number with its exact digits
+func (d DecimalString) MarshalJSON() ([]byte, error) {
+	return []byte(d.Decimal.String()), nil
+}
+
+// Money converts the amount to domain money
+func (d DecimalString) Money() vo.Money {
+	return vo.NewMoney(d.Decimal)
+}
@@ -0,0 +1,17 @@
+package dto
+
+import "time"
+
+// ExchangeRateResponse represents the rate one unit of a currency converts to another at
+type ExchangeRateResponse struct {
+	From string    `json:"from"`
+	To   string    `json:"to"`
+	Rate float64   `json:"rate"`
+	AsOf time.Time `json:"as_of"` // When the provider quoted the rate
+}
+
+// ExchangeRateListResponse represents the current rates from a base currency
+type ExchangeRateListResponse struct {
+	Base  string                 `json:"base"`
+	Rates []ExchangeRateResponse `json:"rates"`
+}
@@ -0,0 +1,58 @@
+// internal/application/dto/export.go
+package dto
+
+import (
+	"io"
+	"time"
+)
+
+// ExportResponse represents an asynchronous export; DownloadURL is set once it can be downloaded
+type ExportResponse struct {
+	ID                   string     `json:"id"`
+	Kind                 string     `json:"kind"`
+	Status               string     `json:"status"`
+	AccountID            string     `json:"account_id,omitempty"`
+	FileName             string     `json:"file_name"`
+	ContentType          string     `json:"content_type"`
+	SizeBytes            int64      `json:"size_bytes"`
+	Error                string     `json:"error,omitempty"`
+	RequestedBy          string     `json:"requested_by"`
+	CreatedAt            time.Time  `json:"created_at"`
+	CompletedAt          *time.Time `json:"completed_at,omitempty"`
+	ExpiresAt            time.Time  `json:"expires_at"`
+	DownloadURL          string     `json:"download_url,omitempty"`
+	DownloadURLExpiresAt *time.Time `json:"download_url_expires_at,omitempty"`
+
+	CallbackURL         string     `json:"callback_url,omitempty"`
+	CallbackSecret      string     `json:"callback_secret,omitempty"` // Only returned when the export is started
+	CallbackDeliveredAt *time.Time `json:"callback_delivered_at,omitempty"`
+	CallbackError       string     `json:"callback_error,omitempty"`
+}
+
+// HistoryExportRequest represents the optional body of an account history export
+type HistoryExportRequest struct {
+	CallbackURL string `json:"callback_url" validate:"omitempty,max=500"` // Notified once the export finishes
+}
+
+// ExportCallbackPayload is the JSON body posted to an export's callback URL once it finishes
+type ExportCallbackPayload struct {
+	ID         string         `json:"id"` // Stable across retries of the same notification
+	Event      string         `json:"event"`
+	OccurredAt time.Time      `json:"occurred_at"`
+	Data       ExportResponse `json:"data"`
+}
+
+// DownloadRequest represents a signed download link of an export
+type DownloadRequest struct {
+	ExportID  string `json:"export_id" validate:"required,max=32"`
+	Expires   int64  `json:"expires" validate:"required"` // Unix time the link expires at
+	Signature string `json:"signature" validate:"required,hexadecimal"`
+}
+
+// ExportDownload is an export's file opened for download; the caller closes Content
+type ExportDownload struct {
+	Content     io.ReadCloser
+	FileName    string
+	ContentType string
+	SizeBytes   int64
+}
@@ -0,0 +1,78 @@
+// internal/application/dto/general_ledger.go
+package dto
+
+// GLAccountResponse represents an account of the general ledger's chart of accounts
+type GLAccountResponse struct {
+	Code       string `json:"code"`
+	Name       string `json:"name"`
+	Type       string `json:"type"`
+	NormalSide string `json:"normal_side"` // Side that increases the account
+}
+
+// GLAccountListResponse represents the chart of accounts, by code
+type GLAccountListResponse struct {
+	Accounts []GLAccountResponse `json:"accounts"`
+}
+
+// GLPostingResponse represents one line of a transaction's journal entry
+type GLPostingResponse struct {
+	Line        int     `json:"line"`
+	Account     string  `json:"account"`
+	AccountName string  `json:"account_name"`
+	Side        string  `json:"side"`
+	Amount      float64 `json:"amount"`
+	Period      string  `json:"period"`
+	ValueDate   string  `json:"value_date"` // YYYY-MM-DD
+}
+
+// TransactionPostingsResponse represents the journal entry a transaction was booked as
+type TransactionPostingsResponse struct {
+	TransactionID string              `json:"transaction_id"`
+	Postings      []GLPostingResponse `json:"postings"`
+}
+
+// TrialBalanceLine represents the postings to one GL account in a period
+type TrialBalanceLine struct {
+	Code    string  `json:"code"`
+	Name    string  `json:"name"`
+	Type    string  `json:"type"`
+	Debit   float64 `json:"debit"`
+	Credit  float64 `json:"credit"`
+	Balance float64 `json:"balance"` // Net movement on the account's normal side
+}
+
+// TrialBalanceResponse represents the trial balance of a period: every GL account with its debits and
+// credits, and whether total debits equal total credits
+type TrialBalanceResponse struct {
+	Period      string             `json:"period"`
+	Lines       []TrialBalanceLine `json:"lines"`
+	TotalDebit  float64            `json:"total_debit"`
+	TotalCredit float64            `json:"total_credit"`
+	Balanced    bool               `json:"balanced"`
+}
+
+// RevenueReportRequest represents the range of accounting periods a revenue report covers
+type RevenueReportRequest struct {
+	From string `json:"from"` // YYYY-MM, defaults to the current period
+	To   string `json:"to"`   // YYYY-MM, defaults to From
+}
+
+// RevenueReportRow represents the fees collected from and the interest paid to the accounts of one
+// product in one period
+type RevenueReportRow struct {
+	Period        string  `json:"period"`
+	ProductID     string  `json:"product_id"` // Empty for accounts opened without a product
+	ProductName   string  `json:"product_name"`
+	FeesCollected float64 `json:"fees_collected"` // Net of fee refunds
+	InterestPaid  float64 `json:"interest_paid"`
+}
+
+// RevenueReportResponse represents fee revenue and interest expense per period and product, from the
+// general ledger, with the range's totals
+type RevenueReportResponse struct {
+	From               string             `json:"from"`
+	To                 string             `json:"to"`
+	Rows               []RevenueReportRow `json:"rows"`
+	TotalFeesCollected float64            `json:"total_fees_collected"`
+	TotalInterestPaid  float64            `json:"total_interest_paid"`
+}
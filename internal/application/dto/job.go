@@ -0,0 +1,38 @@
+// internal/application/dto/job.go
+package dto
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// JobResponse represents a background job and its progress
+type JobResponse struct {
+	ID          string          `json:"id"`
+	Type        string          `json:"type"`
+	Status      string          `json:"status"`
+	Payload     json.RawMessage `json:"payload,omitempty"`
+	Attempts    int             `json:"attempts"`
+	MaxAttempts int             `json:"max_attempts"`
+	RunAt       time.Time       `json:"run_at"`
+	LockedBy    string          `json:"locked_by,omitempty"`
+	LockedUntil *time.Time      `json:"locked_until,omitempty"`
+	LastError   string          `json:"last_error,omitempty"`
+	CreatedAt   time.Time       `json:"created_at"`
+	StartedAt   *time.Time      `json:"started_at,omitempty"`
+	FinishedAt  *time.Time      `json:"finished_at,omitempty"`
+}
+
+// JobListRequest represents the request to list jobs, optionally of one type or status
+type JobListRequest struct {
+	Page     int    `json:"page" validate:"min=1"`
+	PageSize int    `json:"page_size" validate:"min=1,max=100"`
+	Type     string `json:"type" validate:"omitempty,max=50"`
+	Status   string `json:"status" validate:"omitempty,oneof=QUEUED RUNNING SUCCEEDED FAILED"`
+}
+
+// JobListResponse represents paginated job list response
+type JobListResponse struct {
+	Jobs       []JobResponse  `json:"jobs"`
+	Pagination PaginationInfo `json:"pagination"`
+}
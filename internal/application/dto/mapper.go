@@ -2,6 +2,8 @@
 package dto
 
 import (
+	"time"
+
 	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
 	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
 )
@@ -11,14 +13,29 @@ type AccountMapper struct{}
 
 // ToResponse converts Account entity to AccountResponse DTO
 func (m *AccountMapper) ToResponse(account *entity.Account) AccountResponse {
-	return AccountResponse{
+	response := AccountResponse{
 		ID:          account.ID.String(),
 		AccountName: account.AccountName,
+		CustomerID:  account.CustomerID,
+		ProductID:   account.ProductID,
 		Balance:     account.Balance.Amount().InexactFloat64(),
+		Currency:    account.Currency().String(),
 		Status:      string(account.Status),
 		CreatedAt:   account.CreatedAt,
 		UpdatedAt:   account.UpdatedAt,
 	}
+
+	if account.ParentAccountID != nil {
+		parentID := account.ParentAccountID.String()
+		response.ParentAccountID = &parentID
+	}
+
+	if account.SpendingLimit != nil {
+		limit := account.SpendingLimit.InexactFloat64()
+		response.SpendingLimit = &limit
+	}
+
+	return response
 }
 
 // ToResponseList converts slice of Account entities to AccountListResponse DTO
@@ -34,26 +51,61 @@ func (m *AccountMapper) ToResponseList(accounts []*entity.Account, pagination Pa
 	}
 }
 
-// FromCreateRequest converts CreateAccountRequest DTO to domain values
-func (m *AccountMapper) FromCreateRequest(req CreateAccountRequest) (string, vo.Money, error) {
-	money := vo.NewMoneyFromFloat(req.InitialBalance)
+// ToNameHistory converts an account's renames to AccountNameChangeResponse DTOs
+func (m *AccountMapper) ToNameHistory(changes []*entity.AccountNameChange) []AccountNameChangeResponse {
+	responses := make([]AccountNameChangeResponse, len(changes))
+	for i, change := range changes {
+		responses[i] = AccountNameChangeResponse{
+			PreviousName: change.PreviousName,
+			NewName:      change.NewName,
+			ChangedAt:    change.ChangedAt,
+		}
+	}
+	return responses
+}
+
+// FromCreateRequest converts AccountRequest DTO to domain values
+func (m *AccountMapper) FromCreateRequest(req AccountRequest) (string, vo.Money, error) {
+	money := vo.ZeroMoney()
+	if req.InitialBalance != nil {
+		money = req.InitialBalance.Money()
+	}
 	return req.AccountName, money, nil
 }
 
 // TransactionMapper provides mapping between Transaction entity and DTOs
-type TransactionMapper struct{}
+type TransactionMapper struct {
+	Normalizers *vo.TextNormalizers // Cleans descriptions and references of created transactions
+}
 
 // ToResponse converts Transaction entity to TransactionResponse DTO
 func (m *TransactionMapper) ToResponse(transaction *entity.Transaction) TransactionResponse {
 	response := TransactionResponse{
-		ID:              transaction.ID.String(),
-		TransactionType: string(transaction.TransactionType),
-		Amount:          transaction.Amount.Amount().InexactFloat64(),
-		Description:     transaction.Description,
-		Reference:       transaction.Reference,
-		Status:          string(transaction.Status),
-		CreatedAt:       transaction.CreatedAt,
-		CompletedAt:     transaction.CompletedAt,
+		ID:               transaction.ID.String(),
+		TransactionType:  string(transaction.TransactionType),
+		Channel:          string(transaction.Channel),
+		Amount:           transaction.Amount.Amount().InexactFloat64(),
+		Fee:              transaction.Fee.Amount().InexactFloat64(),
+		Currency:         transaction.Currency().String(),
+		Description:      transaction.Description,
+		Reference:        transaction.Reference,
+		ReferenceType:    string(transaction.ReferenceType),
+		VirtualAccountID: transaction.VirtualAccountID,
+		Merchant:         transaction.Merchant,
+		Category:         transaction.Category,
+		Status:           string(transaction.Status),
+		CreatedAt:        transaction.CreatedAt,
+		ValueDate:        transaction.ValueDate.Format("2006-01-02"),
+		CompletedAt:      transaction.CompletedAt,
+		ReviewReason:     transaction.ReviewReason,
+		ReviewHeldAt:     transaction.ReviewHeldAt,
+		ReviewDueAt:      transaction.ReviewDueAt,
+		ReviewedBy:       transaction.ReviewedBy,
+		FailureKind:      string(transaction.FailureKind),
+		FailureReason:    transaction.FailureReason,
+		ReplayCount:      transaction.ReplayCount,
+		AdjustmentReason: string(transaction.AdjustmentReason),
+		RequestedBy:      transaction.RequestedBy,
 	}
 
 	if transaction.FromAccountID != nil {
@@ -66,6 +118,24 @@ func (m *TransactionMapper) ToResponse(transaction *entity.Transaction) Transact
 		response.ToAccountID = &toID
 	}
 
+	if transaction.AdjustsID != nil {
+		adjustsID := transaction.AdjustsID.String()
+		response.AdjustsID = &adjustsID
+	}
+
+	if transaction.ReversedTransactionID != nil {
+		reversedID := transaction.ReversedTransactionID.String()
+		response.ReversedTransactionID = &reversedID
+	}
+
+	if rate := transaction.ExchangeRate; rate != nil {
+		asOf := rate.AsOf
+		response.ExchangeRate = rate.Rate.InexactFloat64()
+		response.ExchangeRateAsOf = &asOf
+		response.ConvertedAmount = transaction.ConvertedAmount.Amount().InexactFloat64()
+		response.ConvertedCurrency = transaction.ConvertedAmount.Currency().String()
+	}
+
 	return response
 }
 
@@ -93,7 +163,7 @@ func (m *TransactionMapper) FromCreateRequest(req CreateTransactionRequest) (
 	err error,
 ) {
 	// Parse amount
-	amount = vo.NewMoneyFromFloat(req.Amount)
+	amount = req.Amount.Money()
 
 	// Parse transaction type
 	transactionType = vo.TransactionType(req.TransactionType)
@@ -115,8 +185,842 @@ func (m *TransactionMapper) FromCreateRequest(req CreateTransactionRequest) (
 		toAccountID = &toID
 	}
 
-	description = req.Description
-	reference = req.Reference
+	// An invalid channel is rejected by the caller, so it only falls back to the default normalization here
+	channel, _ := vo.NewTransactionChannel(req.Channel)
+	normalization := m.Normalizers.For(channel)
+	description = normalization.Normalize(req.Description)
+	reference = normalization.Normalize(req.Reference)
 
 	return fromAccountID, toAccountID, transactionType, amount, description, reference, nil
 }
+
+// BackupMapper provides mapping between Backup entity and DTOs
+type BackupMapper struct{}
+
+// ToResponse converts Backup entity to BackupResponse DTO
+func (m *BackupMapper) ToResponse(backup *entity.Backup) BackupResponse {
+	return BackupResponse{
+		ID:          backup.ID,
+		Location:    backup.Location,
+		SizeBytes:   backup.SizeBytes,
+		Status:      string(backup.Status),
+		Error:       backup.Error,
+		RequestedBy: backup.RequestedBy,
+		StartedAt:   backup.StartedAt,
+		CompletedAt: backup.CompletedAt,
+	}
+}
+
+// ToResponseList converts slice of Backup entities to BackupListResponse DTO
+func (m *BackupMapper) ToResponseList(backups []*entity.Backup, pagination PaginationInfo) BackupListResponse {
+	responses := make([]BackupResponse, len(backups))
+	for i, backup := range backups {
+		responses[i] = m.ToResponse(backup)
+	}
+
+	return BackupListResponse{
+		Backups:    responses,
+		Pagination: pagination,
+	}
+}
+
+// ExportMapper provides mapping between Export entity and DTOs
+type ExportMapper struct{}
+
+// ToResponse converts Export entity to ExportResponse DTO, without a download URL
+func (m *ExportMapper) ToResponse(export *entity.Export) ExportResponse {
+	return ExportResponse{
+		ID:          export.ID,
+		Kind:        string(export.Kind),
+		Status:      string(export.Status),
+		AccountID:   export.AccountID,
+		FileName:    export.FileName,
+		ContentType: export.ContentType,
+		SizeBytes:   export.SizeBytes,
+		Error:       export.Error,
+		RequestedBy: export.RequestedBy,
+		CreatedAt:   export.CreatedAt,
+		CompletedAt: export.CompletedAt,
+		ExpiresAt:   export.ExpiresAt,
+
+		CallbackURL:         export.CallbackURL,
+		CallbackDeliveredAt: export.CallbackDeliveredAt,
+		CallbackError:       export.CallbackError,
+	}
+}
+
+// JobMapper provides mapping between Job entity and DTOs
+type JobMapper struct{}
+
+// ToResponse converts Job entity to JobResponse DTO
+func (m *JobMapper) ToResponse(job *entity.Job) JobResponse {
+	return JobResponse{
+		ID:          job.ID,
+		Type:        job.Type,
+		Status:      string(job.Status),
+		Payload:     job.Payload,
+		Attempts:    job.Attempts,
+		MaxAttempts: job.MaxAttempts,
+		RunAt:       job.RunAt,
+		LockedBy:    job.LockedBy,
+		LockedUntil: job.LockedUntil,
+		LastError:   job.LastError,
+		CreatedAt:   job.CreatedAt,
+		StartedAt:   job.StartedAt,
+		FinishedAt:  job.FinishedAt,
+	}
+}
+
+// ToResponseList converts slice of Job entities to JobListResponse DTO
+func (m *JobMapper) ToResponseList(jobs []*entity.Job, pagination PaginationInfo) JobListResponse {
+	responses := make([]JobResponse, len(jobs))
+	for i, job := range jobs {
+		responses[i] = m.ToResponse(job)
+	}
+
+	return JobListResponse{
+		Jobs:       responses,
+		Pagination: pagination,
+	}
+}
+
+// SagaMapper provides mapping between Saga entity and DTOs
+type SagaMapper struct{}
+
+// ToResponse converts Saga entity to SagaResponse DTO
+func (m *SagaMapper) ToResponse(saga *entity.Saga) SagaResponse {
+	steps := make([]SagaStepResponse, len(saga.Steps))
+	for i, step := range saga.Steps {
+		steps[i] = SagaStepResponse{
+			Name:      step.Name,
+			Status:    string(step.Status),
+			Error:     step.Error,
+			UpdatedAt: step.UpdatedAt,
+		}
+	}
+
+	return SagaResponse{
+		ID:            saga.ID,
+		Type:          saga.Type,
+		TransactionID: saga.TransactionID.String(),
+		Status:        string(saga.Status),
+		Steps:         steps,
+		Error:         saga.Error,
+		CreatedAt:     saga.CreatedAt,
+		UpdatedAt:     saga.UpdatedAt,
+	}
+}
+
+// HolidayMapper provides mapping between Holiday entity and DTOs
+type HolidayMapper struct{}
+
+// ToResponse converts Holiday entity to HolidayResponse DTO
+func (m *HolidayMapper) ToResponse(holiday *entity.Holiday) HolidayResponse {
+	return HolidayResponse{
+		Region: holiday.Region,
+		Date:   holiday.Date.Format("2006-01-02"),
+		Name:   holiday.Name,
+	}
+}
+
+// CategoryMapper provides mapping between Category entities and DTOs
+type CategoryMapper struct{}
+
+// ToResponse converts Category entity to CategoryResponse DTO
+func (m *CategoryMapper) ToResponse(category *entity.Category) CategoryResponse {
+	return CategoryResponse{
+		Code:       category.Code,
+		Name:       category.Name,
+		ParentCode: category.ParentCode,
+		CreatedAt:  category.CreatedAt,
+		UpdatedAt:  category.UpdatedAt,
+	}
+}
+
+// ToRuleResponse converts CategoryRule entity to CategoryRuleResponse DTO
+func (m *CategoryMapper) ToRuleResponse(rule *entity.CategoryRule) CategoryRuleResponse {
+	return CategoryRuleResponse{
+		ID:           rule.ID,
+		CategoryCode: rule.CategoryCode,
+		Field:        string(rule.Field),
+		Pattern:      rule.Pattern,
+		Priority:     rule.Priority,
+		CreatedAt:    rule.CreatedAt,
+	}
+}
+
+// BusinessRuleMapper provides mapping between BusinessRule entity and DTOs
+type BusinessRuleMapper struct{}
+
+// ToResponse converts BusinessRule entity to BusinessRuleResponse DTO
+func (m *BusinessRuleMapper) ToResponse(rule *entity.BusinessRule) BusinessRuleResponse {
+	return BusinessRuleResponse{
+		ID:         rule.ID,
+		Name:       rule.Name,
+		Kind:       string(rule.Kind),
+		Expression: rule.Expression,
+		Message:    rule.Message,
+		Priority:   rule.Priority,
+		Enabled:    rule.Enabled,
+		CreatedAt:  rule.CreatedAt,
+		UpdatedAt:  rule.UpdatedAt,
+	}
+}
+
+// ProductMapper provides mapping between Product entity and DTOs
+type ProductMapper struct{}
+
+// ToResponse converts Product entity to ProductResponse DTO
+func (m *ProductMapper) ToResponse(product *entity.Product) ProductResponse {
+	response := ProductResponse{
+		ID:           product.ID,
+		Name:         product.Name,
+		Type:         string(product.Type),
+		Currency:     product.Currency,
+		InterestRate: product.InterestRate.InexactFloat64(),
+		Fees: ProductFees{
+			DebitFee:    NewDecimalString(product.Fees.DebitFee.Amount()),
+			TransferFee: NewDecimalString(product.Fees.TransferFee.Amount()),
+		},
+		Limits: ProductLimits{
+			MinOpeningBalance: NewDecimalString(product.Limits.MinOpeningBalance.Amount()),
+		},
+		Active:    product.Active,
+		CreatedAt: product.CreatedAt,
+		UpdatedAt: product.UpdatedAt,
+	}
+
+	if product.Limits.MaxTransactionAmount != nil {
+		limit := NewDecimalString(product.Limits.MaxTransactionAmount.Amount())
+		response.Limits.MaxTransactionAmount = &limit
+	}
+
+	return response
+}
+
+// FromRequest converts a product's fees and limits to domain values
+func (m *ProductMapper) FromRequest(fees ProductFees, limits ProductLimits) (entity.ProductFees, entity.ProductLimits) {
+	domainLimits := entity.ProductLimits{
+		MinOpeningBalance: limits.MinOpeningBalance.Money(),
+	}
+	if limits.MaxTransactionAmount != nil {
+		limit := limits.MaxTransactionAmount.Money()
+		domainLimits.MaxTransactionAmount = &limit
+	}
+
+	return entity.ProductFees{
+		DebitFee:    fees.DebitFee.Money(),
+		TransferFee: fees.TransferFee.Money(),
+	}, domainLimits
+}
+
+// CashbackMapper provides mapping between cashback entities and DTOs
+type CashbackMapper struct{}
+
+// ToCampaignResponse converts CashbackCampaign entity to CashbackCampaignResponse DTO
+func (m *CashbackMapper) ToCampaignResponse(campaign *entity.CashbackCampaign) CashbackCampaignResponse {
+	transactionTypes := make([]string, len(campaign.TransactionTypes))
+	for i, transactionType := range campaign.TransactionTypes {
+		transactionTypes[i] = string(transactionType)
+	}
+
+	return CashbackCampaignResponse{
+		ID:               campaign.ID,
+		Name:             campaign.Name,
+		Percentage:       campaign.Percentage.InexactFloat64(),
+		Cap:              campaign.Cap.InexactFloat64(),
+		TransactionTypes: transactionTypes,
+		StartsAt:         campaign.StartsAt,
+		EndsAt:           campaign.EndsAt,
+		Active:           campaign.Active,
+		CreatedAt:        campaign.CreatedAt,
+		UpdatedAt:        campaign.UpdatedAt,
+	}
+}
+
+// ToRewardResponse converts CashbackReward entity to CashbackRewardResponse DTO
+func (m *CashbackMapper) ToRewardResponse(reward *entity.CashbackReward) CashbackRewardResponse {
+	return CashbackRewardResponse{
+		ID:                  reward.ID,
+		CampaignID:          reward.CampaignID,
+		TransactionID:       reward.TransactionID.String(),
+		CreditTransactionID: reward.CreditTransactionID.String(),
+		Amount:              reward.Amount.InexactFloat64(),
+		CreatedAt:           reward.CreatedAt,
+	}
+}
+
+// TransactionHistoryMapper provides mapping between history entries and DTOs
+type TransactionHistoryMapper struct{}
+
+// ToResponse converts HistoryEntry entity to HistoryEntryResponse DTO
+func (m *TransactionHistoryMapper) ToResponse(entry *entity.HistoryEntry) HistoryEntryResponse {
+	response := HistoryEntryResponse{
+		TransactionID:    entry.TransactionID.String(),
+		Sequence:         entry.Sequence,
+		TransactionType:  string(entry.TransactionType),
+		Direction:        string(entry.Direction),
+		CounterpartyName: entry.CounterpartyName,
+		Amount:           entry.Amount.InexactFloat64(),
+		Fee:              entry.Fee.InexactFloat64(),
+		Change:           entry.Change.InexactFloat64(),
+		Category:         entry.Category,
+		Tags:             []string{},
+		Status:           string(entry.Status),
+		Description:      entry.Description,
+		Reference:        entry.Reference,
+		CreatedAt:        entry.CreatedAt,
+		CompletedAt:      entry.CompletedAt,
+	}
+
+	if entry.CounterpartyID != nil {
+		counterpartyID := entry.CounterpartyID.String()
+		response.CounterpartyID = &counterpartyID
+	}
+	if entry.BalanceAfter != nil {
+		balance := entry.BalanceAfter.InexactFloat64()
+		response.BalanceAfter = &balance
+	}
+
+	return response
+}
+
+// NotificationTemplateMapper provides mapping between NotificationTemplate entity and DTOs
+type NotificationTemplateMapper struct{}
+
+// ToResponse converts NotificationTemplate entity to NotificationTemplateResponse DTO
+func (m *NotificationTemplateMapper) ToResponse(template *entity.NotificationTemplate) NotificationTemplateResponse {
+	return NotificationTemplateResponse{
+		ID:        template.ID,
+		Event:     template.Event,
+		Channel:   string(template.Channel),
+		Locale:    template.Locale,
+		Version:   template.Version,
+		Subject:   template.Subject,
+		Body:      template.Body,
+		CreatedBy: template.CreatedBy,
+		CreatedAt: template.CreatedAt,
+	}
+}
+
+// ToResponseList converts NotificationTemplate entities to a NotificationTemplateListResponse DTO
+func (m *NotificationTemplateMapper) ToResponseList(templates []*entity.NotificationTemplate) NotificationTemplateListResponse {
+	responses := make([]NotificationTemplateResponse, len(templates))
+	for i, template := range templates {
+		responses[i] = m.ToResponse(template)
+	}
+	return NotificationTemplateListResponse{Templates: responses}
+}
+
+// NotificationPreferenceMapper provides mapping between NotificationPreference entity and DTOs
+type NotificationPreferenceMapper struct{}
+
+// ToResponse converts NotificationPreference entity to NotificationPreferenceResponse DTO
+func (m *NotificationPreferenceMapper) ToResponse(preference *entity.NotificationPreference) NotificationPreferenceResponse {
+	recipients := make(map[string]string, len(preference.Recipients))
+	for channel, recipient := range preference.Recipients {
+		recipients[string(channel)] = recipient
+	}
+
+	events := make(map[string][]string, len(preference.Events))
+	for event, channels := range preference.Events {
+		names := make([]string, len(channels))
+		for i, channel := range channels {
+			names[i] = string(channel)
+		}
+		events[event] = names
+	}
+
+	response := NotificationPreferenceResponse{
+		CustomerID: preference.CustomerID,
+		Locale:     preference.Locale,
+		Timezone:   preference.Timezone,
+		Recipients: recipients,
+		Events:     events,
+	}
+	if preference.QuietHours != nil {
+		response.QuietHours = &QuietHoursRequest{Start: preference.QuietHours.Start, End: preference.QuietHours.End}
+	}
+	if preference.MinAmount != nil {
+		minAmount := preference.MinAmount.InexactFloat64()
+		response.MinAmount = &minAmount
+	}
+	if !preference.UpdatedAt.IsZero() {
+		updatedAt := preference.UpdatedAt
+		response.UpdatedAt = &updatedAt
+	}
+	return response
+}
+
+// AccountingPeriodMapper provides mapping between AccountingPeriod entity and DTOs
+type AccountingPeriodMapper struct{}
+
+// ToResponse converts the close that locked a period to AccountingPeriodResponse DTO; an open period has none
+func (m *AccountingPeriodMapper) ToResponse(period string, closing *entity.AccountingPeriod) AccountingPeriodResponse {
+	if closing == nil {
+		return AccountingPeriodResponse{Period: period, Status: string(entity.AccountingPeriodOpen)}
+	}
+
+	closedAt := closing.ClosedAt
+	return AccountingPeriodResponse{
+		Period:   period,
+		Status:   string(entity.AccountingPeriodClosed),
+		ClosedBy: closing.ClosedBy,
+		Reason:   closing.Reason,
+		ClosedAt: &closedAt,
+	}
+}
+
+// DailyAggregateMapper provides mapping between daily aggregates and DTOs
+type DailyAggregateMapper struct{}
+
+// ToResponse converts DailyAggregate entity to DailyTotal DTO
+func (m *DailyAggregateMapper) ToResponse(aggregate *entity.DailyAggregate) DailyTotal {
+	return DailyTotal{
+		Date:    aggregate.Date.Format("2006-01-02"),
+		Inflow:  aggregate.Inflow.InexactFloat64(),
+		Outflow: aggregate.Outflow.InexactFloat64(),
+		Net:     aggregate.Net().InexactFloat64(),
+		Count:   aggregate.Count,
+	}
+}
+
+// ReferralMapper provides mapping between referral entities and DTOs
+type ReferralMapper struct{}
+
+// ToCodeResponse converts ReferralCode entity to ReferralCodeResponse DTO
+func (m *ReferralMapper) ToCodeResponse(code *entity.ReferralCode) ReferralCodeResponse {
+	return ReferralCodeResponse{
+		Code:      code.Code,
+		AccountID: code.AccountID.String(),
+		CreatedAt: code.CreatedAt,
+	}
+}
+
+// ToResponse converts Referral entity to ReferralResponse DTO
+func (m *ReferralMapper) ToResponse(referral *entity.Referral) ReferralResponse {
+	response := ReferralResponse{
+		ID:                referral.ID,
+		Code:              referral.Code,
+		ReferrerAccountID: referral.ReferrerAccountID.String(),
+		RefereeAccountID:  referral.RefereeAccountID.String(),
+		Status:            string(referral.Status),
+		CreatedAt:         referral.CreatedAt,
+		RewardedAt:        referral.RewardedAt,
+	}
+
+	if referral.QualifyingTransactionID != nil {
+		response.QualifyingTransactionID = referral.QualifyingTransactionID.String()
+	}
+	if referral.ReferrerCreditID != nil {
+		response.ReferrerCreditID = referral.ReferrerCreditID.String()
+	}
+	if referral.RefereeCreditID != nil {
+		response.RefereeCreditID = referral.RefereeCreditID.String()
+	}
+
+	return response
+}
+
+// TransferTemplateMapper provides mapping between TransferTemplate entity and DTOs
+type TransferTemplateMapper struct{}
+
+// ToResponse converts TransferTemplate entity to TransferTemplateResponse DTO
+func (m *TransferTemplateMapper) ToResponse(template *entity.TransferTemplate) TransferTemplateResponse {
+	return TransferTemplateResponse{
+		ID:          template.ID,
+		AccountID:   template.AccountID.String(),
+		Name:        template.Name,
+		ToAccountID: template.ToAccountID.String(),
+		Amount:      template.Amount.InexactFloat64(),
+		Description: template.Description,
+		Reference:   template.Reference,
+		UseCount:    template.UseCount,
+		LastUsedAt:  template.LastUsedAt,
+		CreatedAt:   template.CreatedAt,
+		UpdatedAt:   template.UpdatedAt,
+	}
+}
+
+// ScheduledTransactionMapper provides mapping between ScheduledTransaction entity and DTOs
+type ScheduledTransactionMapper struct{}
+
+// ToResponse converts ScheduledTransaction entity to ScheduledTransactionResponse DTO
+func (m *ScheduledTransactionMapper) ToResponse(scheduled *entity.ScheduledTransaction) ScheduledTransactionResponse {
+	return ScheduledTransactionResponse{
+		ID:                scheduled.ID,
+		FromAccountID:     scheduled.FromAccountID.String(),
+		ToAccountID:       scheduled.ToAccountID.String(),
+		Amount:            scheduled.Amount.InexactFloat64(),
+		Description:       scheduled.Description,
+		Reference:         scheduled.Reference,
+		Cron:              scheduled.Cron,
+		StartAt:           scheduled.StartAt,
+		EndAt:             scheduled.EndAt,
+		NextRunAt:         scheduled.NextRunAt,
+		Status:            string(scheduled.Status),
+		RunCount:          scheduled.RunCount,
+		FailureCount:      scheduled.FailureCount,
+		LastRunAt:         scheduled.LastRunAt,
+		LastTransactionID: scheduled.LastTransactionID,
+		LastError:         scheduled.LastError,
+		CreatedAt:         scheduled.CreatedAt,
+		UpdatedAt:         scheduled.UpdatedAt,
+	}
+}
+
+// ToResponseList converts scheduled transactions to ScheduledTransactionListResponse DTO
+func (m *ScheduledTransactionMapper) ToResponseList(scheduled []*entity.ScheduledTransaction, pagination PaginationInfo) ScheduledTransactionListResponse {
+	responses := make([]ScheduledTransactionResponse, len(scheduled))
+	for i, s := range scheduled {
+		responses[i] = m.ToResponse(s)
+	}
+	return ScheduledTransactionListResponse{ScheduledTransactions: responses, Pagination: pagination}
+}
+
+// AttachmentMapper provides mapping between Attachment entity and DTOs
+type AttachmentMapper struct{}
+
+// ToResponse converts Attachment entity to AttachmentResponse DTO
+func (m *AttachmentMapper) ToResponse(attachment *entity.Attachment) AttachmentResponse {
+	return AttachmentResponse{
+		ID:            attachment.ID,
+		AccountID:     attachment.AccountID.String(),
+		TransactionID: attachment.TransactionID.String(),
+		FileName:      attachment.FileName,
+		ContentType:   attachment.ContentType,
+		SizeBytes:     attachment.SizeBytes,
+		CreatedAt:     attachment.CreatedAt,
+	}
+}
+
+// SavedFilterMapper provides mapping between SavedFilter entity and DTOs
+type SavedFilterMapper struct{}
+
+// ToResponse converts SavedFilter entity to SavedFilterResponse DTO
+func (m *SavedFilterMapper) ToResponse(filter *entity.SavedFilter) SavedFilterResponse {
+	tags := filter.Tags
+	if tags == nil {
+		tags = []string{}
+	}
+
+	return SavedFilterResponse{
+		ID:              filter.ID,
+		AccountID:       filter.AccountID.String(),
+		Name:            filter.Name,
+		Tags:            tags,
+		Direction:       string(filter.Direction),
+		TransactionType: string(filter.TransactionType),
+		CreatedAt:       filter.CreatedAt,
+		UpdatedAt:       filter.UpdatedAt,
+	}
+}
+
+// BudgetMapper provides mapping between Budget entity and DTOs
+type BudgetMapper struct{}
+
+// ToResponse converts Budget entity and its spend in a period to BudgetResponse DTO
+func (m *BudgetMapper) ToResponse(budget *entity.Budget, period string, spent vo.Money) BudgetResponse {
+	remaining, _ := budget.Amount.Subtract(spent)
+
+	return BudgetResponse{
+		ID:           budget.ID,
+		AccountID:    budget.AccountID.String(),
+		CategoryCode: budget.CategoryCode,
+		Amount:       budget.Amount.Amount().InexactFloat64(),
+		Period:       period,
+		Spent:        spent.Amount().InexactFloat64(),
+		Remaining:    remaining.Amount().InexactFloat64(),
+		PercentUsed:  budget.PercentUsed(spent),
+		CreatedAt:    budget.CreatedAt,
+		UpdatedAt:    budget.UpdatedAt,
+	}
+}
+
+// WebhookMapper provides mapping between WebhookSubscription entity and DTOs
+type WebhookMapper struct{}
+
+// ToResponse converts WebhookSubscription entity to WebhookResponse DTO; the secret is never included
+func (m *WebhookMapper) ToResponse(webhook *entity.WebhookSubscription) WebhookResponse {
+	events := make([]string, len(webhook.Events))
+	for i, evt := range webhook.Events {
+		events[i] = string(evt)
+	}
+
+	response := WebhookResponse{
+		ID:                  webhook.ID,
+		AccountID:           webhook.AccountID.String(),
+		URL:                 webhook.URL,
+		Events:              events,
+		Status:              string(webhook.Status),
+		ConsecutiveFailures: webhook.ConsecutiveFailures,
+		LastError:           webhook.LastError,
+		LastDeliveryAt:      webhook.LastDeliveryAt,
+		DisabledAt:          webhook.DisabledAt,
+		CreatedAt:           webhook.CreatedAt,
+		UpdatedAt:           webhook.UpdatedAt,
+	}
+	if webhook.Threshold != nil {
+		threshold := webhook.Threshold.InexactFloat64()
+		response.Threshold = &threshold
+	}
+
+	return response
+}
+
+// VirtualAccountMapper provides mapping between VirtualAccount entity and DTOs
+type VirtualAccountMapper struct{}
+
+// ToResponse converts VirtualAccount entity to VirtualAccountResponse DTO
+func (m *VirtualAccountMapper) ToResponse(virtualAccount *entity.VirtualAccount) VirtualAccountResponse {
+	return VirtualAccountResponse{
+		ID:        virtualAccount.ID,
+		AccountID: virtualAccount.AccountID.String(),
+		Label:     virtualAccount.Label,
+		Status:    string(virtualAccount.Status),
+		CreatedAt: virtualAccount.CreatedAt,
+		UpdatedAt: virtualAccount.UpdatedAt,
+	}
+}
+
+// ToResponseList converts slice of VirtualAccount entities to VirtualAccountListResponse DTO
+func (m *VirtualAccountMapper) ToResponseList(virtualAccounts []*entity.VirtualAccount, pagination PaginationInfo) VirtualAccountListResponse {
+	responses := make([]VirtualAccountResponse, len(virtualAccounts))
+	for i, virtualAccount := range virtualAccounts {
+		responses[i] = m.ToResponse(virtualAccount)
+	}
+
+	return VirtualAccountListResponse{
+		VirtualAccounts: responses,
+		Pagination:      pagination,
+	}
+}
+
+// GeneralLedgerMapper handles conversion between general ledger entities and DTOs
+type GeneralLedgerMapper struct{}
+
+// ToAccountResponse converts GLAccount entity to GLAccountResponse DTO
+func (m *GeneralLedgerMapper) ToAccountResponse(account entity.GLAccount) GLAccountResponse {
+	return GLAccountResponse{
+		Code:       account.Code,
+		Name:       account.Name,
+		Type:       string(account.Type),
+		NormalSide: string(account.NormalSide()),
+	}
+}
+
+// ToPostingResponse converts GLPosting entity to GLPostingResponse DTO
+func (m *GeneralLedgerMapper) ToPostingResponse(posting *entity.GLPosting) GLPostingResponse {
+	account, _ := entity.FindGLAccount(posting.Account)
+	return GLPostingResponse{
+		Line:        posting.Line,
+		Account:     posting.Account,
+		AccountName: account.Name,
+		Side:        string(posting.Side),
+		Amount:      posting.Amount.InexactFloat64(),
+		Period:      posting.Period,
+		ValueDate:   posting.ValueDate.Format("2006-01-02"),
+	}
+}
+
+// ToTrialBalanceLine converts a GL account and its totals to TrialBalanceLine DTO
+func (m *GeneralLedgerMapper) ToTrialBalanceLine(account entity.GLAccount, debit, credit vo.Money) TrialBalanceLine {
+	balance, _ := debit.Subtract(credit)
+	if account.NormalSide() == entity.GLSideCredit {
+		balance, _ = credit.Subtract(debit)
+	}
+
+	return TrialBalanceLine{
+		Code:    account.Code,
+		Name:    account.Name,
+		Type:    string(account.Type),
+		Debit:   debit.InexactFloat64(),
+		Credit:  credit.InexactFloat64(),
+		Balance: balance.InexactFloat64(),
+	}
+}
+
+// OwnershipTransferMapper provides mapping between ownership transfers and DTOs
+type OwnershipTransferMapper struct{}
+
+// ToResponse converts OwnershipTransfer entity to OwnershipTransferResponse DTO
+func (m *OwnershipTransferMapper) ToResponse(transfer *entity.OwnershipTransfer) OwnershipTransferResponse {
+	return OwnershipTransferResponse{
+		ID:             transfer.ID,
+		AccountID:      transfer.AccountID.String(),
+		FromCustomerID: transfer.FromCustomerID,
+		ToCustomerID:   transfer.ToCustomerID,
+		ReasonCode:     string(transfer.ReasonCode),
+		Note:           transfer.Note,
+		EffectiveDate:  transfer.EffectiveDate.Format("2006-01-02"),
+		Status:         string(transfer.Status),
+		RequestedBy:    transfer.RequestedBy,
+		DecidedBy:      transfer.DecidedBy,
+		DecisionReason: transfer.DecisionReason,
+		CreatedAt:      transfer.CreatedAt,
+		DecidedAt:      transfer.DecidedAt,
+		CompletedAt:    transfer.CompletedAt,
+	}
+}
+
+// ToResponseList converts ownership transfers to OwnershipTransferListResponse DTO
+func (m *OwnershipTransferMapper) ToResponseList(transfers []*entity.OwnershipTransfer, pagination PaginationInfo) OwnershipTransferListResponse {
+	responses := make([]OwnershipTransferResponse, len(transfers))
+	for i, transfer := range transfers {
+		responses[i] = m.ToResponse(transfer)
+	}
+	return OwnershipTransferListResponse{Transfers: responses, Pagination: pagination}
+}
+
+// TransactionBlockMapper provides mapping between transaction blocks and DTOs
+type TransactionBlockMapper struct{}
+
+// ToResponse converts TransactionBlock entity to TransactionBlockResponse DTO, telling whether it is in force now
+func (m *TransactionBlockMapper) ToResponse(block *entity.TransactionBlock, now time.Time) TransactionBlockResponse {
+	return TransactionBlockResponse{
+		ID:              block.ID,
+		AccountID:       block.AccountID.String(),
+		TransactionType: string(block.TransactionType),
+		ReasonCode:      string(block.ReasonCode),
+		Note:            block.Note,
+		CreatedBy:       block.CreatedBy,
+		CreatedAt:       block.CreatedAt,
+		ExpiresAt:       block.ExpiresAt,
+		LiftedBy:        block.LiftedBy,
+		LiftedAt:        block.LiftedAt,
+		Active:          block.IsActive(now),
+	}
+}
+
+// ToResponseList converts transaction blocks to TransactionBlockListResponse DTO
+func (m *TransactionBlockMapper) ToResponseList(blocks []*entity.TransactionBlock, now time.Time, pagination PaginationInfo) TransactionBlockListResponse {
+	responses := make([]TransactionBlockResponse, len(blocks))
+	for i, block := range blocks {
+		responses[i] = m.ToResponse(block, now)
+	}
+	return TransactionBlockListResponse{Blocks: responses, Pagination: pagination}
+}
+
+// ProductMigrationMapper provides mapping between product migrations and DTOs
+type ProductMigrationMapper struct{}
+
+// ToResponse converts ProductMigration entity to ProductMigrationResponse DTO with its per-account report
+func (m *ProductMigrationMapper) ToResponse(migration *entity.ProductMigration) ProductMigrationResponse {
+	response := m.toSummary(migration)
+	response.Accounts = make([]ProductMigrationAccountResponse, len(migration.Accounts))
+	for i, account := range migration.Accounts {
+		response.Accounts[i] = ProductMigrationAccountResponse{
+			AccountID:  account.AccountID.String(),
+			Status:     string(account.Status),
+			Reason:     account.Reason,
+			MigratedAt: account.MigratedAt,
+		}
+	}
+	return response
+}
+
+// ToResponseList converts product migrations to ProductMigrationListResponse DTO, without the per-account reports
+func (m *ProductMigrationMapper) ToResponseList(migrations []*entity.ProductMigration, pagination PaginationInfo) ProductMigrationListResponse {
+	responses := make([]ProductMigrationResponse, len(migrations))
+	for i, migration := range migrations {
+		responses[i] = m.toSummary(migration)
+	}
+	return ProductMigrationListResponse{Migrations: responses, Pagination: pagination}
+}
+
+// toSummary converts a product migration to a response with the account counts only
+func (m *ProductMigrationMapper) toSummary(migration *entity.ProductMigration) ProductMigrationResponse {
+	return ProductMigrationResponse{
+		ID:            migration.ID,
+		FromProductID: migration.FromProductID,
+		ToProductID:   migration.ToProductID,
+		EffectiveDate: migration.EffectiveDate.Format("2006-01-02"),
+		Status:        string(migration.Status),
+		Note:          migration.Note,
+		RequestedBy:   migration.RequestedBy,
+		Summary: ProductMigrationSummary{
+			Accounts: len(migration.Accounts),
+			Pending:  migration.Count(vo.ProductMigrationAccountPending),
+			Migrated: migration.Count(vo.ProductMigrationAccountMigrated),
+			Skipped:  migration.Count(vo.ProductMigrationAccountSkipped),
+		},
+		CreatedAt:   migration.CreatedAt,
+		CompletedAt: migration.CompletedAt,
+	}
+}
+
+// AdminUserMapper provides mapping between AdminUser entity and DTOs
+type AdminUserMapper struct{}
+
+// ToResponse converts AdminUser entity to AdminUserResponse DTO; the API key is never included
+func (m *AdminUserMapper) ToResponse(admin *entity.AdminUser) AdminUserResponse {
+	roles := make([]string, len(admin.Roles))
+	for i, role := range admin.Roles {
+		roles[i] = string(role)
+	}
+
+	return AdminUserResponse{
+		ID:          admin.ID,
+		Email:       admin.Email,
+		Name:        admin.Name,
+		Roles:       roles,
+		Permissions: m.permissions(admin.Permissions()),
+		Status:      string(admin.Status),
+		CreatedAt:   admin.CreatedAt,
+		UpdatedAt:   admin.UpdatedAt,
+	}
+}
+
+// ToRoleList converts the permissions matrix to AdminRoleListResponse DTO
+func (m *AdminUserMapper) ToRoleList() AdminRoleListResponse {
+	roles := make([]AdminRoleResponse, 0, len(vo.AdminRoles()))
+	for _, role := range vo.AdminRoles() {
+		roles = append(roles, AdminRoleResponse{Role: string(role), Permissions: m.permissions(role.Permissions())})
+	}
+	return AdminRoleListResponse{Roles: roles, Permissions: m.permissions(vo.AdminPermissions())}
+}
+
+// permissions converts permissions to their names
+func (m *AdminUserMapper) permissions(permissions []vo.AdminPermission) []string {
+	names := make([]string, len(permissions))
+	for i, permission := range permissions {
+		names[i] = string(permission)
+	}
+	return names
+}
+
+// AdminActivityMapper provides mapping between admin activity and DTOs
+type AdminActivityMapper struct{}
+
+// ToResponse converts AdminActivity entity to AdminActivityResponse DTO
+func (m *AdminActivityMapper) ToResponse(activity *entity.AdminActivity) AdminActivityResponse {
+	anomalies := make([]string, len(activity.Anomalies))
+	for i, anomaly := range activity.Anomalies {
+		anomalies[i] = string(anomaly)
+	}
+
+	return AdminActivityResponse{
+		ID:         activity.ID,
+		AdminID:    activity.AdminID,
+		AdminEmail: activity.AdminEmail,
+		Method:     activity.Method,
+		Route:      activity.Route,
+		Path:       activity.Path,
+		Permission: activity.Permission,
+		StatusCode: activity.StatusCode,
+		IP:         activity.IP,
+		Country:    activity.Country,
+		UserAgent:  activity.UserAgent,
+		Anomalies:  anomalies,
+		OccurredAt: activity.OccurredAt,
+	}
+}
+
+// ToResponses converts admin activity to AdminActivityResponse DTOs
+func (m *AdminActivityMapper) ToResponses(activities []*entity.AdminActivity) []AdminActivityResponse {
+	responses := make([]AdminActivityResponse, len(activities))
+	for i, activity := range activities {
+		responses[i] = m.ToResponse(activity)
+	}
+	return responses
+}
@@ -0,0 +1,33 @@
+// internal/application/dto/notification_preference.go
+package dto
+
+import "time"
+
+// QuietHoursRequest is a daily window, in the customer's time zone, in which SMS and push notifications are held back
+type QuietHoursRequest struct {
+	Start string `json:"start" validate:"required,len=5"` // HH:MM
+	End   string `json:"end" validate:"required,len=5"`   // HH:MM; before start to span midnight
+}
+
+// NotificationPreferenceRequest represents the request to replace a customer's notification preferences
+type NotificationPreferenceRequest struct {
+	CustomerID string              `json:"-" validate:"required,max=50"`
+	Locale     string              `json:"locale" validate:"omitempty,max=5"`     // en by default
+	Timezone   string              `json:"timezone" validate:"omitempty,max=50"`  // IANA time zone, UTC by default
+	Recipients map[string]string   `json:"recipients" validate:"omitempty,max=3"` // Recipient per channel: EMAIL, SMS, PUSH
+	Events     map[string][]string `json:"events"`                                // Channels per event type
+	QuietHours *QuietHoursRequest  `json:"quiet_hours" validate:"omitempty"`
+	MinAmount  *DecimalString      `json:"min_amount" validate:"omitempty,min=0"` // Transactions below it are not alerted
+}
+
+// NotificationPreferenceResponse represents the response structure for a customer's notification preferences
+type NotificationPreferenceResponse struct {
+	CustomerID string              `json:"customer_id"`
+	Locale     string              `json:"locale"`
+	Timezone   string              `json:"timezone"`
+	Recipients map[string]string   `json:"recipients"`
+	Events     map[string][]string `json:"events"`
+	QuietHours *QuietHoursRequest  `json:"quiet_hours,omitempty"`
+	MinAmount  *float64            `json:"min_amount,omitempty"`
+	UpdatedAt  *time.Time          `json:"updated_at,omitempty"` // Unset until the customer saves preferences
+}
@@ -0,0 +1,72 @@
+// internal/application/dto/notification_template.go
+package dto
+
+import "time"
+
+// NotificationTemplateKey identifies a notification template by event, channel and locale
+type NotificationTemplateKey struct {
+	Event   string `json:"-" validate:"required,max=50"`
+	Channel string `json:"-" validate:"required,oneof=EMAIL SMS PUSH"`
+	Locale  string `json:"-" validate:"required,max=5"`
+}
+
+// PublishNotificationTemplateRequest represents the request to save the next version of a notification template
+type PublishNotificationTemplateRequest struct {
+	NotificationTemplateKey
+	Subject   string `json:"subject" validate:"max=200"` // Go template; EMAIL and PUSH only
+	Body      string `json:"body" validate:"required,max=5000"`
+	CreatedBy string `json:"created_by" validate:"required,max=100"`
+}
+
+// RollbackNotificationTemplateRequest represents the request to republish an earlier version of a notification template
+type RollbackNotificationTemplateRequest struct {
+	NotificationTemplateKey
+	Version   int    `json:"version" validate:"required,min=1"`
+	CreatedBy string `json:"created_by" validate:"required,max=100"`
+}
+
+// PreviewNotificationTemplateRequest represents the request to render a notification template with sample data.
+// Subject and body render unsaved content instead of a saved version.
+type PreviewNotificationTemplateRequest struct {
+	NotificationTemplateKey
+	Version int                    `json:"version" validate:"min=0"` // Latest when zero
+	Subject *string                `json:"subject" validate:"omitempty,max=200"`
+	Body    *string                `json:"body" validate:"omitempty,max=5000"`
+	Data    map[string]interface{} `json:"data"` // Event data; a sample of the event's data when omitted
+}
+
+// TestSendNotificationRequest represents the request to render a notification template and send it to one recipient
+type TestSendNotificationRequest struct {
+	PreviewNotificationTemplateRequest
+	Recipient string `json:"recipient" validate:"required,max=200"`
+}
+
+// NotificationTemplateResponse represents the response structure for a notification template version
+type NotificationTemplateResponse struct {
+	ID        string    `json:"id"`
+	Event     string    `json:"event"`
+	Channel   string    `json:"channel"`
+	Locale    string    `json:"locale"`
+	Version   int       `json:"version"`
+	Subject   string    `json:"subject,omitempty"`
+	Body      string    `json:"body"`
+	CreatedBy string    `json:"created_by,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// NotificationTemplateListResponse represents a list of notification template versions
+type NotificationTemplateListResponse struct {
+	Templates []NotificationTemplateResponse `json:"templates"`
+}
+
+// NotificationPreviewResponse represents a notification template rendered with sample data
+type NotificationPreviewResponse struct {
+	Event     string                 `json:"event"`
+	Channel   string                 `json:"channel"`
+	Locale    string                 `json:"locale"`
+	Version   int                    `json:"version,omitempty"` // Zero for unsaved content
+	Subject   string                 `json:"subject,omitempty"`
+	Body      string                 `json:"body"`
+	Data      map[string]interface{} `json:"data"`
+	Recipient string                 `json:"recipient,omitempty"` // Test sends only
+}
@@ -0,0 +1,54 @@
+// internal/application/dto/ownership_transfer.go
+package dto
+
+import "time"
+
+// CreateOwnershipTransferRequest represents an admin requesting to move an account to another customer
+type CreateOwnershipTransferRequest struct {
+	AccountID     string `json:"-" validate:"required"`
+	ToCustomerID  string `json:"to_customer_id" validate:"required,max=50"`
+	ReasonCode    string `json:"reason_code" validate:"required,oneof=INHERITANCE BUSINESS_SALE GIFT LEGAL_ORDER CORRECTION"`
+	Note          string `json:"note" validate:"max=500"`
+	EffectiveDate string `json:"effective_date"` // YYYY-MM-DD, defaults to today
+	RequestedBy   string `json:"requested_by" validate:"required,max=100"`
+}
+
+// OwnershipTransferDecisionRequest represents an admin approving or rejecting an ownership transfer
+type OwnershipTransferDecisionRequest struct {
+	ID     string `json:"-" validate:"required"`
+	Admin  string `json:"admin" validate:"required,max=100"`
+	Reason string `json:"reason" validate:"max=500"`
+}
+
+// OwnershipTransferListRequest represents the filters of an ownership transfer list
+type OwnershipTransferListRequest struct {
+	Page       int    `json:"page" validate:"min=1"`
+	PageSize   int    `json:"page_size" validate:"min=1,max=100"`
+	AccountID  string `json:"account_id" validate:"omitempty,max=16"`
+	CustomerID string `json:"customer_id" validate:"omitempty,max=50"` // Transfers from or to the customer
+	Status     string `json:"status" validate:"omitempty,oneof=PENDING APPROVED COMPLETED REJECTED"`
+}
+
+// OwnershipTransferResponse represents an account ownership transfer
+type OwnershipTransferResponse struct {
+	ID             string     `json:"id"`
+	AccountID      string     `json:"account_id"`
+	FromCustomerID string     `json:"from_customer_id"`
+	ToCustomerID   string     `json:"to_customer_id"`
+	ReasonCode     string     `json:"reason_code"`
+	Note           string     `json:"note,omitempty"`
+	EffectiveDate  string     `json:"effective_date"` // YYYY-MM-DD
+	Status         string     `json:"status"`
+	RequestedBy    string     `json:"requested_by"`
+	DecidedBy      string     `json:"decided_by,omitempty"`
+	DecisionReason string     `json:"decision_reason,omitempty"`
+	CreatedAt      time.Time  `json:"created_at"`
+	DecidedAt      *time.Time `json:"decided_at,omitempty"`
+	CompletedAt    *time.Time `json:"completed_at,omitempty"`
+}
+
+// OwnershipTransferListResponse represents a page of ownership transfers
+type OwnershipTransferListResponse struct {
+	Transfers  []OwnershipTransferResponse `json:"transfers"`
+	Pagination PaginationInfo              `json:"pagination"`
+}
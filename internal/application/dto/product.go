@@ -0,0 +1,55 @@
+// internal/application/dto/product.go
+package dto
+
+import "time"
+
+// ProductFees represents the fee schedule of a product
+type ProductFees struct {
+	DebitFee    DecimalString `json:"debit_fee" validate:"min=0"`    // Charged per debit
+	TransferFee DecimalString `json:"transfer_fee" validate:"min=0"` // Charged per outgoing transfer
+}
+
+// ProductLimits represents the limits a product places on its accounts
+type ProductLimits struct {
+	MinOpeningBalance    DecimalString  `json:"min_opening_balance" validate:"min=0"`
+	MaxTransactionAmount *DecimalString `json:"max_transaction_amount,omitempty" validate:"omitempty,gt=0"` // Unlimited when omitted
+}
+
+// CreateProductRequest represents the request to add a product to the catalog
+type CreateProductRequest struct {
+	Name         string        `json:"name" validate:"required,max=100"`
+	Type         string        `json:"type" validate:"required,oneof=SAVINGS CURRENT BUSINESS"`
+	Currency     string        `json:"currency" validate:"omitempty,len=3"` // Defaults to the service currency
+	InterestRate float64       `json:"interest_rate" validate:"min=0,max=100"`
+	Fees         ProductFees   `json:"fees"`
+	Limits       ProductLimits `json:"limits"`
+}
+
+// UpdateProductRequest represents the request to change a product's terms or retire it
+type UpdateProductRequest struct {
+	ID           string        `json:"-" validate:"required"`
+	Name         string        `json:"name" validate:"required,max=100"`
+	InterestRate float64       `json:"interest_rate" validate:"min=0,max=100"`
+	Fees         ProductFees   `json:"fees"`
+	Limits       ProductLimits `json:"limits"`
+	Active       *bool         `json:"active"` // Left unchanged when omitted
+}
+
+// ProductResponse represents the response structure for a product
+type ProductResponse struct {
+	ID           string        `json:"id"`
+	Name         string        `json:"name"`
+	Type         string        `json:"type"`
+	Currency     string        `json:"currency"`
+	InterestRate float64       `json:"interest_rate"`
+	Fees         ProductFees   `json:"fees"`
+	Limits       ProductLimits `json:"limits"`
+	Active       bool          `json:"active"`
+	CreatedAt    time.Time     `json:"created_at"`
+	UpdatedAt    time.Time     `json:"updated_at"`
+}
+
+// ProductListResponse represents the product catalog
+type ProductListResponse struct {
+	Products []ProductResponse `json:"products"`
+}
@@ -0,0 +1,61 @@
+// internal/application/dto/product_migration.go
+package dto
+
+import "time"
+
+// CreateProductMigrationRequest represents an admin moving accounts in bulk to another product
+type CreateProductMigrationRequest struct {
+	FromProductID string   `json:"from_product_id" validate:"required,max=32"`
+	ToProductID   string   `json:"to_product_id" validate:"required,max=32"`
+	AccountIDs    []string `json:"account_ids" validate:"max=1000,dive,required,max=16"` // Empty migrates every account of the from product
+	EffectiveDate string   `json:"effective_date"`                                       // YYYY-MM-DD, defaults to today
+	Note          string   `json:"note" validate:"max=500"`
+	RequestedBy   string   `json:"requested_by" validate:"required,max=100"`
+	DryRun        bool     `json:"dry_run"` // Only report which accounts would be migrated or skipped
+}
+
+// ProductMigrationListRequest represents the filters of a product migration list
+type ProductMigrationListRequest struct {
+	Page      int    `json:"page" validate:"min=1"`
+	PageSize  int    `json:"page_size" validate:"min=1,max=100"`
+	ProductID string `json:"product_id" validate:"omitempty,max=32"` // Migrations from or to the product
+	Status    string `json:"status" validate:"omitempty,oneof=SCHEDULED COMPLETED"`
+}
+
+// ProductMigrationSummary counts the accounts of a product migration by outcome
+type ProductMigrationSummary struct {
+	Accounts int `json:"accounts"`
+	Pending  int `json:"pending"`
+	Migrated int `json:"migrated"`
+	Skipped  int `json:"skipped"`
+}
+
+// ProductMigrationAccountResponse represents the outcome of one account of a product migration
+type ProductMigrationAccountResponse struct {
+	AccountID  string     `json:"account_id"`
+	Status     string     `json:"status"` // PENDING, MIGRATED or SKIPPED
+	Reason     string     `json:"reason,omitempty"`
+	MigratedAt *time.Time `json:"migrated_at,omitempty"`
+}
+
+// ProductMigrationResponse represents a product migration and its per-account report
+type ProductMigrationResponse struct {
+	ID            string                            `json:"id,omitempty"` // Empty for a dry run, which is not saved
+	FromProductID string                            `json:"from_product_id"`
+	ToProductID   string                            `json:"to_product_id"`
+	EffectiveDate string                            `json:"effective_date"` // YYYY-MM-DD
+	Status        string                            `json:"status"`
+	Note          string                            `json:"note,omitempty"`
+	RequestedBy   string                            `json:"requested_by"`
+	DryRun        bool                              `json:"dry_run,omitempty"`
+	Summary       ProductMigrationSummary           `json:"summary"`
+	Accounts      []ProductMigrationAccountResponse `json:"accounts,omitempty"` // Left out of lists
+	CreatedAt     time.Time                         `json:"created_at"`
+	CompletedAt   *time.Time                        `json:"completed_at,omitempty"`
+}
+
+// ProductMigrationListResponse represents a page of product migrations
+type ProductMigrationListResponse struct {
+	Migrations []ProductMigrationResponse `json:"migrations"`
+	Pagination PaginationInfo             `json:"pagination"`
+}
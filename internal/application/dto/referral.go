@@ -0,0 +1,37 @@
+// internal/application/dto/referral.go
+package dto
+
+import "time"
+
+// RedeemReferralRequest represents the request of an account to redeem another account's referral code
+type RedeemReferralRequest struct {
+	Code      string `json:"code" validate:"required,len=8"`
+	AccountID string `json:"account_id" validate:"required"` // The referee
+}
+
+// ReferralCodeResponse represents the referral code of an account
+type ReferralCodeResponse struct {
+	Code      string    `json:"code"`
+	AccountID string    `json:"account_id"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// ReferralResponse represents the response structure for a referral
+type ReferralResponse struct {
+	ID                      string     `json:"id"`
+	Code                    string     `json:"code"`
+	ReferrerAccountID       string     `json:"referrer_account_id"`
+	RefereeAccountID        string     `json:"referee_account_id"`
+	Status                  string     `json:"status"`
+	QualifyingTransactionID string     `json:"qualifying_transaction_id,omitempty"`
+	ReferrerCreditID        string     `json:"referrer_credit_id,omitempty"`
+	RefereeCreditID         string     `json:"referee_credit_id,omitempty"`
+	CreatedAt               time.Time  `json:"created_at"`
+	RewardedAt              *time.Time `json:"rewarded_at,omitempty"`
+}
+
+// ReferralListResponse represents the referrals an account made
+type ReferralListResponse struct {
+	Referrals  []ReferralResponse `json:"referrals"`
+	Pagination PaginationInfo     `json:"pagination"`
+}
@@ -0,0 +1,26 @@
+// internal/application/dto/saga.go
+package dto
+
+import (
+	"time"
+)
+
+// SagaStepResponse represents the state of a single saga step
+type SagaStepResponse struct {
+	Name      string     `json:"name"`
+	Status    string     `json:"status"`
+	Error     string     `json:"error,omitempty"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+}
+
+// SagaResponse represents the response structure for saga status
+type SagaResponse struct {
+	ID            string             `json:"id"`
+	Type          string             `json:"type"`
+	TransactionID string             `json:"transaction_id"`
+	Status        string             `json:"status"`
+	Steps         []SagaStepResponse `json:"steps"`
+	Error         string             `json:"error,omitempty"`
+	CreatedAt     time.Time          `json:"created_at"`
+	UpdatedAt     time.Time          `json:"updated_at"`
+}
@@ -0,0 +1,54 @@
+// internal/application/dto/scheduled_transaction.go
+package dto
+
+import "time"
+
+// ScheduledTransactionRequest represents the request to schedule a transfer or to change a schedule;
+// the schedule ID is only required under the update profile, and the paying account cannot change
+type ScheduledTransactionRequest struct {
+	ID            string        `json:"-" validate:"required_on=update,excluded_on=create"`
+	FromAccountID string        `json:"from_account_id" validate:"required_on=create,max=16"`
+	ToAccountID   string        `json:"to_account_id" validate:"required,max=16"`
+	Amount        DecimalString `json:"amount" validate:"required,gt=0"`
+	Description   string        `json:"description" validate:"max=500"`
+	Reference     string        `json:"reference" validate:"max=100"` // Defaults to SCHEDULED-<id> on transfers
+	Cron          string        `json:"cron" validate:"max=100"`      // Five-field cron expression; empty runs once at start_at
+	StartAt       *time.Time    `json:"start_at,omitempty"`           // When a one-time transfer runs, or from when a recurring one does
+	EndAt         *time.Time    `json:"end_at,omitempty"`             // Last time a recurring transfer may run
+}
+
+// ScheduledTransactionListRequest represents the filters of a scheduled transaction list
+type ScheduledTransactionListRequest struct {
+	Page      int    `json:"page" validate:"min=1"`
+	PageSize  int    `json:"page_size" validate:"min=1,max=100"`
+	AccountID string `json:"account_id" validate:"omitempty,max=16"` // Schedules paying from or to the account
+	Status    string `json:"status" validate:"omitempty,oneof=ACTIVE COMPLETED"`
+}
+
+// ScheduledTransactionResponse represents a scheduled transfer and the outcome of its last run
+type ScheduledTransactionResponse struct {
+	ID                string     `json:"id"`
+	FromAccountID     string     `json:"from_account_id"`
+	ToAccountID       string     `json:"to_account_id"`
+	Amount            float64    `json:"amount"`
+	Description       string     `json:"description"`
+	Reference         string     `json:"reference"`
+	Cron              string     `json:"cron,omitempty"`
+	StartAt           *time.Time `json:"start_at,omitempty"`
+	EndAt             *time.Time `json:"end_at,omitempty"`
+	NextRunAt         *time.Time `json:"next_run_at,omitempty"`
+	Status            string     `json:"status"`
+	RunCount          int        `json:"run_count"`
+	FailureCount      int        `json:"failure_count"`
+	LastRunAt         *time.Time `json:"last_run_at,omitempty"`
+	LastTransactionID string     `json:"last_transaction_id,omitempty"`
+	LastError         string     `json:"last_error,omitempty"`
+	CreatedAt         time.Time  `json:"created_at"`
+	UpdatedAt         time.Time  `json:"updated_at"`
+}
+
+// ScheduledTransactionListResponse represents a page of scheduled transactions
+type ScheduledTransactionListResponse struct {
+	ScheduledTransactions []ScheduledTransactionResponse `json:"scheduled_transactions"`
+	Pagination            PaginationInfo                 `json:"pagination"`
+}
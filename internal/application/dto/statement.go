@@ -0,0 +1,38 @@
+package dto
+
+import "time"
+
+// StatementRequest represents the request for an account's statement over a period
+type StatementRequest struct {
+	AccountID string `json:"account_id" validate:"required"`
+	From      string `json:"from"` // YYYY-MM-DD, defaults to the start of the current month
+	To        string `json:"to"`   // YYYY-MM-DD, defaults to today
+}
+
+// StatementResponse represents an account's statement: the transactions completed within a period,
+// oldest first, between the balance the account opened and closed the period with
+type StatementResponse struct {
+	AccountID      string         `json:"account_id"`
+	AccountName    string         `json:"account_name"`
+	Currency       string         `json:"currency"`
+	From           string         `json:"from"`
+	To             string         `json:"to"`
+	OpeningBalance float64        `json:"opening_balance"` // Balance at the start of From
+	TotalCredits   float64        `json:"total_credits"`
+	TotalDebits    float64        `json:"total_debits"`    // Includes fees
+	ClosingBalance float64        `json:"closing_balance"` // Balance at the end of To
+	Rows           []StatementRow `json:"rows"`
+}
+
+// StatementRow represents a transaction on a statement with the balance it left behind
+type StatementRow struct {
+	TransactionID   string    `json:"transaction_id"`
+	CompletedAt     time.Time `json:"completed_at"`
+	TransactionType string    `json:"transaction_type"`
+	Description     string    `json:"description"`
+	Reference       string    `json:"reference"`
+	Debit           float64   `json:"debit"` // Taken from the account, fee included
+	Fee             float64   `json:"fee"`
+	Credit          float64   `json:"credit"` // Paid into the account
+	RunningBalance  float64   `json:"running_balance"`
+}
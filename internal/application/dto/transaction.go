@@ -7,26 +7,82 @@ import (
 
 // CreateTransactionRequest represents the request to create a new transaction
 type CreateTransactionRequest struct {
-	FromAccountID   *string `json:"from_account_id,omitempty"`
-	ToAccountID     *string `json:"to_account_id,omitempty"`
-	TransactionType string  `json:"transaction_type" validate:"required,oneof=DEBIT CREDIT TRANSFER"`
-	Amount          float64 `json:"amount" validate:"required,gt=0"`
-	Description     string  `json:"description" validate:"max=500"`
-	Reference       string  `json:"reference" validate:"max=100"`
+	FromAccountID      *string       `json:"from_account_id,omitempty"`
+	ToAccountID        *string       `json:"to_account_id,omitempty"`
+	ToVirtualAccountID string        `json:"to_virtual_account_id,omitempty" validate:"max=18"` // Credits the virtual number's settlement account
+	TransactionType    string        `json:"transaction_type" validate:"required,oneof=DEBIT CREDIT TRANSFER FX_TRANSFER"`
+	Amount             DecimalString `json:"amount" validate:"required,gt=0"`
+	Currency           string        `json:"currency" validate:"omitempty,len=3"` // ISO 4217; must match the (source) account's currency when given
+	Description        string        `json:"description" validate:"max=500"`
+	Reference          string        `json:"reference" validate:"max=100"`
+	ReferenceType      string        `json:"reference_type" validate:"omitempty,oneof=FREE RF"` // Defaults to FREE
+	Merchant           string        `json:"merchant" validate:"max=100"`
+	Channel            string        `json:"channel" validate:"omitempty,oneof=API BRANCH ATM MOBILE"` // Defaults to API
+}
+
+// GroupTransferRequest represents an inter-company transfer between accounts of one parent's group
+type GroupTransferRequest struct {
+	ParentID      string        `json:"-" validate:"required"`
+	FromAccountID string        `json:"from_account_id" validate:"required"`
+	ToAccountID   string        `json:"to_account_id" validate:"required"`
+	Amount        DecimalString `json:"amount" validate:"required,gt=0"`
+	Description   string        `json:"description" validate:"max=500"`
 }
 
 // TransactionResponse represents the response structure for transaction data
 type TransactionResponse struct {
-	ID              string     `json:"id"`
-	FromAccountID   *string    `json:"from_account_id,omitempty"`
-	ToAccountID     *string    `json:"to_account_id,omitempty"`
-	TransactionType string     `json:"transaction_type"`
-	Amount          float64    `json:"amount"`
-	Description     string     `json:"description"`
-	Reference       string     `json:"reference"`
-	Status          string     `json:"status"`
-	CreatedAt       time.Time  `json:"created_at"`
-	CompletedAt     *time.Time `json:"completed_at,omitempty"`
+	ID               string  `json:"id"`
+	FromAccountID    *string `json:"from_account_id,omitempty"`
+	ToAccountID      *string `json:"to_account_id,omitempty"`
+	VirtualAccountID string  `json:"virtual_account_id,omitempty"`
+	TransactionType  string  `json:"transaction_type"`
+	Channel          string  `json:"channel"`
+	Amount           float64 `json:"amount"`
+	Fee              float64 `json:"fee"` // Charged to the source account on top of the amount
+	Currency         string  `json:"currency"`
+	Description      string  `json:"description"`
+
+	// Conversion of an FX_TRANSFER
+	ExchangeRate      float64    `json:"exchange_rate,omitempty"`
+	ExchangeRateAsOf  *time.Time `json:"exchange_rate_as_of,omitempty"`
+	ConvertedAmount   float64    `json:"converted_amount,omitempty"` // Credited to the destination account
+	ConvertedCurrency string     `json:"converted_currency,omitempty"`
+
+	Reference             string     `json:"reference"`
+	ReferenceType         string     `json:"reference_type"`
+	Merchant              string     `json:"merchant,omitempty"`
+	Category              string     `json:"category"`
+	Status                string     `json:"status"`
+	CreatedAt             time.Time  `json:"created_at"`
+	ValueDate             string     `json:"value_date"` // YYYY-MM-DD
+	CompletedAt           *time.Time `json:"completed_at,omitempty"`
+	ReviewReason          string     `json:"review_reason,omitempty"`
+	ReviewHeldAt          *time.Time `json:"review_held_at,omitempty"`
+	ReviewDueAt           *time.Time `json:"review_due_at,omitempty"`
+	ReviewedBy            string     `json:"reviewed_by,omitempty"`
+	ClaimedBy             string     `json:"claimed_by,omitempty"`       // Admin currently working the review
+	ClaimExpiresAt        *time.Time `json:"claim_expires_at,omitempty"` // Claim lapses unless renewed
+	FailureKind           string     `json:"failure_kind,omitempty"`     // BUSINESS or INFRASTRUCTURE once FAILED
+	FailureReason         string     `json:"failure_reason,omitempty"`
+	ReplayCount           int        `json:"replay_count,omitempty"`
+	AdjustsID             *string    `json:"adjusts_id,omitempty"`              // Transaction this adjustment corrects
+	ReversedTransactionID *string    `json:"reversed_transaction_id,omitempty"` // Transaction a REVERSAL moves back
+	AdjustmentReason      string     `json:"adjustment_reason,omitempty"`       // Reason code of an ADJUSTMENT
+	RequestedBy           string     `json:"requested_by,omitempty"`            // Admin who posted an ADJUSTMENT
+
+	// Limits the source account has nearly used up, on the response confirming the transaction
+	Warnings []LimitWarning `json:"warnings,omitempty"`
+
+	// Embedded with include=accounts
+	FromAccount *TransactionAccount `json:"from_account,omitempty"`
+	ToAccount   *TransactionAccount `json:"to_account,omitempty"`
+}
+
+// TransactionAccount represents the account details embedded in a transaction response
+type TransactionAccount struct {
+	ID          string `json:"id"`
+	AccountName string `json:"account_name"`
+	Status      string `json:"status"`
 }
 
 // TransactionListResponse represents paginated transaction list response
@@ -35,12 +91,288 @@ type TransactionListResponse struct {
 	Pagination   PaginationInfo        `json:"pagination"`
 }
 
+// TransactionBatchResponse represents the transactions found by a batch lookup and the IDs without one
+type TransactionBatchResponse struct {
+	Found   []TransactionResponse `json:"found"`   // In the order requested
+	Missing []string              `json:"missing"` // IDs without a transaction
+}
+
+// TransactionSyncRequest represents a request for the transactions of an account written after a change number
+type TransactionSyncRequest struct {
+	AccountID     string `json:"account_id" validate:"required"`
+	SinceSequence int64  `json:"since_seq" validate:"min=0"`
+	Limit         int    `json:"limit" validate:"min=1,max=500"`
+}
+
+// TransactionSyncResponse represents the transactions of an account written after a change number, in
+// change order. Cancelled transactions come as tombstones so a client drops them from its cache.
+type TransactionSyncResponse struct {
+	AccountID    string                 `json:"account_id"`
+	Transactions []TransactionResponse  `json:"transactions"`
+	Tombstones   []TransactionTombstone `json:"tombstones"`
+	NextSequence int64                  `json:"next_seq"` // Change number to pass as since_seq on the next call
+	HasMore      bool                   `json:"has_more"`
+}
+
+// TransactionTombstone represents a transaction a client should drop from its cache
+type TransactionTombstone struct {
+	TransactionID string `json:"transaction_id"`
+	Sequence      int64  `json:"seq"` // Change number of the cancellation
+}
+
+// AccountActivityRequest represents the request for an account's completed transactions grouped by day
+type AccountActivityRequest struct {
+	AccountID string `json:"account_id" validate:"required"`
+	From      string `json:"from"` // YYYY-MM-DD, defaults to the start of the current month
+	To        string `json:"to"`   // YYYY-MM-DD, defaults to today
+}
+
+// AccountActivityResponse represents an account's completed transactions within a date range, grouped by
+// the day they completed, oldest first
+type AccountActivityResponse struct {
+	AccountID      string        `json:"account_id"`
+	From           string        `json:"from"`
+	To             string        `json:"to"`
+	OpeningBalance float64       `json:"opening_balance"` // Balance at the start of From
+	ClosingBalance float64       `json:"closing_balance"` // Balance at the end of To
+	Currency       string        `json:"currency"`
+	Days           []ActivityDay `json:"days"` // Days without transactions are left out
+}
+
+// ActivityDay represents the transactions an account completed on one day with the day's subtotals
+type ActivityDay struct {
+	Date           string         `json:"date"` // YYYY-MM-DD
+	OpeningBalance float64        `json:"opening_balance"`
+	Inflow         float64        `json:"inflow"`
+	Outflow        float64        `json:"outflow"` // Includes fees
+	Net            float64        `json:"net"`
+	ClosingBalance float64        `json:"closing_balance"`
+	Items          []ActivityItem `json:"items"`
+}
+
+// ActivityItem represents a transaction in an account's activity with the balance it left behind
+type ActivityItem struct {
+	TransactionResponse
+	Change       float64 `json:"change"` // Signed effect on the account, fees included
+	BalanceAfter float64 `json:"balance_after"`
+}
+
+// AccountProjectionRequest represents the request for an account's projected interest and fees
+type AccountProjectionRequest struct {
+	AccountID string `json:"account_id" validate:"required"`
+	Days      int    `json:"days" validate:"min=0,max=92"` // Length of the projected period, defaults to 30
+}
+
+// AccountProjectionResponse represents the interest an account is projected to earn and the fees it
+// is expected to pay over the next period. Interest is earned on the current balance at the product's
+// rate; fees are those the fee rules and the product would charge on the account's outgoing
+// transactions of the last period of the same length, were they made again.
+type AccountProjectionResponse struct {
+	AccountID         string          `json:"account_id"`
+	ProductID         string          `json:"product_id,omitempty"`
+	Currency          string          `json:"currency"`
+	Balance           float64         `json:"balance"`
+	InterestRate      float64         `json:"interest_rate"` // Annual percentage
+	From              string          `json:"from"`          // YYYY-MM-DD, first day of the projected period
+	To                string          `json:"to"`            // YYYY-MM-DD, last day of the projected period
+	Days              int             `json:"days"`
+	ActivitySince     string          `json:"activity_since"` // YYYY-MM-DD, start of the activity fees are projected from
+	ProjectedInterest float64         `json:"projected_interest"`
+	ExpectedFees      float64         `json:"expected_fees"`
+	ProjectedBalance  float64         `json:"projected_balance"` // Balance plus interest less fees
+	Fees              []ProjectedFees `json:"fees"`              // Per transaction type, types without fees left out
+}
+
+// ProjectedFees represents the fees expected on an account's outgoing transactions of one type
+type ProjectedFees struct {
+	TransactionType string  `json:"transaction_type"`
+	Count           int     `json:"count"`
+	RuleFees        float64 `json:"rule_fees"`    // Charged by the business rules
+	ProductFees     float64 `json:"product_fees"` // Charged by the account's product
+	Total           float64 `json:"total"`
+}
+
 // ProcessTransactionRequest represents the request to process a transaction
 type ConfirmTransactionRequest struct {
 	ID string `json:"id" validate:"required"`
 }
 
+// ReplayTransactionRequest represents an admin's replay of a transaction that failed on infrastructure
+type ReplayTransactionRequest struct {
+	ID          string `json:"-" validate:"required"`
+	RequestedBy string `json:"requested_by" validate:"required,max=100"`
+}
+
+// CreateAdjustmentRequest represents an admin's correction of an account's balance with an ADJUSTMENT.
+// It is held for review until a second admin approves it.
+type CreateAdjustmentRequest struct {
+	AccountID   string        `json:"account_id" validate:"required"`
+	Direction   string        `json:"direction" validate:"required,oneof=INCREASE DECREASE"`
+	Amount      DecimalString `json:"amount" validate:"required,gt=0"`
+	ReasonCode  string        `json:"reason_code" validate:"required,oneof=BOOKING_ERROR SYSTEM_ERROR FEE_CORRECTION INTEREST_CORRECTION GOODWILL LEGAL_ORDER"`
+	Description string        `json:"description" validate:"max=500"`
+	RequestedBy string        `json:"requested_by" validate:"required,max=100"`
+}
+
+// AdjustmentReportRequest represents the range of accounting periods an adjustment report covers
+type AdjustmentReportRequest struct {
+	From string `json:"from"` // YYYY-MM, defaults to the current period
+	To   string `json:"to"`   // YYYY-MM, defaults to From
+}
+
+// AdjustmentReportRow represents the completed adjustments of one reason code in one period
+type AdjustmentReportRow struct {
+	Period     string  `json:"period"`
+	ReasonCode string  `json:"reason_code"`
+	Count      int     `json:"count"`
+	Increased  float64 `json:"increased"` // Credited to accounts
+	Decreased  float64 `json:"decreased"` // Debited from accounts
+	Net        float64 `json:"net"`
+}
+
+// AdjustmentReportResponse represents completed adjustments per period and reason code, with the range's totals
+type AdjustmentReportResponse struct {
+	From           string                `json:"from"`
+	To             string                `json:"to"`
+	Rows           []AdjustmentReportRow `json:"rows"`
+	TotalCount     int                   `json:"total_count"`
+	TotalIncreased float64               `json:"total_increased"`
+	TotalDecreased float64               `json:"total_decreased"`
+	TotalNet       float64               `json:"total_net"`
+}
+
+// AdjustTransactionRequest represents an admin's adjustment correcting a transaction, posted into the
+// current period. The type, accounts and amount default to the original's, reposting it.
+type AdjustTransactionRequest struct {
+	ID              string         `json:"-" validate:"required"` // Transaction the adjustment corrects
+	TransactionType string         `json:"transaction_type" validate:"omitempty,oneof=DEBIT CREDIT TRANSFER"`
+	FromAccountID   *string        `json:"from_account_id,omitempty"`
+	ToAccountID     *string        `json:"to_account_id,omitempty"`
+	Amount          *DecimalString `json:"amount" validate:"omitempty,gt=0"`
+	Description     string         `json:"description" validate:"max=500"`
+	RequestedBy     string         `json:"requested_by" validate:"required,max=100"`
+	Reason          string         `json:"reason" validate:"required,max=500"`
+}
+
+// ReviewDecisionRequest represents an admin's approval or decline of a transaction held for review
+type ReviewDecisionRequest struct {
+	ID       string `json:"-" validate:"required"`
+	Reviewer string `json:"reviewer" validate:"required,max=100"`
+	Reason   string `json:"reason" validate:"max=500"`
+}
+
+// ReviewClaimRequest represents an admin claiming or releasing a transaction held for review
+type ReviewClaimRequest struct {
+	ID       string `json:"-" validate:"required"`
+	Reviewer string `json:"reviewer" validate:"required,max=100"`
+}
+
+// ReviewClaimResponse represents an admin's claim on a transaction held for review
+type ReviewClaimResponse struct {
+	TransactionID string    `json:"transaction_id"`
+	ClaimedBy     string    `json:"claimed_by"`
+	ClaimedAt     time.Time `json:"claimed_at"`
+	ExpiresAt     time.Time `json:"expires_at"`
+}
+
+// ReviewQueueMetricsResponse represents the size and age of the review queue
+type ReviewQueueMetricsResponse struct {
+	Depth             int     `json:"depth"`
+	Claimed           int     `json:"claimed"`
+	Unclaimed         int     `json:"unclaimed"`
+	Overdue           int     `json:"overdue"` // Past their deadline, awaiting the automatic decline
+	OldestAgeSeconds  float64 `json:"oldest_age_seconds"`
+	AverageAgeSeconds float64 `json:"average_age_seconds"`
+}
+
 // CancelTransactionRequest represents the request to cancel a transaction
 type CancelTransactionRequest struct {
 	ID string `json:"id" validate:"required"`
 }
+
+// Outcomes of a cancellation request
+const (
+	CancellationOutcomeCancelled = "CANCELLED"
+	CancellationOutcomeReversed  = "REVERSED" // Settled before it could be cancelled, so the money was moved back
+)
+
+// CancelTransactionResponse represents the outcome of a cancellation request
+type CancelTransactionResponse struct {
+	TransactionID string                `json:"transaction_id"`
+	Outcome       string                `json:"outcome"`
+	Reversals     []TransactionResponse `json:"reversals,omitempty"` // The reversal and any fee refund, when REVERSED
+}
+
+// ReverseTransactionRequest represents the request to reverse a completed transaction
+type ReverseTransactionRequest struct {
+	ID string `json:"id" validate:"required"`
+}
+
+// ReverseTransactionResponse represents the transactions that moved a completed transaction's money back
+type ReverseTransactionResponse struct {
+	TransactionID string               `json:"transaction_id"`
+	Reversal      TransactionResponse  `json:"reversal"`
+	FeeRefund     *TransactionResponse `json:"fee_refund,omitempty"` // When the transaction charged a fee
+}
+
+// LiveTransactionRequest represents the filter of the live transaction monitor
+type LiveTransactionRequest struct {
+	MinAmount       *DecimalString `json:"min_amount" validate:"omitempty,min=0"` // Matches amounts strictly greater
+	Status          string         `json:"status" validate:"omitempty,oneof=PENDING REVIEW COMPLETED FAILED CANCELLED"`
+	TransactionType string         `json:"type" validate:"omitempty,oneof=DEBIT CREDIT TRANSFER FX_TRANSFER ADJUSTMENT REVERSAL"`
+}
+
+// LiveTransactionResponse represents a transaction event delivered by the live monitor
+type LiveTransactionResponse struct {
+	EventID         string     `json:"event_id"`
+	Event           string     `json:"event"` // transaction.created or transaction.completed
+	OccurredAt      time.Time  `json:"occurred_at"`
+	TransactionID   string     `json:"transaction_id"`
+	FromAccountID   *string    `json:"from_account_id,omitempty"`
+	ToAccountID     *string    `json:"to_account_id,omitempty"`
+	TransactionType string     `json:"transaction_type"`
+	Channel         string     `json:"channel"`
+	Amount          float64    `json:"amount"`
+	Status          string     `json:"status"`
+	Description     string     `json:"description"`
+	Reference       string     `json:"reference"`
+	Category        string     `json:"category"`
+	CreatedAt       time.Time  `json:"created_at"`
+	CompletedAt     *time.Time `json:"completed_at,omitempty"`
+}
+
+// SimulateTransferRequest represents a transfer to dry-run before it is created
+type SimulateTransferRequest struct {
+	FromAccountID string        `json:"from_account_id" validate:"required"`
+	ToAccountID   string        `json:"to_account_id" validate:"required"`
+	Amount        DecimalString `json:"amount" validate:"required,gt=0"`
+	Description   string        `json:"description" validate:"max=500"`
+	Reference     string        `json:"reference" validate:"max=100"`
+	ReferenceType string        `json:"reference_type" validate:"omitempty,oneof=FREE RF"` // Defaults to FREE
+	Merchant      string        `json:"merchant" validate:"max=100"`
+	Channel       string        `json:"channel" validate:"omitempty,oneof=API BRANCH ATM MOBILE"` // Defaults to API
+}
+
+// SimulatedBalance represents the projected effect of a transfer on one account
+type SimulatedBalance struct {
+	AccountID        string  `json:"account_id"`
+	Balance          float64 `json:"balance"`
+	ProjectedBalance float64 `json:"projected_balance"`
+}
+
+// TransferSimulationResponse represents the outcome a transfer would have if it were created and confirmed now
+type TransferSimulationResponse struct {
+	Valid          bool              `json:"valid"`
+	Amount         float64           `json:"amount"`
+	Fee            float64           `json:"fee"`
+	TotalDebit     float64           `json:"total_debit"` // Amount plus fee taken from the source account
+	Currency       string            `json:"currency"`
+	ExchangeRate   float64           `json:"exchange_rate"`
+	CreditedAmount float64           `json:"credited_amount"`      // Amount received in the destination currency
+	ValueDate      string            `json:"value_date,omitempty"` // YYYY-MM-DD
+	Category       string            `json:"category,omitempty"`
+	FromAccount    *SimulatedBalance `json:"from_account,omitempty"`
+	ToAccount      *SimulatedBalance `json:"to_account,omitempty"`
+	Errors         []ErrorResponse   `json:"errors"`
+	Problems       []error           `json:"-"` // Rendered into Errors by the transport with its error codes
+}
@@ -0,0 +1,50 @@
+// internal/application/dto/transaction_block.go
+package dto
+
+import "time"
+
+// CreateTransactionBlockRequest represents an admin blocking a type of transaction on an account for a while
+type CreateTransactionBlockRequest struct {
+	AccountID       string `json:"-" validate:"required"`
+	TransactionType string `json:"transaction_type" validate:"required,oneof=DEBIT CREDIT TRANSFER"`
+	ReasonCode      string `json:"reason_code" validate:"required,oneof=CREDENTIAL_CHANGE NEW_DEVICE SUSPECTED_FRAUD CUSTOMER_REQUEST LEGAL_ORDER"`
+	Note            string `json:"note" validate:"max=500"`
+	DurationMinutes int    `json:"duration_minutes" validate:"required,min=1,max=525600"` // At most a year
+	CreatedBy       string `json:"created_by" validate:"required,max=100"`
+}
+
+// LiftTransactionBlockRequest represents an admin ending a transaction block before it expires
+type LiftTransactionBlockRequest struct {
+	AccountID string `json:"-" validate:"required"`
+	ID        string `json:"-" validate:"required"`
+	Admin     string `json:"admin" validate:"required,max=100"`
+}
+
+// TransactionBlockListRequest represents the filters of an account's transaction block list
+type TransactionBlockListRequest struct {
+	AccountID string `json:"-" validate:"required"`
+	Page      int    `json:"page" validate:"min=1"`
+	PageSize  int    `json:"page_size" validate:"min=1,max=100"`
+	Active    bool   `json:"active"` // Only blocks in force now
+}
+
+// TransactionBlockResponse represents a temporary block of a type of transaction on an account
+type TransactionBlockResponse struct {
+	ID              string     `json:"id"`
+	AccountID       string     `json:"account_id"`
+	TransactionType string     `json:"transaction_type"`
+	ReasonCode      string     `json:"reason_code"`
+	Note            string     `json:"note,omitempty"`
+	CreatedBy       string     `json:"created_by"`
+	CreatedAt       time.Time  `json:"created_at"`
+	ExpiresAt       time.Time  `json:"expires_at"`
+	LiftedBy        string     `json:"lifted_by,omitempty"`
+	LiftedAt        *time.Time `json:"lifted_at,omitempty"`
+	Active          bool       `json:"active"` // In force at the time of the response
+}
+
+// TransactionBlockListResponse represents a page of transaction blocks
+type TransactionBlockListResponse struct {
+	Blocks     []TransactionBlockResponse `json:"blocks"`
+	Pagination PaginationInfo             `json:"pagination"`
+}
@@ -0,0 +1,56 @@
+// internal/application/dto/transaction_history.go
+package dto
+
+import "time"
+
+// HistoryEntryResponse represents one account's side of a transaction in its transaction history
+type HistoryEntryResponse struct {
+	TransactionID    string     `json:"transaction_id"`
+	Sequence         int64      `json:"sequence,omitempty"` // Position among the account's completed transactions
+	TransactionType  string     `json:"transaction_type"`
+	Direction        string     `json:"direction"` // IN or OUT
+	CounterpartyID   *string    `json:"counterparty_id,omitempty"`
+	CounterpartyName string     `json:"counterparty_name,omitempty"`
+	Amount           float64    `json:"amount"`
+	Fee              float64    `json:"fee"`
+	Change           float64    `json:"change"`                  // Signed effect on the account, fees included
+	BalanceAfter     *float64   `json:"balance_after,omitempty"` // Once completed
+	Category         string     `json:"category"`
+	Tags             []string   `json:"tags"` // Set by the account holder
+	Status           string     `json:"status"`
+	Description      string     `json:"description"`
+	Reference        string     `json:"reference"`
+	CreatedAt        time.Time  `json:"created_at"`
+	CompletedAt      *time.Time `json:"completed_at,omitempty"`
+}
+
+// TransactionHistoryResponse represents a page of an account's transaction history
+type TransactionHistoryResponse struct {
+	AccountID  string                 `json:"account_id"`
+	Entries    []HistoryEntryResponse `json:"entries"`
+	Pagination PaginationInfo         `json:"pagination"`
+}
+
+// HistoryRebuildResponse represents the outcome of projecting an account's transactions again
+type HistoryRebuildResponse struct {
+	AccountID    string `json:"account_id"`
+	Transactions int    `json:"transactions"` // Transactions projected
+}
+
+// HistorySyncRequest represents a request for the completed history entries of an account after a sequence number
+type HistorySyncRequest struct {
+	AfterSequence int64 `json:"after_sequence" validate:"min=0"`
+	Limit         int   `json:"limit" validate:"min=1,max=500"`
+}
+
+// HistorySyncResponse represents the completed history entries of an account after a sequence number, in
+// sequence order. Entries stop before a missing sequence number, which is reported in Gap, so a client
+// resuming from NextSequence never skips a transaction.
+type HistorySyncResponse struct {
+	AccountID    string                 `json:"account_id"`
+	Entries      []HistoryEntryResponse `json:"entries"`
+	NextSequence int64                  `json:"next_sequence"` // Sequence to pass as after_seq on the next call
+	LastSequence int64                  `json:"last_sequence"` // Latest sequence assigned to the account
+	HasMore      bool                   `json:"has_more"`
+	Gap          *int64                 `json:"gap,omitempty"` // First sequence not yet in the history
+}
@@ -0,0 +1,56 @@
+// internal/application/dto/transaction_tag.go
+package dto
+
+import "time"
+
+// SetTransactionTagsRequest represents an account holder's tags for one of their transactions; the tags
+// replace the ones set before, and none clears them
+type SetTransactionTagsRequest struct {
+	AccountID     string   `json:"-" validate:"required"`
+	TransactionID string   `json:"-" validate:"required"`
+	Tags          []string `json:"tags" validate:"max=10,dive,required,max=30"`
+}
+
+// TransactionTagsResponse represents an account's tags of a transaction
+type TransactionTagsResponse struct {
+	AccountID     string   `json:"account_id"`
+	TransactionID string   `json:"transaction_id"`
+	Tags          []string `json:"tags"`
+}
+
+// HistoryRequest represents the request for a page of an account's transaction history, optionally
+// narrowed by tags, direction and transaction type or by a saved filter. Criteria given with a saved
+// filter replace the filter's own.
+type HistoryRequest struct {
+	ListRequest
+	Tags            []string `json:"tags" validate:"max=10,dive,required,max=30"` // Entries tagged with any of them
+	Direction       string   `json:"direction" validate:"omitempty,oneof=IN OUT"`
+	TransactionType string   `json:"transaction_type" validate:"omitempty,oneof=DEBIT CREDIT TRANSFER FX_TRANSFER ADJUSTMENT REVERSAL"`
+	FilterID        string   `json:"filter_id" validate:"max=32"`
+}
+
+// SavedFilterRequest represents the request to save a named history filter for an account
+type SavedFilterRequest struct {
+	AccountID       string   `json:"-" validate:"required"`
+	Name            string   `json:"name" validate:"required,max=100"`
+	Tags            []string `json:"tags" validate:"max=10,dive,required,max=30"`
+	Direction       string   `json:"direction" validate:"omitempty,oneof=IN OUT"`
+	TransactionType string   `json:"transaction_type" validate:"omitempty,oneof=DEBIT CREDIT TRANSFER FX_TRANSFER ADJUSTMENT REVERSAL"`
+}
+
+// SavedFilterResponse represents the response structure for a saved history filter
+type SavedFilterResponse struct {
+	ID              string    `json:"id"`
+	AccountID       string    `json:"account_id"`
+	Name            string    `json:"name"`
+	Tags            []string  `json:"tags"`
+	Direction       string    `json:"direction,omitempty"`
+	TransactionType string    `json:"transaction_type,omitempty"`
+	CreatedAt       time.Time `json:"created_at"`
+	UpdatedAt       time.Time `json:"updated_at"`
+}
+
+// SavedFilterListResponse represents the saved history filters of an account
+type SavedFilterListResponse struct {
+	Filters []SavedFilterResponse `json:"filters"`
+}
@@ -0,0 +1,45 @@
+// internal/application/dto/transfer_template.go
+package dto
+
+import "time"
+
+// TransferTemplateRequest represents the request to save a transfer template for an account or to change
+// one; the template ID is only required under the update profile
+type TransferTemplateRequest struct {
+	AccountID   string        `json:"-" validate:"required"`
+	ID          string        `json:"-" validate:"required_on=update,excluded_on=create"`
+	Name        string        `json:"name" validate:"required,max=100"`
+	ToAccountID string        `json:"to_account_id" validate:"required"`
+	Amount      DecimalString `json:"amount" validate:"required,gt=0"`
+	Description string        `json:"description" validate:"max=500"`
+	Reference   string        `json:"reference" validate:"max=100"` // Defaults to TEMPLATE-<id> on transfers
+}
+
+// ExecuteTransferTemplateRequest represents the request to transfer from a template; every field is optional
+type ExecuteTransferTemplateRequest struct {
+	AccountID   string         `json:"-" validate:"required"`
+	ID          string         `json:"-" validate:"required"`
+	Amount      *DecimalString `json:"amount,omitempty" validate:"omitempty,gt=0"`         // Overrides the template amount
+	Description *string        `json:"description,omitempty" validate:"omitempty,max=500"` // Overrides the template description
+	Channel     string         `json:"channel" validate:"omitempty,oneof=API BRANCH ATM MOBILE"`
+}
+
+// TransferTemplateResponse represents the response structure for a transfer template
+type TransferTemplateResponse struct {
+	ID          string     `json:"id"`
+	AccountID   string     `json:"account_id"`
+	Name        string     `json:"name"`
+	ToAccountID string     `json:"to_account_id"`
+	Amount      float64    `json:"amount"`
+	Description string     `json:"description"`
+	Reference   string     `json:"reference"`
+	UseCount    int        `json:"use_count"`
+	LastUsedAt  *time.Time `json:"last_used_at,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+}
+
+// TransferTemplateListResponse represents the transfer templates of an account
+type TransferTemplateListResponse struct {
+	Templates []TransferTemplateResponse `json:"templates"`
+}
@@ -0,0 +1,36 @@
+// internal/application/dto/velocity.go
+package dto
+
+import "time"
+
+// VelocityUsageResponse represents an account's usage of its velocity limits in the current windows
+type VelocityUsageResponse struct {
+	AccountID          string              `json:"account_id"`
+	DailyDebit         VelocityAmountUsage `json:"daily_debit"`
+	HourlyTransactions VelocityCountUsage  `json:"hourly_transactions"`
+}
+
+// VelocityAmountUsage represents the money sent out in a window against its limit
+type VelocityAmountUsage struct {
+	Used      float64   `json:"used"`
+	Limit     *float64  `json:"limit,omitempty"`     // Absent when unlimited
+	Remaining *float64  `json:"remaining,omitempty"` // Absent when unlimited
+	ResetsAt  time.Time `json:"resets_at"`
+}
+
+// VelocityCountUsage represents the transactions made in a window against its limit
+type VelocityCountUsage struct {
+	Used      int64     `json:"used"`
+	Limit     *int64    `json:"limit,omitempty"`     // Absent when unlimited
+	Remaining *int64    `json:"remaining,omitempty"` // Absent when unlimited
+	ResetsAt  time.Time `json:"resets_at"`
+}
+
+// LimitWarning tells that an account has nearly used up one of its limits
+type LimitWarning struct {
+	Code        string    `json:"code"` // DAILY_DEBIT_LIMIT_NEAR or HOURLY_TRANSACTION_LIMIT_NEAR
+	Message     string    `json:"message"`
+	PercentUsed float64   `json:"percent_used"`
+	Remaining   float64   `json:"remaining"` // Money left of a daily debit limit, transactions left of an hourly one
+	ResetsAt    time.Time `json:"resets_at"`
+}
@@ -0,0 +1,34 @@
+// internal/application/dto/virtual_account.go
+package dto
+
+import "time"
+
+// CreateVirtualAccountRequest represents the request to issue a virtual account number for a settlement account
+type CreateVirtualAccountRequest struct {
+	AccountID string `json:"-" validate:"required"`
+	Label     string `json:"label" validate:"max=100"`
+}
+
+// UpdateVirtualAccountRequest represents the request to relabel, close or reopen a virtual account
+type UpdateVirtualAccountRequest struct {
+	AccountID string `json:"-" validate:"required"`
+	ID        string `json:"-" validate:"required"`
+	Label     string `json:"label" validate:"max=100"`
+	Status    string `json:"status" validate:"required,oneof=ACTIVE CLOSED"`
+}
+
+// VirtualAccountResponse represents a virtual account number and the account it settles into
+type VirtualAccountResponse struct {
+	ID        string    `json:"id"`
+	AccountID string    `json:"account_id"`
+	Label     string    `json:"label,omitempty"`
+	Status    string    `json:"status"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// VirtualAccountListResponse represents the paginated virtual accounts of a settlement account
+type VirtualAccountListResponse struct {
+	VirtualAccounts []VirtualAccountResponse `json:"virtual_accounts"`
+	Pagination      PaginationInfo           `json:"pagination"`
+}
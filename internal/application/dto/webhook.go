@@ -0,0 +1,62 @@
+// internal/application/dto/webhook.go
+package dto
+
+import "time"
+
+// CreateWebhookRequest represents the request to subscribe a URL to an account's events
+type CreateWebhookRequest struct {
+	AccountID string         `json:"-" validate:"required"`
+	URL       string         `json:"url" validate:"required,url,max=500"`
+	Events    []string       `json:"events" validate:"required,min=1,dive,oneof=balance.below_threshold credit.received"`
+	Threshold *DecimalString `json:"threshold" validate:"omitempty,min=0"` // Required for balance.below_threshold
+}
+
+// WebhookResponse represents a webhook subscription of an account
+type WebhookResponse struct {
+	ID                  string     `json:"id"`
+	AccountID           string     `json:"account_id"`
+	URL                 string     `json:"url"`
+	Secret              string     `json:"secret,omitempty"` // Only returned when the subscription is created
+	Events              []string   `json:"events"`
+	Threshold           *float64   `json:"threshold,omitempty"`
+	Status              string     `json:"status"`
+	ConsecutiveFailures int        `json:"consecutive_failures"`
+	LastError           string     `json:"last_error,omitempty"`
+	LastDeliveryAt      *time.Time `json:"last_delivery_at,omitempty"`
+	DisabledAt          *time.Time `json:"disabled_at,omitempty"`
+	CreatedAt           time.Time  `json:"created_at"`
+	UpdatedAt           time.Time  `json:"updated_at"`
+}
+
+// WebhookListResponse represents the webhook subscriptions of an account
+type WebhookListResponse struct {
+	Webhooks []WebhookResponse `json:"webhooks"`
+}
+
+// WebhookTestResponse represents the outcome of a test delivery
+type WebhookTestResponse struct {
+	Delivered  bool   `json:"delivered"`
+	StatusCode int    `json:"status_code,omitempty"`
+	Error      string `json:"error,omitempty"`
+}
+
+// WebhookPayload is the JSON body posted to subscriber URLs
+type WebhookPayload struct {
+	ID         string      `json:"id"` // Stable across retries of the same event
+	Event      string      `json:"event"`
+	AccountID  string      `json:"account_id"`
+	OccurredAt time.Time   `json:"occurred_at"`
+	Data       WebhookData `json:"data"`
+}
+
+// WebhookData describes what happened to the account
+type WebhookData struct {
+	TransactionID    string   `json:"transaction_id,omitempty"`
+	Amount           *float64 `json:"amount,omitempty"`
+	FromAccountID    *string  `json:"from_account_id,omitempty"`
+	VirtualAccountID string   `json:"virtual_account_id,omitempty"`
+	Description      string   `json:"description,omitempty"`
+	Reference        string   `json:"reference,omitempty"`
+	Balance          *float64 `json:"balance,omitempty"`
+	Threshold        *float64 `json:"threshold,omitempty"`
+}
@@ -0,0 +1,57 @@
+// internal/application/exchange_rate.go
+package usecase
+
+import (
+	"context"
+
+	"github.com/hydr0g3nz/mini_bank/internal/application/dto"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/infra"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+)
+
+type exchangeRateUseCase struct {
+	rates    infra.ExchangeRateService
+	currency string
+	logger   infra.Logger
+}
+
+// NewExchangeRateUseCase creates a new exchange rate use case quoting rates from rates
+func NewExchangeRateUseCase(rates infra.ExchangeRateService, currency string, logger infra.Logger) ExchangeRateUseCase {
+	return &exchangeRateUseCase{
+		rates:    rates,
+		currency: currency,
+		logger:   logger,
+	}
+}
+
+// ListRates retrieves the current rates from a base currency, the service's currency when empty. These
+// are the rates an FX transfer created now would be converted at.
+func (uc *exchangeRateUseCase) ListRates(ctx context.Context, base string) (*dto.ExchangeRateListResponse, error) {
+	if base == "" {
+		base = uc.currency
+	}
+	currency, err := vo.NewCurrency(base)
+	if err != nil {
+		return nil, err
+	}
+
+	rates, err := uc.rates.ListRates(ctx, currency)
+	if err != nil {
+		uc.logger.Error("Failed to list exchange rates", "error", err, "base", currency.String())
+		return nil, err
+	}
+
+	response := &dto.ExchangeRateListResponse{
+		Base:  currency.String(),
+		Rates: make([]dto.ExchangeRateResponse, len(rates)),
+	}
+	for i, rate := range rates {
+		response.Rates[i] = dto.ExchangeRateResponse{
+			From: rate.From.String(),
+			To:   rate.To.String(),
+			Rate: rate.Rate.InexactFloat64(),
+			AsOf: rate.AsOf,
+		}
+	}
+	return response, nil
+}
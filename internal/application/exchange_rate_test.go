@@ -0,0 +1,65 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// StubExchangeRateService quotes a fixed list of rates
+type StubExchangeRateService struct {
+	Rates []vo.ExchangeRate
+}
+
+func (s *StubExchangeRateService) GetRate(ctx context.Context, from, to vo.Currency) (vo.ExchangeRate, error) {
+	for _, rate := range s.Rates {
+		if rate.From == from && rate.To == to {
+			return rate, nil
+		}
+	}
+	return vo.ExchangeRate{}, fmt.Errorf("%s to %s: %w", from, to, errs.ErrExchangeRateUnavailable)
+}
+
+func (s *StubExchangeRateService) ListRates(ctx context.Context, base vo.Currency) ([]vo.ExchangeRate, error) {
+	var rates []vo.ExchangeRate
+	for _, rate := range s.Rates {
+		if rate.From == base {
+			rates = append(rates, rate)
+		}
+	}
+	return rates, nil
+}
+
+// usdToTHB is the rate the FX tests convert dollars to baht at
+var usdToTHB = vo.ExchangeRate{From: "USD", To: "THB", Rate: decimal.RequireFromString("35.5"), AsOf: time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)}
+
+func TestExchangeRateUseCase_ListRates(t *testing.T) {
+	mockLogger := new(MockLogger)
+	mockLogger.On("Error", mock.Anything, mock.Anything).Maybe()
+	uc := NewExchangeRateUseCase(&StubExchangeRateService{Rates: []vo.ExchangeRate{usdToTHB, usdToTHB.Inverse()}}, "THB", mockLogger)
+
+	// The service currency by default
+	result, err := uc.ListRates(context.Background(), "")
+	require.NoError(t, err)
+	assert.Equal(t, "THB", result.Base)
+	require.Len(t, result.Rates, 1)
+	assert.Equal(t, "USD", result.Rates[0].To)
+
+	result, err = uc.ListRates(context.Background(), "usd")
+	require.NoError(t, err)
+	assert.Equal(t, "USD", result.Base)
+	require.Len(t, result.Rates, 1)
+	assert.Equal(t, 35.5, result.Rates[0].Rate)
+	assert.Equal(t, usdToTHB.AsOf, result.Rates[0].AsOf)
+
+	_, err = uc.ListRates(context.Background(), "XYZ")
+	assert.IsType(t, errs.ValidationError{}, err)
+}
@@ -0,0 +1,496 @@
+// internal/application/export.go
+package usecase
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"path"
+	"strconv"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/application/dto"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/infra"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/repository"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+)
+
+const (
+	// exportTimeout bounds how long producing a single export may take
+	exportTimeout = 30 * time.Minute
+	// exportHistoryPageSize is the number of history entries read at a time when exporting an account's history
+	exportHistoryPageSize = 500
+	// expiredExportBatchSize caps how many expired exports are loaded at a time when purging them
+	expiredExportBatchSize = 100
+	// exportObjectPrefix is the blob storage key prefix of export files
+	exportObjectPrefix = "exports/"
+	// exportDownloadPath is the path, below the public URL, export download links point to
+	exportDownloadPath = "/downloads/exports/"
+)
+
+// historyExportHeader is the header row of an account history CSV export
+var historyExportHeader = []string{
+	"created_at", "completed_at", "transaction_id", "transaction_type", "direction", "counterparty_id",
+	"counterparty_name", "description", "reference", "category", "status", "amount", "fee", "change", "balance_after",
+}
+
+// DownloadLinks signs time-limited download links, so whoever holds a link can fetch the file without
+// an API key until it expires, and checks the links presented for download
+type DownloadLinks struct {
+	signingKey  infra.SecretValue
+	publicURL   string
+	ttl         time.Duration
+	valueDating *ValueDatingPolicy
+}
+
+// NewDownloadLinks creates download links signed with the current value of signingKey and valid for
+// ttl by the clock of valueDating. Links are prefixed with publicURL, the service's external address;
+// empty gives relative links.
+func NewDownloadLinks(signingKey infra.SecretValue, publicURL string, ttl time.Duration, valueDating *ValueDatingPolicy) *DownloadLinks {
+	return &DownloadLinks{
+		signingKey:  signingKey,
+		publicURL:   publicURL,
+		ttl:         ttl,
+		valueDating: valueDating,
+	}
+}
+
+// URL returns a link to download an export and when it expires, which is never after notAfter
+func (l *DownloadLinks) URL(exportID string, notAfter time.Time) (string, time.Time) {
+	expiresAt := l.valueDating.Now().Add(l.ttl)
+	if expiresAt.After(notAfter) {
+		expiresAt = notAfter
+	}
+	expiresAt = expiresAt.Truncate(time.Second)
+
+	query := url.Values{
+		"expires":   {strconv.FormatInt(expiresAt.Unix(), 10)},
+		"signature": {hex.EncodeToString(l.sign(exportID, expiresAt.Unix()))},
+	}
+	return l.publicURL + exportDownloadPath + url.PathEscape(exportID) + "?" + query.Encode(), expiresAt
+}
+
+// Verify checks that a link was signed for the export and has not expired
+func (l *DownloadLinks) Verify(exportID string, expires int64, signature string) error {
+	given, err := hex.DecodeString(signature)
+	if err != nil || !hmac.Equal(given, l.sign(exportID, expires)) {
+		return errs.ErrDownloadLinkInvalid
+	}
+	if l.valueDating.Now().Unix() >= expires {
+		return errs.ErrDownloadLinkInvalid
+	}
+	return nil
+}
+
+// sign returns the HMAC-SHA256 of an export's ID and link expiry
+func (l *DownloadLinks) sign(exportID string, expires int64) []byte {
+	mac := hmac.New(sha256.New, []byte(l.signingKey.Value()))
+	mac.Write([]byte(exportID + "\n" + strconv.FormatInt(expires, 10)))
+	return mac.Sum(nil)
+}
+
+// exportJob is the payload of an export job
+type exportJob struct {
+	ExportID string `json:"export_id"`
+}
+
+// exportWriter writes the contents of an export to w
+type exportWriter func(ctx context.Context, w io.Writer) error
+
+type exportUseCase struct {
+	exportRepo     repository.ExportRepository
+	accountRepo    repository.AccountRepository
+	auditUseCase   AuditUseCase
+	historyUseCase TransactionHistoryUseCase
+	storage        infra.BlobStorage
+	links          *DownloadLinks
+	retention      time.Duration
+	jobs           *JobQueue
+	callbacks      infra.WebhookSender
+	valueDating    *ValueDatingPolicy
+	logger         infra.Logger
+	mapper         *dto.ExportMapper
+}
+
+// NewExportUseCase creates a new export use case writing export files as jobs on jobs and keeping
+// them in storage for retention. Finished exports with a callback URL are posted to it through
+// callbacks, signed like webhooks.
+func NewExportUseCase(
+	exportRepo repository.ExportRepository,
+	accountRepo repository.AccountRepository,
+	auditUseCase AuditUseCase,
+	historyUseCase TransactionHistoryUseCase,
+	storage infra.BlobStorage,
+	links *DownloadLinks,
+	retention time.Duration,
+	jobs *JobQueue,
+	callbacks infra.WebhookSender,
+	valueDating *ValueDatingPolicy,
+	logger infra.Logger,
+) ExportUseCase {
+	uc := &exportUseCase{
+		exportRepo:     exportRepo,
+		accountRepo:    accountRepo,
+		auditUseCase:   auditUseCase,
+		historyUseCase: historyUseCase,
+		storage:        storage,
+		links:          links,
+		retention:      retention,
+		jobs:           jobs,
+		callbacks:      callbacks,
+		valueDating:    valueDating,
+		logger:         logger,
+		mapper:         &dto.ExportMapper{},
+	}
+	jobs.Register(JobTypeExport, uc.runExport, JobOptions{Timeout: exportTimeout})
+	return uc
+}
+
+// ExportAuditLog records a new audit export and queues a job writing the signed NDJSON
+func (uc *exportUseCase) ExportAuditLog(ctx context.Context, req dto.AuditExportRequest, requestedBy string) (*dto.ExportResponse, error) {
+	if req.From != nil && req.To != nil && !req.From.Before(*req.To) {
+		return nil, errs.ValidationError{Field: "to", Message: "to must be after from"}
+	}
+
+	now := uc.valueDating.Now()
+	fileName := fmt.Sprintf("audit-%s.ndjson", now.UTC().Format("20060102T150405Z"))
+	export := entity.NewExport(entity.ExportKindAudit, fileName, "application/x-ndjson", requestedBy, uc.retention, now)
+	export.AccountID = req.AccountID
+	export.EventType = req.EventType
+	export.From = req.From
+	export.To = req.To
+
+	return uc.start(ctx, export, req.CallbackURL)
+}
+
+// ExportAccountHistory records a new account history export and queues a job writing the CSV
+func (uc *exportUseCase) ExportAccountHistory(ctx context.Context, accountID string, req dto.HistoryExportRequest, requestedBy string) (*dto.ExportResponse, error) {
+	parsedAccountID, err := vo.NewAccountIDFromString(accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := uc.accountRepo.GetByID(ctx, parsedAccountID); err != nil {
+		return nil, errs.ErrAccountNotFound
+	}
+
+	now := uc.valueDating.Now()
+	fileName := fmt.Sprintf("history-%s-%s.csv", accountID, now.UTC().Format("20060102T150405Z"))
+	export := entity.NewExport(entity.ExportKindAccountHistory, fileName, "text/csv", requestedBy, uc.retention, now)
+	export.AccountID = accountID
+
+	return uc.start(ctx, export, req.CallbackURL)
+}
+
+// GetExport retrieves an export. A completed export comes with a new download link on every call.
+func (uc *exportUseCase) GetExport(ctx context.Context, id string) (*dto.ExportResponse, error) {
+	export, err := uc.exportRepo.GetByID(ctx, id)
+	if err != nil {
+		uc.logger.Error("Failed to get export", "error", err, "exportID", id)
+		return nil, err
+	}
+
+	response := uc.mapper.ToResponse(export)
+	if export.IsDownloadable(uc.valueDating.Now()) {
+		downloadURL, expiresAt := uc.links.URL(export.ID, export.ExpiresAt)
+		response.DownloadURL = downloadURL
+		response.DownloadURLExpiresAt = &expiresAt
+	}
+	return &response, nil
+}
+
+// OpenDownload checks the signature and expiry of a download link, then opens the export's file
+func (uc *exportUseCase) OpenDownload(ctx context.Context, req dto.DownloadRequest) (*dto.ExportDownload, error) {
+	if err := uc.links.Verify(req.ExportID, req.Expires, req.Signature); err != nil {
+		uc.logger.Warn("Invalid download link", "exportID", req.ExportID)
+		return nil, err
+	}
+
+	export, err := uc.exportRepo.GetByID(ctx, req.ExportID)
+	if err != nil {
+		return nil, err
+	}
+	if export.IsExpired(uc.valueDating.Now()) {
+		return nil, errs.ErrExportExpired
+	}
+	if !export.IsDownloadable(uc.valueDating.Now()) {
+		return nil, errs.ErrExportNotReady
+	}
+
+	content, err := uc.storage.Open(ctx, export.ObjectKey)
+	if err != nil {
+		uc.logger.Error("Failed to open export file", "error", err, "exportID", export.ID, "objectKey", export.ObjectKey)
+		if errors.Is(err, infra.ErrBlobNotFound) {
+			return nil, errs.ErrExportNotFound
+		}
+		return nil, err
+	}
+
+	return &dto.ExportDownload{
+		Content:     content,
+		FileName:    export.FileName,
+		ContentType: export.ContentType,
+		SizeBytes:   export.SizeBytes,
+	}, nil
+}
+
+// PurgeExpired deletes the exports past their retention, file first, so no record is left pointing
+// to a deleted file
+func (uc *exportUseCase) PurgeExpired(ctx context.Context) (int64, error) {
+	var purged int64
+	for {
+		exports, err := uc.exportRepo.ListExpired(ctx, uc.valueDating.Now(), expiredExportBatchSize)
+		if err != nil {
+			uc.logger.Error("Failed to list expired exports", "error", err)
+			return purged, err
+		}
+
+		for _, export := range exports {
+			if export.ObjectKey != "" {
+				if err := uc.storage.Delete(ctx, export.ObjectKey); err != nil {
+					uc.logger.Error("Failed to delete export file", "error", err, "exportID", export.ID, "objectKey", export.ObjectKey)
+					return purged, err
+				}
+			}
+			if err := uc.exportRepo.Delete(ctx, export.ID); err != nil {
+				uc.logger.Error("Failed to delete export", "error", err, "exportID", export.ID)
+				return purged, err
+			}
+			purged++
+		}
+
+		if len(exports) < expiredExportBatchSize {
+			break
+		}
+	}
+
+	if purged > 0 {
+		uc.logger.Info("Expired exports purged", "count", purged)
+	}
+	return purged, nil
+}
+
+// start records a new export and queues the job writing its file. The callback secret is only
+// returned here.
+func (uc *exportUseCase) start(ctx context.Context, export *entity.Export, callbackURL string) (*dto.ExportResponse, error) {
+	if callbackURL != "" {
+		if err := export.SetCallback(callbackURL); err != nil {
+			return nil, err
+		}
+	}
+
+	uc.logger.Info("Starting export", "exportID", export.ID, "kind", export.Kind, "requestedBy", export.RequestedBy)
+
+	if err := uc.exportRepo.Create(ctx, export); err != nil {
+		uc.logger.Error("Failed to record export", "error", err, "exportID", export.ID)
+		return nil, err
+	}
+
+	// Jobs are picked up by the wall clock, whatever the business clock says
+	if _, err := uc.jobs.Enqueue(ctx, JobTypeExport, exportJob{ExportID: export.ID}, time.Now()); err != nil {
+		export.MarkAsFailed("export could not be queued")
+		if updateErr := uc.exportRepo.Update(ctx, export); updateErr != nil {
+			uc.logger.Error("Failed to update export record", "error", updateErr, "exportID", export.ID)
+		}
+		return nil, err
+	}
+
+	response := uc.mapper.ToResponse(export)
+	response.CallbackSecret = export.CallbackSecret
+	return &response, nil
+}
+
+// runExport streams the contents of an export job's export into blob storage and records the
+// outcome. A failed attempt is retried, and only recorded on the export once the job is out of attempts.
+func (uc *exportUseCase) runExport(ctx context.Context, job *entity.Job) error {
+	var payload exportJob
+	if err := job.DecodePayload(&payload); err != nil {
+		return err
+	}
+
+	export, err := uc.exportRepo.GetByID(ctx, payload.ExportID)
+	if err != nil {
+		return err
+	}
+	// A job run again after its worker died may find the export already recorded
+	if export.Status.IsFinished() {
+		return nil
+	}
+
+	write, err := uc.writer(export)
+	if err != nil {
+		return err
+	}
+
+	// Retries overwrite the file of the failed attempt
+	objectKey := exportObjectPrefix + export.ID + path.Ext(export.FileName)
+
+	reader, writer := io.Pipe()
+	go func() {
+		writer.CloseWithError(write(ctx, writer))
+	}()
+	size, exportErr := uc.storage.Put(ctx, objectKey, reader)
+	// Unblocks the writer when the storage stopped reading early
+	reader.CloseWithError(exportErr)
+
+	if exportErr != nil {
+		uc.logger.Error("Export failed", "error", exportErr, "exportID", export.ID, "attempt", job.Attempts)
+		if !job.IsLastAttempt() {
+			return exportErr
+		}
+		export.MarkAsFailed(exportErr.Error())
+	} else {
+		export.MarkAsCompleted(objectKey, size)
+		uc.logger.Info("Export completed", "exportID", export.ID, "objectKey", objectKey, "sizeBytes", size)
+	}
+
+	// The outcome is recorded even when the export ran out of time
+	ctx = context.WithoutCancel(ctx)
+	if err := uc.exportRepo.Update(ctx, export); err != nil {
+		uc.logger.Error("Failed to update export record", "error", err, "exportID", export.ID)
+		return err
+	}
+
+	if export.CallbackURL != "" && uc.callbacks != nil {
+		uc.notifyCallback(ctx, export)
+	}
+	return exportErr
+}
+
+// notifyCallback posts a finished export, with a fresh download link once it completed, to its
+// callback URL and records the outcome. The export job is not retried for a failed notification;
+// the outbound HTTP client already retried it, and the client can still poll.
+func (uc *exportUseCase) notifyCallback(ctx context.Context, export *entity.Export) {
+	data := uc.mapper.ToResponse(export)
+	if export.IsDownloadable(uc.valueDating.Now()) {
+		downloadURL, expiresAt := uc.links.URL(export.ID, export.ExpiresAt)
+		data.DownloadURL = downloadURL
+		data.DownloadURLExpiresAt = &expiresAt
+	}
+	event := export.CallbackEvent()
+	payload := dto.ExportCallbackPayload{
+		ID:         fmt.Sprintf("%s-%s", export.ID, event),
+		Event:      event,
+		OccurredAt: *export.CompletedAt,
+		Data:       data,
+	}
+
+	body, err := json.Marshal(payload)
+	if err == nil {
+		_, err = uc.callbacks.Send(ctx, export.CallbackURL, export.CallbackSecret, body)
+	}
+	if err != nil {
+		uc.logger.Warn("Export callback failed", "error", err, "exportID", export.ID, "event", event)
+		export.RecordCallbackFailure(err.Error())
+	} else {
+		export.RecordCallbackDelivered()
+	}
+
+	if err := uc.exportRepo.Update(ctx, export); err != nil {
+		uc.logger.Error("Failed to record export callback", "error", err, "exportID", export.ID)
+	}
+}
+
+// writer returns the function writing the contents of an export, from the filters stored on it
+func (uc *exportUseCase) writer(export *entity.Export) (exportWriter, error) {
+	switch export.Kind {
+	case entity.ExportKindAudit:
+		req := dto.AuditExportRequest{
+			EventType: export.EventType,
+			AccountID: export.AccountID,
+			From:      export.From,
+			To:        export.To,
+		}
+		return func(ctx context.Context, w io.Writer) error {
+			_, err := uc.auditUseCase.ExportAudit(ctx, req, w)
+			return err
+		}, nil
+	case entity.ExportKindAccountHistory:
+		return func(ctx context.Context, w io.Writer) error {
+			return uc.writeHistoryCSV(ctx, export.AccountID, w)
+		}, nil
+	}
+	return nil, fmt.Errorf("unknown export kind %q", export.Kind)
+}
+
+// writeHistoryCSV writes an account's transaction history as CSV, newest first
+func (uc *exportUseCase) writeHistoryCSV(ctx context.Context, accountID string, w io.Writer) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(historyExportHeader); err != nil {
+		return err
+	}
+
+	// Transactions projected while the export runs push older entries onto later pages, where
+	// they would be written twice
+	written := make(map[string]bool)
+	for page := 1; ; page++ {
+		history, err := uc.historyUseCase.GetAccountHistory(ctx, accountID, dto.HistoryRequest{ListRequest: dto.ListRequest{Page: page, PageSize: exportHistoryPageSize}})
+		if err != nil {
+			return err
+		}
+
+		for _, entry := range history.Entries {
+			if written[entry.TransactionID] {
+				continue
+			}
+			written[entry.TransactionID] = true
+			if err := writer.Write(historyExportRecord(entry)); err != nil {
+				return err
+			}
+		}
+
+		if len(history.Entries) < exportHistoryPageSize {
+			break
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+// historyExportRecord returns the CSV row of a history entry
+func historyExportRecord(entry dto.HistoryEntryResponse) []string {
+	formatTime := func(t *time.Time) string {
+		if t == nil {
+			return ""
+		}
+		return t.UTC().Format(time.RFC3339)
+	}
+	formatAmount := func(amount *float64) string {
+		if amount == nil {
+			return ""
+		}
+		return strconv.FormatFloat(*amount, 'f', -1, 64)
+	}
+	counterpartyID := ""
+	if entry.CounterpartyID != nil {
+		counterpartyID = *entry.CounterpartyID
+	}
+
+	return []string{
+		formatTime(&entry.CreatedAt),
+		formatTime(entry.CompletedAt),
+		entry.TransactionID,
+		entry.TransactionType,
+		entry.Direction,
+		counterpartyID,
+		entry.CounterpartyName,
+		entry.Description,
+		entry.Reference,
+		entry.Category,
+		entry.Status,
+		formatAmount(&entry.Amount),
+		formatAmount(&entry.Fee),
+		formatAmount(&entry.Change),
+		formatAmount(entry.BalanceAfter),
+	}
+}
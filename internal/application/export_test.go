@@ -0,0 +1,309 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/application/dto"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/infra"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/repository"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type MockExportRepository struct {
+	mock.Mock
+}
+
+func (m *MockExportRepository) Create(ctx context.Context, export *entity.Export) error {
+	args := m.Called(ctx, export)
+	return args.Error(0)
+}
+
+func (m *MockExportRepository) GetByID(ctx context.Context, id string) (*entity.Export, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.Export), args.Error(1)
+}
+
+func (m *MockExportRepository) Update(ctx context.Context, export *entity.Export) error {
+	args := m.Called(ctx, export)
+	return args.Error(0)
+}
+
+func (m *MockExportRepository) ListExpired(ctx context.Context, before time.Time, limit int) ([]*entity.Export, error) {
+	args := m.Called(ctx, before, limit)
+	return args.Get(0).([]*entity.Export), args.Error(1)
+}
+
+func (m *MockExportRepository) Delete(ctx context.Context, id string) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+// memoryBlobStorage keeps blobs in memory
+type memoryBlobStorage struct {
+	mu    sync.Mutex
+	blobs map[string][]byte
+}
+
+func newMemoryBlobStorage() *memoryBlobStorage {
+	return &memoryBlobStorage{blobs: make(map[string][]byte)}
+}
+
+func (s *memoryBlobStorage) Put(ctx context.Context, key string, r io.Reader) (int64, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return 0, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.blobs[key] = data
+	return int64(len(data)), nil
+}
+
+func (s *memoryBlobStorage) Open(ctx context.Context, key string) (io.ReadCloser, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	data, ok := s.blobs[key]
+	if !ok {
+		return nil, infra.ErrBlobNotFound
+	}
+	return io.NopCloser(bytes.NewReader(data)), nil
+}
+
+func (s *memoryBlobStorage) Delete(ctx context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.blobs, key)
+	return nil
+}
+
+// callbackRecorder records the export callbacks posted
+type callbackRecorder struct {
+	urls     []string
+	secrets  []string
+	payloads []dto.ExportCallbackPayload
+}
+
+func (r *callbackRecorder) Send(ctx context.Context, url, secret string, body []byte) (*infra.WebhookDelivery, error) {
+	var payload dto.ExportCallbackPayload
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return nil, err
+	}
+	r.urls = append(r.urls, url)
+	r.secrets = append(r.secrets, secret)
+	r.payloads = append(r.payloads, payload)
+	return &infra.WebhookDelivery{StatusCode: 200}, nil
+}
+
+// downloadRequest parses a download link back into the request the download handler builds from it
+func downloadRequest(t *testing.T, link string) dto.DownloadRequest {
+	parsed, err := url.Parse(link)
+	require.NoError(t, err)
+	expires, err := strconv.ParseInt(parsed.Query().Get("expires"), 10, 64)
+	require.NoError(t, err)
+	return dto.DownloadRequest{
+		ExportID:  strings.TrimPrefix(parsed.Path, exportDownloadPath),
+		Expires:   expires,
+		Signature: parsed.Query().Get("signature"),
+	}
+}
+
+func TestDownloadLinks(t *testing.T) {
+	// Links expire by the business clock, here long before the wall clock
+	clock := &StubClock{At: time.Date(2024, 5, 17, 9, 0, 0, 0, time.UTC)}
+	valueDating := NewValueDatingPolicy(&StubCalendar{}, vo.ProcessingWindow{}, clock)
+	links := NewDownloadLinks(infra.StaticSecret("download-secret"), "https://bank.example", 15*time.Minute, valueDating)
+
+	link, expiresAt := links.URL("EXP1", clock.At.Add(time.Hour))
+	assert.True(t, strings.HasPrefix(link, "https://bank.example/downloads/exports/EXP1?"))
+	assert.Equal(t, clock.At.Add(15*time.Minute), expiresAt)
+
+	req := downloadRequest(t, strings.TrimPrefix(link, "https://bank.example"))
+	assert.NoError(t, links.Verify("EXP1", req.Expires, req.Signature))
+
+	// The signature covers the export and the expiry
+	assert.ErrorIs(t, links.Verify("EXP2", req.Expires, req.Signature), errs.ErrDownloadLinkInvalid)
+	assert.ErrorIs(t, links.Verify("EXP1", req.Expires+3600, req.Signature), errs.ErrDownloadLinkInvalid)
+	assert.ErrorIs(t, links.Verify("EXP1", req.Expires, "not-hex"), errs.ErrDownloadLinkInvalid)
+
+	// Links never outlive the export, and expired links are refused
+	expiredLink, expiresAt := links.URL("EXP1", clock.At.Add(-time.Minute))
+	assert.True(t, expiresAt.Before(clock.At))
+	expired := downloadRequest(t, expiredLink)
+	assert.ErrorIs(t, links.Verify("EXP1", expired.Expires, expired.Signature), errs.ErrDownloadLinkInvalid)
+
+	// The link is refused once the clock passes its expiry
+	clock.At = clock.At.Add(15 * time.Minute)
+	assert.ErrorIs(t, links.Verify("EXP1", req.Expires, req.Signature), errs.ErrDownloadLinkInvalid)
+}
+
+func TestExportUseCase_ExportAccountHistory(t *testing.T) {
+	account := createTestAccount()
+	payment, err := entity.NewDebitTransaction(account.ID, vo.NewMoneyFromFloat(50), "Coffee, large", "", time.Now())
+	payment = completedTransaction(t, payment, err)
+	entry := entity.NewHistoryEntries(payment)[0]
+	balance := vo.NewMoneyFromFloat(950)
+	entry.BalanceAfter = &balance
+
+	mockExportRepo := new(MockExportRepository)
+	mockAccountRepo := new(MockAccountRepository)
+	mockHistoryRepo := new(MockTransactionHistoryRepository)
+	mockOverrideRepo := new(MockCategoryOverrideRepository)
+	mockLogger := new(MockLogger)
+	mockLogger.On("Info", mock.Anything, mock.Anything).Maybe()
+
+	mockAccountRepo.On("GetByID", mock.Anything, account.ID).Return(account, nil)
+	mockHistoryRepo.On("ListByAccountID", mock.Anything, account.ID, repository.HistoryFilter{}, exportHistoryPageSize, 0).Return([]*entity.HistoryEntry{entry}, nil)
+	mockHistoryRepo.On("CountByAccountID", mock.Anything, account.ID, repository.HistoryFilter{}).Return(int64(1), nil)
+	mockOverrideRepo.On("ListByTransactionIDs", mock.Anything, account.ID, []vo.TransactionID{payment.ID}).Return([]*entity.CategoryOverride{}, nil)
+
+	var created, stored *entity.Export
+	mockExportRepo.On("Create", mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) { created = args.Get(1).(*entity.Export) }).
+		Return(nil)
+	mockExportRepo.On("Update", mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) { stored = args.Get(1).(*entity.Export) }).
+		Return(nil)
+	mockJobRepo := new(MockJobRepository)
+	var job *entity.Job
+	mockJobRepo.On("Enqueue", mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) { job = args.Get(1).(*entity.Job) }).
+		Return(true, nil)
+
+	storage := newMemoryBlobStorage()
+	mockTagRepo := new(MockTransactionTagRepository)
+	mockTagRepo.On("ListByTransactionIDs", mock.Anything, account.ID, []vo.TransactionID{payment.ID}).Return([]*entity.TransactionTag{}, nil)
+	historyUseCase := NewTransactionHistoryUseCase(mockHistoryRepo, mockAccountRepo, nil, mockTagRepo, nil, NewCategorizer(nil, mockOverrideRepo, mockLogger), mockLogger)
+	valueDating := NewValueDatingPolicy(&StubCalendar{}, vo.ProcessingWindow{}, nil)
+	links := NewDownloadLinks(infra.StaticSecret("download-secret"), "", 15*time.Minute, valueDating)
+	jobs := NewJobQueue(mockJobRepo, testJobQueueConfig, mockLogger)
+	callbacks := &callbackRecorder{}
+	uc := NewExportUseCase(mockExportRepo, mockAccountRepo, nil, historyUseCase, storage, links, 24*time.Hour, jobs, callbacks, valueDating, mockLogger)
+
+	_, err = uc.ExportAccountHistory(context.Background(), account.ID.String(), dto.HistoryExportRequest{CallbackURL: "mailto:ops@client.example"}, "127.0.0.1")
+	assert.IsType(t, errs.ValidationError{}, err)
+
+	req := dto.HistoryExportRequest{CallbackURL: "https://client.example/exports"}
+	response, err := uc.ExportAccountHistory(context.Background(), account.ID.String(), req, "127.0.0.1")
+	require.NoError(t, err)
+	assert.Equal(t, string(vo.ExportStatusRunning), response.Status)
+	assert.Empty(t, response.DownloadURL)
+	assert.Equal(t, req.CallbackURL, response.CallbackURL)
+	require.NotEmpty(t, response.CallbackSecret)
+
+	// The file is written by the queued job
+	require.NotNil(t, job)
+	assert.Equal(t, JobTypeExport, job.Type)
+	mockExportRepo.On("GetByID", mock.Anything, created.ID).Return(created, nil).Once()
+	require.NoError(t, runQueuedJob(t, jobs, job))
+	require.Equal(t, vo.ExportStatusCompleted, stored.Status)
+
+	// The callback URL is told, with a link, so the client need not poll
+	require.Len(t, callbacks.payloads, 1)
+	callback := callbacks.payloads[0]
+	assert.Equal(t, req.CallbackURL, callbacks.urls[0])
+	assert.Equal(t, response.CallbackSecret, callbacks.secrets[0])
+	assert.Equal(t, entity.ExportCallbackCompleted, callback.Event)
+	assert.Equal(t, stored.ID+"-export.completed", callback.ID)
+	assert.Equal(t, string(vo.ExportStatusCompleted), callback.Data.Status)
+	assert.NotEmpty(t, callback.Data.DownloadURL)
+	assert.Empty(t, callback.Data.CallbackSecret)
+	assert.NotNil(t, stored.CallbackDeliveredAt)
+
+	// A completed export comes with a link that downloads the CSV
+	mockExportRepo.On("GetByID", mock.Anything, stored.ID).Return(stored, nil)
+	completed, err := uc.GetExport(context.Background(), stored.ID)
+	require.NoError(t, err)
+	require.NotEmpty(t, completed.DownloadURL)
+
+	download, err := uc.OpenDownload(context.Background(), downloadRequest(t, completed.DownloadURL))
+	require.NoError(t, err)
+	defer download.Content.Close()
+	content, err := io.ReadAll(download.Content)
+	require.NoError(t, err)
+
+	lines := strings.Split(strings.TrimSpace(string(content)), "\n")
+	require.Len(t, lines, 2)
+	assert.Equal(t, strings.Join(historyExportHeader, ","), lines[0])
+	assert.Contains(t, lines[1], payment.ID.String())
+	assert.Contains(t, lines[1], `"Coffee, large"`)
+	assert.True(t, strings.HasSuffix(lines[1], ",50,0,-50,950"))
+	assert.Equal(t, "text/csv", download.ContentType)
+	assert.Equal(t, int64(len(content)), download.SizeBytes)
+}
+
+func TestExportUseCase_OpenDownload(t *testing.T) {
+	valueDating := NewValueDatingPolicy(&StubCalendar{}, vo.ProcessingWindow{}, nil)
+	links := NewDownloadLinks(infra.StaticSecret("download-secret"), "", 15*time.Minute, valueDating)
+
+	running := entity.NewExport(entity.ExportKindAudit, "audit.ndjson", "application/x-ndjson", "admin", time.Hour, time.Now())
+	expired := entity.NewExport(entity.ExportKindAudit, "audit.ndjson", "application/x-ndjson", "admin", time.Hour, time.Now())
+	require.NoError(t, expired.MarkAsCompleted("exports/audit.ndjson", 10))
+	expired.ExpiresAt = time.Now().Add(-time.Second)
+
+	mockExportRepo := new(MockExportRepository)
+	mockExportRepo.On("GetByID", mock.Anything, running.ID).Return(running, nil)
+	mockExportRepo.On("GetByID", mock.Anything, expired.ID).Return(expired, nil)
+	mockLogger := new(MockLogger)
+	mockLogger.On("Warn", mock.Anything, mock.Anything).Maybe()
+
+	uc := NewExportUseCase(mockExportRepo, nil, nil, nil, newMemoryBlobStorage(), links, time.Hour, NewJobQueue(nil, testJobQueueConfig, mockLogger), nil, valueDating, mockLogger)
+	ctx := context.Background()
+
+	link, _ := links.URL(running.ID, time.Now().Add(time.Hour))
+	_, err := uc.OpenDownload(ctx, downloadRequest(t, link))
+	assert.ErrorIs(t, err, errs.ErrExportNotReady)
+
+	link, _ = links.URL(expired.ID, time.Now().Add(time.Hour))
+	_, err = uc.OpenDownload(ctx, downloadRequest(t, link))
+	assert.ErrorIs(t, err, errs.ErrExportExpired)
+
+	// A tampered link is refused before the export is looked up
+	tampered := downloadRequest(t, link)
+	tampered.ExportID = running.ID
+	_, err = uc.OpenDownload(ctx, tampered)
+	assert.ErrorIs(t, err, errs.ErrDownloadLinkInvalid)
+}
+
+func TestExportUseCase_PurgeExpired(t *testing.T) {
+	expired := entity.NewExport(entity.ExportKindAudit, "audit.ndjson", "application/x-ndjson", "admin", -time.Minute, time.Now())
+	require.NoError(t, expired.MarkAsCompleted("exports/audit.ndjson", 2))
+	failed := entity.NewExport(entity.ExportKindAudit, "audit.ndjson", "application/x-ndjson", "admin", -time.Minute, time.Now())
+	require.NoError(t, failed.MarkAsFailed("storage unavailable"))
+
+	storage := newMemoryBlobStorage()
+	_, err := storage.Put(context.Background(), expired.ObjectKey, strings.NewReader("{}"))
+	require.NoError(t, err)
+
+	mockExportRepo := new(MockExportRepository)
+	mockExportRepo.On("ListExpired", mock.Anything, mock.Anything, expiredExportBatchSize).Return([]*entity.Export{expired, failed}, nil)
+	mockExportRepo.On("Delete", mock.Anything, expired.ID).Return(nil)
+	mockExportRepo.On("Delete", mock.Anything, failed.ID).Return(nil)
+	mockLogger := new(MockLogger)
+	mockLogger.On("Info", mock.Anything, mock.Anything).Maybe()
+
+	valueDating := NewValueDatingPolicy(&StubCalendar{}, vo.ProcessingWindow{}, nil)
+	uc := NewExportUseCase(mockExportRepo, nil, nil, nil, storage, nil, time.Hour, NewJobQueue(nil, testJobQueueConfig, mockLogger), nil, valueDating, mockLogger)
+	purged, err := uc.PurgeExpired(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), purged)
+	assert.Empty(t, storage.blobs)
+	mockExportRepo.AssertExpectations(t)
+}
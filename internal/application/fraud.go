@@ -0,0 +1,76 @@
+// internal/application/fraud.go
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/infra"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+)
+
+// FraudRule inspects a transaction about to be confirmed. It returns a reason when the
+// transaction should be held for review and an empty string when it may proceed.
+type FraudRule interface {
+	Name() string
+	Evaluate(ctx context.Context, transaction *entity.Transaction) (string, error)
+}
+
+// FraudEngine runs the fraud rules on confirmations. A rule that errors holds the
+// transaction for review rather than letting it through unchecked.
+type FraudEngine struct {
+	rules  []FraudRule
+	logger infra.Logger
+}
+
+// NewFraudEngine creates a fraud engine evaluating the given rules in order
+func NewFraudEngine(logger infra.Logger, rules ...FraudRule) *FraudEngine {
+	return &FraudEngine{
+		rules:  rules,
+		logger: logger,
+	}
+}
+
+// Screen evaluates every rule and reports whether the transaction must be reviewed and why
+func (e *FraudEngine) Screen(ctx context.Context, transaction *entity.Transaction) (string, bool) {
+	if e == nil {
+		return "", false
+	}
+
+	var reasons []string
+	for _, rule := range e.rules {
+		reason, err := rule.Evaluate(ctx, transaction)
+		if err != nil {
+			e.logger.Warn("Fraud rule failed, holding transaction for review",
+				"error", err,
+				"rule", rule.Name(),
+				"transactionID", transaction.ID.String())
+			reason = fmt.Sprintf("%s: rule could not be evaluated", rule.Name())
+		}
+		if reason != "" {
+			reasons = append(reasons, reason)
+		}
+	}
+
+	return strings.Join(reasons, "; "), len(reasons) > 0
+}
+
+// LargeAmountRule holds transactions at or above a threshold amount
+type LargeAmountRule struct {
+	Threshold vo.Money
+}
+
+// Name identifies the rule in logs
+func (r LargeAmountRule) Name() string {
+	return "large_amount"
+}
+
+// Evaluate holds the transaction when its amount reaches the threshold
+func (r LargeAmountRule) Evaluate(ctx context.Context, transaction *entity.Transaction) (string, error) {
+	if transaction.Amount.LessThan(r.Threshold) {
+		return "", nil
+	}
+	return fmt.Sprintf("amount %s reaches the review threshold of %s", transaction.Amount.StringFixed(2), r.Threshold.StringFixed(2)), nil
+}
@@ -0,0 +1,369 @@
+// internal/application/general_ledger.go
+package usecase
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/hydr0g3nz/mini_bank/internal/application/dto"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/event"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/infra"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/repository"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+)
+
+// maxRevenueReportPeriods bounds the number of periods a revenue report covers
+const maxRevenueReportPeriods = 36
+
+// revenueReportHeader is the header row of a revenue report CSV export
+var revenueReportHeader = []string{"period", "product_id", "product_name", "fees_collected", "interest_paid"}
+
+// GeneralLedgerPoster books every completed transaction into the general ledger as a balanced journal
+// entry. It wraps the event publisher so each completion is posted once, on the instance that
+// completed the transaction.
+type GeneralLedgerPoster struct {
+	ledgerRepo      repository.GeneralLedgerRepository
+	transactionRepo repository.TransactionRepository
+	accountRepo     repository.AccountRepository
+	next            infra.EventPublisher
+	logger          infra.Logger
+}
+
+// NewGeneralLedgerPoster creates a general ledger poster publishing through next
+func NewGeneralLedgerPoster(
+	ledgerRepo repository.GeneralLedgerRepository,
+	transactionRepo repository.TransactionRepository,
+	accountRepo repository.AccountRepository,
+	next infra.EventPublisher,
+	logger infra.Logger,
+) *GeneralLedgerPoster {
+	return &GeneralLedgerPoster{
+		ledgerRepo:      ledgerRepo,
+		transactionRepo: transactionRepo,
+		accountRepo:     accountRepo,
+		next:            next,
+		logger:          logger,
+	}
+}
+
+// Publish forwards the event, then posts the transaction it completed
+func (p *GeneralLedgerPoster) Publish(ctx context.Context, evt event.Event) error {
+	err := p.next.Publish(ctx, evt)
+
+	if evt.Type == event.TransactionCompleted {
+		p.post(ctx, evt)
+	}
+
+	return err
+}
+
+// post records the journal entry of the completed transaction. A failure is only logged: the
+// transaction itself succeeded, and the trial balance shows the gap.
+func (p *GeneralLedgerPoster) post(ctx context.Context, evt event.Event) {
+	payload, err := evt.DecodeTransaction()
+	if err != nil {
+		p.logger.Warn("Failed to decode transaction event", "error", err, "eventID", evt.ID)
+		return
+	}
+	transactionID, err := vo.NewTransactionIDFromString(payload.TransactionID)
+	if err != nil {
+		return
+	}
+
+	transaction, err := p.transactionRepo.GetByID(ctx, transactionID)
+	if err != nil {
+		p.logger.Warn("Failed to load transaction for the general ledger", "error", err, "transactionID", payload.TransactionID)
+		return
+	}
+
+	postings, err := entity.NewGLPostings(transaction)
+	if err != nil {
+		p.logger.Error("Failed to book transaction in the general ledger", "error", err, "transactionID", payload.TransactionID)
+		return
+	}
+	p.assignProducts(ctx, postings)
+
+	if _, err := p.ledgerRepo.Record(ctx, postings); err != nil {
+		p.logger.Error("Failed to record general ledger postings", "error", err, "transactionID", payload.TransactionID)
+	}
+}
+
+// assignProducts records on each line the product of the customer account it is booked for, so
+// revenue and expense can be reported per product. Lines of accounts that cannot be loaded keep none.
+func (p *GeneralLedgerPoster) assignProducts(ctx context.Context, postings []*entity.GLPosting) {
+	products := make(map[string]string)
+	for _, posting := range postings {
+		if posting.CustomerAccountID == nil {
+			continue
+		}
+
+		accountID := posting.CustomerAccountID.String()
+		productID, ok := products[accountID]
+		if !ok {
+			account, err := p.accountRepo.GetByID(ctx, *posting.CustomerAccountID)
+			if err != nil {
+				p.logger.Warn("Failed to load account for the general ledger", "error", err, "accountID", accountID)
+			} else {
+				productID = account.ProductID
+			}
+			products[accountID] = productID
+		}
+		posting.ProductID = productID
+	}
+}
+
+var _ infra.EventPublisher = (*GeneralLedgerPoster)(nil)
+
+type generalLedgerUseCase struct {
+	ledgerRepo      repository.GeneralLedgerRepository
+	transactionRepo repository.TransactionRepository
+	productRepo     repository.ProductRepository
+	valueDating     *ValueDatingPolicy
+	logger          infra.Logger
+	mapper          *dto.GeneralLedgerMapper
+}
+
+// NewGeneralLedgerUseCase creates a new general ledger use case; the current period is the one of the
+// value dating policy's business date
+func NewGeneralLedgerUseCase(
+	ledgerRepo repository.GeneralLedgerRepository,
+	transactionRepo repository.TransactionRepository,
+	productRepo repository.ProductRepository,
+	valueDating *ValueDatingPolicy,
+	logger infra.Logger,
+) GeneralLedgerUseCase {
+	return &generalLedgerUseCase{
+		ledgerRepo:      ledgerRepo,
+		transactionRepo: transactionRepo,
+		productRepo:     productRepo,
+		valueDating:     valueDating,
+		logger:          logger,
+		mapper:          &dto.GeneralLedgerMapper{},
+	}
+}
+
+// ListAccounts retrieves the chart of accounts
+func (uc *generalLedgerUseCase) ListAccounts(ctx context.Context) (*dto.GLAccountListResponse, error) {
+	response := &dto.GLAccountListResponse{Accounts: make([]dto.GLAccountResponse, len(entity.ChartOfAccounts))}
+	for i, account := range entity.ChartOfAccounts {
+		response.Accounts[i] = uc.mapper.ToAccountResponse(account)
+	}
+	return response, nil
+}
+
+// GetTransactionPostings retrieves the journal entry a transaction was booked as; it has none until
+// the transaction completes
+func (uc *generalLedgerUseCase) GetTransactionPostings(ctx context.Context, transactionID string) (*dto.TransactionPostingsResponse, error) {
+	parsedID, err := vo.NewTransactionIDFromString(transactionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := uc.transactionRepo.GetByID(ctx, parsedID); err != nil {
+		return nil, errs.ErrTransactionNotFound
+	}
+
+	postings, err := uc.ledgerRepo.ListByTransactionID(ctx, parsedID)
+	if err != nil {
+		uc.logger.Error("Failed to list general ledger postings", "error", err, "transactionID", transactionID)
+		return nil, err
+	}
+
+	response := &dto.TransactionPostingsResponse{
+		TransactionID: transactionID,
+		Postings:      make([]dto.GLPostingResponse, len(postings)),
+	}
+	for i, posting := range postings {
+		response.Postings[i] = uc.mapper.ToPostingResponse(posting)
+	}
+	return response, nil
+}
+
+// GetTrialBalance lists every account of the chart with its debits and credits in the period, proving
+// the ledger balances when total debits equal total credits
+func (uc *generalLedgerUseCase) GetTrialBalance(ctx context.Context, period string) (*dto.TrialBalanceResponse, error) {
+	period, err := uc.period(period)
+	if err != nil {
+		return nil, err
+	}
+
+	balances, err := uc.ledgerRepo.SumByPeriod(ctx, period)
+	if err != nil {
+		uc.logger.Error("Failed to total general ledger postings", "error", err, "period", period)
+		return nil, err
+	}
+	byAccount := make(map[string]*entity.GLBalance, len(balances))
+	for _, balance := range balances {
+		byAccount[balance.Account] = balance
+	}
+
+	response := &dto.TrialBalanceResponse{
+		Period: period,
+		Lines:  make([]dto.TrialBalanceLine, len(entity.ChartOfAccounts)),
+	}
+	totalDebit, totalCredit := vo.ZeroMoney(), vo.ZeroMoney()
+	for i, account := range entity.ChartOfAccounts {
+		debit, credit := vo.ZeroMoney(), vo.ZeroMoney()
+		if balance, ok := byAccount[account.Code]; ok {
+			debit, credit = balance.Debit, balance.Credit
+		}
+		response.Lines[i] = uc.mapper.ToTrialBalanceLine(account, debit, credit)
+		totalDebit, _ = totalDebit.Add(debit)
+		totalCredit, _ = totalCredit.Add(credit)
+	}
+	response.TotalDebit = totalDebit.InexactFloat64()
+	response.TotalCredit = totalCredit.InexactFloat64()
+	response.Balanced = totalDebit.Equal(totalCredit)
+
+	if !response.Balanced {
+		uc.logger.Warn("Trial balance does not balance", "period", period, "debit", totalDebit.String(), "credit", totalCredit.String())
+	}
+	return response, nil
+}
+
+// GetRevenueReport summarizes, per period and product, the fees collected net of refunds and the
+// interest paid, from the postings to fee income and interest expense
+func (uc *generalLedgerUseCase) GetRevenueReport(ctx context.Context, req dto.RevenueReportRequest) (*dto.RevenueReportResponse, error) {
+	from, to, err := reportPeriods(req.From, req.To, maxRevenueReportPeriods, uc.valueDating)
+	if err != nil {
+		return nil, err
+	}
+
+	totals, err := uc.ledgerRepo.SumByProduct(ctx, []string{entity.GLCodeFeeIncome, entity.GLCodeInterestExpense}, from, to)
+	if err != nil {
+		uc.logger.Error("Failed to total general ledger postings by product", "error", err, "from", from, "to", to)
+		return nil, err
+	}
+
+	response := &dto.RevenueReportResponse{From: from, To: to, Rows: []dto.RevenueReportRow{}}
+	productNames := make(map[string]string)
+	fees, interest := vo.ZeroMoney(), vo.ZeroMoney()
+	var rowFees, rowInterest vo.Money
+	for _, total := range totals {
+		last := len(response.Rows) - 1
+		if last < 0 || response.Rows[last].Period != total.Period || response.Rows[last].ProductID != total.ProductID {
+			response.Rows = append(response.Rows, dto.RevenueReportRow{
+				Period:      total.Period,
+				ProductID:   total.ProductID,
+				ProductName: uc.productName(ctx, total.ProductID, productNames),
+			})
+			last++
+			rowFees, rowInterest = vo.ZeroMoney(), vo.ZeroMoney()
+		}
+
+		// Fee income grows with credits, interest expense with debits
+		switch total.Account {
+		case entity.GLCodeFeeIncome:
+			net, _ := total.Credit.Subtract(total.Debit)
+			rowFees, _ = rowFees.Add(net)
+			fees, _ = fees.Add(net)
+		case entity.GLCodeInterestExpense:
+			net, _ := total.Debit.Subtract(total.Credit)
+			rowInterest, _ = rowInterest.Add(net)
+			interest, _ = interest.Add(net)
+		}
+		response.Rows[last].FeesCollected = rowFees.InexactFloat64()
+		response.Rows[last].InterestPaid = rowInterest.InexactFloat64()
+	}
+	response.TotalFeesCollected = fees.InexactFloat64()
+	response.TotalInterestPaid = interest.InexactFloat64()
+
+	return response, nil
+}
+
+// ExportRevenueReport writes the revenue report as CSV, one row per period and product
+func (uc *generalLedgerUseCase) ExportRevenueReport(ctx context.Context, req dto.RevenueReportRequest, w io.Writer) error {
+	report, err := uc.GetRevenueReport(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	formatAmount := func(amount float64) string {
+		return strconv.FormatFloat(amount, 'f', 2, 64)
+	}
+
+	writer := csv.NewWriter(w)
+	if err := writer.Write(revenueReportHeader); err != nil {
+		return err
+	}
+	for _, row := range report.Rows {
+		record := []string{row.Period, row.ProductID, row.ProductName, formatAmount(row.FeesCollected), formatAmount(row.InterestPaid)}
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+// period parses a YYYY-MM period, the current one when empty
+func (uc *generalLedgerUseCase) period(period string) (string, error) {
+	return reportPeriod(period, uc.valueDating)
+}
+
+// reportPeriods parses the YYYY-MM range a report covers: from defaults to the current period and to to
+// from, and the range may span at most maxPeriods periods
+func reportPeriods(from, to string, maxPeriods int, valueDating *ValueDatingPolicy) (string, string, error) {
+	from, err := reportPeriod(from, valueDating)
+	if err != nil {
+		return "", "", err
+	}
+	if to == "" {
+		to = from
+	} else if to, err = reportPeriod(to, valueDating); err != nil {
+		return "", "", err
+	}
+
+	if to < from {
+		return "", "", errs.ValidationError{Field: "to", Message: "to must not be before from"}
+	}
+	if periodsBetween(from, to) > maxPeriods {
+		return "", "", errs.ValidationError{Field: "to", Message: fmt.Sprintf("the range must not exceed %d periods", maxPeriods)}
+	}
+	return from, to, nil
+}
+
+// reportPeriod parses a YYYY-MM period, the current one when empty
+func reportPeriod(period string, valueDating *ValueDatingPolicy) (string, error) {
+	if period == "" {
+		return entity.AccountingPeriodOf(valueDating.Today()), nil
+	}
+
+	start, err := entity.ParseAccountingPeriod(period)
+	if err != nil {
+		return "", err
+	}
+	return entity.AccountingPeriodOf(start), nil
+}
+
+// productName returns the name of a product, remembering names already looked up; unknown products
+// have none
+func (uc *generalLedgerUseCase) productName(ctx context.Context, productID string, names map[string]string) string {
+	if productID == "" {
+		return ""
+	}
+	if name, ok := names[productID]; ok {
+		return name
+	}
+
+	product, err := uc.productRepo.GetByID(ctx, productID)
+	if err != nil {
+		uc.logger.Warn("Failed to load product for the revenue report", "error", err, "productID", productID)
+	} else {
+		names[productID] = product.Name
+	}
+	return names[productID]
+}
+
+// periodsBetween counts the periods from from to to inclusive
+func periodsBetween(from, to string) int {
+	start, _ := entity.ParseAccountingPeriod(from)
+	end, _ := entity.ParseAccountingPeriod(to)
+	return (end.Year()-start.Year())*12 + int(end.Month()-start.Month()) + 1
+}
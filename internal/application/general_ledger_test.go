@@ -0,0 +1,192 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/application/dto"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/event"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type MockGeneralLedgerRepository struct {
+	mock.Mock
+}
+
+func (m *MockGeneralLedgerRepository) Record(ctx context.Context, postings []*entity.GLPosting) (bool, error) {
+	args := m.Called(ctx, postings)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockGeneralLedgerRepository) ListByTransactionID(ctx context.Context, transactionID vo.TransactionID) ([]*entity.GLPosting, error) {
+	args := m.Called(ctx, transactionID)
+	return args.Get(0).([]*entity.GLPosting), args.Error(1)
+}
+
+func (m *MockGeneralLedgerRepository) SumByPeriod(ctx context.Context, period string) ([]*entity.GLBalance, error) {
+	args := m.Called(ctx, period)
+	return args.Get(0).([]*entity.GLBalance), args.Error(1)
+}
+
+func (m *MockGeneralLedgerRepository) SumByProduct(ctx context.Context, accounts []string, from, to string) ([]*entity.GLProductTotal, error) {
+	args := m.Called(ctx, accounts, from, to)
+	return args.Get(0).([]*entity.GLProductTotal), args.Error(1)
+}
+
+func TestGeneralLedgerPoster_Publish(t *testing.T) {
+	account := createTestAccount()
+	account.ProductID = "PRD1"
+	withdrawal, err := entity.NewDebitTransaction(account.ID, vo.NewMoneyFromFloat(100), "ATM", "", time.Now())
+	require.NoError(t, err)
+	withdrawal.Fee = vo.NewMoneyFromFloat(5)
+	withdrawal = completedTransaction(t, withdrawal, nil)
+
+	mockLedgerRepo := new(MockGeneralLedgerRepository)
+	mockTxnRepo := new(MockTransactionRepository)
+	mockAccountRepo := new(MockAccountRepository)
+	mockTxnRepo.On("GetByID", mock.Anything, withdrawal.ID).Return(withdrawal, nil)
+	mockAccountRepo.On("GetByID", mock.Anything, account.ID).Return(account, nil).Once()
+	mockLedgerRepo.On("Record", mock.Anything, mock.MatchedBy(func(postings []*entity.GLPosting) bool {
+		if len(postings) != 3 || postings[0].TransactionID != withdrawal.ID {
+			return false
+		}
+		// Every line is booked for the account charged, under its product
+		for _, posting := range postings {
+			if posting.ProductID != "PRD1" {
+				return false
+			}
+		}
+		return true
+	})).Return(true, nil)
+
+	next := &StubEventPublisher{}
+	poster := NewGeneralLedgerPoster(mockLedgerRepo, mockTxnRepo, mockAccountRepo, next, new(MockLogger))
+
+	require.NoError(t, poster.Publish(context.Background(), event.NewTransactionEvent(event.TransactionCompleted, withdrawal)))
+	assert.Len(t, next.Events, 1)
+	mockLedgerRepo.AssertNumberOfCalls(t, "Record", 1)
+
+	// Only completions are posted
+	require.NoError(t, poster.Publish(context.Background(), event.NewTransactionEvent(event.TransactionCreated, withdrawal)))
+	mockLedgerRepo.AssertNumberOfCalls(t, "Record", 1)
+}
+
+func TestGeneralLedgerUseCase_GetTrialBalance(t *testing.T) {
+	ctx := context.Background()
+	mockLedgerRepo := new(MockGeneralLedgerRepository)
+	valueDating := NewValueDatingPolicy(&StubCalendar{}, vo.ProcessingWindow{}, nil)
+	valueDating.now = func() time.Time { return time.Date(2024, 3, 15, 10, 0, 0, 0, time.UTC) }
+	logger := new(MockLogger)
+	logger.On("Warn", mock.Anything, mock.Anything).Maybe()
+	uc := NewGeneralLedgerUseCase(mockLedgerRepo, nil, nil, valueDating, logger)
+
+	mockLedgerRepo.On("SumByPeriod", ctx, "2024-03").Return([]*entity.GLBalance{
+		{Account: entity.GLCodeCash, Debit: vo.NewMoneyFromFloat(200), Credit: vo.NewMoneyFromFloat(50)},
+		{Account: entity.GLCodeCustomerDeposits, Debit: vo.NewMoneyFromFloat(55), Credit: vo.NewMoneyFromFloat(200)},
+		{Account: entity.GLCodeFeeIncome, Debit: vo.ZeroMoney(), Credit: vo.NewMoneyFromFloat(5)},
+	}, nil)
+
+	// The current period by default
+	result, err := uc.GetTrialBalance(ctx, "")
+
+	require.NoError(t, err)
+	assert.Equal(t, "2024-03", result.Period)
+	require.Len(t, result.Lines, len(entity.ChartOfAccounts))
+	assert.Equal(t, entity.GLCodeCash, result.Lines[0].Code)
+	assert.Equal(t, 150.0, result.Lines[0].Balance)
+	assert.Equal(t, entity.GLCodeFXPosition, result.Lines[1].Code)
+	assert.Zero(t, result.Lines[1].Balance)
+	assert.Equal(t, 145.0, result.Lines[2].Balance)
+	assert.Equal(t, 5.0, result.Lines[3].Balance)
+	assert.Zero(t, result.Lines[4].Debit)
+	assert.Equal(t, 255.0, result.TotalDebit)
+	assert.Equal(t, 255.0, result.TotalCredit)
+	assert.True(t, result.Balanced)
+
+	mockLedgerRepo.On("SumByPeriod", ctx, "2024-02").Return([]*entity.GLBalance{
+		{Account: entity.GLCodeCash, Debit: vo.NewMoneyFromFloat(10), Credit: vo.ZeroMoney()},
+	}, nil)
+	result, err = uc.GetTrialBalance(ctx, "2024-02")
+	require.NoError(t, err)
+	assert.False(t, result.Balanced)
+
+	_, err = uc.GetTrialBalance(ctx, "February")
+	assert.IsType(t, errs.ValidationError{}, err)
+}
+
+func TestGeneralLedgerUseCase_GetTransactionPostings(t *testing.T) {
+	ctx := context.Background()
+	deposit, err := entity.NewCreditTransaction(vo.NewAccountID(), vo.NewMoneyFromFloat(200), "Salary", "", time.Now())
+	deposit = completedTransaction(t, deposit, err)
+	postings, err := entity.NewGLPostings(deposit)
+	require.NoError(t, err)
+
+	mockLedgerRepo := new(MockGeneralLedgerRepository)
+	mockTxnRepo := new(MockTransactionRepository)
+	mockTxnRepo.On("GetByID", ctx, deposit.ID).Return(deposit, nil)
+	mockLedgerRepo.On("ListByTransactionID", ctx, deposit.ID).Return(postings, nil)
+	uc := NewGeneralLedgerUseCase(mockLedgerRepo, mockTxnRepo, nil, nil, new(MockLogger))
+
+	result, err := uc.GetTransactionPostings(ctx, deposit.ID.String())
+
+	require.NoError(t, err)
+	require.Len(t, result.Postings, 2)
+	assert.Equal(t, "Customer deposits", result.Postings[0].AccountName)
+	assert.Equal(t, "CREDIT", result.Postings[0].Side)
+	assert.Equal(t, "Cash and settlement", result.Postings[1].AccountName)
+	assert.Equal(t, 200.0, result.Postings[1].Amount)
+
+	missing := vo.NewTransactionID()
+	mockTxnRepo.On("GetByID", ctx, missing).Return(nil, errs.ErrTransactionNotFound)
+	_, err = uc.GetTransactionPostings(ctx, missing.String())
+	assert.ErrorIs(t, err, errs.ErrTransactionNotFound)
+}
+
+func TestGeneralLedgerUseCase_GetRevenueReport(t *testing.T) {
+	ctx := context.Background()
+	mockLedgerRepo := new(MockGeneralLedgerRepository)
+	mockProductRepo := new(MockProductRepository)
+	valueDating := NewValueDatingPolicy(&StubCalendar{}, vo.ProcessingWindow{}, nil)
+	valueDating.now = func() time.Time { return time.Date(2024, 3, 15, 10, 0, 0, 0, time.UTC) }
+	uc := NewGeneralLedgerUseCase(mockLedgerRepo, nil, mockProductRepo, valueDating, new(MockLogger))
+
+	accounts := []string{entity.GLCodeFeeIncome, entity.GLCodeInterestExpense}
+	mockProductRepo.On("GetByID", ctx, "PRD1").Return(&entity.Product{ID: "PRD1", Name: "Everyday"}, nil)
+	mockLedgerRepo.On("SumByProduct", ctx, accounts, "2024-02", "2024-03").Return([]*entity.GLProductTotal{
+		{Period: "2024-02", ProductID: "", Account: entity.GLCodeFeeIncome, Debit: vo.ZeroMoney(), Credit: vo.NewMoneyFromFloat(4)},
+		{Period: "2024-02", ProductID: "PRD1", Account: entity.GLCodeFeeIncome, Debit: vo.NewMoneyFromFloat(5), Credit: vo.NewMoneyFromFloat(30)},
+		{Period: "2024-02", ProductID: "PRD1", Account: entity.GLCodeInterestExpense, Debit: vo.NewMoneyFromFloat(12.5), Credit: vo.ZeroMoney()},
+		{Period: "2024-03", ProductID: "PRD1", Account: entity.GLCodeFeeIncome, Debit: vo.ZeroMoney(), Credit: vo.NewMoneyFromFloat(10)},
+	}, nil)
+
+	result, err := uc.GetRevenueReport(ctx, dto.RevenueReportRequest{From: "2024-02", To: "2024-03"})
+
+	require.NoError(t, err)
+	assert.Equal(t, []dto.RevenueReportRow{
+		{Period: "2024-02", ProductID: "", ProductName: "", FeesCollected: 4},
+		{Period: "2024-02", ProductID: "PRD1", ProductName: "Everyday", FeesCollected: 25, InterestPaid: 12.5},
+		{Period: "2024-03", ProductID: "PRD1", ProductName: "Everyday", FeesCollected: 10},
+	}, result.Rows)
+	assert.Equal(t, 39.0, result.TotalFeesCollected)
+	assert.Equal(t, 12.5, result.TotalInterestPaid)
+
+	var csv bytes.Buffer
+	require.NoError(t, uc.ExportRevenueReport(ctx, dto.RevenueReportRequest{From: "2024-02", To: "2024-03"}, &csv))
+	assert.Equal(t, "period,product_id,product_name,fees_collected,interest_paid\n"+
+		"2024-02,,,4.00,0.00\n"+
+		"2024-02,PRD1,Everyday,25.00,12.50\n"+
+		"2024-03,PRD1,Everyday,10.00,0.00\n", csv.String())
+
+	_, err = uc.GetRevenueReport(ctx, dto.RevenueReportRequest{From: "2024-03", To: "2024-02"})
+	assert.IsType(t, errs.ValidationError{}, err)
+
+	_, err = uc.GetRevenueReport(ctx, dto.RevenueReportRequest{From: "2020-01", To: "2024-02"})
+	assert.IsType(t, errs.ValidationError{}, err)
+}
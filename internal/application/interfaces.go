@@ -3,6 +3,7 @@ package usecase
 
 import (
 	"context"
+	"io"
 
 	"github.com/hydr0g3nz/mini_bank/internal/application/dto"
 )
@@ -10,25 +11,46 @@ import (
 // AccountUseCase defines the interface for account business logic
 type AccountUseCase interface {
 	// CreateAccount creates a new account
-	CreateAccount(ctx context.Context, req dto.CreateAccountRequest) (*dto.AccountResponse, error)
+	CreateAccount(ctx context.Context, req dto.AccountRequest) (*dto.AccountResponse, error)
 
 	// GetAccount retrieves an account by ID
 	GetAccount(ctx context.Context, id string) (*dto.AccountResponse, error)
 
+	// GetAccountWithNameHistory retrieves an account with its previous names, for admins
+	GetAccountWithNameHistory(ctx context.Context, id string) (*dto.AccountResponse, error)
+
+	// GetAccounts retrieves several accounts by ID, skipping unknown ones
+	GetAccounts(ctx context.Context, ids []string) ([]dto.AccountResponse, error)
+
+	// BatchGetAccounts retrieves up to 100 accounts by ID, telling which IDs have no account
+	BatchGetAccounts(ctx context.Context, req dto.BatchGetRequest) (*dto.AccountBatchResponse, error)
+
 	// UpdateAccount updates an existing account
-	UpdateAccount(ctx context.Context, req dto.UpdateAccountRequest) (*dto.AccountResponse, error)
+	UpdateAccount(ctx context.Context, req dto.AccountRequest) (*dto.AccountResponse, error)
 
 	// DeleteAccount deletes an account
 	DeleteAccount(ctx context.Context, id string) error
 
-	// ListAccounts retrieves accounts with pagination
-	ListAccounts(ctx context.Context, req dto.ListRequest) (*dto.AccountListResponse, error)
+	// ListAccounts retrieves the accounts matching the request's filters with pagination
+	ListAccounts(ctx context.Context, req dto.AccountListRequest) (*dto.AccountListResponse, error)
 
 	// SuspendAccount suspends an account
 	SuspendAccount(ctx context.Context, id string) error
 
 	// ActivateAccount activates an account
 	ActivateAccount(ctx context.Context, id string) error
+
+	// AddChildAccount attaches an account to a corporate parent account
+	AddChildAccount(ctx context.Context, req dto.AddChildAccountRequest) (*dto.AccountResponse, error)
+
+	// RemoveChildAccount detaches a child account from its parent
+	RemoveChildAccount(ctx context.Context, parentID, childID string) error
+
+	// SetSpendingLimit sets or removes the monthly spending limit of a child account
+	SetSpendingLimit(ctx context.Context, req dto.SpendingLimitRequest) (*dto.AccountResponse, error)
+
+	// GetAccountGroup retrieves a parent account with its children and roll-up balances
+	GetAccountGroup(ctx context.Context, parentID string) (*dto.AccountGroupResponse, error)
 }
 
 // TransactionUseCase defines the interface for transaction business logic
@@ -36,18 +58,635 @@ type TransactionUseCase interface {
 	// CreateTransaction creates a new transaction
 	CreateTransaction(ctx context.Context, req dto.CreateTransactionRequest) (*dto.TransactionResponse, error)
 	ConfirmTransaction(ctx context.Context, req dto.ConfirmTransactionRequest) (*dto.TransactionResponse, error)
+
+	// SimulateTransfer dry-runs a transfer and reports its projected fee, balances and any errors
+	SimulateTransfer(ctx context.Context, req dto.SimulateTransferRequest) (*dto.TransferSimulationResponse, error)
+
+	// GroupTransfer creates and confirms a transfer between accounts of one parent's group
+	GroupTransfer(ctx context.Context, req dto.GroupTransferRequest) (*dto.TransactionResponse, error)
+
 	// GetTransaction retrieves a transaction by ID
 	GetTransaction(ctx context.Context, id string) (*dto.TransactionResponse, error)
 
+	// BatchGetTransactions retrieves up to 100 transactions by ID, telling which IDs have no transaction
+	BatchGetTransactions(ctx context.Context, req dto.BatchGetRequest) (*dto.TransactionBatchResponse, error)
+
 	// ListTransactions retrieves transactions with pagination
 	ListTransactions(ctx context.Context, req dto.ListRequest) (*dto.TransactionListResponse, error)
 
 	// GetTransactionsByAccount retrieves transactions for a specific account
 	GetTransactionsByAccount(ctx context.Context, accountID string, req dto.ListRequest) (*dto.TransactionListResponse, error)
 
-	// CancelTransaction cancels a transaction
-	CancelTransaction(ctx context.Context, req dto.CancelTransactionRequest) error
+	// SyncAccountTransactions retrieves the transactions of an account written after a change number, for client caches
+	SyncAccountTransactions(ctx context.Context, req dto.TransactionSyncRequest) (*dto.TransactionSyncResponse, error)
+
+	// GetAccountActivity retrieves an account's completed transactions grouped by day with running balances
+	GetAccountActivity(ctx context.Context, req dto.AccountActivityRequest) (*dto.AccountActivityResponse, error)
+
+	// GetAccountProjection projects an account's interest earnings and fees over the next period
+	GetAccountProjection(ctx context.Context, req dto.AccountProjectionRequest) (*dto.AccountProjectionResponse, error)
+
+	// CancelTransaction cancels a transaction, reversing it if it settled while being cancelled
+	CancelTransaction(ctx context.Context, req dto.CancelTransactionRequest) (*dto.CancelTransactionResponse, error)
+
+	// ReverseTransaction moves the money of a completed transaction back with a REVERSAL and refunds its fee
+	ReverseTransaction(ctx context.Context, req dto.ReverseTransactionRequest) (*dto.ReverseTransactionResponse, error)
 
 	// GetTransactionsByStatus retrieves transactions by status
 	GetTransactionsByStatus(ctx context.Context, status string, req dto.ListRequest) (*dto.TransactionListResponse, error)
+
+	// GetTransactionsByChannel retrieves transactions initiated through a channel
+	GetTransactionsByChannel(ctx context.Context, channel string, req dto.ListRequest) (*dto.TransactionListResponse, error)
+
+	// ListReviews retrieves the transactions held for review
+	ListReviews(ctx context.Context, req dto.ListRequest) (*dto.TransactionListResponse, error)
+
+	// ApproveTransaction releases a transaction held for review and processes it
+	ApproveTransaction(ctx context.Context, req dto.ReviewDecisionRequest) (*dto.TransactionResponse, error)
+
+	// DeclineTransaction fails a transaction held for review
+	DeclineTransaction(ctx context.Context, req dto.ReviewDecisionRequest) (*dto.TransactionResponse, error)
+
+	// ClaimReview locks a transaction held for review to one admin for a limited time
+	ClaimReview(ctx context.Context, req dto.ReviewClaimRequest) (*dto.ReviewClaimResponse, error)
+
+	// ReleaseReview gives up an admin's claim on a transaction held for review
+	ReleaseReview(ctx context.Context, req dto.ReviewClaimRequest) error
+
+	// GetReviewQueueMetrics reports the size and age of the review queue
+	GetReviewQueueMetrics(ctx context.Context) (*dto.ReviewQueueMetricsResponse, error)
+
+	// ListFailedTransactions retrieves failed transactions by failure kind
+	ListFailedTransactions(ctx context.Context, kind string, req dto.ListRequest) (*dto.TransactionListResponse, error)
+
+	// ReplayTransaction processes a transaction that failed on infrastructure again
+	ReplayTransaction(ctx context.Context, req dto.ReplayTransactionRequest) (*dto.TransactionResponse, error)
+
+	// AdjustTransaction posts an adjustment correcting a transaction into the current period
+	AdjustTransaction(ctx context.Context, req dto.AdjustTransactionRequest) (*dto.TransactionResponse, error)
+
+	// CreateAdjustment posts an ADJUSTMENT of an account's balance, held for approval by a second admin
+	CreateAdjustment(ctx context.Context, req dto.CreateAdjustmentRequest) (*dto.TransactionResponse, error)
+
+	// GetAdjustmentReport totals completed adjustments per period and reason code
+	GetAdjustmentReport(ctx context.Context, req dto.AdjustmentReportRequest) (*dto.AdjustmentReportResponse, error)
+
+	// RecalculateBalance replays an account's completed transactions to recompute its balance and,
+	// when confirmed, repairs a stored balance that differs while recording the adjustment
+	RecalculateBalance(ctx context.Context, req dto.RecalculateBalanceRequest) (*dto.BalanceRecalculationResponse, error)
+
+	// DeclineOverdueReviews declines the transactions whose review deadline has passed
+	DeclineOverdueReviews(ctx context.Context) (int, error)
+
+	// IncludeAccounts embeds the names and statuses of the accounts involved in transaction responses
+	IncludeAccounts(ctx context.Context, responses []dto.TransactionResponse) error
+}
+
+// BackupUseCase defines the interface for backup and recovery validation logic
+type BackupUseCase interface {
+	// TriggerBackup starts a logical database backup
+	TriggerBackup(ctx context.Context, requestedBy string) (*dto.BackupResponse, error)
+
+	// GetBackup retrieves backup metadata by ID
+	GetBackup(ctx context.Context, id string) (*dto.BackupResponse, error)
+
+	// ListBackups retrieves backup metadata with pagination
+	ListBackups(ctx context.Context, req dto.ListRequest) (*dto.BackupListResponse, error)
+
+	// ReconstructBalance rebuilds an account balance as of a timestamp from the transaction log
+	ReconstructBalance(ctx context.Context, req dto.BalanceAsOfRequest) (*dto.BalanceAsOfResponse, error)
+}
+
+// CommandUseCase defines the interface for message-driven commands
+type CommandUseCase interface {
+	// ExecuteTransfer creates and confirms a transfer; repeated calls with the same command ID are idempotent
+	ExecuteTransfer(ctx context.Context, cmd dto.TransferCommand) (*dto.TransactionResponse, error)
+}
+
+// SagaUseCase defines the interface for querying multi-step payment sagas
+type SagaUseCase interface {
+	// GetSaga retrieves saga status by ID
+	GetSaga(ctx context.Context, id string) (*dto.SagaResponse, error)
+
+	// GetSagaByTransaction retrieves the saga status of a transaction
+	GetSagaByTransaction(ctx context.Context, transactionID string) (*dto.SagaResponse, error)
+}
+
+// CalendarUseCase defines the interface for the holiday and business-day calendar
+type CalendarUseCase interface {
+	// AddHoliday adds a holiday to a region's calendar
+	AddHoliday(ctx context.Context, req dto.CreateHolidayRequest) (*dto.HolidayResponse, error)
+
+	// DeleteHoliday removes a holiday from a region's calendar
+	DeleteHoliday(ctx context.Context, region, date string) error
+
+	// ListHolidays retrieves a region's holidays within a date range
+	ListHolidays(ctx context.Context, req dto.ListHolidaysRequest) (*dto.HolidayListResponse, error)
+
+	// GetBusinessDay reports whether a date is a business day in a region
+	GetBusinessDay(ctx context.Context, region, date string) (*dto.BusinessDayResponse, error)
+
+	// GetSettlementDate computes the date N business days after a trade date
+	GetSettlementDate(ctx context.Context, req dto.SettlementDateRequest) (*dto.SettlementDateResponse, error)
+}
+
+// CategoryUseCase defines the interface for transaction categorization and category analytics
+type CategoryUseCase interface {
+	// CreateCategory adds a category to the taxonomy
+	CreateCategory(ctx context.Context, req dto.CreateCategoryRequest) (*dto.CategoryResponse, error)
+
+	// UpdateCategory renames a category or moves it under another parent
+	UpdateCategory(ctx context.Context, req dto.UpdateCategoryRequest) (*dto.CategoryResponse, error)
+
+	// DeleteCategory removes a category that has no subcategories or rules
+	DeleteCategory(ctx context.Context, code string) error
+
+	// ListCategories retrieves the category taxonomy
+	ListCategories(ctx context.Context) (*dto.CategoryListResponse, error)
+
+	// CreateRule adds a categorization rule
+	CreateRule(ctx context.Context, req dto.CreateCategoryRuleRequest) (*dto.CategoryRuleResponse, error)
+
+	// DeleteRule removes a categorization rule
+	DeleteRule(ctx context.Context, id string) error
+
+	// ListRules retrieves the categorization rules in evaluation order
+	ListRules(ctx context.Context) (*dto.CategoryRuleListResponse, error)
+
+	// SetTransactionCategory overrides the category of a transaction for one of its accounts
+	SetTransactionCategory(ctx context.Context, req dto.SetTransactionCategoryRequest) error
+
+	// ClearTransactionCategory removes an account's category override of a transaction
+	ClearTransactionCategory(ctx context.Context, accountID, transactionID string) error
+
+	// GetCategorySummary totals an account's completed money movement per category
+	GetCategorySummary(ctx context.Context, req dto.CategorySummaryRequest) (*dto.CategorySummaryResponse, error)
+}
+
+// BusinessRuleUseCase defines the interface for managing the expression rules applied to new transactions
+type BusinessRuleUseCase interface {
+	// CreateRule adds a validation or fee rule
+	CreateRule(ctx context.Context, req dto.CreateBusinessRuleRequest) (*dto.BusinessRuleResponse, error)
+
+	// UpdateRule changes a rule or switches it on or off
+	UpdateRule(ctx context.Context, req dto.UpdateBusinessRuleRequest) (*dto.BusinessRuleResponse, error)
+
+	// DeleteRule removes a rule
+	DeleteRule(ctx context.Context, id string) error
+
+	// ListRules retrieves the rules in evaluation order
+	ListRules(ctx context.Context) (*dto.BusinessRuleListResponse, error)
+
+	// TestRule evaluates an expression against a sample transaction
+	TestRule(ctx context.Context, req dto.TestBusinessRuleRequest) (*dto.TestBusinessRuleResponse, error)
+}
+
+// ProductUseCase defines the interface for the catalog of products accounts are opened from
+type ProductUseCase interface {
+	// CreateProduct adds a product to the catalog
+	CreateProduct(ctx context.Context, req dto.CreateProductRequest) (*dto.ProductResponse, error)
+
+	// GetProduct retrieves a product by ID
+	GetProduct(ctx context.Context, id string) (*dto.ProductResponse, error)
+
+	// UpdateProduct changes a product's terms or retires it
+	UpdateProduct(ctx context.Context, req dto.UpdateProductRequest) (*dto.ProductResponse, error)
+
+	// ListProducts retrieves the product catalog
+	ListProducts(ctx context.Context) (*dto.ProductListResponse, error)
+}
+
+// BudgetUseCase defines the interface for monthly category budgets
+type BudgetUseCase interface {
+	// CreateBudget sets a monthly budget for a category of an account
+	CreateBudget(ctx context.Context, req dto.CreateBudgetRequest) (*dto.BudgetResponse, error)
+
+	// GetBudget retrieves a budget of an account with its current month spend
+	GetBudget(ctx context.Context, accountID, id string) (*dto.BudgetResponse, error)
+
+	// ListBudgets retrieves the budgets of an account with their current month spend
+	ListBudgets(ctx context.Context, accountID string) (*dto.BudgetListResponse, error)
+
+	// UpdateBudget changes a budget's monthly amount
+	UpdateBudget(ctx context.Context, req dto.UpdateBudgetRequest) (*dto.BudgetResponse, error)
+
+	// DeleteBudget removes a budget of an account
+	DeleteBudget(ctx context.Context, accountID, id string) error
+}
+
+// CustomerUseCase defines the interface for views across a customer's accounts
+type CustomerUseCase interface {
+	// GetCustomerSummary aggregates balances, recent activity and pending transactions
+	// across all accounts of a customer
+	GetCustomerSummary(ctx context.Context, req dto.CustomerSummaryRequest) (*dto.CustomerSummaryResponse, error)
+}
+
+// OwnershipTransferUseCase defines the interface for moving accounts between customers
+type OwnershipTransferUseCase interface {
+	// RequestOwnershipTransfer requests moving an account to another customer on an effective date
+	RequestOwnershipTransfer(ctx context.Context, req dto.CreateOwnershipTransferRequest) (*dto.OwnershipTransferResponse, error)
+
+	// ApproveOwnershipTransfer records a second admin's approval; a transfer already effective is applied at once
+	ApproveOwnershipTransfer(ctx context.Context, req dto.OwnershipTransferDecisionRequest) (*dto.OwnershipTransferResponse, error)
+
+	// RejectOwnershipTransfer rejects a pending transfer or withdraws an approved one before it takes effect
+	RejectOwnershipTransfer(ctx context.Context, req dto.OwnershipTransferDecisionRequest) (*dto.OwnershipTransferResponse, error)
+
+	// GetOwnershipTransfer retrieves an ownership transfer
+	GetOwnershipTransfer(ctx context.Context, id string) (*dto.OwnershipTransferResponse, error)
+
+	// ListOwnershipTransfers retrieves ownership transfers, newest first
+	ListOwnershipTransfers(ctx context.Context, req dto.OwnershipTransferListRequest) (*dto.OwnershipTransferListResponse, error)
+
+	// ApplyDueTransfers applies the approved transfers whose effective date has arrived
+	ApplyDueTransfers(ctx context.Context) (int, error)
+}
+
+// ProductMigrationUseCase defines the interface for moving accounts in bulk between products
+type ProductMigrationUseCase interface {
+	// CreateProductMigration schedules moving accounts to another product on an effective date, or
+	// only reports what would happen for a dry run
+	CreateProductMigration(ctx context.Context, req dto.CreateProductMigrationRequest) (*dto.ProductMigrationResponse, error)
+
+	// GetProductMigration retrieves a product migration with its per-account report
+	GetProductMigration(ctx context.Context, id string) (*dto.ProductMigrationResponse, error)
+
+	// ListProductMigrations retrieves product migrations, newest first
+	ListProductMigrations(ctx context.Context, req dto.ProductMigrationListRequest) (*dto.ProductMigrationListResponse, error)
+
+	// ApplyDueMigrations applies the scheduled migrations whose effective date has arrived
+	ApplyDueMigrations(ctx context.Context) (int, error)
+}
+
+// TransactionBlockUseCase defines the interface for temporary transaction blocks on accounts
+type TransactionBlockUseCase interface {
+	// CreateTransactionBlock blocks a type of transaction on an account for a while
+	CreateTransactionBlock(ctx context.Context, req dto.CreateTransactionBlockRequest) (*dto.TransactionBlockResponse, error)
+
+	// LiftTransactionBlock ends a block before it expires
+	LiftTransactionBlock(ctx context.Context, req dto.LiftTransactionBlockRequest) (*dto.TransactionBlockResponse, error)
+
+	// GetTransactionBlock retrieves a block of an account
+	GetTransactionBlock(ctx context.Context, accountID, id string) (*dto.TransactionBlockResponse, error)
+
+	// ListTransactionBlocks retrieves an account's blocks, newest first
+	ListTransactionBlocks(ctx context.Context, req dto.TransactionBlockListRequest) (*dto.TransactionBlockListResponse, error)
+}
+
+// VelocityUseCase defines the interface for reading accounts' usage of their velocity limits
+type VelocityUseCase interface {
+	// GetVelocityUsage retrieves what an account has used of its velocity limits in the current windows
+	GetVelocityUsage(ctx context.Context, accountID string) (*dto.VelocityUsageResponse, error)
+}
+
+// TransactionMonitorUseCase defines the interface for live transaction monitoring
+type TransactionMonitorUseCase interface {
+	// WatchTransactions streams newly created and completed transactions matching
+	// the filter until ctx is done
+	WatchTransactions(ctx context.Context, req dto.LiveTransactionRequest) (<-chan dto.LiveTransactionResponse, error)
+}
+
+// WebhookUseCase defines the interface for account webhook subscriptions
+type WebhookUseCase interface {
+	// CreateWebhook subscribes a URL to an account's events
+	CreateWebhook(ctx context.Context, req dto.CreateWebhookRequest) (*dto.WebhookResponse, error)
+
+	// GetWebhook retrieves a webhook subscription of an account
+	GetWebhook(ctx context.Context, accountID, id string) (*dto.WebhookResponse, error)
+
+	// ListWebhooks retrieves the webhook subscriptions of an account
+	ListWebhooks(ctx context.Context, accountID string) (*dto.WebhookListResponse, error)
+
+	// DeleteWebhook removes a webhook subscription of an account
+	DeleteWebhook(ctx context.Context, accountID, id string) error
+
+	// EnableWebhook re-activates a webhook subscription disabled after failed deliveries
+	EnableWebhook(ctx context.Context, accountID, id string) (*dto.WebhookResponse, error)
+
+	// TestWebhook sends a test event to the subscription URL and reports the outcome
+	TestWebhook(ctx context.Context, accountID, id string) (*dto.WebhookTestResponse, error)
+}
+
+// VirtualAccountUseCase defines the interface for virtual account numbers crediting into settlement accounts
+type VirtualAccountUseCase interface {
+	// CreateVirtualAccount issues a virtual account number for a settlement account
+	CreateVirtualAccount(ctx context.Context, req dto.CreateVirtualAccountRequest) (*dto.VirtualAccountResponse, error)
+
+	// GetVirtualAccount looks up a virtual account number
+	GetVirtualAccount(ctx context.Context, id string) (*dto.VirtualAccountResponse, error)
+
+	// ListVirtualAccounts retrieves the virtual accounts of a settlement account
+	ListVirtualAccounts(ctx context.Context, accountID string, req dto.ListRequest) (*dto.VirtualAccountListResponse, error)
+
+	// UpdateVirtualAccount relabels, closes or reopens a virtual account
+	UpdateVirtualAccount(ctx context.Context, req dto.UpdateVirtualAccountRequest) (*dto.VirtualAccountResponse, error)
+
+	// DeleteVirtualAccount removes a virtual account
+	DeleteVirtualAccount(ctx context.Context, accountID, id string) error
+
+	// GetVirtualAccountTransactions retrieves the transactions paid to a virtual account number
+	GetVirtualAccountTransactions(ctx context.Context, id string, req dto.ListRequest) (*dto.TransactionListResponse, error)
+}
+
+// AuditUseCase defines the interface for exporting and retaining the audit log
+type AuditUseCase interface {
+	// ExportAudit streams the matching audit entries to w as signed NDJSON
+	ExportAudit(ctx context.Context, req dto.AuditExportRequest, w io.Writer) (*dto.AuditSignatureLine, error)
+
+	// PurgeExpired removes the audit entries past the retention period
+	PurgeExpired(ctx context.Context) (int64, error)
+}
+
+// ExportUseCase defines the interface for asynchronous exports and their signed download links
+type ExportUseCase interface {
+	// ExportAuditLog starts exporting the matching audit entries in the background
+	ExportAuditLog(ctx context.Context, req dto.AuditExportRequest, requestedBy string) (*dto.ExportResponse, error)
+
+	// ExportAccountHistory starts exporting an account's transaction history in the background
+	ExportAccountHistory(ctx context.Context, accountID string, req dto.HistoryExportRequest, requestedBy string) (*dto.ExportResponse, error)
+
+	// GetExport retrieves an export, with a fresh download link once it completed
+	GetExport(ctx context.Context, id string) (*dto.ExportResponse, error)
+
+	// OpenDownload checks a download link and opens the export's file
+	OpenDownload(ctx context.Context, req dto.DownloadRequest) (*dto.ExportDownload, error)
+
+	// PurgeExpired deletes the exports past their retention and their files
+	PurgeExpired(ctx context.Context) (int64, error)
+}
+
+// JobUseCase defines the interface for inspecting and retrying background jobs
+type JobUseCase interface {
+	// GetJob retrieves a job by ID
+	GetJob(ctx context.Context, id string) (*dto.JobResponse, error)
+
+	// ListJobs retrieves jobs with pagination, newest first, optionally of one type or status
+	ListJobs(ctx context.Context, req dto.JobListRequest) (*dto.JobListResponse, error)
+
+	// RetryJob queues a failed job again with a fresh set of attempts
+	RetryJob(ctx context.Context, id string) (*dto.JobResponse, error)
+
+	// PurgeFinished deletes the jobs that finished longer ago than the retention period
+	PurgeFinished(ctx context.Context) (int64, error)
+}
+
+// CashbackUseCase defines the interface for cashback campaigns and the cashback accounts earn
+type CashbackUseCase interface {
+	// CreateCampaign starts a cashback campaign
+	CreateCampaign(ctx context.Context, req dto.CreateCashbackCampaignRequest) (*dto.CashbackCampaignResponse, error)
+
+	// UpdateCampaign changes a campaign's terms, or pauses or resumes it
+	UpdateCampaign(ctx context.Context, req dto.UpdateCashbackCampaignRequest) (*dto.CashbackCampaignResponse, error)
+
+	// ListCampaigns retrieves the cashback campaigns, newest first
+	ListCampaigns(ctx context.Context) (*dto.CashbackCampaignListResponse, error)
+
+	// GetAccountCashback retrieves the cashback an account earned with its total
+	GetAccountCashback(ctx context.Context, accountID string, req dto.ListRequest) (*dto.CashbackHistoryResponse, error)
+}
+
+// ReferralUseCase defines the interface for referral codes and their redemption
+type ReferralUseCase interface {
+	// GetReferralCode retrieves the referral code of an account, creating it on first use
+	GetReferralCode(ctx context.Context, accountID string) (*dto.ReferralCodeResponse, error)
+
+	// RedeemReferral records an account redeeming another account's referral code
+	RedeemReferral(ctx context.Context, req dto.RedeemReferralRequest) (*dto.ReferralResponse, error)
+
+	// ListReferrals retrieves the referrals an account made
+	ListReferrals(ctx context.Context, accountID string, req dto.ListRequest) (*dto.ReferralListResponse, error)
+}
+
+// TransferTemplateUseCase defines the interface for saved transfers of an account
+type TransferTemplateUseCase interface {
+	// CreateTemplate saves a transfer template for an account
+	CreateTemplate(ctx context.Context, req dto.TransferTemplateRequest) (*dto.TransferTemplateResponse, error)
+
+	// GetTemplate retrieves a transfer template of an account
+	GetTemplate(ctx context.Context, accountID, id string) (*dto.TransferTemplateResponse, error)
+
+	// ListTemplates retrieves the transfer templates of an account
+	ListTemplates(ctx context.Context, accountID string) (*dto.TransferTemplateListResponse, error)
+
+	// UpdateTemplate changes a transfer template
+	UpdateTemplate(ctx context.Context, req dto.TransferTemplateRequest) (*dto.TransferTemplateResponse, error)
+
+	// DeleteTemplate removes a transfer template of an account
+	DeleteTemplate(ctx context.Context, accountID, id string) error
+
+	// ExecuteTemplate creates and confirms a transfer from a template in one call
+	ExecuteTemplate(ctx context.Context, req dto.ExecuteTransferTemplateRequest) (*dto.TransactionResponse, error)
+}
+
+// NotificationTemplateUseCase defines the interface for the versioned content of customer notifications
+type NotificationTemplateUseCase interface {
+	// PublishTemplate saves the next version of a notification template
+	PublishTemplate(ctx context.Context, req dto.PublishNotificationTemplateRequest) (*dto.NotificationTemplateResponse, error)
+
+	// RollbackTemplate republishes the content of an earlier version as the next version
+	RollbackTemplate(ctx context.Context, req dto.RollbackNotificationTemplateRequest) (*dto.NotificationTemplateResponse, error)
+
+	// GetTemplate retrieves a version of a notification template, the latest when version is zero
+	GetTemplate(ctx context.Context, key dto.NotificationTemplateKey, version int) (*dto.NotificationTemplateResponse, error)
+
+	// ListTemplates retrieves the latest version of every notification template
+	ListTemplates(ctx context.Context) (*dto.NotificationTemplateListResponse, error)
+
+	// ListVersions retrieves every version of a notification template, newest first
+	ListVersions(ctx context.Context, key dto.NotificationTemplateKey) (*dto.NotificationTemplateListResponse, error)
+
+	// PreviewTemplate renders a saved version, or unsaved content, with the given or sample event data
+	PreviewTemplate(ctx context.Context, req dto.PreviewNotificationTemplateRequest) (*dto.NotificationPreviewResponse, error)
+
+	// TestSend renders a notification template and sends it to one recipient
+	TestSend(ctx context.Context, req dto.TestSendNotificationRequest) (*dto.NotificationPreviewResponse, error)
+}
+
+// AccountingPeriodUseCase defines the interface for closing accounting periods
+type AccountingPeriodUseCase interface {
+	// ClosePeriod closes the books for a month that has ended, locking it and every earlier month
+	ClosePeriod(ctx context.Context, req dto.ClosePeriodRequest) (*dto.AccountingPeriodResponse, error)
+
+	// GetPeriod retrieves the status of a period
+	GetPeriod(ctx context.Context, period string) (*dto.AccountingPeriodResponse, error)
+
+	// ListPeriods retrieves the period closes, latest first, with the current period
+	ListPeriods(ctx context.Context) (*dto.AccountingPeriodListResponse, error)
+}
+
+// GeneralLedgerUseCase defines the interface for the general ledger behind customer transactions
+type GeneralLedgerUseCase interface {
+	// ListAccounts retrieves the chart of accounts
+	ListAccounts(ctx context.Context) (*dto.GLAccountListResponse, error)
+
+	// GetTransactionPostings retrieves the journal entry a completed transaction was booked as
+	GetTransactionPostings(ctx context.Context, transactionID string) (*dto.TransactionPostingsResponse, error)
+
+	// GetTrialBalance totals the debits and credits of every GL account in a period, the current one by default
+	GetTrialBalance(ctx context.Context, period string) (*dto.TrialBalanceResponse, error)
+
+	// GetRevenueReport summarizes the fees collected and interest paid per period and product
+	GetRevenueReport(ctx context.Context, req dto.RevenueReportRequest) (*dto.RevenueReportResponse, error)
+
+	// ExportRevenueReport writes the revenue report as CSV to w
+	ExportRevenueReport(ctx context.Context, req dto.RevenueReportRequest, w io.Writer) error
+}
+
+// NotificationPreferenceUseCase defines the interface for customers' notification preferences
+type NotificationPreferenceUseCase interface {
+	// GetPreferences retrieves a customer's notification preferences, the defaults when none are saved
+	GetPreferences(ctx context.Context, customerID string) (*dto.NotificationPreferenceResponse, error)
+
+	// UpdatePreferences replaces a customer's notification preferences
+	UpdatePreferences(ctx context.Context, req dto.NotificationPreferenceRequest) (*dto.NotificationPreferenceResponse, error)
+
+	// ResetPreferences removes a customer's notification preferences, turning notifications off
+	ResetPreferences(ctx context.Context, customerID string) error
+}
+
+// CacheUseCase defines the interface for operating on the response cache
+type CacheUseCase interface {
+	// InvalidateCache removes cached entries by key pattern or entity reference
+	InvalidateCache(ctx context.Context, req dto.CacheInvalidationRequest) (*dto.CacheInvalidationResponse, error)
+}
+
+// TransactionHistoryUseCase defines the interface for the transaction history read model
+type TransactionHistoryUseCase interface {
+	// GetAccountHistory retrieves an account's transaction history, newest first, narrowed by the request's filters
+	GetAccountHistory(ctx context.Context, accountID string, req dto.HistoryRequest) (*dto.TransactionHistoryResponse, error)
+
+	// SyncAccountHistory retrieves an account's completed history entries after a sequence number, in sequence order
+	SyncAccountHistory(ctx context.Context, accountID string, req dto.HistorySyncRequest) (*dto.HistorySyncResponse, error)
+
+	// RebuildAccountHistory projects every transaction of an account again, recomputing its running balance
+	RebuildAccountHistory(ctx context.Context, accountID string) (*dto.HistoryRebuildResponse, error)
+}
+
+// TransactionTagUseCase defines the interface for an account holder's transaction tags and saved history filters
+type TransactionTagUseCase interface {
+	// SetTransactionTags replaces an account's tags of one of its transactions
+	SetTransactionTags(ctx context.Context, req dto.SetTransactionTagsRequest) (*dto.TransactionTagsResponse, error)
+
+	// CreateSavedFilter saves a named history filter for an account
+	CreateSavedFilter(ctx context.Context, req dto.SavedFilterRequest) (*dto.SavedFilterResponse, error)
+
+	// GetSavedFilter retrieves a saved history filter of an account
+	GetSavedFilter(ctx context.Context, accountID, id string) (*dto.SavedFilterResponse, error)
+
+	// ListSavedFilters retrieves the saved history filters of an account
+	ListSavedFilters(ctx context.Context, accountID string) (*dto.SavedFilterListResponse, error)
+
+	// DeleteSavedFilter removes a saved history filter of an account
+	DeleteSavedFilter(ctx context.Context, accountID, id string) error
+}
+
+// ScheduledTransactionUseCase defines the interface for transfers scheduled to run once or recurring
+type ScheduledTransactionUseCase interface {
+	// CreateScheduledTransaction schedules a transfer between two accounts
+	CreateScheduledTransaction(ctx context.Context, req dto.ScheduledTransactionRequest) (*dto.ScheduledTransactionResponse, error)
+
+	// GetScheduledTransaction retrieves a scheduled transaction by ID
+	GetScheduledTransaction(ctx context.Context, id string) (*dto.ScheduledTransactionResponse, error)
+
+	// ListScheduledTransactions retrieves scheduled transactions, optionally of an account or a status
+	ListScheduledTransactions(ctx context.Context, req dto.ScheduledTransactionListRequest) (*dto.ScheduledTransactionListResponse, error)
+
+	// UpdateScheduledTransaction replaces the transfer and schedule of an active scheduled transaction
+	UpdateScheduledTransaction(ctx context.Context, req dto.ScheduledTransactionRequest) (*dto.ScheduledTransactionResponse, error)
+
+	// DeleteScheduledTransaction removes a scheduled transaction
+	DeleteScheduledTransaction(ctx context.Context, id string) error
+
+	// RunDueTransactions makes the transfers of the schedules that are due
+	RunDueTransactions(ctx context.Context) (int, error)
+}
+
+// AttachmentUseCase defines the interface for files account holders attach to their transactions
+type AttachmentUseCase interface {
+	// UploadAttachment stores a file attached to one of an account's transactions
+	UploadAttachment(ctx context.Context, req dto.UploadAttachmentRequest) (*dto.AttachmentResponse, error)
+
+	// ListAttachments retrieves an account's attachments of one of its transactions
+	ListAttachments(ctx context.Context, accountID, transactionID string) (*dto.AttachmentListResponse, error)
+
+	// GetAttachment retrieves an attachment of one of an account's transactions
+	GetAttachment(ctx context.Context, accountID, transactionID, id string) (*dto.AttachmentResponse, error)
+
+	// OpenAttachment opens the file of an attachment for download
+	OpenAttachment(ctx context.Context, accountID, transactionID, id string) (*dto.AttachmentDownload, error)
+
+	// DeleteAttachment removes an attachment and its file
+	DeleteAttachment(ctx context.Context, accountID, transactionID, id string) error
+
+	// PurgeArchived deletes the attachments of deleted accounts and their files
+	PurgeArchived(ctx context.Context) (int64, error)
+}
+
+// DailyAggregateUseCase defines the interface for the materialized daily aggregates behind reports
+type DailyAggregateUseCase interface {
+	// GetDailyTotals retrieves an account's inflow, outflow and count per day within a date range
+	GetDailyTotals(ctx context.Context, req dto.DailyTotalsRequest) (*dto.DailyTotalsResponse, error)
+
+	// RebuildDailyTotals recomputes an account's daily aggregates from its completed transactions
+	RebuildDailyTotals(ctx context.Context, accountID string) (*dto.DailyTotalsRebuildResponse, error)
+}
+
+// ClockUseCase defines the interface for a sandbox's test clock
+type ClockUseCase interface {
+	// GetClock retrieves the time the test clock shows
+	GetClock(ctx context.Context) (*dto.ClockResponse, error)
+
+	// AdvanceClock moves the test clock forward
+	AdvanceClock(ctx context.Context, req dto.AdvanceClockRequest) (*dto.ClockResponse, error)
+}
+
+// AdminUserUseCase defines the interface for admin users and the permissions of their roles
+type AdminUserUseCase interface {
+	// CreateAdmin adds an admin user and returns them with their API key, shown only this once
+	CreateAdmin(ctx context.Context, req dto.CreateAdminUserRequest) (*dto.AdminUserResponse, error)
+
+	// GetAdmin retrieves an admin user
+	GetAdmin(ctx context.Context, id string) (*dto.AdminUserResponse, error)
+
+	// ListAdmins retrieves every admin user by email
+	ListAdmins(ctx context.Context) (*dto.AdminUserListResponse, error)
+
+	// UpdateAdmin changes an admin user's roles or disables and re-enables them
+	UpdateAdmin(ctx context.Context, req dto.UpdateAdminUserRequest) (*dto.AdminUserResponse, error)
+
+	// RotateAdminKey issues a new API key to an admin user, revoking the previous one
+	RotateAdminKey(ctx context.Context, req dto.RotateAdminKeyRequest) (*dto.AdminUserResponse, error)
+
+	// ListRoles retrieves the permissions matrix of the admin roles
+	ListRoles(ctx context.Context) *dto.AdminRoleListResponse
+
+	// Authenticate resolves an admin API key to its active admin user; unknown keys and disabled admin
+	// users are errs.ErrUnauthorized
+	Authenticate(ctx context.Context, key string) (*dto.AdminUserResponse, error)
+}
+
+// AdminSecurityUseCase defines the interface for the audit of admin activity and its anomalies
+type AdminSecurityUseCase interface {
+	// RecordActivity records a request of an admin user with the anomalies it shows, alerting security
+	// when it shows any
+	RecordActivity(ctx context.Context, record dto.AdminActivityRecord) error
+
+	// ListActivity retrieves recorded admin activity, newest first
+	ListActivity(ctx context.Context, req dto.AdminActivityListRequest) (*dto.AdminActivityListResponse, error)
+
+	// GetSecurityDashboard sums up admin activity and its anomalies over the last hours, per admin user
+	GetSecurityDashboard(ctx context.Context, req dto.SecurityDashboardRequest) (*dto.SecurityDashboardResponse, error)
+}
+
+// ExchangeRateUseCase defines the interface for the exchange rates FX transfers are converted at
+type ExchangeRateUseCase interface {
+	// ListRates retrieves the current rates from a base currency, the service's currency when empty
+	ListRates(ctx context.Context, base string) (*dto.ExchangeRateListResponse, error)
+}
+
+// StatementUseCase defines the interface for account statements
+type StatementUseCase interface {
+	// GetStatement retrieves an account's transactions completed within a period with their running
+	// balance, between its opening and closing balance
+	GetStatement(ctx context.Context, req dto.StatementRequest) (*dto.StatementResponse, error)
 }
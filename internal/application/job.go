@@ -0,0 +1,508 @@
+// internal/application/job.go
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/application/dto"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/infra"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/repository"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+)
+
+// Job types run by the job queue
+const (
+	JobTypeBackup      = "backup.run"   // Dumps the database for a backup record
+	JobTypeExport      = "export.run"   // Writes the file of an export record
+	JobTypeReviewSweep = "review.sweep" // Declines reviews left undecided past their SLA
+	JobTypeAuditPurge  = "audit.purge"  // Purges audit entries past their retention
+	JobTypeExportPurge = "export.purge" // Purges exports past their retention
+	JobTypeJobPurge    = "job.purge"    // Purges finished jobs past their retention
+
+	JobTypeAttachmentPurge = "attachment.purge" // Purges the attachments of deleted accounts
+
+	JobTypeOwnershipTransfers    = "ownership.apply"           // Applies approved ownership transfers that reached their effective date
+	JobTypeProductMigrations     = "product_migration.apply"   // Applies scheduled product migrations that reached their effective date
+	JobTypeScheduledTransactions = "scheduled_transaction.run" // Makes the transfers of scheduled transactions that are due
+)
+
+// jobFinishTimeout bounds storing the outcome of an attempt, which also happens during shutdown
+const jobFinishTimeout = 10 * time.Second
+
+// JobHandler runs one attempt of a job; an error fails the attempt. Handlers must tolerate running
+// a job more than once: a worker that dies mid-attempt leaves the job to be claimed again.
+type JobHandler func(ctx context.Context, job *entity.Job) error
+
+// JobOptions tune how the queue runs the jobs of a type
+type JobOptions struct {
+	MaxAttempts int           // Attempts before a job fails for good; 0 uses the queue's default
+	Timeout     time.Duration // Bounds a single attempt; 0 leaves it unbounded
+}
+
+// JobQueueConfig holds job queue configuration
+type JobQueueConfig struct {
+	Workers        int           // Jobs run concurrently by this instance
+	PollInterval   time.Duration // How often idle workers look for due jobs
+	Lease          time.Duration // How long a claim holds without renewal; a running job renews it at a third of this
+	MaxAttempts    int           // Default attempts of a job
+	RetryBaseDelay time.Duration // Delay before the first retry, doubled on every further one
+	RetryMaxDelay  time.Duration
+	Clock          infra.Clock // Tells which jobs are due; the system clock when nil
+}
+
+type jobRegistration struct {
+	handler JobHandler
+	options JobOptions
+}
+
+type jobSchedule struct {
+	jobType  string
+	interval time.Duration
+	lastSlot time.Time
+}
+
+// JobQueue runs background work persisted in the job repository on a pool of workers. Jobs survive
+// restarts, are retried with exponential backoff, and are taken over by another instance when the
+// worker running them dies. Recurring jobs are enqueued once per interval across all instances.
+type JobQueue struct {
+	jobRepo  repository.JobRepository
+	config   JobQueueConfig
+	workerID string
+	logger   infra.Logger
+	metrics  *JobMetrics
+
+	mu        sync.RWMutex
+	handlers  map[string]jobRegistration
+	schedules []*jobSchedule
+}
+
+// NewJobQueue creates a job queue; register the handlers of every job type before running it
+func NewJobQueue(jobRepo repository.JobRepository, config JobQueueConfig, logger infra.Logger) *JobQueue {
+	host, err := os.Hostname()
+	if err != nil {
+		host = "worker"
+	}
+
+	return &JobQueue{
+		jobRepo:  jobRepo,
+		config:   config,
+		workerID: fmt.Sprintf("%s-%d", host, os.Getpid()),
+		logger:   logger,
+		metrics:  NewJobMetrics(),
+		handlers: make(map[string]jobRegistration),
+	}
+}
+
+// Metrics returns the queue's attempt counters
+func (q *JobQueue) Metrics() *JobMetrics {
+	return q.metrics
+}
+
+// Register sets the handler running the jobs of a type
+func (q *JobQueue) Register(jobType string, handler JobHandler, options JobOptions) {
+	if options.MaxAttempts <= 0 {
+		options.MaxAttempts = q.config.MaxAttempts
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.handlers[jobType] = jobRegistration{handler: handler, options: options}
+}
+
+// Schedule runs handler as a recurring job every interval. Runs are aligned to multiples of the
+// interval, so every instance enqueues the same run and only one of them is kept. A failed run is
+// not retried; the next one is soon due.
+func (q *JobQueue) Schedule(jobType string, interval time.Duration, handler JobHandler) {
+	q.Register(jobType, handler, JobOptions{MaxAttempts: 1, Timeout: interval})
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.schedules = append(q.schedules, &jobSchedule{jobType: jobType, interval: interval})
+}
+
+// Enqueue stores a new job of a registered type to run payload no earlier than runAt
+func (q *JobQueue) Enqueue(ctx context.Context, jobType string, payload interface{}, runAt time.Time) (*entity.Job, error) {
+	registration, ok := q.registration(jobType)
+	if !ok {
+		return nil, fmt.Errorf("no handler registered for job type %q", jobType)
+	}
+
+	job, err := entity.NewJob(jobType, payload, registration.options.MaxAttempts, runAt)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := q.jobRepo.Enqueue(ctx, job); err != nil {
+		q.logger.Error("Failed to enqueue job", "error", err, "jobID", job.ID, "type", jobType)
+		return nil, err
+	}
+
+	return job, nil
+}
+
+// Run enqueues scheduled jobs and runs due jobs until the context is cancelled. It returns once the
+// workers have stopped; jobs they were running go back to the queue.
+func (q *JobQueue) Run(ctx context.Context) {
+	types := q.types()
+
+	var wg sync.WaitGroup
+	for i := 1; i <= q.config.Workers; i++ {
+		wg.Add(1)
+		go func(workerID string) {
+			defer wg.Done()
+			q.work(ctx, workerID, types)
+		}(fmt.Sprintf("%s-%d", q.workerID, i))
+	}
+
+	ticker := time.NewTicker(q.config.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		q.enqueueScheduled(ctx, q.now())
+
+		select {
+		case <-ctx.Done():
+			wg.Wait()
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// work claims and runs due jobs until the context is cancelled, polling while there are none
+func (q *JobQueue) work(ctx context.Context, workerID string, types []string) {
+	ticker := time.NewTicker(q.config.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		now := q.now()
+		job, err := q.jobRepo.ClaimNext(ctx, types, workerID, now, now.Add(q.config.Lease))
+		if err != nil && ctx.Err() == nil {
+			q.logger.Warn("Failed to claim job", "error", err, "worker", workerID)
+		}
+
+		// Drain due jobs without waiting between them
+		if job != nil {
+			q.process(ctx, job)
+			if ctx.Err() == nil {
+				continue
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// process runs one attempt of a claimed job, renewing its lease meanwhile, and stores the outcome
+func (q *JobQueue) process(ctx context.Context, job *entity.Job) {
+	registration, _ := q.registration(job.Type)
+	q.metrics.attempts.Add(1)
+
+	var err error
+	if job.Attempts > job.MaxAttempts {
+		// The lease of the last attempt lapsed, most likely because its worker died mid-run
+		err = errors.New("the last attempt was abandoned by its worker")
+	} else {
+		err = q.attempt(ctx, job, registration)
+	}
+
+	now := q.now()
+	switch {
+	case err == nil:
+		job.MarkSucceeded()
+		q.metrics.succeeded.Add(1)
+		q.logger.Info("Job succeeded", "jobID", job.ID, "type", job.Type, "attempt", job.Attempts)
+	case ctx.Err() != nil && job.Attempts <= job.MaxAttempts:
+		job.Interrupt(now)
+		q.metrics.interrupted.Add(1)
+		q.logger.Info("Job interrupted by shutdown", "jobID", job.ID, "type", job.Type)
+	default:
+		job.MarkFailed(err.Error(), now.Add(q.retryDelay(job.Attempts)))
+		if job.Status == vo.JobStatusFailed {
+			q.metrics.failed.Add(1)
+			q.logger.Error("Job failed", "error", err, "jobID", job.ID, "type", job.Type, "attempts", job.Attempts)
+		} else {
+			q.metrics.retried.Add(1)
+			q.logger.Warn("Job attempt failed, retrying", "error", err, "jobID", job.ID, "type", job.Type, "attempt", job.Attempts, "retryAt", job.RunAt)
+		}
+	}
+
+	// The outcome is stored even when the queue is shutting down
+	finishCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), jobFinishTimeout)
+	defer cancel()
+	if err := q.jobRepo.Finish(finishCtx, job); err != nil {
+		if errors.Is(err, errs.ErrJobLeaseLost) {
+			q.metrics.leasesLost.Add(1)
+			q.logger.Warn("Job was taken over by another worker", "jobID", job.ID, "type", job.Type)
+			return
+		}
+		q.logger.Error("Failed to store job outcome", "error", err, "jobID", job.ID, "type", job.Type)
+	}
+}
+
+// attempt calls the job's handler under its timeout while renewing the lease. Losing the lease
+// cancels the handler, since another worker is about to run the job.
+func (q *JobQueue) attempt(ctx context.Context, job *entity.Job, registration jobRegistration) (err error) {
+	runCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	if registration.options.Timeout > 0 {
+		runCtx, cancel = context.WithTimeout(runCtx, registration.options.Timeout)
+		defer cancel()
+	}
+
+	heartbeatDone := make(chan struct{})
+	defer close(heartbeatDone)
+	go q.heartbeat(runCtx, job, cancel, heartbeatDone)
+
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("job handler panicked: %v", r)
+		}
+	}()
+	return registration.handler(runCtx, job)
+}
+
+// heartbeat renews a running job's lease until done is closed
+func (q *JobQueue) heartbeat(ctx context.Context, job *entity.Job, cancel context.CancelFunc, done <-chan struct{}) {
+	ticker := time.NewTicker(q.config.Lease / 3)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-done:
+			return
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		err := q.jobRepo.ExtendLease(ctx, job.ID, job.LockedBy, q.now().Add(q.config.Lease))
+		if errors.Is(err, errs.ErrJobLeaseLost) {
+			q.logger.Warn("Lost job lease, cancelling the attempt", "jobID", job.ID, "type", job.Type)
+			cancel()
+			return
+		}
+		if err != nil && ctx.Err() == nil {
+			q.logger.Warn("Failed to renew job lease", "error", err, "jobID", job.ID)
+		}
+	}
+}
+
+// enqueueScheduled enqueues the current run of every schedule not enqueued yet
+func (q *JobQueue) enqueueScheduled(ctx context.Context, now time.Time) {
+	q.mu.RLock()
+	schedules := append([]*jobSchedule(nil), q.schedules...)
+	q.mu.RUnlock()
+
+	for _, schedule := range schedules {
+		slot := now.Truncate(schedule.interval)
+		if !slot.After(schedule.lastSlot) {
+			continue
+		}
+
+		job, err := entity.NewJob(schedule.jobType, nil, 1, slot)
+		if err != nil {
+			continue
+		}
+		job.UniqueKey = schedule.jobType + "@" + slot.UTC().Format(time.RFC3339)
+
+		// Another instance may have enqueued the run already, which is just as good
+		if _, err := q.jobRepo.Enqueue(ctx, job); err != nil {
+			if ctx.Err() == nil {
+				q.logger.Warn("Failed to enqueue scheduled job", "error", err, "type", schedule.jobType)
+			}
+			continue
+		}
+		schedule.lastSlot = slot
+	}
+}
+
+// retryDelay returns the backoff before retrying a job that failed its attempt-th attempt
+func (q *JobQueue) retryDelay(attempt int) time.Duration {
+	delay := q.config.RetryBaseDelay
+	for i := 1; i < attempt && delay < q.config.RetryMaxDelay; i++ {
+		delay *= 2
+	}
+	if delay > q.config.RetryMaxDelay {
+		delay = q.config.RetryMaxDelay
+	}
+	return delay
+}
+
+// now returns the current time of the queue's clock
+func (q *JobQueue) now() time.Time {
+	if q.config.Clock == nil {
+		return time.Now()
+	}
+	return q.config.Clock.Now()
+}
+
+// registration returns the handler and options of a job type
+func (q *JobQueue) registration(jobType string) (jobRegistration, bool) {
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+	registration, ok := q.handlers[jobType]
+	return registration, ok
+}
+
+// types returns the registered job types, which are the ones this instance claims
+func (q *JobQueue) types() []string {
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+
+	types := make([]string, 0, len(q.handlers))
+	for jobType := range q.handlers {
+		types = append(types, jobType)
+	}
+	sort.Strings(types)
+	return types
+}
+
+// JobMetrics counts the attempts run by a job queue
+type JobMetrics struct {
+	attempts    atomic.Int64
+	succeeded   atomic.Int64
+	retried     atomic.Int64
+	failed      atomic.Int64
+	interrupted atomic.Int64
+	leasesLost  atomic.Int64
+}
+
+// JobMetricsSnapshot is a point-in-time copy of job queue metrics
+type JobMetricsSnapshot struct {
+	Attempts    int64 `json:"attempts"`
+	Succeeded   int64 `json:"succeeded"`
+	Retried     int64 `json:"retried"` // Failed attempts queued for another one
+	Failed      int64 `json:"failed"`  // Jobs out of attempts
+	Interrupted int64 `json:"interrupted"`
+	LeasesLost  int64 `json:"leases_lost"` // Attempts whose job was taken over by another worker
+}
+
+// NewJobMetrics creates empty job queue metrics
+func NewJobMetrics() *JobMetrics {
+	return &JobMetrics{}
+}
+
+// Snapshot returns the current metrics
+func (m *JobMetrics) Snapshot() JobMetricsSnapshot {
+	return JobMetricsSnapshot{
+		Attempts:    m.attempts.Load(),
+		Succeeded:   m.succeeded.Load(),
+		Retried:     m.retried.Load(),
+		Failed:      m.failed.Load(),
+		Interrupted: m.interrupted.Load(),
+		LeasesLost:  m.leasesLost.Load(),
+	}
+}
+
+// String renders the metrics as JSON for expvar
+func (m *JobMetrics) String() string {
+	data, _ := json.Marshal(m.Snapshot())
+	return string(data)
+}
+
+type jobUseCase struct {
+	jobRepo     repository.JobRepository
+	retention   time.Duration
+	valueDating *ValueDatingPolicy
+	logger      infra.Logger
+	mapper      *dto.JobMapper
+}
+
+// NewJobUseCase creates a new job use case keeping finished jobs for retention
+func NewJobUseCase(jobRepo repository.JobRepository, retention time.Duration, valueDating *ValueDatingPolicy, logger infra.Logger) JobUseCase {
+	return &jobUseCase{
+		jobRepo:     jobRepo,
+		retention:   retention,
+		valueDating: valueDating,
+		logger:      logger,
+		mapper:      &dto.JobMapper{},
+	}
+}
+
+// GetJob retrieves a job by ID
+func (uc *jobUseCase) GetJob(ctx context.Context, id string) (*dto.JobResponse, error) {
+	job, err := uc.jobRepo.GetByID(ctx, id)
+	if err != nil {
+		uc.logger.Error("Failed to get job", "error", err, "jobID", id)
+		return nil, err
+	}
+
+	response := uc.mapper.ToResponse(job)
+	return &response, nil
+}
+
+// ListJobs retrieves jobs with pagination, newest first, optionally of one type or status
+func (uc *jobUseCase) ListJobs(ctx context.Context, req dto.JobListRequest) (*dto.JobListResponse, error) {
+	filter := repository.JobFilter{Type: req.Type, Status: vo.JobStatus(req.Status)}
+	offset := (req.Page - 1) * req.PageSize
+
+	jobs, err := uc.jobRepo.List(ctx, filter, req.PageSize, offset)
+	if err != nil {
+		uc.logger.Error("Failed to list jobs", "error", err)
+		return nil, err
+	}
+
+	total, err := uc.jobRepo.Count(ctx, filter)
+	if err != nil {
+		uc.logger.Error("Failed to count jobs", "error", err)
+		return nil, err
+	}
+
+	pagination := dto.NewPaginationInfo(req.Page, req.PageSize, total)
+
+	response := uc.mapper.ToResponseList(jobs, pagination)
+	return &response, nil
+}
+
+// RetryJob queues a failed job again with a fresh set of attempts
+func (uc *jobUseCase) RetryJob(ctx context.Context, id string) (*dto.JobResponse, error) {
+	job, err := uc.jobRepo.GetByID(ctx, id)
+	if err != nil {
+		uc.logger.Error("Failed to get job", "error", err, "jobID", id)
+		return nil, err
+	}
+
+	if err := job.Retry(uc.valueDating.Now()); err != nil {
+		return nil, err
+	}
+
+	if err := uc.jobRepo.Update(ctx, job); err != nil {
+		uc.logger.Error("Failed to update job", "error", err, "jobID", id)
+		return nil, err
+	}
+
+	uc.logger.Info("Job queued for retry", "jobID", id, "type", job.Type)
+	response := uc.mapper.ToResponse(job)
+	return &response, nil
+}
+
+// PurgeFinished deletes the jobs that finished longer ago than the retention period
+func (uc *jobUseCase) PurgeFinished(ctx context.Context) (int64, error) {
+	cutoff := uc.valueDating.Now().Add(-uc.retention)
+
+	purged, err := uc.jobRepo.DeleteFinishedBefore(ctx, cutoff)
+	if err != nil {
+		uc.logger.Error("Failed to purge finished jobs", "error", err, "cutoff", cutoff)
+		return 0, err
+	}
+
+	if purged > 0 {
+		uc.logger.Info("Finished jobs purged", "count", purged, "cutoff", cutoff)
+	}
+	return purged, nil
+}
@@ -0,0 +1,295 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/application/dto"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/repository"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type MockJobRepository struct {
+	mock.Mock
+}
+
+func (m *MockJobRepository) Enqueue(ctx context.Context, job *entity.Job) (bool, error) {
+	args := m.Called(ctx, job)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockJobRepository) ClaimNext(ctx context.Context, types []string, workerID string, now, leaseUntil time.Time) (*entity.Job, error) {
+	args := m.Called(ctx, types, workerID, now, leaseUntil)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.Job), args.Error(1)
+}
+
+func (m *MockJobRepository) ExtendLease(ctx context.Context, id, workerID string, leaseUntil time.Time) error {
+	args := m.Called(ctx, id, workerID, leaseUntil)
+	return args.Error(0)
+}
+
+func (m *MockJobRepository) Finish(ctx context.Context, job *entity.Job) error {
+	args := m.Called(ctx, job)
+	return args.Error(0)
+}
+
+func (m *MockJobRepository) Update(ctx context.Context, job *entity.Job) error {
+	args := m.Called(ctx, job)
+	return args.Error(0)
+}
+
+func (m *MockJobRepository) GetByID(ctx context.Context, id string) (*entity.Job, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.Job), args.Error(1)
+}
+
+func (m *MockJobRepository) List(ctx context.Context, filter repository.JobFilter, limit, offset int) ([]*entity.Job, error) {
+	args := m.Called(ctx, filter, limit, offset)
+	return args.Get(0).([]*entity.Job), args.Error(1)
+}
+
+func (m *MockJobRepository) Count(ctx context.Context, filter repository.JobFilter) (int64, error) {
+	args := m.Called(ctx, filter)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockJobRepository) DeleteFinishedBefore(ctx context.Context, cutoff time.Time) (int64, error) {
+	args := m.Called(ctx, cutoff)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+var testJobQueueConfig = JobQueueConfig{
+	Workers:        1,
+	PollInterval:   10 * time.Millisecond,
+	Lease:          time.Minute,
+	MaxAttempts:    3,
+	RetryBaseDelay: time.Second,
+	RetryMaxDelay:  time.Minute,
+}
+
+// runQueuedJob runs a job the way a worker would, through the handler registered for its type
+func runQueuedJob(t *testing.T, queue *JobQueue, job *entity.Job) error {
+	registration, ok := queue.registration(job.Type)
+	require.True(t, ok, "no handler registered for %s", job.Type)
+	require.NoError(t, job.Start("test-worker", time.Now(), time.Now().Add(time.Minute)))
+	return registration.handler(context.Background(), job)
+}
+
+func TestJobQueue_ProcessRetriesWithBackoff(t *testing.T) {
+	mockJobRepo := new(MockJobRepository)
+	mockLogger := new(MockLogger)
+	mockLogger.On("Info", mock.Anything, mock.Anything).Maybe()
+	mockLogger.On("Warn", mock.Anything, mock.Anything).Maybe()
+	mockLogger.On("Error", mock.Anything, mock.Anything).Maybe()
+
+	var finished []entity.Job
+	mockJobRepo.On("Finish", mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) { finished = append(finished, *args.Get(1).(*entity.Job)) }).
+		Return(nil)
+
+	queue := NewJobQueue(mockJobRepo, testJobQueueConfig, mockLogger)
+	calls := 0
+	queue.Register("statement.generate", func(ctx context.Context, job *entity.Job) error {
+		calls++
+		if calls < 3 {
+			return errors.New("statement store unavailable")
+		}
+		return nil
+	}, JobOptions{})
+
+	job, err := entity.NewJob("statement.generate", nil, 3, time.Now())
+	require.NoError(t, err)
+
+	for attempt := 1; attempt <= 3; attempt++ {
+		// Workers claim the job once its retry is due
+		require.NoError(t, job.Start("worker-1", job.RunAt, job.RunAt.Add(time.Minute)))
+		started := time.Now()
+		queue.process(context.Background(), job)
+
+		last := finished[len(finished)-1]
+		if attempt < 3 {
+			// Backoff doubles from the base delay
+			assert.Equal(t, vo.JobStatusQueued, last.Status)
+			assert.WithinDuration(t, started.Add(time.Duration(1<<(attempt-1))*time.Second), last.RunAt, 100*time.Millisecond)
+			assert.Equal(t, "statement store unavailable", last.LastError)
+		} else {
+			assert.Equal(t, vo.JobStatusSucceeded, last.Status)
+		}
+	}
+
+	assert.Equal(t, JobMetricsSnapshot{Attempts: 3, Succeeded: 1, Retried: 2}, queue.Metrics().Snapshot())
+}
+
+func TestJobQueue_ProcessFailsAbandonedLastAttempt(t *testing.T) {
+	mockJobRepo := new(MockJobRepository)
+	mockLogger := new(MockLogger)
+	mockLogger.On("Error", mock.Anything, mock.Anything).Maybe()
+	mockJobRepo.On("Finish", mock.Anything, mock.Anything).Return(nil)
+
+	queue := NewJobQueue(mockJobRepo, testJobQueueConfig, mockLogger)
+	queue.Register("export.run", func(ctx context.Context, job *entity.Job) error {
+		t.Fatal("an abandoned last attempt must not run again")
+		return nil
+	}, JobOptions{MaxAttempts: 1})
+
+	job, err := entity.NewJob("export.run", nil, 1, time.Now())
+	require.NoError(t, err)
+	now := time.Now()
+	require.NoError(t, job.Start("worker-1", now, now))
+	require.NoError(t, job.Start("worker-2", now, now.Add(time.Minute)))
+
+	queue.process(context.Background(), job)
+
+	assert.Equal(t, vo.JobStatusFailed, job.Status)
+	assert.Contains(t, job.LastError, "abandoned")
+}
+
+func TestJobQueue_ProcessInterruptedByShutdown(t *testing.T) {
+	mockJobRepo := new(MockJobRepository)
+	mockLogger := new(MockLogger)
+	mockLogger.On("Info", mock.Anything, mock.Anything).Maybe()
+	mockJobRepo.On("Finish", mock.Anything, mock.Anything).Return(nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	queue := NewJobQueue(mockJobRepo, testJobQueueConfig, mockLogger)
+	queue.Register("backup.run", func(ctx context.Context, job *entity.Job) error {
+		cancel()
+		<-ctx.Done()
+		return ctx.Err()
+	}, JobOptions{})
+
+	job, err := entity.NewJob("backup.run", nil, 3, time.Now())
+	require.NoError(t, err)
+	require.NoError(t, job.Start("worker-1", time.Now(), time.Now().Add(time.Minute)))
+
+	queue.process(ctx, job)
+
+	// The attempt does not count, and the outcome is stored despite the cancelled context
+	assert.Equal(t, vo.JobStatusQueued, job.Status)
+	assert.Equal(t, 0, job.Attempts)
+	mockJobRepo.AssertCalled(t, "Finish", mock.Anything, job)
+}
+
+func TestJobQueue_EnqueueScheduledOncePerSlot(t *testing.T) {
+	mockJobRepo := new(MockJobRepository)
+	var keys []string
+	mockJobRepo.On("Enqueue", mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) { keys = append(keys, args.Get(1).(*entity.Job).UniqueKey) }).
+		Return(true, nil)
+
+	queue := NewJobQueue(mockJobRepo, testJobQueueConfig, new(MockLogger))
+	queue.Schedule(JobTypeReviewSweep, time.Minute, func(ctx context.Context, job *entity.Job) error { return nil })
+
+	slot := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+	queue.enqueueScheduled(context.Background(), slot.Add(5*time.Second))
+	queue.enqueueScheduled(context.Background(), slot.Add(50*time.Second))
+	queue.enqueueScheduled(context.Background(), slot.Add(65*time.Second))
+
+	assert.Equal(t, []string{"review.sweep@2024-01-01T10:00:00Z", "review.sweep@2024-01-01T10:01:00Z"}, keys)
+}
+
+func TestJobQueue_RunClaimsAndRunsDueJobs(t *testing.T) {
+	job, err := entity.NewJob("statement.generate", nil, 3, time.Now())
+	require.NoError(t, err)
+
+	mockJobRepo := new(MockJobRepository)
+	mockLogger := new(MockLogger)
+	mockLogger.On("Info", mock.Anything, mock.Anything).Maybe()
+	mockJobRepo.On("ClaimNext", mock.Anything, []string{"statement.generate"}, mock.Anything, mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			require.NoError(t, job.Start(args.String(2), args.Get(3).(time.Time), args.Get(4).(time.Time)))
+		}).
+		Return(job, nil).Once()
+	mockJobRepo.On("ClaimNext", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, nil)
+	mockJobRepo.On("Finish", mock.Anything, job).Return(nil)
+
+	ran := make(chan string, 1)
+	queue := NewJobQueue(mockJobRepo, testJobQueueConfig, mockLogger)
+	queue.Register("statement.generate", func(ctx context.Context, job *entity.Job) error {
+		ran <- job.ID
+		return nil
+	}, JobOptions{})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stopped := make(chan struct{})
+	go func() {
+		queue.Run(ctx)
+		close(stopped)
+	}()
+
+	select {
+	case id := <-ran:
+		assert.Equal(t, job.ID, id)
+	case <-time.After(5 * time.Second):
+		t.Fatal("job did not run")
+	}
+
+	cancel()
+	<-stopped
+	assert.Equal(t, vo.JobStatusSucceeded, job.Status)
+}
+
+func TestJobUseCase_RetryJob(t *testing.T) {
+	now := time.Now()
+	failed, err := entity.NewJob(JobTypeExport, nil, 1, now)
+	require.NoError(t, err)
+	require.NoError(t, failed.Start("worker-1", now, now.Add(time.Minute)))
+	require.NoError(t, failed.MarkFailed("storage unavailable", now))
+	succeeded, err := entity.NewJob(JobTypeExport, nil, 1, now)
+	require.NoError(t, err)
+	require.NoError(t, succeeded.Start("worker-1", now, now.Add(time.Minute)))
+	require.NoError(t, succeeded.MarkSucceeded())
+
+	mockJobRepo := new(MockJobRepository)
+	mockJobRepo.On("GetByID", mock.Anything, failed.ID).Return(failed, nil)
+	mockJobRepo.On("GetByID", mock.Anything, succeeded.ID).Return(succeeded, nil)
+	mockJobRepo.On("GetByID", mock.Anything, "JOB404").Return(nil, errs.ErrJobNotFound)
+	mockJobRepo.On("Update", mock.Anything, failed).Return(nil)
+	mockLogger := new(MockLogger)
+	mockLogger.On("Info", mock.Anything, mock.Anything).Maybe()
+	mockLogger.On("Error", mock.Anything, mock.Anything).Maybe()
+
+	uc := NewJobUseCase(mockJobRepo, 7*24*time.Hour, NewValueDatingPolicy(&StubCalendar{}, vo.ProcessingWindow{}, nil), mockLogger)
+
+	response, err := uc.RetryJob(context.Background(), failed.ID)
+	require.NoError(t, err)
+	assert.Equal(t, string(vo.JobStatusQueued), response.Status)
+	assert.Equal(t, 0, response.Attempts)
+
+	_, err = uc.RetryJob(context.Background(), succeeded.ID)
+	assert.ErrorIs(t, err, errs.ErrJobNotRetryable)
+	_, err = uc.RetryJob(context.Background(), "JOB404")
+	assert.ErrorIs(t, err, errs.ErrJobNotFound)
+	mockJobRepo.AssertNumberOfCalls(t, "Update", 1)
+}
+
+func TestJobUseCase_ListJobs(t *testing.T) {
+	job, err := entity.NewJob(JobTypeBackup, map[string]string{"backup_id": "BKP1"}, 3, time.Now())
+	require.NoError(t, err)
+
+	filter := repository.JobFilter{Type: JobTypeBackup, Status: vo.JobStatusQueued}
+	mockJobRepo := new(MockJobRepository)
+	mockJobRepo.On("List", mock.Anything, filter, 10, 10).Return([]*entity.Job{job}, nil)
+	mockJobRepo.On("Count", mock.Anything, filter).Return(int64(11), nil)
+
+	uc := NewJobUseCase(mockJobRepo, time.Hour, NewValueDatingPolicy(&StubCalendar{}, vo.ProcessingWindow{}, nil), new(MockLogger))
+	response, err := uc.ListJobs(context.Background(), dto.JobListRequest{Page: 2, PageSize: 10, Type: JobTypeBackup, Status: "QUEUED"})
+
+	require.NoError(t, err)
+	require.Len(t, response.Jobs, 1)
+	assert.JSONEq(t, `{"backup_id":"BKP1"}`, string(response.Jobs[0].Payload))
+	assert.Equal(t, int64(11), response.Pagination.TotalItems)
+}
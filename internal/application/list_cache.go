@@ -0,0 +1,263 @@
+// internal/application/list_cache.go
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/domain/infra"
+)
+
+// List endpoints whose pages are cached with stale-while-revalidate
+const (
+	ListEndpointAccounts             = "accounts"
+	ListEndpointTransactions         = "transactions"
+	ListEndpointAccountTransactions  = "account_transactions"
+	ListEndpointTransactionsByStatus = "transactions_by_status"
+)
+
+// listRefreshTimeout bounds a background refresh, which no longer has a request to time it out
+const listRefreshTimeout = 10 * time.Second
+
+// ListCachePolicy tells how long a cached list page is served
+type ListCachePolicy struct {
+	SoftTTL time.Duration // Older pages are still served, but refreshed in the background; 0 never refreshes early
+	HardTTL time.Duration // Pages are dropped from the cache after this
+}
+
+// DefaultListCachePolicies returns the list cache policy of every list endpoint
+func DefaultListCachePolicies() map[string]ListCachePolicy {
+	return map[string]ListCachePolicy{
+		ListEndpointAccounts:             {SoftTTL: time.Minute, HardTTL: 5 * time.Minute},
+		ListEndpointTransactions:         {SoftTTL: 30 * time.Second, HardTTL: 2 * time.Minute},
+		ListEndpointAccountTransactions:  {SoftTTL: time.Minute, HardTTL: 5 * time.Minute},
+		ListEndpointTransactionsByStatus: {SoftTTL: time.Minute, HardTTL: 5 * time.Minute},
+	}
+}
+
+// ParseListCachePolicies overrides the default policies with ENDPOINT:SOFT_SECONDS:HARD_SECONDS
+// entries, e.g. "transactions:15:120"
+func ParseListCachePolicies(entries []string) (map[string]ListCachePolicy, error) {
+	policies := DefaultListCachePolicies()
+	for _, entry := range entries {
+		parts := strings.Split(strings.TrimSpace(entry), ":")
+		if len(parts) != 3 {
+			return nil, fmt.Errorf("invalid list cache policy %q, expected ENDPOINT:SOFT_SECONDS:HARD_SECONDS", entry)
+		}
+
+		endpoint := parts[0]
+		if _, ok := policies[endpoint]; !ok {
+			return nil, fmt.Errorf("unknown list endpoint %q", endpoint)
+		}
+
+		soft, err := strconv.Atoi(parts[1])
+		if err != nil || soft < 0 {
+			return nil, fmt.Errorf("invalid soft TTL in list cache policy %q", entry)
+		}
+		hard, err := strconv.Atoi(parts[2])
+		if err != nil || hard <= 0 {
+			return nil, fmt.Errorf("invalid hard TTL in list cache policy %q", entry)
+		}
+		if soft >= hard {
+			return nil, fmt.Errorf("soft TTL must be below the hard TTL in list cache policy %q", entry)
+		}
+
+		policies[endpoint] = ListCachePolicy{SoftTTL: time.Duration(soft) * time.Second, HardTTL: time.Duration(hard) * time.Second}
+	}
+	return policies, nil
+}
+
+// listCacheEntry is a cached list page with the time it was loaded
+type listCacheEntry[T any] struct {
+	Value    T         `json:"value"`
+	StoredAt time.Time `json:"stored_at"`
+}
+
+// ListCache caches list pages with stale-while-revalidate: a page older than its endpoint's soft TTL
+// is served at once while one background refresh per page reloads it, so a heavy list query never
+// makes a caller wait once its page has been cached
+type ListCache struct {
+	cache      infra.CacheService
+	policies   map[string]ListCachePolicy
+	metrics    *ListCacheMetrics
+	refreshing sync.Map // Keys with a refresh running in this process
+	logger     infra.Logger
+}
+
+// NewListCache creates a list cache; endpoints without a policy are cached with their default one
+func NewListCache(cache infra.CacheService, policies map[string]ListCachePolicy, logger infra.Logger) *ListCache {
+	merged := DefaultListCachePolicies()
+	for endpoint, policy := range policies {
+		merged[endpoint] = policy
+	}
+
+	return &ListCache{
+		cache:    cache,
+		policies: merged,
+		metrics:  NewListCacheMetrics(),
+		logger:   logger,
+	}
+}
+
+// Metrics returns the list cache metrics
+func (c *ListCache) Metrics() *ListCacheMetrics {
+	return c.metrics
+}
+
+// loadList returns the cached page under key, loading and caching it on a miss. A page past its
+// soft TTL is returned as-is and refreshed in the background.
+func loadList[T any](ctx context.Context, c *ListCache, endpoint, key string, load func(ctx context.Context) (T, error)) (T, error) {
+	policy := c.policies[endpoint]
+
+	var entry listCacheEntry[T]
+	err := c.cache.Get(ctx, key, &entry)
+	// Pages cached before stale-while-revalidate have no load time and are loaded again
+	if err == nil && !entry.StoredAt.IsZero() {
+		age := time.Since(entry.StoredAt)
+		if policy.SoftTTL <= 0 || age < policy.SoftTTL {
+			c.metrics.endpoint(endpoint).fresh.Add(1)
+			return entry.Value, nil
+		}
+
+		c.metrics.endpoint(endpoint).recordStale(age - policy.SoftTTL)
+		c.refreshInBackground(ctx, endpoint, key, policy, func(ctx context.Context) (any, error) { return load(ctx) })
+		return entry.Value, nil
+	}
+	if err != nil && !errors.Is(err, infra.ErrCacheMiss) {
+		c.logger.Warn("Failed to read cached list", "error", err, "key", key)
+	}
+
+	c.metrics.endpoint(endpoint).misses.Add(1)
+	value, err := load(ctx)
+	if err != nil {
+		return value, err
+	}
+	c.store(ctx, key, policy, value)
+	return value, nil
+}
+
+// refreshInBackground reloads a page unless a refresh of it is already running in this process
+func (c *ListCache) refreshInBackground(ctx context.Context, endpoint, key string, policy ListCachePolicy, load func(ctx context.Context) (any, error)) {
+	if _, running := c.refreshing.LoadOrStore(key, struct{}{}); running {
+		return
+	}
+
+	// The refresh outlives the request that noticed the page was stale
+	refreshCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), listRefreshTimeout)
+	go func() {
+		defer cancel()
+		defer c.refreshing.Delete(key)
+
+		metrics := c.metrics.endpoint(endpoint)
+		metrics.refreshes.Add(1)
+		value, err := load(refreshCtx)
+		if err != nil {
+			metrics.refreshFailures.Add(1)
+			c.logger.Warn("Failed to refresh cached list", "error", err, "key", key)
+			return
+		}
+		c.store(refreshCtx, key, policy, value)
+	}()
+}
+
+func (c *ListCache) store(ctx context.Context, key string, policy ListCachePolicy, value any) {
+	entry := listCacheEntry[any]{Value: value, StoredAt: time.Now()}
+	if err := c.cache.Set(ctx, key, entry, policy.HardTTL); err != nil {
+		c.logger.Warn("Failed to cache list", "error", err, "key", key)
+	}
+}
+
+// ListCacheMetrics counts list cache reads per endpoint. It is an expvar.Var, so it can be published as-is.
+type ListCacheMetrics struct {
+	mu        sync.Mutex
+	endpoints map[string]*listEndpointMetrics
+}
+
+type listEndpointMetrics struct {
+	fresh           atomic.Int64
+	stale           atomic.Int64
+	misses          atomic.Int64
+	refreshes       atomic.Int64
+	refreshFailures atomic.Int64
+	stalenessNanos  atomic.Int64
+	maxStaleness    atomic.Int64
+}
+
+// ListEndpointSnapshot is a point-in-time copy of one endpoint's list cache metrics
+type ListEndpointSnapshot struct {
+	Fresh               int64   `json:"fresh"`
+	Stale               int64   `json:"stale"` // Pages served past their soft TTL
+	Misses              int64   `json:"misses"`
+	Refreshes           int64   `json:"refreshes"`
+	RefreshFailures     int64   `json:"refresh_failures"`
+	AvgStalenessSeconds float64 `json:"avg_staleness_seconds"` // Time past the soft TTL of stale pages served
+	MaxStalenessSeconds float64 `json:"max_staleness_seconds"`
+	StaleRatio          float64 `json:"stale_ratio"`
+}
+
+// NewListCacheMetrics creates empty list cache metrics
+func NewListCacheMetrics() *ListCacheMetrics {
+	return &ListCacheMetrics{endpoints: make(map[string]*listEndpointMetrics)}
+}
+
+// Snapshot returns the current metrics per endpoint
+func (m *ListCacheMetrics) Snapshot() map[string]ListEndpointSnapshot {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	snapshots := make(map[string]ListEndpointSnapshot, len(m.endpoints))
+	for endpoint, metrics := range m.endpoints {
+		snapshot := ListEndpointSnapshot{
+			Fresh:               metrics.fresh.Load(),
+			Stale:               metrics.stale.Load(),
+			Misses:              metrics.misses.Load(),
+			Refreshes:           metrics.refreshes.Load(),
+			RefreshFailures:     metrics.refreshFailures.Load(),
+			MaxStalenessSeconds: time.Duration(metrics.maxStaleness.Load()).Seconds(),
+		}
+		if snapshot.Stale > 0 {
+			snapshot.AvgStalenessSeconds = time.Duration(metrics.stalenessNanos.Load() / snapshot.Stale).Seconds()
+		}
+		if reads := snapshot.Fresh + snapshot.Stale + snapshot.Misses; reads > 0 {
+			snapshot.StaleRatio = float64(snapshot.Stale) / float64(reads)
+		}
+		snapshots[endpoint] = snapshot
+	}
+	return snapshots
+}
+
+// String renders the metrics as JSON for expvar
+func (m *ListCacheMetrics) String() string {
+	data, _ := json.Marshal(m.Snapshot())
+	return string(data)
+}
+
+func (m *ListCacheMetrics) endpoint(endpoint string) *listEndpointMetrics {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	metrics, ok := m.endpoints[endpoint]
+	if !ok {
+		metrics = &listEndpointMetrics{}
+		m.endpoints[endpoint] = metrics
+	}
+	return metrics
+}
+
+func (m *listEndpointMetrics) recordStale(staleness time.Duration) {
+	m.stale.Add(1)
+	m.stalenessNanos.Add(int64(staleness))
+	for {
+		current := m.maxStaleness.Load()
+		if int64(staleness) <= current || m.maxStaleness.CompareAndSwap(current, int64(staleness)) {
+			return
+		}
+	}
+}
@@ -0,0 +1,101 @@
+package usecase
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/domain/infra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// cachedPage makes the mock cache return a page loaded age ago
+func cachedPage(value []string, age time.Duration) func(mock.Arguments) {
+	return func(args mock.Arguments) {
+		entry := args.Get(2).(*listCacheEntry[[]string])
+		entry.Value = value
+		entry.StoredAt = time.Now().Add(-age)
+	}
+}
+
+func TestLoadList(t *testing.T) {
+	policies := map[string]ListCachePolicy{ListEndpointAccounts: {SoftTTL: time.Minute, HardTTL: 5 * time.Minute}}
+
+	t.Run("fresh page is served from cache", func(t *testing.T) {
+		cache := new(MockCacheService)
+		cache.On("Get", mock.Anything, "accounts:list", mock.Anything).Run(cachedPage([]string{"cached"}, 10*time.Second)).Return(nil)
+		lists := NewListCache(cache, policies, new(MockLogger))
+
+		page, err := loadList(context.Background(), lists, ListEndpointAccounts, "accounts:list", func(ctx context.Context) ([]string, error) {
+			t.Fatal("fresh page must not be reloaded")
+			return nil, nil
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"cached"}, page)
+		assert.Equal(t, int64(1), lists.Metrics().Snapshot()[ListEndpointAccounts].Fresh)
+	})
+
+	t.Run("stale page is served and refreshed in the background", func(t *testing.T) {
+		cache := new(MockCacheService)
+		cache.On("Get", mock.Anything, "accounts:list", mock.Anything).Run(cachedPage([]string{"cached"}, 2*time.Minute)).Return(nil)
+		refreshed := make(chan interface{}, 1)
+		cache.On("Set", mock.Anything, "accounts:list", mock.Anything, 5*time.Minute).Run(func(args mock.Arguments) {
+			refreshed <- args.Get(2)
+		}).Return(nil)
+		lists := NewListCache(cache, policies, new(MockLogger))
+
+		var loads atomic.Int32
+		page, err := loadList(context.Background(), lists, ListEndpointAccounts, "accounts:list", func(ctx context.Context) ([]string, error) {
+			loads.Add(1)
+			return []string{"reloaded"}, nil
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"cached"}, page)
+
+		select {
+		case stored := <-refreshed:
+			assert.Equal(t, []string{"reloaded"}, stored.(listCacheEntry[any]).Value)
+		case <-time.After(time.Second):
+			t.Fatal("stale page was not refreshed")
+		}
+		assert.Equal(t, int32(1), loads.Load())
+
+		snapshot := lists.Metrics().Snapshot()[ListEndpointAccounts]
+		assert.Equal(t, int64(1), snapshot.Stale)
+		assert.Equal(t, int64(1), snapshot.Refreshes)
+		assert.InDelta(t, 60, snapshot.MaxStalenessSeconds, 1)
+	})
+
+	t.Run("missing page is loaded and cached", func(t *testing.T) {
+		cache := new(MockCacheService)
+		cache.On("Get", mock.Anything, "accounts:list", mock.Anything).Return(infra.ErrCacheMiss)
+		cache.On("Set", mock.Anything, "accounts:list", mock.Anything, 5*time.Minute).Return(nil)
+		lists := NewListCache(cache, policies, new(MockLogger))
+
+		page, err := loadList(context.Background(), lists, ListEndpointAccounts, "accounts:list", func(ctx context.Context) ([]string, error) {
+			return []string{"loaded"}, nil
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"loaded"}, page)
+		cache.AssertExpectations(t)
+		assert.Equal(t, int64(1), lists.Metrics().Snapshot()[ListEndpointAccounts].Misses)
+	})
+}
+
+func TestParseListCachePolicies(t *testing.T) {
+	policies, err := ParseListCachePolicies([]string{"transactions:15:120"})
+	require.NoError(t, err)
+	assert.Equal(t, ListCachePolicy{SoftTTL: 15 * time.Second, HardTTL: 2 * time.Minute}, policies[ListEndpointTransactions])
+	assert.Equal(t, DefaultListCachePolicies()[ListEndpointAccounts], policies[ListEndpointAccounts])
+
+	for _, entry := range []string{"transactions:15", "ledgers:15:120", "transactions:120:15", "transactions:15:0"} {
+		_, err := ParseListCachePolicies([]string{entry})
+		assert.Error(t, err, entry)
+	}
+}
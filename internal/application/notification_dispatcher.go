@@ -0,0 +1,213 @@
+// internal/application/notification_dispatcher.go
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/event"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/infra"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/repository"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+)
+
+// NotificationDispatcher notifies customers of the events they chose in their preferences,
+// rendering the latest template for each channel in the customer's locale, or in the default
+// locale when there is none. It wraps the event publisher so each event is notified once, by
+// the instance that raised it.
+type NotificationDispatcher struct {
+	preferenceRepo repository.NotificationPreferenceRepository
+	templateRepo   repository.NotificationTemplateRepository
+	accountRepo    repository.AccountRepository
+	sender         infra.NotificationSender
+	next           infra.EventPublisher
+	logger         infra.Logger
+}
+
+// NewNotificationDispatcher creates a notification dispatcher publishing through next
+func NewNotificationDispatcher(
+	preferenceRepo repository.NotificationPreferenceRepository,
+	templateRepo repository.NotificationTemplateRepository,
+	accountRepo repository.AccountRepository,
+	sender infra.NotificationSender,
+	next infra.EventPublisher,
+	logger infra.Logger,
+) *NotificationDispatcher {
+	return &NotificationDispatcher{
+		preferenceRepo: preferenceRepo,
+		templateRepo:   templateRepo,
+		accountRepo:    accountRepo,
+		sender:         sender,
+		next:           next,
+		logger:         logger,
+	}
+}
+
+// Publish forwards the event and notifies customers of it in the background
+func (d *NotificationDispatcher) Publish(ctx context.Context, evt event.Event) error {
+	err := d.next.Publish(ctx, evt)
+
+	if _, ok := notificationSamples[evt.Type]; ok {
+		go d.dispatch(context.WithoutCancel(ctx), evt)
+	}
+
+	return err
+}
+
+// dispatch notifies the customers the event concerns
+func (d *NotificationDispatcher) dispatch(ctx context.Context, evt event.Event) {
+	customerIDs, amount, err := d.notifiedCustomers(ctx, evt)
+	if err != nil {
+		d.logger.Warn("Failed to decode event for notifications", "error", err, "eventID", evt.ID)
+		return
+	}
+
+	data, err := notificationData(evt.Data)
+	if err != nil {
+		d.logger.Warn("Failed to decode event data for notifications", "error", err, "eventID", evt.ID)
+		return
+	}
+
+	for _, customerID := range customerIDs {
+		d.notify(ctx, customerID, evt, amount, data)
+	}
+}
+
+// notifiedCustomers returns the customers who hear of an event, each once, and the amount preferences'
+// minimum applies to. Both parties hear of an ownership transfer, whichever of them owns the account
+// at the time; other events go to the owners of the accounts they concern.
+func (d *NotificationDispatcher) notifiedCustomers(ctx context.Context, evt event.Event) ([]string, *vo.Money, error) {
+	if evt.Type == event.OwnershipTransferApproved || evt.Type == event.OwnershipTransferred {
+		payload, err := evt.DecodeOwnershipTransfer()
+		if err != nil {
+			return nil, nil, err
+		}
+		return []string{payload.FromCustomerID, payload.ToCustomerID}, nil, nil
+	}
+
+	accountIDs, amount, err := notifiedAccounts(evt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var customerIDs []string
+	notified := make(map[string]bool)
+	for _, accountID := range accountIDs {
+		account, err := d.accountRepo.GetByID(ctx, accountID)
+		if err != nil {
+			d.logger.Warn("Failed to load account for notifications", "error", err, "accountID", accountID.String())
+			continue
+		}
+		if account.CustomerID == "" || notified[account.CustomerID] {
+			continue
+		}
+		notified[account.CustomerID] = true
+		customerIDs = append(customerIDs, account.CustomerID)
+	}
+	return customerIDs, amount, nil
+}
+
+// notify sends the event to a customer on every channel their preferences allow right now
+func (d *NotificationDispatcher) notify(ctx context.Context, customerID string, evt event.Event, amount *vo.Money, data map[string]interface{}) {
+	preference, err := d.preferenceRepo.GetByCustomerID(ctx, customerID)
+	if err != nil {
+		if !errors.Is(err, errs.ErrNotificationPreferenceNotFound) {
+			d.logger.Warn("Failed to load notification preferences", "error", err, "customerID", customerID)
+		}
+		return
+	}
+
+	for _, channel := range preference.Channels(string(evt.Type), amount, evt.OccurredAt) {
+		template, err := d.template(ctx, string(evt.Type), channel, preference.Locale)
+		if err != nil {
+			d.logger.Warn("No notification template for event", "error", err, "event", evt.Type, "channel", channel, "locale", preference.Locale)
+			continue
+		}
+
+		rendered, err := template.Render(data)
+		if err != nil {
+			d.logger.Error("Failed to render notification", "error", err, "event", evt.Type, "channel", channel,
+				"locale", template.Locale, "version", template.Version)
+			continue
+		}
+
+		err = d.sender.Send(ctx, infra.Notification{
+			Channel:   channel,
+			Recipient: preference.Recipients[channel],
+			Subject:   rendered.Subject,
+			Body:      rendered.Body,
+		})
+		if err != nil {
+			d.logger.Warn("Failed to send notification", "error", err, "event", evt.Type, "channel", channel, "customerID", customerID)
+		}
+	}
+}
+
+// template retrieves the latest template for the event and channel in the locale, falling back to the default locale
+func (d *NotificationDispatcher) template(ctx context.Context, eventType string, channel vo.NotificationChannel, locale string) (*entity.NotificationTemplate, error) {
+	template, err := d.templateRepo.GetLatest(ctx, eventType, channel, locale)
+	if errors.Is(err, errs.ErrNotificationTemplateNotFound) && locale != entity.DefaultNotificationLocale {
+		return d.templateRepo.GetLatest(ctx, eventType, channel, entity.DefaultNotificationLocale)
+	}
+	return template, err
+}
+
+// notifiedAccounts returns the accounts whose customers hear of an event and, for transactions,
+// the amount preferences' minimum applies to. The payer hears of every transaction event, the
+// payee only of the money arriving.
+func notifiedAccounts(evt event.Event) ([]vo.AccountID, *vo.Money, error) {
+	var ids []string
+	var amount *vo.Money
+
+	switch evt.Type {
+	case event.AccountStatusChanged:
+		payload, err := evt.DecodeAccount()
+		if err != nil {
+			return nil, nil, err
+		}
+		ids = append(ids, payload.AccountID)
+	case event.BudgetThresholdReached:
+		payload, err := evt.DecodeBudget()
+		if err != nil {
+			return nil, nil, err
+		}
+		ids = append(ids, payload.AccountID)
+	case event.LimitThresholdReached:
+		payload, err := evt.DecodeLimit()
+		if err != nil {
+			return nil, nil, err
+		}
+		ids = append(ids, payload.AccountID)
+	default:
+		payload, err := evt.DecodeTransaction()
+		if err != nil {
+			return nil, nil, err
+		}
+		value, err := vo.NewMoneyFromString(payload.Amount)
+		if err != nil {
+			return nil, nil, err
+		}
+		amount = &value
+		if payload.FromAccountID != nil {
+			ids = append(ids, *payload.FromAccountID)
+		}
+		if payload.ToAccountID != nil && evt.Type == event.TransactionCompleted {
+			ids = append(ids, *payload.ToAccountID)
+		}
+	}
+
+	accountIDs := make([]vo.AccountID, 0, len(ids))
+	for _, id := range ids {
+		accountID, err := vo.NewAccountIDFromString(id)
+		if err != nil {
+			return nil, nil, err
+		}
+		accountIDs = append(accountIDs, accountID)
+	}
+	return accountIDs, amount, nil
+}
+
+// Ensure NotificationDispatcher can stand in for the event publisher it wraps
+var _ infra.EventPublisher = (*NotificationDispatcher)(nil)
@@ -0,0 +1,90 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/event"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/infra"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNotificationDispatcher_Dispatch(t *testing.T) {
+	payer := createTestAccount()
+	require.NoError(t, payer.AssignCustomer("PAYER"))
+	payee := createTestAccount()
+	require.NoError(t, payee.AssignCustomer("PAYEE"))
+
+	transfer, err := entity.NewTransferTransaction(payer.ID, payee.ID, vo.NewMoneyFromFloat(250), "Dinner", "", time.Now())
+	transfer = completedTransaction(t, transfer, err)
+
+	minAmount := vo.NewMoneyFromFloat(1000)
+	smsTemplate, err := entity.NewNotificationTemplate("transaction.completed", vo.NotificationChannelSMS, "en", "", "{{.amount}} sent", "ops", nil)
+	require.NoError(t, err)
+	emailTemplate, err := entity.NewNotificationTemplate("transaction.completed", vo.NotificationChannelEmail, "th", "รับเงิน", "ได้รับ {{.amount}}", "ops", nil)
+	require.NoError(t, err)
+
+	mockPreferenceRepo := new(MockNotificationPreferenceRepository)
+	mockTemplateRepo := new(MockNotificationTemplateRepository)
+	mockAccountRepo := new(MockAccountRepository)
+
+	mockAccountRepo.On("GetByID", mock.Anything, payer.ID).Return(payer, nil)
+	mockAccountRepo.On("GetByID", mock.Anything, payee.ID).Return(payee, nil)
+	mockPreferenceRepo.On("GetByCustomerID", mock.Anything, "PAYER").Return(newTestNotificationPreference(t, "PAYER",
+		map[string][]vo.NotificationChannel{"transaction.completed": {vo.NotificationChannelSMS}}, nil, nil), nil)
+	mockPreferenceRepo.On("GetByCustomerID", mock.Anything, "PAYEE").Return(newTestNotificationPreference(t, "PAYEE",
+		map[string][]vo.NotificationChannel{"transaction.completed": {vo.NotificationChannelEmail}}, nil, nil), nil)
+
+	// The payer's Thai SMS falls back to the English template
+	mockTemplateRepo.On("GetLatest", mock.Anything, "transaction.completed", vo.NotificationChannelSMS, "th").Return(nil, errs.ErrNotificationTemplateNotFound)
+	mockTemplateRepo.On("GetLatest", mock.Anything, "transaction.completed", vo.NotificationChannelSMS, "en").Return(smsTemplate, nil)
+	mockTemplateRepo.On("GetLatest", mock.Anything, "transaction.completed", vo.NotificationChannelEmail, "th").Return(emailTemplate, nil)
+
+	sender := &StubNotificationSender{}
+	dispatcher := NewNotificationDispatcher(mockPreferenceRepo, mockTemplateRepo, mockAccountRepo, sender, &StubEventPublisher{}, new(MockLogger))
+	dispatcher.dispatch(context.Background(), event.NewTransactionEvent(event.TransactionCompleted, transfer))
+
+	assert.Equal(t, []infra.Notification{
+		{Channel: vo.NotificationChannelSMS, Recipient: "+66PAYER", Body: "250 sent"},
+		{Channel: vo.NotificationChannelEmail, Recipient: "PAYEE@example.com", Subject: "รับเงิน", Body: "ได้รับ 250"},
+	}, sender.Notifications)
+
+	// Below the payer's minimum amount, and in their quiet hours, nothing more is sent
+	mockPreferenceRepo.ExpectedCalls = nil
+	mockPreferenceRepo.On("GetByCustomerID", mock.Anything, "PAYER").Return(newTestNotificationPreference(t, "PAYER",
+		map[string][]vo.NotificationChannel{"transaction.completed": {vo.NotificationChannelSMS}}, nil, &minAmount), nil)
+	mockPreferenceRepo.On("GetByCustomerID", mock.Anything, "PAYEE").Return(newTestNotificationPreference(t, "PAYEE",
+		map[string][]vo.NotificationChannel{"transaction.completed": {vo.NotificationChannelSMS}}, &entity.QuietHours{Start: "22:00", End: "07:00"}, nil), nil)
+
+	late := event.NewTransactionEvent(event.TransactionCompleted, transfer)
+	late.OccurredAt = time.Date(2024, 1, 1, 23, 0, 0, 0, time.UTC)
+	dispatcher.dispatch(context.Background(), late)
+
+	assert.Len(t, sender.Notifications, 2)
+}
+
+func TestNotificationDispatcher_PayeeOnlyHearsOfCompletedTransfers(t *testing.T) {
+	payer := createTestAccount()
+	payee := createTestAccount()
+	require.NoError(t, payee.AssignCustomer("PAYEE"))
+
+	transfer, err := entity.NewTransferTransaction(payer.ID, payee.ID, vo.NewMoneyFromFloat(250), "Dinner", "", time.Now())
+	require.NoError(t, err)
+
+	mockAccountRepo := new(MockAccountRepository)
+	mockAccountRepo.On("GetByID", mock.Anything, payer.ID).Return(payer, nil)
+
+	sender := &StubNotificationSender{}
+	dispatcher := NewNotificationDispatcher(new(MockNotificationPreferenceRepository), new(MockNotificationTemplateRepository), mockAccountRepo, sender, &StubEventPublisher{}, new(MockLogger))
+	dispatcher.dispatch(context.Background(), event.NewTransactionEvent(event.TransactionFailed, transfer))
+
+	// The payer belongs to no customer, and the payee is not told of the failure
+	assert.Empty(t, sender.Notifications)
+	mockAccountRepo.AssertNotCalled(t, "GetByID", mock.Anything, payee.ID)
+}
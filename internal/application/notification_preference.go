@@ -0,0 +1,143 @@
+// internal/application/notification_preference.go
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hydr0g3nz/mini_bank/internal/application/dto"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/event"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/infra"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/repository"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+)
+
+type notificationPreferenceUseCase struct {
+	preferenceRepo repository.NotificationPreferenceRepository
+	customerRepo   repository.CustomerRepository
+	logger         infra.Logger
+	mapper         *dto.NotificationPreferenceMapper
+}
+
+// NewNotificationPreferenceUseCase creates a new notification preference use case
+func NewNotificationPreferenceUseCase(
+	preferenceRepo repository.NotificationPreferenceRepository,
+	customerRepo repository.CustomerRepository,
+	logger infra.Logger,
+) NotificationPreferenceUseCase {
+	return &notificationPreferenceUseCase{
+		preferenceRepo: preferenceRepo,
+		customerRepo:   customerRepo,
+		logger:         logger,
+		mapper:         &dto.NotificationPreferenceMapper{},
+	}
+}
+
+// GetPreferences retrieves a customer's notification preferences, the defaults when none are saved
+func (uc *notificationPreferenceUseCase) GetPreferences(ctx context.Context, customerID string) (*dto.NotificationPreferenceResponse, error) {
+	if err := uc.checkCustomer(ctx, customerID); err != nil {
+		return nil, err
+	}
+
+	preference, err := uc.preferenceRepo.GetByCustomerID(ctx, customerID)
+	if errors.Is(err, errs.ErrNotificationPreferenceNotFound) {
+		preference, err = entity.DefaultNotificationPreference(customerID), nil
+	}
+	if err != nil {
+		uc.logger.Error("Failed to get notification preferences", "error", err, "customerID", customerID)
+		return nil, err
+	}
+
+	response := uc.mapper.ToResponse(preference)
+	return &response, nil
+}
+
+// UpdatePreferences replaces a customer's notification preferences
+func (uc *notificationPreferenceUseCase) UpdatePreferences(ctx context.Context, req dto.NotificationPreferenceRequest) (*dto.NotificationPreferenceResponse, error) {
+	if err := uc.checkCustomer(ctx, req.CustomerID); err != nil {
+		return nil, err
+	}
+
+	recipients := make(map[vo.NotificationChannel]string, len(req.Recipients))
+	for name, recipient := range req.Recipients {
+		channel, err := vo.NewNotificationChannel(name)
+		if err != nil {
+			return nil, errs.ValidationError{Field: "recipients", Message: err.Error()}
+		}
+		recipients[channel] = recipient
+	}
+
+	events := make(map[string][]vo.NotificationChannel, len(req.Events))
+	for name, channelNames := range req.Events {
+		if _, ok := notificationSamples[event.Type(name)]; !ok {
+			return nil, errs.ValidationError{
+				Field:   "events",
+				Message: "customers are not notified of event " + name,
+			}
+		}
+		channels := make([]vo.NotificationChannel, len(channelNames))
+		for i, channelName := range channelNames {
+			channel, err := vo.NewNotificationChannel(channelName)
+			if err != nil {
+				return nil, errs.ValidationError{Field: "events", Message: err.Error()}
+			}
+			channels[i] = channel
+		}
+		events[name] = channels
+	}
+
+	var quietHours *entity.QuietHours
+	if req.QuietHours != nil {
+		quietHours = &entity.QuietHours{Start: req.QuietHours.Start, End: req.QuietHours.End}
+	}
+
+	var minAmount *vo.Money
+	if req.MinAmount != nil {
+		amount := req.MinAmount.Money()
+		minAmount = &amount
+	}
+
+	preference, err := entity.NewNotificationPreference(req.CustomerID, req.Locale, req.Timezone, recipients, events, quietHours, minAmount)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.preferenceRepo.Save(ctx, preference); err != nil {
+		uc.logger.Error("Failed to save notification preferences", "error", err, "customerID", req.CustomerID)
+		return nil, err
+	}
+
+	uc.logger.Info("Notification preferences updated", "customerID", req.CustomerID, "events", len(preference.Events))
+	response := uc.mapper.ToResponse(preference)
+	return &response, nil
+}
+
+// ResetPreferences removes a customer's notification preferences, turning notifications off
+func (uc *notificationPreferenceUseCase) ResetPreferences(ctx context.Context, customerID string) error {
+	if err := uc.checkCustomer(ctx, customerID); err != nil {
+		return err
+	}
+
+	if err := uc.preferenceRepo.Delete(ctx, customerID); err != nil {
+		uc.logger.Error("Failed to reset notification preferences", "error", err, "customerID", customerID)
+		return err
+	}
+
+	uc.logger.Info("Notification preferences reset", "customerID", customerID)
+	return nil
+}
+
+// checkCustomer checks the customer holds an account
+func (uc *notificationPreferenceUseCase) checkCustomer(ctx context.Context, customerID string) error {
+	exists, err := uc.customerRepo.Exists(ctx, customerID)
+	if err != nil {
+		uc.logger.Error("Failed to check customer", "error", err, "customerID", customerID)
+		return err
+	}
+	if !exists {
+		return errs.ErrCustomerNotFound
+	}
+	return nil
+}
@@ -0,0 +1,130 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hydr0g3nz/mini_bank/internal/application/dto"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type MockNotificationPreferenceRepository struct {
+	mock.Mock
+}
+
+func (m *MockNotificationPreferenceRepository) Save(ctx context.Context, preference *entity.NotificationPreference) error {
+	args := m.Called(ctx, preference)
+	return args.Error(0)
+}
+
+func (m *MockNotificationPreferenceRepository) GetByCustomerID(ctx context.Context, customerID string) (*entity.NotificationPreference, error) {
+	args := m.Called(ctx, customerID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.NotificationPreference), args.Error(1)
+}
+
+func (m *MockNotificationPreferenceRepository) Delete(ctx context.Context, customerID string) error {
+	args := m.Called(ctx, customerID)
+	return args.Error(0)
+}
+
+func TestNotificationPreferenceUseCase_UpdatePreferences(t *testing.T) {
+	mockPreferenceRepo := new(MockNotificationPreferenceRepository)
+	mockCustomerRepo := new(MockCustomerRepository)
+	mockLogger := new(MockLogger)
+	mockLogger.On("Info", mock.Anything, mock.Anything).Maybe()
+
+	mockCustomerRepo.On("Exists", mock.Anything, "CUST001").Return(true, nil)
+	mockPreferenceRepo.On("Save", mock.Anything, mock.AnythingOfType("*entity.NotificationPreference")).Return(nil)
+
+	uc := NewNotificationPreferenceUseCase(mockPreferenceRepo, mockCustomerRepo, mockLogger)
+
+	minAmount := dto.NewDecimalStringFromFloat(100)
+	result, err := uc.UpdatePreferences(context.Background(), dto.NotificationPreferenceRequest{
+		CustomerID: "CUST001",
+		Timezone:   "Asia/Bangkok",
+		Recipients: map[string]string{"email": "alice@example.com", "PUSH": "device-token"},
+		Events: map[string][]string{
+			"transaction.completed":    {"PUSH", "email"},
+			"budget.threshold_reached": {"EMAIL"},
+		},
+		QuietHours: &dto.QuietHoursRequest{Start: "22:00", End: "07:00"},
+		MinAmount:  &minAmount,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "en", result.Locale)
+	assert.Equal(t, map[string]string{"EMAIL": "alice@example.com", "PUSH": "device-token"}, result.Recipients)
+	assert.Equal(t, []string{"EMAIL", "PUSH"}, result.Events["transaction.completed"])
+	require.NotNil(t, result.MinAmount)
+	assert.Equal(t, 100.0, *result.MinAmount)
+
+	_, err = uc.UpdatePreferences(context.Background(), dto.NotificationPreferenceRequest{
+		CustomerID: "CUST001",
+		Recipients: map[string]string{"EMAIL": "alice@example.com"},
+		Events:     map[string][]string{"account.created": {"EMAIL"}},
+	})
+	assert.IsType(t, errs.ValidationError{}, err, "only events customers are notified of can be chosen")
+
+	_, err = uc.UpdatePreferences(context.Background(), dto.NotificationPreferenceRequest{
+		CustomerID: "CUST001",
+		Recipients: map[string]string{"FAX": "+6620000000"},
+	})
+	assert.IsType(t, errs.ValidationError{}, err)
+
+	mockPreferenceRepo.AssertNumberOfCalls(t, "Save", 1)
+}
+
+func TestNotificationPreferenceUseCase_GetPreferences(t *testing.T) {
+	mockPreferenceRepo := new(MockNotificationPreferenceRepository)
+	mockCustomerRepo := new(MockCustomerRepository)
+
+	mockCustomerRepo.On("Exists", mock.Anything, "CUST001").Return(true, nil)
+	mockCustomerRepo.On("Exists", mock.Anything, "UNKNOWN").Return(false, nil)
+	mockPreferenceRepo.On("GetByCustomerID", mock.Anything, "CUST001").Return(nil, errs.ErrNotificationPreferenceNotFound)
+
+	uc := NewNotificationPreferenceUseCase(mockPreferenceRepo, mockCustomerRepo, new(MockLogger))
+
+	// A customer who saved nothing gets no notifications
+	result, err := uc.GetPreferences(context.Background(), "CUST001")
+	require.NoError(t, err)
+	assert.Empty(t, result.Events)
+	assert.Nil(t, result.UpdatedAt)
+
+	_, err = uc.GetPreferences(context.Background(), "UNKNOWN")
+	assert.ErrorIs(t, err, errs.ErrCustomerNotFound)
+}
+
+func TestNotificationPreferenceUseCase_ResetPreferences(t *testing.T) {
+	mockPreferenceRepo := new(MockNotificationPreferenceRepository)
+	mockCustomerRepo := new(MockCustomerRepository)
+	mockLogger := new(MockLogger)
+	mockLogger.On("Info", mock.Anything, mock.Anything).Maybe()
+
+	mockCustomerRepo.On("Exists", mock.Anything, "CUST001").Return(true, nil)
+	mockPreferenceRepo.On("Delete", mock.Anything, "CUST001").Return(nil)
+
+	uc := NewNotificationPreferenceUseCase(mockPreferenceRepo, mockCustomerRepo, mockLogger)
+
+	require.NoError(t, uc.ResetPreferences(context.Background(), "CUST001"))
+	mockPreferenceRepo.AssertExpectations(t)
+}
+
+func newTestNotificationPreference(t *testing.T, customerID string, events map[string][]vo.NotificationChannel, quietHours *entity.QuietHours, minAmount *vo.Money) *entity.NotificationPreference {
+	preference, err := entity.NewNotificationPreference(customerID, "th", "UTC",
+		map[vo.NotificationChannel]string{
+			vo.NotificationChannelEmail: customerID + "@example.com",
+			vo.NotificationChannelSMS:   "+66" + customerID,
+		},
+		events, quietHours, minAmount,
+	)
+	require.NoError(t, err)
+	return preference
+}
@@ -0,0 +1,329 @@
+// internal/application/notification_template.go
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/application/dto"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/event"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/infra"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/repository"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+)
+
+// notificationSamples are the events customers can be notified of, each with sample data shaped like
+// the event's payload. Templates refer to the payload's JSON fields, e.g. {{.amount}}.
+var notificationSamples = map[event.Type]interface{}{
+	event.TransactionCompleted:      sampleTransactionPayload(vo.TransactionStatusCompleted),
+	event.TransactionFailed:         sampleTransactionPayload(vo.TransactionStatusFailed),
+	event.TransactionCancelled:      sampleTransactionPayload(vo.TransactionStatusCancelled),
+	event.TransactionInReview:       sampleTransactionPayload(vo.TransactionStatusReview),
+	event.AccountStatusChanged:      event.AccountPayload{AccountID: "2024010112345678", AccountName: "Savings", Balance: "1500.00", Status: "FROZEN"},
+	event.OwnershipTransferApproved: sampleOwnershipTransferPayload(vo.OwnershipTransferStatusApproved),
+	event.OwnershipTransferred:      sampleOwnershipTransferPayload(vo.OwnershipTransferStatusCompleted),
+	event.BudgetThresholdReached:    event.BudgetPayload{BudgetID: "BGT01HN2Q7F8Y3K5M9T4W6R0X1ZBC", AccountID: "2024010112345678", CategoryCode: "DINING", Period: "2024-01", Threshold: 80, Amount: "500.00", Spent: "410.00", PercentUsed: 82},
+	event.LimitThresholdReached:     event.LimitPayload{AccountID: "2024010112345678", TransactionID: "TXN20240101120000123456", Limit: "DAILY_DEBIT", Threshold: 80, Used: "8500.00", Maximum: "10000.00", Remaining: "1500.00", PercentUsed: 85},
+}
+
+// sampleTransactionPayload returns a transfer payload in the given status for previews
+func sampleTransactionPayload(status vo.TransactionStatus) event.TransactionPayload {
+	from, to := "2024010112345678", "2024010187654321"
+	createdAt := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	payload := event.TransactionPayload{
+		TransactionID:   "TXN20240101120000123456",
+		FromAccountID:   &from,
+		ToAccountID:     &to,
+		TransactionType: string(vo.TransactionTypeTransfer),
+		Channel:         string(vo.TransactionChannelMobile),
+		Amount:          "250.00",
+		Status:          string(status),
+		Description:     "Dinner",
+		Reference:       "REF-1",
+		Category:        "DINING",
+		CreatedAt:       createdAt,
+	}
+	if status == vo.TransactionStatusCompleted {
+		payload.CompletedAt = &createdAt
+	}
+	return payload
+}
+
+// sampleOwnershipTransferPayload returns an inheritance payload in the given status for previews
+func sampleOwnershipTransferPayload(status vo.OwnershipTransferStatus) event.OwnershipTransferPayload {
+	return event.OwnershipTransferPayload{
+		TransferID:     "OWN01HN2Q7F8Y3K5M9T4W6R0X1ZBD",
+		AccountID:      "2024010112345678",
+		FromCustomerID: "CUST-1",
+		ToCustomerID:   "CUST-2",
+		ReasonCode:     string(vo.OwnershipTransferReasonInheritance),
+		EffectiveDate:  "2024-01-15",
+		Status:         string(status),
+		RequestedBy:    "admin-1",
+		DecidedBy:      "admin-2",
+	}
+}
+
+// notificationData decodes an event's JSON data into the map templates are executed against
+func notificationData(data json.RawMessage) (map[string]interface{}, error) {
+	values := make(map[string]interface{})
+	if err := json.Unmarshal(data, &values); err != nil {
+		return nil, err
+	}
+	return values, nil
+}
+
+type notificationTemplateUseCase struct {
+	templateRepo repository.NotificationTemplateRepository
+	sender       infra.NotificationSender
+	logger       infra.Logger
+	mapper       *dto.NotificationTemplateMapper
+}
+
+// NewNotificationTemplateUseCase creates a new notification template use case
+func NewNotificationTemplateUseCase(
+	templateRepo repository.NotificationTemplateRepository,
+	sender infra.NotificationSender,
+	logger infra.Logger,
+) NotificationTemplateUseCase {
+	return &notificationTemplateUseCase{
+		templateRepo: templateRepo,
+		sender:       sender,
+		logger:       logger,
+		mapper:       &dto.NotificationTemplateMapper{},
+	}
+}
+
+// PublishTemplate saves the next version of a notification template, which is sent from then on
+func (uc *notificationTemplateUseCase) PublishTemplate(ctx context.Context, req dto.PublishNotificationTemplateRequest) (*dto.NotificationTemplateResponse, error) {
+	eventType, channel, locale, err := parseNotificationTemplateKey(req.NotificationTemplateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	previous, err := uc.latest(ctx, eventType, channel, locale)
+	if err != nil {
+		return nil, err
+	}
+
+	template, err := entity.NewNotificationTemplate(eventType, channel, locale, req.Subject, req.Body, req.CreatedBy, previous)
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.create(ctx, template)
+}
+
+// RollbackTemplate republishes the content of an earlier version as the next version
+func (uc *notificationTemplateUseCase) RollbackTemplate(ctx context.Context, req dto.RollbackNotificationTemplateRequest) (*dto.NotificationTemplateResponse, error) {
+	eventType, channel, locale, err := parseNotificationTemplateKey(req.NotificationTemplateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	target, err := uc.templateRepo.GetVersion(ctx, eventType, channel, locale, req.Version)
+	if err != nil {
+		return nil, err
+	}
+	previous, err := uc.templateRepo.GetLatest(ctx, eventType, channel, locale)
+	if err != nil {
+		return nil, err
+	}
+
+	template, err := entity.NewNotificationTemplate(eventType, channel, locale, target.Subject, target.Body, req.CreatedBy, previous)
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.create(ctx, template)
+}
+
+// GetTemplate retrieves a version of a notification template, the latest when version is zero
+func (uc *notificationTemplateUseCase) GetTemplate(ctx context.Context, key dto.NotificationTemplateKey, version int) (*dto.NotificationTemplateResponse, error) {
+	template, err := uc.find(ctx, key, version)
+	if err != nil {
+		return nil, err
+	}
+
+	response := uc.mapper.ToResponse(template)
+	return &response, nil
+}
+
+// ListTemplates retrieves the latest version of every notification template
+func (uc *notificationTemplateUseCase) ListTemplates(ctx context.Context) (*dto.NotificationTemplateListResponse, error) {
+	templates, err := uc.templateRepo.ListLatest(ctx)
+	if err != nil {
+		uc.logger.Error("Failed to list notification templates", "error", err)
+		return nil, err
+	}
+
+	response := uc.mapper.ToResponseList(templates)
+	return &response, nil
+}
+
+// ListVersions retrieves every version of a notification template, newest first
+func (uc *notificationTemplateUseCase) ListVersions(ctx context.Context, key dto.NotificationTemplateKey) (*dto.NotificationTemplateListResponse, error) {
+	eventType, channel, locale, err := parseNotificationTemplateKey(key)
+	if err != nil {
+		return nil, err
+	}
+
+	templates, err := uc.templateRepo.ListVersions(ctx, eventType, channel, locale)
+	if err != nil {
+		uc.logger.Error("Failed to list notification template versions", "error", err, "event", eventType, "channel", channel, "locale", locale)
+		return nil, err
+	}
+	if len(templates) == 0 {
+		return nil, errs.ErrNotificationTemplateNotFound
+	}
+
+	response := uc.mapper.ToResponseList(templates)
+	return &response, nil
+}
+
+// PreviewTemplate renders a saved version, or unsaved content, with the given or sample event data
+func (uc *notificationTemplateUseCase) PreviewTemplate(ctx context.Context, req dto.PreviewNotificationTemplateRequest) (*dto.NotificationPreviewResponse, error) {
+	template, err := uc.previewed(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	data := req.Data
+	if data == nil {
+		if data, err = sampleNotificationData(event.Type(template.Event)); err != nil {
+			return nil, err
+		}
+	}
+
+	rendered, err := template.Render(data)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.NotificationPreviewResponse{
+		Event:   template.Event,
+		Channel: string(template.Channel),
+		Locale:  template.Locale,
+		Version: template.Version,
+		Subject: rendered.Subject,
+		Body:    rendered.Body,
+		Data:    data,
+	}, nil
+}
+
+// TestSend renders a notification template like PreviewTemplate and sends it to one recipient
+func (uc *notificationTemplateUseCase) TestSend(ctx context.Context, req dto.TestSendNotificationRequest) (*dto.NotificationPreviewResponse, error) {
+	preview, err := uc.PreviewTemplate(ctx, req.PreviewNotificationTemplateRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	err = uc.sender.Send(ctx, infra.Notification{
+		Channel:   vo.NotificationChannel(preview.Channel),
+		Recipient: req.Recipient,
+		Subject:   preview.Subject,
+		Body:      preview.Body,
+	})
+	if err != nil {
+		uc.logger.Error("Failed to send test notification", "error", err, "event", preview.Event, "channel", preview.Channel)
+		return nil, err
+	}
+
+	uc.logger.Info("Test notification sent", "event", preview.Event, "channel", preview.Channel, "locale", preview.Locale, "version", preview.Version)
+	preview.Recipient = req.Recipient
+	return preview, nil
+}
+
+// previewed returns the template a preview renders: the request's unsaved content, or a saved version
+func (uc *notificationTemplateUseCase) previewed(ctx context.Context, req dto.PreviewNotificationTemplateRequest) (*entity.NotificationTemplate, error) {
+	if req.Body == nil {
+		return uc.find(ctx, req.NotificationTemplateKey, req.Version)
+	}
+
+	eventType, channel, locale, err := parseNotificationTemplateKey(req.NotificationTemplateKey)
+	if err != nil {
+		return nil, err
+	}
+	subject := ""
+	if req.Subject != nil {
+		subject = *req.Subject
+	}
+
+	template, err := entity.NewNotificationTemplate(eventType, channel, locale, subject, *req.Body, "", nil)
+	if err != nil {
+		return nil, err
+	}
+	template.Version = 0
+	return template, nil
+}
+
+// find retrieves a version of a template, the latest when version is zero
+func (uc *notificationTemplateUseCase) find(ctx context.Context, key dto.NotificationTemplateKey, version int) (*entity.NotificationTemplate, error) {
+	eventType, channel, locale, err := parseNotificationTemplateKey(key)
+	if err != nil {
+		return nil, err
+	}
+
+	if version > 0 {
+		return uc.templateRepo.GetVersion(ctx, eventType, channel, locale, version)
+	}
+	return uc.templateRepo.GetLatest(ctx, eventType, channel, locale)
+}
+
+// latest retrieves the latest version of a template, nil when it has none yet
+func (uc *notificationTemplateUseCase) latest(ctx context.Context, eventType string, channel vo.NotificationChannel, locale string) (*entity.NotificationTemplate, error) {
+	template, err := uc.templateRepo.GetLatest(ctx, eventType, channel, locale)
+	if errors.Is(err, errs.ErrNotificationTemplateNotFound) {
+		return nil, nil
+	}
+	return template, err
+}
+
+// create saves a new template version
+func (uc *notificationTemplateUseCase) create(ctx context.Context, template *entity.NotificationTemplate) (*dto.NotificationTemplateResponse, error) {
+	if err := uc.templateRepo.Create(ctx, template); err != nil {
+		uc.logger.Error("Failed to save notification template", "error", err, "event", template.Event, "channel", template.Channel, "locale", template.Locale)
+		return nil, err
+	}
+
+	uc.logger.Info("Notification template published", "event", template.Event, "channel", template.Channel,
+		"locale", template.Locale, "version", template.Version, "createdBy", template.CreatedBy)
+	response := uc.mapper.ToResponse(template)
+	return &response, nil
+}
+
+// parseNotificationTemplateKey checks a template key names an event customers are notified of, a
+// channel and a locale
+func parseNotificationTemplateKey(key dto.NotificationTemplateKey) (string, vo.NotificationChannel, string, error) {
+	if _, ok := notificationSamples[event.Type(key.Event)]; !ok {
+		return "", "", "", errs.ValidationError{
+			Field:   "event",
+			Message: "customers are not notified of event " + key.Event,
+		}
+	}
+
+	channel, err := vo.NewNotificationChannel(key.Channel)
+	if err != nil {
+		return "", "", "", errs.ValidationError{Field: "channel", Message: err.Error()}
+	}
+
+	locale, err := entity.NormalizeNotificationLocale(key.Locale)
+	if err != nil {
+		return "", "", "", err
+	}
+
+	return key.Event, channel, locale, nil
+}
+
+// sampleNotificationData returns the sample data of an event as templates see it
+func sampleNotificationData(eventType event.Type) (map[string]interface{}, error) {
+	payload, err := json.Marshal(notificationSamples[eventType])
+	if err != nil {
+		return nil, err
+	}
+	return notificationData(payload)
+}
@@ -0,0 +1,220 @@
+package usecase
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/hydr0g3nz/mini_bank/internal/application/dto"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/infra"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type MockNotificationTemplateRepository struct {
+	mock.Mock
+}
+
+func (m *MockNotificationTemplateRepository) Create(ctx context.Context, template *entity.NotificationTemplate) error {
+	args := m.Called(ctx, template)
+	return args.Error(0)
+}
+
+func (m *MockNotificationTemplateRepository) GetLatest(ctx context.Context, event string, channel vo.NotificationChannel, locale string) (*entity.NotificationTemplate, error) {
+	args := m.Called(ctx, event, channel, locale)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.NotificationTemplate), args.Error(1)
+}
+
+func (m *MockNotificationTemplateRepository) GetVersion(ctx context.Context, event string, channel vo.NotificationChannel, locale string, version int) (*entity.NotificationTemplate, error) {
+	args := m.Called(ctx, event, channel, locale, version)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.NotificationTemplate), args.Error(1)
+}
+
+func (m *MockNotificationTemplateRepository) ListVersions(ctx context.Context, event string, channel vo.NotificationChannel, locale string) ([]*entity.NotificationTemplate, error) {
+	args := m.Called(ctx, event, channel, locale)
+	return args.Get(0).([]*entity.NotificationTemplate), args.Error(1)
+}
+
+func (m *MockNotificationTemplateRepository) ListLatest(ctx context.Context) ([]*entity.NotificationTemplate, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]*entity.NotificationTemplate), args.Error(1)
+}
+
+// StubNotificationSender records sent notifications and fails them while Err is set
+type StubNotificationSender struct {
+	mu            sync.Mutex
+	Notifications []infra.Notification
+	Err           error
+}
+
+func (s *StubNotificationSender) Send(ctx context.Context, notification infra.Notification) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.Err != nil {
+		return s.Err
+	}
+	s.Notifications = append(s.Notifications, notification)
+	return nil
+}
+
+func smsTemplateKey() dto.NotificationTemplateKey {
+	return dto.NotificationTemplateKey{Event: "transaction.completed", Channel: "SMS", Locale: "en"}
+}
+
+func TestNotificationTemplateUseCase_PublishTemplate(t *testing.T) {
+	first, err := entity.NewNotificationTemplate("transaction.completed", vo.NotificationChannelSMS, "en", "", "Sent {{.amount}}", "ops", nil)
+	require.NoError(t, err)
+
+	mockRepo := new(MockNotificationTemplateRepository)
+	mockLogger := new(MockLogger)
+	mockLogger.On("Info", mock.Anything, mock.Anything).Maybe()
+
+	mockRepo.On("GetLatest", mock.Anything, "transaction.completed", vo.NotificationChannelSMS, "en").Return(first, nil)
+	mockRepo.On("Create", mock.Anything, mock.AnythingOfType("*entity.NotificationTemplate")).Return(nil)
+
+	uc := NewNotificationTemplateUseCase(mockRepo, &StubNotificationSender{}, mockLogger)
+
+	result, err := uc.PublishTemplate(context.Background(), dto.PublishNotificationTemplateRequest{
+		NotificationTemplateKey: smsTemplateKey(),
+		Body:                    "You sent {{.amount}} to {{.to_account_id}}",
+		CreatedBy:               "ops",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, 2, result.Version)
+	assert.Equal(t, "SMS", result.Channel)
+
+	_, err = uc.PublishTemplate(context.Background(), dto.PublishNotificationTemplateRequest{
+		NotificationTemplateKey: dto.NotificationTemplateKey{Event: "account.created", Channel: "SMS", Locale: "en"},
+		Body:                    "Welcome",
+		CreatedBy:               "ops",
+	})
+	assert.IsType(t, errs.ValidationError{}, err, "only events customers are notified of have templates")
+}
+
+func TestNotificationTemplateUseCase_PublishFirstVersion(t *testing.T) {
+	mockRepo := new(MockNotificationTemplateRepository)
+	mockLogger := new(MockLogger)
+	mockLogger.On("Info", mock.Anything, mock.Anything).Maybe()
+
+	mockRepo.On("GetLatest", mock.Anything, "transaction.failed", vo.NotificationChannelEmail, "th").Return(nil, errs.ErrNotificationTemplateNotFound)
+	mockRepo.On("Create", mock.Anything, mock.AnythingOfType("*entity.NotificationTemplate")).Return(nil)
+
+	uc := NewNotificationTemplateUseCase(mockRepo, &StubNotificationSender{}, mockLogger)
+
+	result, err := uc.PublishTemplate(context.Background(), dto.PublishNotificationTemplateRequest{
+		NotificationTemplateKey: dto.NotificationTemplateKey{Event: "transaction.failed", Channel: "EMAIL", Locale: "TH"},
+		Subject:                 "Transfer failed",
+		Body:                    "{{.amount}} was not sent",
+		CreatedBy:               "ops",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, result.Version)
+	assert.Equal(t, "th", result.Locale)
+}
+
+func TestNotificationTemplateUseCase_RollbackTemplate(t *testing.T) {
+	first, err := entity.NewNotificationTemplate("transaction.completed", vo.NotificationChannelSMS, "en", "", "Sent {{.amount}}", "ops", nil)
+	require.NoError(t, err)
+	second, err := entity.NewNotificationTemplate("transaction.completed", vo.NotificationChannelSMS, "en", "", "Broken {{.amount}}", "ops", first)
+	require.NoError(t, err)
+
+	mockRepo := new(MockNotificationTemplateRepository)
+	mockLogger := new(MockLogger)
+	mockLogger.On("Info", mock.Anything, mock.Anything).Maybe()
+
+	mockRepo.On("GetVersion", mock.Anything, "transaction.completed", vo.NotificationChannelSMS, "en", 1).Return(first, nil)
+	mockRepo.On("GetLatest", mock.Anything, "transaction.completed", vo.NotificationChannelSMS, "en").Return(second, nil)
+	mockRepo.On("Create", mock.Anything, mock.AnythingOfType("*entity.NotificationTemplate")).Return(nil)
+
+	uc := NewNotificationTemplateUseCase(mockRepo, &StubNotificationSender{}, mockLogger)
+
+	result, err := uc.RollbackTemplate(context.Background(), dto.RollbackNotificationTemplateRequest{
+		NotificationTemplateKey: smsTemplateKey(),
+		Version:                 1,
+		CreatedBy:               "oncall",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, 3, result.Version)
+	assert.Equal(t, first.Body, result.Body)
+	assert.Equal(t, "oncall", result.CreatedBy)
+}
+
+func TestNotificationTemplateUseCase_PreviewTemplate(t *testing.T) {
+	saved, err := entity.NewNotificationTemplate("transaction.completed", vo.NotificationChannelSMS, "en", "", "Sent {{.amount}} to {{.to_account_id}}", "ops", nil)
+	require.NoError(t, err)
+
+	mockRepo := new(MockNotificationTemplateRepository)
+	mockRepo.On("GetLatest", mock.Anything, "transaction.completed", vo.NotificationChannelSMS, "en").Return(saved, nil)
+
+	uc := NewNotificationTemplateUseCase(mockRepo, &StubNotificationSender{}, new(MockLogger))
+
+	// Saved version with sample data
+	result, err := uc.PreviewTemplate(context.Background(), dto.PreviewNotificationTemplateRequest{NotificationTemplateKey: smsTemplateKey()})
+	require.NoError(t, err)
+	assert.Equal(t, "Sent 250.00 to 2024010187654321", result.Body)
+	assert.Equal(t, 1, result.Version)
+
+	// Unsaved content with the caller's data
+	body := "Hi, {{.amount}} arrived"
+	result, err = uc.PreviewTemplate(context.Background(), dto.PreviewNotificationTemplateRequest{
+		NotificationTemplateKey: smsTemplateKey(),
+		Body:                    &body,
+		Data:                    map[string]interface{}{"amount": "9.99"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "Hi, 9.99 arrived", result.Body)
+	assert.Equal(t, 0, result.Version)
+
+	// Fields the event does not carry fail the preview
+	body = "{{.balance_after}}"
+	_, err = uc.PreviewTemplate(context.Background(), dto.PreviewNotificationTemplateRequest{
+		NotificationTemplateKey: smsTemplateKey(),
+		Body:                    &body,
+	})
+	assert.IsType(t, errs.ValidationError{}, err)
+}
+
+func TestNotificationTemplateUseCase_TestSend(t *testing.T) {
+	saved, err := entity.NewNotificationTemplate("budget.threshold_reached", vo.NotificationChannelPush, "en", "{{.category_code}} budget", "{{.percent_used}}% used", "ops", nil)
+	require.NoError(t, err)
+
+	mockRepo := new(MockNotificationTemplateRepository)
+	mockLogger := new(MockLogger)
+	mockLogger.On("Info", mock.Anything, mock.Anything).Maybe()
+	mockRepo.On("GetVersion", mock.Anything, "budget.threshold_reached", vo.NotificationChannelPush, "en", 1).Return(saved, nil)
+
+	sender := &StubNotificationSender{}
+	uc := NewNotificationTemplateUseCase(mockRepo, sender, mockLogger)
+
+	result, err := uc.TestSend(context.Background(), dto.TestSendNotificationRequest{
+		PreviewNotificationTemplateRequest: dto.PreviewNotificationTemplateRequest{
+			NotificationTemplateKey: dto.NotificationTemplateKey{Event: "budget.threshold_reached", Channel: "PUSH", Locale: "en"},
+			Version:                 1,
+		},
+		Recipient: "device-token",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "device-token", result.Recipient)
+	require.Len(t, sender.Notifications, 1)
+	assert.Equal(t, infra.Notification{
+		Channel:   vo.NotificationChannelPush,
+		Recipient: "device-token",
+		Subject:   "DINING budget",
+		Body:      "82% used",
+	}, sender.Notifications[0])
+}
@@ -0,0 +1,111 @@
+// internal/application/outbox_relay.go
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/domain/infra"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/repository"
+)
+
+// OutboxRelay delivers outbox entries to an external publisher with at-least-once semantics.
+// An entry is only marked published after the publisher acknowledges it, so a crash between
+// the two steps results in a redelivery rather than a lost event.
+type OutboxRelay struct {
+	outboxRepo   repository.OutboxRepository
+	units        repository.UnitOfWork
+	publisher    infra.EventPublisher
+	batchSize    int
+	pollInterval time.Duration
+	logger       infra.Logger
+}
+
+// NewOutboxRelay creates a new outbox relay; a nil units relays without locking the entries
+func NewOutboxRelay(
+	outboxRepo repository.OutboxRepository,
+	units repository.UnitOfWork,
+	publisher infra.EventPublisher,
+	batchSize int,
+	pollInterval time.Duration,
+	logger infra.Logger,
+) *OutboxRelay {
+	return &OutboxRelay{
+		outboxRepo:   outboxRepo,
+		units:        units,
+		publisher:    publisher,
+		batchSize:    batchSize,
+		pollInterval: pollInterval,
+		logger:       logger,
+	}
+}
+
+// Run relays pending entries until the context is cancelled
+func (r *OutboxRelay) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := r.RelayPending(ctx); err != nil && ctx.Err() == nil {
+			r.logger.Warn("Outbox relay pass failed", "error", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RelayPending publishes one batch of pending entries in order and returns how many were delivered.
+// It stops at the first failure so events for the same key are never delivered out of order. The batch
+// runs in a unit of work holding its entries locked, so relays on several instances never deliver the
+// same entry at once: each skips the entries another has locked.
+func (r *OutboxRelay) RelayPending(ctx context.Context) (int, error) {
+	delivered := 0
+	var deliveryErr error
+	err := r.atomically(ctx, func(ctx context.Context) error {
+		entries, err := r.outboxRepo.ListPending(ctx, r.batchSize)
+		if err != nil {
+			return err
+		}
+
+		for _, entry := range entries {
+			if err := r.publisher.Publish(ctx, entry.Event); err != nil {
+				r.logger.Warn("Failed to relay outbox entry",
+					"error", err,
+					"eventID", entry.Event.ID,
+					"attempts", entry.Attempts+1,
+				)
+				if markErr := r.outboxRepo.MarkFailed(ctx, entry.Sequence, err.Error()); markErr != nil {
+					r.logger.Error("Failed to record outbox delivery failure", "error", markErr, "eventID", entry.Event.ID)
+				}
+				// Commit the entries delivered so far along with the failed attempt
+				deliveryErr = err
+				return nil
+			}
+
+			if err := r.outboxRepo.MarkPublished(ctx, entry.Sequence); err != nil {
+				// The batch will be delivered again on the next pass
+				r.logger.Error("Failed to mark outbox entry as published", "error", err, "eventID", entry.Event.ID)
+				return err
+			}
+			delivered++
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return delivered, deliveryErr
+}
+
+// atomically runs fn in a unit of work, or directly when the relay has none
+func (r *OutboxRelay) atomically(ctx context.Context, fn func(ctx context.Context) error) error {
+	if r.units == nil {
+		return fn(ctx)
+	}
+	return r.units.Do(ctx, fn)
+}
@@ -0,0 +1,125 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/domain/event"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockOutboxRepository struct {
+	mock.Mock
+}
+
+func (m *MockOutboxRepository) Append(ctx context.Context, evt event.Event) error {
+	args := m.Called(ctx, evt)
+	return args.Error(0)
+}
+
+func (m *MockOutboxRepository) ListPending(ctx context.Context, limit int) ([]*event.OutboxEntry, error) {
+	args := m.Called(ctx, limit)
+	return args.Get(0).([]*event.OutboxEntry), args.Error(1)
+}
+
+func (m *MockOutboxRepository) MarkPublished(ctx context.Context, sequence uint64) error {
+	args := m.Called(ctx, sequence)
+	return args.Error(0)
+}
+
+func (m *MockOutboxRepository) MarkFailed(ctx context.Context, sequence uint64, reason string) error {
+	args := m.Called(ctx, sequence, reason)
+	return args.Error(0)
+}
+
+// failingEventPublisher fails every publish after the first `succeed` calls
+type failingEventPublisher struct {
+	StubEventPublisher
+	succeed int
+}
+
+func (p *failingEventPublisher) Publish(ctx context.Context, evt event.Event) error {
+	if len(p.Events) >= p.succeed {
+		return errors.New("broker unavailable")
+	}
+	return p.StubEventPublisher.Publish(ctx, evt)
+}
+
+func outboxEntries(n int) []*event.OutboxEntry {
+	entries := make([]*event.OutboxEntry, n)
+	for i := range entries {
+		entries[i] = &event.OutboxEntry{
+			Sequence: uint64(i + 1),
+			Event:    event.NewAccountEvent(event.AccountCreated, createTestAccount()),
+		}
+	}
+	return entries
+}
+
+func TestOutboxRelay_RelayPending(t *testing.T) {
+	tests := []struct {
+		name          string
+		succeed       int
+		setupMocks    func(*MockOutboxRepository, *MockLogger)
+		wantDelivered int
+		wantErr       bool
+	}{
+		{
+			name:    "delivers all pending entries in order",
+			succeed: 3,
+			setupMocks: func(outboxRepo *MockOutboxRepository, logger *MockLogger) {
+				outboxRepo.On("ListPending", mock.Anything, 10).Return(outboxEntries(3), nil)
+				outboxRepo.On("MarkPublished", mock.Anything, mock.AnythingOfType("uint64")).Return(nil).Times(3)
+			},
+			wantDelivered: 3,
+		},
+		{
+			name:    "stops at first failure and records it",
+			succeed: 1,
+			setupMocks: func(outboxRepo *MockOutboxRepository, logger *MockLogger) {
+				outboxRepo.On("ListPending", mock.Anything, 10).Return(outboxEntries(3), nil)
+				outboxRepo.On("MarkPublished", mock.Anything, uint64(1)).Return(nil).Once()
+				outboxRepo.On("MarkFailed", mock.Anything, uint64(2), "broker unavailable").Return(nil).Once()
+				logger.On("Warn", mock.Anything, mock.Anything).Return()
+			},
+			wantDelivered: 1,
+			wantErr:       true,
+		},
+		{
+			name:    "nothing pending",
+			succeed: 0,
+			setupMocks: func(outboxRepo *MockOutboxRepository, logger *MockLogger) {
+				outboxRepo.On("ListPending", mock.Anything, 10).Return([]*event.OutboxEntry{}, nil)
+			},
+			wantDelivered: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockOutboxRepo := new(MockOutboxRepository)
+			mockLogger := new(MockLogger)
+			publisher := &failingEventPublisher{succeed: tt.succeed}
+
+			tt.setupMocks(mockOutboxRepo, mockLogger)
+
+			units := &StubUnitOfWork{}
+			relay := NewOutboxRelay(mockOutboxRepo, units, publisher, 10, time.Second, mockLogger)
+			delivered, err := relay.RelayPending(context.Background())
+
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.wantDelivered, delivered)
+			assert.Len(t, publisher.Events, tt.wantDelivered)
+			mockOutboxRepo.AssertExpectations(t)
+			// A failed delivery is recorded, so the batch still commits
+			assert.Equal(t, []error{nil}, units.Outcomes)
+		})
+	}
+}
@@ -0,0 +1,303 @@
+// internal/application/ownership_transfer.go
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/application/dto"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/event"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/infra"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/repository"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+)
+
+// dueOwnershipTransferBatchSize caps how many due transfers one run applies
+const dueOwnershipTransferBatchSize = 100
+
+type ownershipTransferUseCase struct {
+	transferRepo repository.OwnershipTransferRepository
+	accountRepo  repository.AccountRepository
+	cache        infra.CacheService
+	events       infra.EventPublisher
+	valueDating  *ValueDatingPolicy
+	nameScope    vo.AccountNameScope
+	logger       infra.Logger
+	mapper       *dto.OwnershipTransferMapper
+}
+
+// NewOwnershipTransferUseCase creates a new ownership transfer use case. Effective dates are business
+// dates of valueDating; an account must keep a name unique within nameScope under its new owner.
+func NewOwnershipTransferUseCase(
+	transferRepo repository.OwnershipTransferRepository,
+	accountRepo repository.AccountRepository,
+	cache infra.CacheService,
+	events infra.EventPublisher,
+	valueDating *ValueDatingPolicy,
+	nameScope vo.AccountNameScope,
+	logger infra.Logger,
+) OwnershipTransferUseCase {
+	return &ownershipTransferUseCase{
+		transferRepo: transferRepo,
+		accountRepo:  accountRepo,
+		cache:        cache,
+		events:       events,
+		valueDating:  valueDating,
+		nameScope:    nameScope,
+		logger:       logger,
+		mapper:       &dto.OwnershipTransferMapper{},
+	}
+}
+
+// RequestOwnershipTransfer requests moving a standalone account to another customer. An account has
+// at most one transfer in progress; nothing changes until a second admin approves it.
+func (uc *ownershipTransferUseCase) RequestOwnershipTransfer(ctx context.Context, req dto.CreateOwnershipTransferRequest) (*dto.OwnershipTransferResponse, error) {
+	accountID, err := vo.NewAccountIDFromString(req.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	today := uc.valueDating.Today()
+	effectiveDate := today
+	if req.EffectiveDate != "" {
+		if effectiveDate, err = parseDate("effective_date", req.EffectiveDate); err != nil {
+			return nil, err
+		}
+	}
+
+	account, err := uc.accountRepo.GetByID(ctx, accountID)
+	if err != nil {
+		uc.logger.Error("Account not found", "error", err, "accountID", req.AccountID)
+		return nil, errs.ErrAccountNotFound
+	}
+
+	transfer, err := entity.NewOwnershipTransfer(account, req.ToCustomerID, vo.OwnershipTransferReason(req.ReasonCode), req.Note,
+		effectiveDate, today, req.RequestedBy)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.checkAccount(ctx, account, transfer.ToCustomerID); err != nil {
+		return nil, err
+	}
+
+	_, err = uc.transferRepo.GetOpenByAccount(ctx, accountID)
+	if err == nil {
+		return nil, errs.ErrOwnershipTransferOpen
+	}
+	if !errors.Is(err, errs.ErrOwnershipTransferNotFound) {
+		uc.logger.Error("Failed to check for open ownership transfers", "error", err, "accountID", req.AccountID)
+		return nil, err
+	}
+
+	if err := uc.transferRepo.Create(ctx, transfer); err != nil {
+		uc.logger.Error("Failed to save ownership transfer", "error", err, "transferID", transfer.ID, "accountID", req.AccountID)
+		return nil, err
+	}
+	uc.publish(ctx, event.NewOwnershipTransferEvent(event.OwnershipTransferRequested, transfer))
+
+	uc.logger.Info("Ownership transfer awaiting approval", "transferID", transfer.ID, "accountID", req.AccountID,
+		"fromCustomerID", transfer.FromCustomerID, "toCustomerID", transfer.ToCustomerID, "reasonCode", req.ReasonCode,
+		"effectiveDate", transfer.EffectiveDate.Format(dateLayout), "requestedBy", req.RequestedBy)
+
+	response := uc.mapper.ToResponse(transfer)
+	return &response, nil
+}
+
+// ApproveOwnershipTransfer records the approval of an admin other than the requester. A transfer
+// whose effective date has arrived is applied at once; later ones by the scheduled run.
+func (uc *ownershipTransferUseCase) ApproveOwnershipTransfer(ctx context.Context, req dto.OwnershipTransferDecisionRequest) (*dto.OwnershipTransferResponse, error) {
+	transfer, err := uc.transferRepo.GetByID(ctx, req.ID)
+	if err != nil {
+		uc.logger.Error("Failed to get ownership transfer", "error", err, "transferID", req.ID)
+		return nil, err
+	}
+
+	if err := transfer.Approve(req.Admin); err != nil {
+		return nil, err
+	}
+
+	if err := uc.transferRepo.Update(ctx, transfer); err != nil {
+		uc.logger.Error("Failed to update ownership transfer", "error", err, "transferID", req.ID)
+		return nil, err
+	}
+	uc.publish(ctx, event.NewOwnershipTransferEvent(event.OwnershipTransferApproved, transfer))
+
+	uc.logger.Info("Ownership transfer approved", "transferID", req.ID, "accountID", transfer.AccountID.String(), "admin", req.Admin,
+		"effectiveDate", transfer.EffectiveDate.Format(dateLayout))
+
+	// The approval stands when applying fails; the scheduled run tries again
+	if transfer.IsDue(uc.valueDating.Today()) {
+		if err := uc.complete(ctx, transfer); err != nil {
+			uc.logger.Warn("Failed to apply approved ownership transfer", "error", err, "transferID", req.ID)
+		}
+	}
+
+	response := uc.mapper.ToResponse(transfer)
+	return &response, nil
+}
+
+// RejectOwnershipTransfer rejects a pending transfer or withdraws an approved one before it takes effect
+func (uc *ownershipTransferUseCase) RejectOwnershipTransfer(ctx context.Context, req dto.OwnershipTransferDecisionRequest) (*dto.OwnershipTransferResponse, error) {
+	transfer, err := uc.transferRepo.GetByID(ctx, req.ID)
+	if err != nil {
+		uc.logger.Error("Failed to get ownership transfer", "error", err, "transferID", req.ID)
+		return nil, err
+	}
+
+	if err := transfer.Reject(req.Admin, req.Reason); err != nil {
+		return nil, err
+	}
+
+	if err := uc.transferRepo.Update(ctx, transfer); err != nil {
+		uc.logger.Error("Failed to update ownership transfer", "error", err, "transferID", req.ID)
+		return nil, err
+	}
+	uc.publish(ctx, event.NewOwnershipTransferEvent(event.OwnershipTransferRejected, transfer))
+
+	uc.logger.Info("Ownership transfer rejected", "transferID", req.ID, "accountID", transfer.AccountID.String(),
+		"admin", req.Admin, "reason", req.Reason)
+
+	response := uc.mapper.ToResponse(transfer)
+	return &response, nil
+}
+
+// GetOwnershipTransfer retrieves an ownership transfer
+func (uc *ownershipTransferUseCase) GetOwnershipTransfer(ctx context.Context, id string) (*dto.OwnershipTransferResponse, error) {
+	transfer, err := uc.transferRepo.GetByID(ctx, id)
+	if err != nil {
+		uc.logger.Error("Failed to get ownership transfer", "error", err, "transferID", id)
+		return nil, err
+	}
+
+	response := uc.mapper.ToResponse(transfer)
+	return &response, nil
+}
+
+// ListOwnershipTransfers retrieves ownership transfers, newest first
+func (uc *ownershipTransferUseCase) ListOwnershipTransfers(ctx context.Context, req dto.OwnershipTransferListRequest) (*dto.OwnershipTransferListResponse, error) {
+	filter := repository.OwnershipTransferFilter{
+		AccountID:  req.AccountID,
+		CustomerID: req.CustomerID,
+		Status:     vo.OwnershipTransferStatus(req.Status),
+	}
+	offset := (req.Page - 1) * req.PageSize
+
+	transfers, err := uc.transferRepo.List(ctx, filter, req.PageSize, offset)
+	if err != nil {
+		uc.logger.Error("Failed to list ownership transfers", "error", err)
+		return nil, err
+	}
+
+	total, err := uc.transferRepo.Count(ctx, filter)
+	if err != nil {
+		uc.logger.Error("Failed to count ownership transfers", "error", err)
+		return nil, err
+	}
+
+	response := uc.mapper.ToResponseList(transfers, dto.NewPaginationInfo(req.Page, req.PageSize, total))
+	return &response, nil
+}
+
+// ApplyDueTransfers applies the approved transfers whose effective date has arrived. A transfer that
+// cannot be applied is logged and tried again on the next run.
+func (uc *ownershipTransferUseCase) ApplyDueTransfers(ctx context.Context) (int, error) {
+	due, err := uc.transferRepo.ListDue(ctx, uc.valueDating.Today(), dueOwnershipTransferBatchSize)
+	if err != nil {
+		uc.logger.Error("Failed to load due ownership transfers", "error", err)
+		return 0, err
+	}
+
+	applied := 0
+	for _, transfer := range due {
+		if err := uc.complete(ctx, transfer); err != nil {
+			uc.logger.Warn("Failed to apply ownership transfer", "error", err, "transferID", transfer.ID, "accountID", transfer.AccountID.String())
+			continue
+		}
+		applied++
+	}
+
+	if applied > 0 {
+		uc.logger.Info("Applied due ownership transfers", "count", applied)
+	}
+	return applied, nil
+}
+
+// complete hands the account to the new customer and records the transfer as completed; transfer is
+// left as it was when that fails. The account is saved first, so a transfer that fails to be recorded
+// is completed again on the next run.
+func (uc *ownershipTransferUseCase) complete(ctx context.Context, transfer *entity.OwnershipTransfer) error {
+	account, err := uc.accountRepo.GetByID(ctx, transfer.AccountID)
+	if err != nil {
+		uc.logger.Error("Account not found", "error", err, "accountID", transfer.AccountID.String())
+		return errs.ErrAccountNotFound
+	}
+
+	moved := account.CustomerID != transfer.ToCustomerID
+	if moved {
+		if err := uc.checkAccount(ctx, account, transfer.ToCustomerID); err != nil {
+			return err
+		}
+	}
+	completed := *transfer
+	if err := completed.Complete(account, uc.valueDating.Today()); err != nil {
+		return err
+	}
+
+	if moved {
+		if err := uc.accountRepo.Update(ctx, account); err != nil {
+			uc.logger.Error("Failed to update account in repository", "error", err, "accountID", account.ID.String())
+			return err
+		}
+
+		cacheKey := fmt.Sprintf("account:%s", account.ID.String())
+		if err := uc.cache.Set(ctx, cacheKey, (&dto.AccountMapper{}).ToResponse(account), 15*time.Minute); err != nil {
+			uc.logger.Warn("Failed to update account cache", "error", err, "accountID", account.ID.String())
+		}
+		uc.publish(ctx, event.NewAccountEvent(event.AccountUpdated, account))
+	}
+
+	if err := uc.transferRepo.Update(ctx, &completed); err != nil {
+		uc.logger.Error("Failed to update ownership transfer", "error", err, "transferID", transfer.ID)
+		return err
+	}
+	*transfer = completed
+	uc.publish(ctx, event.NewOwnershipTransferEvent(event.OwnershipTransferred, transfer))
+
+	uc.logger.Info("Account ownership transferred", "transferID", transfer.ID, "accountID", account.ID.String(),
+		"fromCustomerID", transfer.FromCustomerID, "toCustomerID", transfer.ToCustomerID)
+	return nil
+}
+
+// checkAccount rejects moving a corporate parent account, whose children must keep its owner, and a
+// name the new owner already uses when names are unique per customer
+func (uc *ownershipTransferUseCase) checkAccount(ctx context.Context, account *entity.Account, toCustomerID string) error {
+	children, err := uc.accountRepo.GetChildren(ctx, account.ID)
+	if err != nil {
+		uc.logger.Error("Failed to load child accounts", "error", err, "accountID", account.ID.String())
+		return err
+	}
+	if len(children) > 0 {
+		return errs.ErrAccountHasChildren
+	}
+
+	if uc.nameScope != vo.AccountNameScopeCustomer {
+		return nil
+	}
+	existing, err := uc.accountRepo.GetByCustomerAccountName(ctx, toCustomerID, account.AccountName)
+	if err == nil && existing != nil && existing.ID != account.ID {
+		return errs.ErrAccountAlreadyExists
+	}
+	return nil
+}
+
+// publish publishes an ownership transfer event; failures are logged and never fail the operation
+func (uc *ownershipTransferUseCase) publish(ctx context.Context, evt event.Event) {
+	if err := uc.events.Publish(ctx, evt); err != nil {
+		uc.logger.Warn("Failed to publish ownership transfer event", "error", err, "eventType", evt.Type, "accountID", evt.Key)
+	}
+}
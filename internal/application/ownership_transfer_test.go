@@ -0,0 +1,264 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/application/dto"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/event"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/repository"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockOwnershipTransferRepository is a mock implementation of OwnershipTransferRepository
+type MockOwnershipTransferRepository struct {
+	mock.Mock
+}
+
+func (m *MockOwnershipTransferRepository) Create(ctx context.Context, transfer *entity.OwnershipTransfer) error {
+	args := m.Called(ctx, transfer)
+	return args.Error(0)
+}
+
+func (m *MockOwnershipTransferRepository) GetByID(ctx context.Context, id string) (*entity.OwnershipTransfer, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.OwnershipTransfer), args.Error(1)
+}
+
+func (m *MockOwnershipTransferRepository) GetOpenByAccount(ctx context.Context, accountID vo.AccountID) (*entity.OwnershipTransfer, error) {
+	args := m.Called(ctx, accountID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.OwnershipTransfer), args.Error(1)
+}
+
+func (m *MockOwnershipTransferRepository) Update(ctx context.Context, transfer *entity.OwnershipTransfer) error {
+	args := m.Called(ctx, transfer)
+	return args.Error(0)
+}
+
+func (m *MockOwnershipTransferRepository) List(ctx context.Context, filter repository.OwnershipTransferFilter, limit, offset int) ([]*entity.OwnershipTransfer, error) {
+	args := m.Called(ctx, filter, limit, offset)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.OwnershipTransfer), args.Error(1)
+}
+
+func (m *MockOwnershipTransferRepository) Count(ctx context.Context, filter repository.OwnershipTransferFilter) (int64, error) {
+	args := m.Called(ctx, filter)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockOwnershipTransferRepository) ListDue(ctx context.Context, date time.Time, limit int) ([]*entity.OwnershipTransfer, error) {
+	args := m.Called(ctx, date, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.OwnershipTransfer), args.Error(1)
+}
+
+// ownershipTransferToday is the business date of the ownership transfer tests
+var ownershipTransferToday = time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC)
+
+// newOwnershipTransferFixture returns a use case, on ownershipTransferToday, over an account of CUST-1
+func newOwnershipTransferFixture(t *testing.T) (*MockOwnershipTransferRepository, *MockAccountRepository, *StubEventPublisher, OwnershipTransferUseCase, *entity.Account) {
+	account := createTestAccount()
+	require.NoError(t, account.AssignCustomer("CUST-1"))
+
+	mockTransferRepo := new(MockOwnershipTransferRepository)
+	mockAccountRepo := new(MockAccountRepository)
+	mockCache := new(MockCacheService)
+	mockLogger := new(MockLogger)
+	mockLogger.On("Info", mock.Anything, mock.Anything).Maybe()
+	mockLogger.On("Warn", mock.Anything, mock.Anything).Maybe()
+	mockLogger.On("Error", mock.Anything, mock.Anything).Maybe()
+	mockCache.On("Set", mock.Anything, "account:"+account.ID.String(), mock.Anything, 15*time.Minute).Return(nil).Maybe()
+
+	mockAccountRepo.On("GetByID", mock.Anything, account.ID).Return(account, nil).Maybe()
+	mockAccountRepo.On("GetChildren", mock.Anything, account.ID).Return([]*entity.Account{}, nil).Maybe()
+
+	valueDating := NewValueDatingPolicy(&StubCalendar{}, vo.ProcessingWindow{}, nil)
+	valueDating.now = func() time.Time { return ownershipTransferToday.Add(10 * time.Hour) }
+
+	events := &StubEventPublisher{}
+	uc := NewOwnershipTransferUseCase(mockTransferRepo, mockAccountRepo, mockCache, events, valueDating, vo.AccountNameScopeGlobal, mockLogger)
+	return mockTransferRepo, mockAccountRepo, events, uc, account
+}
+
+// newTestOwnershipTransfer requests moving account to CUST-2 on effectiveDate, on behalf of alice
+func newTestOwnershipTransfer(t *testing.T, account *entity.Account, effectiveDate time.Time) *entity.OwnershipTransfer {
+	transfer, err := entity.NewOwnershipTransfer(account, "CUST-2", vo.OwnershipTransferReasonBusinessSale, "",
+		effectiveDate, ownershipTransferToday, "alice")
+	require.NoError(t, err)
+	return transfer
+}
+
+func TestOwnershipTransferUseCase_RequestOwnershipTransfer(t *testing.T) {
+	mockTransferRepo, mockAccountRepo, events, uc, account := newOwnershipTransferFixture(t)
+	mockTransferRepo.On("GetOpenByAccount", mock.Anything, account.ID).Return(nil, errs.ErrOwnershipTransferNotFound)
+	mockTransferRepo.On("Create", mock.Anything, mock.AnythingOfType("*entity.OwnershipTransfer")).Return(nil)
+
+	response, err := uc.RequestOwnershipTransfer(context.Background(), dto.CreateOwnershipTransferRequest{
+		AccountID:    account.ID.String(),
+		ToCustomerID: "CUST-2",
+		ReasonCode:   "INHERITANCE",
+		RequestedBy:  "alice",
+	})
+
+	// Nothing changes hands until a second admin approves it; the effective date defaults to today
+	require.NoError(t, err)
+	assert.Equal(t, "PENDING", response.Status)
+	assert.Equal(t, "CUST-1", response.FromCustomerID)
+	assert.Equal(t, "CUST-2", response.ToCustomerID)
+	assert.Equal(t, "2024-03-15", response.EffectiveDate)
+	assert.Equal(t, "CUST-1", account.CustomerID)
+	mockAccountRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	require.Len(t, events.Events, 1)
+	assert.Equal(t, event.OwnershipTransferRequested, events.Events[0].Type)
+}
+
+func TestOwnershipTransferUseCase_RequestOwnershipTransfer_Rejected(t *testing.T) {
+	tests := []struct {
+		name          string
+		effectiveDate string
+		setupMocks    func(*MockOwnershipTransferRepository, *MockAccountRepository, *entity.Account)
+		expectedError error
+	}{
+		{
+			name:          "transfer already in progress",
+			effectiveDate: "2024-04-01",
+			setupMocks: func(transferRepo *MockOwnershipTransferRepository, accountRepo *MockAccountRepository, account *entity.Account) {
+				transferRepo.On("GetOpenByAccount", mock.Anything, account.ID).Return(&entity.OwnershipTransfer{}, nil)
+			},
+			expectedError: errs.ErrOwnershipTransferOpen,
+		},
+		{
+			name:          "corporate parent account",
+			effectiveDate: "2024-04-01",
+			setupMocks: func(transferRepo *MockOwnershipTransferRepository, accountRepo *MockAccountRepository, account *entity.Account) {
+				accountRepo.ExpectedCalls = nil
+				accountRepo.On("GetByID", mock.Anything, account.ID).Return(account, nil)
+				accountRepo.On("GetChildren", mock.Anything, account.ID).Return([]*entity.Account{createTestAccount()}, nil)
+			},
+			expectedError: errs.ErrAccountHasChildren,
+		},
+		{
+			name:          "effective date in the past",
+			effectiveDate: "2024-03-14",
+			setupMocks:    func(*MockOwnershipTransferRepository, *MockAccountRepository, *entity.Account) {},
+			expectedError: errs.ValidationError{Field: "effective_date", Message: "effective date cannot be in the past"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockTransferRepo, mockAccountRepo, events, uc, account := newOwnershipTransferFixture(t)
+			tt.setupMocks(mockTransferRepo, mockAccountRepo, account)
+
+			_, err := uc.RequestOwnershipTransfer(context.Background(), dto.CreateOwnershipTransferRequest{
+				AccountID:     account.ID.String(),
+				ToCustomerID:  "CUST-2",
+				ReasonCode:    "GIFT",
+				EffectiveDate: tt.effectiveDate,
+				RequestedBy:   "alice",
+			})
+
+			assert.Equal(t, tt.expectedError, err)
+			mockTransferRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+			assert.Empty(t, events.Events)
+		})
+	}
+}
+
+func TestOwnershipTransferUseCase_ApproveOwnershipTransfer_SelfApproval(t *testing.T) {
+	mockTransferRepo, _, events, uc, account := newOwnershipTransferFixture(t)
+	transfer := newTestOwnershipTransfer(t, account, ownershipTransferToday)
+	mockTransferRepo.On("GetByID", mock.Anything, transfer.ID).Return(transfer, nil)
+
+	_, err := uc.ApproveOwnershipTransfer(context.Background(), dto.OwnershipTransferDecisionRequest{ID: transfer.ID, Admin: "Alice"})
+
+	assert.ErrorIs(t, err, errs.ErrSelfApproval)
+	assert.Equal(t, vo.OwnershipTransferStatusPending, transfer.Status)
+	mockTransferRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	assert.Empty(t, events.Events)
+}
+
+func TestOwnershipTransferUseCase_ApproveOwnershipTransfer_DueToday(t *testing.T) {
+	mockTransferRepo, mockAccountRepo, events, uc, account := newOwnershipTransferFixture(t)
+	transfer := newTestOwnershipTransfer(t, account, ownershipTransferToday)
+	mockTransferRepo.On("GetByID", mock.Anything, transfer.ID).Return(transfer, nil)
+	mockTransferRepo.On("Update", mock.Anything, mock.AnythingOfType("*entity.OwnershipTransfer")).Return(nil)
+	mockAccountRepo.On("Update", mock.Anything, account).Return(nil)
+
+	response, err := uc.ApproveOwnershipTransfer(context.Background(), dto.OwnershipTransferDecisionRequest{ID: transfer.ID, Admin: "bob"})
+
+	require.NoError(t, err)
+	assert.Equal(t, "COMPLETED", response.Status)
+	assert.Equal(t, "bob", response.DecidedBy)
+	assert.Equal(t, "CUST-2", account.CustomerID)
+	mockTransferRepo.AssertNumberOfCalls(t, "Update", 2)
+
+	var types []event.Type
+	for _, evt := range events.Events {
+		types = append(types, evt.Type)
+	}
+	assert.Equal(t, []event.Type{event.OwnershipTransferApproved, event.AccountUpdated, event.OwnershipTransferred}, types)
+}
+
+func TestOwnershipTransferUseCase_ApproveOwnershipTransfer_ApplyFails(t *testing.T) {
+	mockTransferRepo, mockAccountRepo, events, uc, account := newOwnershipTransferFixture(t)
+	transfer := newTestOwnershipTransfer(t, account, ownershipTransferToday)
+	mockTransferRepo.On("GetByID", mock.Anything, transfer.ID).Return(transfer, nil)
+	mockTransferRepo.On("Update", mock.Anything, mock.AnythingOfType("*entity.OwnershipTransfer")).Return(nil)
+	mockAccountRepo.On("Update", mock.Anything, account).Return(assert.AnError)
+
+	response, err := uc.ApproveOwnershipTransfer(context.Background(), dto.OwnershipTransferDecisionRequest{ID: transfer.ID, Admin: "bob"})
+
+	// The approval stands and the scheduled run tries again
+	require.NoError(t, err)
+	assert.Equal(t, "APPROVED", response.Status)
+	mockTransferRepo.AssertNumberOfCalls(t, "Update", 1)
+	require.Len(t, events.Events, 1)
+	assert.Equal(t, event.OwnershipTransferApproved, events.Events[0].Type)
+}
+
+func TestOwnershipTransferUseCase_ApplyDueTransfers(t *testing.T) {
+	mockTransferRepo, mockAccountRepo, events, uc, account := newOwnershipTransferFixture(t)
+	due := newTestOwnershipTransfer(t, account, ownershipTransferToday)
+	require.NoError(t, due.Approve("bob"))
+
+	// An account that moved elsewhere since the approval is left alone
+	other := createTestAccount()
+	require.NoError(t, other.AssignCustomer("CUST-3"))
+	stale := &entity.OwnershipTransfer{ID: "OWN2", AccountID: other.ID, FromCustomerID: "CUST-1", ToCustomerID: "CUST-2",
+		EffectiveDate: ownershipTransferToday, Status: vo.OwnershipTransferStatusApproved}
+
+	mockAccountRepo.On("GetByID", mock.Anything, other.ID).Return(other, nil)
+	mockAccountRepo.On("GetChildren", mock.Anything, other.ID).Return([]*entity.Account{}, nil)
+	mockAccountRepo.On("Update", mock.Anything, account).Return(nil)
+	mockTransferRepo.On("ListDue", mock.Anything, ownershipTransferToday, dueOwnershipTransferBatchSize).
+		Return([]*entity.OwnershipTransfer{due, stale}, nil)
+	mockTransferRepo.On("Update", mock.Anything, mock.AnythingOfType("*entity.OwnershipTransfer")).Return(nil)
+
+	applied, err := uc.ApplyDueTransfers(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, applied)
+	assert.Equal(t, vo.OwnershipTransferStatusCompleted, due.Status)
+	assert.Equal(t, vo.OwnershipTransferStatusApproved, stale.Status)
+	assert.Equal(t, "CUST-2", account.CustomerID)
+	assert.Equal(t, "CUST-3", other.CustomerID)
+	require.Len(t, events.Events, 2)
+	assert.Equal(t, event.OwnershipTransferred, events.Events[1].Type)
+}
@@ -0,0 +1,169 @@
+// internal/application/product.go
+package usecase
+
+import (
+	"context"
+
+	"github.com/hydr0g3nz/mini_bank/internal/application/dto"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/infra"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/repository"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"github.com/shopspring/decimal"
+)
+
+// ProductPolicy applies the terms of the product an account was opened from to its outgoing
+// transactions: the product's limit on a single transaction and its fees
+type ProductPolicy struct {
+	accountRepo repository.AccountRepository
+	productRepo repository.ProductRepository
+	logger      infra.Logger
+}
+
+// NewProductPolicy creates a product policy
+func NewProductPolicy(accountRepo repository.AccountRepository, productRepo repository.ProductRepository, logger infra.Logger) *ProductPolicy {
+	return &ProductPolicy{
+		accountRepo: accountRepo,
+		productRepo: productRepo,
+		logger:      logger,
+	}
+}
+
+// Apply checks a new transaction against the product of its source account and adds the
+// product's fee to the fee already set on it. Accounts without a product have no such terms.
+func (p *ProductPolicy) Apply(ctx context.Context, transaction *entity.Transaction) error {
+	if p == nil || transaction.FromAccountID == nil {
+		return nil
+	}
+
+	account, err := p.accountRepo.GetByID(ctx, *transaction.FromAccountID)
+	if err != nil {
+		p.logger.Error("Failed to load account for product terms", "error", err, "accountID", transaction.FromAccountID.String())
+		return loadAccountError(err)
+	}
+	product, err := p.Product(ctx, account)
+	if err != nil || product == nil {
+		return err
+	}
+
+	if err := product.CheckTransaction(transaction.Amount); err != nil {
+		p.logger.Warn("Transaction exceeds product limit", "productID", product.ID, "amount", transaction.Amount.String())
+		return err
+	}
+
+	fee, err := transaction.Fee.Add(product.Fee(transaction.TransactionType))
+	if err != nil {
+		return err
+	}
+	return transaction.SetFee(fee)
+}
+
+// Product returns the product an account was opened from, or nil when it has none
+func (p *ProductPolicy) Product(ctx context.Context, account *entity.Account) (*entity.Product, error) {
+	if p == nil || account.ProductID == "" {
+		return nil, nil
+	}
+
+	product, err := p.productRepo.GetByID(ctx, account.ProductID)
+	if err != nil {
+		p.logger.Error("Failed to load product of account", "error", err, "accountID", account.ID.String(), "productID", account.ProductID)
+		return nil, err
+	}
+	return product, nil
+}
+
+type productUseCase struct {
+	productRepo repository.ProductRepository
+	currency    string
+	logger      infra.Logger
+	mapper      *dto.ProductMapper
+}
+
+// NewProductUseCase creates a new product use case
+func NewProductUseCase(productRepo repository.ProductRepository, currency string, logger infra.Logger) ProductUseCase {
+	return &productUseCase{
+		productRepo: productRepo,
+		currency:    currency,
+		logger:      logger,
+		mapper:      &dto.ProductMapper{},
+	}
+}
+
+// CreateProduct adds a product to the catalog
+func (uc *productUseCase) CreateProduct(ctx context.Context, req dto.CreateProductRequest) (*dto.ProductResponse, error) {
+	code := req.Currency
+	if code == "" {
+		code = uc.currency
+	}
+	currency, err := vo.NewCurrency(code)
+	if err != nil {
+		return nil, err
+	}
+
+	fees, limits := uc.mapper.FromRequest(req.Fees, req.Limits)
+	product, err := entity.NewProduct(req.Name, entity.ProductType(req.Type), currency.String(), decimal.NewFromFloat(req.InterestRate), fees, limits)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.productRepo.Create(ctx, product); err != nil {
+		uc.logger.Error("Failed to create product", "error", err, "name", product.Name)
+		return nil, err
+	}
+
+	uc.logger.Info("Product created", "productID", product.ID, "name", product.Name, "type", product.Type)
+	response := uc.mapper.ToResponse(product)
+	return &response, nil
+}
+
+// GetProduct retrieves a product by ID
+func (uc *productUseCase) GetProduct(ctx context.Context, id string) (*dto.ProductResponse, error) {
+	product, err := uc.productRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	response := uc.mapper.ToResponse(product)
+	return &response, nil
+}
+
+// UpdateProduct changes a product's terms, which apply to its accounts from then on, or retires it
+func (uc *productUseCase) UpdateProduct(ctx context.Context, req dto.UpdateProductRequest) (*dto.ProductResponse, error) {
+	product, err := uc.productRepo.GetByID(ctx, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	fees, limits := uc.mapper.FromRequest(req.Fees, req.Limits)
+	if err := product.Update(req.Name, decimal.NewFromFloat(req.InterestRate), fees, limits); err != nil {
+		return nil, err
+	}
+	if req.Active != nil {
+		product.SetActive(*req.Active)
+	}
+
+	if err := uc.productRepo.Update(ctx, product); err != nil {
+		uc.logger.Error("Failed to update product", "error", err, "productID", product.ID)
+		return nil, err
+	}
+
+	uc.logger.Info("Product updated", "productID", product.ID, "active", product.Active)
+	response := uc.mapper.ToResponse(product)
+	return &response, nil
+}
+
+// ListProducts retrieves the product catalog
+func (uc *productUseCase) ListProducts(ctx context.Context) (*dto.ProductListResponse, error) {
+	products, err := uc.productRepo.List(ctx)
+	if err != nil {
+		uc.logger.Error("Failed to list products", "error", err)
+		return nil, err
+	}
+
+	responses := make([]dto.ProductResponse, len(products))
+	for i, product := range products {
+		responses[i] = uc.mapper.ToResponse(product)
+	}
+
+	return &dto.ProductListResponse{Products: responses}, nil
+}
@@ -0,0 +1,291 @@
+// internal/application/product_migration.go
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/application/dto"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/event"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/infra"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/repository"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+)
+
+// dueProductMigrationBatchSize caps how many due migrations one run applies
+const dueProductMigrationBatchSize = 20
+
+type productMigrationUseCase struct {
+	migrationRepo repository.ProductMigrationRepository
+	productRepo   repository.ProductRepository
+	accountRepo   repository.AccountRepository
+	cache         infra.CacheService
+	events        infra.EventPublisher
+	valueDating   *ValueDatingPolicy
+	logger        infra.Logger
+	mapper        *dto.ProductMigrationMapper
+}
+
+// NewProductMigrationUseCase creates a new product migration use case. Effective dates are business
+// dates of valueDating.
+func NewProductMigrationUseCase(
+	migrationRepo repository.ProductMigrationRepository,
+	productRepo repository.ProductRepository,
+	accountRepo repository.AccountRepository,
+	cache infra.CacheService,
+	events infra.EventPublisher,
+	valueDating *ValueDatingPolicy,
+	logger infra.Logger,
+) ProductMigrationUseCase {
+	return &productMigrationUseCase{
+		migrationRepo: migrationRepo,
+		productRepo:   productRepo,
+		accountRepo:   accountRepo,
+		cache:         cache,
+		events:        events,
+		valueDating:   valueDating,
+		logger:        logger,
+		mapper:        &dto.ProductMigrationMapper{},
+	}
+}
+
+// CreateProductMigration schedules moving the listed accounts, or every account of the from product,
+// to the new product on the effective date. Ineligible accounts are skipped and reported. A dry run
+// returns the same report without saving anything; otherwise a migration effective today is applied
+// at once and later ones by the scheduled run.
+func (uc *productMigrationUseCase) CreateProductMigration(ctx context.Context, req dto.CreateProductMigrationRequest) (*dto.ProductMigrationResponse, error) {
+	today := uc.valueDating.Today()
+	effectiveDate := today
+	if req.EffectiveDate != "" {
+		var err error
+		if effectiveDate, err = parseDate("effective_date", req.EffectiveDate); err != nil {
+			return nil, err
+		}
+	}
+
+	from, err := uc.productRepo.GetByID(ctx, req.FromProductID)
+	if err != nil {
+		uc.logger.Error("Failed to get product", "error", err, "productID", req.FromProductID)
+		return nil, err
+	}
+	to, err := uc.productRepo.GetByID(ctx, req.ToProductID)
+	if err != nil {
+		uc.logger.Error("Failed to get product", "error", err, "productID", req.ToProductID)
+		return nil, err
+	}
+
+	accounts, err := uc.loadAccounts(ctx, from.ID, req.AccountIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	migration, err := entity.NewProductMigration(from, to, accounts, req.Note, effectiveDate, today, req.RequestedBy)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.DryRun {
+		response := uc.mapper.ToResponse(migration)
+		response.ID = ""
+		response.DryRun = true
+		return &response, nil
+	}
+
+	if err := uc.migrationRepo.Create(ctx, migration); err != nil {
+		uc.logger.Error("Failed to save product migration", "error", err, "migrationID", migration.ID)
+		return nil, err
+	}
+	uc.publish(ctx, event.NewProductMigrationEvent(event.ProductMigrationScheduled, migration))
+
+	uc.logger.Info("Product migration scheduled", "migrationID", migration.ID, "fromProductID", from.ID, "toProductID", to.ID,
+		"accounts", len(migration.Accounts), "skipped", migration.Count(vo.ProductMigrationAccountSkipped),
+		"effectiveDate", migration.EffectiveDate.Format(dateLayout), "requestedBy", migration.RequestedBy)
+
+	// The migration stands when applying fails; the scheduled run tries again
+	if migration.IsDue(today) {
+		if err := uc.apply(ctx, migration); err != nil {
+			uc.logger.Warn("Failed to apply product migration", "error", err, "migrationID", migration.ID)
+		}
+	}
+
+	response := uc.mapper.ToResponse(migration)
+	return &response, nil
+}
+
+// GetProductMigration retrieves a product migration with its per-account report
+func (uc *productMigrationUseCase) GetProductMigration(ctx context.Context, id string) (*dto.ProductMigrationResponse, error) {
+	migration, err := uc.migrationRepo.GetByID(ctx, id)
+	if err != nil {
+		uc.logger.Error("Failed to get product migration", "error", err, "migrationID", id)
+		return nil, err
+	}
+
+	response := uc.mapper.ToResponse(migration)
+	return &response, nil
+}
+
+// ListProductMigrations retrieves product migrations, newest first
+func (uc *productMigrationUseCase) ListProductMigrations(ctx context.Context, req dto.ProductMigrationListRequest) (*dto.ProductMigrationListResponse, error) {
+	filter := repository.ProductMigrationFilter{
+		ProductID: req.ProductID,
+		Status:    vo.ProductMigrationStatus(req.Status),
+	}
+	offset := (req.Page - 1) * req.PageSize
+
+	migrations, err := uc.migrationRepo.List(ctx, filter, req.PageSize, offset)
+	if err != nil {
+		uc.logger.Error("Failed to list product migrations", "error", err)
+		return nil, err
+	}
+
+	total, err := uc.migrationRepo.Count(ctx, filter)
+	if err != nil {
+		uc.logger.Error("Failed to count product migrations", "error", err)
+		return nil, err
+	}
+
+	response := uc.mapper.ToResponseList(migrations, dto.NewPaginationInfo(req.Page, req.PageSize, total))
+	return &response, nil
+}
+
+// ApplyDueMigrations applies the scheduled migrations whose effective date has arrived. A migration
+// with accounts that could not be applied is logged and tried again on the next run.
+func (uc *productMigrationUseCase) ApplyDueMigrations(ctx context.Context) (int, error) {
+	due, err := uc.migrationRepo.ListDue(ctx, uc.valueDating.Today(), dueProductMigrationBatchSize)
+	if err != nil {
+		uc.logger.Error("Failed to load due product migrations", "error", err)
+		return 0, err
+	}
+
+	applied := 0
+	for _, migration := range due {
+		if err := uc.apply(ctx, migration); err != nil {
+			uc.logger.Warn("Failed to apply product migration", "error", err, "migrationID", migration.ID)
+			continue
+		}
+		applied++
+	}
+
+	if applied > 0 {
+		uc.logger.Info("Applied due product migrations", "count", applied)
+	}
+	return applied, nil
+}
+
+// loadAccounts loads the listed accounts, or every account of the product when none are listed. One
+// more account than a migration takes is loaded, so an oversized migration is rejected, not truncated.
+func (uc *productMigrationUseCase) loadAccounts(ctx context.Context, productID string, accountIDs []string) ([]*entity.Account, error) {
+	if len(accountIDs) == 0 {
+		accounts, err := uc.accountRepo.List(ctx, repository.AccountFilter{ProductID: productID}, entity.MaxProductMigrationAccounts+1, 0)
+		if err != nil {
+			uc.logger.Error("Failed to list accounts of product", "error", err, "productID", productID)
+			return nil, err
+		}
+		return accounts, nil
+	}
+
+	seen := make(map[string]bool, len(accountIDs))
+	accounts := make([]*entity.Account, 0, len(accountIDs))
+	for _, id := range accountIDs {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+
+		accountID, err := vo.NewAccountIDFromString(id)
+		if err != nil {
+			return nil, err
+		}
+		account, err := uc.accountRepo.GetByID(ctx, accountID)
+		if err != nil {
+			uc.logger.Error("Account not found", "error", err, "accountID", id)
+			return nil, errs.ErrAccountNotFound
+		}
+		accounts = append(accounts, account)
+	}
+	return accounts, nil
+}
+
+// apply moves the pending accounts of a migration to the new product and saves the outcome. Accounts
+// are saved one by one before the migration; an account that fails to be saved stays pending and
+// keeps the migration scheduled, and one already moved by an interrupted run is only recorded.
+func (uc *productMigrationUseCase) apply(ctx context.Context, migration *entity.ProductMigration) error {
+	from, err := uc.productRepo.GetByID(ctx, migration.FromProductID)
+	if err != nil {
+		uc.logger.Error("Failed to get product", "error", err, "productID", migration.FromProductID)
+		return err
+	}
+	to, err := uc.productRepo.GetByID(ctx, migration.ToProductID)
+	if err != nil {
+		uc.logger.Error("Failed to get product", "error", err, "productID", migration.ToProductID)
+		return err
+	}
+
+	now := uc.valueDating.Now()
+	var failed error
+	for i := range migration.Accounts {
+		outcome := migration.Accounts[i]
+		if outcome.Status != vo.ProductMigrationAccountPending {
+			continue
+		}
+
+		account, err := uc.accountRepo.GetByID(ctx, outcome.AccountID)
+		if errors.Is(err, errs.ErrAccountNotFound) {
+			migration.Skip(i, "account no longer exists")
+			continue
+		}
+		if err != nil {
+			uc.logger.Error("Failed to get account", "error", err, "accountID", outcome.AccountID.String())
+			failed = err
+			continue
+		}
+
+		if !migration.Migrate(i, account, from, to, now) {
+			continue
+		}
+		if err := uc.accountRepo.Update(ctx, account); err != nil {
+			uc.logger.Error("Failed to update account in repository", "error", err, "accountID", account.ID.String())
+			migration.Accounts[i] = outcome
+			failed = err
+			continue
+		}
+
+		cacheKey := fmt.Sprintf("account:%s", account.ID.String())
+		if err := uc.cache.Set(ctx, cacheKey, (&dto.AccountMapper{}).ToResponse(account), 15*time.Minute); err != nil {
+			uc.logger.Warn("Failed to update account cache", "error", err, "accountID", account.ID.String())
+		}
+		uc.publish(ctx, event.NewAccountEvent(event.AccountUpdated, account))
+		uc.publish(ctx, event.NewAccountProductMigratedEvent(migration, account.ID))
+	}
+
+	completed := failed == nil
+	if completed {
+		if err := migration.Complete(now); err != nil {
+			return err
+		}
+	}
+
+	if err := uc.migrationRepo.Update(ctx, migration); err != nil {
+		uc.logger.Error("Failed to update product migration", "error", err, "migrationID", migration.ID)
+		return err
+	}
+	if !completed {
+		return failed
+	}
+	uc.publish(ctx, event.NewProductMigrationEvent(event.ProductMigrationCompleted, migration))
+
+	uc.logger.Info("Product migration completed", "migrationID", migration.ID, "fromProductID", from.ID, "toProductID", to.ID,
+		"migrated", migration.Count(vo.ProductMigrationAccountMigrated), "skipped", migration.Count(vo.ProductMigrationAccountSkipped))
+	return nil
+}
+
+// publish publishes a product migration event; failures are logged and never fail the operation
+func (uc *productMigrationUseCase) publish(ctx context.Context, evt event.Event) {
+	if err := uc.events.Publish(ctx, evt); err != nil {
+		uc.logger.Warn("Failed to publish product migration event", "error", err, "eventType", evt.Type, "accountID", evt.Key)
+	}
+}
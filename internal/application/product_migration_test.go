@@ -0,0 +1,224 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/application/dto"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/event"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/repository"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockProductMigrationRepository is a mock implementation of ProductMigrationRepository
+type MockProductMigrationRepository struct {
+	mock.Mock
+}
+
+func (m *MockProductMigrationRepository) Create(ctx context.Context, migration *entity.ProductMigration) error {
+	args := m.Called(ctx, migration)
+	return args.Error(0)
+}
+
+func (m *MockProductMigrationRepository) GetByID(ctx context.Context, id string) (*entity.ProductMigration, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.ProductMigration), args.Error(1)
+}
+
+func (m *MockProductMigrationRepository) Update(ctx context.Context, migration *entity.ProductMigration) error {
+	args := m.Called(ctx, migration)
+	return args.Error(0)
+}
+
+func (m *MockProductMigrationRepository) List(ctx context.Context, filter repository.ProductMigrationFilter, limit, offset int) ([]*entity.ProductMigration, error) {
+	args := m.Called(ctx, filter, limit, offset)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.ProductMigration), args.Error(1)
+}
+
+func (m *MockProductMigrationRepository) Count(ctx context.Context, filter repository.ProductMigrationFilter) (int64, error) {
+	args := m.Called(ctx, filter)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockProductMigrationRepository) ListDue(ctx context.Context, date time.Time, limit int) ([]*entity.ProductMigration, error) {
+	args := m.Called(ctx, date, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.ProductMigration), args.Error(1)
+}
+
+// productMigrationToday is the business date of the product migration tests
+var productMigrationToday = time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC)
+
+// productMigrationFixture is a use case, on productMigrationToday, moving accounts between two
+// savings products; the new one requires a balance of 500
+type productMigrationFixture struct {
+	migrationRepo *MockProductMigrationRepository
+	accountRepo   *MockAccountRepository
+	events        *StubEventPublisher
+	uc            ProductMigrationUseCase
+	from, to      *entity.Product
+}
+
+func newProductMigrationFixture(t *testing.T) *productMigrationFixture {
+	from := createTestProduct(t)
+	to := createTestProduct(t)
+
+	mockMigrationRepo := new(MockProductMigrationRepository)
+	mockProductRepo := new(MockProductRepository)
+	mockAccountRepo := new(MockAccountRepository)
+	mockCache := new(MockCacheService)
+	mockLogger := new(MockLogger)
+	mockLogger.On("Info", mock.Anything, mock.Anything).Maybe()
+	mockLogger.On("Warn", mock.Anything, mock.Anything).Maybe()
+	mockLogger.On("Error", mock.Anything, mock.Anything).Maybe()
+	mockCache.On("Set", mock.Anything, mock.Anything, mock.Anything, 15*time.Minute).Return(nil).Maybe()
+	mockProductRepo.On("GetByID", mock.Anything, from.ID).Return(from, nil).Maybe()
+	mockProductRepo.On("GetByID", mock.Anything, to.ID).Return(to, nil).Maybe()
+
+	valueDating := NewValueDatingPolicy(&StubCalendar{}, vo.ProcessingWindow{}, nil)
+	valueDating.now = func() time.Time { return productMigrationToday.Add(10 * time.Hour) }
+
+	events := &StubEventPublisher{}
+	return &productMigrationFixture{
+		migrationRepo: mockMigrationRepo,
+		accountRepo:   mockAccountRepo,
+		events:        events,
+		uc:            NewProductMigrationUseCase(mockMigrationRepo, mockProductRepo, mockAccountRepo, mockCache, events, valueDating, mockLogger),
+		from:          from,
+		to:            to,
+	}
+}
+
+// account returns an account of the from product with the balance, known to the account repository
+func (f *productMigrationFixture) account(balance float64) *entity.Account {
+	account, _ := entity.NewAccount("Savings", vo.NewMoneyFromFloat(balance))
+	account.ProductID = f.from.ID
+	f.accountRepo.On("GetByID", mock.Anything, account.ID).Return(account, nil).Maybe()
+	return account
+}
+
+func TestProductMigrationUseCase_CreateProductMigration_DryRun(t *testing.T) {
+	f := newProductMigrationFixture(t)
+	eligible, poor := f.account(1000), f.account(100)
+	f.accountRepo.On("List", mock.Anything, repository.AccountFilter{ProductID: f.from.ID}, entity.MaxProductMigrationAccounts+1, 0).
+		Return([]*entity.Account{eligible, poor}, nil)
+
+	response, err := f.uc.CreateProductMigration(context.Background(), dto.CreateProductMigrationRequest{
+		FromProductID: f.from.ID,
+		ToProductID:   f.to.ID,
+		RequestedBy:   "alice",
+		DryRun:        true,
+	})
+
+	// Every account of the product is reported; nothing is saved, changed or published
+	require.NoError(t, err)
+	assert.True(t, response.DryRun)
+	assert.Empty(t, response.ID)
+	assert.Equal(t, dto.ProductMigrationSummary{Accounts: 2, Pending: 1, Skipped: 1}, response.Summary)
+	require.Len(t, response.Accounts, 2)
+	assert.Equal(t, "PENDING", response.Accounts[0].Status)
+	assert.Equal(t, "SKIPPED", response.Accounts[1].Status)
+	assert.Contains(t, response.Accounts[1].Reason, "balance is below the minimum")
+	assert.Equal(t, f.from.ID, eligible.ProductID)
+	f.migrationRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	f.accountRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	assert.Empty(t, f.events.Events)
+}
+
+func TestProductMigrationUseCase_CreateProductMigration_Scheduled(t *testing.T) {
+	f := newProductMigrationFixture(t)
+	account := f.account(1000)
+	f.migrationRepo.On("Create", mock.Anything, mock.AnythingOfType("*entity.ProductMigration")).Return(nil)
+
+	response, err := f.uc.CreateProductMigration(context.Background(), dto.CreateProductMigrationRequest{
+		FromProductID: f.from.ID,
+		ToProductID:   f.to.ID,
+		AccountIDs:    []string{account.ID.String(), account.ID.String()},
+		EffectiveDate: "2024-04-01",
+		RequestedBy:   "alice",
+	})
+
+	// A later effective date leaves the accounts alone until the scheduled run
+	require.NoError(t, err)
+	assert.NotEmpty(t, response.ID)
+	assert.Equal(t, "SCHEDULED", response.Status)
+	assert.Equal(t, "2024-04-01", response.EffectiveDate)
+	assert.Equal(t, dto.ProductMigrationSummary{Accounts: 1, Pending: 1}, response.Summary)
+	assert.Equal(t, f.from.ID, account.ProductID)
+	f.accountRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	require.Len(t, f.events.Events, 1)
+	assert.Equal(t, event.ProductMigrationScheduled, f.events.Events[0].Type)
+}
+
+func TestProductMigrationUseCase_CreateProductMigration_EffectiveToday(t *testing.T) {
+	f := newProductMigrationFixture(t)
+	account := f.account(1000)
+	f.migrationRepo.On("Create", mock.Anything, mock.AnythingOfType("*entity.ProductMigration")).Return(nil)
+	f.migrationRepo.On("Update", mock.Anything, mock.AnythingOfType("*entity.ProductMigration")).Return(nil)
+	f.accountRepo.On("Update", mock.Anything, account).Return(nil)
+
+	response, err := f.uc.CreateProductMigration(context.Background(), dto.CreateProductMigrationRequest{
+		FromProductID: f.from.ID,
+		ToProductID:   f.to.ID,
+		AccountIDs:    []string{account.ID.String()},
+		RequestedBy:   "alice",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "COMPLETED", response.Status)
+	assert.Equal(t, dto.ProductMigrationSummary{Accounts: 1, Migrated: 1}, response.Summary)
+	require.NotNil(t, response.Accounts[0].MigratedAt)
+	assert.Equal(t, f.to.ID, account.ProductID)
+
+	var types []event.Type
+	for _, evt := range f.events.Events {
+		types = append(types, evt.Type)
+	}
+	assert.Equal(t, []event.Type{event.ProductMigrationScheduled, event.AccountUpdated, event.AccountProductMigrated, event.ProductMigrationCompleted}, types)
+}
+
+func TestProductMigrationUseCase_ApplyDueMigrations(t *testing.T) {
+	f := newProductMigrationFixture(t)
+	saved, failing := f.account(1000), f.account(1000)
+	migration, err := entity.NewProductMigration(f.from, f.to, []*entity.Account{saved, failing}, "",
+		productMigrationToday, productMigrationToday, "alice")
+	require.NoError(t, err)
+
+	f.migrationRepo.On("ListDue", mock.Anything, productMigrationToday, dueProductMigrationBatchSize).Return([]*entity.ProductMigration{migration}, nil)
+	f.migrationRepo.On("Update", mock.Anything, migration).Return(nil)
+	f.accountRepo.On("Update", mock.Anything, saved).Return(nil)
+	f.accountRepo.On("Update", mock.Anything, failing).Return(errors.New("database unavailable")).Once()
+
+	// An account that fails to be saved stays pending and keeps the migration scheduled
+	applied, err := f.uc.ApplyDueMigrations(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 0, applied)
+	assert.Equal(t, vo.ProductMigrationStatusScheduled, migration.Status)
+	assert.Equal(t, vo.ProductMigrationAccountMigrated, migration.Accounts[0].Status)
+	assert.Equal(t, vo.ProductMigrationAccountPending, migration.Accounts[1].Status)
+
+	// The next run migrates the rest without touching the account already moved; the repository
+	// still holds the failed account on its old product
+	failing.ProductID = f.from.ID
+	f.accountRepo.On("Update", mock.Anything, failing).Return(nil)
+	applied, err = f.uc.ApplyDueMigrations(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 1, applied)
+	assert.Equal(t, vo.ProductMigrationStatusCompleted, migration.Status)
+	assert.Equal(t, 2, migration.Count(vo.ProductMigrationAccountMigrated))
+	f.accountRepo.AssertNumberOfCalls(t, "Update", 3)
+}
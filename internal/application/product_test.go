@@ -0,0 +1,216 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/application/dto"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type MockProductRepository struct {
+	mock.Mock
+}
+
+func (m *MockProductRepository) Create(ctx context.Context, product *entity.Product) error {
+	args := m.Called(ctx, product)
+	return args.Error(0)
+}
+
+func (m *MockProductRepository) GetByID(ctx context.Context, id string) (*entity.Product, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.Product), args.Error(1)
+}
+
+func (m *MockProductRepository) Update(ctx context.Context, product *entity.Product) error {
+	args := m.Called(ctx, product)
+	return args.Error(0)
+}
+
+func (m *MockProductRepository) List(ctx context.Context) ([]*entity.Product, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]*entity.Product), args.Error(1)
+}
+
+func createTestProduct(t *testing.T) *entity.Product {
+	maxAmount := vo.NewMoneyFromFloat(5000)
+	product, err := entity.NewProduct("Everyday Savings", entity.ProductTypeSavings, "THB", decimal.NewFromFloat(1.25),
+		entity.ProductFees{DebitFee: vo.NewMoneyFromFloat(5), TransferFee: vo.NewMoneyFromFloat(10)},
+		entity.ProductLimits{MinOpeningBalance: vo.NewMoneyFromFloat(500), MaxTransactionAmount: &maxAmount})
+	require.NoError(t, err)
+	return product
+}
+
+func TestAccountUseCase_CreateAccountWithProduct(t *testing.T) {
+	product := createTestProduct(t)
+	retired := createTestProduct(t)
+	retired.SetActive(false)
+
+	tests := []struct {
+		name           string
+		productID      string
+		initialBalance float64
+		currency       string
+		expectedErr    error
+	}{
+		{name: "opened_from_product", productID: product.ID, initialBalance: 1000},
+		{name: "in_product_currency", productID: product.ID, initialBalance: 1000, currency: "thb"},
+		{name: "other_currency_than_product", productID: product.ID, initialBalance: 1000, currency: "USD", expectedErr: errs.ErrCurrencyMismatch},
+		{name: "below_min_opening_balance", productID: product.ID, initialBalance: 100, expectedErr: errs.BusinessError{Code: "MIN_OPENING_BALANCE", Message: "product Everyday Savings requires an opening balance of at least 500.00"}},
+		{name: "retired_product", productID: retired.ID, initialBalance: 1000, expectedErr: errs.ErrProductRetired},
+		{name: "unknown_product", productID: "PRD0", initialBalance: 1000, expectedErr: errs.ErrProductNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(MockAccountRepository)
+			mockProductRepo := new(MockProductRepository)
+			mockCache := new(MockCacheService)
+			mockLogger := new(MockLogger)
+
+			mockRepo.On("GetByAccountName", mock.Anything, "Savings").Return(nil, errs.ErrAccountNotFound)
+			mockRepo.On("Create", mock.Anything, mock.AnythingOfType("*entity.Account")).Return(nil)
+			mockProductRepo.On("GetByID", mock.Anything, product.ID).Return(product, nil)
+			mockProductRepo.On("GetByID", mock.Anything, retired.ID).Return(retired, nil)
+			mockProductRepo.On("GetByID", mock.Anything, "PRD0").Return(nil, errs.ErrProductNotFound)
+			mockCache.On("Set", mock.Anything, mock.Anything, mock.Anything, 15*time.Minute).Return(nil)
+			mockLogger.On("Info", mock.Anything, mock.Anything).Maybe()
+			mockLogger.On("Error", mock.Anything, mock.Anything).Maybe()
+
+			uc := NewAccountUseCase(mockRepo, mockProductRepo, mockCache, nil, &StubEventPublisher{}, vo.AccountNameScopeGlobal, "THB", mockLogger)
+			result, err := uc.CreateAccount(context.Background(), dto.AccountRequest{
+				AccountName:    "Savings",
+				InitialBalance: initialBalance(tt.initialBalance),
+				ProductID:      tt.productID,
+				Currency:       tt.currency,
+			})
+
+			if tt.expectedErr != nil {
+				assert.Equal(t, tt.expectedErr, err)
+				mockRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, product.ID, result.ProductID)
+			assert.Equal(t, "THB", result.Currency)
+		})
+	}
+}
+
+func TestProductPolicy_Apply(t *testing.T) {
+	product := createTestProduct(t)
+	account := createTestAccount()
+	account.ProductID = product.ID
+	plain := createTestAccount()
+	plain.ID = vo.NewAccountID()
+
+	tests := []struct {
+		name        string
+		account     *entity.Account
+		transaction func() (*entity.Transaction, error)
+		expectedFee float64
+		expectedErr error
+	}{
+		{
+			name:    "transfer_fee",
+			account: account,
+			transaction: func() (*entity.Transaction, error) {
+				return entity.NewTransferTransaction(account.ID, vo.NewAccountID(), vo.NewMoneyFromFloat(1000), "Rent", "", time.Now())
+			},
+			expectedFee: 10,
+		},
+		{
+			name:    "debit_fee",
+			account: account,
+			transaction: func() (*entity.Transaction, error) {
+				return entity.NewDebitTransaction(account.ID, vo.NewMoneyFromFloat(1000), "ATM", "", time.Now())
+			},
+			expectedFee: 5,
+		},
+		{
+			name:    "above_product_limit",
+			account: account,
+			transaction: func() (*entity.Transaction, error) {
+				return entity.NewDebitTransaction(account.ID, vo.NewMoneyFromFloat(5000.01), "ATM", "", time.Now())
+			},
+			expectedErr: errs.ErrProductLimitExceeded,
+		},
+		{
+			name:    "account_without_product",
+			account: plain,
+			transaction: func() (*entity.Transaction, error) {
+				return entity.NewDebitTransaction(plain.ID, vo.NewMoneyFromFloat(10000), "ATM", "", time.Now())
+			},
+		},
+		{
+			name: "credit_has_no_source_account",
+			transaction: func() (*entity.Transaction, error) {
+				return entity.NewCreditTransaction(account.ID, vo.NewMoneyFromFloat(10000), "Salary", "", time.Now())
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(MockAccountRepository)
+			mockProductRepo := new(MockProductRepository)
+			mockLogger := new(MockLogger)
+			if tt.account != nil {
+				mockRepo.On("GetByID", mock.Anything, tt.account.ID).Return(tt.account, nil)
+			}
+			mockProductRepo.On("GetByID", mock.Anything, product.ID).Return(product, nil)
+			mockLogger.On("Warn", mock.Anything, mock.Anything).Maybe()
+
+			transaction, err := tt.transaction()
+			require.NoError(t, err)
+
+			err = NewProductPolicy(mockRepo, mockProductRepo, mockLogger).Apply(context.Background(), transaction)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.True(t, transaction.Fee.Equal(vo.NewMoneyFromFloat(tt.expectedFee)), "fee %s", transaction.Fee)
+		})
+	}
+}
+
+func TestProductUseCase_CreateProduct(t *testing.T) {
+	mockProductRepo := new(MockProductRepository)
+	mockLogger := new(MockLogger)
+	mockProductRepo.On("Create", mock.Anything, mock.AnythingOfType("*entity.Product")).Return(nil)
+	mockLogger.On("Info", mock.Anything, mock.Anything).Maybe()
+
+	uc := NewProductUseCase(mockProductRepo, "THB", mockLogger)
+
+	result, err := uc.CreateProduct(context.Background(), dto.CreateProductRequest{
+		Name:         "Business Current",
+		Type:         "BUSINESS",
+		InterestRate: 0.5,
+		Fees:         dto.ProductFees{TransferFee: dto.NewDecimalStringFromFloat(15)},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "THB", result.Currency)
+	assert.Equal(t, "15", result.Fees.TransferFee.String())
+	assert.Nil(t, result.Limits.MaxTransactionAmount)
+	assert.True(t, result.Active)
+
+	dollars, err := uc.CreateProduct(context.Background(), dto.CreateProductRequest{Name: "Dollar Savings", Type: "SAVINGS", Currency: "usd"})
+	require.NoError(t, err)
+	assert.Equal(t, "USD", dollars.Currency)
+
+	_, err = uc.CreateProduct(context.Background(), dto.CreateProductRequest{Name: "Points Savings", Type: "SAVINGS", Currency: "PTS"})
+	assert.IsType(t, errs.ValidationError{}, err)
+	mockProductRepo.AssertNumberOfCalls(t, "Create", 2)
+}
@@ -0,0 +1,340 @@
+// internal/application/referral.go
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/application/dto"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/event"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/infra"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/repository"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+)
+
+// ReferralProgram holds the terms of the referral program
+type ReferralProgram struct {
+	ReferrerBonus       vo.Money // Paid to the account whose code was redeemed; zero pays nothing
+	RefereeBonus        vo.Money // Paid to the account that redeemed the code; zero pays nothing
+	MinQualifyingAmount vo.Money // Smallest payment of the referee that earns the bonuses
+	DailyLimit          int      // Most redemptions of a referrer's code in 24 hours
+}
+
+// ReferralRewarder pays the referral bonuses to the referrer and the referee when the referee's
+// first qualifying payment completes. It wraps the event publisher so the bonuses are paid once,
+// on the instance that completed the payment.
+type ReferralRewarder struct {
+	referralRepo repository.ReferralRepository
+	credits      *bonusCredits
+	program      ReferralProgram
+	next         infra.EventPublisher
+	logger       infra.Logger
+}
+
+// NewReferralRewarder creates a referral rewarder publishing through next
+func NewReferralRewarder(
+	referralRepo repository.ReferralRepository,
+	accountRepo repository.AccountRepository,
+	transactionRepo repository.TransactionRepository,
+	cache infra.CacheService,
+	program ReferralProgram,
+	next infra.EventPublisher,
+	valueDating *ValueDatingPolicy,
+	logger infra.Logger,
+) *ReferralRewarder {
+	return &ReferralRewarder{
+		referralRepo: referralRepo,
+		credits: &bonusCredits{
+			accountRepo:     accountRepo,
+			transactionRepo: transactionRepo,
+			cache:           cache,
+			events:          next,
+			valueDating:     valueDating,
+			logger:          logger,
+		},
+		program: program,
+		next:    next,
+		logger:  logger,
+	}
+}
+
+// Publish forwards the event and rewards referrals on qualifying payments
+func (r *ReferralRewarder) Publish(ctx context.Context, evt event.Event) error {
+	err := r.next.Publish(ctx, evt)
+
+	if evt.Type == event.TransactionCompleted {
+		r.reward(ctx, evt)
+	}
+
+	return err
+}
+
+// reward pays the bonuses of the paying account's pending referral if the payment qualifies
+func (r *ReferralRewarder) reward(ctx context.Context, evt event.Event) {
+	payload, err := evt.DecodeTransaction()
+	if err != nil {
+		r.logger.Warn("Failed to decode transaction event", "error", err, "eventID", evt.ID)
+		return
+	}
+
+	// Only payments out of the referee's account qualify
+	if payload.FromAccountID == nil {
+		return
+	}
+
+	amount, err := vo.NewMoneyFromString(payload.Amount)
+	if err != nil || amount.LessThan(r.program.MinQualifyingAmount) {
+		return
+	}
+
+	accountID, err := vo.NewAccountIDFromString(*payload.FromAccountID)
+	if err != nil {
+		return
+	}
+	transactionID, err := vo.NewTransactionIDFromString(payload.TransactionID)
+	if err != nil {
+		return
+	}
+
+	referral, err := r.referralRepo.GetPendingByRefereeAccountID(ctx, accountID)
+	if err != nil {
+		if !errors.Is(err, errs.ErrReferralNotFound) {
+			r.logger.Warn("Failed to load referral", "error", err, "accountID", accountID.String())
+		}
+		return
+	}
+
+	if err := r.pay(ctx, referral, transactionID); err != nil {
+		r.logger.Warn("Failed to pay referral bonuses", "error", err, "referralID", referral.ID)
+	}
+}
+
+// pay credits the bonuses of a referral, once
+func (r *ReferralRewarder) pay(ctx context.Context, referral *entity.Referral, transactionID vo.TransactionID) error {
+	var credits []*entity.Transaction
+
+	referrerCredit, err := r.createCredit(ctx, referral, referral.ReferrerAccountID, r.program.ReferrerBonus)
+	if err != nil {
+		return err
+	}
+	if referrerCredit != nil {
+		credits = append(credits, referrerCredit)
+	}
+
+	refereeCredit, err := r.createCredit(ctx, referral, referral.RefereeAccountID, r.program.RefereeBonus)
+	if err != nil {
+		return err
+	}
+	if refereeCredit != nil {
+		credits = append(credits, refereeCredit)
+	}
+
+	if err := referral.Reward(transactionID, referrerCredit, refereeCredit); err != nil {
+		return err
+	}
+
+	rewarded, err := r.referralRepo.MarkRewarded(ctx, referral)
+	if err != nil {
+		return err
+	}
+	if !rewarded {
+		for _, credit := range credits {
+			if err := r.credits.cancel(ctx, credit); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	for _, credit := range credits {
+		if err := r.credits.settle(ctx, credit); err != nil {
+			return err
+		}
+	}
+
+	r.logger.Info("Referral rewarded",
+		"referralID", referral.ID,
+		"referrerAccountID", referral.ReferrerAccountID.String(),
+		"refereeAccountID", referral.RefereeAccountID.String(),
+		"transactionID", transactionID.String())
+	return nil
+}
+
+// createCredit saves the pending bonus credit of one side of a referral, nil when the bonus is zero
+func (r *ReferralRewarder) createCredit(ctx context.Context, referral *entity.Referral, accountID vo.AccountID, bonus vo.Money) (*entity.Transaction, error) {
+	if !bonus.IsPositive() {
+		return nil, nil
+	}
+	return r.credits.create(ctx, accountID, bonus, "Referral bonus", referral.Reference())
+}
+
+// Ensure ReferralRewarder can stand in for the event publisher it wraps
+var _ infra.EventPublisher = (*ReferralRewarder)(nil)
+
+type referralUseCase struct {
+	referralRepo repository.ReferralRepository
+	accountRepo  repository.AccountRepository
+	program      ReferralProgram
+	valueDating  *ValueDatingPolicy
+	logger       infra.Logger
+	mapper       *dto.ReferralMapper
+}
+
+// NewReferralUseCase creates a new referral use case
+func NewReferralUseCase(
+	referralRepo repository.ReferralRepository,
+	accountRepo repository.AccountRepository,
+	program ReferralProgram,
+	valueDating *ValueDatingPolicy,
+	logger infra.Logger,
+) ReferralUseCase {
+	return &referralUseCase{
+		referralRepo: referralRepo,
+		accountRepo:  accountRepo,
+		program:      program,
+		valueDating:  valueDating,
+		logger:       logger,
+		mapper:       &dto.ReferralMapper{},
+	}
+}
+
+// GetReferralCode retrieves the referral code of an account, creating it on first use
+func (uc *referralUseCase) GetReferralCode(ctx context.Context, accountID string) (*dto.ReferralCodeResponse, error) {
+	parsedAccountID, err := vo.NewAccountIDFromString(accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := uc.accountRepo.GetByID(ctx, parsedAccountID); err != nil {
+		return nil, err
+	}
+
+	// Retry the rare code collision with another account
+	for attempt := 0; attempt < 3; attempt++ {
+		code, err := uc.referralRepo.GetCodeByAccountID(ctx, parsedAccountID)
+		if err == nil {
+			response := uc.mapper.ToCodeResponse(code)
+			return &response, nil
+		}
+		if !errors.Is(err, errs.ErrReferralCodeNotFound) {
+			uc.logger.Error("Failed to get referral code", "error", err, "accountID", accountID)
+			return nil, err
+		}
+
+		code = entity.NewReferralCode(parsedAccountID)
+		created, err := uc.referralRepo.CreateCode(ctx, code)
+		if err != nil {
+			uc.logger.Error("Failed to create referral code", "error", err, "accountID", accountID)
+			return nil, err
+		}
+		if created {
+			uc.logger.Info("Referral code created", "accountID", accountID)
+			response := uc.mapper.ToCodeResponse(code)
+			return &response, nil
+		}
+	}
+
+	return nil, errs.ErrInternalError
+}
+
+// RedeemReferral records an account redeeming another account's referral code. An account, and an
+// account's customer, can be referred once, and a code is redeemed at most DailyLimit times a day.
+func (uc *referralUseCase) RedeemReferral(ctx context.Context, req dto.RedeemReferralRequest) (*dto.ReferralResponse, error) {
+	refereeAccountID, err := vo.NewAccountIDFromString(req.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	code, err := uc.referralRepo.GetCode(ctx, req.Code)
+	if err != nil {
+		return nil, err
+	}
+
+	referrer, err := uc.accountRepo.GetByID(ctx, code.AccountID)
+	if err != nil {
+		return nil, err
+	}
+	referee, err := uc.accountRepo.GetByID(ctx, refereeAccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := uc.valueDating.Now()
+	referral, err := entity.NewReferral(code, referrer, referee, now)
+	if err != nil {
+		return nil, err
+	}
+
+	if referee.CustomerID != "" {
+		redeemed, err := uc.referralRepo.CountByRefereeCustomerID(ctx, referee.CustomerID)
+		if err != nil {
+			uc.logger.Error("Failed to count customer referrals", "error", err, "customerID", referee.CustomerID)
+			return nil, err
+		}
+		if redeemed > 0 {
+			return nil, errs.ErrReferralAlreadyRedeemed
+		}
+	}
+
+	recent, err := uc.referralRepo.CountByReferrerSince(ctx, referrer.ID, now.Add(-24*time.Hour))
+	if err != nil {
+		uc.logger.Error("Failed to count recent referrals", "error", err, "accountID", referrer.ID.String())
+		return nil, err
+	}
+	if recent >= int64(uc.program.DailyLimit) {
+		uc.logger.Warn("Referral code redemption limit reached", "code", code.Code, "accountID", referrer.ID.String())
+		return nil, errs.ErrReferralLimitExceeded
+	}
+
+	created, err := uc.referralRepo.Create(ctx, referral)
+	if err != nil {
+		uc.logger.Error("Failed to create referral", "error", err, "code", code.Code)
+		return nil, err
+	}
+	if !created {
+		return nil, errs.ErrReferralAlreadyRedeemed
+	}
+
+	uc.logger.Info("Referral code redeemed",
+		"referralID", referral.ID,
+		"referrerAccountID", referrer.ID.String(),
+		"refereeAccountID", referee.ID.String())
+
+	response := uc.mapper.ToResponse(referral)
+	return &response, nil
+}
+
+// ListReferrals retrieves the referrals an account made, newest first
+func (uc *referralUseCase) ListReferrals(ctx context.Context, accountID string, req dto.ListRequest) (*dto.ReferralListResponse, error) {
+	parsedAccountID, err := vo.NewAccountIDFromString(accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	offset := (req.Page - 1) * req.PageSize
+
+	referrals, err := uc.referralRepo.ListByReferrerAccountID(ctx, parsedAccountID, req.PageSize, offset)
+	if err != nil {
+		uc.logger.Error("Failed to list referrals", "error", err, "accountID", accountID)
+		return nil, err
+	}
+
+	total, err := uc.referralRepo.CountByReferrerAccountID(ctx, parsedAccountID)
+	if err != nil {
+		uc.logger.Error("Failed to count referrals", "error", err, "accountID", accountID)
+		return nil, err
+	}
+
+	responses := make([]dto.ReferralResponse, len(referrals))
+	for i, referral := range referrals {
+		responses[i] = uc.mapper.ToResponse(referral)
+	}
+
+	return &dto.ReferralListResponse{
+		Referrals:  responses,
+		Pagination: dto.NewPaginationInfo(req.Page, req.PageSize, total),
+	}, nil
+}
@@ -0,0 +1,235 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/application/dto"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/event"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type MockReferralRepository struct {
+	mock.Mock
+}
+
+func (m *MockReferralRepository) CreateCode(ctx context.Context, code *entity.ReferralCode) (bool, error) {
+	args := m.Called(ctx, code)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockReferralRepository) GetCode(ctx context.Context, code string) (*entity.ReferralCode, error) {
+	args := m.Called(ctx, code)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.ReferralCode), args.Error(1)
+}
+
+func (m *MockReferralRepository) GetCodeByAccountID(ctx context.Context, accountID vo.AccountID) (*entity.ReferralCode, error) {
+	args := m.Called(ctx, accountID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.ReferralCode), args.Error(1)
+}
+
+func (m *MockReferralRepository) Create(ctx context.Context, referral *entity.Referral) (bool, error) {
+	args := m.Called(ctx, referral)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockReferralRepository) GetPendingByRefereeAccountID(ctx context.Context, accountID vo.AccountID) (*entity.Referral, error) {
+	args := m.Called(ctx, accountID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.Referral), args.Error(1)
+}
+
+func (m *MockReferralRepository) MarkRewarded(ctx context.Context, referral *entity.Referral) (bool, error) {
+	args := m.Called(ctx, referral)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockReferralRepository) CountByRefereeCustomerID(ctx context.Context, customerID string) (int64, error) {
+	args := m.Called(ctx, customerID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockReferralRepository) CountByReferrerSince(ctx context.Context, accountID vo.AccountID, since time.Time) (int64, error) {
+	args := m.Called(ctx, accountID, since)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockReferralRepository) ListByReferrerAccountID(ctx context.Context, accountID vo.AccountID, limit, offset int) ([]*entity.Referral, error) {
+	args := m.Called(ctx, accountID, limit, offset)
+	return args.Get(0).([]*entity.Referral), args.Error(1)
+}
+
+func (m *MockReferralRepository) CountByReferrerAccountID(ctx context.Context, accountID vo.AccountID) (int64, error) {
+	args := m.Called(ctx, accountID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+var testReferralProgram = ReferralProgram{
+	ReferrerBonus:       vo.NewMoneyFromFloat(100),
+	RefereeBonus:        vo.NewMoneyFromFloat(50),
+	MinQualifyingAmount: vo.NewMoneyFromFloat(200),
+	DailyLimit:          2,
+}
+
+func createTestReferral(t *testing.T) (*entity.Referral, *entity.Account, *entity.Account) {
+	referrer := createTestAccount()
+	referee := createTestAccount()
+	referral, err := entity.NewReferral(entity.NewReferralCode(referrer.ID), referrer, referee, time.Now())
+	require.NoError(t, err)
+	return referral, referrer, referee
+}
+
+func TestReferralRewarder_Publish(t *testing.T) {
+	tests := []struct {
+		name     string
+		amount   float64
+		rewarded bool
+		paid     bool
+	}{
+		{name: "qualifying_payment", amount: 200, rewarded: true, paid: true},
+		{name: "below_minimum", amount: 199.99},
+		{name: "rewarded_by_another_completion", amount: 500, rewarded: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			referral, referrer, referee := createTestReferral(t)
+			payment, err := entity.NewDebitTransaction(referee.ID, vo.NewMoneyFromFloat(tt.amount), "Groceries", "", time.Now())
+			payment = completedTransaction(t, payment, err)
+
+			mockReferralRepo := new(MockReferralRepository)
+			mockAccountRepo := new(MockAccountRepository)
+			mockTxnRepo := new(MockTransactionRepository)
+			mockCache := new(MockCacheService)
+			mockLogger := new(MockLogger)
+			mockLogger.On("Info", mock.Anything, mock.Anything).Maybe()
+
+			var credits []*entity.Transaction
+			mockReferralRepo.On("GetPendingByRefereeAccountID", mock.Anything, referee.ID).Return(referral, nil)
+			mockReferralRepo.On("MarkRewarded", mock.Anything, referral).Return(tt.rewarded, nil)
+			mockTxnRepo.On("Create", mock.Anything, mock.AnythingOfType("*entity.Transaction")).
+				Run(func(args mock.Arguments) { credits = append(credits, args.Get(1).(*entity.Transaction)) }).
+				Return(nil)
+			mockTxnRepo.On("Update", mock.Anything, mock.AnythingOfType("*entity.Transaction")).Return(nil)
+			mockAccountRepo.On("GetByID", mock.Anything, referrer.ID).Return(referrer, nil)
+			mockAccountRepo.On("GetByID", mock.Anything, referee.ID).Return(referee, nil)
+			mockAccountRepo.On("Update", mock.Anything, mock.AnythingOfType("*entity.Account")).Return(nil)
+			mockCache.On("Delete", mock.Anything, mock.Anything).Return(nil)
+
+			next := &StubEventPublisher{}
+			rewarder := NewReferralRewarder(mockReferralRepo, mockAccountRepo, mockTxnRepo, mockCache, testReferralProgram, next, NewValueDatingPolicy(&StubCalendar{}, vo.ProcessingWindow{}, nil), mockLogger)
+
+			require.NoError(t, rewarder.Publish(context.Background(), event.NewTransactionEvent(event.TransactionCompleted, payment)))
+
+			if tt.amount < 200 {
+				mockReferralRepo.AssertNotCalled(t, "GetPendingByRefereeAccountID", mock.Anything, mock.Anything)
+				assert.Len(t, next.Events, 1)
+				return
+			}
+
+			require.Len(t, credits, 2)
+			for _, credit := range credits {
+				assert.Equal(t, referral.Reference(), credit.Reference)
+			}
+
+			if !tt.paid {
+				for _, credit := range credits {
+					assert.Equal(t, vo.TransactionStatusCancelled, credit.Status)
+				}
+				assert.True(t, referrer.Balance.Equal(vo.NewMoneyFromFloat(1000)))
+				assert.Len(t, next.Events, 1)
+				return
+			}
+
+			assert.Equal(t, entity.ReferralStatusRewarded, referral.Status)
+			assert.Equal(t, payment.ID, *referral.QualifyingTransactionID)
+			assert.True(t, referrer.Balance.Equal(vo.NewMoneyFromFloat(1100)))
+			assert.True(t, referee.Balance.Equal(vo.NewMoneyFromFloat(1050)))
+			assert.Len(t, next.Events, 3)
+		})
+	}
+}
+
+func TestReferralUseCase_RedeemReferral(t *testing.T) {
+	tests := []struct {
+		name          string
+		customerID    string
+		customerCount int64
+		recentCount   int64
+		created       bool
+		expectedError error
+	}{
+		{name: "redeemed", created: true},
+		{name: "customer_already_referred", customerID: "CUST-1", customerCount: 1, expectedError: errs.ErrReferralAlreadyRedeemed},
+		{name: "daily_limit_reached", recentCount: 2, expectedError: errs.ErrReferralLimitExceeded},
+		{name: "account_already_referred", created: false, expectedError: errs.ErrReferralAlreadyRedeemed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			referrer := createTestAccount()
+			referee := createTestAccount()
+			referee.CustomerID = tt.customerID
+			code := entity.NewReferralCode(referrer.ID)
+
+			mockReferralRepo := new(MockReferralRepository)
+			mockAccountRepo := new(MockAccountRepository)
+			mockLogger := new(MockLogger)
+			mockLogger.On("Info", mock.Anything, mock.Anything).Maybe()
+			mockLogger.On("Warn", mock.Anything, mock.Anything).Maybe()
+
+			mockReferralRepo.On("GetCode", mock.Anything, code.Code).Return(code, nil)
+			mockAccountRepo.On("GetByID", mock.Anything, referrer.ID).Return(referrer, nil)
+			mockAccountRepo.On("GetByID", mock.Anything, referee.ID).Return(referee, nil)
+			mockReferralRepo.On("CountByRefereeCustomerID", mock.Anything, tt.customerID).Return(tt.customerCount, nil)
+			// The day of redemptions counted is the day before the business clock
+			clock := &StubClock{At: time.Date(2024, 5, 17, 15, 0, 0, 0, time.UTC)}
+			mockReferralRepo.On("CountByReferrerSince", mock.Anything, referrer.ID, clock.At.Add(-24*time.Hour)).Return(tt.recentCount, nil)
+			mockReferralRepo.On("Create", mock.Anything, mock.AnythingOfType("*entity.Referral")).Return(tt.created, nil)
+
+			valueDating := NewValueDatingPolicy(&StubCalendar{}, vo.ProcessingWindow{}, clock)
+			uc := NewReferralUseCase(mockReferralRepo, mockAccountRepo, testReferralProgram, valueDating, mockLogger)
+
+			result, err := uc.RedeemReferral(context.Background(), dto.RedeemReferralRequest{Code: code.Code, AccountID: referee.ID.String()})
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "PENDING", result.Status)
+			assert.Equal(t, referrer.ID.String(), result.ReferrerAccountID)
+		})
+	}
+}
+
+func TestReferralUseCase_RedeemOwnCode(t *testing.T) {
+	account := createTestAccount()
+	code := entity.NewReferralCode(account.ID)
+
+	mockReferralRepo := new(MockReferralRepository)
+	mockAccountRepo := new(MockAccountRepository)
+	mockReferralRepo.On("GetCode", mock.Anything, code.Code).Return(code, nil)
+	mockAccountRepo.On("GetByID", mock.Anything, account.ID).Return(account, nil)
+
+	uc := NewReferralUseCase(mockReferralRepo, mockAccountRepo, testReferralProgram, NewValueDatingPolicy(&StubCalendar{}, vo.ProcessingWindow{}, nil), new(MockLogger))
+
+	_, err := uc.RedeemReferral(context.Background(), dto.RedeemReferralRequest{Code: code.Code, AccountID: account.ID.String()})
+
+	assert.ErrorIs(t, err, errs.ErrSelfReferral)
+	mockReferralRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
@@ -0,0 +1,115 @@
+// internal/application/replay.go
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/application/dto"
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+)
+
+// ListFailedTransactions retrieves failed transactions by failure kind, oldest first; the kind
+// defaults to INFRASTRUCTURE, the failures that can be replayed
+func (uc *transactionUseCase) ListFailedTransactions(ctx context.Context, kind string, req dto.ListRequest) (*dto.TransactionListResponse, error) {
+	failureKind := vo.FailureKindInfrastructure
+	if kind != "" {
+		failureKind = vo.FailureKind(kind)
+	}
+	if !failureKind.IsValid() {
+		return nil, errs.ValidationError{Field: "kind", Message: fmt.Sprintf("invalid failure kind: %s", kind)}
+	}
+
+	offset := (req.Page - 1) * req.PageSize
+
+	transactions, err := uc.transactionRepo.GetFailedByKind(ctx, failureKind, req.PageSize, offset)
+	if err != nil {
+		uc.logger.Error("Failed to get failed transactions from repository", "error", err, "kind", failureKind)
+		return nil, err
+	}
+
+	total, err := uc.transactionRepo.CountFailedByKind(ctx, failureKind)
+	if err != nil {
+		uc.logger.Error("Failed to count failed transactions", "error", err, "kind", failureKind)
+		return nil, err
+	}
+
+	pagination := dto.NewPaginationInfo(req.Page, req.PageSize, total)
+	response := uc.mapper.ToResponseList(transactions, pagination)
+	return &response, nil
+}
+
+// ReplayTransaction processes a transaction that failed on infrastructure again, under the same
+// lock and idempotency key as a confirmation. The transaction is put back to PENDING and saved
+// before it is processed, so a crash mid-replay leaves a confirmable transaction rather than a
+// failed one with moved money. Replaying a transaction that has since completed returns it unchanged.
+func (uc *transactionUseCase) ReplayTransaction(ctx context.Context, req dto.ReplayTransactionRequest) (*dto.TransactionResponse, error) {
+	transactionID, err := vo.NewTransactionIDFromString(req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	lockKey := fmt.Sprintf("lock:transaction:%s", req.ID)
+	lockToken, lockAcquired, err := uc.acquireDistributedLock(ctx, lockKey, 30*time.Second)
+	if err != nil {
+		return nil, fmt.Errorf("failed to acquire lock: %w", err)
+	}
+	if !lockAcquired {
+		return nil, errs.ErrTransactionAlreadyInProgress
+	}
+	defer func() {
+		if err := uc.releaseLock(ctx, lockKey, lockToken); err != nil {
+			uc.logger.Warn("Failed to release distributed lock", "error", err, "transactionID", req.ID)
+		}
+	}()
+
+	transaction, err := uc.transactionRepo.GetByID(ctx, transactionID)
+	if err != nil {
+		return nil, errs.ErrTransactionNotFound
+	}
+
+	if transaction.Status.IsCompleted() {
+		uc.logger.Info("Transaction already completed, nothing to replay", "transactionID", req.ID)
+		response := uc.mapper.ToResponse(transaction)
+		return &response, nil
+	}
+
+	// A transfer saga that did not fully compensate may have moved money already
+	if transaction.FromAccountID != nil && transaction.ToAccountID != nil {
+		saga, err := uc.sagas.sagaRepo.GetByTransactionID(ctx, transactionID)
+		switch {
+		case errors.Is(err, errs.ErrSagaNotFound):
+		case err != nil:
+			uc.logger.Error("Failed to load saga for replay", "error", err, "transactionID", req.ID)
+			return nil, err
+		case saga.Status != vo.SagaStatusCompensated:
+			return nil, fmt.Errorf("%w: saga is %s", errs.ErrTransactionNotReplayable, saga.Status)
+		}
+	}
+
+	if err := uc.claimTransaction(ctx, transaction, lockToken); err != nil {
+		return nil, err
+	}
+
+	if err := transaction.PrepareReplay(); err != nil {
+		return nil, err
+	}
+
+	if err := uc.transactionRepo.Update(ctx, transaction); err != nil {
+		uc.logger.Error("Failed to record transaction replay", "error", err, "transactionID", req.ID)
+		return nil, err
+	}
+
+	uc.logger.Info("Replaying failed transaction", "transactionID", req.ID, "requestedBy", req.RequestedBy, "replayCount", transaction.ReplayCount)
+
+	response, err := uc.finalize(ctx, transaction, fmt.Sprintf("confirm_transaction:%s", req.ID))
+	if err != nil {
+		return nil, err
+	}
+
+	uc.logger.Info("Transaction replayed successfully", "transactionID", req.ID, "requestedBy", req.RequestedBy)
+	return response, nil
+}
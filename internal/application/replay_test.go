@@ -0,0 +1,107 @@
+package usecase
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/application/dto"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/event"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestFailureKind(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		kind vo.FailureKind
+	}{
+		{name: "insufficient balance", err: errs.ErrInsufficientBalance, kind: vo.FailureKindBusiness},
+		{name: "wrapped business error", err: errors.Join(errors.New("debit_source"), errs.ErrSpendingLimitExceeded), kind: vo.FailureKindBusiness},
+		{name: "business error type", err: errs.BusinessError{Code: "ACCOUNT_FROZEN", Message: "account is frozen"}, kind: vo.FailureKindBusiness},
+		{name: "validation error type", err: errs.ValidationError{Field: "amount", Message: "invalid"}, kind: vo.FailureKindBusiness},
+		{name: "database error", err: errors.New("connection reset by peer"), kind: vo.FailureKindInfrastructure},
+		{name: "read-only database", err: errs.ErrDatabaseReadOnly, kind: vo.FailureKindInfrastructure},
+		{name: "compensation failed after a business error", err: fmt.Errorf("%w: %w", errs.ErrSagaCompensationFailed, errs.ErrInsufficientBalance), kind: vo.FailureKindInfrastructure},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.kind, failureKind(tt.err))
+		})
+	}
+}
+
+func (suite *TransactionUseCaseTestSuite) TestReplayTransaction() {
+	id := suite.expectConfirmationLock()
+
+	suite.mockTxnRepo.On("GetByID", suite.ctx, suite.testTransaction.ID).Return(suite.testTransaction, nil)
+	suite.mockTxnRepo.On("Update", suite.ctx, suite.testTransaction).Return(nil)
+	suite.mockAccountRepo.On("GetByIDForUpdate", suite.ctx, suite.testAccount.ID).Return(suite.testAccount, nil)
+	suite.mockAccountRepo.On("UpdateFenced", suite.ctx, suite.testAccount, suite.testTransaction.ID, mock.AnythingOfType("int64")).Return(errors.New("connection reset by peer")).Once()
+
+	// The confirmation fails on the database, not on a business rule
+	_, err := suite.usecase.ConfirmTransaction(suite.ctx, dto.ConfirmTransactionRequest{ID: id})
+	suite.Require().Error(err)
+	assert.Equal(suite.T(), vo.TransactionStatusFailed, suite.testTransaction.Status)
+	assert.Equal(suite.T(), vo.FailureKindInfrastructure, suite.testTransaction.FailureKind)
+	assert.Equal(suite.T(), "connection reset by peer", suite.testTransaction.FailureReason)
+
+	// The failed update never reached the database
+	suite.testAccount.Balance = vo.NewMoneyFromFloat(1000)
+	suite.mockAccountRepo.On("UpdateFenced", suite.ctx, suite.testAccount, suite.testTransaction.ID, mock.AnythingOfType("int64")).Return(nil)
+	suite.mockCache.On("Set", suite.ctx, "confirm_transaction:"+id, mock.Anything, 24*time.Hour).Return(nil)
+	suite.mockCache.On("Delete", suite.ctx, "account:"+suite.testAccount.ID.String()).Return(nil)
+
+	result, err := suite.usecase.ReplayTransaction(suite.ctx, dto.ReplayTransactionRequest{ID: id, RequestedBy: "alice"})
+
+	suite.Require().NoError(err)
+	assert.Equal(suite.T(), "COMPLETED", result.Status)
+	assert.Equal(suite.T(), 1, result.ReplayCount)
+	assert.Empty(suite.T(), result.FailureKind)
+	suite.Require().Len(suite.mockEvents.Events, 2)
+	assert.Equal(suite.T(), event.TransactionFailed, suite.mockEvents.Events[0].Type)
+	assert.Equal(suite.T(), event.TransactionCompleted, suite.mockEvents.Events[1].Type)
+
+	// Replaying again returns the completed transaction without processing it twice
+	result, err = suite.usecase.ReplayTransaction(suite.ctx, dto.ReplayTransactionRequest{ID: id, RequestedBy: "alice"})
+	suite.Require().NoError(err)
+	assert.Equal(suite.T(), "COMPLETED", result.Status)
+	assert.True(suite.T(), suite.testAccount.Balance.Equal(vo.NewMoneyFromFloat(900)))
+	suite.mockAccountRepo.AssertNumberOfCalls(suite.T(), "UpdateFenced", 2)
+}
+
+func (suite *TransactionUseCaseTestSuite) TestReplayTransaction_BusinessFailure() {
+	id := suite.expectConfirmationLock()
+	suite.Require().NoError(suite.testTransaction.Fail(vo.FailureKindBusiness, errs.ErrInsufficientBalance.Error()))
+
+	suite.mockTxnRepo.On("GetByID", suite.ctx, suite.testTransaction.ID).Return(suite.testTransaction, nil)
+
+	_, err := suite.usecase.ReplayTransaction(suite.ctx, dto.ReplayTransactionRequest{ID: id, RequestedBy: "alice"})
+
+	assert.ErrorIs(suite.T(), err, errs.ErrTransactionNotReplayable)
+	suite.mockTxnRepo.AssertNotCalled(suite.T(), "Update", mock.Anything, mock.Anything)
+}
+
+func (suite *TransactionUseCaseTestSuite) TestListFailedTransactions() {
+	req := dto.ListRequest{Page: 1, PageSize: 10}
+	suite.Require().NoError(suite.testTransaction.Fail(vo.FailureKindInfrastructure, "timeout"))
+
+	suite.mockTxnRepo.On("GetFailedByKind", suite.ctx, vo.FailureKindInfrastructure, 10, 0).Return([]*entity.Transaction{suite.testTransaction}, nil)
+	suite.mockTxnRepo.On("CountFailedByKind", suite.ctx, vo.FailureKindInfrastructure).Return(int64(1), nil)
+
+	result, err := suite.usecase.ListFailedTransactions(suite.ctx, "", req)
+
+	suite.Require().NoError(err)
+	suite.Require().Len(result.Transactions, 1)
+	assert.Equal(suite.T(), "INFRASTRUCTURE", result.Transactions[0].FailureKind)
+	assert.Equal(suite.T(), "timeout", result.Transactions[0].FailureReason)
+
+	_, err = suite.usecase.ListFailedTransactions(suite.ctx, "NETWORK", req)
+	assert.IsType(suite.T(), errs.ValidationError{}, err)
+}
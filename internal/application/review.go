@@ -0,0 +1,262 @@
+// internal/application/review.go
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/application/dto"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/event"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+)
+
+const (
+	// overdueReviewBatchSize caps how many overdue reviews one sweep declines
+	overdueReviewBatchSize = 100
+	// reviewTimeoutReviewer is recorded as the reviewer of reviews declined by the SLA timer
+	reviewTimeoutReviewer = "system"
+	// defaultReviewClaimTTL is how long a claim holds when no review policy sets it
+	defaultReviewClaimTTL = 5 * time.Minute
+	// defaultReviewSLA is how long a review waits for a decision when no review policy sets it
+	defaultReviewSLA = 24 * time.Hour
+)
+
+// ReviewPolicy decides which confirmations the fraud rules hold for review, how long an
+// admin has to decide before the transaction is declined automatically and how long an
+// admin's claim on a review lasts
+type ReviewPolicy struct {
+	engine   *FraudEngine
+	sla      time.Duration
+	claimTTL time.Duration
+}
+
+// NewReviewPolicy creates a review policy; a nil engine holds nothing
+func NewReviewPolicy(engine *FraudEngine, sla, claimTTL time.Duration) *ReviewPolicy {
+	return &ReviewPolicy{
+		engine:   engine,
+		sla:      sla,
+		claimTTL: claimTTL,
+	}
+}
+
+// ClaimTTL returns how long a claim on a review holds unless it is renewed
+func (p *ReviewPolicy) ClaimTTL() time.Duration {
+	if p == nil || p.claimTTL <= 0 {
+		return defaultReviewClaimTTL
+	}
+	return p.claimTTL
+}
+
+// DueAt returns until when a transaction placed in review at now waits for a decision
+func (p *ReviewPolicy) DueAt(now time.Time) time.Time {
+	if p == nil || p.sla <= 0 {
+		return now.Add(defaultReviewSLA)
+	}
+	return now.Add(p.sla)
+}
+
+// Hold screens a transaction at now and returns why and until when it must be held for review
+func (p *ReviewPolicy) Hold(ctx context.Context, transaction *entity.Transaction, now time.Time) (string, time.Time, bool) {
+	if p == nil {
+		return "", time.Time{}, false
+	}
+
+	reason, hold := p.engine.Screen(ctx, transaction)
+	if !hold {
+		return "", time.Time{}, false
+	}
+	return reason, now.Add(p.sla), true
+}
+
+// holdForReview moves a transaction into review instead of processing it
+func (uc *transactionUseCase) holdForReview(ctx context.Context, transaction *entity.Transaction, reason string, dueAt time.Time) (*dto.TransactionResponse, error) {
+	transactionID := transaction.ID.String()
+
+	if err := transaction.PlaceInReview(reason, dueAt, uc.valueDating.Now()); err != nil {
+		return nil, err
+	}
+
+	if err := uc.transactionRepo.Update(ctx, transaction); err != nil {
+		uc.logger.Error("Failed to hold transaction for review", "error", err, "transactionID", transactionID)
+		return nil, err
+	}
+
+	response := uc.mapper.ToResponse(transaction)
+	uc.cacheTransaction(ctx, response)
+	uc.publish(ctx, event.NewTransactionEvent(event.TransactionInReview, transaction))
+
+	uc.logger.Warn("Transaction held for review", "transactionID", transactionID, "reason", reason, "reviewDueAt", dueAt)
+	return &response, nil
+}
+
+// ListReviews retrieves the transactions held for review with who has claimed them; the queue is never served from cache
+func (uc *transactionUseCase) ListReviews(ctx context.Context, req dto.ListRequest) (*dto.TransactionListResponse, error) {
+	offset := (req.Page - 1) * req.PageSize
+
+	transactions, err := uc.transactionRepo.GetByStatus(ctx, vo.TransactionStatusReview, req.PageSize, offset)
+	if err != nil {
+		uc.logger.Error("Failed to list transactions in review", "error", err)
+		return nil, err
+	}
+
+	total, err := uc.transactionRepo.CountByStatus(ctx, vo.TransactionStatusReview)
+	if err != nil {
+		uc.logger.Error("Failed to count transactions in review", "error", err)
+		return nil, err
+	}
+
+	response := uc.mapper.ToResponseList(transactions, dto.NewPaginationInfo(req.Page, req.PageSize, total))
+	uc.attachReviewClaims(ctx, response.Transactions)
+	return &response, nil
+}
+
+// ApproveTransaction releases a transaction held for review and processes it like a confirmation
+func (uc *transactionUseCase) ApproveTransaction(ctx context.Context, req dto.ReviewDecisionRequest) (*dto.TransactionResponse, error) {
+	var response *dto.TransactionResponse
+
+	err := uc.decideReview(ctx, req.ID, func(transaction *entity.Transaction) error {
+		if err := uc.checkReviewClaim(ctx, req.ID, req.Reviewer); err != nil {
+			return err
+		}
+		if err := transaction.ApproveReview(req.Reviewer); err != nil {
+			return err
+		}
+
+		var err error
+		response, err = uc.finalize(ctx, transaction, fmt.Sprintf("confirm_transaction:%s", req.ID))
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	uc.logger.Info("Reviewed transaction approved", "transactionID", req.ID, "reviewer", req.Reviewer)
+	return response, nil
+}
+
+// DeclineTransaction fails a transaction held for review
+func (uc *transactionUseCase) DeclineTransaction(ctx context.Context, req dto.ReviewDecisionRequest) (*dto.TransactionResponse, error) {
+	var response *dto.TransactionResponse
+
+	err := uc.decideReview(ctx, req.ID, func(transaction *entity.Transaction) error {
+		if err := uc.checkReviewClaim(ctx, req.ID, req.Reviewer); err != nil {
+			return err
+		}
+
+		var err error
+		response, err = uc.decline(ctx, transaction, req.Reviewer, req.Reason)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	uc.logger.Info("Reviewed transaction declined", "transactionID", req.ID, "reviewer", req.Reviewer)
+	return response, nil
+}
+
+// DeclineOverdueReviews declines the transactions whose review deadline has passed and returns how many it declined
+func (uc *transactionUseCase) DeclineOverdueReviews(ctx context.Context) (int, error) {
+	overdue, err := uc.transactionRepo.GetOverdueReviews(ctx, uc.valueDating.Now(), overdueReviewBatchSize)
+	if err != nil {
+		uc.logger.Error("Failed to load overdue reviews", "error", err)
+		return 0, err
+	}
+
+	declined := 0
+	for _, candidate := range overdue {
+		err := uc.decideReview(ctx, candidate.ID.String(), func(transaction *entity.Transaction) error {
+			// An admin may have decided while the sweep was running
+			if !transaction.IsReviewOverdue(uc.valueDating.Now()) {
+				return nil
+			}
+			_, err := uc.decline(ctx, transaction, reviewTimeoutReviewer, "review deadline passed without a decision")
+			if err == nil {
+				declined++
+			}
+			return err
+		})
+		if err != nil {
+			uc.logger.Warn("Failed to decline overdue review", "error", err, "transactionID", candidate.ID.String())
+		}
+	}
+
+	if declined > 0 {
+		uc.logger.Info("Declined overdue reviews", "count", declined)
+	}
+	return declined, nil
+}
+
+// decideReview loads a transaction held for review under the confirmation lock and applies a decision.
+// The claim on a review is dropped once the decision takes it out of review.
+func (uc *transactionUseCase) decideReview(ctx context.Context, id string, decide func(*entity.Transaction) error) error {
+	transactionID, err := vo.NewTransactionIDFromString(id)
+	if err != nil {
+		return err
+	}
+
+	lockKey := fmt.Sprintf("lock:transaction:%s", id)
+	lockToken, lockAcquired, err := uc.acquireDistributedLock(ctx, lockKey, 30*time.Second)
+	if err != nil {
+		return fmt.Errorf("failed to acquire lock: %w", err)
+	}
+	if !lockAcquired {
+		return errs.ErrTransactionAlreadyInProgress
+	}
+	defer func() {
+		if err := uc.releaseLock(ctx, lockKey, lockToken); err != nil {
+			uc.logger.Warn("Failed to release distributed lock", "error", err, "transactionID", id)
+		}
+	}()
+
+	transaction, err := uc.transactionRepo.GetByID(ctx, transactionID)
+	if err != nil {
+		return errs.ErrTransactionNotFound
+	}
+
+	if !transaction.Status.IsInReview() {
+		return errs.ErrTransactionNotInReview
+	}
+
+	if err := uc.claimTransaction(ctx, transaction, lockToken); err != nil {
+		return err
+	}
+
+	if err := decide(transaction); err != nil {
+		return err
+	}
+
+	if !transaction.Status.IsInReview() {
+		uc.releaseReviewClaim(ctx, id)
+	}
+	return nil
+}
+
+// decline fails a transaction held for review and publishes the failure
+func (uc *transactionUseCase) decline(ctx context.Context, transaction *entity.Transaction, reviewer, reason string) (*dto.TransactionResponse, error) {
+	if err := transaction.DeclineReview(reviewer, reason); err != nil {
+		return nil, err
+	}
+
+	if err := uc.transactionRepo.Update(ctx, transaction); err != nil {
+		uc.logger.Error("Failed to decline transaction", "error", err, "transactionID", transaction.ID.String())
+		return nil, err
+	}
+
+	response := uc.mapper.ToResponse(transaction)
+	uc.cacheTransaction(ctx, response)
+	uc.publish(ctx, event.NewTransactionEvent(event.TransactionFailed, transaction))
+
+	return &response, nil
+}
+
+// cacheTransaction refreshes the cached copy of a transaction after its status changed
+func (uc *transactionUseCase) cacheTransaction(ctx context.Context, response dto.TransactionResponse) {
+	cacheKey := fmt.Sprintf("transaction:%s", response.ID)
+	if err := uc.cache.Set(ctx, cacheKey, response, 30*time.Minute); err != nil {
+		uc.logger.Warn("Failed to update transaction cache", "error", err, "transactionID", response.ID)
+	}
+}
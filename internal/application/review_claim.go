@@ -0,0 +1,167 @@
+// internal/application/review_claim.go
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/application/dto"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/infra"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+)
+
+// reviewQueuePageSize is how many reviews the queue metrics load per page
+const reviewQueuePageSize = 100
+
+// reviewClaim is an admin's hold on a transaction in the review queue; it lapses with its cache entry
+type reviewClaim struct {
+	Reviewer  string    `json:"reviewer"`
+	ClaimedAt time.Time `json:"claimed_at"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// ClaimReview locks a transaction held for review to one admin until the claim TTL passes.
+// Claiming a review the admin already holds renews the claim.
+func (uc *transactionUseCase) ClaimReview(ctx context.Context, req dto.ReviewClaimRequest) (*dto.ReviewClaimResponse, error) {
+	var claim reviewClaim
+
+	err := uc.decideReview(ctx, req.ID, func(transaction *entity.Transaction) error {
+		if err := uc.checkReviewClaim(ctx, req.ID, req.Reviewer); err != nil {
+			return err
+		}
+
+		now := uc.valueDating.Now()
+		ttl := uc.review.ClaimTTL()
+		claim = reviewClaim{Reviewer: req.Reviewer, ClaimedAt: now, ExpiresAt: now.Add(ttl)}
+		return uc.cache.Set(ctx, reviewClaimKey(req.ID), claim, ttl)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	uc.logger.Info("Review claimed", "transactionID", req.ID, "reviewer", req.Reviewer, "expiresAt", claim.ExpiresAt)
+	return &dto.ReviewClaimResponse{
+		TransactionID: req.ID,
+		ClaimedBy:     claim.Reviewer,
+		ClaimedAt:     claim.ClaimedAt,
+		ExpiresAt:     claim.ExpiresAt,
+	}, nil
+}
+
+// ReleaseReview gives up an admin's claim so another admin can pick the review up
+func (uc *transactionUseCase) ReleaseReview(ctx context.Context, req dto.ReviewClaimRequest) error {
+	err := uc.decideReview(ctx, req.ID, func(transaction *entity.Transaction) error {
+		current, ok, err := uc.loadReviewClaim(ctx, req.ID)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return errs.ErrReviewNotClaimed
+		}
+		if current.Reviewer != req.Reviewer {
+			return fmt.Errorf("%w: %s", errs.ErrReviewClaimed, current.Reviewer)
+		}
+		return uc.cache.Delete(ctx, reviewClaimKey(req.ID))
+	})
+	if err != nil {
+		return err
+	}
+
+	uc.logger.Info("Review released", "transactionID", req.ID, "reviewer", req.Reviewer)
+	return nil
+}
+
+// GetReviewQueueMetrics reports the size and age of the review queue, including how much of it is claimed
+func (uc *transactionUseCase) GetReviewQueueMetrics(ctx context.Context) (*dto.ReviewQueueMetricsResponse, error) {
+	now := uc.valueDating.Now()
+	metrics := &dto.ReviewQueueMetricsResponse{}
+
+	var totalAge, oldestAge time.Duration
+	for offset := 0; ; offset += reviewQueuePageSize {
+		transactions, err := uc.transactionRepo.GetByStatus(ctx, vo.TransactionStatusReview, reviewQueuePageSize, offset)
+		if err != nil {
+			uc.logger.Error("Failed to load review queue", "error", err)
+			return nil, err
+		}
+
+		for _, transaction := range transactions {
+			age := transaction.ReviewAge(now)
+			totalAge += age
+			if age > oldestAge {
+				oldestAge = age
+			}
+
+			metrics.Depth++
+			// A claim that cannot be read counts as unclaimed; the cache failure is already on record
+			if _, ok, _ := uc.loadReviewClaim(ctx, transaction.ID.String()); ok {
+				metrics.Claimed++
+			}
+			if transaction.IsReviewOverdue(now) {
+				metrics.Overdue++
+			}
+		}
+
+		if len(transactions) < reviewQueuePageSize {
+			break
+		}
+	}
+
+	metrics.Unclaimed = metrics.Depth - metrics.Claimed
+	metrics.OldestAgeSeconds = oldestAge.Seconds()
+	if metrics.Depth > 0 {
+		metrics.AverageAgeSeconds = (totalAge / time.Duration(metrics.Depth)).Seconds()
+	}
+
+	return metrics, nil
+}
+
+// checkReviewClaim checks that a review is unclaimed or claimed by the deciding admin.
+// A claim that cannot be read blocks the decision, since another admin may hold it.
+func (uc *transactionUseCase) checkReviewClaim(ctx context.Context, id, reviewer string) error {
+	current, ok, err := uc.loadReviewClaim(ctx, id)
+	if err != nil {
+		return err
+	}
+	if ok && current.Reviewer != reviewer {
+		return fmt.Errorf("%w: %s", errs.ErrReviewClaimed, current.Reviewer)
+	}
+	return nil
+}
+
+// attachReviewClaims shows who is working each review of a listing; claims that cannot be read are left out
+func (uc *transactionUseCase) attachReviewClaims(ctx context.Context, responses []dto.TransactionResponse) {
+	for i := range responses {
+		if claim, ok, _ := uc.loadReviewClaim(ctx, responses[i].ID); ok {
+			expiresAt := claim.ExpiresAt
+			responses[i].ClaimedBy = claim.Reviewer
+			responses[i].ClaimExpiresAt = &expiresAt
+		}
+	}
+}
+
+// loadReviewClaim returns the live claim on a review; a lapsed claim counts as none
+func (uc *transactionUseCase) loadReviewClaim(ctx context.Context, id string) (reviewClaim, bool, error) {
+	var claim reviewClaim
+	if err := uc.cache.Get(ctx, reviewClaimKey(id), &claim); err != nil {
+		if errors.Is(err, infra.ErrCacheMiss) {
+			return reviewClaim{}, false, nil
+		}
+		return reviewClaim{}, false, fmt.Errorf("%w: failed to load review claim: %w", errs.ErrTransient, err)
+	}
+	return claim, claim.Reviewer != "" && uc.valueDating.Now().Before(claim.ExpiresAt), nil
+}
+
+// releaseReviewClaim drops the claim on a review once it has been decided
+func (uc *transactionUseCase) releaseReviewClaim(ctx context.Context, id string) {
+	if err := uc.cache.Delete(ctx, reviewClaimKey(id)); err != nil {
+		uc.logger.Warn("Failed to release review claim", "error", err, "transactionID", id)
+	}
+}
+
+func reviewClaimKey(id string) string {
+	return fmt.Sprintf("review_claim:%s", id)
+}
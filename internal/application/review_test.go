@@ -0,0 +1,381 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/application/dto"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/event"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/infra"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// failingFraudRule is a fraud rule whose backing check is unavailable
+type failingFraudRule struct{}
+
+func (failingFraudRule) Name() string { return "failing" }
+
+func (failingFraudRule) Evaluate(ctx context.Context, transaction *entity.Transaction) (string, error) {
+	return "", errors.New("velocity store unavailable")
+}
+
+func TestFraudEngine_Screen(t *testing.T) {
+	transaction, err := entity.NewDebitTransaction(vo.NewAccountID(), vo.NewMoneyFromFloat(1000), "Test", "", time.Now())
+	require.NoError(t, err)
+
+	mockLogger := new(MockLogger)
+	mockLogger.On("Warn", mock.Anything, mock.Anything).Maybe()
+
+	tests := []struct {
+		name       string
+		engine     *FraudEngine
+		expectHold bool
+		reason     string
+	}{
+		{name: "no engine", engine: nil},
+		{name: "no rules", engine: NewFraudEngine(mockLogger)},
+		{name: "below threshold", engine: NewFraudEngine(mockLogger, LargeAmountRule{Threshold: vo.NewMoneyFromFloat(1000.01)})},
+		{
+			name:       "at threshold",
+			engine:     NewFraudEngine(mockLogger, LargeAmountRule{Threshold: vo.NewMoneyFromFloat(1000)}),
+			expectHold: true,
+			reason:     "amount 1000.00 reaches the review threshold of 1000.00",
+		},
+		{
+			name:       "rule error holds",
+			engine:     NewFraudEngine(mockLogger, LargeAmountRule{Threshold: vo.NewMoneyFromFloat(5000)}, failingFraudRule{}),
+			expectHold: true,
+			reason:     "failing: rule could not be evaluated",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reason, hold := tt.engine.Screen(context.Background(), transaction)
+
+			assert.Equal(t, tt.expectHold, hold)
+			assert.Equal(t, tt.reason, reason)
+		})
+	}
+}
+
+// expectConfirmationLock sets up the cache calls of an uncached confirmation of the test transaction.
+// The review is unclaimed unless a claim was set up before.
+func (suite *TransactionUseCaseTestSuite) expectConfirmationLock() string {
+	id := suite.testTransaction.ID.String()
+	suite.mockCache.On("Get", suite.ctx, "confirm_transaction:"+id, mock.Anything).Return(infra.ErrCacheMiss).Maybe()
+	suite.mockCache.On("Get", suite.ctx, "review_claim:"+id, mock.Anything).Return(infra.ErrCacheMiss).Maybe()
+	suite.mockCache.On("Delete", suite.ctx, "review_claim:"+id).Return(nil).Maybe()
+	suite.mockLocks.On("Acquire", suite.ctx, "lock:transaction:"+id, 30*time.Second).Return(int64(1), true, nil)
+	suite.mockLocks.On("Release", suite.ctx, "lock:transaction:"+id, int64(1)).Return(nil)
+	suite.mockCache.On("Set", suite.ctx, "transaction:"+id, mock.Anything, 30*time.Minute).Return(nil)
+	suite.expectClaim(true)
+	return id
+}
+
+// expectClaim makes claims on the test transaction succeed, recording the token like the repository, or fail
+func (suite *TransactionUseCaseTestSuite) expectClaim(claimed bool) {
+	suite.mockTxnRepo.On("ClaimProcessing", suite.ctx, suite.testTransaction, mock.AnythingOfType("int64")).
+		Run(func(args mock.Arguments) {
+			if claimed {
+				suite.testTransaction.ProcessingToken = args.Get(2).(int64)
+			}
+		}).
+		Return(claimed, nil).Maybe()
+}
+
+func (suite *TransactionUseCaseTestSuite) TestConfirmTransaction_HeldForReview() {
+	suite.usecase.(*transactionUseCase).review = NewReviewPolicy(NewFraudEngine(suite.mockLogger, LargeAmountRule{Threshold: vo.NewMoneyFromFloat(50)}), time.Hour, time.Minute)
+	id := suite.expectConfirmationLock()
+
+	suite.mockTxnRepo.On("GetByID", suite.ctx, suite.testTransaction.ID).Return(suite.testTransaction, nil)
+	suite.mockTxnRepo.On("Update", suite.ctx, suite.testTransaction).Return(nil)
+
+	result, err := suite.usecase.ConfirmTransaction(suite.ctx, dto.ConfirmTransactionRequest{ID: id})
+
+	suite.Require().NoError(err)
+	assert.Equal(suite.T(), "REVIEW", result.Status)
+	assert.Contains(suite.T(), result.ReviewReason, "review threshold")
+	suite.Require().NotNil(result.ReviewDueAt)
+	assert.WithinDuration(suite.T(), time.Now().Add(time.Hour), *result.ReviewDueAt, time.Minute)
+	suite.mockAccountRepo.AssertNotCalled(suite.T(), "Update", mock.Anything, mock.Anything)
+	suite.Require().Len(suite.mockEvents.Events, 1)
+	assert.Equal(suite.T(), event.TransactionInReview, suite.mockEvents.Events[0].Type)
+
+	// Confirming again leaves the transaction in review
+	result, err = suite.usecase.ConfirmTransaction(suite.ctx, dto.ConfirmTransactionRequest{ID: id})
+	suite.Require().NoError(err)
+	assert.Equal(suite.T(), "REVIEW", result.Status)
+	suite.mockTxnRepo.AssertNumberOfCalls(suite.T(), "Update", 1)
+}
+
+func (suite *TransactionUseCaseTestSuite) TestApproveTransaction() {
+	suite.Require().NoError(suite.testTransaction.PlaceInReview("large amount", time.Now().Add(time.Hour), time.Now()))
+	id := suite.expectConfirmationLock()
+
+	suite.mockTxnRepo.On("GetByID", suite.ctx, suite.testTransaction.ID).Return(suite.testTransaction, nil)
+	suite.mockTxnRepo.On("Update", suite.ctx, suite.testTransaction).Return(nil)
+	suite.mockAccountRepo.On("GetByIDForUpdate", suite.ctx, suite.testAccount.ID).Return(suite.testAccount, nil)
+	suite.mockAccountRepo.On("UpdateFenced", suite.ctx, suite.testAccount, suite.testTransaction.ID, mock.AnythingOfType("int64")).Return(nil)
+	suite.mockCache.On("Set", suite.ctx, "confirm_transaction:"+id, mock.Anything, 24*time.Hour).Return(nil)
+	suite.mockCache.On("Delete", suite.ctx, "account:"+suite.testAccount.ID.String()).Return(nil)
+
+	result, err := suite.usecase.ApproveTransaction(suite.ctx, dto.ReviewDecisionRequest{ID: id, Reviewer: "alice"})
+
+	suite.Require().NoError(err)
+	assert.Equal(suite.T(), "COMPLETED", result.Status)
+	assert.Equal(suite.T(), "alice", result.ReviewedBy)
+	assert.True(suite.T(), suite.testAccount.Balance.Equal(vo.NewMoneyFromFloat(900)))
+
+	// A decided review cannot be decided again
+	_, err = suite.usecase.DeclineTransaction(suite.ctx, dto.ReviewDecisionRequest{ID: id, Reviewer: "bob"})
+	assert.ErrorIs(suite.T(), err, errs.ErrTransactionNotInReview)
+}
+
+func (suite *TransactionUseCaseTestSuite) TestDeclineTransaction() {
+	suite.Require().NoError(suite.testTransaction.PlaceInReview("large amount", time.Now().Add(time.Hour), time.Now()))
+	id := suite.expectConfirmationLock()
+
+	suite.mockTxnRepo.On("GetByID", suite.ctx, suite.testTransaction.ID).Return(suite.testTransaction, nil)
+	suite.mockTxnRepo.On("Update", suite.ctx, suite.testTransaction).Return(nil)
+
+	result, err := suite.usecase.DeclineTransaction(suite.ctx, dto.ReviewDecisionRequest{ID: id, Reviewer: "bob", Reason: "unusual recipient"})
+
+	suite.Require().NoError(err)
+	assert.Equal(suite.T(), "FAILED", result.Status)
+	assert.Equal(suite.T(), "unusual recipient", result.ReviewReason)
+	suite.mockAccountRepo.AssertNotCalled(suite.T(), "Update", mock.Anything, mock.Anything)
+	suite.Require().Len(suite.mockEvents.Events, 1)
+	assert.Equal(suite.T(), event.TransactionFailed, suite.mockEvents.Events[0].Type)
+}
+
+func (suite *TransactionUseCaseTestSuite) TestDeclineOverdueReviews() {
+	suite.Require().NoError(suite.testTransaction.PlaceInReview("large amount", time.Now().Add(-time.Minute), time.Now()))
+	suite.expectConfirmationLock()
+
+	suite.mockTxnRepo.On("GetOverdueReviews", suite.ctx, mock.Anything, overdueReviewBatchSize).Return([]*entity.Transaction{suite.testTransaction}, nil)
+	suite.mockTxnRepo.On("GetByID", suite.ctx, suite.testTransaction.ID).Return(suite.testTransaction, nil)
+	suite.mockTxnRepo.On("Update", suite.ctx, suite.testTransaction).Return(nil)
+
+	declined, err := suite.usecase.DeclineOverdueReviews(suite.ctx)
+
+	suite.Require().NoError(err)
+	assert.Equal(suite.T(), 1, declined)
+	assert.Equal(suite.T(), vo.TransactionStatusFailed, suite.testTransaction.Status)
+	assert.Equal(suite.T(), reviewTimeoutReviewer, suite.testTransaction.ReviewedBy)
+}
+
+// expectReviewClaim makes the test transaction's review claimed by a reviewer
+func (suite *TransactionUseCaseTestSuite) expectReviewClaim(reviewer string) {
+	claim := reviewClaim{Reviewer: reviewer, ClaimedAt: time.Now(), ExpiresAt: time.Now().Add(time.Minute)}
+	suite.mockCache.On("Get", suite.ctx, "review_claim:"+suite.testTransaction.ID.String(), mock.Anything).
+		Run(func(args mock.Arguments) { *args.Get(2).(*reviewClaim) = claim }).
+		Return(nil)
+}
+
+func (suite *TransactionUseCaseTestSuite) TestClaimReview() {
+	suite.Require().NoError(suite.testTransaction.PlaceInReview("large amount", time.Now().Add(time.Hour), time.Now()))
+	id := suite.expectConfirmationLock()
+
+	suite.mockTxnRepo.On("GetByID", suite.ctx, suite.testTransaction.ID).Return(suite.testTransaction, nil)
+	suite.mockCache.On("Set", suite.ctx, "review_claim:"+id, mock.AnythingOfType("usecase.reviewClaim"), defaultReviewClaimTTL).Return(nil)
+
+	result, err := suite.usecase.ClaimReview(suite.ctx, dto.ReviewClaimRequest{ID: id, Reviewer: "alice"})
+
+	suite.Require().NoError(err)
+	assert.Equal(suite.T(), "alice", result.ClaimedBy)
+	assert.WithinDuration(suite.T(), time.Now().Add(defaultReviewClaimTTL), result.ExpiresAt, time.Second)
+	assert.Equal(suite.T(), vo.TransactionStatusReview, suite.testTransaction.Status)
+}
+
+func (suite *TransactionUseCaseTestSuite) TestClaimReview_ClaimedByAnotherAdmin() {
+	suite.Require().NoError(suite.testTransaction.PlaceInReview("large amount", time.Now().Add(time.Hour), time.Now()))
+	suite.expectReviewClaim("alice")
+	id := suite.expectConfirmationLock()
+
+	suite.mockTxnRepo.On("GetByID", suite.ctx, suite.testTransaction.ID).Return(suite.testTransaction, nil)
+
+	_, err := suite.usecase.ClaimReview(suite.ctx, dto.ReviewClaimRequest{ID: id, Reviewer: "bob"})
+	assert.ErrorIs(suite.T(), err, errs.ErrReviewClaimed)
+
+	_, err = suite.usecase.ApproveTransaction(suite.ctx, dto.ReviewDecisionRequest{ID: id, Reviewer: "bob"})
+	assert.ErrorIs(suite.T(), err, errs.ErrReviewClaimed)
+
+	err = suite.usecase.ReleaseReview(suite.ctx, dto.ReviewClaimRequest{ID: id, Reviewer: "bob"})
+	assert.ErrorIs(suite.T(), err, errs.ErrReviewClaimed)
+
+	suite.mockTxnRepo.AssertNotCalled(suite.T(), "Update", mock.Anything, mock.Anything)
+	suite.mockCache.AssertNotCalled(suite.T(), "Delete", suite.ctx, "review_claim:"+id)
+}
+
+func (suite *TransactionUseCaseTestSuite) TestDeclineTransaction_ReleasesClaim() {
+	suite.Require().NoError(suite.testTransaction.PlaceInReview("large amount", time.Now().Add(time.Hour), time.Now()))
+	suite.expectReviewClaim("bob")
+	id := suite.expectConfirmationLock()
+
+	suite.mockTxnRepo.On("GetByID", suite.ctx, suite.testTransaction.ID).Return(suite.testTransaction, nil)
+	suite.mockTxnRepo.On("Update", suite.ctx, suite.testTransaction).Return(nil)
+
+	_, err := suite.usecase.DeclineTransaction(suite.ctx, dto.ReviewDecisionRequest{ID: id, Reviewer: "bob"})
+
+	suite.Require().NoError(err)
+	suite.mockCache.AssertCalled(suite.T(), "Delete", suite.ctx, "review_claim:"+id)
+}
+
+func (suite *TransactionUseCaseTestSuite) TestGetReviewQueueMetrics() {
+	suite.Require().NoError(suite.testTransaction.PlaceInReview("large amount", time.Now().Add(-time.Minute), time.Now()))
+	heldAt := time.Now().Add(-2 * time.Hour)
+	suite.testTransaction.ReviewHeldAt = &heldAt
+	suite.expectReviewClaim("alice")
+
+	fresh, err := entity.NewDebitTransaction(suite.testAccount.ID, vo.NewMoneyFromFloat(10), "Test", "", time.Now())
+	suite.Require().NoError(err)
+	suite.Require().NoError(fresh.PlaceInReview("large amount", time.Now().Add(time.Hour), time.Now()))
+	suite.mockCache.On("Get", suite.ctx, "review_claim:"+fresh.ID.String(), mock.Anything).Return(infra.ErrCacheMiss)
+
+	suite.mockTxnRepo.On("GetByStatus", suite.ctx, vo.TransactionStatusReview, reviewQueuePageSize, 0).
+		Return([]*entity.Transaction{suite.testTransaction, fresh}, nil)
+
+	metrics, err := suite.usecase.GetReviewQueueMetrics(suite.ctx)
+
+	suite.Require().NoError(err)
+	assert.Equal(suite.T(), 2, metrics.Depth)
+	assert.Equal(suite.T(), 1, metrics.Claimed)
+	assert.Equal(suite.T(), 1, metrics.Unclaimed)
+	assert.Equal(suite.T(), 1, metrics.Overdue)
+	assert.InDelta(suite.T(), 7200, metrics.OldestAgeSeconds, 5)
+	assert.InDelta(suite.T(), 3600, metrics.AverageAgeSeconds, 5)
+}
+
+func (suite *TransactionUseCaseTestSuite) TestListReviews_ShowsClaims() {
+	suite.Require().NoError(suite.testTransaction.PlaceInReview("large amount", time.Now().Add(time.Hour), time.Now()))
+	suite.expectReviewClaim("alice")
+
+	suite.mockTxnRepo.On("GetByStatus", suite.ctx, vo.TransactionStatusReview, 10, 0).Return([]*entity.Transaction{suite.testTransaction}, nil)
+	suite.mockTxnRepo.On("CountByStatus", suite.ctx, vo.TransactionStatusReview).Return(int64(1), nil)
+
+	result, err := suite.usecase.ListReviews(suite.ctx, dto.ListRequest{Page: 1, PageSize: 10})
+
+	suite.Require().NoError(err)
+	suite.Require().Len(result.Transactions, 1)
+	assert.Equal(suite.T(), "alice", result.Transactions[0].ClaimedBy)
+	assert.NotNil(suite.T(), result.Transactions[0].ClaimExpiresAt)
+}
+
+func (suite *TransactionUseCaseTestSuite) TestApproveTransaction_ClaimUnreadable() {
+	suite.Require().NoError(suite.testTransaction.PlaceInReview("large amount", time.Now().Add(time.Hour), time.Now()))
+	id := suite.testTransaction.ID.String()
+	suite.mockLocks.On("Acquire", suite.ctx, "lock:transaction:"+id, 30*time.Second).Return(int64(1), true, nil)
+	suite.mockLocks.On("Release", suite.ctx, "lock:transaction:"+id, int64(1)).Return(nil)
+	suite.mockCache.On("Get", suite.ctx, "review_claim:"+id, mock.Anything).Return(errors.New("connection refused"))
+	suite.mockTxnRepo.On("GetByID", suite.ctx, suite.testTransaction.ID).Return(suite.testTransaction, nil)
+	suite.expectClaim(true)
+
+	// Another admin may hold the claim, so an unreadable claim blocks the decision
+	_, err := suite.usecase.ApproveTransaction(suite.ctx, dto.ReviewDecisionRequest{ID: id, Reviewer: "alice"})
+
+	assert.ErrorIs(suite.T(), err, errs.ErrTransient)
+	assert.Equal(suite.T(), vo.TransactionStatusReview, suite.testTransaction.Status)
+}
+
+func (suite *TransactionUseCaseTestSuite) TestCancelTransaction_WithdrawsFromReview() {
+	suite.Require().NoError(suite.testTransaction.PlaceInReview("large amount", time.Now().Add(time.Hour), time.Now()))
+	id := suite.expectConfirmationLock()
+
+	var saga *entity.Saga
+	suite.mockSagaRepo.On("Create", suite.ctx, mock.AnythingOfType("*entity.Saga")).
+		Run(func(args mock.Arguments) { saga = args.Get(1).(*entity.Saga) }).
+		Return(nil)
+	suite.mockSagaRepo.On("Update", suite.ctx, mock.AnythingOfType("*entity.Saga")).Return(nil)
+	suite.mockTxnRepo.On("GetByID", suite.ctx, suite.testTransaction.ID).Return(suite.testTransaction, nil)
+	suite.mockTxnRepo.On("Update", suite.ctx, suite.testTransaction).Return(nil)
+
+	result, err := suite.usecase.CancelTransaction(suite.ctx, dto.CancelTransactionRequest{ID: id})
+
+	suite.Require().NoError(err)
+	assert.Equal(suite.T(), dto.CancellationOutcomeCancelled, result.Outcome)
+	assert.Empty(suite.T(), result.Reversals)
+	assert.Equal(suite.T(), vo.TransactionStatusCancelled, suite.testTransaction.Status)
+	suite.Require().Len(suite.mockEvents.Events, 1)
+	assert.Equal(suite.T(), event.TransactionCancelled, suite.mockEvents.Events[0].Type)
+
+	suite.Require().NotNil(saga)
+	assert.Equal(suite.T(), entity.SagaTypeCancellation, saga.Type)
+	assert.Equal(suite.T(), vo.SagaStatusCompleted, saga.Status)
+	assert.Equal(suite.T(), vo.SagaStepStatusCompleted, saga.Steps[0].Status)
+	assert.Equal(suite.T(), vo.SagaStepStatusSkipped, saga.Steps[1].Status)
+	assert.Equal(suite.T(), vo.SagaStepStatusSkipped, saga.Steps[2].Status)
+	suite.mockAccountRepo.AssertNotCalled(suite.T(), "UpdateFenced", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *TransactionUseCaseTestSuite) TestCancelTransaction_ReversesSettledTransaction() {
+	inReview := *suite.testTransaction
+	suite.Require().NoError(inReview.PlaceInReview("large amount", time.Now().Add(time.Hour), time.Now()))
+	settled := inReview
+	suite.Require().NoError(settled.ApproveReview("alice"))
+	suite.Require().NoError(settled.MarkAsCompleted(time.Now()))
+	suite.testAccount.Balance = vo.NewMoneyFromFloat(900)
+
+	var saga *entity.Saga
+	suite.mockSagaRepo.On("Create", suite.ctx, mock.AnythingOfType("*entity.Saga")).
+		Run(func(args mock.Arguments) { saga = args.Get(1).(*entity.Saga) }).
+		Return(nil)
+	suite.mockSagaRepo.On("Update", suite.ctx, mock.AnythingOfType("*entity.Saga")).Return(nil)
+
+	// The approval settles the transaction between the cancellation request and its saga
+	suite.mockTxnRepo.On("GetByID", suite.ctx, suite.testTransaction.ID).Return(&inReview, nil).Once()
+	suite.mockTxnRepo.On("GetByID", suite.ctx, suite.testTransaction.ID).Return(&settled, nil)
+
+	// The reversal is recorded and processed like any credit
+	suite.mockCache.On("Set", suite.ctx, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	suite.mockCache.On("Delete", suite.ctx, mock.Anything).Return(nil)
+	suite.mockLocks.On("Acquire", suite.ctx, mock.Anything, mock.Anything).Return(int64(1), true, nil)
+	suite.mockLocks.On("Release", suite.ctx, mock.Anything, int64(1)).Return(nil)
+	suite.mockTxnRepo.On("Create", suite.ctx, mock.AnythingOfType("*entity.Transaction")).Return(nil)
+	suite.mockTxnRepo.On("ClaimProcessing", suite.ctx, mock.AnythingOfType("*entity.Transaction"), mock.AnythingOfType("int64")).Return(true, nil)
+	suite.mockTxnRepo.On("Update", suite.ctx, mock.AnythingOfType("*entity.Transaction")).Return(nil)
+	suite.mockAccountRepo.On("GetByIDForUpdate", suite.ctx, suite.testAccount.ID).Return(suite.testAccount, nil)
+	suite.mockAccountRepo.On("UpdateFenced", suite.ctx, suite.testAccount, mock.Anything, mock.AnythingOfType("int64")).Return(nil)
+
+	result, err := suite.usecase.CancelTransaction(suite.ctx, dto.CancelTransactionRequest{ID: suite.testTransaction.ID.String()})
+
+	suite.Require().NoError(err)
+	assert.Equal(suite.T(), dto.CancellationOutcomeReversed, result.Outcome)
+	suite.Require().Len(result.Reversals, 1)
+	assert.Equal(suite.T(), "REVERSAL", result.Reversals[0].TransactionType)
+	assert.Equal(suite.T(), suite.testTransaction.ID.String(), *result.Reversals[0].ReversedTransactionID)
+	assert.Equal(suite.T(), "COMPLETED", result.Reversals[0].Status)
+	assert.Equal(suite.T(), "REVERSAL-"+suite.testTransaction.ID.String(), result.Reversals[0].Reference)
+	assert.True(suite.T(), vo.NewMoneyFromFloat(1000).Equal(suite.testAccount.Balance))
+
+	// No fee was charged, so there is none to refund
+	suite.Require().NotNil(saga)
+	assert.Equal(suite.T(), vo.SagaStatusCompleted, saga.Status)
+	assert.Equal(suite.T(), vo.SagaStepStatusCompleted, saga.Steps[1].Status)
+	assert.Equal(suite.T(), vo.SagaStepStatusSkipped, saga.Steps[2].Status)
+}
+
+func (suite *TransactionUseCaseTestSuite) TestCancelTransaction_DeclinedBeforeWithdrawal() {
+	inReview := *suite.testTransaction
+	suite.Require().NoError(inReview.PlaceInReview("large amount", time.Now().Add(time.Hour), time.Now()))
+	declined := inReview
+	suite.Require().NoError(declined.DeclineReview("bob", "unusual recipient"))
+	id := suite.expectConfirmationLock()
+
+	suite.mockSagaRepo.On("Create", suite.ctx, mock.AnythingOfType("*entity.Saga")).Return(nil)
+	suite.mockSagaRepo.On("Update", suite.ctx, mock.AnythingOfType("*entity.Saga")).Return(nil)
+	suite.mockTxnRepo.On("GetByID", suite.ctx, suite.testTransaction.ID).Return(&inReview, nil).Once()
+	suite.mockTxnRepo.On("GetByID", suite.ctx, suite.testTransaction.ID).Return(&declined, nil)
+
+	_, err := suite.usecase.CancelTransaction(suite.ctx, dto.CancelTransactionRequest{ID: id})
+
+	assert.ErrorIs(suite.T(), err, errs.ErrTransactionCannotBeCancelled)
+	suite.mockTxnRepo.AssertNotCalled(suite.T(), "Create", mock.Anything, mock.Anything)
+}
@@ -0,0 +1,154 @@
+// internal/application/saga.go
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hydr0g3nz/mini_bank/internal/application/dto"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/infra"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/repository"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+)
+
+// sagaStep is one leg of a saga. Compensate undoes a completed Execute and may be nil
+// for steps with no side effects. Skip, when set, is asked just before the step would run
+// and lets earlier steps decide that it is not needed.
+type sagaStep struct {
+	name       string
+	execute    func(ctx context.Context) error
+	compensate func(ctx context.Context) error
+	skip       func() bool
+}
+
+// sagaCoordinator executes saga steps in order, persisting progress after every
+// transition and compensating completed steps in reverse order when a step fails
+type sagaCoordinator struct {
+	sagaRepo repository.SagaRepository
+	logger   infra.Logger
+}
+
+// run executes the steps as a saga and returns the error of the failed step, if any
+func (c *sagaCoordinator) run(ctx context.Context, sagaType string, transactionID vo.TransactionID, steps []sagaStep) error {
+	names := make([]string, len(steps))
+	for i, step := range steps {
+		names[i] = step.name
+	}
+
+	saga := entity.NewSaga(sagaType, transactionID, names...)
+	if err := c.sagaRepo.Create(ctx, saga); err != nil {
+		c.logger.Error("Failed to record saga", "error", err, "transactionID", transactionID.String())
+		return fmt.Errorf("failed to record saga: %w", err)
+	}
+
+	for i, step := range steps {
+		if step.skip != nil && step.skip() {
+			saga.MarkStepSkipped(i)
+			c.save(ctx, saga)
+			continue
+		}
+
+		if err := step.execute(ctx); err != nil {
+			c.logger.Warn("Saga step failed, compensating",
+				"error", err,
+				"sagaID", saga.ID,
+				"step", step.name)
+			saga.MarkStepFailed(i, err.Error())
+			c.save(ctx, saga)
+			if !c.compensate(ctx, saga, steps[:i]) {
+				return fmt.Errorf("%w: %w", errs.ErrSagaCompensationFailed, err)
+			}
+			return err
+		}
+
+		saga.MarkStepCompleted(i)
+		c.save(ctx, saga)
+	}
+
+	saga.MarkAsCompleted()
+	c.save(ctx, saga)
+
+	return nil
+}
+
+// compensate undoes completed steps in reverse order, stopping at the first step that cannot be undone,
+// and reports whether every step was undone
+func (c *sagaCoordinator) compensate(ctx context.Context, saga *entity.Saga, completed []sagaStep) bool {
+	for i := len(completed) - 1; i >= 0; i-- {
+		step := completed[i]
+		if saga.Steps[i].Status == vo.SagaStepStatusSkipped {
+			continue
+		}
+		if step.compensate != nil {
+			if err := step.compensate(ctx); err != nil {
+				c.logger.Error("Saga compensation failed, manual intervention required",
+					"error", err,
+					"sagaID", saga.ID,
+					"step", step.name)
+				saga.MarkStepCompensationFailed(i, err.Error())
+				c.save(ctx, saga)
+				return false
+			}
+		}
+
+		saga.MarkStepCompensated(i)
+		c.save(ctx, saga)
+	}
+
+	saga.MarkAsCompensated()
+	c.save(ctx, saga)
+	return true
+}
+
+// save persists saga progress; failures are logged since the steps themselves already ran
+func (c *sagaCoordinator) save(ctx context.Context, saga *entity.Saga) {
+	if err := c.sagaRepo.Update(ctx, saga); err != nil {
+		c.logger.Error("Failed to persist saga state", "error", err, "sagaID", saga.ID, "status", saga.Status)
+	}
+}
+
+type sagaUseCase struct {
+	sagaRepo repository.SagaRepository
+	logger   infra.Logger
+	mapper   *dto.SagaMapper
+}
+
+// NewSagaUseCase creates a new saga use case
+func NewSagaUseCase(sagaRepo repository.SagaRepository, logger infra.Logger) SagaUseCase {
+	return &sagaUseCase{
+		sagaRepo: sagaRepo,
+		logger:   logger,
+		mapper:   &dto.SagaMapper{},
+	}
+}
+
+// GetSaga retrieves saga status by ID
+func (uc *sagaUseCase) GetSaga(ctx context.Context, id string) (*dto.SagaResponse, error) {
+	saga, err := uc.sagaRepo.GetByID(ctx, id)
+	if err != nil {
+		uc.logger.Error("Failed to get saga", "error", err, "sagaID", id)
+		return nil, err
+	}
+
+	response := uc.mapper.ToResponse(saga)
+	return &response, nil
+}
+
+// GetSagaByTransaction retrieves the saga status of a transaction
+func (uc *sagaUseCase) GetSagaByTransaction(ctx context.Context, transactionID string) (*dto.SagaResponse, error) {
+	id, err := vo.NewTransactionIDFromString(transactionID)
+	if err != nil {
+		return nil, err
+	}
+
+	saga, err := uc.sagaRepo.GetByTransactionID(ctx, id)
+	if err != nil {
+		uc.logger.Error("Failed to get saga for transaction", "error", err, "transactionID", transactionID)
+		return nil, err
+	}
+
+	response := uc.mapper.ToResponse(saga)
+	return &response, nil
+}
@@ -0,0 +1,234 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type MockSagaRepository struct {
+	mock.Mock
+}
+
+func (m *MockSagaRepository) Create(ctx context.Context, saga *entity.Saga) error {
+	args := m.Called(ctx, saga)
+	return args.Error(0)
+}
+
+func (m *MockSagaRepository) GetByID(ctx context.Context, id string) (*entity.Saga, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.Saga), args.Error(1)
+}
+
+func (m *MockSagaRepository) GetByTransactionID(ctx context.Context, transactionID vo.TransactionID) (*entity.Saga, error) {
+	args := m.Called(ctx, transactionID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.Saga), args.Error(1)
+}
+
+func (m *MockSagaRepository) Update(ctx context.Context, saga *entity.Saga) error {
+	args := m.Called(ctx, saga)
+	return args.Error(0)
+}
+
+func TestTransactionUseCase_ProcessTransferSaga(t *testing.T) {
+	tests := []struct {
+		name              string
+		setupMocks        func(accountRepo *MockAccountRepository, from, to *entity.Account)
+		expectedError     error
+		expectedStatus    vo.SagaStatus
+		expectedSteps     []vo.SagaStepStatus
+		expectedFromFunds float64
+	}{
+		{
+			name: "success_all_steps_completed",
+			setupMocks: func(accountRepo *MockAccountRepository, from, to *entity.Account) {
+				accountRepo.On("GetByIDForUpdate", mock.Anything, from.ID).Return(from, nil)
+				accountRepo.On("GetByIDForUpdate", mock.Anything, to.ID).Return(to, nil)
+				accountRepo.On("UpdateFenced", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+			},
+			expectedStatus:    vo.SagaStatusCompleted,
+			expectedSteps:     []vo.SagaStepStatus{vo.SagaStepStatusCompleted, vo.SagaStepStatusCompleted, vo.SagaStepStatusCompleted},
+			expectedFromFunds: 900,
+		},
+		{
+			name: "fail_insufficient_balance_nothing_to_compensate",
+			setupMocks: func(accountRepo *MockAccountRepository, from, to *entity.Account) {
+				from.Balance = vo.NewMoneyFromFloat(50)
+				accountRepo.On("GetByIDForUpdate", mock.Anything, from.ID).Return(from, nil)
+				accountRepo.On("GetByIDForUpdate", mock.Anything, to.ID).Return(to, nil)
+				accountRepo.On("IsApplied", mock.Anything, mock.Anything, from.ID).Return(false, nil)
+			},
+			expectedError:     errs.ErrInsufficientBalance,
+			expectedStatus:    vo.SagaStatusCompensated,
+			expectedSteps:     []vo.SagaStepStatus{vo.SagaStepStatusCompensated, vo.SagaStepStatusFailed, vo.SagaStepStatusPending},
+			expectedFromFunds: 50,
+		},
+		{
+			name: "debit_applied_before_is_not_repeated",
+			setupMocks: func(accountRepo *MockAccountRepository, from, to *entity.Account) {
+				from.Balance = vo.NewMoneyFromFloat(50) // An earlier attempt debited 100 of 150
+				accountRepo.On("GetByIDForUpdate", mock.Anything, from.ID).Return(from, nil)
+				accountRepo.On("GetByIDForUpdate", mock.Anything, to.ID).Return(to, nil)
+				accountRepo.On("IsApplied", mock.Anything, mock.Anything, from.ID).Return(true, nil)
+				accountRepo.On("UpdateFenced", mock.Anything, to, mock.Anything, mock.Anything).Return(nil)
+			},
+			expectedStatus:    vo.SagaStatusCompleted,
+			expectedSteps:     []vo.SagaStepStatus{vo.SagaStepStatusCompleted, vo.SagaStepStatusCompleted, vo.SagaStepStatusCompleted},
+			expectedFromFunds: 50,
+		},
+		{
+			name: "credit_applied_before_is_not_repeated",
+			setupMocks: func(accountRepo *MockAccountRepository, from, to *entity.Account) {
+				accountRepo.On("GetByIDForUpdate", mock.Anything, from.ID).Return(from, nil)
+				accountRepo.On("GetByIDForUpdate", mock.Anything, to.ID).Return(to, nil)
+				accountRepo.On("UpdateFenced", mock.Anything, from, mock.Anything, mock.Anything).Return(nil)
+				accountRepo.On("UpdateFenced", mock.Anything, to, mock.Anything, mock.Anything).Return(errs.ErrTransactionAlreadyApplied)
+			},
+			expectedStatus:    vo.SagaStatusCompleted,
+			expectedSteps:     []vo.SagaStepStatus{vo.SagaStepStatusCompleted, vo.SagaStepStatusCompleted, vo.SagaStepStatusCompleted},
+			expectedFromFunds: 900,
+		},
+		{
+			name: "fail_credit_compensates_debit",
+			setupMocks: func(accountRepo *MockAccountRepository, from, to *entity.Account) {
+				accountRepo.On("GetByIDForUpdate", mock.Anything, from.ID).Return(from, nil)
+				accountRepo.On("GetByIDForUpdate", mock.Anything, to.ID).Return(to, nil)
+				accountRepo.On("UpdateFenced", mock.Anything, from, mock.Anything, mock.Anything).Return(nil)
+				accountRepo.On("UpdateFenced", mock.Anything, to, mock.Anything, mock.Anything).Return(errors.New("database unavailable"))
+				accountRepo.On("Revert", mock.Anything, from, mock.Anything).Return(nil)
+			},
+			expectedStatus:    vo.SagaStatusCompensated,
+			expectedSteps:     []vo.SagaStepStatus{vo.SagaStepStatusCompensated, vo.SagaStepStatusCompensated, vo.SagaStepStatusFailed},
+			expectedFromFunds: 1000,
+		},
+		{
+			name: "taken_over_compensates_own_debit",
+			setupMocks: func(accountRepo *MockAccountRepository, from, to *entity.Account) {
+				accountRepo.On("GetByIDForUpdate", mock.Anything, from.ID).Return(from, nil)
+				accountRepo.On("GetByIDForUpdate", mock.Anything, to.ID).Return(to, nil)
+				accountRepo.On("UpdateFenced", mock.Anything, from, mock.Anything, mock.Anything).Return(nil)
+				accountRepo.On("UpdateFenced", mock.Anything, to, mock.Anything, mock.Anything).Return(errs.ErrStaleFencingToken)
+				accountRepo.On("Revert", mock.Anything, from, mock.Anything).Return(nil)
+			},
+			expectedError:     errs.ErrStaleFencingToken,
+			expectedStatus:    vo.SagaStatusCompensated,
+			expectedSteps:     []vo.SagaStepStatus{vo.SagaStepStatusCompensated, vo.SagaStepStatusCompensated, vo.SagaStepStatusFailed},
+			expectedFromFunds: 1000,
+		},
+		{
+			name: "fail_compensation_leaves_saga_failed",
+			setupMocks: func(accountRepo *MockAccountRepository, from, to *entity.Account) {
+				accountRepo.On("GetByIDForUpdate", mock.Anything, from.ID).Return(from, nil)
+				accountRepo.On("GetByIDForUpdate", mock.Anything, to.ID).Return(to, nil)
+				accountRepo.On("UpdateFenced", mock.Anything, from, mock.Anything, mock.Anything).Return(nil)
+				accountRepo.On("UpdateFenced", mock.Anything, to, mock.Anything, mock.Anything).Return(errors.New("database unavailable"))
+				accountRepo.On("Revert", mock.Anything, from, mock.Anything).Return(errors.New("database unavailable"))
+			},
+			expectedError:     errs.ErrSagaCompensationFailed,
+			expectedStatus:    vo.SagaStatusFailed,
+			expectedSteps:     []vo.SagaStepStatus{vo.SagaStepStatusCompleted, vo.SagaStepStatusCompensationFailed, vo.SagaStepStatusFailed},
+			expectedFromFunds: 1000, // In-memory credit applied, but never persisted
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockAccountRepo := new(MockAccountRepository)
+			mockSagaRepo := new(MockSagaRepository)
+			mockLogger := new(MockLogger)
+			mockLogger.On("Info", mock.Anything, mock.Anything).Maybe()
+			mockLogger.On("Warn", mock.Anything, mock.Anything).Maybe()
+			mockLogger.On("Error", mock.Anything, mock.Anything).Maybe()
+
+			from := createTestAccount()
+			to := createTestAccount()
+			to.ID = vo.NewAccountID()
+			mockAccountRepo.On("GetByIDs", mock.Anything, []vo.AccountID{from.ID, to.ID}).
+				Return(map[vo.AccountID]*entity.Account{from.ID: from, to.ID: to}, nil)
+			tt.setupMocks(mockAccountRepo, from, to)
+
+			var saga *entity.Saga
+			mockSagaRepo.On("Create", mock.Anything, mock.Anything).
+				Run(func(args mock.Arguments) { saga = args.Get(1).(*entity.Saga) }).
+				Return(nil)
+			mockSagaRepo.On("Update", mock.Anything, mock.Anything).Return(nil)
+
+			uc := NewTransactionUseCase(nil, mockAccountRepo, nil, mockSagaRepo, nil, nil, nil, nil, &StubEventPublisher{}, nil, NewValueDatingPolicy(&StubCalendar{}, vo.ProcessingWindow{}, nil), nil, NewCategorizer(nil, nil, mockLogger), nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, "THB", mockLogger).(*transactionUseCase)
+			transaction, err := entity.NewTransferTransaction(from.ID, to.ID, vo.NewMoneyFromFloat(100), "Rent", "", time.Now())
+			require.NoError(t, err)
+
+			err = uc.processTransferTransaction(context.Background(), transaction)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+			}
+			if tt.expectedStatus != vo.SagaStatusCompleted {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			require.NotNil(t, saga)
+			assert.Equal(t, transaction.ID, saga.TransactionID)
+			assert.Equal(t, tt.expectedStatus, saga.Status)
+			for i, status := range tt.expectedSteps {
+				assert.Equal(t, status, saga.Steps[i].Status, "step %s", saga.Steps[i].Name)
+			}
+			assert.True(t, from.Balance.Equal(vo.NewMoneyFromFloat(tt.expectedFromFunds)), "from balance %s", from.Balance.String())
+		})
+	}
+}
+
+func TestTransactionUseCase_ProcessTransferInUnitOfWork(t *testing.T) {
+	mockAccountRepo := new(MockAccountRepository)
+	mockSagaRepo := new(MockSagaRepository)
+	mockLogger := new(MockLogger)
+	mockLogger.On("Info", mock.Anything, mock.Anything).Maybe()
+	mockLogger.On("Warn", mock.Anything, mock.Anything).Maybe()
+	mockLogger.On("Error", mock.Anything, mock.Anything).Maybe()
+
+	from := createTestAccount()
+	to := createTestAccount()
+	to.ID = vo.NewAccountID()
+	mockAccountRepo.On("GetByIDs", mock.Anything, []vo.AccountID{from.ID, to.ID}).
+		Return(map[vo.AccountID]*entity.Account{from.ID: from, to.ID: to}, nil)
+	mockAccountRepo.On("GetByIDForUpdate", mock.Anything, from.ID).Return(from, nil)
+	mockAccountRepo.On("GetByIDForUpdate", mock.Anything, to.ID).Return(to, nil)
+	mockAccountRepo.On("UpdateFenced", mock.Anything, from, mock.Anything, mock.Anything).Return(nil)
+	mockAccountRepo.On("UpdateFenced", mock.Anything, to, mock.Anything, mock.Anything).Return(errors.New("database unavailable"))
+
+	units := &StubUnitOfWork{}
+	uc := NewTransactionUseCase(nil, mockAccountRepo, nil, mockSagaRepo, units, nil, nil, nil, &StubEventPublisher{}, nil, NewValueDatingPolicy(&StubCalendar{}, vo.ProcessingWindow{}, nil), nil, NewCategorizer(nil, nil, mockLogger), nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, "THB", mockLogger).(*transactionUseCase)
+	transaction, err := entity.NewTransferTransaction(from.ID, to.ID, vo.NewMoneyFromFloat(100), "Rent", "", time.Now())
+	require.NoError(t, err)
+
+	// Rolling back the unit of work undoes the debit, so no saga records or compensates it
+	err = units.Do(context.Background(), func(ctx context.Context) error {
+		return uc.processTransferTransaction(ctx, transaction)
+	})
+
+	assert.Error(t, err)
+	mockSagaRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	mockAccountRepo.AssertNotCalled(t, "Revert", mock.Anything, mock.Anything, mock.Anything)
+
+	// Both rows are locked in lock order first, so opposite transfers cannot deadlock
+	order := vo.LockOrder(from.ID, to.ID)
+	require.GreaterOrEqual(t, len(mockAccountRepo.Calls), 2)
+	assert.Equal(t, "GetByIDForUpdate", mockAccountRepo.Calls[0].Method)
+	assert.Equal(t, order[0], mockAccountRepo.Calls[0].Arguments.Get(1))
+	assert.Equal(t, order[1], mockAccountRepo.Calls[1].Arguments.Get(1))
+}
@@ -0,0 +1,120 @@
+// internal/application/sandbox.go
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/infra"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/repository"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+)
+
+// sandboxOpeningBalance funds the magic test accounts that are meant to pay out
+const sandboxOpeningBalance = 1000000
+
+// sandboxAccountNames name the magic test accounts opened in sandbox mode
+var sandboxAccountNames = map[vo.SandboxBehavior]string{
+	vo.SandboxBehaviorInsufficientFunds: "Sandbox: insufficient funds",
+	vo.SandboxBehaviorSlow:              "Sandbox: slow",
+	vo.SandboxBehaviorScreeningFailure:  "Sandbox: fails screening",
+}
+
+// Sandbox gives the magic test accounts of sandbox mode their deterministic behavior, so API
+// consumers can exercise their error handling without fabricating data. A nil sandbox gives
+// every account its normal behavior.
+type Sandbox struct {
+	accountRepo repository.AccountRepository
+	delay       time.Duration
+	logger      infra.Logger
+}
+
+// NewSandbox creates a sandbox confirming transactions of the slow test account after delay
+func NewSandbox(accountRepo repository.AccountRepository, delay time.Duration, logger infra.Logger) *Sandbox {
+	return &Sandbox{
+		accountRepo: accountRepo,
+		delay:       delay,
+		logger:      logger,
+	}
+}
+
+// SeedAccounts opens the magic test accounts that do not exist yet. The insufficient funds
+// account is opened empty, the others with a balance they can pay out of.
+func (s *Sandbox) SeedAccounts(ctx context.Context) error {
+	for behavior, accountID := range vo.SandboxAccountIDs() {
+		if _, err := s.accountRepo.GetByID(ctx, accountID); err == nil {
+			continue
+		}
+
+		balance := vo.NewMoneyFromFloat(sandboxOpeningBalance)
+		if behavior == vo.SandboxBehaviorInsufficientFunds {
+			balance = vo.ZeroMoney()
+		}
+		account, err := entity.NewAccount(sandboxAccountNames[behavior], balance)
+		if err != nil {
+			return err
+		}
+		account.ID = accountID
+
+		if err := s.accountRepo.Create(ctx, account); err != nil {
+			return fmt.Errorf("failed to open sandbox account %s: %w", accountID.String(), err)
+		}
+		s.logger.Info("Sandbox account opened", "accountID", accountID.String(), "behavior", behavior)
+	}
+	return nil
+}
+
+// Process applies the behavior of the magic test accounts a transaction involves before it is
+// processed: a payment out of the insufficient funds account fails for insufficient balance and
+// a transaction involving the slow account waits for the sandbox delay first. A caller giving up
+// while it waits leaves the transaction unchanged to be retried.
+func (s *Sandbox) Process(ctx context.Context, transaction *entity.Transaction) error {
+	if s == nil {
+		return nil
+	}
+
+	if sandboxBehaviorOf(transaction.FromAccountID) == vo.SandboxBehaviorInsufficientFunds {
+		return errs.ErrInsufficientBalance
+	}
+
+	if sandboxBehaviorOf(transaction.FromAccountID) == vo.SandboxBehaviorSlow ||
+		sandboxBehaviorOf(transaction.ToAccountID) == vo.SandboxBehaviorSlow {
+		timer := time.NewTimer(s.delay)
+		defer timer.Stop()
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			return fmt.Errorf("%w: %w", errs.ErrTransient, ctx.Err())
+		}
+	}
+	return nil
+}
+
+// sandboxBehaviorOf returns the behavior of the magic test account with this ID, or an empty behavior
+func sandboxBehaviorOf(accountID *vo.AccountID) vo.SandboxBehavior {
+	if accountID == nil {
+		return ""
+	}
+	behavior, _ := accountID.SandboxBehavior()
+	return behavior
+}
+
+// SandboxScreeningRule holds every transaction involving the magic test account that fails screening
+type SandboxScreeningRule struct{}
+
+// Name identifies the rule in logs
+func (r SandboxScreeningRule) Name() string {
+	return "sandbox_screening"
+}
+
+// Evaluate holds the transaction when either of its accounts is the screening failure test account
+func (r SandboxScreeningRule) Evaluate(ctx context.Context, transaction *entity.Transaction) (string, error) {
+	if sandboxBehaviorOf(transaction.FromAccountID) != vo.SandboxBehaviorScreeningFailure &&
+		sandboxBehaviorOf(transaction.ToAccountID) != vo.SandboxBehaviorScreeningFailure {
+		return "", nil
+	}
+	return "sandbox account always fails screening", nil
+}
@@ -0,0 +1,119 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSandbox_SeedAccounts(t *testing.T) {
+	ids := vo.SandboxAccountIDs()
+	mockAccountRepo := new(MockAccountRepository)
+	mockLogger := new(MockLogger)
+	mockLogger.On("Info", mock.Anything, mock.Anything).Maybe()
+
+	// The slow account was opened by an earlier start
+	existing := createTestAccount()
+	existing.ID = ids[vo.SandboxBehaviorSlow]
+	mockAccountRepo.On("GetByID", mock.Anything, ids[vo.SandboxBehaviorSlow]).Return(existing, nil)
+	mockAccountRepo.On("GetByID", mock.Anything, mock.Anything).Return((*entity.Account)(nil), errs.ErrAccountNotFound)
+	opened := map[vo.AccountID]*entity.Account{}
+	mockAccountRepo.On("Create", mock.Anything, mock.AnythingOfType("*entity.Account")).
+		Run(func(args mock.Arguments) {
+			account := args.Get(1).(*entity.Account)
+			opened[account.ID] = account
+		}).Return(nil)
+
+	err := NewSandbox(mockAccountRepo, time.Second, mockLogger).SeedAccounts(context.Background())
+
+	require.NoError(t, err)
+	require.Len(t, opened, 2)
+	assert.True(t, opened[ids[vo.SandboxBehaviorInsufficientFunds]].Balance.IsZero())
+	assert.True(t, opened[ids[vo.SandboxBehaviorScreeningFailure]].Balance.IsPositive())
+}
+
+func TestSandbox_Process(t *testing.T) {
+	ids := vo.SandboxAccountIDs()
+	transfer := func(from, to vo.AccountID) *entity.Transaction {
+		transaction, err := entity.NewTransferTransaction(from, to, vo.NewMoneyFromFloat(10), "Sandbox", "", time.Now())
+		require.NoError(t, err)
+		return transaction
+	}
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	tests := []struct {
+		name        string
+		sandbox     *Sandbox
+		ctx         context.Context
+		transaction *entity.Transaction
+		wantErr     error
+	}{
+		{
+			name:        "payment out of the insufficient funds account fails",
+			sandbox:     NewSandbox(nil, 0, nil),
+			ctx:         context.Background(),
+			transaction: transfer(ids[vo.SandboxBehaviorInsufficientFunds], vo.NewAccountID()),
+			wantErr:     errs.ErrInsufficientBalance,
+		},
+		{
+			name:        "payment into the insufficient funds account goes through",
+			sandbox:     NewSandbox(nil, 0, nil),
+			ctx:         context.Background(),
+			transaction: transfer(vo.NewAccountID(), ids[vo.SandboxBehaviorInsufficientFunds]),
+		},
+		{
+			name:        "slow account waits for the delay",
+			sandbox:     NewSandbox(nil, time.Millisecond, nil),
+			ctx:         context.Background(),
+			transaction: transfer(vo.NewAccountID(), ids[vo.SandboxBehaviorSlow]),
+		},
+		{
+			name:        "caller giving up on the slow account can retry",
+			sandbox:     NewSandbox(nil, time.Hour, nil),
+			ctx:         cancelled,
+			transaction: transfer(ids[vo.SandboxBehaviorSlow], vo.NewAccountID()),
+			wantErr:     errs.ErrTransient,
+		},
+		{
+			name:        "no sandbox leaves the test accounts alone",
+			ctx:         context.Background(),
+			transaction: transfer(ids[vo.SandboxBehaviorInsufficientFunds], ids[vo.SandboxBehaviorSlow]),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.sandbox.Process(tt.ctx, tt.transaction)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestSandboxScreeningRule_Evaluate(t *testing.T) {
+	ids := vo.SandboxAccountIDs()
+	flagged, err := entity.NewCreditTransaction(ids[vo.SandboxBehaviorScreeningFailure], vo.NewMoneyFromFloat(1), "Sandbox", "", time.Now())
+	require.NoError(t, err)
+	clean, err := entity.NewCreditTransaction(vo.NewAccountID(), vo.NewMoneyFromFloat(1), "Sandbox", "", time.Now())
+	require.NoError(t, err)
+
+	reason, err := SandboxScreeningRule{}.Evaluate(context.Background(), flagged)
+	require.NoError(t, err)
+	assert.NotEmpty(t, reason)
+
+	reason, err = SandboxScreeningRule{}.Evaluate(context.Background(), clean)
+	require.NoError(t, err)
+	assert.Empty(t, reason)
+}
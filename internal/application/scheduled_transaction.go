@@ -0,0 +1,254 @@
+// internal/application/scheduled_transaction.go
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hydr0g3nz/mini_bank/internal/application/dto"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/infra"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/repository"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+)
+
+// dueScheduledTransactionBatchSize caps how many due schedules one run materializes
+const dueScheduledTransactionBatchSize = 100
+
+type scheduledTransactionUseCase struct {
+	scheduledRepo      repository.ScheduledTransactionRepository
+	accountRepo        repository.AccountRepository
+	transactionUseCase TransactionUseCase
+	valueDating        *ValueDatingPolicy
+	logger             infra.Logger
+	mapper             *dto.ScheduledTransactionMapper
+}
+
+// NewScheduledTransactionUseCase creates a new scheduled transaction use case making its transfers
+// through the transaction use case. Cron expressions are matched in the processing window's time zone.
+func NewScheduledTransactionUseCase(
+	scheduledRepo repository.ScheduledTransactionRepository,
+	accountRepo repository.AccountRepository,
+	transactionUseCase TransactionUseCase,
+	valueDating *ValueDatingPolicy,
+	logger infra.Logger,
+) ScheduledTransactionUseCase {
+	return &scheduledTransactionUseCase{
+		scheduledRepo:      scheduledRepo,
+		accountRepo:        accountRepo,
+		transactionUseCase: transactionUseCase,
+		valueDating:        valueDating,
+		logger:             logger,
+		mapper:             &dto.ScheduledTransactionMapper{},
+	}
+}
+
+// CreateScheduledTransaction schedules a transfer between two accounts, once or recurring
+func (uc *scheduledTransactionUseCase) CreateScheduledTransaction(ctx context.Context, req dto.ScheduledTransactionRequest) (*dto.ScheduledTransactionResponse, error) {
+	fromAccountID, err := uc.account(ctx, req.FromAccountID)
+	if err != nil {
+		return nil, err
+	}
+	toAccountID, err := uc.account(ctx, req.ToAccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	scheduled, err := entity.NewScheduledTransaction(fromAccountID, toAccountID, req.Amount.Money(), req.Description, req.Reference, req.Cron,
+		req.StartAt, req.EndAt, uc.valueDating.LocalNow())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.scheduledRepo.Create(ctx, scheduled); err != nil {
+		uc.logger.Error("Failed to create scheduled transaction", "error", err, "fromAccountID", req.FromAccountID)
+		return nil, err
+	}
+
+	uc.logger.Info("Scheduled transaction created", "scheduleID", scheduled.ID, "fromAccountID", req.FromAccountID, "cron", scheduled.Cron, "nextRunAt", scheduled.NextRunAt)
+	response := uc.mapper.ToResponse(scheduled)
+	return &response, nil
+}
+
+// GetScheduledTransaction retrieves a scheduled transaction by ID
+func (uc *scheduledTransactionUseCase) GetScheduledTransaction(ctx context.Context, id string) (*dto.ScheduledTransactionResponse, error) {
+	scheduled, err := uc.scheduledRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	response := uc.mapper.ToResponse(scheduled)
+	return &response, nil
+}
+
+// ListScheduledTransactions retrieves scheduled transactions, optionally of an account or a status
+func (uc *scheduledTransactionUseCase) ListScheduledTransactions(ctx context.Context, req dto.ScheduledTransactionListRequest) (*dto.ScheduledTransactionListResponse, error) {
+	filter := repository.ScheduledTransactionFilter{
+		AccountID: req.AccountID,
+		Status:    vo.ScheduledTransactionStatus(req.Status),
+	}
+	offset := (req.Page - 1) * req.PageSize
+
+	scheduled, err := uc.scheduledRepo.List(ctx, filter, req.PageSize, offset)
+	if err != nil {
+		uc.logger.Error("Failed to list scheduled transactions", "error", err)
+		return nil, err
+	}
+
+	total, err := uc.scheduledRepo.Count(ctx, filter)
+	if err != nil {
+		uc.logger.Error("Failed to count scheduled transactions", "error", err)
+		return nil, err
+	}
+
+	response := uc.mapper.ToResponseList(scheduled, dto.NewPaginationInfo(req.Page, req.PageSize, total))
+	return &response, nil
+}
+
+// UpdateScheduledTransaction replaces the transfer and schedule of an active scheduled transaction
+func (uc *scheduledTransactionUseCase) UpdateScheduledTransaction(ctx context.Context, req dto.ScheduledTransactionRequest) (*dto.ScheduledTransactionResponse, error) {
+	scheduled, err := uc.scheduledRepo.GetByID(ctx, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.FromAccountID != "" && req.FromAccountID != scheduled.FromAccountID.String() {
+		return nil, errs.ValidationError{
+			Field:   "fromAccountID",
+			Message: "the paying account of a scheduled transaction cannot change",
+		}
+	}
+
+	toAccountID, err := uc.account(ctx, req.ToAccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := scheduled.Update(toAccountID, req.Amount.Money(), req.Description, req.Reference, req.Cron, req.StartAt, req.EndAt, uc.valueDating.LocalNow()); err != nil {
+		return nil, err
+	}
+
+	if err := uc.scheduledRepo.Update(ctx, scheduled); err != nil {
+		uc.logger.Error("Failed to update scheduled transaction", "error", err, "scheduleID", req.ID)
+		return nil, err
+	}
+
+	uc.logger.Info("Scheduled transaction updated", "scheduleID", req.ID, "nextRunAt", scheduled.NextRunAt)
+	response := uc.mapper.ToResponse(scheduled)
+	return &response, nil
+}
+
+// DeleteScheduledTransaction removes a scheduled transaction; transfers it already made are kept
+func (uc *scheduledTransactionUseCase) DeleteScheduledTransaction(ctx context.Context, id string) error {
+	if err := uc.scheduledRepo.Delete(ctx, id); err != nil {
+		if !errors.Is(err, errs.ErrScheduledTransactionNotFound) {
+			uc.logger.Error("Failed to delete scheduled transaction", "error", err, "scheduleID", id)
+		}
+		return err
+	}
+
+	uc.logger.Info("Scheduled transaction deleted", "scheduleID", id)
+	return nil
+}
+
+// RunDueTransactions makes the transfers of the schedules that are due: each run creates a pending
+// transfer and confirms it. A run whose transfer cannot be made or fails is recorded on the schedule,
+// which moves on to its next run; one interrupted before its outcome was recorded confirms the same
+// transfer on the next call instead of creating another. A transfer booked for a later business day
+// keeps its run pending, and the schedule due, until it is confirmed on its value date.
+func (uc *scheduledTransactionUseCase) RunDueTransactions(ctx context.Context) (int, error) {
+	now := uc.valueDating.LocalNow()
+	due, err := uc.scheduledRepo.ListDue(ctx, now, dueScheduledTransactionBatchSize)
+	if err != nil {
+		uc.logger.Error("Failed to load due scheduled transactions", "error", err)
+		return 0, err
+	}
+
+	ran := 0
+	for _, scheduled := range due {
+		if err := uc.run(ctx, scheduled); err != nil {
+			if !errors.Is(err, errs.ErrTransactionNotDue) {
+				uc.logger.Warn("Failed to run scheduled transaction", "error", err, "scheduleID", scheduled.ID)
+			}
+			continue
+		}
+		ran++
+	}
+
+	if ran > 0 {
+		uc.logger.Info("Ran due scheduled transactions", "count", ran)
+	}
+	return ran, nil
+}
+
+// run makes the transfer of a due schedule and records its outcome. It returns an error, leaving the
+// run to the next call, only when the outcome cannot be told or recorded.
+func (uc *scheduledTransactionUseCase) run(ctx context.Context, scheduled *entity.ScheduledTransaction) error {
+	transactionID := scheduled.PendingTransactionID
+	if transactionID == "" {
+		fromAccountID := scheduled.FromAccountID.String()
+		toAccountID := scheduled.ToAccountID.String()
+		created, err := uc.transactionUseCase.CreateTransaction(ctx, dto.CreateTransactionRequest{
+			FromAccountID:   &fromAccountID,
+			ToAccountID:     &toAccountID,
+			TransactionType: string(vo.TransactionTypeTransfer),
+			Amount:          dto.NewDecimalString(scheduled.Amount.Amount()),
+			Description:     scheduled.Description,
+			Reference:       scheduled.TransferReference(),
+		})
+		if err != nil {
+			return uc.record(ctx, scheduled, "", err)
+		}
+		transactionID = created.ID
+
+		// Saved before confirming, so an interrupted run confirms this transfer rather than paying twice
+		scheduled.PendingTransactionID = transactionID
+		if err := uc.scheduledRepo.Update(ctx, scheduled); err != nil {
+			uc.logger.Error("Failed to update scheduled transaction", "error", err, "scheduleID", scheduled.ID)
+			return err
+		}
+	}
+
+	_, err := uc.transactionUseCase.ConfirmTransaction(ctx, dto.ConfirmTransactionRequest{ID: transactionID})
+	switch {
+	case errors.Is(err, errs.ErrTransactionAlreadyInProgress):
+		// Another worker is confirming it; its outcome is recorded on the next call
+		return err
+	case errors.Is(err, errs.ErrTransactionNotDue):
+		// Booked for a later business day; the run stays pending so a later call confirms it then
+		uc.logger.Info("Scheduled transfer waits for its value date", "scheduleID", scheduled.ID, "transactionID", transactionID)
+		return err
+	}
+	return uc.record(ctx, scheduled, transactionID, err)
+}
+
+// record saves the outcome of a schedule's due run
+func (uc *scheduledTransactionUseCase) record(ctx context.Context, scheduled *entity.ScheduledTransaction, transactionID string, runErr error) error {
+	if runErr != nil {
+		uc.logger.Warn("Scheduled transfer failed", "error", runErr, "scheduleID", scheduled.ID, "transactionID", transactionID)
+	}
+
+	scheduled.RecordRun(transactionID, runErr, uc.valueDating.LocalNow())
+	if err := uc.scheduledRepo.Update(ctx, scheduled); err != nil {
+		uc.logger.Error("Failed to update scheduled transaction", "error", err, "scheduleID", scheduled.ID)
+		return err
+	}
+
+	uc.logger.Info("Scheduled transaction ran", "scheduleID", scheduled.ID, "transactionID", transactionID, "status", scheduled.Status, "nextRunAt", scheduled.NextRunAt)
+	return nil
+}
+
+// account checks that an account a schedule pays from or to exists
+func (uc *scheduledTransactionUseCase) account(ctx context.Context, id string) (vo.AccountID, error) {
+	accountID, err := vo.NewAccountIDFromString(id)
+	if err != nil {
+		return vo.AccountID{}, err
+	}
+
+	if _, err := uc.accountRepo.GetByID(ctx, accountID); err != nil {
+		return vo.AccountID{}, err
+	}
+
+	return accountID, nil
+}
@@ -0,0 +1,195 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/application/dto"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/repository"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type MockScheduledTransactionRepository struct {
+	mock.Mock
+}
+
+func (m *MockScheduledTransactionRepository) Create(ctx context.Context, scheduled *entity.ScheduledTransaction) error {
+	args := m.Called(ctx, scheduled)
+	return args.Error(0)
+}
+
+func (m *MockScheduledTransactionRepository) GetByID(ctx context.Context, id string) (*entity.ScheduledTransaction, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.ScheduledTransaction), args.Error(1)
+}
+
+func (m *MockScheduledTransactionRepository) Update(ctx context.Context, scheduled *entity.ScheduledTransaction) error {
+	args := m.Called(ctx, scheduled)
+	return args.Error(0)
+}
+
+func (m *MockScheduledTransactionRepository) Delete(ctx context.Context, id string) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockScheduledTransactionRepository) List(ctx context.Context, filter repository.ScheduledTransactionFilter, limit, offset int) ([]*entity.ScheduledTransaction, error) {
+	args := m.Called(ctx, filter, limit, offset)
+	return args.Get(0).([]*entity.ScheduledTransaction), args.Error(1)
+}
+
+func (m *MockScheduledTransactionRepository) Count(ctx context.Context, filter repository.ScheduledTransactionFilter) (int64, error) {
+	args := m.Called(ctx, filter)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockScheduledTransactionRepository) ListDue(ctx context.Context, now time.Time, limit int) ([]*entity.ScheduledTransaction, error) {
+	args := m.Called(ctx, now, limit)
+	return args.Get(0).([]*entity.ScheduledTransaction), args.Error(1)
+}
+
+func TestScheduledTransactionUseCase_CreateScheduledTransaction(t *testing.T) {
+	from := createTestAccount()
+	to := createTestAccount()
+	clock := &StubClock{At: time.Date(2026, time.March, 10, 8, 0, 0, 0, time.UTC)}
+
+	mockScheduledRepo := new(MockScheduledTransactionRepository)
+	mockAccountRepo := new(MockAccountRepository)
+	mockLogger := new(MockLogger)
+	mockLogger.On("Info", mock.Anything, mock.Anything).Maybe()
+	mockAccountRepo.On("GetByID", mock.Anything, from.ID).Return(from, nil)
+	mockAccountRepo.On("GetByID", mock.Anything, to.ID).Return(to, nil)
+	mockScheduledRepo.On("Create", mock.Anything, mock.AnythingOfType("*entity.ScheduledTransaction")).Return(nil)
+
+	uc := NewScheduledTransactionUseCase(mockScheduledRepo, mockAccountRepo, new(MockTransactionUseCase),
+		NewValueDatingPolicy(&StubCalendar{}, vo.ProcessingWindow{}, clock), mockLogger)
+
+	result, err := uc.CreateScheduledTransaction(context.Background(), dto.ScheduledTransactionRequest{
+		FromAccountID: from.ID.String(),
+		ToAccountID:   to.ID.String(),
+		Amount:        dto.NewDecimalStringFromFloat(500),
+		Description:   "Rent",
+		Cron:          "0 9 1 * *",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "ACTIVE", result.Status)
+	require.NotNil(t, result.NextRunAt)
+	assert.Equal(t, time.Date(2026, time.April, 1, 9, 0, 0, 0, time.UTC), *result.NextRunAt)
+
+	_, err = uc.CreateScheduledTransaction(context.Background(), dto.ScheduledTransactionRequest{
+		FromAccountID: from.ID.String(),
+		ToAccountID:   to.ID.String(),
+		Amount:        dto.NewDecimalStringFromFloat(500),
+		Cron:          "every month",
+	})
+	assert.IsType(t, errs.ValidationError{}, err)
+}
+
+func TestScheduledTransactionUseCase_RunDueTransactions(t *testing.T) {
+	now := time.Date(2026, time.March, 10, 9, 0, 30, 0, time.UTC)
+	pending := &dto.TransactionResponse{ID: "TXN20260310090030123456", Status: "PENDING"}
+
+	tests := []struct {
+		name                string
+		pendingTransaction  string
+		createError         error
+		confirmError        error
+		expectedRan         int
+		expectedTransaction string
+		expectedError       string
+		expectedPending     string
+		skipsCreate         bool
+	}{
+		{name: "success", expectedRan: 1, expectedTransaction: pending.ID},
+		{name: "queued_past_cutoff_left_pending", confirmError: errs.ErrTransactionNotDue, expectedRan: 0, expectedPending: pending.ID},
+		{name: "confirm_failed", confirmError: errs.ErrInsufficientBalance, expectedRan: 1, expectedTransaction: pending.ID, expectedError: errs.ErrInsufficientBalance.Error()},
+		{name: "create_failed", createError: errs.ErrAccountNotFound, expectedRan: 1, expectedError: errs.ErrAccountNotFound.Error()},
+		{name: "interrupted_run_confirms_same_transfer", pendingTransaction: pending.ID, expectedRan: 1, expectedTransaction: pending.ID, skipsCreate: true},
+		{name: "confirm_in_progress_left_for_next_run", confirmError: errs.ErrTransactionAlreadyInProgress, expectedRan: 0, expectedPending: pending.ID},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			earlier := now.Add(-time.Hour)
+			scheduled, err := entity.NewScheduledTransaction(vo.NewAccountID(), vo.NewAccountID(), vo.NewMoneyFromFloat(50), "Allowance", "", "0 9 * * *", nil, nil, earlier)
+			require.NoError(t, err)
+			scheduled.PendingTransactionID = tt.pendingTransaction
+
+			mockScheduledRepo := new(MockScheduledTransactionRepository)
+			mockTxnUC := new(MockTransactionUseCase)
+			mockLogger := new(MockLogger)
+			mockLogger.On("Info", mock.Anything, mock.Anything).Maybe()
+			mockLogger.On("Warn", mock.Anything, mock.Anything).Maybe()
+
+			mockScheduledRepo.On("ListDue", mock.Anything, now, dueScheduledTransactionBatchSize).Return([]*entity.ScheduledTransaction{scheduled}, nil)
+			mockScheduledRepo.On("Update", mock.Anything, scheduled).Return(nil)
+			if tt.createError != nil {
+				mockTxnUC.On("CreateTransaction", mock.Anything, mock.Anything).Return(nil, tt.createError)
+			} else {
+				mockTxnUC.On("CreateTransaction", mock.Anything, mock.MatchedBy(func(req dto.CreateTransactionRequest) bool {
+					return req.TransactionType == "TRANSFER" &&
+						*req.FromAccountID == scheduled.FromAccountID.String() &&
+						req.Amount.InexactFloat64() == 50 &&
+						req.Reference == entity.ScheduledTransactionReferencePrefix+scheduled.ID
+				})).Return(pending, nil).Maybe()
+				mockTxnUC.On("ConfirmTransaction", mock.Anything, dto.ConfirmTransactionRequest{ID: pending.ID}).
+					Return(&dto.TransactionResponse{ID: pending.ID, Status: "COMPLETED"}, tt.confirmError)
+			}
+
+			uc := NewScheduledTransactionUseCase(mockScheduledRepo, nil, mockTxnUC,
+				NewValueDatingPolicy(&StubCalendar{}, vo.ProcessingWindow{}, &StubClock{At: now}), mockLogger)
+
+			ran, err := uc.RunDueTransactions(context.Background())
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedRan, ran)
+			assert.Equal(t, tt.expectedPending, scheduled.PendingTransactionID)
+			if tt.skipsCreate {
+				mockTxnUC.AssertNotCalled(t, "CreateTransaction", mock.Anything, mock.Anything)
+			}
+			if tt.expectedRan == 0 {
+				// The run is retried on the next call, so the schedule stays due
+				assert.Equal(t, 0, scheduled.RunCount)
+				require.NotNil(t, scheduled.NextRunAt)
+				assert.False(t, scheduled.NextRunAt.After(now))
+				return
+			}
+			assert.Equal(t, 1, scheduled.RunCount)
+			assert.Equal(t, tt.expectedTransaction, scheduled.LastTransactionID)
+			assert.Equal(t, tt.expectedError, scheduled.LastError)
+			require.NotNil(t, scheduled.NextRunAt)
+			assert.Equal(t, time.Date(2026, time.March, 11, 9, 0, 0, 0, time.UTC), *scheduled.NextRunAt)
+		})
+	}
+}
+
+func TestScheduledTransactionUseCase_UpdateScheduledTransaction_PayingAccountFixed(t *testing.T) {
+	now := time.Date(2026, time.March, 10, 8, 0, 0, 0, time.UTC)
+	scheduled, err := entity.NewScheduledTransaction(vo.NewAccountID(), vo.NewAccountID(), vo.NewMoneyFromFloat(50), "", "", "0 9 * * *", nil, nil, now)
+	require.NoError(t, err)
+
+	mockScheduledRepo := new(MockScheduledTransactionRepository)
+	mockScheduledRepo.On("GetByID", mock.Anything, scheduled.ID).Return(scheduled, nil)
+
+	uc := NewScheduledTransactionUseCase(mockScheduledRepo, new(MockAccountRepository), new(MockTransactionUseCase),
+		NewValueDatingPolicy(&StubCalendar{}, vo.ProcessingWindow{}, &StubClock{At: now}), new(MockLogger))
+
+	_, err = uc.UpdateScheduledTransaction(context.Background(), dto.ScheduledTransactionRequest{
+		ID:            scheduled.ID,
+		FromAccountID: vo.NewAccountID().String(),
+		ToAccountID:   scheduled.ToAccountID.String(),
+		Amount:        dto.NewDecimalStringFromFloat(50),
+		Cron:          "0 9 * * *",
+	})
+	assert.IsType(t, errs.ValidationError{}, err)
+}
@@ -0,0 +1,147 @@
+// internal/application/statement.go
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/application/dto"
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/infra"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/repository"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+)
+
+// maxStatementDays bounds the period of a statement, which lists every transaction completed within it
+const maxStatementDays = 366
+
+type statementUseCase struct {
+	transactionRepo repository.TransactionRepository
+	accountRepo     repository.AccountRepository
+	valueDating     *ValueDatingPolicy
+	logger          infra.Logger
+}
+
+// NewStatementUseCase creates a new statement use case
+func NewStatementUseCase(
+	transactionRepo repository.TransactionRepository,
+	accountRepo repository.AccountRepository,
+	valueDating *ValueDatingPolicy,
+	logger infra.Logger,
+) StatementUseCase {
+	return &statementUseCase{
+		transactionRepo: transactionRepo,
+		accountRepo:     accountRepo,
+		valueDating:     valueDating,
+		logger:          logger,
+	}
+}
+
+// GetStatement lists an account's transactions completed within a period of UTC days with the balance
+// after each. The opening balance is the current balance less what every transaction completed since
+// the start of the period did to it, totalled by the database rather than loaded.
+func (uc *statementUseCase) GetStatement(ctx context.Context, req dto.StatementRequest) (*dto.StatementResponse, error) {
+	accountID, err := vo.NewAccountIDFromString(req.AccountID)
+	if err != nil {
+		uc.logger.Error("Invalid account ID format", "error", err, "accountID", req.AccountID)
+		return nil, err
+	}
+
+	// The period defaults to month-to-date of the current business date
+	today := uc.valueDating.Today()
+	from := time.Date(today.Year(), today.Month(), 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(today.Year(), today.Month(), today.Day(), 0, 0, 0, 0, time.UTC)
+	if req.From != "" {
+		if from, err = parseDate("from", req.From); err != nil {
+			return nil, err
+		}
+	}
+	if req.To != "" {
+		if to, err = parseDate("to", req.To); err != nil {
+			return nil, err
+		}
+	}
+	if to.Before(from) {
+		return nil, errs.ValidationError{Field: "to", Message: "to must not be before from"}
+	}
+	if to.Sub(from) >= maxStatementDays*24*time.Hour {
+		return nil, errs.ValidationError{Field: "to", Message: fmt.Sprintf("the period must not exceed %d days", maxStatementDays)}
+	}
+
+	account, err := uc.accountRepo.GetByID(ctx, accountID)
+	if err != nil {
+		uc.logger.Error("Account not found", "error", err, "accountID", req.AccountID)
+		return nil, errs.ErrAccountNotFound
+	}
+
+	since, err := uc.transactionRepo.SumBalanceEffectSince(ctx, accountID, from)
+	if err != nil {
+		uc.logger.Error("Failed to total transactions since statement start", "error", err, "accountID", req.AccountID)
+		return nil, err
+	}
+	balance, err := account.Balance.Subtract(since)
+	if err != nil {
+		uc.logger.Error("Failed to compute statement opening balance", "error", err, "accountID", req.AccountID)
+		return nil, err
+	}
+
+	until := to.AddDate(0, 0, 1)
+	transactions, err := uc.transactionRepo.GetCompletedByAccountIDBetween(ctx, accountID, from, until)
+	if err != nil {
+		uc.logger.Error("Failed to get statement transactions", "error", err, "accountID", req.AccountID)
+		return nil, err
+	}
+
+	response := &dto.StatementResponse{
+		AccountID:      accountID.String(),
+		AccountName:    account.AccountName,
+		Currency:       account.Currency().String(),
+		From:           from.Format(dateLayout),
+		To:             to.Format(dateLayout),
+		OpeningBalance: balance.InexactFloat64(),
+		Rows:           make([]dto.StatementRow, 0, len(transactions)),
+	}
+
+	credits, debits := vo.ZeroMoney(), vo.ZeroMoney()
+	for _, transaction := range transactions {
+		row := dto.StatementRow{
+			TransactionID:   transaction.ID.String(),
+			TransactionType: string(transaction.TransactionType),
+			Description:     transaction.Description,
+			Reference:       transaction.Reference,
+		}
+		if transaction.CompletedAt != nil {
+			row.CompletedAt = *transaction.CompletedAt
+		}
+
+		// A transaction between two sides of the same account shows both
+		if transaction.FromAccountID != nil && *transaction.FromAccountID == accountID {
+			debit := transaction.TotalDebit()
+			if debits, err = debits.Add(debit); err != nil {
+				return nil, err
+			}
+			row.Debit = debit.InexactFloat64()
+			row.Fee = transaction.Fee.InexactFloat64()
+		}
+		if transaction.ToAccountID != nil && *transaction.ToAccountID == accountID {
+			credit := transaction.CreditedAmount()
+			if credits, err = credits.Add(credit); err != nil {
+				return nil, err
+			}
+			row.Credit = credit.InexactFloat64()
+		}
+
+		if balance, err = balance.Add(transaction.BalanceEffect(accountID)); err != nil {
+			return nil, err
+		}
+		row.RunningBalance = balance.InexactFloat64()
+		response.Rows = append(response.Rows, row)
+	}
+
+	response.TotalCredits = credits.InexactFloat64()
+	response.TotalDebits = debits.InexactFloat64()
+	response.ClosingBalance = balance.InexactFloat64()
+	uc.logger.Debug("Account statement retrieved successfully", "accountID", req.AccountID, "count", len(response.Rows))
+	return response, nil
+}
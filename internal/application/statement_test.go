@@ -0,0 +1,161 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/application/dto"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStatementUseCase_GetStatement(t *testing.T) {
+	account := createTestAccount()
+	account.Balance = vo.NewMoneyFromFloat(2000)
+	from := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	until := time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)
+
+	complete := func(transaction *entity.Transaction, completedAt time.Time) {
+		transaction.Status = vo.TransactionStatusCompleted
+		transaction.CompletedAt = &completedAt
+	}
+	withdrawal, err := entity.NewDebitTransaction(account.ID, vo.NewMoneyFromFloat(100), "ATM", "", time.Now())
+	require.NoError(t, err)
+	require.NoError(t, withdrawal.SetFee(vo.NewMoneyFromFloat(5)))
+	complete(withdrawal, from.Add(9*time.Hour))
+	salary, err := entity.NewCreditTransaction(account.ID, vo.NewMoneyFromFloat(500), "Salary", "PAY-03", time.Now())
+	require.NoError(t, err)
+	complete(salary, from.AddDate(0, 0, 24))
+
+	mockTxnRepo := new(MockTransactionRepository)
+	mockAccountRepo := new(MockAccountRepository)
+	mockLogger := new(MockLogger)
+	mockLogger.On("Debug", mock.Anything, mock.Anything).Maybe()
+	mockAccountRepo.On("GetByID", mock.Anything, account.ID).Return(account, nil)
+	// A later refund of 50 completed after the statement, on top of its 395
+	mockTxnRepo.On("SumBalanceEffectSince", mock.Anything, account.ID, from).Return(vo.NewMoneyFromFloat(445), nil)
+	mockTxnRepo.On("GetCompletedByAccountIDBetween", mock.Anything, account.ID, from, until).
+		Return([]*entity.Transaction{withdrawal, salary}, nil)
+
+	result, err := NewStatementUseCase(mockTxnRepo, mockAccountRepo, NewValueDatingPolicy(&StubCalendar{}, vo.ProcessingWindow{}, nil), mockLogger).GetStatement(context.Background(), dto.StatementRequest{
+		AccountID: account.ID.String(),
+		From:      "2024-03-01",
+		To:        "2024-03-31",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "2024-03-01", result.From)
+	assert.Equal(t, "2024-03-31", result.To)
+	assert.Equal(t, 1555.0, result.OpeningBalance)
+	assert.Equal(t, 500.0, result.TotalCredits)
+	assert.Equal(t, 105.0, result.TotalDebits)
+	assert.Equal(t, 1950.0, result.ClosingBalance)
+	require.Len(t, result.Rows, 2)
+
+	assert.Equal(t, withdrawal.ID.String(), result.Rows[0].TransactionID)
+	assert.Equal(t, 105.0, result.Rows[0].Debit)
+	assert.Equal(t, 5.0, result.Rows[0].Fee)
+	assert.Zero(t, result.Rows[0].Credit)
+	assert.Equal(t, 1450.0, result.Rows[0].RunningBalance)
+
+	assert.Equal(t, "PAY-03", result.Rows[1].Reference)
+	assert.Equal(t, 500.0, result.Rows[1].Credit)
+	assert.Equal(t, 1950.0, result.Rows[1].RunningBalance)
+}
+
+func TestStatementUseCase_GetStatement_DefaultPeriod(t *testing.T) {
+	account := createTestAccount()
+	clock := &StubClock{At: time.Date(2024, 5, 17, 15, 0, 0, 0, time.UTC)}
+	from := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+
+	mockTxnRepo := new(MockTransactionRepository)
+	mockAccountRepo := new(MockAccountRepository)
+	mockLogger := new(MockLogger)
+	mockLogger.On("Debug", mock.Anything, mock.Anything).Maybe()
+	mockAccountRepo.On("GetByID", mock.Anything, account.ID).Return(account, nil)
+	mockTxnRepo.On("SumBalanceEffectSince", mock.Anything, account.ID, from).Return(vo.ZeroMoney(), nil)
+	mockTxnRepo.On("GetCompletedByAccountIDBetween", mock.Anything, account.ID, from, time.Date(2024, 5, 18, 0, 0, 0, 0, time.UTC)).
+		Return([]*entity.Transaction{}, nil)
+
+	valueDating := NewValueDatingPolicy(&StubCalendar{}, vo.ProcessingWindow{}, clock)
+	result, err := NewStatementUseCase(mockTxnRepo, mockAccountRepo, valueDating, mockLogger).GetStatement(context.Background(), dto.StatementRequest{
+		AccountID: account.ID.String(),
+	})
+
+	require.NoError(t, err)
+	// Month-to-date of the test clock, not of the system clock
+	assert.Equal(t, "2024-05-01", result.From)
+	assert.Equal(t, "2024-05-17", result.To)
+}
+
+func TestStatementUseCase_GetStatement_Errors(t *testing.T) {
+	account := createTestAccount()
+	dollars, _ := entity.NewAccount("Dollar Account", vo.NewMoneyFromFloat(100).In("USD"))
+
+	tests := []struct {
+		name        string
+		req         dto.StatementRequest
+		setup       func(*MockTransactionRepository, *MockAccountRepository)
+		expectedErr error
+	}{
+		{
+			name:        "invalid_date",
+			req:         dto.StatementRequest{AccountID: account.ID.String(), From: "01/03/2024"},
+			expectedErr: errs.ValidationError{},
+		},
+		{
+			name:        "to_before_from",
+			req:         dto.StatementRequest{AccountID: account.ID.String(), From: "2024-03-31", To: "2024-03-01"},
+			expectedErr: errs.ValidationError{},
+		},
+		{
+			name:        "period_too_long",
+			req:         dto.StatementRequest{AccountID: account.ID.String(), From: "2023-01-01", To: "2024-03-01"},
+			expectedErr: errs.ValidationError{},
+		},
+		{
+			name: "account_not_found",
+			req:  dto.StatementRequest{AccountID: account.ID.String(), From: "2024-03-01", To: "2024-03-31"},
+			setup: func(_ *MockTransactionRepository, accounts *MockAccountRepository) {
+				accounts.On("GetByID", mock.Anything, account.ID).Return((*entity.Account)(nil), errors.New("record not found"))
+			},
+			expectedErr: errs.ErrAccountNotFound,
+		},
+		{
+			name: "opening_balance_currency_mismatch",
+			req:  dto.StatementRequest{AccountID: dollars.ID.String(), From: "2024-03-01", To: "2024-03-31"},
+			setup: func(transactions *MockTransactionRepository, accounts *MockAccountRepository) {
+				accounts.On("GetByID", mock.Anything, dollars.ID).Return(dollars, nil)
+				transactions.On("SumBalanceEffectSince", mock.Anything, dollars.ID, mock.Anything).Return(vo.NewMoneyFromFloat(5).In("THB"), nil)
+			},
+			expectedErr: errs.ErrCurrencyMismatch,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockTxnRepo := new(MockTransactionRepository)
+			mockAccountRepo := new(MockAccountRepository)
+			mockLogger := new(MockLogger)
+			mockLogger.On("Error", mock.Anything, mock.Anything).Maybe()
+			if tt.setup != nil {
+				tt.setup(mockTxnRepo, mockAccountRepo)
+			}
+
+			_, err := NewStatementUseCase(mockTxnRepo, mockAccountRepo, NewValueDatingPolicy(&StubCalendar{}, vo.ProcessingWindow{}, nil), mockLogger).GetStatement(context.Background(), tt.req)
+
+			if _, ok := tt.expectedErr.(errs.ValidationError); ok {
+				assert.IsType(t, errs.ValidationError{}, err)
+			} else {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			}
+			mockTxnRepo.AssertNotCalled(t, "GetCompletedByAccountIDBetween", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+}
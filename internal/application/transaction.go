@@ -3,12 +3,15 @@ package usecase
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/hydr0g3nz/mini_bank/internal/application/dto"
 	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
 	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/event"
 	"github.com/hydr0g3nz/mini_bank/internal/domain/infra"
 	"github.com/hydr0g3nz/mini_bank/internal/domain/repository"
 	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
@@ -17,24 +20,91 @@ import (
 type transactionUseCase struct {
 	transactionRepo repository.TransactionRepository
 	accountRepo     repository.AccountRepository
+	virtualAccounts repository.VirtualAccountRepository
 	cache           infra.CacheService
+	locks           infra.LockService
+	lists           *ListCache
+	events          infra.EventPublisher
+	outbox          repository.OutboxRepository
 	logger          infra.Logger
+	valueDating     *ValueDatingPolicy
+	review          *ReviewPolicy
+	categorizer     *Categorizer
+	rules           *BusinessRules
+	products        *ProductPolicy
+	credits         *CreditBatcher
+	periods         *PeriodLock
+	blocks          *TransactionBlocks
+	velocity        *VelocityLimits
+	sandbox         *Sandbox
+	exchangeRates   infra.ExchangeRateService
+	channelLimits   vo.ChannelLimits
+	currency        string
 	mapper          *dto.TransactionMapper
+	sagas           *sagaCoordinator
+	units           repository.UnitOfWork
 }
 
-// NewTransactionUseCase creates a new transaction use case
+// NewTransactionUseCase creates a new transaction use case; a nil lists caches list pages with the default
+// policies, a nil units applies the balance changes of a transaction one repository call at a time, and
+// a nil outbox leaves the transaction events to events alone
 func NewTransactionUseCase(
 	transactionRepo repository.TransactionRepository,
 	accountRepo repository.AccountRepository,
+	virtualAccounts repository.VirtualAccountRepository,
+	sagaRepo repository.SagaRepository,
+	units repository.UnitOfWork,
 	cache infra.CacheService,
+	locks infra.LockService,
+	lists *ListCache,
+	events infra.EventPublisher,
+	outbox repository.OutboxRepository,
+	valueDating *ValueDatingPolicy,
+	review *ReviewPolicy,
+	categorizer *Categorizer,
+	rules *BusinessRules,
+	products *ProductPolicy,
+	credits *CreditBatcher,
+	periods *PeriodLock,
+	blocks *TransactionBlocks,
+	velocity *VelocityLimits,
+	sandbox *Sandbox,
+	exchangeRates infra.ExchangeRateService,
+	channelLimits vo.ChannelLimits,
+	normalizers *vo.TextNormalizers,
+	currency string,
 	logger infra.Logger,
 ) TransactionUseCase {
+	if lists == nil {
+		lists = NewListCache(cache, nil, logger)
+	}
+
 	return &transactionUseCase{
 		transactionRepo: transactionRepo,
 		accountRepo:     accountRepo,
+		virtualAccounts: virtualAccounts,
 		cache:           cache,
+		locks:           locks,
+		lists:           lists,
+		events:          events,
+		outbox:          outbox,
+		valueDating:     valueDating,
+		review:          review,
+		categorizer:     categorizer,
+		rules:           rules,
+		products:        products,
+		credits:         credits,
+		periods:         periods,
+		blocks:          blocks,
+		velocity:        velocity,
+		sandbox:         sandbox,
+		exchangeRates:   exchangeRates,
+		channelLimits:   channelLimits,
+		currency:        currency,
 		logger:          logger,
-		mapper:          &dto.TransactionMapper{},
+		mapper:          &dto.TransactionMapper{Normalizers: normalizers},
+		sagas:           &sagaCoordinator{sagaRepo: sagaRepo, logger: logger},
+		units:           units,
 	}
 }
 
@@ -44,7 +114,28 @@ func (uc *transactionUseCase) CreateTransaction(ctx context.Context, req dto.Cre
 		"type", req.TransactionType,
 		"amount", req.Amount,
 		"fromAccountID", req.FromAccountID,
-		"toAccountID", req.ToAccountID)
+		"toAccountID", req.ToAccountID,
+		"toVirtualAccountID", req.ToVirtualAccountID,
+		"channel", req.Channel)
+
+	channel, err := parseChannel(req.Channel)
+	if err != nil {
+		return nil, err
+	}
+	referenceType, err := parseReferenceType(req.ReferenceType)
+	if err != nil {
+		return nil, err
+	}
+
+	// Credits paid to a virtual account number go to its settlement account
+	var virtualAccount *entity.VirtualAccount
+	if req.ToVirtualAccountID != "" {
+		if virtualAccount, err = uc.resolveVirtualAccount(ctx, req); err != nil {
+			return nil, err
+		}
+		settlementAccountID := virtualAccount.AccountID.String()
+		req.ToAccountID = &settlementAccountID
+	}
 
 	// Convert DTO to domain values
 	fromAccountID, toAccountID, transactionType, amount, description, reference, err := uc.mapper.FromCreateRequest(req)
@@ -54,19 +145,39 @@ func (uc *transactionUseCase) CreateTransaction(ctx context.Context, req dto.Cre
 	}
 
 	// Validate accounts exist and can transact
-	if err := uc.validateAccountsForTransaction(ctx, fromAccountID, toAccountID, transactionType); err != nil {
+	currency, toCurrency, err := uc.validateAccountsForTransaction(ctx, fromAccountID, toAccountID, transactionType)
+	if err != nil {
 		return nil, err
 	}
 
+	// The amount is in the accounts' currency, the source account's for an FX transfer; a request naming
+	// another one is rejected
+	if req.Currency != "" {
+		requested, err := vo.NewCurrency(req.Currency)
+		if err != nil {
+			return nil, err
+		}
+		if !requested.Matches(currency) {
+			uc.logger.Warn("Transaction currency does not match the accounts", "currency", requested.String(), "accountCurrency", currency.String())
+			return nil, errs.ErrCurrencyMismatch
+		}
+		if currency.IsZero() {
+			currency = requested
+		}
+	}
+	amount = amount.In(currency)
+
 	// Create transaction entity based on type
 	var transaction *entity.Transaction
 	switch transactionType {
 	case vo.TransactionTypeDebit:
-		transaction, err = entity.NewDebitTransaction(*fromAccountID, amount, description, reference)
+		transaction, err = entity.NewDebitTransaction(*fromAccountID, amount, description, reference, uc.valueDating.Now())
 	case vo.TransactionTypeCredit:
-		transaction, err = entity.NewCreditTransaction(*toAccountID, amount, description, reference)
+		transaction, err = entity.NewCreditTransaction(*toAccountID, amount, description, reference, uc.valueDating.Now())
 	case vo.TransactionTypeTransfer:
-		transaction, err = entity.NewTransferTransaction(*fromAccountID, *toAccountID, amount, description, reference)
+		transaction, err = entity.NewTransferTransaction(*fromAccountID, *toAccountID, amount, description, reference, uc.valueDating.Now())
+	case vo.TransactionTypeFXTransfer:
+		transaction, err = uc.newFXTransfer(ctx, *fromAccountID, *toAccountID, amount, toCurrency, description, reference)
 	default:
 		return nil, errs.ErrInvalidInput
 	}
@@ -76,8 +187,52 @@ func (uc *transactionUseCase) CreateTransaction(ctx context.Context, req dto.Cre
 		return nil, err
 	}
 
+	if err := transaction.SetChannel(channel); err != nil {
+		return nil, err
+	}
+	if err := transaction.SetReferenceType(referenceType); err != nil {
+		uc.logger.Warn("Transaction reference does not match its type", "referenceType", referenceType, "error", err)
+		return nil, err
+	}
+	if err := uc.checkChannelLimit(channel, amount); err != nil {
+		uc.logger.Warn("Transaction exceeds channel limit", "channel", channel, "amount", amount.String())
+		return nil, err
+	}
+
+	// Book the transaction for the business date allowed by the processing window
+	valueDate, err := uc.valueDating.ValueDate(ctx, transactionType)
+	if err != nil {
+		uc.logger.Error("Failed to determine value date", "error", err)
+		return nil, err
+	}
+	if err := transaction.SetValueDate(valueDate); err != nil {
+		return nil, err
+	}
+
+	transaction.Merchant = strings.TrimSpace(req.Merchant)
+	if virtualAccount != nil {
+		transaction.VirtualAccountID = virtualAccount.ID
+	}
+	uc.categorizer.Categorize(ctx, transaction)
+
+	// Admin-defined rules may reject the transaction or charge a fee on it, and so may the
+	// product of its source account
+	if err := uc.rules.Apply(ctx, transaction); err != nil {
+		return nil, err
+	}
+	if err := uc.products.Apply(ctx, transaction); err != nil {
+		return nil, err
+	}
+
 	// Save to repository
-	if err := uc.transactionRepo.Create(ctx, transaction); err != nil {
+	created := event.NewTransactionEvent(event.TransactionCreated, transaction)
+	err = uc.atomically(ctx, func(ctx context.Context) error {
+		if err := uc.transactionRepo.Create(ctx, transaction); err != nil {
+			return err
+		}
+		return uc.record(ctx, created)
+	})
+	if err != nil {
 		uc.logger.Error("Failed to save transaction to repository", "error", err, "transactionID", transaction.ID.String())
 		return nil, err
 	}
@@ -91,10 +246,142 @@ func (uc *transactionUseCase) CreateTransaction(ctx context.Context, req dto.Cre
 		uc.logger.Warn("Failed to cache transaction", "error", err, "transactionID", transaction.ID.String())
 	}
 
+	uc.publish(ctx, created)
+
 	uc.logger.Info("Transaction created successfully", "transactionID", transaction.ID.String())
 	return &response, nil
 }
 
+// SimulateTransfer runs the checks and balance changes of a transfer without persisting anything; between
+// accounts held in different currencies it simulates the FX transfer.
+// Every problem found is reported in the response; an error is only returned when the request is malformed
+// or the accounts cannot be loaded.
+func (uc *transactionUseCase) SimulateTransfer(ctx context.Context, req dto.SimulateTransferRequest) (*dto.TransferSimulationResponse, error) {
+	fromAccountID, err := vo.NewAccountIDFromString(req.FromAccountID)
+	if err != nil {
+		return nil, err
+	}
+	toAccountID, err := vo.NewAccountIDFromString(req.ToAccountID)
+	if err != nil {
+		return nil, err
+	}
+	channel, err := parseChannel(req.Channel)
+	if err != nil {
+		return nil, err
+	}
+	referenceType, err := parseReferenceType(req.ReferenceType)
+	if err != nil {
+		return nil, err
+	}
+
+	accounts, err := uc.accountRepo.GetByIDs(ctx, []vo.AccountID{fromAccountID, toAccountID})
+	if err != nil {
+		uc.logger.Error("Failed to load accounts for transfer simulation", "error", err)
+		return nil, err
+	}
+	from, to := accounts[fromAccountID], accounts[toAccountID]
+
+	// The amount is in the source account's currency; a destination held in another currency is credited
+	// the amount converted as the FX transfer would be
+	response := &dto.TransferSimulationResponse{
+		Currency: uc.currency,
+		Errors:   []dto.ErrorResponse{},
+	}
+	amount := req.Amount.Money()
+	if from != nil && !from.Currency().IsZero() {
+		response.Currency = from.Currency().String()
+		amount = amount.In(from.Currency())
+	}
+	fx := from != nil && to != nil && !to.Currency().Matches(from.Currency())
+
+	if err := uc.checkChannelLimit(channel, amount); err != nil {
+		response.Problems = append(response.Problems, err)
+	}
+
+	// The fee comes from the business rules and the source account's product, which need the whole transaction
+	fee := vo.ZeroMoney()
+	exchangeRate := 1.0
+	credited := amount
+	normalization := uc.mapper.Normalizers.For(channel)
+	description, reference := normalization.Normalize(req.Description), normalization.Normalize(req.Reference)
+	var transaction *entity.Transaction
+	if fx {
+		transaction, err = uc.newFXTransfer(ctx, fromAccountID, toAccountID, amount, to.Currency(), description, reference)
+	} else {
+		transaction, err = entity.NewTransferTransaction(fromAccountID, toAccountID, amount, description, reference, uc.valueDating.Now())
+	}
+	if err != nil {
+		response.Problems = append(response.Problems, err)
+	} else {
+		transaction.Channel = channel
+		if err := transaction.SetReferenceType(referenceType); err != nil {
+			response.Problems = append(response.Problems, err)
+		}
+		transaction.Merchant = strings.TrimSpace(req.Merchant)
+		uc.categorizer.Categorize(ctx, transaction)
+		response.Category = transaction.Category
+
+		if err := uc.rules.Apply(ctx, transaction); err != nil {
+			response.Problems = append(response.Problems, err)
+		}
+		if err := uc.products.Apply(ctx, transaction); err != nil {
+			response.Problems = append(response.Problems, err)
+		}
+		if err := uc.blocks.Check(ctx, transaction, uc.valueDating.Now()); err != nil {
+			response.Problems = append(response.Problems, err)
+		}
+		if err := uc.velocity.Check(ctx, transaction); err != nil {
+			response.Problems = append(response.Problems, err)
+		}
+		fee = transaction.Fee
+		credited = transaction.CreditedAmount()
+		if rate := transaction.ExchangeRate; rate != nil {
+			exchangeRate = rate.Rate.InexactFloat64()
+		}
+	}
+
+	totalDebit, err := amount.Add(fee)
+	if err != nil {
+		return nil, err
+	}
+	response.Amount = amount.InexactFloat64()
+	response.Fee = fee.InexactFloat64()
+	response.TotalDebit = totalDebit.InexactFloat64()
+	response.ExchangeRate = exchangeRate
+	response.CreditedAmount = credited.InexactFloat64()
+
+	// Balances are changed on the loaded copies only; nothing is written back
+	response.FromAccount = uc.simulateBalance(fromAccountID, from, response, func(account *entity.Account) error {
+		if err := uc.checkSpendingLimit(ctx, account, totalDebit); err != nil {
+			return err
+		}
+		return account.Debit(totalDebit)
+	})
+	response.ToAccount = uc.simulateBalance(toAccountID, to, response, func(account *entity.Account) error {
+		// Without a rate there is no converted amount to credit; the missing rate is already a problem
+		if fx && transaction == nil {
+			return nil
+		}
+		return account.Credit(credited)
+	})
+
+	valueDate, err := uc.valueDating.ValueDate(ctx, vo.TransactionTypeTransfer)
+	if err != nil {
+		response.Problems = append(response.Problems, err)
+	} else {
+		response.ValueDate = valueDate.Format("2006-01-02")
+	}
+
+	response.Valid = len(response.Problems) == 0
+
+	uc.logger.Info("Transfer simulated",
+		"fromAccountID", req.FromAccountID,
+		"toAccountID", req.ToAccountID,
+		"amount", req.Amount,
+		"valid", response.Valid)
+	return response, nil
+}
+
 // ConfirmTransaction confirms and processes a transaction (Idempotent)
 func (uc *transactionUseCase) ConfirmTransaction(ctx context.Context, req dto.ConfirmTransactionRequest) (*dto.TransactionResponse, error) {
 	uc.logger.Info("Confirming transaction", "transactionID", req.ID)
@@ -118,7 +405,7 @@ func (uc *transactionUseCase) ConfirmTransaction(ctx context.Context, req dto.Co
 
 	// Try to acquire distributed lock for this transaction to prevent concurrent processing
 	lockKey := fmt.Sprintf("lock:transaction:%s", req.ID)
-	lockAcquired, err := uc.acquireDistributedLock(ctx, lockKey, 30*time.Second)
+	lockToken, lockAcquired, err := uc.acquireDistributedLock(ctx, lockKey, 30*time.Second)
 	if err != nil {
 		uc.logger.Error("Failed to acquire distributed lock", "error", err, "transactionID", req.ID)
 		return nil, fmt.Errorf("failed to acquire lock: %w", err)
@@ -130,7 +417,7 @@ func (uc *transactionUseCase) ConfirmTransaction(ctx context.Context, req dto.Co
 
 	// Ensure lock is released
 	defer func() {
-		if err := uc.releaseLock(ctx, lockKey); err != nil {
+		if err := uc.releaseLock(ctx, lockKey, lockToken); err != nil {
 			uc.logger.Warn("Failed to release distributed lock", "error", err, "transactionID", req.ID)
 		}
 	}()
@@ -155,58 +442,174 @@ func (uc *transactionUseCase) ConfirmTransaction(ctx context.Context, req dto.Co
 		return &response, nil
 	}
 
+	// Transactions held for review are released by an admin, not by confirming again
+	if transaction.Status.IsInReview() {
+		uc.logger.Info("Transaction is held for review", "transactionID", req.ID)
+		response := uc.mapper.ToResponse(transaction)
+		return &response, nil
+	}
+
 	// Check if transaction can be confirmed
 	if !transaction.Status.CanTransitionTo(vo.TransactionStatusCompleted) {
 		uc.logger.Error("Transaction cannot be confirmed", "status", transaction.Status, "transactionID", req.ID)
 		return nil, fmt.Errorf("%w in status : %s", errs.ErrTransactionCannotBeConfirmed, transaction.Status)
 	}
 
-	// Process the transaction based on type
-	if err := uc.processTransaction(ctx, transaction); err != nil {
-		// Mark transaction as failed
-		if markErr := transaction.MarkAsFailed(); markErr != nil {
-			uc.logger.Error("Failed to mark transaction as failed", "error", markErr, "transactionID", req.ID)
-		} else {
-			uc.transactionRepo.Update(ctx, transaction)
-		}
+	// Transactions queued past the cutoff wait for their value date
+	if !transaction.IsDueOn(uc.valueDating.Today()) {
+		uc.logger.Warn("Transaction is not due yet", "transactionID", req.ID, "valueDate", transaction.ValueDate.Format("2006-01-02"))
+		return nil, fmt.Errorf("%w: %s", errs.ErrTransactionNotDue, transaction.ValueDate.Format("2006-01-02"))
+	}
 
-		uc.logger.Error("Failed to process transaction", "error", err, "transactionID", req.ID)
+	// Changes from here on are fenced, so they are dropped if this worker's lock expires and another takes over
+	if err := uc.claimTransaction(ctx, transaction, lockToken); err != nil {
 		return nil, err
 	}
 
-	// Mark transaction as completed
-	if err := transaction.MarkAsCompleted(); err != nil {
-		uc.logger.Error("Failed to mark transaction as completed", "error", err, "transactionID", req.ID)
+	// The fraud rules may hold the transaction for an admin to approve instead of processing it now
+	if reason, dueAt, hold := uc.review.Hold(ctx, transaction, uc.valueDating.Now()); hold {
+		return uc.holdForReview(ctx, transaction, reason, dueAt)
+	}
+
+	response, err := uc.finalize(ctx, transaction, idempotencyKey)
+	if err != nil {
 		return nil, err
 	}
 
-	// Update transaction in repository
-	if err := uc.transactionRepo.Update(ctx, transaction); err != nil {
-		uc.logger.Error("Failed to update transaction in repository", "error", err, "transactionID", req.ID)
+	uc.logger.Info("Transaction confirmed successfully", "transactionID", req.ID)
+	return response, nil
+}
+
+// finalize processes a transaction, records the outcome and publishes it
+func (uc *transactionUseCase) finalize(ctx context.Context, transaction *entity.Transaction, idempotencyKey string) (*dto.TransactionResponse, error) {
+	transactionID := transaction.ID.String()
+
+	// Nothing is posted into a closed period, past a block on its accounts or past the source account's
+	// velocity limits; otherwise process the transaction based on type, once the sandbox test accounts it
+	// involves have failed or delayed it
+	err := uc.periods.CheckOpen(ctx, transaction.ValueDate)
+	if err == nil {
+		err = uc.blocks.Check(ctx, transaction, uc.valueDating.Now())
+	}
+	release := func() {}
+	if err == nil {
+		release, err = uc.velocity.Reserve(ctx, transaction)
+	}
+	if err == nil {
+		err = uc.sandbox.Process(ctx, transaction)
+	}
+	var completed event.Event
+	if err == nil {
+		completed, err = uc.complete(ctx, transaction)
+	}
+	if err != nil {
+		// A transaction that did not go through does not count toward the velocity limits
+		release()
+
+		// Transient failures leave the transaction as it was so the caller can simply retry
+		if errs.IsRetryable(err) {
+			uc.logger.Warn("Transaction processing failed transiently, leaving it unchanged", "error", err, "transactionID", transactionID)
+			if !errors.Is(err, errs.ErrTransient) {
+				err = fmt.Errorf("%w: %w", errs.ErrTransient, err)
+			}
+			return nil, err
+		}
+
+		// Mark transaction as failed, recording whether a replay could succeed
+		if markErr := transaction.Fail(failureKind(err), err.Error()); markErr != nil {
+			uc.logger.Error("Failed to mark transaction as failed", "error", markErr, "transactionID", transactionID)
+		} else {
+			failed := event.NewTransactionEvent(event.TransactionFailed, transaction)
+			saveErr := uc.atomically(ctx, func(ctx context.Context) error {
+				if err := uc.transactionRepo.Update(ctx, transaction); err != nil {
+					return err
+				}
+				return uc.record(ctx, failed)
+			})
+			if saveErr != nil {
+				uc.logger.Error("Failed to record transaction failure", "error", saveErr, "transactionID", transactionID)
+			} else {
+				uc.publish(ctx, failed)
+			}
+		}
+
+		uc.logger.Error("Failed to process transaction", "error", err, "transactionID", transactionID)
 		return nil, err
 	}
 
 	// Convert to response
 	response := uc.mapper.ToResponse(transaction)
 
-	// Cache the result for idempotency (longer TTL since it's completed)
-	if err := uc.cache.Set(ctx, idempotencyKey, response, 24*time.Hour); err != nil {
-		uc.logger.Warn("Failed to cache confirmed transaction result", "error", err, "transactionID", req.ID)
-	}
-
 	// Update transaction cache
-	transactionCacheKey := fmt.Sprintf("transaction:%s", req.ID)
+	transactionCacheKey := fmt.Sprintf("transaction:%s", transactionID)
 	if err := uc.cache.Set(ctx, transactionCacheKey, response, 30*time.Minute); err != nil {
-		uc.logger.Warn("Failed to update transaction cache", "error", err, "transactionID", req.ID)
+		uc.logger.Warn("Failed to update transaction cache", "error", err, "transactionID", transactionID)
+	}
+
+	// Only the confirmation warns of nearly used up limits, retries of it included
+	uc.warnNearLimits(ctx, transaction, &response)
+
+	// Cache the result for idempotency (longer TTL since it's completed)
+	if err := uc.cache.Set(ctx, idempotencyKey, response, 24*time.Hour); err != nil {
+		uc.logger.Warn("Failed to cache confirmed transaction result", "error", err, "transactionID", transactionID)
 	}
 
 	// Invalidate account caches since balances changed
 	uc.invalidateAccountCaches(ctx, transaction)
 
-	uc.logger.Info("Transaction confirmed successfully", "transactionID", req.ID)
+	uc.publish(ctx, completed)
+
 	return &response, nil
 }
 
+// complete applies a transaction's balance changes and records it as completed in one unit of work, so
+// a failure at any point rolls back every account it changed and leaves the transaction as it was. The
+// returned completion event is already in the outbox, stored in the same unit of work.
+func (uc *transactionUseCase) complete(ctx context.Context, transaction *entity.Transaction) (event.Event, error) {
+	before := *transaction
+	var completed event.Event
+	err := uc.atomically(ctx, func(ctx context.Context) error {
+		if err := uc.processTransaction(ctx, transaction); err != nil {
+			return err
+		}
+
+		// Mark transaction as completed
+		if err := transaction.MarkAsCompleted(uc.valueDating.Now()); err != nil {
+			uc.logger.Error("Failed to mark transaction as completed", "error", err, "transactionID", transaction.ID.String())
+			return err
+		}
+
+		// Update transaction in repository
+		if err := uc.transactionRepo.Update(ctx, transaction); err != nil {
+			uc.logger.Error("Failed to update transaction in repository", "error", err, "transactionID", transaction.ID.String())
+			return err
+		}
+
+		completed = event.NewTransactionEvent(event.TransactionCompleted, transaction)
+		return uc.record(ctx, completed)
+	})
+	if err != nil {
+		*transaction = before
+	}
+	return completed, err
+}
+
+// atomically runs fn in a unit of work, or directly when the use case has none
+func (uc *transactionUseCase) atomically(ctx context.Context, fn func(ctx context.Context) error) error {
+	if uc.units == nil {
+		return fn(ctx)
+	}
+	return uc.units.Do(ctx, fn)
+}
+
+// failureKind records why processing failed: business failures are final, infrastructure failures may be replayed
+func failureKind(err error) vo.FailureKind {
+	if errs.Classify(err) == errs.CategoryBusiness {
+		return vo.FailureKindBusiness
+	}
+	return vo.FailureKindInfrastructure
+}
+
 // GetTransaction retrieves a transaction by ID
 func (uc *transactionUseCase) GetTransaction(ctx context.Context, id string) (*dto.TransactionResponse, error) {
 	uc.logger.Debug("Getting transaction", "transactionID", id)
@@ -245,6 +648,79 @@ func (uc *transactionUseCase) GetTransaction(ctx context.Context, id string) (*d
 	return &response, nil
 }
 
+// BatchGetTransactions retrieves several transactions in the order requested and lists the IDs without a
+// transaction. Cached transactions are read in one round trip and the rest are loaded in one query;
+// repeated IDs are looked up once.
+func (uc *transactionUseCase) BatchGetTransactions(ctx context.Context, req dto.BatchGetRequest) (*dto.TransactionBatchResponse, error) {
+	ids := distinctIDs(req.IDs)
+	uc.logger.Debug("Getting transactions", "count", len(ids))
+
+	transactionIDs := make([]vo.TransactionID, len(ids))
+	cacheKeys := make([]string, len(ids))
+	cached := make([]dto.TransactionResponse, len(ids))
+	dests := make([]interface{}, len(ids))
+	for i, id := range ids {
+		transactionID, err := vo.NewTransactionIDFromString(id)
+		if err != nil {
+			uc.logger.Error("Invalid transaction ID format", "error", err, "transactionID", id)
+			return nil, err
+		}
+		transactionIDs[i] = transactionID
+		cacheKeys[i] = fmt.Sprintf("transaction:%s", id)
+		dests[i] = &cached[i]
+	}
+
+	found, err := uc.cache.GetMany(ctx, cacheKeys, dests)
+	if err != nil {
+		uc.logger.Warn("Failed to read transactions from cache", "error", err)
+		found = make([]bool, len(ids))
+	}
+
+	var missing []vo.TransactionID
+	for i := range ids {
+		if !found[i] {
+			missing = append(missing, transactionIDs[i])
+		}
+	}
+
+	if len(missing) > 0 {
+		transactions, err := uc.transactionRepo.GetByIDs(ctx, missing)
+		if err != nil {
+			uc.logger.Error("Failed to get transactions from repository", "error", err)
+			return nil, err
+		}
+
+		for i, id := range ids {
+			if found[i] {
+				continue
+			}
+			transaction, ok := transactions[transactionIDs[i]]
+			if !ok {
+				continue
+			}
+
+			cached[i] = uc.mapper.ToResponse(transaction)
+			found[i] = true
+			if err := uc.cache.Set(ctx, cacheKeys[i], cached[i], 30*time.Minute); err != nil {
+				uc.logger.Warn("Failed to cache transaction", "error", err, "transactionID", id)
+			}
+		}
+	}
+
+	response := &dto.TransactionBatchResponse{
+		Found:   make([]dto.TransactionResponse, 0, len(ids)),
+		Missing: make([]string, 0),
+	}
+	for i, id := range ids {
+		if found[i] {
+			response.Found = append(response.Found, cached[i])
+		} else {
+			response.Missing = append(response.Missing, id)
+		}
+	}
+	return response, nil
+}
+
 // ListTransactions retrieves transactions with pagination
 func (uc *transactionUseCase) ListTransactions(ctx context.Context, req dto.ListRequest) (*dto.TransactionListResponse, error) {
 	uc.logger.Debug("Listing transactions", "page", req.Page, "pageSize", req.PageSize)
@@ -252,40 +728,32 @@ func (uc *transactionUseCase) ListTransactions(ctx context.Context, req dto.List
 	// Calculate offset
 	offset := (req.Page - 1) * req.PageSize
 
-	// Try to get from cache first
+	// Serve from cache, refreshing stale pages in the background
 	cacheKey := fmt.Sprintf("transactions:list:page:%d:size:%d", req.Page, req.PageSize)
-	var cachedResponse dto.TransactionListResponse
-	if err := uc.cache.Get(ctx, cacheKey, &cachedResponse); err == nil {
-		uc.logger.Debug("Transaction list found in cache")
-		return &cachedResponse, nil
-	}
-
-	// Get from repository
-	transactions, err := uc.transactionRepo.List(ctx, req.PageSize, offset)
-	if err != nil {
-		uc.logger.Error("Failed to get transactions from repository", "error", err)
-		return nil, err
-	}
+	response, err := loadList(ctx, uc.lists, ListEndpointTransactions, cacheKey, func(ctx context.Context) (dto.TransactionListResponse, error) {
+		transactions, err := uc.transactionRepo.List(ctx, req.PageSize, offset)
+		if err != nil {
+			uc.logger.Error("Failed to get transactions from repository", "error", err)
+			return dto.TransactionListResponse{}, err
+		}
 
-	// Create pagination info
-	pagination := dto.PaginationInfo{
-		Page:       req.Page,
-		PageSize:   req.PageSize,
-		TotalItems: int64(len(transactions)),
-		TotalPages: (len(transactions) + req.PageSize - 1) / req.PageSize,
-		HasNext:    len(transactions) == req.PageSize,
-		HasPrev:    req.Page > 1,
-	}
+		total, err := uc.transactionRepo.Count(ctx)
+		if err != nil {
+			uc.logger.Error("Failed to count transactions", "error", err)
+			return dto.TransactionListResponse{}, err
+		}
 
-	// Convert to response DTO
-	response := uc.mapper.ToResponseList(transactions, pagination)
+		// Create pagination info
+		pagination := dto.NewPaginationInfo(req.Page, req.PageSize, total)
 
-	// Cache the result for shorter time
-	if err := uc.cache.Set(ctx, cacheKey, response, 2*time.Minute); err != nil {
-		uc.logger.Warn("Failed to cache transaction list", "error", err)
+		// Convert to response DTO
+		return uc.mapper.ToResponseList(transactions, pagination), nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	uc.logger.Debug("Transaction list retrieved successfully", "count", len(transactions))
+	uc.logger.Debug("Transaction list retrieved successfully", "count", len(response.Transactions))
 	return &response, nil
 }
 
@@ -303,77 +771,135 @@ func (uc *transactionUseCase) GetTransactionsByAccount(ctx context.Context, acco
 	// Calculate offset
 	offset := (req.Page - 1) * req.PageSize
 
-	// Try to get from cache first
+	// Serve from cache, refreshing stale pages in the background
 	cacheKey := fmt.Sprintf("transactions:account:%s:page:%d:size:%d", accountID, req.Page, req.PageSize)
-	var cachedResponse dto.TransactionListResponse
-	if err := uc.cache.Get(ctx, cacheKey, &cachedResponse); err == nil {
-		uc.logger.Debug("Account transactions found in cache", "accountID", accountID)
-		return &cachedResponse, nil
+	response, err := loadList(ctx, uc.lists, ListEndpointAccountTransactions, cacheKey, func(ctx context.Context) (dto.TransactionListResponse, error) {
+		transactions, err := uc.transactionRepo.GetByAccountID(ctx, parsedAccountID, req.PageSize, offset)
+		if err != nil {
+			uc.logger.Error("Failed to get transactions by account from repository", "error", err, "accountID", accountID)
+			return dto.TransactionListResponse{}, err
+		}
+
+		total, err := uc.transactionRepo.CountByAccountID(ctx, parsedAccountID)
+		if err != nil {
+			uc.logger.Error("Failed to count transactions by account", "error", err, "accountID", accountID)
+			return dto.TransactionListResponse{}, err
+		}
+
+		// Create pagination info
+		pagination := dto.NewPaginationInfo(req.Page, req.PageSize, total)
+
+		// Convert to response DTO
+		return uc.mapper.ToResponseList(transactions, pagination), nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	// Get from repository
-	transactions, err := uc.transactionRepo.GetByAccountID(ctx, parsedAccountID, req.PageSize, offset)
+	// Overrides are resolved on every read so they take effect without waiting for the cache to expire
+	uc.categorizer.ApplyOverrides(ctx, parsedAccountID, response.Transactions)
+
+	uc.logger.Debug("Account transactions retrieved successfully", "accountID", accountID, "count", len(response.Transactions))
+	return &response, nil
+}
+
+// SyncAccountTransactions retrieves the transactions of an account written after a change number, in change
+// order. Every write of a transaction takes the account's next change number, so a transaction changed several
+// times since the cursor comes once, in its latest state; cancelled ones come as tombstones.
+func (uc *transactionUseCase) SyncAccountTransactions(ctx context.Context, req dto.TransactionSyncRequest) (*dto.TransactionSyncResponse, error) {
+	accountID, err := vo.NewAccountIDFromString(req.AccountID)
 	if err != nil {
-		uc.logger.Error("Failed to get transactions by account from repository", "error", err, "accountID", accountID)
+		uc.logger.Error("Invalid account ID format", "error", err, "accountID", req.AccountID)
 		return nil, err
 	}
 
-	// Create pagination info
-	pagination := dto.PaginationInfo{
-		Page:       req.Page,
-		PageSize:   req.PageSize,
-		TotalItems: int64(len(transactions)),
-		TotalPages: (len(transactions) + req.PageSize - 1) / req.PageSize,
-		HasNext:    len(transactions) == req.PageSize,
-		HasPrev:    req.Page > 1,
+	if _, err := uc.accountRepo.GetByID(ctx, accountID); err != nil {
+		return nil, errs.ErrAccountNotFound
 	}
 
-	// Convert to response DTO
-	response := uc.mapper.ToResponseList(transactions, pagination)
+	transactions, err := uc.transactionRepo.GetChangedByAccountIDSince(ctx, accountID, req.SinceSequence, req.Limit)
+	if err != nil {
+		uc.logger.Error("Failed to get changed transactions", "error", err, "accountID", req.AccountID)
+		return nil, err
+	}
 
-	// Cache the result
-	if err := uc.cache.Set(ctx, cacheKey, response, 5*time.Minute); err != nil {
-		uc.logger.Warn("Failed to cache account transactions", "error", err, "accountID", accountID)
+	response := &dto.TransactionSyncResponse{
+		AccountID:    req.AccountID,
+		Transactions: make([]dto.TransactionResponse, 0, len(transactions)),
+		Tombstones:   []dto.TransactionTombstone{},
+		NextSequence: req.SinceSequence,
+		HasMore:      len(transactions) == req.Limit,
+	}
+	for _, transaction := range transactions {
+		change := transaction.ChangeFor(accountID)
+		if transaction.Status == vo.TransactionStatusCancelled {
+			response.Tombstones = append(response.Tombstones, dto.TransactionTombstone{
+				TransactionID: transaction.ID.String(),
+				Sequence:      change,
+			})
+		} else {
+			response.Transactions = append(response.Transactions, uc.mapper.ToResponse(transaction))
+		}
+		response.NextSequence = change
 	}
 
-	uc.logger.Debug("Account transactions retrieved successfully", "accountID", accountID, "count", len(transactions))
-	return &response, nil
+	uc.categorizer.ApplyOverrides(ctx, accountID, response.Transactions)
+
+	uc.logger.Debug("Account transactions synced", "accountID", req.AccountID, "since", req.SinceSequence, "count", len(transactions))
+	return response, nil
 }
 
-// CancelTransaction cancels a transaction
-func (uc *transactionUseCase) CancelTransaction(ctx context.Context, req dto.CancelTransactionRequest) error {
+// CancelTransaction cancels a pending transaction, or withdraws one held for review through a cancellation saga
+func (uc *transactionUseCase) CancelTransaction(ctx context.Context, req dto.CancelTransactionRequest) (*dto.CancelTransactionResponse, error) {
 	uc.logger.Info("Cancelling transaction", "transactionID", req.ID)
 
 	// Parse transaction ID
 	transactionID, err := vo.NewTransactionIDFromString(req.ID)
 	if err != nil {
 		uc.logger.Error("Invalid transaction ID format", "error", err, "transactionID", req.ID)
-		return err
+		return nil, err
 	}
 
 	// Get transaction
 	transaction, err := uc.transactionRepo.GetByID(ctx, transactionID)
 	if err != nil {
 		uc.logger.Error("Transaction not found", "error", err, "transactionID", req.ID)
-		return errs.ErrTransactionNotFound
+		return nil, errs.ErrTransactionNotFound
+	}
+
+	// An adjustment awaiting its second admin is declined through the review queue instead
+	if transaction.TransactionType.IsAdjustment() {
+		return nil, fmt.Errorf("%w: adjustments are declined through the review queue", errs.ErrTransactionCannotBeCancelled)
+	}
+
+	// A transaction held for review is already out of the customer's hands and may settle while it is being cancelled
+	if transaction.Status.IsInReview() {
+		return uc.cancelInFlight(ctx, transaction)
 	}
 
 	// Check if transaction can be cancelled
 	if !transaction.Status.IsPending() {
 		uc.logger.Error("Transaction cannot be cancelled", "status", transaction.Status, "transactionID", req.ID)
-		return fmt.Errorf("%w in status: %s", errs.ErrTransactionCannotBeCancelled, transaction.Status)
+		return nil, fmt.Errorf("%w in status: %s", errs.ErrTransactionCannotBeCancelled, transaction.Status)
 	}
 
 	// Cancel transaction
 	if err := transaction.MarkAsCancelled(); err != nil {
 		uc.logger.Error("Failed to cancel transaction", "error", err, "transactionID", req.ID)
-		return err
+		return nil, err
 	}
 
 	// Update in repository
-	if err := uc.transactionRepo.Update(ctx, transaction); err != nil {
+	cancelled := event.NewTransactionEvent(event.TransactionCancelled, transaction)
+	err = uc.atomically(ctx, func(ctx context.Context) error {
+		if err := uc.transactionRepo.Update(ctx, transaction); err != nil {
+			return err
+		}
+		return uc.record(ctx, cancelled)
+	})
+	if err != nil {
 		uc.logger.Error("Failed to update cancelled transaction in repository", "error", err, "transactionID", req.ID)
-		return err
+		return nil, err
 	}
 
 	// Update cache
@@ -383,8 +909,10 @@ func (uc *transactionUseCase) CancelTransaction(ctx context.Context, req dto.Can
 		uc.logger.Warn("Failed to update transaction cache", "error", err, "transactionID", req.ID)
 	}
 
+	uc.publish(ctx, cancelled)
+
 	uc.logger.Info("Transaction cancelled successfully", "transactionID", req.ID)
-	return nil
+	return &dto.CancelTransactionResponse{TransactionID: req.ID, Outcome: dto.CancellationOutcomeCancelled}, nil
 }
 
 // GetTransactionsByStatus retrieves transactions by status
@@ -401,92 +929,243 @@ func (uc *transactionUseCase) GetTransactionsByStatus(ctx context.Context, statu
 	// Calculate offset
 	offset := (req.Page - 1) * req.PageSize
 
-	// Try to get from cache first
+	// Serve from cache, refreshing stale pages in the background
 	cacheKey := fmt.Sprintf("transactions:status:%s:page:%d:size:%d", status, req.Page, req.PageSize)
-	var cachedResponse dto.TransactionListResponse
-	if err := uc.cache.Get(ctx, cacheKey, &cachedResponse); err == nil {
-		uc.logger.Debug("Transactions by status found in cache", "status", status)
-		return &cachedResponse, nil
-	}
-
-	// Get from repository
-	transactions, err := uc.transactionRepo.GetByStatus(ctx, transactionStatus, req.PageSize, offset)
-	if err != nil {
-		uc.logger.Error("Failed to get transactions by status from repository", "error", err, "status", status)
-		return nil, err
-	}
+	response, err := loadList(ctx, uc.lists, ListEndpointTransactionsByStatus, cacheKey, func(ctx context.Context) (dto.TransactionListResponse, error) {
+		transactions, err := uc.transactionRepo.GetByStatus(ctx, transactionStatus, req.PageSize, offset)
+		if err != nil {
+			uc.logger.Error("Failed to get transactions by status from repository", "error", err, "status", status)
+			return dto.TransactionListResponse{}, err
+		}
 
-	// Create pagination info
-	pagination := dto.PaginationInfo{
-		Page:       req.Page,
-		PageSize:   req.PageSize,
-		TotalItems: int64(len(transactions)),
-		TotalPages: (len(transactions) + req.PageSize - 1) / req.PageSize,
-		HasNext:    len(transactions) == req.PageSize,
-		HasPrev:    req.Page > 1,
-	}
+		total, err := uc.transactionRepo.CountByStatus(ctx, transactionStatus)
+		if err != nil {
+			uc.logger.Error("Failed to count transactions by status", "error", err, "status", status)
+			return dto.TransactionListResponse{}, err
+		}
 
-	// Convert to response DTO
-	response := uc.mapper.ToResponseList(transactions, pagination)
+		// Create pagination info
+		pagination := dto.NewPaginationInfo(req.Page, req.PageSize, total)
 
-	// Cache the result
-	if err := uc.cache.Set(ctx, cacheKey, response, 5*time.Minute); err != nil {
-		uc.logger.Warn("Failed to cache transactions by status", "error", err, "status", status)
+		// Convert to response DTO
+		return uc.mapper.ToResponseList(transactions, pagination), nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	uc.logger.Debug("Transactions by status retrieved successfully", "status", status, "count", len(transactions))
+	uc.logger.Debug("Transactions by status retrieved successfully", "status", status, "count", len(response.Transactions))
 	return &response, nil
 }
 
 // Helper methods
 
-// validateAccountsForTransaction validates that accounts exist and can perform the transaction
+// validateAccountsForTransaction validates that accounts exist and can perform the transaction,
+// returning the currency of the amount and the currency the destination is credited in; they differ
+// only for an FX transfer
 func (uc *transactionUseCase) validateAccountsForTransaction(
 	ctx context.Context,
 	fromAccountID *vo.AccountID,
 	toAccountID *vo.AccountID,
 	transactionType vo.TransactionType,
-) error {
+) (vo.Currency, vo.Currency, error) {
 	switch transactionType {
 	case vo.TransactionTypeDebit:
 		if fromAccountID == nil {
-			return errs.ErrMissingAccountID
+			return "", "", errs.ErrMissingAccountID
 		}
-		return uc.validateAccountCanTransact(ctx, *fromAccountID)
+		currency, err := uc.validateAccountCanTransact(ctx, *fromAccountID)
+		return currency, currency, err
 
 	case vo.TransactionTypeCredit:
 		if toAccountID == nil {
-			return errs.ErrMissingAccountID
+			return "", "", errs.ErrMissingAccountID
 		}
-		return uc.validateAccountCanTransact(ctx, *toAccountID)
+		currency, err := uc.validateAccountCanTransact(ctx, *toAccountID)
+		return currency, currency, err
 
 	case vo.TransactionTypeTransfer:
 		if fromAccountID == nil || toAccountID == nil {
-			return errs.ErrMissingAccountID
+			return "", "", errs.ErrMissingAccountID
 		}
-		if err := uc.validateAccountCanTransact(ctx, *fromAccountID); err != nil {
-			return err
+		currency, err := uc.validateAccountsCanTransact(ctx, *fromAccountID, *toAccountID)
+		return currency, currency, err
+
+	case vo.TransactionTypeFXTransfer:
+		if fromAccountID == nil || toAccountID == nil {
+			return "", "", errs.ErrMissingAccountID
 		}
-		return uc.validateAccountCanTransact(ctx, *toAccountID)
+		return uc.validateFXAccounts(ctx, *fromAccountID, *toAccountID)
 	}
 
-	return nil
+	return "", "", nil
 }
 
-// validateAccountCanTransact checks if an account exists and can perform transactions
-func (uc *transactionUseCase) validateAccountCanTransact(ctx context.Context, accountID vo.AccountID) error {
+// newFXTransfer creates an FX transfer of amount, converted to the destination's currency at the rate
+// the exchange rate provider quotes now; without a provider no rate is available
+func (uc *transactionUseCase) newFXTransfer(
+	ctx context.Context,
+	fromAccountID vo.AccountID,
+	toAccountID vo.AccountID,
+	amount vo.Money,
+	toCurrency vo.Currency,
+	description string,
+	reference string,
+) (*entity.Transaction, error) {
+	if uc.exchangeRates == nil {
+		return nil, errs.ErrExchangeRateUnavailable
+	}
+
+	rate, err := uc.exchangeRates.GetRate(ctx, amount.Currency(), toCurrency)
+	if err != nil {
+		uc.logger.Warn("Failed to get exchange rate", "error", err, "from", amount.Currency().String(), "to", toCurrency.String())
+		return nil, err
+	}
+
+	uc.logger.Info("Converting FX transfer", "from", rate.From.String(), "to", rate.To.String(), "rate", rate.Rate.String(), "asOf", rate.AsOf)
+	return entity.NewFXTransferTransaction(fromAccountID, toAccountID, amount, rate, description, reference, uc.valueDating.Now())
+}
+
+// resolveVirtualAccount looks up the open virtual account a credit or transfer is paid to
+func (uc *transactionUseCase) resolveVirtualAccount(ctx context.Context, req dto.CreateTransactionRequest) (*entity.VirtualAccount, error) {
+	if req.ToAccountID != nil {
+		return nil, errs.ValidationError{
+			Field:   "toVirtualAccountID",
+			Message: "to_account_id and to_virtual_account_id cannot both be set",
+		}
+	}
+
+	if vo.TransactionType(req.TransactionType) == vo.TransactionTypeDebit {
+		return nil, errs.ValidationError{
+			Field:   "toVirtualAccountID",
+			Message: "debits cannot be paid to a virtual account",
+		}
+	}
+
+	virtualAccount, err := uc.virtualAccounts.GetByID(ctx, req.ToVirtualAccountID)
+	if err != nil {
+		uc.logger.Warn("Virtual account not found", "error", err, "virtualAccountID", req.ToVirtualAccountID)
+		return nil, err
+	}
+
+	if !virtualAccount.CanReceive() {
+		return nil, errs.ErrVirtualAccountClosed
+	}
+
+	return virtualAccount, nil
+}
+
+// validateAccountCanTransact checks if an account exists and can perform transactions, returning the
+// currency it is held in
+func (uc *transactionUseCase) validateAccountCanTransact(ctx context.Context, accountID vo.AccountID) (vo.Currency, error) {
 	account, err := uc.accountRepo.GetByID(ctx, accountID)
 	if err != nil {
 		uc.logger.Error("Account not found for transaction validation", "error", err, "accountID", accountID.String())
-		return errs.ErrAccountNotFound
+		return "", errs.ErrAccountNotFound
 	}
 
 	if !account.CanTransact() {
 		uc.logger.Error("Account cannot perform transactions", "accountID", accountID.String(), "status", account.Status)
-		return fmt.Errorf("%w : %s", errs.ErrAccountCannotTransact, account.Status)
+		return "", fmt.Errorf("%w : %s", errs.ErrAccountCannotTransact, account.Status)
 	}
 
-	return nil
+	return account.Currency(), nil
+}
+
+// loadAccountsForTransaction loads accounts in one query, checking that they exist and can perform transactions
+func (uc *transactionUseCase) loadAccountsForTransaction(ctx context.Context, accountIDs ...vo.AccountID) ([]*entity.Account, error) {
+	accounts, err := uc.accountRepo.GetByIDs(ctx, accountIDs)
+	if err != nil {
+		uc.logger.Error("Failed to load accounts for transaction validation", "error", err)
+		return nil, loadAccountError(err)
+	}
+
+	loaded := make([]*entity.Account, 0, len(accountIDs))
+	for _, accountID := range accountIDs {
+		account, ok := accounts[accountID]
+		if !ok {
+			uc.logger.Error("Account not found for transaction validation", "accountID", accountID.String())
+			return nil, errs.ErrAccountNotFound
+		}
+
+		if !account.CanTransact() {
+			uc.logger.Error("Account cannot perform transactions", "accountID", accountID.String(), "status", account.Status)
+			return nil, fmt.Errorf("%w : %s", errs.ErrAccountCannotTransact, account.Status)
+		}
+		loaded = append(loaded, account)
+	}
+
+	return loaded, nil
+}
+
+// validateAccountsCanTransact checks that accounts exist, can perform transactions and are held in one
+// currency, loading them in one query; it returns that currency
+func (uc *transactionUseCase) validateAccountsCanTransact(ctx context.Context, accountIDs ...vo.AccountID) (vo.Currency, error) {
+	accounts, err := uc.loadAccountsForTransaction(ctx, accountIDs...)
+	if err != nil {
+		return "", err
+	}
+
+	var currency vo.Currency
+	for _, account := range accounts {
+		accountID := account.ID
+		if !account.Currency().Matches(currency) {
+			uc.logger.Warn("Accounts are held in different currencies", "accountID", accountID.String(),
+				"currency", account.Currency().String(), "otherCurrency", currency.String())
+			return "", errs.ErrCurrencyMismatch
+		}
+		if currency.IsZero() {
+			currency = account.Currency()
+		}
+	}
+
+	return currency, nil
+}
+
+// validateFXAccounts checks that the accounts of an FX transfer exist, can perform transactions and are
+// held in different currencies, returning the source's and the destination's
+func (uc *transactionUseCase) validateFXAccounts(ctx context.Context, fromAccountID, toAccountID vo.AccountID) (vo.Currency, vo.Currency, error) {
+	accounts, err := uc.loadAccountsForTransaction(ctx, fromAccountID, toAccountID)
+	if err != nil {
+		return "", "", err
+	}
+
+	from, to := accounts[0].Currency(), accounts[1].Currency()
+	if from.Matches(to) {
+		uc.logger.Warn("FX transfer between accounts held in the same currency", "fromAccountID", fromAccountID.String(),
+			"toAccountID", toAccountID.String(), "currency", from.String())
+		return "", "", errs.ErrSameCurrencyFX
+	}
+
+	return from, to, nil
+}
+
+// simulateBalance projects a balance change on an account, recording why it would fail on the simulation
+func (uc *transactionUseCase) simulateBalance(
+	accountID vo.AccountID,
+	account *entity.Account,
+	simulation *dto.TransferSimulationResponse,
+	apply func(*entity.Account) error,
+) *dto.SimulatedBalance {
+	if account == nil {
+		simulation.Problems = append(simulation.Problems, errs.ErrAccountNotFound)
+		return nil
+	}
+
+	balance := &dto.SimulatedBalance{
+		AccountID: accountID.String(),
+		Balance:   account.Balance.InexactFloat64(),
+	}
+
+	if !account.CanTransact() {
+		simulation.Problems = append(simulation.Problems, fmt.Errorf("%w : %s", errs.ErrAccountCannotTransact, account.Status))
+	}
+	if err := apply(account); err != nil {
+		simulation.Problems = append(simulation.Problems, err)
+	}
+
+	balance.ProjectedBalance = account.Balance.InexactFloat64()
+	return balance
 }
 
 // processTransaction executes the actual transaction logic
@@ -496,8 +1175,12 @@ func (uc *transactionUseCase) processTransaction(ctx context.Context, transactio
 		return uc.processDebitTransaction(ctx, transaction)
 	case vo.TransactionTypeCredit:
 		return uc.processCreditTransaction(ctx, transaction)
-	case vo.TransactionTypeTransfer:
+	case vo.TransactionTypeTransfer, vo.TransactionTypeFXTransfer:
 		return uc.processTransferTransaction(ctx, transaction)
+	case vo.TransactionTypeAdjustment:
+		return uc.processAdjustmentTransaction(ctx, transaction)
+	case vo.TransactionTypeReversal:
+		return uc.processReversalTransaction(ctx, transaction)
 	default:
 		return fmt.Errorf("%w : %s", errs.ErrUnsupportedType, transaction.TransactionType)
 	}
@@ -509,24 +1192,31 @@ func (uc *transactionUseCase) processDebitTransaction(ctx context.Context, trans
 		return errs.ErrMissingAccountID
 	}
 
-	// Get account
-	account, err := uc.accountRepo.GetByID(ctx, *transaction.FromAccountID)
-	if err != nil {
-		return errs.ErrAccountNotFound
-	}
+	return uc.applyOnce(ctx, transaction, *transaction.FromAccountID, func() error {
+		// Get account, locking its row until the balance change is committed
+		account, err := uc.accountRepo.GetByIDForUpdate(ctx, *transaction.FromAccountID)
+		if err != nil {
+			return loadAccountError(err)
+		}
 
-	// Check if account can transact
-	if !account.CanTransact() {
-		return errs.ErrAccountCannotTransact
-	}
+		// Check if account can transact
+		if !account.CanTransact() {
+			return errs.ErrAccountCannotTransact
+		}
 
-	// Perform debit
-	if err := account.Debit(transaction.Amount); err != nil {
-		return err
-	}
+		// Child accounts may not exceed the monthly limit set by their parent
+		if err := uc.checkSpendingLimit(ctx, account, transaction.TotalDebit()); err != nil {
+			return err
+		}
+
+		// Perform debit, fee included
+		if err := account.Debit(transaction.TotalDebit()); err != nil {
+			return err
+		}
 
-	// Update account
-	return uc.accountRepo.Update(ctx, account)
+		// Update account
+		return uc.accountRepo.UpdateFenced(ctx, account, transaction.ID, transaction.ProcessingToken)
+	})
 }
 
 // processCreditTransaction processes a credit transaction
@@ -535,95 +1225,255 @@ func (uc *transactionUseCase) processCreditTransaction(ctx context.Context, tran
 		return errs.ErrMissingAccountID
 	}
 
-	// Get account
-	account, err := uc.accountRepo.GetByID(ctx, *transaction.ToAccountID)
-	if err != nil {
-		return errs.ErrAccountNotFound
+	// Credits to hot accounts are written in batches
+	if uc.credits.Batches(*transaction.ToAccountID) {
+		return uc.batchCredit(ctx, transaction, *transaction.ToAccountID, transaction.Amount)
 	}
 
-	// Check if account can transact
-	if !account.CanTransact() {
-		return errs.ErrAccountCannotTransact
-	}
+	return uc.applyOnce(ctx, transaction, *transaction.ToAccountID, func() error {
+		// Get account, locking its row until the balance change is committed
+		account, err := uc.accountRepo.GetByIDForUpdate(ctx, *transaction.ToAccountID)
+		if err != nil {
+			return loadAccountError(err)
+		}
 
-	// Perform credit
-	if err := account.Credit(transaction.Amount); err != nil {
-		return err
+		// Check if account can transact
+		if !account.CanTransact() {
+			return errs.ErrAccountCannotTransact
+		}
+
+		// Perform credit
+		if err := account.Credit(transaction.Amount); err != nil {
+			return err
+		}
+
+		// Update account
+		return uc.accountRepo.UpdateFenced(ctx, account, transaction.ID, transaction.ProcessingToken)
+	})
+}
+
+// processAdjustmentTransaction applies an approved adjustment to its account: an admin correction is
+// neither held back by the account's status nor by spending limits
+func (uc *transactionUseCase) processAdjustmentTransaction(ctx context.Context, transaction *entity.Transaction) error {
+	if transaction.ToAccountID != nil {
+		return uc.applyToAccount(ctx, transaction, *transaction.ToAccountID, func(account *entity.Account) error {
+			return account.Credit(transaction.Amount)
+		})
 	}
+	if transaction.FromAccountID != nil {
+		return uc.applyToAccount(ctx, transaction, *transaction.FromAccountID, func(account *entity.Account) error {
+			return account.Debit(transaction.Amount)
+		})
+	}
+	return errs.ErrMissingAccountID
+}
 
-	// Update account
-	return uc.accountRepo.Update(ctx, account)
+// processReversalTransaction moves a reversed transaction's money back the way it came: a reversal with
+// both accounts runs as a transfer, one with a single account as a credit or a debit
+func (uc *transactionUseCase) processReversalTransaction(ctx context.Context, transaction *entity.Transaction) error {
+	switch {
+	case transaction.FromAccountID != nil && transaction.ToAccountID != nil:
+		return uc.processTransferTransaction(ctx, transaction)
+	case transaction.FromAccountID != nil:
+		return uc.processDebitTransaction(ctx, transaction)
+	default:
+		return uc.processCreditTransaction(ctx, transaction)
+	}
 }
 
-// processTransferTransaction processes a transfer transaction
+// processTransferTransaction debits the source account and credits the destination. In a unit of work
+// a failure rolls the debit back with the rest. A credit to a hot account is written by the credit
+// batcher in a database transaction of its own, so such transfers, and transfers outside a unit of
+// work, run as a saga: a failure after the source account was debited is compensated and recorded.
+// An FX transfer credits the destination with the amount converted when the transfer was created.
 func (uc *transactionUseCase) processTransferTransaction(ctx context.Context, transaction *entity.Transaction) error {
 	if transaction.FromAccountID == nil || transaction.ToAccountID == nil {
 		return errs.ErrMissingAccountID
 	}
 
-	// Get both accounts
-	fromAccount, err := uc.accountRepo.GetByID(ctx, *transaction.FromAccountID)
-	if err != nil {
-		return errs.ErrAccountNotFound
+	fromAccountID := *transaction.FromAccountID
+	toAccountID := *transaction.ToAccountID
+	amount := transaction.CreditedAmount()
+	totalDebit := transaction.TotalDebit()
+	validate := func(ctx context.Context) error {
+		if transaction.ExchangeRate != nil {
+			_, _, err := uc.validateFXAccounts(ctx, fromAccountID, toAccountID)
+			return err
+		}
+		_, err := uc.validateAccountsCanTransact(ctx, fromAccountID, toAccountID)
+		return err
 	}
-
-	toAccount, err := uc.accountRepo.GetByID(ctx, *transaction.ToAccountID)
-	if err != nil {
-		return errs.ErrAccountNotFound
+	debit := func(account *entity.Account) error {
+		if err := uc.checkSpendingLimit(ctx, account, totalDebit); err != nil {
+			return err
+		}
+		return account.Debit(totalDebit)
 	}
 
-	// Check if both accounts can transact
-	if !fromAccount.CanTransact() {
-		return errs.ErrAccountCannotTransact
-	}
-	if !toAccount.CanTransact() {
-		return errs.ErrAccountCannotTransact
+	if repository.UnitOfWorkFromContext(ctx) != nil && !uc.credits.Batches(toAccountID) {
+		if err := uc.lockAccounts(ctx, fromAccountID, toAccountID); err != nil {
+			return err
+		}
+		if err := validate(ctx); err != nil {
+			return err
+		}
+		if err := uc.applyToAccount(ctx, transaction, fromAccountID, debit); err != nil {
+			return err
+		}
+		return uc.applyToAccount(ctx, transaction, toAccountID, func(account *entity.Account) error {
+			return account.Credit(amount)
+		})
+	}
+
+	steps := []sagaStep{
+		{
+			name:    "validate_accounts",
+			execute: validate,
+		},
+		{
+			name: "debit_source",
+			execute: func(ctx context.Context) error {
+				return uc.applyToAccount(ctx, transaction, fromAccountID, debit)
+			},
+			compensate: func(ctx context.Context) error {
+				return uc.compensateOnAccount(ctx, transaction, fromAccountID, func(account *entity.Account) error {
+					return account.Credit(totalDebit)
+				})
+			},
+		},
+		{
+			name: "credit_destination",
+			execute: func(ctx context.Context) error {
+				if uc.credits.Batches(toAccountID) {
+					return uc.batchCredit(ctx, transaction, toAccountID, amount)
+				}
+				return uc.applyToAccount(ctx, transaction, toAccountID, func(account *entity.Account) error {
+					return account.Credit(amount)
+				})
+			},
+		},
+	}
+
+	return uc.sagas.run(ctx, entity.SagaTypeTransfer, transaction.ID, steps)
+}
+
+// applyToAccount loads an account, applies a balance change of a transaction and persists it under the transaction's fence
+func (uc *transactionUseCase) applyToAccount(ctx context.Context, transaction *entity.Transaction, accountID vo.AccountID, apply func(*entity.Account) error) error {
+	return uc.applyOnce(ctx, transaction, accountID, func() error {
+		return uc.changeAccount(ctx, accountID, apply, func(account *entity.Account) error {
+			return uc.accountRepo.UpdateFenced(ctx, account, transaction.ID, transaction.ProcessingToken)
+		})
+	})
+}
+
+// batchCredit credits a hot account through the credit batcher
+func (uc *transactionUseCase) batchCredit(ctx context.Context, transaction *entity.Transaction, accountID vo.AccountID, amount vo.Money) error {
+	return uc.applyOnce(ctx, transaction, accountID, func() error {
+		return uc.credits.Credit(ctx, transaction, accountID, amount)
+	})
+}
+
+// applyOnce runs change, which applies a transaction to an account and records it as applied in the same
+// database transaction. A change an earlier attempt already persisted counts as applied, so a confirmation
+// replayed after the idempotency cache lost its result cannot apply it twice.
+func (uc *transactionUseCase) applyOnce(ctx context.Context, transaction *entity.Transaction, accountID vo.AccountID, change func() error) error {
+	err := change()
+	if errors.Is(err, errs.ErrTransactionAlreadyApplied) {
+		uc.logger.Info("Transaction already applied to account", "transactionID", transaction.ID.String(), "accountID", accountID.String())
+		return nil
+	}
+
+	// The balance an earlier attempt left behind may fail the checks, e.g. a debit leaving too little for itself
+	if err != nil && errs.Classify(err) == errs.CategoryBusiness && !errors.Is(err, errs.ErrAccountNotFound) {
+		applied, checkErr := uc.accountRepo.IsApplied(ctx, transaction.ID, accountID)
+		if checkErr != nil {
+			uc.logger.Warn("Failed to check whether transaction was applied", "error", checkErr, "transactionID", transaction.ID.String())
+			return fmt.Errorf("%w: %w", errs.ErrTransient, checkErr)
+		}
+		if applied {
+			uc.logger.Info("Transaction already applied to account", "transactionID", transaction.ID.String(), "accountID", accountID.String())
+			return nil
+		}
 	}
 
-	// Perform debit from source account
-	if err := fromAccount.Debit(transaction.Amount); err != nil {
-		return fmt.Errorf("failed to debit from account: %w", err)
+	return err
+}
+
+// compensateOnAccount undoes a balance change this worker applied and removes the record of it, so a
+// replay applies the transaction again. It is not fenced: a newer claim on the transaction must not
+// strand money the older claim already moved.
+func (uc *transactionUseCase) compensateOnAccount(ctx context.Context, transaction *entity.Transaction, accountID vo.AccountID, apply func(*entity.Account) error) error {
+	return uc.changeAccount(ctx, accountID, apply, func(account *entity.Account) error {
+		return uc.accountRepo.Revert(ctx, account, transaction.ID)
+	})
+}
+
+// lockAccounts locks the rows of accounts in vo.LockOrder until the unit of work ends
+func (uc *transactionUseCase) lockAccounts(ctx context.Context, accountIDs ...vo.AccountID) error {
+	for _, accountID := range vo.LockOrder(accountIDs...) {
+		if _, err := uc.accountRepo.GetByIDForUpdate(ctx, accountID); err != nil {
+			return loadAccountError(err)
+		}
 	}
+	return nil
+}
 
-	// Perform credit to destination account
-	if err := toAccount.Credit(transaction.Amount); err != nil {
-		// Rollback the debit if credit fails
-		fromAccount.Credit(transaction.Amount) // Ignore error on rollback
-		return fmt.Errorf("failed to credit to account: %w", err)
+// changeAccount loads and locks an account, applies a balance change and persists it with save
+func (uc *transactionUseCase) changeAccount(ctx context.Context, accountID vo.AccountID, apply func(*entity.Account) error, save func(*entity.Account) error) error {
+	account, err := uc.accountRepo.GetByIDForUpdate(ctx, accountID)
+	if err != nil {
+		return loadAccountError(err)
 	}
 
-	// Update both accounts
-	if err := uc.accountRepo.Update(ctx, fromAccount); err != nil {
-		return fmt.Errorf("failed to update from account: %w", err)
+	if err := apply(account); err != nil {
+		return err
 	}
 
-	if err := uc.accountRepo.Update(ctx, toAccount); err != nil {
-		return fmt.Errorf("failed to update to account: %w", err)
+	if err := save(account); err != nil {
+		return fmt.Errorf("failed to update account %s: %w", accountID.String(), err)
 	}
 
 	return nil
 }
 
-// acquireDistributedLock acquires a distributed lock using Redis
-func (uc *transactionUseCase) acquireDistributedLock(ctx context.Context, key string, expiration time.Duration) (bool, error) {
-	// This is a simplified implementation. In production, consider using a more robust
-	// distributed lock implementation like Redlock
-	lockValue := fmt.Sprintf("lock_%d", time.Now().UnixNano())
+// loadAccountError reports a failed account load as not found, unless the load may succeed when retried
+func loadAccountError(err error) error {
+	if errs.IsRetryable(err) {
+		return fmt.Errorf("failed to load account: %w", err)
+	}
+	return errs.ErrAccountNotFound
+}
 
-	// Try to set the lock with expiration
-	// This should be implemented using Redis SETNX with expiration
-	// For now, we'll use a simple cache set operation
-	err := uc.cache.Set(ctx, key, lockValue, expiration)
+// acquireDistributedLock takes the lock on key shared by every instance and returns its fencing token.
+// Tokens grow with every acquisition, so a worker whose lock expired holds a smaller token than the
+// one that took over; the repositories reject the writes of the older token.
+func (uc *transactionUseCase) acquireDistributedLock(ctx context.Context, key string, expiration time.Duration) (int64, bool, error) {
+	token, acquired, err := uc.locks.Acquire(ctx, key, expiration)
 	if err != nil {
-		return false, err
+		// The lock service being unavailable is no reason to give up on the transaction
+		return 0, false, fmt.Errorf("%w: %w", errs.ErrTransient, err)
 	}
+	return token, acquired, nil
+}
 
-	return true, nil
+// claimTransaction fences a transaction with the lock's token before changing it. A failed claim means
+// a worker with a newer token took the transaction over after this worker's lock expired.
+func (uc *transactionUseCase) claimTransaction(ctx context.Context, transaction *entity.Transaction, token int64) error {
+	claimed, err := uc.transactionRepo.ClaimProcessing(ctx, transaction, token)
+	if err != nil {
+		uc.logger.Error("Failed to claim transaction for processing", "error", err, "transactionID", transaction.ID.String())
+		return err
+	}
+	if !claimed {
+		uc.logger.Warn("Transaction was claimed by a newer worker", "transactionID", transaction.ID.String())
+		return errs.ErrTransactionAlreadyInProgress
+	}
+	return nil
 }
 
-// releaseLock releases a distributed lock
-func (uc *transactionUseCase) releaseLock(ctx context.Context, key string) error {
-	return uc.cache.Delete(ctx, key)
+// releaseLock releases a distributed lock unless it expired and another worker took it over
+func (uc *transactionUseCase) releaseLock(ctx context.Context, key string, token int64) error {
+	return uc.locks.Release(ctx, key, token)
 }
 
 // invalidateAccountCaches invalidates account caches after balance changes
@@ -651,3 +1501,31 @@ func (uc *transactionUseCase) invalidateAccountCaches(ctx context.Context, trans
 	// For now, we'll just log that lists should be invalidated
 	uc.logger.Debug("Account balances changed, consider invalidating account list caches")
 }
+
+// record stores a transaction event in the outbox. Called in the unit of work that makes the change the
+// event reports, the event is kept exactly when the change commits; publish tells subscribers afterwards.
+func (uc *transactionUseCase) record(ctx context.Context, evt event.Event) error {
+	if uc.outbox == nil {
+		return nil
+	}
+	if err := uc.outbox.Append(ctx, evt); err != nil {
+		return fmt.Errorf("failed to store event in outbox: %w", err)
+	}
+	return nil
+}
+
+// publish publishes a transaction event; failures are logged and never fail the operation
+func (uc *transactionUseCase) publish(ctx context.Context, evt event.Event) {
+	if err := uc.events.Publish(ctx, evt); err != nil {
+		uc.logger.Warn("Failed to publish transaction event", "error", err, "eventType", evt.Type, "key", evt.Key)
+	}
+}
+
+// parseReferenceType parses a request's reference type, defaulting to FREE
+func parseReferenceType(referenceType string) (vo.ReferenceType, error) {
+	t, err := vo.NewReferenceType(referenceType)
+	if err != nil {
+		return "", errs.ValidationError{Field: "reference_type", Message: err.Error()}
+	}
+	return t, nil
+}
@@ -0,0 +1,64 @@
+// internal/application/transaction_accounts.go
+package usecase
+
+import (
+	"context"
+
+	"github.com/hydr0g3nz/mini_bank/internal/application/dto"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+)
+
+// IncludeAccounts embeds the name and status of the source and destination accounts in each response,
+// loading every account the responses refer to in one query. Accounts that no longer exist are left out.
+func (uc *transactionUseCase) IncludeAccounts(ctx context.Context, responses []dto.TransactionResponse) error {
+	seen := make(map[vo.AccountID]bool)
+	var accountIDs []vo.AccountID
+	for _, response := range responses {
+		for _, id := range []*string{response.FromAccountID, response.ToAccountID} {
+			if id == nil {
+				continue
+			}
+			accountID, err := vo.NewAccountIDFromString(*id)
+			if err != nil || seen[accountID] {
+				continue
+			}
+			seen[accountID] = true
+			accountIDs = append(accountIDs, accountID)
+		}
+	}
+
+	if len(accountIDs) == 0 {
+		return nil
+	}
+
+	accounts, err := uc.accountRepo.GetByIDs(ctx, accountIDs)
+	if err != nil {
+		uc.logger.Error("Failed to load accounts for transactions", "error", err)
+		return err
+	}
+
+	lookup := func(id *string) *dto.TransactionAccount {
+		if id == nil {
+			return nil
+		}
+		accountID, err := vo.NewAccountIDFromString(*id)
+		if err != nil {
+			return nil
+		}
+		account, ok := accounts[accountID]
+		if !ok {
+			return nil
+		}
+		return &dto.TransactionAccount{
+			ID:          account.ID.String(),
+			AccountName: account.AccountName,
+			Status:      string(account.Status),
+		}
+	}
+
+	for i := range responses {
+		responses[i].FromAccount = lookup(responses[i].FromAccountID)
+		responses[i].ToAccount = lookup(responses[i].ToAccountID)
+	}
+	return nil
+}
@@ -0,0 +1,36 @@
+package usecase
+
+import (
+	"github.com/hydr0g3nz/mini_bank/internal/application/dto"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *TransactionUseCaseTestSuite) TestIncludeAccounts() {
+	toAccount, _ := entity.NewAccount("To Account", vo.NewMoneyFromFloat(500.0))
+	deleted := vo.NewAccountID()
+	fromID, toID, deletedID := suite.testAccount.ID.String(), toAccount.ID.String(), deleted.String()
+
+	responses := []dto.TransactionResponse{
+		{ID: "1", FromAccountID: &fromID, ToAccountID: &toID},
+		{ID: "2", ToAccountID: &fromID},
+		{ID: "3", FromAccountID: &deletedID},
+	}
+
+	// Each account is loaded once, however many transactions refer to it
+	suite.mockAccountRepo.On("GetByIDs", suite.ctx, []vo.AccountID{suite.testAccount.ID, toAccount.ID, deleted}).
+		Return(map[vo.AccountID]*entity.Account{suite.testAccount.ID: suite.testAccount, toAccount.ID: toAccount}, nil).Once()
+
+	err := suite.usecase.IncludeAccounts(suite.ctx, responses)
+
+	suite.Require().NoError(err)
+	suite.Require().NotNil(responses[0].FromAccount)
+	assert.Equal(suite.T(), suite.testAccount.AccountName, responses[0].FromAccount.AccountName)
+	assert.Equal(suite.T(), "ACTIVE", responses[0].FromAccount.Status)
+	assert.Equal(suite.T(), "To Account", responses[0].ToAccount.AccountName)
+	assert.Nil(suite.T(), responses[1].FromAccount)
+	assert.Equal(suite.T(), fromID, responses[1].ToAccount.ID)
+	assert.Nil(suite.T(), responses[2].FromAccount)
+	suite.mockAccountRepo.AssertExpectations(suite.T())
+}
@@ -0,0 +1,128 @@
+// internal/application/transaction_activity.go
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/application/dto"
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+)
+
+// maxActivityDays bounds the range of an activity request, which loads every transaction completed since its start
+const maxActivityDays = 92
+
+// GetAccountActivity lists an account's completed transactions grouped by the day they completed, with the
+// balance after each transaction and each day's subtotals. The opening balance is rebuilt from the current
+// balance by rolling back every transaction completed since the start of the range.
+func (uc *transactionUseCase) GetAccountActivity(ctx context.Context, req dto.AccountActivityRequest) (*dto.AccountActivityResponse, error) {
+	accountID, err := vo.NewAccountIDFromString(req.AccountID)
+	if err != nil {
+		uc.logger.Error("Invalid account ID format", "error", err, "accountID", req.AccountID)
+		return nil, err
+	}
+
+	// The range defaults to month-to-date of the current business date
+	today := uc.valueDating.Today()
+	from := time.Date(today.Year(), today.Month(), 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(today.Year(), today.Month(), today.Day(), 0, 0, 0, 0, time.UTC)
+	if req.From != "" {
+		if from, err = parseDate("from", req.From); err != nil {
+			return nil, err
+		}
+	}
+	if req.To != "" {
+		if to, err = parseDate("to", req.To); err != nil {
+			return nil, err
+		}
+	}
+	if to.Before(from) {
+		return nil, errs.ValidationError{Field: "to", Message: "to must not be before from"}
+	}
+	if to.Sub(from) >= maxActivityDays*24*time.Hour {
+		return nil, errs.ValidationError{Field: "to", Message: fmt.Sprintf("the range must not exceed %d days", maxActivityDays)}
+	}
+
+	account, err := uc.accountRepo.GetByID(ctx, accountID)
+	if err != nil {
+		uc.logger.Error("Account not found", "error", err, "accountID", req.AccountID)
+		return nil, errs.ErrAccountNotFound
+	}
+
+	transactions, err := uc.transactionRepo.GetCompletedByAccountIDSince(ctx, accountID, from)
+	if err != nil {
+		uc.logger.Error("Failed to get completed transactions", "error", err, "accountID", req.AccountID)
+		return nil, err
+	}
+
+	// Roll the current balance back to the start of the range
+	balance := account.Balance
+	for _, transaction := range transactions {
+		balance, _ = balance.Subtract(transaction.BalanceEffect(accountID))
+	}
+
+	// Transactions completed after the range still had to be rolled back, but are not listed
+	until := to.AddDate(0, 0, 1)
+	response := &dto.AccountActivityResponse{
+		AccountID:      accountID.String(),
+		From:           from.Format(dateLayout),
+		To:             to.Format(dateLayout),
+		OpeningBalance: balance.InexactFloat64(),
+		Currency:       account.Currency().String(),
+		Days:           []dto.ActivityDay{},
+	}
+	var items []dto.TransactionResponse
+	var changes []vo.Money
+	for _, transaction := range transactions {
+		if transaction.CompletedAt == nil || !transaction.CompletedAt.Before(until) {
+			continue
+		}
+		items = append(items, uc.mapper.ToResponse(transaction))
+		changes = append(changes, transaction.BalanceEffect(accountID))
+	}
+
+	// The account's category overrides apply as they do in the transaction list
+	uc.categorizer.ApplyOverrides(ctx, accountID, items)
+
+	var day *dto.ActivityDay
+	inflow, outflow := vo.ZeroMoney(), vo.ZeroMoney()
+	closeDay := func() {
+		if day == nil {
+			return
+		}
+		net, _ := inflow.Subtract(outflow)
+		day.Inflow = inflow.InexactFloat64()
+		day.Outflow = outflow.InexactFloat64()
+		day.Net = net.InexactFloat64()
+		day.ClosingBalance = balance.InexactFloat64()
+		response.Days = append(response.Days, *day)
+	}
+	for i, item := range items {
+		date := item.CompletedAt.UTC().Format(dateLayout)
+		if day == nil || day.Date != date {
+			closeDay()
+			day = &dto.ActivityDay{Date: date, OpeningBalance: balance.InexactFloat64()}
+			inflow, outflow = vo.ZeroMoney(), vo.ZeroMoney()
+		}
+
+		change := changes[i]
+		if change.IsNegative() {
+			outflow, _ = outflow.Add(change.Abs())
+		} else {
+			inflow, _ = inflow.Add(change)
+		}
+		balance, _ = balance.Add(change)
+		day.Items = append(day.Items, dto.ActivityItem{
+			TransactionResponse: item,
+			Change:              change.InexactFloat64(),
+			BalanceAfter:        balance.InexactFloat64(),
+		})
+	}
+	closeDay()
+
+	response.ClosingBalance = balance.InexactFloat64()
+	uc.logger.Debug("Account activity retrieved successfully", "accountID", req.AccountID, "days", len(response.Days), "count", len(items))
+	return response, nil
+}
@@ -0,0 +1,202 @@
+// internal/application/transaction_block.go
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/application/dto"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/event"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/infra"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/repository"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+)
+
+// TransactionBlocks rejects transactions stopped by a temporary block on one of their accounts
+type TransactionBlocks struct {
+	blockRepo repository.TransactionBlockRepository
+}
+
+// NewTransactionBlocks creates a transaction block check over the recorded blocks
+func NewTransactionBlocks(blockRepo repository.TransactionBlockRepository) *TransactionBlocks {
+	return &TransactionBlocks{blockRepo: blockRepo}
+}
+
+// Check returns ErrTransactionBlocked when a block in force at now stops the transaction; the error
+// tells until when, but not why, since it is shown to the customer. A nil check allows every transaction.
+func (b *TransactionBlocks) Check(ctx context.Context, transaction *entity.Transaction, now time.Time) error {
+	if b == nil {
+		return nil
+	}
+
+	var accountIDs []vo.AccountID
+	for _, accountID := range []*vo.AccountID{transaction.FromAccountID, transaction.ToAccountID} {
+		if accountID != nil {
+			accountIDs = append(accountIDs, *accountID)
+		}
+	}
+
+	blocks, err := b.blockRepo.ListActive(ctx, accountIDs, now)
+	if err != nil {
+		return err
+	}
+
+	for _, block := range blocks {
+		if block.Blocks(transaction) {
+			return fmt.Errorf("%w: %s transactions of account %s are blocked until %s", errs.ErrTransactionBlocked,
+				block.TransactionType, block.AccountID.String(), block.ExpiresAt.Format(time.RFC3339))
+		}
+	}
+	return nil
+}
+
+type transactionBlockUseCase struct {
+	blockRepo   repository.TransactionBlockRepository
+	accountRepo repository.AccountRepository
+	events      infra.EventPublisher
+	valueDating *ValueDatingPolicy
+	logger      infra.Logger
+	mapper      *dto.TransactionBlockMapper
+}
+
+// NewTransactionBlockUseCase creates a new transaction block use case; blocks start and expire on the
+// clock of valueDating
+func NewTransactionBlockUseCase(
+	blockRepo repository.TransactionBlockRepository,
+	accountRepo repository.AccountRepository,
+	events infra.EventPublisher,
+	valueDating *ValueDatingPolicy,
+	logger infra.Logger,
+) TransactionBlockUseCase {
+	return &transactionBlockUseCase{
+		blockRepo:   blockRepo,
+		accountRepo: accountRepo,
+		events:      events,
+		valueDating: valueDating,
+		logger:      logger,
+		mapper:      &dto.TransactionBlockMapper{},
+	}
+}
+
+// CreateTransactionBlock blocks a type of transaction on an account from now for the requested duration.
+// Transactions it stops fail when they are confirmed; creating them is still allowed.
+func (uc *transactionBlockUseCase) CreateTransactionBlock(ctx context.Context, req dto.CreateTransactionBlockRequest) (*dto.TransactionBlockResponse, error) {
+	accountID, err := vo.NewAccountIDFromString(req.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := uc.accountRepo.GetByID(ctx, accountID); err != nil {
+		uc.logger.Error("Account not found", "error", err, "accountID", req.AccountID)
+		return nil, errs.ErrAccountNotFound
+	}
+
+	now := uc.valueDating.Now()
+	block, err := entity.NewTransactionBlock(accountID, vo.TransactionType(req.TransactionType), vo.TransactionBlockReason(req.ReasonCode),
+		req.Note, req.CreatedBy, now, now.Add(time.Duration(req.DurationMinutes)*time.Minute))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.blockRepo.Create(ctx, block); err != nil {
+		uc.logger.Error("Failed to save transaction block", "error", err, "blockID", block.ID, "accountID", req.AccountID)
+		return nil, err
+	}
+	uc.publish(ctx, event.NewTransactionBlockEvent(event.TransactionBlockPlaced, block))
+
+	uc.logger.Info("Transaction block placed", "blockID", block.ID, "accountID", req.AccountID,
+		"transactionType", req.TransactionType, "reasonCode", req.ReasonCode,
+		"expiresAt", block.ExpiresAt.Format(time.RFC3339), "createdBy", req.CreatedBy)
+
+	response := uc.mapper.ToResponse(block, now)
+	return &response, nil
+}
+
+// LiftTransactionBlock ends a block before it expires; the record is kept
+func (uc *transactionBlockUseCase) LiftTransactionBlock(ctx context.Context, req dto.LiftTransactionBlockRequest) (*dto.TransactionBlockResponse, error) {
+	block, err := uc.getBlock(ctx, req.AccountID, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := uc.valueDating.Now()
+	if err := block.Lift(req.Admin, now); err != nil {
+		return nil, err
+	}
+
+	if err := uc.blockRepo.Update(ctx, block); err != nil {
+		uc.logger.Error("Failed to update transaction block", "error", err, "blockID", req.ID)
+		return nil, err
+	}
+	uc.publish(ctx, event.NewTransactionBlockEvent(event.TransactionBlockLifted, block))
+
+	uc.logger.Info("Transaction block lifted", "blockID", req.ID, "accountID", req.AccountID, "admin", req.Admin)
+
+	response := uc.mapper.ToResponse(block, now)
+	return &response, nil
+}
+
+// GetTransactionBlock retrieves a block of an account
+func (uc *transactionBlockUseCase) GetTransactionBlock(ctx context.Context, accountID, id string) (*dto.TransactionBlockResponse, error) {
+	block, err := uc.getBlock(ctx, accountID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	response := uc.mapper.ToResponse(block, uc.valueDating.Now())
+	return &response, nil
+}
+
+// ListTransactionBlocks retrieves an account's blocks, newest first
+func (uc *transactionBlockUseCase) ListTransactionBlocks(ctx context.Context, req dto.TransactionBlockListRequest) (*dto.TransactionBlockListResponse, error) {
+	accountID, err := vo.NewAccountIDFromString(req.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := uc.valueDating.Now()
+	filter := repository.TransactionBlockFilter{AccountID: accountID.String()}
+	if req.Active {
+		filter.ActiveAt = &now
+	}
+	offset := (req.Page - 1) * req.PageSize
+
+	blocks, err := uc.blockRepo.List(ctx, filter, req.PageSize, offset)
+	if err != nil {
+		uc.logger.Error("Failed to list transaction blocks", "error", err, "accountID", req.AccountID)
+		return nil, err
+	}
+
+	total, err := uc.blockRepo.Count(ctx, filter)
+	if err != nil {
+		uc.logger.Error("Failed to count transaction blocks", "error", err, "accountID", req.AccountID)
+		return nil, err
+	}
+
+	response := uc.mapper.ToResponseList(blocks, now, dto.NewPaginationInfo(req.Page, req.PageSize, total))
+	return &response, nil
+}
+
+// getBlock loads a block, treating one of another account as not found
+func (uc *transactionBlockUseCase) getBlock(ctx context.Context, accountID, id string) (*entity.TransactionBlock, error) {
+	block, err := uc.blockRepo.GetByID(ctx, id)
+	if err != nil {
+		uc.logger.Error("Failed to get transaction block", "error", err, "blockID", id)
+		return nil, err
+	}
+
+	if block.AccountID.String() != accountID {
+		return nil, errs.ErrTransactionBlockNotFound
+	}
+	return block, nil
+}
+
+// publish publishes a transaction block event; failures are logged and never fail the operation
+func (uc *transactionBlockUseCase) publish(ctx context.Context, evt event.Event) {
+	if err := uc.events.Publish(ctx, evt); err != nil {
+		uc.logger.Warn("Failed to publish transaction block event", "error", err, "eventType", evt.Type, "accountID", evt.Key)
+	}
+}
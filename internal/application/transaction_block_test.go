@@ -0,0 +1,188 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/application/dto"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/event"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/repository"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockTransactionBlockRepository is a mock implementation of TransactionBlockRepository
+type MockTransactionBlockRepository struct {
+	mock.Mock
+}
+
+func (m *MockTransactionBlockRepository) Create(ctx context.Context, block *entity.TransactionBlock) error {
+	args := m.Called(ctx, block)
+	return args.Error(0)
+}
+
+func (m *MockTransactionBlockRepository) GetByID(ctx context.Context, id string) (*entity.TransactionBlock, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.TransactionBlock), args.Error(1)
+}
+
+func (m *MockTransactionBlockRepository) Update(ctx context.Context, block *entity.TransactionBlock) error {
+	args := m.Called(ctx, block)
+	return args.Error(0)
+}
+
+func (m *MockTransactionBlockRepository) List(ctx context.Context, filter repository.TransactionBlockFilter, limit, offset int) ([]*entity.TransactionBlock, error) {
+	args := m.Called(ctx, filter, limit, offset)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.TransactionBlock), args.Error(1)
+}
+
+func (m *MockTransactionBlockRepository) Count(ctx context.Context, filter repository.TransactionBlockFilter) (int64, error) {
+	args := m.Called(ctx, filter)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockTransactionBlockRepository) ListActive(ctx context.Context, accountIDs []vo.AccountID, now time.Time) ([]*entity.TransactionBlock, error) {
+	args := m.Called(ctx, accountIDs, now)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.TransactionBlock), args.Error(1)
+}
+
+// transactionBlockNow is the time of the transaction block tests
+var transactionBlockNow = time.Date(2024, 3, 15, 10, 0, 0, 0, time.UTC)
+
+// newTestTransactionBlock blocks transactions of the type on the account for a day from transactionBlockNow
+func newTestTransactionBlock(t *testing.T, accountID vo.AccountID, transactionType vo.TransactionType) *entity.TransactionBlock {
+	block, err := entity.NewTransactionBlock(accountID, transactionType, vo.TransactionBlockReasonCredentialChange, "",
+		"alice", transactionBlockNow, transactionBlockNow.Add(24*time.Hour))
+	require.NoError(t, err)
+	return block
+}
+
+// newTransactionBlockFixture returns a use case, at transactionBlockNow, over an existing account
+func newTransactionBlockFixture(t *testing.T) (*MockTransactionBlockRepository, *StubEventPublisher, TransactionBlockUseCase, *entity.Account) {
+	account := createTestAccount()
+
+	mockBlockRepo := new(MockTransactionBlockRepository)
+	mockAccountRepo := new(MockAccountRepository)
+	mockLogger := new(MockLogger)
+	mockLogger.On("Info", mock.Anything, mock.Anything).Maybe()
+	mockLogger.On("Error", mock.Anything, mock.Anything).Maybe()
+	mockAccountRepo.On("GetByID", mock.Anything, account.ID).Return(account, nil).Maybe()
+
+	valueDating := NewValueDatingPolicy(&StubCalendar{}, vo.ProcessingWindow{}, nil)
+	valueDating.now = func() time.Time { return transactionBlockNow }
+
+	events := &StubEventPublisher{}
+	uc := NewTransactionBlockUseCase(mockBlockRepo, mockAccountRepo, events, valueDating, mockLogger)
+	return mockBlockRepo, events, uc, account
+}
+
+func TestTransactionBlocks_Check(t *testing.T) {
+	ctx := context.Background()
+	from, to := vo.NewAccountID(), vo.NewAccountID()
+	transfer, err := entity.NewTransferTransaction(from, to, vo.NewMoneyFromFloat(100), "Rent", "", time.Now())
+	require.NoError(t, err)
+
+	// A nil check, as when blocks are not configured, allows every transaction
+	var none *TransactionBlocks
+	assert.NoError(t, none.Check(ctx, transfer, transactionBlockNow))
+
+	blockRepo := new(MockTransactionBlockRepository)
+	blocks := NewTransactionBlocks(blockRepo)
+
+	// Outgoing transfers of the payee are not stopped by a transfer into it
+	blockRepo.On("ListActive", ctx, []vo.AccountID{from, to}, transactionBlockNow).
+		Return([]*entity.TransactionBlock{newTestTransactionBlock(t, to, vo.TransactionTypeTransfer)}, nil).Once()
+	assert.NoError(t, blocks.Check(ctx, transfer, transactionBlockNow))
+
+	blockRepo.On("ListActive", ctx, []vo.AccountID{from, to}, transactionBlockNow).
+		Return([]*entity.TransactionBlock{newTestTransactionBlock(t, from, vo.TransactionTypeTransfer)}, nil).Once()
+	err = blocks.Check(ctx, transfer, transactionBlockNow)
+	assert.ErrorIs(t, err, errs.ErrTransactionBlocked)
+	assert.Equal(t, vo.FailureKindBusiness, failureKind(err))
+	assert.NotContains(t, err.Error(), string(vo.TransactionBlockReasonCredentialChange))
+}
+
+func TestTransactionBlockUseCase_CreateTransactionBlock(t *testing.T) {
+	mockBlockRepo, events, uc, account := newTransactionBlockFixture(t)
+	mockBlockRepo.On("Create", mock.Anything, mock.AnythingOfType("*entity.TransactionBlock")).Return(nil)
+
+	response, err := uc.CreateTransactionBlock(context.Background(), dto.CreateTransactionBlockRequest{
+		AccountID:       account.ID.String(),
+		TransactionType: "TRANSFER",
+		ReasonCode:      "CREDENTIAL_CHANGE",
+		DurationMinutes: 24 * 60,
+		CreatedBy:       "alice",
+	})
+
+	require.NoError(t, err)
+	assert.True(t, response.Active)
+	assert.Equal(t, "TRANSFER", response.TransactionType)
+	assert.Equal(t, transactionBlockNow.Add(24*time.Hour), response.ExpiresAt)
+	require.Len(t, events.Events, 1)
+	assert.Equal(t, event.TransactionBlockPlaced, events.Events[0].Type)
+	assert.Equal(t, account.ID.String(), events.Events[0].Key)
+}
+
+func TestTransactionBlockUseCase_LiftTransactionBlock(t *testing.T) {
+	mockBlockRepo, events, uc, account := newTransactionBlockFixture(t)
+	block := newTestTransactionBlock(t, account.ID, vo.TransactionTypeDebit)
+	mockBlockRepo.On("GetByID", mock.Anything, block.ID).Return(block, nil)
+	mockBlockRepo.On("Update", mock.Anything, block).Return(nil)
+
+	// A block is only reachable through its own account
+	_, err := uc.LiftTransactionBlock(context.Background(), dto.LiftTransactionBlockRequest{
+		AccountID: vo.NewAccountID().String(), ID: block.ID, Admin: "bob",
+	})
+	assert.ErrorIs(t, err, errs.ErrTransactionBlockNotFound)
+
+	response, err := uc.LiftTransactionBlock(context.Background(), dto.LiftTransactionBlockRequest{
+		AccountID: account.ID.String(), ID: block.ID, Admin: "bob",
+	})
+	require.NoError(t, err)
+	assert.False(t, response.Active)
+	assert.Equal(t, "bob", response.LiftedBy)
+	require.Len(t, events.Events, 1)
+	assert.Equal(t, event.TransactionBlockLifted, events.Events[0].Type)
+
+	_, err = uc.LiftTransactionBlock(context.Background(), dto.LiftTransactionBlockRequest{
+		AccountID: account.ID.String(), ID: block.ID, Admin: "bob",
+	})
+	assert.ErrorIs(t, err, errs.ErrTransactionBlockNotActive)
+	mockBlockRepo.AssertNumberOfCalls(t, "Update", 1)
+}
+
+func (suite *TransactionUseCaseTestSuite) TestConfirmTransaction_Blocked() {
+	id := suite.expectConfirmationLock()
+	block, err := entity.NewTransactionBlock(suite.testAccount.ID, vo.TransactionTypeDebit, vo.TransactionBlockReasonSuspectedFraud, "",
+		"alice", time.Now().Add(-time.Hour), time.Now().Add(time.Hour))
+	suite.Require().NoError(err)
+	blockRepo := new(MockTransactionBlockRepository)
+	blockRepo.On("ListActive", suite.ctx, []vo.AccountID{suite.testAccount.ID}, mock.AnythingOfType("time.Time")).
+		Return([]*entity.TransactionBlock{block}, nil)
+	suite.usecase.(*transactionUseCase).blocks = NewTransactionBlocks(blockRepo)
+
+	suite.mockTxnRepo.On("GetByID", suite.ctx, suite.testTransaction.ID).Return(suite.testTransaction, nil)
+	suite.mockTxnRepo.On("Update", suite.ctx, suite.testTransaction).Return(nil)
+
+	_, err = suite.usecase.ConfirmTransaction(suite.ctx, dto.ConfirmTransactionRequest{ID: id})
+
+	// Nothing is posted and the failure cannot be replayed
+	assert.ErrorIs(suite.T(), err, errs.ErrTransactionBlocked)
+	assert.Equal(suite.T(), vo.TransactionStatusFailed, suite.testTransaction.Status)
+	assert.Equal(suite.T(), vo.FailureKindBusiness, suite.testTransaction.FailureKind)
+	suite.mockAccountRepo.AssertNotCalled(suite.T(), "UpdateFenced", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
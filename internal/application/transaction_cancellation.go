@@ -0,0 +1,139 @@
+// internal/application/transaction_cancellation.go
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/application/dto"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/event"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+)
+
+// cancelInFlight cancels a transaction that is waiting on someone else as a saga recorded against it.
+// The transaction is first withdrawn from review; if a decision settled it in the meantime, its amount
+// and fee are moved back instead. Each step's outcome shows in the transaction's saga.
+func (uc *transactionUseCase) cancelInFlight(ctx context.Context, transaction *entity.Transaction) (*dto.CancelTransactionResponse, error) {
+	transactionID := transaction.ID.String()
+	response := &dto.CancelTransactionResponse{TransactionID: transactionID, Outcome: dto.CancellationOutcomeCancelled}
+
+	// Set by the first step when the transaction turned out to be settled already
+	var settled *entity.Transaction
+	reverse := func(newReversal func(*entity.Transaction, time.Time) (*entity.Transaction, error)) func(ctx context.Context) error {
+		return func(ctx context.Context) error {
+			reversal, err := newReversal(settled, uc.valueDating.Now())
+			if err != nil {
+				return err
+			}
+
+			reversed, err := uc.settle(ctx, reversal)
+			if err != nil {
+				return err
+			}
+			response.Reversals = append(response.Reversals, *reversed)
+			return nil
+		}
+	}
+
+	steps := []sagaStep{
+		{
+			name: "cancel_upstream",
+			execute: func(ctx context.Context) error {
+				var err error
+				settled, err = uc.withdrawFromReview(ctx, transaction.ID)
+				return err
+			},
+		},
+		{
+			name:    "reverse_settlement",
+			execute: reverse(entity.NewReversalTransaction),
+			skip:    func() bool { return settled == nil },
+		},
+		{
+			name:    "refund_fee",
+			execute: reverse(entity.NewFeeRefundTransaction),
+			skip:    func() bool { return settled == nil || !settled.Fee.IsPositive() },
+		},
+	}
+
+	if err := uc.sagas.run(ctx, entity.SagaTypeCancellation, transaction.ID, steps); err != nil {
+		uc.logger.Error("Failed to cancel transaction in flight", "error", err, "transactionID", transactionID)
+		return nil, err
+	}
+
+	if settled != nil {
+		response.Outcome = dto.CancellationOutcomeReversed
+		uc.logger.Warn("Transaction settled before it could be cancelled and was reversed", "transactionID", transactionID, "reversals", len(response.Reversals))
+		return response, nil
+	}
+
+	uc.logger.Info("Transaction withdrawn from review", "transactionID", transactionID)
+	return response, nil
+}
+
+// withdrawFromReview cancels a transaction still held for review. When a decision got there first, it
+// returns the transaction if the decision settled it, or an error if it was declined.
+func (uc *transactionUseCase) withdrawFromReview(ctx context.Context, transactionID vo.TransactionID) (*entity.Transaction, error) {
+	id := transactionID.String()
+	err := uc.decideReview(ctx, id, func(transaction *entity.Transaction) error {
+		if err := transaction.MarkAsCancelled(); err != nil {
+			return err
+		}
+
+		if err := uc.transactionRepo.Update(ctx, transaction); err != nil {
+			uc.logger.Error("Failed to withdraw transaction from review", "error", err, "transactionID", id)
+			return err
+		}
+
+		uc.cacheTransaction(ctx, uc.mapper.ToResponse(transaction))
+		uc.publish(ctx, event.NewTransactionEvent(event.TransactionCancelled, transaction))
+		return nil
+	})
+	if !errors.Is(err, errs.ErrTransactionNotInReview) {
+		return nil, err
+	}
+
+	transaction, err := uc.transactionRepo.GetByID(ctx, transactionID)
+	if err != nil {
+		return nil, errs.ErrTransactionNotFound
+	}
+	if !transaction.Status.IsCompleted() {
+		return nil, fmt.Errorf("%w in status: %s", errs.ErrTransactionCannotBeCancelled, transaction.Status)
+	}
+	return transaction, nil
+}
+
+// settle records a transaction the bank originates and processes it straight away. It skips the fraud
+// review, since it only moves back money whose movement was already approved.
+func (uc *transactionUseCase) settle(ctx context.Context, transaction *entity.Transaction) (*dto.TransactionResponse, error) {
+	transactionID := transaction.ID.String()
+
+	if err := uc.transactionRepo.Create(ctx, transaction); err != nil {
+		uc.logger.Error("Failed to record transaction", "error", err, "transactionID", transactionID)
+		return nil, err
+	}
+
+	lockKey := fmt.Sprintf("lock:transaction:%s", transactionID)
+	lockToken, lockAcquired, err := uc.acquireDistributedLock(ctx, lockKey, 30*time.Second)
+	if err != nil {
+		return nil, fmt.Errorf("failed to acquire lock: %w", err)
+	}
+	if !lockAcquired {
+		return nil, errs.ErrTransactionAlreadyInProgress
+	}
+	defer func() {
+		if err := uc.releaseLock(ctx, lockKey, lockToken); err != nil {
+			uc.logger.Warn("Failed to release distributed lock", "error", err, "transactionID", transactionID)
+		}
+	}()
+
+	if err := uc.claimTransaction(ctx, transaction, lockToken); err != nil {
+		return nil, err
+	}
+
+	return uc.finalize(ctx, transaction, fmt.Sprintf("confirm_transaction:%s", transactionID))
+}
@@ -0,0 +1,416 @@
+// internal/application/transaction_history.go
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/application/dto"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/event"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/infra"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/repository"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+)
+
+// historyRebuildPageSize is the number of transactions loaded at a time when rebuilding an account's history
+const historyRebuildPageSize = 200
+
+// TransactionHistoryProjector keeps the transaction_history read model up to date by projecting every
+// transaction event into one flat row per account touched. It wraps the event publisher so each
+// change is projected once, on the instance that made it.
+type TransactionHistoryProjector struct {
+	historyRepo     repository.TransactionHistoryRepository
+	accountRepo     repository.AccountRepository
+	transactionRepo repository.TransactionRepository
+	next            infra.EventPublisher
+	logger          infra.Logger
+}
+
+// NewTransactionHistoryProjector creates a transaction history projector publishing through next
+func NewTransactionHistoryProjector(
+	historyRepo repository.TransactionHistoryRepository,
+	accountRepo repository.AccountRepository,
+	transactionRepo repository.TransactionRepository,
+	next infra.EventPublisher,
+	logger infra.Logger,
+) *TransactionHistoryProjector {
+	return &TransactionHistoryProjector{
+		historyRepo:     historyRepo,
+		accountRepo:     accountRepo,
+		transactionRepo: transactionRepo,
+		next:            next,
+		logger:          logger,
+	}
+}
+
+// Publish forwards the event, then projects the transaction it is about
+func (p *TransactionHistoryProjector) Publish(ctx context.Context, evt event.Event) error {
+	err := p.next.Publish(ctx, evt)
+
+	switch evt.Type {
+	case event.TransactionCreated, event.TransactionInReview, event.TransactionCompleted,
+		event.TransactionFailed, event.TransactionCancelled:
+		p.project(ctx, evt)
+	}
+
+	return err
+}
+
+// project replaces the history entries of the event's transaction with its current state. A failed
+// projection is only logged: the transaction itself succeeded, and a rebuild repairs the history.
+func (p *TransactionHistoryProjector) project(ctx context.Context, evt event.Event) {
+	payload, err := evt.DecodeTransaction()
+	if err != nil {
+		p.logger.Warn("Failed to decode transaction event", "error", err, "eventID", evt.ID)
+		return
+	}
+	transactionID, err := vo.NewTransactionIDFromString(payload.TransactionID)
+	if err != nil {
+		return
+	}
+
+	// The event may be older than the transaction, so its latest state is projected
+	transaction, err := p.transactionRepo.GetByID(ctx, transactionID)
+	if err != nil {
+		p.logger.Warn("Failed to load transaction for history", "error", err, "transactionID", payload.TransactionID)
+		return
+	}
+
+	entries := entity.NewHistoryEntries(transaction)
+	accounts, err := p.accountRepo.GetByIDs(ctx, historyAccountIDs(entries))
+	if err != nil {
+		p.logger.Warn("Failed to load accounts for history", "error", err, "transactionID", payload.TransactionID)
+		return
+	}
+
+	for _, entry := range entries {
+		entry.CounterpartyName = counterpartyName(accounts, entry)
+
+		// The account was just updated, so its balance is the balance after the transaction
+		if entry.Status.IsCompleted() {
+			if account, ok := accounts[entry.AccountID]; ok {
+				balance := account.Balance
+				entry.BalanceAfter = &balance
+			}
+		}
+	}
+
+	if err := p.historyRepo.Upsert(ctx, entries); err != nil {
+		p.logger.Error("Failed to project transaction history", "error", err, "transactionID", payload.TransactionID)
+	}
+}
+
+var _ infra.EventPublisher = (*TransactionHistoryProjector)(nil)
+
+// historyAccountIDs returns the accounts and counterparties of history entries
+func historyAccountIDs(entries []*entity.HistoryEntry) []vo.AccountID {
+	seen := make(map[vo.AccountID]bool)
+	var accountIDs []vo.AccountID
+	add := func(accountID vo.AccountID) {
+		if !seen[accountID] {
+			seen[accountID] = true
+			accountIDs = append(accountIDs, accountID)
+		}
+	}
+	for _, entry := range entries {
+		add(entry.AccountID)
+		if entry.CounterpartyID != nil {
+			add(*entry.CounterpartyID)
+		}
+	}
+	return accountIDs
+}
+
+// counterpartyName returns the name of an entry's counterparty, empty when there is none or it was closed
+func counterpartyName(accounts map[vo.AccountID]*entity.Account, entry *entity.HistoryEntry) string {
+	if entry.CounterpartyID == nil {
+		return ""
+	}
+	if account, ok := accounts[*entry.CounterpartyID]; ok {
+		return account.AccountName
+	}
+	return ""
+}
+
+type transactionHistoryUseCase struct {
+	historyRepo     repository.TransactionHistoryRepository
+	accountRepo     repository.AccountRepository
+	transactionRepo repository.TransactionRepository
+	tagRepo         repository.TransactionTagRepository
+	filterRepo      repository.SavedFilterRepository
+	categorizer     *Categorizer
+	logger          infra.Logger
+	mapper          *dto.TransactionHistoryMapper
+}
+
+// NewTransactionHistoryUseCase creates a new transaction history use case
+func NewTransactionHistoryUseCase(
+	historyRepo repository.TransactionHistoryRepository,
+	accountRepo repository.AccountRepository,
+	transactionRepo repository.TransactionRepository,
+	tagRepo repository.TransactionTagRepository,
+	filterRepo repository.SavedFilterRepository,
+	categorizer *Categorizer,
+	logger infra.Logger,
+) TransactionHistoryUseCase {
+	return &transactionHistoryUseCase{
+		historyRepo:     historyRepo,
+		accountRepo:     accountRepo,
+		transactionRepo: transactionRepo,
+		tagRepo:         tagRepo,
+		filterRepo:      filterRepo,
+		categorizer:     categorizer,
+		logger:          logger,
+		mapper:          &dto.TransactionHistoryMapper{},
+	}
+}
+
+// GetAccountHistory retrieves an account's transaction history from the read model, newest first,
+// narrowed by the request's filters or the saved filter it names
+func (uc *transactionHistoryUseCase) GetAccountHistory(ctx context.Context, accountID string, req dto.HistoryRequest) (*dto.TransactionHistoryResponse, error) {
+	parsedAccountID, err := vo.NewAccountIDFromString(accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := uc.accountRepo.GetByID(ctx, parsedAccountID); err != nil {
+		return nil, errs.ErrAccountNotFound
+	}
+
+	filter, err := uc.historyFilter(ctx, accountID, req)
+	if err != nil {
+		return nil, err
+	}
+
+	offset := (req.Page - 1) * req.PageSize
+
+	entries, err := uc.historyRepo.ListByAccountID(ctx, parsedAccountID, filter, req.PageSize, offset)
+	if err != nil {
+		uc.logger.Error("Failed to list transaction history", "error", err, "accountID", accountID)
+		return nil, err
+	}
+
+	count, err := uc.historyRepo.CountByAccountID(ctx, parsedAccountID, filter)
+	if err != nil {
+		uc.logger.Error("Failed to count transaction history", "error", err, "accountID", accountID)
+		return nil, err
+	}
+
+	responses := make([]dto.HistoryEntryResponse, len(entries))
+	transactionIDs := make([]vo.TransactionID, len(entries))
+	for i, entry := range entries {
+		responses[i] = uc.mapper.ToResponse(entry)
+		transactionIDs[i] = entry.TransactionID
+	}
+
+	// Overrides are resolved on every read, so changing one does not require projecting the transaction again
+	overrides, err := uc.categorizer.Overrides(ctx, parsedAccountID, transactionIDs)
+	if err != nil {
+		uc.logger.Warn("Failed to load category overrides", "error", err, "accountID", accountID)
+	}
+	for i := range responses {
+		if category, ok := overrides[responses[i].TransactionID]; ok {
+			responses[i].Category = category
+		}
+	}
+	uc.attachTags(ctx, parsedAccountID, responses)
+
+	return &dto.TransactionHistoryResponse{
+		AccountID:  accountID,
+		Entries:    responses,
+		Pagination: dto.NewPaginationInfo(req.Page, req.PageSize, count),
+	}, nil
+}
+
+// historyFilter builds the repository filter of a history request, starting from the saved filter it
+// names and replacing each criterion the request gives
+func (uc *transactionHistoryUseCase) historyFilter(ctx context.Context, accountID string, req dto.HistoryRequest) (repository.HistoryFilter, error) {
+	var filter repository.HistoryFilter
+	if req.FilterID != "" {
+		saved, err := accountSavedFilter(ctx, uc.filterRepo, accountID, req.FilterID)
+		if err != nil {
+			return repository.HistoryFilter{}, err
+		}
+		filter = repository.HistoryFilter{
+			Tags:            saved.Tags,
+			Direction:       saved.Direction,
+			TransactionType: saved.TransactionType,
+		}
+	}
+
+	if len(req.Tags) > 0 {
+		tags, err := entity.NormalizeTags(req.Tags)
+		if err != nil {
+			return repository.HistoryFilter{}, err
+		}
+		filter.Tags = tags
+	}
+	if req.Direction != "" {
+		filter.Direction = entity.HistoryDirection(req.Direction)
+	}
+	if req.TransactionType != "" {
+		filter.TransactionType = vo.TransactionType(req.TransactionType)
+	}
+
+	return filter, nil
+}
+
+// attachTags sets the account's tags on history entries. Tags are read on every request like category
+// overrides; failing to load them leaves the entries untagged rather than failing the request.
+func (uc *transactionHistoryUseCase) attachTags(ctx context.Context, accountID vo.AccountID, responses []dto.HistoryEntryResponse) {
+	if len(responses) == 0 {
+		return
+	}
+
+	transactionIDs := make([]vo.TransactionID, 0, len(responses))
+	for _, response := range responses {
+		if transactionID, err := vo.NewTransactionIDFromString(response.TransactionID); err == nil {
+			transactionIDs = append(transactionIDs, transactionID)
+		}
+	}
+
+	tags, err := uc.tagRepo.ListByTransactionIDs(ctx, accountID, transactionIDs)
+	if err != nil {
+		uc.logger.Warn("Failed to load transaction tags", "error", err, "accountID", accountID.String())
+		return
+	}
+
+	byTransaction := make(map[string][]string)
+	for _, tag := range tags {
+		transactionID := tag.TransactionID.String()
+		byTransaction[transactionID] = append(byTransaction[transactionID], tag.Tag)
+	}
+	for i := range responses {
+		if transactionTags, ok := byTransaction[responses[i].TransactionID]; ok {
+			responses[i].Tags = transactionTags
+		}
+	}
+}
+
+// SyncAccountHistory retrieves an account's completed history entries after a sequence number, in sequence
+// order. Sequence numbers are assigned when a transaction completes but projected into the history afterwards,
+// so a number can be missing for a moment, or for good if its projection failed. Entries stop before the first
+// missing number, which is reported so the client retries later instead of skipping past it.
+func (uc *transactionHistoryUseCase) SyncAccountHistory(ctx context.Context, accountID string, req dto.HistorySyncRequest) (*dto.HistorySyncResponse, error) {
+	parsedAccountID, err := vo.NewAccountIDFromString(accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := uc.accountRepo.GetByID(ctx, parsedAccountID); err != nil {
+		return nil, errs.ErrAccountNotFound
+	}
+
+	// Read the last sequence first, so every number up to it has been assigned before the history is read
+	lastSequence, err := uc.transactionRepo.GetLastSequence(ctx, parsedAccountID)
+	if err != nil {
+		uc.logger.Error("Failed to get last sequence", "error", err, "accountID", accountID)
+		return nil, err
+	}
+
+	entries, err := uc.historyRepo.ListByAccountIDAfterSequence(ctx, parsedAccountID, req.AfterSequence, req.Limit)
+	if err != nil {
+		uc.logger.Error("Failed to list transaction history", "error", err, "accountID", accountID)
+		return nil, err
+	}
+
+	response := &dto.HistorySyncResponse{
+		AccountID:    accountID,
+		Entries:      make([]dto.HistoryEntryResponse, 0, len(entries)),
+		NextSequence: req.AfterSequence,
+		LastSequence: lastSequence,
+	}
+	for _, entry := range entries {
+		if entry.Sequence != response.NextSequence+1 {
+			break
+		}
+		response.Entries = append(response.Entries, uc.mapper.ToResponse(entry))
+		response.NextSequence = entry.Sequence
+	}
+	uc.attachTags(ctx, parsedAccountID, response.Entries)
+
+	if len(response.Entries) < req.Limit && response.NextSequence < lastSequence {
+		gap := response.NextSequence + 1
+		response.Gap = &gap
+		uc.logger.Warn("Transaction history has a sequence gap", "accountID", accountID, "sequence", gap)
+	}
+	response.HasMore = response.Gap == nil && response.NextSequence < lastSequence
+
+	return response, nil
+}
+
+// RebuildAccountHistory projects every transaction of an account again. Unlike the live projection, which
+// records the balance when a transaction completes, the running balance is recomputed from the current
+// balance by rolling back every completed transaction, so it also repairs entries projected out of order.
+func (uc *transactionHistoryUseCase) RebuildAccountHistory(ctx context.Context, accountID string) (*dto.HistoryRebuildResponse, error) {
+	parsedAccountID, err := vo.NewAccountIDFromString(accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := uc.accountRepo.GetByID(ctx, parsedAccountID)
+	if err != nil {
+		return nil, errs.ErrAccountNotFound
+	}
+
+	// Completed transactions come oldest first
+	completed, err := uc.transactionRepo.GetCompletedByAccountIDSince(ctx, parsedAccountID, time.Time{})
+	if err != nil {
+		uc.logger.Error("Failed to get completed transactions", "error", err, "accountID", accountID)
+		return nil, err
+	}
+	balance := account.Balance
+	for _, transaction := range completed {
+		balance, _ = balance.Subtract(transaction.BalanceEffect(parsedAccountID))
+	}
+	balances := make(map[vo.TransactionID]vo.Money, len(completed))
+	for _, transaction := range completed {
+		balance, _ = balance.Add(transaction.BalanceEffect(parsedAccountID))
+		balances[transaction.ID] = balance
+	}
+
+	var entries []*entity.HistoryEntry
+	for offset := 0; ; offset += historyRebuildPageSize {
+		transactions, err := uc.transactionRepo.GetByAccountID(ctx, parsedAccountID, historyRebuildPageSize, offset)
+		if err != nil {
+			uc.logger.Error("Failed to get account transactions", "error", err, "accountID", accountID)
+			return nil, err
+		}
+		for _, transaction := range transactions {
+			for _, entry := range entity.NewHistoryEntries(transaction) {
+				if entry.AccountID != parsedAccountID {
+					continue
+				}
+				if balanceAfter, ok := balances[transaction.ID]; ok {
+					entry.BalanceAfter = &balanceAfter
+				}
+				entries = append(entries, entry)
+			}
+		}
+		if len(transactions) < historyRebuildPageSize {
+			break
+		}
+	}
+
+	accounts, err := uc.accountRepo.GetByIDs(ctx, historyAccountIDs(entries))
+	if err != nil {
+		uc.logger.Error("Failed to load counterparties", "error", err, "accountID", accountID)
+		return nil, err
+	}
+	for _, entry := range entries {
+		entry.CounterpartyName = counterpartyName(accounts, entry)
+	}
+
+	if err := uc.historyRepo.ReplaceByAccountID(ctx, parsedAccountID, entries); err != nil {
+		uc.logger.Error("Failed to replace transaction history", "error", err, "accountID", accountID)
+		return nil, err
+	}
+
+	uc.logger.Info("Transaction history rebuilt", "accountID", accountID, "transactions", len(entries))
+	return &dto.HistoryRebuildResponse{
+		AccountID:    accountID,
+		Transactions: len(entries),
+	}, nil
+}
@@ -0,0 +1,260 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/application/dto"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/event"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/repository"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type MockTransactionHistoryRepository struct {
+	mock.Mock
+}
+
+func (m *MockTransactionHistoryRepository) Upsert(ctx context.Context, entries []*entity.HistoryEntry) error {
+	args := m.Called(ctx, entries)
+	return args.Error(0)
+}
+
+func (m *MockTransactionHistoryRepository) ReplaceByAccountID(ctx context.Context, accountID vo.AccountID, entries []*entity.HistoryEntry) error {
+	args := m.Called(ctx, accountID, entries)
+	return args.Error(0)
+}
+
+func (m *MockTransactionHistoryRepository) ListByAccountID(ctx context.Context, accountID vo.AccountID, filter repository.HistoryFilter, limit, offset int) ([]*entity.HistoryEntry, error) {
+	args := m.Called(ctx, accountID, filter, limit, offset)
+	return args.Get(0).([]*entity.HistoryEntry), args.Error(1)
+}
+
+func (m *MockTransactionHistoryRepository) ListByAccountIDAfterSequence(ctx context.Context, accountID vo.AccountID, afterSequence int64, limit int) ([]*entity.HistoryEntry, error) {
+	args := m.Called(ctx, accountID, afterSequence, limit)
+	return args.Get(0).([]*entity.HistoryEntry), args.Error(1)
+}
+
+func (m *MockTransactionHistoryRepository) CountByAccountID(ctx context.Context, accountID vo.AccountID, filter repository.HistoryFilter) (int64, error) {
+	args := m.Called(ctx, accountID, filter)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func TestTransactionHistoryProjector_Publish(t *testing.T) {
+	payer := createTestAccount()
+	payee := createTestAccount()
+	payee.AccountName = "Landlord"
+	transfer, err := entity.NewTransferTransaction(payer.ID, payee.ID, vo.NewMoneyFromFloat(100), "Rent", "", time.Now())
+	transfer = completedTransaction(t, transfer, err)
+
+	mockHistoryRepo := new(MockTransactionHistoryRepository)
+	mockAccountRepo := new(MockAccountRepository)
+	mockTxnRepo := new(MockTransactionRepository)
+	mockTxnRepo.On("GetByID", mock.Anything, transfer.ID).Return(transfer, nil)
+	mockAccountRepo.On("GetByIDs", mock.Anything, []vo.AccountID{payer.ID, payee.ID}).
+		Return(map[vo.AccountID]*entity.Account{payer.ID: payer, payee.ID: payee}, nil)
+
+	var entries []*entity.HistoryEntry
+	mockHistoryRepo.On("Upsert", mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) { entries = args.Get(1).([]*entity.HistoryEntry) }).
+		Return(nil)
+
+	next := &StubEventPublisher{}
+	projector := NewTransactionHistoryProjector(mockHistoryRepo, mockAccountRepo, mockTxnRepo, next, new(MockLogger))
+
+	require.NoError(t, projector.Publish(context.Background(), event.NewTransactionEvent(event.TransactionCompleted, transfer)))
+	assert.Len(t, next.Events, 1)
+
+	require.Len(t, entries, 2)
+	assert.Equal(t, payer.ID, entries[0].AccountID)
+	assert.Equal(t, "Landlord", entries[0].CounterpartyName)
+	require.NotNil(t, entries[0].BalanceAfter)
+	assert.True(t, entries[0].BalanceAfter.Equal(payer.Balance))
+	assert.Equal(t, payee.ID, entries[1].AccountID)
+	assert.Equal(t, payer.AccountName, entries[1].CounterpartyName)
+
+	// Account events are not projected
+	require.NoError(t, projector.Publish(context.Background(), event.NewAccountEvent(event.AccountCreated, payer)))
+	mockHistoryRepo.AssertNumberOfCalls(t, "Upsert", 1)
+}
+
+func TestTransactionHistoryUseCase_RebuildAccountHistory(t *testing.T) {
+	account := createTestAccount() // Balance 1000 after the transactions below
+	counterparty := createTestAccount()
+	counterparty.AccountName = "Employer"
+
+	salary, err := entity.NewTransferTransaction(counterparty.ID, account.ID, vo.NewMoneyFromFloat(300), "Salary", "", time.Now())
+	salary = completedTransaction(t, salary, err)
+	coffee, err := entity.NewDebitTransaction(account.ID, vo.NewMoneyFromFloat(50), "Coffee", "", time.Now())
+	coffee = completedTransaction(t, coffee, err)
+	pending, err := entity.NewDebitTransaction(account.ID, vo.NewMoneyFromFloat(20), "Lunch", "", time.Now())
+	require.NoError(t, err)
+
+	mockHistoryRepo := new(MockTransactionHistoryRepository)
+	mockAccountRepo := new(MockAccountRepository)
+	mockTxnRepo := new(MockTransactionRepository)
+	mockLogger := new(MockLogger)
+	mockLogger.On("Info", mock.Anything, mock.Anything).Maybe()
+
+	mockAccountRepo.On("GetByID", mock.Anything, account.ID).Return(account, nil)
+	mockTxnRepo.On("GetCompletedByAccountIDSince", mock.Anything, account.ID, mock.Anything).Return([]*entity.Transaction{salary, coffee}, nil)
+	mockTxnRepo.On("GetByAccountID", mock.Anything, account.ID, historyRebuildPageSize, 0).Return([]*entity.Transaction{pending, coffee, salary}, nil)
+	mockAccountRepo.On("GetByIDs", mock.Anything, []vo.AccountID{account.ID, counterparty.ID}).
+		Return(map[vo.AccountID]*entity.Account{account.ID: account, counterparty.ID: counterparty}, nil)
+
+	var entries []*entity.HistoryEntry
+	mockHistoryRepo.On("ReplaceByAccountID", mock.Anything, account.ID, mock.Anything).
+		Run(func(args mock.Arguments) { entries = args.Get(2).([]*entity.HistoryEntry) }).
+		Return(nil)
+
+	uc := NewTransactionHistoryUseCase(mockHistoryRepo, mockAccountRepo, mockTxnRepo, nil, nil, nil, mockLogger)
+	result, err := uc.RebuildAccountHistory(context.Background(), account.ID.String())
+
+	require.NoError(t, err)
+	assert.Equal(t, 3, result.Transactions)
+	require.Len(t, entries, 3)
+
+	// 750 before the salary, 1050 after it, 1000 after the coffee; pending transactions have no balance yet
+	assert.Nil(t, entries[0].BalanceAfter)
+	assert.True(t, entries[1].BalanceAfter.Equal(vo.NewMoneyFromFloat(1000)))
+	assert.True(t, entries[2].BalanceAfter.Equal(vo.NewMoneyFromFloat(1050)))
+	assert.Equal(t, entity.HistoryDirectionIn, entries[2].Direction)
+	assert.Equal(t, "Employer", entries[2].CounterpartyName)
+}
+
+func TestTransactionHistoryUseCase_GetAccountHistory(t *testing.T) {
+	account := createTestAccount()
+	payment, err := entity.NewDebitTransaction(account.ID, vo.NewMoneyFromFloat(50), "Coffee", "", time.Now())
+	payment = completedTransaction(t, payment, err)
+	entry := entity.NewHistoryEntries(payment)[0]
+	entry.Category = "SHOPPING"
+
+	mockHistoryRepo := new(MockTransactionHistoryRepository)
+	mockAccountRepo := new(MockAccountRepository)
+	mockOverrideRepo := new(MockCategoryOverrideRepository)
+	mockTagRepo := new(MockTransactionTagRepository)
+	mockAccountRepo.On("GetByID", mock.Anything, account.ID).Return(account, nil)
+	mockHistoryRepo.On("ListByAccountID", mock.Anything, account.ID, repository.HistoryFilter{}, 10, 0).Return([]*entity.HistoryEntry{entry}, nil)
+	mockHistoryRepo.On("CountByAccountID", mock.Anything, account.ID, repository.HistoryFilter{}).Return(int64(1), nil)
+	mockOverrideRepo.On("ListByTransactionIDs", mock.Anything, account.ID, []vo.TransactionID{payment.ID}).Return([]*entity.CategoryOverride{
+		entity.NewCategoryOverride(account.ID, payment.ID, "DINING"),
+	}, nil)
+	tags, err := entity.NewTransactionTags(account.ID, payment.ID, []string{"coffee", "work"})
+	require.NoError(t, err)
+	mockTagRepo.On("ListByTransactionIDs", mock.Anything, account.ID, []vo.TransactionID{payment.ID}).Return(tags, nil)
+
+	mockLogger := new(MockLogger)
+	uc := NewTransactionHistoryUseCase(mockHistoryRepo, mockAccountRepo, nil, mockTagRepo, nil, NewCategorizer(nil, mockOverrideRepo, mockLogger), mockLogger)
+	result, err := uc.GetAccountHistory(context.Background(), account.ID.String(), dto.HistoryRequest{ListRequest: dto.ListRequest{Page: 1, PageSize: 10}})
+
+	require.NoError(t, err)
+	require.Len(t, result.Entries, 1)
+	assert.Equal(t, "DINING", result.Entries[0].Category)
+	assert.Equal(t, []string{"coffee", "work"}, result.Entries[0].Tags)
+	assert.Equal(t, -50.0, result.Entries[0].Change)
+	assert.Equal(t, int64(1), result.Pagination.TotalItems)
+}
+
+func TestTransactionHistoryUseCase_GetAccountHistory_SavedFilter(t *testing.T) {
+	account := createTestAccount()
+	filter, err := entity.NewSavedFilter(account.ID, "Trips", []string{"travel"}, entity.HistoryDirectionOut, "")
+	require.NoError(t, err)
+
+	mockHistoryRepo := new(MockTransactionHistoryRepository)
+	mockAccountRepo := new(MockAccountRepository)
+	mockFilterRepo := new(MockSavedFilterRepository)
+	mockAccountRepo.On("GetByID", mock.Anything, account.ID).Return(account, nil)
+	mockFilterRepo.On("GetByID", mock.Anything, filter.ID).Return(filter, nil)
+
+	// The request's tags replace the saved ones; the saved direction still applies
+	expected := repository.HistoryFilter{Tags: []string{"hotel"}, Direction: entity.HistoryDirectionOut}
+	mockHistoryRepo.On("ListByAccountID", mock.Anything, account.ID, expected, 10, 0).Return([]*entity.HistoryEntry{}, nil)
+	mockHistoryRepo.On("CountByAccountID", mock.Anything, account.ID, expected).Return(int64(0), nil)
+
+	mockOverrideRepo := new(MockCategoryOverrideRepository)
+	mockOverrideRepo.On("ListByTransactionIDs", mock.Anything, account.ID, mock.Anything).Return([]*entity.CategoryOverride{}, nil)
+
+	mockLogger := new(MockLogger)
+	uc := NewTransactionHistoryUseCase(mockHistoryRepo, mockAccountRepo, nil, nil, mockFilterRepo, NewCategorizer(nil, mockOverrideRepo, mockLogger), mockLogger)
+	result, err := uc.GetAccountHistory(context.Background(), account.ID.String(), dto.HistoryRequest{
+		ListRequest: dto.ListRequest{Page: 1, PageSize: 10},
+		Tags:        []string{"Hotel"},
+		FilterID:    filter.ID,
+	})
+
+	require.NoError(t, err)
+	assert.Empty(t, result.Entries)
+	mockHistoryRepo.AssertExpectations(t)
+
+	// Another account's filter is not found
+	other, err := entity.NewSavedFilter(vo.NewAccountID(), "Trips", nil, "", "")
+	require.NoError(t, err)
+	mockFilterRepo.On("GetByID", mock.Anything, other.ID).Return(other, nil)
+	_, err = uc.GetAccountHistory(context.Background(), account.ID.String(), dto.HistoryRequest{
+		ListRequest: dto.ListRequest{Page: 1, PageSize: 10},
+		FilterID:    other.ID,
+	})
+	assert.ErrorIs(t, err, errs.ErrSavedFilterNotFound)
+}
+
+func TestTransactionHistoryUseCase_SyncAccountHistory(t *testing.T) {
+	account := createTestAccount()
+	entriesWithSequences := func(sequences ...int64) []*entity.HistoryEntry {
+		entries := make([]*entity.HistoryEntry, len(sequences))
+		for i, sequence := range sequences {
+			payment, err := entity.NewDebitTransaction(account.ID, vo.NewMoneyFromFloat(10), "Coffee", "", time.Now())
+			payment = completedTransaction(t, payment, err)
+			payment.FromSequence = sequence
+			entries[i] = entity.NewHistoryEntries(payment)[0]
+		}
+		return entries
+	}
+
+	tests := []struct {
+		name         string
+		entries      []*entity.HistoryEntry
+		lastSequence int64
+		limit        int
+		wantEntries  int
+		wantNext     int64
+		wantHasMore  bool
+		wantGap      *int64
+	}{
+		{name: "caught up", entries: entriesWithSequences(4, 5), lastSequence: 5, limit: 10, wantEntries: 2, wantNext: 5},
+		{name: "more after the page", entries: entriesWithSequences(4, 5), lastSequence: 9, limit: 2, wantEntries: 2, wantNext: 5, wantHasMore: true},
+		{name: "stops before a gap", entries: entriesWithSequences(4, 6), lastSequence: 6, limit: 10, wantEntries: 1, wantNext: 4, wantGap: func() *int64 { gap := int64(5); return &gap }()},
+		{name: "not projected yet", entries: nil, lastSequence: 4, limit: 10, wantNext: 3, wantGap: func() *int64 { gap := int64(4); return &gap }()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockHistoryRepo := new(MockTransactionHistoryRepository)
+			mockAccountRepo := new(MockAccountRepository)
+			mockTxnRepo := new(MockTransactionRepository)
+			mockLogger := new(MockLogger)
+			mockLogger.On("Warn", mock.Anything, mock.Anything).Maybe()
+
+			mockAccountRepo.On("GetByID", mock.Anything, account.ID).Return(account, nil)
+			mockTxnRepo.On("GetLastSequence", mock.Anything, account.ID).Return(tt.lastSequence, nil)
+			mockHistoryRepo.On("ListByAccountIDAfterSequence", mock.Anything, account.ID, int64(3), tt.limit).Return(tt.entries, nil)
+
+			mockTagRepo := new(MockTransactionTagRepository)
+			mockTagRepo.On("ListByTransactionIDs", mock.Anything, account.ID, mock.Anything).Return([]*entity.TransactionTag{}, nil)
+
+			uc := NewTransactionHistoryUseCase(mockHistoryRepo, mockAccountRepo, mockTxnRepo, mockTagRepo, nil, nil, mockLogger)
+			result, err := uc.SyncAccountHistory(context.Background(), account.ID.String(), dto.HistorySyncRequest{AfterSequence: 3, Limit: tt.limit})
+
+			require.NoError(t, err)
+			assert.Len(t, result.Entries, tt.wantEntries)
+			assert.Equal(t, tt.wantNext, result.NextSequence)
+			assert.Equal(t, tt.lastSequence, result.LastSequence)
+			assert.Equal(t, tt.wantHasMore, result.HasMore)
+			assert.Equal(t, tt.wantGap, result.Gap)
+		})
+	}
+}
@@ -0,0 +1,138 @@
+// internal/application/transaction_monitor.go
+package usecase
+
+import (
+	"context"
+	"sync"
+
+	"github.com/hydr0g3nz/mini_bank/internal/application/dto"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/event"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/infra"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"github.com/shopspring/decimal"
+)
+
+// liveBufferSize bounds the events queued for a slow live stream consumer
+const liveBufferSize = 64
+
+// liveTransactionEvents are the events the live monitor streams
+var liveTransactionEvents = []event.Type{event.TransactionCreated, event.TransactionCompleted}
+
+// liveTransactionFilter selects the transactions a live stream delivers
+type liveTransactionFilter struct {
+	minAmount       *decimal.Decimal
+	status          vo.TransactionStatus
+	transactionType vo.TransactionType
+}
+
+func newLiveTransactionFilter(req dto.LiveTransactionRequest) liveTransactionFilter {
+	filter := liveTransactionFilter{
+		status:          vo.TransactionStatus(req.Status),
+		transactionType: vo.TransactionType(req.TransactionType),
+	}
+	if req.MinAmount != nil {
+		minAmount := req.MinAmount.Decimal
+		filter.minAmount = &minAmount
+	}
+	return filter
+}
+
+// matches checks a transaction against every criterion of the filter
+func (f liveTransactionFilter) matches(payload event.TransactionPayload) bool {
+	if f.status != "" && vo.TransactionStatus(payload.Status) != f.status {
+		return false
+	}
+	if f.transactionType != "" && vo.TransactionType(payload.TransactionType) != f.transactionType {
+		return false
+	}
+	if f.minAmount != nil {
+		amount, err := decimal.NewFromString(payload.Amount)
+		if err != nil || !amount.GreaterThan(*f.minAmount) {
+			return false
+		}
+	}
+	return true
+}
+
+type transactionMonitorUseCase struct {
+	events infra.EventBus
+	logger infra.Logger
+}
+
+// NewTransactionMonitorUseCase creates a new transaction monitor use case
+func NewTransactionMonitorUseCase(events infra.EventBus, logger infra.Logger) TransactionMonitorUseCase {
+	return &transactionMonitorUseCase{
+		events: events,
+		logger: logger,
+	}
+}
+
+// WatchTransactions streams newly created and completed transactions matching the
+// filter until ctx is done, then closes the channel. Events are dropped rather than
+// blocking the event bus when the consumer falls behind.
+func (uc *transactionMonitorUseCase) WatchTransactions(ctx context.Context, req dto.LiveTransactionRequest) (<-chan dto.LiveTransactionResponse, error) {
+	filter := newLiveTransactionFilter(req)
+	stream := make(chan dto.LiveTransactionResponse, liveBufferSize)
+
+	var mu sync.Mutex
+	closed := false
+
+	unsubscribe := uc.events.Subscribe(func(_ context.Context, evt event.Event) {
+		payload, err := evt.DecodeTransaction()
+		if err != nil {
+			uc.logger.Warn("Failed to decode transaction event", "error", err, "eventID", evt.ID)
+			return
+		}
+		if !filter.matches(payload) {
+			return
+		}
+
+		response := toLiveTransactionResponse(evt, payload)
+
+		mu.Lock()
+		defer mu.Unlock()
+		if closed {
+			return
+		}
+		select {
+		case stream <- response:
+		default:
+			uc.logger.Warn("Live transaction stream is falling behind, dropping event", "eventID", evt.ID)
+		}
+	}, liveTransactionEvents...)
+
+	go func() {
+		<-ctx.Done()
+		unsubscribe()
+
+		mu.Lock()
+		closed = true
+		close(stream)
+		mu.Unlock()
+	}()
+
+	return stream, nil
+}
+
+// toLiveTransactionResponse converts a transaction event to LiveTransactionResponse DTO
+func toLiveTransactionResponse(evt event.Event, payload event.TransactionPayload) dto.LiveTransactionResponse {
+	amount, _ := decimal.NewFromString(payload.Amount)
+
+	return dto.LiveTransactionResponse{
+		EventID:         evt.ID,
+		Event:           string(evt.Type),
+		OccurredAt:      evt.OccurredAt,
+		TransactionID:   payload.TransactionID,
+		FromAccountID:   payload.FromAccountID,
+		ToAccountID:     payload.ToAccountID,
+		TransactionType: payload.TransactionType,
+		Channel:         payload.Channel,
+		Amount:          amount.InexactFloat64(),
+		Status:          payload.Status,
+		Description:     payload.Description,
+		Reference:       payload.Reference,
+		Category:        payload.Category,
+		CreatedAt:       payload.CreatedAt,
+		CompletedAt:     payload.CompletedAt,
+	}
+}
@@ -0,0 +1,149 @@
+package usecase
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/application/dto"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/event"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/infra"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// StubEventBus delivers published events synchronously to subscribers
+type StubEventBus struct {
+	mu       sync.Mutex
+	handlers map[int]stubSubscription
+	nextID   int
+}
+
+type stubSubscription struct {
+	handler infra.EventHandler
+	types   []event.Type
+}
+
+func (s *StubEventBus) Publish(ctx context.Context, evt event.Event) error {
+	s.mu.Lock()
+	var handlers []infra.EventHandler
+	for _, sub := range s.handlers {
+		for _, eventType := range sub.types {
+			if eventType == evt.Type {
+				handlers = append(handlers, sub.handler)
+			}
+		}
+	}
+	s.mu.Unlock()
+
+	for _, handler := range handlers {
+		handler(ctx, evt)
+	}
+	return nil
+}
+
+func (s *StubEventBus) Subscribe(handler infra.EventHandler, eventTypes ...event.Type) func() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.handlers == nil {
+		s.handlers = make(map[int]stubSubscription)
+	}
+	id := s.nextID
+	s.nextID++
+	s.handlers[id] = stubSubscription{handler: handler, types: eventTypes}
+
+	return func() {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		delete(s.handlers, id)
+	}
+}
+
+func (s *StubEventBus) Subscribers() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.handlers)
+}
+
+func TestTransactionMonitorUseCase_WatchTransactions(t *testing.T) {
+	bus := &StubEventBus{}
+	mockLogger := new(MockLogger)
+	mockLogger.On("Warn", mock.Anything, mock.Anything).Maybe()
+	uc := NewTransactionMonitorUseCase(bus, mockLogger)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	minAmount := dto.NewDecimalStringFromFloat(100)
+	stream, err := uc.WatchTransactions(ctx, dto.LiveTransactionRequest{
+		MinAmount:       &minAmount,
+		TransactionType: "TRANSFER",
+	})
+	require.NoError(t, err)
+
+	transfer := func(amount float64) *entity.Transaction {
+		txn, err := entity.NewTransferTransaction(vo.NewAccountID(), vo.NewAccountID(), vo.NewMoneyFromFloat(amount), "Transfer", "", time.Now())
+		require.NoError(t, err)
+		return txn
+	}
+	debit, err := entity.NewDebitTransaction(vo.NewAccountID(), vo.NewMoneyFromFloat(500), "Debit", "", time.Now())
+	require.NoError(t, err)
+
+	large := transfer(250)
+	completed := transfer(300)
+	require.NoError(t, completed.MarkAsCompleted(time.Now()))
+
+	ignored := []event.Event{
+		event.NewTransactionEvent(event.TransactionCreated, transfer(100)), // Not above the minimum
+		event.NewTransactionEvent(event.TransactionCreated, debit),         // Wrong type
+		event.NewTransactionEvent(event.TransactionCancelled, large),       // Not streamed
+	}
+	for _, evt := range ignored {
+		require.NoError(t, bus.Publish(ctx, evt))
+	}
+	require.NoError(t, bus.Publish(ctx, event.NewTransactionEvent(event.TransactionCreated, large)))
+	require.NoError(t, bus.Publish(ctx, event.NewTransactionEvent(event.TransactionCompleted, completed)))
+
+	first := <-stream
+	assert.Equal(t, string(event.TransactionCreated), first.Event)
+	assert.Equal(t, large.ID.String(), first.TransactionID)
+	assert.Equal(t, 250.0, first.Amount)
+	assert.Equal(t, "PENDING", first.Status)
+
+	second := <-stream
+	assert.Equal(t, string(event.TransactionCompleted), second.Event)
+	assert.Equal(t, completed.ID.String(), second.TransactionID)
+	assert.Equal(t, "COMPLETED", second.Status)
+
+	// Cancelling the watch unsubscribes and closes the stream
+	cancel()
+	select {
+	case _, ok := <-stream:
+		assert.False(t, ok)
+	case <-time.After(time.Second):
+		t.Fatal("stream was not closed")
+	}
+	assert.Equal(t, 0, bus.Subscribers())
+}
+
+func TestTransactionMonitorUseCase_StatusFilter(t *testing.T) {
+	bus := &StubEventBus{}
+	uc := NewTransactionMonitorUseCase(bus, new(MockLogger))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream, err := uc.WatchTransactions(ctx, dto.LiveTransactionRequest{Status: "COMPLETED"})
+	require.NoError(t, err)
+
+	txn, err := entity.NewCreditTransaction(vo.NewAccountID(), vo.NewMoneyFromFloat(10), "Deposit", "", time.Now())
+	require.NoError(t, err)
+	require.NoError(t, bus.Publish(ctx, event.NewTransactionEvent(event.TransactionCreated, txn)))
+	require.NoError(t, txn.MarkAsCompleted(time.Now()))
+	require.NoError(t, bus.Publish(ctx, event.NewTransactionEvent(event.TransactionCompleted, txn)))
+
+	response := <-stream
+	assert.Equal(t, string(event.TransactionCompleted), response.Event)
+	assert.Empty(t, stream)
+}
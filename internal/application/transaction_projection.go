@@ -0,0 +1,138 @@
+// internal/application/transaction_projection.go
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hydr0g3nz/mini_bank/internal/application/dto"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+)
+
+// defaultProjectionDays is the length of the projected period when the request names none
+const defaultProjectionDays = 30
+
+// GetAccountProjection projects the interest an account earns and the fees it pays over the next days.
+// Interest comes from the product's rate on the current balance. Fees come from running the account's
+// outgoing transactions of the last period of the same length through the fee rules and the product
+// again, as a dry run: nothing is charged or saved, and rules or product terms changed since apply.
+func (uc *transactionUseCase) GetAccountProjection(ctx context.Context, req dto.AccountProjectionRequest) (*dto.AccountProjectionResponse, error) {
+	accountID, err := vo.NewAccountIDFromString(req.AccountID)
+	if err != nil {
+		uc.logger.Error("Invalid account ID format", "error", err, "accountID", req.AccountID)
+		return nil, err
+	}
+
+	days := req.Days
+	if days == 0 {
+		days = defaultProjectionDays
+	}
+	if days < 0 || days > maxActivityDays {
+		return nil, errs.ValidationError{Field: "days", Message: fmt.Sprintf("days must be between 1 and %d", maxActivityDays)}
+	}
+
+	account, err := uc.accountRepo.GetByID(ctx, accountID)
+	if err != nil {
+		uc.logger.Error("Account not found", "error", err, "accountID", req.AccountID)
+		return nil, errs.ErrAccountNotFound
+	}
+
+	product, err := uc.products.Product(ctx, account)
+	if err != nil {
+		return nil, err
+	}
+
+	today := uc.valueDating.Today()
+	since := today.AddDate(0, 0, -days)
+	transactions, err := uc.transactionRepo.GetCompletedByAccountIDSince(ctx, accountID, since)
+	if err != nil {
+		uc.logger.Error("Failed to get completed transactions", "error", err, "accountID", req.AccountID)
+		return nil, err
+	}
+
+	// Only the transactions the account made itself are charged fees when created
+	var outgoing []*entity.Transaction
+	for _, transaction := range transactions {
+		if transaction.FromAccountID == nil || *transaction.FromAccountID != accountID {
+			continue
+		}
+		switch transaction.TransactionType {
+		case vo.TransactionTypeDebit, vo.TransactionTypeTransfer, vo.TransactionTypeFXTransfer:
+			outgoing = append(outgoing, transaction)
+		}
+	}
+
+	ruleFees, err := uc.rules.DryRunFees(ctx, outgoing)
+	if err != nil {
+		return nil, err
+	}
+
+	type feeTotals struct {
+		count                int
+		rule, product, total vo.Money
+	}
+	byType := make(map[vo.TransactionType]*feeTotals)
+	expectedFees := vo.ZeroMoney()
+	for i, transaction := range outgoing {
+		productFee := vo.ZeroMoney()
+		if product != nil {
+			productFee = product.Fee(transaction.TransactionType)
+		}
+		fee, _ := ruleFees[i].Add(productFee)
+
+		totals, ok := byType[transaction.TransactionType]
+		if !ok {
+			totals = &feeTotals{rule: vo.ZeroMoney(), product: vo.ZeroMoney(), total: vo.ZeroMoney()}
+			byType[transaction.TransactionType] = totals
+		}
+		totals.count++
+		totals.rule, _ = totals.rule.Add(ruleFees[i])
+		totals.product, _ = totals.product.Add(productFee)
+		totals.total, _ = totals.total.Add(fee)
+		expectedFees, _ = expectedFees.Add(fee)
+	}
+
+	interest := vo.ZeroMoney()
+	response := &dto.AccountProjectionResponse{
+		AccountID:     accountID.String(),
+		Currency:      account.Currency().String(),
+		Balance:       account.Balance.InexactFloat64(),
+		From:          today.Format(dateLayout),
+		To:            today.AddDate(0, 0, days-1).Format(dateLayout),
+		Days:          days,
+		ActivitySince: since.Format(dateLayout),
+		ExpectedFees:  expectedFees.InexactFloat64(),
+		Fees:          []dto.ProjectedFees{},
+	}
+	if product != nil {
+		interest = product.Interest(account.Balance, days)
+		response.ProductID = product.ID
+		response.InterestRate = product.InterestRate.InexactFloat64()
+	}
+	response.ProjectedInterest = interest.InexactFloat64()
+
+	projected, _ := account.Balance.Add(interest)
+	projected, _ = projected.Subtract(expectedFees)
+	response.ProjectedBalance = projected.InexactFloat64()
+
+	for transactionType, totals := range byType {
+		if totals.total.IsZero() {
+			continue
+		}
+		response.Fees = append(response.Fees, dto.ProjectedFees{
+			TransactionType: string(transactionType),
+			Count:           totals.count,
+			RuleFees:        totals.rule.InexactFloat64(),
+			ProductFees:     totals.product.InexactFloat64(),
+			Total:           totals.total.InexactFloat64(),
+		})
+	}
+	sort.Slice(response.Fees, func(i, j int) bool {
+		return response.Fees[i].TransactionType < response.Fees[j].TransactionType
+	})
+
+	return response, nil
+}
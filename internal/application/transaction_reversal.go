@@ -0,0 +1,105 @@
+// internal/application/transaction_reversal.go
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/application/dto"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+)
+
+// ReverseTransaction moves the money of a completed transaction back with a REVERSAL linked to it and
+// refunds the fee it charged. A transaction is reversed once; a reversal that failed, for instance on
+// the balance it had to take back, may be tried again. Each step's outcome shows in a saga recorded
+// against the original transaction.
+func (uc *transactionUseCase) ReverseTransaction(ctx context.Context, req dto.ReverseTransactionRequest) (*dto.ReverseTransactionResponse, error) {
+	uc.logger.Info("Reversing transaction", "transactionID", req.ID)
+
+	transactionID, err := vo.NewTransactionIDFromString(req.ID)
+	if err != nil {
+		uc.logger.Error("Invalid transaction ID format", "error", err, "transactionID", req.ID)
+		return nil, err
+	}
+
+	// Two requests must not both find the transaction unreversed
+	lockKey := fmt.Sprintf("lock:reversal:%s", req.ID)
+	lockToken, lockAcquired, err := uc.acquireDistributedLock(ctx, lockKey, 30*time.Second)
+	if err != nil {
+		return nil, fmt.Errorf("failed to acquire lock: %w", err)
+	}
+	if !lockAcquired {
+		return nil, errs.ErrTransactionAlreadyInProgress
+	}
+	defer func() {
+		if err := uc.releaseLock(ctx, lockKey, lockToken); err != nil {
+			uc.logger.Warn("Failed to release distributed lock", "error", err, "transactionID", req.ID)
+		}
+	}()
+
+	original, err := uc.transactionRepo.GetByID(ctx, transactionID)
+	if err != nil {
+		uc.logger.Error("Transaction not found", "error", err, "transactionID", req.ID)
+		return nil, errs.ErrTransactionNotFound
+	}
+
+	reversals, err := uc.transactionRepo.GetByReversedTransactionID(ctx, transactionID)
+	if err != nil {
+		uc.logger.Error("Failed to load reversals of transaction", "error", err, "transactionID", req.ID)
+		return nil, err
+	}
+	for _, reversal := range reversals {
+		if !reversal.Status.IsFailed() {
+			return nil, fmt.Errorf("%w by %s", errs.ErrTransactionAlreadyReversed, reversal.ID)
+		}
+	}
+
+	reversal, err := entity.NewReversalTransaction(original, uc.valueDating.Now())
+	if err != nil {
+		uc.logger.Warn("Transaction cannot be reversed", "error", err, "transactionID", req.ID)
+		return nil, err
+	}
+
+	response := &dto.ReverseTransactionResponse{TransactionID: req.ID}
+	steps := []sagaStep{
+		{
+			name: "reverse_settlement",
+			execute: func(ctx context.Context) error {
+				reversed, err := uc.settle(ctx, reversal)
+				if err != nil {
+					return err
+				}
+				response.Reversal = *reversed
+				return nil
+			},
+		},
+		{
+			name: "refund_fee",
+			execute: func(ctx context.Context) error {
+				refund, err := entity.NewFeeRefundTransaction(original, uc.valueDating.Now())
+				if err != nil {
+					return err
+				}
+
+				refunded, err := uc.settle(ctx, refund)
+				if err != nil {
+					return err
+				}
+				response.FeeRefund = refunded
+				return nil
+			},
+			skip: func() bool { return !original.Fee.IsPositive() },
+		},
+	}
+
+	if err := uc.sagas.run(ctx, entity.SagaTypeReversal, transactionID, steps); err != nil {
+		uc.logger.Error("Failed to reverse transaction", "error", err, "transactionID", req.ID)
+		return nil, err
+	}
+
+	uc.logger.Info("Transaction reversed successfully", "transactionID", req.ID, "reversalID", response.Reversal.ID)
+	return response, nil
+}
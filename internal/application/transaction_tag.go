@@ -0,0 +1,193 @@
+// internal/application/transaction_tag.go
+package usecase
+
+import (
+	"context"
+
+	"github.com/hydr0g3nz/mini_bank/internal/application/dto"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/infra"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/repository"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+)
+
+type transactionTagUseCase struct {
+	tagRepo         repository.TransactionTagRepository
+	filterRepo      repository.SavedFilterRepository
+	accountRepo     repository.AccountRepository
+	transactionRepo repository.TransactionRepository
+	logger          infra.Logger
+	mapper          *dto.SavedFilterMapper
+}
+
+// NewTransactionTagUseCase creates a new transaction tag use case
+func NewTransactionTagUseCase(
+	tagRepo repository.TransactionTagRepository,
+	filterRepo repository.SavedFilterRepository,
+	accountRepo repository.AccountRepository,
+	transactionRepo repository.TransactionRepository,
+	logger infra.Logger,
+) TransactionTagUseCase {
+	return &transactionTagUseCase{
+		tagRepo:         tagRepo,
+		filterRepo:      filterRepo,
+		accountRepo:     accountRepo,
+		transactionRepo: transactionRepo,
+		logger:          logger,
+		mapper:          &dto.SavedFilterMapper{},
+	}
+}
+
+// SetTransactionTags replaces an account's tags of one of its transactions
+func (uc *transactionTagUseCase) SetTransactionTags(ctx context.Context, req dto.SetTransactionTagsRequest) (*dto.TransactionTagsResponse, error) {
+	accountID, transactionID, err := ownTransaction(ctx, uc.transactionRepo, req.AccountID, req.TransactionID)
+	if err != nil {
+		return nil, err
+	}
+
+	tags, err := entity.NewTransactionTags(accountID, transactionID, req.Tags)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.tagRepo.ReplaceTags(ctx, accountID, transactionID, tags); err != nil {
+		uc.logger.Error("Failed to set transaction tags", "error", err, "accountID", req.AccountID, "transactionID", req.TransactionID)
+		return nil, err
+	}
+
+	response := &dto.TransactionTagsResponse{
+		AccountID:     req.AccountID,
+		TransactionID: req.TransactionID,
+		Tags:          make([]string, len(tags)),
+	}
+	for i, tag := range tags {
+		response.Tags[i] = tag.Tag
+	}
+
+	uc.logger.Info("Transaction tags set", "accountID", req.AccountID, "transactionID", req.TransactionID, "tags", len(tags))
+	return response, nil
+}
+
+// CreateSavedFilter saves a named history filter for an account
+func (uc *transactionTagUseCase) CreateSavedFilter(ctx context.Context, req dto.SavedFilterRequest) (*dto.SavedFilterResponse, error) {
+	accountID, err := vo.NewAccountIDFromString(req.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := uc.accountRepo.GetByID(ctx, accountID); err != nil {
+		return nil, err
+	}
+
+	count, err := uc.filterRepo.CountByAccountID(ctx, accountID)
+	if err != nil {
+		uc.logger.Error("Failed to count saved filters", "error", err, "accountID", req.AccountID)
+		return nil, err
+	}
+	if count >= entity.MaxSavedFiltersPerAccount {
+		return nil, errs.ErrSavedFilterLimitExceeded
+	}
+
+	filter, err := entity.NewSavedFilter(accountID, req.Name, req.Tags, entity.HistoryDirection(req.Direction), vo.TransactionType(req.TransactionType))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.filterRepo.Create(ctx, filter); err != nil {
+		uc.logger.Error("Failed to create saved filter", "error", err, "accountID", req.AccountID)
+		return nil, err
+	}
+
+	uc.logger.Info("Saved filter created", "filterID", filter.ID, "accountID", req.AccountID)
+	response := uc.mapper.ToResponse(filter)
+	return &response, nil
+}
+
+// GetSavedFilter retrieves a saved history filter of an account
+func (uc *transactionTagUseCase) GetSavedFilter(ctx context.Context, accountID, id string) (*dto.SavedFilterResponse, error) {
+	filter, err := accountSavedFilter(ctx, uc.filterRepo, accountID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	response := uc.mapper.ToResponse(filter)
+	return &response, nil
+}
+
+// ListSavedFilters retrieves the saved history filters of an account by name
+func (uc *transactionTagUseCase) ListSavedFilters(ctx context.Context, accountID string) (*dto.SavedFilterListResponse, error) {
+	parsedAccountID, err := vo.NewAccountIDFromString(accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	filters, err := uc.filterRepo.ListByAccountID(ctx, parsedAccountID)
+	if err != nil {
+		uc.logger.Error("Failed to list saved filters", "error", err, "accountID", accountID)
+		return nil, err
+	}
+
+	responses := make([]dto.SavedFilterResponse, len(filters))
+	for i, filter := range filters {
+		responses[i] = uc.mapper.ToResponse(filter)
+	}
+
+	return &dto.SavedFilterListResponse{Filters: responses}, nil
+}
+
+// DeleteSavedFilter removes a saved history filter of an account
+func (uc *transactionTagUseCase) DeleteSavedFilter(ctx context.Context, accountID, id string) error {
+	if _, err := accountSavedFilter(ctx, uc.filterRepo, accountID, id); err != nil {
+		return err
+	}
+
+	if err := uc.filterRepo.Delete(ctx, id); err != nil {
+		uc.logger.Error("Failed to delete saved filter", "error", err, "filterID", id)
+		return err
+	}
+
+	uc.logger.Info("Saved filter deleted", "filterID", id, "accountID", accountID)
+	return nil
+}
+
+// ownTransaction parses the IDs and checks that the transaction involves the account, hiding
+// transactions of other accounts
+func ownTransaction(ctx context.Context, transactionRepo repository.TransactionRepository, accountID, transactionID string) (vo.AccountID, vo.TransactionID, error) {
+	parsedAccountID, err := vo.NewAccountIDFromString(accountID)
+	if err != nil {
+		return vo.AccountID{}, vo.TransactionID{}, err
+	}
+
+	parsedTransactionID, err := vo.NewTransactionIDFromString(transactionID)
+	if err != nil {
+		return vo.AccountID{}, vo.TransactionID{}, err
+	}
+
+	transaction, err := transactionRepo.GetByID(ctx, parsedTransactionID)
+	if err != nil {
+		return vo.AccountID{}, vo.TransactionID{}, err
+	}
+
+	involved := (transaction.FromAccountID != nil && transaction.FromAccountID.String() == parsedAccountID.String()) ||
+		(transaction.ToAccountID != nil && transaction.ToAccountID.String() == parsedAccountID.String())
+	if !involved {
+		return vo.AccountID{}, vo.TransactionID{}, errs.ErrTransactionNotFound
+	}
+
+	return parsedAccountID, parsedTransactionID, nil
+}
+
+// accountSavedFilter retrieves a saved filter, hiding filters of other accounts
+func accountSavedFilter(ctx context.Context, filterRepo repository.SavedFilterRepository, accountID, id string) (*entity.SavedFilter, error) {
+	filter, err := filterRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if filter.AccountID.String() != accountID {
+		return nil, errs.ErrSavedFilterNotFound
+	}
+
+	return filter, nil
+}
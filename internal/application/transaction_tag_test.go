@@ -0,0 +1,167 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/application/dto"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type MockTransactionTagRepository struct {
+	mock.Mock
+}
+
+func (m *MockTransactionTagRepository) ReplaceTags(ctx context.Context, accountID vo.AccountID, transactionID vo.TransactionID, tags []*entity.TransactionTag) error {
+	args := m.Called(ctx, accountID, transactionID, tags)
+	return args.Error(0)
+}
+
+func (m *MockTransactionTagRepository) ListByTransactionIDs(ctx context.Context, accountID vo.AccountID, transactionIDs []vo.TransactionID) ([]*entity.TransactionTag, error) {
+	args := m.Called(ctx, accountID, transactionIDs)
+	return args.Get(0).([]*entity.TransactionTag), args.Error(1)
+}
+
+type MockSavedFilterRepository struct {
+	mock.Mock
+}
+
+func (m *MockSavedFilterRepository) Create(ctx context.Context, filter *entity.SavedFilter) error {
+	args := m.Called(ctx, filter)
+	return args.Error(0)
+}
+
+func (m *MockSavedFilterRepository) GetByID(ctx context.Context, id string) (*entity.SavedFilter, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.SavedFilter), args.Error(1)
+}
+
+func (m *MockSavedFilterRepository) Delete(ctx context.Context, id string) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockSavedFilterRepository) ListByAccountID(ctx context.Context, accountID vo.AccountID) ([]*entity.SavedFilter, error) {
+	args := m.Called(ctx, accountID)
+	return args.Get(0).([]*entity.SavedFilter), args.Error(1)
+}
+
+func (m *MockSavedFilterRepository) CountByAccountID(ctx context.Context, accountID vo.AccountID) (int64, error) {
+	args := m.Called(ctx, accountID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func TestTransactionTagUseCase_SetTransactionTags(t *testing.T) {
+	account := createTestAccount()
+	transaction, err := entity.NewDebitTransaction(account.ID, vo.NewMoneyFromFloat(10), "Card payment", "", time.Now())
+	require.NoError(t, err)
+
+	tests := []struct {
+		name          string
+		accountID     vo.AccountID
+		tags          []string
+		expectedTags  []string
+		expectedError error
+	}{
+		{name: "success", accountID: account.ID, tags: []string{"Food", " weekend", "food"}, expectedTags: []string{"food", "weekend"}},
+		{name: "clears", accountID: account.ID, tags: nil, expectedTags: []string{}},
+		{name: "fail_transaction_of_another_account", accountID: vo.NewAccountID(), tags: []string{"food"}, expectedError: errs.ErrTransactionNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockTagRepo := new(MockTransactionTagRepository)
+			mockTxnRepo := new(MockTransactionRepository)
+			mockLogger := new(MockLogger)
+			mockLogger.On("Info", mock.Anything, mock.Anything).Maybe()
+			mockTxnRepo.On("GetByID", mock.Anything, transaction.ID).Return(transaction, nil)
+			if tt.expectedError == nil {
+				mockTagRepo.On("ReplaceTags", mock.Anything, account.ID, transaction.ID, mock.MatchedBy(func(tags []*entity.TransactionTag) bool {
+					return len(tags) == len(tt.expectedTags)
+				})).Return(nil)
+			}
+
+			uc := NewTransactionTagUseCase(mockTagRepo, nil, nil, mockTxnRepo, mockLogger)
+			result, err := uc.SetTransactionTags(context.Background(), dto.SetTransactionTagsRequest{
+				AccountID:     tt.accountID.String(),
+				TransactionID: transaction.ID.String(),
+				Tags:          tt.tags,
+			})
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedTags, result.Tags)
+			mockTagRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestTransactionTagUseCase_CreateSavedFilter(t *testing.T) {
+	account := createTestAccount()
+
+	tests := []struct {
+		name          string
+		count         int64
+		expectedError error
+	}{
+		{name: "success", count: 0},
+		{name: "fail_limit_reached", count: entity.MaxSavedFiltersPerAccount, expectedError: errs.ErrSavedFilterLimitExceeded},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockFilterRepo := new(MockSavedFilterRepository)
+			mockAccountRepo := new(MockAccountRepository)
+			mockLogger := new(MockLogger)
+			mockLogger.On("Info", mock.Anything, mock.Anything).Maybe()
+			mockAccountRepo.On("GetByID", mock.Anything, account.ID).Return(account, nil)
+			mockFilterRepo.On("CountByAccountID", mock.Anything, account.ID).Return(tt.count, nil)
+			mockFilterRepo.On("Create", mock.Anything, mock.Anything).Return(nil).Maybe()
+
+			uc := NewTransactionTagUseCase(nil, mockFilterRepo, mockAccountRepo, nil, mockLogger)
+			result, err := uc.CreateSavedFilter(context.Background(), dto.SavedFilterRequest{
+				AccountID: account.ID.String(),
+				Name:      "Trips",
+				Tags:      []string{"Travel"},
+				Direction: "OUT",
+			})
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				mockFilterRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, []string{"travel"}, result.Tags)
+			assert.Equal(t, "OUT", result.Direction)
+		})
+	}
+}
+
+func TestTransactionTagUseCase_GetSavedFilter_OtherAccount(t *testing.T) {
+	filter, err := entity.NewSavedFilter(vo.NewAccountID(), "Trips", nil, "", "")
+	require.NoError(t, err)
+
+	mockFilterRepo := new(MockSavedFilterRepository)
+	mockFilterRepo.On("GetByID", mock.Anything, filter.ID).Return(filter, nil)
+
+	uc := NewTransactionTagUseCase(nil, mockFilterRepo, nil, nil, new(MockLogger))
+	_, err = uc.GetSavedFilter(context.Background(), vo.NewAccountID().String(), filter.ID)
+	assert.ErrorIs(t, err, errs.ErrSavedFilterNotFound)
+
+	err = uc.DeleteSavedFilter(context.Background(), vo.NewAccountID().String(), filter.ID)
+	assert.ErrorIs(t, err, errs.ErrSavedFilterNotFound)
+	mockFilterRepo.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+}
@@ -3,18 +3,49 @@ package usecase
 import (
 	"context"
 	"errors"
+	"fmt"
 	"testing"
 	"time"
 
 	"github.com/hydr0g3nz/mini_bank/internal/application/dto"
 	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/event"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/infra"
 	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/repository"
 	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 )
 
+// StubUnitOfWork runs units of work directly, in a context marked as inside one, and records the
+// outcome of each
+type StubUnitOfWork struct {
+	Outcomes []error
+}
+
+func (u *StubUnitOfWork) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	err := fn(repository.WithUnitOfWork(ctx, u))
+	u.Outcomes = append(u.Outcomes, err)
+	return err
+}
+
+// MockLockService is a mock implementation of LockService
+type MockLockService struct {
+	mock.Mock
+}
+
+func (m *MockLockService) Acquire(ctx context.Context, key string, ttl time.Duration) (int64, bool, error) {
+	args := m.Called(ctx, key, ttl)
+	return args.Get(0).(int64), args.Bool(1), args.Error(2)
+}
+
+func (m *MockLockService) Release(ctx context.Context, key string, token int64) error {
+	args := m.Called(ctx, key, token)
+	return args.Error(0)
+}
+
 // Mock Transaction Repository
 type MockTransactionRepository struct {
 	mock.Mock
@@ -33,11 +64,24 @@ func (m *MockTransactionRepository) GetByID(ctx context.Context, id vo.Transacti
 	return args.Get(0).(*entity.Transaction), args.Error(1)
 }
 
+func (m *MockTransactionRepository) GetByIDs(ctx context.Context, ids []vo.TransactionID) (map[vo.TransactionID]*entity.Transaction, error) {
+	args := m.Called(ctx, ids)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[vo.TransactionID]*entity.Transaction), args.Error(1)
+}
+
 func (m *MockTransactionRepository) Update(ctx context.Context, transaction *entity.Transaction) error {
 	args := m.Called(ctx, transaction)
 	return args.Error(0)
 }
 
+func (m *MockTransactionRepository) ClaimProcessing(ctx context.Context, transaction *entity.Transaction, token int64) (bool, error) {
+	args := m.Called(ctx, transaction, token)
+	return args.Bool(0), args.Error(1)
+}
+
 func (m *MockTransactionRepository) List(ctx context.Context, limit, offset int) ([]*entity.Transaction, error) {
 	args := m.Called(ctx, limit, offset)
 	if args.Get(0) == nil {
@@ -46,6 +90,11 @@ func (m *MockTransactionRepository) List(ctx context.Context, limit, offset int)
 	return args.Get(0).([]*entity.Transaction), args.Error(1)
 }
 
+func (m *MockTransactionRepository) Count(ctx context.Context) (int64, error) {
+	args := m.Called(ctx)
+	return args.Get(0).(int64), args.Error(1)
+}
+
 func (m *MockTransactionRepository) GetByAccountID(ctx context.Context, accountID vo.AccountID, limit, offset int) ([]*entity.Transaction, error) {
 	args := m.Called(ctx, accountID, limit, offset)
 	if args.Get(0) == nil {
@@ -54,6 +103,21 @@ func (m *MockTransactionRepository) GetByAccountID(ctx context.Context, accountI
 	return args.Get(0).([]*entity.Transaction), args.Error(1)
 }
 
+func (m *MockTransactionRepository) CountByAccountID(ctx context.Context, accountID vo.AccountID) (int64, error) {
+	args := m.Called(ctx, accountID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockTransactionRepository) GetByVirtualAccountID(ctx context.Context, virtualAccountID string, limit, offset int) ([]*entity.Transaction, error) {
+	args := m.Called(ctx, virtualAccountID, limit, offset)
+	return args.Get(0).([]*entity.Transaction), args.Error(1)
+}
+
+func (m *MockTransactionRepository) CountByVirtualAccountID(ctx context.Context, virtualAccountID string) (int64, error) {
+	args := m.Called(ctx, virtualAccountID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
 func (m *MockTransactionRepository) GetByStatus(ctx context.Context, status vo.TransactionStatus, limit, offset int) ([]*entity.Transaction, error) {
 	args := m.Called(ctx, status, limit, offset)
 	if args.Get(0) == nil {
@@ -62,13 +126,108 @@ func (m *MockTransactionRepository) GetByStatus(ctx context.Context, status vo.T
 	return args.Get(0).([]*entity.Transaction), args.Error(1)
 }
 
+func (m *MockTransactionRepository) CountByStatus(ctx context.Context, status vo.TransactionStatus) (int64, error) {
+	args := m.Called(ctx, status)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockTransactionRepository) GetFailedByKind(ctx context.Context, kind vo.FailureKind, limit, offset int) ([]*entity.Transaction, error) {
+	args := m.Called(ctx, kind, limit, offset)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.Transaction), args.Error(1)
+}
+
+func (m *MockTransactionRepository) CountFailedByKind(ctx context.Context, kind vo.FailureKind) (int64, error) {
+	args := m.Called(ctx, kind)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockTransactionRepository) GetByChannel(ctx context.Context, channel vo.TransactionChannel, limit, offset int) ([]*entity.Transaction, error) {
+	args := m.Called(ctx, channel, limit, offset)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.Transaction), args.Error(1)
+}
+
+func (m *MockTransactionRepository) CountByChannel(ctx context.Context, channel vo.TransactionChannel) (int64, error) {
+	args := m.Called(ctx, channel)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockTransactionRepository) GetOverdueReviews(ctx context.Context, before time.Time, limit int) ([]*entity.Transaction, error) {
+	args := m.Called(ctx, before, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.Transaction), args.Error(1)
+}
+
+func (m *MockTransactionRepository) GetCompletedByAccountIDSince(ctx context.Context, accountID vo.AccountID, since time.Time) ([]*entity.Transaction, error) {
+	args := m.Called(ctx, accountID, since)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.Transaction), args.Error(1)
+}
+
+func (m *MockTransactionRepository) GetCompletedByAccountIDBetween(ctx context.Context, accountID vo.AccountID, from, until time.Time) ([]*entity.Transaction, error) {
+	args := m.Called(ctx, accountID, from, until)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.Transaction), args.Error(1)
+}
+
+func (m *MockTransactionRepository) SumBalanceEffectSince(ctx context.Context, accountID vo.AccountID, since time.Time) (vo.Money, error) {
+	args := m.Called(ctx, accountID, since)
+	return args.Get(0).(vo.Money), args.Error(1)
+}
+
+func (m *MockTransactionRepository) GetCompletedAdjustments(ctx context.Context, from, until time.Time) ([]*entity.Transaction, error) {
+	args := m.Called(ctx, from, until)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.Transaction), args.Error(1)
+}
+
+func (m *MockTransactionRepository) GetByReversedTransactionID(ctx context.Context, id vo.TransactionID) ([]*entity.Transaction, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.Transaction), args.Error(1)
+}
+
+func (m *MockTransactionRepository) GetLastSequence(ctx context.Context, accountID vo.AccountID) (int64, error) {
+	args := m.Called(ctx, accountID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockTransactionRepository) GetChangedByAccountIDSince(ctx context.Context, accountID vo.AccountID, sinceChange int64, limit int) ([]*entity.Transaction, error) {
+	args := m.Called(ctx, accountID, sinceChange, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.Transaction), args.Error(1)
+}
+
 // Test Suite
 type TransactionUseCaseTestSuite struct {
 	suite.Suite
 	usecase         TransactionUseCase
 	mockTxnRepo     *MockTransactionRepository
 	mockAccountRepo *MockAccountRepository
+	mockSagaRepo    *MockSagaRepository
+	mockVirtualRepo *MockVirtualAccountRepository
+	mockCategories  *MockCategoryRepository
+	mockOverrides   *MockCategoryOverrideRepository
 	mockCache       *MockCacheService
+	mockLocks       *MockLockService
+	mockEvents      *StubEventPublisher
 	mockLogger      *MockLogger
 	ctx             context.Context
 	testAccount     *entity.Account
@@ -78,7 +237,13 @@ type TransactionUseCaseTestSuite struct {
 func (suite *TransactionUseCaseTestSuite) SetupTest() {
 	suite.mockTxnRepo = new(MockTransactionRepository)
 	suite.mockAccountRepo = new(MockAccountRepository)
+	suite.mockSagaRepo = new(MockSagaRepository)
+	suite.mockVirtualRepo = new(MockVirtualAccountRepository)
+	suite.mockCategories = new(MockCategoryRepository)
+	suite.mockOverrides = new(MockCategoryOverrideRepository)
 	suite.mockCache = new(MockCacheService)
+	suite.mockLocks = new(MockLockService)
+	suite.mockEvents = &StubEventPublisher{}
 	suite.mockLogger = new(MockLogger)
 	suite.ctx = context.Background()
 
@@ -88,7 +253,11 @@ func (suite *TransactionUseCaseTestSuite) SetupTest() {
 	suite.mockLogger.On("Error", mock.Anything, mock.Anything).Maybe()
 	suite.mockLogger.On("Warn", mock.Anything, mock.Anything).Maybe()
 
-	suite.usecase = NewTransactionUseCase(suite.mockTxnRepo, suite.mockAccountRepo, suite.mockCache, suite.mockLogger).(*transactionUseCase)
+	// No categorization rules or overrides unless a test sets them up
+	suite.mockCategories.On("ListRules", mock.Anything).Return([]*entity.CategoryRule{}, nil).Maybe()
+	suite.mockOverrides.On("ListByTransactionIDs", mock.Anything, mock.Anything, mock.Anything).Return([]*entity.CategoryOverride{}, nil).Maybe()
+
+	suite.usecase = NewTransactionUseCase(suite.mockTxnRepo, suite.mockAccountRepo, suite.mockVirtualRepo, suite.mockSagaRepo, nil, suite.mockCache, suite.mockLocks, nil, suite.mockEvents, nil, NewValueDatingPolicy(&StubCalendar{}, vo.ProcessingWindow{}, nil), nil, NewCategorizer(suite.mockCategories, suite.mockOverrides, suite.mockLogger), nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, "THB", suite.mockLogger).(*transactionUseCase)
 
 	// Create test account
 	var err error
@@ -101,6 +270,7 @@ func (suite *TransactionUseCaseTestSuite) SetupTest() {
 		vo.NewMoneyFromFloat(100.0),
 		"Test debit",
 		"TEST-REF",
+		time.Now(),
 	)
 	suite.Require().NoError(err)
 }
@@ -110,7 +280,7 @@ func (suite *TransactionUseCaseTestSuite) TestCreateTransaction_Debit_Success()
 	req := dto.CreateTransactionRequest{
 		FromAccountID:   &fromAccountID,
 		TransactionType: "DEBIT",
-		Amount:          100.0,
+		Amount:          dto.NewDecimalStringFromFloat(100.0),
 		Description:     "Test debit",
 		Reference:       "TEST-REF",
 	}
@@ -129,12 +299,63 @@ func (suite *TransactionUseCaseTestSuite) TestCreateTransaction_Debit_Success()
 	suite.mockAccountRepo.AssertExpectations(suite.T())
 }
 
+func (suite *TransactionUseCaseTestSuite) TestCreateTransaction_NormalizesDescription() {
+	normalizers, err := vo.NewTextNormalizers(100, nil, []string{"darn"}, []string{"ATM:12:EMOJI+PROFANITY"})
+	suite.Require().NoError(err)
+	suite.usecase.(*transactionUseCase).mapper = &dto.TransactionMapper{Normalizers: normalizers}
+
+	fromAccountID := suite.testAccount.ID.String()
+	req := dto.CreateTransactionRequest{
+		FromAccountID:   &fromAccountID,
+		TransactionType: "DEBIT",
+		Amount:          dto.NewDecimalStringFromFloat(100.0),
+		Description:     "  darn\tATM 🏧 cash withdrawal ",
+		Reference:       " ATM-\x07042 ",
+		Channel:         "ATM",
+	}
+
+	suite.mockAccountRepo.On("GetByID", suite.ctx, suite.testAccount.ID).Return(suite.testAccount, nil)
+	suite.mockTxnRepo.On("Create", suite.ctx, mock.AnythingOfType("*entity.Transaction")).Return(nil)
+	suite.mockCache.On("Set", suite.ctx, mock.AnythingOfType("string"), mock.Anything, 30*time.Minute).Return(nil)
+
+	result, err := suite.usecase.CreateTransaction(suite.ctx, req)
+
+	suite.Require().NoError(err)
+	assert.Equal(suite.T(), "**** ATM cas", result.Description)
+	assert.Equal(suite.T(), "ATM-042", result.Reference)
+}
+
+func (suite *TransactionUseCaseTestSuite) TestCreateTransaction_StructuredReference() {
+	toAccountID := suite.testAccount.ID.String()
+	req := dto.CreateTransactionRequest{
+		ToAccountID:     &toAccountID,
+		TransactionType: "CREDIT",
+		Amount:          dto.NewDecimalStringFromFloat(100.0),
+		Reference:       "RF18 5390 0754 7034",
+		ReferenceType:   "RF",
+	}
+
+	suite.mockAccountRepo.On("GetByID", suite.ctx, suite.testAccount.ID).Return(suite.testAccount, nil)
+	suite.mockTxnRepo.On("Create", suite.ctx, mock.AnythingOfType("*entity.Transaction")).Return(nil)
+	suite.mockCache.On("Set", suite.ctx, mock.AnythingOfType("string"), mock.Anything, 30*time.Minute).Return(nil)
+
+	result, err := suite.usecase.CreateTransaction(suite.ctx, req)
+
+	suite.Require().NoError(err)
+	assert.Equal(suite.T(), "RF18539007547034", result.Reference)
+	assert.Equal(suite.T(), "RF", result.ReferenceType)
+
+	req.Reference = "RF19 5390 0754 7034"
+	_, err = suite.usecase.CreateTransaction(suite.ctx, req)
+	assert.ErrorIs(suite.T(), err, errs.ErrInvalidReference)
+}
+
 func (suite *TransactionUseCaseTestSuite) TestCreateTransaction_Credit_Success() {
 	toAccountID := suite.testAccount.ID.String()
 	req := dto.CreateTransactionRequest{
 		ToAccountID:     &toAccountID,
 		TransactionType: "CREDIT",
-		Amount:          100.0,
+		Amount:          dto.NewDecimalStringFromFloat(100.0),
 		Description:     "Test credit",
 		Reference:       "TEST-REF",
 	}
@@ -163,13 +384,13 @@ func (suite *TransactionUseCaseTestSuite) TestCreateTransaction_Transfer_Success
 		FromAccountID:   &fromAccountID,
 		ToAccountID:     &toAccountID,
 		TransactionType: "TRANSFER",
-		Amount:          100.0,
+		Amount:          dto.NewDecimalStringFromFloat(100.0),
 		Description:     "Test transfer",
 		Reference:       "TEST-REF",
 	}
 
-	suite.mockAccountRepo.On("GetByID", suite.ctx, suite.testAccount.ID).Return(suite.testAccount, nil)
-	suite.mockAccountRepo.On("GetByID", suite.ctx, toAccount.ID).Return(toAccount, nil)
+	suite.mockAccountRepo.On("GetByIDs", suite.ctx, []vo.AccountID{suite.testAccount.ID, toAccount.ID}).
+		Return(map[vo.AccountID]*entity.Account{suite.testAccount.ID: suite.testAccount, toAccount.ID: toAccount}, nil)
 	suite.mockTxnRepo.On("Create", suite.ctx, mock.AnythingOfType("*entity.Transaction")).Return(nil)
 	suite.mockCache.On("Set", suite.ctx, mock.AnythingOfType("string"), mock.Anything, 30*time.Minute).Return(nil)
 
@@ -183,12 +404,97 @@ func (suite *TransactionUseCaseTestSuite) TestCreateTransaction_Transfer_Success
 	suite.mockAccountRepo.AssertExpectations(suite.T())
 }
 
+func (suite *TransactionUseCaseTestSuite) TestCreateTransaction_Currency() {
+	suite.testAccount.Balance = suite.testAccount.Balance.In("THB")
+	dollarAccount, _ := entity.NewAccount("Dollar Account", vo.NewMoneyFromFloat(500.0).In("USD"))
+
+	fromAccountID := suite.testAccount.ID.String()
+	toAccountID := dollarAccount.ID.String()
+	req := dto.CreateTransactionRequest{
+		FromAccountID:   &fromAccountID,
+		TransactionType: "DEBIT",
+		Amount:          dto.NewDecimalStringFromFloat(100.0),
+		Currency:        "USD",
+		Description:     "Test debit",
+	}
+
+	suite.mockAccountRepo.On("GetByID", suite.ctx, suite.testAccount.ID).Return(suite.testAccount, nil)
+	suite.mockAccountRepo.On("GetByIDs", suite.ctx, []vo.AccountID{suite.testAccount.ID, dollarAccount.ID}).
+		Return(map[vo.AccountID]*entity.Account{suite.testAccount.ID: suite.testAccount, dollarAccount.ID: dollarAccount}, nil)
+	suite.mockTxnRepo.On("Create", suite.ctx, mock.AnythingOfType("*entity.Transaction")).Return(nil)
+	suite.mockCache.On("Set", suite.ctx, mock.AnythingOfType("string"), mock.Anything, 30*time.Minute).Return(nil)
+
+	// The amount must be in the account's currency
+	_, err := suite.usecase.CreateTransaction(suite.ctx, req)
+	assert.ErrorIs(suite.T(), err, errs.ErrCurrencyMismatch)
+
+	// A transfer cannot move money between currencies
+	req.TransactionType = "TRANSFER"
+	req.ToAccountID = &toAccountID
+	req.Currency = ""
+	_, err = suite.usecase.CreateTransaction(suite.ctx, req)
+	assert.ErrorIs(suite.T(), err, errs.ErrCurrencyMismatch)
+	suite.mockTxnRepo.AssertNotCalled(suite.T(), "Create", mock.Anything, mock.Anything)
+
+	// Without a currency the amount is taken in the account's
+	req.TransactionType = "DEBIT"
+	req.ToAccountID = nil
+	result, err := suite.usecase.CreateTransaction(suite.ctx, req)
+	suite.Require().NoError(err)
+	assert.Equal(suite.T(), "THB", result.Currency)
+}
+
+func (suite *TransactionUseCaseTestSuite) TestCreateTransaction_FXTransfer() {
+	dollarAccount, _ := entity.NewAccount("Dollar Account", vo.NewMoneyFromFloat(500.0).In("USD"))
+	bahtAccount, _ := entity.NewAccount("Baht Account", vo.NewMoneyFromFloat(500.0).In("THB"))
+	otherDollarAccount, _ := entity.NewAccount("Other Dollar Account", vo.NewMoneyFromFloat(500.0).In("USD"))
+
+	fromAccountID := dollarAccount.ID.String()
+	toAccountID := bahtAccount.ID.String()
+	req := dto.CreateTransactionRequest{
+		FromAccountID:   &fromAccountID,
+		ToAccountID:     &toAccountID,
+		TransactionType: "FX_TRANSFER",
+		Amount:          dto.NewDecimalStringFromFloat(100.0),
+		Description:     "Tuition",
+	}
+
+	suite.mockAccountRepo.On("GetByIDs", suite.ctx, []vo.AccountID{dollarAccount.ID, bahtAccount.ID}).
+		Return(map[vo.AccountID]*entity.Account{dollarAccount.ID: dollarAccount, bahtAccount.ID: bahtAccount}, nil)
+	suite.mockAccountRepo.On("GetByIDs", suite.ctx, []vo.AccountID{dollarAccount.ID, otherDollarAccount.ID}).
+		Return(map[vo.AccountID]*entity.Account{dollarAccount.ID: dollarAccount, otherDollarAccount.ID: otherDollarAccount}, nil)
+	suite.mockTxnRepo.On("Create", suite.ctx, mock.AnythingOfType("*entity.Transaction")).Return(nil)
+	suite.mockCache.On("Set", suite.ctx, mock.AnythingOfType("string"), mock.Anything, 30*time.Minute).Return(nil)
+
+	// Without a rate for the pair the transfer cannot be converted
+	_, err := suite.usecase.CreateTransaction(suite.ctx, req)
+	assert.ErrorIs(suite.T(), err, errs.ErrExchangeRateUnavailable)
+	suite.mockTxnRepo.AssertNotCalled(suite.T(), "Create", mock.Anything, mock.Anything)
+
+	suite.usecase.(*transactionUseCase).exchangeRates = &StubExchangeRateService{Rates: []vo.ExchangeRate{usdToTHB}}
+	result, err := suite.usecase.CreateTransaction(suite.ctx, req)
+	suite.Require().NoError(err)
+	assert.Equal(suite.T(), "FX_TRANSFER", result.TransactionType)
+	assert.Equal(suite.T(), 100.0, result.Amount)
+	assert.Equal(suite.T(), "USD", result.Currency)
+	assert.Equal(suite.T(), 35.5, result.ExchangeRate)
+	assert.Equal(suite.T(), usdToTHB.AsOf, *result.ExchangeRateAsOf)
+	assert.Equal(suite.T(), 3550.0, result.ConvertedAmount)
+	assert.Equal(suite.T(), "THB", result.ConvertedCurrency)
+
+	// Accounts held in one currency transfer with TRANSFER
+	otherAccountID := otherDollarAccount.ID.String()
+	req.ToAccountID = &otherAccountID
+	_, err = suite.usecase.CreateTransaction(suite.ctx, req)
+	assert.ErrorIs(suite.T(), err, errs.ErrSameCurrencyFX)
+}
+
 func (suite *TransactionUseCaseTestSuite) TestCreateTransaction_AccountNotFound() {
 	fromAccountID := suite.testAccount.ID.String()
 	req := dto.CreateTransactionRequest{
 		FromAccountID:   &fromAccountID,
 		TransactionType: "DEBIT",
-		Amount:          100.0,
+		Amount:          dto.NewDecimalStringFromFloat(100.0),
 		Description:     "Test debit",
 		Reference:       "TEST-REF",
 	}
@@ -203,6 +509,97 @@ func (suite *TransactionUseCaseTestSuite) TestCreateTransaction_AccountNotFound(
 	suite.mockAccountRepo.AssertExpectations(suite.T())
 }
 
+func (suite *TransactionUseCaseTestSuite) TestSimulateTransfer_Success() {
+	toAccount, _ := entity.NewAccount("To Account", vo.NewMoneyFromFloat(500.0))
+	req := dto.SimulateTransferRequest{
+		FromAccountID: suite.testAccount.ID.String(),
+		ToAccountID:   toAccount.ID.String(),
+		Amount:        dto.NewDecimalStringFromFloat(250.0),
+		Description:   "Rent",
+	}
+
+	suite.mockAccountRepo.On("GetByIDs", suite.ctx, []vo.AccountID{suite.testAccount.ID, toAccount.ID}).
+		Return(map[vo.AccountID]*entity.Account{suite.testAccount.ID: suite.testAccount, toAccount.ID: toAccount}, nil)
+
+	result, err := suite.usecase.SimulateTransfer(suite.ctx, req)
+
+	suite.Require().NoError(err)
+	assert.True(suite.T(), result.Valid)
+	assert.Empty(suite.T(), result.Problems)
+	assert.Equal(suite.T(), 250.0, result.TotalDebit)
+	assert.Equal(suite.T(), 1.0, result.ExchangeRate)
+	assert.Equal(suite.T(), "THB", result.Currency)
+	assert.Equal(suite.T(), 1000.0, result.FromAccount.Balance)
+	assert.Equal(suite.T(), 750.0, result.FromAccount.ProjectedBalance)
+	assert.Equal(suite.T(), 750.0, result.ToAccount.ProjectedBalance)
+	assert.NotEmpty(suite.T(), result.ValueDate)
+	suite.mockAccountRepo.AssertNotCalled(suite.T(), "Update", mock.Anything, mock.Anything)
+	suite.mockTxnRepo.AssertNotCalled(suite.T(), "Create", mock.Anything, mock.Anything)
+	assert.Empty(suite.T(), suite.mockEvents.Events)
+}
+
+func (suite *TransactionUseCaseTestSuite) TestSimulateTransfer_ReportsProblems() {
+	missing := vo.NewAccountID()
+	suite.Require().NoError(suite.testAccount.Suspend())
+	req := dto.SimulateTransferRequest{
+		FromAccountID: suite.testAccount.ID.String(),
+		ToAccountID:   missing.String(),
+		Amount:        dto.NewDecimalStringFromFloat(5000.0),
+	}
+
+	suite.mockAccountRepo.On("GetByIDs", suite.ctx, []vo.AccountID{suite.testAccount.ID, missing}).
+		Return(map[vo.AccountID]*entity.Account{suite.testAccount.ID: suite.testAccount}, nil)
+
+	result, err := suite.usecase.SimulateTransfer(suite.ctx, req)
+
+	suite.Require().NoError(err)
+	assert.False(suite.T(), result.Valid)
+	suite.Require().Len(result.Problems, 3)
+	assert.ErrorIs(suite.T(), result.Problems[0], errs.ErrAccountCannotTransact)
+	assert.ErrorIs(suite.T(), result.Problems[1], errs.ErrInsufficientBalance)
+	assert.ErrorIs(suite.T(), result.Problems[2], errs.ErrAccountNotFound)
+	assert.Equal(suite.T(), 1000.0, result.FromAccount.ProjectedBalance)
+	assert.Nil(suite.T(), result.ToAccount)
+}
+
+func (suite *TransactionUseCaseTestSuite) TestSimulateTransfer_CrossCurrency() {
+	dollarAccount, _ := entity.NewAccount("Dollar Account", vo.NewMoneyFromFloat(500.0).In("USD"))
+	bahtAccount, _ := entity.NewAccount("Baht Account", vo.NewMoneyFromFloat(500.0).In("THB"))
+	req := dto.SimulateTransferRequest{
+		FromAccountID: dollarAccount.ID.String(),
+		ToAccountID:   bahtAccount.ID.String(),
+		Amount:        dto.NewDecimalStringFromFloat(100.0),
+		Description:   "Tuition",
+	}
+
+	suite.mockAccountRepo.On("GetByIDs", suite.ctx, []vo.AccountID{dollarAccount.ID, bahtAccount.ID}).
+		Return(map[vo.AccountID]*entity.Account{dollarAccount.ID: dollarAccount, bahtAccount.ID: bahtAccount}, nil)
+
+	suite.usecase.(*transactionUseCase).exchangeRates = &StubExchangeRateService{Rates: []vo.ExchangeRate{usdToTHB}}
+	result, err := suite.usecase.SimulateTransfer(suite.ctx, req)
+
+	suite.Require().NoError(err)
+	assert.True(suite.T(), result.Valid)
+	assert.Empty(suite.T(), result.Problems)
+	assert.Equal(suite.T(), "USD", result.Currency)
+	assert.Equal(suite.T(), 100.0, result.TotalDebit)
+	assert.Equal(suite.T(), 35.5, result.ExchangeRate)
+	assert.Equal(suite.T(), 3550.0, result.CreditedAmount)
+	assert.Equal(suite.T(), 400.0, result.FromAccount.ProjectedBalance)
+	assert.Equal(suite.T(), 4050.0, result.ToAccount.ProjectedBalance)
+	suite.mockTxnRepo.AssertNotCalled(suite.T(), "Create", mock.Anything, mock.Anything)
+
+	// Without a rate for the pair nothing can be credited
+	suite.usecase.(*transactionUseCase).exchangeRates = nil
+	result, err = suite.usecase.SimulateTransfer(suite.ctx, req)
+
+	suite.Require().NoError(err)
+	assert.False(suite.T(), result.Valid)
+	suite.Require().Len(result.Problems, 1)
+	assert.ErrorIs(suite.T(), result.Problems[0], errs.ErrExchangeRateUnavailable)
+	assert.Equal(suite.T(), result.ToAccount.Balance, result.ToAccount.ProjectedBalance)
+}
+
 func (suite *TransactionUseCaseTestSuite) TestConfirmTransaction_Success() {
 	req := dto.ConfirmTransactionRequest{
 		ID: suite.testTransaction.ID.String(),
@@ -210,19 +607,20 @@ func (suite *TransactionUseCaseTestSuite) TestConfirmTransaction_Success() {
 
 	// Mock cache miss for idempotency check
 	idempotencyKey := "confirm_transaction:" + req.ID
-	suite.mockCache.On("Get", suite.ctx, idempotencyKey, mock.Anything).Return(errors.New("cache miss"))
+	suite.mockCache.On("Get", suite.ctx, idempotencyKey, mock.Anything).Return(infra.ErrCacheMiss)
 
 	// Mock lock acquisition
 	lockKey := "lock:transaction:" + req.ID
-	suite.mockCache.On("Set", suite.ctx, lockKey, mock.Anything, 30*time.Second).Return(nil)
-	suite.mockCache.On("Delete", suite.ctx, lockKey).Return(nil)
+	suite.mockLocks.On("Acquire", suite.ctx, lockKey, 30*time.Second).Return(int64(1), true, nil)
+	suite.mockLocks.On("Release", suite.ctx, lockKey, int64(1)).Return(nil)
 
-	// Mock transaction retrieval
+	// Mock transaction retrieval and claim
 	suite.mockTxnRepo.On("GetByID", suite.ctx, suite.testTransaction.ID).Return(suite.testTransaction, nil)
+	suite.expectClaim(true)
 
-	// Mock account operations for debit transaction
-	suite.mockAccountRepo.On("GetByID", suite.ctx, *suite.testTransaction.FromAccountID).Return(suite.testAccount, nil)
-	suite.mockAccountRepo.On("Update", suite.ctx, mock.AnythingOfType("*entity.Account")).Return(nil)
+	// Mock account operations for debit transaction, fenced by the claim
+	suite.mockAccountRepo.On("GetByIDForUpdate", suite.ctx, *suite.testTransaction.FromAccountID).Return(suite.testAccount, nil)
+	suite.mockAccountRepo.On("UpdateFenced", suite.ctx, mock.AnythingOfType("*entity.Account"), suite.testTransaction.ID, mock.AnythingOfType("int64")).Return(nil)
 
 	// Mock transaction update
 	suite.mockTxnRepo.On("Update", suite.ctx, mock.AnythingOfType("*entity.Transaction")).Return(nil)
@@ -237,10 +635,38 @@ func (suite *TransactionUseCaseTestSuite) TestConfirmTransaction_Success() {
 	assert.NoError(suite.T(), err)
 	assert.NotNil(suite.T(), result)
 	assert.Equal(suite.T(), "COMPLETED", result.Status)
+	suite.Require().Len(suite.mockEvents.Events, 1)
+	assert.Equal(suite.T(), event.TransactionCompleted, suite.mockEvents.Events[0].Type)
+	assert.Equal(suite.T(), suite.testAccount.ID.String(), suite.mockEvents.Events[0].Key)
 	suite.mockTxnRepo.AssertExpectations(suite.T())
 	suite.mockAccountRepo.AssertExpectations(suite.T())
 }
 
+func (suite *TransactionUseCaseTestSuite) TestConfirmTransaction_NotDue() {
+	// Queued past the cutoff for a later business day
+	suite.Require().NoError(suite.testTransaction.SetValueDate(time.Now().AddDate(0, 0, 3)))
+
+	req := dto.ConfirmTransactionRequest{
+		ID: suite.testTransaction.ID.String(),
+	}
+
+	idempotencyKey := "confirm_transaction:" + req.ID
+	suite.mockCache.On("Get", suite.ctx, idempotencyKey, mock.Anything).Return(infra.ErrCacheMiss)
+
+	lockKey := "lock:transaction:" + req.ID
+	suite.mockLocks.On("Acquire", suite.ctx, lockKey, 30*time.Second).Return(int64(1), true, nil)
+	suite.mockLocks.On("Release", suite.ctx, lockKey, int64(1)).Return(nil)
+
+	suite.mockTxnRepo.On("GetByID", suite.ctx, suite.testTransaction.ID).Return(suite.testTransaction, nil)
+
+	result, err := suite.usecase.ConfirmTransaction(suite.ctx, req)
+
+	assert.ErrorIs(suite.T(), err, errs.ErrTransactionNotDue)
+	assert.Nil(suite.T(), result)
+	assert.True(suite.T(), suite.testTransaction.Status.IsPending())
+	suite.mockAccountRepo.AssertNotCalled(suite.T(), "Update", mock.Anything, mock.Anything)
+}
+
 func (suite *TransactionUseCaseTestSuite) TestConfirmTransaction_AlreadyCompleted() {
 	// Create completed transaction
 	completedTxn, _ := entity.NewDebitTransaction(
@@ -248,8 +674,9 @@ func (suite *TransactionUseCaseTestSuite) TestConfirmTransaction_AlreadyComplete
 		vo.NewMoneyFromFloat(100.0),
 		"Test debit",
 		"TEST-REF",
+		time.Now(),
 	)
-	completedTxn.MarkAsCompleted()
+	completedTxn.MarkAsCompleted(time.Now())
 
 	req := dto.ConfirmTransactionRequest{
 		ID: completedTxn.ID.String(),
@@ -257,12 +684,12 @@ func (suite *TransactionUseCaseTestSuite) TestConfirmTransaction_AlreadyComplete
 
 	// Mock cache miss for idempotency check
 	idempotencyKey := "confirm_transaction:" + req.ID
-	suite.mockCache.On("Get", suite.ctx, idempotencyKey, mock.Anything).Return(errors.New("cache miss"))
+	suite.mockCache.On("Get", suite.ctx, idempotencyKey, mock.Anything).Return(infra.ErrCacheMiss)
 
 	// Mock lock acquisition
 	lockKey := "lock:transaction:" + req.ID
-	suite.mockCache.On("Set", suite.ctx, lockKey, mock.Anything, 30*time.Second).Return(nil)
-	suite.mockCache.On("Delete", suite.ctx, lockKey).Return(nil)
+	suite.mockLocks.On("Acquire", suite.ctx, lockKey, 30*time.Second).Return(int64(1), true, nil)
+	suite.mockLocks.On("Release", suite.ctx, lockKey, int64(1)).Return(nil)
 
 	// Mock transaction retrieval
 	suite.mockTxnRepo.On("GetByID", suite.ctx, completedTxn.ID).Return(completedTxn, nil)
@@ -285,12 +712,12 @@ func (suite *TransactionUseCaseTestSuite) TestConfirmTransaction_NotFound() {
 
 	// Mock cache miss for idempotency check
 	idempotencyKey := "confirm_transaction:" + req.ID
-	suite.mockCache.On("Get", suite.ctx, idempotencyKey, mock.Anything).Return(errors.New("cache miss"))
+	suite.mockCache.On("Get", suite.ctx, idempotencyKey, mock.Anything).Return(infra.ErrCacheMiss)
 
 	// Mock lock acquisition
 	lockKey := "lock:transaction:" + req.ID
-	suite.mockCache.On("Set", suite.ctx, lockKey, mock.Anything, 30*time.Second).Return(nil)
-	suite.mockCache.On("Delete", suite.ctx, lockKey).Return(nil)
+	suite.mockLocks.On("Acquire", suite.ctx, lockKey, 30*time.Second).Return(int64(1), true, nil)
+	suite.mockLocks.On("Release", suite.ctx, lockKey, int64(1)).Return(nil)
 
 	// Mock transaction not found
 	suite.mockTxnRepo.On("GetByID", suite.ctx, suite.testTransaction.ID).Return(nil, errs.ErrTransactionNotFound)
@@ -306,7 +733,7 @@ func (suite *TransactionUseCaseTestSuite) TestConfirmTransaction_NotFound() {
 func (suite *TransactionUseCaseTestSuite) TestGetTransaction_Success() {
 	transactionID := suite.testTransaction.ID.String()
 
-	suite.mockCache.On("Get", suite.ctx, "transaction:"+transactionID, mock.Anything).Return(errors.New("cache miss"))
+	suite.mockCache.On("Get", suite.ctx, "transaction:"+transactionID, mock.Anything).Return(infra.ErrCacheMiss)
 	suite.mockTxnRepo.On("GetByID", suite.ctx, suite.testTransaction.ID).Return(suite.testTransaction, nil)
 	suite.mockCache.On("Set", suite.ctx, "transaction:"+transactionID, mock.Anything, 30*time.Minute).Return(nil)
 
@@ -346,7 +773,7 @@ func (suite *TransactionUseCaseTestSuite) TestGetTransaction_FromCache() {
 func (suite *TransactionUseCaseTestSuite) TestGetTransaction_NotFound() {
 	transactionID := suite.testTransaction.ID.String()
 
-	suite.mockCache.On("Get", suite.ctx, "transaction:"+transactionID, mock.Anything).Return(errors.New("cache miss"))
+	suite.mockCache.On("Get", suite.ctx, "transaction:"+transactionID, mock.Anything).Return(infra.ErrCacheMiss)
 	suite.mockTxnRepo.On("GetByID", suite.ctx, suite.testTransaction.ID).Return(nil, errs.ErrTransactionNotFound)
 
 	result, err := suite.usecase.GetTransaction(suite.ctx, transactionID)
@@ -357,6 +784,49 @@ func (suite *TransactionUseCaseTestSuite) TestGetTransaction_NotFound() {
 	suite.mockTxnRepo.AssertExpectations(suite.T())
 }
 
+func (suite *TransactionUseCaseTestSuite) TestBatchGetTransactions_Success() {
+	loaded := suite.testTransaction
+	cached, err := entity.NewCreditTransaction(suite.testAccount.ID, vo.NewMoneyFromFloat(50.0), "Test credit", "", time.Now())
+	suite.Require().NoError(err)
+	unknown, err := entity.NewCreditTransaction(suite.testAccount.ID, vo.NewMoneyFromFloat(10.0), "Unknown", "", time.Now())
+	suite.Require().NoError(err)
+	keys := []string{"transaction:" + cached.ID.String(), "transaction:" + loaded.ID.String(), "transaction:" + unknown.ID.String()}
+
+	suite.mockCache.On("GetMany", suite.ctx, keys, mock.Anything).
+		Run(func(args mock.Arguments) {
+			*args.Get(2).([]interface{})[0].(*dto.TransactionResponse) = dto.TransactionResponse{ID: cached.ID.String(), Description: "Cached"}
+		}).
+		Return([]bool{true, false, false}, nil)
+	suite.mockTxnRepo.On("GetByIDs", suite.ctx, []vo.TransactionID{loaded.ID, unknown.ID}).
+		Return(map[vo.TransactionID]*entity.Transaction{loaded.ID: loaded}, nil)
+	suite.mockCache.On("Set", suite.ctx, "transaction:"+loaded.ID.String(), mock.Anything, 30*time.Minute).Return(nil)
+
+	// A repeated ID is looked up and reported once
+	result, err := suite.usecase.BatchGetTransactions(suite.ctx, dto.BatchGetRequest{
+		IDs: []string{cached.ID.String(), loaded.ID.String(), cached.ID.String(), unknown.ID.String()},
+	})
+
+	suite.Require().NoError(err)
+	suite.Require().Len(result.Found, 2)
+	assert.Equal(suite.T(), "Cached", result.Found[0].Description)
+	assert.Equal(suite.T(), loaded.ID.String(), result.Found[1].ID)
+	assert.Equal(suite.T(), []string{unknown.ID.String()}, result.Missing)
+	suite.mockTxnRepo.AssertExpectations(suite.T())
+	suite.mockCache.AssertExpectations(suite.T())
+}
+
+func (suite *TransactionUseCaseTestSuite) TestBatchGetTransactions_AllCached() {
+	id := suite.testTransaction.ID.String()
+	suite.mockCache.On("GetMany", suite.ctx, []string{"transaction:" + id}, mock.Anything).Return([]bool{true}, nil)
+
+	result, err := suite.usecase.BatchGetTransactions(suite.ctx, dto.BatchGetRequest{IDs: []string{id}})
+
+	suite.Require().NoError(err)
+	assert.Len(suite.T(), result.Found, 1)
+	assert.Empty(suite.T(), result.Missing)
+	suite.mockTxnRepo.AssertNotCalled(suite.T(), "GetByIDs", mock.Anything, mock.Anything)
+}
+
 func (suite *TransactionUseCaseTestSuite) TestListTransactions_Success() {
 	req := dto.ListRequest{
 		Page:     1,
@@ -366,8 +836,9 @@ func (suite *TransactionUseCaseTestSuite) TestListTransactions_Success() {
 	transactions := []*entity.Transaction{suite.testTransaction}
 	cacheKey := "transactions:list:page:1:size:10"
 
-	suite.mockCache.On("Get", suite.ctx, cacheKey, mock.Anything).Return(errors.New("cache miss"))
+	suite.mockCache.On("Get", suite.ctx, cacheKey, mock.Anything).Return(infra.ErrCacheMiss)
 	suite.mockTxnRepo.On("List", suite.ctx, 10, 0).Return(transactions, nil)
+	suite.mockTxnRepo.On("Count", suite.ctx).Return(int64(25), nil)
 	suite.mockCache.On("Set", suite.ctx, cacheKey, mock.Anything, 2*time.Minute).Return(nil)
 
 	result, err := suite.usecase.ListTransactions(suite.ctx, req)
@@ -376,6 +847,9 @@ func (suite *TransactionUseCaseTestSuite) TestListTransactions_Success() {
 	assert.NotNil(suite.T(), result)
 	assert.Len(suite.T(), result.Transactions, 1)
 	assert.Equal(suite.T(), 1, result.Pagination.Page)
+	assert.Equal(suite.T(), int64(25), result.Pagination.TotalItems)
+	assert.Equal(suite.T(), 3, result.Pagination.TotalPages)
+	assert.True(suite.T(), result.Pagination.HasNext)
 	suite.mockTxnRepo.AssertExpectations(suite.T())
 }
 
@@ -389,8 +863,9 @@ func (suite *TransactionUseCaseTestSuite) TestGetTransactionsByAccount_Success()
 	transactions := []*entity.Transaction{suite.testTransaction}
 	cacheKey := "transactions:account:" + accountID + ":page:1:size:10"
 
-	suite.mockCache.On("Get", suite.ctx, cacheKey, mock.Anything).Return(errors.New("cache miss"))
+	suite.mockCache.On("Get", suite.ctx, cacheKey, mock.Anything).Return(infra.ErrCacheMiss)
 	suite.mockTxnRepo.On("GetByAccountID", suite.ctx, suite.testAccount.ID, 10, 0).Return(transactions, nil)
+	suite.mockTxnRepo.On("CountByAccountID", suite.ctx, suite.testAccount.ID).Return(int64(1), nil)
 	suite.mockCache.On("Set", suite.ctx, cacheKey, mock.Anything, 5*time.Minute).Return(nil)
 
 	result, err := suite.usecase.GetTransactionsByAccount(suite.ctx, accountID, req)
@@ -401,6 +876,172 @@ func (suite *TransactionUseCaseTestSuite) TestGetTransactionsByAccount_Success()
 	suite.mockTxnRepo.AssertExpectations(suite.T())
 }
 
+func (suite *TransactionUseCaseTestSuite) TestSyncAccountTransactions_Success() {
+	suite.testTransaction.FromChange = 7
+	cancelled, err := entity.NewDebitTransaction(suite.testAccount.ID, vo.NewMoneyFromFloat(20), "Lunch", "", time.Now())
+	suite.Require().NoError(err)
+	suite.Require().NoError(cancelled.MarkAsCancelled())
+	cancelled.FromChange = 9
+
+	suite.mockAccountRepo.On("GetByID", suite.ctx, suite.testAccount.ID).Return(suite.testAccount, nil)
+	suite.mockTxnRepo.On("GetChangedByAccountIDSince", suite.ctx, suite.testAccount.ID, int64(5), 2).
+		Return([]*entity.Transaction{suite.testTransaction, cancelled}, nil)
+
+	result, err := suite.usecase.SyncAccountTransactions(suite.ctx, dto.TransactionSyncRequest{
+		AccountID:     suite.testAccount.ID.String(),
+		SinceSequence: 5,
+		Limit:         2,
+	})
+
+	suite.Require().NoError(err)
+	suite.Require().Len(result.Transactions, 1)
+	assert.Equal(suite.T(), suite.testTransaction.ID.String(), result.Transactions[0].ID)
+	assert.Equal(suite.T(), []dto.TransactionTombstone{{TransactionID: cancelled.ID.String(), Sequence: 9}}, result.Tombstones)
+	assert.Equal(suite.T(), int64(9), result.NextSequence)
+	assert.True(suite.T(), result.HasMore)
+}
+
+func (suite *TransactionUseCaseTestSuite) TestGetAccountActivity_Success() {
+	suite.testAccount.Balance = vo.NewMoneyFromFloat(2000)
+	completed := func(transaction *entity.Transaction, err error) func(at string) *entity.Transaction {
+		suite.Require().NoError(err)
+		return func(at string) *entity.Transaction {
+			completedAt, err := time.Parse(time.RFC3339, at)
+			suite.Require().NoError(err)
+			transaction.Status = vo.TransactionStatusCompleted
+			transaction.CompletedAt = &completedAt
+			return transaction
+		}
+	}
+
+	deposit := completed(entity.NewCreditTransaction(suite.testAccount.ID, vo.NewMoneyFromFloat(500), "Salary", "", time.Now()))("2024-03-01T10:00:00Z")
+	withdrawal, err := entity.NewDebitTransaction(suite.testAccount.ID, vo.NewMoneyFromFloat(100), "ATM", "", time.Now())
+	suite.Require().NoError(err)
+	suite.Require().NoError(withdrawal.SetFee(vo.NewMoneyFromFloat(5)))
+	withdrawal = completed(withdrawal, nil)("2024-03-01T15:00:00Z")
+	transfer := completed(entity.NewTransferTransaction(suite.testAccount.ID, vo.NewAccountID(), vo.NewMoneyFromFloat(200), "Rent", "", time.Now()))("2024-03-02T09:00:00Z")
+	later := completed(entity.NewCreditTransaction(suite.testAccount.ID, vo.NewMoneyFromFloat(50), "Refund", "", time.Now()))("2024-03-05T09:00:00Z")
+
+	from, _ := time.Parse(dateLayout, "2024-03-01")
+	suite.mockAccountRepo.On("GetByID", suite.ctx, suite.testAccount.ID).Return(suite.testAccount, nil)
+	suite.mockTxnRepo.On("GetCompletedByAccountIDSince", suite.ctx, suite.testAccount.ID, from).
+		Return([]*entity.Transaction{deposit, withdrawal, transfer, later}, nil)
+
+	result, err := suite.usecase.GetAccountActivity(suite.ctx, dto.AccountActivityRequest{
+		AccountID: suite.testAccount.ID.String(),
+		From:      "2024-03-01",
+		To:        "2024-03-02",
+	})
+
+	suite.Require().NoError(err)
+	// The refund after the range is rolled back but not listed
+	assert.Equal(suite.T(), 1755.0, result.OpeningBalance)
+	assert.Equal(suite.T(), 1950.0, result.ClosingBalance)
+	suite.Require().Len(result.Days, 2)
+
+	first := result.Days[0]
+	assert.Equal(suite.T(), "2024-03-01", first.Date)
+	assert.Equal(suite.T(), 1755.0, first.OpeningBalance)
+	assert.Equal(suite.T(), 500.0, first.Inflow)
+	assert.Equal(suite.T(), 105.0, first.Outflow)
+	assert.Equal(suite.T(), 395.0, first.Net)
+	assert.Equal(suite.T(), 2150.0, first.ClosingBalance)
+	suite.Require().Len(first.Items, 2)
+	assert.Equal(suite.T(), 2255.0, first.Items[0].BalanceAfter)
+	assert.Equal(suite.T(), -105.0, first.Items[1].Change)
+	assert.Equal(suite.T(), 2150.0, first.Items[1].BalanceAfter)
+
+	second := result.Days[1]
+	assert.Equal(suite.T(), "2024-03-02", second.Date)
+	assert.Equal(suite.T(), transfer.ID.String(), second.Items[0].ID)
+	assert.Equal(suite.T(), 1950.0, second.ClosingBalance)
+}
+
+func (suite *TransactionUseCaseTestSuite) TestGetAccountActivity_RangeTooLong() {
+	_, err := suite.usecase.GetAccountActivity(suite.ctx, dto.AccountActivityRequest{
+		AccountID: suite.testAccount.ID.String(),
+		From:      "2024-01-01",
+		To:        "2024-06-30",
+	})
+
+	assert.IsType(suite.T(), errs.ValidationError{}, err)
+	suite.mockTxnRepo.AssertNotCalled(suite.T(), "GetCompletedByAccountIDSince", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *TransactionUseCaseTestSuite) TestGetAccountProjection_Success() {
+	product := createTestProduct(suite.T())
+	suite.testAccount.ProductID = product.ID
+	mockProductRepo := new(MockProductRepository)
+	mockProductRepo.On("GetByID", suite.ctx, product.ID).Return(product, nil)
+	uc := suite.usecase.(*transactionUseCase)
+	uc.products = NewProductPolicy(suite.mockAccountRepo, mockProductRepo, suite.mockLogger)
+
+	newRule := func(kind entity.BusinessRuleKind, expression string) *entity.BusinessRule {
+		rule, err := entity.NewBusinessRule(expression, kind, expression, "", 0)
+		suite.Require().NoError(err)
+		return rule
+	}
+	mockRuleRepo := new(MockBusinessRuleRepository)
+	mockRuleRepo.On("ListEnabled", suite.ctx).Return([]*entity.BusinessRule{
+		newRule(entity.BusinessRuleKindValidation, "amount > 50000"),
+		newRule(entity.BusinessRuleKindFee, "2.0"),
+	}, nil)
+	engine := &StubRuleEngine{Results: map[string]interface{}{"amount > 50000": true, "2.0": 2.0}}
+	uc.rules = NewBusinessRules(mockRuleRepo, engine, "THB", suite.mockLogger)
+
+	other := vo.NewAccountID()
+	withdrawal, err := entity.NewDebitTransaction(suite.testAccount.ID, vo.NewMoneyFromFloat(100), "ATM", "", time.Now())
+	suite.Require().NoError(err)
+	rent, err := entity.NewTransferTransaction(suite.testAccount.ID, other, vo.NewMoneyFromFloat(200), "Rent", "", time.Now())
+	suite.Require().NoError(err)
+	incoming, err := entity.NewTransferTransaction(other, suite.testAccount.ID, vo.NewMoneyFromFloat(300), "Refund", "", time.Now())
+	suite.Require().NoError(err)
+	salary, err := entity.NewCreditTransaction(suite.testAccount.ID, vo.NewMoneyFromFloat(3000), "Salary", "", time.Now())
+	suite.Require().NoError(err)
+
+	suite.mockAccountRepo.On("GetByID", suite.ctx, suite.testAccount.ID).Return(suite.testAccount, nil)
+	suite.mockTxnRepo.On("GetCompletedByAccountIDSince", suite.ctx, suite.testAccount.ID, mock.Anything).
+		Return([]*entity.Transaction{withdrawal, rent, incoming, salary}, nil)
+
+	result, err := suite.usecase.GetAccountProjection(suite.ctx, dto.AccountProjectionRequest{
+		AccountID: suite.testAccount.ID.String(),
+	})
+
+	suite.Require().NoError(err)
+	assert.Equal(suite.T(), 30, result.Days)
+	assert.Equal(suite.T(), product.ID, result.ProductID)
+	assert.Equal(suite.T(), 1.25, result.InterestRate)
+	// 1000 at 1.25% for 30 days
+	assert.Equal(suite.T(), 1.03, result.ProjectedInterest)
+	// The validation rule is not evaluated; only the two outgoing transactions are charged
+	assert.Equal(suite.T(), 19.0, result.ExpectedFees)
+	assert.Equal(suite.T(), 982.03, result.ProjectedBalance)
+	suite.Require().Len(result.Fees, 2)
+	assert.Equal(suite.T(), dto.ProjectedFees{TransactionType: "DEBIT", Count: 1, RuleFees: 2, ProductFees: 5, Total: 7}, result.Fees[0])
+	assert.Equal(suite.T(), dto.ProjectedFees{TransactionType: "TRANSFER", Count: 1, RuleFees: 2, ProductFees: 10, Total: 12}, result.Fees[1])
+	suite.mockTxnRepo.AssertNotCalled(suite.T(), "Update", mock.Anything, mock.Anything)
+	assert.True(suite.T(), withdrawal.Fee.IsZero())
+}
+
+func (suite *TransactionUseCaseTestSuite) TestGetAccountProjection_WithoutProduct() {
+	suite.mockAccountRepo.On("GetByID", suite.ctx, suite.testAccount.ID).Return(suite.testAccount, nil)
+	suite.mockTxnRepo.On("GetCompletedByAccountIDSince", suite.ctx, suite.testAccount.ID, mock.Anything).
+		Return([]*entity.Transaction{suite.testTransaction}, nil)
+
+	result, err := suite.usecase.GetAccountProjection(suite.ctx, dto.AccountProjectionRequest{
+		AccountID: suite.testAccount.ID.String(),
+		Days:      7,
+	})
+
+	suite.Require().NoError(err)
+	assert.Equal(suite.T(), 7, result.Days)
+	assert.Empty(suite.T(), result.ProductID)
+	assert.Zero(suite.T(), result.ProjectedInterest)
+	assert.Zero(suite.T(), result.ExpectedFees)
+	assert.Empty(suite.T(), result.Fees)
+	assert.Equal(suite.T(), 1000.0, result.ProjectedBalance)
+}
+
 func (suite *TransactionUseCaseTestSuite) TestCancelTransaction_Success() {
 	req := dto.CancelTransactionRequest{
 		ID: suite.testTransaction.ID.String(),
@@ -410,9 +1051,10 @@ func (suite *TransactionUseCaseTestSuite) TestCancelTransaction_Success() {
 	suite.mockTxnRepo.On("Update", suite.ctx, mock.AnythingOfType("*entity.Transaction")).Return(nil)
 	suite.mockCache.On("Set", suite.ctx, "transaction:"+req.ID, mock.Anything, 30*time.Minute).Return(nil)
 
-	err := suite.usecase.CancelTransaction(suite.ctx, req)
+	result, err := suite.usecase.CancelTransaction(suite.ctx, req)
 
 	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), dto.CancellationOutcomeCancelled, result.Outcome)
 	suite.mockTxnRepo.AssertExpectations(suite.T())
 }
 
@@ -423,13 +1065,82 @@ func (suite *TransactionUseCaseTestSuite) TestCancelTransaction_NotFound() {
 
 	suite.mockTxnRepo.On("GetByID", suite.ctx, suite.testTransaction.ID).Return(nil, errs.ErrTransactionNotFound)
 
-	err := suite.usecase.CancelTransaction(suite.ctx, req)
+	_, err := suite.usecase.CancelTransaction(suite.ctx, req)
 
 	assert.Error(suite.T(), err)
 	assert.Equal(suite.T(), errs.ErrTransactionNotFound, err)
 	suite.mockTxnRepo.AssertExpectations(suite.T())
 }
 
+func (suite *TransactionUseCaseTestSuite) TestReverseTransaction_Success() {
+	completed := *suite.testTransaction
+	suite.Require().NoError(completed.SetFee(vo.NewMoneyFromFloat(5)))
+	suite.Require().NoError(completed.MarkAsCompleted(time.Now()))
+	suite.testAccount.Balance = vo.NewMoneyFromFloat(895)
+
+	var saga *entity.Saga
+	suite.mockSagaRepo.On("Create", suite.ctx, mock.AnythingOfType("*entity.Saga")).
+		Run(func(args mock.Arguments) { saga = args.Get(1).(*entity.Saga) }).
+		Return(nil)
+	suite.mockSagaRepo.On("Update", suite.ctx, mock.AnythingOfType("*entity.Saga")).Return(nil)
+	suite.mockTxnRepo.On("GetByID", suite.ctx, completed.ID).Return(&completed, nil)
+	suite.mockTxnRepo.On("GetByReversedTransactionID", suite.ctx, completed.ID).Return([]*entity.Transaction{}, nil)
+
+	// The reversal and the fee refund are recorded and processed like any credit
+	suite.mockCache.On("Set", suite.ctx, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	suite.mockCache.On("Delete", suite.ctx, mock.Anything).Return(nil)
+	suite.mockLocks.On("Acquire", suite.ctx, mock.Anything, mock.Anything).Return(int64(1), true, nil)
+	suite.mockLocks.On("Release", suite.ctx, mock.Anything, int64(1)).Return(nil)
+	suite.mockTxnRepo.On("Create", suite.ctx, mock.AnythingOfType("*entity.Transaction")).Return(nil)
+	suite.mockTxnRepo.On("ClaimProcessing", suite.ctx, mock.AnythingOfType("*entity.Transaction"), mock.AnythingOfType("int64")).Return(true, nil)
+	suite.mockTxnRepo.On("Update", suite.ctx, mock.AnythingOfType("*entity.Transaction")).Return(nil)
+	suite.mockAccountRepo.On("GetByIDForUpdate", suite.ctx, suite.testAccount.ID).Return(suite.testAccount, nil)
+	suite.mockAccountRepo.On("UpdateFenced", suite.ctx, suite.testAccount, mock.Anything, mock.AnythingOfType("int64")).Return(nil)
+
+	result, err := suite.usecase.ReverseTransaction(suite.ctx, dto.ReverseTransactionRequest{ID: completed.ID.String()})
+
+	suite.Require().NoError(err)
+	assert.Equal(suite.T(), "REVERSAL", result.Reversal.TransactionType)
+	assert.Equal(suite.T(), "COMPLETED", result.Reversal.Status)
+	assert.Equal(suite.T(), completed.ID.String(), *result.Reversal.ReversedTransactionID)
+	suite.Require().NotNil(result.FeeRefund)
+	assert.Equal(suite.T(), "CREDIT", result.FeeRefund.TransactionType)
+	assert.True(suite.T(), vo.NewMoneyFromFloat(1000).Equal(suite.testAccount.Balance))
+
+	suite.Require().NotNil(saga)
+	assert.Equal(suite.T(), entity.SagaTypeReversal, saga.Type)
+	assert.Equal(suite.T(), vo.SagaStatusCompleted, saga.Status)
+}
+
+func (suite *TransactionUseCaseTestSuite) TestReverseTransaction_AlreadyReversed() {
+	completed := *suite.testTransaction
+	suite.Require().NoError(completed.MarkAsCompleted(time.Now()))
+	reversal, err := entity.NewReversalTransaction(&completed, time.Now())
+	suite.Require().NoError(err)
+
+	suite.mockLocks.On("Acquire", suite.ctx, mock.Anything, mock.Anything).Return(int64(1), true, nil)
+	suite.mockLocks.On("Release", suite.ctx, mock.Anything, int64(1)).Return(nil)
+	suite.mockTxnRepo.On("GetByID", suite.ctx, completed.ID).Return(&completed, nil)
+	suite.mockTxnRepo.On("GetByReversedTransactionID", suite.ctx, completed.ID).Return([]*entity.Transaction{reversal}, nil)
+
+	_, err = suite.usecase.ReverseTransaction(suite.ctx, dto.ReverseTransactionRequest{ID: completed.ID.String()})
+
+	assert.ErrorIs(suite.T(), err, errs.ErrTransactionAlreadyReversed)
+	suite.mockTxnRepo.AssertNotCalled(suite.T(), "Create", mock.Anything, mock.Anything)
+}
+
+func (suite *TransactionUseCaseTestSuite) TestReverseTransaction_NotCompleted() {
+	suite.mockLocks.On("Acquire", suite.ctx, mock.Anything, mock.Anything).Return(int64(1), true, nil)
+	suite.mockLocks.On("Release", suite.ctx, mock.Anything, int64(1)).Return(nil)
+	suite.mockTxnRepo.On("GetByID", suite.ctx, suite.testTransaction.ID).Return(suite.testTransaction, nil)
+	suite.mockTxnRepo.On("GetByReversedTransactionID", suite.ctx, suite.testTransaction.ID).Return([]*entity.Transaction{}, nil)
+
+	_, err := suite.usecase.ReverseTransaction(suite.ctx, dto.ReverseTransactionRequest{ID: suite.testTransaction.ID.String()})
+
+	assert.ErrorIs(suite.T(), err, errs.ErrTransactionNotReversible)
+	suite.mockTxnRepo.AssertNotCalled(suite.T(), "Create", mock.Anything, mock.Anything)
+}
+
 func (suite *TransactionUseCaseTestSuite) TestCancelTransaction_AlreadyCompleted() {
 	// Create completed transaction
 	completedTxn, _ := entity.NewDebitTransaction(
@@ -437,8 +1148,9 @@ func (suite *TransactionUseCaseTestSuite) TestCancelTransaction_AlreadyCompleted
 		vo.NewMoneyFromFloat(100.0),
 		"Test debit",
 		"TEST-REF",
+		time.Now(),
 	)
-	completedTxn.MarkAsCompleted()
+	completedTxn.MarkAsCompleted(time.Now())
 
 	req := dto.CancelTransactionRequest{
 		ID: completedTxn.ID.String(),
@@ -446,7 +1158,7 @@ func (suite *TransactionUseCaseTestSuite) TestCancelTransaction_AlreadyCompleted
 
 	suite.mockTxnRepo.On("GetByID", suite.ctx, completedTxn.ID).Return(completedTxn, nil)
 
-	err := suite.usecase.CancelTransaction(suite.ctx, req)
+	_, err := suite.usecase.CancelTransaction(suite.ctx, req)
 
 	assert.Error(suite.T(), err)
 	assert.Contains(suite.T(), err.Error(), errs.ErrTransactionCannotBeCancelled.Error())
@@ -463,8 +1175,9 @@ func (suite *TransactionUseCaseTestSuite) TestGetTransactionsByStatus_Success()
 	transactions := []*entity.Transaction{suite.testTransaction}
 	cacheKey := "transactions:status:PENDING:page:1:size:10"
 
-	suite.mockCache.On("Get", suite.ctx, cacheKey, mock.Anything).Return(errors.New("cache miss"))
+	suite.mockCache.On("Get", suite.ctx, cacheKey, mock.Anything).Return(infra.ErrCacheMiss)
 	suite.mockTxnRepo.On("GetByStatus", suite.ctx, vo.TransactionStatusPending, 10, 0).Return(transactions, nil)
+	suite.mockTxnRepo.On("CountByStatus", suite.ctx, vo.TransactionStatusPending).Return(int64(1), nil)
 	suite.mockCache.On("Set", suite.ctx, cacheKey, mock.Anything, 5*time.Minute).Return(nil)
 
 	result, err := suite.usecase.GetTransactionsByStatus(suite.ctx, status, req)
@@ -499,6 +1212,7 @@ func (suite *TransactionUseCaseTestSuite) TestConfirmTransaction_InsufficientBal
 		vo.NewMoneyFromFloat(100.0),
 		"Test debit",
 		"TEST-REF",
+		time.Now(),
 	)
 
 	req := dto.ConfirmTransactionRequest{
@@ -507,18 +1221,20 @@ func (suite *TransactionUseCaseTestSuite) TestConfirmTransaction_InsufficientBal
 
 	// Mock cache miss for idempotency check
 	idempotencyKey := "confirm_transaction:" + req.ID
-	suite.mockCache.On("Get", suite.ctx, idempotencyKey, mock.Anything).Return(errors.New("cache miss"))
+	suite.mockCache.On("Get", suite.ctx, idempotencyKey, mock.Anything).Return(infra.ErrCacheMiss)
 
 	// Mock lock acquisition
 	lockKey := "lock:transaction:" + req.ID
-	suite.mockCache.On("Set", suite.ctx, lockKey, mock.Anything, 30*time.Second).Return(nil)
-	suite.mockCache.On("Delete", suite.ctx, lockKey).Return(nil)
+	suite.mockLocks.On("Acquire", suite.ctx, lockKey, 30*time.Second).Return(int64(1), true, nil)
+	suite.mockLocks.On("Release", suite.ctx, lockKey, int64(1)).Return(nil)
 
-	// Mock transaction retrieval
+	// Mock transaction retrieval and claim
 	suite.mockTxnRepo.On("GetByID", suite.ctx, highAmountTxn.ID).Return(highAmountTxn, nil)
+	suite.mockTxnRepo.On("ClaimProcessing", suite.ctx, highAmountTxn, mock.AnythingOfType("int64")).Return(true, nil)
 
 	// Mock account retrieval with low balance
-	suite.mockAccountRepo.On("GetByID", suite.ctx, *highAmountTxn.FromAccountID).Return(lowBalanceAccount, nil)
+	suite.mockAccountRepo.On("GetByIDForUpdate", suite.ctx, *highAmountTxn.FromAccountID).Return(lowBalanceAccount, nil)
+	suite.mockAccountRepo.On("IsApplied", suite.ctx, highAmountTxn.ID, *highAmountTxn.FromAccountID).Return(false, nil)
 
 	// Mock transaction update to failed status
 	suite.mockTxnRepo.On("Update", suite.ctx, mock.AnythingOfType("*entity.Transaction")).Return(nil)
@@ -532,6 +1248,216 @@ func (suite *TransactionUseCaseTestSuite) TestConfirmTransaction_InsufficientBal
 	suite.mockAccountRepo.AssertExpectations(suite.T())
 }
 
+func (suite *TransactionUseCaseTestSuite) TestConfirmTransaction_TransientFailure() {
+	id := suite.expectConfirmationLock()
+
+	suite.mockTxnRepo.On("GetByID", suite.ctx, suite.testTransaction.ID).Return(suite.testTransaction, nil)
+	suite.mockAccountRepo.On("GetByIDForUpdate", suite.ctx, suite.testAccount.ID).Return((*entity.Account)(nil), fmt.Errorf("%w: deadlock detected", errs.ErrTransient))
+
+	result, err := suite.usecase.ConfirmTransaction(suite.ctx, dto.ConfirmTransactionRequest{ID: id})
+
+	assert.Nil(suite.T(), result)
+	assert.ErrorIs(suite.T(), err, errs.ErrTransient)
+	assert.NotErrorIs(suite.T(), err, errs.ErrAccountNotFound)
+	assert.Equal(suite.T(), vo.TransactionStatusPending, suite.testTransaction.Status)
+	suite.mockTxnRepo.AssertNotCalled(suite.T(), "Update", mock.Anything, mock.Anything)
+	assert.Empty(suite.T(), suite.mockEvents.Events)
+}
+
+func (suite *TransactionUseCaseTestSuite) TestConfirmTransaction_BusinessFailureIsRecorded() {
+	id := suite.expectConfirmationLock()
+	suite.testAccount.Balance = vo.NewMoneyFromFloat(50)
+
+	suite.mockTxnRepo.On("GetByID", suite.ctx, suite.testTransaction.ID).Return(suite.testTransaction, nil)
+	suite.mockTxnRepo.On("Update", suite.ctx, suite.testTransaction).Return(nil)
+	suite.mockAccountRepo.On("GetByIDForUpdate", suite.ctx, suite.testAccount.ID).Return(suite.testAccount, nil)
+	suite.mockAccountRepo.On("IsApplied", suite.ctx, suite.testTransaction.ID, suite.testAccount.ID).Return(false, nil)
+
+	_, err := suite.usecase.ConfirmTransaction(suite.ctx, dto.ConfirmTransactionRequest{ID: id})
+
+	assert.ErrorIs(suite.T(), err, errs.ErrInsufficientBalance)
+	assert.Equal(suite.T(), vo.TransactionStatusFailed, suite.testTransaction.Status)
+	assert.Equal(suite.T(), vo.FailureKindBusiness, suite.testTransaction.FailureKind)
+	suite.Require().Len(suite.mockEvents.Events, 1)
+	assert.Equal(suite.T(), event.TransactionFailed, suite.mockEvents.Events[0].Type)
+}
+
+func (suite *TransactionUseCaseTestSuite) TestConfirmTransaction_CompletionRolledBackWithBalances() {
+	id := suite.expectConfirmationLock()
+	units := &StubUnitOfWork{}
+	suite.usecase.(*transactionUseCase).units = units
+
+	suite.mockTxnRepo.On("GetByID", suite.ctx, suite.testTransaction.ID).Return(suite.testTransaction, nil)
+	suite.mockAccountRepo.On("GetByIDForUpdate", mock.Anything, suite.testAccount.ID).Return(suite.testAccount, nil)
+	suite.mockAccountRepo.On("UpdateFenced", mock.Anything, suite.testAccount, suite.testTransaction.ID, mock.AnythingOfType("int64")).Return(nil)
+	suite.mockTxnRepo.On("Update", mock.Anything, suite.testTransaction).Return(errors.New("database unavailable")).Once()
+	suite.mockTxnRepo.On("Update", mock.Anything, suite.testTransaction).Return(nil).Once()
+
+	// The debit and the completion are written in one unit of work, so failing to record the
+	// completion rolls the debit back and the transaction can be replayed; the failure is
+	// recorded in a unit of work of its own
+	_, err := suite.usecase.ConfirmTransaction(suite.ctx, dto.ConfirmTransactionRequest{ID: id})
+
+	suite.Require().Error(err)
+	suite.Require().Len(units.Outcomes, 2)
+	assert.Error(suite.T(), units.Outcomes[0])
+	assert.NoError(suite.T(), units.Outcomes[1])
+	assert.Equal(suite.T(), vo.TransactionStatusFailed, suite.testTransaction.Status)
+	assert.Equal(suite.T(), vo.FailureKindInfrastructure, suite.testTransaction.FailureKind)
+	suite.mockTxnRepo.AssertExpectations(suite.T())
+}
+
+func (suite *TransactionUseCaseTestSuite) TestConfirmTransaction_CompletionEventStoredWithCompletion() {
+	id := suite.expectConfirmationLock()
+	units := &StubUnitOfWork{}
+	outbox := new(MockOutboxRepository)
+	uc := suite.usecase.(*transactionUseCase)
+	uc.units = units
+	uc.outbox = outbox
+
+	suite.mockTxnRepo.On("GetByID", suite.ctx, suite.testTransaction.ID).Return(suite.testTransaction, nil)
+	suite.mockAccountRepo.On("GetByIDForUpdate", mock.Anything, suite.testAccount.ID).Return(suite.testAccount, nil)
+	suite.mockAccountRepo.On("UpdateFenced", mock.Anything, suite.testAccount, suite.testTransaction.ID, mock.AnythingOfType("int64")).Return(nil)
+	suite.mockTxnRepo.On("Update", mock.Anything, suite.testTransaction).Return(nil)
+	suite.mockCache.On("Set", suite.ctx, "confirm_transaction:"+id, mock.Anything, 24*time.Hour).Return(nil)
+	suite.mockCache.On("Delete", suite.ctx, "account:"+suite.testAccount.ID.String()).Return(nil)
+	inUnitOfWork := mock.MatchedBy(func(ctx context.Context) bool { return repository.UnitOfWorkFromContext(ctx) != nil })
+	outbox.On("Append", inUnitOfWork, mock.AnythingOfType("event.Event")).Return(nil).Once()
+
+	// The completion event is stored in the unit of work that completes the transaction, and
+	// subscribers are told of that same event once it has committed
+	_, err := suite.usecase.ConfirmTransaction(suite.ctx, dto.ConfirmTransactionRequest{ID: id})
+
+	suite.Require().NoError(err)
+	suite.Require().Equal([]error{nil}, units.Outcomes)
+	stored := outbox.Calls[0].Arguments.Get(1).(event.Event)
+	assert.Equal(suite.T(), event.TransactionCompleted, stored.Type)
+	suite.Require().Len(suite.mockEvents.Events, 1)
+	assert.Equal(suite.T(), stored.ID, suite.mockEvents.Events[0].ID)
+	outbox.AssertExpectations(suite.T())
+}
+
+func (suite *TransactionUseCaseTestSuite) TestConfirmTransaction_CompletionRolledBackWithoutOutbox() {
+	id := suite.expectConfirmationLock()
+	units := &StubUnitOfWork{}
+	outbox := new(MockOutboxRepository)
+	uc := suite.usecase.(*transactionUseCase)
+	uc.units = units
+	uc.outbox = outbox
+
+	suite.mockTxnRepo.On("GetByID", suite.ctx, suite.testTransaction.ID).Return(suite.testTransaction, nil)
+	suite.mockAccountRepo.On("GetByIDForUpdate", mock.Anything, suite.testAccount.ID).Return(suite.testAccount, nil)
+	suite.mockAccountRepo.On("UpdateFenced", mock.Anything, suite.testAccount, suite.testTransaction.ID, mock.AnythingOfType("int64")).Return(nil)
+	suite.mockTxnRepo.On("Update", mock.Anything, suite.testTransaction).Return(nil)
+	outbox.On("Append", mock.Anything, mock.MatchedBy(func(evt event.Event) bool { return evt.Type == event.TransactionCompleted })).
+		Return(errors.New("database unavailable")).Once()
+	outbox.On("Append", mock.Anything, mock.MatchedBy(func(evt event.Event) bool { return evt.Type == event.TransactionFailed })).
+		Return(nil).Once()
+
+	// Without its event in the outbox the completion is rolled back, like any other part of it
+	_, err := suite.usecase.ConfirmTransaction(suite.ctx, dto.ConfirmTransactionRequest{ID: id})
+
+	suite.Require().Error(err)
+	suite.Require().Len(units.Outcomes, 2)
+	assert.Error(suite.T(), units.Outcomes[0])
+	assert.Equal(suite.T(), vo.TransactionStatusFailed, suite.testTransaction.Status)
+	suite.Require().Len(suite.mockEvents.Events, 1)
+	assert.Equal(suite.T(), event.TransactionFailed, suite.mockEvents.Events[0].Type)
+	outbox.AssertExpectations(suite.T())
+}
+
+func (suite *TransactionUseCaseTestSuite) TestConfirmTransaction_TakenOverBeforeClaim() {
+	id := suite.testTransaction.ID.String()
+	suite.mockCache.On("Get", suite.ctx, "confirm_transaction:"+id, mock.Anything).Return(infra.ErrCacheMiss)
+	suite.mockLocks.On("Acquire", suite.ctx, "lock:transaction:"+id, 30*time.Second).Return(int64(1), true, nil)
+	suite.mockLocks.On("Release", suite.ctx, "lock:transaction:"+id, int64(1)).Return(nil)
+	suite.mockTxnRepo.On("GetByID", suite.ctx, suite.testTransaction.ID).Return(suite.testTransaction, nil)
+	suite.expectClaim(false)
+
+	// A worker whose lock expired was overtaken by one holding a newer token
+	_, err := suite.usecase.ConfirmTransaction(suite.ctx, dto.ConfirmTransactionRequest{ID: id})
+
+	assert.ErrorIs(suite.T(), err, errs.ErrTransactionAlreadyInProgress)
+	suite.mockAccountRepo.AssertNotCalled(suite.T(), "GetByID", mock.Anything, mock.Anything)
+	suite.mockTxnRepo.AssertNotCalled(suite.T(), "Update", mock.Anything, mock.Anything)
+}
+
+func (suite *TransactionUseCaseTestSuite) TestConfirmTransaction_TakenOverMidProcessing() {
+	id := suite.expectConfirmationLock()
+
+	suite.mockTxnRepo.On("GetByID", suite.ctx, suite.testTransaction.ID).Return(suite.testTransaction, nil)
+	suite.mockAccountRepo.On("GetByIDForUpdate", suite.ctx, suite.testAccount.ID).Return(suite.testAccount, nil)
+	suite.mockAccountRepo.On("UpdateFenced", suite.ctx, suite.testAccount, suite.testTransaction.ID, mock.AnythingOfType("int64")).Return(errs.ErrStaleFencingToken)
+
+	// The newer worker owns the outcome, so this one records nothing
+	_, err := suite.usecase.ConfirmTransaction(suite.ctx, dto.ConfirmTransactionRequest{ID: id})
+
+	assert.ErrorIs(suite.T(), err, errs.ErrStaleFencingToken)
+	assert.Equal(suite.T(), vo.TransactionStatusPending, suite.testTransaction.Status)
+	assert.NotZero(suite.T(), suite.testTransaction.ProcessingToken)
+	suite.mockTxnRepo.AssertNotCalled(suite.T(), "Update", mock.Anything, mock.Anything)
+	assert.Empty(suite.T(), suite.mockEvents.Events)
+}
+
+func (suite *TransactionUseCaseTestSuite) TestConfirmTransaction_AlreadyAppliedIsNotRepeated() {
+	id := suite.expectConfirmationLock()
+
+	// An earlier confirmation debited the account but crashed before completing the transaction,
+	// and the idempotency cache has since been flushed
+	suite.mockTxnRepo.On("GetByID", suite.ctx, suite.testTransaction.ID).Return(suite.testTransaction, nil)
+	suite.mockTxnRepo.On("Update", suite.ctx, suite.testTransaction).Return(nil)
+	suite.mockAccountRepo.On("GetByIDForUpdate", suite.ctx, suite.testAccount.ID).Return(suite.testAccount, nil)
+	suite.mockAccountRepo.On("UpdateFenced", suite.ctx, suite.testAccount, suite.testTransaction.ID, mock.AnythingOfType("int64")).Return(errs.ErrTransactionAlreadyApplied)
+	suite.mockCache.On("Set", suite.ctx, "confirm_transaction:"+id, mock.Anything, 24*time.Hour).Return(nil)
+	suite.mockCache.On("Delete", suite.ctx, "account:"+suite.testAccount.ID.String()).Return(nil)
+
+	result, err := suite.usecase.ConfirmTransaction(suite.ctx, dto.ConfirmTransactionRequest{ID: id})
+
+	suite.Require().NoError(err)
+	assert.Equal(suite.T(), "COMPLETED", result.Status)
+	suite.Require().Len(suite.mockEvents.Events, 1)
+	assert.Equal(suite.T(), event.TransactionCompleted, suite.mockEvents.Events[0].Type)
+}
+
+func (suite *TransactionUseCaseTestSuite) TestConfirmTransaction_CacheUnavailable() {
+	id := suite.testTransaction.ID.String()
+	suite.mockCache.On("Get", suite.ctx, "confirm_transaction:"+id, mock.Anything).Return(errors.New("connection refused"))
+	suite.mockLocks.On("Acquire", suite.ctx, "lock:transaction:"+id, 30*time.Second).Return(int64(0), false, errors.New("connection refused"))
+
+	result, err := suite.usecase.ConfirmTransaction(suite.ctx, dto.ConfirmTransactionRequest{ID: id})
+
+	assert.Nil(suite.T(), result)
+	assert.ErrorIs(suite.T(), err, errs.ErrTransient)
+	suite.mockTxnRepo.AssertNotCalled(suite.T(), "GetByID", mock.Anything, mock.Anything)
+}
+
+func (suite *TransactionUseCaseTestSuite) TestConfirmTransaction_LockHeldElsewhere() {
+	id := suite.testTransaction.ID.String()
+	suite.mockCache.On("Get", suite.ctx, "confirm_transaction:"+id, mock.Anything).Return(infra.ErrCacheMiss)
+	suite.mockLocks.On("Acquire", suite.ctx, "lock:transaction:"+id, 30*time.Second).Return(int64(0), false, nil)
+
+	result, err := suite.usecase.ConfirmTransaction(suite.ctx, dto.ConfirmTransactionRequest{ID: id})
+
+	assert.Nil(suite.T(), result)
+	assert.ErrorIs(suite.T(), err, errs.ErrTransactionAlreadyInProgress)
+	suite.mockTxnRepo.AssertNotCalled(suite.T(), "GetByID", mock.Anything, mock.Anything)
+	suite.mockLocks.AssertNotCalled(suite.T(), "Release", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *TransactionUseCaseTestSuite) TestConfirmTransaction_ReleasesLockWithItsToken() {
+	id := suite.testTransaction.ID.String()
+	suite.mockCache.On("Get", suite.ctx, "confirm_transaction:"+id, mock.Anything).Return(infra.ErrCacheMiss)
+	suite.mockLocks.On("Acquire", suite.ctx, "lock:transaction:"+id, 30*time.Second).Return(int64(42), true, nil)
+	suite.mockLocks.On("Release", suite.ctx, "lock:transaction:"+id, int64(42)).Return(infra.ErrLockNotHeld)
+	suite.mockTxnRepo.On("GetByID", suite.ctx, suite.testTransaction.ID).Return(nil, errs.ErrTransactionNotFound)
+
+	_, err := suite.usecase.ConfirmTransaction(suite.ctx, dto.ConfirmTransactionRequest{ID: id})
+
+	// A lock that expired in the meantime is left to the worker holding it now
+	assert.ErrorIs(suite.T(), err, errs.ErrTransactionNotFound)
+	suite.mockLocks.AssertExpectations(suite.T())
+}
+
 func TestTransactionUseCaseTestSuite(t *testing.T) {
 	suite.Run(t, new(TransactionUseCaseTestSuite))
 }
@@ -0,0 +1,103 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// slowLockAccountRepository takes a moment after locking a row, as the round trip to the database
+// would, so concurrent units of work hold their first row while the others lock theirs
+type slowLockAccountRepository struct {
+	*memoryAccountRepository
+}
+
+func (r slowLockAccountRepository) GetByIDForUpdate(ctx context.Context, id vo.AccountID) (*entity.Account, error) {
+	account, err := r.memoryAccountRepository.GetByIDForUpdate(ctx, id)
+	time.Sleep(100 * time.Microsecond)
+	return account, err
+}
+
+// TestCompleteTransfer_OpposingTransfersDoNotDeadlock completes transfers between every pair of three
+// accounts in both directions at once, each in a unit of work holding the rows it locked until it
+// ends. Transfers locking their source row first deadlock on the transfers going the other way;
+// locked in vo.LockOrder, every transfer completes and each balance ends where the transfers add up to.
+func TestCompleteTransfer_OpposingTransfersDoNotDeadlock(t *testing.T) {
+	const rounds = 50
+
+	accounts := make([]*entity.Account, 3)
+	for i := range accounts {
+		account, err := entity.NewAccount(fmt.Sprintf("Account %d", i), vo.NewMoneyFromFloat(10000))
+		require.NoError(t, err)
+		accounts[i] = account
+	}
+	store := newMemoryAccountRepository(accounts...)
+
+	mockTxnRepo := new(MockTransactionRepository)
+	mockTxnRepo.On("Update", mock.Anything, mock.Anything).Return(nil)
+	mockLogger := new(MockLogger)
+	mockLogger.On("Info", mock.Anything, mock.Anything).Maybe()
+	mockLogger.On("Warn", mock.Anything, mock.Anything).Maybe()
+	mockLogger.On("Error", mock.Anything, mock.Anything).Maybe()
+	uc := NewTransactionUseCase(mockTxnRepo, slowLockAccountRepository{store}, nil, nil, &memoryUnitOfWork{store: store}, nil, nil, nil, &StubEventPublisher{}, nil,
+		NewValueDatingPolicy(&StubCalendar{}, vo.ProcessingWindow{}, nil), nil, NewCategorizer(nil, nil, mockLogger), nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, "THB", mockLogger).(*transactionUseCase)
+
+	// Each direction moves its own amount, so a lost update shows in the balances
+	expected := make([]float64, len(accounts))
+	for i := range expected {
+		expected[i] = 10000
+	}
+	results := make(chan error, len(accounts)*(len(accounts)-1)*rounds)
+	var wg sync.WaitGroup
+	for from := range accounts {
+		for to := range accounts {
+			if from == to {
+				continue
+			}
+			amount := float64(1 + from*len(accounts) + to)
+			expected[from] -= amount * rounds
+			expected[to] += amount * rounds
+
+			wg.Add(1)
+			go func(from, to *entity.Account, amount vo.Money) {
+				defer wg.Done()
+				for i := 0; i < rounds; i++ {
+					transfer, err := entity.NewTransferTransaction(from.ID, to.ID, amount, "Stress transfer", "", time.Now())
+					if err == nil {
+						_, err = uc.complete(context.Background(), transfer)
+					}
+					results <- err
+				}
+			}(accounts[from], accounts[to], vo.NewMoneyFromFloat(amount))
+		}
+	}
+
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(2 * raceTimeout):
+		t.Fatal("opposing transfers deadlocked")
+	}
+
+	close(results)
+	for err := range results {
+		require.NoError(t, err)
+	}
+	for i, account := range accounts {
+		final, err := store.GetByID(context.Background(), account.ID)
+		require.NoError(t, err)
+		assert.True(t, final.Balance.Equal(vo.NewMoneyFromFloat(expected[i])), "account %d: balance %s, expected %v", i, final.Balance, expected[i])
+	}
+}
@@ -0,0 +1,223 @@
+// internal/application/transfer_template.go
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hydr0g3nz/mini_bank/internal/application/dto"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/infra"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/repository"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+)
+
+type transferTemplateUseCase struct {
+	templateRepo       repository.TransferTemplateRepository
+	accountRepo        repository.AccountRepository
+	transactionUseCase TransactionUseCase
+	valueDating        *ValueDatingPolicy
+	logger             infra.Logger
+	mapper             *dto.TransferTemplateMapper
+}
+
+// NewTransferTemplateUseCase creates a new transfer template use case
+func NewTransferTemplateUseCase(
+	templateRepo repository.TransferTemplateRepository,
+	accountRepo repository.AccountRepository,
+	transactionUseCase TransactionUseCase,
+	valueDating *ValueDatingPolicy,
+	logger infra.Logger,
+) TransferTemplateUseCase {
+	return &transferTemplateUseCase{
+		templateRepo:       templateRepo,
+		accountRepo:        accountRepo,
+		transactionUseCase: transactionUseCase,
+		valueDating:        valueDating,
+		logger:             logger,
+		mapper:             &dto.TransferTemplateMapper{},
+	}
+}
+
+// CreateTemplate saves a transfer template for an account
+func (uc *transferTemplateUseCase) CreateTemplate(ctx context.Context, req dto.TransferTemplateRequest) (*dto.TransferTemplateResponse, error) {
+	accountID, err := vo.NewAccountIDFromString(req.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := uc.accountRepo.GetByID(ctx, accountID); err != nil {
+		return nil, err
+	}
+
+	toAccountID, err := uc.beneficiary(ctx, req.ToAccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	template, err := entity.NewTransferTemplate(accountID, req.Name, toAccountID, req.Amount.Money(), req.Description, req.Reference)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.templateRepo.Create(ctx, template); err != nil {
+		uc.logger.Error("Failed to create transfer template", "error", err, "accountID", req.AccountID)
+		return nil, err
+	}
+
+	uc.logger.Info("Transfer template created", "templateID", template.ID, "accountID", req.AccountID)
+	response := uc.mapper.ToResponse(template)
+	return &response, nil
+}
+
+// GetTemplate retrieves a transfer template of an account
+func (uc *transferTemplateUseCase) GetTemplate(ctx context.Context, accountID, id string) (*dto.TransferTemplateResponse, error) {
+	template, err := uc.accountTemplate(ctx, accountID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	response := uc.mapper.ToResponse(template)
+	return &response, nil
+}
+
+// ListTemplates retrieves the transfer templates of an account by name
+func (uc *transferTemplateUseCase) ListTemplates(ctx context.Context, accountID string) (*dto.TransferTemplateListResponse, error) {
+	parsedAccountID, err := vo.NewAccountIDFromString(accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	templates, err := uc.templateRepo.ListByAccountID(ctx, parsedAccountID)
+	if err != nil {
+		uc.logger.Error("Failed to list transfer templates", "error", err, "accountID", accountID)
+		return nil, err
+	}
+
+	responses := make([]dto.TransferTemplateResponse, len(templates))
+	for i, template := range templates {
+		responses[i] = uc.mapper.ToResponse(template)
+	}
+
+	return &dto.TransferTemplateListResponse{Templates: responses}, nil
+}
+
+// UpdateTemplate changes a transfer template's beneficiary, amount or memo
+func (uc *transferTemplateUseCase) UpdateTemplate(ctx context.Context, req dto.TransferTemplateRequest) (*dto.TransferTemplateResponse, error) {
+	template, err := uc.accountTemplate(ctx, req.AccountID, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	toAccountID, err := uc.beneficiary(ctx, req.ToAccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := template.Update(req.Name, toAccountID, req.Amount.Money(), req.Description, req.Reference); err != nil {
+		return nil, err
+	}
+
+	if err := uc.templateRepo.Update(ctx, template); err != nil {
+		uc.logger.Error("Failed to update transfer template", "error", err, "templateID", req.ID)
+		return nil, err
+	}
+
+	uc.logger.Info("Transfer template updated", "templateID", req.ID)
+	response := uc.mapper.ToResponse(template)
+	return &response, nil
+}
+
+// DeleteTemplate removes a transfer template of an account
+func (uc *transferTemplateUseCase) DeleteTemplate(ctx context.Context, accountID, id string) error {
+	if _, err := uc.accountTemplate(ctx, accountID, id); err != nil {
+		return err
+	}
+
+	if err := uc.templateRepo.Delete(ctx, id); err != nil {
+		uc.logger.Error("Failed to delete transfer template", "error", err, "templateID", id)
+		return err
+	}
+
+	uc.logger.Info("Transfer template deleted", "templateID", id, "accountID", accountID)
+	return nil
+}
+
+// ExecuteTemplate creates and confirms a transfer from a template in one call, with the amount and
+// description optionally overridden. A transfer queued past the cutoff is returned PENDING.
+func (uc *transferTemplateUseCase) ExecuteTemplate(ctx context.Context, req dto.ExecuteTransferTemplateRequest) (*dto.TransactionResponse, error) {
+	template, err := uc.accountTemplate(ctx, req.AccountID, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	fromAccountID := template.AccountID.String()
+	toAccountID := template.ToAccountID.String()
+	amount := dto.NewDecimalString(template.Amount.Amount())
+	if req.Amount != nil {
+		amount = *req.Amount
+	}
+	description := template.Description
+	if req.Description != nil {
+		description = *req.Description
+	}
+
+	created, err := uc.transactionUseCase.CreateTransaction(ctx, dto.CreateTransactionRequest{
+		FromAccountID:   &fromAccountID,
+		ToAccountID:     &toAccountID,
+		TransactionType: string(vo.TransactionTypeTransfer),
+		Amount:          amount,
+		Description:     description,
+		Reference:       template.TransferReference(),
+		Channel:         req.Channel,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := uc.transactionUseCase.ConfirmTransaction(ctx, dto.ConfirmTransactionRequest{ID: created.ID})
+	if errors.Is(err, errs.ErrTransactionNotDue) {
+		result, err = created, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// The transfer went through; failing to count it must not fail the request
+	template.RecordUse(uc.valueDating.Now())
+	if err := uc.templateRepo.Update(ctx, template); err != nil {
+		uc.logger.Warn("Failed to record transfer template use", "error", err, "templateID", template.ID)
+	}
+
+	uc.logger.Info("Transfer template executed", "templateID", template.ID, "transactionID", result.ID, "status", result.Status)
+	return result, nil
+}
+
+// accountTemplate retrieves a transfer template, hiding templates of other accounts
+func (uc *transferTemplateUseCase) accountTemplate(ctx context.Context, accountID, id string) (*entity.TransferTemplate, error) {
+	template, err := uc.templateRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if template.AccountID.String() != accountID {
+		return nil, errs.ErrTransferTemplateNotFound
+	}
+
+	return template, nil
+}
+
+// beneficiary checks that the account a template pays exists
+func (uc *transferTemplateUseCase) beneficiary(ctx context.Context, id string) (vo.AccountID, error) {
+	accountID, err := vo.NewAccountIDFromString(id)
+	if err != nil {
+		return vo.AccountID{}, err
+	}
+
+	if _, err := uc.accountRepo.GetByID(ctx, accountID); err != nil {
+		return vo.AccountID{}, err
+	}
+
+	return accountID, nil
+}
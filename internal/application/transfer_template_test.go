@@ -0,0 +1,205 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hydr0g3nz/mini_bank/internal/application/dto"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type MockTransferTemplateRepository struct {
+	mock.Mock
+}
+
+func (m *MockTransferTemplateRepository) Create(ctx context.Context, template *entity.TransferTemplate) error {
+	args := m.Called(ctx, template)
+	return args.Error(0)
+}
+
+func (m *MockTransferTemplateRepository) GetByID(ctx context.Context, id string) (*entity.TransferTemplate, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.TransferTemplate), args.Error(1)
+}
+
+func (m *MockTransferTemplateRepository) Update(ctx context.Context, template *entity.TransferTemplate) error {
+	args := m.Called(ctx, template)
+	return args.Error(0)
+}
+
+func (m *MockTransferTemplateRepository) Delete(ctx context.Context, id string) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockTransferTemplateRepository) ListByAccountID(ctx context.Context, accountID vo.AccountID) ([]*entity.TransferTemplate, error) {
+	args := m.Called(ctx, accountID)
+	return args.Get(0).([]*entity.TransferTemplate), args.Error(1)
+}
+
+func createTestTransferTemplate(t *testing.T) (*entity.TransferTemplate, *entity.Account, *entity.Account) {
+	from := createTestAccount()
+	to := createTestAccount()
+	template, err := entity.NewTransferTemplate(from.ID, "Rent", to.ID, vo.NewMoneyFromFloat(500), "Monthly rent", "")
+	require.NoError(t, err)
+	return template, from, to
+}
+
+func TestTransferTemplateUseCase_CreateTemplate(t *testing.T) {
+	from := createTestAccount()
+	to := createTestAccount()
+
+	mockTemplateRepo := new(MockTransferTemplateRepository)
+	mockAccountRepo := new(MockAccountRepository)
+	mockLogger := new(MockLogger)
+	mockLogger.On("Info", mock.Anything, mock.Anything).Maybe()
+
+	mockAccountRepo.On("GetByID", mock.Anything, from.ID).Return(from, nil)
+	mockAccountRepo.On("GetByID", mock.Anything, to.ID).Return(to, nil)
+	mockTemplateRepo.On("Create", mock.Anything, mock.AnythingOfType("*entity.TransferTemplate")).Return(nil)
+
+	uc := NewTransferTemplateUseCase(mockTemplateRepo, mockAccountRepo, new(MockTransactionUseCase), NewValueDatingPolicy(&StubCalendar{}, vo.ProcessingWindow{}, nil), mockLogger)
+
+	result, err := uc.CreateTemplate(context.Background(), dto.TransferTemplateRequest{
+		AccountID:   from.ID.String(),
+		Name:        "Rent",
+		ToAccountID: to.ID.String(),
+		Amount:      dto.NewDecimalStringFromFloat(500),
+		Description: "Monthly rent",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "Rent", result.Name)
+	assert.Equal(t, to.ID.String(), result.ToAccountID)
+	assert.Equal(t, 500.0, result.Amount)
+
+	// A template cannot pay the account it belongs to
+	_, err = uc.CreateTemplate(context.Background(), dto.TransferTemplateRequest{
+		AccountID:   from.ID.String(),
+		Name:        "Self",
+		ToAccountID: from.ID.String(),
+		Amount:      dto.NewDecimalStringFromFloat(500),
+	})
+	assert.ErrorIs(t, err, errs.ErrSameAccountTransfer)
+	mockTemplateRepo.AssertNumberOfCalls(t, "Create", 1)
+}
+
+func TestTransferTemplateUseCase_ExecuteTemplate(t *testing.T) {
+	amount := dto.NewDecimalStringFromFloat(250)
+	description := "Half of the rent"
+
+	tests := []struct {
+		name             string
+		request          func(template *entity.TransferTemplate) dto.ExecuteTransferTemplateRequest
+		confirmError     error
+		expectedAmount   float64
+		expectedMemo     string
+		expectedStatus   string
+		expectedError    error
+		expectedUseCount int
+		skipsTransaction bool
+	}{
+		{
+			name: "success_template_values",
+			request: func(template *entity.TransferTemplate) dto.ExecuteTransferTemplateRequest {
+				return dto.ExecuteTransferTemplateRequest{AccountID: template.AccountID.String(), ID: template.ID}
+			},
+			expectedAmount:   500,
+			expectedMemo:     "Monthly rent",
+			expectedStatus:   "COMPLETED",
+			expectedUseCount: 1,
+		},
+		{
+			name: "success_overridden_amount_and_description",
+			request: func(template *entity.TransferTemplate) dto.ExecuteTransferTemplateRequest {
+				return dto.ExecuteTransferTemplateRequest{AccountID: template.AccountID.String(), ID: template.ID, Amount: &amount, Description: &description}
+			},
+			expectedAmount:   250,
+			expectedMemo:     description,
+			expectedStatus:   "COMPLETED",
+			expectedUseCount: 1,
+		},
+		{
+			name: "queued_past_cutoff",
+			request: func(template *entity.TransferTemplate) dto.ExecuteTransferTemplateRequest {
+				return dto.ExecuteTransferTemplateRequest{AccountID: template.AccountID.String(), ID: template.ID}
+			},
+			confirmError:     errs.ErrTransactionNotDue,
+			expectedAmount:   500,
+			expectedMemo:     "Monthly rent",
+			expectedStatus:   "PENDING",
+			expectedUseCount: 1,
+		},
+		{
+			name: "failed_transfer_is_not_counted",
+			request: func(template *entity.TransferTemplate) dto.ExecuteTransferTemplateRequest {
+				return dto.ExecuteTransferTemplateRequest{AccountID: template.AccountID.String(), ID: template.ID}
+			},
+			confirmError:   errs.ErrInsufficientBalance,
+			expectedAmount: 500,
+			expectedMemo:   "Monthly rent",
+			expectedError:  errs.ErrInsufficientBalance,
+		},
+		{
+			name: "template_of_another_account",
+			request: func(template *entity.TransferTemplate) dto.ExecuteTransferTemplateRequest {
+				return dto.ExecuteTransferTemplateRequest{AccountID: template.ToAccountID.String(), ID: template.ID}
+			},
+			expectedError:    errs.ErrTransferTemplateNotFound,
+			skipsTransaction: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			template, from, to := createTestTransferTemplate(t)
+			pending := &dto.TransactionResponse{ID: "TXN20240729120000123456", Status: "PENDING"}
+
+			mockTemplateRepo := new(MockTransferTemplateRepository)
+			mockTxnUC := new(MockTransactionUseCase)
+			mockLogger := new(MockLogger)
+			mockLogger.On("Info", mock.Anything, mock.Anything).Maybe()
+
+			mockTemplateRepo.On("GetByID", mock.Anything, template.ID).Return(template, nil)
+			mockTemplateRepo.On("Update", mock.Anything, template).Return(nil)
+			mockTxnUC.On("CreateTransaction", mock.Anything, mock.MatchedBy(func(req dto.CreateTransactionRequest) bool {
+				return req.TransactionType == "TRANSFER" &&
+					*req.FromAccountID == from.ID.String() &&
+					*req.ToAccountID == to.ID.String() &&
+					req.Amount.InexactFloat64() == tt.expectedAmount &&
+					req.Description == tt.expectedMemo &&
+					req.Reference == entity.TransferTemplateReferencePrefix+template.ID
+			})).Return(pending, nil)
+			if tt.confirmError != nil {
+				mockTxnUC.On("ConfirmTransaction", mock.Anything, dto.ConfirmTransactionRequest{ID: pending.ID}).Return(nil, tt.confirmError)
+			} else {
+				mockTxnUC.On("ConfirmTransaction", mock.Anything, dto.ConfirmTransactionRequest{ID: pending.ID}).
+					Return(&dto.TransactionResponse{ID: pending.ID, Status: "COMPLETED"}, nil)
+			}
+
+			uc := NewTransferTemplateUseCase(mockTemplateRepo, new(MockAccountRepository), mockTxnUC, NewValueDatingPolicy(&StubCalendar{}, vo.ProcessingWindow{}, nil), mockLogger)
+
+			result, err := uc.ExecuteTemplate(context.Background(), tt.request(template))
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectedStatus, result.Status)
+			}
+			assert.Equal(t, tt.expectedUseCount, template.UseCount)
+			if tt.skipsTransaction {
+				mockTxnUC.AssertNotCalled(t, "CreateTransaction", mock.Anything, mock.Anything)
+			}
+		})
+	}
+}
@@ -0,0 +1,68 @@
+// internal/application/value_dating.go
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/domain/infra"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+)
+
+// ValueDatingPolicy assigns value dates: transactions subject to the processing window
+// that arrive after the cutoff or on a non-business day are booked for the next business day
+type ValueDatingPolicy struct {
+	calendar infra.Calendar
+	window   vo.ProcessingWindow
+	now      func() time.Time
+}
+
+// NewValueDatingPolicy creates a new value dating policy reading the time from clock, the system clock when nil
+func NewValueDatingPolicy(calendar infra.Calendar, window vo.ProcessingWindow, clock infra.Clock) *ValueDatingPolicy {
+	now := time.Now
+	if clock != nil {
+		now = clock.Now
+	}
+
+	return &ValueDatingPolicy{
+		calendar: calendar,
+		window:   window,
+		now:      now,
+	}
+}
+
+// ValueDate returns the business date a new transaction of the given type is booked for
+func (p *ValueDatingPolicy) ValueDate(ctx context.Context, transactionType vo.TransactionType) (time.Time, error) {
+	now := p.now()
+	today := p.window.Date(now)
+
+	if !p.window.Applies(transactionType) {
+		return today, nil
+	}
+
+	businessDay, err := p.calendar.IsBusinessDay(ctx, today)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if businessDay && !p.window.IsAfterCutoff(now) {
+		return today, nil
+	}
+
+	return p.calendar.NextBusinessDay(ctx, today)
+}
+
+// Now returns the current time of the policy's clock
+func (p *ValueDatingPolicy) Now() time.Time {
+	return p.now()
+}
+
+// LocalNow returns the current time of the policy's clock in the window's time zone
+func (p *ValueDatingPolicy) LocalNow() time.Time {
+	return p.window.In(p.now())
+}
+
+// Today returns the current business date in the window's time zone
+func (p *ValueDatingPolicy) Today() time.Time {
+	return p.window.Date(p.now())
+}
@@ -0,0 +1,102 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// StubCalendar treats weekends and the listed dates as non-business days
+type StubCalendar struct {
+	Holidays []string
+}
+
+func (c *StubCalendar) IsBusinessDay(ctx context.Context, date time.Time) (bool, error) {
+	if date.Weekday() == time.Saturday || date.Weekday() == time.Sunday {
+		return false, nil
+	}
+	for _, holiday := range c.Holidays {
+		if date.Format("2006-01-02") == holiday {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+func (c *StubCalendar) NextBusinessDay(ctx context.Context, date time.Time) (time.Time, error) {
+	next := vo.DateOf(date).AddDate(0, 0, 1)
+	for {
+		if ok, _ := c.IsBusinessDay(ctx, next); ok {
+			return next, nil
+		}
+		next = next.AddDate(0, 0, 1)
+	}
+}
+
+func TestValueDatingPolicy_ValueDate(t *testing.T) {
+	window, err := vo.NewProcessingWindow("17:00", "Asia/Bangkok", []string{"TRANSFER"})
+	require.NoError(t, err)
+	bangkok := window.Location
+
+	tests := []struct {
+		name            string
+		now             time.Time
+		transactionType vo.TransactionType
+		holidays        []string
+		expected        string
+	}{
+		{
+			name:            "before_cutoff_books_today",
+			now:             time.Date(2025, 7, 1, 16, 59, 0, 0, bangkok), // Tuesday
+			transactionType: vo.TransactionTypeTransfer,
+			expected:        "2025-07-01",
+		},
+		{
+			name:            "after_cutoff_books_next_business_day",
+			now:             time.Date(2025, 7, 1, 17, 0, 0, 0, bangkok),
+			transactionType: vo.TransactionTypeTransfer,
+			expected:        "2025-07-02",
+		},
+		{
+			name:            "friday_after_cutoff_skips_weekend",
+			now:             time.Date(2025, 7, 4, 18, 0, 0, 0, bangkok),
+			transactionType: vo.TransactionTypeTransfer,
+			expected:        "2025-07-07",
+		},
+		{
+			name:            "holiday_books_next_business_day",
+			now:             time.Date(2025, 7, 1, 9, 0, 0, 0, bangkok),
+			transactionType: vo.TransactionTypeTransfer,
+			holidays:        []string{"2025-07-01", "2025-07-02"},
+			expected:        "2025-07-03",
+		},
+		{
+			name:            "cutoff_uses_window_time_zone",
+			now:             time.Date(2025, 7, 1, 9, 30, 0, 0, time.UTC), // 16:30 in Bangkok
+			transactionType: vo.TransactionTypeTransfer,
+			expected:        "2025-07-01",
+		},
+		{
+			name:            "types_outside_window_book_today",
+			now:             time.Date(2025, 7, 5, 20, 0, 0, 0, bangkok), // Saturday
+			transactionType: vo.TransactionTypeDebit,
+			expected:        "2025-07-05",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policy := NewValueDatingPolicy(&StubCalendar{Holidays: tt.holidays}, window, nil)
+			policy.now = func() time.Time { return tt.now }
+
+			valueDate, err := policy.ValueDate(context.Background(), tt.transactionType)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, valueDate.Format("2006-01-02"))
+		})
+	}
+}
@@ -0,0 +1,302 @@
+// internal/application/velocity.go
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/application/dto"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/event"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/infra"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/repository"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	// Counters outlive their window so a reservation released just after it ends finds them
+	dailyDebitCounterTTL         = 48 * time.Hour
+	hourlyTransactionsCounterTTL = 2 * time.Hour
+)
+
+// VelocityLimits counts what each account sends out, per business day of the processing time zone and
+// per hour, and rejects transactions past the configured limits. Debits and transfers out of an account
+// count; credits and admin adjustments do not. The counters are kept whether or not a limit is set, so
+// usage can always be read back.
+type VelocityLimits struct {
+	counters    infra.CounterStore
+	limits      vo.VelocityLimits
+	valueDating *ValueDatingPolicy
+	logger      infra.Logger
+}
+
+// NewVelocityLimits creates velocity limits over shared counters; windows follow the clock of valueDating
+func NewVelocityLimits(counters infra.CounterStore, limits vo.VelocityLimits, valueDating *ValueDatingPolicy, logger infra.Logger) *VelocityLimits {
+	return &VelocityLimits{counters: counters, limits: limits, valueDating: valueDating, logger: logger}
+}
+
+// Reserve counts the transaction against its source account's windows, returning ErrVelocityLimitExceeded
+// without counting it when it would go past a limit. The returned release uncounts it again, for when the
+// transaction then fails. A nil VelocityLimits counts nothing.
+func (v *VelocityLimits) Reserve(ctx context.Context, transaction *entity.Transaction) (release func(), err error) {
+	release = func() {}
+	if v == nil || !countsTowardVelocity(transaction) {
+		return release, nil
+	}
+
+	amount := minorUnits(transaction.TotalDebit())
+	dayKey, hourKey := v.keys(*transaction.FromAccountID, v.valueDating.Now())
+
+	spent, err := v.counters.IncrementBy(ctx, dayKey, amount, dailyDebitCounterTTL)
+	if err != nil {
+		return release, fmt.Errorf("%w: velocity counters unavailable: %w", errs.ErrTransient, err)
+	}
+	count, err := v.counters.IncrementBy(ctx, hourKey, 1, hourlyTransactionsCounterTTL)
+	if err != nil {
+		v.undo(ctx, dayKey, amount, dailyDebitCounterTTL)
+		return release, fmt.Errorf("%w: velocity counters unavailable: %w", errs.ErrTransient, err)
+	}
+
+	release = func() {
+		v.undo(ctx, dayKey, amount, dailyDebitCounterTTL)
+		v.undo(ctx, hourKey, 1, hourlyTransactionsCounterTTL)
+	}
+
+	if err := v.exceeded(spent-amount, count-1, amount); err != nil {
+		release()
+		return func() {}, err
+	}
+	return release, nil
+}
+
+// Check returns ErrVelocityLimitExceeded when the transaction would go past a limit, without counting it
+func (v *VelocityLimits) Check(ctx context.Context, transaction *entity.Transaction) error {
+	if v == nil || !countsTowardVelocity(transaction) {
+		return nil
+	}
+
+	spent, count, err := v.counts(ctx, *transaction.FromAccountID, v.valueDating.Now())
+	if err != nil {
+		return err
+	}
+	return v.exceeded(spent, count, minorUnits(transaction.TotalDebit()))
+}
+
+// exceeded returns ErrVelocityLimitExceeded when one more transaction of amount, in cents, goes past a
+// limit of windows already at spent and count
+func (v *VelocityLimits) exceeded(spent, count, amount int64) error {
+	if limit := v.limits.DailyDebit; limit.IsPositive() && spent+amount > minorUnits(limit) {
+		remaining := max(minorUnits(limit)-spent, 0)
+		return fmt.Errorf("%w: daily debit limit of %s leaves %s today", errs.ErrVelocityLimitExceeded,
+			limit.StringFixed(2), fromMinorUnits(remaining).StringFixed(2))
+	}
+	if limit := v.limits.HourlyTransactions; limit > 0 && count+1 > limit {
+		return fmt.Errorf("%w: at most %d outgoing transactions an hour", errs.ErrVelocityLimitExceeded, limit)
+	}
+	return nil
+}
+
+// Usage returns what the account has used of its limits in the current windows
+func (v *VelocityLimits) Usage(ctx context.Context, accountID vo.AccountID) (*dto.VelocityUsageResponse, error) {
+	now := v.valueDating.Now()
+	spent, count, err := v.counts(ctx, accountID, now)
+	if err != nil {
+		return nil, err
+	}
+
+	usage := &dto.VelocityUsageResponse{
+		AccountID: accountID.String(),
+		DailyDebit: dto.VelocityAmountUsage{
+			Used:     fromMinorUnits(spent).InexactFloat64(),
+			ResetsAt: v.valueDating.window.Date(now).AddDate(0, 0, 1),
+		},
+		HourlyTransactions: dto.VelocityCountUsage{
+			Used:     count,
+			ResetsAt: now.Truncate(time.Hour).Add(time.Hour),
+		},
+	}
+
+	if v.limits.DailyDebit.IsPositive() {
+		limit := v.limits.DailyDebit.InexactFloat64()
+		remaining := fromMinorUnits(max(minorUnits(v.limits.DailyDebit)-spent, 0)).InexactFloat64()
+		usage.DailyDebit.Limit, usage.DailyDebit.Remaining = &limit, &remaining
+	}
+	if v.limits.HourlyTransactions > 0 {
+		limit := v.limits.HourlyTransactions
+		remaining := max(limit-count, 0)
+		usage.HourlyTransactions.Limit, usage.HourlyTransactions.Remaining = &limit, &remaining
+	}
+	return usage, nil
+}
+
+// limitWarning is a limit an account has used at least the warning percentage of
+type limitWarning struct {
+	dto.LimitWarning
+	alert *event.LimitPayload // Set when the transaction is what took the usage past the warning percentage
+}
+
+// warnings returns the limits the transaction's source account has used at least the warning percentage
+// of, counting the transaction itself
+func (v *VelocityLimits) warnings(ctx context.Context, transaction *entity.Transaction) ([]limitWarning, error) {
+	if v == nil || v.limits.WarningPercent == 0 || !countsTowardVelocity(transaction) {
+		return nil, nil
+	}
+
+	now := v.valueDating.Now()
+	accountID := *transaction.FromAccountID
+	spent, count, err := v.counts(ctx, accountID, now)
+	if err != nil {
+		return nil, err
+	}
+
+	threshold := int64(v.limits.WarningPercent)
+	alert := func(limit string, before, after, maximum int64, used, allowed, remaining string) *event.LimitPayload {
+		if before*100 >= threshold*maximum {
+			return nil
+		}
+		return &event.LimitPayload{
+			AccountID:     accountID.String(),
+			TransactionID: transaction.ID.String(),
+			Limit:         limit,
+			Threshold:     v.limits.WarningPercent,
+			Used:          used,
+			Maximum:       allowed,
+			Remaining:     remaining,
+			PercentUsed:   percentUsed(after, maximum),
+		}
+	}
+
+	var warnings []limitWarning
+	if limit := minorUnits(v.limits.DailyDebit); limit > 0 && spent*100 >= threshold*limit {
+		remaining := fromMinorUnits(max(limit-spent, 0))
+		warnings = append(warnings, limitWarning{
+			LimitWarning: dto.LimitWarning{
+				Code: "DAILY_DEBIT_LIMIT_NEAR",
+				Message: fmt.Sprintf("%s of the daily debit limit of %s remains today",
+					remaining.StringFixed(2), v.limits.DailyDebit.StringFixed(2)),
+				PercentUsed: percentUsed(spent, limit),
+				Remaining:   remaining.InexactFloat64(),
+				ResetsAt:    v.valueDating.window.Date(now).AddDate(0, 0, 1),
+			},
+			alert: alert("DAILY_DEBIT", spent-minorUnits(transaction.TotalDebit()), spent, limit,
+				fromMinorUnits(spent).StringFixed(2), v.limits.DailyDebit.StringFixed(2), remaining.StringFixed(2)),
+		})
+	}
+	if limit := v.limits.HourlyTransactions; limit > 0 && count*100 >= threshold*limit {
+		remaining := max(limit-count, 0)
+		warnings = append(warnings, limitWarning{
+			LimitWarning: dto.LimitWarning{
+				Code:        "HOURLY_TRANSACTION_LIMIT_NEAR",
+				Message:     fmt.Sprintf("%d of the %d outgoing transactions allowed an hour remain", remaining, limit),
+				PercentUsed: percentUsed(count, limit),
+				Remaining:   float64(remaining),
+				ResetsAt:    now.Truncate(time.Hour).Add(time.Hour),
+			},
+			alert: alert("HOURLY_TRANSACTIONS", count-1, count, limit,
+				strconv.FormatInt(count, 10), strconv.FormatInt(limit, 10), strconv.FormatInt(remaining, 10)),
+		})
+	}
+	return warnings, nil
+}
+
+// counts returns the account's cents debited in the day, and transactions made in the hour, of now
+func (v *VelocityLimits) counts(ctx context.Context, accountID vo.AccountID, now time.Time) (spent, count int64, err error) {
+	dayKey, hourKey := v.keys(accountID, now)
+	counters, err := v.counters.GetCounters(ctx, []string{dayKey, hourKey})
+	if err != nil {
+		return 0, 0, fmt.Errorf("%w: velocity counters unavailable: %w", errs.ErrTransient, err)
+	}
+	return counters[0], counters[1], nil
+}
+
+// keys returns the counter keys of the account's daily and hourly windows at now. Days are business days
+// of the processing time zone; hours are UTC hours.
+func (v *VelocityLimits) keys(accountID vo.AccountID, now time.Time) (dayKey, hourKey string) {
+	day := v.valueDating.window.Date(now)
+	return fmt.Sprintf("velocity:daily_debit:%s:%s", accountID.String(), day.Format("20060102")),
+		fmt.Sprintf("velocity:hourly_transactions:%s:%s", accountID.String(), now.UTC().Format("2006010215"))
+}
+
+// undo takes a reservation back off a counter; failures are logged and leave the counter high until it expires
+func (v *VelocityLimits) undo(ctx context.Context, key string, delta int64, ttl time.Duration) {
+	if _, err := v.counters.IncrementBy(context.WithoutCancel(ctx), key, -delta, ttl); err != nil {
+		v.logger.Warn("Failed to release velocity counter", "error", err, "key", key)
+	}
+}
+
+// countsTowardVelocity checks if the transaction is money the customer sends out of an account
+func countsTowardVelocity(transaction *entity.Transaction) bool {
+	switch transaction.TransactionType {
+	case vo.TransactionTypeDebit, vo.TransactionTypeTransfer, vo.TransactionTypeFXTransfer:
+		return transaction.FromAccountID != nil
+	}
+	return false
+}
+
+// minorUnits converts money to whole cents, the unit of the daily debit counters
+func minorUnits(amount vo.Money) int64 {
+	return amount.Amount().Shift(2).IntPart()
+}
+
+// fromMinorUnits converts whole cents back to money
+func fromMinorUnits(cents int64) vo.Money {
+	return vo.NewMoney(decimal.New(cents, -2))
+}
+
+// percentUsed returns used as a percentage of limit, to two decimals
+func percentUsed(used, limit int64) float64 {
+	return decimal.NewFromInt(used).Mul(decimal.NewFromInt(100)).Div(decimal.NewFromInt(limit)).Round(2).InexactFloat64()
+}
+
+// warnNearLimits adds the velocity limits the source account has nearly used up to the response confirming
+// a transaction, and alerts the customer to those the transaction took past the warning percentage. The
+// counters being unavailable only leaves the warnings out.
+func (uc *transactionUseCase) warnNearLimits(ctx context.Context, transaction *entity.Transaction, response *dto.TransactionResponse) {
+	warnings, err := uc.velocity.warnings(ctx, transaction)
+	if err != nil {
+		uc.logger.Warn("Failed to check velocity limit warnings", "error", err, "transactionID", transaction.ID.String())
+		return
+	}
+
+	for _, warning := range warnings {
+		response.Warnings = append(response.Warnings, warning.LimitWarning)
+		if warning.alert != nil {
+			uc.publish(ctx, event.NewLimitAlertEvent(*warning.alert))
+		}
+	}
+}
+
+type velocityUseCase struct {
+	velocity    *VelocityLimits
+	accountRepo repository.AccountRepository
+	logger      infra.Logger
+}
+
+// NewVelocityUseCase creates a new velocity use case
+func NewVelocityUseCase(velocity *VelocityLimits, accountRepo repository.AccountRepository, logger infra.Logger) VelocityUseCase {
+	return &velocityUseCase{velocity: velocity, accountRepo: accountRepo, logger: logger}
+}
+
+// GetVelocityUsage retrieves what an account has used of its velocity limits, so clients can show what remains
+func (uc *velocityUseCase) GetVelocityUsage(ctx context.Context, accountID string) (*dto.VelocityUsageResponse, error) {
+	id, err := vo.NewAccountIDFromString(accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := uc.accountRepo.GetByID(ctx, id); err != nil {
+		uc.logger.Error("Account not found", "error", err, "accountID", accountID)
+		return nil, errs.ErrAccountNotFound
+	}
+
+	usage, err := uc.velocity.Usage(ctx, id)
+	if err != nil {
+		uc.logger.Error("Failed to read velocity counters", "error", err, "accountID", accountID)
+		return nil, err
+	}
+	return usage, nil
+}
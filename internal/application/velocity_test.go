@@ -0,0 +1,300 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/application/dto"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/event"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// StubCounterStore keeps counters in a map, failing every call with err when it is set
+type StubCounterStore struct {
+	Counters map[string]int64
+	Err      error
+}
+
+func (s *StubCounterStore) IncrementBy(ctx context.Context, key string, delta int64, ttl time.Duration) (int64, error) {
+	if s.Err != nil {
+		return 0, s.Err
+	}
+	if s.Counters == nil {
+		s.Counters = make(map[string]int64)
+	}
+	s.Counters[key] += delta
+	return s.Counters[key], nil
+}
+
+func (s *StubCounterStore) GetCounters(ctx context.Context, keys []string) ([]int64, error) {
+	if s.Err != nil {
+		return nil, s.Err
+	}
+	counters := make([]int64, len(keys))
+	for i, key := range keys {
+		counters[i] = s.Counters[key]
+	}
+	return counters, nil
+}
+
+// velocityNow is the time of the velocity tests
+var velocityNow = time.Date(2024, 3, 15, 10, 30, 0, 0, time.UTC)
+
+// newTestVelocityLimits returns velocity limits at velocityNow over an empty stub store
+func newTestVelocityLimits(dailyDebit float64, hourlyTransactions int) (*VelocityLimits, *StubCounterStore) {
+	limits, _ := vo.NewVelocityLimits(dailyDebit, hourlyTransactions, 80)
+	valueDating := NewValueDatingPolicy(&StubCalendar{}, vo.ProcessingWindow{}, nil)
+	valueDating.now = func() time.Time { return velocityNow }
+
+	mockLogger := new(MockLogger)
+	mockLogger.On("Warn", mock.Anything, mock.Anything).Maybe()
+
+	counters := &StubCounterStore{}
+	return NewVelocityLimits(counters, limits, valueDating, mockLogger), counters
+}
+
+func TestVelocityLimits_Reserve_DailyDebit(t *testing.T) {
+	ctx := context.Background()
+	velocity, _ := newTestVelocityLimits(500, 0)
+	accountID := vo.NewAccountID()
+
+	first, err := entity.NewDebitTransaction(accountID, vo.NewMoneyFromFloat(300), "Groceries", "", time.Now())
+	require.NoError(t, err)
+	release, err := velocity.Reserve(ctx, first)
+	require.NoError(t, err)
+
+	second, err := entity.NewDebitTransaction(accountID, vo.NewMoneyFromFloat(300), "Groceries", "", time.Now())
+	require.NoError(t, err)
+	assert.ErrorIs(t, velocity.Check(ctx, second), errs.ErrVelocityLimitExceeded)
+	_, err = velocity.Reserve(ctx, second)
+	assert.ErrorIs(t, err, errs.ErrVelocityLimitExceeded)
+	assert.Contains(t, err.Error(), "leaves 200.00")
+	assert.Equal(t, vo.FailureKindBusiness, failureKind(err))
+
+	// The rejected transaction was not counted
+	usage, err := velocity.Usage(ctx, accountID)
+	require.NoError(t, err)
+	assert.Equal(t, 300.0, usage.DailyDebit.Used)
+	assert.Equal(t, int64(1), usage.HourlyTransactions.Used)
+
+	// Releasing the first frees its share again
+	release()
+	usage, err = velocity.Usage(ctx, accountID)
+	require.NoError(t, err)
+	assert.Equal(t, 0.0, usage.DailyDebit.Used)
+	assert.Equal(t, 500.0, *usage.DailyDebit.Remaining)
+	_, err = velocity.Reserve(ctx, second)
+	assert.NoError(t, err)
+}
+
+func TestVelocityLimits_Reserve_HourlyTransactions(t *testing.T) {
+	ctx := context.Background()
+	velocity, _ := newTestVelocityLimits(0, 2)
+	from, to := vo.NewAccountID(), vo.NewAccountID()
+
+	for i := 0; i < 2; i++ {
+		transfer, err := entity.NewTransferTransaction(from, to, vo.NewMoneyFromFloat(10), "Lunch", "", time.Now())
+		require.NoError(t, err)
+		_, err = velocity.Reserve(ctx, transfer)
+		require.NoError(t, err)
+	}
+
+	transfer, err := entity.NewTransferTransaction(from, to, vo.NewMoneyFromFloat(10), "Lunch", "", time.Now())
+	require.NoError(t, err)
+	_, err = velocity.Reserve(ctx, transfer)
+	assert.ErrorIs(t, err, errs.ErrVelocityLimitExceeded)
+
+	// Money coming in counts toward nothing
+	credit, err := entity.NewCreditTransaction(from, vo.NewMoneyFromFloat(10), "Refund", "", time.Now())
+	require.NoError(t, err)
+	_, err = velocity.Reserve(ctx, credit)
+	assert.NoError(t, err)
+
+	// The payee's own limits are untouched by transfers into it
+	usage, err := velocity.Usage(ctx, to)
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), usage.HourlyTransactions.Used)
+}
+
+func TestVelocityLimits_Reserve_Unavailable(t *testing.T) {
+	ctx := context.Background()
+	debit, err := entity.NewDebitTransaction(vo.NewAccountID(), vo.NewMoneyFromFloat(10), "Coffee", "", time.Now())
+	require.NoError(t, err)
+
+	// Nil limits, as when they are not configured, count nothing
+	var none *VelocityLimits
+	_, err = none.Reserve(ctx, debit)
+	assert.NoError(t, err)
+
+	velocity, counters := newTestVelocityLimits(500, 0)
+	counters.Err = errors.New("connection refused")
+	_, err = velocity.Reserve(ctx, debit)
+	assert.ErrorIs(t, err, errs.ErrTransient)
+}
+
+func TestVelocityLimits_Usage(t *testing.T) {
+	ctx := context.Background()
+	accountID := vo.NewAccountID()
+
+	// Unlimited windows still report what was used
+	velocity, counters := newTestVelocityLimits(0, 0)
+	counters.Counters = map[string]int64{
+		"velocity:daily_debit:" + accountID.String() + ":20240315":           12550,
+		"velocity:hourly_transactions:" + accountID.String() + ":2024031510": 3,
+	}
+	usage, err := velocity.Usage(ctx, accountID)
+	require.NoError(t, err)
+	assert.Equal(t, 125.5, usage.DailyDebit.Used)
+	assert.Nil(t, usage.DailyDebit.Limit)
+	assert.Nil(t, usage.DailyDebit.Remaining)
+	assert.Equal(t, time.Date(2024, 3, 16, 0, 0, 0, 0, time.UTC), usage.DailyDebit.ResetsAt)
+	assert.Equal(t, int64(3), usage.HourlyTransactions.Used)
+	assert.Nil(t, usage.HourlyTransactions.Limit)
+	assert.Equal(t, time.Date(2024, 3, 15, 11, 0, 0, 0, time.UTC), usage.HourlyTransactions.ResetsAt)
+
+	velocity, _ = newTestVelocityLimits(100, 2)
+	velocity.counters = counters
+	usage, err = velocity.Usage(ctx, accountID)
+	require.NoError(t, err)
+	assert.Equal(t, 100.0, *usage.DailyDebit.Limit)
+	assert.Equal(t, 0.0, *usage.DailyDebit.Remaining)
+	assert.Equal(t, int64(2), *usage.HourlyTransactions.Limit)
+	assert.Equal(t, int64(0), *usage.HourlyTransactions.Remaining)
+}
+
+func TestVelocityUseCase_GetVelocityUsage(t *testing.T) {
+	account := createTestAccount()
+	mockAccountRepo := new(MockAccountRepository)
+	mockLogger := new(MockLogger)
+	mockLogger.On("Error", mock.Anything, mock.Anything).Maybe()
+	mockAccountRepo.On("GetByID", mock.Anything, account.ID).Return(account, nil)
+	mockAccountRepo.On("GetByID", mock.Anything, mock.Anything).Return((*entity.Account)(nil), errs.ErrAccountNotFound)
+
+	velocity, _ := newTestVelocityLimits(1000, 0)
+	uc := NewVelocityUseCase(velocity, mockAccountRepo, mockLogger)
+
+	usage, err := uc.GetVelocityUsage(context.Background(), account.ID.String())
+	require.NoError(t, err)
+	assert.Equal(t, account.ID.String(), usage.AccountID)
+	assert.Equal(t, 1000.0, *usage.DailyDebit.Remaining)
+
+	_, err = uc.GetVelocityUsage(context.Background(), vo.NewAccountID().String())
+	assert.ErrorIs(t, err, errs.ErrAccountNotFound)
+}
+
+func (suite *TransactionUseCaseTestSuite) TestConfirmTransaction_VelocityLimitExceeded() {
+	id := suite.expectConfirmationLock()
+	velocity, _ := newTestVelocityLimits(50, 0)
+	suite.usecase.(*transactionUseCase).velocity = velocity
+
+	suite.mockTxnRepo.On("GetByID", suite.ctx, suite.testTransaction.ID).Return(suite.testTransaction, nil)
+	suite.mockTxnRepo.On("Update", suite.ctx, suite.testTransaction).Return(nil)
+
+	_, err := suite.usecase.ConfirmTransaction(suite.ctx, dto.ConfirmTransactionRequest{ID: id})
+
+	assert.ErrorIs(suite.T(), err, errs.ErrVelocityLimitExceeded)
+	assert.Equal(suite.T(), vo.TransactionStatusFailed, suite.testTransaction.Status)
+	assert.Equal(suite.T(), vo.FailureKindBusiness, suite.testTransaction.FailureKind)
+	suite.mockAccountRepo.AssertNotCalled(suite.T(), "UpdateFenced", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *TransactionUseCaseTestSuite) TestConfirmTransaction_FailureReleasesVelocity() {
+	id := suite.expectConfirmationLock()
+	velocity, _ := newTestVelocityLimits(1000, 0)
+	suite.usecase.(*transactionUseCase).velocity = velocity
+	suite.testAccount.Balance = vo.NewMoneyFromFloat(50)
+
+	suite.mockTxnRepo.On("GetByID", suite.ctx, suite.testTransaction.ID).Return(suite.testTransaction, nil)
+	suite.mockTxnRepo.On("Update", suite.ctx, suite.testTransaction).Return(nil)
+	suite.mockAccountRepo.On("GetByIDForUpdate", suite.ctx, suite.testAccount.ID).Return(suite.testAccount, nil)
+	suite.mockAccountRepo.On("IsApplied", suite.ctx, suite.testTransaction.ID, suite.testAccount.ID).Return(false, nil)
+
+	_, err := suite.usecase.ConfirmTransaction(suite.ctx, dto.ConfirmTransactionRequest{ID: id})
+	suite.Require().ErrorIs(err, errs.ErrInsufficientBalance)
+
+	// The declined debit does not use up the day's limit
+	usage, err := velocity.Usage(suite.ctx, suite.testAccount.ID)
+	suite.Require().NoError(err)
+	assert.Equal(suite.T(), 0.0, usage.DailyDebit.Used)
+	assert.Equal(suite.T(), int64(0), usage.HourlyTransactions.Used)
+}
+
+func TestVelocityLimits_Warnings(t *testing.T) {
+	ctx := context.Background()
+	velocity, _ := newTestVelocityLimits(500, 5)
+	accountID := vo.NewAccountID()
+
+	reserve := func(amount float64) *entity.Transaction {
+		debit, err := entity.NewDebitTransaction(accountID, vo.NewMoneyFromFloat(amount), "Groceries", "", time.Now())
+		require.NoError(t, err)
+		_, err = velocity.Reserve(ctx, debit)
+		require.NoError(t, err)
+		return debit
+	}
+
+	// Under 80% of every limit nothing is said
+	warnings, err := velocity.warnings(ctx, reserve(300))
+	require.NoError(t, err)
+	assert.Empty(t, warnings)
+
+	// The debit taking the day past 80% warns and alerts
+	debit := reserve(120)
+	warnings, err = velocity.warnings(ctx, debit)
+	require.NoError(t, err)
+	require.Len(t, warnings, 1)
+	assert.Equal(t, "DAILY_DEBIT_LIMIT_NEAR", warnings[0].Code)
+	assert.Equal(t, 84.0, warnings[0].PercentUsed)
+	assert.Equal(t, 80.0, warnings[0].Remaining)
+	assert.Equal(t, time.Date(2024, 3, 16, 0, 0, 0, 0, time.UTC), warnings[0].ResetsAt)
+	require.NotNil(t, warnings[0].alert)
+	assert.Equal(t, "DAILY_DEBIT", warnings[0].alert.Limit)
+	assert.Equal(t, debit.ID.String(), warnings[0].alert.TransactionID)
+	assert.Equal(t, "80.00", warnings[0].alert.Remaining)
+
+	// Later debits keep warning without alerting again
+	warnings, err = velocity.warnings(ctx, reserve(10))
+	require.NoError(t, err)
+	require.Len(t, warnings, 1)
+	assert.Nil(t, warnings[0].alert)
+
+	// The fourth transaction of the hour reaches 80% of five
+	warnings, err = velocity.warnings(ctx, reserve(10))
+	require.NoError(t, err)
+	require.Len(t, warnings, 2)
+	assert.Equal(t, "HOURLY_TRANSACTION_LIMIT_NEAR", warnings[1].Code)
+	assert.Equal(t, 1.0, warnings[1].Remaining)
+	require.NotNil(t, warnings[1].alert)
+	assert.Equal(t, "4", warnings[1].alert.Used)
+}
+
+func (suite *TransactionUseCaseTestSuite) TestConfirmTransaction_WarnsNearLimit() {
+	id := suite.expectConfirmationLock()
+	velocity, _ := newTestVelocityLimits(110, 0)
+	suite.usecase.(*transactionUseCase).velocity = velocity
+
+	suite.mockTxnRepo.On("GetByID", suite.ctx, suite.testTransaction.ID).Return(suite.testTransaction, nil)
+	suite.mockTxnRepo.On("Update", suite.ctx, suite.testTransaction).Return(nil)
+	suite.mockAccountRepo.On("GetByIDForUpdate", suite.ctx, suite.testAccount.ID).Return(suite.testAccount, nil)
+	suite.mockAccountRepo.On("IsApplied", suite.ctx, suite.testTransaction.ID, suite.testAccount.ID).Return(false, nil)
+	suite.mockAccountRepo.On("UpdateFenced", suite.ctx, suite.testAccount, suite.testTransaction.ID, mock.AnythingOfType("int64")).Return(nil)
+	suite.mockCache.On("Set", suite.ctx, mock.AnythingOfType("string"), mock.Anything, mock.Anything).Return(nil)
+	suite.mockCache.On("Delete", suite.ctx, mock.AnythingOfType("string")).Return(nil)
+
+	result, err := suite.usecase.ConfirmTransaction(suite.ctx, dto.ConfirmTransactionRequest{ID: id})
+
+	suite.Require().NoError(err)
+	suite.Require().Len(result.Warnings, 1)
+	assert.Equal(suite.T(), "DAILY_DEBIT_LIMIT_NEAR", result.Warnings[0].Code)
+	assert.Equal(suite.T(), 10.0, result.Warnings[0].Remaining)
+	suite.Require().Len(suite.mockEvents.Events, 2)
+	assert.Equal(suite.T(), event.LimitThresholdReached, suite.mockEvents.Events[0].Type)
+	assert.Equal(suite.T(), suite.testAccount.ID.String(), suite.mockEvents.Events[0].Key)
+	assert.Equal(suite.T(), event.TransactionCompleted, suite.mockEvents.Events[1].Type)
+}
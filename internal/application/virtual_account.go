@@ -0,0 +1,182 @@
+// internal/application/virtual_account.go
+package usecase
+
+import (
+	"context"
+
+	"github.com/hydr0g3nz/mini_bank/internal/application/dto"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/infra"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/repository"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+)
+
+type virtualAccountUseCase struct {
+	virtualAccountRepo repository.VirtualAccountRepository
+	accountRepo        repository.AccountRepository
+	transactionRepo    repository.TransactionRepository
+	logger             infra.Logger
+	mapper             *dto.VirtualAccountMapper
+	transactionMapper  *dto.TransactionMapper
+}
+
+// NewVirtualAccountUseCase creates a new virtual account use case
+func NewVirtualAccountUseCase(
+	virtualAccountRepo repository.VirtualAccountRepository,
+	accountRepo repository.AccountRepository,
+	transactionRepo repository.TransactionRepository,
+	logger infra.Logger,
+) VirtualAccountUseCase {
+	return &virtualAccountUseCase{
+		virtualAccountRepo: virtualAccountRepo,
+		accountRepo:        accountRepo,
+		transactionRepo:    transactionRepo,
+		logger:             logger,
+		mapper:             &dto.VirtualAccountMapper{},
+		transactionMapper:  &dto.TransactionMapper{},
+	}
+}
+
+// CreateVirtualAccount issues a new virtual account number crediting into the settlement account
+func (uc *virtualAccountUseCase) CreateVirtualAccount(ctx context.Context, req dto.CreateVirtualAccountRequest) (*dto.VirtualAccountResponse, error) {
+	accountID, err := vo.NewAccountIDFromString(req.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := uc.accountRepo.GetByID(ctx, accountID); err != nil {
+		return nil, errs.ErrAccountNotFound
+	}
+
+	virtualAccount, err := entity.NewVirtualAccount(accountID, req.Label)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.virtualAccountRepo.Create(ctx, virtualAccount); err != nil {
+		uc.logger.Error("Failed to create virtual account", "error", err, "accountID", req.AccountID)
+		return nil, err
+	}
+
+	uc.logger.Info("Virtual account issued", "virtualAccountID", virtualAccount.ID, "accountID", req.AccountID)
+	response := uc.mapper.ToResponse(virtualAccount)
+	return &response, nil
+}
+
+// GetVirtualAccount looks up a virtual account number and the account it settles into
+func (uc *virtualAccountUseCase) GetVirtualAccount(ctx context.Context, id string) (*dto.VirtualAccountResponse, error) {
+	virtualAccount, err := uc.virtualAccountRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	response := uc.mapper.ToResponse(virtualAccount)
+	return &response, nil
+}
+
+// ListVirtualAccounts retrieves the virtual accounts of a settlement account with pagination
+func (uc *virtualAccountUseCase) ListVirtualAccounts(ctx context.Context, accountID string, req dto.ListRequest) (*dto.VirtualAccountListResponse, error) {
+	parsedAccountID, err := vo.NewAccountIDFromString(accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	offset := (req.Page - 1) * req.PageSize
+
+	virtualAccounts, err := uc.virtualAccountRepo.ListByAccountID(ctx, parsedAccountID, req.PageSize, offset)
+	if err != nil {
+		uc.logger.Error("Failed to list virtual accounts", "error", err, "accountID", accountID)
+		return nil, err
+	}
+
+	total, err := uc.virtualAccountRepo.CountByAccountID(ctx, parsedAccountID)
+	if err != nil {
+		uc.logger.Error("Failed to count virtual accounts", "error", err, "accountID", accountID)
+		return nil, err
+	}
+
+	response := uc.mapper.ToResponseList(virtualAccounts, dto.NewPaginationInfo(req.Page, req.PageSize, total))
+	return &response, nil
+}
+
+// UpdateVirtualAccount relabels, closes or reopens a virtual account of a settlement account
+func (uc *virtualAccountUseCase) UpdateVirtualAccount(ctx context.Context, req dto.UpdateVirtualAccountRequest) (*dto.VirtualAccountResponse, error) {
+	virtualAccount, err := uc.accountVirtualAccount(ctx, req.AccountID, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := virtualAccount.Rename(req.Label); err != nil {
+		return nil, err
+	}
+	if err := virtualAccount.SetStatus(entity.VirtualAccountStatus(req.Status)); err != nil {
+		return nil, err
+	}
+
+	if err := uc.virtualAccountRepo.Update(ctx, virtualAccount); err != nil {
+		uc.logger.Error("Failed to update virtual account", "error", err, "virtualAccountID", req.ID)
+		return nil, err
+	}
+
+	uc.logger.Info("Virtual account updated", "virtualAccountID", req.ID, "status", req.Status)
+	response := uc.mapper.ToResponse(virtualAccount)
+	return &response, nil
+}
+
+// DeleteVirtualAccount removes a virtual account of a settlement account. Transactions
+// already paid to it keep the virtual number.
+func (uc *virtualAccountUseCase) DeleteVirtualAccount(ctx context.Context, accountID, id string) error {
+	if _, err := uc.accountVirtualAccount(ctx, accountID, id); err != nil {
+		return err
+	}
+
+	if err := uc.virtualAccountRepo.Delete(ctx, id); err != nil {
+		uc.logger.Error("Failed to delete virtual account", "error", err, "virtualAccountID", id)
+		return err
+	}
+
+	uc.logger.Info("Virtual account deleted", "virtualAccountID", id, "accountID", accountID)
+	return nil
+}
+
+// GetVirtualAccountTransactions retrieves the transactions paid to a virtual account number for reconciliation
+func (uc *virtualAccountUseCase) GetVirtualAccountTransactions(ctx context.Context, id string, req dto.ListRequest) (*dto.TransactionListResponse, error) {
+	offset := (req.Page - 1) * req.PageSize
+
+	transactions, err := uc.transactionRepo.GetByVirtualAccountID(ctx, id, req.PageSize, offset)
+	if err != nil {
+		uc.logger.Error("Failed to get virtual account transactions", "error", err, "virtualAccountID", id)
+		return nil, err
+	}
+
+	total, err := uc.transactionRepo.CountByVirtualAccountID(ctx, id)
+	if err != nil {
+		uc.logger.Error("Failed to count virtual account transactions", "error", err, "virtualAccountID", id)
+		return nil, err
+	}
+
+	// A deleted virtual account still has its transactions; an unknown one has none
+	if total == 0 {
+		if _, err := uc.virtualAccountRepo.GetByID(ctx, id); err != nil {
+			return nil, err
+		}
+	}
+
+	response := uc.transactionMapper.ToResponseList(transactions, dto.NewPaginationInfo(req.Page, req.PageSize, total))
+	return &response, nil
+}
+
+// accountVirtualAccount retrieves a virtual account, hiding those of other accounts
+func (uc *virtualAccountUseCase) accountVirtualAccount(ctx context.Context, accountID, id string) (*entity.VirtualAccount, error) {
+	virtualAccount, err := uc.virtualAccountRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if virtualAccount.AccountID.String() != accountID {
+		return nil, errs.ErrVirtualAccountNotFound
+	}
+
+	return virtualAccount, nil
+}
@@ -0,0 +1,202 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/application/dto"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockVirtualAccountRepository is a mock implementation of VirtualAccountRepository
+type MockVirtualAccountRepository struct {
+	mock.Mock
+}
+
+func (m *MockVirtualAccountRepository) Create(ctx context.Context, virtualAccount *entity.VirtualAccount) error {
+	args := m.Called(ctx, virtualAccount)
+	return args.Error(0)
+}
+
+func (m *MockVirtualAccountRepository) GetByID(ctx context.Context, id string) (*entity.VirtualAccount, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.VirtualAccount), args.Error(1)
+}
+
+func (m *MockVirtualAccountRepository) Update(ctx context.Context, virtualAccount *entity.VirtualAccount) error {
+	args := m.Called(ctx, virtualAccount)
+	return args.Error(0)
+}
+
+func (m *MockVirtualAccountRepository) Delete(ctx context.Context, id string) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockVirtualAccountRepository) ListByAccountID(ctx context.Context, accountID vo.AccountID, limit, offset int) ([]*entity.VirtualAccount, error) {
+	args := m.Called(ctx, accountID, limit, offset)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.VirtualAccount), args.Error(1)
+}
+
+func (m *MockVirtualAccountRepository) CountByAccountID(ctx context.Context, accountID vo.AccountID) (int64, error) {
+	args := m.Called(ctx, accountID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+// newVirtualAccountFixture returns a use case over a settlement account with one virtual account
+func newVirtualAccountFixture(t *testing.T) (*MockVirtualAccountRepository, *MockAccountRepository, *MockTransactionRepository, VirtualAccountUseCase, *entity.VirtualAccount) {
+	account, err := entity.NewAccount("Settlement", vo.NewMoneyFromFloat(0))
+	require.NoError(t, err)
+	virtualAccount, err := entity.NewVirtualAccount(account.ID, "Invoice 1001")
+	require.NoError(t, err)
+
+	mockVirtualRepo := new(MockVirtualAccountRepository)
+	mockAccountRepo := new(MockAccountRepository)
+	mockTxnRepo := new(MockTransactionRepository)
+	mockLogger := new(MockLogger)
+	mockLogger.On("Info", mock.Anything, mock.Anything).Maybe()
+	mockLogger.On("Error", mock.Anything, mock.Anything).Maybe()
+
+	mockAccountRepo.On("GetByID", mock.Anything, account.ID).Return(account, nil).Maybe()
+	mockVirtualRepo.On("GetByID", mock.Anything, virtualAccount.ID).Return(virtualAccount, nil).Maybe()
+
+	uc := NewVirtualAccountUseCase(mockVirtualRepo, mockAccountRepo, mockTxnRepo, mockLogger)
+	return mockVirtualRepo, mockAccountRepo, mockTxnRepo, uc, virtualAccount
+}
+
+func TestVirtualAccountUseCase_CreateVirtualAccount(t *testing.T) {
+	mockVirtualRepo, _, _, uc, existing := newVirtualAccountFixture(t)
+	mockVirtualRepo.On("Create", mock.Anything, mock.AnythingOfType("*entity.VirtualAccount")).Return(nil)
+
+	response, err := uc.CreateVirtualAccount(context.Background(), dto.CreateVirtualAccountRequest{
+		AccountID: existing.AccountID.String(),
+		Label:     "Invoice 1002",
+	})
+
+	require.NoError(t, err)
+	assert.Len(t, response.ID, 18)
+	assert.Equal(t, existing.AccountID.String(), response.AccountID)
+	assert.Equal(t, "ACTIVE", response.Status)
+	mockVirtualRepo.AssertExpectations(t)
+}
+
+func TestVirtualAccountUseCase_CreateVirtualAccount_AccountNotFound(t *testing.T) {
+	mockVirtualRepo, mockAccountRepo, _, uc, _ := newVirtualAccountFixture(t)
+	unknown := vo.NewAccountID()
+	mockAccountRepo.On("GetByID", mock.Anything, unknown).Return((*entity.Account)(nil), errs.ErrAccountNotFound)
+
+	_, err := uc.CreateVirtualAccount(context.Background(), dto.CreateVirtualAccountRequest{AccountID: unknown.String()})
+
+	assert.ErrorIs(t, err, errs.ErrAccountNotFound)
+	mockVirtualRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestVirtualAccountUseCase_UpdateVirtualAccount(t *testing.T) {
+	mockVirtualRepo, _, _, uc, virtualAccount := newVirtualAccountFixture(t)
+	mockVirtualRepo.On("Update", mock.Anything, virtualAccount).Return(nil)
+
+	response, err := uc.UpdateVirtualAccount(context.Background(), dto.UpdateVirtualAccountRequest{
+		AccountID: virtualAccount.AccountID.String(),
+		ID:        virtualAccount.ID,
+		Label:     "Invoice 1001 (paid)",
+		Status:    "CLOSED",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "CLOSED", response.Status)
+	assert.Equal(t, "Invoice 1001 (paid)", response.Label)
+	assert.False(t, virtualAccount.CanReceive())
+}
+
+func TestVirtualAccountUseCase_DeleteVirtualAccount_OtherAccount(t *testing.T) {
+	mockVirtualRepo, _, _, uc, virtualAccount := newVirtualAccountFixture(t)
+
+	err := uc.DeleteVirtualAccount(context.Background(), vo.NewAccountID().String(), virtualAccount.ID)
+
+	assert.ErrorIs(t, err, errs.ErrVirtualAccountNotFound)
+	mockVirtualRepo.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+}
+
+func TestVirtualAccountUseCase_GetVirtualAccountTransactions(t *testing.T) {
+	mockVirtualRepo, _, mockTxnRepo, uc, virtualAccount := newVirtualAccountFixture(t)
+	transaction, err := entity.NewCreditTransaction(virtualAccount.AccountID, vo.NewMoneyFromFloat(75), "Invoice payment", "INV-1001", time.Now())
+	require.NoError(t, err)
+	transaction.VirtualAccountID = virtualAccount.ID
+
+	mockTxnRepo.On("GetByVirtualAccountID", mock.Anything, virtualAccount.ID, 10, 0).Return([]*entity.Transaction{transaction}, nil)
+	mockTxnRepo.On("CountByVirtualAccountID", mock.Anything, virtualAccount.ID).Return(int64(1), nil)
+
+	response, err := uc.GetVirtualAccountTransactions(context.Background(), virtualAccount.ID, dto.ListRequest{Page: 1, PageSize: 10})
+
+	require.NoError(t, err)
+	require.Len(t, response.Transactions, 1)
+	assert.Equal(t, virtualAccount.ID, response.Transactions[0].VirtualAccountID)
+	assert.Equal(t, int64(1), response.Pagination.TotalItems)
+
+	// An unknown virtual account is reported instead of an empty list
+	mockTxnRepo.On("GetByVirtualAccountID", mock.Anything, "VA2026010100000000", 10, 0).Return([]*entity.Transaction{}, nil)
+	mockTxnRepo.On("CountByVirtualAccountID", mock.Anything, "VA2026010100000000").Return(int64(0), nil)
+	mockVirtualRepo.On("GetByID", mock.Anything, "VA2026010100000000").Return(nil, errs.ErrVirtualAccountNotFound)
+
+	_, err = uc.GetVirtualAccountTransactions(context.Background(), "VA2026010100000000", dto.ListRequest{Page: 1, PageSize: 10})
+	assert.ErrorIs(t, err, errs.ErrVirtualAccountNotFound)
+}
+
+func (suite *TransactionUseCaseTestSuite) TestCreateTransaction_Credit_VirtualAccount() {
+	virtualAccount, err := entity.NewVirtualAccount(suite.testAccount.ID, "Invoice 1001")
+	suite.Require().NoError(err)
+
+	suite.mockVirtualRepo.On("GetByID", suite.ctx, virtualAccount.ID).Return(virtualAccount, nil)
+	suite.mockAccountRepo.On("GetByID", suite.ctx, suite.testAccount.ID).Return(suite.testAccount, nil)
+	suite.mockTxnRepo.On("Create", suite.ctx, mock.AnythingOfType("*entity.Transaction")).Return(nil)
+	suite.mockCache.On("Set", suite.ctx, mock.AnythingOfType("string"), mock.Anything, 30*time.Minute).Return(nil)
+
+	result, err := suite.usecase.CreateTransaction(suite.ctx, dto.CreateTransactionRequest{
+		ToVirtualAccountID: virtualAccount.ID,
+		TransactionType:    "CREDIT",
+		Amount:             dto.NewDecimalStringFromFloat(75.0),
+		Description:        "Invoice payment",
+	})
+
+	suite.Require().NoError(err)
+	suite.Require().NotNil(result.ToAccountID)
+	assert.Equal(suite.T(), suite.testAccount.ID.String(), *result.ToAccountID)
+	assert.Equal(suite.T(), virtualAccount.ID, result.VirtualAccountID)
+}
+
+func (suite *TransactionUseCaseTestSuite) TestCreateTransaction_VirtualAccountRejected() {
+	closed, err := entity.NewVirtualAccount(suite.testAccount.ID, "Closed invoice")
+	suite.Require().NoError(err)
+	suite.Require().NoError(closed.SetStatus(entity.VirtualAccountStatusClosed))
+	suite.mockVirtualRepo.On("GetByID", suite.ctx, closed.ID).Return(closed, nil)
+
+	_, err = suite.usecase.CreateTransaction(suite.ctx, dto.CreateTransactionRequest{
+		ToVirtualAccountID: closed.ID,
+		TransactionType:    "CREDIT",
+		Amount:             dto.NewDecimalStringFromFloat(75.0),
+	})
+	assert.ErrorIs(suite.T(), err, errs.ErrVirtualAccountClosed)
+
+	toAccountID := suite.testAccount.ID.String()
+	_, err = suite.usecase.CreateTransaction(suite.ctx, dto.CreateTransactionRequest{
+		ToAccountID:        &toAccountID,
+		ToVirtualAccountID: closed.ID,
+		TransactionType:    "CREDIT",
+		Amount:             dto.NewDecimalStringFromFloat(75.0),
+	})
+	var validationErr errs.ValidationError
+	assert.ErrorAs(suite.T(), err, &validationErr)
+	suite.mockTxnRepo.AssertNotCalled(suite.T(), "Create", mock.Anything, mock.Anything)
+}
@@ -0,0 +1,359 @@
+// internal/application/webhook.go
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hydr0g3nz/mini_bank/internal/application/dto"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/event"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/infra"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/repository"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+)
+
+// WebhookNotifier delivers account webhooks when transactions complete: credit.received to
+// subscribers of the receiving account and balance.below_threshold to subscribers of the
+// paying account whose threshold the payment crossed. It wraps the event publisher so each
+// event is delivered once, by the instance that completed the transaction.
+type WebhookNotifier struct {
+	webhookRepo repository.WebhookRepository
+	accountRepo repository.AccountRepository
+	sender      infra.WebhookSender
+	maxFailures int
+	next        infra.EventPublisher
+	logger      infra.Logger
+}
+
+// NewWebhookNotifier creates a webhook notifier publishing through next
+func NewWebhookNotifier(
+	webhookRepo repository.WebhookRepository,
+	accountRepo repository.AccountRepository,
+	sender infra.WebhookSender,
+	maxFailures int,
+	next infra.EventPublisher,
+	logger infra.Logger,
+) *WebhookNotifier {
+	return &WebhookNotifier{
+		webhookRepo: webhookRepo,
+		accountRepo: accountRepo,
+		sender:      sender,
+		maxFailures: maxFailures,
+		next:        next,
+		logger:      logger,
+	}
+}
+
+// Publish forwards the event and delivers webhooks of completed transactions in the background
+func (n *WebhookNotifier) Publish(ctx context.Context, evt event.Event) error {
+	err := n.next.Publish(ctx, evt)
+
+	if evt.Type == event.TransactionCompleted {
+		go n.notify(context.WithoutCancel(ctx), evt)
+	}
+
+	return err
+}
+
+// notify delivers the webhooks a completed transaction triggers
+func (n *WebhookNotifier) notify(ctx context.Context, evt event.Event) {
+	payload, err := evt.DecodeTransaction()
+	if err != nil {
+		n.logger.Warn("Failed to decode transaction event", "error", err, "eventID", evt.ID)
+		return
+	}
+
+	amount, err := vo.NewMoneyFromString(payload.Amount)
+	if err != nil {
+		n.logger.Warn("Invalid transaction amount in event", "error", err, "eventID", evt.ID)
+		return
+	}
+	amountValue := amount.InexactFloat64()
+
+	if payload.ToAccountID != nil {
+		n.deliverAll(ctx, *payload.ToAccountID, entity.WebhookEventCreditReceived, func(*entity.WebhookSubscription) (dto.WebhookData, bool) {
+			return dto.WebhookData{
+				TransactionID:    payload.TransactionID,
+				Amount:           &amountValue,
+				FromAccountID:    payload.FromAccountID,
+				VirtualAccountID: payload.VirtualAccountID,
+				Description:      payload.Description,
+				Reference:        payload.Reference,
+			}, true
+		}, evt)
+	}
+
+	if payload.FromAccountID != nil {
+		accountID, err := vo.NewAccountIDFromString(*payload.FromAccountID)
+		if err != nil {
+			return
+		}
+
+		account, err := n.accountRepo.GetByID(ctx, accountID)
+		if err != nil {
+			n.logger.Warn("Failed to load account for webhooks", "error", err, "accountID", accountID.String())
+			return
+		}
+
+		// The balance now reflects the payment; before it, the amount was still there
+		after := account.Balance
+		before, _ := after.Add(amount)
+		balance := after.InexactFloat64()
+
+		n.deliverAll(ctx, *payload.FromAccountID, entity.WebhookEventBalanceBelowThreshold, func(webhook *entity.WebhookSubscription) (dto.WebhookData, bool) {
+			if !webhook.CrossedBelowThreshold(before, after) {
+				return dto.WebhookData{}, false
+			}
+			threshold := webhook.Threshold.InexactFloat64()
+			return dto.WebhookData{
+				TransactionID: payload.TransactionID,
+				Amount:        &amountValue,
+				Balance:       &balance,
+				Threshold:     &threshold,
+			}, true
+		}, evt)
+	}
+}
+
+// deliverAll delivers an event to the account's subscribers the data function selects
+func (n *WebhookNotifier) deliverAll(
+	ctx context.Context,
+	accountID string,
+	webhookEvent entity.WebhookEvent,
+	data func(*entity.WebhookSubscription) (dto.WebhookData, bool),
+	evt event.Event,
+) {
+	parsedAccountID, err := vo.NewAccountIDFromString(accountID)
+	if err != nil {
+		return
+	}
+
+	webhooks, err := n.webhookRepo.ListByAccountID(ctx, parsedAccountID)
+	if err != nil {
+		n.logger.Warn("Failed to load webhooks", "error", err, "accountID", accountID)
+		return
+	}
+
+	for _, webhook := range webhooks {
+		if !webhook.Subscribes(webhookEvent) {
+			continue
+		}
+		webhookData, ok := data(webhook)
+		if !ok {
+			continue
+		}
+
+		n.deliver(ctx, webhook, dto.WebhookPayload{
+			ID:         fmt.Sprintf("%s-%s", evt.ID, webhookEvent),
+			Event:      string(webhookEvent),
+			AccountID:  accountID,
+			OccurredAt: evt.OccurredAt,
+			Data:       webhookData,
+		})
+	}
+}
+
+// deliver posts one payload and records the outcome on the subscription
+func (n *WebhookNotifier) deliver(ctx context.Context, webhook *entity.WebhookSubscription, payload dto.WebhookPayload) {
+	if _, err := send(ctx, n.sender, webhook, payload); err != nil {
+		if webhook.RecordFailure(err.Error(), n.maxFailures) {
+			n.logger.Warn("Webhook disabled after repeated failures", "webhookID", webhook.ID, "failures", webhook.ConsecutiveFailures)
+		} else {
+			n.logger.Warn("Webhook delivery failed", "error", err, "webhookID", webhook.ID, "event", payload.Event)
+		}
+	} else {
+		webhook.RecordSuccess()
+	}
+
+	if err := n.webhookRepo.Update(ctx, webhook); err != nil {
+		n.logger.Error("Failed to record webhook delivery", "error", err, "webhookID", webhook.ID)
+	}
+}
+
+// send encodes and posts a payload to a subscription
+func send(ctx context.Context, sender infra.WebhookSender, webhook *entity.WebhookSubscription, payload dto.WebhookPayload) (*infra.WebhookDelivery, error) {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode webhook payload: %w", err)
+	}
+	return sender.Send(ctx, webhook.URL, webhook.Secret, body)
+}
+
+// Ensure WebhookNotifier can stand in for the event publisher it wraps
+var _ infra.EventPublisher = (*WebhookNotifier)(nil)
+
+type webhookUseCase struct {
+	webhookRepo repository.WebhookRepository
+	accountRepo repository.AccountRepository
+	sender      infra.WebhookSender
+	valueDating *ValueDatingPolicy
+	logger      infra.Logger
+	mapper      *dto.WebhookMapper
+}
+
+// NewWebhookUseCase creates a new webhook use case
+func NewWebhookUseCase(
+	webhookRepo repository.WebhookRepository,
+	accountRepo repository.AccountRepository,
+	sender infra.WebhookSender,
+	valueDating *ValueDatingPolicy,
+	logger infra.Logger,
+) WebhookUseCase {
+	return &webhookUseCase{
+		webhookRepo: webhookRepo,
+		accountRepo: accountRepo,
+		sender:      sender,
+		valueDating: valueDating,
+		logger:      logger,
+		mapper:      &dto.WebhookMapper{},
+	}
+}
+
+// CreateWebhook subscribes a URL to an account's events; the response carries the signing secret
+func (uc *webhookUseCase) CreateWebhook(ctx context.Context, req dto.CreateWebhookRequest) (*dto.WebhookResponse, error) {
+	accountID, err := vo.NewAccountIDFromString(req.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := uc.accountRepo.GetByID(ctx, accountID); err != nil {
+		return nil, err
+	}
+
+	events := make([]entity.WebhookEvent, len(req.Events))
+	for i, evt := range req.Events {
+		events[i] = entity.WebhookEvent(evt)
+	}
+
+	var threshold *vo.Money
+	if req.Threshold != nil {
+		amount := req.Threshold.Money()
+		threshold = &amount
+	}
+
+	webhook, err := entity.NewWebhookSubscription(accountID, req.URL, events, threshold)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.webhookRepo.Create(ctx, webhook); err != nil {
+		uc.logger.Error("Failed to create webhook", "error", err, "accountID", req.AccountID)
+		return nil, err
+	}
+
+	uc.logger.Info("Webhook created", "webhookID", webhook.ID, "accountID", req.AccountID, "events", req.Events)
+	response := uc.mapper.ToResponse(webhook)
+	response.Secret = webhook.Secret
+	return &response, nil
+}
+
+// GetWebhook retrieves a webhook subscription of an account
+func (uc *webhookUseCase) GetWebhook(ctx context.Context, accountID, id string) (*dto.WebhookResponse, error) {
+	webhook, err := uc.accountWebhook(ctx, accountID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	response := uc.mapper.ToResponse(webhook)
+	return &response, nil
+}
+
+// ListWebhooks retrieves the webhook subscriptions of an account
+func (uc *webhookUseCase) ListWebhooks(ctx context.Context, accountID string) (*dto.WebhookListResponse, error) {
+	parsedAccountID, err := vo.NewAccountIDFromString(accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	webhooks, err := uc.webhookRepo.ListByAccountID(ctx, parsedAccountID)
+	if err != nil {
+		uc.logger.Error("Failed to list webhooks", "error", err, "accountID", accountID)
+		return nil, err
+	}
+
+	responses := make([]dto.WebhookResponse, len(webhooks))
+	for i, webhook := range webhooks {
+		responses[i] = uc.mapper.ToResponse(webhook)
+	}
+
+	return &dto.WebhookListResponse{Webhooks: responses}, nil
+}
+
+// DeleteWebhook removes a webhook subscription of an account
+func (uc *webhookUseCase) DeleteWebhook(ctx context.Context, accountID, id string) error {
+	webhook, err := uc.accountWebhook(ctx, accountID, id)
+	if err != nil {
+		return err
+	}
+
+	if err := uc.webhookRepo.Delete(ctx, webhook.ID); err != nil {
+		uc.logger.Error("Failed to delete webhook", "error", err, "webhookID", id)
+		return err
+	}
+
+	uc.logger.Info("Webhook deleted", "webhookID", id, "accountID", accountID)
+	return nil
+}
+
+// EnableWebhook re-activates a webhook subscription disabled after failed deliveries
+func (uc *webhookUseCase) EnableWebhook(ctx context.Context, accountID, id string) (*dto.WebhookResponse, error) {
+	webhook, err := uc.accountWebhook(ctx, accountID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	webhook.Enable()
+	if err := uc.webhookRepo.Update(ctx, webhook); err != nil {
+		uc.logger.Error("Failed to enable webhook", "error", err, "webhookID", id)
+		return nil, err
+	}
+
+	uc.logger.Info("Webhook enabled", "webhookID", id, "accountID", accountID)
+	response := uc.mapper.ToResponse(webhook)
+	return &response, nil
+}
+
+// TestWebhook sends a webhook.test event to the subscription URL and reports the outcome.
+// Test deliveries do not count towards disabling the subscription.
+func (uc *webhookUseCase) TestWebhook(ctx context.Context, accountID, id string) (*dto.WebhookTestResponse, error) {
+	webhook, err := uc.accountWebhook(ctx, accountID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	now := uc.valueDating.Now()
+	delivery, err := send(ctx, uc.sender, webhook, dto.WebhookPayload{
+		ID:         fmt.Sprintf("%s-test-%d", webhook.ID, now.UnixNano()),
+		Event:      string(entity.WebhookEventTest),
+		AccountID:  accountID,
+		OccurredAt: now,
+	})
+
+	response := &dto.WebhookTestResponse{Delivered: err == nil}
+	if delivery != nil {
+		response.StatusCode = delivery.StatusCode
+	}
+	if err != nil {
+		response.Error = err.Error()
+	}
+
+	uc.logger.Info("Webhook test delivery", "webhookID", id, "delivered", response.Delivered, "statusCode", response.StatusCode)
+	return response, nil
+}
+
+// accountWebhook retrieves a webhook subscription, hiding those of other accounts
+func (uc *webhookUseCase) accountWebhook(ctx context.Context, accountID, id string) (*entity.WebhookSubscription, error) {
+	webhook, err := uc.webhookRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if webhook.AccountID.String() != accountID {
+		return nil, errs.ErrWebhookNotFound
+	}
+
+	return webhook, nil
+}
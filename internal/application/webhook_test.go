@@ -0,0 +1,202 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/application/dto"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/event"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/infra"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type MockWebhookRepository struct {
+	mock.Mock
+}
+
+func (m *MockWebhookRepository) Create(ctx context.Context, webhook *entity.WebhookSubscription) error {
+	args := m.Called(ctx, webhook)
+	return args.Error(0)
+}
+
+func (m *MockWebhookRepository) GetByID(ctx context.Context, id string) (*entity.WebhookSubscription, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.WebhookSubscription), args.Error(1)
+}
+
+func (m *MockWebhookRepository) Update(ctx context.Context, webhook *entity.WebhookSubscription) error {
+	args := m.Called(ctx, webhook)
+	return args.Error(0)
+}
+
+func (m *MockWebhookRepository) Delete(ctx context.Context, id string) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockWebhookRepository) ListByAccountID(ctx context.Context, accountID vo.AccountID) ([]*entity.WebhookSubscription, error) {
+	args := m.Called(ctx, accountID)
+	return args.Get(0).([]*entity.WebhookSubscription), args.Error(1)
+}
+
+// StubWebhookSender records deliveries and fails them while Err is set
+type StubWebhookSender struct {
+	mu       sync.Mutex
+	Payloads []dto.WebhookPayload
+	Err      error
+}
+
+func (s *StubWebhookSender) Send(ctx context.Context, url, secret string, body []byte) (*infra.WebhookDelivery, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var payload dto.WebhookPayload
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return nil, err
+	}
+	s.Payloads = append(s.Payloads, payload)
+
+	if s.Err != nil {
+		return &infra.WebhookDelivery{StatusCode: 500}, s.Err
+	}
+	return &infra.WebhookDelivery{StatusCode: 200}, nil
+}
+
+func newTestWebhook(t *testing.T, accountID vo.AccountID, threshold *float64, events ...entity.WebhookEvent) *entity.WebhookSubscription {
+	var limit *vo.Money
+	if threshold != nil {
+		amount := vo.NewMoneyFromFloat(*threshold)
+		limit = &amount
+	}
+	webhook, err := entity.NewWebhookSubscription(accountID, "https://example.com/hook", events, limit)
+	require.NoError(t, err)
+	return webhook
+}
+
+func TestWebhookNotifier_Notify(t *testing.T) {
+	threshold := 500.0
+
+	tests := []struct {
+		name           string
+		balanceAfter   float64 // Balance of the paying account once the transfer completed
+		expectedEvents []string
+	}{
+		{name: "crosses_threshold", balanceAfter: 450, expectedEvents: []string{"credit.received", "balance.below_threshold"}},
+		{name: "stays_above_threshold", balanceAfter: 700, expectedEvents: []string{"credit.received"}},
+		{name: "already_below_threshold", balanceAfter: 100, expectedEvents: []string{"credit.received"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			payer := createTestAccount()
+			payer.Balance = vo.NewMoneyFromFloat(tt.balanceAfter)
+			payee := createTestAccount()
+
+			transfer, err := entity.NewTransferTransaction(payer.ID, payee.ID, vo.NewMoneyFromFloat(200), "Rent", "", time.Now())
+			transfer = completedTransaction(t, transfer, err)
+
+			payerWebhook := newTestWebhook(t, payer.ID, &threshold, entity.WebhookEventBalanceBelowThreshold)
+			payeeWebhook := newTestWebhook(t, payee.ID, nil, entity.WebhookEventCreditReceived)
+
+			mockWebhookRepo := new(MockWebhookRepository)
+			mockAccountRepo := new(MockAccountRepository)
+			mockWebhookRepo.On("ListByAccountID", mock.Anything, payee.ID).Return([]*entity.WebhookSubscription{payeeWebhook}, nil)
+			mockWebhookRepo.On("ListByAccountID", mock.Anything, payer.ID).Return([]*entity.WebhookSubscription{payerWebhook}, nil)
+			mockWebhookRepo.On("Update", mock.Anything, mock.Anything).Return(nil)
+			mockAccountRepo.On("GetByID", mock.Anything, payer.ID).Return(payer, nil)
+
+			sender := &StubWebhookSender{}
+			notifier := NewWebhookNotifier(mockWebhookRepo, mockAccountRepo, sender, 3, &StubEventPublisher{}, new(MockLogger))
+			notifier.notify(context.Background(), event.NewTransactionEvent(event.TransactionCompleted, transfer))
+
+			events := make([]string, len(sender.Payloads))
+			for i, payload := range sender.Payloads {
+				events[i] = payload.Event
+			}
+			assert.Equal(t, tt.expectedEvents, events)
+			assert.Equal(t, payee.ID.String(), sender.Payloads[0].AccountID)
+			assert.Equal(t, transfer.ID.String(), sender.Payloads[0].Data.TransactionID)
+
+			if len(sender.Payloads) == 2 {
+				assert.Equal(t, payer.ID.String(), sender.Payloads[1].AccountID)
+				assert.Equal(t, tt.balanceAfter, *sender.Payloads[1].Data.Balance)
+				assert.Equal(t, threshold, *sender.Payloads[1].Data.Threshold)
+			}
+		})
+	}
+}
+
+func TestWebhookNotifier_DisablesAfterRepeatedFailures(t *testing.T) {
+	payee := createTestAccount()
+	webhook := newTestWebhook(t, payee.ID, nil, entity.WebhookEventCreditReceived)
+
+	mockWebhookRepo := new(MockWebhookRepository)
+	mockLogger := new(MockLogger)
+	mockLogger.On("Warn", mock.Anything, mock.Anything).Maybe()
+	mockWebhookRepo.On("ListByAccountID", mock.Anything, payee.ID).Return([]*entity.WebhookSubscription{webhook}, nil)
+	mockWebhookRepo.On("Update", mock.Anything, webhook).Return(nil)
+
+	sender := &StubWebhookSender{Err: errors.New("connection refused")}
+	notifier := NewWebhookNotifier(mockWebhookRepo, new(MockAccountRepository), sender, 2, &StubEventPublisher{}, mockLogger)
+
+	for i := 0; i < 3; i++ {
+		credit, err := entity.NewCreditTransaction(payee.ID, vo.NewMoneyFromFloat(100), "Salary", "", time.Now())
+		credit = completedTransaction(t, credit, err)
+		notifier.notify(context.Background(), event.NewTransactionEvent(event.TransactionCompleted, credit))
+	}
+
+	assert.Len(t, sender.Payloads, 2, "disabled subscriptions receive no further deliveries")
+	assert.Equal(t, entity.WebhookStatusDisabled, webhook.Status)
+	assert.Equal(t, "connection refused", webhook.LastError)
+	mockWebhookRepo.AssertNumberOfCalls(t, "Update", 2)
+}
+
+func TestWebhookUseCase_TestWebhook(t *testing.T) {
+	account := createTestAccount()
+	webhook := newTestWebhook(t, account.ID, nil, entity.WebhookEventCreditReceived)
+
+	mockWebhookRepo := new(MockWebhookRepository)
+	mockLogger := new(MockLogger)
+	mockLogger.On("Info", mock.Anything, mock.Anything).Maybe()
+	mockWebhookRepo.On("GetByID", mock.Anything, webhook.ID).Return(webhook, nil)
+
+	sender := &StubWebhookSender{Err: errors.New("webhook endpoint returned status 500")}
+	clock := &StubClock{At: time.Date(2024, 5, 17, 9, 0, 0, 0, time.UTC)}
+	uc := NewWebhookUseCase(mockWebhookRepo, nil, sender, NewValueDatingPolicy(&StubCalendar{}, vo.ProcessingWindow{}, clock), mockLogger)
+
+	result, err := uc.TestWebhook(context.Background(), account.ID.String(), webhook.ID)
+	require.NoError(t, err)
+
+	assert.False(t, result.Delivered)
+	assert.Equal(t, 500, result.StatusCode)
+	assert.Equal(t, "webhook endpoint returned status 500", result.Error)
+	require.Len(t, sender.Payloads, 1)
+	assert.Equal(t, string(entity.WebhookEventTest), sender.Payloads[0].Event)
+	assert.Equal(t, clock.At, sender.Payloads[0].OccurredAt)
+	assert.Zero(t, webhook.ConsecutiveFailures, "test deliveries do not count as failures")
+	mockWebhookRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+}
+
+func TestWebhookUseCase_GetWebhook_OtherAccount(t *testing.T) {
+	webhook := newTestWebhook(t, vo.NewAccountID(), nil, entity.WebhookEventCreditReceived)
+
+	mockWebhookRepo := new(MockWebhookRepository)
+	mockWebhookRepo.On("GetByID", mock.Anything, webhook.ID).Return(webhook, nil)
+
+	uc := NewWebhookUseCase(mockWebhookRepo, nil, nil, nil, new(MockLogger))
+	_, err := uc.GetWebhook(context.Background(), vo.NewAccountID().String(), webhook.ID)
+
+	assert.ErrorIs(t, err, errs.ErrWebhookNotFound)
+}
@@ -10,15 +10,20 @@ import (
 
 // Account represents a bank account
 type Account struct {
-	ID          vo.AccountID     `json:"id"`
-	AccountName string           `json:"account_name"`
-	Balance     vo.Money         `json:"balance"`
-	Status      vo.AccountStatus `json:"status"`
-	CreatedAt   time.Time        `json:"created_at"`
-	UpdatedAt   time.Time        `json:"updated_at"`
+	ID              vo.AccountID     `json:"id"`
+	AccountName     string           `json:"account_name"`
+	CustomerID      string           `json:"customer_id,omitempty"`
+	ProductID       string           `json:"product_id,omitempty"`
+	ParentAccountID *vo.AccountID    `json:"parent_account_id,omitempty"`
+	SpendingLimit   *vo.Money        `json:"spending_limit,omitempty"` // Monthly outflow cap set by the parent
+	Balance         vo.Money         `json:"balance"`
+	OpeningBalance  *vo.Money        `json:"opening_balance,omitempty"` // Balance the account was opened with; unknown for accounts opened before it was recorded
+	Status          vo.AccountStatus `json:"status"`
+	CreatedAt       time.Time        `json:"created_at"`
+	UpdatedAt       time.Time        `json:"updated_at"`
 }
 
-// NewAccount creates a new account
+// NewAccount creates a new account held in the currency of its initial balance
 func NewAccount(accountName string, initialBalance vo.Money) (*Account, error) {
 	if strings.TrimSpace(accountName) == "" {
 		return nil, errs.ValidationError{
@@ -29,15 +34,183 @@ func NewAccount(accountName string, initialBalance vo.Money) (*Account, error) {
 
 	now := time.Now()
 	return &Account{
-		ID:          vo.NewAccountID(),
-		AccountName: strings.TrimSpace(accountName),
-		Balance:     initialBalance,
-		Status:      vo.AccountStatusActive,
-		CreatedAt:   now,
-		UpdatedAt:   now,
+		ID:             vo.NewAccountID(),
+		AccountName:    strings.TrimSpace(accountName),
+		Balance:        initialBalance,
+		OpeningBalance: &initialBalance,
+		Status:         vo.AccountStatusActive,
+		CreatedAt:      now,
+		UpdatedAt:      now,
 	}, nil
 }
 
+// AccountNameChange records a rename of an account, so documents issued under an earlier name can be matched to it
+type AccountNameChange struct {
+	AccountID    vo.AccountID `json:"account_id"`
+	PreviousName string       `json:"previous_name"`
+	NewName      string       `json:"new_name"`
+	ChangedAt    time.Time    `json:"changed_at"`
+}
+
+// Rename changes the account's name
+func (a *Account) Rename(accountName string) error {
+	accountName = strings.TrimSpace(accountName)
+	if accountName == "" {
+		return errs.ValidationError{
+			Field:   "accountName",
+			Message: "account name is required",
+		}
+	}
+
+	a.AccountName = accountName
+	a.UpdatedAt = time.Now()
+	return nil
+}
+
+// AssignCustomer links the account to the customer owning it
+func (a *Account) AssignCustomer(customerID string) error {
+	customerID = strings.TrimSpace(customerID)
+	if customerID == "" || len(customerID) > 50 {
+		return errs.ValidationError{
+			Field:   "customerID",
+			Message: "customer ID must be 1 to 50 characters",
+		}
+	}
+
+	a.CustomerID = customerID
+	a.UpdatedAt = time.Now()
+	return nil
+}
+
+// Currency returns the currency the account is held in
+func (a *Account) Currency() vo.Currency {
+	return a.Balance.Currency()
+}
+
+// OpenWithProduct opens the account under a product, whose terms then apply to it; the account must
+// be held in the product's currency
+func (a *Account) OpenWithProduct(product *Product) error {
+	if !a.Currency().Matches(vo.Currency(product.Currency)) {
+		return errs.ErrCurrencyMismatch
+	}
+
+	if err := product.CheckOpening(a.Balance); err != nil {
+		return err
+	}
+
+	a.ProductID = product.ID
+	a.UpdatedAt = time.Now()
+	return nil
+}
+
+// AttachToParent makes the account a child of a corporate parent account.
+// Hierarchies are one level deep: a parent cannot itself be a child.
+func (a *Account) AttachToParent(parent *Account) error {
+	if parent.ID == a.ID {
+		return errs.BusinessError{
+			Code:    "INVALID_ACCOUNT_HIERARCHY",
+			Message: "an account cannot be its own parent",
+		}
+	}
+
+	if parent.IsChild() {
+		return errs.BusinessError{
+			Code:    "INVALID_ACCOUNT_HIERARCHY",
+			Message: "parent account " + parent.ID.String() + " is itself a child account",
+		}
+	}
+
+	if a.ParentAccountID != nil {
+		return errs.BusinessError{
+			Code:    "INVALID_ACCOUNT_HIERARCHY",
+			Message: "account already belongs to parent " + a.ParentAccountID.String(),
+		}
+	}
+
+	if a.CustomerID != "" && parent.CustomerID != "" && a.CustomerID != parent.CustomerID {
+		return errs.BusinessError{
+			Code:    "INVALID_ACCOUNT_HIERARCHY",
+			Message: "parent and child accounts must belong to the same customer",
+		}
+	}
+
+	// Group balances are rolled up, so every account of a group is held in one currency
+	if !a.Currency().Matches(parent.Currency()) {
+		return errs.BusinessError{
+			Code:    "INVALID_ACCOUNT_HIERARCHY",
+			Message: "parent and child accounts must be held in the same currency",
+		}
+	}
+
+	parentID := parent.ID
+	a.ParentAccountID = &parentID
+	a.UpdatedAt = time.Now()
+	return nil
+}
+
+// DetachFromParent makes a child account standalone again; its spending limit is dropped
+func (a *Account) DetachFromParent() {
+	a.ParentAccountID = nil
+	a.SpendingLimit = nil
+	a.UpdatedAt = time.Now()
+}
+
+// IsChild checks if the account belongs to a parent account
+func (a *Account) IsChild() bool {
+	return a.ParentAccountID != nil
+}
+
+// IsChildOf checks if the account belongs to the given parent account
+func (a *Account) IsChildOf(parentID vo.AccountID) bool {
+	return a.ParentAccountID != nil && *a.ParentAccountID == parentID
+}
+
+// SetSpendingLimit caps the monthly outflow of a child account; nil removes the cap
+func (a *Account) SetSpendingLimit(limit *vo.Money) error {
+	if !a.IsChild() {
+		return errs.BusinessError{
+			Code:    "INVALID_ACCOUNT_HIERARCHY",
+			Message: "spending limits can only be set on child accounts",
+		}
+	}
+
+	if limit != nil && !limit.IsPositive() {
+		return errs.ValidationError{
+			Field:   "spendingLimit",
+			Message: "spending limit must be greater than zero",
+		}
+	}
+
+	if limit != nil {
+		if !limit.Currency().Matches(a.Currency()) {
+			return errs.ErrCurrencyMismatch
+		}
+		inCurrency := limit.In(a.Currency())
+		limit = &inCurrency
+	}
+
+	a.SpendingLimit = limit
+	a.UpdatedAt = time.Now()
+	return nil
+}
+
+// CheckSpendingLimit checks that spending amount on top of what was already spent this month stays within the limit
+func (a *Account) CheckSpendingLimit(spent, amount vo.Money) error {
+	if a.SpendingLimit == nil {
+		return nil
+	}
+
+	total, err := spent.Add(amount)
+	if err != nil {
+		return err
+	}
+
+	if total.GreaterThan(*a.SpendingLimit) {
+		return errs.ErrSpendingLimitExceeded
+	}
+	return nil
+}
+
 // Debit decreases the account balance
 func (a *Account) Debit(amount vo.Money) error {
 	if amount.IsZero() || !amount.IsPositive() {
@@ -1,11 +1,13 @@
 package entity
 
 import (
+	"strings"
 	"testing"
 	"time"
 
 	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
 	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -74,6 +76,18 @@ func TestNewAccount(t *testing.T) {
 	}
 }
 
+func TestAccount_Rename(t *testing.T) {
+	account, err := NewAccount("Savings", vo.NewMoneyFromFloat(100))
+	require.NoError(t, err)
+
+	require.NoError(t, account.Rename("  Holiday Fund "))
+	assert.Equal(t, "Holiday Fund", account.AccountName)
+
+	err = account.Rename(" ")
+	assert.IsType(t, errs.ValidationError{}, err)
+	assert.Equal(t, "Holiday Fund", account.AccountName)
+}
+
 func TestAccount_Debit(t *testing.T) {
 	tests := []struct {
 		name            string
@@ -347,3 +361,118 @@ func TestAccount_StatusChecks(t *testing.T) {
 	assert.False(t, account.IsActive())
 	assert.False(t, account.CanTransact())
 }
+
+func TestAccount_AssignCustomer(t *testing.T) {
+	account, err := NewAccount("Test Account", vo.NewMoneyFromFloat(100.0))
+	require.NoError(t, err)
+
+	require.NoError(t, account.AssignCustomer("  CUST001 "))
+	assert.Equal(t, "CUST001", account.CustomerID)
+
+	var validationErr errs.ValidationError
+	assert.ErrorAs(t, account.AssignCustomer("   "), &validationErr)
+	assert.ErrorAs(t, account.AssignCustomer(strings.Repeat("C", 51)), &validationErr)
+	assert.Equal(t, "CUST001", account.CustomerID)
+}
+
+func TestAccount_Hierarchy(t *testing.T) {
+	parent, err := NewAccount("Holding Co", vo.NewMoneyFromFloat(1000.0))
+	require.NoError(t, err)
+	child, err := NewAccount("Subsidiary", vo.NewMoneyFromFloat(100.0))
+	require.NoError(t, err)
+	other, err := NewAccount("Other", vo.NewMoneyFromFloat(100.0))
+	require.NoError(t, err)
+
+	var businessErr errs.BusinessError
+	assert.ErrorAs(t, parent.AttachToParent(parent), &businessErr)
+	assert.ErrorAs(t, child.SetSpendingLimit(nil), &businessErr)
+
+	require.NoError(t, child.AttachToParent(parent))
+	assert.True(t, child.IsChildOf(parent.ID))
+	assert.False(t, child.IsChildOf(other.ID))
+
+	// Hierarchies are one level deep and a child has a single parent
+	assert.ErrorAs(t, other.AttachToParent(child), &businessErr)
+	assert.ErrorAs(t, child.AttachToParent(other), &businessErr)
+
+	// Parent and child must share a customer when both have one
+	require.NoError(t, other.AssignCustomer("CUST001"))
+	customerParent, err := NewAccount("Customer Parent", vo.NewMoneyFromFloat(0))
+	require.NoError(t, err)
+	require.NoError(t, customerParent.AssignCustomer("CUST002"))
+	assert.ErrorAs(t, other.AttachToParent(customerParent), &businessErr)
+
+	var validationErr errs.ValidationError
+	zero := vo.ZeroMoney()
+	assert.ErrorAs(t, child.SetSpendingLimit(&zero), &validationErr)
+
+	limit := vo.NewMoneyFromFloat(500)
+	require.NoError(t, child.SetSpendingLimit(&limit))
+	assert.NoError(t, child.CheckSpendingLimit(vo.NewMoneyFromFloat(400), vo.NewMoneyFromFloat(100)))
+	assert.ErrorIs(t, child.CheckSpendingLimit(vo.NewMoneyFromFloat(400), vo.NewMoneyFromFloat(100.01)), errs.ErrSpendingLimitExceeded)
+
+	child.DetachFromParent()
+	assert.False(t, child.IsChild())
+	assert.Nil(t, child.SpendingLimit)
+	assert.NoError(t, child.CheckSpendingLimit(vo.NewMoneyFromFloat(400), vo.NewMoneyFromFloat(1000)))
+}
+
+func TestAccount_Currency(t *testing.T) {
+	baht, err := NewAccount("Baht Savings", vo.NewMoneyFromFloat(1000).In("THB"))
+	require.NoError(t, err)
+	dollars, err := NewAccount("Dollar Savings", vo.NewMoneyFromFloat(100).In("USD"))
+	require.NoError(t, err)
+	assert.Equal(t, vo.Currency("THB"), baht.Currency())
+
+	// Amounts in another currency are rejected, amounts without one are taken as the account's
+	assert.ErrorIs(t, baht.Debit(vo.NewMoneyFromFloat(10).In("USD")), errs.ErrCurrencyMismatch)
+	assert.ErrorIs(t, baht.Credit(vo.NewMoneyFromFloat(10).In("USD")), errs.ErrCurrencyMismatch)
+	assert.Equal(t, "1000", baht.Balance.String())
+	require.NoError(t, baht.Credit(vo.NewMoneyFromFloat(10)))
+	assert.Equal(t, "1010", baht.Balance.String())
+	assert.Equal(t, vo.Currency("THB"), baht.Currency())
+
+	var businessErr errs.BusinessError
+	assert.ErrorAs(t, dollars.AttachToParent(baht), &businessErr)
+
+	holding, err := NewAccount("Dollar Holding", vo.ZeroMoney().In("USD"))
+	require.NoError(t, err)
+	require.NoError(t, dollars.AttachToParent(holding))
+	limit := vo.NewMoneyFromFloat(500).In("USD")
+	require.NoError(t, dollars.SetSpendingLimit(&limit))
+	euros := vo.NewMoneyFromFloat(500).In("EUR")
+	assert.ErrorIs(t, dollars.SetSpendingLimit(&euros), errs.ErrCurrencyMismatch)
+
+	product, err := NewProduct("Dollar Savings", ProductTypeSavings, "USD", decimal.Zero, ProductFees{}, ProductLimits{})
+	require.NoError(t, err)
+	assert.ErrorIs(t, baht.OpenWithProduct(product), errs.ErrCurrencyMismatch)
+	assert.NoError(t, dollars.OpenWithProduct(product))
+}
+
+func TestAccount_RecalculateBalance(t *testing.T) {
+	account, err := NewAccount("Savings", vo.NewMoneyFromFloat(500))
+	require.NoError(t, err)
+	other, err := NewAccount("Other", vo.NewMoneyFromFloat(0))
+	require.NoError(t, err)
+
+	deposit, err := NewCreditTransaction(account.ID, vo.NewMoneyFromFloat(200), "Deposit", "", time.Now())
+	require.NoError(t, err)
+	require.NoError(t, deposit.MarkAsCompleted(time.Now()))
+	transfer, err := NewTransferTransaction(account.ID, other.ID, vo.NewMoneyFromFloat(50), "Rent", "", time.Now())
+	require.NoError(t, err)
+	require.NoError(t, transfer.MarkAsCompleted(time.Now()))
+	pending, err := NewDebitTransaction(account.ID, vo.NewMoneyFromFloat(75), "Pending", "", time.Now())
+	require.NoError(t, err)
+
+	balance, err := account.RecalculateBalance([]*Transaction{deposit, transfer, pending})
+	require.NoError(t, err)
+	assert.True(t, balance.Equal(vo.NewMoneyFromFloat(650)))
+
+	adjustment := account.RepairBalance(balance, "ops@bank", "ticket 42")
+	assert.True(t, account.Balance.Equal(vo.NewMoneyFromFloat(650)))
+	assert.True(t, adjustment.Delta().Equal(vo.NewMoneyFromFloat(150)))
+
+	account.OpeningBalance = nil
+	_, err = account.RecalculateBalance(nil)
+	assert.ErrorIs(t, err, errs.ErrOpeningBalanceUnknown)
+}
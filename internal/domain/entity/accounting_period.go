@@ -0,0 +1,87 @@
+package entity
+
+import (
+	"strings"
+	"time"
+
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+)
+
+// accountingPeriodLayout formats an accounting period, a calendar month
+const accountingPeriodLayout = "2006-01"
+
+// AccountingPeriodStatus tells whether postings may still be booked into a period
+type AccountingPeriodStatus string
+
+const (
+	AccountingPeriodOpen   AccountingPeriodStatus = "OPEN"
+	AccountingPeriodClosed AccountingPeriodStatus = "CLOSED"
+)
+
+// AccountingPeriod records an admin closing the books for a month. Closing a month locks it and
+// every month before it: nothing may be posted with a value date in them any more, and corrections
+// are posted into the current period as adjustments linked to the transaction they correct.
+type AccountingPeriod struct {
+	Period   string    `json:"period"` // YYYY-MM
+	ClosedBy string    `json:"closed_by"`
+	Reason   string    `json:"reason,omitempty"`
+	ClosedAt time.Time `json:"closed_at"`
+}
+
+// CloseAccountingPeriod closes a month that has ended by today, after the latest closed period
+// (nil when none was closed yet)
+func CloseAccountingPeriod(period string, today time.Time, closedBy, reason string, latest *AccountingPeriod) (*AccountingPeriod, error) {
+	start, err := ParseAccountingPeriod(period)
+	if err != nil {
+		return nil, err
+	}
+	period = start.Format(accountingPeriodLayout)
+
+	if period >= AccountingPeriodOf(today) {
+		return nil, errs.ValidationError{
+			Field:   "period",
+			Message: "only a month that has ended can be closed",
+		}
+	}
+
+	if latest != nil && period <= latest.Period {
+		return nil, errs.ErrPeriodAlreadyClosed
+	}
+
+	closedBy = strings.TrimSpace(closedBy)
+	if closedBy == "" {
+		return nil, errs.ValidationError{
+			Field:   "closed_by",
+			Message: "closed by is required",
+		}
+	}
+
+	return &AccountingPeriod{
+		Period:   period,
+		ClosedBy: closedBy,
+		Reason:   strings.TrimSpace(reason),
+		ClosedAt: time.Now(),
+	}, nil
+}
+
+// ParseAccountingPeriod parses a YYYY-MM period into the first day of the month
+func ParseAccountingPeriod(period string) (time.Time, error) {
+	start, err := time.Parse(accountingPeriodLayout, strings.TrimSpace(period))
+	if err != nil {
+		return time.Time{}, errs.ValidationError{
+			Field:   "period",
+			Message: "period must be a month such as 2024-01",
+		}
+	}
+	return start, nil
+}
+
+// AccountingPeriodOf returns the period a business date falls in
+func AccountingPeriodOf(date time.Time) string {
+	return date.Format(accountingPeriodLayout)
+}
+
+// Locks checks if closing this period locked the given one, itself or any earlier month
+func (p *AccountingPeriod) Locks(period string) bool {
+	return period <= p.Period
+}
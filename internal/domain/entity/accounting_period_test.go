@@ -0,0 +1,45 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCloseAccountingPeriod(t *testing.T) {
+	today := time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC)
+
+	period, err := CloseAccountingPeriod("2024-02", today, " ops ", " month end ", nil)
+	require.NoError(t, err)
+	assert.Equal(t, "2024-02", period.Period)
+	assert.Equal(t, "ops", period.ClosedBy)
+	assert.Equal(t, "month end", period.Reason)
+
+	// The current month has not ended
+	_, err = CloseAccountingPeriod("2024-03", today, "ops", "", nil)
+	assert.IsType(t, errs.ValidationError{}, err)
+
+	// Months up to the latest close are already locked
+	_, err = CloseAccountingPeriod("2024-01", today, "ops", "", period)
+	assert.ErrorIs(t, err, errs.ErrPeriodAlreadyClosed)
+	_, err = CloseAccountingPeriod("2024-02", today, "ops", "", period)
+	assert.ErrorIs(t, err, errs.ErrPeriodAlreadyClosed)
+
+	_, err = CloseAccountingPeriod("2024-13", today, "ops", "", nil)
+	assert.IsType(t, errs.ValidationError{}, err)
+
+	_, err = CloseAccountingPeriod("2024-01", today, " ", "", nil)
+	assert.IsType(t, errs.ValidationError{}, err)
+}
+
+func TestAccountingPeriodLocks(t *testing.T) {
+	period := &AccountingPeriod{Period: "2024-02"}
+
+	assert.True(t, period.Locks("2023-12"))
+	assert.True(t, period.Locks("2024-02"))
+	assert.False(t, period.Locks("2024-03"))
+	assert.Equal(t, "2024-02", AccountingPeriodOf(time.Date(2024, 2, 29, 23, 0, 0, 0, time.UTC)))
+}
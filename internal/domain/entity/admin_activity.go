@@ -0,0 +1,77 @@
+package entity
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+)
+
+// AdminActivity is one request an admin user made to the API, recorded with where it came from and
+// the anomalies it showed. Denied requests are recorded too.
+type AdminActivity struct {
+	ID         string            `json:"id"`
+	AdminID    string            `json:"admin_id"`
+	AdminEmail string            `json:"admin_email"`
+	Method     string            `json:"method"`
+	Route      string            `json:"route"` // Route pattern, e.g. /api/v1/accounts/:id
+	Path       string            `json:"path"`
+	Permission string            `json:"permission,omitempty"` // Permission the route requires
+	StatusCode int               `json:"status_code"`
+	IP         string            `json:"ip"`
+	Country    string            `json:"country,omitempty"` // ISO 3166 code reported by the edge proxy
+	UserAgent  string            `json:"user_agent,omitempty"`
+	Anomalies  []vo.AdminAnomaly `json:"anomalies,omitempty"`
+	OccurredAt time.Time         `json:"occurred_at"`
+}
+
+// NewAdminActivity records a request of an admin user, cutting the client-supplied path, country and
+// user agent to the lengths kept
+func NewAdminActivity(adminID, adminEmail, method, route, path, permission string, statusCode int, ip, country, userAgent string, occurredAt time.Time) *AdminActivity {
+	return &AdminActivity{
+		ID:         vo.NewID("AAC"),
+		AdminID:    adminID,
+		AdminEmail: adminEmail,
+		Method:     method,
+		Route:      route,
+		Path:       clip(path, 500),
+		Permission: permission,
+		StatusCode: statusCode,
+		IP:         ip,
+		Country:    clip(strings.ToUpper(strings.TrimSpace(country)), 2),
+		UserAgent:  clip(userAgent, 300),
+		OccurredAt: occurredAt,
+	}
+}
+
+// IsMutation checks if the request could change anything, i.e. was not a read
+func (a *AdminActivity) IsMutation() bool {
+	switch a.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return false
+	default:
+		return true
+	}
+}
+
+// IsAnomalous checks if the request showed any anomaly
+func (a *AdminActivity) IsAnomalous() bool {
+	return len(a.Anomalies) > 0
+}
+
+// clip cuts s to at most n bytes without splitting a character
+func clip(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+
+	cut := 0
+	for i := range s {
+		if i > n {
+			break
+		}
+		cut = i
+	}
+	return s[:cut]
+}
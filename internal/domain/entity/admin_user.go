@@ -0,0 +1,163 @@
+package entity
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/mail"
+	"strings"
+	"time"
+
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+)
+
+// AdminUserStatus tells whether an admin user's API key is accepted
+type AdminUserStatus string
+
+const (
+	AdminUserStatusActive   AdminUserStatus = "ACTIVE"
+	AdminUserStatusDisabled AdminUserStatus = "DISABLED"
+)
+
+// IsValid checks if admin user status is valid
+func (s AdminUserStatus) IsValid() bool {
+	return s == AdminUserStatusActive || s == AdminUserStatusDisabled
+}
+
+// AdminUser is a person calling the admin API with their own API key, allowed the permissions of
+// their roles. Only the hash of the key is kept; the key itself is shown once when it is issued.
+type AdminUser struct {
+	ID        string          `json:"id"`
+	Email     string          `json:"email"`
+	Name      string          `json:"name"`
+	Roles     []vo.AdminRole  `json:"roles"`
+	KeyHash   string          `json:"-"`
+	Status    AdminUserStatus `json:"status"`
+	CreatedAt time.Time       `json:"created_at"`
+	UpdatedAt time.Time       `json:"updated_at"`
+}
+
+// NewAdminUser creates an active admin user and returns it with its freshly issued API key
+func NewAdminUser(email, name string, roles []vo.AdminRole) (*AdminUser, string, error) {
+	address, err := mail.ParseAddress(strings.TrimSpace(email))
+	if err != nil || address.Name != "" || len(address.Address) > 254 {
+		return nil, "", errs.ValidationError{
+			Field:   "email",
+			Message: "email must be a valid address",
+		}
+	}
+
+	name = strings.TrimSpace(name)
+	if name == "" || len(name) > 100 {
+		return nil, "", errs.ValidationError{
+			Field:   "name",
+			Message: "name must be 1 to 100 characters",
+		}
+	}
+
+	now := time.Now()
+
+	admin := &AdminUser{
+		ID:        vo.NewID("ADM"),
+		Email:     strings.ToLower(address.Address),
+		Name:      name,
+		Status:    AdminUserStatusActive,
+		CreatedAt: now,
+	}
+
+	if err := admin.AssignRoles(roles); err != nil {
+		return nil, "", err
+	}
+
+	key, err := admin.RotateKey()
+	if err != nil {
+		return nil, "", err
+	}
+
+	return admin, key, nil
+}
+
+// AssignRoles replaces the admin user's roles
+func (a *AdminUser) AssignRoles(roles []vo.AdminRole) error {
+	if len(roles) == 0 {
+		return errs.ValidationError{
+			Field:   "roles",
+			Message: "at least one role is required",
+		}
+	}
+
+	unique := make([]vo.AdminRole, 0, len(roles))
+	seen := make(map[vo.AdminRole]bool, len(roles))
+	for _, role := range roles {
+		if !role.IsValid() {
+			return errs.ValidationError{
+				Field:   "roles",
+				Message: fmt.Sprintf("unsupported role %q", role),
+			}
+		}
+		if !seen[role] {
+			seen[role] = true
+			unique = append(unique, role)
+		}
+	}
+
+	a.Roles = unique
+	a.UpdatedAt = time.Now()
+	return nil
+}
+
+// RotateKey issues a new API key to the admin user, revoking the previous one, and returns it
+func (a *AdminUser) RotateKey() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("failed to generate admin API key: %w", err)
+	}
+
+	key := "adm_" + hex.EncodeToString(buf)
+	a.KeyHash = HashAdminKey(key)
+	a.UpdatedAt = time.Now()
+	return key, nil
+}
+
+// Disable revokes the admin user's access without removing them
+func (a *AdminUser) Disable() {
+	a.Status = AdminUserStatusDisabled
+	a.UpdatedAt = time.Now()
+}
+
+// Enable restores a disabled admin user's access with their current key
+func (a *AdminUser) Enable() {
+	a.Status = AdminUserStatusActive
+	a.UpdatedAt = time.Now()
+}
+
+// IsActive checks whether the admin user's API key is accepted
+func (a *AdminUser) IsActive() bool {
+	return a.Status == AdminUserStatusActive
+}
+
+// Permissions returns the union of the permissions of the admin user's roles, in matrix order
+func (a *AdminUser) Permissions() []vo.AdminPermission {
+	granted := make(map[vo.AdminPermission]bool)
+	for _, role := range a.Roles {
+		for _, permission := range role.Permissions() {
+			granted[permission] = true
+		}
+	}
+
+	permissions := make([]vo.AdminPermission, 0, len(granted))
+	for _, permission := range vo.AdminPermissions() {
+		if granted[permission] {
+			permissions = append(permissions, permission)
+		}
+	}
+	return permissions
+}
+
+// HashAdminKey returns the hash an admin API key is stored and looked up by
+func HashAdminKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,67 @@
+package entity
+
+import (
+	"strings"
+	"testing"
+
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewAdminUser(t *testing.T) {
+	tests := []struct {
+		name      string
+		email     string
+		roles     []vo.AdminRole
+		wantField string
+	}{
+		{name: "one role", email: " Alice@Example.com ", roles: []vo.AdminRole{vo.AdminRoleViewer}},
+		{name: "invalid email", email: "alice", roles: []vo.AdminRole{vo.AdminRoleViewer}, wantField: "email"},
+		{name: "email with display name", email: "Alice <alice@example.com>", roles: []vo.AdminRole{vo.AdminRoleViewer}, wantField: "email"},
+		{name: "no roles", email: "alice@example.com", wantField: "roles"},
+		{name: "unsupported role", email: "alice@example.com", roles: []vo.AdminRole{"ROOT"}, wantField: "roles"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			admin, key, err := NewAdminUser(tt.email, "Alice", tt.roles)
+
+			if tt.wantField != "" {
+				var validationErr errs.ValidationError
+				require.ErrorAs(t, err, &validationErr)
+				assert.Equal(t, tt.wantField, validationErr.Field)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, "alice@example.com", admin.Email)
+			assert.True(t, admin.IsActive())
+			assert.True(t, strings.HasPrefix(key, "adm_"))
+			assert.Equal(t, HashAdminKey(key), admin.KeyHash)
+		})
+	}
+}
+
+func TestAdminUser_Permissions(t *testing.T) {
+	admin, _, err := NewAdminUser("alice@example.com", "Alice", []vo.AdminRole{vo.AdminRoleAuditor, vo.AdminRoleApprover, vo.AdminRoleAuditor})
+	require.NoError(t, err)
+
+	// Duplicate roles are dropped; shared permissions are listed once, in matrix order
+	assert.Equal(t, []vo.AdminRole{vo.AdminRoleAuditor, vo.AdminRoleApprover}, admin.Roles)
+	assert.Equal(t, []vo.AdminPermission{vo.AdminPermissionViewAccounts, vo.AdminPermissionApproveTransactions, vo.AdminPermissionViewAudit}, admin.Permissions())
+
+	require.NoError(t, admin.AssignRoles([]vo.AdminRole{vo.AdminRoleSuperAdmin}))
+	assert.Equal(t, vo.AdminPermissions(), admin.Permissions())
+}
+
+func TestAdminUser_RotateKey(t *testing.T) {
+	admin, key, err := NewAdminUser("alice@example.com", "Alice", []vo.AdminRole{vo.AdminRoleViewer})
+	require.NoError(t, err)
+
+	rotated, err := admin.RotateKey()
+	require.NoError(t, err)
+	assert.NotEqual(t, key, rotated)
+	assert.Equal(t, HashAdminKey(rotated), admin.KeyHash)
+}
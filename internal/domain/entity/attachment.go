@@ -0,0 +1,85 @@
+package entity
+
+import (
+	"fmt"
+	"mime"
+	"net/http"
+	"path"
+	"strings"
+	"time"
+
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+)
+
+const (
+	// MaxAttachmentSize is the largest file, in bytes, that can be attached to a transaction
+	MaxAttachmentSize = 5 << 20
+	// MaxAttachmentsPerTransaction is the number of files an account can attach to one of their transactions
+	MaxAttachmentsPerTransaction = 5
+)
+
+// attachmentExtensions maps the content types accepted for attachments to the extension their files are stored with
+var attachmentExtensions = map[string]string{
+	"application/pdf": ".pdf",
+	"image/jpeg":      ".jpg",
+	"image/png":       ".png",
+	"image/webp":      ".webp",
+}
+
+// Attachment is a small file, such as a receipt or an invoice, an account holder attached to one of
+// their transactions. Like tags it belongs to the account, so each side of a transfer keeps its own.
+type Attachment struct {
+	ID            string           `json:"id"`
+	AccountID     vo.AccountID     `json:"account_id"`
+	TransactionID vo.TransactionID `json:"transaction_id"`
+	FileName      string           `json:"file_name"`
+	ContentType   string           `json:"content_type"`
+	SizeBytes     int64            `json:"size_bytes"`
+	ObjectKey     string           `json:"-"` // Key of the file in blob storage
+	CreatedAt     time.Time        `json:"created_at"`
+}
+
+// NewAttachment creates a new attachment of a file whose content type was detected from its contents
+func NewAttachment(accountID vo.AccountID, transactionID vo.TransactionID, fileName, contentType string) (*Attachment, error) {
+	extension, ok := attachmentExtensions[contentType]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", errs.ErrAttachmentTypeNotAllowed, contentType)
+	}
+
+	// Only the base name is kept; a client's path means nothing here
+	fileName = strings.TrimSpace(path.Base(strings.ReplaceAll(fileName, "\\", "/")))
+	if fileName == "" || fileName == "." || fileName == "/" || len(fileName) > 255 {
+		return nil, errs.ValidationError{
+			Field:   "file",
+			Message: "file name must be 1 to 255 characters",
+		}
+	}
+
+	now := time.Now()
+
+	id := vo.NewID("ATT")
+
+	return &Attachment{
+		ID:            id,
+		AccountID:     accountID,
+		TransactionID: transactionID,
+		FileName:      fileName,
+		ContentType:   contentType,
+		ObjectKey:     "attachments/" + accountID.String() + "/" + id + extension,
+		CreatedAt:     now,
+	}, nil
+}
+
+// DetectAttachmentContentType tells the content type of a file from its first bytes, ignoring what the
+// client claimed, and checks that it may be attached
+func DetectAttachmentContentType(head []byte) (string, error) {
+	contentType, _, err := mime.ParseMediaType(http.DetectContentType(head))
+	if err != nil {
+		return "", fmt.Errorf("%w: unknown", errs.ErrAttachmentTypeNotAllowed)
+	}
+	if _, ok := attachmentExtensions[contentType]; !ok {
+		return "", fmt.Errorf("%w: %s", errs.ErrAttachmentTypeNotAllowed, contentType)
+	}
+	return contentType, nil
+}
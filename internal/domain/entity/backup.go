@@ -0,0 +1,65 @@
+package entity
+
+import (
+	"time"
+
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+)
+
+// Backup represents metadata of a logical database backup
+type Backup struct {
+	ID          string          `json:"id"`
+	Location    string          `json:"location"`
+	SizeBytes   int64           `json:"size_bytes"`
+	Status      vo.BackupStatus `json:"status"`
+	Error       string          `json:"error,omitempty"`
+	RequestedBy string          `json:"requested_by"`
+	StartedAt   time.Time       `json:"started_at"`
+	CompletedAt *time.Time      `json:"completed_at,omitempty"`
+}
+
+// NewBackup creates a new running backup record
+func NewBackup(requestedBy string) *Backup {
+	now := time.Now()
+
+	return &Backup{
+		ID:          vo.NewID("BKP"),
+		Status:      vo.BackupStatusRunning,
+		RequestedBy: requestedBy,
+		StartedAt:   now,
+	}
+}
+
+// MarkAsCompleted records a successful backup
+func (b *Backup) MarkAsCompleted(location string, sizeBytes int64) error {
+	if !b.Status.IsRunning() {
+		return errs.BusinessError{
+			Code:    "INVALID_STATUS_TRANSITION",
+			Message: "cannot complete backup with status: " + string(b.Status),
+		}
+	}
+
+	now := time.Now()
+	b.Status = vo.BackupStatusCompleted
+	b.Location = location
+	b.SizeBytes = sizeBytes
+	b.CompletedAt = &now
+	return nil
+}
+
+// MarkAsFailed records a failed backup with its reason
+func (b *Backup) MarkAsFailed(reason string) error {
+	if !b.Status.IsRunning() {
+		return errs.BusinessError{
+			Code:    "INVALID_STATUS_TRANSITION",
+			Message: "cannot fail backup with status: " + string(b.Status),
+		}
+	}
+
+	now := time.Now()
+	b.Status = vo.BackupStatusFailed
+	b.Error = reason
+	b.CompletedAt = &now
+	return nil
+}
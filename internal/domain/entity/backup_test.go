@@ -0,0 +1,53 @@
+package entity
+
+import (
+	"testing"
+
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewBackup(t *testing.T) {
+	backup := NewBackup("127.0.0.1")
+
+	assert.Len(t, backup.ID, vo.IDLength)
+	assert.Equal(t, "BKP", backup.ID[:3])
+	assert.Equal(t, vo.BackupStatusRunning, backup.Status)
+	assert.Equal(t, "127.0.0.1", backup.RequestedBy)
+	assert.Nil(t, backup.CompletedAt)
+}
+
+func TestBackup_MarkAsCompleted(t *testing.T) {
+	backup := NewBackup("admin")
+
+	err := backup.MarkAsCompleted("backups/file.dump", 1024)
+
+	require.NoError(t, err)
+	assert.Equal(t, vo.BackupStatusCompleted, backup.Status)
+	assert.Equal(t, "backups/file.dump", backup.Location)
+	assert.Equal(t, int64(1024), backup.SizeBytes)
+	assert.NotNil(t, backup.CompletedAt)
+
+	// A finished backup cannot change state again
+	err = backup.MarkAsFailed("late failure")
+	require.Error(t, err)
+	assert.IsType(t, errs.BusinessError{}, err)
+	assert.Equal(t, vo.BackupStatusCompleted, backup.Status)
+}
+
+func TestBackup_MarkAsFailed(t *testing.T) {
+	backup := NewBackup("admin")
+
+	err := backup.MarkAsFailed("pg_dump not found")
+
+	require.NoError(t, err)
+	assert.Equal(t, vo.BackupStatusFailed, backup.Status)
+	assert.Equal(t, "pg_dump not found", backup.Error)
+	assert.NotNil(t, backup.CompletedAt)
+
+	err = backup.MarkAsCompleted("backups/file.dump", 1024)
+	require.Error(t, err)
+	assert.IsType(t, errs.BusinessError{}, err)
+}
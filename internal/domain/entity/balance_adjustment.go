@@ -0,0 +1,59 @@
+package entity
+
+import (
+	"time"
+
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+)
+
+// BalanceAdjustment records a repair of an account's stored balance to the balance recalculated
+// from its transactions; rows are only ever inserted
+type BalanceAdjustment struct {
+	AccountID       vo.AccountID `json:"account_id"`
+	PreviousBalance vo.Money     `json:"previous_balance"`
+	NewBalance      vo.Money     `json:"new_balance"`
+	RequestedBy     string       `json:"requested_by"`
+	Reason          string       `json:"reason"`
+	CreatedAt       time.Time    `json:"created_at"`
+}
+
+// Delta returns how much the repair changed the balance by
+func (b *BalanceAdjustment) Delta() vo.Money {
+	delta, _ := b.NewBalance.Subtract(b.PreviousBalance)
+	return delta
+}
+
+// RecalculateBalance replays the account's completed transactions over its opening balance.
+// Transactions that are not completed, or do not involve the account, leave it unchanged.
+func (a *Account) RecalculateBalance(transactions []*Transaction) (vo.Money, error) {
+	if a.OpeningBalance == nil {
+		return vo.ZeroMoney(), errs.ErrOpeningBalanceUnknown
+	}
+
+	balance := *a.OpeningBalance
+	for _, transaction := range transactions {
+		var err error
+		if balance, err = balance.Add(transaction.BalanceEffect(a.ID)); err != nil {
+			return vo.ZeroMoney(), err
+		}
+	}
+	return balance, nil
+}
+
+// RepairBalance sets the stored balance to a recalculated one and returns the adjustment recording it
+func (a *Account) RepairBalance(balance vo.Money, requestedBy, reason string) *BalanceAdjustment {
+	now := time.Now()
+	adjustment := &BalanceAdjustment{
+		AccountID:       a.ID,
+		PreviousBalance: a.Balance,
+		NewBalance:      balance,
+		RequestedBy:     requestedBy,
+		Reason:          reason,
+		CreatedAt:       now,
+	}
+
+	a.Balance = balance
+	a.UpdatedAt = now
+	return adjustment
+}
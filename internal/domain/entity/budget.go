@@ -0,0 +1,105 @@
+package entity
+
+import (
+	"time"
+
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"github.com/shopspring/decimal"
+)
+
+// BudgetAlertThresholds are the percentages of a budget that trigger an alert, in ascending order
+var BudgetAlertThresholds = []int{80, 100}
+
+var oneHundred = decimal.NewFromInt(100)
+
+// Budget is a monthly spending limit for one category of an account
+type Budget struct {
+	ID               string       `json:"id"`
+	AccountID        vo.AccountID `json:"account_id"`
+	CategoryCode     string       `json:"category_code"`
+	Amount           vo.Money     `json:"amount"`
+	AlertedPeriod    string       `json:"alerted_period,omitempty"` // YYYY-MM of the last alert
+	AlertedThreshold int          `json:"alerted_threshold"`        // Highest threshold alerted in AlertedPeriod
+	CreatedAt        time.Time    `json:"created_at"`
+	UpdatedAt        time.Time    `json:"updated_at"`
+}
+
+// NewBudget creates a new monthly budget for a category of an account
+func NewBudget(accountID vo.AccountID, categoryCode string, amount vo.Money) (*Budget, error) {
+	if accountID.IsEmpty() {
+		return nil, errs.ValidationError{
+			Field:   "accountID",
+			Message: "account ID is required",
+		}
+	}
+
+	categoryCode = NormalizeCategoryCode(categoryCode)
+	if categoryCode == "" {
+		return nil, errs.ValidationError{
+			Field:   "categoryCode",
+			Message: "category code is required",
+		}
+	}
+
+	now := time.Now()
+
+	budget := &Budget{
+		ID:           vo.NewID("BGT"),
+		AccountID:    accountID,
+		CategoryCode: categoryCode,
+		CreatedAt:    now,
+	}
+
+	if err := budget.UpdateAmount(amount); err != nil {
+		return nil, err
+	}
+
+	return budget, nil
+}
+
+// UpdateAmount changes the monthly limit. Alerts already sent this month are
+// re-armed so crossing a threshold of the new limit alerts again.
+func (b *Budget) UpdateAmount(amount vo.Money) error {
+	if !amount.IsPositive() {
+		return errs.ValidationError{
+			Field:   "amount",
+			Message: "budget amount must be greater than zero",
+		}
+	}
+
+	b.Amount = amount
+	b.AlertedPeriod = ""
+	b.AlertedThreshold = 0
+	b.UpdatedAt = time.Now()
+	return nil
+}
+
+// PercentUsed returns how much of the budget the spent amount uses
+func (b *Budget) PercentUsed(spent vo.Money) float64 {
+	return spent.Amount().Div(b.Amount.Amount()).Mul(oneHundred).Round(2).InexactFloat64()
+}
+
+// ReachedThreshold returns the highest alert threshold the spent amount reaches, or 0 when none
+func (b *Budget) ReachedThreshold(spent vo.Money) int {
+	reached := 0
+	for _, threshold := range BudgetAlertThresholds {
+		limit := b.Amount.Amount().Mul(decimal.NewFromInt(int64(threshold))).Div(oneHundred)
+		if spent.Amount().GreaterThanOrEqual(limit) {
+			reached = threshold
+		}
+	}
+	return reached
+}
+
+// HasAlerted checks if a threshold has already been alerted in a period
+func (b *Budget) HasAlerted(period string, threshold int) bool {
+	return b.AlertedPeriod == period && b.AlertedThreshold >= threshold
+}
+
+// BudgetPeriod returns the YYYY-MM period a time falls in and the start of that period
+func BudgetPeriod(t time.Time) (string, time.Time) {
+	year, month, _ := t.UTC().Date()
+	start := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+	return start.Format("2006-01"), start
+}
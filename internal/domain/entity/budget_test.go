@@ -0,0 +1,68 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewBudget(t *testing.T) {
+	budget, err := NewBudget(vo.NewAccountID(), " groceries ", vo.NewMoneyFromFloat(500))
+
+	require.NoError(t, err)
+	assert.Equal(t, "GROCERIES", budget.CategoryCode)
+	assert.Contains(t, budget.ID, "BGT")
+
+	_, err = NewBudget(vo.NewAccountID(), "GROCERIES", vo.ZeroMoney())
+	assert.IsType(t, errs.ValidationError{}, err)
+
+	_, err = NewBudget(vo.NewAccountID(), " ", vo.NewMoneyFromFloat(500))
+	assert.IsType(t, errs.ValidationError{}, err)
+}
+
+func TestBudget_ReachedThreshold(t *testing.T) {
+	budget, err := NewBudget(vo.NewAccountID(), "GROCERIES", vo.NewMoneyFromFloat(500))
+	require.NoError(t, err)
+
+	tests := []struct {
+		spent    float64
+		expected int
+	}{
+		{spent: 0, expected: 0},
+		{spent: 399.99, expected: 0},
+		{spent: 400, expected: 80},
+		{spent: 499.99, expected: 80},
+		{spent: 500, expected: 100},
+		{spent: 750, expected: 100},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.expected, budget.ReachedThreshold(vo.NewMoneyFromFloat(tt.spent)), "spent %.2f", tt.spent)
+	}
+	assert.Equal(t, 150.0, budget.PercentUsed(vo.NewMoneyFromFloat(750)))
+}
+
+func TestBudget_UpdateAmountRearmsAlerts(t *testing.T) {
+	budget, err := NewBudget(vo.NewAccountID(), "GROCERIES", vo.NewMoneyFromFloat(500))
+	require.NoError(t, err)
+	budget.AlertedPeriod = "2025-07"
+	budget.AlertedThreshold = 80
+
+	assert.True(t, budget.HasAlerted("2025-07", 80))
+	assert.False(t, budget.HasAlerted("2025-07", 100))
+	assert.False(t, budget.HasAlerted("2025-08", 80))
+
+	require.NoError(t, budget.UpdateAmount(vo.NewMoneyFromFloat(1000)))
+	assert.False(t, budget.HasAlerted("2025-07", 80))
+}
+
+func TestBudgetPeriod(t *testing.T) {
+	period, start := BudgetPeriod(time.Date(2025, 7, 31, 23, 0, 0, 0, time.UTC))
+
+	assert.Equal(t, "2025-07", period)
+	assert.Equal(t, time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC), start)
+}
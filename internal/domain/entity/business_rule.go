@@ -0,0 +1,130 @@
+package entity
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+)
+
+// maxRuleExpressionLength bounds the size of a business rule expression
+const maxRuleExpressionLength = 2000
+
+// BusinessRuleKind is what a business rule decides about a transaction
+type BusinessRuleKind string
+
+const (
+	// BusinessRuleKindValidation rejects transactions for which its expression is true
+	BusinessRuleKindValidation BusinessRuleKind = "VALIDATION"
+	// BusinessRuleKindFee charges the amount its expression evaluates to
+	BusinessRuleKindFee BusinessRuleKind = "FEE"
+)
+
+// IsValid checks if the rule kind is valid
+func (k BusinessRuleKind) IsValid() bool {
+	switch k {
+	case BusinessRuleKindValidation, BusinessRuleKindFee:
+		return true
+	default:
+		return false
+	}
+}
+
+// BusinessRule is an admin-defined expression evaluated when transactions are created,
+// adding validations or fees without a release
+type BusinessRule struct {
+	ID         string           `json:"id"`
+	Name       string           `json:"name"`
+	Kind       BusinessRuleKind `json:"kind"`
+	Expression string           `json:"expression"`
+	Message    string           `json:"message,omitempty"` // Shown to clients when a validation rule rejects a transaction
+	Priority   int              `json:"priority"`          // Lower priorities are evaluated first
+	Enabled    bool             `json:"enabled"`
+	CreatedAt  time.Time        `json:"created_at"`
+	UpdatedAt  time.Time        `json:"updated_at"`
+}
+
+// NewBusinessRule creates a new enabled business rule
+func NewBusinessRule(name string, kind BusinessRuleKind, expression, message string, priority int) (*BusinessRule, error) {
+	if !kind.IsValid() {
+		return nil, errs.ValidationError{
+			Field:   "kind",
+			Message: "invalid rule kind: " + string(kind),
+		}
+	}
+
+	now := time.Now()
+
+	rule := &BusinessRule{
+		ID:        vo.NewID("BRL"),
+		Kind:      kind,
+		Enabled:   true,
+		CreatedAt: now,
+	}
+
+	if err := rule.Update(name, expression, message, priority); err != nil {
+		return nil, err
+	}
+
+	return rule, nil
+}
+
+// Update replaces the rule's name, expression, message and priority
+func (r *BusinessRule) Update(name, expression, message string, priority int) error {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return errs.ValidationError{
+			Field:   "name",
+			Message: "rule name is required",
+		}
+	}
+
+	expression = strings.TrimSpace(expression)
+	if expression == "" {
+		return errs.ValidationError{
+			Field:   "expression",
+			Message: "expression is required",
+		}
+	}
+	if len(expression) > maxRuleExpressionLength {
+		return errs.ValidationError{
+			Field:   "expression",
+			Message: fmt.Sprintf("expression cannot exceed %d characters", maxRuleExpressionLength),
+		}
+	}
+
+	if priority < 0 {
+		return errs.ValidationError{
+			Field:   "priority",
+			Message: "priority cannot be negative",
+		}
+	}
+
+	message = strings.TrimSpace(message)
+	if message == "" && r.Kind == BusinessRuleKindValidation {
+		message = fmt.Sprintf("transaction rejected by rule %s", name)
+	}
+
+	r.Name = name
+	r.Expression = expression
+	r.Message = message
+	r.Priority = priority
+	r.UpdatedAt = time.Now()
+	return nil
+}
+
+// SetEnabled switches the rule on or off
+func (r *BusinessRule) SetEnabled(enabled bool) {
+	r.Enabled = enabled
+	r.UpdatedAt = time.Now()
+}
+
+// Violation is the error returned when the validation rule rejects a transaction
+func (r *BusinessRule) Violation() error {
+	return errs.BusinessError{
+		Code:    "BUSINESS_RULE_VIOLATION",
+		Message: r.Message,
+	}
+}
@@ -0,0 +1,165 @@
+package entity
+
+import (
+	"strings"
+	"time"
+
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"github.com/shopspring/decimal"
+)
+
+// CashbackReferencePrefix starts the reference of cashback credits, followed by the campaign ID
+const CashbackReferencePrefix = "CASHBACK-"
+
+// CashbackCampaign pays back a percentage of eligible completed payments, up to a cap per payment
+type CashbackCampaign struct {
+	ID               string               `json:"id"`
+	Name             string               `json:"name"`
+	Percentage       decimal.Decimal      `json:"percentage"`        // Of the payment amount
+	Cap              vo.Money             `json:"cap"`               // Most paid back on a single payment
+	TransactionTypes []vo.TransactionType `json:"transaction_types"` // Payments that earn cashback: DEBIT and/or TRANSFER
+	StartsAt         time.Time            `json:"starts_at"`
+	EndsAt           time.Time            `json:"ends_at"` // Exclusive
+	Active           bool                 `json:"active"`
+	CreatedAt        time.Time            `json:"created_at"`
+	UpdatedAt        time.Time            `json:"updated_at"`
+}
+
+// NewCashbackCampaign creates a new active cashback campaign
+func NewCashbackCampaign(name string, percentage decimal.Decimal, maxCashback vo.Money, transactionTypes []vo.TransactionType, startsAt, endsAt time.Time) (*CashbackCampaign, error) {
+	now := time.Now()
+
+	campaign := &CashbackCampaign{
+		ID:        vo.NewID("CBC"),
+		Active:    true,
+		CreatedAt: now,
+	}
+
+	if err := campaign.Update(name, percentage, maxCashback, transactionTypes, startsAt, endsAt); err != nil {
+		return nil, err
+	}
+
+	return campaign, nil
+}
+
+// Update replaces the campaign's terms; cashback already paid is not recalculated
+func (c *CashbackCampaign) Update(name string, percentage decimal.Decimal, maxCashback vo.Money, transactionTypes []vo.TransactionType, startsAt, endsAt time.Time) error {
+	name = strings.TrimSpace(name)
+	if name == "" || len(name) > 100 {
+		return errs.ValidationError{
+			Field:   "name",
+			Message: "campaign name must be 1 to 100 characters",
+		}
+	}
+
+	if !percentage.IsPositive() || percentage.GreaterThan(oneHundred) {
+		return errs.ValidationError{
+			Field:   "percentage",
+			Message: "percentage must be greater than 0 and at most 100",
+		}
+	}
+
+	if !maxCashback.IsPositive() {
+		return errs.ValidationError{
+			Field:   "cap",
+			Message: "cap must be greater than zero",
+		}
+	}
+
+	if len(transactionTypes) == 0 {
+		return errs.ValidationError{
+			Field:   "transactionTypes",
+			Message: "at least one transaction type is required",
+		}
+	}
+	for _, transactionType := range transactionTypes {
+		// Cashback is paid back on money leaving an account
+		if transactionType != vo.TransactionTypeDebit && transactionType != vo.TransactionTypeTransfer {
+			return errs.ValidationError{
+				Field:   "transactionTypes",
+				Message: "only DEBIT and TRANSFER payments earn cashback, got " + string(transactionType),
+			}
+		}
+	}
+
+	if !endsAt.After(startsAt) {
+		return errs.ValidationError{
+			Field:   "endsAt",
+			Message: "campaign must end after it starts",
+		}
+	}
+
+	c.Name = name
+	c.Percentage = percentage
+	c.Cap = maxCashback
+	c.TransactionTypes = transactionTypes
+	c.StartsAt = startsAt
+	c.EndsAt = endsAt
+	c.UpdatedAt = time.Now()
+	return nil
+}
+
+// SetActive pauses or resumes the campaign
+func (c *CashbackCampaign) SetActive(active bool) {
+	c.Active = active
+	c.UpdatedAt = time.Now()
+}
+
+// IsRunning checks if the campaign pays cashback on payments completed at the time
+func (c *CashbackCampaign) IsRunning(at time.Time) bool {
+	return c.Active && !at.Before(c.StartsAt) && at.Before(c.EndsAt)
+}
+
+// Earned returns the cashback a payment completed at the time earns, zero when it is not eligible
+func (c *CashbackCampaign) Earned(transactionType vo.TransactionType, amount vo.Money, completedAt time.Time) vo.Money {
+	if !c.IsRunning(completedAt) || !c.covers(transactionType) {
+		return vo.ZeroMoney()
+	}
+
+	cashback := amount.Multiply(c.Percentage.Div(oneHundred)).Truncate(2)
+	if cashback.GreaterThan(c.Cap) {
+		return c.Cap
+	}
+	return cashback
+}
+
+// Reference is the reference of the cashback credits the campaign pays
+func (c *CashbackCampaign) Reference() string {
+	return CashbackReferencePrefix + c.ID
+}
+
+func (c *CashbackCampaign) covers(transactionType vo.TransactionType) bool {
+	for _, covered := range c.TransactionTypes {
+		if covered == transactionType {
+			return true
+		}
+	}
+	return false
+}
+
+// CashbackReward records the cashback a campaign paid on a payment; a payment earns from each campaign once
+type CashbackReward struct {
+	ID                  string           `json:"id"`
+	CampaignID          string           `json:"campaign_id"`
+	AccountID           vo.AccountID     `json:"account_id"`
+	TransactionID       vo.TransactionID `json:"transaction_id"`        // The payment that earned the cashback
+	CreditTransactionID vo.TransactionID `json:"credit_transaction_id"` // The credit paying it
+	Amount              vo.Money         `json:"amount"`
+	CreatedAt           time.Time        `json:"created_at"`
+}
+
+// NewCashbackReward records the cashback a campaign pays on a payment through a credit transaction
+func NewCashbackReward(campaign *CashbackCampaign, transactionID vo.TransactionID, credit *Transaction) *CashbackReward {
+	now := time.Now()
+
+	return &CashbackReward{
+		ID:                  vo.NewID("CBR"),
+		CampaignID:          campaign.ID,
+		AccountID:           *credit.ToAccountID,
+		TransactionID:       transactionID,
+		CreditTransactionID: credit.ID,
+		Amount:              credit.Amount,
+		CreatedAt:           now,
+	}
+}
@@ -0,0 +1,72 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCashbackCampaign(t *testing.T) {
+	startsAt := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	endsAt := startsAt.AddDate(0, 1, 0)
+	debit := []vo.TransactionType{vo.TransactionTypeDebit}
+
+	campaign, err := NewCashbackCampaign(" Summer ", decimal.NewFromInt(5), vo.NewMoneyFromFloat(10), debit, startsAt, endsAt)
+
+	require.NoError(t, err)
+	assert.Equal(t, "Summer", campaign.Name)
+	assert.Contains(t, campaign.ID, "CBC")
+	assert.Equal(t, CashbackReferencePrefix+campaign.ID, campaign.Reference())
+	assert.True(t, campaign.Active)
+
+	_, err = NewCashbackCampaign("Summer", decimal.NewFromInt(101), vo.NewMoneyFromFloat(10), debit, startsAt, endsAt)
+	assert.IsType(t, errs.ValidationError{}, err)
+
+	_, err = NewCashbackCampaign("Summer", decimal.NewFromInt(5), vo.ZeroMoney(), debit, startsAt, endsAt)
+	assert.IsType(t, errs.ValidationError{}, err)
+
+	_, err = NewCashbackCampaign("Summer", decimal.NewFromInt(5), vo.NewMoneyFromFloat(10), []vo.TransactionType{vo.TransactionTypeCredit}, startsAt, endsAt)
+	assert.IsType(t, errs.ValidationError{}, err)
+
+	_, err = NewCashbackCampaign("Summer", decimal.NewFromInt(5), vo.NewMoneyFromFloat(10), debit, endsAt, startsAt)
+	assert.IsType(t, errs.ValidationError{}, err)
+}
+
+func TestCashbackCampaign_Earned(t *testing.T) {
+	startsAt := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	endsAt := startsAt.AddDate(0, 1, 0)
+	campaign, err := NewCashbackCampaign("Summer", decimal.NewFromFloat(1.5), vo.NewMoneyFromFloat(10), []vo.TransactionType{vo.TransactionTypeDebit}, startsAt, endsAt)
+	require.NoError(t, err)
+
+	during := startsAt.Add(24 * time.Hour)
+
+	tests := []struct {
+		name            string
+		transactionType vo.TransactionType
+		amount          float64
+		completedAt     time.Time
+		expected        float64
+	}{
+		{name: "percentage", transactionType: vo.TransactionTypeDebit, amount: 100, completedAt: during, expected: 1.5},
+		{name: "truncated", transactionType: vo.TransactionTypeDebit, amount: 33.33, completedAt: during, expected: 0.49},
+		{name: "capped", transactionType: vo.TransactionTypeDebit, amount: 1000, completedAt: during, expected: 10},
+		{name: "first_instant", transactionType: vo.TransactionTypeDebit, amount: 100, completedAt: startsAt, expected: 1.5},
+		{name: "ended", transactionType: vo.TransactionTypeDebit, amount: 100, completedAt: endsAt},
+		{name: "not_covered", transactionType: vo.TransactionTypeTransfer, amount: 100, completedAt: during},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			earned := campaign.Earned(tt.transactionType, vo.NewMoneyFromFloat(tt.amount), tt.completedAt)
+			assert.True(t, earned.Equal(vo.NewMoneyFromFloat(tt.expected)), "got %s", earned)
+		})
+	}
+
+	campaign.SetActive(false)
+	assert.True(t, campaign.Earned(vo.TransactionTypeDebit, vo.NewMoneyFromFloat(100), during).IsZero())
+}
@@ -0,0 +1,201 @@
+package entity
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+)
+
+// CategoryUncategorized is assigned to transactions no rule matches; it is implicit and never stored
+const CategoryUncategorized = "UNCATEGORIZED"
+
+var categoryCodePattern = regexp.MustCompile(`^[A-Z][A-Z0-9_]{0,29}$`)
+
+// CategoryRuleField is the transaction field a categorization rule matches on
+type CategoryRuleField string
+
+const (
+	CategoryRuleFieldDescription CategoryRuleField = "DESCRIPTION"
+	CategoryRuleFieldReference   CategoryRuleField = "REFERENCE"
+	CategoryRuleFieldMerchant    CategoryRuleField = "MERCHANT"
+)
+
+// IsValid checks if the rule field is valid
+func (f CategoryRuleField) IsValid() bool {
+	switch f {
+	case CategoryRuleFieldDescription, CategoryRuleFieldReference, CategoryRuleFieldMerchant:
+		return true
+	default:
+		return false
+	}
+}
+
+// Category is a node of the transaction category taxonomy
+type Category struct {
+	Code       string    `json:"code"`
+	Name       string    `json:"name"`
+	ParentCode string    `json:"parent_code,omitempty"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+// NewCategory creates a new category, optionally nested under a parent
+func NewCategory(code, name, parentCode string) (*Category, error) {
+	code = NormalizeCategoryCode(code)
+	if !categoryCodePattern.MatchString(code) {
+		return nil, errs.ValidationError{
+			Field:   "code",
+			Message: "code must be 1 to 30 characters of A-Z, 0-9 and _ starting with a letter",
+		}
+	}
+
+	if code == CategoryUncategorized {
+		return nil, errs.ValidationError{
+			Field:   "code",
+			Message: CategoryUncategorized + " is reserved",
+		}
+	}
+
+	now := time.Now()
+	category := &Category{
+		Code:      code,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	if err := category.Update(name, parentCode); err != nil {
+		return nil, err
+	}
+
+	return category, nil
+}
+
+// Update renames the category and moves it under another parent
+func (c *Category) Update(name, parentCode string) error {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return errs.ValidationError{
+			Field:   "name",
+			Message: "category name is required",
+		}
+	}
+
+	parentCode = NormalizeCategoryCode(parentCode)
+	if parentCode == c.Code {
+		return errs.ValidationError{
+			Field:   "parentCode",
+			Message: "category cannot be its own parent",
+		}
+	}
+
+	c.Name = name
+	c.ParentCode = parentCode
+	c.UpdatedAt = time.Now()
+	return nil
+}
+
+// NormalizeCategoryCode returns the canonical form of a category code
+func NormalizeCategoryCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+// CategoryRule assigns a category to transactions whose field contains a pattern
+type CategoryRule struct {
+	ID           string            `json:"id"`
+	CategoryCode string            `json:"category_code"`
+	Field        CategoryRuleField `json:"field"`
+	Pattern      string            `json:"pattern"`
+	Priority     int               `json:"priority"` // Lower priorities are evaluated first
+	CreatedAt    time.Time         `json:"created_at"`
+}
+
+// NewCategoryRule creates a new categorization rule
+func NewCategoryRule(categoryCode string, field CategoryRuleField, pattern string, priority int) (*CategoryRule, error) {
+	if !field.IsValid() {
+		return nil, errs.ValidationError{
+			Field:   "field",
+			Message: "invalid rule field: " + string(field),
+		}
+	}
+
+	pattern = strings.TrimSpace(pattern)
+	if pattern == "" {
+		return nil, errs.ValidationError{
+			Field:   "pattern",
+			Message: "pattern is required",
+		}
+	}
+
+	if priority < 0 {
+		return nil, errs.ValidationError{
+			Field:   "priority",
+			Message: "priority cannot be negative",
+		}
+	}
+
+	now := time.Now()
+
+	return &CategoryRule{
+		ID:           vo.NewID("CRL"),
+		CategoryCode: NormalizeCategoryCode(categoryCode),
+		Field:        field,
+		Pattern:      pattern,
+		Priority:     priority,
+		CreatedAt:    now,
+	}, nil
+}
+
+// Matches checks if the rule's field of the transaction contains its pattern, ignoring case
+func (r *CategoryRule) Matches(transaction *Transaction) bool {
+	var value string
+	switch r.Field {
+	case CategoryRuleFieldDescription:
+		value = transaction.Description
+	case CategoryRuleFieldReference:
+		value = transaction.Reference
+	case CategoryRuleFieldMerchant:
+		value = transaction.Merchant
+	}
+
+	return value != "" && strings.Contains(strings.ToLower(value), strings.ToLower(r.Pattern))
+}
+
+// CategorizeTransaction returns the category of the first matching rule by priority,
+// or CategoryUncategorized when no rule matches
+func CategorizeTransaction(transaction *Transaction, rules []*CategoryRule) string {
+	ordered := make([]*CategoryRule, len(rules))
+	copy(ordered, rules)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		return ordered[i].Priority < ordered[j].Priority
+	})
+
+	for _, rule := range ordered {
+		if rule.Matches(transaction) {
+			return rule.CategoryCode
+		}
+	}
+
+	return CategoryUncategorized
+}
+
+// CategoryOverride is an account holder's own category for one of their transactions
+type CategoryOverride struct {
+	AccountID     vo.AccountID     `json:"account_id"`
+	TransactionID vo.TransactionID `json:"transaction_id"`
+	CategoryCode  string           `json:"category_code"`
+	UpdatedAt     time.Time        `json:"updated_at"`
+}
+
+// NewCategoryOverride creates a new category override for a transaction as seen by an account
+func NewCategoryOverride(accountID vo.AccountID, transactionID vo.TransactionID, categoryCode string) *CategoryOverride {
+	return &CategoryOverride{
+		AccountID:     accountID,
+		TransactionID: transactionID,
+		CategoryCode:  NormalizeCategoryCode(categoryCode),
+		UpdatedAt:     time.Now(),
+	}
+}
@@ -0,0 +1,72 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCategory(t *testing.T) {
+	category, err := NewCategory(" groceries ", " Groceries ", "shopping")
+
+	require.NoError(t, err)
+	assert.Equal(t, "GROCERIES", category.Code)
+	assert.Equal(t, "Groceries", category.Name)
+	assert.Equal(t, "SHOPPING", category.ParentCode)
+}
+
+func TestNewCategory_Invalid(t *testing.T) {
+	tests := []struct {
+		name       string
+		code       string
+		catName    string
+		parentCode string
+	}{
+		{name: "empty_code", code: "", catName: "Groceries"},
+		{name: "code_with_spaces", code: "FOOD AND DRINK", catName: "Food"},
+		{name: "reserved_code", code: CategoryUncategorized, catName: "Other"},
+		{name: "empty_name", code: "GROCERIES", catName: " "},
+		{name: "own_parent", code: "GROCERIES", catName: "Groceries", parentCode: "groceries"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewCategory(tt.code, tt.catName, tt.parentCode)
+			assert.IsType(t, errs.ValidationError{}, err)
+		})
+	}
+}
+
+func TestCategorizeTransaction(t *testing.T) {
+	transaction, err := NewDebitTransaction(vo.NewAccountID(), vo.NewMoneyFromFloat(25), "Lunch at Noodle Bar", "INV-2025", time.Now())
+	require.NoError(t, err)
+	transaction.Merchant = "Noodle Bar"
+
+	dining, err := NewCategoryRule("DINING", CategoryRuleFieldMerchant, "noodle", 10)
+	require.NoError(t, err)
+	lunch, err := NewCategoryRule("LUNCH", CategoryRuleFieldDescription, "LUNCH", 1)
+	require.NoError(t, err)
+	invoices, err := NewCategoryRule("BILLS", CategoryRuleFieldReference, "BILL-", 0)
+	require.NoError(t, err)
+
+	// The lowest priority matching rule wins regardless of order
+	assert.Equal(t, "LUNCH", CategorizeTransaction(transaction, []*CategoryRule{dining, lunch, invoices}))
+	assert.Equal(t, "DINING", CategorizeTransaction(transaction, []*CategoryRule{dining, invoices}))
+	assert.Equal(t, CategoryUncategorized, CategorizeTransaction(transaction, []*CategoryRule{invoices}))
+	assert.Equal(t, CategoryUncategorized, CategorizeTransaction(transaction, nil))
+}
+
+func TestNewCategoryRule_Invalid(t *testing.T) {
+	_, err := NewCategoryRule("DINING", CategoryRuleField("AMOUNT"), "noodle", 0)
+	assert.IsType(t, errs.ValidationError{}, err)
+
+	_, err = NewCategoryRule("DINING", CategoryRuleFieldMerchant, " ", 0)
+	assert.IsType(t, errs.ValidationError{}, err)
+
+	_, err = NewCategoryRule("DINING", CategoryRuleFieldMerchant, "noodle", -1)
+	assert.IsType(t, errs.ValidationError{}, err)
+}
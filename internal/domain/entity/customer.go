@@ -0,0 +1,20 @@
+package entity
+
+import (
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+)
+
+// CustomerSummary aggregates the accounts of a customer
+type CustomerSummary struct {
+	CustomerID          string            `json:"customer_id"`
+	AccountCount        int               `json:"account_count"`
+	Balances            []CustomerBalance `json:"balances"`             // One per currency the customer holds accounts in
+	RecentTransactions  []*Transaction    `json:"recent_transactions"`  // Newest first
+	PendingTransactions []*Transaction    `json:"pending_transactions"` // Oldest first
+}
+
+// CustomerBalance is the combined balance of a customer's accounts held in one currency
+type CustomerBalance struct {
+	Total        vo.Money `json:"total"`
+	AccountCount int      `json:"account_count"`
+}
@@ -0,0 +1,60 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+)
+
+// DailyAggregate totals the completed money movement of an account on one UTC day. Aggregates are
+// materialized as transactions complete, so reports read one row per day instead of every transaction.
+type DailyAggregate struct {
+	AccountID vo.AccountID
+	Date      time.Time // Midnight UTC
+	Inflow    vo.Money
+	Outflow   vo.Money // Fees included
+	Count     int
+	UpdatedAt time.Time
+}
+
+// NewDailyAggregate creates an empty aggregate for the UTC day containing at
+func NewDailyAggregate(accountID vo.AccountID, at time.Time) *DailyAggregate {
+	at = at.UTC()
+	return &DailyAggregate{
+		AccountID: accountID,
+		Date:      time.Date(at.Year(), at.Month(), at.Day(), 0, 0, 0, 0, time.UTC),
+		Inflow:    vo.ZeroMoney(),
+		Outflow:   vo.ZeroMoney(),
+		UpdatedAt: time.Now(),
+	}
+}
+
+// NewDailyAggregateFor creates the aggregate a completed transaction contributes to an account's day.
+// It returns nil when the transaction is not completed or does not touch the account.
+func NewDailyAggregateFor(transaction *Transaction, accountID vo.AccountID) *DailyAggregate {
+	change := transaction.BalanceEffect(accountID)
+	if transaction.CompletedAt == nil || change.IsZero() {
+		return nil
+	}
+
+	aggregate := NewDailyAggregate(accountID, *transaction.CompletedAt)
+	aggregate.Add(change)
+	return aggregate
+}
+
+// Add counts a transaction that changed the account's balance by change
+func (a *DailyAggregate) Add(change vo.Money) {
+	if change.IsNegative() {
+		a.Outflow, _ = a.Outflow.Add(change.Abs())
+	} else {
+		a.Inflow, _ = a.Inflow.Add(change)
+	}
+	a.Count++
+	a.UpdatedAt = time.Now()
+}
+
+// Net returns the inflow less the outflow
+func (a *DailyAggregate) Net() vo.Money {
+	net, _ := a.Inflow.Subtract(a.Outflow)
+	return net
+}
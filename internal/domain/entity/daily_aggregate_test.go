@@ -0,0 +1,41 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewDailyAggregateFor(t *testing.T) {
+	fromID, toID := vo.NewAccountID(), vo.NewAccountID()
+	transfer, err := NewTransferTransaction(fromID, toID, vo.NewMoneyFromFloat(100), "Rent", "", time.Now())
+	require.NoError(t, err)
+	transfer.Fee = vo.NewMoneyFromFloat(5)
+
+	// Nothing counts until the transfer completes
+	assert.Nil(t, NewDailyAggregateFor(transfer, fromID))
+
+	require.NoError(t, transfer.MarkAsCompleted(time.Now()))
+	completedAt := time.Date(2024, 3, 15, 23, 30, 0, 0, time.FixedZone("UTC-2", -2*60*60))
+	transfer.CompletedAt = &completedAt
+
+	outgoing := NewDailyAggregateFor(transfer, fromID)
+	require.NotNil(t, outgoing)
+	assert.Equal(t, time.Date(2024, 3, 16, 0, 0, 0, 0, time.UTC), outgoing.Date)
+	assert.Equal(t, "105", outgoing.Outflow.String())
+	assert.True(t, outgoing.Inflow.IsZero())
+	assert.Equal(t, 1, outgoing.Count)
+
+	incoming := NewDailyAggregateFor(transfer, toID)
+	require.NotNil(t, incoming)
+	assert.Equal(t, "100", incoming.Inflow.String())
+
+	incoming.Add(vo.NewMoneyFromFloat(-30))
+	assert.Equal(t, "70", incoming.Net().String())
+	assert.Equal(t, 2, incoming.Count)
+
+	assert.Nil(t, NewDailyAggregateFor(transfer, vo.NewAccountID()))
+}
@@ -0,0 +1,144 @@
+package entity
+
+import (
+	"time"
+
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+)
+
+// ExportKind tells what an export contains
+type ExportKind string
+
+const (
+	ExportKindAudit          ExportKind = "AUDIT"           // The audit log as signed NDJSON
+	ExportKindAccountHistory ExportKind = "ACCOUNT_HISTORY" // An account's transaction history as CSV
+)
+
+// Events posted to an export's callback URL once it finishes
+const (
+	ExportCallbackCompleted = "export.completed"
+	ExportCallbackFailed    = "export.failed"
+)
+
+// Export represents a file produced in the background and kept in blob storage until it expires
+type Export struct {
+	ID          string          `json:"id"`
+	Kind        ExportKind      `json:"kind"`
+	AccountID   string          `json:"account_id,omitempty"` // Account of a history export, or the account filter of an audit export
+	EventType   string          `json:"event_type,omitempty"` // Event type filter of an audit export
+	From        *time.Time      `json:"from,omitempty"`
+	To          *time.Time      `json:"to,omitempty"`
+	Status      vo.ExportStatus `json:"status"`
+	FileName    string          `json:"file_name"`
+	ContentType string          `json:"content_type"`
+	ObjectKey   string          `json:"object_key,omitempty"` // Key of the file in blob storage once it is written
+	SizeBytes   int64           `json:"size_bytes"`
+	Error       string          `json:"error,omitempty"`
+	RequestedBy string          `json:"requested_by"`
+	CreatedAt   time.Time       `json:"created_at"`
+	CompletedAt *time.Time      `json:"completed_at,omitempty"`
+	ExpiresAt   time.Time       `json:"expires_at"` // The file is deleted and can no longer be downloaded after this
+
+	// CallbackURL is notified once the export finishes, signed with CallbackSecret like a webhook,
+	// so the client need not poll; empty notifies no one
+	CallbackURL         string     `json:"callback_url,omitempty"`
+	CallbackSecret      string     `json:"-"`
+	CallbackDeliveredAt *time.Time `json:"callback_delivered_at,omitempty"`
+	CallbackError       string     `json:"callback_error,omitempty"` // Why the last delivery failed
+}
+
+// NewExport creates a new running export, requested at now, of a file kept for retention
+func NewExport(kind ExportKind, fileName, contentType, requestedBy string, retention time.Duration, now time.Time) *Export {
+	return &Export{
+		ID:          vo.NewID("EXP"),
+		Kind:        kind,
+		Status:      vo.ExportStatusRunning,
+		FileName:    fileName,
+		ContentType: contentType,
+		RequestedBy: requestedBy,
+		CreatedAt:   now,
+		ExpiresAt:   now.Add(retention),
+	}
+}
+
+// MarkAsCompleted records the file the export wrote to blob storage
+func (e *Export) MarkAsCompleted(objectKey string, sizeBytes int64) error {
+	if !e.Status.IsRunning() {
+		return errs.BusinessError{
+			Code:    "INVALID_STATUS_TRANSITION",
+			Message: "cannot complete export with status: " + string(e.Status),
+		}
+	}
+
+	now := time.Now()
+	e.Status = vo.ExportStatusCompleted
+	e.ObjectKey = objectKey
+	e.SizeBytes = sizeBytes
+	e.CompletedAt = &now
+	return nil
+}
+
+// MarkAsFailed records a failed export with its reason
+func (e *Export) MarkAsFailed(reason string) error {
+	if !e.Status.IsRunning() {
+		return errs.BusinessError{
+			Code:    "INVALID_STATUS_TRANSITION",
+			Message: "cannot fail export with status: " + string(e.Status),
+		}
+	}
+
+	now := time.Now()
+	e.Status = vo.ExportStatusFailed
+	e.Error = reason
+	e.CompletedAt = &now
+	return nil
+}
+
+// SetCallback has the export notify target once it finishes, signed with a freshly generated secret
+func (e *Export) SetCallback(target string) error {
+	if !isWebhookURL(target) {
+		return errs.ValidationError{
+			Field:   "callback_url",
+			Message: "callback_url must be an absolute http or https URL",
+		}
+	}
+
+	secret, err := newWebhookSecret()
+	if err != nil {
+		return err
+	}
+	e.CallbackURL = target
+	e.CallbackSecret = secret
+	return nil
+}
+
+// CallbackEvent returns the event a finished export's callback reports
+func (e *Export) CallbackEvent() string {
+	if e.Status == vo.ExportStatusCompleted {
+		return ExportCallbackCompleted
+	}
+	return ExportCallbackFailed
+}
+
+// RecordCallbackDelivered records that the callback URL accepted the notification
+func (e *Export) RecordCallbackDelivered() {
+	now := time.Now()
+	e.CallbackDeliveredAt = &now
+	e.CallbackError = ""
+}
+
+// RecordCallbackFailure records why the notification could not be delivered
+func (e *Export) RecordCallbackFailure(reason string) {
+	e.CallbackError = reason
+}
+
+// IsExpired checks if the export's retention ended at now
+func (e *Export) IsExpired(now time.Time) bool {
+	return !now.Before(e.ExpiresAt)
+}
+
+// IsDownloadable checks if the export's file can be downloaded at now
+func (e *Export) IsDownloadable(now time.Time) bool {
+	return e.Status == vo.ExportStatusCompleted && !e.IsExpired(now)
+}
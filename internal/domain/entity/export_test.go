@@ -0,0 +1,72 @@
+package entity
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewExport(t *testing.T) {
+	export := NewExport(ExportKindAudit, "audit.ndjson", "application/x-ndjson", "127.0.0.1", 24*time.Hour, time.Now())
+
+	assert.Len(t, export.ID, vo.IDLength)
+	assert.Equal(t, "EXP", export.ID[:3])
+	assert.Equal(t, vo.ExportStatusRunning, export.Status)
+	assert.Equal(t, export.CreatedAt.Add(24*time.Hour), export.ExpiresAt)
+	assert.False(t, export.IsDownloadable(time.Now()))
+}
+
+func TestExport_MarkAsCompleted(t *testing.T) {
+	export := NewExport(ExportKindAccountHistory, "history.csv", "text/csv", "admin", time.Hour, time.Now())
+
+	require.NoError(t, export.MarkAsCompleted("exports/history.csv", 2048))
+
+	assert.Equal(t, vo.ExportStatusCompleted, export.Status)
+	assert.Equal(t, "exports/history.csv", export.ObjectKey)
+	assert.Equal(t, int64(2048), export.SizeBytes)
+	assert.NotNil(t, export.CompletedAt)
+	assert.True(t, export.IsDownloadable(time.Now()))
+	assert.False(t, export.IsDownloadable(export.ExpiresAt))
+
+	// A finished export cannot change state again
+	err := export.MarkAsFailed("late failure")
+	require.Error(t, err)
+	assert.IsType(t, errs.BusinessError{}, err)
+	assert.Equal(t, vo.ExportStatusCompleted, export.Status)
+}
+
+func TestExport_MarkAsFailed(t *testing.T) {
+	export := NewExport(ExportKindAudit, "audit.ndjson", "application/x-ndjson", "admin", time.Hour, time.Now())
+
+	require.NoError(t, export.MarkAsFailed("storage unavailable"))
+
+	assert.Equal(t, vo.ExportStatusFailed, export.Status)
+	assert.Equal(t, "storage unavailable", export.Error)
+	assert.False(t, export.IsDownloadable(time.Now()))
+	assert.Error(t, export.MarkAsCompleted("exports/audit.ndjson", 1))
+}
+
+func TestExport_SetCallback(t *testing.T) {
+	export := NewExport(ExportKindAccountHistory, "history.csv", "text/csv", "admin", time.Hour, time.Now())
+
+	err := export.SetCallback("ftp://client.example/exports")
+	assert.IsType(t, errs.ValidationError{}, err)
+	assert.Empty(t, export.CallbackURL)
+
+	require.NoError(t, export.SetCallback("https://client.example/exports"))
+	assert.Equal(t, "https://client.example/exports", export.CallbackURL)
+	assert.True(t, strings.HasPrefix(export.CallbackSecret, "whsec_"))
+
+	require.NoError(t, export.MarkAsFailed("disk full"))
+	assert.Equal(t, ExportCallbackFailed, export.CallbackEvent())
+
+	export.RecordCallbackFailure("unexpected status 500")
+	export.RecordCallbackDelivered()
+	assert.NotNil(t, export.CallbackDeliveredAt)
+	assert.Empty(t, export.CallbackError)
+}
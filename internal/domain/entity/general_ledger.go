@@ -0,0 +1,243 @@
+package entity
+
+import (
+	"strings"
+	"time"
+
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"github.com/shopspring/decimal"
+)
+
+// GLAccountType classifies a general ledger account
+type GLAccountType string
+
+const (
+	GLAccountTypeAsset     GLAccountType = "ASSET"
+	GLAccountTypeLiability GLAccountType = "LIABILITY"
+	GLAccountTypeIncome    GLAccountType = "INCOME"
+	GLAccountTypeExpense   GLAccountType = "EXPENSE"
+)
+
+// GLSide is the side of the ledger a posting is booked on
+type GLSide string
+
+const (
+	GLSideDebit  GLSide = "DEBIT"
+	GLSideCredit GLSide = "CREDIT"
+)
+
+// General ledger account codes
+const (
+	GLCodeCash              = "1000"
+	GLCodeFXPosition        = "1500"
+	GLCodeCustomerDeposits  = "2000"
+	GLCodeFeeIncome         = "4000"
+	GLCodeIncentivesExpense = "5000"
+	GLCodeInterestExpense   = "5100"
+	GLCodeAdjustments       = "5900"
+)
+
+// InterestReferencePrefix starts the reference of credits paying interest to an account
+const InterestReferencePrefix = "INTEREST-"
+
+// GLAccount is an account of the bank's own books, as opposed to a customer account
+type GLAccount struct {
+	Code string        `json:"code"`
+	Name string        `json:"name"`
+	Type GLAccountType `json:"type"`
+}
+
+// NormalSide returns the side that increases the account: debit for assets and expenses, credit for
+// liabilities and income
+func (a GLAccount) NormalSide() GLSide {
+	if a.Type == GLAccountTypeAsset || a.Type == GLAccountTypeExpense {
+		return GLSideDebit
+	}
+	return GLSideCredit
+}
+
+// ChartOfAccounts lists the general ledger accounts customer transactions are posted to, by code
+var ChartOfAccounts = []GLAccount{
+	{Code: GLCodeCash, Name: "Cash and settlement", Type: GLAccountTypeAsset},
+	{Code: GLCodeFXPosition, Name: "Foreign exchange position", Type: GLAccountTypeAsset},
+	{Code: GLCodeCustomerDeposits, Name: "Customer deposits", Type: GLAccountTypeLiability},
+	{Code: GLCodeFeeIncome, Name: "Fee income", Type: GLAccountTypeIncome},
+	{Code: GLCodeIncentivesExpense, Name: "Cashback and referral bonuses", Type: GLAccountTypeExpense},
+	{Code: GLCodeInterestExpense, Name: "Interest expense", Type: GLAccountTypeExpense},
+	{Code: GLCodeAdjustments, Name: "Balance adjustments", Type: GLAccountTypeExpense},
+}
+
+// FindGLAccount looks up an account of the chart by code
+func FindGLAccount(code string) (GLAccount, bool) {
+	for _, account := range ChartOfAccounts {
+		if account.Code == code {
+			return account, true
+		}
+	}
+	return GLAccount{}, false
+}
+
+// GLPosting is one line of the journal entry a completed transaction is booked as. The lines of an
+// entry are numbered from 1 and their debits equal their credits.
+type GLPosting struct {
+	TransactionID     vo.TransactionID `json:"transaction_id"`
+	Line              int              `json:"line"`
+	Account           string           `json:"account"` // GL account code
+	Side              GLSide           `json:"side"`
+	Amount            vo.Money         `json:"amount"`
+	CustomerAccountID *vo.AccountID    `json:"customer_account_id,omitempty"` // Customer account the line is booked for
+	ProductID         string           `json:"product_id,omitempty"`          // Product of the customer account
+	Period            string           `json:"period"`                        // Accounting period of the value date, YYYY-MM
+	ValueDate         time.Time        `json:"value_date"`
+	CreatedAt         time.Time        `json:"created_at"`
+}
+
+// GLBalance totals the postings to a GL account
+type GLBalance struct {
+	Account string
+	Debit   vo.Money
+	Credit  vo.Money
+}
+
+// GLProductTotal totals the postings to a GL account in a period for the accounts of one product
+type GLProductTotal struct {
+	Period    string
+	ProductID string // Empty for accounts opened without a product
+	Account   string
+	Debit     vo.Money
+	Credit    vo.Money
+}
+
+// NewGLPostings books a completed transaction as a balanced journal entry. Customer balances are the
+// bank's deposits: the source account's amount and fee are debited from them and the destination's
+// amount credited. Money leaving or entering the bank goes through cash, fees are income, and
+// cashback, referral bonuses, interest and balance adjustments are expenses. An FX transfer buys the
+// source currency into and sells the destination currency out of the FX position, so each currency
+// of the entry balances on its own. Every line is booked for
+// the customer account it concerns: the fee for the account charged, the counter line for the account paid.
+func NewGLPostings(transaction *Transaction) ([]*GLPosting, error) {
+	if !transaction.Status.IsCompleted() {
+		return nil, errs.BusinessError{
+			Code:    "INVALID_STATUS_TRANSITION",
+			Message: "cannot post transaction with status: " + string(transaction.Status),
+		}
+	}
+
+	entry := &glEntry{transaction: transaction, createdAt: time.Now()}
+
+	from, to := transaction.FromAccountID, transaction.ToAccountID
+	if from != nil {
+		entry.add(GLCodeCustomerDeposits, GLSideDebit, transaction.TotalDebit(), from)
+	}
+	if to != nil {
+		entry.add(GLCodeCustomerDeposits, GLSideCredit, transaction.CreditedAmount(), to)
+	}
+
+	switch transaction.TransactionType {
+	case vo.TransactionTypeDebit:
+		entry.add(GLCodeCash, GLSideCredit, transaction.Amount, from)
+	case vo.TransactionTypeCredit:
+		entry.add(creditSource(transaction), GLSideDebit, transaction.Amount, to)
+	case vo.TransactionTypeTransfer:
+		// The amount stays within customer deposits
+	case vo.TransactionTypeFXTransfer:
+		entry.add(GLCodeFXPosition, GLSideCredit, transaction.Amount, from)
+		entry.add(GLCodeFXPosition, GLSideDebit, transaction.ConvertedAmount, to)
+	case vo.TransactionTypeReversal:
+		// Books the opposite of the original's customer lines; a reversed credit goes back through cash
+		switch {
+		case transaction.ExchangeRate != nil:
+			entry.add(GLCodeFXPosition, GLSideCredit, transaction.Amount, from)
+			entry.add(GLCodeFXPosition, GLSideDebit, transaction.ConvertedAmount, to)
+		case from == nil:
+			entry.add(GLCodeCash, GLSideDebit, transaction.Amount, to)
+		case to == nil:
+			entry.add(GLCodeCash, GLSideCredit, transaction.Amount, from)
+		}
+	case vo.TransactionTypeAdjustment:
+		// Kept apart from customer money movements so corrections can be reported on their own
+		if to != nil {
+			entry.add(GLCodeAdjustments, GLSideDebit, transaction.Amount, to)
+		} else {
+			entry.add(GLCodeAdjustments, GLSideCredit, transaction.Amount, from)
+		}
+	default:
+		return nil, errs.ErrUnsupportedType
+	}
+
+	if transaction.Fee.IsPositive() {
+		entry.add(GLCodeFeeIncome, GLSideCredit, transaction.Fee, from)
+	}
+
+	if !entry.balanced() {
+		return nil, errs.BusinessError{
+			Code:    "UNBALANCED_ENTRY",
+			Message: "journal entry of transaction " + transaction.ID.String() + " does not balance",
+		}
+	}
+	return entry.postings, nil
+}
+
+// creditSource returns the GL account a credit's money comes from
+func creditSource(transaction *Transaction) string {
+	switch {
+	case transaction.IsFeeRefund():
+		return GLCodeFeeIncome
+	case strings.HasPrefix(transaction.Reference, InterestReferencePrefix):
+		return GLCodeInterestExpense
+	case strings.HasPrefix(transaction.Reference, CashbackReferencePrefix),
+		strings.HasPrefix(transaction.Reference, ReferralReferencePrefix):
+		return GLCodeIncentivesExpense
+	default:
+		return GLCodeCash
+	}
+}
+
+// glEntry collects the postings of one transaction
+type glEntry struct {
+	transaction *Transaction
+	createdAt   time.Time
+	postings    []*GLPosting
+}
+
+// add appends a line booked for a customer account; zero amounts are not posted
+func (e *glEntry) add(account string, side GLSide, amount vo.Money, customerAccountID *vo.AccountID) {
+	if amount.IsZero() {
+		return
+	}
+	e.postings = append(e.postings, &GLPosting{
+		TransactionID:     e.transaction.ID,
+		Line:              len(e.postings) + 1,
+		Account:           account,
+		Side:              side,
+		Amount:            amount,
+		CustomerAccountID: customerAccountID,
+		Period:            AccountingPeriodOf(e.transaction.ValueDate),
+		ValueDate:         e.transaction.ValueDate,
+		CreatedAt:         e.createdAt,
+	})
+}
+
+// balanced checks the entry's debits equal its credits in each currency; lines in an unspecified
+// currency are in the transaction's
+func (e *glEntry) balanced() bool {
+	net := make(map[vo.Currency]decimal.Decimal)
+	for _, posting := range e.postings {
+		currency := posting.Amount.Currency()
+		if currency.IsZero() {
+			currency = e.transaction.Currency()
+		}
+		if posting.Side == GLSideDebit {
+			net[currency] = net[currency].Add(posting.Amount.Amount())
+		} else {
+			net[currency] = net[currency].Sub(posting.Amount.Amount())
+		}
+	}
+	for _, amount := range net {
+		if !amount.IsZero() {
+			return false
+		}
+	}
+	return true
+}
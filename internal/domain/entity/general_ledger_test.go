@@ -0,0 +1,233 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// glLine is a posting's account, side and amount
+type glLine struct {
+	account string
+	side    GLSide
+	amount  string
+}
+
+func glLines(postings []*GLPosting) []glLine {
+	lines := make([]glLine, len(postings))
+	for i, posting := range postings {
+		lines[i] = glLine{account: posting.Account, side: posting.Side, amount: posting.Amount.String()}
+	}
+	return lines
+}
+
+func TestNewGLPostings(t *testing.T) {
+	fromID, toID := vo.NewAccountID(), vo.NewAccountID()
+
+	withdrawal, err := NewDebitTransaction(fromID, vo.NewMoneyFromFloat(100), "ATM", "", time.Now())
+	require.NoError(t, err)
+	withdrawal.Fee = vo.NewMoneyFromFloat(5)
+
+	deposit, err := NewCreditTransaction(toID, vo.NewMoneyFromFloat(200), "Salary", "", time.Now())
+	require.NoError(t, err)
+
+	transfer, err := NewTransferTransaction(fromID, toID, vo.NewMoneyFromFloat(50), "Rent", "", time.Now())
+	require.NoError(t, err)
+	transfer.Fee = vo.NewMoneyFromFloat(2)
+
+	cashback, err := NewCreditTransaction(fromID, vo.NewMoneyFromFloat(3), "Cashback", CashbackReferencePrefix+"CBK1", time.Now())
+	require.NoError(t, err)
+
+	interest, err := NewCreditTransaction(toID, vo.NewMoneyFromFloat(1.25), "Interest", InterestReferencePrefix+"2024-03", time.Now())
+	require.NoError(t, err)
+
+	increase, err := NewAdjustmentTransaction(toID, vo.AdjustmentDirectionIncrease, vo.NewMoneyFromFloat(7), vo.AdjustmentReasonBookingError, "", "ops", time.Now())
+	require.NoError(t, err)
+
+	decrease, err := NewAdjustmentTransaction(fromID, vo.AdjustmentDirectionDecrease, vo.NewMoneyFromFloat(4), vo.AdjustmentReasonSystemError, "", "ops", time.Now())
+	require.NoError(t, err)
+
+	tests := []struct {
+		name        string
+		transaction *Transaction
+		expected    []glLine
+	}{
+		{
+			name:        "withdrawal with a fee",
+			transaction: withdrawal,
+			expected: []glLine{
+				{GLCodeCustomerDeposits, GLSideDebit, "105"},
+				{GLCodeCash, GLSideCredit, "100"},
+				{GLCodeFeeIncome, GLSideCredit, "5"},
+			},
+		},
+		{
+			name:        "deposit",
+			transaction: deposit,
+			expected: []glLine{
+				{GLCodeCustomerDeposits, GLSideCredit, "200"},
+				{GLCodeCash, GLSideDebit, "200"},
+			},
+		},
+		{
+			name:        "transfer with a fee",
+			transaction: transfer,
+			expected: []glLine{
+				{GLCodeCustomerDeposits, GLSideDebit, "52"},
+				{GLCodeCustomerDeposits, GLSideCredit, "50"},
+				{GLCodeFeeIncome, GLSideCredit, "2"},
+			},
+		},
+		{
+			name:        "cashback",
+			transaction: cashback,
+			expected: []glLine{
+				{GLCodeCustomerDeposits, GLSideCredit, "3"},
+				{GLCodeIncentivesExpense, GLSideDebit, "3"},
+			},
+		},
+		{
+			name:        "interest",
+			transaction: interest,
+			expected: []glLine{
+				{GLCodeCustomerDeposits, GLSideCredit, "1.25"},
+				{GLCodeInterestExpense, GLSideDebit, "1.25"},
+			},
+		},
+		{
+			name:        "adjustment increasing a balance",
+			transaction: increase,
+			expected: []glLine{
+				{GLCodeCustomerDeposits, GLSideCredit, "7"},
+				{GLCodeAdjustments, GLSideDebit, "7"},
+			},
+		},
+		{
+			name:        "adjustment decreasing a balance",
+			transaction: decrease,
+			expected: []glLine{
+				{GLCodeCustomerDeposits, GLSideDebit, "4"},
+				{GLCodeAdjustments, GLSideCredit, "4"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.NoError(t, tt.transaction.MarkAsCompleted(time.Now()))
+
+			postings, err := NewGLPostings(tt.transaction)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, glLines(postings))
+			for i, posting := range postings {
+				assert.Equal(t, i+1, posting.Line)
+				assert.NotNil(t, posting.CustomerAccountID)
+				assert.Equal(t, AccountingPeriodOf(tt.transaction.ValueDate), posting.Period)
+			}
+		})
+	}
+}
+
+func TestNewGLPostings_CustomerAccounts(t *testing.T) {
+	fromID, toID := vo.NewAccountID(), vo.NewAccountID()
+	transfer, err := NewTransferTransaction(fromID, toID, vo.NewMoneyFromFloat(50), "Rent", "", time.Now())
+	require.NoError(t, err)
+	transfer.Fee = vo.NewMoneyFromFloat(2)
+	require.NoError(t, transfer.MarkAsCompleted(time.Now()))
+
+	postings, err := NewGLPostings(transfer)
+
+	// The fee is booked for the account charged
+	require.NoError(t, err)
+	require.Len(t, postings, 3)
+	assert.Equal(t, fromID, *postings[0].CustomerAccountID)
+	assert.Equal(t, toID, *postings[1].CustomerAccountID)
+	assert.Equal(t, fromID, *postings[2].CustomerAccountID)
+}
+
+func TestNewGLPostings_FeeRefund(t *testing.T) {
+	original, err := NewDebitTransaction(vo.NewAccountID(), vo.NewMoneyFromFloat(100), "ATM", "", time.Now())
+	require.NoError(t, err)
+	original.Fee = vo.NewMoneyFromFloat(5)
+	require.NoError(t, original.MarkAsCompleted(time.Now()))
+
+	refund, err := NewFeeRefundTransaction(original, time.Now())
+	require.NoError(t, err)
+	require.NoError(t, refund.MarkAsCompleted(time.Now()))
+	assert.True(t, refund.IsFeeRefund())
+
+	postings, err := NewGLPostings(refund)
+
+	require.NoError(t, err)
+	assert.Equal(t, []glLine{
+		{GLCodeCustomerDeposits, GLSideCredit, "5"},
+		{GLCodeFeeIncome, GLSideDebit, "5"},
+	}, glLines(postings))
+
+	// A reversal of the amount goes back through cash
+	reversal, err := NewReversalTransaction(original, time.Now())
+	require.NoError(t, err)
+	assert.False(t, reversal.IsFeeRefund())
+	require.NoError(t, reversal.MarkAsCompleted(time.Now()))
+
+	postings, err = NewGLPostings(reversal)
+
+	require.NoError(t, err)
+	assert.Equal(t, []glLine{
+		{GLCodeCustomerDeposits, GLSideCredit, "100"},
+		{GLCodeCash, GLSideDebit, "100"},
+	}, glLines(postings))
+}
+
+func TestNewGLPostings_FXTransfer(t *testing.T) {
+	rate, err := vo.NewExchangeRate("USD", "THB", decimal.RequireFromString("35.5"), time.Now())
+	require.NoError(t, err)
+	transfer, err := NewFXTransferTransaction(vo.NewAccountID(), vo.NewAccountID(), vo.NewMoneyFromInt(100), rate, "Tuition", "", time.Now())
+	require.NoError(t, err)
+	require.NoError(t, transfer.SetFee(vo.NewMoneyFromInt(2)))
+	require.NoError(t, transfer.MarkAsCompleted(time.Now()))
+
+	postings, err := NewGLPostings(transfer)
+
+	// Each currency balances on its own through the FX position
+	require.NoError(t, err)
+	assert.Equal(t, []glLine{
+		{GLCodeCustomerDeposits, GLSideDebit, "102"},
+		{GLCodeCustomerDeposits, GLSideCredit, "3550"},
+		{GLCodeFXPosition, GLSideCredit, "100"},
+		{GLCodeFXPosition, GLSideDebit, "3550"},
+		{GLCodeFeeIncome, GLSideCredit, "2"},
+	}, glLines(postings))
+	assert.Equal(t, vo.Currency("USD"), postings[0].Amount.Currency())
+	assert.Equal(t, vo.Currency("THB"), postings[3].Amount.Currency())
+}
+
+func TestNewGLPostings_NotCompleted(t *testing.T) {
+	pending, err := NewCreditTransaction(vo.NewAccountID(), vo.NewMoneyFromFloat(10), "Deposit", "", time.Now())
+	require.NoError(t, err)
+	pending.ValueDate = time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	_, err = NewGLPostings(pending)
+	assert.Error(t, err)
+}
+
+func TestGLAccountNormalSide(t *testing.T) {
+	for _, account := range ChartOfAccounts {
+		found, ok := FindGLAccount(account.Code)
+		require.True(t, ok)
+		assert.Equal(t, account, found)
+	}
+
+	cash, _ := FindGLAccount(GLCodeCash)
+	deposits, _ := FindGLAccount(GLCodeCustomerDeposits)
+	assert.Equal(t, GLSideDebit, cash.NormalSide())
+	assert.Equal(t, GLSideCredit, deposits.NormalSide())
+
+	_, ok := FindGLAccount("9999")
+	assert.False(t, ok)
+}
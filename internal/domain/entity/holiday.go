@@ -0,0 +1,48 @@
+package entity
+
+import (
+	"strings"
+	"time"
+
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+)
+
+// Holiday represents a non-business day in a calendar region
+type Holiday struct {
+	Region    string    `json:"region"`
+	Date      time.Time `json:"date"`
+	Name      string    `json:"name"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// NewHoliday creates a new holiday for a region
+func NewHoliday(region string, date time.Time, name string) (*Holiday, error) {
+	region = NormalizeRegion(region)
+	if region == "" || len(region) > 10 {
+		return nil, errs.ValidationError{
+			Field:   "region",
+			Message: "region must be 1 to 10 characters",
+		}
+	}
+
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, errs.ValidationError{
+			Field:   "name",
+			Message: "holiday name is required",
+		}
+	}
+
+	return &Holiday{
+		Region:    region,
+		Date:      vo.DateOf(date),
+		Name:      name,
+		CreatedAt: time.Now(),
+	}, nil
+}
+
+// NormalizeRegion returns the canonical form of a calendar region code
+func NormalizeRegion(region string) string {
+	return strings.ToUpper(strings.TrimSpace(region))
+}
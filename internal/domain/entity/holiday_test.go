@@ -0,0 +1,34 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewHoliday(t *testing.T) {
+	date := time.Date(2025, 12, 31, 15, 30, 0, 0, time.UTC)
+
+	holiday, err := NewHoliday(" th ", date, " New Year's Eve ")
+
+	require.NoError(t, err)
+	assert.Equal(t, "TH", holiday.Region)
+	assert.Equal(t, "New Year's Eve", holiday.Name)
+	assert.Equal(t, time.Date(2025, 12, 31, 0, 0, 0, 0, time.UTC), holiday.Date)
+}
+
+func TestNewHoliday_Invalid(t *testing.T) {
+	date := time.Date(2025, 12, 31, 0, 0, 0, 0, time.UTC)
+
+	_, err := NewHoliday("", date, "New Year's Eve")
+	assert.IsType(t, errs.ValidationError{}, err)
+
+	_, err = NewHoliday("VERY-LONG-REGION", date, "New Year's Eve")
+	assert.IsType(t, errs.ValidationError{}, err)
+
+	_, err = NewHoliday("TH", date, "  ")
+	assert.IsType(t, errs.ValidationError{}, err)
+}
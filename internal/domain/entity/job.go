@@ -0,0 +1,172 @@
+package entity
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+)
+
+// Job represents a unit of background work in the job queue. Workers claim a job with a lease and
+// renew it while they run; a job whose lease lapses, because its worker died, is claimed again.
+type Job struct {
+	ID          string          `json:"id"`
+	Type        string          `json:"type"` // Selects the handler that runs the job
+	Payload     json.RawMessage `json:"payload,omitempty"`
+	UniqueKey   string          `json:"unique_key,omitempty"` // At most one job exists per key, e.g. per run of a schedule
+	Status      vo.JobStatus    `json:"status"`
+	Attempts    int             `json:"attempts"`
+	MaxAttempts int             `json:"max_attempts"`
+	RunAt       time.Time       `json:"run_at"`                 // Earliest time the job may run; pushed back between retries
+	LockedBy    string          `json:"locked_by,omitempty"`    // Worker that claimed the job last
+	LockedUntil *time.Time      `json:"locked_until,omitempty"` // Lease of a running job
+	LastError   string          `json:"last_error,omitempty"`
+	CreatedAt   time.Time       `json:"created_at"`
+	StartedAt   *time.Time      `json:"started_at,omitempty"`
+	FinishedAt  *time.Time      `json:"finished_at,omitempty"`
+}
+
+// NewJob creates a new queued job of the given type running payload, encoded as JSON, no earlier than runAt
+func NewJob(jobType string, payload interface{}, maxAttempts int, runAt time.Time) (*Job, error) {
+	if jobType == "" {
+		return nil, errs.ValidationError{Field: "type", Message: "job type is required"}
+	}
+	if maxAttempts < 1 {
+		return nil, errs.ValidationError{Field: "max_attempts", Message: "a job needs at least one attempt"}
+	}
+
+	var data json.RawMessage
+	if payload != nil {
+		var err error
+		if data, err = json.Marshal(payload); err != nil {
+			return nil, fmt.Errorf("failed to encode %s job payload: %w", jobType, err)
+		}
+	}
+
+	now := time.Now()
+
+	return &Job{
+		ID:          vo.NewID("JOB"),
+		Type:        jobType,
+		Payload:     data,
+		Status:      vo.JobStatusQueued,
+		MaxAttempts: maxAttempts,
+		RunAt:       runAt,
+		CreatedAt:   now,
+	}, nil
+}
+
+// DecodePayload decodes the job's JSON payload into v
+func (j *Job) DecodePayload(v interface{}) error {
+	if err := json.Unmarshal(j.Payload, v); err != nil {
+		return fmt.Errorf("invalid %s job payload: %w", j.Type, err)
+	}
+	return nil
+}
+
+// IsClaimable checks if a worker may claim the job at now: it is due, or its worker's lease lapsed
+func (j *Job) IsClaimable(now time.Time) bool {
+	switch j.Status {
+	case vo.JobStatusQueued:
+		return !now.Before(j.RunAt)
+	case vo.JobStatusRunning:
+		return j.LockedUntil != nil && !now.Before(*j.LockedUntil)
+	default:
+		return false
+	}
+}
+
+// Start records a worker's claim on the job, leased until leaseUntil, as a new attempt
+func (j *Job) Start(workerID string, now, leaseUntil time.Time) error {
+	if !j.IsClaimable(now) {
+		return errs.BusinessError{
+			Code:    "INVALID_STATUS_TRANSITION",
+			Message: "cannot start job with status: " + string(j.Status),
+		}
+	}
+
+	j.Status = vo.JobStatusRunning
+	j.Attempts++
+	j.LockedBy = workerID
+	j.LockedUntil = &leaseUntil
+	j.StartedAt = &now
+	return nil
+}
+
+// IsLastAttempt checks if a failure of the current attempt fails the job for good
+func (j *Job) IsLastAttempt() bool {
+	return j.Attempts >= j.MaxAttempts
+}
+
+// MarkSucceeded records that the current attempt succeeded
+func (j *Job) MarkSucceeded() error {
+	if j.Status != vo.JobStatusRunning {
+		return errs.BusinessError{
+			Code:    "INVALID_STATUS_TRANSITION",
+			Message: "cannot complete job with status: " + string(j.Status),
+		}
+	}
+
+	now := time.Now()
+	j.Status = vo.JobStatusSucceeded
+	j.LockedUntil = nil
+	j.LastError = ""
+	j.FinishedAt = &now
+	return nil
+}
+
+// MarkFailed records a failed attempt. The job is queued again to run at retryAt, unless it was
+// its last attempt.
+func (j *Job) MarkFailed(reason string, retryAt time.Time) error {
+	if j.Status != vo.JobStatusRunning {
+		return errs.BusinessError{
+			Code:    "INVALID_STATUS_TRANSITION",
+			Message: "cannot fail job with status: " + string(j.Status),
+		}
+	}
+
+	j.LockedUntil = nil
+	j.LastError = reason
+	if j.IsLastAttempt() {
+		now := time.Now()
+		j.Status = vo.JobStatusFailed
+		j.FinishedAt = &now
+		return nil
+	}
+
+	j.Status = vo.JobStatusQueued
+	j.RunAt = retryAt
+	return nil
+}
+
+// Interrupt returns a running job to the queue without counting the attempt, as when its worker
+// shuts down before the job finished
+func (j *Job) Interrupt(now time.Time) error {
+	if j.Status != vo.JobStatusRunning {
+		return errs.BusinessError{
+			Code:    "INVALID_STATUS_TRANSITION",
+			Message: "cannot interrupt job with status: " + string(j.Status),
+		}
+	}
+
+	j.Status = vo.JobStatusQueued
+	j.Attempts--
+	j.RunAt = now
+	j.LockedUntil = nil
+	return nil
+}
+
+// Retry queues a failed job again with a fresh set of attempts
+func (j *Job) Retry(now time.Time) error {
+	if j.Status != vo.JobStatusFailed {
+		return errs.ErrJobNotRetryable
+	}
+
+	j.Status = vo.JobStatusQueued
+	j.Attempts = 0
+	j.RunAt = now
+	j.FinishedAt = nil
+	return nil
+}
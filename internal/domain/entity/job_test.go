@@ -0,0 +1,99 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewJob(t *testing.T) {
+	runAt := time.Now().Add(time.Minute)
+	job, err := NewJob("backup.run", map[string]string{"backup_id": "BKP1"}, 3, runAt)
+	require.NoError(t, err)
+
+	assert.Len(t, job.ID, vo.IDLength)
+	assert.Equal(t, "JOB", job.ID[:3])
+	assert.Equal(t, vo.JobStatusQueued, job.Status)
+	assert.False(t, job.IsClaimable(time.Now()))
+	assert.True(t, job.IsClaimable(runAt))
+
+	var payload struct {
+		BackupID string `json:"backup_id"`
+	}
+	require.NoError(t, job.DecodePayload(&payload))
+	assert.Equal(t, "BKP1", payload.BackupID)
+
+	_, err = NewJob("", nil, 1, runAt)
+	assert.IsType(t, errs.ValidationError{}, err)
+	_, err = NewJob("backup.run", nil, 0, runAt)
+	assert.IsType(t, errs.ValidationError{}, err)
+}
+
+func TestJob_RetriesUntilOutOfAttempts(t *testing.T) {
+	now := time.Now()
+	job, err := NewJob("export.run", nil, 2, now)
+	require.NoError(t, err)
+
+	require.NoError(t, job.Start("worker-1", now, now.Add(time.Minute)))
+	assert.Equal(t, vo.JobStatusRunning, job.Status)
+	assert.Equal(t, 1, job.Attempts)
+	assert.False(t, job.IsLastAttempt())
+
+	// A running job cannot be claimed again until its lease lapses
+	assert.False(t, job.IsClaimable(now.Add(30*time.Second)))
+	assert.True(t, job.IsClaimable(now.Add(time.Minute)))
+
+	retryAt := now.Add(10 * time.Second)
+	require.NoError(t, job.MarkFailed("storage unavailable", retryAt))
+	assert.Equal(t, vo.JobStatusQueued, job.Status)
+	assert.Equal(t, retryAt, job.RunAt)
+	assert.Nil(t, job.LockedUntil)
+
+	require.NoError(t, job.Start("worker-2", retryAt, retryAt.Add(time.Minute)))
+	assert.True(t, job.IsLastAttempt())
+	require.NoError(t, job.MarkFailed("storage unavailable", retryAt))
+	assert.Equal(t, vo.JobStatusFailed, job.Status)
+	assert.Equal(t, "storage unavailable", job.LastError)
+	assert.NotNil(t, job.FinishedAt)
+	assert.False(t, job.IsClaimable(retryAt.Add(time.Hour)))
+
+	// A failed job can be retried by hand with fresh attempts
+	require.NoError(t, job.Retry(now))
+	assert.Equal(t, vo.JobStatusQueued, job.Status)
+	assert.Equal(t, 0, job.Attempts)
+	assert.Nil(t, job.FinishedAt)
+}
+
+func TestJob_MarkSucceeded(t *testing.T) {
+	now := time.Now()
+	job, err := NewJob("review.sweep", nil, 1, now)
+	require.NoError(t, err)
+
+	assert.Error(t, job.MarkSucceeded())
+	require.NoError(t, job.Start("worker-1", now, now.Add(time.Minute)))
+	require.NoError(t, job.MarkSucceeded())
+
+	assert.Equal(t, vo.JobStatusSucceeded, job.Status)
+	assert.NotNil(t, job.FinishedAt)
+	assert.ErrorIs(t, job.Retry(now), errs.ErrJobNotRetryable)
+	assert.Error(t, job.Start("worker-2", now, now.Add(time.Minute)))
+}
+
+func TestJob_Interrupt(t *testing.T) {
+	now := time.Now()
+	job, err := NewJob("backup.run", nil, 3, now)
+	require.NoError(t, err)
+	require.NoError(t, job.Start("worker-1", now, now.Add(time.Minute)))
+
+	later := now.Add(time.Second)
+	require.NoError(t, job.Interrupt(later))
+
+	assert.Equal(t, vo.JobStatusQueued, job.Status)
+	assert.Equal(t, 0, job.Attempts)
+	assert.Equal(t, later, job.RunAt)
+	assert.Error(t, job.Interrupt(later))
+}
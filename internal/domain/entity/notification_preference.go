@@ -0,0 +1,231 @@
+package entity
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+)
+
+// DefaultNotificationTimezone is the time zone quiet hours are read in when a customer sets none
+const DefaultNotificationTimezone = "UTC"
+
+const maxNotificationRecipientLength = 200
+
+// QuietHours is the daily window, in the customer's time zone, in which notifications that
+// interrupt (SMS and push) are held back. A window ending before it starts spans midnight.
+type QuietHours struct {
+	Start string `json:"start"` // HH:MM
+	End   string `json:"end"`   // HH:MM
+}
+
+// NotificationPreference is how a customer wants to be notified: the channels for each event
+// type, where each channel reaches them, the locale messages are written in, the quiet hours
+// in which SMS and push stay silent and the smallest transaction worth an alert.
+type NotificationPreference struct {
+	CustomerID string                              `json:"customer_id"`
+	Locale     string                              `json:"locale"`
+	Timezone   string                              `json:"timezone"`
+	Recipients map[vo.NotificationChannel]string   `json:"recipients"` // Address, phone number or device token per channel
+	Events     map[string][]vo.NotificationChannel `json:"events"`     // Channels per event type
+	QuietHours *QuietHours                         `json:"quiet_hours,omitempty"`
+	MinAmount  *vo.Money                           `json:"min_amount,omitempty"` // Transactions below it are not alerted
+	UpdatedAt  time.Time                           `json:"updated_at"`
+}
+
+// NewNotificationPreference creates the notification preferences of a customer
+func NewNotificationPreference(
+	customerID, locale, timezone string,
+	recipients map[vo.NotificationChannel]string,
+	events map[string][]vo.NotificationChannel,
+	quietHours *QuietHours,
+	minAmount *vo.Money,
+) (*NotificationPreference, error) {
+	customerID = strings.TrimSpace(customerID)
+	if customerID == "" {
+		return nil, errs.ValidationError{
+			Field:   "customer_id",
+			Message: "customer ID is required",
+		}
+	}
+
+	locale, err := NormalizeNotificationLocale(locale)
+	if err != nil {
+		return nil, err
+	}
+
+	timezone = strings.TrimSpace(timezone)
+	if timezone == "" {
+		timezone = DefaultNotificationTimezone
+	}
+	if _, err := time.LoadLocation(timezone); err != nil {
+		return nil, errs.ValidationError{
+			Field:   "timezone",
+			Message: "unknown time zone " + timezone,
+		}
+	}
+
+	cleanRecipients := make(map[vo.NotificationChannel]string, len(recipients))
+	for channel, recipient := range recipients {
+		if !channel.IsValid() {
+			return nil, errs.ValidationError{
+				Field:   "recipients",
+				Message: "invalid notification channel: " + string(channel),
+			}
+		}
+		recipient = strings.TrimSpace(recipient)
+		if recipient == "" {
+			continue
+		}
+		if len(recipient) > maxNotificationRecipientLength {
+			return nil, errs.ValidationError{
+				Field:   "recipients",
+				Message: fmt.Sprintf("recipient cannot exceed %d characters", maxNotificationRecipientLength),
+			}
+		}
+		cleanRecipients[channel] = recipient
+	}
+
+	cleanEvents := make(map[string][]vo.NotificationChannel, len(events))
+	for event, channels := range events {
+		if !notificationEventPattern.MatchString(event) {
+			return nil, errs.ValidationError{
+				Field:   "events",
+				Message: "event must be an event type such as transaction.completed",
+			}
+		}
+
+		seen := make(map[vo.NotificationChannel]bool, len(channels))
+		var unique []vo.NotificationChannel
+		for _, channel := range channels {
+			if !channel.IsValid() {
+				return nil, errs.ValidationError{
+					Field:   "events",
+					Message: "invalid notification channel: " + string(channel),
+				}
+			}
+			if _, ok := cleanRecipients[channel]; !ok {
+				return nil, errs.ValidationError{
+					Field:   "events",
+					Message: fmt.Sprintf("%s notifications for %s need a %s recipient", channel, event, channel),
+				}
+			}
+			if !seen[channel] {
+				seen[channel] = true
+				unique = append(unique, channel)
+			}
+		}
+		if len(unique) > 0 {
+			sort.Slice(unique, func(i, j int) bool { return unique[i] < unique[j] })
+			cleanEvents[event] = unique
+		}
+	}
+
+	if quietHours != nil {
+		start, startErr := parseClock(quietHours.Start)
+		end, endErr := parseClock(quietHours.End)
+		if startErr != nil || endErr != nil {
+			return nil, errs.ValidationError{
+				Field:   "quiet_hours",
+				Message: "quiet hours start and end must be times such as 22:00",
+			}
+		}
+		if start == end {
+			return nil, errs.ValidationError{
+				Field:   "quiet_hours",
+				Message: "quiet hours must start and end at different times",
+			}
+		}
+		quietHours = &QuietHours{Start: strings.TrimSpace(quietHours.Start), End: strings.TrimSpace(quietHours.End)}
+	}
+
+	if minAmount != nil && minAmount.IsNegative() {
+		return nil, errs.ValidationError{
+			Field:   "min_amount",
+			Message: "minimum amount cannot be negative",
+		}
+	}
+
+	return &NotificationPreference{
+		CustomerID: customerID,
+		Locale:     locale,
+		Timezone:   timezone,
+		Recipients: cleanRecipients,
+		Events:     cleanEvents,
+		QuietHours: quietHours,
+		MinAmount:  minAmount,
+		UpdatedAt:  time.Now(),
+	}, nil
+}
+
+// DefaultNotificationPreference returns the preferences of a customer who has set none: no
+// notifications at all
+func DefaultNotificationPreference(customerID string) *NotificationPreference {
+	return &NotificationPreference{
+		CustomerID: customerID,
+		Locale:     DefaultNotificationLocale,
+		Timezone:   DefaultNotificationTimezone,
+		Recipients: map[vo.NotificationChannel]string{},
+		Events:     map[string][]vo.NotificationChannel{},
+	}
+}
+
+// Channels returns the channels a notification of the event goes out on at the given time. The
+// amount, nil for events without one, is checked against the minimum, and SMS and push are left
+// out during quiet hours.
+func (p *NotificationPreference) Channels(event string, amount *vo.Money, at time.Time) []vo.NotificationChannel {
+	if amount != nil && p.MinAmount != nil && amount.LessThan(*p.MinAmount) {
+		return nil
+	}
+
+	quiet := p.InQuietHours(at)
+
+	var channels []vo.NotificationChannel
+	for _, channel := range p.Events[event] {
+		if quiet && channel != vo.NotificationChannelEmail {
+			continue
+		}
+		channels = append(channels, channel)
+	}
+	return channels
+}
+
+// InQuietHours checks if the instant falls in the customer's quiet hours
+func (p *NotificationPreference) InQuietHours(at time.Time) bool {
+	if p.QuietHours == nil {
+		return false
+	}
+
+	start, err := parseClock(p.QuietHours.Start)
+	if err != nil {
+		return false
+	}
+	end, err := parseClock(p.QuietHours.End)
+	if err != nil {
+		return false
+	}
+
+	location, err := time.LoadLocation(p.Timezone)
+	if err != nil {
+		location = time.UTC
+	}
+	local := at.In(location)
+	now := time.Duration(local.Hour())*time.Hour + time.Duration(local.Minute())*time.Minute
+
+	if start < end {
+		return now >= start && now < end
+	}
+	return now >= start || now < end
+}
+
+// parseClock parses an HH:MM time of day into its offset from midnight
+func parseClock(clock string) (time.Duration, error) {
+	parsed, err := time.Parse("15:04", strings.TrimSpace(clock))
+	if err != nil {
+		return 0, err
+	}
+	return time.Duration(parsed.Hour())*time.Hour + time.Duration(parsed.Minute())*time.Minute, nil
+}
@@ -0,0 +1,89 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewNotificationPreference(t *testing.T) {
+	recipients := map[vo.NotificationChannel]string{
+		vo.NotificationChannelEmail: " alice@example.com ",
+		vo.NotificationChannelSMS:   "+66800000000",
+	}
+	events := map[string][]vo.NotificationChannel{
+		"transaction.completed": {vo.NotificationChannelSMS, vo.NotificationChannelEmail, vo.NotificationChannelSMS},
+		"transaction.failed":    {},
+	}
+	minAmount := vo.NewMoneyFromFloat(100)
+
+	preference, err := NewNotificationPreference("CUST-1", "", "", recipients, events, &QuietHours{Start: "22:00", End: "07:00"}, &minAmount)
+	require.NoError(t, err)
+	assert.Equal(t, DefaultNotificationLocale, preference.Locale)
+	assert.Equal(t, DefaultNotificationTimezone, preference.Timezone)
+	assert.Equal(t, "alice@example.com", preference.Recipients[vo.NotificationChannelEmail])
+	assert.Equal(t, []vo.NotificationChannel{vo.NotificationChannelEmail, vo.NotificationChannelSMS}, preference.Events["transaction.completed"])
+	assert.NotContains(t, preference.Events, "transaction.failed")
+
+	_, err = NewNotificationPreference("CUST-1", "en", "", recipients, map[string][]vo.NotificationChannel{
+		"transaction.completed": {vo.NotificationChannelPush},
+	}, nil, nil)
+	assert.IsType(t, errs.ValidationError{}, err, "push needs a device token")
+
+	_, err = NewNotificationPreference("CUST-1", "en", "Mars/Olympus", recipients, nil, nil, nil)
+	assert.IsType(t, errs.ValidationError{}, err)
+
+	_, err = NewNotificationPreference("CUST-1", "en", "", recipients, nil, &QuietHours{Start: "22:00", End: "22:00"}, nil)
+	assert.IsType(t, errs.ValidationError{}, err)
+
+	_, err = NewNotificationPreference("CUST-1", "en", "", recipients, nil, &QuietHours{Start: "10pm", End: "07:00"}, nil)
+	assert.IsType(t, errs.ValidationError{}, err)
+
+	negative := vo.NewMoneyFromFloat(-1)
+	_, err = NewNotificationPreference("CUST-1", "en", "", recipients, nil, nil, &negative)
+	assert.IsType(t, errs.ValidationError{}, err)
+}
+
+func TestNotificationPreference_Channels(t *testing.T) {
+	minAmount := vo.NewMoneyFromFloat(100)
+	preference, err := NewNotificationPreference("CUST-1", "th", "Asia/Bangkok",
+		map[vo.NotificationChannel]string{
+			vo.NotificationChannelEmail: "alice@example.com",
+			vo.NotificationChannelPush:  "device-token",
+		},
+		map[string][]vo.NotificationChannel{
+			"transaction.completed":    {vo.NotificationChannelEmail, vo.NotificationChannelPush},
+			"budget.threshold_reached": {vo.NotificationChannelPush},
+		},
+		&QuietHours{Start: "22:00", End: "07:00"},
+		&minAmount,
+	)
+	require.NoError(t, err)
+
+	bangkok, err := time.LoadLocation("Asia/Bangkok")
+	require.NoError(t, err)
+	noon := time.Date(2024, 1, 1, 12, 0, 0, 0, bangkok)
+	midnight := time.Date(2024, 1, 1, 23, 30, 0, 0, bangkok)
+	early := time.Date(2024, 1, 2, 6, 59, 0, 0, bangkok)
+	morning := time.Date(2024, 1, 2, 7, 0, 0, 0, bangkok)
+
+	large := vo.NewMoneyFromFloat(250)
+	small := vo.NewMoneyFromFloat(99.99)
+
+	assert.Equal(t, []vo.NotificationChannel{vo.NotificationChannelEmail, vo.NotificationChannelPush}, preference.Channels("transaction.completed", &large, noon))
+	assert.Empty(t, preference.Channels("transaction.completed", &small, noon), "below the minimum amount")
+	assert.Empty(t, preference.Channels("transaction.failed", &large, noon), "event not chosen")
+
+	// Quiet hours span midnight and only silence push
+	assert.Equal(t, []vo.NotificationChannel{vo.NotificationChannelEmail}, preference.Channels("transaction.completed", &large, midnight))
+	assert.Equal(t, []vo.NotificationChannel{vo.NotificationChannelEmail}, preference.Channels("transaction.completed", &large, early))
+	assert.Equal(t, []vo.NotificationChannel{vo.NotificationChannelPush}, preference.Channels("budget.threshold_reached", nil, morning))
+
+	// Quiet hours are read in the customer's time zone
+	assert.True(t, preference.InQuietHours(time.Date(2024, 1, 1, 16, 0, 0, 0, time.UTC)))
+	assert.False(t, preference.InQuietHours(time.Date(2024, 1, 1, 14, 0, 0, 0, time.UTC)))
+}
@@ -0,0 +1,187 @@
+package entity
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"text/template"
+	"time"
+
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+)
+
+// DefaultNotificationLocale is the locale used when a template has no version in the customer's locale
+const DefaultNotificationLocale = "en"
+
+const (
+	maxNotificationSubjectLength = 200
+	maxNotificationBodyLength    = 5000
+)
+
+var (
+	notificationEventPattern  = regexp.MustCompile(`^[a-z_]+(\.[a-z_]+)+$`)
+	notificationLocalePattern = regexp.MustCompile(`^[a-z]{2}(-[a-z]{2})?$`)
+)
+
+// NotificationTemplate is one version of the message sent for an event on a channel in a locale.
+// The subject and body are Go text templates executed against the event's data. Templates are
+// never edited in place: every change is saved as the next version, so earlier content can be
+// restored and sent messages traced back to what produced them.
+type NotificationTemplate struct {
+	ID        string                 `json:"id"`
+	Event     string                 `json:"event"` // Event type, e.g. transaction.completed
+	Channel   vo.NotificationChannel `json:"channel"`
+	Locale    string                 `json:"locale"` // e.g. en, th, en-gb
+	Version   int                    `json:"version"`
+	Subject   string                 `json:"subject,omitempty"`
+	Body      string                 `json:"body"`
+	CreatedBy string                 `json:"created_by,omitempty"`
+	CreatedAt time.Time              `json:"created_at"`
+}
+
+// RenderedNotification is a notification template executed against an event's data
+type RenderedNotification struct {
+	Subject string
+	Body    string
+}
+
+// NewNotificationTemplate creates the next version of a template after previous, or its first version
+// when previous is nil
+func NewNotificationTemplate(event string, channel vo.NotificationChannel, locale, subject, body, createdBy string, previous *NotificationTemplate) (*NotificationTemplate, error) {
+	event = strings.TrimSpace(event)
+	if !notificationEventPattern.MatchString(event) {
+		return nil, errs.ValidationError{
+			Field:   "event",
+			Message: "event must be an event type such as transaction.completed",
+		}
+	}
+
+	if !channel.IsValid() {
+		return nil, errs.ValidationError{
+			Field:   "channel",
+			Message: "invalid notification channel: " + string(channel),
+		}
+	}
+
+	locale, err := NormalizeNotificationLocale(locale)
+	if err != nil {
+		return nil, err
+	}
+
+	subject = strings.TrimSpace(subject)
+	if channel.HasSubject() && subject == "" {
+		return nil, errs.ValidationError{
+			Field:   "subject",
+			Message: fmt.Sprintf("%s templates need a subject", channel),
+		}
+	}
+	if !channel.HasSubject() && subject != "" {
+		return nil, errs.ValidationError{
+			Field:   "subject",
+			Message: fmt.Sprintf("%s messages have no subject", channel),
+		}
+	}
+	if len(subject) > maxNotificationSubjectLength {
+		return nil, errs.ValidationError{
+			Field:   "subject",
+			Message: fmt.Sprintf("subject cannot exceed %d characters", maxNotificationSubjectLength),
+		}
+	}
+
+	if strings.TrimSpace(body) == "" {
+		return nil, errs.ValidationError{
+			Field:   "body",
+			Message: "body is required",
+		}
+	}
+	if len(body) > maxNotificationBodyLength {
+		return nil, errs.ValidationError{
+			Field:   "body",
+			Message: fmt.Sprintf("body cannot exceed %d characters", maxNotificationBodyLength),
+		}
+	}
+
+	now := time.Now()
+
+	t := &NotificationTemplate{
+		ID:        vo.NewID("NTP"),
+		Event:     event,
+		Channel:   channel,
+		Locale:    locale,
+		Version:   1,
+		Subject:   subject,
+		Body:      body,
+		CreatedBy: strings.TrimSpace(createdBy),
+		CreatedAt: now,
+	}
+	if previous != nil {
+		t.Version = previous.Version + 1
+	}
+
+	// Reject templates that do not parse, so a saved template only fails to render on missing data
+	if _, _, err := t.parse(); err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+// NormalizeNotificationLocale lower-cases a locale and checks it is a language, optionally with a region
+func NormalizeNotificationLocale(locale string) (string, error) {
+	locale = strings.ToLower(strings.ReplaceAll(strings.TrimSpace(locale), "_", "-"))
+	if locale == "" {
+		return DefaultNotificationLocale, nil
+	}
+	if !notificationLocalePattern.MatchString(locale) {
+		return "", errs.ValidationError{
+			Field:   "locale",
+			Message: "locale must be a language code such as en or th, optionally with a region such as en-gb",
+		}
+	}
+	return locale, nil
+}
+
+// Render executes the template against an event's data. Referring to data the event does not
+// carry is an error rather than an empty string, so a broken template is noticed.
+func (t *NotificationTemplate) Render(data map[string]interface{}) (*RenderedNotification, error) {
+	subject, body, err := t.parse()
+	if err != nil {
+		return nil, err
+	}
+
+	rendered := &RenderedNotification{}
+	if subject != nil {
+		if rendered.Subject, err = executeTemplate(subject, data); err != nil {
+			return nil, errs.ValidationError{Field: "subject", Message: err.Error()}
+		}
+	}
+	if rendered.Body, err = executeTemplate(body, data); err != nil {
+		return nil, errs.ValidationError{Field: "body", Message: err.Error()}
+	}
+	return rendered, nil
+}
+
+// parse parses the subject, nil when the channel has none, and the body
+func (t *NotificationTemplate) parse() (subject, body *template.Template, err error) {
+	if t.Subject != "" {
+		subject, err = template.New("subject").Option("missingkey=error").Parse(t.Subject)
+		if err != nil {
+			return nil, nil, errs.ValidationError{Field: "subject", Message: err.Error()}
+		}
+	}
+	body, err = template.New("body").Option("missingkey=error").Parse(t.Body)
+	if err != nil {
+		return nil, nil, errs.ValidationError{Field: "body", Message: err.Error()}
+	}
+	return subject, body, nil
+}
+
+// executeTemplate runs a parsed template into a string
+func executeTemplate(tmpl *template.Template, data map[string]interface{}) (string, error) {
+	var out strings.Builder
+	if err := tmpl.Execute(&out, data); err != nil {
+		return "", err
+	}
+	return out.String(), nil
+}
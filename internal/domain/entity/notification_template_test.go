@@ -0,0 +1,50 @@
+package entity
+
+import (
+	"testing"
+
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewNotificationTemplate(t *testing.T) {
+	first, err := NewNotificationTemplate("transaction.completed", vo.NotificationChannelEmail, "EN_gb", "Paid {{.amount}}", "You sent {{.amount}}", "ops", nil)
+	require.NoError(t, err)
+	assert.Equal(t, 1, first.Version)
+	assert.Equal(t, "en-gb", first.Locale)
+
+	second, err := NewNotificationTemplate("transaction.completed", vo.NotificationChannelEmail, "en-gb", "Sent {{.amount}}", "You sent {{.amount}}", "ops", first)
+	require.NoError(t, err)
+	assert.Equal(t, 2, second.Version)
+
+	_, err = NewNotificationTemplate("transaction.completed", vo.NotificationChannelEmail, "en", "", "Body", "ops", nil)
+	assert.IsType(t, errs.ValidationError{}, err, "email needs a subject")
+
+	_, err = NewNotificationTemplate("transaction.completed", vo.NotificationChannelSMS, "en", "Subject", "Body", "ops", nil)
+	assert.IsType(t, errs.ValidationError{}, err, "sms has no subject")
+
+	_, err = NewNotificationTemplate("transaction.completed", vo.NotificationChannelSMS, "en", "", "Sent {{.amount", "ops", nil)
+	assert.IsType(t, errs.ValidationError{}, err, "body must parse")
+
+	_, err = NewNotificationTemplate("completed", vo.NotificationChannelSMS, "en", "", "Body", "ops", nil)
+	assert.IsType(t, errs.ValidationError{}, err)
+
+	_, err = NewNotificationTemplate("transaction.completed", vo.NotificationChannelSMS, "english", "", "Body", "ops", nil)
+	assert.IsType(t, errs.ValidationError{}, err)
+}
+
+func TestNotificationTemplate_Render(t *testing.T) {
+	template, err := NewNotificationTemplate("transaction.completed", vo.NotificationChannelPush, "", "Sent {{.amount}}", "{{.amount}} sent to {{.to_account_id}}", "ops", nil)
+	require.NoError(t, err)
+	assert.Equal(t, DefaultNotificationLocale, template.Locale)
+
+	rendered, err := template.Render(map[string]interface{}{"amount": "250.00", "to_account_id": "2024010187654321"})
+	require.NoError(t, err)
+	assert.Equal(t, "Sent 250.00", rendered.Subject)
+	assert.Equal(t, "250.00 sent to 2024010187654321", rendered.Body)
+
+	_, err = template.Render(map[string]interface{}{"amount": "250.00"})
+	assert.IsType(t, errs.ValidationError{}, err, "missing data is an error")
+}
@@ -0,0 +1,177 @@
+package entity
+
+import (
+	"strings"
+	"time"
+
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+)
+
+// OwnershipTransfer moves an account from one customer to another, e.g. to an heir or the buyer of a
+// business. An admin requests it, a second admin approves it, and it takes effect on its effective
+// date; until then it can be rejected. The account keeps its ID, balance and history.
+type OwnershipTransfer struct {
+	ID             string                     `json:"id"`
+	AccountID      vo.AccountID               `json:"account_id"`
+	FromCustomerID string                     `json:"from_customer_id"`
+	ToCustomerID   string                     `json:"to_customer_id"`
+	ReasonCode     vo.OwnershipTransferReason `json:"reason_code"`
+	Note           string                     `json:"note,omitempty"`
+	EffectiveDate  time.Time                  `json:"effective_date"` // Business date the account changes hands
+	Status         vo.OwnershipTransferStatus `json:"status"`
+	RequestedBy    string                     `json:"requested_by"`
+	DecidedBy      string                     `json:"decided_by,omitempty"` // Admin who approved or rejected it
+	DecisionReason string                     `json:"decision_reason,omitempty"`
+	CreatedAt      time.Time                  `json:"created_at"`
+	DecidedAt      *time.Time                 `json:"decided_at,omitempty"`
+	CompletedAt    *time.Time                 `json:"completed_at,omitempty"`
+}
+
+// NewOwnershipTransfer requests moving a standalone account to another customer on effectiveDate,
+// which may not be before today
+func NewOwnershipTransfer(account *Account, toCustomerID string, reasonCode vo.OwnershipTransferReason, note string,
+	effectiveDate, today time.Time, requestedBy string) (*OwnershipTransfer, error) {
+	toCustomerID = strings.TrimSpace(toCustomerID)
+	if toCustomerID == "" || len(toCustomerID) > 50 {
+		return nil, errs.ValidationError{
+			Field:   "to_customer_id",
+			Message: "customer ID must be 1 to 50 characters",
+		}
+	}
+
+	if !reasonCode.IsValid() {
+		return nil, errs.ValidationError{
+			Field:   "reason_code",
+			Message: "invalid reason code: " + string(reasonCode),
+		}
+	}
+
+	requestedBy = strings.TrimSpace(requestedBy)
+	if requestedBy == "" {
+		return nil, errs.ValidationError{
+			Field:   "requested_by",
+			Message: "requested by is required",
+		}
+	}
+
+	if effectiveDate.Before(today) {
+		return nil, errs.ValidationError{
+			Field:   "effective_date",
+			Message: "effective date cannot be in the past",
+		}
+	}
+
+	if err := checkTransferable(account, toCustomerID); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+
+	return &OwnershipTransfer{
+		ID:             vo.NewID("OWN"),
+		AccountID:      account.ID,
+		FromCustomerID: account.CustomerID,
+		ToCustomerID:   toCustomerID,
+		ReasonCode:     reasonCode,
+		Note:           strings.TrimSpace(note),
+		EffectiveDate:  effectiveDate,
+		Status:         vo.OwnershipTransferStatusPending,
+		RequestedBy:    requestedBy,
+		CreatedAt:      now,
+	}, nil
+}
+
+// checkTransferable rejects moving an account without an owner, to its owner, or inside a corporate
+// group, whose accounts must all belong to one customer
+func checkTransferable(account *Account, toCustomerID string) error {
+	if account.CustomerID == "" {
+		return errs.BusinessError{
+			Code:    "INVALID_OWNERSHIP_TRANSFER",
+			Message: "account has no owner to transfer it from; assign a customer instead",
+		}
+	}
+
+	if account.CustomerID == toCustomerID {
+		return errs.BusinessError{
+			Code:    "INVALID_OWNERSHIP_TRANSFER",
+			Message: "account already belongs to customer " + toCustomerID,
+		}
+	}
+
+	if account.IsChild() {
+		return errs.BusinessError{
+			Code:    "INVALID_OWNERSHIP_TRANSFER",
+			Message: "account belongs to parent " + account.ParentAccountID.String() + "; detach it first",
+		}
+	}
+	return nil
+}
+
+// Approve records a second admin's approval; the transfer then waits for its effective date
+func (t *OwnershipTransfer) Approve(approver string) error {
+	if t.Status != vo.OwnershipTransferStatusPending {
+		return errs.ErrOwnershipTransferNotPending
+	}
+
+	approver = strings.TrimSpace(approver)
+	if strings.EqualFold(approver, t.RequestedBy) {
+		return errs.ErrSelfApproval
+	}
+
+	now := time.Now()
+	t.Status = vo.OwnershipTransferStatusApproved
+	t.DecidedBy = approver
+	t.DecidedAt = &now
+	return nil
+}
+
+// Reject rejects a pending transfer, or withdraws an approved one that has not taken effect
+func (t *OwnershipTransfer) Reject(admin, reason string) error {
+	if !t.Status.IsOpen() {
+		return errs.ErrOwnershipTransferNotPending
+	}
+
+	now := time.Now()
+	t.Status = vo.OwnershipTransferStatusRejected
+	t.DecidedBy = strings.TrimSpace(admin)
+	t.DecisionReason = strings.TrimSpace(reason)
+	t.DecidedAt = &now
+	return nil
+}
+
+// IsDue checks if an approved transfer has reached its effective date
+func (t *OwnershipTransfer) IsDue(today time.Time) bool {
+	return t.Status == vo.OwnershipTransferStatusApproved && !t.EffectiveDate.After(today)
+}
+
+// Complete hands the account to the new customer. An account already moved to them, by an earlier
+// attempt that failed to record the transfer, is left as it is.
+func (t *OwnershipTransfer) Complete(account *Account, today time.Time) error {
+	if !t.IsDue(today) {
+		return errs.BusinessError{
+			Code:    "INVALID_STATUS_TRANSITION",
+			Message: "cannot complete ownership transfer with status " + string(t.Status) + " before its effective date",
+		}
+	}
+
+	if account.CustomerID != t.ToCustomerID {
+		if account.CustomerID != t.FromCustomerID {
+			return errs.BusinessError{
+				Code:    "INVALID_OWNERSHIP_TRANSFER",
+				Message: "account no longer belongs to customer " + t.FromCustomerID,
+			}
+		}
+		if err := checkTransferable(account, t.ToCustomerID); err != nil {
+			return err
+		}
+		if err := account.AssignCustomer(t.ToCustomerID); err != nil {
+			return err
+		}
+	}
+
+	now := time.Now()
+	t.Status = vo.OwnershipTransferStatusCompleted
+	t.CompletedAt = &now
+	return nil
+}
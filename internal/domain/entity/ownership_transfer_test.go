@@ -0,0 +1,81 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewOwnershipTransfer(t *testing.T) {
+	today := time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC)
+	account, err := NewAccount("Shop", vo.NewMoneyFromFloat(100))
+	require.NoError(t, err)
+
+	// An account without an owner is assigned, not transferred
+	_, err = NewOwnershipTransfer(account, "CUST-2", vo.OwnershipTransferReasonGift, "", today, today, "alice")
+	assert.IsType(t, errs.BusinessError{}, err)
+
+	require.NoError(t, account.AssignCustomer("CUST-1"))
+	transfer, err := NewOwnershipTransfer(account, " CUST-2 ", vo.OwnershipTransferReasonBusinessSale, " sold ", today, today, " alice ")
+	require.NoError(t, err)
+	assert.Equal(t, "CUST-1", transfer.FromCustomerID)
+	assert.Equal(t, "CUST-2", transfer.ToCustomerID)
+	assert.Equal(t, "sold", transfer.Note)
+	assert.Equal(t, "alice", transfer.RequestedBy)
+	assert.Equal(t, vo.OwnershipTransferStatusPending, transfer.Status)
+	assert.Len(t, transfer.ID, vo.IDLength)
+
+	_, err = NewOwnershipTransfer(account, "CUST-1", vo.OwnershipTransferReasonGift, "", today, today, "alice")
+	assert.IsType(t, errs.BusinessError{}, err)
+	_, err = NewOwnershipTransfer(account, "CUST-2", vo.OwnershipTransferReason("WHIM"), "", today, today, "alice")
+	assert.IsType(t, errs.ValidationError{}, err)
+	_, err = NewOwnershipTransfer(account, "CUST-2", vo.OwnershipTransferReasonGift, "", today.AddDate(0, 0, -1), today, "alice")
+	assert.IsType(t, errs.ValidationError{}, err)
+	_, err = NewOwnershipTransfer(account, "CUST-2", vo.OwnershipTransferReasonGift, "", today, today, " ")
+	assert.IsType(t, errs.ValidationError{}, err)
+
+	parentID := vo.NewAccountID()
+	account.ParentAccountID = &parentID
+	_, err = NewOwnershipTransfer(account, "CUST-2", vo.OwnershipTransferReasonGift, "", today, today, "alice")
+	assert.IsType(t, errs.BusinessError{}, err)
+}
+
+func TestOwnershipTransferLifecycle(t *testing.T) {
+	today := time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC)
+	account, err := NewAccount("Shop", vo.NewMoneyFromFloat(100))
+	require.NoError(t, err)
+	require.NoError(t, account.AssignCustomer("CUST-1"))
+
+	transfer, err := NewOwnershipTransfer(account, "CUST-2", vo.OwnershipTransferReasonInheritance, "", today.AddDate(0, 0, 1), today, "alice")
+	require.NoError(t, err)
+
+	assert.ErrorIs(t, transfer.Approve("ALICE"), errs.ErrSelfApproval)
+	require.NoError(t, transfer.Approve("bob"))
+	assert.ErrorIs(t, transfer.Approve("carol"), errs.ErrOwnershipTransferNotPending)
+
+	// Approved transfers wait for their effective date
+	assert.False(t, transfer.IsDue(today))
+	assert.IsType(t, errs.BusinessError{}, transfer.Complete(account, today))
+	assert.Equal(t, "CUST-1", account.CustomerID)
+
+	tomorrow := today.AddDate(0, 0, 1)
+	require.NoError(t, transfer.Complete(account, tomorrow))
+	assert.Equal(t, vo.OwnershipTransferStatusCompleted, transfer.Status)
+	assert.Equal(t, "CUST-2", account.CustomerID)
+	require.NotNil(t, transfer.CompletedAt)
+	assert.ErrorIs(t, transfer.Reject("bob", "too late"), errs.ErrOwnershipTransferNotPending)
+
+	// An account that moved to a third customer meanwhile is not taken from them
+	stale := &OwnershipTransfer{FromCustomerID: "CUST-1", ToCustomerID: "CUST-3", EffectiveDate: today,
+		Status: vo.OwnershipTransferStatusApproved}
+	assert.IsType(t, errs.BusinessError{}, stale.Complete(account, today))
+	assert.Equal(t, "CUST-2", account.CustomerID)
+
+	require.NoError(t, stale.Reject("bob", "superseded"))
+	assert.Equal(t, vo.OwnershipTransferStatusRejected, stale.Status)
+	assert.Equal(t, "superseded", stale.DecisionReason)
+}
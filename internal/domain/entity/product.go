@@ -0,0 +1,191 @@
+package entity
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"github.com/shopspring/decimal"
+)
+
+// ProductType is the kind of account a product opens
+type ProductType string
+
+const (
+	ProductTypeSavings  ProductType = "SAVINGS"
+	ProductTypeCurrent  ProductType = "CURRENT"
+	ProductTypeBusiness ProductType = "BUSINESS"
+)
+
+// IsValid checks if the product type is valid
+func (t ProductType) IsValid() bool {
+	switch t {
+	case ProductTypeSavings, ProductTypeCurrent, ProductTypeBusiness:
+		return true
+	default:
+		return false
+	}
+}
+
+// interestDaysPerYear is the day count interest rates are annualized over
+const interestDaysPerYear = 365
+
+// ProductFees is the fee schedule of a product, charged to its accounts on top of the amount
+type ProductFees struct {
+	DebitFee    vo.Money `json:"debit_fee"`    // Per debit (withdrawal or payment)
+	TransferFee vo.Money `json:"transfer_fee"` // Per outgoing transfer
+}
+
+// ProductLimits are the limits a product places on its accounts
+type ProductLimits struct {
+	MinOpeningBalance    vo.Money  `json:"min_opening_balance"`
+	MaxTransactionAmount *vo.Money `json:"max_transaction_amount,omitempty"` // Per outgoing transaction; nil is unlimited
+}
+
+// Product is an account template: accounts opened from it share its interest rate, fees and limits
+type Product struct {
+	ID           string          `json:"id"`
+	Name         string          `json:"name"`
+	Type         ProductType     `json:"type"`
+	Currency     string          `json:"currency"`
+	InterestRate decimal.Decimal `json:"interest_rate"` // Annual percentage
+	Fees         ProductFees     `json:"fees"`
+	Limits       ProductLimits   `json:"limits"`
+	Active       bool            `json:"active"` // Retired products open no new accounts
+	CreatedAt    time.Time       `json:"created_at"`
+	UpdatedAt    time.Time       `json:"updated_at"`
+}
+
+// NewProduct creates a new active product
+func NewProduct(name string, productType ProductType, currency string, interestRate decimal.Decimal, fees ProductFees, limits ProductLimits) (*Product, error) {
+	if !productType.IsValid() {
+		return nil, errs.ValidationError{
+			Field:   "type",
+			Message: "invalid product type: " + string(productType),
+		}
+	}
+
+	if currency == "" {
+		return nil, errs.ValidationError{
+			Field:   "currency",
+			Message: "currency is required",
+		}
+	}
+
+	now := time.Now()
+
+	product := &Product{
+		ID:        vo.NewID("PRD"),
+		Type:      productType,
+		Currency:  currency,
+		Active:    true,
+		CreatedAt: now,
+	}
+
+	if err := product.Update(name, interestRate, fees, limits); err != nil {
+		return nil, err
+	}
+
+	return product, nil
+}
+
+// Update replaces the product's name, interest rate, fees and limits; accounts already
+// opened from the product follow the new terms
+func (p *Product) Update(name string, interestRate decimal.Decimal, fees ProductFees, limits ProductLimits) error {
+	name = strings.TrimSpace(name)
+	if name == "" || len(name) > 100 {
+		return errs.ValidationError{
+			Field:   "name",
+			Message: "product name must be 1 to 100 characters",
+		}
+	}
+
+	if interestRate.IsNegative() || interestRate.GreaterThan(oneHundred) {
+		return errs.ValidationError{
+			Field:   "interestRate",
+			Message: "interest rate must be between 0 and 100 percent",
+		}
+	}
+
+	if fees.DebitFee.IsNegative() || fees.TransferFee.IsNegative() {
+		return errs.ValidationError{
+			Field:   "fees",
+			Message: "fees cannot be negative",
+		}
+	}
+
+	if limits.MinOpeningBalance.IsNegative() {
+		return errs.ValidationError{
+			Field:   "minOpeningBalance",
+			Message: "minimum opening balance cannot be negative",
+		}
+	}
+
+	if limits.MaxTransactionAmount != nil && !limits.MaxTransactionAmount.IsPositive() {
+		return errs.ValidationError{
+			Field:   "maxTransactionAmount",
+			Message: "maximum transaction amount must be greater than zero",
+		}
+	}
+
+	p.Name = name
+	p.InterestRate = interestRate
+	p.Fees = fees
+	p.Limits = limits
+	p.UpdatedAt = time.Now()
+	return nil
+}
+
+// SetActive retires the product or offers it again
+func (p *Product) SetActive(active bool) {
+	p.Active = active
+	p.UpdatedAt = time.Now()
+}
+
+// CheckOpening checks that an account can be opened from the product with the initial balance
+func (p *Product) CheckOpening(initialBalance vo.Money) error {
+	if !p.Active {
+		return errs.ErrProductRetired
+	}
+
+	if initialBalance.LessThan(p.Limits.MinOpeningBalance) {
+		return errs.BusinessError{
+			Code:    "MIN_OPENING_BALANCE",
+			Message: fmt.Sprintf("product %s requires an opening balance of at least %s", p.Name, p.Limits.MinOpeningBalance.StringFixed(2)),
+		}
+	}
+
+	return nil
+}
+
+// CheckTransaction checks an outgoing transaction of an account opened from the product against its limits
+func (p *Product) CheckTransaction(amount vo.Money) error {
+	if p.Limits.MaxTransactionAmount != nil && amount.GreaterThan(*p.Limits.MaxTransactionAmount) {
+		return fmt.Errorf("%w: %s allows at most %s per transaction", errs.ErrProductLimitExceeded, p.Name, p.Limits.MaxTransactionAmount.StringFixed(2))
+	}
+	return nil
+}
+
+// Fee returns the fee the product charges on an outgoing transaction of the type
+func (p *Product) Fee(transactionType vo.TransactionType) vo.Money {
+	switch transactionType {
+	case vo.TransactionTypeDebit:
+		return p.Fees.DebitFee
+	case vo.TransactionTypeTransfer, vo.TransactionTypeFXTransfer:
+		return p.Fees.TransferFee
+	default:
+		return vo.ZeroMoney()
+	}
+}
+
+// Interest returns the simple interest a balance earns over a number of days at the product's annual
+// rate, on a 365-day year and rounded to cents. Balances that are not positive earn none.
+func (p *Product) Interest(balance vo.Money, days int) vo.Money {
+	if !balance.IsPositive() || days <= 0 || !p.InterestRate.IsPositive() {
+		return vo.ZeroMoney()
+	}
+	factor := p.InterestRate.Div(oneHundred).Mul(decimal.NewFromInt(int64(days))).Div(decimal.NewFromInt(interestDaysPerYear))
+	return balance.Multiply(factor).Round(2)
+}
@@ -0,0 +1,190 @@
+package entity
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+)
+
+// MaxProductMigrationAccounts caps how many accounts one product migration moves
+const MaxProductMigrationAccounts = 1000
+
+// ProductMigration moves accounts in bulk from one product to another, e.g. when a fee schedule
+// changes. The accounts take the new product's interest rate, fees and limits on the effective date.
+// Each account is checked when the migration is requested and again when it takes effect; accounts
+// that are not eligible are skipped with the reason, and the others are migrated.
+type ProductMigration struct {
+	ID            string                    `json:"id"`
+	FromProductID string                    `json:"from_product_id"`
+	ToProductID   string                    `json:"to_product_id"`
+	EffectiveDate time.Time                 `json:"effective_date"` // Business date the accounts change product
+	Status        vo.ProductMigrationStatus `json:"status"`
+	Note          string                    `json:"note,omitempty"`
+	RequestedBy   string                    `json:"requested_by"`
+	Accounts      []ProductMigrationAccount `json:"accounts"`
+	CreatedAt     time.Time                 `json:"created_at"`
+	CompletedAt   *time.Time                `json:"completed_at,omitempty"`
+}
+
+// ProductMigrationAccount is the outcome of one account of a product migration
+type ProductMigrationAccount struct {
+	AccountID  vo.AccountID                     `json:"account_id"`
+	Status     vo.ProductMigrationAccountStatus `json:"status"`
+	Reason     string                           `json:"reason,omitempty"` // Why the account was skipped
+	MigratedAt *time.Time                       `json:"migrated_at,omitempty"`
+}
+
+// NewProductMigration plans moving the accounts from one product to another of the same type and
+// currency on effectiveDate, which may not be before today. Accounts that are not eligible are
+// recorded as skipped.
+func NewProductMigration(from, to *Product, accounts []*Account, note string, effectiveDate, today time.Time, requestedBy string) (*ProductMigration, error) {
+	if from.ID == to.ID {
+		return nil, errs.ValidationError{
+			Field:   "to_product_id",
+			Message: "accounts must move to another product",
+		}
+	}
+
+	if !to.Active {
+		return nil, errs.ErrProductRetired
+	}
+
+	if from.Type != to.Type || from.Currency != to.Currency {
+		return nil, errs.BusinessError{
+			Code: "PRODUCT_MISMATCH",
+			Message: fmt.Sprintf("accounts of %s (%s, %s) cannot move to %s (%s, %s)",
+				from.Name, from.Type, from.Currency, to.Name, to.Type, to.Currency),
+		}
+	}
+
+	if len(accounts) == 0 || len(accounts) > MaxProductMigrationAccounts {
+		return nil, errs.ValidationError{
+			Field:   "account_ids",
+			Message: fmt.Sprintf("a migration moves 1 to %d accounts", MaxProductMigrationAccounts),
+		}
+	}
+
+	requestedBy = strings.TrimSpace(requestedBy)
+	if requestedBy == "" {
+		return nil, errs.ValidationError{
+			Field:   "requested_by",
+			Message: "requested by is required",
+		}
+	}
+
+	if effectiveDate.Before(today) {
+		return nil, errs.ValidationError{
+			Field:   "effective_date",
+			Message: "effective date cannot be in the past",
+		}
+	}
+
+	migrationAccounts := make([]ProductMigrationAccount, len(accounts))
+	for i, account := range accounts {
+		migrationAccounts[i] = ProductMigrationAccount{AccountID: account.ID, Status: vo.ProductMigrationAccountPending}
+		if reason := productMigrationIneligibility(account, from, to); reason != "" {
+			migrationAccounts[i].Status = vo.ProductMigrationAccountSkipped
+			migrationAccounts[i].Reason = reason
+		}
+	}
+
+	now := time.Now()
+
+	return &ProductMigration{
+		ID:            vo.NewID("PMG"),
+		FromProductID: from.ID,
+		ToProductID:   to.ID,
+		EffectiveDate: effectiveDate,
+		Status:        vo.ProductMigrationStatusScheduled,
+		Note:          strings.TrimSpace(note),
+		RequestedBy:   requestedBy,
+		Accounts:      migrationAccounts,
+		CreatedAt:     now,
+	}, nil
+}
+
+// IsDue checks if the migration is scheduled to take effect on or before today
+func (m *ProductMigration) IsDue(today time.Time) bool {
+	return m.Status == vo.ProductMigrationStatusScheduled && !m.EffectiveDate.After(today)
+}
+
+// Migrate moves the account at index i to the new product if it is still eligible, and records the
+// outcome. An account already on the new product is recorded as migrated without changing it. It
+// reports whether the account was changed and must be saved.
+func (m *ProductMigration) Migrate(i int, account *Account, from, to *Product, now time.Time) bool {
+	outcome := &m.Accounts[i]
+	if outcome.Status != vo.ProductMigrationAccountPending {
+		return false
+	}
+
+	if account.ProductID == to.ID {
+		outcome.Status = vo.ProductMigrationAccountMigrated
+		outcome.MigratedAt = &now
+		return false
+	}
+
+	if reason := productMigrationIneligibility(account, from, to); reason != "" {
+		outcome.Status = vo.ProductMigrationAccountSkipped
+		outcome.Reason = reason
+		return false
+	}
+
+	account.ProductID = to.ID
+	account.UpdatedAt = now
+	outcome.Status = vo.ProductMigrationAccountMigrated
+	outcome.MigratedAt = &now
+	return true
+}
+
+// Skip records that the pending account at index i was not migrated and why
+func (m *ProductMigration) Skip(i int, reason string) {
+	if m.Accounts[i].Status == vo.ProductMigrationAccountPending {
+		m.Accounts[i].Status = vo.ProductMigrationAccountSkipped
+		m.Accounts[i].Reason = reason
+	}
+}
+
+// Complete records that the migration took effect; it fails while accounts are still pending
+func (m *ProductMigration) Complete(now time.Time) error {
+	if m.Count(vo.ProductMigrationAccountPending) > 0 {
+		return errs.BusinessError{
+			Code:    "PRODUCT_MIGRATION_INCOMPLETE",
+			Message: "product migration still has pending accounts",
+		}
+	}
+
+	m.Status = vo.ProductMigrationStatusCompleted
+	m.CompletedAt = &now
+	return nil
+}
+
+// Count returns how many of the migration's accounts have the status
+func (m *ProductMigration) Count(status vo.ProductMigrationAccountStatus) int {
+	count := 0
+	for _, account := range m.Accounts {
+		if account.Status == status {
+			count++
+		}
+	}
+	return count
+}
+
+// productMigrationIneligibility returns why the account cannot move between the products, or "" when it can
+func productMigrationIneligibility(account *Account, from, to *Product) string {
+	if !to.Active {
+		return "product " + to.ID + " is retired"
+	}
+	if account.ProductID != from.ID {
+		return "account is not on product " + from.ID
+	}
+	if account.Status == vo.AccountStatusSuspended {
+		return "account is suspended"
+	}
+	if account.Balance.LessThan(to.Limits.MinOpeningBalance) {
+		return fmt.Sprintf("balance is below the minimum of %s required by %s", to.Limits.MinOpeningBalance.StringFixed(2), to.Name)
+	}
+	return ""
+}
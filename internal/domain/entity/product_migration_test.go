@@ -0,0 +1,112 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newMigrationProducts creates an old and a new savings product; the new one requires a balance of 500
+func newMigrationProducts(t *testing.T) (*Product, *Product) {
+	from, err := NewProduct("Savings 2023", ProductTypeSavings, "THB", decimal.NewFromFloat(1), ProductFees{}, ProductLimits{})
+	require.NoError(t, err)
+	to, err := NewProduct("Savings 2024", ProductTypeSavings, "THB", decimal.NewFromFloat(1.5),
+		ProductFees{TransferFee: vo.NewMoneyFromFloat(5)}, ProductLimits{MinOpeningBalance: vo.NewMoneyFromFloat(500)})
+	require.NoError(t, err)
+	return from, to
+}
+
+// newProductAccount opens an account on the product with the balance
+func newProductAccount(t *testing.T, product *Product, balance float64) *Account {
+	account, err := NewAccount("Savings", vo.NewMoneyFromFloat(balance))
+	require.NoError(t, err)
+	account.ProductID = product.ID
+	return account
+}
+
+func TestNewProductMigration(t *testing.T) {
+	today := time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC)
+	from, to := newMigrationProducts(t)
+
+	eligible := newProductAccount(t, from, 1000)
+	poor := newProductAccount(t, from, 100)
+	elsewhere := newProductAccount(t, to, 1000)
+	suspended := newProductAccount(t, from, 1000)
+	suspended.Status = vo.AccountStatusSuspended
+
+	migration, err := NewProductMigration(from, to, []*Account{eligible, poor, elsewhere, suspended}, " new fees ", today, today, " alice ")
+	require.NoError(t, err)
+	assert.Len(t, migration.ID, vo.IDLength)
+	assert.Equal(t, vo.ProductMigrationStatusScheduled, migration.Status)
+	assert.Equal(t, "new fees", migration.Note)
+	assert.Equal(t, "alice", migration.RequestedBy)
+	assert.Equal(t, 1, migration.Count(vo.ProductMigrationAccountPending))
+	assert.Equal(t, 3, migration.Count(vo.ProductMigrationAccountSkipped))
+	assert.Contains(t, migration.Accounts[1].Reason, "balance is below the minimum of 500.00")
+	assert.Contains(t, migration.Accounts[2].Reason, "not on product")
+	assert.Equal(t, "account is suspended", migration.Accounts[3].Reason)
+	assert.True(t, migration.IsDue(today))
+	assert.False(t, migration.IsDue(today.AddDate(0, 0, -1)))
+
+	accounts := []*Account{eligible}
+	_, err = NewProductMigration(from, from, accounts, "", today, today, "alice")
+	assert.IsType(t, errs.ValidationError{}, err)
+	_, err = NewProductMigration(from, to, nil, "", today, today, "alice")
+	assert.IsType(t, errs.ValidationError{}, err)
+	_, err = NewProductMigration(from, to, accounts, "", today.AddDate(0, 0, -1), today, "alice")
+	assert.IsType(t, errs.ValidationError{}, err)
+	_, err = NewProductMigration(from, to, accounts, "", today, today, " ")
+	assert.IsType(t, errs.ValidationError{}, err)
+
+	current, err := NewProduct("Current", ProductTypeCurrent, "THB", decimal.Zero, ProductFees{}, ProductLimits{})
+	require.NoError(t, err)
+	_, err = NewProductMigration(from, current, accounts, "", today, today, "alice")
+	assert.IsType(t, errs.BusinessError{}, err)
+
+	to.Active = false
+	_, err = NewProductMigration(from, to, accounts, "", today, today, "alice")
+	assert.ErrorIs(t, err, errs.ErrProductRetired)
+}
+
+func TestProductMigration_MigrateAndComplete(t *testing.T) {
+	today := time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC)
+	from, to := newMigrationProducts(t)
+
+	first := newProductAccount(t, from, 1000)
+	second := newProductAccount(t, from, 1000)
+	third := newProductAccount(t, from, 1000)
+	migration, err := NewProductMigration(from, to, []*Account{first, second, third}, "", today, today, "alice")
+	require.NoError(t, err)
+
+	// Still pending accounts keep the migration open
+	assert.IsType(t, errs.BusinessError{}, migration.Complete(today))
+
+	assert.True(t, migration.Migrate(0, first, from, to, today))
+	assert.Equal(t, to.ID, first.ProductID)
+	assert.Equal(t, vo.ProductMigrationAccountMigrated, migration.Accounts[0].Status)
+	require.NotNil(t, migration.Accounts[0].MigratedAt)
+
+	// Migrating again changes nothing
+	assert.False(t, migration.Migrate(0, first, from, to, today))
+
+	// An account that became ineligible since the request is skipped
+	second.Balance = vo.NewMoneyFromFloat(10)
+	assert.False(t, migration.Migrate(1, second, from, to, today))
+	assert.Equal(t, vo.ProductMigrationAccountSkipped, migration.Accounts[1].Status)
+	assert.Equal(t, from.ID, second.ProductID)
+
+	// An account already moved by an interrupted run counts as migrated
+	third.ProductID = to.ID
+	assert.False(t, migration.Migrate(2, third, from, to, today))
+	assert.Equal(t, vo.ProductMigrationAccountMigrated, migration.Accounts[2].Status)
+
+	require.NoError(t, migration.Complete(today))
+	assert.Equal(t, vo.ProductMigrationStatusCompleted, migration.Status)
+	require.NotNil(t, migration.CompletedAt)
+	assert.False(t, migration.IsDue(today))
+}
@@ -0,0 +1,74 @@
+package entity
+
+import (
+	"testing"
+
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewProduct(t *testing.T) {
+	fees := ProductFees{DebitFee: vo.NewMoneyFromFloat(5)}
+	limits := ProductLimits{MinOpeningBalance: vo.NewMoneyFromFloat(500)}
+
+	product, err := NewProduct(" Everyday Savings ", ProductTypeSavings, "THB", decimal.NewFromFloat(1.25), fees, limits)
+
+	require.NoError(t, err)
+	assert.Equal(t, "Everyday Savings", product.Name)
+	assert.Contains(t, product.ID, "PRD")
+	assert.True(t, product.Active)
+
+	_, err = NewProduct("Loan", ProductType("LOAN"), "THB", decimal.Zero, fees, limits)
+	assert.IsType(t, errs.ValidationError{}, err)
+
+	_, err = NewProduct("Savings", ProductTypeSavings, "THB", decimal.NewFromInt(101), fees, limits)
+	assert.IsType(t, errs.ValidationError{}, err)
+
+	_, err = NewProduct("Savings", ProductTypeSavings, "THB", decimal.Zero, ProductFees{TransferFee: vo.NewMoneyFromFloat(-1)}, limits)
+	assert.IsType(t, errs.ValidationError{}, err)
+
+	zero := vo.ZeroMoney()
+	_, err = NewProduct("Savings", ProductTypeSavings, "THB", decimal.Zero, fees, ProductLimits{MaxTransactionAmount: &zero})
+	assert.IsType(t, errs.ValidationError{}, err)
+}
+
+func TestProduct_Terms(t *testing.T) {
+	maxAmount := vo.NewMoneyFromFloat(1000)
+	product, err := NewProduct("Current", ProductTypeCurrent, "THB", decimal.Zero,
+		ProductFees{DebitFee: vo.NewMoneyFromFloat(5), TransferFee: vo.NewMoneyFromFloat(10)},
+		ProductLimits{MinOpeningBalance: vo.NewMoneyFromFloat(500), MaxTransactionAmount: &maxAmount})
+	require.NoError(t, err)
+
+	assert.NoError(t, product.CheckOpening(vo.NewMoneyFromFloat(500)))
+	assert.IsType(t, errs.BusinessError{}, product.CheckOpening(vo.NewMoneyFromFloat(499.99)))
+
+	assert.NoError(t, product.CheckTransaction(vo.NewMoneyFromFloat(1000)))
+	assert.ErrorIs(t, product.CheckTransaction(vo.NewMoneyFromFloat(1000.01)), errs.ErrProductLimitExceeded)
+
+	assert.True(t, product.Fee(vo.TransactionTypeDebit).Equal(vo.NewMoneyFromFloat(5)))
+	assert.True(t, product.Fee(vo.TransactionTypeTransfer).Equal(vo.NewMoneyFromFloat(10)))
+	assert.True(t, product.Fee(vo.TransactionTypeCredit).IsZero())
+
+	product.SetActive(false)
+	assert.ErrorIs(t, product.CheckOpening(vo.NewMoneyFromFloat(500)), errs.ErrProductRetired)
+
+	// Retiring a product keeps the terms of accounts already opened from it
+	assert.NoError(t, product.CheckTransaction(vo.NewMoneyFromFloat(1000)))
+}
+
+func TestProduct_Interest(t *testing.T) {
+	product, err := NewProduct("Savings", ProductTypeSavings, "THB", decimal.NewFromFloat(1.5), ProductFees{}, ProductLimits{})
+	require.NoError(t, err)
+
+	// 10,000 at 1.5% for 30 days: 10000 * 0.015 * 30 / 365
+	assert.True(t, product.Interest(vo.NewMoneyFromFloat(10000), 30).Equal(vo.NewMoneyFromFloat(12.33)))
+	assert.True(t, product.Interest(vo.NewMoneyFromFloat(10000), 365).Equal(vo.NewMoneyFromFloat(150)))
+	assert.True(t, product.Interest(vo.NewMoneyFromFloat(-500), 30).IsZero())
+	assert.True(t, product.Interest(vo.NewMoneyFromFloat(10000), 0).IsZero())
+
+	product.InterestRate = decimal.Zero
+	assert.True(t, product.Interest(vo.NewMoneyFromFloat(10000), 30).IsZero())
+}
@@ -0,0 +1,111 @@
+package entity
+
+import (
+	"crypto/rand"
+	"math/big"
+	"time"
+
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+)
+
+// ReferralReferencePrefix starts the reference of referral bonus credits, followed by the referral ID
+const ReferralReferencePrefix = "REFERRAL-"
+
+// referralCodeAlphabet leaves out characters that are easily misread, such as 0/O and 1/I
+const referralCodeAlphabet = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+
+// ReferralCode is the code an account hands out to refer others
+type ReferralCode struct {
+	Code      string       `json:"code"`
+	AccountID vo.AccountID `json:"account_id"`
+	CreatedAt time.Time    `json:"created_at"`
+}
+
+// NewReferralCode creates a random 8-character referral code for an account
+func NewReferralCode(accountID vo.AccountID) *ReferralCode {
+	code := make([]byte, 8)
+	for i := range code {
+		n, _ := rand.Int(rand.Reader, big.NewInt(int64(len(referralCodeAlphabet))))
+		code[i] = referralCodeAlphabet[n.Int64()]
+	}
+
+	return &ReferralCode{
+		Code:      string(code),
+		AccountID: accountID,
+		CreatedAt: time.Now(),
+	}
+}
+
+// ReferralStatus represents the progress of a referral
+type ReferralStatus string
+
+const (
+	ReferralStatusPending  ReferralStatus = "PENDING"  // Redeemed; waiting for the referee's first qualifying payment
+	ReferralStatusRewarded ReferralStatus = "REWARDED" // Bonuses paid
+)
+
+// Referral records an account redeeming another account's referral code
+type Referral struct {
+	ID                      string            `json:"id"`
+	Code                    string            `json:"code"`
+	ReferrerAccountID       vo.AccountID      `json:"referrer_account_id"`
+	RefereeAccountID        vo.AccountID      `json:"referee_account_id"`
+	RefereeCustomerID       string            `json:"referee_customer_id,omitempty"`
+	Status                  ReferralStatus    `json:"status"`
+	QualifyingTransactionID *vo.TransactionID `json:"qualifying_transaction_id,omitempty"`
+	ReferrerCreditID        *vo.TransactionID `json:"referrer_credit_id,omitempty"`
+	RefereeCreditID         *vo.TransactionID `json:"referee_credit_id,omitempty"`
+	CreatedAt               time.Time         `json:"created_at"`
+	RewardedAt              *time.Time        `json:"rewarded_at,omitempty"`
+}
+
+// NewReferral records the referee redeeming the referrer's code at now. Accounts cannot refer
+// themselves or another account of the same customer.
+func NewReferral(code *ReferralCode, referrer, referee *Account, now time.Time) (*Referral, error) {
+	if code.AccountID != referrer.ID {
+		return nil, errs.ValidationError{
+			Field:   "code",
+			Message: "referral code does not belong to the referrer",
+		}
+	}
+
+	if referrer.ID == referee.ID || (referee.CustomerID != "" && referee.CustomerID == referrer.CustomerID) {
+		return nil, errs.ErrSelfReferral
+	}
+
+	return &Referral{
+		ID:                vo.NewID("RFL"),
+		Code:              code.Code,
+		ReferrerAccountID: referrer.ID,
+		RefereeAccountID:  referee.ID,
+		RefereeCustomerID: referee.CustomerID,
+		Status:            ReferralStatusPending,
+		CreatedAt:         now,
+	}, nil
+}
+
+// Reward marks the referral rewarded for the referee's qualifying payment, paid through the
+// given credits; a credit is nil when that side earns no bonus
+func (r *Referral) Reward(qualifyingTransactionID vo.TransactionID, referrerCredit, refereeCredit *Transaction) error {
+	if r.Status != ReferralStatusPending {
+		return errs.ErrReferralAlreadyRewarded
+	}
+
+	now := time.Now()
+	r.Status = ReferralStatusRewarded
+	r.QualifyingTransactionID = &qualifyingTransactionID
+	if referrerCredit != nil {
+		r.ReferrerCreditID = &referrerCredit.ID
+	}
+	if refereeCredit != nil {
+		r.RefereeCreditID = &refereeCredit.ID
+	}
+	r.RewardedAt = &now
+	return nil
+}
+
+// Reference is the reference of the bonus credits the referral pays
+func (r *Referral) Reference() string {
+	return ReferralReferencePrefix + r.ID
+}
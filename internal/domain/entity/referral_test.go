@@ -0,0 +1,68 @@
+package entity
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewReferralCode(t *testing.T) {
+	code := NewReferralCode(vo.NewAccountID())
+
+	assert.Len(t, code.Code, 8)
+	for _, c := range code.Code {
+		assert.True(t, strings.ContainsRune(referralCodeAlphabet, c), "unexpected character %q", c)
+	}
+}
+
+func TestNewReferral(t *testing.T) {
+	referrer, err := NewAccount("Referrer", vo.NewMoneyFromFloat(100))
+	require.NoError(t, err)
+	referee, err := NewAccount("Referee", vo.NewMoneyFromFloat(100))
+	require.NoError(t, err)
+	code := NewReferralCode(referrer.ID)
+
+	referral, err := NewReferral(code, referrer, referee, time.Now())
+	require.NoError(t, err)
+	assert.Equal(t, ReferralStatusPending, referral.Status)
+	assert.Equal(t, ReferralReferencePrefix+referral.ID, referral.Reference())
+
+	_, err = NewReferral(code, referrer, referrer, time.Now())
+	assert.ErrorIs(t, err, errs.ErrSelfReferral)
+
+	// Another account of the same customer is the same person
+	referrer.CustomerID = "CUST-1"
+	referee.CustomerID = "CUST-1"
+	_, err = NewReferral(code, referrer, referee, time.Now())
+	assert.ErrorIs(t, err, errs.ErrSelfReferral)
+
+	_, err = NewReferral(NewReferralCode(referee.ID), referrer, referee, time.Now())
+	assert.IsType(t, errs.ValidationError{}, err)
+}
+
+func TestReferral_Reward(t *testing.T) {
+	referrer, err := NewAccount("Referrer", vo.NewMoneyFromFloat(100))
+	require.NoError(t, err)
+	referee, err := NewAccount("Referee", vo.NewMoneyFromFloat(100))
+	require.NoError(t, err)
+	referral, err := NewReferral(NewReferralCode(referrer.ID), referrer, referee, time.Now())
+	require.NoError(t, err)
+
+	credit, err := NewCreditTransaction(referee.ID, vo.NewMoneyFromFloat(50), "Referral bonus", referral.Reference(), time.Now())
+	require.NoError(t, err)
+	payment := vo.NewTransactionID()
+
+	// The referrer earns no bonus when the program pays only referees
+	require.NoError(t, referral.Reward(payment, nil, credit))
+	assert.Equal(t, ReferralStatusRewarded, referral.Status)
+	assert.Nil(t, referral.ReferrerCreditID)
+	assert.Equal(t, credit.ID, *referral.RefereeCreditID)
+	assert.NotNil(t, referral.RewardedAt)
+
+	assert.ErrorIs(t, referral.Reward(payment, nil, credit), errs.ErrReferralAlreadyRewarded)
+}
@@ -0,0 +1,144 @@
+package entity
+
+import (
+	"fmt"
+	"time"
+
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+)
+
+// Saga types
+const (
+	SagaTypeTransfer     = "TRANSFER"
+	SagaTypeCancellation = "CANCELLATION"
+	SagaTypeReversal     = "REVERSAL"
+)
+
+// SagaStep records the progress of one step of a saga
+type SagaStep struct {
+	Name      string            `json:"name"`
+	Status    vo.SagaStepStatus `json:"status"`
+	Error     string            `json:"error,omitempty"`
+	UpdatedAt *time.Time        `json:"updated_at,omitempty"`
+}
+
+// Saga records the persisted state of a multi-step operation and its compensation
+type Saga struct {
+	ID            string           `json:"id"`
+	Type          string           `json:"type"`
+	TransactionID vo.TransactionID `json:"transaction_id"`
+	Status        vo.SagaStatus    `json:"status"`
+	Steps         []SagaStep       `json:"steps"`
+	Error         string           `json:"error,omitempty"`
+	CreatedAt     time.Time        `json:"created_at"`
+	UpdatedAt     time.Time        `json:"updated_at"`
+}
+
+// NewSaga creates a new running saga with all steps pending
+func NewSaga(sagaType string, transactionID vo.TransactionID, stepNames ...string) *Saga {
+	now := time.Now()
+
+	steps := make([]SagaStep, len(stepNames))
+	for i, name := range stepNames {
+		steps[i] = SagaStep{Name: name, Status: vo.SagaStepStatusPending}
+	}
+
+	return &Saga{
+		ID:            vo.NewID("SGA"),
+		Type:          sagaType,
+		TransactionID: transactionID,
+		Status:        vo.SagaStatusRunning,
+		Steps:         steps,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+}
+
+// MarkStepCompleted records a successfully executed step
+func (s *Saga) MarkStepCompleted(index int) error {
+	if s.Status != vo.SagaStatusRunning {
+		return s.invalidTransition("complete step")
+	}
+	s.setStep(index, vo.SagaStepStatusCompleted, "")
+	return nil
+}
+
+// MarkStepSkipped records a step that did not need to run
+func (s *Saga) MarkStepSkipped(index int) error {
+	if s.Status != vo.SagaStatusRunning {
+		return s.invalidTransition("skip step")
+	}
+	s.setStep(index, vo.SagaStepStatusSkipped, "")
+	return nil
+}
+
+// MarkStepFailed records a failed step and starts compensation
+func (s *Saga) MarkStepFailed(index int, reason string) error {
+	if s.Status != vo.SagaStatusRunning {
+		return s.invalidTransition("fail step")
+	}
+	s.setStep(index, vo.SagaStepStatusFailed, reason)
+	s.Status = vo.SagaStatusCompensating
+	s.Error = reason
+	return nil
+}
+
+// MarkStepCompensated records a successfully undone step
+func (s *Saga) MarkStepCompensated(index int) error {
+	if s.Status != vo.SagaStatusCompensating {
+		return s.invalidTransition("compensate step")
+	}
+	s.setStep(index, vo.SagaStepStatusCompensated, "")
+	return nil
+}
+
+// MarkStepCompensationFailed records a step that could not be undone
+func (s *Saga) MarkStepCompensationFailed(index int, reason string) error {
+	if s.Status != vo.SagaStatusCompensating {
+		return s.invalidTransition("fail compensation")
+	}
+	s.setStep(index, vo.SagaStepStatusCompensationFailed, reason)
+	s.Status = vo.SagaStatusFailed
+	return nil
+}
+
+// MarkAsCompleted records that every step succeeded
+func (s *Saga) MarkAsCompleted() error {
+	if s.Status != vo.SagaStatusRunning {
+		return s.invalidTransition("complete")
+	}
+	s.Status = vo.SagaStatusCompleted
+	s.UpdatedAt = time.Now()
+	return nil
+}
+
+// MarkAsCompensated records that compensation finished; a saga whose
+// compensation failed stays FAILED
+func (s *Saga) MarkAsCompensated() error {
+	if s.Status == vo.SagaStatusFailed {
+		return nil
+	}
+	if s.Status != vo.SagaStatusCompensating {
+		return s.invalidTransition("finish compensation")
+	}
+	s.Status = vo.SagaStatusCompensated
+	s.UpdatedAt = time.Now()
+	return nil
+}
+
+// setStep updates a step's status
+func (s *Saga) setStep(index int, status vo.SagaStepStatus, reason string) {
+	now := time.Now()
+	s.Steps[index].Status = status
+	s.Steps[index].Error = reason
+	s.Steps[index].UpdatedAt = &now
+	s.UpdatedAt = now
+}
+
+func (s *Saga) invalidTransition(action string) error {
+	return errs.BusinessError{
+		Code:    "INVALID_STATUS_TRANSITION",
+		Message: fmt.Sprintf("cannot %s of saga with status: %s", action, s.Status),
+	}
+}
@@ -0,0 +1,80 @@
+package entity
+
+import (
+	"testing"
+
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewSaga(t *testing.T) {
+	transactionID := vo.NewTransactionID()
+	saga := NewSaga(SagaTypeTransfer, transactionID, "debit", "credit")
+
+	assert.Len(t, saga.ID, vo.IDLength)
+	assert.Equal(t, "SGA", saga.ID[:3])
+	assert.Equal(t, vo.SagaStatusRunning, saga.Status)
+	assert.Equal(t, transactionID, saga.TransactionID)
+	require.Len(t, saga.Steps, 2)
+	assert.Equal(t, "debit", saga.Steps[0].Name)
+	assert.Equal(t, vo.SagaStepStatusPending, saga.Steps[0].Status)
+}
+
+func TestSaga_Completed(t *testing.T) {
+	saga := NewSaga(SagaTypeTransfer, vo.NewTransactionID(), "debit", "credit")
+
+	require.NoError(t, saga.MarkStepCompleted(0))
+	require.NoError(t, saga.MarkStepCompleted(1))
+	require.NoError(t, saga.MarkAsCompleted())
+
+	assert.Equal(t, vo.SagaStatusCompleted, saga.Status)
+	assert.Equal(t, vo.SagaStepStatusCompleted, saga.Steps[1].Status)
+
+	// A completed saga cannot start compensating
+	err := saga.MarkStepFailed(1, "late failure")
+	require.Error(t, err)
+	assert.IsType(t, errs.BusinessError{}, err)
+}
+
+func TestSaga_Compensated(t *testing.T) {
+	saga := NewSaga(SagaTypeTransfer, vo.NewTransactionID(), "debit", "credit")
+
+	require.NoError(t, saga.MarkStepCompleted(0))
+	require.NoError(t, saga.MarkStepFailed(1, "account not found"))
+	assert.Equal(t, vo.SagaStatusCompensating, saga.Status)
+	assert.Equal(t, "account not found", saga.Error)
+
+	require.NoError(t, saga.MarkStepCompensated(0))
+	require.NoError(t, saga.MarkAsCompensated())
+
+	assert.Equal(t, vo.SagaStatusCompensated, saga.Status)
+	assert.Equal(t, vo.SagaStepStatusCompensated, saga.Steps[0].Status)
+	assert.Equal(t, vo.SagaStepStatusFailed, saga.Steps[1].Status)
+}
+
+func TestSaga_CompensationFailed(t *testing.T) {
+	saga := NewSaga(SagaTypeTransfer, vo.NewTransactionID(), "debit", "credit")
+
+	require.NoError(t, saga.MarkStepCompleted(0))
+	require.NoError(t, saga.MarkStepFailed(1, "account not found"))
+	require.NoError(t, saga.MarkStepCompensationFailed(0, "database unavailable"))
+
+	// The saga stays failed so it can be picked up for manual intervention
+	require.NoError(t, saga.MarkAsCompensated())
+	assert.Equal(t, vo.SagaStatusFailed, saga.Status)
+	assert.Equal(t, vo.SagaStepStatusCompensationFailed, saga.Steps[0].Status)
+}
+
+func TestSaga_SkippedStep(t *testing.T) {
+	saga := NewSaga(SagaTypeCancellation, vo.NewTransactionID(), "cancel_upstream", "reverse_settlement")
+
+	require.NoError(t, saga.MarkStepCompleted(0))
+	require.NoError(t, saga.MarkStepSkipped(1))
+	require.NoError(t, saga.MarkAsCompleted())
+
+	assert.Equal(t, vo.SagaStatusCompleted, saga.Status)
+	assert.Equal(t, vo.SagaStepStatusSkipped, saga.Steps[1].Status)
+	assert.IsType(t, errs.BusinessError{}, saga.MarkStepSkipped(1))
+}
@@ -0,0 +1,207 @@
+package entity
+
+import (
+	"strings"
+	"time"
+
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+)
+
+// ScheduledTransactionReferencePrefix starts the default reference of scheduled transfers, followed by the schedule ID
+const ScheduledTransactionReferencePrefix = "SCHEDULED-"
+
+// ScheduledTransaction is a transfer set up to run later, once at StartAt or on every match of a
+// cron expression from StartAt on until EndAt. Each run creates a transfer and confirms it. Runs
+// missed while the service was down are skipped, so a recurring schedule never pays twice to catch up.
+type ScheduledTransaction struct {
+	ID                string                        `json:"id"`
+	FromAccountID     vo.AccountID                  `json:"from_account_id"` // The account paying
+	ToAccountID       vo.AccountID                  `json:"to_account_id"`   // The beneficiary
+	Amount            vo.Money                      `json:"amount"`
+	Description       string                        `json:"description"`
+	Reference         string                        `json:"reference"`
+	Cron              string                        `json:"cron,omitempty"` // Five-field cron expression; empty for a one-time transfer
+	StartAt           *time.Time                    `json:"start_at,omitempty"`
+	EndAt             *time.Time                    `json:"end_at,omitempty"` // Recurring schedules only
+	NextRunAt         *time.Time                    `json:"next_run_at,omitempty"`
+	Status            vo.ScheduledTransactionStatus `json:"status"`
+	RunCount          int                           `json:"run_count"`
+	FailureCount      int                           `json:"failure_count"` // Runs whose transfer was not made or failed
+	LastRunAt         *time.Time                    `json:"last_run_at,omitempty"`
+	LastTransactionID string                        `json:"last_transaction_id,omitempty"`
+	LastError         string                        `json:"last_error,omitempty"`
+	// PendingTransactionID is the transfer created by a run that has not been recorded yet; the next
+	// run confirms it instead of creating another
+	PendingTransactionID string    `json:"-"`
+	CreatedAt            time.Time `json:"created_at"`
+	UpdatedAt            time.Time `json:"updated_at"`
+}
+
+// NewScheduledTransaction schedules a transfer from an account. Without cron it runs once at startAt,
+// which must be later than now; with cron it runs on every match at or after startAt, or now, until
+// endAt. Cron expressions are matched in the location of now.
+func NewScheduledTransaction(fromAccountID, toAccountID vo.AccountID, amount vo.Money, description, reference, cron string,
+	startAt, endAt *time.Time, now time.Time) (*ScheduledTransaction, error) {
+	if fromAccountID.IsEmpty() {
+		return nil, errs.ValidationError{
+			Field:   "fromAccountID",
+			Message: "paying account is required",
+		}
+	}
+
+	scheduled := &ScheduledTransaction{
+		ID:            vo.NewID("SCH"),
+		FromAccountID: fromAccountID,
+		Status:        vo.ScheduledTransactionStatusActive,
+		CreatedAt:     now,
+	}
+
+	if err := scheduled.Update(toAccountID, amount, description, reference, cron, startAt, endAt, now); err != nil {
+		return nil, err
+	}
+
+	return scheduled, nil
+}
+
+// Update replaces the transfer and its schedule, and works out the next run from now
+func (s *ScheduledTransaction) Update(toAccountID vo.AccountID, amount vo.Money, description, reference, cron string,
+	startAt, endAt *time.Time, now time.Time) error {
+	if s.Status != vo.ScheduledTransactionStatusActive {
+		return errs.ErrScheduledTransactionCompleted
+	}
+
+	if toAccountID.IsEmpty() {
+		return errs.ValidationError{
+			Field:   "toAccountID",
+			Message: "beneficiary account is required",
+		}
+	}
+	if toAccountID == s.FromAccountID {
+		return errs.ErrSameAccountTransfer
+	}
+
+	if !amount.IsPositive() {
+		return errs.ErrInvalidTransactionAmount
+	}
+
+	description = strings.TrimSpace(description)
+	if len(description) > 500 {
+		return errs.ValidationError{
+			Field:   "description",
+			Message: "description must be at most 500 characters",
+		}
+	}
+
+	reference = strings.TrimSpace(reference)
+	if len(reference) > 100 {
+		return errs.ValidationError{
+			Field:   "reference",
+			Message: "reference must be at most 100 characters",
+		}
+	}
+
+	cron = strings.Join(strings.Fields(cron), " ")
+	nextRunAt, err := firstRun(cron, startAt, endAt, now)
+	if err != nil {
+		return err
+	}
+
+	s.ToAccountID = toAccountID
+	s.Amount = amount
+	s.Description = description
+	s.Reference = reference
+	s.Cron = cron
+	s.StartAt = startAt
+	s.EndAt = endAt
+	s.NextRunAt = &nextRunAt
+	s.UpdatedAt = now
+	return nil
+}
+
+// firstRun validates a schedule and returns when it runs first
+func firstRun(cron string, startAt, endAt *time.Time, now time.Time) (time.Time, error) {
+	if cron == "" {
+		if startAt == nil || !startAt.After(now) {
+			return time.Time{}, errs.ValidationError{
+				Field:   "startAt",
+				Message: "a one-time transfer needs a start time in the future",
+			}
+		}
+		if endAt != nil {
+			return time.Time{}, errs.ValidationError{
+				Field:   "endAt",
+				Message: "only recurring transfers have an end time",
+			}
+		}
+		return *startAt, nil
+	}
+
+	schedule, err := vo.NewCronSchedule(cron)
+	if err != nil {
+		return time.Time{}, errs.ValidationError{
+			Field:   "cron",
+			Message: err.Error(),
+		}
+	}
+
+	// A match exactly at the start time counts
+	from := now
+	if startAt != nil && startAt.After(now) {
+		from = startAt.In(now.Location()).Add(-time.Minute)
+	}
+	next := schedule.Next(from)
+	if next.IsZero() || (endAt != nil && next.After(*endAt)) {
+		return time.Time{}, errs.ValidationError{
+			Field:   "cron",
+			Message: "the schedule never runs before it ends",
+		}
+	}
+	return next, nil
+}
+
+// IsDue checks if the schedule has a run due at the given time
+func (s *ScheduledTransaction) IsDue(now time.Time) bool {
+	return s.Status == vo.ScheduledTransactionStatusActive && s.NextRunAt != nil && !s.NextRunAt.After(now)
+}
+
+// IsRecurring checks if the schedule runs more than once
+func (s *ScheduledTransaction) IsRecurring() bool {
+	return s.Cron != ""
+}
+
+// TransferReference is the reference of transfers made by the schedule
+func (s *ScheduledTransaction) TransferReference() string {
+	if s.Reference != "" {
+		return s.Reference
+	}
+	return ScheduledTransactionReferencePrefix + s.ID
+}
+
+// RecordRun records the outcome of the due run, whose transfer is transactionID when one was made,
+// and moves the schedule to its next run after the given time, completing it when there is none
+func (s *ScheduledTransaction) RecordRun(transactionID string, runErr error, at time.Time) {
+	s.RunCount++
+	s.LastRunAt = &at
+	s.LastTransactionID = transactionID
+	s.LastError = ""
+	if runErr != nil {
+		s.FailureCount++
+		s.LastError = runErr.Error()
+	}
+	s.PendingTransactionID = ""
+	s.UpdatedAt = at
+
+	var next time.Time
+	if s.IsRecurring() {
+		// Validated when scheduled, so the expression parses
+		schedule, _ := vo.NewCronSchedule(s.Cron)
+		next = schedule.Next(at)
+	}
+	if next.IsZero() || (s.EndAt != nil && next.After(*s.EndAt)) {
+		s.Status = vo.ScheduledTransactionStatusCompleted
+		s.NextRunAt = nil
+		return
+	}
+	s.NextRunAt = &next
+}
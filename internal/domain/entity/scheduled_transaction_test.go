@@ -0,0 +1,91 @@
+package entity
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewScheduledTransaction(t *testing.T) {
+	from := vo.NewAccountID()
+	to := vo.NewAccountID()
+	amount := vo.NewMoneyFromFloat(500)
+	now := time.Date(2026, time.March, 10, 8, 0, 0, 0, time.UTC)
+	later := now.Add(2 * time.Hour)
+	earlier := now.Add(-time.Hour)
+	start := time.Date(2026, time.April, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2026, time.June, 30, 0, 0, 0, 0, time.UTC)
+
+	scheduled, err := NewScheduledTransaction(from, to, amount, " Rent ", "", "", &later, nil, now)
+	require.NoError(t, err)
+	assert.Equal(t, "Rent", scheduled.Description)
+	assert.Equal(t, vo.ScheduledTransactionStatusActive, scheduled.Status)
+	assert.Equal(t, later, *scheduled.NextRunAt)
+	assert.Equal(t, ScheduledTransactionReferencePrefix+scheduled.ID, scheduled.TransferReference())
+
+	// Recurring schedules run on the first match from their start, which counts itself
+	scheduled, err = NewScheduledTransaction(from, to, amount, "", "", "0 0 1 * *", &start, &end, now)
+	require.NoError(t, err)
+	assert.Equal(t, start, *scheduled.NextRunAt)
+
+	scheduled, err = NewScheduledTransaction(from, to, amount, "", "", "0 9 * * *", nil, nil, now)
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2026, time.March, 10, 9, 0, 0, 0, time.UTC), *scheduled.NextRunAt)
+
+	_, err = NewScheduledTransaction(from, to, amount, "", "", "", &earlier, nil, now)
+	assert.IsType(t, errs.ValidationError{}, err)
+	_, err = NewScheduledTransaction(from, to, amount, "", "", "", nil, nil, now)
+	assert.IsType(t, errs.ValidationError{}, err)
+	_, err = NewScheduledTransaction(from, to, amount, "", "", "", &later, &end, now)
+	assert.IsType(t, errs.ValidationError{}, err)
+	_, err = NewScheduledTransaction(from, to, amount, "", "", "0 0 31 2 *", nil, nil, now)
+	assert.IsType(t, errs.ValidationError{}, err)
+	_, err = NewScheduledTransaction(from, to, amount, "", "", "0 0 1 7 *", &start, &end, now)
+	assert.IsType(t, errs.ValidationError{}, err)
+	_, err = NewScheduledTransaction(from, to, amount, "", "", "every day", nil, nil, now)
+	assert.IsType(t, errs.ValidationError{}, err)
+	_, err = NewScheduledTransaction(from, from, amount, "", "", "", &later, nil, now)
+	assert.ErrorIs(t, err, errs.ErrSameAccountTransfer)
+	_, err = NewScheduledTransaction(from, to, vo.NewMoneyFromFloat(0), "", "", "", &later, nil, now)
+	assert.ErrorIs(t, err, errs.ErrInvalidTransactionAmount)
+}
+
+func TestScheduledTransaction_RecordRun(t *testing.T) {
+	now := time.Date(2026, time.March, 10, 8, 0, 0, 0, time.UTC)
+	end := time.Date(2026, time.March, 12, 0, 0, 0, 0, time.UTC)
+
+	scheduled, err := NewScheduledTransaction(vo.NewAccountID(), vo.NewAccountID(), vo.NewMoneyFromFloat(50), "", "", "0 9 * * *", nil, &end, now)
+	require.NoError(t, err)
+
+	// A run late by a day skips the missed match
+	runAt := time.Date(2026, time.March, 11, 9, 30, 0, 0, time.UTC)
+	assert.True(t, scheduled.IsDue(runAt))
+	scheduled.PendingTransactionID = "TXN1"
+	scheduled.RecordRun("TXN1", nil, runAt)
+	assert.Equal(t, 1, scheduled.RunCount)
+	assert.Equal(t, "TXN1", scheduled.LastTransactionID)
+	assert.Empty(t, scheduled.PendingTransactionID)
+	assert.Equal(t, vo.ScheduledTransactionStatusCompleted, scheduled.Status)
+	assert.Nil(t, scheduled.NextRunAt)
+	assert.False(t, scheduled.IsDue(runAt.Add(24*time.Hour)))
+
+	later := now.Add(time.Hour)
+	scheduled, err = NewScheduledTransaction(vo.NewAccountID(), vo.NewAccountID(), vo.NewMoneyFromFloat(50), "", "", "*/30 * * * *", &later, nil, now)
+	require.NoError(t, err)
+	scheduled.RecordRun("", errors.New("insufficient balance"), later)
+	assert.Equal(t, 1, scheduled.FailureCount)
+	assert.Equal(t, "insufficient balance", scheduled.LastError)
+	assert.Equal(t, later.Add(30*time.Minute), *scheduled.NextRunAt)
+
+	err = scheduled.Update(vo.NewAccountID(), vo.NewMoneyFromFloat(50), "", "", "", &later, nil, now)
+	require.NoError(t, err)
+	scheduled.RecordRun("TXN2", nil, later)
+	assert.Equal(t, vo.ScheduledTransactionStatusCompleted, scheduled.Status)
+	err = scheduled.Update(vo.NewAccountID(), vo.NewMoneyFromFloat(50), "", "", "", &later, nil, now)
+	assert.ErrorIs(t, err, errs.ErrScheduledTransactionCompleted)
+}
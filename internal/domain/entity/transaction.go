@@ -1,6 +1,7 @@
 package entity
 
 import (
+	"fmt"
 	"strings"
 	"time"
 
@@ -10,24 +11,50 @@ import (
 
 // Transaction represents a financial transaction
 type Transaction struct {
-	ID              vo.TransactionID     `json:"id"`
-	FromAccountID   *vo.AccountID        `json:"from_account_id,omitempty"`
-	ToAccountID     *vo.AccountID        `json:"to_account_id,omitempty"`
-	TransactionType vo.TransactionType   `json:"transaction_type"`
-	Amount          vo.Money             `json:"amount"`
-	Description     string               `json:"description"`
-	Reference       string               `json:"reference"`
-	Status          vo.TransactionStatus `json:"status"`
-	CreatedAt       time.Time            `json:"created_at"`
-	CompletedAt     *time.Time           `json:"completed_at,omitempty"`
-}
-
-// NewDebitTransaction creates a new debit transaction (withdrawal)
+	ID                    vo.TransactionID      `json:"id"`
+	FromAccountID         *vo.AccountID         `json:"from_account_id,omitempty"`
+	ToAccountID           *vo.AccountID         `json:"to_account_id,omitempty"`
+	VirtualAccountID      string                `json:"virtual_account_id,omitempty"` // Virtual number the credit was paid to
+	TransactionType       vo.TransactionType    `json:"transaction_type"`
+	Channel               vo.TransactionChannel `json:"channel"` // Where the transaction was initiated
+	Amount                vo.Money              `json:"amount"`
+	Fee                   vo.Money              `json:"fee"`                     // Charged to the source account on top of the amount
+	ExchangeRate          *vo.ExchangeRate      `json:"exchange_rate,omitempty"` // Rate an FX_TRANSFER converted the amount at
+	ConvertedAmount       vo.Money              `json:"converted_amount"`        // What an FX_TRANSFER credits, in the destination account's currency
+	Description           string                `json:"description"`
+	Reference             string                `json:"reference"`
+	ReferenceType         vo.ReferenceType      `json:"reference_type"` // How the reference is structured
+	Merchant              string                `json:"merchant,omitempty"`
+	Category              string                `json:"category"`
+	Status                vo.TransactionStatus  `json:"status"`
+	CreatedAt             time.Time             `json:"created_at"`
+	ValueDate             time.Time             `json:"value_date"` // Business date the transaction is booked for
+	CompletedAt           *time.Time            `json:"completed_at,omitempty"`
+	ReviewReason          string                `json:"review_reason,omitempty"` // Why the fraud rules held the confirmation
+	ReviewHeldAt          *time.Time            `json:"review_held_at,omitempty"`
+	ReviewDueAt           *time.Time            `json:"review_due_at,omitempty"` // Declined automatically if still in review after this
+	ReviewedBy            string                `json:"reviewed_by,omitempty"`
+	FailureKind           vo.FailureKind        `json:"failure_kind,omitempty"`
+	FailureReason         string                `json:"failure_reason,omitempty"`
+	ReplayCount           int                   `json:"replay_count,omitempty"`            // Times an admin replayed the transaction after an infrastructure failure
+	AdjustsID             *vo.TransactionID     `json:"adjusts_id,omitempty"`              // Transaction this adjustment corrects
+	ReversedTransactionID *vo.TransactionID     `json:"reversed_transaction_id,omitempty"` // Transaction this REVERSAL moves back
+	AdjustmentReason      vo.AdjustmentReason   `json:"adjustment_reason,omitempty"`       // Reason code of an ADJUSTMENT
+	RequestedBy           string                `json:"requested_by,omitempty"`            // Admin who posted an ADJUSTMENT; another admin approves it
+	ProcessingToken       int64                 `json:"-"`                                 // Fencing token of the worker that last claimed the transaction for processing
+	FromSequence          int64                 `json:"from_sequence,omitempty"`           // Position among the source account's completed transactions
+	ToSequence            int64                 `json:"to_sequence,omitempty"`             // Position among the destination account's completed transactions
+	FromChange            int64                 `json:"-"`                                 // Source account's change number of the last write
+	ToChange              int64                 `json:"-"`                                 // Destination account's change number of the last write
+}
+
+// NewDebitTransaction creates a new debit transaction (withdrawal) at now
 func NewDebitTransaction(
 	fromAccountID vo.AccountID,
 	amount vo.Money,
 	description string,
 	reference string,
+	now time.Time,
 ) (*Transaction, error) {
 	if fromAccountID.IsEmpty() {
 		return nil, errs.ValidationError{
@@ -45,20 +72,25 @@ func NewDebitTransaction(
 		FromAccountID:   &fromAccountID,
 		ToAccountID:     nil,
 		TransactionType: vo.TransactionTypeDebit,
+		Channel:         vo.TransactionChannelAPI,
+		ReferenceType:   vo.ReferenceTypeFree,
 		Amount:          amount,
 		Description:     strings.TrimSpace(description),
 		Reference:       strings.TrimSpace(reference),
+		Category:        CategoryUncategorized,
 		Status:          vo.TransactionStatusPending,
-		CreatedAt:       time.Now(),
+		CreatedAt:       now,
+		ValueDate:       vo.DateOf(now),
 	}, nil
 }
 
-// NewCreditTransaction creates a new credit transaction (deposit)
+// NewCreditTransaction creates a new credit transaction (deposit) at now
 func NewCreditTransaction(
 	toAccountID vo.AccountID,
 	amount vo.Money,
 	description string,
 	reference string,
+	now time.Time,
 ) (*Transaction, error) {
 	if toAccountID.IsEmpty() {
 		return nil, errs.ValidationError{
@@ -76,21 +108,26 @@ func NewCreditTransaction(
 		FromAccountID:   nil,
 		ToAccountID:     &toAccountID,
 		TransactionType: vo.TransactionTypeCredit,
+		Channel:         vo.TransactionChannelAPI,
+		ReferenceType:   vo.ReferenceTypeFree,
 		Amount:          amount,
 		Description:     strings.TrimSpace(description),
 		Reference:       strings.TrimSpace(reference),
+		Category:        CategoryUncategorized,
 		Status:          vo.TransactionStatusPending,
-		CreatedAt:       time.Now(),
+		CreatedAt:       now,
+		ValueDate:       vo.DateOf(now),
 	}, nil
 }
 
-// NewTransferTransaction creates a new transfer transaction
+// NewTransferTransaction creates a new transfer transaction at now
 func NewTransferTransaction(
 	fromAccountID vo.AccountID,
 	toAccountID vo.AccountID,
 	amount vo.Money,
 	description string,
 	reference string,
+	now time.Time,
 ) (*Transaction, error) {
 	if fromAccountID.IsEmpty() {
 		return nil, errs.ValidationError{
@@ -119,16 +156,181 @@ func NewTransferTransaction(
 		FromAccountID:   &fromAccountID,
 		ToAccountID:     &toAccountID,
 		TransactionType: vo.TransactionTypeTransfer,
+		Channel:         vo.TransactionChannelAPI,
+		ReferenceType:   vo.ReferenceTypeFree,
 		Amount:          amount,
 		Description:     strings.TrimSpace(description),
 		Reference:       strings.TrimSpace(reference),
+		Category:        CategoryUncategorized,
 		Status:          vo.TransactionStatusPending,
-		CreatedAt:       time.Now(),
+		CreatedAt:       now,
+		ValueDate:       vo.DateOf(now),
 	}, nil
 }
 
+// NewFXTransferTransaction creates a transfer between accounts held in different currencies. The amount,
+// in the source account's currency, is converted at rate and the converted amount credited to the
+// destination account; the rate is recorded on the transaction.
+func NewFXTransferTransaction(
+	fromAccountID vo.AccountID,
+	toAccountID vo.AccountID,
+	amount vo.Money,
+	rate vo.ExchangeRate,
+	description string,
+	reference string,
+	now time.Time,
+) (*Transaction, error) {
+	transaction, err := NewTransferTransaction(fromAccountID, toAccountID, amount, description, reference, now)
+	if err != nil {
+		return nil, err
+	}
+
+	converted, err := rate.Convert(amount)
+	if err != nil {
+		return nil, err
+	}
+	if converted.IsZero() {
+		return nil, errs.ErrInvalidTransactionAmount
+	}
+
+	transaction.TransactionType = vo.TransactionTypeFXTransfer
+	transaction.Amount = amount.In(rate.From)
+	transaction.ExchangeRate = &rate
+	transaction.ConvertedAmount = converted
+	return transaction, nil
+}
+
+// NewAdjustmentTransaction creates an admin's correction of an account's balance: an increase is
+// credited to the account and a decrease debited from it. The reason code is mandatory and a second
+// admin must approve the adjustment before it is processed, see ApproveReview.
+func NewAdjustmentTransaction(
+	accountID vo.AccountID,
+	direction vo.AdjustmentDirection,
+	amount vo.Money,
+	reason vo.AdjustmentReason,
+	description string,
+	requestedBy string,
+	now time.Time,
+) (*Transaction, error) {
+	if accountID.IsEmpty() {
+		return nil, errs.ValidationError{
+			Field:   "accountID",
+			Message: "account ID is required for adjustment transaction",
+		}
+	}
+
+	if !direction.IsValid() {
+		return nil, errs.ValidationError{
+			Field:   "direction",
+			Message: "invalid adjustment direction: " + string(direction),
+		}
+	}
+
+	if !reason.IsValid() {
+		return nil, errs.ValidationError{
+			Field:   "reasonCode",
+			Message: "invalid adjustment reason code: " + string(reason),
+		}
+	}
+
+	if strings.TrimSpace(requestedBy) == "" {
+		return nil, errs.ValidationError{
+			Field:   "requestedBy",
+			Message: "requested by is required for adjustment transaction",
+		}
+	}
+
+	if amount.IsZero() || !amount.IsPositive() {
+		return nil, errs.ErrInvalidTransactionAmount
+	}
+
+	transaction := &Transaction{
+		ID:               vo.NewTransactionID(),
+		TransactionType:  vo.TransactionTypeAdjustment,
+		Channel:          vo.TransactionChannelAPI,
+		ReferenceType:    vo.ReferenceTypeFree,
+		Amount:           amount,
+		Description:      strings.TrimSpace(description),
+		Category:         CategoryUncategorized,
+		Status:           vo.TransactionStatusPending,
+		CreatedAt:        now,
+		ValueDate:        vo.DateOf(now),
+		AdjustmentReason: reason,
+		RequestedBy:      strings.TrimSpace(requestedBy),
+	}
+	if direction == vo.AdjustmentDirectionIncrease {
+		transaction.ToAccountID = &accountID
+	} else {
+		transaction.FromAccountID = &accountID
+	}
+	return transaction, nil
+}
+
+// NewReversalTransaction creates a REVERSAL moving a completed transaction's amount back: a credit
+// for a debit, a debit for a credit and the opposite transfer for a transfer. An FX transfer moves the
+// converted amount back at the inverse of the rate it was made at, so the source account gets back
+// exactly what it sent. The fee is refunded separately, see NewFeeRefundTransaction. Reversals and
+// adjustments cannot be reversed; a wrong adjustment is corrected by another one.
+func NewReversalTransaction(original *Transaction, now time.Time) (*Transaction, error) {
+	if !original.Status.IsCompleted() {
+		return nil, fmt.Errorf("%w: status is %s", errs.ErrTransactionNotReversible, original.Status)
+	}
+
+	description := "Reversal of " + original.ID.String()
+	reference := "REVERSAL-" + original.ID.String()
+	var reversal *Transaction
+	var err error
+	switch original.TransactionType {
+	case vo.TransactionTypeDebit:
+		reversal, err = NewCreditTransaction(*original.FromAccountID, original.Amount, description, reference, now)
+	case vo.TransactionTypeCredit:
+		reversal, err = NewDebitTransaction(*original.ToAccountID, original.Amount, description, reference, now)
+	case vo.TransactionTypeTransfer:
+		reversal, err = NewTransferTransaction(*original.ToAccountID, *original.FromAccountID, original.Amount, description, reference, now)
+	case vo.TransactionTypeFXTransfer:
+		reversal, err = NewFXTransferTransaction(*original.ToAccountID, *original.FromAccountID, original.ConvertedAmount, original.ExchangeRate.Inverse(), description, reference, now)
+		if err == nil {
+			reversal.ConvertedAmount = original.Amount
+		}
+	default:
+		return nil, fmt.Errorf("%w: %s transactions are not reversed", errs.ErrTransactionNotReversible, original.TransactionType)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	originalID := original.ID
+	reversal.TransactionType = vo.TransactionTypeReversal
+	reversal.ReversedTransactionID = &originalID
+	return reversal, nil
+}
+
+// NewFeeRefundTransaction creates a credit returning the fee a completed transaction charged its source account
+func NewFeeRefundTransaction(original *Transaction, now time.Time) (*Transaction, error) {
+	if !original.Status.IsCompleted() || original.FromAccountID == nil || !original.Fee.IsPositive() {
+		return nil, errs.BusinessError{
+			Code:    "NO_FEE_TO_REFUND",
+			Message: "transaction " + original.ID.String() + " charged no fee",
+		}
+	}
+
+	return NewCreditTransaction(*original.FromAccountID, original.Fee, feeRefundDescriptionPrefix+original.ID.String(), "REVERSAL-"+original.ID.String(), now)
+}
+
+// feeRefundDescriptionPrefix starts the description of fee refunds, followed by the refunded transaction's ID
+const feeRefundDescriptionPrefix = "Fee refund for "
+
+// IsFeeRefund checks if the transaction is a credit returning a fee, see NewFeeRefundTransaction
+func (t *Transaction) IsFeeRefund() bool {
+	return t.TransactionType == vo.TransactionTypeCredit &&
+		strings.HasPrefix(t.Reference, "REVERSAL-") &&
+		strings.HasPrefix(t.Description, feeRefundDescriptionPrefix)
+}
+
 // Business methods
-func (t *Transaction) MarkAsCompleted() error {
+
+// MarkAsCompleted records the transaction completed at now
+func (t *Transaction) MarkAsCompleted(now time.Time) error {
 	if !t.Status.CanTransitionTo(vo.TransactionStatusCompleted) {
 		return errs.BusinessError{
 			Code:    "INVALID_STATUS_TRANSITION",
@@ -136,7 +338,6 @@ func (t *Transaction) MarkAsCompleted() error {
 		}
 	}
 
-	now := time.Now()
 	t.Status = vo.TransactionStatusCompleted
 	t.CompletedAt = &now
 	return nil
@@ -166,8 +367,105 @@ func (t *Transaction) MarkAsCancelled() error {
 	return nil
 }
 
-// SetStatus sets transaction status with validation
-func (t *Transaction) SetStatus(status vo.TransactionStatus) error {
+// maxFailureReasonLength bounds the recorded failure reason
+const maxFailureReasonLength = 500
+
+// Fail marks the transaction as failed, recording why
+func (t *Transaction) Fail(kind vo.FailureKind, reason string) error {
+	if err := t.MarkAsFailed(); err != nil {
+		return err
+	}
+
+	if len(reason) > maxFailureReasonLength {
+		reason = strings.ToValidUTF8(reason[:maxFailureReasonLength], "")
+	}
+	t.FailureKind = kind
+	t.FailureReason = reason
+	return nil
+}
+
+// PrepareReplay returns a transaction that failed on infrastructure to pending so its
+// confirmation can run again; failures decided by business rules are final
+func (t *Transaction) PrepareReplay() error {
+	if !t.Status.IsFailed() || t.FailureKind != vo.FailureKindInfrastructure {
+		return errs.ErrTransactionNotReplayable
+	}
+
+	t.Status = vo.TransactionStatusPending
+	t.FailureKind = ""
+	t.FailureReason = ""
+	t.ReplayCount++
+	return nil
+}
+
+// PlaceInReview holds a pending transaction for review, from now until an admin decides or dueAt passes
+func (t *Transaction) PlaceInReview(reason string, dueAt, now time.Time) error {
+	if !t.Status.CanTransitionTo(vo.TransactionStatusReview) {
+		return errs.BusinessError{
+			Code:    "INVALID_STATUS_TRANSITION",
+			Message: "cannot transition from " + string(t.Status) + " to REVIEW",
+		}
+	}
+
+	t.Status = vo.TransactionStatusReview
+	t.ReviewReason = reason
+	t.ReviewHeldAt = &now
+	t.ReviewDueAt = &dueAt
+	return nil
+}
+
+// ReviewAge returns how long a transaction has been waiting in the review queue
+func (t *Transaction) ReviewAge(now time.Time) time.Duration {
+	if !t.Status.IsInReview() {
+		return 0
+	}
+
+	// Reviews held before the hold time was recorded count from creation
+	heldAt := t.CreatedAt
+	if t.ReviewHeldAt != nil {
+		heldAt = *t.ReviewHeldAt
+	}
+	return now.Sub(heldAt)
+}
+
+// ApproveReview records the admin who released a transaction held for review; it is then processed.
+// The admin who posted an adjustment cannot approve it.
+func (t *Transaction) ApproveReview(reviewer string) error {
+	if !t.Status.IsInReview() {
+		return errs.ErrTransactionNotInReview
+	}
+
+	if t.TransactionType.IsAdjustment() && strings.EqualFold(strings.TrimSpace(reviewer), t.RequestedBy) {
+		return errs.ErrSelfApproval
+	}
+
+	t.ReviewedBy = reviewer
+	return nil
+}
+
+// DeclineReview fails a transaction held for review
+func (t *Transaction) DeclineReview(reviewer, reason string) error {
+	if !t.Status.IsInReview() {
+		return errs.ErrTransactionNotInReview
+	}
+
+	t.Status = vo.TransactionStatusFailed
+	t.FailureKind = vo.FailureKindBusiness
+	t.FailureReason = "declined in review"
+	t.ReviewedBy = reviewer
+	if reason != "" {
+		t.ReviewReason = reason
+	}
+	return nil
+}
+
+// IsReviewOverdue checks if a transaction in review has passed its review deadline
+func (t *Transaction) IsReviewOverdue(now time.Time) bool {
+	return t.Status.IsInReview() && t.ReviewDueAt != nil && now.After(*t.ReviewDueAt)
+}
+
+// SetStatus sets transaction status with validation; a completed transaction completed at now
+func (t *Transaction) SetStatus(status vo.TransactionStatus, now time.Time) error {
 	if !status.IsValid() {
 		return errs.ValidationError{
 			Field:   "status",
@@ -184,9 +482,186 @@ func (t *Transaction) SetStatus(status vo.TransactionStatus) error {
 
 	t.Status = status
 	if status.IsCompleted() {
-		now := time.Now()
 		t.CompletedAt = &now
 	}
 
 	return nil
 }
+
+// SetValueDate books a pending transaction for a later business date
+func (t *Transaction) SetValueDate(valueDate time.Time) error {
+	if !t.Status.IsPending() {
+		return errs.BusinessError{
+			Code:    "INVALID_STATUS_TRANSITION",
+			Message: "cannot change value date of transaction with status: " + string(t.Status),
+		}
+	}
+
+	if !vo.SameOrBeforeDate(t.CreatedAt, valueDate) {
+		return errs.ValidationError{
+			Field:   "valueDate",
+			Message: "value date cannot be before the creation date",
+		}
+	}
+
+	t.ValueDate = vo.DateOf(valueDate)
+	return nil
+}
+
+// AdjustFor marks a pending transaction as an adjustment correcting an earlier transaction
+func (t *Transaction) AdjustFor(original *Transaction) error {
+	if !t.Status.IsPending() {
+		return errs.BusinessError{
+			Code:    "INVALID_STATUS_TRANSITION",
+			Message: "cannot link adjustment of transaction with status: " + string(t.Status),
+		}
+	}
+
+	if original.ID == t.ID {
+		return errs.ValidationError{
+			Field:   "adjustsID",
+			Message: "a transaction cannot adjust itself",
+		}
+	}
+
+	originalID := original.ID
+	t.AdjustsID = &originalID
+	return nil
+}
+
+// SetChannel records the channel a transaction was initiated through
+func (t *Transaction) SetChannel(channel vo.TransactionChannel) error {
+	if !channel.IsValid() {
+		return errs.ValidationError{
+			Field:   "channel",
+			Message: "invalid transaction channel: " + string(channel),
+		}
+	}
+
+	t.Channel = channel
+	return nil
+}
+
+// SetReferenceType validates the reference against its type and stores it in the type's format
+func (t *Transaction) SetReferenceType(referenceType vo.ReferenceType) error {
+	if !referenceType.IsValid() {
+		return errs.ValidationError{
+			Field:   "referenceType",
+			Message: "invalid reference type: " + string(referenceType),
+		}
+	}
+
+	reference, err := referenceType.Format(t.Reference)
+	if err != nil {
+		return err
+	}
+
+	t.Reference = reference
+	t.ReferenceType = referenceType
+	return nil
+}
+
+// SetFee records the fee charged to the source account; only pending transactions with a source account carry one
+func (t *Transaction) SetFee(fee vo.Money) error {
+	if fee.IsNegative() {
+		return errs.ValidationError{
+			Field:   "fee",
+			Message: "fee cannot be negative",
+		}
+	}
+
+	if !fee.IsZero() && t.FromAccountID == nil {
+		return errs.ValidationError{
+			Field:   "fee",
+			Message: "only transactions with a source account can carry a fee",
+		}
+	}
+
+	if t.Status != vo.TransactionStatusPending {
+		return errs.ErrInvalidTransactionStatus
+	}
+
+	// The fee is charged in the currency of the amount
+	if !fee.Currency().Matches(t.Currency()) {
+		return errs.ErrCurrencyMismatch
+	}
+
+	t.Fee = fee.In(t.Currency())
+	return nil
+}
+
+// Currency returns the currency the transaction is made in
+func (t *Transaction) Currency() vo.Currency {
+	return t.Amount.Currency()
+}
+
+// CreditedAmount returns what the transaction adds to its destination account: the converted amount of
+// an FX transfer or its reversal, the amount otherwise
+func (t *Transaction) CreditedAmount() vo.Money {
+	if t.ExchangeRate != nil {
+		return t.ConvertedAmount
+	}
+	return t.Amount
+}
+
+// TotalDebit returns what the transaction takes from its source account: the amount plus the fee
+func (t *Transaction) TotalDebit() vo.Money {
+	total, _ := t.Amount.Add(t.Fee)
+	return total
+}
+
+// Categorize assigns the transaction to a category
+func (t *Transaction) Categorize(categoryCode string) {
+	t.Category = NormalizeCategoryCode(categoryCode)
+}
+
+// IsDueOn checks if the transaction may be processed on the given business date
+func (t *Transaction) IsDueOn(date time.Time) bool {
+	return vo.SameOrBeforeDate(t.ValueDate, date)
+}
+
+// SequenceFor returns the transaction's position in the sequence of an account's completed transactions.
+// Each account numbers its completed transactions 1, 2, 3, ... in the order they completed; a transaction
+// that has not completed or does not involve the account has 0.
+func (t *Transaction) SequenceFor(accountID vo.AccountID) int64 {
+	if t.FromAccountID != nil && *t.FromAccountID == accountID {
+		return t.FromSequence
+	}
+	if t.ToAccountID != nil && *t.ToAccountID == accountID {
+		return t.ToSequence
+	}
+	return 0
+}
+
+// ChangeFor returns the account's change number of the transaction's last write. Every write of a
+// transaction takes the next change number of each account it involves, so listing an account's
+// transactions by change number yields each one once, in its latest state.
+func (t *Transaction) ChangeFor(accountID vo.AccountID) int64 {
+	if t.FromAccountID != nil && *t.FromAccountID == accountID {
+		return t.FromChange
+	}
+	if t.ToAccountID != nil && *t.ToAccountID == accountID {
+		return t.ToChange
+	}
+	return 0
+}
+
+// BalanceEffect returns the signed change this transaction applied to the given account.
+// Debits from the account are negative, credits to the account are positive and
+// transactions that are not completed or do not involve the account have no effect.
+func (t *Transaction) BalanceEffect(accountID vo.AccountID) vo.Money {
+	effect := vo.ZeroMoney()
+	if !t.Status.IsCompleted() {
+		return effect
+	}
+
+	if t.FromAccountID != nil && t.FromAccountID.String() == accountID.String() {
+		effect, _ = effect.Subtract(t.TotalDebit())
+	}
+
+	if t.ToAccountID != nil && t.ToAccountID.String() == accountID.String() {
+		effect, _ = effect.Add(t.CreditedAmount())
+	}
+
+	return effect
+}
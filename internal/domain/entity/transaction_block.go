@@ -0,0 +1,117 @@
+package entity
+
+import (
+	"strings"
+	"time"
+
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+)
+
+// TransactionBlock temporarily stops one type of transaction on an account, e.g. outgoing transfers
+// for a day after a password change. Debits and transfers are blocked out of the account and credits
+// into it; transfers into the account still arrive. It ends when it expires or an admin lifts it.
+type TransactionBlock struct {
+	ID              string                    `json:"id"`
+	AccountID       vo.AccountID              `json:"account_id"`
+	TransactionType vo.TransactionType        `json:"transaction_type"`
+	ReasonCode      vo.TransactionBlockReason `json:"reason_code"`
+	Note            string                    `json:"note,omitempty"`
+	CreatedBy       string                    `json:"created_by"`
+	CreatedAt       time.Time                 `json:"created_at"`
+	ExpiresAt       time.Time                 `json:"expires_at"`
+	LiftedBy        string                    `json:"lifted_by,omitempty"` // Admin who ended the block before it expired
+	LiftedAt        *time.Time                `json:"lifted_at,omitempty"`
+}
+
+// NewTransactionBlock blocks transactions of the type on the account from now until expiresAt
+func NewTransactionBlock(accountID vo.AccountID, transactionType vo.TransactionType, reasonCode vo.TransactionBlockReason,
+	note, createdBy string, now, expiresAt time.Time) (*TransactionBlock, error) {
+	if accountID.IsEmpty() {
+		return nil, errs.ErrMissingAccountID
+	}
+
+	switch transactionType {
+	case vo.TransactionTypeDebit, vo.TransactionTypeCredit, vo.TransactionTypeTransfer:
+	default:
+		return nil, errs.ValidationError{
+			Field:   "transaction_type",
+			Message: "only DEBIT, CREDIT and TRANSFER transactions can be blocked",
+		}
+	}
+
+	if !reasonCode.IsValid() {
+		return nil, errs.ValidationError{
+			Field:   "reason_code",
+			Message: "invalid reason code: " + string(reasonCode),
+		}
+	}
+
+	createdBy = strings.TrimSpace(createdBy)
+	if createdBy == "" {
+		return nil, errs.ValidationError{
+			Field:   "created_by",
+			Message: "created by is required",
+		}
+	}
+
+	if !expiresAt.After(now) {
+		return nil, errs.ValidationError{
+			Field:   "expires_at",
+			Message: "block must expire in the future",
+		}
+	}
+
+	return &TransactionBlock{
+		ID:              vo.NewID("BLK"),
+		AccountID:       accountID,
+		TransactionType: transactionType,
+		ReasonCode:      reasonCode,
+		Note:            strings.TrimSpace(note),
+		CreatedBy:       createdBy,
+		CreatedAt:       now,
+		ExpiresAt:       expiresAt,
+	}, nil
+}
+
+// IsActive checks if the block is in force at the given time
+func (b *TransactionBlock) IsActive(now time.Time) bool {
+	return b.LiftedAt == nil && now.Before(b.ExpiresAt)
+}
+
+// Blocks checks if the block stops the transaction, whatever the time. A TRANSFER block stops FX
+// transfers too; reversals only move back money that was already allowed to move and are never stopped.
+func (b *TransactionBlock) Blocks(transaction *Transaction) bool {
+	transactionType := transaction.TransactionType
+	if transactionType.IsFXTransfer() {
+		transactionType = vo.TransactionTypeTransfer
+	}
+	if transactionType != b.TransactionType {
+		return false
+	}
+
+	accountID := transaction.FromAccountID
+	if b.TransactionType == vo.TransactionTypeCredit {
+		accountID = transaction.ToAccountID
+	}
+	return accountID != nil && accountID.String() == b.AccountID.String()
+}
+
+// Lift ends an active block before it expires
+func (b *TransactionBlock) Lift(admin string, now time.Time) error {
+	if !b.IsActive(now) {
+		return errs.ErrTransactionBlockNotActive
+	}
+
+	admin = strings.TrimSpace(admin)
+	if admin == "" {
+		return errs.ValidationError{
+			Field:   "admin",
+			Message: "admin is required",
+		}
+	}
+
+	b.LiftedBy = admin
+	b.LiftedAt = &now
+	return nil
+}
@@ -0,0 +1,74 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewTransactionBlock(t *testing.T) {
+	now := time.Date(2024, 3, 15, 10, 0, 0, 0, time.UTC)
+	accountID := vo.NewAccountID()
+
+	block, err := NewTransactionBlock(accountID, vo.TransactionTypeTransfer, vo.TransactionBlockReasonCredentialChange,
+		" password reset ", " alice ", now, now.Add(24*time.Hour))
+	require.NoError(t, err)
+	assert.Len(t, block.ID, vo.IDLength)
+	assert.Equal(t, "password reset", block.Note)
+	assert.Equal(t, "alice", block.CreatedBy)
+	assert.True(t, block.IsActive(now))
+	assert.False(t, block.IsActive(now.Add(24*time.Hour)))
+
+	// Admin adjustments are never blocked
+	_, err = NewTransactionBlock(accountID, vo.TransactionTypeAdjustment, vo.TransactionBlockReasonLegalOrder, "", "alice", now, now.Add(time.Hour))
+	assert.IsType(t, errs.ValidationError{}, err)
+	_, err = NewTransactionBlock(accountID, vo.TransactionTypeDebit, vo.TransactionBlockReason("BORED"), "", "alice", now, now.Add(time.Hour))
+	assert.IsType(t, errs.ValidationError{}, err)
+	_, err = NewTransactionBlock(accountID, vo.TransactionTypeDebit, vo.TransactionBlockReasonLegalOrder, "", " ", now, now.Add(time.Hour))
+	assert.IsType(t, errs.ValidationError{}, err)
+	_, err = NewTransactionBlock(accountID, vo.TransactionTypeDebit, vo.TransactionBlockReasonLegalOrder, "", "alice", now, now)
+	assert.IsType(t, errs.ValidationError{}, err)
+}
+
+func TestTransactionBlockBlocks(t *testing.T) {
+	now := time.Date(2024, 3, 15, 10, 0, 0, 0, time.UTC)
+	blocked, other := vo.NewAccountID(), vo.NewAccountID()
+
+	outgoing, err := NewTransferTransaction(blocked, other, vo.NewMoneyFromFloat(10), "", "", time.Now())
+	require.NoError(t, err)
+	incoming, err := NewTransferTransaction(other, blocked, vo.NewMoneyFromFloat(10), "", "", time.Now())
+	require.NoError(t, err)
+	debit, err := NewDebitTransaction(blocked, vo.NewMoneyFromFloat(10), "", "", time.Now())
+	require.NoError(t, err)
+	credit, err := NewCreditTransaction(blocked, vo.NewMoneyFromFloat(10), "", "", time.Now())
+	require.NoError(t, err)
+
+	transfers, err := NewTransactionBlock(blocked, vo.TransactionTypeTransfer, vo.TransactionBlockReasonNewDevice, "", "alice", now, now.Add(time.Hour))
+	require.NoError(t, err)
+	assert.True(t, transfers.Blocks(outgoing))
+	assert.False(t, transfers.Blocks(incoming))
+	assert.False(t, transfers.Blocks(debit))
+
+	credits, err := NewTransactionBlock(blocked, vo.TransactionTypeCredit, vo.TransactionBlockReasonLegalOrder, "", "alice", now, now.Add(time.Hour))
+	require.NoError(t, err)
+	assert.True(t, credits.Blocks(credit))
+	assert.False(t, credits.Blocks(incoming))
+}
+
+func TestTransactionBlockLift(t *testing.T) {
+	now := time.Date(2024, 3, 15, 10, 0, 0, 0, time.UTC)
+	block, err := NewTransactionBlock(vo.NewAccountID(), vo.TransactionTypeDebit, vo.TransactionBlockReasonCustomerRequest, "", "alice", now, now.Add(time.Hour))
+	require.NoError(t, err)
+
+	assert.IsType(t, errs.ValidationError{}, block.Lift(" ", now))
+	assert.ErrorIs(t, block.Lift("bob", now.Add(time.Hour)), errs.ErrTransactionBlockNotActive)
+
+	require.NoError(t, block.Lift("bob", now.Add(time.Minute)))
+	assert.Equal(t, "bob", block.LiftedBy)
+	assert.False(t, block.IsActive(now.Add(2*time.Minute)))
+	assert.ErrorIs(t, block.Lift("bob", now.Add(2*time.Minute)), errs.ErrTransactionBlockNotActive)
+}
@@ -0,0 +1,79 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+)
+
+// HistoryDirection tells whether a history entry moved money into or out of its account
+type HistoryDirection string
+
+const (
+	HistoryDirectionIn  HistoryDirection = "IN"
+	HistoryDirectionOut HistoryDirection = "OUT"
+)
+
+// HistoryEntry is one account's side of a transaction in the transaction history read model. It is
+// flat on purpose: the counterparty's name and the account's balance after the transaction are copied
+// into it when it is projected, so listing history needs neither joins nor entity conversions.
+type HistoryEntry struct {
+	AccountID        vo.AccountID
+	TransactionID    vo.TransactionID
+	Sequence         int64 // Position among the account's completed transactions; 0 until completed
+	TransactionType  vo.TransactionType
+	Direction        HistoryDirection
+	CounterpartyID   *vo.AccountID // The other account of a transfer
+	CounterpartyName string
+	Amount           vo.Money // What moved on the account's side, in its currency
+	Fee              vo.Money // Only charged on the OUT side
+	Change           vo.Money // Signed effect on the account, zero until completed
+	BalanceAfter     *vo.Money
+	Category         string
+	Status           vo.TransactionStatus
+	Description      string
+	Reference        string
+	CreatedAt        time.Time
+	CompletedAt      *time.Time
+	ProjectedAt      time.Time
+}
+
+// NewHistoryEntries creates the history entries of a transaction, one for each account it touches.
+// Counterparty names and balances are left for the projection to fill in.
+func NewHistoryEntries(transaction *Transaction) []*HistoryEntry {
+	newEntry := func(accountID vo.AccountID, direction HistoryDirection, counterpartyID *vo.AccountID) *HistoryEntry {
+		entry := &HistoryEntry{
+			AccountID:       accountID,
+			TransactionID:   transaction.ID,
+			Sequence:        transaction.SequenceFor(accountID),
+			TransactionType: transaction.TransactionType,
+			Direction:       direction,
+			CounterpartyID:  counterpartyID,
+			Amount:          transaction.Amount,
+			Fee:             vo.ZeroMoney(),
+			Change:          transaction.BalanceEffect(accountID),
+			Category:        transaction.Category,
+			Status:          transaction.Status,
+			Description:     transaction.Description,
+			Reference:       transaction.Reference,
+			CreatedAt:       transaction.CreatedAt,
+			CompletedAt:     transaction.CompletedAt,
+			ProjectedAt:     time.Now(),
+		}
+		if direction == HistoryDirectionOut {
+			entry.Fee = transaction.Fee
+		} else {
+			entry.Amount = transaction.CreditedAmount()
+		}
+		return entry
+	}
+
+	var entries []*HistoryEntry
+	if transaction.FromAccountID != nil {
+		entries = append(entries, newEntry(*transaction.FromAccountID, HistoryDirectionOut, transaction.ToAccountID))
+	}
+	if transaction.ToAccountID != nil {
+		entries = append(entries, newEntry(*transaction.ToAccountID, HistoryDirectionIn, transaction.FromAccountID))
+	}
+	return entries
+}
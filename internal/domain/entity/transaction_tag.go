@@ -0,0 +1,157 @@
+package entity
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+)
+
+const (
+	// MaxTagsPerTransaction is the number of tags an account holder can put on one of their transactions
+	MaxTagsPerTransaction = 10
+	// MaxTagLength is the longest tag, in characters
+	MaxTagLength = 30
+	// MaxSavedFiltersPerAccount is the number of history filters an account can save
+	MaxSavedFiltersPerAccount = 20
+)
+
+// TransactionTag is a label an account holder put on one of their transactions. Like a category
+// override it belongs to the account, so the two sides of a transfer are tagged separately.
+type TransactionTag struct {
+	AccountID     vo.AccountID     `json:"account_id"`
+	TransactionID vo.TransactionID `json:"transaction_id"`
+	Tag           string           `json:"tag"`
+	CreatedAt     time.Time        `json:"created_at"`
+}
+
+// NewTransactionTags creates the tags of a transaction as seen by an account, after normalizing them
+func NewTransactionTags(accountID vo.AccountID, transactionID vo.TransactionID, tags []string) ([]*TransactionTag, error) {
+	normalized, err := NormalizeTags(tags)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	transactionTags := make([]*TransactionTag, len(normalized))
+	for i, tag := range normalized {
+		transactionTags[i] = &TransactionTag{
+			AccountID:     accountID,
+			TransactionID: transactionID,
+			Tag:           tag,
+			CreatedAt:     now,
+		}
+	}
+	return transactionTags, nil
+}
+
+// NormalizeTags trims and lowercases tags and drops repeated ones, keeping their order. A tag is 1 to
+// MaxTagLength letters, digits, '-' or '_', and at most MaxTagsPerTransaction are kept.
+func NormalizeTags(tags []string) ([]string, error) {
+	seen := make(map[string]bool, len(tags))
+	normalized := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		tag = strings.ToLower(strings.TrimSpace(tag))
+		if !isTag(tag) {
+			return nil, errs.ValidationError{
+				Field:   "tags",
+				Message: fmt.Sprintf("tag %q must be 1 to %d letters, digits, '-' or '_'", tag, MaxTagLength),
+			}
+		}
+		if seen[tag] {
+			continue
+		}
+		seen[tag] = true
+		normalized = append(normalized, tag)
+	}
+
+	if len(normalized) > MaxTagsPerTransaction {
+		return nil, errs.ValidationError{
+			Field:   "tags",
+			Message: fmt.Sprintf("a transaction can have at most %d tags", MaxTagsPerTransaction),
+		}
+	}
+	return normalized, nil
+}
+
+// isTag checks if a normalized tag is made of allowed characters and not too long
+func isTag(tag string) bool {
+	if tag == "" || len(tag) > MaxTagLength {
+		return false
+	}
+	for _, r := range tag {
+		if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' && r != '_' {
+			return false
+		}
+	}
+	return true
+}
+
+// SavedFilter is a named set of history filters an account holder saved to apply again. Zero fields
+// match every entry.
+type SavedFilter struct {
+	ID              string             `json:"id"`
+	AccountID       vo.AccountID       `json:"account_id"`
+	Name            string             `json:"name"`
+	Tags            []string           `json:"tags,omitempty"`             // Entries tagged with any of them
+	Direction       HistoryDirection   `json:"direction,omitempty"`        // IN or OUT
+	TransactionType vo.TransactionType `json:"transaction_type,omitempty"` // DEBIT, CREDIT, ...
+	CreatedAt       time.Time          `json:"created_at"`
+	UpdatedAt       time.Time          `json:"updated_at"`
+}
+
+// NewSavedFilter creates a new saved history filter of an account
+func NewSavedFilter(accountID vo.AccountID, name string, tags []string, direction HistoryDirection, transactionType vo.TransactionType) (*SavedFilter, error) {
+	now := time.Now()
+
+	filter := &SavedFilter{
+		ID:        vo.NewID("FLT"),
+		AccountID: accountID,
+		CreatedAt: now,
+	}
+
+	if err := filter.Update(name, tags, direction, transactionType); err != nil {
+		return nil, err
+	}
+
+	return filter, nil
+}
+
+// Update replaces the filter's name and criteria
+func (f *SavedFilter) Update(name string, tags []string, direction HistoryDirection, transactionType vo.TransactionType) error {
+	name = strings.TrimSpace(name)
+	if name == "" || len(name) > 100 {
+		return errs.ValidationError{
+			Field:   "name",
+			Message: "filter name must be 1 to 100 characters",
+		}
+	}
+
+	normalized, err := NormalizeTags(tags)
+	if err != nil {
+		return err
+	}
+
+	if direction != "" && direction != HistoryDirectionIn && direction != HistoryDirectionOut {
+		return errs.ValidationError{
+			Field:   "direction",
+			Message: "direction must be IN or OUT",
+		}
+	}
+
+	if transactionType != "" && !transactionType.IsValid() {
+		return errs.ValidationError{
+			Field:   "transactionType",
+			Message: "invalid transaction type: " + string(transactionType),
+		}
+	}
+
+	f.Name = name
+	f.Tags = normalized
+	f.Direction = direction
+	f.TransactionType = transactionType
+	f.UpdatedAt = time.Now()
+	return nil
+}
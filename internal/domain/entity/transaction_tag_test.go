@@ -0,0 +1,69 @@
+package entity
+
+import (
+	"strings"
+	"testing"
+
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeTags(t *testing.T) {
+	tags, err := NormalizeTags([]string{" Groceries", "rent", "groceries", "trip_2026"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"groceries", "rent", "trip_2026"}, tags)
+
+	_, err = NormalizeTags([]string{"two words"})
+	assert.IsType(t, errs.ValidationError{}, err)
+
+	_, err = NormalizeTags([]string{" "})
+	assert.IsType(t, errs.ValidationError{}, err)
+
+	_, err = NormalizeTags([]string{strings.Repeat("a", MaxTagLength+1)})
+	assert.IsType(t, errs.ValidationError{}, err)
+
+	tooMany := make([]string, MaxTagsPerTransaction+1)
+	for i := range tooMany {
+		tooMany[i] = strings.Repeat("t", i+1)
+	}
+	_, err = NormalizeTags(tooMany)
+	assert.IsType(t, errs.ValidationError{}, err)
+}
+
+func TestNewTransactionTags(t *testing.T) {
+	accountID := vo.NewAccountID()
+	transactionID := vo.NewTransactionID()
+
+	tags, err := NewTransactionTags(accountID, transactionID, []string{"Rent", "rent"})
+	require.NoError(t, err)
+	require.Len(t, tags, 1)
+	assert.Equal(t, accountID, tags[0].AccountID)
+	assert.Equal(t, transactionID, tags[0].TransactionID)
+	assert.Equal(t, "rent", tags[0].Tag)
+
+	tags, err = NewTransactionTags(accountID, transactionID, nil)
+	require.NoError(t, err)
+	assert.Empty(t, tags)
+}
+
+func TestNewSavedFilter(t *testing.T) {
+	accountID := vo.NewAccountID()
+
+	filter, err := NewSavedFilter(accountID, " Holidays ", []string{"Trip"}, HistoryDirectionOut, vo.TransactionTypeTransfer)
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(filter.ID, "FLT"))
+	assert.Equal(t, "Holidays", filter.Name)
+	assert.Equal(t, []string{"trip"}, filter.Tags)
+	assert.Equal(t, HistoryDirectionOut, filter.Direction)
+
+	_, err = NewSavedFilter(accountID, "", nil, "", "")
+	assert.IsType(t, errs.ValidationError{}, err)
+
+	_, err = NewSavedFilter(accountID, "Sideways", nil, "SIDEWAYS", "")
+	assert.IsType(t, errs.ValidationError{}, err)
+
+	_, err = NewSavedFilter(accountID, "Unknown", nil, "", "REFUND")
+	assert.IsType(t, errs.ValidationError{}, err)
+}
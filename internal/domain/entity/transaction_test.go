@@ -1,11 +1,13 @@
 package entity
 
 import (
+	"strings"
 	"testing"
 	"time"
 
 	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
 	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -61,7 +63,7 @@ func TestNewDebitTransaction(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			transaction, err := NewDebitTransaction(tt.fromAccountID, tt.amount, tt.description, tt.reference)
+			transaction, err := NewDebitTransaction(tt.fromAccountID, tt.amount, tt.description, tt.reference, time.Now())
 
 			if tt.expectError {
 				require.Error(t, err)
@@ -130,7 +132,7 @@ func TestNewCreditTransaction(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			transaction, err := NewCreditTransaction(tt.toAccountID, tt.amount, tt.description, tt.reference)
+			transaction, err := NewCreditTransaction(tt.toAccountID, tt.amount, tt.description, tt.reference, time.Now())
 
 			if tt.expectError {
 				require.Error(t, err)
@@ -224,7 +226,7 @@ func TestNewTransferTransaction(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			transaction, err := NewTransferTransaction(tt.fromAccountID, tt.toAccountID, tt.amount, tt.description, tt.reference)
+			transaction, err := NewTransferTransaction(tt.fromAccountID, tt.toAccountID, tt.amount, tt.description, tt.reference, time.Now())
 
 			if tt.expectError {
 				require.Error(t, err)
@@ -283,12 +285,15 @@ func TestTransaction_MarkAsCompleted(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			transaction, err := NewDebitTransaction(fromAccountID, amount, "Test", "REF")
+			createdAt := time.Date(2024, time.March, 1, 9, 0, 0, 0, time.UTC)
+			transaction, err := NewDebitTransaction(fromAccountID, amount, "Test", "REF", createdAt)
 			require.NoError(t, err)
+			assert.Equal(t, createdAt, transaction.CreatedAt)
 
 			transaction.Status = tt.initialStatus
 
-			err = transaction.MarkAsCompleted()
+			completedAt := createdAt.Add(time.Minute)
+			err = transaction.MarkAsCompleted(completedAt)
 
 			if tt.expectError {
 				require.Error(t, err)
@@ -298,8 +303,8 @@ func TestTransaction_MarkAsCompleted(t *testing.T) {
 			} else {
 				require.NoError(t, err)
 				assert.Equal(t, vo.TransactionStatusCompleted, transaction.Status)
-				assert.NotNil(t, transaction.CompletedAt)
-				assert.WithinDuration(t, time.Now(), *transaction.CompletedAt, time.Second)
+				require.NotNil(t, transaction.CompletedAt)
+				assert.Equal(t, completedAt, *transaction.CompletedAt)
 			}
 		})
 	}
@@ -333,7 +338,7 @@ func TestTransaction_MarkAsFailed(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			transaction, err := NewDebitTransaction(fromAccountID, amount, "Test", "REF")
+			transaction, err := NewDebitTransaction(fromAccountID, amount, "Test", "REF", time.Now())
 			require.NoError(t, err)
 
 			transaction.Status = tt.initialStatus
@@ -385,7 +390,7 @@ func TestTransaction_MarkAsCancelled(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			transaction, err := NewDebitTransaction(fromAccountID, amount, "Test", "REF")
+			transaction, err := NewDebitTransaction(fromAccountID, amount, "Test", "REF", time.Now())
 			require.NoError(t, err)
 
 			transaction.Status = tt.initialStatus
@@ -445,12 +450,12 @@ func TestTransaction_SetStatus(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			transaction, err := NewDebitTransaction(fromAccountID, amount, "Test", "REF")
+			transaction, err := NewDebitTransaction(fromAccountID, amount, "Test", "REF", time.Now())
 			require.NoError(t, err)
 
 			transaction.Status = tt.initialStatus
 
-			err = transaction.SetStatus(tt.targetStatus)
+			err = transaction.SetStatus(tt.targetStatus, time.Now())
 
 			if tt.expectError {
 				require.Error(t, err)
@@ -468,3 +473,411 @@ func TestTransaction_SetStatus(t *testing.T) {
 		})
 	}
 }
+
+func TestTransaction_BalanceEffect(t *testing.T) {
+	accountID := vo.NewAccountID()
+	otherAccountID := vo.NewAccountID()
+	amount := vo.NewMoneyFromFloat(100.0)
+
+	debit, err := NewDebitTransaction(accountID, amount, "Test", "REF", time.Now())
+	require.NoError(t, err)
+	credit, err := NewCreditTransaction(accountID, amount, "Test", "REF", time.Now())
+	require.NoError(t, err)
+	transfer, err := NewTransferTransaction(accountID, otherAccountID, amount, "Test", "REF", time.Now())
+	require.NoError(t, err)
+
+	tests := []struct {
+		name        string
+		transaction *Transaction
+		accountID   vo.AccountID
+		completed   bool
+		expected    float64
+	}{
+		{
+			name:        "Completed debit decreases balance",
+			transaction: debit,
+			accountID:   accountID,
+			completed:   true,
+			expected:    -100.0,
+		},
+		{
+			name:        "Completed credit increases balance",
+			transaction: credit,
+			accountID:   accountID,
+			completed:   true,
+			expected:    100.0,
+		},
+		{
+			name:        "Completed transfer debits source account",
+			transaction: transfer,
+			accountID:   accountID,
+			completed:   true,
+			expected:    -100.0,
+		},
+		{
+			name:        "Completed transfer credits destination account",
+			transaction: transfer,
+			accountID:   otherAccountID,
+			completed:   true,
+			expected:    100.0,
+		},
+		{
+			name:        "Pending transaction has no effect",
+			transaction: debit,
+			accountID:   accountID,
+			completed:   false,
+			expected:    0,
+		},
+		{
+			name:        "Unrelated account has no effect",
+			transaction: debit,
+			accountID:   otherAccountID,
+			completed:   true,
+			expected:    0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			transaction := *tt.transaction
+			if tt.completed {
+				transaction.Status = vo.TransactionStatusCompleted
+			}
+
+			effect := transaction.BalanceEffect(tt.accountID)
+
+			assert.True(t, vo.NewMoneyFromFloat(tt.expected).Equal(effect), "expected %v, got %s", tt.expected, effect)
+		})
+	}
+}
+
+func TestNewReversalTransaction(t *testing.T) {
+	accountID := vo.NewAccountID()
+	otherAccountID := vo.NewAccountID()
+
+	transfer, err := NewTransferTransaction(accountID, otherAccountID, vo.NewMoneyFromFloat(100.0), "Rent", "", time.Now())
+	require.NoError(t, err)
+	require.NoError(t, transfer.SetFee(vo.NewMoneyFromFloat(5.0)))
+
+	_, err = NewReversalTransaction(transfer, time.Now())
+	assert.ErrorIs(t, err, errs.ErrTransactionNotReversible, "pending transactions cannot be reversed")
+
+	require.NoError(t, transfer.MarkAsCompleted(time.Now()))
+	reversal, err := NewReversalTransaction(transfer, time.Now())
+	require.NoError(t, err)
+	assert.Equal(t, vo.TransactionTypeReversal, reversal.TransactionType)
+	assert.Equal(t, transfer.ID, *reversal.ReversedTransactionID)
+	assert.Equal(t, otherAccountID, *reversal.FromAccountID)
+	assert.Equal(t, accountID, *reversal.ToAccountID)
+	assert.True(t, vo.NewMoneyFromFloat(100.0).Equal(reversal.Amount))
+	assert.True(t, reversal.Fee.IsZero())
+	assert.Equal(t, "REVERSAL-"+transfer.ID.String(), reversal.Reference)
+
+	refund, err := NewFeeRefundTransaction(transfer, time.Now())
+	require.NoError(t, err)
+	assert.Equal(t, vo.TransactionTypeCredit, refund.TransactionType)
+	assert.Equal(t, accountID, *refund.ToAccountID)
+	assert.True(t, vo.NewMoneyFromFloat(5.0).Equal(refund.Amount))
+
+	debit, err := NewDebitTransaction(accountID, vo.NewMoneyFromFloat(40.0), "ATM", "", time.Now())
+	require.NoError(t, err)
+	require.NoError(t, debit.MarkAsCompleted(time.Now()))
+	reversal, err = NewReversalTransaction(debit, time.Now())
+	require.NoError(t, err)
+	assert.Equal(t, vo.TransactionTypeReversal, reversal.TransactionType)
+	assert.Nil(t, reversal.FromAccountID)
+	assert.Equal(t, accountID, *reversal.ToAccountID)
+
+	// A reversal is not reversed in turn
+	require.NoError(t, reversal.MarkAsCompleted(time.Now()))
+	_, err = NewReversalTransaction(reversal, time.Now())
+	assert.ErrorIs(t, err, errs.ErrTransactionNotReversible)
+
+	_, err = NewFeeRefundTransaction(debit, time.Now())
+	assert.IsType(t, errs.BusinessError{}, err, "no fee was charged")
+}
+
+func TestNewFXTransferTransaction(t *testing.T) {
+	dollarsID, bahtID := vo.NewAccountID(), vo.NewAccountID()
+	rate, err := vo.NewExchangeRate("USD", "THB", decimal.RequireFromString("35.5"), time.Now())
+	require.NoError(t, err)
+
+	_, err = NewFXTransferTransaction(dollarsID, bahtID, vo.NewMoneyFromInt(100).In("EUR"), rate, "Tuition", "", time.Now())
+	assert.ErrorIs(t, err, errs.ErrCurrencyMismatch)
+	_, err = NewFXTransferTransaction(dollarsID, dollarsID, vo.NewMoneyFromInt(100), rate, "Tuition", "", time.Now())
+	assert.ErrorIs(t, err, errs.ErrSameAccountTransfer)
+
+	transfer, err := NewFXTransferTransaction(dollarsID, bahtID, vo.NewMoneyFromInt(100), rate, "Tuition", "", time.Now())
+	require.NoError(t, err)
+	assert.Equal(t, vo.TransactionTypeFXTransfer, transfer.TransactionType)
+	assert.Equal(t, vo.Currency("USD"), transfer.Currency())
+	assert.Equal(t, rate, *transfer.ExchangeRate)
+	assert.Equal(t, "3550", transfer.CreditedAmount().String())
+	assert.Equal(t, vo.Currency("THB"), transfer.CreditedAmount().Currency())
+
+	// The source pays the amount and fee in its currency, the destination receives the converted amount
+	require.NoError(t, transfer.SetFee(vo.NewMoneyFromInt(1)))
+	require.NoError(t, transfer.MarkAsCompleted(time.Now()))
+	assert.Equal(t, "-101", transfer.BalanceEffect(dollarsID).String())
+	assert.Equal(t, "3550", transfer.BalanceEffect(bahtID).String())
+
+	entries := NewHistoryEntries(transfer)
+	require.Len(t, entries, 2)
+	assert.Equal(t, "100", entries[0].Amount.String())
+	assert.Equal(t, "3550", entries[1].Amount.String())
+
+	// The reversal sends the converted amount back and returns exactly what was sent
+	reversal, err := NewReversalTransaction(transfer, time.Now())
+	require.NoError(t, err)
+	assert.Equal(t, vo.TransactionTypeReversal, reversal.TransactionType)
+	assert.Equal(t, bahtID, *reversal.FromAccountID)
+	assert.Equal(t, "3550", reversal.Amount.String())
+	assert.Equal(t, vo.Currency("THB"), reversal.Currency())
+	assert.Equal(t, vo.Currency("USD"), reversal.ExchangeRate.To)
+	assert.True(t, reversal.CreditedAmount().Equal(transfer.Amount))
+}
+
+func TestTransaction_SetValueDate(t *testing.T) {
+	transaction, err := NewDebitTransaction(vo.NewAccountID(), vo.NewMoneyFromFloat(100.0), "Test", "", time.Now())
+	require.NoError(t, err)
+
+	// New transactions are booked on their creation date
+	assert.True(t, transaction.IsDueOn(time.Now()))
+
+	nextWeek := time.Now().AddDate(0, 0, 7)
+	require.NoError(t, transaction.SetValueDate(nextWeek))
+	assert.Equal(t, nextWeek.Format("2006-01-02"), transaction.ValueDate.Format("2006-01-02"))
+	assert.False(t, transaction.IsDueOn(time.Now()))
+	assert.True(t, transaction.IsDueOn(nextWeek))
+
+	// Cannot be booked before it was created
+	err = transaction.SetValueDate(time.Now().AddDate(0, 0, -1))
+	assert.IsType(t, errs.ValidationError{}, err)
+
+	// Cannot be rebooked once processed
+	require.NoError(t, transaction.MarkAsCompleted(time.Now()))
+	err = transaction.SetValueDate(nextWeek)
+	assert.IsType(t, errs.BusinessError{}, err)
+}
+
+func TestTransaction_Review(t *testing.T) {
+	newPending := func() *Transaction {
+		transaction, err := NewDebitTransaction(vo.NewAccountID(), vo.NewMoneyFromFloat(100.0), "Test", "", time.Now())
+		require.NoError(t, err)
+		return transaction
+	}
+
+	t.Run("approve", func(t *testing.T) {
+		transaction := newPending()
+		dueAt := time.Now().Add(time.Hour)
+
+		assert.ErrorIs(t, transaction.ApproveReview("alice"), errs.ErrTransactionNotInReview)
+		require.NoError(t, transaction.PlaceInReview("large amount", dueAt, time.Now()))
+		assert.Equal(t, vo.TransactionStatusReview, transaction.Status)
+		assert.Equal(t, "large amount", transaction.ReviewReason)
+		assert.False(t, transaction.IsReviewOverdue(time.Now()))
+		assert.True(t, transaction.IsReviewOverdue(dueAt.Add(time.Second)))
+
+		require.NoError(t, transaction.ApproveReview("alice"))
+		assert.Equal(t, "alice", transaction.ReviewedBy)
+		require.NoError(t, transaction.MarkAsCompleted(time.Now()))
+		assert.False(t, transaction.IsReviewOverdue(dueAt.Add(time.Second)))
+	})
+
+	t.Run("decline", func(t *testing.T) {
+		transaction := newPending()
+		require.NoError(t, transaction.PlaceInReview("large amount", time.Now(), time.Now()))
+
+		require.NoError(t, transaction.DeclineReview("bob", "unusual recipient"))
+		assert.Equal(t, vo.TransactionStatusFailed, transaction.Status)
+		assert.Equal(t, "bob", transaction.ReviewedBy)
+		assert.Equal(t, "unusual recipient", transaction.ReviewReason)
+		assert.ErrorIs(t, transaction.DeclineReview("bob", ""), errs.ErrTransactionNotInReview)
+	})
+
+	t.Run("only pending transactions", func(t *testing.T) {
+		transaction := newPending()
+		require.NoError(t, transaction.MarkAsCompleted(time.Now()))
+
+		err := transaction.PlaceInReview("large amount", time.Now(), time.Now())
+		assert.IsType(t, errs.BusinessError{}, err)
+	})
+}
+
+func TestNewAdjustmentTransaction(t *testing.T) {
+	accountID := vo.NewAccountID()
+	amount := vo.NewMoneyFromFloat(25)
+
+	increase, err := NewAdjustmentTransaction(accountID, vo.AdjustmentDirectionIncrease, amount, vo.AdjustmentReasonFeeCorrection, " Fee charged twice ", " alice ", time.Now())
+	require.NoError(t, err)
+	assert.Equal(t, vo.TransactionTypeAdjustment, increase.TransactionType)
+	assert.Equal(t, vo.TransactionStatusPending, increase.Status)
+	assert.Equal(t, &accountID, increase.ToAccountID)
+	assert.Nil(t, increase.FromAccountID)
+	assert.Equal(t, "Fee charged twice", increase.Description)
+	assert.Equal(t, vo.AdjustmentReasonFeeCorrection, increase.AdjustmentReason)
+	assert.Equal(t, "alice", increase.RequestedBy)
+
+	decrease, err := NewAdjustmentTransaction(accountID, vo.AdjustmentDirectionDecrease, amount, vo.AdjustmentReasonLegalOrder, "", "alice", time.Now())
+	require.NoError(t, err)
+	assert.Equal(t, &accountID, decrease.FromAccountID)
+	assert.Nil(t, decrease.ToAccountID)
+
+	tests := []struct {
+		name        string
+		direction   vo.AdjustmentDirection
+		amount      vo.Money
+		reason      vo.AdjustmentReason
+		requestedBy string
+	}{
+		{"invalid direction", "SIDEWAYS", amount, vo.AdjustmentReasonGoodwill, "alice"},
+		{"missing reason code", vo.AdjustmentDirectionIncrease, amount, "", "alice"},
+		{"invalid reason code", vo.AdjustmentDirectionIncrease, amount, "BECAUSE", "alice"},
+		{"missing requester", vo.AdjustmentDirectionIncrease, amount, vo.AdjustmentReasonGoodwill, " "},
+		{"zero amount", vo.AdjustmentDirectionIncrease, vo.ZeroMoney(), vo.AdjustmentReasonGoodwill, "alice"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewAdjustmentTransaction(accountID, tt.direction, tt.amount, tt.reason, "", tt.requestedBy, time.Now())
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestTransaction_ApproveReview_Adjustment(t *testing.T) {
+	adjustment, err := NewAdjustmentTransaction(vo.NewAccountID(), vo.AdjustmentDirectionIncrease, vo.NewMoneyFromFloat(25), vo.AdjustmentReasonGoodwill, "", "alice", time.Now())
+	require.NoError(t, err)
+	require.NoError(t, adjustment.PlaceInReview("adjustment", time.Now().Add(time.Hour), time.Now()))
+
+	// The admin who posted it cannot approve it, however the name is written
+	assert.ErrorIs(t, adjustment.ApproveReview("Alice "), errs.ErrSelfApproval)
+	assert.Empty(t, adjustment.ReviewedBy)
+
+	require.NoError(t, adjustment.ApproveReview("bob"))
+	assert.Equal(t, "bob", adjustment.ReviewedBy)
+}
+
+func TestTransaction_SetChannel(t *testing.T) {
+	transaction, err := NewCreditTransaction(vo.NewAccountID(), vo.NewMoneyFromFloat(100), "Cash deposit", "", time.Now())
+	require.NoError(t, err)
+	assert.Equal(t, vo.TransactionChannelAPI, transaction.Channel)
+
+	require.NoError(t, transaction.SetChannel(vo.TransactionChannelBranch))
+	assert.Equal(t, vo.TransactionChannelBranch, transaction.Channel)
+
+	err = transaction.SetChannel(vo.TransactionChannel("FAX"))
+	assert.IsType(t, errs.ValidationError{}, err)
+	assert.Equal(t, vo.TransactionChannelBranch, transaction.Channel)
+}
+
+func TestTransaction_SetReferenceType(t *testing.T) {
+	transaction, err := NewCreditTransaction(vo.NewAccountID(), vo.NewMoneyFromFloat(100), "Invoice 7034", "rf18 5390 0754 7034", time.Now())
+	require.NoError(t, err)
+	assert.Equal(t, vo.ReferenceTypeFree, transaction.ReferenceType)
+
+	require.NoError(t, transaction.SetReferenceType(vo.ReferenceTypeRF))
+	assert.Equal(t, vo.ReferenceTypeRF, transaction.ReferenceType)
+	assert.Equal(t, "RF18539007547034", transaction.Reference)
+
+	invalid, err := NewCreditTransaction(vo.NewAccountID(), vo.NewMoneyFromFloat(100), "Invoice 7034", "RF19539007547034", time.Now())
+	require.NoError(t, err)
+	assert.ErrorIs(t, invalid.SetReferenceType(vo.ReferenceTypeRF), errs.ErrInvalidReference)
+	assert.Equal(t, vo.ReferenceTypeFree, invalid.ReferenceType)
+
+	err = invalid.SetReferenceType(vo.ReferenceType("IBAN"))
+	assert.IsType(t, errs.ValidationError{}, err)
+}
+
+func TestTransaction_SetFee(t *testing.T) {
+	accountID := vo.NewAccountID()
+	transfer, err := NewTransferTransaction(accountID, vo.NewAccountID(), vo.NewMoneyFromFloat(100), "Rent", "", time.Now())
+	require.NoError(t, err)
+
+	require.NoError(t, transfer.SetFee(vo.NewMoneyFromFloat(2.5)))
+	assert.True(t, transfer.TotalDebit().Equal(vo.NewMoneyFromFloat(102.5)))
+
+	transfer.Status = vo.TransactionStatusCompleted
+	assert.True(t, transfer.BalanceEffect(accountID).Equal(vo.NewMoneyFromFloat(-102.5)))
+	assert.True(t, transfer.BalanceEffect(*transfer.ToAccountID).Equal(vo.NewMoneyFromFloat(100)))
+	assert.ErrorIs(t, transfer.SetFee(vo.ZeroMoney()), errs.ErrInvalidTransactionStatus)
+
+	credit, err := NewCreditTransaction(accountID, vo.NewMoneyFromFloat(100), "Cash deposit", "", time.Now())
+	require.NoError(t, err)
+	assert.IsType(t, errs.ValidationError{}, credit.SetFee(vo.NewMoneyFromFloat(1)))
+	assert.IsType(t, errs.ValidationError{}, transfer.SetFee(vo.NewMoneyFromFloat(-1)))
+}
+
+func TestTransaction_ReviewAge(t *testing.T) {
+	transaction, err := NewDebitTransaction(vo.NewAccountID(), vo.NewMoneyFromFloat(100), "Test", "", time.Now())
+	require.NoError(t, err)
+	assert.Zero(t, transaction.ReviewAge(time.Now()))
+
+	require.NoError(t, transaction.PlaceInReview("large amount", time.Now().Add(time.Hour), time.Now()))
+	require.NotNil(t, transaction.ReviewHeldAt)
+	assert.InDelta(t, time.Hour.Seconds(), transaction.ReviewAge(transaction.ReviewHeldAt.Add(time.Hour)).Seconds(), 0.001)
+
+	// Reviews held before the hold time was recorded count from creation
+	transaction.ReviewHeldAt = nil
+	assert.Equal(t, 2*time.Hour, transaction.ReviewAge(transaction.CreatedAt.Add(2*time.Hour)))
+}
+
+func TestTransaction_Replay(t *testing.T) {
+	newPending := func() *Transaction {
+		transaction, err := NewDebitTransaction(vo.NewAccountID(), vo.NewMoneyFromFloat(100), "Test", "", time.Now())
+		require.NoError(t, err)
+		return transaction
+	}
+
+	t.Run("infrastructure failure", func(t *testing.T) {
+		transaction := newPending()
+		assert.ErrorIs(t, transaction.PrepareReplay(), errs.ErrTransactionNotReplayable)
+
+		require.NoError(t, transaction.Fail(vo.FailureKindInfrastructure, "connection reset"))
+		assert.Equal(t, vo.TransactionStatusFailed, transaction.Status)
+		assert.Equal(t, "connection reset", transaction.FailureReason)
+
+		require.NoError(t, transaction.PrepareReplay())
+		assert.Equal(t, vo.TransactionStatusPending, transaction.Status)
+		assert.Empty(t, transaction.FailureKind)
+		assert.Empty(t, transaction.FailureReason)
+		assert.Equal(t, 1, transaction.ReplayCount)
+		assert.ErrorIs(t, transaction.PrepareReplay(), errs.ErrTransactionNotReplayable)
+	})
+
+	t.Run("business failure is final", func(t *testing.T) {
+		transaction := newPending()
+		require.NoError(t, transaction.Fail(vo.FailureKindBusiness, "insufficient balance"))
+
+		assert.ErrorIs(t, transaction.PrepareReplay(), errs.ErrTransactionNotReplayable)
+		assert.Equal(t, vo.TransactionStatusFailed, transaction.Status)
+	})
+
+	t.Run("long reasons are truncated", func(t *testing.T) {
+		transaction := newPending()
+		require.NoError(t, transaction.Fail(vo.FailureKindInfrastructure, strings.Repeat("x", 600)))
+		assert.Len(t, transaction.FailureReason, maxFailureReasonLength)
+	})
+}
+
+func TestNewHistoryEntries(t *testing.T) {
+	fromID, toID := vo.NewAccountID(), vo.NewAccountID()
+	transfer, err := NewTransferTransaction(fromID, toID, vo.NewMoneyFromFloat(100), "Rent", "REF-1", time.Now())
+	require.NoError(t, err)
+	require.NoError(t, transfer.SetFee(vo.NewMoneyFromFloat(5)))
+
+	entries := NewHistoryEntries(transfer)
+	require.Len(t, entries, 2)
+	assert.Equal(t, HistoryDirectionOut, entries[0].Direction)
+	assert.Equal(t, fromID, entries[0].AccountID)
+	assert.Equal(t, toID, *entries[0].CounterpartyID)
+	assert.True(t, entries[0].Fee.Equal(vo.NewMoneyFromFloat(5)))
+	assert.True(t, entries[0].Change.IsZero(), "pending transactions do not change the balance yet")
+	assert.Equal(t, HistoryDirectionIn, entries[1].Direction)
+	assert.True(t, entries[1].Fee.IsZero())
+
+	require.NoError(t, transfer.MarkAsCompleted(time.Now()))
+	entries = NewHistoryEntries(transfer)
+	assert.True(t, entries[0].Change.Equal(vo.NewMoneyFromFloat(-105)))
+	assert.True(t, entries[1].Change.Equal(vo.NewMoneyFromFloat(100)))
+	assert.NotNil(t, entries[1].CompletedAt)
+}
@@ -0,0 +1,108 @@
+package entity
+
+import (
+	"strings"
+	"time"
+
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+)
+
+// TransferTemplateReferencePrefix starts the default reference of template transfers, followed by the template ID
+const TransferTemplateReferencePrefix = "TEMPLATE-"
+
+// TransferTemplate is a saved transfer from an account that can be executed again in one call
+type TransferTemplate struct {
+	ID          string       `json:"id"`
+	AccountID   vo.AccountID `json:"account_id"` // The account paying
+	Name        string       `json:"name"`
+	ToAccountID vo.AccountID `json:"to_account_id"` // The beneficiary
+	Amount      vo.Money     `json:"amount"`
+	Description string       `json:"description"`
+	Reference   string       `json:"reference"`
+	UseCount    int          `json:"use_count"`
+	LastUsedAt  *time.Time   `json:"last_used_at,omitempty"`
+	CreatedAt   time.Time    `json:"created_at"`
+	UpdatedAt   time.Time    `json:"updated_at"`
+}
+
+// NewTransferTemplate creates a new transfer template of an account
+func NewTransferTemplate(accountID vo.AccountID, name string, toAccountID vo.AccountID, amount vo.Money, description, reference string) (*TransferTemplate, error) {
+	now := time.Now()
+
+	template := &TransferTemplate{
+		ID:        vo.NewID("TPL"),
+		AccountID: accountID,
+		CreatedAt: now,
+	}
+
+	if err := template.Update(name, toAccountID, amount, description, reference); err != nil {
+		return nil, err
+	}
+
+	return template, nil
+}
+
+// Update replaces the template's beneficiary, amount and memo
+func (t *TransferTemplate) Update(name string, toAccountID vo.AccountID, amount vo.Money, description, reference string) error {
+	name = strings.TrimSpace(name)
+	if name == "" || len(name) > 100 {
+		return errs.ValidationError{
+			Field:   "name",
+			Message: "template name must be 1 to 100 characters",
+		}
+	}
+
+	if toAccountID.IsEmpty() {
+		return errs.ValidationError{
+			Field:   "toAccountID",
+			Message: "beneficiary account is required",
+		}
+	}
+	if toAccountID == t.AccountID {
+		return errs.ErrSameAccountTransfer
+	}
+
+	if !amount.IsPositive() {
+		return errs.ErrInvalidTransactionAmount
+	}
+
+	description = strings.TrimSpace(description)
+	if len(description) > 500 {
+		return errs.ValidationError{
+			Field:   "description",
+			Message: "description must be at most 500 characters",
+		}
+	}
+
+	reference = strings.TrimSpace(reference)
+	if len(reference) > 100 {
+		return errs.ValidationError{
+			Field:   "reference",
+			Message: "reference must be at most 100 characters",
+		}
+	}
+
+	t.Name = name
+	t.ToAccountID = toAccountID
+	t.Amount = amount
+	t.Description = description
+	t.Reference = reference
+	t.UpdatedAt = time.Now()
+	return nil
+}
+
+// TransferReference is the reference of transfers made from the template
+func (t *TransferTemplate) TransferReference() string {
+	if t.Reference != "" {
+		return t.Reference
+	}
+	return TransferTemplateReferencePrefix + t.ID
+}
+
+// RecordUse counts a transfer made from the template
+func (t *TransferTemplate) RecordUse(at time.Time) {
+	t.UseCount++
+	t.LastUsedAt = &at
+	t.UpdatedAt = at
+}
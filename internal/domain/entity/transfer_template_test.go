@@ -0,0 +1,46 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewTransferTemplate(t *testing.T) {
+	from := vo.NewAccountID()
+	to := vo.NewAccountID()
+
+	template, err := NewTransferTemplate(from, "  Rent ", to, vo.NewMoneyFromFloat(500), "Monthly rent", "")
+	require.NoError(t, err)
+	assert.Equal(t, "Rent", template.Name)
+	assert.Equal(t, TransferTemplateReferencePrefix+template.ID, template.TransferReference())
+
+	require.NoError(t, template.Update("Rent", to, vo.NewMoneyFromFloat(500), "Monthly rent", "LEASE-42"))
+	assert.Equal(t, "LEASE-42", template.TransferReference())
+
+	_, err = NewTransferTemplate(from, "Rent", from, vo.NewMoneyFromFloat(500), "", "")
+	assert.ErrorIs(t, err, errs.ErrSameAccountTransfer)
+
+	_, err = NewTransferTemplate(from, "Rent", to, vo.NewMoneyFromFloat(0), "", "")
+	assert.ErrorIs(t, err, errs.ErrInvalidTransactionAmount)
+
+	_, err = NewTransferTemplate(from, " ", to, vo.NewMoneyFromFloat(500), "", "")
+	assert.IsType(t, errs.ValidationError{}, err)
+}
+
+func TestTransferTemplate_RecordUse(t *testing.T) {
+	template, err := NewTransferTemplate(vo.NewAccountID(), "Rent", vo.NewAccountID(), vo.NewMoneyFromFloat(500), "", "")
+	require.NoError(t, err)
+
+	usedAt := time.Now()
+	template.RecordUse(usedAt)
+	template.RecordUse(usedAt)
+
+	assert.Equal(t, 2, template.UseCount)
+	require.NotNil(t, template.LastUsedAt)
+	assert.Equal(t, usedAt, *template.LastUsedAt)
+}
@@ -0,0 +1,98 @@
+package entity
+
+import (
+	"crypto/rand"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+)
+
+// VirtualAccountStatus is the state of a virtual account number
+type VirtualAccountStatus string
+
+const (
+	VirtualAccountStatusActive VirtualAccountStatus = "ACTIVE"
+	VirtualAccountStatusClosed VirtualAccountStatus = "CLOSED" // Credits to the number are rejected
+)
+
+// IsValid checks if the status is known
+func (s VirtualAccountStatus) IsValid() bool {
+	return s == VirtualAccountStatusActive || s == VirtualAccountStatusClosed
+}
+
+// VirtualAccount is an account number handed out to payers that credits into a physical
+// settlement account. Credits keep the virtual number so they can be reconciled per payer.
+type VirtualAccount struct {
+	ID        string               `json:"id"` // Format: VA + YYYYMMDD + 8 digits
+	AccountID vo.AccountID         `json:"account_id"`
+	Label     string               `json:"label"`
+	Status    VirtualAccountStatus `json:"status"`
+	CreatedAt time.Time            `json:"created_at"`
+	UpdatedAt time.Time            `json:"updated_at"`
+}
+
+// NewVirtualAccount issues a new active virtual account number for a settlement account
+func NewVirtualAccount(accountID vo.AccountID, label string) (*VirtualAccount, error) {
+	if accountID.IsEmpty() {
+		return nil, errs.ValidationError{
+			Field:   "accountID",
+			Message: "account ID is required",
+		}
+	}
+
+	now := time.Now()
+
+	// Generate 8-digit random sequence, as account IDs do
+	n, _ := rand.Int(rand.Reader, big.NewInt(99999999))
+
+	virtualAccount := &VirtualAccount{
+		ID:        fmt.Sprintf("VA%s%08d", now.Format("20060102"), n.Int64()),
+		AccountID: accountID,
+		Status:    VirtualAccountStatusActive,
+		CreatedAt: now,
+	}
+
+	if err := virtualAccount.Rename(label); err != nil {
+		return nil, err
+	}
+
+	return virtualAccount, nil
+}
+
+// Rename changes the label used to recognise the payer of the virtual account
+func (v *VirtualAccount) Rename(label string) error {
+	label = strings.TrimSpace(label)
+	if len(label) > 100 {
+		return errs.ValidationError{
+			Field:   "label",
+			Message: "label must be at most 100 characters",
+		}
+	}
+
+	v.Label = label
+	v.UpdatedAt = time.Now()
+	return nil
+}
+
+// SetStatus closes or reopens the virtual account
+func (v *VirtualAccount) SetStatus(status VirtualAccountStatus) error {
+	if !status.IsValid() {
+		return errs.ValidationError{
+			Field:   "status",
+			Message: "invalid virtual account status: " + string(status),
+		}
+	}
+
+	v.Status = status
+	v.UpdatedAt = time.Now()
+	return nil
+}
+
+// CanReceive checks if credits to the virtual account are accepted
+func (v *VirtualAccount) CanReceive() bool {
+	return v.Status == VirtualAccountStatusActive
+}
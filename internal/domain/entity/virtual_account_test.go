@@ -0,0 +1,43 @@
+package entity
+
+import (
+	"strings"
+	"testing"
+
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewVirtualAccount(t *testing.T) {
+	accountID := vo.NewAccountID()
+
+	virtualAccount, err := NewVirtualAccount(accountID, "  Tenant 101 ")
+	require.NoError(t, err)
+	assert.Len(t, virtualAccount.ID, 18)
+	assert.True(t, strings.HasPrefix(virtualAccount.ID, "VA"))
+	assert.Equal(t, accountID, virtualAccount.AccountID)
+	assert.Equal(t, "Tenant 101", virtualAccount.Label)
+	assert.True(t, virtualAccount.CanReceive())
+
+	var validationErr errs.ValidationError
+	_, err = NewVirtualAccount(vo.AccountID{}, "")
+	assert.ErrorAs(t, err, &validationErr)
+	_, err = NewVirtualAccount(accountID, strings.Repeat("L", 101))
+	assert.ErrorAs(t, err, &validationErr)
+}
+
+func TestVirtualAccount_SetStatus(t *testing.T) {
+	virtualAccount, err := NewVirtualAccount(vo.NewAccountID(), "")
+	require.NoError(t, err)
+
+	require.NoError(t, virtualAccount.SetStatus(VirtualAccountStatusClosed))
+	assert.False(t, virtualAccount.CanReceive())
+
+	require.NoError(t, virtualAccount.SetStatus(VirtualAccountStatusActive))
+	assert.True(t, virtualAccount.CanReceive())
+
+	var validationErr errs.ValidationError
+	assert.ErrorAs(t, virtualAccount.SetStatus("FROZEN"), &validationErr)
+}
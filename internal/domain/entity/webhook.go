@@ -0,0 +1,198 @@
+package entity
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"time"
+
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+)
+
+// WebhookEvent is an account event a webhook subscription can receive
+type WebhookEvent string
+
+const (
+	// WebhookEventBalanceBelowThreshold fires when a payment takes the balance below the subscription's threshold
+	WebhookEventBalanceBelowThreshold WebhookEvent = "balance.below_threshold"
+	// WebhookEventCreditReceived fires when money is credited to the account
+	WebhookEventCreditReceived WebhookEvent = "credit.received"
+	// WebhookEventTest is sent by test deliveries only
+	WebhookEventTest WebhookEvent = "webhook.test"
+)
+
+// IsValid checks if the event can be subscribed to
+func (e WebhookEvent) IsValid() bool {
+	switch e {
+	case WebhookEventBalanceBelowThreshold, WebhookEventCreditReceived:
+		return true
+	default:
+		return false
+	}
+}
+
+// WebhookStatus is the delivery state of a webhook subscription
+type WebhookStatus string
+
+const (
+	WebhookStatusActive   WebhookStatus = "ACTIVE"
+	WebhookStatusDisabled WebhookStatus = "DISABLED" // Too many consecutive failed deliveries
+)
+
+// WebhookSubscription delivers one account's events to a URL, signed with its own secret
+type WebhookSubscription struct {
+	ID                  string         `json:"id"`
+	AccountID           vo.AccountID   `json:"account_id"`
+	URL                 string         `json:"url"`
+	Secret              string         `json:"-"`
+	Events              []WebhookEvent `json:"events"`
+	Threshold           *vo.Money      `json:"threshold,omitempty"` // Required for balance.below_threshold
+	Status              WebhookStatus  `json:"status"`
+	ConsecutiveFailures int            `json:"consecutive_failures"`
+	LastError           string         `json:"last_error,omitempty"`
+	LastDeliveryAt      *time.Time     `json:"last_delivery_at,omitempty"`
+	DisabledAt          *time.Time     `json:"disabled_at,omitempty"`
+	CreatedAt           time.Time      `json:"created_at"`
+	UpdatedAt           time.Time      `json:"updated_at"`
+}
+
+// NewWebhookSubscription creates an active subscription with a freshly generated secret
+func NewWebhookSubscription(accountID vo.AccountID, target string, events []WebhookEvent, threshold *vo.Money) (*WebhookSubscription, error) {
+	if accountID.IsEmpty() {
+		return nil, errs.ValidationError{
+			Field:   "accountID",
+			Message: "account ID is required",
+		}
+	}
+
+	if !isWebhookURL(target) {
+		return nil, errs.ValidationError{
+			Field:   "url",
+			Message: "url must be an absolute http or https URL",
+		}
+	}
+
+	if len(events) == 0 {
+		return nil, errs.ValidationError{
+			Field:   "events",
+			Message: "at least one event is required",
+		}
+	}
+
+	unique := make([]WebhookEvent, 0, len(events))
+	seen := make(map[WebhookEvent]bool, len(events))
+	for _, evt := range events {
+		if !evt.IsValid() {
+			return nil, errs.ValidationError{
+				Field:   "events",
+				Message: fmt.Sprintf("unsupported event %q", evt),
+			}
+		}
+		if !seen[evt] {
+			seen[evt] = true
+			unique = append(unique, evt)
+		}
+	}
+
+	if seen[WebhookEventBalanceBelowThreshold] {
+		if threshold == nil || threshold.IsNegative() {
+			return nil, errs.ValidationError{
+				Field:   "threshold",
+				Message: "a non-negative threshold is required for balance.below_threshold",
+			}
+		}
+	} else {
+		threshold = nil
+	}
+
+	secret, err := newWebhookSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+
+	return &WebhookSubscription{
+		ID:        vo.NewID("WHK"),
+		AccountID: accountID,
+		URL:       target,
+		Secret:    secret,
+		Events:    unique,
+		Threshold: threshold,
+		Status:    WebhookStatusActive,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}, nil
+}
+
+// Subscribes checks if the subscription is active and receives the event
+func (w *WebhookSubscription) Subscribes(evt WebhookEvent) bool {
+	if w.Status != WebhookStatusActive {
+		return false
+	}
+	for _, subscribed := range w.Events {
+		if subscribed == evt {
+			return true
+		}
+	}
+	return false
+}
+
+// CrossedBelowThreshold checks if a balance change from before to after went below the threshold
+func (w *WebhookSubscription) CrossedBelowThreshold(before, after vo.Money) bool {
+	if w.Threshold == nil {
+		return false
+	}
+	return after.LessThan(*w.Threshold) && !before.LessThan(*w.Threshold)
+}
+
+// RecordSuccess resets the failure count after a delivered event
+func (w *WebhookSubscription) RecordSuccess() {
+	now := time.Now()
+	w.ConsecutiveFailures = 0
+	w.LastError = ""
+	w.LastDeliveryAt = &now
+	w.UpdatedAt = now
+}
+
+// RecordFailure counts a failed delivery and disables the subscription once
+// maxFailures consecutive deliveries have failed; it reports whether it disabled it
+func (w *WebhookSubscription) RecordFailure(reason string, maxFailures int) bool {
+	now := time.Now()
+	w.ConsecutiveFailures++
+	w.LastError = reason
+	w.LastDeliveryAt = &now
+	w.UpdatedAt = now
+
+	if w.Status == WebhookStatusActive && w.ConsecutiveFailures >= maxFailures {
+		w.Status = WebhookStatusDisabled
+		w.DisabledAt = &now
+		return true
+	}
+	return false
+}
+
+// Enable re-activates a disabled subscription with a clean failure count
+func (w *WebhookSubscription) Enable() {
+	w.Status = WebhookStatusActive
+	w.ConsecutiveFailures = 0
+	w.DisabledAt = nil
+	w.UpdatedAt = time.Now()
+}
+
+// isWebhookURL checks if target is an absolute http or https URL payloads can be posted to
+func isWebhookURL(target string) bool {
+	parsed, err := url.Parse(target)
+	return err == nil && (parsed.Scheme == "http" || parsed.Scheme == "https") && parsed.Host != ""
+}
+
+// newWebhookSecret generates the HMAC signing secret of a subscription
+func newWebhookSecret() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("failed to generate webhook secret: %w", err)
+	}
+	return "whsec_" + hex.EncodeToString(buf), nil
+}
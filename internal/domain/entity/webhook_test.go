@@ -0,0 +1,100 @@
+package entity
+
+import (
+	"strings"
+	"testing"
+
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewWebhookSubscription(t *testing.T) {
+	accountID := vo.NewAccountID()
+	threshold := vo.NewMoneyFromFloat(100)
+
+	tests := []struct {
+		name      string
+		url       string
+		events    []WebhookEvent
+		threshold *vo.Money
+		wantField string
+	}{
+		{name: "credit only", url: "https://example.com/hook", events: []WebhookEvent{WebhookEventCreditReceived}},
+		{name: "balance with threshold", url: "http://example.com/hook", events: []WebhookEvent{WebhookEventBalanceBelowThreshold}, threshold: &threshold},
+		{name: "relative url", url: "/hook", events: []WebhookEvent{WebhookEventCreditReceived}, wantField: "url"},
+		{name: "unsupported scheme", url: "ftp://example.com", events: []WebhookEvent{WebhookEventCreditReceived}, wantField: "url"},
+		{name: "no events", url: "https://example.com/hook", wantField: "events"},
+		{name: "test event", url: "https://example.com/hook", events: []WebhookEvent{WebhookEventTest}, wantField: "events"},
+		{name: "balance without threshold", url: "https://example.com/hook", events: []WebhookEvent{WebhookEventBalanceBelowThreshold}, wantField: "threshold"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			webhook, err := NewWebhookSubscription(accountID, tt.url, tt.events, tt.threshold)
+
+			if tt.wantField != "" {
+				var validationErr errs.ValidationError
+				require.ErrorAs(t, err, &validationErr)
+				assert.Equal(t, tt.wantField, validationErr.Field)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.True(t, strings.HasPrefix(webhook.ID, "WHK"))
+			assert.True(t, strings.HasPrefix(webhook.Secret, "whsec_"))
+			assert.Equal(t, WebhookStatusActive, webhook.Status)
+			assert.Equal(t, tt.threshold, webhook.Threshold)
+		})
+	}
+}
+
+func TestWebhookSubscription_UniqueSecretsAndEvents(t *testing.T) {
+	threshold := vo.NewMoneyFromFloat(100)
+	events := []WebhookEvent{WebhookEventCreditReceived, WebhookEventCreditReceived}
+
+	first, err := NewWebhookSubscription(vo.NewAccountID(), "https://example.com", events, &threshold)
+	require.NoError(t, err)
+	second, err := NewWebhookSubscription(vo.NewAccountID(), "https://example.com", events, nil)
+	require.NoError(t, err)
+
+	assert.NotEqual(t, first.Secret, second.Secret)
+	assert.Equal(t, []WebhookEvent{WebhookEventCreditReceived}, first.Events)
+	assert.Nil(t, first.Threshold, "threshold only applies to balance.below_threshold")
+}
+
+func TestWebhookSubscription_CrossedBelowThreshold(t *testing.T) {
+	threshold := vo.NewMoneyFromFloat(100)
+	webhook, err := NewWebhookSubscription(vo.NewAccountID(), "https://example.com", []WebhookEvent{WebhookEventBalanceBelowThreshold}, &threshold)
+	require.NoError(t, err)
+
+	assert.True(t, webhook.CrossedBelowThreshold(vo.NewMoneyFromFloat(150), vo.NewMoneyFromFloat(99.99)))
+	assert.True(t, webhook.CrossedBelowThreshold(vo.NewMoneyFromFloat(100), vo.NewMoneyFromFloat(50)))
+	assert.False(t, webhook.CrossedBelowThreshold(vo.NewMoneyFromFloat(90), vo.NewMoneyFromFloat(50)), "already below")
+	assert.False(t, webhook.CrossedBelowThreshold(vo.NewMoneyFromFloat(200), vo.NewMoneyFromFloat(100)), "still at threshold")
+}
+
+func TestWebhookSubscription_FailuresDisable(t *testing.T) {
+	webhook, err := NewWebhookSubscription(vo.NewAccountID(), "https://example.com", []WebhookEvent{WebhookEventCreditReceived}, nil)
+	require.NoError(t, err)
+
+	assert.False(t, webhook.RecordFailure("timeout", 3))
+	assert.False(t, webhook.RecordFailure("timeout", 3))
+	webhook.RecordSuccess()
+	assert.Zero(t, webhook.ConsecutiveFailures)
+
+	assert.False(t, webhook.RecordFailure("timeout", 3))
+	assert.False(t, webhook.RecordFailure("timeout", 3))
+	assert.True(t, webhook.RecordFailure("status 500", 3))
+	assert.Equal(t, WebhookStatusDisabled, webhook.Status)
+	assert.Equal(t, "status 500", webhook.LastError)
+	assert.NotNil(t, webhook.DisabledAt)
+	assert.False(t, webhook.Subscribes(WebhookEventCreditReceived))
+
+	webhook.Enable()
+	assert.Equal(t, WebhookStatusActive, webhook.Status)
+	assert.Zero(t, webhook.ConsecutiveFailures)
+	assert.Nil(t, webhook.DisabledAt)
+	assert.True(t, webhook.Subscribes(WebhookEventCreditReceived))
+}
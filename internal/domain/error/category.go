@@ -0,0 +1,88 @@
+package errs
+
+import (
+	"context"
+	"errors"
+)
+
+var (
+	// ErrTransient marks a failure that may succeed when retried, e.g. a deadlock or a dropped connection.
+	// Infrastructure adapters wrap the errors they recognise as transient with it.
+	ErrTransient = errors.New("temporary failure, retry later")
+
+	// ErrSagaCompensationFailed marks a saga whose completed steps could not all be undone
+	ErrSagaCompensationFailed = errors.New("saga compensation failed")
+)
+
+// Category tells how a failed operation should be handled
+type Category string
+
+const (
+	CategoryBusiness       Category = "BUSINESS"       // Rejected by a business rule; retrying fails the same way
+	CategoryRetryable      Category = "RETRYABLE"      // Transient; retrying may succeed and nothing needs recording
+	CategoryInfrastructure Category = "INFRASTRUCTURE" // A dependency failed; needs attention before it is retried
+)
+
+// businessErrors are the sentinel errors that reject an operation on its merits
+var businessErrors = []error{
+	ErrInvalidTransactionAmount,
+	ErrMissingAccountID,
+	ErrSameAccountTransfer,
+	ErrUnsupportedType,
+	ErrChannelLimitExceeded,
+	ErrInvalidReference,
+	ErrProductLimitExceeded,
+	ErrAccountNotFound,
+	ErrInsufficientBalance,
+	ErrCurrencyMismatch,
+	ErrSameCurrencyFX,
+	ErrExchangeRateUnavailable,
+	ErrAccountCannotTransact,
+	ErrSpendingLimitExceeded,
+	ErrVelocityLimitExceeded,
+	ErrVirtualAccountClosed,
+	ErrPeriodClosed,
+	ErrTransactionBlocked,
+}
+
+// retryableErrors are the sentinel errors of failures that pass on their own
+var retryableErrors = []error{
+	ErrTransient,
+	ErrDatabaseReadOnly,
+	ErrTransactionAlreadyInProgress,
+	ErrStaleFencingToken,
+	context.DeadlineExceeded,
+}
+
+// Classify categorises an error. A failed saga compensation is always an infrastructure failure,
+// since money may have moved; unrecognised errors are infrastructure failures too.
+func Classify(err error) Category {
+	if errors.Is(err, ErrSagaCompensationFailed) {
+		return CategoryInfrastructure
+	}
+
+	var businessErr BusinessError
+	var validationErr ValidationError
+	if errors.As(err, &businessErr) || errors.As(err, &validationErr) || isAny(err, businessErrors) {
+		return CategoryBusiness
+	}
+
+	if isAny(err, retryableErrors) {
+		return CategoryRetryable
+	}
+	return CategoryInfrastructure
+}
+
+// IsRetryable checks if an operation that failed with err may succeed when retried
+func IsRetryable(err error) bool {
+	return Classify(err) == CategoryRetryable
+}
+
+func isAny(err error, targets []error) bool {
+	for _, target := range targets {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+	return false
+}
@@ -16,12 +16,151 @@ var (
 	ErrTransactionNotFound          = errors.New("transaction not found")
 	ErrTransactionCannotBeConfirmed = errors.New("transaction cannot be confirmed")
 	ErrTransactionCannotBeCancelled = errors.New("transaction cannot be cancelled")
+	ErrTransactionNotDue            = errors.New("transaction is not due before its value date")
+	ErrTransactionNotInReview       = errors.New("transaction is not held for review")
+	ErrChannelLimitExceeded         = errors.New("transaction exceeds the limit of its channel")
+	ErrInvalidReference             = errors.New("reference does not match its reference type")
+	ErrReviewClaimed                = errors.New("review is claimed by another admin")
+	ErrReviewNotClaimed             = errors.New("review is not claimed by this admin")
+	ErrSelfApproval                 = errors.New("a change must be approved by an admin other than the one who requested it")
+	ErrTransactionNotReplayable     = errors.New("transaction cannot be replayed")
+	ErrStaleFencingToken            = errors.New("transaction was taken over by a newer processing token")
+	ErrTransactionAlreadyApplied    = errors.New("transaction was already applied to the account")
+	ErrTransactionNotReversible     = errors.New("transaction cannot be reversed")
+	ErrTransactionAlreadyReversed   = errors.New("transaction was already reversed")
 
 	// Account Errors
 	ErrAccountNotFound       = errors.New("account not found")
 	ErrInsufficientBalance   = errors.New("insufficient balance")
 	ErrAccountAlreadyExists  = errors.New("account already exists")
 	ErrAccountCannotTransact = errors.New("account cannot perform transactions")
+	ErrAccountNotOpenAt      = errors.New("account did not exist at the requested time")
+	ErrAccountHasChildren    = errors.New("account has child accounts")
+	ErrAccountNotInGroup     = errors.New("account is not part of the parent account's group")
+	ErrSpendingLimitExceeded = errors.New("transaction exceeds the account's monthly spending limit")
+	ErrVelocityLimitExceeded = errors.New("transaction exceeds the account's velocity limits")
+	ErrOpeningBalanceUnknown = errors.New("account's opening balance was not recorded")
+	ErrBalanceChanged        = errors.New("account balance changed during recalculation")
+	ErrCurrencyMismatch      = errors.New("amounts in different currencies cannot be combined")
+	ErrSameCurrencyFX        = errors.New("FX transfers run between accounts held in different currencies")
+
+	// Exchange Rate Errors
+	ErrExchangeRateUnavailable = errors.New("no exchange rate is available between the currencies")
+
+	// Virtual Account Errors
+	ErrVirtualAccountNotFound = errors.New("virtual account not found")
+	ErrVirtualAccountClosed   = errors.New("virtual account is closed")
+
+	// Customer Errors
+	ErrCustomerNotFound = errors.New("customer not found")
+
+	// Ownership Transfer Errors
+	ErrOwnershipTransferNotFound   = errors.New("ownership transfer not found")
+	ErrOwnershipTransferNotPending = errors.New("ownership transfer is not awaiting a decision")
+	ErrOwnershipTransferOpen       = errors.New("account already has an ownership transfer in progress")
+
+	// Transaction Block Errors
+	ErrTransactionBlocked        = errors.New("transactions of this type are blocked on the account")
+	ErrTransactionBlockNotFound  = errors.New("transaction block not found")
+	ErrTransactionBlockNotActive = errors.New("transaction block has expired or was lifted")
+
+	// Webhook Errors
+	ErrWebhookNotFound = errors.New("webhook subscription not found")
+
+	// Backup Errors
+	ErrBackupNotFound = errors.New("backup not found")
+
+	// Export Errors
+	ErrExportNotFound      = errors.New("export not found")
+	ErrExportNotReady      = errors.New("export is not ready for download")
+	ErrExportExpired       = errors.New("export has expired")
+	ErrDownloadLinkInvalid = errors.New("download link is invalid or has expired")
+
+	// Job Errors
+	ErrJobNotFound     = errors.New("job not found")
+	ErrJobNotRetryable = errors.New("only failed jobs can be retried")
+	ErrJobLeaseLost    = errors.New("job lease was lost to another worker")
+
+	// Calendar Errors
+	ErrHolidayNotFound      = errors.New("holiday not found")
+	ErrHolidayAlreadyExists = errors.New("holiday already exists")
+
+	// Category Errors
+	ErrCategoryNotFound      = errors.New("category not found")
+	ErrCategoryAlreadyExists = errors.New("category already exists")
+	ErrCategoryInUse         = errors.New("category has subcategories or rules")
+	ErrCategoryRuleNotFound  = errors.New("category rule not found")
+
+	// Business Rule Errors
+	ErrBusinessRuleNotFound = errors.New("business rule not found")
+	ErrBusinessRuleFailed   = errors.New("business rule could not be evaluated")
+
+	// Product Errors
+	ErrProductNotFound      = errors.New("product not found")
+	ErrProductRetired       = errors.New("product is retired")
+	ErrProductLimitExceeded = errors.New("transaction exceeds the account's product limit")
+
+	// Product Migration Errors
+	ErrProductMigrationNotFound = errors.New("product migration not found")
+
+	// Cashback Errors
+	ErrCashbackCampaignNotFound = errors.New("cashback campaign not found")
+
+	// Referral Errors
+	ErrReferralCodeNotFound    = errors.New("referral code not found")
+	ErrReferralNotFound        = errors.New("referral not found")
+	ErrReferralAlreadyRedeemed = errors.New("account or customer has already redeemed a referral code")
+	ErrReferralAlreadyRewarded = errors.New("referral has already been rewarded")
+	ErrReferralLimitExceeded   = errors.New("referral code has reached its daily redemption limit")
+	ErrSelfReferral            = errors.New("accounts cannot refer themselves or their customer's accounts")
+
+	// Transfer Template Errors
+	ErrTransferTemplateNotFound = errors.New("transfer template not found")
+
+	// Scheduled Transaction Errors
+	ErrScheduledTransactionNotFound  = errors.New("scheduled transaction not found")
+	ErrScheduledTransactionCompleted = errors.New("scheduled transaction has completed")
+
+	// Saved Filter Errors
+	ErrSavedFilterNotFound      = errors.New("saved filter not found")
+	ErrSavedFilterAlreadyExists = errors.New("a saved filter with this name already exists")
+	ErrSavedFilterLimitExceeded = errors.New("account has reached its saved filter limit")
+
+	// Attachment Errors
+	ErrAttachmentNotFound       = errors.New("attachment not found")
+	ErrAttachmentTooLarge       = errors.New("attachment is too large")
+	ErrAttachmentTypeNotAllowed = errors.New("attachment type is not allowed")
+	ErrAttachmentLimitExceeded  = errors.New("transaction has reached its attachment limit")
+
+	// Notification Template Errors
+	ErrNotificationTemplateNotFound = errors.New("notification template not found")
+	ErrNotificationTemplateConflict = errors.New("notification template was changed concurrently")
+
+	// Accounting Period Errors
+	ErrPeriodNotClosed     = errors.New("accounting period is not closed")
+	ErrPeriodClosed        = errors.New("accounting period is closed")
+	ErrPeriodAlreadyClosed = errors.New("accounting period is already closed")
+
+	// Notification Preference Errors
+	ErrNotificationPreferenceNotFound = errors.New("notification preferences not found")
+
+	// Budget Errors
+	ErrBudgetNotFound      = errors.New("budget not found")
+	ErrBudgetAlreadyExists = errors.New("budget already exists for this category")
+
+	// Admin User Errors
+	ErrAdminUserNotFound = errors.New("admin user not found")
+	ErrAdminEmailTaken   = errors.New("an admin user with this email already exists")
+	ErrActorMismatch     = errors.New("the request names an admin other than the caller")
+
+	// Saga Errors
+	ErrSagaNotFound = errors.New("saga not found")
+
+	// Database Errors
+	ErrDatabaseReadOnly = errors.New("database is read-only")
+
+	// Capacity Errors
+	ErrServiceOverloaded = errors.New("too many requests in flight")
 
 	// General Errors
 	ErrInvalidInput  = errors.New("invalid input")
@@ -0,0 +1,10 @@
+package event
+
+import "time"
+
+// AuditEntry is a domain event kept in the audit log
+type AuditEntry struct {
+	Sequence   uint64 // Monotonic audit log position; exports are ordered by it
+	Event      Event
+	RecordedAt time.Time
+}
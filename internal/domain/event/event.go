@@ -0,0 +1,435 @@
+package event
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+)
+
+// Type identifies the kind of domain event
+type Type string
+
+const (
+	AccountCreated       Type = "account.created"
+	AccountUpdated       Type = "account.updated"
+	AccountDeleted       Type = "account.deleted"
+	AccountStatusChanged Type = "account.status_changed"
+
+	OwnershipTransferRequested Type = "account.ownership_transfer_requested"
+	OwnershipTransferApproved  Type = "account.ownership_transfer_approved"
+	OwnershipTransferRejected  Type = "account.ownership_transfer_rejected"
+	OwnershipTransferred       Type = "account.ownership_transferred"
+
+	AccountProductMigrated Type = "account.product_migrated"
+
+	TransactionBlockPlaced Type = "account.transaction_block_placed"
+	TransactionBlockLifted Type = "account.transaction_block_lifted"
+
+	TransactionCreated   Type = "transaction.created"
+	TransactionCompleted Type = "transaction.completed"
+	TransactionFailed    Type = "transaction.failed"
+	TransactionCancelled Type = "transaction.cancelled"
+	TransactionInReview  Type = "transaction.in_review"
+
+	BudgetThresholdReached Type = "budget.threshold_reached"
+
+	LimitThresholdReached Type = "limit.threshold_reached"
+
+	ProductMigrationScheduled Type = "product.migration_scheduled"
+	ProductMigrationCompleted Type = "product.migration_completed"
+
+	CacheInvalidated Type = "cache.invalidated"
+
+	AdminUserCreated     Type = "admin.user_created"
+	AdminUserChanged     Type = "admin.user_changed" // Roles or status changed
+	AdminUserKeyRotated  Type = "admin.user_key_rotated"
+	AdminAnomalyDetected Type = "admin.anomaly_detected" // An admin request looked unlike the admin's usual activity
+)
+
+// Event is a serializable domain event delivered through the event bus
+type Event struct {
+	ID         string          `json:"id"`
+	Type       Type            `json:"type"`
+	Key        string          `json:"key"` // Ordering/partition key: the primary account affected
+	OccurredAt time.Time       `json:"occurred_at"`
+	Data       json.RawMessage `json:"data"`
+}
+
+// AccountPayload is the event data for account events
+type AccountPayload struct {
+	AccountID   string `json:"account_id"`
+	AccountName string `json:"account_name"`
+	Balance     string `json:"balance"`
+	Currency    string `json:"currency"`
+	Status      string `json:"status"`
+}
+
+// OwnershipTransferPayload is the event data for account ownership transfer events
+type OwnershipTransferPayload struct {
+	TransferID     string `json:"transfer_id"`
+	AccountID      string `json:"account_id"`
+	FromCustomerID string `json:"from_customer_id"`
+	ToCustomerID   string `json:"to_customer_id"`
+	ReasonCode     string `json:"reason_code"`
+	EffectiveDate  string `json:"effective_date"` // YYYY-MM-DD
+	Status         string `json:"status"`
+	RequestedBy    string `json:"requested_by"`
+	DecidedBy      string `json:"decided_by,omitempty"`
+	DecisionReason string `json:"decision_reason,omitempty"`
+}
+
+// ProductMigrationPayload is the event data for product migration events
+type ProductMigrationPayload struct {
+	MigrationID   string `json:"migration_id"`
+	FromProductID string `json:"from_product_id"`
+	ToProductID   string `json:"to_product_id"`
+	EffectiveDate string `json:"effective_date"` // YYYY-MM-DD
+	Status        string `json:"status"`
+	RequestedBy   string `json:"requested_by"`
+	Accounts      int    `json:"accounts"`
+	Migrated      int    `json:"migrated"`
+	Skipped       int    `json:"skipped"`
+}
+
+// AccountProductMigrationPayload is the event data for an account moved to another product
+type AccountProductMigrationPayload struct {
+	MigrationID   string `json:"migration_id"`
+	AccountID     string `json:"account_id"`
+	FromProductID string `json:"from_product_id"`
+	ToProductID   string `json:"to_product_id"`
+}
+
+// TransactionBlockPayload is the event data for transaction block events
+type TransactionBlockPayload struct {
+	BlockID         string     `json:"block_id"`
+	AccountID       string     `json:"account_id"`
+	TransactionType string     `json:"transaction_type"`
+	ReasonCode      string     `json:"reason_code"`
+	CreatedBy       string     `json:"created_by"`
+	ExpiresAt       time.Time  `json:"expires_at"`
+	LiftedBy        string     `json:"lifted_by,omitempty"`
+	LiftedAt        *time.Time `json:"lifted_at,omitempty"`
+}
+
+// TransactionPayload is the event data for transaction events
+type TransactionPayload struct {
+	TransactionID     string     `json:"transaction_id"`
+	FromAccountID     *string    `json:"from_account_id,omitempty"`
+	ToAccountID       *string    `json:"to_account_id,omitempty"`
+	VirtualAccountID  string     `json:"virtual_account_id,omitempty"`
+	TransactionType   string     `json:"transaction_type"`
+	Channel           string     `json:"channel"`
+	Amount            string     `json:"amount"`
+	Currency          string     `json:"currency"`
+	ExchangeRate      string     `json:"exchange_rate,omitempty"` // Conversion of an FX_TRANSFER
+	ConvertedAmount   string     `json:"converted_amount,omitempty"`
+	ConvertedCurrency string     `json:"converted_currency,omitempty"`
+	Status            string     `json:"status"`
+	Description       string     `json:"description"`
+	Reference         string     `json:"reference"`
+	Category          string     `json:"category"`
+	CreatedAt         time.Time  `json:"created_at"`
+	CompletedAt       *time.Time `json:"completed_at,omitempty"`
+}
+
+// BudgetPayload is the event data for budget alerts
+type BudgetPayload struct {
+	BudgetID     string  `json:"budget_id"`
+	AccountID    string  `json:"account_id"`
+	CategoryCode string  `json:"category_code"`
+	Period       string  `json:"period"`    // YYYY-MM
+	Threshold    int     `json:"threshold"` // Percentage of the budget reached
+	Amount       string  `json:"amount"`
+	Spent        string  `json:"spent"`
+	PercentUsed  float64 `json:"percent_used"`
+}
+
+// LimitPayload is the event data for alerts on an account nearing one of its limits
+type LimitPayload struct {
+	AccountID     string  `json:"account_id"`
+	TransactionID string  `json:"transaction_id"` // Transaction that took the usage past the threshold
+	Limit         string  `json:"limit"`          // DAILY_DEBIT or HOURLY_TRANSACTIONS
+	Threshold     int     `json:"threshold"`      // Percentage of the limit reached
+	Used          string  `json:"used"`
+	Maximum       string  `json:"maximum"`
+	Remaining     string  `json:"remaining"`
+	PercentUsed   float64 `json:"percent_used"`
+}
+
+// CacheInvalidationPayload is the event data recording an admin's cache invalidation
+type CacheInvalidationPayload struct {
+	RequestedBy string           `json:"requested_by"`
+	Reason      string           `json:"reason,omitempty"`
+	Patterns    map[string]int64 `json:"patterns"` // Keys removed per pattern
+	Error       string           `json:"error,omitempty"`
+}
+
+// AdminUserPayload is the event data for admin user events
+type AdminUserPayload struct {
+	AdminID   string   `json:"admin_id"`
+	Email     string   `json:"email"`
+	Roles     []string `json:"roles"`
+	Status    string   `json:"status"`
+	ChangedBy string   `json:"changed_by"`
+}
+
+// AdminAnomalyPayload is the event data for an anomalous admin request
+type AdminAnomalyPayload struct {
+	ActivityID string   `json:"activity_id"`
+	AdminID    string   `json:"admin_id"`
+	AdminEmail string   `json:"admin_email"`
+	Method     string   `json:"method"`
+	Path       string   `json:"path"`
+	StatusCode int      `json:"status_code"`
+	IP         string   `json:"ip"`
+	Country    string   `json:"country,omitempty"`
+	Anomalies  []string `json:"anomalies"`
+}
+
+// NewAccountEvent creates an account event from the current entity state
+func NewAccountEvent(eventType Type, account *entity.Account) Event {
+	payload := AccountPayload{
+		AccountID:   account.ID.String(),
+		AccountName: account.AccountName,
+		Balance:     account.Balance.String(),
+		Currency:    account.Currency().String(),
+		Status:      string(account.Status),
+	}
+	return newEvent(eventType, account.ID.String(), payload)
+}
+
+// NewOwnershipTransferEvent creates an ownership transfer event from the current entity state
+func NewOwnershipTransferEvent(eventType Type, transfer *entity.OwnershipTransfer) Event {
+	payload := OwnershipTransferPayload{
+		TransferID:     transfer.ID,
+		AccountID:      transfer.AccountID.String(),
+		FromCustomerID: transfer.FromCustomerID,
+		ToCustomerID:   transfer.ToCustomerID,
+		ReasonCode:     string(transfer.ReasonCode),
+		EffectiveDate:  transfer.EffectiveDate.Format("2006-01-02"),
+		Status:         string(transfer.Status),
+		RequestedBy:    transfer.RequestedBy,
+		DecidedBy:      transfer.DecidedBy,
+		DecisionReason: transfer.DecisionReason,
+	}
+	return newEvent(eventType, transfer.AccountID.String(), payload)
+}
+
+// NewProductMigrationEvent creates a product migration event from the current entity state
+func NewProductMigrationEvent(eventType Type, migration *entity.ProductMigration) Event {
+	payload := ProductMigrationPayload{
+		MigrationID:   migration.ID,
+		FromProductID: migration.FromProductID,
+		ToProductID:   migration.ToProductID,
+		EffectiveDate: migration.EffectiveDate.Format("2006-01-02"),
+		Status:        string(migration.Status),
+		RequestedBy:   migration.RequestedBy,
+		Accounts:      len(migration.Accounts),
+		Migrated:      migration.Count(vo.ProductMigrationAccountMigrated),
+		Skipped:       migration.Count(vo.ProductMigrationAccountSkipped),
+	}
+	return newEvent(eventType, "", payload)
+}
+
+// NewAccountProductMigratedEvent records that a product migration moved the account to its new product
+func NewAccountProductMigratedEvent(migration *entity.ProductMigration, accountID vo.AccountID) Event {
+	payload := AccountProductMigrationPayload{
+		MigrationID:   migration.ID,
+		AccountID:     accountID.String(),
+		FromProductID: migration.FromProductID,
+		ToProductID:   migration.ToProductID,
+	}
+	return newEvent(AccountProductMigrated, accountID.String(), payload)
+}
+
+// NewTransactionBlockEvent creates a transaction block event from the current entity state
+func NewTransactionBlockEvent(eventType Type, block *entity.TransactionBlock) Event {
+	payload := TransactionBlockPayload{
+		BlockID:         block.ID,
+		AccountID:       block.AccountID.String(),
+		TransactionType: string(block.TransactionType),
+		ReasonCode:      string(block.ReasonCode),
+		CreatedBy:       block.CreatedBy,
+		ExpiresAt:       block.ExpiresAt,
+		LiftedBy:        block.LiftedBy,
+		LiftedAt:        block.LiftedAt,
+	}
+	return newEvent(eventType, block.AccountID.String(), payload)
+}
+
+// NewTransactionEvent creates a transaction event from the current entity state
+func NewTransactionEvent(eventType Type, transaction *entity.Transaction) Event {
+	payload := TransactionPayload{
+		TransactionID:    transaction.ID.String(),
+		TransactionType:  string(transaction.TransactionType),
+		Channel:          string(transaction.Channel),
+		Amount:           transaction.Amount.String(),
+		Currency:         transaction.Currency().String(),
+		Status:           string(transaction.Status),
+		Description:      transaction.Description,
+		Reference:        transaction.Reference,
+		VirtualAccountID: transaction.VirtualAccountID,
+		Category:         transaction.Category,
+		CreatedAt:        transaction.CreatedAt,
+		CompletedAt:      transaction.CompletedAt,
+	}
+
+	if transaction.ExchangeRate != nil {
+		payload.ExchangeRate = transaction.ExchangeRate.Rate.String()
+		payload.ConvertedAmount = transaction.ConvertedAmount.String()
+		payload.ConvertedCurrency = transaction.ConvertedAmount.Currency().String()
+	}
+
+	key := ""
+	if transaction.FromAccountID != nil {
+		fromID := transaction.FromAccountID.String()
+		payload.FromAccountID = &fromID
+		key = fromID
+	}
+	if transaction.ToAccountID != nil {
+		toID := transaction.ToAccountID.String()
+		payload.ToAccountID = &toID
+		if key == "" {
+			key = toID
+		}
+	}
+
+	return newEvent(eventType, key, payload)
+}
+
+// NewBudgetAlertEvent creates a budget alert for a threshold reached in a period
+func NewBudgetAlertEvent(budget *entity.Budget, period string, threshold int, spent vo.Money) Event {
+	payload := BudgetPayload{
+		BudgetID:     budget.ID,
+		AccountID:    budget.AccountID.String(),
+		CategoryCode: budget.CategoryCode,
+		Period:       period,
+		Threshold:    threshold,
+		Amount:       budget.Amount.String(),
+		Spent:        spent.String(),
+		PercentUsed:  budget.PercentUsed(spent),
+	}
+	return newEvent(BudgetThresholdReached, budget.AccountID.String(), payload)
+}
+
+// NewLimitAlertEvent creates an alert for an account's usage of a limit reaching the threshold
+func NewLimitAlertEvent(payload LimitPayload) Event {
+	return newEvent(LimitThresholdReached, payload.AccountID, payload)
+}
+
+// NewCacheInvalidatedEvent creates the audit record of a cache invalidation; patterns maps each
+// pattern invalidated to the keys it removed
+func NewCacheInvalidatedEvent(requestedBy, reason string, patterns map[string]int64, err error) Event {
+	payload := CacheInvalidationPayload{
+		RequestedBy: requestedBy,
+		Reason:      reason,
+		Patterns:    patterns,
+	}
+	if err != nil {
+		payload.Error = err.Error()
+	}
+	return newEvent(CacheInvalidated, "", payload)
+}
+
+// NewAdminUserEvent creates an admin user event from the current entity state, recording who made the change
+func NewAdminUserEvent(eventType Type, admin *entity.AdminUser, changedBy string) Event {
+	roles := make([]string, len(admin.Roles))
+	for i, role := range admin.Roles {
+		roles[i] = string(role)
+	}
+
+	payload := AdminUserPayload{
+		AdminID:   admin.ID,
+		Email:     admin.Email,
+		Roles:     roles,
+		Status:    string(admin.Status),
+		ChangedBy: changedBy,
+	}
+	return newEvent(eventType, admin.ID, payload)
+}
+
+// NewAdminAnomalyEvent creates an event for an admin request that showed anomalies
+func NewAdminAnomalyEvent(activity *entity.AdminActivity) Event {
+	anomalies := make([]string, len(activity.Anomalies))
+	for i, anomaly := range activity.Anomalies {
+		anomalies[i] = string(anomaly)
+	}
+
+	payload := AdminAnomalyPayload{
+		ActivityID: activity.ID,
+		AdminID:    activity.AdminID,
+		AdminEmail: activity.AdminEmail,
+		Method:     activity.Method,
+		Path:       activity.Path,
+		StatusCode: activity.StatusCode,
+		IP:         activity.IP,
+		Country:    activity.Country,
+		Anomalies:  anomalies,
+	}
+	return newEvent(AdminAnomalyDetected, activity.AdminID, payload)
+}
+
+// DecodeAccount decodes the data of an account event
+func (e Event) DecodeAccount() (AccountPayload, error) {
+	var payload AccountPayload
+	err := json.Unmarshal(e.Data, &payload)
+	return payload, err
+}
+
+// DecodeOwnershipTransfer decodes the data of an ownership transfer event
+func (e Event) DecodeOwnershipTransfer() (OwnershipTransferPayload, error) {
+	var payload OwnershipTransferPayload
+	err := json.Unmarshal(e.Data, &payload)
+	return payload, err
+}
+
+// DecodeProductMigration decodes the data of a product migration event
+func (e Event) DecodeProductMigration() (ProductMigrationPayload, error) {
+	var payload ProductMigrationPayload
+	err := json.Unmarshal(e.Data, &payload)
+	return payload, err
+}
+
+// DecodeAccountProductMigration decodes the data of an account product migrated event
+func (e Event) DecodeAccountProductMigration() (AccountProductMigrationPayload, error) {
+	var payload AccountProductMigrationPayload
+	err := json.Unmarshal(e.Data, &payload)
+	return payload, err
+}
+
+// DecodeTransaction decodes the data of a transaction event
+func (e Event) DecodeTransaction() (TransactionPayload, error) {
+	var payload TransactionPayload
+	err := json.Unmarshal(e.Data, &payload)
+	return payload, err
+}
+
+// DecodeBudget decodes the data of a budget event
+func (e Event) DecodeBudget() (BudgetPayload, error) {
+	var payload BudgetPayload
+	err := json.Unmarshal(e.Data, &payload)
+	return payload, err
+}
+
+// DecodeLimit decodes the data of a limit event
+func (e Event) DecodeLimit() (LimitPayload, error) {
+	var payload LimitPayload
+	err := json.Unmarshal(e.Data, &payload)
+	return payload, err
+}
+
+// newEvent wraps a payload into an event with a fresh ID
+func newEvent(eventType Type, key string, payload interface{}) Event {
+	data, _ := json.Marshal(payload)
+	now := time.Now()
+
+	return Event{
+		ID:         vo.NewID("EVT"),
+		Type:       eventType,
+		Key:        key,
+		OccurredAt: now,
+		Data:       data,
+	}
+}
@@ -0,0 +1,66 @@
+package event
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewAccountEvent(t *testing.T) {
+	account, err := entity.NewAccount("Savings", vo.NewMoneyFromFloat(150.25))
+	require.NoError(t, err)
+
+	evt := NewAccountEvent(AccountCreated, account)
+
+	assert.Equal(t, AccountCreated, evt.Type)
+	assert.Equal(t, account.ID.String(), evt.Key)
+	assert.Len(t, evt.ID, vo.IDLength)
+
+	payload, err := evt.DecodeAccount()
+	require.NoError(t, err)
+	assert.Equal(t, account.ID.String(), payload.AccountID)
+	assert.Equal(t, "Savings", payload.AccountName)
+	assert.Equal(t, "150.25", payload.Balance)
+	assert.Equal(t, "ACTIVE", payload.Status)
+}
+
+func TestNewTransactionEvent(t *testing.T) {
+	fromID := vo.NewAccountID()
+	toID := vo.NewAccountID()
+	amount := vo.NewMoneyFromFloat(10)
+
+	debit, err := entity.NewDebitTransaction(fromID, amount, "", "", time.Now())
+	require.NoError(t, err)
+	credit, err := entity.NewCreditTransaction(toID, amount, "", "", time.Now())
+	require.NoError(t, err)
+	transfer, err := entity.NewTransferTransaction(fromID, toID, amount, "", "", time.Now())
+	require.NoError(t, err)
+
+	tests := []struct {
+		name        string
+		transaction *entity.Transaction
+		expectedKey string
+	}{
+		{name: "Debit is keyed by source account", transaction: debit, expectedKey: fromID.String()},
+		{name: "Credit is keyed by destination account", transaction: credit, expectedKey: toID.String()},
+		{name: "Transfer is keyed by source account", transaction: transfer, expectedKey: fromID.String()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			evt := NewTransactionEvent(TransactionCreated, tt.transaction)
+
+			assert.Equal(t, tt.expectedKey, evt.Key)
+
+			payload, err := evt.DecodeTransaction()
+			require.NoError(t, err)
+			assert.Equal(t, tt.transaction.ID.String(), payload.TransactionID)
+			assert.Equal(t, string(tt.transaction.TransactionType), payload.TransactionType)
+			assert.Equal(t, "10", payload.Amount)
+		})
+	}
+}
@@ -0,0 +1,8 @@
+package event
+
+// OutboxEntry is an event stored in the outbox, awaiting delivery to an external broker
+type OutboxEntry struct {
+	Sequence uint64 // Monotonic outbox position; entries are relayed in this order
+	Event    Event
+	Attempts int
+}
@@ -0,0 +1,14 @@
+package infra
+
+import "context"
+
+// BackupResult describes a finished logical backup
+type BackupResult struct {
+	Location  string
+	SizeBytes int64
+}
+
+// BackupService produces logical database backups (e.g. a pg_dump wrapper)
+type BackupService interface {
+	Dump(ctx context.Context, name string) (*BackupResult, error)
+}
@@ -0,0 +1,23 @@
+package infra
+
+import (
+	"context"
+	"errors"
+	"io"
+)
+
+// ErrBlobNotFound is returned when no object is stored under a key
+var ErrBlobNotFound = errors.New("blob not found")
+
+// BlobStorage stores files the service produces, such as exports, and files clients upload, such as
+// transaction attachments, under slash-separated keys
+type BlobStorage interface {
+	// Put stores the contents of r under key, replacing any object there, and returns its size
+	Put(ctx context.Context, key string, r io.Reader) (int64, error)
+
+	// Open opens the object stored under key
+	Open(ctx context.Context, key string) (io.ReadCloser, error)
+
+	// Delete removes the object stored under key; a missing object is not an error
+	Delete(ctx context.Context, key string) error
+}
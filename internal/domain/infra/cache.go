@@ -2,11 +2,50 @@ package infra
 
 import (
 	"context"
+	"errors"
 	"time"
 )
 
+// ErrCacheMiss is returned by CacheService.Get when the key is not cached. Any other error means
+// the cache itself failed, and callers must not read it as "not cached".
+var ErrCacheMiss = errors.New("cache miss")
+
 type CacheService interface {
 	Set(ctx context.Context, key string, value interface{}, expiration time.Duration) error
+	// Get and GetMany treat values cached longer ago than the age tolerance of ctx as misses, see
+	// WithMaxCacheAge
 	Get(ctx context.Context, key string, dest interface{}) error
+	// GetMany retrieves several keys in one round trip, decoding each cached value into the dest at
+	// the same index. It reports which keys were cached; misses are not errors.
+	GetMany(ctx context.Context, keys []string, dests []interface{}) ([]bool, error)
 	Delete(ctx context.Context, key string) error
+	// DeleteMatching removes every key matching a glob pattern such as "account:*" and reports how
+	// many were removed; a pattern without wildcards removes that key alone
+	DeleteMatching(ctx context.Context, pattern string) (int64, error)
+}
+
+type maxCacheAgeKey struct{}
+
+// WithMaxCacheAge returns a context whose cache reads only serve values cached at most maxAge ago;
+// older values are misses, so the caller reads the data from the database instead. A maxAge of 0
+// bypasses the cache.
+func WithMaxCacheAge(ctx context.Context, maxAge time.Duration) context.Context {
+	return context.WithValue(ctx, maxCacheAgeKey{}, maxAge)
+}
+
+// MaxCacheAgeFromContext returns the age tolerance carried by ctx; ok is false when any cached
+// value will do
+func MaxCacheAgeFromContext(ctx context.Context) (maxAge time.Duration, ok bool) {
+	maxAge, ok = ctx.Value(maxCacheAgeKey{}).(time.Duration)
+	return maxAge, ok
+}
+
+// IsCacheFresh checks whether a value cached at cachedAt may be served to ctx at now. A value of
+// unknown age, with a zero cachedAt, is only served when ctx has no age tolerance.
+func IsCacheFresh(ctx context.Context, cachedAt, now time.Time) bool {
+	maxAge, ok := MaxCacheAgeFromContext(ctx)
+	if !ok {
+		return true
+	}
+	return !cachedAt.IsZero() && now.Sub(cachedAt) < maxAge
 }
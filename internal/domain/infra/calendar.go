@@ -0,0 +1,15 @@
+package infra
+
+import (
+	"context"
+	"time"
+)
+
+// Calendar answers business-day questions for processing windows and settlement
+type Calendar interface {
+	// IsBusinessDay checks if the date is neither a weekend nor a holiday
+	IsBusinessDay(ctx context.Context, date time.Time) (bool, error)
+
+	// NextBusinessDay returns the first business day strictly after the date
+	NextBusinessDay(ctx context.Context, date time.Time) (time.Time, error)
+}
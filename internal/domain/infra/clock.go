@@ -0,0 +1,21 @@
+package infra
+
+import "time"
+
+// Clock tells the current time. Time-dependent features read it instead of time.Now, so tests and
+// sandbox deployments can move time forward.
+type Clock interface {
+	// Now returns the current time
+	Now() time.Time
+}
+
+// TestClock is a clock that can be moved forward, for integration tests and sandbox deployments
+type TestClock interface {
+	Clock
+
+	// Advance moves the clock forward by d and returns the new time
+	Advance(d time.Duration) (time.Time, error)
+
+	// Offset returns how far the clock is ahead of real time
+	Offset() time.Duration
+}
@@ -0,0 +1,15 @@
+package infra
+
+import (
+	"context"
+	"time"
+)
+
+// CounterStore keeps shared integer counters that expire, such as usage per time window
+type CounterStore interface {
+	// IncrementBy adds delta, which may be negative, to a counter and returns its new value. A counter
+	// the call creates expires after ttl; later increments leave its expiry alone.
+	IncrementBy(ctx context.Context, key string, delta int64, ttl time.Duration) (int64, error)
+	// GetCounters returns the counters at the same index as their keys, 0 for those that do not exist
+	GetCounters(ctx context.Context, keys []string) ([]int64, error)
+}
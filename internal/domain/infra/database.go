@@ -0,0 +1,12 @@
+package infra
+
+import "time"
+
+// DatabaseMode reports whether the database only accepts reads, e.g. while a failover promotes a new primary
+type DatabaseMode interface {
+	// ReadOnlySince returns when the database was found read-only, and false while it accepts writes
+	ReadOnlySince() (time.Time, bool)
+
+	// RetryAfter is how long clients should wait before retrying a write rejected as read-only
+	RetryAfter() time.Duration
+}
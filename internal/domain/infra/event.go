@@ -0,0 +1,22 @@
+package infra
+
+import (
+	"context"
+
+	"github.com/hydr0g3nz/mini_bank/internal/domain/event"
+)
+
+// EventPublisher publishes domain events
+type EventPublisher interface {
+	Publish(ctx context.Context, evt event.Event) error
+}
+
+// EventHandler handles a delivered event. Handlers must not block for long.
+type EventHandler func(ctx context.Context, evt event.Event)
+
+// EventBus publishes domain events and delivers them to subscribers.
+// When no event types are given, the handler receives every event.
+type EventBus interface {
+	EventPublisher
+	Subscribe(handler EventHandler, eventTypes ...event.Type) (unsubscribe func())
+}
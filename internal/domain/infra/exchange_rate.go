@@ -0,0 +1,15 @@
+package infra
+
+import (
+	"context"
+
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+)
+
+// ExchangeRateService quotes the rates amounts are converted at between currencies.
+// A pair the provider has no rate for is errs.ErrExchangeRateUnavailable.
+type ExchangeRateService interface {
+	GetRate(ctx context.Context, from, to vo.Currency) (vo.ExchangeRate, error)
+	// ListRates returns the rates from base to every currency the provider quotes it in
+	ListRates(ctx context.Context, base vo.Currency) ([]vo.ExchangeRate, error)
+}
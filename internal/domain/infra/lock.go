@@ -0,0 +1,23 @@
+package infra
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// ErrLockNotHeld is returned by LockService.Release when the lock expired or is held with another token
+var ErrLockNotHeld = errors.New("lock not held")
+
+// LockService hands out locks shared by every instance, each held with a fencing token until it is
+// released or its ttl passes. Tokens come from a counter shared by every instance, not from their
+// clocks, so they grow with every acquisition and a worker whose lock expired holds a smaller token
+// than the one that took over.
+type LockService interface {
+	// Acquire takes the lock on key for ttl and returns its token; acquired is false while another
+	// holder has it
+	Acquire(ctx context.Context, key string, ttl time.Duration) (token int64, acquired bool, err error)
+	// Release gives the lock up if it is still held with token, and returns ErrLockNotHeld otherwise,
+	// leaving a lock taken over by another holder alone
+	Release(ctx context.Context, key string, token int64) error
+}
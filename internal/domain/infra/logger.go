@@ -1,5 +1,7 @@
 package infra
 
+import "context"
+
 type Logger interface {
 	Debug(msg string, fields ...interface{})
 	Debugf(format string, args ...interface{})
@@ -15,3 +17,29 @@ type Logger interface {
 	With(fields ...interface{}) Logger
 	Sync() error
 }
+
+type requestIDKey struct{}
+
+// WithRequestID returns a context carrying the ID of the API request it serves
+func WithRequestID(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, requestID)
+}
+
+// RequestIDFromContext returns the API request ID carried by ctx, empty outside a request
+func RequestIDFromContext(ctx context.Context) string {
+	requestID, _ := ctx.Value(requestIDKey{}).(string)
+	return requestID
+}
+
+type traceParentKey struct{}
+
+// WithTraceParent returns a context carrying the W3C traceparent of the API request it serves
+func WithTraceParent(ctx context.Context, traceParent string) context.Context {
+	return context.WithValue(ctx, traceParentKey{}, traceParent)
+}
+
+// TraceParentFromContext returns the W3C traceparent carried by ctx, empty when the caller sent none
+func TraceParentFromContext(ctx context.Context) string {
+	traceParent, _ := ctx.Value(traceParentKey{}).(string)
+	return traceParent
+}
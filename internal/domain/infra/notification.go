@@ -0,0 +1,20 @@
+package infra
+
+import (
+	"context"
+
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+)
+
+// Notification is a rendered message addressed to one recipient
+type Notification struct {
+	Channel   vo.NotificationChannel
+	Recipient string // Email address, phone number or device token, depending on the channel
+	Subject   string // Empty on channels without a subject
+	Body      string
+}
+
+// NotificationSender delivers notifications to customers
+type NotificationSender interface {
+	Send(ctx context.Context, notification Notification) error
+}
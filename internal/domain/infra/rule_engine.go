@@ -0,0 +1,28 @@
+package infra
+
+import "context"
+
+// RuleFactType is the type of a fact business rule expressions can refer to
+type RuleFactType string
+
+const (
+	RuleFactString RuleFactType = "string"
+	RuleFactNumber RuleFactType = "number"
+)
+
+// RuleEngine compiles and evaluates business rule expressions against the facts of a transaction.
+// Expressions are sandboxed: they cannot reach the network, files or the clock, and evaluation
+// is aborted once it exceeds the engine's cost or time budget.
+type RuleEngine interface {
+	// CheckBool verifies an expression compiles and evaluates to a boolean
+	CheckBool(expression string) error
+
+	// CheckNumber verifies an expression compiles and evaluates to a number
+	CheckNumber(expression string) error
+
+	// EvaluateBool evaluates a boolean expression
+	EvaluateBool(ctx context.Context, expression string, facts map[string]interface{}) (bool, error)
+
+	// EvaluateNumber evaluates a numeric expression
+	EvaluateNumber(ctx context.Context, expression string, facts map[string]interface{}) (float64, error)
+}
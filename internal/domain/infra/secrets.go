@@ -0,0 +1,29 @@
+package infra
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrSecretNotFound is returned when the secret store has no secret under a reference
+var ErrSecretNotFound = errors.New("secret not found")
+
+// SecretsProvider fetches secrets, such as passwords and signing keys, from a secret store.
+// The format of a reference depends on the store, e.g. "minibank/db#password" for Vault.
+type SecretsProvider interface {
+	GetSecret(ctx context.Context, ref string) (string, error)
+}
+
+// SecretValue is the current value of a secret, which may be rotated while the service runs.
+// Read it on every use instead of keeping a copy.
+type SecretValue interface {
+	Value() string
+}
+
+// StaticSecret is a secret that never rotates, e.g. one set in a plain environment variable
+type StaticSecret string
+
+// Value returns the secret
+func (s StaticSecret) Value() string {
+	return string(s)
+}
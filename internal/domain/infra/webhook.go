@@ -0,0 +1,14 @@
+package infra
+
+import "context"
+
+// WebhookDelivery is the outcome of posting one webhook payload
+type WebhookDelivery struct {
+	StatusCode int // Zero when no response was received
+}
+
+// WebhookSender posts signed webhook payloads to subscriber URLs.
+// Non-2xx responses and transport failures are returned as errors.
+type WebhookSender interface {
+	Send(ctx context.Context, url, secret string, payload []byte) (*WebhookDelivery, error)
+}
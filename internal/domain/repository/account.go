@@ -3,11 +3,30 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
 	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
 )
 
+// BatchedCredit is one transaction's credit within a batch applied to an account in one write
+type BatchedCredit struct {
+	TransactionID vo.TransactionID
+	Token         int64 // Fencing token the transaction is claimed with
+	Amount        vo.Money
+}
+
+// AccountFilter narrows the accounts listed; zero fields match everything
+type AccountFilter struct {
+	Status        vo.AccountStatus
+	CustomerID    string
+	ProductID     string
+	MinBalance    *vo.Money // Inclusive
+	MaxBalance    *vo.Money // Inclusive
+	CreatedFrom   time.Time // Inclusive
+	CreatedBefore time.Time // Exclusive
+}
+
 type AccountRepository interface {
 	// Create creates a new account
 	Create(ctx context.Context, account *entity.Account) error
@@ -15,15 +34,59 @@ type AccountRepository interface {
 	// GetByID retrieves an account by ID
 	GetByID(ctx context.Context, id vo.AccountID) (*entity.Account, error)
 
+	// GetByIDForUpdate retrieves an account by ID and locks its row until the database transaction of
+	// the unit of work in ctx ends, so concurrent balance changes to the account wait for each other.
+	// Outside a unit of work the lock is released as soon as the row is read.
+	GetByIDForUpdate(ctx context.Context, id vo.AccountID) (*entity.Account, error)
+
+	// GetByIDs retrieves several accounts in one query, keyed by ID; IDs without an account are left out
+	GetByIDs(ctx context.Context, ids []vo.AccountID) (map[vo.AccountID]*entity.Account, error)
+
 	// Update updates an existing account
 	Update(ctx context.Context, account *entity.Account) error
 
+	// UpdateFenced updates an existing account only while the transaction is still claimed with the
+	// fencing token, and returns errs.ErrStaleFencingToken once a newer claim took it over. It records
+	// the transaction as applied to the account in the same database transaction and returns
+	// errs.ErrTransactionAlreadyApplied, changing nothing, if it already was.
+	UpdateFenced(ctx context.Context, account *entity.Account, transactionID vo.TransactionID, token int64) error
+
+	// IsApplied reports whether a transaction's balance change was applied to an account
+	IsApplied(ctx context.Context, transactionID vo.TransactionID, accountID vo.AccountID) (bool, error)
+
+	// ApplyCredits adds a batch of credits to an account's balance in one database transaction. Each
+	// credit is recorded as applied unless its transaction was taken over or already applied, and the
+	// outcome of each is returned in order: nil, errs.ErrStaleFencingToken or errs.ErrTransactionAlreadyApplied.
+	ApplyCredits(ctx context.Context, accountID vo.AccountID, credits []BatchedCredit) ([]error, error)
+
+	// Revert updates an existing account after a transaction's balance change was undone on it and
+	// removes the record of the change, so the transaction can be applied again
+	Revert(ctx context.Context, account *entity.Account, transactionID vo.TransactionID) error
+
+	// RepairBalance sets an account's balance to the adjustment's new balance and records the
+	// adjustment in the same database transaction. It returns errs.ErrBalanceChanged, changing
+	// nothing, when the stored balance is no longer the adjustment's previous balance.
+	RepairBalance(ctx context.Context, adjustment *entity.BalanceAdjustment) error
+
 	// Delete deletes an account by ID
 	Delete(ctx context.Context, id vo.AccountID) error
 
-	// List retrieves accounts with pagination
-	List(ctx context.Context, limit, offset int) ([]*entity.Account, error)
+	// List retrieves matching accounts with pagination, newest first
+	List(ctx context.Context, filter AccountFilter, limit, offset int) ([]*entity.Account, error)
+
+	// Count returns the number of matching accounts
+	Count(ctx context.Context, filter AccountFilter) (int64, error)
 
 	// GetByAccountName retrieves an account by account name
 	GetByAccountName(ctx context.Context, accountName string) (*entity.Account, error)
+
+	// GetByCustomerAccountName retrieves a customer's account by account name; an empty customer ID
+	// looks among the accounts without a customer
+	GetByCustomerAccountName(ctx context.Context, customerID, accountName string) (*entity.Account, error)
+
+	// GetNameHistory retrieves the renames of an account, oldest first; Update records them
+	GetNameHistory(ctx context.Context, id vo.AccountID) ([]*entity.AccountNameChange, error)
+
+	// GetChildren retrieves the child accounts of a parent account
+	GetChildren(ctx context.Context, parentID vo.AccountID) ([]*entity.Account, error)
 }
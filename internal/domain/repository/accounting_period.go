@@ -0,0 +1,21 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+)
+
+type AccountingPeriodRepository interface {
+	// Create records a period close
+	Create(ctx context.Context, period *entity.AccountingPeriod) error
+
+	// GetLatest retrieves the latest closed period
+	GetLatest(ctx context.Context) (*entity.AccountingPeriod, error)
+
+	// GetClosing retrieves the close that locked a period: its own, or the earliest of a later month
+	GetClosing(ctx context.Context, period string) (*entity.AccountingPeriod, error)
+
+	// List retrieves every closed period, latest first
+	List(ctx context.Context) ([]*entity.AccountingPeriod, error)
+}
@@ -0,0 +1,45 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+)
+
+// AdminActivityFilter narrows the admin activity listed or counted; zero fields match everything
+type AdminActivityFilter struct {
+	AdminID       string
+	IP            string
+	MutationsOnly bool            // Only requests that were not reads
+	AnomalousOnly bool            // Only requests that showed an anomaly
+	Anomaly       vo.AdminAnomaly // Only requests that showed this anomaly
+	From          time.Time       // Inclusive
+}
+
+// AdminActivitySummary sums up one admin user's activity
+type AdminActivitySummary struct {
+	AdminID     string
+	AdminEmail  string
+	Actions     int64
+	Mutations   int64
+	Denied      int64 // Answered 403
+	Anomalous   int64
+	DistinctIPs int64
+	LastSeenAt  time.Time
+}
+
+type AdminActivityRepository interface {
+	// Create records an admin request
+	Create(ctx context.Context, activity *entity.AdminActivity) error
+
+	// List retrieves the matching activity, newest first
+	List(ctx context.Context, filter AdminActivityFilter, limit, offset int) ([]*entity.AdminActivity, error)
+
+	// Count counts the matching activity
+	Count(ctx context.Context, filter AdminActivityFilter) (int64, error)
+
+	// Summarize sums up the matching activity per admin user, most active first
+	Summarize(ctx context.Context, filter AdminActivityFilter) ([]AdminActivitySummary, error)
+}
@@ -0,0 +1,27 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+)
+
+type AdminUserRepository interface {
+	// Create creates a new admin user
+	Create(ctx context.Context, admin *entity.AdminUser) error
+
+	// GetByID retrieves an admin user by ID
+	GetByID(ctx context.Context, id string) (*entity.AdminUser, error)
+
+	// GetByEmail retrieves an admin user by email
+	GetByEmail(ctx context.Context, email string) (*entity.AdminUser, error)
+
+	// GetByKeyHash retrieves the admin user whose API key has the hash
+	GetByKeyHash(ctx context.Context, keyHash string) (*entity.AdminUser, error)
+
+	// Update updates an existing admin user
+	Update(ctx context.Context, admin *entity.AdminUser) error
+
+	// List retrieves every admin user by email
+	List(ctx context.Context) ([]*entity.AdminUser, error)
+}
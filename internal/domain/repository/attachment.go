@@ -0,0 +1,28 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+)
+
+type AttachmentRepository interface {
+	// Create creates a new attachment
+	Create(ctx context.Context, attachment *entity.Attachment) error
+
+	// GetByID retrieves an attachment by ID
+	GetByID(ctx context.Context, id string) (*entity.Attachment, error)
+
+	// Delete removes an attachment
+	Delete(ctx context.Context, id string) error
+
+	// ListByTransactionID retrieves an account's attachments of a transaction, oldest first
+	ListByTransactionID(ctx context.Context, accountID vo.AccountID, transactionID vo.TransactionID) ([]*entity.Attachment, error)
+
+	// CountByTransactionID returns the number of files an account attached to a transaction
+	CountByTransactionID(ctx context.Context, accountID vo.AccountID, transactionID vo.TransactionID) (int64, error)
+
+	// ListOfDeletedAccounts retrieves up to limit attachments of accounts that were deleted
+	ListOfDeletedAccounts(ctx context.Context, limit int) ([]*entity.Attachment, error)
+}
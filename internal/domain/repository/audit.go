@@ -0,0 +1,27 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/domain/event"
+)
+
+// AuditFilter narrows the audit entries read from the log; zero fields match everything
+type AuditFilter struct {
+	EventType event.Type
+	Key       string     // Primary account the event was about
+	From      *time.Time // Inclusive, on the time the event occurred
+	To        *time.Time // Exclusive, on the time the event occurred
+}
+
+type AuditRepository interface {
+	// Record stores an event in the audit log; recording the same event again is a no-op
+	Record(ctx context.Context, evt event.Event) error
+
+	// ListAfter retrieves matching entries with a sequence above afterSequence, in log order
+	ListAfter(ctx context.Context, filter AuditFilter, afterSequence uint64, limit int) ([]*event.AuditEntry, error)
+
+	// DeleteOccurredBefore removes the entries of events that occurred before cutoff and returns how many were removed
+	DeleteOccurredBefore(ctx context.Context, cutoff time.Time) (int64, error)
+}
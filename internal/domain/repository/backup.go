@@ -0,0 +1,24 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+)
+
+type BackupRepository interface {
+	// Create records a new backup
+	Create(ctx context.Context, backup *entity.Backup) error
+
+	// GetByID retrieves a backup by ID
+	GetByID(ctx context.Context, id string) (*entity.Backup, error)
+
+	// Update updates an existing backup record
+	Update(ctx context.Context, backup *entity.Backup) error
+
+	// List retrieves backups with pagination, newest first
+	List(ctx context.Context, limit, offset int) ([]*entity.Backup, error)
+
+	// Count returns the total number of backups
+	Count(ctx context.Context) (int64, error)
+}
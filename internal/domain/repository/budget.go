@@ -0,0 +1,30 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+)
+
+type BudgetRepository interface {
+	// Create creates a new budget
+	Create(ctx context.Context, budget *entity.Budget) error
+
+	// GetByID retrieves a budget by ID
+	GetByID(ctx context.Context, id string) (*entity.Budget, error)
+
+	// Update updates an existing budget
+	Update(ctx context.Context, budget *entity.Budget) error
+
+	// Delete removes a budget
+	Delete(ctx context.Context, id string) error
+
+	// ListByAccountID retrieves the budgets of an account ordered by category
+	ListByAccountID(ctx context.Context, accountID vo.AccountID) ([]*entity.Budget, error)
+
+	// RecordAlert records that a threshold was alerted in a period. It returns false when
+	// the threshold, or a higher one, was already recorded for the period so that
+	// concurrent completions alert only once.
+	RecordAlert(ctx context.Context, id string, period string, threshold int) (bool, error)
+}
@@ -0,0 +1,27 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+)
+
+type BusinessRuleRepository interface {
+	// Create creates a new business rule
+	Create(ctx context.Context, rule *entity.BusinessRule) error
+
+	// GetByID retrieves a business rule by ID
+	GetByID(ctx context.Context, id string) (*entity.BusinessRule, error)
+
+	// Update updates an existing business rule
+	Update(ctx context.Context, rule *entity.BusinessRule) error
+
+	// Delete removes a business rule
+	Delete(ctx context.Context, id string) error
+
+	// List retrieves all business rules in evaluation order
+	List(ctx context.Context) ([]*entity.BusinessRule, error)
+
+	// ListEnabled retrieves the enabled business rules in evaluation order
+	ListEnabled(ctx context.Context) ([]*entity.BusinessRule, error)
+}
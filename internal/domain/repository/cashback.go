@@ -0,0 +1,39 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+)
+
+type CashbackRepository interface {
+	// CreateCampaign creates a new cashback campaign
+	CreateCampaign(ctx context.Context, campaign *entity.CashbackCampaign) error
+
+	// GetCampaign retrieves a cashback campaign by ID
+	GetCampaign(ctx context.Context, id string) (*entity.CashbackCampaign, error)
+
+	// UpdateCampaign updates an existing cashback campaign
+	UpdateCampaign(ctx context.Context, campaign *entity.CashbackCampaign) error
+
+	// ListCampaigns retrieves all cashback campaigns, newest first
+	ListCampaigns(ctx context.Context) ([]*entity.CashbackCampaign, error)
+
+	// ListRunningCampaigns retrieves the active campaigns whose date range covers the time
+	ListRunningCampaigns(ctx context.Context, at time.Time) ([]*entity.CashbackCampaign, error)
+
+	// CreateReward records a cashback reward. It returns false when the campaign already
+	// rewarded the payment, so a redelivered completion pays out only once.
+	CreateReward(ctx context.Context, reward *entity.CashbackReward) (bool, error)
+
+	// ListRewardsByAccountID retrieves the cashback rewards of an account, newest first
+	ListRewardsByAccountID(ctx context.Context, accountID vo.AccountID, limit, offset int) ([]*entity.CashbackReward, error)
+
+	// CountRewardsByAccountID counts the cashback rewards of an account
+	CountRewardsByAccountID(ctx context.Context, accountID vo.AccountID) (int64, error)
+
+	// SumRewardsByAccountID totals the cashback rewards of an account
+	SumRewardsByAccountID(ctx context.Context, accountID vo.AccountID) (vo.Money, error)
+}
@@ -0,0 +1,45 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+)
+
+type CategoryRepository interface {
+	// Create creates a new category
+	Create(ctx context.Context, category *entity.Category) error
+
+	// GetByCode retrieves a category by code
+	GetByCode(ctx context.Context, code string) (*entity.Category, error)
+
+	// Update updates an existing category
+	Update(ctx context.Context, category *entity.Category) error
+
+	// Delete removes a category
+	Delete(ctx context.Context, code string) error
+
+	// List retrieves all categories ordered by code
+	List(ctx context.Context) ([]*entity.Category, error)
+
+	// CreateRule creates a new categorization rule
+	CreateRule(ctx context.Context, rule *entity.CategoryRule) error
+
+	// DeleteRule removes a categorization rule
+	DeleteRule(ctx context.Context, id string) error
+
+	// ListRules retrieves all categorization rules in evaluation order
+	ListRules(ctx context.Context) ([]*entity.CategoryRule, error)
+}
+
+type CategoryOverrideRepository interface {
+	// Save creates or replaces the override of a transaction for an account
+	Save(ctx context.Context, override *entity.CategoryOverride) error
+
+	// Delete removes the override of a transaction for an account; removing a missing override is a no-op
+	Delete(ctx context.Context, accountID vo.AccountID, transactionID vo.TransactionID) error
+
+	// ListByTransactionIDs retrieves an account's overrides for the given transactions
+	ListByTransactionIDs(ctx context.Context, accountID vo.AccountID, transactionIDs []vo.TransactionID) ([]*entity.CategoryOverride, error)
+}
@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+)
+
+type CustomerRepository interface {
+	// GetSummary aggregates the balances, recent transactions and pending transactions
+	// of all the customer's accounts; each transaction list holds at most limit entries
+	GetSummary(ctx context.Context, customerID string, limit int) (*entity.CustomerSummary, error)
+
+	// Exists checks if the customer holds any account
+	Exists(ctx context.Context, customerID string) (bool, error)
+}
@@ -0,0 +1,21 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+)
+
+type DailyAggregateRepository interface {
+	// Record adds what a transaction contributed to its account's day. Each transaction counts once per
+	// account: it returns false, changing nothing, when the transaction was already recorded.
+	Record(ctx context.Context, transactionID vo.TransactionID, contribution *entity.DailyAggregate) (bool, error)
+
+	// ReplaceByAccountID replaces an account's aggregates and the transactions recorded in them in one transaction
+	ReplaceByAccountID(ctx context.Context, accountID vo.AccountID, aggregates []*entity.DailyAggregate, transactionIDs []vo.TransactionID) error
+
+	// ListByAccountID retrieves an account's aggregates for the days from from to to inclusive, oldest first
+	ListByAccountID(ctx context.Context, accountID vo.AccountID, from, to time.Time) ([]*entity.DailyAggregate, error)
+}
@@ -0,0 +1,25 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+)
+
+type ExportRepository interface {
+	// Create records a new export
+	Create(ctx context.Context, export *entity.Export) error
+
+	// GetByID retrieves an export by ID
+	GetByID(ctx context.Context, id string) (*entity.Export, error)
+
+	// Update updates an existing export record
+	Update(ctx context.Context, export *entity.Export) error
+
+	// ListExpired retrieves up to limit exports that expired before the given time, oldest first
+	ListExpired(ctx context.Context, before time.Time, limit int) ([]*entity.Export, error)
+
+	// Delete removes an export record
+	Delete(ctx context.Context, id string) error
+}
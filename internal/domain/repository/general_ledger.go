@@ -0,0 +1,24 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+)
+
+type GeneralLedgerRepository interface {
+	// Record saves the journal entry of a transaction. Each transaction is posted once: it returns false,
+	// changing nothing, when the transaction was already posted.
+	Record(ctx context.Context, postings []*entity.GLPosting) (bool, error)
+
+	// ListByTransactionID retrieves the postings of a transaction by line
+	ListByTransactionID(ctx context.Context, transactionID vo.TransactionID) ([]*entity.GLPosting, error)
+
+	// SumByPeriod totals the debits and credits of every GL account posted to in a period
+	SumByPeriod(ctx context.Context, period string) ([]*entity.GLBalance, error)
+
+	// SumByProduct totals the debits and credits of the given GL accounts per period and product, for
+	// the periods from from to to inclusive, ordered by period and product
+	SumByProduct(ctx context.Context, accounts []string, from, to string) ([]*entity.GLProductTotal, error)
+}
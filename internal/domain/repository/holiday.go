@@ -0,0 +1,22 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+)
+
+type HolidayRepository interface {
+	// Create records a new holiday
+	Create(ctx context.Context, holiday *entity.Holiday) error
+
+	// GetByDate retrieves the holiday of a region on a date
+	GetByDate(ctx context.Context, region string, date time.Time) (*entity.Holiday, error)
+
+	// Delete removes the holiday of a region on a date
+	Delete(ctx context.Context, region string, date time.Time) error
+
+	// ListByRegion retrieves the holidays of a region within a date range (inclusive), in date order
+	ListByRegion(ctx context.Context, region string, from, to time.Time) ([]*entity.Holiday, error)
+}
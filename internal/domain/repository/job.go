@@ -0,0 +1,46 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+)
+
+// JobFilter narrows the jobs listed; zero fields match everything
+type JobFilter struct {
+	Type   string
+	Status vo.JobStatus
+}
+
+type JobRepository interface {
+	// Enqueue stores a new job. It returns false, storing nothing, when a job with the same unique key exists.
+	Enqueue(ctx context.Context, job *entity.Job) (bool, error)
+
+	// ClaimNext claims the next job of the given types that is claimable at now for workerID, leased
+	// until leaseUntil. It returns nil when no job is claimable.
+	ClaimNext(ctx context.Context, types []string, workerID string, now, leaseUntil time.Time) (*entity.Job, error)
+
+	// ExtendLease renews a worker's lease on a running job; ErrJobLeaseLost when the worker no longer holds it
+	ExtendLease(ctx context.Context, id, workerID string, leaseUntil time.Time) error
+
+	// Finish stores the outcome of an attempt by the worker in job.LockedBy; ErrJobLeaseLost when
+	// another worker claimed the job since
+	Finish(ctx context.Context, job *entity.Job) error
+
+	// Update updates an existing job that is not running
+	Update(ctx context.Context, job *entity.Job) error
+
+	// GetByID retrieves a job by ID
+	GetByID(ctx context.Context, id string) (*entity.Job, error)
+
+	// List retrieves matching jobs with pagination, newest first
+	List(ctx context.Context, filter JobFilter, limit, offset int) ([]*entity.Job, error)
+
+	// Count counts matching jobs
+	Count(ctx context.Context, filter JobFilter) (int64, error)
+
+	// DeleteFinishedBefore removes the jobs that finished before cutoff and returns how many were removed
+	DeleteFinishedBefore(ctx context.Context, cutoff time.Time) (int64, error)
+}
@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+)
+
+type NotificationPreferenceRepository interface {
+	// Save creates or replaces the notification preferences of a customer
+	Save(ctx context.Context, preference *entity.NotificationPreference) error
+
+	// GetByCustomerID retrieves the notification preferences of a customer
+	GetByCustomerID(ctx context.Context, customerID string) (*entity.NotificationPreference, error)
+
+	// Delete removes the notification preferences of a customer; removing missing preferences is a no-op
+	Delete(ctx context.Context, customerID string) error
+}
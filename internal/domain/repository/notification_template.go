@@ -0,0 +1,26 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+)
+
+type NotificationTemplateRepository interface {
+	// Create saves a new version of a notification template, failing with ErrNotificationTemplateConflict
+	// when the version was already saved
+	Create(ctx context.Context, template *entity.NotificationTemplate) error
+
+	// GetLatest retrieves the latest version of the template for an event, channel and locale
+	GetLatest(ctx context.Context, event string, channel vo.NotificationChannel, locale string) (*entity.NotificationTemplate, error)
+
+	// GetVersion retrieves one version of the template for an event, channel and locale
+	GetVersion(ctx context.Context, event string, channel vo.NotificationChannel, locale string, version int) (*entity.NotificationTemplate, error)
+
+	// ListVersions retrieves every version of the template for an event, channel and locale, newest first
+	ListVersions(ctx context.Context, event string, channel vo.NotificationChannel, locale string) ([]*entity.NotificationTemplate, error)
+
+	// ListLatest retrieves the latest version of every template, by event, channel and locale
+	ListLatest(ctx context.Context) ([]*entity.NotificationTemplate, error)
+}
@@ -0,0 +1,22 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/hydr0g3nz/mini_bank/internal/domain/event"
+)
+
+type OutboxRepository interface {
+	// Append stores an event for later delivery; an event already stored is left as it is
+	Append(ctx context.Context, evt event.Event) error
+
+	// ListPending retrieves undelivered entries in outbox order. In a unit of work the entries stay
+	// locked until it ends, and entries another unit of work has locked are skipped.
+	ListPending(ctx context.Context, limit int) ([]*event.OutboxEntry, error)
+
+	// MarkPublished records that an entry was delivered
+	MarkPublished(ctx context.Context, sequence uint64) error
+
+	// MarkFailed records a failed delivery attempt
+	MarkFailed(ctx context.Context, sequence uint64, reason string) error
+}
@@ -0,0 +1,39 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+)
+
+// OwnershipTransferFilter narrows the ownership transfers listed; zero fields match everything
+type OwnershipTransferFilter struct {
+	AccountID  string
+	CustomerID string // Matches transfers from or to the customer
+	Status     vo.OwnershipTransferStatus
+}
+
+type OwnershipTransferRepository interface {
+	// Create records a new ownership transfer
+	Create(ctx context.Context, transfer *entity.OwnershipTransfer) error
+
+	// GetByID retrieves an ownership transfer by ID
+	GetByID(ctx context.Context, id string) (*entity.OwnershipTransfer, error)
+
+	// GetOpenByAccount retrieves the account's transfer that is pending or approved
+	GetOpenByAccount(ctx context.Context, accountID vo.AccountID) (*entity.OwnershipTransfer, error)
+
+	// Update updates an existing ownership transfer
+	Update(ctx context.Context, transfer *entity.OwnershipTransfer) error
+
+	// List retrieves the matching transfers, newest first
+	List(ctx context.Context, filter OwnershipTransferFilter, limit, offset int) ([]*entity.OwnershipTransfer, error)
+
+	// Count counts the matching transfers
+	Count(ctx context.Context, filter OwnershipTransferFilter) (int64, error)
+
+	// ListDue retrieves up to limit approved transfers effective on or before the date, earliest first
+	ListDue(ctx context.Context, date time.Time, limit int) ([]*entity.OwnershipTransfer, error)
+}
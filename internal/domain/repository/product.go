@@ -0,0 +1,21 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+)
+
+type ProductRepository interface {
+	// Create creates a new product
+	Create(ctx context.Context, product *entity.Product) error
+
+	// GetByID retrieves a product by ID
+	GetByID(ctx context.Context, id string) (*entity.Product, error)
+
+	// Update updates an existing product
+	Update(ctx context.Context, product *entity.Product) error
+
+	// List retrieves all products, oldest first
+	List(ctx context.Context) ([]*entity.Product, error)
+}
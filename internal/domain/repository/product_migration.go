@@ -0,0 +1,35 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+)
+
+// ProductMigrationFilter narrows the product migrations listed; zero fields match everything
+type ProductMigrationFilter struct {
+	ProductID string // Matches migrations from or to the product
+	Status    vo.ProductMigrationStatus
+}
+
+type ProductMigrationRepository interface {
+	// Create records a new product migration
+	Create(ctx context.Context, migration *entity.ProductMigration) error
+
+	// GetByID retrieves a product migration by ID
+	GetByID(ctx context.Context, id string) (*entity.ProductMigration, error)
+
+	// Update updates an existing product migration
+	Update(ctx context.Context, migration *entity.ProductMigration) error
+
+	// List retrieves the matching migrations, newest first
+	List(ctx context.Context, filter ProductMigrationFilter, limit, offset int) ([]*entity.ProductMigration, error)
+
+	// Count counts the matching migrations
+	Count(ctx context.Context, filter ProductMigrationFilter) (int64, error)
+
+	// ListDue retrieves up to limit scheduled migrations effective on or before the date, earliest first
+	ListDue(ctx context.Context, date time.Time, limit int) ([]*entity.ProductMigration, error)
+}
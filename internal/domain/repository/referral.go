@@ -0,0 +1,43 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+)
+
+type ReferralRepository interface {
+	// CreateCode saves a referral code. It returns false when the account already has a code
+	// or the code is taken.
+	CreateCode(ctx context.Context, code *entity.ReferralCode) (bool, error)
+
+	// GetCode retrieves a referral code
+	GetCode(ctx context.Context, code string) (*entity.ReferralCode, error)
+
+	// GetCodeByAccountID retrieves the referral code of an account
+	GetCodeByAccountID(ctx context.Context, accountID vo.AccountID) (*entity.ReferralCode, error)
+
+	// Create saves a redeemed referral. It returns false when the referee account was already referred.
+	Create(ctx context.Context, referral *entity.Referral) (bool, error)
+
+	// GetPendingByRefereeAccountID retrieves the referral of an account still waiting for its qualifying payment
+	GetPendingByRefereeAccountID(ctx context.Context, accountID vo.AccountID) (*entity.Referral, error)
+
+	// MarkRewarded saves a rewarded referral. It returns false when the referral was no longer
+	// pending, so the bonuses are paid only once.
+	MarkRewarded(ctx context.Context, referral *entity.Referral) (bool, error)
+
+	// CountByRefereeCustomerID counts the referrals redeemed by accounts of a customer
+	CountByRefereeCustomerID(ctx context.Context, customerID string) (int64, error)
+
+	// CountByReferrerSince counts the referrals of a referrer redeemed since the time
+	CountByReferrerSince(ctx context.Context, accountID vo.AccountID, since time.Time) (int64, error)
+
+	// ListByReferrerAccountID retrieves the referrals an account made, newest first
+	ListByReferrerAccountID(ctx context.Context, accountID vo.AccountID, limit, offset int) ([]*entity.Referral, error)
+
+	// CountByReferrerAccountID counts the referrals an account made
+	CountByReferrerAccountID(ctx context.Context, accountID vo.AccountID) (int64, error)
+}
@@ -0,0 +1,22 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+)
+
+type SagaRepository interface {
+	// Create records a new saga
+	Create(ctx context.Context, saga *entity.Saga) error
+
+	// GetByID retrieves a saga by ID
+	GetByID(ctx context.Context, id string) (*entity.Saga, error)
+
+	// GetByTransactionID retrieves the most recent saga for a transaction
+	GetByTransactionID(ctx context.Context, transactionID vo.TransactionID) (*entity.Saga, error)
+
+	// Update updates an existing saga
+	Update(ctx context.Context, saga *entity.Saga) error
+}
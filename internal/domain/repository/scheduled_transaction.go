@@ -0,0 +1,39 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+)
+
+// ScheduledTransactionFilter narrows the scheduled transactions listed; zero fields match everything
+type ScheduledTransactionFilter struct {
+	AccountID string // Matches schedules paying from or to the account
+	Status    vo.ScheduledTransactionStatus
+}
+
+type ScheduledTransactionRepository interface {
+	// Create creates a new scheduled transaction
+	Create(ctx context.Context, scheduled *entity.ScheduledTransaction) error
+
+	// GetByID retrieves a scheduled transaction by ID
+	GetByID(ctx context.Context, id string) (*entity.ScheduledTransaction, error)
+
+	// Update updates an existing scheduled transaction
+	Update(ctx context.Context, scheduled *entity.ScheduledTransaction) error
+
+	// Delete removes a scheduled transaction
+	Delete(ctx context.Context, id string) error
+
+	// List retrieves the matching scheduled transactions, newest first
+	List(ctx context.Context, filter ScheduledTransactionFilter, limit, offset int) ([]*entity.ScheduledTransaction, error)
+
+	// Count counts the matching scheduled transactions
+	Count(ctx context.Context, filter ScheduledTransactionFilter) (int64, error)
+
+	// ListDue retrieves up to limit active scheduled transactions whose next run is at or before the
+	// given time, earliest first
+	ListDue(ctx context.Context, now time.Time, limit int) ([]*entity.ScheduledTransaction, error)
+}
@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
 	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
@@ -14,15 +15,78 @@ type TransactionRepository interface {
 	// GetByID retrieves a transaction by ID
 	GetByID(ctx context.Context, id vo.TransactionID) (*entity.Transaction, error)
 
-	// Update updates an existing transaction
+	// GetByIDs retrieves several transactions in one query, keyed by ID; IDs without a transaction are left out
+	GetByIDs(ctx context.Context, ids []vo.TransactionID) (map[vo.TransactionID]*entity.Transaction, error)
+
+	// Update updates an existing transaction as long as its processing token is unchanged,
+	// and returns errs.ErrStaleFencingToken when a newer claim took it over
 	Update(ctx context.Context, transaction *entity.Transaction) error
 
+	// ClaimProcessing records the fencing token of the worker about to process a transaction and reports
+	// whether it was claimed; a claim only succeeds with a token greater than the current one
+	ClaimProcessing(ctx context.Context, transaction *entity.Transaction, token int64) (bool, error)
+
 	// List retrieves transactions with pagination
 	List(ctx context.Context, limit, offset int) ([]*entity.Transaction, error)
 
+	// Count returns the total number of transactions
+	Count(ctx context.Context) (int64, error)
+
 	// GetByAccountID retrieves transactions for a specific account
 	GetByAccountID(ctx context.Context, accountID vo.AccountID, limit, offset int) ([]*entity.Transaction, error)
 
+	// CountByAccountID returns the number of transactions for a specific account
+	CountByAccountID(ctx context.Context, accountID vo.AccountID) (int64, error)
+
+	// GetByVirtualAccountID retrieves the transactions paid to a virtual account number
+	GetByVirtualAccountID(ctx context.Context, virtualAccountID string, limit, offset int) ([]*entity.Transaction, error)
+
+	// CountByVirtualAccountID returns the number of transactions paid to a virtual account number
+	CountByVirtualAccountID(ctx context.Context, virtualAccountID string) (int64, error)
+
 	// GetByStatus retrieves transactions by status
 	GetByStatus(ctx context.Context, status vo.TransactionStatus, limit, offset int) ([]*entity.Transaction, error)
+
+	// CountByStatus returns the number of transactions with a status
+	CountByStatus(ctx context.Context, status vo.TransactionStatus) (int64, error)
+
+	// GetFailedByKind retrieves failed transactions by why they failed, oldest first
+	GetFailedByKind(ctx context.Context, kind vo.FailureKind, limit, offset int) ([]*entity.Transaction, error)
+
+	// CountFailedByKind returns the number of failed transactions that failed for a reason
+	CountFailedByKind(ctx context.Context, kind vo.FailureKind) (int64, error)
+
+	// GetByChannel retrieves transactions initiated through a channel
+	GetByChannel(ctx context.Context, channel vo.TransactionChannel, limit, offset int) ([]*entity.Transaction, error)
+
+	// CountByChannel returns the number of transactions initiated through a channel
+	CountByChannel(ctx context.Context, channel vo.TransactionChannel) (int64, error)
+
+	// GetOverdueReviews retrieves transactions still in review whose review deadline is before the given time, oldest deadline first
+	GetOverdueReviews(ctx context.Context, before time.Time, limit int) ([]*entity.Transaction, error)
+
+	// GetCompletedByAccountIDSince retrieves completed transactions of an account completed after the given time
+	GetCompletedByAccountIDSince(ctx context.Context, accountID vo.AccountID, since time.Time) ([]*entity.Transaction, error)
+
+	// GetCompletedByAccountIDBetween retrieves completed transactions of an account completed from from up to,
+	// not including, until, oldest first
+	GetCompletedByAccountIDBetween(ctx context.Context, accountID vo.AccountID, from, until time.Time) ([]*entity.Transaction, error)
+
+	// SumBalanceEffectSince totals what the transactions of an account completed from the given time on did
+	// to its balance: the credits it received less the amounts and fees taken from it
+	SumBalanceEffectSince(ctx context.Context, accountID vo.AccountID, since time.Time) (vo.Money, error)
+
+	// GetCompletedAdjustments retrieves completed ADJUSTMENT transactions with a value date from from up to,
+	// not including, until, by value date
+	GetCompletedAdjustments(ctx context.Context, from, until time.Time) ([]*entity.Transaction, error)
+
+	// GetByReversedTransactionID retrieves the REVERSAL transactions recorded against a transaction, in any status
+	GetByReversedTransactionID(ctx context.Context, id vo.TransactionID) ([]*entity.Transaction, error)
+
+	// GetLastSequence returns the sequence number of the latest completed transaction of an account, 0 when there is none
+	GetLastSequence(ctx context.Context, accountID vo.AccountID) (int64, error)
+
+	// GetChangedByAccountIDSince retrieves up to limit transactions of an account written after the given
+	// change number, in change order, each in its latest state
+	GetChangedByAccountIDSince(ctx context.Context, accountID vo.AccountID, sinceChange int64, limit int) ([]*entity.Transaction, error)
 }
@@ -0,0 +1,35 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+)
+
+// TransactionBlockFilter narrows the transaction blocks listed; zero fields match everything
+type TransactionBlockFilter struct {
+	AccountID string
+	ActiveAt  *time.Time // Only blocks in force at this time
+}
+
+type TransactionBlockRepository interface {
+	// Create records a new transaction block
+	Create(ctx context.Context, block *entity.TransactionBlock) error
+
+	// GetByID retrieves a transaction block by ID
+	GetByID(ctx context.Context, id string) (*entity.TransactionBlock, error)
+
+	// Update updates an existing transaction block
+	Update(ctx context.Context, block *entity.TransactionBlock) error
+
+	// List retrieves the matching blocks, newest first
+	List(ctx context.Context, filter TransactionBlockFilter, limit, offset int) ([]*entity.TransactionBlock, error)
+
+	// Count counts the matching blocks
+	Count(ctx context.Context, filter TransactionBlockFilter) (int64, error)
+
+	// ListActive retrieves every block in force on the accounts at the given time
+	ListActive(ctx context.Context, accountIDs []vo.AccountID, now time.Time) ([]*entity.TransactionBlock, error)
+}
@@ -0,0 +1,34 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+)
+
+// HistoryFilter narrows the history entries listed; zero fields match everything
+type HistoryFilter struct {
+	Tags            []string // Entries the account tagged with any of them
+	Direction       entity.HistoryDirection
+	TransactionType vo.TransactionType
+}
+
+type TransactionHistoryRepository interface {
+	// Upsert inserts history entries or replaces the ones already projected. The balance after a
+	// transaction is kept once recorded, so projecting an entry again cannot move it.
+	Upsert(ctx context.Context, entries []*entity.HistoryEntry) error
+
+	// ReplaceByAccountID replaces an account's whole history, recorded balances included, in one transaction
+	ReplaceByAccountID(ctx context.Context, accountID vo.AccountID, entries []*entity.HistoryEntry) error
+
+	// ListByAccountID retrieves the matching history of an account, newest first
+	ListByAccountID(ctx context.Context, accountID vo.AccountID, filter HistoryFilter, limit, offset int) ([]*entity.HistoryEntry, error)
+
+	// ListByAccountIDAfterSequence retrieves up to limit completed history entries of an account with a
+	// sequence number above afterSequence, in sequence order
+	ListByAccountIDAfterSequence(ctx context.Context, accountID vo.AccountID, afterSequence int64, limit int) ([]*entity.HistoryEntry, error)
+
+	// CountByAccountID returns the number of matching history entries of an account
+	CountByAccountID(ctx context.Context, accountID vo.AccountID, filter HistoryFilter) (int64, error)
+}
@@ -0,0 +1,33 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+)
+
+type TransactionTagRepository interface {
+	// ReplaceTags replaces an account's tags of a transaction in one transaction; no tags clears them
+	ReplaceTags(ctx context.Context, accountID vo.AccountID, transactionID vo.TransactionID, tags []*entity.TransactionTag) error
+
+	// ListByTransactionIDs retrieves an account's tags of the given transactions
+	ListByTransactionIDs(ctx context.Context, accountID vo.AccountID, transactionIDs []vo.TransactionID) ([]*entity.TransactionTag, error)
+}
+
+type SavedFilterRepository interface {
+	// Create creates a new saved filter
+	Create(ctx context.Context, filter *entity.SavedFilter) error
+
+	// GetByID retrieves a saved filter by ID
+	GetByID(ctx context.Context, id string) (*entity.SavedFilter, error)
+
+	// Delete removes a saved filter
+	Delete(ctx context.Context, id string) error
+
+	// ListByAccountID retrieves the saved filters of an account by name
+	ListByAccountID(ctx context.Context, accountID vo.AccountID) ([]*entity.SavedFilter, error)
+
+	// CountByAccountID returns the number of filters an account saved
+	CountByAccountID(ctx context.Context, accountID vo.AccountID) (int64, error)
+}
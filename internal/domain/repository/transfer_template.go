@@ -0,0 +1,25 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+)
+
+type TransferTemplateRepository interface {
+	// Create creates a new transfer template
+	Create(ctx context.Context, template *entity.TransferTemplate) error
+
+	// GetByID retrieves a transfer template by ID
+	GetByID(ctx context.Context, id string) (*entity.TransferTemplate, error)
+
+	// Update updates an existing transfer template
+	Update(ctx context.Context, template *entity.TransferTemplate) error
+
+	// Delete removes a transfer template
+	Delete(ctx context.Context, id string) error
+
+	// ListByAccountID retrieves the transfer templates of an account by name
+	ListByAccountID(ctx context.Context, accountID vo.AccountID) ([]*entity.TransferTemplate, error)
+}
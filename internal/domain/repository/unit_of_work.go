@@ -0,0 +1,31 @@
+package repository
+
+import "context"
+
+// UnitOfWork runs several repository calls in one database transaction
+type UnitOfWork interface {
+	// Do runs fn in a database transaction, committed when fn returns nil and rolled back otherwise.
+	// Repository calls made with the context fn receives join the transaction; a unit started inside
+	// another joins the outer one.
+	Do(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+type unitOfWorkKey struct{}
+
+// WithUnitOfWork returns a context whose repository calls join the database transaction tx, for
+// implementations of UnitOfWork
+func WithUnitOfWork(ctx context.Context, tx interface{}) context.Context {
+	return context.WithValue(ctx, unitOfWorkKey{}, tx)
+}
+
+// UnitOfWorkFromContext returns the database transaction the repository calls of ctx join, nil
+// outside a unit of work
+func UnitOfWorkFromContext(ctx context.Context) interface{} {
+	return ctx.Value(unitOfWorkKey{})
+}
+
+// WithoutUnitOfWork returns a context whose repository calls run outside the unit of work of ctx, for
+// work done on behalf of several callers
+func WithoutUnitOfWork(ctx context.Context) context.Context {
+	return context.WithValue(ctx, unitOfWorkKey{}, nil)
+}
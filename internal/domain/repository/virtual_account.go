@@ -0,0 +1,28 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+)
+
+type VirtualAccountRepository interface {
+	// Create creates a new virtual account
+	Create(ctx context.Context, virtualAccount *entity.VirtualAccount) error
+
+	// GetByID retrieves a virtual account by its virtual number
+	GetByID(ctx context.Context, id string) (*entity.VirtualAccount, error)
+
+	// Update updates an existing virtual account
+	Update(ctx context.Context, virtualAccount *entity.VirtualAccount) error
+
+	// Delete deletes a virtual account by its virtual number
+	Delete(ctx context.Context, id string) error
+
+	// ListByAccountID retrieves the virtual accounts of a settlement account, oldest first
+	ListByAccountID(ctx context.Context, accountID vo.AccountID, limit, offset int) ([]*entity.VirtualAccount, error)
+
+	// CountByAccountID returns the number of virtual accounts of a settlement account
+	CountByAccountID(ctx context.Context, accountID vo.AccountID) (int64, error)
+}
@@ -0,0 +1,25 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+)
+
+type WebhookRepository interface {
+	// Create creates a new webhook subscription
+	Create(ctx context.Context, webhook *entity.WebhookSubscription) error
+
+	// GetByID retrieves a webhook subscription by ID
+	GetByID(ctx context.Context, id string) (*entity.WebhookSubscription, error)
+
+	// Update updates an existing webhook subscription
+	Update(ctx context.Context, webhook *entity.WebhookSubscription) error
+
+	// Delete deletes a webhook subscription by ID
+	Delete(ctx context.Context, id string) error
+
+	// ListByAccountID retrieves the webhook subscriptions of an account, oldest first
+	ListByAccountID(ctx context.Context, accountID vo.AccountID) ([]*entity.WebhookSubscription, error)
+}
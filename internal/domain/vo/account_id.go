@@ -4,6 +4,7 @@ import (
 	"crypto/rand"
 	"fmt"
 	"math/big"
+	"sort"
 	"strconv"
 	"time"
 
@@ -52,6 +53,24 @@ func (id AccountID) IsValid() bool {
 	return validateAccountID(id.value) == nil
 }
 
+// LockOrder returns the distinct IDs in the order their accounts must be locked in. Every caller
+// locking several accounts takes them in ascending ID order, so an A to B transfer and a B to A
+// transfer wait on the same account first instead of each holding the lock the other needs.
+func LockOrder(ids ...AccountID) []AccountID {
+	ordered := make([]AccountID, 0, len(ids))
+	seen := make(map[AccountID]bool, len(ids))
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			ordered = append(ordered, id)
+		}
+	}
+	sort.Slice(ordered, func(i, j int) bool {
+		return ordered[i].value < ordered[j].value
+	})
+	return ordered
+}
+
 func validateAccountID(id string) error {
 	if id == "" {
 		return errs.ErrInvalidAccountID
@@ -330,3 +330,18 @@ func TestAccountID_Comparison(t *testing.T) {
 
 	assert.Equal(t, id3.String(), id4.String())
 }
+
+func TestLockOrder(t *testing.T) {
+	a, err := NewAccountIDFromString("2024072900000001")
+	require.NoError(t, err)
+	b, err := NewAccountIDFromString("2024072900000002")
+	require.NoError(t, err)
+
+	// Both directions of a transfer lock the same account first
+	assert.Equal(t, []AccountID{a, b}, LockOrder(a, b))
+	assert.Equal(t, []AccountID{a, b}, LockOrder(b, a))
+
+	// An account is locked once
+	assert.Equal(t, []AccountID{a}, LockOrder(a, a))
+	assert.Empty(t, LockOrder())
+}
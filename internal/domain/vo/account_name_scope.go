@@ -0,0 +1,38 @@
+package vo
+
+import (
+	"fmt"
+	"strings"
+)
+
+// AccountNameScope tells among which accounts an account name must be unique
+type AccountNameScope string
+
+const (
+	AccountNameScopeGlobal   AccountNameScope = "GLOBAL"   // No two accounts share a name
+	AccountNameScopeCustomer AccountNameScope = "CUSTOMER" // No two accounts of one customer share a name
+	AccountNameScopeNone     AccountNameScope = "NONE"     // Duplicate names are allowed
+)
+
+// NewAccountNameScope parses an account name scope; an empty scope defaults to CUSTOMER
+func NewAccountNameScope(scope string) (AccountNameScope, error) {
+	if scope == "" {
+		return AccountNameScopeCustomer, nil
+	}
+
+	s := AccountNameScope(strings.ToUpper(strings.TrimSpace(scope)))
+	if !s.IsValid() {
+		return "", fmt.Errorf("invalid account name scope %q", scope)
+	}
+	return s, nil
+}
+
+// IsValid checks if account name scope is valid
+func (s AccountNameScope) IsValid() bool {
+	switch s {
+	case AccountNameScopeGlobal, AccountNameScopeCustomer, AccountNameScopeNone:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,37 @@
+package vo
+
+// AdjustmentReason is the reason code an admin gives for correcting an account's balance with an ADJUSTMENT
+type AdjustmentReason string
+
+const (
+	AdjustmentReasonBookingError       AdjustmentReason = "BOOKING_ERROR"       // A transaction was booked with the wrong amount or account
+	AdjustmentReasonSystemError        AdjustmentReason = "SYSTEM_ERROR"        // A processing fault left the balance wrong
+	AdjustmentReasonFeeCorrection      AdjustmentReason = "FEE_CORRECTION"      // A fee was charged wrongly and is not refunded through a reversal
+	AdjustmentReasonInterestCorrection AdjustmentReason = "INTEREST_CORRECTION" // Interest was paid or charged wrongly
+	AdjustmentReasonGoodwill           AdjustmentReason = "GOODWILL"            // A gesture towards the customer
+	AdjustmentReasonLegalOrder         AdjustmentReason = "LEGAL_ORDER"         // Ordered by a court or regulator
+)
+
+// IsValid checks if adjustment reason is valid
+func (r AdjustmentReason) IsValid() bool {
+	switch r {
+	case AdjustmentReasonBookingError, AdjustmentReasonSystemError, AdjustmentReasonFeeCorrection,
+		AdjustmentReasonInterestCorrection, AdjustmentReasonGoodwill, AdjustmentReasonLegalOrder:
+		return true
+	default:
+		return false
+	}
+}
+
+// AdjustmentDirection tells whether an adjustment raises or lowers an account's balance
+type AdjustmentDirection string
+
+const (
+	AdjustmentDirectionIncrease AdjustmentDirection = "INCREASE"
+	AdjustmentDirectionDecrease AdjustmentDirection = "DECREASE"
+)
+
+// IsValid checks if adjustment direction is valid
+func (d AdjustmentDirection) IsValid() bool {
+	return d == AdjustmentDirectionIncrease || d == AdjustmentDirectionDecrease
+}
@@ -0,0 +1,78 @@
+package vo
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// AdminAnomaly is a reason an admin action looks unlike the admin's usual activity
+type AdminAnomaly string
+
+const (
+	AdminAnomalyNewIP          AdminAnomaly = "NEW_IP"          // First action of the admin from this IP address
+	AdminAnomalyUnusualHours   AdminAnomaly = "UNUSUAL_HOURS"   // Outside the admins' working hours
+	AdminAnomalyMassOperations AdminAnomaly = "MASS_OPERATIONS" // More changes in a short window than the threshold
+)
+
+// AdminAnomalies lists every anomaly in a stable order
+func AdminAnomalies() []AdminAnomaly {
+	return []AdminAnomaly{AdminAnomalyNewIP, AdminAnomalyUnusualHours, AdminAnomalyMassOperations}
+}
+
+// AdminWorkingHours is the daily window in which admin activity is expected; actions outside it are
+// unusual. A window whose end is before its start runs past midnight.
+type AdminWorkingHours struct {
+	Start    time.Duration // Offset from midnight
+	End      time.Duration // Offset from midnight; equal to Start when every hour is usual
+	Location *time.Location
+}
+
+// NewAdminWorkingHours creates working hours from a "HH:MM-HH:MM" window and an IANA time zone; an
+// empty window makes every hour usual
+func NewAdminWorkingHours(window, timezone string) (AdminWorkingHours, error) {
+	location, err := time.LoadLocation(timezone)
+	if err != nil {
+		return AdminWorkingHours{}, fmt.Errorf("invalid time zone %q: %w", timezone, err)
+	}
+	if window == "" {
+		return AdminWorkingHours{Location: location}, nil
+	}
+
+	start, end, ok := strings.Cut(window, "-")
+	if !ok {
+		return AdminWorkingHours{}, fmt.Errorf("invalid working hours %q, expected HH:MM-HH:MM", window)
+	}
+
+	hours := AdminWorkingHours{Location: location}
+	for _, bound := range []struct {
+		value  string
+		target *time.Duration
+	}{{start, &hours.Start}, {end, &hours.End}} {
+		parsed, err := time.Parse("15:04", strings.TrimSpace(bound.value))
+		if err != nil {
+			return AdminWorkingHours{}, fmt.Errorf("invalid working hours %q, expected HH:MM-HH:MM: %w", window, err)
+		}
+		*bound.target = time.Duration(parsed.Hour())*time.Hour + time.Duration(parsed.Minute())*time.Minute
+	}
+	return hours, nil
+}
+
+// Contains checks if the instant falls within the working hours of its day
+func (h AdminWorkingHours) Contains(t time.Time) bool {
+	if h.Start == h.End {
+		return true
+	}
+
+	location := h.Location
+	if location == nil {
+		location = time.UTC
+	}
+	local := t.In(location)
+	offset := local.Sub(DateOf(local))
+
+	if h.Start < h.End {
+		return offset >= h.Start && offset < h.End
+	}
+	return offset >= h.Start || offset < h.End
+}
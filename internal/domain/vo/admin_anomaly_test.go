@@ -0,0 +1,64 @@
+package vo
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewAdminWorkingHours(t *testing.T) {
+	tests := []struct {
+		name     string
+		window   string
+		timezone string
+		wantErr  bool
+	}{
+		{name: "valid", window: "08:00-19:30", timezone: "Asia/Bangkok"},
+		{name: "every hour", window: "", timezone: "UTC"},
+		{name: "missing end", window: "08:00", timezone: "UTC", wantErr: true},
+		{name: "invalid bound", window: "8am-7pm", timezone: "UTC", wantErr: true},
+		{name: "invalid time zone", window: "08:00-19:00", timezone: "Mars/Olympus", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewAdminWorkingHours(tt.window, tt.timezone)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestAdminWorkingHours_Contains(t *testing.T) {
+	day := func(hour, minute int) time.Time {
+		return time.Date(2026, 3, 2, hour, minute, 0, 0, time.UTC)
+	}
+
+	office, err := NewAdminWorkingHours("08:00-19:00", "UTC")
+	require.NoError(t, err)
+	assert.True(t, office.Contains(day(8, 0)))
+	assert.True(t, office.Contains(day(18, 59)))
+	assert.False(t, office.Contains(day(19, 0)))
+	assert.False(t, office.Contains(day(3, 0)))
+
+	// A night shift runs past midnight
+	night, err := NewAdminWorkingHours("22:00-06:00", "UTC")
+	require.NoError(t, err)
+	assert.True(t, night.Contains(day(23, 0)))
+	assert.True(t, night.Contains(day(5, 59)))
+	assert.False(t, night.Contains(day(12, 0)))
+
+	// The window is read in its own time zone: 02:00 UTC is 09:00 in Bangkok
+	bangkok, err := NewAdminWorkingHours("08:00-19:00", "Asia/Bangkok")
+	require.NoError(t, err)
+	assert.True(t, bangkok.Contains(day(2, 0)))
+
+	always, err := NewAdminWorkingHours("", "UTC")
+	require.NoError(t, err)
+	assert.True(t, always.Contains(day(3, 0)))
+}
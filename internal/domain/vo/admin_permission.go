@@ -0,0 +1,57 @@
+package vo
+
+// AdminPermission is an action on the API an admin user can be granted through a role
+type AdminPermission string
+
+const (
+	AdminPermissionViewAccounts        AdminPermission = "accounts:view"        // Read accounts and their transactions
+	AdminPermissionMutateAccounts      AdminPermission = "accounts:mutate"      // Open, change, suspend and close accounts
+	AdminPermissionApproveTransactions AdminPermission = "transactions:approve" // Work the review queue of held transactions
+	AdminPermissionManageWebhooks      AdminPermission = "webhooks:manage"      // Subscribe accounts to webhooks and manage them
+	AdminPermissionViewAudit           AdminPermission = "audit:view"           // Export the audit log and review admin activity
+	AdminPermissionManageAdmins        AdminPermission = "admins:manage"        // Add admin users and change their roles
+)
+
+// AdminPermissions lists every permission in a stable order
+func AdminPermissions() []AdminPermission {
+	return []AdminPermission{
+		AdminPermissionViewAccounts, AdminPermissionMutateAccounts, AdminPermissionApproveTransactions,
+		AdminPermissionManageWebhooks, AdminPermissionViewAudit, AdminPermissionManageAdmins,
+	}
+}
+
+// AdminRole is a named set of permissions assigned to admin users
+type AdminRole string
+
+const (
+	AdminRoleViewer     AdminRole = "VIEWER"
+	AdminRoleOperator   AdminRole = "OPERATOR"
+	AdminRoleApprover   AdminRole = "APPROVER"
+	AdminRoleAuditor    AdminRole = "AUDITOR"
+	AdminRoleSuperAdmin AdminRole = "SUPER_ADMIN"
+)
+
+// adminRolePermissions is the permissions matrix: the permissions each role grants
+var adminRolePermissions = map[AdminRole][]AdminPermission{
+	AdminRoleViewer:     {AdminPermissionViewAccounts},
+	AdminRoleOperator:   {AdminPermissionViewAccounts, AdminPermissionMutateAccounts, AdminPermissionManageWebhooks},
+	AdminRoleApprover:   {AdminPermissionViewAccounts, AdminPermissionApproveTransactions},
+	AdminRoleAuditor:    {AdminPermissionViewAccounts, AdminPermissionViewAudit},
+	AdminRoleSuperAdmin: AdminPermissions(),
+}
+
+// AdminRoles lists every role in a stable order
+func AdminRoles() []AdminRole {
+	return []AdminRole{AdminRoleViewer, AdminRoleOperator, AdminRoleApprover, AdminRoleAuditor, AdminRoleSuperAdmin}
+}
+
+// IsValid checks if admin role is valid
+func (r AdminRole) IsValid() bool {
+	_, ok := adminRolePermissions[r]
+	return ok
+}
+
+// Permissions returns the permissions the role grants
+func (r AdminRole) Permissions() []AdminPermission {
+	return append([]AdminPermission(nil), adminRolePermissions[r]...)
+}
@@ -0,0 +1,30 @@
+package vo
+
+// BackupStatus represents the lifecycle state of a database backup
+type BackupStatus string
+
+const (
+	BackupStatusRunning   BackupStatus = "RUNNING"
+	BackupStatusCompleted BackupStatus = "COMPLETED"
+	BackupStatusFailed    BackupStatus = "FAILED"
+)
+
+// IsValid checks if backup status is valid
+func (s BackupStatus) IsValid() bool {
+	switch s {
+	case BackupStatusRunning, BackupStatusCompleted, BackupStatusFailed:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsRunning checks if backup is still running
+func (s BackupStatus) IsRunning() bool {
+	return s == BackupStatusRunning
+}
+
+// IsFinished checks if backup reached a terminal state
+func (s BackupStatus) IsFinished() bool {
+	return s == BackupStatusCompleted || s == BackupStatusFailed
+}
@@ -0,0 +1,168 @@
+package vo
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// cronSearchLimit bounds how far ahead a schedule's next run is looked for, so a schedule that can
+// never match, such as February 30th, is told apart from one that matches rarely
+const cronSearchLimit = 5 * 366 * 24 * time.Hour
+
+// cronField describes one of the five fields of a cron expression
+type cronField struct {
+	name     string
+	min, max int
+}
+
+var cronFields = [5]cronField{
+	{name: "minute", min: 0, max: 59},
+	{name: "hour", min: 0, max: 23},
+	{name: "day of month", min: 1, max: 31},
+	{name: "month", min: 1, max: 12},
+	{name: "day of week", min: 0, max: 7}, // 0 and 7 are both Sunday
+}
+
+// CronSchedule is a recurrence in the five-field cron format: minute, hour, day of month, month and
+// day of week, e.g. "0 9 1 * *" for 09:00 on the first of every month. A field is "*", a value, a
+// range "1-5", a step "*/15" or "1-20/5", or a comma-separated list of those. When both the day of
+// month and the day of week are restricted, a day matching either one matches, as in cron.
+type CronSchedule struct {
+	expression string
+	fields     [5]uint64 // Bit n is set when value n matches
+	anyDay     bool      // The day of month is "*"
+	anyWeekday bool      // The day of week is "*"
+}
+
+// NewCronSchedule parses a five-field cron expression
+func NewCronSchedule(expression string) (CronSchedule, error) {
+	parts := strings.Fields(expression)
+	if len(parts) != len(cronFields) {
+		return CronSchedule{}, fmt.Errorf("cron expression %q must have 5 fields: minute, hour, day of month, month and day of week", expression)
+	}
+
+	schedule := CronSchedule{
+		expression: strings.Join(parts, " "),
+		anyDay:     parts[2] == "*",
+		anyWeekday: parts[4] == "*",
+	}
+	for i, part := range parts {
+		bits, err := parseCronField(part, cronFields[i])
+		if err != nil {
+			return CronSchedule{}, err
+		}
+		schedule.fields[i] = bits
+	}
+
+	// Sunday matches as 0 whichever way it was written
+	if schedule.fields[4]&(1<<7) != 0 {
+		schedule.fields[4] |= 1
+	}
+
+	return schedule, nil
+}
+
+// parseCronField parses one field of a cron expression into the set of values it matches
+func parseCronField(value string, field cronField) (uint64, error) {
+	var bits uint64
+	for _, item := range strings.Split(value, ",") {
+		rangePart, stepPart, hasStep := strings.Cut(item, "/")
+
+		step := 1
+		if hasStep {
+			parsed, err := strconv.Atoi(stepPart)
+			if err != nil || parsed < 1 {
+				return 0, fmt.Errorf("invalid step %q in %s field", stepPart, field.name)
+			}
+			step = parsed
+		}
+
+		low, high := field.min, field.max
+		if rangePart != "*" {
+			from, to, isRange := strings.Cut(rangePart, "-")
+			var err error
+			if low, err = parseCronValue(from, field); err != nil {
+				return 0, err
+			}
+			high = low
+			if isRange {
+				if high, err = parseCronValue(to, field); err != nil {
+					return 0, err
+				}
+				if high < low {
+					return 0, fmt.Errorf("invalid range %q in %s field", rangePart, field.name)
+				}
+			} else if hasStep {
+				// "5/15" steps from 5 to the end of the field
+				high = field.max
+			}
+		}
+
+		for v := low; v <= high; v += step {
+			bits |= 1 << v
+		}
+	}
+	return bits, nil
+}
+
+// parseCronValue parses a single value of a cron field
+func parseCronValue(value string, field cronField) (int, error) {
+	parsed, err := strconv.Atoi(value)
+	if err != nil || parsed < field.min || parsed > field.max {
+		return 0, fmt.Errorf("invalid value %q in %s field, expected %d to %d", value, field.name, field.min, field.max)
+	}
+	return parsed, nil
+}
+
+// String returns the cron expression
+func (c CronSchedule) String() string {
+	return c.expression
+}
+
+// IsZero checks if the schedule was never parsed
+func (c CronSchedule) IsZero() bool {
+	return c.expression == ""
+}
+
+// Next returns the first minute strictly after the given time the schedule matches, in the time's
+// location, or the zero time when it matches none within five years
+func (c CronSchedule) Next(after time.Time) time.Time {
+	if c.IsZero() {
+		return time.Time{}
+	}
+
+	t := after.Truncate(time.Minute).Add(time.Minute)
+	limit := t.Add(cronSearchLimit)
+	for t.Before(limit) {
+		switch {
+		case !c.matches(3, int(t.Month())):
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, t.Location())
+		case !c.matchesDay(t):
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, t.Location())
+		case !c.matches(1, t.Hour()):
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, t.Location())
+		case !c.matches(0, t.Minute()):
+			t = t.Truncate(time.Minute).Add(time.Minute)
+		default:
+			return t
+		}
+	}
+	return time.Time{}
+}
+
+// matches checks if a value is in a field's set
+func (c CronSchedule) matches(field, value int) bool {
+	return c.fields[field]&(1<<value) != 0
+}
+
+// matchesDay checks the day of month and the day of week together
+func (c CronSchedule) matchesDay(t time.Time) bool {
+	day := c.matches(2, t.Day())
+	weekday := c.matches(4, int(t.Weekday()))
+	if c.anyDay || c.anyWeekday {
+		return day && weekday
+	}
+	return day || weekday
+}
@@ -0,0 +1,44 @@
+package vo
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCronSchedule_Next(t *testing.T) {
+	from := time.Date(2026, time.January, 30, 10, 17, 45, 0, time.UTC) // A Friday
+
+	tests := []struct {
+		expression string
+		expected   time.Time
+	}{
+		{expression: "* * * * *", expected: time.Date(2026, time.January, 30, 10, 18, 0, 0, time.UTC)},
+		{expression: "*/15 * * * *", expected: time.Date(2026, time.January, 30, 10, 30, 0, 0, time.UTC)},
+		{expression: "0 9 * * *", expected: time.Date(2026, time.January, 31, 9, 0, 0, 0, time.UTC)},
+		{expression: "0 9 1 * *", expected: time.Date(2026, time.February, 1, 9, 0, 0, 0, time.UTC)},
+		{expression: "0 9 * * 1-5", expected: time.Date(2026, time.February, 2, 9, 0, 0, 0, time.UTC)},
+		{expression: "30 8 * * 7", expected: time.Date(2026, time.February, 1, 8, 30, 0, 0, time.UTC)},
+		{expression: "0 0 31 * *", expected: time.Date(2026, time.January, 31, 0, 0, 0, 0, time.UTC)},
+		{expression: "0 0 29 2 *", expected: time.Date(2028, time.February, 29, 0, 0, 0, 0, time.UTC)},
+		{expression: "0 12 15 * 1", expected: time.Date(2026, time.February, 2, 12, 0, 0, 0, time.UTC)}, // Day of month or Monday
+		{expression: "0 0 30 2 *", expected: time.Time{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expression, func(t *testing.T) {
+			schedule, err := NewCronSchedule(tt.expression)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, schedule.Next(from))
+		})
+	}
+}
+
+func TestNewCronSchedule_Invalid(t *testing.T) {
+	for _, expression := range []string{"", "* * * *", "60 * * * *", "* 24 * * *", "* * 0 * *", "* * * 13 *", "* * * * 8", "5-1 * * * *", "*/0 * * * *", "a * * * *"} {
+		_, err := NewCronSchedule(expression)
+		assert.Error(t, err, expression)
+	}
+}
@@ -0,0 +1,69 @@
+package vo
+
+import (
+	"strings"
+
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+)
+
+// Currency is an ISO 4217 alphabetic currency code. The zero Currency is unspecified: amounts not yet
+// tied to an account, such as those parsed from a request, combine with any currency.
+type Currency string
+
+// iso4217 holds the active ISO 4217 codes of currencies accounts can be held in; fund, precious
+// metal and testing codes are left out
+var iso4217 = map[Currency]bool{
+	"AED": true, "AFN": true, "ALL": true, "AMD": true, "ANG": true, "AOA": true, "ARS": true, "AUD": true,
+	"AWG": true, "AZN": true, "BAM": true, "BBD": true, "BDT": true, "BGN": true, "BHD": true, "BIF": true,
+	"BMD": true, "BND": true, "BOB": true, "BRL": true, "BSD": true, "BTN": true, "BWP": true, "BYN": true,
+	"BZD": true, "CAD": true, "CDF": true, "CHF": true, "CLP": true, "CNY": true, "COP": true, "CRC": true,
+	"CUP": true, "CVE": true, "CZK": true, "DJF": true, "DKK": true, "DOP": true, "DZD": true, "EGP": true,
+	"ERN": true, "ETB": true, "EUR": true, "FJD": true, "FKP": true, "GBP": true, "GEL": true, "GHS": true,
+	"GIP": true, "GMD": true, "GNF": true, "GTQ": true, "GYD": true, "HKD": true, "HNL": true, "HTG": true,
+	"HUF": true, "IDR": true, "ILS": true, "INR": true, "IQD": true, "IRR": true, "ISK": true, "JMD": true,
+	"JOD": true, "JPY": true, "KES": true, "KGS": true, "KHR": true, "KMF": true, "KPW": true, "KRW": true,
+	"KWD": true, "KYD": true, "KZT": true, "LAK": true, "LBP": true, "LKR": true, "LRD": true, "LSL": true,
+	"LYD": true, "MAD": true, "MDL": true, "MGA": true, "MKD": true, "MMK": true, "MNT": true, "MOP": true,
+	"MRU": true, "MUR": true, "MVR": true, "MWK": true, "MXN": true, "MYR": true, "MZN": true, "NAD": true,
+	"NGN": true, "NIO": true, "NOK": true, "NPR": true, "NZD": true, "OMR": true, "PAB": true, "PEN": true,
+	"PGK": true, "PHP": true, "PKR": true, "PLN": true, "PYG": true, "QAR": true, "RON": true, "RSD": true,
+	"RUB": true, "RWF": true, "SAR": true, "SBD": true, "SCR": true, "SDG": true, "SEK": true, "SGD": true,
+	"SHP": true, "SLE": true, "SOS": true, "SRD": true, "SSP": true, "STN": true, "SVC": true, "SYP": true,
+	"SZL": true, "THB": true, "TJS": true, "TMT": true, "TND": true, "TOP": true, "TRY": true, "TTD": true,
+	"TWD": true, "TZS": true, "UAH": true, "UGX": true, "USD": true, "UYU": true, "UZS": true, "VES": true,
+	"VND": true, "VUV": true, "WST": true, "XAF": true, "XCD": true, "XCG": true, "XOF": true, "XPF": true,
+	"YER": true, "ZAR": true, "ZMW": true, "ZWG": true,
+}
+
+// NewCurrency parses an ISO 4217 currency code, in any case
+func NewCurrency(code string) (Currency, error) {
+	currency := Currency(strings.ToUpper(strings.TrimSpace(code)))
+	if !currency.IsValid() {
+		return "", errs.ValidationError{
+			Field:   "currency",
+			Message: "currency must be an ISO 4217 code such as THB or USD",
+		}
+	}
+	return currency, nil
+}
+
+// IsValid checks if currency is an active ISO 4217 code
+func (c Currency) IsValid() bool {
+	return iso4217[c]
+}
+
+// IsZero checks if currency is unspecified
+func (c Currency) IsZero() bool {
+	return c == ""
+}
+
+// Matches checks if amounts in the two currencies can be combined: they are the same, or either is
+// unspecified
+func (c Currency) Matches(other Currency) bool {
+	return c == "" || other == "" || c == other
+}
+
+// String returns string representation
+func (c Currency) String() string {
+	return string(c)
+}
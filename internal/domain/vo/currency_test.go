@@ -0,0 +1,43 @@
+package vo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCurrency(t *testing.T) {
+	tests := []struct {
+		name     string
+		code     string
+		expected Currency
+		wantErr  bool
+	}{
+		{name: "valid", code: "USD", expected: "USD"},
+		{name: "lowercase", code: " thb ", expected: "THB"},
+		{name: "unknown code", code: "ABC", wantErr: true},
+		{name: "precious metal", code: "XAU", wantErr: true},
+		{name: "too long", code: "USDT", wantErr: true},
+		{name: "empty", code: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			currency, err := NewCurrency(tt.code)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, currency)
+		})
+	}
+}
+
+func TestCurrency_Matches(t *testing.T) {
+	assert.True(t, Currency("THB").Matches("THB"))
+	assert.True(t, Currency("THB").Matches(""))
+	assert.True(t, Currency("").Matches("USD"))
+	assert.False(t, Currency("THB").Matches("USD"))
+}
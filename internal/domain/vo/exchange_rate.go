@@ -0,0 +1,71 @@
+package vo
+
+import (
+	"time"
+
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/shopspring/decimal"
+)
+
+// rateScale is the number of decimal places kept when a rate is derived from another, e.g. inverted
+const rateScale = 10
+
+// ExchangeRate is the price of one unit of From in To, as quoted at AsOf
+type ExchangeRate struct {
+	From Currency        `json:"from"`
+	To   Currency        `json:"to"`
+	Rate decimal.Decimal `json:"rate"`
+	AsOf time.Time       `json:"as_of"`
+}
+
+// NewExchangeRate creates an exchange rate between two different currencies
+func NewExchangeRate(from, to Currency, rate decimal.Decimal, asOf time.Time) (ExchangeRate, error) {
+	if !from.IsValid() || !to.IsValid() {
+		return ExchangeRate{}, errs.ValidationError{
+			Field:   "currency",
+			Message: "exchange rates are quoted between ISO 4217 currencies",
+		}
+	}
+	if from == to {
+		return ExchangeRate{}, errs.ValidationError{
+			Field:   "currency",
+			Message: "exchange rates are quoted between two different currencies",
+		}
+	}
+	if !rate.IsPositive() {
+		return ExchangeRate{}, errs.ValidationError{
+			Field:   "rate",
+			Message: "exchange rate must be greater than zero",
+		}
+	}
+	return ExchangeRate{
+		From: from,
+		To:   to,
+		Rate: rate,
+		AsOf: asOf,
+	}, nil
+}
+
+// IsZero checks if no rate was applied
+func (r ExchangeRate) IsZero() bool {
+	return r.Rate.IsZero()
+}
+
+// Convert converts an amount in From to To, rounded to cents. An amount in another currency is
+// errs.ErrCurrencyMismatch; an amount in an unspecified currency is taken to be in From.
+func (r ExchangeRate) Convert(amount Money) (Money, error) {
+	if !amount.Currency().Matches(r.From) {
+		return Money{}, errs.ErrCurrencyMismatch
+	}
+	return NewMoneyIn(amount.Amount().Mul(r.Rate).Round(2), r.To), nil
+}
+
+// Inverse returns the rate from To back to From
+func (r ExchangeRate) Inverse() ExchangeRate {
+	return ExchangeRate{
+		From: r.To,
+		To:   r.From,
+		Rate: decimal.NewFromInt(1).DivRound(r.Rate, rateScale),
+		AsOf: r.AsOf,
+	}
+}
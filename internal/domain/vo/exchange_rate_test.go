@@ -0,0 +1,72 @@
+package vo
+
+import (
+	"testing"
+	"time"
+
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewExchangeRate(t *testing.T) {
+	asOf := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		from    Currency
+		to      Currency
+		rate    decimal.Decimal
+		wantErr bool
+	}{
+		{name: "valid", from: "USD", to: "THB", rate: decimal.RequireFromString("35.5")},
+		{name: "same currency", from: "USD", to: "USD", rate: decimal.NewFromInt(1), wantErr: true},
+		{name: "unknown currency", from: "USD", to: "ABC", rate: decimal.NewFromInt(1), wantErr: true},
+		{name: "zero rate", from: "USD", to: "THB", rate: decimal.Zero, wantErr: true},
+		{name: "negative rate", from: "USD", to: "THB", rate: decimal.NewFromInt(-1), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rate, err := NewExchangeRate(tt.from, tt.to, tt.rate, asOf)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.from, rate.From)
+			assert.Equal(t, tt.to, rate.To)
+			assert.Equal(t, asOf, rate.AsOf)
+		})
+	}
+}
+
+func TestExchangeRate_Convert(t *testing.T) {
+	rate, err := NewExchangeRate("USD", "THB", decimal.RequireFromString("35.555"), time.Now())
+	require.NoError(t, err)
+
+	converted, err := rate.Convert(NewMoneyIn(decimal.RequireFromString("10.01"), "USD"))
+	require.NoError(t, err)
+	assert.Equal(t, "355.91", converted.String())
+	assert.Equal(t, Currency("THB"), converted.Currency())
+
+	// An amount in an unspecified currency is taken to be in the rate's source currency
+	converted, err = rate.Convert(NewMoneyFromInt(2))
+	require.NoError(t, err)
+	assert.Equal(t, "71.11", converted.String())
+
+	_, err = rate.Convert(NewMoneyIn(decimal.NewFromInt(1), "EUR"))
+	assert.ErrorIs(t, err, errs.ErrCurrencyMismatch)
+}
+
+func TestExchangeRate_Inverse(t *testing.T) {
+	rate, err := NewExchangeRate("USD", "THB", decimal.NewFromInt(40), time.Now())
+	require.NoError(t, err)
+
+	inverse := rate.Inverse()
+	assert.Equal(t, Currency("THB"), inverse.From)
+	assert.Equal(t, Currency("USD"), inverse.To)
+	assert.True(t, inverse.Rate.Equal(decimal.RequireFromString("0.025")))
+	assert.Equal(t, rate.AsOf, inverse.AsOf)
+}
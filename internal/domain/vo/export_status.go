@@ -0,0 +1,30 @@
+package vo
+
+// ExportStatus represents the lifecycle state of an asynchronous export
+type ExportStatus string
+
+const (
+	ExportStatusRunning   ExportStatus = "RUNNING"
+	ExportStatusCompleted ExportStatus = "COMPLETED"
+	ExportStatusFailed    ExportStatus = "FAILED"
+)
+
+// IsValid checks if export status is valid
+func (s ExportStatus) IsValid() bool {
+	switch s {
+	case ExportStatusRunning, ExportStatusCompleted, ExportStatusFailed:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsRunning checks if export is still being produced
+func (s ExportStatus) IsRunning() bool {
+	return s == ExportStatusRunning
+}
+
+// IsFinished checks if export reached a terminal state
+func (s ExportStatus) IsFinished() bool {
+	return s == ExportStatusCompleted || s == ExportStatusFailed
+}
@@ -0,0 +1,19 @@
+package vo
+
+// FailureKind tells why a transaction failed
+type FailureKind string
+
+const (
+	FailureKindBusiness       FailureKind = "BUSINESS"       // Rejected by a business rule, e.g. insufficient balance; final
+	FailureKindInfrastructure FailureKind = "INFRASTRUCTURE" // A dependency such as the database failed; the transaction may be replayed
+)
+
+// IsValid checks if failure kind is valid
+func (k FailureKind) IsValid() bool {
+	switch k {
+	case FailureKindBusiness, FailureKindInfrastructure:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,40 @@
+package vo
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"time"
+)
+
+// crockfordAlphabet is Crockford's base32, which leaves out I, L, O and U so IDs read back unambiguously
+const crockfordAlphabet = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+
+// IDLength is the length of the IDs NewID returns for a three-letter prefix
+const IDLength = 3 + 26
+
+// NewID creates an entity ID: prefix followed by a ULID (e.g., JOB01J3Z8Q5W6X7Y8Z9A0B1C2D3E4).
+// The ULID's 48-bit millisecond timestamp sorts IDs by creation time, and its 80 random bits keep
+// apart the IDs every instance creates within the same millisecond.
+func NewID(prefix string) string {
+	var ulid [16]byte
+	ms := uint64(time.Now().UnixMilli())
+	binary.BigEndian.PutUint16(ulid[0:2], uint16(ms>>32))
+	binary.BigEndian.PutUint32(ulid[2:6], uint32(ms))
+	rand.Read(ulid[6:])
+
+	return prefix + encodeCrockford(ulid)
+}
+
+// encodeCrockford encodes 128 bits as 26 base32 characters, most significant first
+func encodeCrockford(value [16]byte) string {
+	hi := binary.BigEndian.Uint64(value[:8])
+	lo := binary.BigEndian.Uint64(value[8:])
+
+	encoded := make([]byte, 26)
+	for i := len(encoded) - 1; i >= 0; i-- {
+		encoded[i] = crockfordAlphabet[lo&31]
+		lo = lo>>5 | hi<<59
+		hi >>= 5
+	}
+	return string(encoded)
+}
@@ -0,0 +1,52 @@
+package vo
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewID(t *testing.T) {
+	id := NewID("JOB")
+
+	assert.Len(t, id, IDLength)
+	assert.True(t, strings.HasPrefix(id, "JOB"))
+	for _, char := range id[3:] {
+		assert.True(t, strings.ContainsRune(crockfordAlphabet, char), "unexpected character %q in %s", char, id)
+	}
+}
+
+func TestNewID_Uniqueness(t *testing.T) {
+	// Far more IDs than fit in a millisecond, which the old six-digit suffix collided on
+	ids := make(map[string]bool)
+	for i := 0; i < 100000; i++ {
+		id := NewID("EVT")
+		require.False(t, ids[id], "Duplicate ID generated: %s", id)
+		ids[id] = true
+	}
+}
+
+func TestNewID_SortsByCreationTime(t *testing.T) {
+	first := NewID("TST")
+	time.Sleep(2 * time.Millisecond)
+	second := NewID("TST")
+
+	assert.Less(t, first, second)
+}
+
+func TestEncodeCrockford(t *testing.T) {
+	var value [16]byte
+	assert.Equal(t, "00000000000000000000000000", encodeCrockford(value))
+
+	value[15] = 31
+	assert.Equal(t, "0000000000000000000000000Z", encodeCrockford(value))
+
+	for i := range value {
+		value[i] = 0xFF
+	}
+	// 128 bits leave two high bits of the first character unused
+	assert.Equal(t, "7ZZZZZZZZZZZZZZZZZZZZZZZZZ", encodeCrockford(value))
+}
@@ -0,0 +1,26 @@
+package vo
+
+// JobStatus represents the lifecycle state of a background job
+type JobStatus string
+
+const (
+	JobStatusQueued    JobStatus = "QUEUED"  // Waiting for its run time or a free worker, including between retries
+	JobStatusRunning   JobStatus = "RUNNING" // Claimed by a worker holding its lease
+	JobStatusSucceeded JobStatus = "SUCCEEDED"
+	JobStatusFailed    JobStatus = "FAILED" // Out of attempts
+)
+
+// IsValid checks if job status is valid
+func (s JobStatus) IsValid() bool {
+	switch s {
+	case JobStatusQueued, JobStatusRunning, JobStatusSucceeded, JobStatusFailed:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsFinished checks if job reached a terminal state
+func (s JobStatus) IsFinished() bool {
+	return s == JobStatusSucceeded || s == JobStatusFailed
+}
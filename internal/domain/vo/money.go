@@ -4,21 +4,32 @@ package vo
 import (
 	"errors"
 
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
 	"github.com/shopspring/decimal"
 )
 
-// Money represents monetary value using Shopspring decimal for precision
+// Money represents monetary value using Shopspring decimal for precision, in a currency that may be
+// left unspecified until the amount is tied to an account
 type Money struct {
-	amount decimal.Decimal
+	amount   decimal.Decimal
+	currency Currency
 }
 
-// NewMoney creates a new Money instance from decimal
+// NewMoney creates a new Money instance from decimal in an unspecified currency
 func NewMoney(amount decimal.Decimal) Money {
 	return Money{
 		amount: amount,
 	}
 }
 
+// NewMoneyIn creates a new Money instance from decimal in a currency
+func NewMoneyIn(amount decimal.Decimal, currency Currency) Money {
+	return Money{
+		amount:   amount,
+		currency: currency,
+	}
+}
+
 // NewMoneyFromString creates Money from string representation
 func NewMoneyFromString(amount string) (Money, error) {
 	dec, err := decimal.NewFromString(amount)
@@ -48,6 +59,19 @@ func (m Money) Amount() decimal.Decimal {
 	return m.amount
 }
 
+// Currency returns the currency, empty when unspecified
+func (m Money) Currency() Currency {
+	return m.currency
+}
+
+// In returns the amount in a currency
+func (m Money) In(currency Currency) Money {
+	return Money{
+		amount:   m.amount,
+		currency: currency,
+	}
+}
+
 // IsZero checks if amount is zero
 func (m Money) IsZero() bool {
 	return m.amount.IsZero()
@@ -63,24 +87,46 @@ func (m Money) IsNegative() bool {
 	return m.amount.IsNegative()
 }
 
-// Add adds two Money values
+// Add adds two Money values; values in different currencies are errs.ErrCurrencyMismatch
 func (m Money) Add(other Money) (Money, error) {
+	currency, err := m.combinedCurrency(other)
+	if err != nil {
+		return Money{}, err
+	}
 	return Money{
-		amount: m.amount.Add(other.amount),
+		amount:   m.amount.Add(other.amount),
+		currency: currency,
 	}, nil
 }
 
-// Subtract subtracts two Money values
+// Subtract subtracts two Money values; values in different currencies are errs.ErrCurrencyMismatch
 func (m Money) Subtract(other Money) (Money, error) {
+	currency, err := m.combinedCurrency(other)
+	if err != nil {
+		return Money{}, err
+	}
 	return Money{
-		amount: m.amount.Sub(other.amount),
+		amount:   m.amount.Sub(other.amount),
+		currency: currency,
 	}, nil
 }
 
+// combinedCurrency returns the currency of the result of combining two Money values
+func (m Money) combinedCurrency(other Money) (Currency, error) {
+	if !m.currency.Matches(other.currency) {
+		return "", errs.ErrCurrencyMismatch
+	}
+	if m.currency.IsZero() {
+		return other.currency, nil
+	}
+	return m.currency, nil
+}
+
 // Multiply multiplies Money by a decimal factor
 func (m Money) Multiply(factor decimal.Decimal) Money {
 	return Money{
-		amount: m.amount.Mul(factor),
+		amount:   m.amount.Mul(factor),
+		currency: m.currency,
 	}
 }
 
@@ -95,7 +141,8 @@ func (m Money) Divide(divisor decimal.Decimal) (Money, error) {
 		return Money{}, errors.New("cannot divide by zero")
 	}
 	return Money{
-		amount: m.amount.Div(divisor),
+		amount:   m.amount.Div(divisor),
+		currency: m.currency,
 	}, nil
 }
 
@@ -107,13 +154,14 @@ func (m Money) DivideFloat(divisor float64) (Money, error) {
 // Abs returns the absolute value of Money
 func (m Money) Abs() Money {
 	return Money{
-		amount: m.amount.Abs(),
+		amount:   m.amount.Abs(),
+		currency: m.currency,
 	}
 }
 
-// Equal checks if two Money values are equal
+// Equal checks if two Money values are equal; an unspecified currency matches any
 func (m Money) Equal(other Money) bool {
-	return m.amount.Equal(other.amount)
+	return m.amount.Equal(other.amount) && m.currency.Matches(other.currency)
 }
 
 // GreaterThan checks if this Money is greater than other
@@ -139,21 +187,24 @@ func (m Money) LessThanOrEqual(other Money) bool {
 // Round rounds the Money to the specified number of decimal places
 func (m Money) Round(places int32) Money {
 	return Money{
-		amount: m.amount.Round(places),
+		amount:   m.amount.Round(places),
+		currency: m.currency,
 	}
 }
 
 // RoundBank rounds the Money using banker's rounding
 func (m Money) RoundBank(places int32) Money {
 	return Money{
-		amount: m.amount.RoundBank(places),
+		amount:   m.amount.RoundBank(places),
+		currency: m.currency,
 	}
 }
 
 // Truncate truncates the Money to the specified number of decimal places
 func (m Money) Truncate(places int32) Money {
 	return Money{
-		amount: m.amount.Truncate(places),
+		amount:   m.amount.Truncate(places),
+		currency: m.currency,
 	}
 }
 
@@ -186,6 +237,7 @@ func (m Money) IntPart() int64 {
 // Copy returns a copy of the Money value
 func (m Money) Copy() Money {
 	return Money{
-		amount: m.amount.Copy(),
+		amount:   m.amount.Copy(),
+		currency: m.currency,
 	}
 }
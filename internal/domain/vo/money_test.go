@@ -3,6 +3,7 @@ package vo
 import (
 	"testing"
 
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
 	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -618,3 +619,27 @@ func newMoneyFromStringMustValue(s string) Money {
 	}
 	return money
 }
+
+func TestMoney_Currency(t *testing.T) {
+	thb := NewMoneyIn(decimal.NewFromInt(100), "THB")
+	usd := NewMoneyIn(decimal.NewFromInt(100), "USD")
+
+	sum, err := thb.Add(NewMoneyFromInt(50))
+	require.NoError(t, err)
+	assert.Equal(t, Currency("THB"), sum.Currency())
+	assert.Equal(t, "150", sum.String())
+
+	difference, err := NewMoneyFromInt(50).Subtract(thb)
+	require.NoError(t, err)
+	assert.Equal(t, Currency("THB"), difference.Currency())
+
+	_, err = thb.Add(usd)
+	assert.ErrorIs(t, err, errs.ErrCurrencyMismatch)
+	_, err = thb.Subtract(usd)
+	assert.ErrorIs(t, err, errs.ErrCurrencyMismatch)
+
+	assert.Equal(t, Currency("THB"), thb.Multiply(decimal.NewFromInt(2)).Currency())
+	assert.Equal(t, Currency("USD"), NewMoneyFromInt(1).In("USD").Currency())
+	assert.False(t, thb.Equal(usd))
+	assert.True(t, thb.Equal(NewMoneyFromInt(100)))
+}
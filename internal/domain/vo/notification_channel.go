@@ -0,0 +1,39 @@
+package vo
+
+import (
+	"fmt"
+	"strings"
+)
+
+// NotificationChannel represents how a notification reaches a customer
+type NotificationChannel string
+
+const (
+	NotificationChannelEmail NotificationChannel = "EMAIL"
+	NotificationChannelSMS   NotificationChannel = "SMS"
+	NotificationChannelPush  NotificationChannel = "PUSH"
+)
+
+// NewNotificationChannel parses a notification channel
+func NewNotificationChannel(channel string) (NotificationChannel, error) {
+	c := NotificationChannel(strings.ToUpper(strings.TrimSpace(channel)))
+	if !c.IsValid() {
+		return "", fmt.Errorf("invalid notification channel %q", channel)
+	}
+	return c, nil
+}
+
+// IsValid checks if notification channel is valid
+func (c NotificationChannel) IsValid() bool {
+	switch c {
+	case NotificationChannelEmail, NotificationChannelSMS, NotificationChannelPush:
+		return true
+	default:
+		return false
+	}
+}
+
+// HasSubject reports whether messages on the channel carry a subject line
+func (c NotificationChannel) HasSubject() bool {
+	return c == NotificationChannelEmail || c == NotificationChannelPush
+}
@@ -0,0 +1,48 @@
+package vo
+
+// OwnershipTransferStatus represents the lifecycle state of an account ownership transfer
+type OwnershipTransferStatus string
+
+const (
+	OwnershipTransferStatusPending   OwnershipTransferStatus = "PENDING"   // Awaiting approval by a second admin
+	OwnershipTransferStatusApproved  OwnershipTransferStatus = "APPROVED"  // Approved, waiting for its effective date
+	OwnershipTransferStatusCompleted OwnershipTransferStatus = "COMPLETED" // The account belongs to the new customer
+	OwnershipTransferStatusRejected  OwnershipTransferStatus = "REJECTED"  // Rejected, or withdrawn before it took effect
+)
+
+// IsValid checks if ownership transfer status is valid
+func (s OwnershipTransferStatus) IsValid() bool {
+	switch s {
+	case OwnershipTransferStatusPending, OwnershipTransferStatusApproved, OwnershipTransferStatusCompleted, OwnershipTransferStatusRejected:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsOpen checks if the transfer may still take effect
+func (s OwnershipTransferStatus) IsOpen() bool {
+	return s == OwnershipTransferStatusPending || s == OwnershipTransferStatusApproved
+}
+
+// OwnershipTransferReason is the reason code an admin gives for moving an account to another customer
+type OwnershipTransferReason string
+
+const (
+	OwnershipTransferReasonInheritance  OwnershipTransferReason = "INHERITANCE"   // The owner died and the account passes to an heir
+	OwnershipTransferReasonBusinessSale OwnershipTransferReason = "BUSINESS_SALE" // The business holding the account was sold
+	OwnershipTransferReasonGift         OwnershipTransferReason = "GIFT"          // The owner gives the account away
+	OwnershipTransferReasonLegalOrder   OwnershipTransferReason = "LEGAL_ORDER"   // Ordered by a court or regulator
+	OwnershipTransferReasonCorrection   OwnershipTransferReason = "CORRECTION"    // The account was opened for the wrong customer
+)
+
+// IsValid checks if ownership transfer reason is valid
+func (r OwnershipTransferReason) IsValid() bool {
+	switch r {
+	case OwnershipTransferReasonInheritance, OwnershipTransferReasonBusinessSale, OwnershipTransferReasonGift,
+		OwnershipTransferReasonLegalOrder, OwnershipTransferReasonCorrection:
+		return true
+	default:
+		return false
+	}
+}
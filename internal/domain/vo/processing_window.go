@@ -0,0 +1,94 @@
+package vo
+
+import (
+	"fmt"
+	"time"
+)
+
+// ProcessingWindow defines the daily cutoff after which transactions of the given
+// types are value-dated to the next business day
+type ProcessingWindow struct {
+	Cutoff    time.Duration // Offset from midnight; zero disables the cutoff
+	Location  *time.Location
+	AppliesTo []TransactionType
+}
+
+// NewProcessingWindow creates a processing window from a "HH:MM" cutoff, an IANA time zone
+// and the transaction types subject to it
+func NewProcessingWindow(cutoff, timezone string, transactionTypes []string) (ProcessingWindow, error) {
+	location, err := time.LoadLocation(timezone)
+	if err != nil {
+		return ProcessingWindow{}, fmt.Errorf("invalid time zone %q: %w", timezone, err)
+	}
+
+	var offset time.Duration
+	if cutoff != "" {
+		parsed, err := time.Parse("15:04", cutoff)
+		if err != nil {
+			return ProcessingWindow{}, fmt.Errorf("invalid cutoff %q, expected HH:MM: %w", cutoff, err)
+		}
+		offset = time.Duration(parsed.Hour())*time.Hour + time.Duration(parsed.Minute())*time.Minute
+	}
+
+	types := make([]TransactionType, 0, len(transactionTypes))
+	for _, transactionType := range transactionTypes {
+		t := TransactionType(transactionType)
+		if !t.IsValid() {
+			return ProcessingWindow{}, fmt.Errorf("invalid transaction type %q", transactionType)
+		}
+		types = append(types, t)
+	}
+
+	return ProcessingWindow{
+		Cutoff:    offset,
+		Location:  location,
+		AppliesTo: types,
+	}, nil
+}
+
+// Applies checks if the transaction type is subject to the cutoff
+func (w ProcessingWindow) Applies(transactionType TransactionType) bool {
+	for _, t := range w.AppliesTo {
+		if t == transactionType {
+			return true
+		}
+	}
+	return false
+}
+
+// IsAfterCutoff checks if the instant falls after the cutoff of its day
+func (w ProcessingWindow) IsAfterCutoff(t time.Time) bool {
+	if w.Cutoff == 0 {
+		return false
+	}
+	local := t.In(w.location())
+	return local.Sub(DateOf(local)) >= w.Cutoff
+}
+
+// In returns the instant in the window's time zone
+func (w ProcessingWindow) In(t time.Time) time.Time {
+	return t.In(w.location())
+}
+
+// Date returns the calendar date of the instant in the window's time zone
+func (w ProcessingWindow) Date(t time.Time) time.Time {
+	return DateOf(t.In(w.location()))
+}
+
+func (w ProcessingWindow) location() *time.Location {
+	if w.Location == nil {
+		return time.UTC
+	}
+	return w.Location
+}
+
+// DateOf truncates a time to midnight of its calendar date in its own location
+func DateOf(t time.Time) time.Time {
+	year, month, day := t.Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, t.Location())
+}
+
+// SameOrBeforeDate checks if a falls on or before the calendar date of b
+func SameOrBeforeDate(a, b time.Time) bool {
+	return a.Format("2006-01-02") <= b.Format("2006-01-02")
+}
@@ -0,0 +1,55 @@
+package vo
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewProcessingWindow(t *testing.T) {
+	tests := []struct {
+		name      string
+		cutoff    string
+		timezone  string
+		types     []string
+		wantErr   bool
+		wantAfter time.Duration
+	}{
+		{name: "valid", cutoff: "17:30", timezone: "Asia/Bangkok", types: []string{"TRANSFER"}, wantAfter: 17*time.Hour + 30*time.Minute},
+		{name: "no cutoff", cutoff: "", timezone: "UTC", types: nil},
+		{name: "invalid cutoff", cutoff: "5pm", timezone: "UTC", wantErr: true},
+		{name: "invalid time zone", cutoff: "17:00", timezone: "Mars/Olympus", wantErr: true},
+		{name: "invalid transaction type", cutoff: "17:00", timezone: "UTC", types: []string{"WIRE"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			window, err := NewProcessingWindow(tt.cutoff, tt.timezone, tt.types)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantAfter, window.Cutoff)
+			assert.Len(t, window.AppliesTo, len(tt.types))
+		})
+	}
+}
+
+func TestProcessingWindow_IsAfterCutoff(t *testing.T) {
+	window, err := NewProcessingWindow("17:00", "Asia/Bangkok", []string{"TRANSFER"})
+	require.NoError(t, err)
+
+	assert.False(t, window.IsAfterCutoff(time.Date(2025, 7, 1, 16, 59, 59, 0, window.Location)))
+	assert.True(t, window.IsAfterCutoff(time.Date(2025, 7, 1, 17, 0, 0, 0, window.Location)))
+	// 10:00 UTC is 17:00 in Bangkok
+	assert.True(t, window.IsAfterCutoff(time.Date(2025, 7, 1, 10, 0, 0, 0, time.UTC)))
+
+	assert.True(t, window.Applies(TransactionTypeTransfer))
+	assert.False(t, window.Applies(TransactionTypeDebit))
+
+	disabled := ProcessingWindow{}
+	assert.False(t, disabled.IsAfterCutoff(time.Date(2025, 7, 1, 23, 59, 0, 0, time.UTC)))
+}
@@ -0,0 +1,23 @@
+package vo
+
+// ProductMigrationStatus represents the lifecycle state of a bulk product migration
+type ProductMigrationStatus string
+
+const (
+	ProductMigrationStatusScheduled ProductMigrationStatus = "SCHEDULED" // Waiting for its effective date
+	ProductMigrationStatusCompleted ProductMigrationStatus = "COMPLETED" // Every account was migrated or skipped
+)
+
+// IsValid checks if product migration status is valid
+func (s ProductMigrationStatus) IsValid() bool {
+	return s == ProductMigrationStatusScheduled || s == ProductMigrationStatusCompleted
+}
+
+// ProductMigrationAccountStatus represents the outcome of one account of a product migration
+type ProductMigrationAccountStatus string
+
+const (
+	ProductMigrationAccountPending  ProductMigrationAccountStatus = "PENDING"  // Eligible, waiting for the effective date
+	ProductMigrationAccountMigrated ProductMigrationAccountStatus = "MIGRATED" // On the new product
+	ProductMigrationAccountSkipped  ProductMigrationAccountStatus = "SKIPPED"  // Not eligible when requested or when due
+)
@@ -0,0 +1,132 @@
+package vo
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+)
+
+// ReferenceType tells how a transaction's reference is structured
+type ReferenceType string
+
+const (
+	ReferenceTypeFree ReferenceType = "FREE" // Any text
+	ReferenceTypeRF   ReferenceType = "RF"   // ISO 11649 creditor reference, e.g. RF18 5390 0754 7034
+)
+
+// rfMaxLength is the longest creditor reference: RF, two check digits and up to 21 characters
+const rfMaxLength = 25
+
+// NewReferenceType parses a reference type; an empty type defaults to FREE
+func NewReferenceType(referenceType string) (ReferenceType, error) {
+	if referenceType == "" {
+		return ReferenceTypeFree, nil
+	}
+
+	t := ReferenceType(strings.ToUpper(strings.TrimSpace(referenceType)))
+	if !t.IsValid() {
+		return "", fmt.Errorf("invalid reference type %q", referenceType)
+	}
+	return t, nil
+}
+
+// IsValid checks if reference type is valid
+func (t ReferenceType) IsValid() bool {
+	switch t {
+	case ReferenceTypeFree, ReferenceTypeRF:
+		return true
+	default:
+		return false
+	}
+}
+
+// ReferenceError explains why a reference does not match its type
+type ReferenceError struct {
+	Type   ReferenceType
+	Reason string
+}
+
+func (e *ReferenceError) Error() string {
+	return fmt.Sprintf("invalid %s reference: %s", e.Type, e.Reason)
+}
+
+func (e *ReferenceError) Unwrap() error {
+	return errs.ErrInvalidReference
+}
+
+// Format validates a reference against the type and returns it in its stored form.
+// Creditor references are stored in electronic format: upper case without spaces.
+func (t ReferenceType) Format(reference string) (string, error) {
+	switch t {
+	case ReferenceTypeRF:
+		return formatRFReference(reference)
+	case ReferenceTypeFree, "":
+		return reference, nil
+	default:
+		return "", fmt.Errorf("invalid reference type %q", t)
+	}
+}
+
+func formatRFReference(reference string) (string, error) {
+	ref := strings.ToUpper(strings.Join(strings.Fields(reference), ""))
+	invalid := func(reason string) (string, error) {
+		return "", &ReferenceError{Type: ReferenceTypeRF, Reason: reason}
+	}
+
+	if !strings.HasPrefix(ref, "RF") {
+		return invalid("must start with RF")
+	}
+	if len(ref) < 5 || len(ref) > rfMaxLength {
+		return invalid(fmt.Sprintf("must be 5 to %d characters long", rfMaxLength))
+	}
+	if ref[2] < '0' || ref[2] > '9' || ref[3] < '0' || ref[3] > '9' {
+		return invalid("check digits must be numeric")
+	}
+	for _, c := range ref[4:] {
+		if !isAlphanumeric(c) {
+			return invalid("may only contain letters and digits")
+		}
+	}
+
+	if rfRemainder(ref[4:]+ref[:4]) != 1 {
+		return invalid("check digits do not match")
+	}
+	return ref, nil
+}
+
+// NewRFReference creates a creditor reference for the given creditor reference body
+func NewRFReference(body string) (string, error) {
+	body = strings.ToUpper(strings.Join(strings.Fields(body), ""))
+	if body == "" || len(body) > rfMaxLength-4 {
+		return "", &ReferenceError{Type: ReferenceTypeRF, Reason: fmt.Sprintf("body must be 1 to %d characters long", rfMaxLength-4)}
+	}
+	for _, c := range body {
+		if !isAlphanumeric(c) {
+			return "", &ReferenceError{Type: ReferenceTypeRF, Reason: "may only contain letters and digits"}
+		}
+	}
+
+	check := 98 - rfRemainder(body+"RF00")
+	return fmt.Sprintf("RF%02d%s", check, body), nil
+}
+
+// rfRemainder converts letters to numbers (A=10 ... Z=35) and returns the number modulo 97
+func rfRemainder(ref string) int64 {
+	var digits strings.Builder
+	for _, c := range ref {
+		if c >= 'A' && c <= 'Z' {
+			fmt.Fprintf(&digits, "%d", c-'A'+10)
+		} else {
+			digits.WriteRune(c)
+		}
+	}
+
+	n, _ := new(big.Int).SetString(digits.String(), 10)
+	return new(big.Int).Mod(n, big.NewInt(97)).Int64()
+}
+
+func isAlphanumeric(c rune) bool {
+	return (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9')
+}
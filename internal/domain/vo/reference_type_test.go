@@ -0,0 +1,72 @@
+package vo
+
+import (
+	"errors"
+	"testing"
+
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewReferenceType(t *testing.T) {
+	referenceType, err := NewReferenceType("")
+	require.NoError(t, err)
+	assert.Equal(t, ReferenceTypeFree, referenceType)
+
+	referenceType, err = NewReferenceType(" rf ")
+	require.NoError(t, err)
+	assert.Equal(t, ReferenceTypeRF, referenceType)
+
+	_, err = NewReferenceType("IBAN")
+	assert.Error(t, err)
+}
+
+func TestReferenceType_Format(t *testing.T) {
+	tests := []struct {
+		name          string
+		referenceType ReferenceType
+		reference     string
+		expected      string
+		reason        string
+	}{
+		{name: "free text is kept", referenceType: ReferenceTypeFree, reference: "invoice 42 / may", expected: "invoice 42 / may"},
+		{name: "print format", referenceType: ReferenceTypeRF, reference: "RF18 5390 0754 7034", expected: "RF18539007547034"},
+		{name: "lower case", referenceType: ReferenceTypeRF, reference: "rf18539007547034", expected: "RF18539007547034"},
+		{name: "short reference", referenceType: ReferenceTypeRF, reference: "RF712348231", expected: "RF712348231"},
+		{name: "wrong check digits", referenceType: ReferenceTypeRF, reference: "RF19 5390 0754 7034", reason: "check digits do not match"},
+		{name: "missing prefix", referenceType: ReferenceTypeRF, reference: "18539007547034", reason: "must start with RF"},
+		{name: "too long", referenceType: ReferenceTypeRF, reference: "RF001234567890123456789012", reason: "must be 5 to 25 characters long"},
+		{name: "non-numeric check digits", referenceType: ReferenceTypeRF, reference: "RFAB1234", reason: "check digits must be numeric"},
+		{name: "punctuation", referenceType: ReferenceTypeRF, reference: "RF18-5390", reason: "may only contain letters and digits"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reference, err := tt.referenceType.Format(tt.reference)
+			if tt.reason != "" {
+				assert.ErrorIs(t, err, errs.ErrInvalidReference)
+				var referenceErr *ReferenceError
+				require.True(t, errors.As(err, &referenceErr))
+				assert.Equal(t, tt.reason, referenceErr.Reason)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, reference)
+		})
+	}
+}
+
+func TestNewRFReference(t *testing.T) {
+	reference, err := NewRFReference("5390 0754 7034")
+	require.NoError(t, err)
+	assert.Equal(t, "RF18539007547034", reference)
+
+	reference, err = NewRFReference("inv2024a")
+	require.NoError(t, err)
+	_, err = ReferenceTypeRF.Format(reference)
+	assert.NoError(t, err)
+
+	_, err = NewRFReference("")
+	assert.ErrorIs(t, err, errs.ErrInvalidReference)
+}
@@ -0,0 +1,39 @@
+package vo
+
+// SagaStatus represents the lifecycle state of a multi-step saga
+type SagaStatus string
+
+const (
+	SagaStatusRunning      SagaStatus = "RUNNING"
+	SagaStatusCompleted    SagaStatus = "COMPLETED"
+	SagaStatusCompensating SagaStatus = "COMPENSATING"
+	SagaStatusCompensated  SagaStatus = "COMPENSATED"
+	SagaStatusFailed       SagaStatus = "FAILED" // Compensation did not finish; needs manual intervention
+)
+
+// IsValid checks if saga status is valid
+func (s SagaStatus) IsValid() bool {
+	switch s {
+	case SagaStatusRunning, SagaStatusCompleted, SagaStatusCompensating, SagaStatusCompensated, SagaStatusFailed:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsFinished checks if saga reached a terminal state
+func (s SagaStatus) IsFinished() bool {
+	return s == SagaStatusCompleted || s == SagaStatusCompensated || s == SagaStatusFailed
+}
+
+// SagaStepStatus represents the state of a single saga step
+type SagaStepStatus string
+
+const (
+	SagaStepStatusPending            SagaStepStatus = "PENDING"
+	SagaStepStatusCompleted          SagaStepStatus = "COMPLETED"
+	SagaStepStatusFailed             SagaStepStatus = "FAILED"
+	SagaStepStatusCompensated        SagaStepStatus = "COMPENSATED"
+	SagaStepStatusCompensationFailed SagaStepStatus = "COMPENSATION_FAILED"
+	SagaStepStatusSkipped            SagaStepStatus = "SKIPPED" // Not needed given how earlier steps turned out
+)
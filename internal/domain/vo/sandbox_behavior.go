@@ -0,0 +1,43 @@
+package vo
+
+// SandboxBehavior is the deterministic outcome a magic test account gives the transactions it takes part in
+type SandboxBehavior string
+
+const (
+	SandboxBehaviorInsufficientFunds SandboxBehavior = "INSUFFICIENT_FUNDS" // Every payment out of it fails for insufficient balance
+	SandboxBehaviorSlow              SandboxBehavior = "SLOW"               // Every transaction involving it is confirmed slowly
+	SandboxBehaviorScreeningFailure  SandboxBehavior = "SCREENING_FAILURE"  // Every transaction involving it is held for review
+)
+
+// sandboxAccounts are the fixed IDs of the magic test accounts; the 2000-01-01 date prefix is never
+// issued to a real account
+var sandboxAccounts = map[string]SandboxBehavior{
+	"2000010100000001": SandboxBehaviorInsufficientFunds,
+	"2000010100000002": SandboxBehaviorSlow,
+	"2000010100000003": SandboxBehaviorScreeningFailure,
+}
+
+// SandboxAccountIDs returns the ID of the magic test account of every sandbox behavior
+func SandboxAccountIDs() map[SandboxBehavior]AccountID {
+	ids := make(map[SandboxBehavior]AccountID, len(sandboxAccounts))
+	for id, behavior := range sandboxAccounts {
+		ids[behavior] = AccountID{value: id}
+	}
+	return ids
+}
+
+// SandboxBehavior returns the behavior of the magic test account with this ID, if it is one
+func (id AccountID) SandboxBehavior() (SandboxBehavior, bool) {
+	behavior, ok := sandboxAccounts[id.value]
+	return behavior, ok
+}
+
+// IsValid checks if sandbox behavior is valid
+func (b SandboxBehavior) IsValid() bool {
+	switch b {
+	case SandboxBehaviorInsufficientFunds, SandboxBehaviorSlow, SandboxBehaviorScreeningFailure:
+		return true
+	default:
+		return false
+	}
+}
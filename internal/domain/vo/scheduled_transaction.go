@@ -0,0 +1,19 @@
+package vo
+
+// ScheduledTransactionStatus represents the lifecycle state of a scheduled transaction
+type ScheduledTransactionStatus string
+
+const (
+	ScheduledTransactionStatusActive    ScheduledTransactionStatus = "ACTIVE"    // Waiting for its next run
+	ScheduledTransactionStatusCompleted ScheduledTransactionStatus = "COMPLETED" // Ran for the last time
+)
+
+// IsValid checks if scheduled transaction status is valid
+func (s ScheduledTransactionStatus) IsValid() bool {
+	switch s {
+	case ScheduledTransactionStatusActive, ScheduledTransactionStatusCompleted:
+		return true
+	default:
+		return false
+	}
+}
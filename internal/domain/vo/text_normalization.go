@@ -0,0 +1,160 @@
+package vo
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// TextFilter is an optional step of text normalization
+type TextFilter string
+
+const (
+	TextFilterEmoji     TextFilter = "EMOJI"
+	TextFilterProfanity TextFilter = "PROFANITY"
+)
+
+// TextNormalization cleans free text such as a transaction's description and reference.
+// Control and format characters are always stripped, whitespace collapsed and the text trimmed;
+// the emoji and profanity filters are optional, and a MaxLength of 0 leaves the length alone.
+type TextNormalization struct {
+	MaxLength     int
+	StripEmoji    bool
+	MaskProfanity bool
+	profanity     *regexp.Regexp
+}
+
+// Normalize runs the text through the pipeline
+func (n TextNormalization) Normalize(text string) string {
+	text = strings.Map(func(r rune) rune {
+		switch {
+		case unicode.IsSpace(r):
+			return ' '
+		case unicode.IsControl(r), unicode.Is(unicode.Cf, r):
+			return -1
+		case n.StripEmoji && isEmoji(r):
+			return -1
+		default:
+			return r
+		}
+	}, text)
+	text = strings.Join(strings.Fields(text), " ")
+
+	if n.MaskProfanity && n.profanity != nil {
+		text = n.profanity.ReplaceAllStringFunc(text, func(word string) string {
+			return strings.Repeat("*", len([]rune(word)))
+		})
+	}
+
+	if n.MaxLength > 0 {
+		if runes := []rune(text); len(runes) > n.MaxLength {
+			text = strings.TrimSpace(string(runes[:n.MaxLength]))
+		}
+	}
+	return text
+}
+
+// isEmoji reports pictographs and the modifiers that combine with them
+func isEmoji(r rune) bool {
+	return unicode.Is(unicode.So, r) ||
+		(r >= 0x1F3FB && r <= 0x1F3FF) || // skin tone modifiers
+		(r >= 0xFE00 && r <= 0xFE0F) // variation selectors
+}
+
+// TextNormalizers holds the default text normalization and its per-channel overrides
+type TextNormalizers struct {
+	Default  TextNormalization
+	Channels map[TransactionChannel]TextNormalization
+}
+
+// NewTextNormalizers creates text normalizers; filters name the default's TextFilters, profane words are masked
+// by the profanity filter, and overrides are "CHANNEL:MAX_LENGTH[:FILTER+FILTER]" entries
+func NewTextNormalizers(maxLength int, filters, profaneWords, overrides []string) (*TextNormalizers, error) {
+	profanity, err := profanityPattern(profaneWords)
+	if err != nil {
+		return nil, err
+	}
+
+	if maxLength < 0 {
+		return nil, fmt.Errorf("max length must not be negative")
+	}
+	defaults := TextNormalization{MaxLength: maxLength, profanity: profanity}
+	if err := defaults.applyFilters(filters); err != nil {
+		return nil, err
+	}
+
+	normalizers := &TextNormalizers{Default: defaults, Channels: make(map[TransactionChannel]TextNormalization, len(overrides))}
+	for _, entry := range overrides {
+		parts := strings.Split(entry, ":")
+		if len(parts) < 2 || len(parts) > 3 {
+			return nil, fmt.Errorf("invalid text normalization %q, expected CHANNEL:MAX_LENGTH[:FILTER+FILTER]", entry)
+		}
+
+		channel := TransactionChannel(strings.ToUpper(strings.TrimSpace(parts[0])))
+		if !channel.IsValid() {
+			return nil, fmt.Errorf("invalid transaction channel %q", parts[0])
+		}
+
+		length, err := strconv.Atoi(strings.TrimSpace(parts[1]))
+		if err != nil || length < 0 {
+			return nil, fmt.Errorf("invalid max length %q of %s", parts[1], channel)
+		}
+
+		// Without its own filters a channel keeps the default's
+		override := TextNormalization{MaxLength: length, StripEmoji: defaults.StripEmoji, MaskProfanity: defaults.MaskProfanity, profanity: profanity}
+		if len(parts) == 3 {
+			override.StripEmoji, override.MaskProfanity = false, false
+			if err := override.applyFilters(strings.Split(parts[2], "+")); err != nil {
+				return nil, err
+			}
+		}
+		normalizers.Channels[channel] = override
+	}
+	return normalizers, nil
+}
+
+// applyFilters turns on the named filters; NONE turns on nothing
+func (n *TextNormalization) applyFilters(filters []string) error {
+	for _, name := range filters {
+		switch TextFilter(strings.ToUpper(strings.TrimSpace(name))) {
+		case TextFilterEmoji:
+			n.StripEmoji = true
+		case TextFilterProfanity:
+			if n.profanity == nil {
+				return fmt.Errorf("profanity filter needs a list of words")
+			}
+			n.MaskProfanity = true
+		case "NONE", "":
+		default:
+			return fmt.Errorf("invalid text filter %q", name)
+		}
+	}
+	return nil
+}
+
+// profanityPattern matches any of the words as a whole word, ignoring case
+func profanityPattern(words []string) (*regexp.Regexp, error) {
+	quoted := make([]string, 0, len(words))
+	for _, word := range words {
+		if word = strings.TrimSpace(word); word != "" {
+			quoted = append(quoted, regexp.QuoteMeta(word))
+		}
+	}
+	if len(quoted) == 0 {
+		return nil, nil
+	}
+	return regexp.Compile(`(?i)\b(?:` + strings.Join(quoted, "|") + `)\b`)
+}
+
+// For returns the text normalization of a channel; nil normalizers only do the mandatory cleanup
+func (n *TextNormalizers) For(channel TransactionChannel) TextNormalization {
+	if n == nil {
+		return TextNormalization{}
+	}
+	if override, ok := n.Channels[channel]; ok {
+		return override
+	}
+	return n.Default
+}
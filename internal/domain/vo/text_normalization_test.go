@@ -0,0 +1,64 @@
+package vo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTextNormalization_Normalize(t *testing.T) {
+	normalizers, err := NewTextNormalizers(20, []string{"emoji", "profanity"}, []string{"darn", "heck"}, nil)
+	require.NoError(t, err)
+	full := normalizers.Default
+
+	tests := []struct {
+		name          string
+		normalization TextNormalization
+		text          string
+		expected      string
+	}{
+		{name: "trims and collapses whitespace", text: "  rent \t for\n\nmay  ", expected: "rent for may"},
+		{name: "strips control and zero-width characters", text: "pay\x00ment\u200b for\u202e you", expected: "payment for you"},
+		{name: "keeps emoji without the filter", text: "party 🎉", expected: "party 🎉"},
+		{name: "strips emoji", normalization: full, text: "party 🎉👍🏽 time ❤️", expected: "party time"},
+		{name: "masks whole profane words", normalization: full, text: "Darn fee, heckler", expected: "**** fee, heckler"},
+		{name: "truncates to max length", normalization: full, text: "monthly subscription renewal", expected: "monthly subscription"},
+		{name: "trims after truncating", normalization: TextNormalization{MaxLength: 8}, text: "monthly subscription", expected: "monthly"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.normalization.Normalize(tt.text))
+		})
+	}
+}
+
+func TestTextNormalizers(t *testing.T) {
+	normalizers, err := NewTextNormalizers(100, []string{"EMOJI"}, []string{"darn"}, []string{"atm:10", "MOBILE:50:PROFANITY", "BRANCH:0:NONE"})
+	require.NoError(t, err)
+
+	assert.Equal(t, TextNormalization{MaxLength: 100, StripEmoji: true}, withoutPattern(normalizers.For(TransactionChannelAPI)))
+	assert.Equal(t, TextNormalization{MaxLength: 10, StripEmoji: true}, withoutPattern(normalizers.For(TransactionChannelATM)))
+	assert.Equal(t, TextNormalization{MaxLength: 50, MaskProfanity: true}, withoutPattern(normalizers.For(TransactionChannelMobile)))
+	assert.Equal(t, TextNormalization{}, withoutPattern(normalizers.For(TransactionChannelBranch)))
+	assert.Equal(t, "darn 🎉", normalizers.For(TransactionChannelBranch).Normalize(" darn 🎉 "))
+
+	var none *TextNormalizers
+	assert.Equal(t, "a b", none.For(TransactionChannelAPI).Normalize(" a  b "))
+
+	for _, overrides := range [][]string{{"ATM"}, {"FAX:10"}, {"ATM:abc"}, {"ATM:-1"}, {"ATM:10:SHOUTING"}, {"ATM:10:EMOJI:X"}} {
+		_, err := NewTextNormalizers(100, nil, nil, overrides)
+		assert.Error(t, err, overrides)
+	}
+
+	_, err = NewTextNormalizers(100, []string{"PROFANITY"}, nil, nil)
+	assert.Error(t, err, "profanity filter without words")
+	_, err = NewTextNormalizers(-1, nil, nil, nil)
+	assert.Error(t, err)
+}
+
+func withoutPattern(n TextNormalization) TextNormalization {
+	n.profanity = nil
+	return n
+}
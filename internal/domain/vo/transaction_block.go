@@ -0,0 +1,24 @@
+package vo
+
+// TransactionBlockReason is the reason code an admin gives for temporarily blocking a type of
+// transaction on an account
+type TransactionBlockReason string
+
+const (
+	TransactionBlockReasonCredentialChange TransactionBlockReason = "CREDENTIAL_CHANGE" // The password or another credential changed recently
+	TransactionBlockReasonNewDevice        TransactionBlockReason = "NEW_DEVICE"        // The customer signed in from a device not seen before
+	TransactionBlockReasonSuspectedFraud   TransactionBlockReason = "SUSPECTED_FRAUD"   // Activity on the account is being investigated
+	TransactionBlockReasonCustomerRequest  TransactionBlockReason = "CUSTOMER_REQUEST"  // The customer asked for the block
+	TransactionBlockReasonLegalOrder       TransactionBlockReason = "LEGAL_ORDER"       // Ordered by a court or regulator
+)
+
+// IsValid checks if transaction block reason is valid
+func (r TransactionBlockReason) IsValid() bool {
+	switch r {
+	case TransactionBlockReasonCredentialChange, TransactionBlockReasonNewDevice, TransactionBlockReasonSuspectedFraud,
+		TransactionBlockReasonCustomerRequest, TransactionBlockReasonLegalOrder:
+		return true
+	default:
+		return false
+	}
+}
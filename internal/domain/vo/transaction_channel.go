@@ -0,0 +1,81 @@
+package vo
+
+import (
+	"fmt"
+	"strings"
+)
+
+// TransactionChannel represents where a transaction was initiated
+type TransactionChannel string
+
+const (
+	TransactionChannelAPI    TransactionChannel = "API"
+	TransactionChannelBranch TransactionChannel = "BRANCH"
+	TransactionChannelATM    TransactionChannel = "ATM"
+	TransactionChannelMobile TransactionChannel = "MOBILE"
+)
+
+// NewTransactionChannel parses a channel; an empty channel defaults to API
+func NewTransactionChannel(channel string) (TransactionChannel, error) {
+	if channel == "" {
+		return TransactionChannelAPI, nil
+	}
+
+	c := TransactionChannel(strings.ToUpper(strings.TrimSpace(channel)))
+	if !c.IsValid() {
+		return "", fmt.Errorf("invalid transaction channel %q", channel)
+	}
+	return c, nil
+}
+
+// IsValid checks if transaction channel is valid
+func (c TransactionChannel) IsValid() bool {
+	switch c {
+	case TransactionChannelAPI, TransactionChannelBranch, TransactionChannelATM, TransactionChannelMobile:
+		return true
+	default:
+		return false
+	}
+}
+
+// ChannelLimits caps the amount of a single transaction per channel; channels without a cap are unlimited
+type ChannelLimits map[TransactionChannel]Money
+
+// NewChannelLimits creates channel limits from "CHANNEL:AMOUNT" entries
+func NewChannelLimits(entries []string) (ChannelLimits, error) {
+	limits := make(ChannelLimits, len(entries))
+	for _, entry := range entries {
+		name, amount, found := strings.Cut(entry, ":")
+		if !found {
+			return nil, fmt.Errorf("invalid channel limit %q, expected CHANNEL:AMOUNT", entry)
+		}
+
+		channel := TransactionChannel(strings.ToUpper(strings.TrimSpace(name)))
+		if !channel.IsValid() {
+			return nil, fmt.Errorf("invalid transaction channel %q", name)
+		}
+
+		limit, err := NewMoneyFromString(strings.TrimSpace(amount))
+		if err != nil {
+			return nil, fmt.Errorf("invalid channel limit amount %q: %w", amount, err)
+		}
+		if !limit.IsPositive() {
+			return nil, fmt.Errorf("channel limit of %s must be positive", channel)
+		}
+
+		limits[channel] = limit
+	}
+	return limits, nil
+}
+
+// Limit returns the cap of a channel and whether it has one
+func (l ChannelLimits) Limit(channel TransactionChannel) (Money, bool) {
+	limit, ok := l[channel]
+	return limit, ok
+}
+
+// Allows checks if a single transaction of the amount is within the channel's cap
+func (l ChannelLimits) Allows(channel TransactionChannel, amount Money) bool {
+	limit, ok := l.Limit(channel)
+	return !ok || !amount.GreaterThan(limit)
+}
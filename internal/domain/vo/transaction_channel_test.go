@@ -0,0 +1,50 @@
+package vo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewTransactionChannel(t *testing.T) {
+	tests := []struct {
+		name     string
+		channel  string
+		expected TransactionChannel
+		wantErr  bool
+	}{
+		{name: "empty defaults to API", channel: "", expected: TransactionChannelAPI},
+		{name: "ATM", channel: "ATM", expected: TransactionChannelATM},
+		{name: "lower case", channel: "mobile", expected: TransactionChannelMobile},
+		{name: "branch", channel: "BRANCH", expected: TransactionChannelBranch},
+		{name: "unknown", channel: "FAX", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			channel, err := NewTransactionChannel(tt.channel)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, channel)
+		})
+	}
+}
+
+func TestChannelLimits(t *testing.T) {
+	limits, err := NewChannelLimits([]string{"ATM:20000", "mobile: 50000.50"})
+	require.NoError(t, err)
+
+	assert.True(t, limits.Allows(TransactionChannelATM, NewMoneyFromFloat(20000)))
+	assert.False(t, limits.Allows(TransactionChannelATM, NewMoneyFromFloat(20000.01)))
+	assert.True(t, limits.Allows(TransactionChannelMobile, NewMoneyFromFloat(50000.50)))
+	assert.True(t, limits.Allows(TransactionChannelBranch, NewMoneyFromFloat(1000000)))
+
+	for _, entries := range [][]string{{"ATM"}, {"FAX:100"}, {"ATM:abc"}, {"ATM:0"}} {
+		_, err := NewChannelLimits(entries)
+		assert.Error(t, err, entries)
+	}
+}
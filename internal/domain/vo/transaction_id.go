@@ -6,6 +6,7 @@ import (
 	"math/big"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
@@ -17,14 +18,33 @@ type TransactionID struct {
 	value string
 }
 
+// issuedSuffixes holds the suffixes already handed out in the current second, so the few IDs one
+// process creates within a second never share a suffix
+var issuedSuffixes = struct {
+	sync.Mutex
+	second   string
+	suffixes map[int64]bool
+}{}
+
 // NewTransactionID creates a new TransactionID
 func NewTransactionID() TransactionID {
 	now := time.Now()
 	timestamp := now.Format("20060102150405") // YYYYMMDDHHmmss
 
-	// Generate 6-digit random suffix
+	issuedSuffixes.Lock()
+	defer issuedSuffixes.Unlock()
+	if issuedSuffixes.second != timestamp {
+		issuedSuffixes.second = timestamp
+		issuedSuffixes.suffixes = make(map[int64]bool)
+	}
+
+	// Generate 6-digit random suffix, drawing again if it was already issued this second
 	max := big.NewInt(999999)
 	n, _ := rand.Int(rand.Reader, max)
+	for issuedSuffixes.suffixes[n.Int64()] {
+		n, _ = rand.Int(rand.Reader, max)
+	}
+	issuedSuffixes.suffixes[n.Int64()] = true
 	suffix := fmt.Sprintf("%06d", n.Int64())
 
 	return TransactionID{value: "TXN" + timestamp + suffix}
@@ -7,13 +7,15 @@ const (
 	TransactionStatusCompleted TransactionStatus = "COMPLETED"
 	TransactionStatusFailed    TransactionStatus = "FAILED"
 	TransactionStatusCancelled TransactionStatus = "CANCELLED"
+	TransactionStatusReview    TransactionStatus = "REVIEW" // Held by the fraud rules until an admin approves or declines it
 )
 
 // IsValid checks if transaction status is valid
 func (s TransactionStatus) IsValid() bool {
 	switch s {
 	case TransactionStatusPending, TransactionStatusCompleted,
-		TransactionStatusFailed, TransactionStatusCancelled:
+		TransactionStatusFailed, TransactionStatusCancelled,
+		TransactionStatusReview:
 		return true
 	default:
 		return false
@@ -40,13 +42,23 @@ func (s TransactionStatus) IsCancelled() bool {
 	return s == TransactionStatusCancelled
 }
 
+// IsInReview checks if status is held for review
+func (s TransactionStatus) IsInReview() bool {
+	return s == TransactionStatusReview
+}
+
 // CanTransitionTo checks if current status can transition to target status
 func (s TransactionStatus) CanTransitionTo(target TransactionStatus) bool {
 	switch s {
 	case TransactionStatusPending:
 		return target == TransactionStatusCompleted ||
 			target == TransactionStatusFailed ||
-			target == TransactionStatusCancelled
+			target == TransactionStatusCancelled ||
+			target == TransactionStatusReview
+	case TransactionStatusReview:
+		return target == TransactionStatusCompleted || // Approved
+			target == TransactionStatusFailed || // Declined
+			target == TransactionStatusCancelled // Withdrawn by the customer before a decision
 	case TransactionStatusCompleted:
 		return false // Completed transactions cannot be changed
 	case TransactionStatusFailed:
@@ -32,6 +32,11 @@ func TestTransactionStatus_IsValid(t *testing.T) {
 			status:   TransactionStatusCancelled,
 			expected: true,
 		},
+		{
+			name:     "Review status is valid",
+			status:   TransactionStatusReview,
+			expected: true,
+		},
 		{
 			name:     "Invalid status",
 			status:   TransactionStatus("INVALID"),
@@ -242,6 +247,12 @@ func TestTransactionStatus_CanTransitionTo(t *testing.T) {
 			targetStatus:  TransactionStatusCancelled,
 			expected:      true,
 		},
+		{
+			name:          "Pending to Review",
+			currentStatus: TransactionStatusPending,
+			targetStatus:  TransactionStatusReview,
+			expected:      true,
+		},
 		{
 			name:          "Pending to Pending (no change)",
 			currentStatus: TransactionStatusPending,
@@ -249,6 +260,32 @@ func TestTransactionStatus_CanTransitionTo(t *testing.T) {
 			expected:      false,
 		},
 
+		// From REVIEW
+		{
+			name:          "Review to Completed (approved)",
+			currentStatus: TransactionStatusReview,
+			targetStatus:  TransactionStatusCompleted,
+			expected:      true,
+		},
+		{
+			name:          "Review to Failed (declined)",
+			currentStatus: TransactionStatusReview,
+			targetStatus:  TransactionStatusFailed,
+			expected:      true,
+		},
+		{
+			name:          "Review to Cancelled (withdrawn)",
+			currentStatus: TransactionStatusReview,
+			targetStatus:  TransactionStatusCancelled,
+			expected:      true,
+		},
+		{
+			name:          "Review to Pending (invalid)",
+			currentStatus: TransactionStatusReview,
+			targetStatus:  TransactionStatusPending,
+			expected:      false,
+		},
+
 		// From COMPLETED
 		{
 			name:          "Completed to Pending (invalid)",
@@ -361,6 +398,7 @@ func TestTransactionStatus_Constants(t *testing.T) {
 	assert.Equal(t, "COMPLETED", string(TransactionStatusCompleted))
 	assert.Equal(t, "FAILED", string(TransactionStatusFailed))
 	assert.Equal(t, "CANCELLED", string(TransactionStatusCancelled))
+	assert.Equal(t, "REVIEW", string(TransactionStatusReview))
 }
 
 func TestTransactionStatus_TransitionMatrix(t *testing.T) {
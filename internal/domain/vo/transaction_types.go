@@ -4,15 +4,18 @@ package vo
 type TransactionType string
 
 const (
-	TransactionTypeDebit    TransactionType = "DEBIT"
-	TransactionTypeCredit   TransactionType = "CREDIT"
-	TransactionTypeTransfer TransactionType = "TRANSFER"
+	TransactionTypeDebit      TransactionType = "DEBIT"
+	TransactionTypeCredit     TransactionType = "CREDIT"
+	TransactionTypeTransfer   TransactionType = "TRANSFER"
+	TransactionTypeAdjustment TransactionType = "ADJUSTMENT"  // Admin correction of one account's balance
+	TransactionTypeFXTransfer TransactionType = "FX_TRANSFER" // Transfer between accounts held in different currencies
+	TransactionTypeReversal   TransactionType = "REVERSAL"    // Compensates a completed transaction by moving its money back
 )
 
 // IsValid checks if transaction type is valid
 func (t TransactionType) IsValid() bool {
 	switch t {
-	case TransactionTypeDebit, TransactionTypeCredit, TransactionTypeTransfer:
+	case TransactionTypeDebit, TransactionTypeCredit, TransactionTypeTransfer, TransactionTypeAdjustment, TransactionTypeFXTransfer, TransactionTypeReversal:
 		return true
 	default:
 		return false
@@ -33,3 +36,18 @@ func (t TransactionType) IsCredit() bool {
 func (t TransactionType) IsTransfer() bool {
 	return t == TransactionTypeTransfer
 }
+
+// IsAdjustment checks if transaction type is adjustment
+func (t TransactionType) IsAdjustment() bool {
+	return t == TransactionTypeAdjustment
+}
+
+// IsFXTransfer checks if transaction type is a cross-currency transfer
+func (t TransactionType) IsFXTransfer() bool {
+	return t == TransactionTypeFXTransfer
+}
+
+// IsReversal checks if transaction type is a reversal of another transaction
+func (t TransactionType) IsReversal() bool {
+	return t == TransactionTypeReversal
+}
@@ -0,0 +1,30 @@
+package vo
+
+import "fmt"
+
+// VelocityLimits caps what an account sends out per day and how many outgoing transactions it makes
+// per hour; a zero cap is unlimited
+type VelocityLimits struct {
+	DailyDebit         Money
+	HourlyTransactions int64
+	WarningPercent     int // Share of a limit from which transactions warn that it is nearly used up; 0 never warns
+}
+
+// NewVelocityLimits creates velocity limits, 0 leaving that limit off
+func NewVelocityLimits(dailyDebit float64, hourlyTransactions, warningPercent int) (VelocityLimits, error) {
+	if dailyDebit < 0 {
+		return VelocityLimits{}, fmt.Errorf("daily debit limit cannot be negative")
+	}
+	if hourlyTransactions < 0 {
+		return VelocityLimits{}, fmt.Errorf("hourly transaction limit cannot be negative")
+	}
+	if warningPercent < 0 || warningPercent > 100 {
+		return VelocityLimits{}, fmt.Errorf("warning percentage must be between 0 and 100")
+	}
+
+	return VelocityLimits{
+		DailyDebit:         NewMoneyFromFloat(dailyDebit),
+		HourlyTransactions: int64(hourlyTransactions),
+		WarningPercent:     warningPercent,
+	}, nil
+}
@@ -0,0 +1,27 @@
+package vo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewVelocityLimits(t *testing.T) {
+	limits, err := NewVelocityLimits(5000.5, 20, 80)
+	require.NoError(t, err)
+	assert.True(t, limits.DailyDebit.Equal(NewMoneyFromFloat(5000.5)))
+	assert.Equal(t, int64(20), limits.HourlyTransactions)
+	assert.Equal(t, 80, limits.WarningPercent)
+
+	limits, err = NewVelocityLimits(0, 0, 0)
+	require.NoError(t, err)
+	assert.True(t, limits.DailyDebit.IsZero())
+
+	_, err = NewVelocityLimits(-1, 0, 0)
+	assert.Error(t, err)
+	_, err = NewVelocityLimits(0, -1, 0)
+	assert.Error(t, err)
+	_, err = NewVelocityLimits(0, 0, 101)
+	assert.Error(t, err)
+}
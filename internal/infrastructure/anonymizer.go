@@ -0,0 +1,277 @@
+package infrastructure
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hydr0g3nz/mini_bank/internal/adapter/repository/gorm/model"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/entity"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/infra"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+)
+
+// AnonymizerConfig holds configuration for copying production data into another environment
+type AnonymizerConfig struct {
+	Target       DBConfig // Database the anonymized copy is written to; must be empty
+	Secret       string   // Keys pseudonyms and amount jitter; a random secret is used when empty
+	AmountJitter float64  // Amounts move by up to this fraction either way, e.g. 0.1 for ±10%
+	BatchSize    int      // Rows read and written per batch
+}
+
+// AnonymizedTable reports how many rows of a table were copied
+type AnonymizedTable struct {
+	Table string
+	Rows  int64
+}
+
+// systemReferencePrefixes start references the application generates from IDs; they hold no personal
+// data and link credits to their source rows, so they are copied as-is
+var systemReferencePrefixes = []string{
+	entity.ReferralReferencePrefix,
+	entity.CashbackReferencePrefix,
+	entity.TransferTemplateReferencePrefix,
+}
+
+// Anonymizer copies the tables of a source database into an empty target database, replacing names,
+// customer IDs, descriptions, free-text references and webhook endpoints with keyed pseudonyms and
+// moving amounts by a bounded jitter. Business IDs and statuses are kept, so rows still reference each
+// other and status distributions match the source. The same value always maps to the same pseudonym
+// under one secret, e.g. a customer ID on an account and on a referral.
+//
+// Outbox events, audit entries and backups are not copied: their payloads embed the original data.
+type Anonymizer struct {
+	source *gorm.DB
+	target *gorm.DB
+	config AnonymizerConfig
+	logger infra.Logger
+}
+
+// NewAnonymizer creates an anonymizer copying from source into target
+func NewAnonymizer(source, target *gorm.DB, config AnonymizerConfig, logger infra.Logger) (*Anonymizer, error) {
+	if config.AmountJitter < 0 || config.AmountJitter >= 1 {
+		return nil, fmt.Errorf("amount jitter must be in [0, 1), got %v", config.AmountJitter)
+	}
+	if config.BatchSize <= 0 {
+		config.BatchSize = 500
+	}
+	if config.Secret == "" {
+		secret := make([]byte, 32)
+		if _, err := rand.Read(secret); err != nil {
+			return nil, fmt.Errorf("can't generate anonymizer secret: %w", err)
+		}
+		config.Secret = hex.EncodeToString(secret)
+	}
+
+	return &Anonymizer{
+		source: source,
+		target: target,
+		config: config,
+		logger: logger,
+	}, nil
+}
+
+// Run copies every table and reports the rows copied per table. It refuses to write into a target
+// that already holds accounts.
+func (a *Anonymizer) Run(ctx context.Context) ([]AnonymizedTable, error) {
+	var existing int64
+	if err := a.target.WithContext(ctx).Model(&model.Account{}).Unscoped().Count(&existing).Error; err != nil {
+		return nil, fmt.Errorf("can't check target database: %w", err)
+	}
+	if existing > 0 {
+		return nil, errors.New("target database already holds accounts; anonymize into an empty database")
+	}
+
+	steps := []struct {
+		table string
+		copy  func(ctx context.Context) (int64, error)
+	}{
+		{"accounts", func(ctx context.Context) (int64, error) { return copyTable(ctx, a, a.anonymizeAccount) }},
+		{"transactions", func(ctx context.Context) (int64, error) { return copyTable(ctx, a, a.anonymizeTransaction) }},
+		{"sagas", func(ctx context.Context) (int64, error) { return copyTable[model.Saga](ctx, a, nil) }},
+		{"holidays", func(ctx context.Context) (int64, error) { return copyTable[model.Holiday](ctx, a, nil) }},
+		{"categories", func(ctx context.Context) (int64, error) { return copyTable[model.Category](ctx, a, nil) }},
+		{"category_rules", func(ctx context.Context) (int64, error) { return copyTable[model.CategoryRule](ctx, a, nil) }},
+		{"category_overrides", func(ctx context.Context) (int64, error) { return copyTable[model.CategoryOverride](ctx, a, nil) }},
+		{"business_rules", func(ctx context.Context) (int64, error) { return copyTable[model.BusinessRule](ctx, a, nil) }},
+		{"products", func(ctx context.Context) (int64, error) { return copyTable[model.Product](ctx, a, nil) }},
+		{"cashback_campaigns", func(ctx context.Context) (int64, error) { return copyTable[model.CashbackCampaign](ctx, a, nil) }},
+		{"cashback_rewards", func(ctx context.Context) (int64, error) { return copyTable(ctx, a, a.anonymizeCashbackReward) }},
+		{"referral_codes", func(ctx context.Context) (int64, error) { return copyTable[model.ReferralCode](ctx, a, nil) }},
+		{"referrals", func(ctx context.Context) (int64, error) { return copyTable(ctx, a, a.anonymizeReferral) }},
+		{"transfer_templates", func(ctx context.Context) (int64, error) { return copyTable(ctx, a, a.anonymizeTransferTemplate) }},
+		{"budgets", func(ctx context.Context) (int64, error) { return copyTable(ctx, a, a.anonymizeBudget) }},
+		{"webhook_subscriptions", func(ctx context.Context) (int64, error) { return copyTable(ctx, a, a.anonymizeWebhook) }},
+		{"virtual_accounts", func(ctx context.Context) (int64, error) { return copyTable(ctx, a, a.anonymizeVirtualAccount) }},
+	}
+
+	report := make([]AnonymizedTable, 0, len(steps))
+	for _, step := range steps {
+		rows, err := step.copy(ctx)
+		if err != nil {
+			return report, fmt.Errorf("failed to copy %s: %w", step.table, err)
+		}
+		a.logger.Info("Table anonymized", "table", step.table, "rows", rows)
+		report = append(report, AnonymizedTable{Table: step.table, Rows: rows})
+	}
+
+	return report, nil
+}
+
+// copyTable copies a table in primary key order, anonymizing each row; a nil anonymize copies rows as-is.
+// Soft-deleted rows are copied too, since live rows may still reference them. Surrogate keys are left
+// to the target, as rows reference each other by business ID.
+func copyTable[T any](ctx context.Context, a *Anonymizer, anonymize func(*T)) (int64, error) {
+	var copied int64
+	var batch []T
+
+	result := a.source.WithContext(ctx).Unscoped().FindInBatches(&batch, a.config.BatchSize, func(tx *gorm.DB, _ int) error {
+		// Creating sets the target's keys on the rows; the batch keeps the source keys to page on
+		rows := make([]T, len(batch))
+		copy(rows, batch)
+		if anonymize != nil {
+			for i := range rows {
+				anonymize(&rows[i])
+			}
+		}
+
+		if err := a.target.WithContext(ctx).Omit("id").Create(&rows).Error; err != nil {
+			return err
+		}
+		copied += int64(len(rows))
+		return nil
+	})
+
+	return copied, result.Error
+}
+
+func (a *Anonymizer) anonymizeAccount(account *model.Account) {
+	account.AccountName = a.pseudonym("Account", account.AccountName)
+	account.CustomerID = a.customerID(account.CustomerID)
+	account.Balance = a.jitter("account:"+account.AccountID, account.Balance)
+	if account.OpeningBalance != nil {
+		opening := a.jitter("opening_balance:"+account.AccountID, *account.OpeningBalance)
+		account.OpeningBalance = &opening
+	}
+	if account.SpendingLimit != nil {
+		limit := a.jitter("spending_limit:"+account.AccountID, *account.SpendingLimit)
+		account.SpendingLimit = &limit
+	}
+}
+
+func (a *Anonymizer) anonymizeTransaction(transaction *model.Transaction) {
+	transaction.Amount = a.jitter("transaction:"+transaction.TransactionID, transaction.Amount)
+	transaction.Fee = a.jitter("fee:"+transaction.TransactionID, transaction.Fee)
+	// The converted amount follows the jittered amount, or amount = converted / rate would give it away
+	if transaction.ExchangeRate != nil {
+		transaction.ConvertedAmount = transaction.Amount.Mul(*transaction.ExchangeRate).Round(2)
+	}
+	transaction.Description = a.pseudonym("Payment", transaction.Description)
+	transaction.Reference = a.reference(transaction.Reference)
+	if transaction.ReviewedBy != "" {
+		transaction.ReviewedBy = a.pseudonym("Reviewer", transaction.ReviewedBy)
+	}
+	if transaction.RequestedBy != "" {
+		transaction.RequestedBy = a.pseudonym("Requester", transaction.RequestedBy)
+	}
+}
+
+func (a *Anonymizer) anonymizeCashbackReward(reward *model.CashbackReward) {
+	reward.Amount = a.jitter("cashback:"+reward.RewardID, reward.Amount)
+}
+
+func (a *Anonymizer) anonymizeReferral(referral *model.Referral) {
+	referral.RefereeCustomerID = a.customerID(referral.RefereeCustomerID)
+}
+
+func (a *Anonymizer) anonymizeTransferTemplate(template *model.TransferTemplate) {
+	template.Name = a.pseudonym("Template", template.Name)
+	template.Amount = a.jitter("template:"+template.TemplateID, template.Amount)
+	template.Description = a.pseudonym("Payment", template.Description)
+	template.Reference = a.reference(template.Reference)
+}
+
+func (a *Anonymizer) anonymizeBudget(budget *model.Budget) {
+	budget.Amount = a.jitter("budget:"+budget.BudgetID, budget.Amount)
+}
+
+func (a *Anonymizer) anonymizeWebhook(webhook *model.WebhookSubscription) {
+	webhook.URL = "https://webhooks.invalid/" + a.hash("webhook_url", webhook.URL)
+	webhook.Secret = a.hash("webhook_secret", webhook.Secret)
+	webhook.LastError = ""
+	if webhook.Threshold != nil {
+		threshold := a.jitter("webhook:"+webhook.WebhookID, *webhook.Threshold)
+		webhook.Threshold = &threshold
+	}
+}
+
+func (a *Anonymizer) anonymizeVirtualAccount(virtualAccount *model.VirtualAccount) {
+	virtualAccount.Label = a.pseudonym("Label", virtualAccount.Label)
+}
+
+// pseudonym replaces a non-empty value with the label and a keyed hash of the value
+func (a *Anonymizer) pseudonym(label, value string) string {
+	if value == "" {
+		return ""
+	}
+	return label + " " + a.hash(label, value)[:8]
+}
+
+// customerID maps a customer ID to the same pseudonym wherever it appears
+func (a *Anonymizer) customerID(id string) string {
+	if id == "" {
+		return ""
+	}
+	return "CUST-" + a.hash("customer", id)[:12]
+}
+
+// reference keeps references the application generated from IDs and replaces free text
+func (a *Anonymizer) reference(reference string) string {
+	for _, prefix := range systemReferencePrefixes {
+		if strings.HasPrefix(reference, prefix) {
+			return reference
+		}
+	}
+	if reference == "" {
+		return ""
+	}
+	return "REF-" + strings.ToUpper(a.hash("reference", reference)[:12])
+}
+
+// jitter moves an amount by up to AmountJitter either way, by the same factor every run with the same
+// secret. Positive amounts stay positive.
+func (a *Anonymizer) jitter(key string, amount decimal.Decimal) decimal.Decimal {
+	if amount.IsZero() || a.config.AmountJitter == 0 {
+		return amount
+	}
+
+	sum := a.mac("jitter", key)
+	unit := float64(binary.BigEndian.Uint64(sum[:8])>>11) / (1 << 53) // [0, 1)
+	factor := decimal.NewFromFloat(1 + a.config.AmountJitter*(2*unit-1))
+
+	jittered := amount.Mul(factor).Round(2)
+	if amount.IsPositive() && !jittered.IsPositive() {
+		return decimal.New(1, -2)
+	}
+	return jittered
+}
+
+func (a *Anonymizer) hash(purpose, value string) string {
+	sum := a.mac(purpose, value)
+	return hex.EncodeToString(sum)
+}
+
+func (a *Anonymizer) mac(purpose, value string) []byte {
+	mac := hmac.New(sha256.New, []byte(a.config.Secret))
+	mac.Write([]byte(purpose))
+	mac.Write([]byte{0})
+	mac.Write([]byte(value))
+	return mac.Sum(nil)
+}
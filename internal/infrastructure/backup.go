@@ -0,0 +1,74 @@
+package infrastructure
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	"github.com/hydr0g3nz/mini_bank/internal/domain/infra"
+)
+
+// BackupConfig holds configuration for logical database backups
+type BackupConfig struct {
+	Dir        string // Directory where dump files are written
+	PgDumpPath string // Path to the pg_dump binary
+}
+
+// PgDumpBackupService implements infra.BackupService by shelling out to pg_dump
+type PgDumpBackupService struct {
+	db     *DBConfig
+	config BackupConfig
+}
+
+// NewPgDumpBackupService creates a new pg_dump backed backup service
+func NewPgDumpBackupService(db *DBConfig, config BackupConfig) infra.BackupService {
+	if config.Dir == "" {
+		config.Dir = "backups"
+	}
+	if config.PgDumpPath == "" {
+		config.PgDumpPath = "pg_dump"
+	}
+	return &PgDumpBackupService{db: db, config: config}
+}
+
+// Dump writes a custom-format pg_dump archive named after the backup
+func (s *PgDumpBackupService) Dump(ctx context.Context, name string) (*infra.BackupResult, error) {
+	if err := os.MkdirAll(s.config.Dir, 0755); err != nil {
+		return nil, fmt.Errorf("can't create backup directory: %w", err)
+	}
+
+	location := filepath.Join(s.config.Dir, name+".dump")
+
+	cmd := exec.CommandContext(ctx, s.config.PgDumpPath,
+		"--host", s.db.Host,
+		"--port", s.db.Port,
+		"--username", s.db.User,
+		"--dbname", s.db.DBName,
+		"--format", "custom",
+		"--no-password",
+		"--file", location,
+	)
+	// Pass the password through the environment so it never shows up in process listings
+	cmd.Env = append(os.Environ(), "PGPASSWORD="+s.db.Password.Value(), "PGSSLMODE="+s.db.SSLMode)
+
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		os.Remove(location)
+		return nil, fmt.Errorf("pg_dump failed: %w: %s", err, stderr.String())
+	}
+
+	info, err := os.Stat(location)
+	if err != nil {
+		return nil, fmt.Errorf("failed to stat backup file %s: %w", location, err)
+	}
+
+	return &infra.BackupResult{
+		Location:  location,
+		SizeBytes: info.Size(),
+	}, nil
+}
@@ -0,0 +1,102 @@
+package infrastructure
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/hydr0g3nz/mini_bank/internal/domain/infra"
+)
+
+// BlobStorageConfig holds blob storage configuration
+type BlobStorageConfig struct {
+	Dir string // Directory objects are stored in
+}
+
+// LocalBlobStorage implements infra.BlobStorage on the local filesystem, or on a volume shared by
+// every instance so any of them can serve a download
+type LocalBlobStorage struct {
+	dir string
+}
+
+// NewLocalBlobStorage creates a blob storage keeping objects under the configured directory
+func NewLocalBlobStorage(config BlobStorageConfig) infra.BlobStorage {
+	if config.Dir == "" {
+		config.Dir = "blobs"
+	}
+	return &LocalBlobStorage{dir: config.Dir}
+}
+
+// Put writes the object to a temporary file and renames it into place, so a reader never sees a
+// partial object
+func (s *LocalBlobStorage) Put(ctx context.Context, key string, r io.Reader) (int64, error) {
+	location, err := s.location(key)
+	if err != nil {
+		return 0, err
+	}
+	if err := os.MkdirAll(filepath.Dir(location), 0755); err != nil {
+		return 0, fmt.Errorf("can't create blob directory: %w", err)
+	}
+
+	file, err := os.CreateTemp(filepath.Dir(location), ".upload-*")
+	if err != nil {
+		return 0, err
+	}
+	defer os.Remove(file.Name()) // Fails harmlessly once the file is renamed
+
+	size, err := io.Copy(file, r)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return 0, err
+	}
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+
+	if err := os.Rename(file.Name(), location); err != nil {
+		return 0, err
+	}
+	return size, nil
+}
+
+// Open opens the object's file
+func (s *LocalBlobStorage) Open(ctx context.Context, key string) (io.ReadCloser, error) {
+	location, err := s.location(key)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := os.Open(location)
+	if os.IsNotExist(err) {
+		return nil, fmt.Errorf("%s: %w", key, infra.ErrBlobNotFound)
+	}
+	return file, err
+}
+
+// Delete removes the object's file
+func (s *LocalBlobStorage) Delete(ctx context.Context, key string) error {
+	location, err := s.location(key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.Remove(location); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+// location returns the path of the object's file, rejecting keys that would escape the directory
+func (s *LocalBlobStorage) location(key string) (string, error) {
+	cleaned := path.Clean("/" + key)
+	if key == "" || cleaned == "/" || cleaned != "/"+key || strings.Contains(key, "\\") {
+		return "", fmt.Errorf("invalid blob key %q", key)
+	}
+	return filepath.Join(s.dir, filepath.FromSlash(key)), nil
+}
@@ -0,0 +1,147 @@
+package infrastructure
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// gzipMagic starts every gzip stream; JSON never starts with it, so plain and compressed values can
+// share the cache and values written before compression was enabled still decode
+var gzipMagic = []byte{0x1f, 0x8b}
+
+// CacheCompressionMetrics counts the values the cache adapter compressed, the bytes it saved and the
+// time it spent on it. It is an expvar.Var, so it can be published as-is.
+type CacheCompressionMetrics struct {
+	compressed      atomic.Int64
+	uncompressed    atomic.Int64
+	rawBytes        atomic.Int64
+	storedBytes     atomic.Int64
+	attempts        atomic.Int64
+	compressNanos   atomic.Int64
+	decompressed    atomic.Int64
+	decompressNanos atomic.Int64
+}
+
+// CacheCompressionSnapshot is a point-in-time copy of the compression metrics
+type CacheCompressionSnapshot struct {
+	Compressed          int64   `json:"compressed"`   // Values stored compressed
+	Uncompressed        int64   `json:"uncompressed"` // Values under the threshold or that did not shrink
+	BytesSaved          int64   `json:"bytes_saved"`
+	Ratio               float64 `json:"ratio"`               // Stored size of compressed values over their JSON size
+	AvgCompressMicros   float64 `json:"avg_compress_micros"` // Per value over the threshold, whether or not it shrank
+	Decompressed        int64   `json:"decompressed"`
+	AvgDecompressMicros float64 `json:"avg_decompress_micros"`
+}
+
+// NewCacheCompressionMetrics creates empty compression metrics
+func NewCacheCompressionMetrics() *CacheCompressionMetrics {
+	return &CacheCompressionMetrics{}
+}
+
+// Snapshot returns the current metrics
+func (m *CacheCompressionMetrics) Snapshot() CacheCompressionSnapshot {
+	raw, stored := m.rawBytes.Load(), m.storedBytes.Load()
+	snapshot := CacheCompressionSnapshot{
+		Compressed:   m.compressed.Load(),
+		Uncompressed: m.uncompressed.Load(),
+		BytesSaved:   raw - stored,
+		Decompressed: m.decompressed.Load(),
+	}
+	if raw > 0 {
+		snapshot.Ratio = float64(stored) / float64(raw)
+	}
+	if attempts := m.attempts.Load(); attempts > 0 {
+		snapshot.AvgCompressMicros = float64(m.compressNanos.Load()) / float64(attempts) / 1e3
+	}
+	if snapshot.Decompressed > 0 {
+		snapshot.AvgDecompressMicros = float64(m.decompressNanos.Load()) / float64(snapshot.Decompressed) / 1e3
+	}
+	return snapshot
+}
+
+// String renders the metrics as JSON for expvar
+func (m *CacheCompressionMetrics) String() string {
+	data, _ := json.Marshal(m.Snapshot())
+	return string(data)
+}
+
+// payloadCodec turns cached values into stored bytes: JSON, gzipped when it is at least threshold
+// bytes long and compressing shrinks it
+type payloadCodec struct {
+	threshold int // 0 disables compression
+	metrics   *CacheCompressionMetrics
+	writers   sync.Pool
+}
+
+func newPayloadCodec(threshold int) *payloadCodec {
+	return &payloadCodec{
+		threshold: threshold,
+		metrics:   NewCacheCompressionMetrics(),
+		writers: sync.Pool{New: func() any {
+			writer, _ := gzip.NewWriterLevel(io.Discard, gzip.BestSpeed)
+			return writer
+		}},
+	}
+}
+
+// encode marshals a value, compressing it when that is worthwhile
+func (c *payloadCodec) encode(value interface{}) ([]byte, error) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal value: %w", err)
+	}
+	if c.threshold <= 0 || len(data) < c.threshold {
+		c.metrics.uncompressed.Add(1)
+		return data, nil
+	}
+
+	start := time.Now()
+	var buf bytes.Buffer
+	writer := c.writers.Get().(*gzip.Writer)
+	defer c.writers.Put(writer)
+	writer.Reset(&buf)
+	if _, err := writer.Write(data); err != nil {
+		return nil, fmt.Errorf("failed to compress value: %w", err)
+	}
+	if err := writer.Close(); err != nil {
+		return nil, fmt.Errorf("failed to compress value: %w", err)
+	}
+	c.metrics.attempts.Add(1)
+	c.metrics.compressNanos.Add(int64(time.Since(start)))
+
+	// Incompressible values, e.g. lists of random IDs, are cheaper to read back as they are
+	if buf.Len() >= len(data) {
+		c.metrics.uncompressed.Add(1)
+		return data, nil
+	}
+
+	c.metrics.compressed.Add(1)
+	c.metrics.rawBytes.Add(int64(len(data)))
+	c.metrics.storedBytes.Add(int64(buf.Len()))
+	return buf.Bytes(), nil
+}
+
+// decode unmarshals stored bytes into dest, decompressing them first if they are gzipped
+func (c *payloadCodec) decode(data []byte, dest interface{}) error {
+	if bytes.HasPrefix(data, gzipMagic) {
+		start := time.Now()
+		reader, err := gzip.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return fmt.Errorf("failed to decompress value: %w", err)
+		}
+		data, err = io.ReadAll(reader)
+		if err != nil {
+			return fmt.Errorf("failed to decompress value: %w", err)
+		}
+		c.metrics.decompressed.Add(1)
+		c.metrics.decompressNanos.Add(int64(time.Since(start)))
+	}
+
+	return json.Unmarshal(data, dest)
+}
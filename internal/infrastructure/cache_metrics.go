@@ -0,0 +1,132 @@
+package infrastructure
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/domain/infra"
+)
+
+// CacheMetrics counts cache calls by outcome. It is an expvar.Var, so it can be published as-is.
+type CacheMetrics struct {
+	hits     atomic.Int64
+	misses   atomic.Int64
+	failures atomic.Int64
+}
+
+// CacheMetricsSnapshot is a point-in-time copy of the cache metrics
+type CacheMetricsSnapshot struct {
+	Hits     int64   `json:"hits"`
+	Misses   int64   `json:"misses"`
+	Failures int64   `json:"failures"` // Gets, sets and deletes that failed for reasons other than a miss
+	HitRatio float64 `json:"hit_ratio"`
+}
+
+// NewCacheMetrics creates empty cache metrics
+func NewCacheMetrics() *CacheMetrics {
+	return &CacheMetrics{}
+}
+
+// Snapshot returns the current metrics
+func (m *CacheMetrics) Snapshot() CacheMetricsSnapshot {
+	snapshot := CacheMetricsSnapshot{
+		Hits:     m.hits.Load(),
+		Misses:   m.misses.Load(),
+		Failures: m.failures.Load(),
+	}
+	if lookups := snapshot.Hits + snapshot.Misses; lookups > 0 {
+		snapshot.HitRatio = float64(snapshot.Hits) / float64(lookups)
+	}
+	return snapshot
+}
+
+// String renders the metrics as JSON for expvar
+func (m *CacheMetrics) String() string {
+	data, _ := json.Marshal(m.Snapshot())
+	return string(data)
+}
+
+// MeteredCache records the outcome of every call to the cache it wraps and logs failures, so a
+// failing Redis shows up in the metrics instead of passing for a cold cache
+type MeteredCache struct {
+	next    infra.CacheService
+	metrics *CacheMetrics
+	logger  infra.Logger
+}
+
+// NewMeteredCache creates a cache recording the calls to next in metrics
+func NewMeteredCache(next infra.CacheService, metrics *CacheMetrics, logger infra.Logger) *MeteredCache {
+	return &MeteredCache{
+		next:    next,
+		metrics: metrics,
+		logger:  logger,
+	}
+}
+
+// Set stores a value
+func (c *MeteredCache) Set(ctx context.Context, key string, value interface{}, expiration time.Duration) error {
+	err := c.next.Set(ctx, key, value, expiration)
+	if err != nil {
+		c.fail("set", key, err)
+	}
+	return err
+}
+
+// Get retrieves a value, counting it as a hit, a miss or a failure
+func (c *MeteredCache) Get(ctx context.Context, key string, dest interface{}) error {
+	err := c.next.Get(ctx, key, dest)
+	switch {
+	case err == nil:
+		c.metrics.hits.Add(1)
+	case errors.Is(err, infra.ErrCacheMiss):
+		c.metrics.misses.Add(1)
+	default:
+		c.fail("get", key, err)
+	}
+	return err
+}
+
+// GetMany retrieves several keys, counting each as a hit or a miss, or the call as a failure
+func (c *MeteredCache) GetMany(ctx context.Context, keys []string, dests []interface{}) ([]bool, error) {
+	found, err := c.next.GetMany(ctx, keys, dests)
+	if err != nil {
+		c.fail("get_many", strings.Join(keys, ","), err)
+		return nil, err
+	}
+
+	for _, ok := range found {
+		if ok {
+			c.metrics.hits.Add(1)
+		} else {
+			c.metrics.misses.Add(1)
+		}
+	}
+	return found, nil
+}
+
+// Delete removes a key
+func (c *MeteredCache) Delete(ctx context.Context, key string) error {
+	err := c.next.Delete(ctx, key)
+	if err != nil {
+		c.fail("delete", key, err)
+	}
+	return err
+}
+
+// DeleteMatching removes the keys matching a pattern
+func (c *MeteredCache) DeleteMatching(ctx context.Context, pattern string) (int64, error) {
+	deleted, err := c.next.DeleteMatching(ctx, pattern)
+	if err != nil {
+		c.fail("delete_matching", pattern, err)
+	}
+	return deleted, err
+}
+
+func (c *MeteredCache) fail(operation, key string, err error) {
+	c.metrics.failures.Add(1)
+	c.logger.Warn("Cache operation failed", "operation", operation, "key", key, "error", err)
+}
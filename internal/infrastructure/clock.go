@@ -0,0 +1,57 @@
+package infrastructure
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/domain/infra"
+)
+
+// SystemClock is the real time
+type SystemClock struct{}
+
+// Now returns the current time
+func (SystemClock) Now() time.Time {
+	return time.Now()
+}
+
+// OffsetClock runs at the speed of real time, ahead of it by an offset that only ever grows. Only
+// this instance sees the offset: every instance of a sandbox must be advanced.
+type OffsetClock struct {
+	mu     sync.RWMutex
+	offset time.Duration
+}
+
+var _ infra.TestClock = (*OffsetClock)(nil)
+
+// NewOffsetClock creates a test clock showing real time
+func NewOffsetClock() *OffsetClock {
+	return &OffsetClock{}
+}
+
+// Now returns real time moved forward by the offset
+func (c *OffsetClock) Now() time.Time {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return time.Now().Add(c.offset)
+}
+
+// Advance moves the clock forward by d and returns the new time; time never runs backwards
+func (c *OffsetClock) Advance(d time.Duration) (time.Time, error) {
+	if d <= 0 {
+		return time.Time{}, fmt.Errorf("clock can only be advanced by a positive duration, got %s", d)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.offset += d
+	return time.Now().Add(c.offset), nil
+}
+
+// Offset returns how far the clock is ahead of real time
+func (c *OffsetClock) Offset() time.Duration {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.offset
+}
@@ -4,15 +4,18 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"os"
+	"strings"
 	"time"
 
 	"github.com/hydr0g3nz/mini_bank/internal/adapter/repository/gorm/model"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/infra"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/jackc/pgx/v5/stdlib"
 	"github.com/jackc/pgx/v5/tracelog"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
-	"gorm.io/gorm/logger"
 )
 
 type SimpleLogger struct{}
@@ -26,44 +29,54 @@ type DBConfig struct {
 	Host     string
 	Port     string
 	User     string
-	Password string
+	Password infra.SecretValue // Read for every new connection, so a rotated password applies without a restart
 	DBName   string
 	SSLMode  string
+
+	LogLevel           string        // Query log level: silent, error, warn or info (every query)
+	SlowQueryThreshold time.Duration // Queries slower than this are logged as warnings; 0 disables it
+	ReadOnlyProbe      time.Duration // How often a read-only database is checked for accepting writes again
+
+	RetryMaxAttempts int           // Attempts of a write that hits a deadlock or serialization failure, the first included
+	RetryBaseDelay   time.Duration // Backoff before the first retry, doubled before each further one
+	RetryMaxDelay    time.Duration // Longest backoff between two attempts
 }
 
-// ConnectDB creates a database connection pool
-func ConnectDB(config *DBConfig) (*gorm.DB, error) {
-	dsn := fmt.Sprintf("host=%s user=%s password=%s dbname=%s port=%s sslmode=%s",
+// ConnectDB creates a database connection pool logging queries through appLogger into metrics
+func ConnectDB(config *DBConfig, appLogger infra.Logger, metrics *QueryMetrics) (*gorm.DB, error) {
+	dsn := fmt.Sprintf("host=%s user=%s dbname=%s port=%s sslmode=%s",
 		config.Host,
 		config.User,
-		config.Password,
 		config.DBName,
 		config.Port,
 		config.SSLMode,
 	)
 
-	newLogger := logger.New(
-		log.New(os.Stdout, "\r\n", log.LstdFlags), // io writer
-		logger.Config{
-			SlowThreshold:             time.Second, // Slow SQL threshold
-			LogLevel:                  logger.Info, // Log level
-			IgnoreRecordNotFoundError: true,        // Ignore ErrRecordNotFound error for logger
-			Colorful:                  true,        // Disable color
-		},
-	)
+	logLevel, err := ParseGormLogLevel(config.LogLevel)
+	if err != nil {
+		return nil, err
+	}
 
-	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{
-		Logger: newLogger,
-	})
+	connConfig, err := pgx.ParseConfig(dsn)
 	if err != nil {
 		return nil, err
 	}
+	sqlDB := stdlib.OpenDB(*connConfig, stdlib.OptionBeforeConnect(func(ctx context.Context, connConfig *pgx.ConnConfig) error {
+		connConfig.Password = config.Password.Value()
+		return nil
+	}))
 
-	sqlDB, err := db.DB()
+	db, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDB}), &gorm.Config{
+		Logger: NewGormLogger(appLogger, logLevel, config.SlowQueryThreshold, metrics),
+	})
 	if err != nil {
 		return nil, err
 	}
 
+	if err := RegisterTransientErrors(db); err != nil {
+		return nil, err
+	}
+
 	// SetMaxIdleConns sets the maximum number of connections in the idle connection pool
 	sqlDB.SetMaxIdleConns(10)
 	// SetMaxOpenConns sets the maximum number of open connections to the database
@@ -84,17 +97,98 @@ func MigrateDB(db *gorm.DB) error {
 	log.Println("Running database migrations...")
 
 	// Auto migrate all model
-	err := db.AutoMigrate(
+	if err := AutoMigrate(db); err != nil {
+		log.Fatalf("Failed to run migrations: %v", err)
+		return err
+	}
+
+	log.Println("Database migrations completed successfully")
+	return nil
+}
+
+// AutoMigrate creates or updates the table of every model, without logging
+func AutoMigrate(db *gorm.DB) error {
+	return db.AutoMigrate(
 		// &model.Hospital{},
 		&model.Account{},
+		&model.AccountNameHistory{},
+		&model.BalanceAdjustment{},
 		&model.Transaction{},
+		&model.Backup{},
+		&model.OutboxEvent{},
+		&model.Saga{},
+		&model.Holiday{},
+		&model.Category{},
+		&model.CategoryRule{},
+		&model.CategoryOverride{},
+		&model.BusinessRule{},
+		&model.Product{},
+		&model.CashbackCampaign{},
+		&model.CashbackReward{},
+		&model.ReferralCode{},
+		&model.Referral{},
+		&model.TransferTemplate{},
+		&model.ScheduledTransaction{},
+		&model.Budget{},
+		&model.WebhookSubscription{},
+		&model.VirtualAccount{},
+		&model.AuditEntry{},
+		&model.TransactionHistory{},
+		&model.TransactionTag{},
+		&model.SavedFilter{},
+		&model.Attachment{},
+		&model.DailyAggregate{},
+		&model.DailyAggregateTransaction{},
+		&model.Export{},
+		&model.Job{},
+		&model.TransactionProcessing{},
+		&model.AccountSequence{},
+		&model.NotificationTemplate{},
+		&model.NotificationPreference{},
+		&model.AccountingPeriod{},
+		&model.GLPosting{},
+		&model.OwnershipTransfer{},
+		&model.ProductMigration{},
+		&model.TransactionBlock{},
+		&model.AdminUser{},
+		&model.AdminActivity{},
 	)
+}
 
-	if err != nil {
-		log.Fatalf("Failed to run migrations: %v", err)
-		return err
+// accountNameIndexes are the unique account name indexes per account name scope; NONE has none
+var accountNameIndexes = map[vo.AccountNameScope]string{
+	vo.AccountNameScopeGlobal:   "CREATE UNIQUE INDEX IF NOT EXISTS idx_accounts_name_global ON accounts (account_name) WHERE deleted_at IS NULL",
+	vo.AccountNameScopeCustomer: "CREATE UNIQUE INDEX IF NOT EXISTS idx_accounts_name_customer ON accounts (customer_id, account_name) WHERE deleted_at IS NULL",
+}
+
+// MigrateAccountNameIndex creates the unique account name index of the scope and drops those of the other scopes.
+// Creating it fails while existing accounts break the scope's uniqueness; they have to be renamed first.
+func MigrateAccountNameIndex(db *gorm.DB, scope vo.AccountNameScope) error {
+	for _, other := range []vo.AccountNameScope{vo.AccountNameScopeGlobal, vo.AccountNameScopeCustomer} {
+		if other == scope {
+			continue
+		}
+		name := "idx_accounts_name_" + strings.ToLower(string(other))
+		if err := db.Exec("DROP INDEX IF EXISTS " + name).Error; err != nil {
+			return fmt.Errorf("drop account name index %s: %w", name, err)
+		}
 	}
 
-	log.Println("Database migrations completed successfully")
+	if statement, ok := accountNameIndexes[scope]; ok {
+		if err := db.Exec(statement).Error; err != nil {
+			return fmt.Errorf("create unique account name index for scope %s: %w", scope, err)
+		}
+	}
+	return nil
+}
+
+// MigrateCurrency records currency on the accounts, transactions and GL postings stored before accounts
+// carried their currency, when every account was held in the service currency
+func MigrateCurrency(db *gorm.DB, currency vo.Currency) error {
+	for _, table := range []string{"accounts", "transactions", "gl_postings"} {
+		if err := db.Exec("UPDATE "+table+" SET currency = ? WHERE currency = ''", currency.String()).Error; err != nil {
+			return fmt.Errorf("backfill currency of %s: %w", table, err)
+		}
+	}
 	return nil
 }
@@ -0,0 +1,153 @@
+package infrastructure
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/hydr0g3nz/mini_bank/internal/domain/event"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/infra"
+)
+
+// eventsChannel is the Redis pub/sub channel carrying domain events between instances
+const eventsChannel = "events"
+
+// EventBusConfig holds event bus configuration
+type EventBusConfig struct {
+	Driver string // memory or redis
+}
+
+type subscription struct {
+	handler infra.EventHandler
+	types   map[event.Type]bool
+}
+
+// InMemoryEventBus delivers events to subscribers within the current process
+type InMemoryEventBus struct {
+	mu            sync.RWMutex
+	subscriptions map[int]subscription
+	nextID        int
+	logger        infra.Logger
+}
+
+// NewInMemoryEventBus creates a new in-process event bus
+func NewInMemoryEventBus(logger infra.Logger) *InMemoryEventBus {
+	return &InMemoryEventBus{
+		subscriptions: make(map[int]subscription),
+		logger:        logger,
+	}
+}
+
+// Publish delivers the event to every matching subscriber
+func (b *InMemoryEventBus) Publish(ctx context.Context, evt event.Event) error {
+	b.mu.RLock()
+	handlers := make([]infra.EventHandler, 0, len(b.subscriptions))
+	for _, sub := range b.subscriptions {
+		if len(sub.types) == 0 || sub.types[evt.Type] {
+			handlers = append(handlers, sub.handler)
+		}
+	}
+	b.mu.RUnlock()
+
+	for _, handler := range handlers {
+		b.dispatch(ctx, handler, evt)
+	}
+
+	return nil
+}
+
+// Subscribe registers a handler for the given event types (all events when none given)
+func (b *InMemoryEventBus) Subscribe(handler infra.EventHandler, eventTypes ...event.Type) func() {
+	types := make(map[event.Type]bool, len(eventTypes))
+	for _, eventType := range eventTypes {
+		types[eventType] = true
+	}
+
+	b.mu.Lock()
+	id := b.nextID
+	b.nextID++
+	b.subscriptions[id] = subscription{handler: handler, types: types}
+	b.mu.Unlock()
+
+	return func() {
+		b.mu.Lock()
+		delete(b.subscriptions, id)
+		b.mu.Unlock()
+	}
+}
+
+// dispatch invokes a handler, isolating the publisher from handler panics
+func (b *InMemoryEventBus) dispatch(ctx context.Context, handler infra.EventHandler, evt event.Event) {
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			b.logger.Error("Event handler panicked", "error", recovered, "eventID", evt.ID, "eventType", evt.Type)
+		}
+	}()
+	handler(ctx, evt)
+}
+
+// RedisEventBus fans events out to every instance through Redis pub/sub.
+// Events published on any instance are received by all instances (including the
+// publisher) and then delivered to local subscribers.
+type RedisEventBus struct {
+	local  *InMemoryEventBus
+	bus    pubSub
+	logger infra.Logger
+	cancel context.CancelFunc
+}
+
+// NewRedisEventBus creates a Redis-backed event bus and starts consuming the shared channel
+func NewRedisEventBus(bus pubSub, logger infra.Logger) *RedisEventBus {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	b := &RedisEventBus{
+		local:  NewInMemoryEventBus(logger),
+		bus:    bus,
+		logger: logger,
+		cancel: cancel,
+	}
+
+	go b.consume(ctx)
+
+	return b
+}
+
+// Publish sends the event to all instances
+func (b *RedisEventBus) Publish(ctx context.Context, evt event.Event) error {
+	data, err := json.Marshal(evt)
+	if err != nil {
+		return fmt.Errorf("failed to marshal event: %w", err)
+	}
+
+	if err := b.bus.Publish(ctx, eventsChannel, string(data)); err != nil {
+		// Keep this instance consistent even if the fan-out is unavailable
+		b.logger.Warn("Failed to fan out event, delivering locally only", "error", err, "eventID", evt.ID)
+		b.local.Publish(ctx, evt)
+		return fmt.Errorf("failed to publish event: %w", err)
+	}
+
+	return nil
+}
+
+// Subscribe registers a local handler for events coming from any instance
+func (b *RedisEventBus) Subscribe(handler infra.EventHandler, eventTypes ...event.Type) func() {
+	return b.local.Subscribe(handler, eventTypes...)
+}
+
+// Close stops consuming the shared channel
+func (b *RedisEventBus) Close() {
+	b.cancel()
+}
+
+// consume delivers events received from Redis to local subscribers
+func (b *RedisEventBus) consume(ctx context.Context) {
+	for message := range b.bus.Subscribe(ctx, eventsChannel) {
+		var evt event.Event
+		if err := json.Unmarshal([]byte(message), &evt); err != nil {
+			b.logger.Warn("Discarding malformed event", "error", err)
+			continue
+		}
+		b.local.Publish(ctx, evt)
+	}
+}
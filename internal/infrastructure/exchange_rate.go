@@ -0,0 +1,230 @@
+package infrastructure
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/infra"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	"github.com/shopspring/decimal"
+)
+
+// Exchange rate providers
+const (
+	ExchangeRateProviderStatic = "static"
+	ExchangeRateProviderHTTP   = "http"
+)
+
+// ExchangeRateConfig holds exchange rate provider configuration
+type ExchangeRateConfig struct {
+	Provider string        // static or http
+	Rates    []string      // FROM:TO:RATE quotes of the static provider, e.g. USD:THB:35.50
+	URL      string        // Endpoint of the http provider, called with ?base=FROM
+	APIKey   string        // Sent as a bearer token to the http provider when set
+	CacheTTL time.Duration // How long the http provider's quotes for a base currency are reused
+	Timeout  time.Duration // Per-attempt timeout of calls to the http provider
+}
+
+// NewExchangeRateService creates the configured exchange rate provider; the http provider is called through client
+func NewExchangeRateService(config ExchangeRateConfig, client *HTTPClient) (infra.ExchangeRateService, error) {
+	switch config.Provider {
+	case ExchangeRateProviderStatic, "":
+		rates, err := ParseExchangeRates(config.Rates, time.Now())
+		if err != nil {
+			return nil, err
+		}
+		return NewStaticExchangeRateProvider(rates), nil
+	case ExchangeRateProviderHTTP:
+		return &HTTPExchangeRateProvider{client: client, url: config.URL, apiKey: config.APIKey, ttl: config.CacheTTL, quotes: make(map[vo.Currency]exchangeRateQuotes)}, nil
+	}
+	return nil, fmt.Errorf("unknown exchange rate provider %q", config.Provider)
+}
+
+// ParseExchangeRates parses FROM:TO:RATE quotes, all quoted at asOf
+func ParseExchangeRates(entries []string, asOf time.Time) ([]vo.ExchangeRate, error) {
+	rates := make([]vo.ExchangeRate, 0, len(entries))
+	for _, entry := range entries {
+		parts := strings.Split(entry, ":")
+		if len(parts) != 3 {
+			return nil, fmt.Errorf("invalid exchange rate %q, expected FROM:TO:RATE", entry)
+		}
+		from, err := vo.NewCurrency(parts[0])
+		if err != nil {
+			return nil, fmt.Errorf("invalid exchange rate %q: %w", entry, err)
+		}
+		to, err := vo.NewCurrency(parts[1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid exchange rate %q: %w", entry, err)
+		}
+		value, err := decimal.NewFromString(strings.TrimSpace(parts[2]))
+		if err != nil {
+			return nil, fmt.Errorf("invalid exchange rate %q: %w", entry, err)
+		}
+		rate, err := vo.NewExchangeRate(from, to, value, asOf)
+		if err != nil {
+			return nil, fmt.Errorf("invalid exchange rate %q: %w", entry, err)
+		}
+		rates = append(rates, rate)
+	}
+	return rates, nil
+}
+
+// StaticExchangeRateProvider quotes a fixed table of rates, e.g. for development or a bank that sets
+// its rates once a day. A pair quoted only the other way round is converted at the inverse rate.
+type StaticExchangeRateProvider struct {
+	rates map[[2]vo.Currency]vo.ExchangeRate
+}
+
+// NewStaticExchangeRateProvider creates a provider quoting rates
+func NewStaticExchangeRateProvider(rates []vo.ExchangeRate) *StaticExchangeRateProvider {
+	p := &StaticExchangeRateProvider{rates: make(map[[2]vo.Currency]vo.ExchangeRate)}
+	for _, rate := range rates {
+		p.rates[[2]vo.Currency{rate.From, rate.To}] = rate
+	}
+	// Inverses fill in pairs not quoted directly
+	for _, rate := range rates {
+		inverse := [2]vo.Currency{rate.To, rate.From}
+		if _, ok := p.rates[inverse]; !ok {
+			p.rates[inverse] = rate.Inverse()
+		}
+	}
+	return p
+}
+
+// GetRate returns the rate from one currency to another
+func (p *StaticExchangeRateProvider) GetRate(ctx context.Context, from, to vo.Currency) (vo.ExchangeRate, error) {
+	rate, ok := p.rates[[2]vo.Currency{from, to}]
+	if !ok {
+		return vo.ExchangeRate{}, fmt.Errorf("%s to %s: %w", from, to, errs.ErrExchangeRateUnavailable)
+	}
+	return rate, nil
+}
+
+// ListRates returns the rates from base, ordered by currency
+func (p *StaticExchangeRateProvider) ListRates(ctx context.Context, base vo.Currency) ([]vo.ExchangeRate, error) {
+	var rates []vo.ExchangeRate
+	for pair, rate := range p.rates {
+		if pair[0] == base {
+			rates = append(rates, rate)
+		}
+	}
+	sort.Slice(rates, func(i, j int) bool { return rates[i].To < rates[j].To })
+	return rates, nil
+}
+
+// exchangeRateQuotes are the rates fetched for a base currency
+type exchangeRateQuotes struct {
+	rates     []vo.ExchangeRate
+	fetchedAt time.Time
+}
+
+// HTTPExchangeRateProvider fetches rates from an HTTP rates API answering GET {url}?base=USD with
+// {"base": "USD", "date": "2024-03-01", "rates": {"THB": 35.5, ...}}. Quotes for a base currency
+// are cached for the configured TTL.
+type HTTPExchangeRateProvider struct {
+	client *HTTPClient
+	url    string
+	apiKey string
+	ttl    time.Duration
+
+	mu     sync.Mutex
+	quotes map[vo.Currency]exchangeRateQuotes
+}
+
+// GetRate returns the rate from one currency to another
+func (p *HTTPExchangeRateProvider) GetRate(ctx context.Context, from, to vo.Currency) (vo.ExchangeRate, error) {
+	rates, err := p.ListRates(ctx, from)
+	if err != nil {
+		return vo.ExchangeRate{}, err
+	}
+	for _, rate := range rates {
+		if rate.To == to {
+			return rate, nil
+		}
+	}
+	return vo.ExchangeRate{}, fmt.Errorf("%s to %s: %w", from, to, errs.ErrExchangeRateUnavailable)
+}
+
+// ListRates returns the rates from base, ordered by currency, from the cache while it is fresh
+func (p *HTTPExchangeRateProvider) ListRates(ctx context.Context, base vo.Currency) ([]vo.ExchangeRate, error) {
+	p.mu.Lock()
+	cached, ok := p.quotes[base]
+	p.mu.Unlock()
+	if ok && time.Since(cached.fetchedAt) < p.ttl {
+		return cached.rates, nil
+	}
+
+	rates, err := p.fetch(ctx, base)
+	if err != nil {
+		return nil, err
+	}
+
+	p.mu.Lock()
+	p.quotes[base] = exchangeRateQuotes{rates: rates, fetchedAt: time.Now()}
+	p.mu.Unlock()
+	return rates, nil
+}
+
+// fetch calls the rates API for the rates from base
+func (p *HTTPExchangeRateProvider) fetch(ctx context.Context, base vo.Currency) ([]vo.ExchangeRate, error) {
+	header := http.Header{"Accept": []string{"application/json"}}
+	if p.apiKey != "" {
+		header.Set("Authorization", "Bearer "+p.apiKey)
+	}
+
+	resp, err := p.client.Do(ctx, OutboundRequest{
+		Method: http.MethodGet,
+		URL:    p.url + "?base=" + url.QueryEscape(base.String()),
+		Header: header,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("%s: %w", base, errs.ErrExchangeRateUnavailable)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("exchange rate provider responded with status %d quoting %s", resp.StatusCode, base)
+	}
+
+	var body struct {
+		Base  string                     `json:"base"`
+		Date  string                     `json:"date"`
+		Rates map[string]decimal.Decimal `json:"rates"`
+	}
+	if err := json.Unmarshal(resp.Body, &body); err != nil {
+		return nil, fmt.Errorf("invalid exchange rate response quoting %s: %w", base, err)
+	}
+	if !strings.EqualFold(body.Base, base.String()) {
+		return nil, fmt.Errorf("exchange rate provider quoted %s when asked for %s", body.Base, base)
+	}
+
+	asOf := time.Now().UTC()
+	if date, err := time.Parse("2006-01-02", body.Date); err == nil {
+		asOf = date
+	}
+
+	rates := make([]vo.ExchangeRate, 0, len(body.Rates))
+	for code, value := range body.Rates {
+		currency, err := vo.NewCurrency(code)
+		if err != nil || currency == base {
+			// Providers quote metals, crypto and the base itself too; accounts cannot be held in them
+			continue
+		}
+		rate, err := vo.NewExchangeRate(base, currency, value, asOf)
+		if err != nil {
+			continue
+		}
+		rates = append(rates, rate)
+	}
+	sort.Slice(rates, func(i, j int) bool { return rates[i].To < rates[j].To })
+	return rates, nil
+}
@@ -0,0 +1,175 @@
+package infrastructure
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/domain/infra"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// QueryMetrics counts the SQL queries run and how long they took. It is an expvar.Var, so it
+// can be published as-is.
+type QueryMetrics struct {
+	queries       atomic.Int64
+	errors        atomic.Int64
+	slow          atomic.Int64
+	totalDuration atomic.Int64 // Nanoseconds
+	maxDuration   atomic.Int64 // Nanoseconds
+}
+
+// QueryMetricsSnapshot is a point-in-time copy of the query metrics
+type QueryMetricsSnapshot struct {
+	Queries   int64   `json:"queries"`
+	Errors    int64   `json:"errors"`
+	Slow      int64   `json:"slow"`
+	TotalMs   float64 `json:"total_ms"`
+	AverageMs float64 `json:"average_ms"`
+	MaxMs     float64 `json:"max_ms"`
+}
+
+// NewQueryMetrics creates empty query metrics
+func NewQueryMetrics() *QueryMetrics {
+	return &QueryMetrics{}
+}
+
+// Observe records one query
+func (m *QueryMetrics) Observe(elapsed time.Duration, slow bool, failed bool) {
+	m.queries.Add(1)
+	m.totalDuration.Add(int64(elapsed))
+	if slow {
+		m.slow.Add(1)
+	}
+	if failed {
+		m.errors.Add(1)
+	}
+
+	for {
+		current := m.maxDuration.Load()
+		if int64(elapsed) <= current || m.maxDuration.CompareAndSwap(current, int64(elapsed)) {
+			return
+		}
+	}
+}
+
+// Snapshot returns the current metrics
+func (m *QueryMetrics) Snapshot() QueryMetricsSnapshot {
+	snapshot := QueryMetricsSnapshot{
+		Queries: m.queries.Load(),
+		Errors:  m.errors.Load(),
+		Slow:    m.slow.Load(),
+		TotalMs: durationMs(time.Duration(m.totalDuration.Load())),
+		MaxMs:   durationMs(time.Duration(m.maxDuration.Load())),
+	}
+	if snapshot.Queries > 0 {
+		snapshot.AverageMs = snapshot.TotalMs / float64(snapshot.Queries)
+	}
+	return snapshot
+}
+
+// String renders the metrics as JSON for expvar
+func (m *QueryMetrics) String() string {
+	data, _ := json.Marshal(m.Snapshot())
+	return string(data)
+}
+
+// GormLogger routes GORM's SQL logs through the application logger, tagged with the ID of the
+// API request that ran them. Failed queries are logged as errors and queries slower than the
+// threshold as warnings; every query is counted in the metrics.
+type GormLogger struct {
+	logger        infra.Logger
+	level         logger.LogLevel
+	slowThreshold time.Duration
+	metrics       *QueryMetrics
+}
+
+// NewGormLogger creates a GORM logger; a zero slowThreshold disables slow query warnings
+func NewGormLogger(appLogger infra.Logger, level logger.LogLevel, slowThreshold time.Duration, metrics *QueryMetrics) *GormLogger {
+	return &GormLogger{
+		logger:        appLogger,
+		level:         level,
+		slowThreshold: slowThreshold,
+		metrics:       metrics,
+	}
+}
+
+// ParseGormLogLevel parses a query log level: silent, error, warn or info
+func ParseGormLogLevel(level string) (logger.LogLevel, error) {
+	switch strings.ToLower(level) {
+	case "silent":
+		return logger.Silent, nil
+	case "error":
+		return logger.Error, nil
+	case "warn":
+		return logger.Warn, nil
+	case "info":
+		return logger.Info, nil
+	default:
+		return 0, fmt.Errorf("invalid query log level %q, expected silent, error, warn or info", level)
+	}
+}
+
+// LogMode returns a copy of the logger at another level
+func (l *GormLogger) LogMode(level logger.LogLevel) logger.Interface {
+	copied := *l
+	copied.level = level
+	return &copied
+}
+
+func (l *GormLogger) Info(ctx context.Context, msg string, data ...interface{}) {
+	if l.level >= logger.Info {
+		l.withRequestID(ctx).Infof(msg, data...)
+	}
+}
+
+func (l *GormLogger) Warn(ctx context.Context, msg string, data ...interface{}) {
+	if l.level >= logger.Warn {
+		l.withRequestID(ctx).Warnf(msg, data...)
+	}
+}
+
+func (l *GormLogger) Error(ctx context.Context, msg string, data ...interface{}) {
+	if l.level >= logger.Error {
+		l.withRequestID(ctx).Errorf(msg, data...)
+	}
+}
+
+// Trace records a finished query and logs it according to the level
+func (l *GormLogger) Trace(ctx context.Context, begin time.Time, fc func() (sql string, rowsAffected int64), err error) {
+	elapsed := time.Since(begin)
+	failed := err != nil && !errors.Is(err, gorm.ErrRecordNotFound)
+	slow := l.slowThreshold > 0 && elapsed > l.slowThreshold
+	if l.metrics != nil {
+		l.metrics.Observe(elapsed, slow, failed)
+	}
+
+	switch {
+	case failed && l.level >= logger.Error:
+		sql, rows := fc()
+		l.withRequestID(ctx).Error("Query failed", "error", err, "sql", sql, "rows", rows, "durationMs", durationMs(elapsed))
+	case slow && l.level >= logger.Warn:
+		sql, rows := fc()
+		l.withRequestID(ctx).Warn("Slow query", "sql", sql, "rows", rows, "durationMs", durationMs(elapsed), "thresholdMs", durationMs(l.slowThreshold))
+	case l.level >= logger.Info:
+		sql, rows := fc()
+		l.withRequestID(ctx).Info("Query", "sql", sql, "rows", rows, "durationMs", durationMs(elapsed))
+	}
+}
+
+// withRequestID tags the log with the API request the query ran for, if any
+func (l *GormLogger) withRequestID(ctx context.Context) infra.Logger {
+	if requestID := infra.RequestIDFromContext(ctx); requestID != "" {
+		return l.logger.With("requestID", requestID)
+	}
+	return l.logger
+}
+
+func durationMs(d time.Duration) float64 {
+	return float64(d) / float64(time.Millisecond)
+}
@@ -0,0 +1,397 @@
+package infrastructure
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	mathrand "math/rand/v2"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/domain/infra"
+)
+
+// ErrCircuitOpen is returned without calling a host whose circuit breaker is open
+var ErrCircuitOpen = errors.New("circuit breaker open")
+
+// HTTPClientConfig holds the behaviour shared by every outbound HTTP integration
+type HTTPClientConfig struct {
+	Timeout          time.Duration // Per-attempt timeout
+	MaxAttempts      int           // Attempts in total, the first included; 1 disables retries
+	RetryBaseDelay   time.Duration // Delay before the first retry, doubled before each further one
+	RetryMaxDelay    time.Duration // Longest delay between two attempts
+	RetryBudget      float64       // Retries allowed per request, averaged over time, e.g. 0.2 for one retry per five requests
+	BreakerThreshold int           // Consecutive failures that open a host's circuit breaker
+	BreakerCooldown  time.Duration // Time an open breaker rejects calls before letting one through
+	MaxResponseBytes int64         // Response bodies are read up to this size
+	UserAgent        string
+}
+
+// withDefaults fills the settings left unset
+func (c HTTPClientConfig) withDefaults() HTTPClientConfig {
+	if c.Timeout <= 0 {
+		c.Timeout = 5 * time.Second
+	}
+	if c.MaxAttempts <= 0 {
+		c.MaxAttempts = 3
+	}
+	if c.RetryBaseDelay <= 0 {
+		c.RetryBaseDelay = 100 * time.Millisecond
+	}
+	if c.RetryMaxDelay <= 0 {
+		c.RetryMaxDelay = 2 * time.Second
+	}
+	if c.BreakerThreshold <= 0 {
+		c.BreakerThreshold = 5
+	}
+	if c.BreakerCooldown <= 0 {
+		c.BreakerCooldown = 30 * time.Second
+	}
+	if c.MaxResponseBytes <= 0 {
+		c.MaxResponseBytes = 1 << 20
+	}
+	if c.UserAgent == "" {
+		c.UserAgent = "mini-bank"
+	}
+	return c
+}
+
+// RequestSigner signs an outbound request. It runs on every attempt, so signatures with a
+// timestamp stay fresh across retries.
+type RequestSigner interface {
+	Sign(req *http.Request, body []byte) error
+}
+
+// RequestSignerFunc adapts a function to RequestSigner
+type RequestSignerFunc func(req *http.Request, body []byte) error
+
+// Sign calls f
+func (f RequestSignerFunc) Sign(req *http.Request, body []byte) error {
+	return f(req, body)
+}
+
+// OutboundRequest is a request made through HTTPClient. The body is held in memory so it can be sent again.
+type OutboundRequest struct {
+	Method string
+	URL    string
+	Header http.Header
+	Body   []byte
+	Signer RequestSigner // Optional
+}
+
+// OutboundResponse is the response to the last attempt of an OutboundRequest
+type OutboundResponse struct {
+	StatusCode int
+	Header     http.Header
+	Body       []byte // Truncated to MaxResponseBytes
+	Attempts   int
+}
+
+// HTTPClient makes outbound HTTP calls with per-attempt timeouts, retries within a budget, a circuit
+// breaker per host, request signing and trace propagation, so every integration behaves the same way.
+// Transport errors, 429 and 502-504 responses are retried; other responses are returned as they are.
+type HTTPClient struct {
+	name    string
+	config  HTTPClientConfig
+	client  *http.Client
+	budget  *retryBudget
+	metrics *HTTPClientMetrics
+
+	mu       sync.Mutex
+	breakers map[string]*circuitBreaker
+}
+
+// NewHTTPClient creates an HTTP client; name identifies the integration in errors
+func NewHTTPClient(name string, config HTTPClientConfig) *HTTPClient {
+	config = config.withDefaults()
+	return &HTTPClient{
+		name:     name,
+		config:   config,
+		client:   &http.Client{Timeout: config.Timeout},
+		budget:   newRetryBudget(config.RetryBudget),
+		metrics:  &HTTPClientMetrics{},
+		breakers: make(map[string]*circuitBreaker),
+	}
+}
+
+// Metrics returns the client's metrics
+func (c *HTTPClient) Metrics() *HTTPClientMetrics {
+	return c.metrics
+}
+
+// Do sends the request, retrying it while the retry budget and the host's circuit breaker allow.
+// The response is returned for any status once received; an error means no usable response.
+func (c *HTTPClient) Do(ctx context.Context, request OutboundRequest) (*OutboundResponse, error) {
+	target, err := url.Parse(request.URL)
+	if err != nil || target.Host == "" {
+		return nil, fmt.Errorf("%s: invalid URL %q", c.name, request.URL)
+	}
+	breaker := c.breaker(target.Host)
+
+	c.metrics.requests.Add(1)
+	c.budget.deposit()
+
+	for attempt := 1; ; attempt++ {
+		// The request is built and signed again on every attempt; failing to do so is not worth retrying
+		req, err := c.newRequest(ctx, request)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", c.name, err)
+		}
+
+		if !breaker.allow() {
+			c.metrics.shortCircuited.Add(1)
+			return nil, fmt.Errorf("%s: %s: %w", c.name, target.Host, ErrCircuitOpen)
+		}
+
+		response, err := c.send(req)
+		breaker.record(err == nil && response.StatusCode < 500 && response.StatusCode != http.StatusTooManyRequests)
+		if err == nil && !isRetryableStatus(response.StatusCode) {
+			response.Attempts = attempt
+			return response, nil
+		}
+
+		if attempt >= c.config.MaxAttempts || ctx.Err() != nil || !c.budget.withdraw() {
+			c.metrics.failures.Add(1)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", c.name, err)
+			}
+			response.Attempts = attempt
+			return response, nil
+		}
+
+		delay := c.backoff(attempt)
+		if response != nil {
+			// Wait as long as the host asked, within the longest delay
+			if retryAfter := parseRetryAfter(response.Header); retryAfter > delay {
+				delay = min(retryAfter, c.config.RetryMaxDelay)
+			}
+		}
+
+		c.metrics.retries.Add(1)
+		select {
+		case <-ctx.Done():
+			c.metrics.failures.Add(1)
+			return nil, fmt.Errorf("%s: %w", c.name, ctx.Err())
+		case <-time.After(delay):
+		}
+	}
+}
+
+// newRequest builds one attempt of the request, with the trace headers and signature
+func (c *HTTPClient) newRequest(ctx context.Context, request OutboundRequest) (*http.Request, error) {
+	req, err := http.NewRequestWithContext(ctx, request.Method, request.URL, bytes.NewReader(request.Body))
+	if err != nil {
+		return nil, err
+	}
+	for key, values := range request.Header {
+		req.Header[key] = values
+	}
+	req.Header.Set("User-Agent", c.config.UserAgent)
+	propagateTrace(ctx, req)
+
+	if request.Signer != nil {
+		if err := request.Signer.Sign(req, request.Body); err != nil {
+			return nil, fmt.Errorf("failed to sign request: %w", err)
+		}
+	}
+	return req, nil
+}
+
+// send sends one attempt and reads its response
+func (c *HTTPClient) send(req *http.Request) (*OutboundResponse, error) {
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, c.config.MaxResponseBytes))
+	if err != nil {
+		return nil, err
+	}
+	// Drain a bounded amount more so the connection can be reused
+	io.Copy(io.Discard, io.LimitReader(resp.Body, 4096))
+
+	return &OutboundResponse{StatusCode: resp.StatusCode, Header: resp.Header, Body: body}, nil
+}
+
+// backoff returns the delay before the given retry, counted from 1, with full jitter
+func (c *HTTPClient) backoff(retry int) time.Duration {
+	delay := c.config.RetryBaseDelay << (retry - 1)
+	if delay <= 0 || delay > c.config.RetryMaxDelay {
+		delay = c.config.RetryMaxDelay
+	}
+	return mathrand.N(delay) + 1
+}
+
+// breaker returns the circuit breaker of a host, creating it on first use
+func (c *HTTPClient) breaker(host string) *circuitBreaker {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	breaker, ok := c.breakers[host]
+	if !ok {
+		breaker = &circuitBreaker{threshold: c.config.BreakerThreshold, cooldown: c.config.BreakerCooldown}
+		c.breakers[host] = breaker
+	}
+	return breaker
+}
+
+// isRetryableStatus checks if a response tells the caller to try again later
+func isRetryableStatus(status int) bool {
+	switch status {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// propagateTrace sends the request ID and a W3C traceparent, continuing the caller's trace when it sent
+// one. Each outbound attempt is a new span of that trace.
+func propagateTrace(ctx context.Context, req *http.Request) {
+	if requestID := infra.RequestIDFromContext(ctx); requestID != "" {
+		req.Header.Set("X-Request-ID", requestID)
+	}
+
+	traceID, flags := randomHex(16), "01"
+	if parts := strings.Split(infra.TraceParentFromContext(ctx), "-"); len(parts) == 4 && parts[0] == "00" && len(parts[1]) == 32 && len(parts[3]) == 2 {
+		traceID, flags = parts[1], parts[3]
+	}
+	req.Header.Set("traceparent", "00-"+traceID+"-"+randomHex(8)+"-"+flags)
+}
+
+// randomHex returns n random bytes, hex encoded
+func randomHex(n int) string {
+	b := make([]byte, n)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// retryBudget caps retries to a share of requests, so a failing dependency gets at most that much extra
+// load instead of MaxAttempts times its traffic. Every request deposits ratio tokens and every retry
+// withdraws one; a few tokens are always available so a quiet client can still retry.
+type retryBudget struct {
+	mu     sync.Mutex
+	ratio  float64
+	tokens float64
+	max    float64
+}
+
+// minRetryTokens are the retries available without any traffic to earn them
+const minRetryTokens = 10
+
+func newRetryBudget(ratio float64) *retryBudget {
+	if ratio < 0 {
+		ratio = 0
+	}
+	return &retryBudget{ratio: ratio, tokens: minRetryTokens, max: minRetryTokens + ratio*100}
+}
+
+func (b *retryBudget) deposit() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.tokens = min(b.tokens+b.ratio, b.max)
+}
+
+func (b *retryBudget) withdraw() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.tokens < 1 {
+		return false
+	}
+	b.tokens--
+	return true
+}
+
+// circuitBreaker stops calling a host after consecutive failures. Once the cooldown passes it lets
+// one call through: success closes the breaker, failure opens it for another cooldown.
+type circuitBreaker struct {
+	mu        sync.Mutex
+	threshold int
+	cooldown  time.Duration
+	failures  int
+	openUntil time.Time
+	probing   bool
+}
+
+// allow checks if a call may be made
+func (b *circuitBreaker) allow() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.failures < b.threshold {
+		return true
+	}
+	if b.probing || time.Now().Before(b.openUntil) {
+		return false
+	}
+	b.probing = true
+	return true
+}
+
+// record counts the outcome of a call
+func (b *circuitBreaker) record(success bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.probing = false
+	if success {
+		b.failures = 0
+		return
+	}
+	b.failures++
+	if b.failures >= b.threshold {
+		b.openUntil = time.Now().Add(b.cooldown)
+	}
+}
+
+// HTTPClientMetrics counts outbound calls by outcome. It is an expvar.Var, so it can be published as-is.
+type HTTPClientMetrics struct {
+	requests       atomic.Int64
+	retries        atomic.Int64
+	failures       atomic.Int64
+	shortCircuited atomic.Int64
+}
+
+// HTTPClientMetricsSnapshot is a point-in-time copy of the HTTP client metrics
+type HTTPClientMetricsSnapshot struct {
+	Requests       int64 `json:"requests"`
+	Retries        int64 `json:"retries"`
+	Failures       int64 `json:"failures"`        // Requests that still failed on their last attempt
+	ShortCircuited int64 `json:"short_circuited"` // Attempts rejected by an open circuit breaker
+}
+
+// Snapshot returns the current metrics
+func (m *HTTPClientMetrics) Snapshot() HTTPClientMetricsSnapshot {
+	return HTTPClientMetricsSnapshot{
+		Requests:       m.requests.Load(),
+		Retries:        m.retries.Load(),
+		Failures:       m.failures.Load(),
+		ShortCircuited: m.shortCircuited.Load(),
+	}
+}
+
+// String renders the metrics as JSON for expvar
+func (m *HTTPClientMetrics) String() string {
+	data, _ := json.Marshal(m.Snapshot())
+	return string(data)
+}
+
+// parseRetryAfter returns the delay a Retry-After header asks for in seconds, zero when absent or malformed
+func parseRetryAfter(header http.Header) time.Duration {
+	seconds, err := strconv.Atoi(header.Get("Retry-After"))
+	if err != nil || seconds < 0 {
+		return 0
+	}
+	return time.Duration(seconds) * time.Second
+}
@@ -0,0 +1,81 @@
+package infrastructure
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/domain/event"
+	"github.com/segmentio/kafka-go"
+)
+
+// KafkaConfig holds Kafka producer and outbox relay configuration
+type KafkaConfig struct {
+	Enabled            bool
+	Brokers            []string
+	TopicPrefix        string // Topics are named <prefix><event type>, e.g. minibank.transaction.completed
+	ClientID           string
+	OutboxPollInterval time.Duration
+	OutboxBatchSize    int
+}
+
+// KafkaEventPublisher publishes domain events to Kafka, one topic per event type,
+// keyed by the affected account so events for an account stay ordered within a partition
+type KafkaEventPublisher struct {
+	writer *kafka.Writer
+	config KafkaConfig
+}
+
+// NewKafkaEventPublisher creates a new Kafka event publisher
+func NewKafkaEventPublisher(config KafkaConfig) *KafkaEventPublisher {
+	writer := &kafka.Writer{
+		Addr:                   kafka.TCP(config.Brokers...),
+		Balancer:               &kafka.Hash{},
+		RequiredAcks:           kafka.RequireAll,
+		AllowAutoTopicCreation: true,
+		Transport: &kafka.Transport{
+			ClientID: config.ClientID,
+		},
+	}
+
+	return &KafkaEventPublisher{
+		writer: writer,
+		config: config,
+	}
+}
+
+// Publish writes the event to its topic and waits for all in-sync replicas to acknowledge
+func (p *KafkaEventPublisher) Publish(ctx context.Context, evt event.Event) error {
+	data, err := json.Marshal(evt)
+	if err != nil {
+		return fmt.Errorf("failed to marshal event: %w", err)
+	}
+
+	message := kafka.Message{
+		Topic: p.Topic(evt.Type),
+		Key:   []byte(evt.Key),
+		Value: data,
+		Time:  evt.OccurredAt,
+		Headers: []kafka.Header{
+			{Key: "event_id", Value: []byte(evt.ID)},
+			{Key: "event_type", Value: []byte(evt.Type)},
+		},
+	}
+
+	if err := p.writer.WriteMessages(ctx, message); err != nil {
+		return fmt.Errorf("failed to write event to kafka: %w", err)
+	}
+
+	return nil
+}
+
+// Topic returns the topic an event type is published to
+func (p *KafkaEventPublisher) Topic(eventType event.Type) string {
+	return p.config.TopicPrefix + string(eventType)
+}
+
+// Close flushes pending writes and closes the producer
+func (p *KafkaEventPublisher) Close() error {
+	return p.writer.Close()
+}
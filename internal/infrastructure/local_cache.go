@@ -0,0 +1,337 @@
+package infrastructure
+
+import (
+	"container/list"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/domain/infra"
+)
+
+const (
+	// cacheInvalidationChannel is the Redis pub/sub channel used to evict local entries across instances
+	cacheInvalidationChannel = "cache:invalidate"
+	// cachePatternInvalidationChannel carries glob patterns whose matching local entries are evicted
+	cachePatternInvalidationChannel = "cache:invalidate_pattern"
+)
+
+// LocalCacheConfig holds configuration for the in-process second-level cache
+type LocalCacheConfig struct {
+	Enabled  bool
+	Size     int           // Maximum number of entries kept in memory
+	TTL      time.Duration // Short TTL so entries never drift far from Redis
+	Prefixes []string      // Only keys with these prefixes are cached locally (hot reads)
+}
+
+// pubSub is the pub/sub transport used for cross-instance fan-out (implemented by RedisClient)
+type pubSub interface {
+	Publish(ctx context.Context, channel string, message string) error
+	Subscribe(ctx context.Context, channel string) <-chan string
+}
+
+// LayeredCache implements infra.CacheService with an in-process LRU in front of another cache (Redis)
+type LayeredCache struct {
+	next       infra.CacheService
+	bus        pubSub
+	local      *lruCache
+	prefixes   []string
+	instanceID string
+	logger     infra.Logger
+	cancel     context.CancelFunc
+}
+
+// NewLayeredCache creates a layered cache and starts listening for invalidations from other instances
+func NewLayeredCache(next infra.CacheService, bus pubSub, config LocalCacheConfig, logger infra.Logger) *LayeredCache {
+	if config.Size <= 0 {
+		config.Size = 1000
+	}
+	if config.TTL <= 0 {
+		config.TTL = 5 * time.Second
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	c := &LayeredCache{
+		next:       next,
+		bus:        bus,
+		local:      newLRUCache(config.Size, config.TTL),
+		prefixes:   config.Prefixes,
+		instanceID: newInstanceID(),
+		logger:     logger,
+		cancel:     cancel,
+	}
+
+	go c.listenForInvalidations(ctx, cacheInvalidationChannel, c.local.delete)
+	go c.listenForInvalidations(ctx, cachePatternInvalidationChannel, c.local.deleteMatching)
+
+	return c
+}
+
+// Set stores a value in Redis and in the local cache, then tells other instances to evict it
+func (c *LayeredCache) Set(ctx context.Context, key string, value interface{}, expiration time.Duration) error {
+	if err := c.next.Set(ctx, key, value, expiration); err != nil {
+		return err
+	}
+
+	if c.isLocal(key) {
+		if data, err := json.Marshal(value); err == nil {
+			c.local.set(key, data)
+		}
+		c.broadcastInvalidation(ctx, key)
+	}
+
+	return nil
+}
+
+// Get serves hot keys from memory and falls back to Redis. Reads with an age tolerance go to Redis,
+// which knows when a value was cached; the local copy only knows when it was last refreshed.
+func (c *LayeredCache) Get(ctx context.Context, key string, dest interface{}) error {
+	if !c.isLocal(key) || hasMaxCacheAge(ctx) {
+		return c.next.Get(ctx, key, dest)
+	}
+
+	if data, ok := c.local.get(key); ok {
+		return json.Unmarshal(data, dest)
+	}
+
+	if err := c.next.Get(ctx, key, dest); err != nil {
+		return err
+	}
+
+	if data, err := json.Marshal(dest); err == nil {
+		c.local.set(key, data)
+	}
+
+	return nil
+}
+
+// GetMany serves hot keys from memory and fetches the rest from Redis in one call; like Get, reads
+// with an age tolerance fetch every key from Redis
+func (c *LayeredCache) GetMany(ctx context.Context, keys []string, dests []interface{}) ([]bool, error) {
+	if len(keys) != len(dests) {
+		return nil, fmt.Errorf("got %d keys but %d destinations", len(keys), len(dests))
+	}
+
+	found := make([]bool, len(keys))
+	var remoteKeys []string
+	var remoteDests []interface{}
+	var remoteIndexes []int
+	bypassLocal := hasMaxCacheAge(ctx)
+	for i, key := range keys {
+		if c.isLocal(key) && !bypassLocal {
+			if data, ok := c.local.get(key); ok && json.Unmarshal(data, dests[i]) == nil {
+				found[i] = true
+				continue
+			}
+		}
+		remoteKeys = append(remoteKeys, key)
+		remoteDests = append(remoteDests, dests[i])
+		remoteIndexes = append(remoteIndexes, i)
+	}
+
+	if len(remoteKeys) == 0 {
+		return found, nil
+	}
+
+	remoteFound, err := c.next.GetMany(ctx, remoteKeys, remoteDests)
+	if err != nil {
+		return nil, err
+	}
+
+	for j, ok := range remoteFound {
+		if !ok {
+			continue
+		}
+		found[remoteIndexes[j]] = true
+		if c.isLocal(remoteKeys[j]) {
+			if data, err := json.Marshal(remoteDests[j]); err == nil {
+				c.local.set(remoteKeys[j], data)
+			}
+		}
+	}
+
+	return found, nil
+}
+
+// Delete removes a key everywhere
+func (c *LayeredCache) Delete(ctx context.Context, key string) error {
+	// Remove from Redis first so peers can't refill their local copy with the stale value
+	if err := c.next.Delete(ctx, key); err != nil {
+		return err
+	}
+
+	if c.isLocal(key) {
+		c.local.delete(key)
+		c.broadcastInvalidation(ctx, key)
+	}
+
+	return nil
+}
+
+// DeleteMatching removes the keys matching a pattern everywhere. Local entries are evicted on every
+// instance, as a pattern may cover hot keys even when it does not start with a hot prefix.
+func (c *LayeredCache) DeleteMatching(ctx context.Context, pattern string) (int64, error) {
+	deleted, err := c.next.DeleteMatching(ctx, pattern)
+	if err != nil {
+		return deleted, err
+	}
+
+	if !IsCachePattern(pattern) {
+		if c.isLocal(pattern) {
+			c.local.delete(pattern)
+			c.broadcastInvalidation(ctx, pattern)
+		}
+		return deleted, nil
+	}
+
+	c.local.deleteMatching(pattern)
+	if err := c.bus.Publish(ctx, cachePatternInvalidationChannel, c.instanceID+"|"+pattern); err != nil {
+		c.logger.Warn("Failed to publish cache invalidation", "error", err, "pattern", pattern)
+	}
+	return deleted, nil
+}
+
+// Close stops listening for invalidations
+func (c *LayeredCache) Close() {
+	c.cancel()
+}
+
+// hasMaxCacheAge checks whether the reads of ctx tolerate only recently cached values
+func hasMaxCacheAge(ctx context.Context) bool {
+	_, ok := infra.MaxCacheAgeFromContext(ctx)
+	return ok
+}
+
+// isLocal checks whether a key belongs to the hot set kept in memory
+func (c *LayeredCache) isLocal(key string) bool {
+	for _, prefix := range c.prefixes {
+		if strings.HasPrefix(key, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// broadcastInvalidation publishes "<instanceID>|<key>" so peers can evict their copy
+func (c *LayeredCache) broadcastInvalidation(ctx context.Context, key string) {
+	if err := c.bus.Publish(ctx, cacheInvalidationChannel, c.instanceID+"|"+key); err != nil {
+		c.logger.Warn("Failed to publish cache invalidation", "error", err, "key", key)
+	}
+}
+
+// listenForInvalidations evicts local entries changed by other instances
+func (c *LayeredCache) listenForInvalidations(ctx context.Context, channel string, evict func(string)) {
+	for message := range c.bus.Subscribe(ctx, channel) {
+		origin, key, found := strings.Cut(message, "|")
+		if !found || origin == c.instanceID {
+			continue
+		}
+		evict(key)
+	}
+}
+
+// newInstanceID generates a random identifier for this process
+func newInstanceID() string {
+	buf := make([]byte, 8)
+	if _, err := rand.Read(buf); err != nil {
+		return fmt.Sprintf("instance_%d", time.Now().UnixNano())
+	}
+	return hex.EncodeToString(buf)
+}
+
+// lruCache is a size-bounded, TTL-aware LRU of raw JSON payloads
+type lruCache struct {
+	mu       sync.Mutex
+	capacity int
+	ttl      time.Duration
+	items    map[string]*list.Element
+	order    *list.List
+}
+
+type lruEntry struct {
+	key       string
+	data      []byte
+	expiresAt time.Time
+}
+
+func newLRUCache(capacity int, ttl time.Duration) *lruCache {
+	return &lruCache{
+		capacity: capacity,
+		ttl:      ttl,
+		items:    make(map[string]*list.Element),
+		order:    list.New(),
+	}
+}
+
+func (l *lruCache) get(key string) ([]byte, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	element, ok := l.items[key]
+	if !ok {
+		return nil, false
+	}
+
+	entry := element.Value.(*lruEntry)
+	if time.Now().After(entry.expiresAt) {
+		l.removeElement(element)
+		return nil, false
+	}
+
+	l.order.MoveToFront(element)
+	return entry.data, true
+}
+
+func (l *lruCache) set(key string, data []byte) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	expiresAt := time.Now().Add(l.ttl)
+
+	if element, ok := l.items[key]; ok {
+		entry := element.Value.(*lruEntry)
+		entry.data = data
+		entry.expiresAt = expiresAt
+		l.order.MoveToFront(element)
+		return
+	}
+
+	l.items[key] = l.order.PushFront(&lruEntry{key: key, data: data, expiresAt: expiresAt})
+
+	if l.order.Len() > l.capacity {
+		l.removeElement(l.order.Back())
+	}
+}
+
+func (l *lruCache) delete(key string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if element, ok := l.items[key]; ok {
+		l.removeElement(element)
+	}
+}
+
+// deleteMatching removes the entries whose keys match a glob pattern; malformed patterns match nothing
+func (l *lruCache) deleteMatching(pattern string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	for key, element := range l.items {
+		if matched, _ := path.Match(pattern, key); matched {
+			l.removeElement(element)
+		}
+	}
+}
+
+func (l *lruCache) removeElement(element *list.Element) {
+	l.order.Remove(element)
+	delete(l.items, element.Value.(*lruEntry).key)
+}
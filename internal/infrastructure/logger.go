@@ -74,6 +74,11 @@ func NewSimpleLogger(isProduction bool) (*Logger, error) {
 	})
 }
 
+// NewNopLogger creates a logger that discards everything, e.g. for the stub server in consumers' tests
+func NewNopLogger() *Logger {
+	return &Logger{zap.NewNop()}
+}
+
 // createFileCore creates a file-based logging core
 func createFileCore(config zap.Config, logDir string) (zapcore.Core, error) {
 	// Use default log directory if not specified
@@ -0,0 +1,211 @@
+package infrastructure
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+	"sync"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/domain/infra"
+)
+
+// MemoryCache implements infra.CacheService and infra.LockService in process memory, for a single
+// instance without Redis such as the stub server. Values are stored as JSON, so they decode exactly as they do from Redis.
+type MemoryCache struct {
+	mu        sync.Mutex
+	items     map[string]memoryCacheEntry
+	lastToken int64 // Fencing token of the last lock acquired; seeded with the start time, see NewMemoryCache
+}
+
+type memoryCacheEntry struct {
+	data      []byte
+	cachedAt  time.Time // Zero for counters, which are not cached values
+	expiresAt time.Time // Zero when the entry never expires
+}
+
+// NewMemoryCache creates an empty in-memory cache. Fencing tokens count up from the start time in
+// nanoseconds, staying above the tokens an earlier run stored in the database.
+func NewMemoryCache() *MemoryCache {
+	return &MemoryCache{
+		items:     make(map[string]memoryCacheEntry),
+		lastToken: time.Now().UnixNano(),
+	}
+}
+
+// Set stores the value as JSON; an expiration of 0 keeps it until it is deleted
+func (c *MemoryCache) Set(ctx context.Context, key string, value interface{}, expiration time.Duration) error {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	entry := memoryCacheEntry{data: data, cachedAt: now}
+	if expiration > 0 {
+		entry.expiresAt = now.Add(expiration)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.items[key] = entry
+	return nil
+}
+
+// Get decodes the cached value into dest, returning infra.ErrCacheMiss when it is not cached or was
+// cached longer ago than ctx tolerates
+func (c *MemoryCache) Get(ctx context.Context, key string, dest interface{}) error {
+	data, ok := c.getFresh(ctx, key)
+	if !ok {
+		return infra.ErrCacheMiss
+	}
+	return json.Unmarshal(data, dest)
+}
+
+// GetMany decodes the cached values into dests, reporting which keys were cached recently enough
+func (c *MemoryCache) GetMany(ctx context.Context, keys []string, dests []interface{}) ([]bool, error) {
+	found := make([]bool, len(keys))
+	for i, key := range keys {
+		data, ok := c.getFresh(ctx, key)
+		if !ok {
+			continue
+		}
+		if err := json.Unmarshal(data, dests[i]); err != nil {
+			return nil, err
+		}
+		found[i] = true
+	}
+	return found, nil
+}
+
+// Delete removes a key
+func (c *MemoryCache) Delete(ctx context.Context, key string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.items, key)
+	return nil
+}
+
+// DeleteMatching removes the keys matching a glob pattern; malformed patterns match nothing
+func (c *MemoryCache) DeleteMatching(ctx context.Context, pattern string) (int64, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var removed int64
+	for key := range c.items {
+		if matched, _ := path.Match(pattern, key); matched {
+			delete(c.items, key)
+			removed++
+		}
+	}
+	return removed, nil
+}
+
+// IncrementBy adds delta to a counter stored as a JSON number, setting ttl on a counter it creates
+func (c *MemoryCache) IncrementBy(ctx context.Context, key string, delta int64, ttl time.Duration) (int64, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var value int64
+	entry, ok := c.items[key]
+	if ok && !entry.expiresAt.IsZero() && time.Now().After(entry.expiresAt) {
+		ok = false
+	}
+	if ok {
+		if err := json.Unmarshal(entry.data, &value); err != nil {
+			return 0, err
+		}
+	} else {
+		entry = memoryCacheEntry{}
+		if ttl > 0 {
+			entry.expiresAt = time.Now().Add(ttl)
+		}
+	}
+
+	value += delta
+	entry.data, _ = json.Marshal(value)
+	c.items[key] = entry
+	return value, nil
+}
+
+// GetCounters reads counters stored by IncrementBy, 0 for missing keys
+func (c *MemoryCache) GetCounters(ctx context.Context, keys []string) ([]int64, error) {
+	counters := make([]int64, len(keys))
+	for i, key := range keys {
+		data, ok := c.get(key)
+		if !ok {
+			continue
+		}
+		if err := json.Unmarshal(data, &counters[i]); err != nil {
+			return nil, err
+		}
+	}
+	return counters, nil
+}
+
+// Acquire takes a lock stored as its fencing token, unless an unexpired lock holds the key
+func (c *MemoryCache) Acquire(ctx context.Context, key string, ttl time.Duration) (int64, bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+	if entry, ok := c.items[key]; ok && (entry.expiresAt.IsZero() || now.Before(entry.expiresAt)) {
+		return 0, false, nil
+	}
+
+	// Tokens come from a counter, so they grow with every acquisition whatever the clock does
+	c.lastToken++
+	token := c.lastToken
+
+	entry := memoryCacheEntry{expiresAt: now.Add(ttl)}
+	entry.data, _ = json.Marshal(token)
+	c.items[key] = entry
+	return token, true, nil
+}
+
+// Release deletes a lock when it is still held with token
+func (c *MemoryCache) Release(ctx context.Context, key string, token int64) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var held int64
+	entry, ok := c.items[key]
+	if !ok || (!entry.expiresAt.IsZero() && time.Now().After(entry.expiresAt)) ||
+		json.Unmarshal(entry.data, &held) != nil || held != token {
+		return fmt.Errorf("%w: %s", infra.ErrLockNotHeld, key)
+	}
+	delete(c.items, key)
+	return nil
+}
+
+// get returns the raw value of a key, dropping it once expired
+func (c *MemoryCache) get(key string) ([]byte, bool) {
+	entry, ok := c.entry(key)
+	return entry.data, ok
+}
+
+// getFresh returns the raw value of a key when it was cached as recently as ctx tolerates
+func (c *MemoryCache) getFresh(ctx context.Context, key string) ([]byte, bool) {
+	entry, ok := c.entry(key)
+	if !ok || !infra.IsCacheFresh(ctx, entry.cachedAt, time.Now()) {
+		return nil, false
+	}
+	return entry.data, true
+}
+
+// entry returns the entry of a key, dropping it once expired
+func (c *MemoryCache) entry(key string) (memoryCacheEntry, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.items[key]
+	if !ok {
+		return memoryCacheEntry{}, false
+	}
+	if !entry.expiresAt.IsZero() && time.Now().After(entry.expiresAt) {
+		delete(c.items, key)
+		return memoryCacheEntry{}, false
+	}
+	return entry, true
+}
@@ -0,0 +1,48 @@
+package infrastructure
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+// NATSConfig holds NATS JetStream configuration for the command entry point
+type NATSConfig struct {
+	Enabled        bool
+	URL            string
+	Stream         string
+	CommandSubject string // Subject transfer commands are consumed from
+	ResultSubject  string // Subject command results are published to
+	Durable        string // Durable consumer name shared by all instances
+	MaxDeliver     int
+}
+
+// ConnectJetStream connects to NATS and ensures the command stream exists
+func ConnectJetStream(ctx context.Context, config NATSConfig) (*nats.Conn, jetstream.JetStream, error) {
+	conn, err := nats.Connect(config.URL, nats.Name("mini-bank"), nats.MaxReconnects(-1))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to connect to nats: %w", err)
+	}
+
+	js, err := jetstream.New(conn)
+	if err != nil {
+		conn.Close()
+		return nil, nil, fmt.Errorf("failed to create jetstream context: %w", err)
+	}
+
+	_, err = js.CreateOrUpdateStream(ctx, jetstream.StreamConfig{
+		Name:       config.Stream,
+		Subjects:   []string{config.CommandSubject, config.ResultSubject},
+		Storage:    jetstream.FileStorage,
+		Duplicates: 24 * time.Hour, // Deduplicate republished commands by Nats-Msg-Id
+	})
+	if err != nil {
+		conn.Close()
+		return nil, nil, fmt.Errorf("failed to ensure stream %s: %w", config.Stream, err)
+	}
+
+	return conn, js, nil
+}
@@ -0,0 +1,29 @@
+package infrastructure
+
+import (
+	"context"
+
+	"github.com/hydr0g3nz/mini_bank/internal/domain/infra"
+)
+
+// LogNotificationSender implements infra.NotificationSender by writing notifications to the log.
+// It stands in for email, SMS and push providers until one is configured.
+type LogNotificationSender struct {
+	logger infra.Logger
+}
+
+// NewLogNotificationSender creates a notification sender logging through logger
+func NewLogNotificationSender(logger infra.Logger) infra.NotificationSender {
+	return &LogNotificationSender{logger: logger}
+}
+
+// Send logs the notification
+func (s *LogNotificationSender) Send(ctx context.Context, notification infra.Notification) error {
+	s.logger.Info("Notification sent",
+		"channel", string(notification.Channel),
+		"recipient", notification.Recipient,
+		"subject", notification.Subject,
+		"body", notification.Body,
+	)
+	return nil
+}
@@ -0,0 +1,42 @@
+package infrastructure
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hydr0g3nz/mini_bank/internal/domain/event"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/infra"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/repository"
+)
+
+// OutboxEventPublisher records every event in the outbox before handing it to the
+// next publisher, so the outbox relay can deliver it to external brokers later. Events
+// a use case already stored in the unit of work of their change are left as they are.
+type OutboxEventPublisher struct {
+	outbox repository.OutboxRepository
+	next   infra.EventPublisher
+}
+
+// NewOutboxEventPublisher creates a publisher that writes through the outbox
+func NewOutboxEventPublisher(outbox repository.OutboxRepository, next infra.EventPublisher) *OutboxEventPublisher {
+	return &OutboxEventPublisher{
+		outbox: outbox,
+		next:   next,
+	}
+}
+
+// Publish stores the event in the outbox and publishes it to the next publisher
+func (p *OutboxEventPublisher) Publish(ctx context.Context, evt event.Event) error {
+	outboxErr := p.outbox.Append(ctx, evt)
+
+	// Internal subscribers still get the event even if the outbox write failed
+	if err := p.next.Publish(ctx, evt); err != nil {
+		return err
+	}
+
+	if outboxErr != nil {
+		return fmt.Errorf("failed to store event in outbox: %w", outboxErr)
+	}
+
+	return nil
+}
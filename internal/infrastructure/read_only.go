@@ -0,0 +1,161 @@
+package infrastructure
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/infra"
+	"github.com/jackc/pgx/v5/pgconn"
+	"gorm.io/gorm"
+)
+
+// readOnlySQLState is PostgreSQL's read_only_sql_transaction error code
+const readOnlySQLState = "25006"
+
+// ReadOnlyMode tracks whether the database rejects writes. Repositories switch it on through GORM
+// callbacks the first time a statement fails as read-only, and Run switches it off once the
+// database accepts writes again. It is an expvar.Var, so it can be published as-is.
+type ReadOnlyMode struct {
+	mu            sync.RWMutex
+	since         *time.Time
+	transitions   int64
+	probeInterval time.Duration
+	logger        infra.Logger
+}
+
+// NewReadOnlyMode creates a read-write mode that probes the database every probeInterval while read-only
+func NewReadOnlyMode(probeInterval time.Duration, logger infra.Logger) *ReadOnlyMode {
+	return &ReadOnlyMode{
+		probeInterval: probeInterval,
+		logger:        logger,
+	}
+}
+
+// ReadOnlySince returns when the database was found read-only, and false while it accepts writes
+func (m *ReadOnlyMode) ReadOnlySince() (time.Time, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	if m.since == nil {
+		return time.Time{}, false
+	}
+	return *m.since, true
+}
+
+// RetryAfter is how long clients should wait before retrying a rejected write: the next probe
+func (m *ReadOnlyMode) RetryAfter() time.Duration {
+	return m.probeInterval
+}
+
+// Enter switches to read-only mode
+func (m *ReadOnlyMode) Enter(cause error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.since != nil {
+		return
+	}
+
+	now := time.Now()
+	m.since = &now
+	m.transitions++
+	m.logger.Error("Database is read-only, rejecting writes", "error", cause)
+}
+
+// Exit switches back to read-write mode
+func (m *ReadOnlyMode) Exit() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.since == nil {
+		return
+	}
+
+	m.logger.Info("Database accepts writes again", "readOnlyFor", time.Since(*m.since).String())
+	m.since = nil
+	m.transitions++
+}
+
+// String renders the mode as JSON for expvar
+func (m *ReadOnlyMode) String() string {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	data, _ := json.Marshal(struct {
+		ReadOnly    bool       `json:"read_only"`
+		Since       *time.Time `json:"since,omitempty"`
+		Transitions int64      `json:"transitions"`
+	}{m.since != nil, m.since, m.transitions})
+	return string(data)
+}
+
+// Register installs GORM callbacks that switch to read-only mode when a statement fails because
+// the database is read-only, and wrap the statement's error in errs.ErrDatabaseReadOnly
+func (m *ReadOnlyMode) Register(db *gorm.DB) error {
+	detect := func(tx *gorm.DB) {
+		if tx.Error != nil && IsReadOnlyError(tx.Error) {
+			m.Enter(tx.Error)
+			tx.Error = fmt.Errorf("%w: %v", errs.ErrDatabaseReadOnly, tx.Error)
+		}
+	}
+
+	callbacks := db.Callback()
+	registrations := []error{
+		callbacks.Create().After("gorm:create").Register("mini_bank:read_only_create", detect),
+		callbacks.Update().After("gorm:update").Register("mini_bank:read_only_update", detect),
+		callbacks.Delete().After("gorm:delete").Register("mini_bank:read_only_delete", detect),
+		callbacks.Query().After("gorm:query").Register("mini_bank:read_only_query", detect), // SELECT ... FOR UPDATE
+		callbacks.Raw().After("gorm:raw").Register("mini_bank:read_only_raw", detect),
+	}
+	return errors.Join(registrations...)
+}
+
+// Run probes the database while in read-only mode and leaves it once the database accepts writes,
+// until the context is cancelled
+func (m *ReadOnlyMode) Run(ctx context.Context, db *gorm.DB) {
+	ticker := time.NewTicker(m.probeInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		if _, readOnly := m.ReadOnlySince(); !readOnly {
+			continue
+		}
+
+		var transactionReadOnly string
+		err := db.WithContext(ctx).Raw("SHOW transaction_read_only").Scan(&transactionReadOnly).Error
+		if err != nil {
+			if ctx.Err() == nil {
+				m.logger.Warn("Read-only probe failed", "error", err)
+			}
+			continue
+		}
+
+		if transactionReadOnly == "off" {
+			m.Exit()
+		}
+	}
+}
+
+// IsReadOnlyError checks if a database error means the database only accepts reads
+func IsReadOnlyError(err error) bool {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		return pgErr.Code == readOnlySQLState
+	}
+
+	// Drivers that do not expose an SQLSTATE, e.g. SQLite's "attempt to write a readonly database"
+	message := strings.ToLower(err.Error())
+	return strings.Contains(message, "read-only transaction") || strings.Contains(message, "readonly database")
+}
@@ -1,22 +1,31 @@
 package infrastructure
 
 import (
+	"bytes"
 	"context"
+	"encoding/binary"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"strconv"
+	"strings"
 	"time"
 
+	"github.com/hydr0g3nz/mini_bank/internal/domain/infra"
 	"github.com/redis/go-redis/v9"
 )
 
 type RedisClient struct {
 	client *redis.Client
+	codec  *payloadCodec
 }
 type CacheConfig struct {
 	Host     string
 	Port     int
 	Password string
 	Db       int
+
+	CompressionThreshold int // Values whose JSON is at least this many bytes are stored gzipped; 0 disables it
 }
 
 // NewRedisClient creates a new Redis client instance
@@ -34,30 +43,102 @@ func NewRedisClient(cfg CacheConfig) *RedisClient {
 		panic(fmt.Errorf("failed to connect to Redis: %w", err))
 	}
 
-	return &RedisClient{client: client}
+	return &RedisClient{client: client, codec: newPayloadCodec(cfg.CompressionThreshold)}
+}
+
+// CompressionMetrics returns the metrics of values compressed by Set and HashSet
+func (r *RedisClient) CompressionMetrics() *CacheCompressionMetrics {
+	return r.codec.metrics
 }
 
-// Set stores a value with expiration
+// Set stores a value with expiration, compressed when its JSON reaches the compression threshold and
+// stamped with the time it was cached
 func (r *RedisClient) Set(ctx context.Context, key string, value interface{}, expiration time.Duration) error {
-	data, err := json.Marshal(value)
+	data, err := r.codec.encode(value)
 	if err != nil {
-		return fmt.Errorf("failed to marshal value: %w", err)
+		return err
 	}
 
-	return r.client.Set(ctx, key, data, expiration).Err()
+	return r.client.Set(ctx, key, stampCachedAt(data, time.Now()), expiration).Err()
 }
 
-// Get retrieves a value by key
+// Get retrieves a value by key, returning infra.ErrCacheMiss when the key does not exist or was
+// cached longer ago than ctx tolerates
 func (r *RedisClient) Get(ctx context.Context, key string, dest interface{}) error {
 	data, err := r.client.Get(ctx, key).Bytes()
 	if err != nil {
-		if err == redis.Nil {
-			return fmt.Errorf("key does not exist: %s", key)
+		if errors.Is(err, redis.Nil) {
+			return fmt.Errorf("%w: %s", infra.ErrCacheMiss, key)
 		}
 		return fmt.Errorf("failed to get value: %w", err)
 	}
 
-	return json.Unmarshal(data, dest)
+	data, cachedAt := splitCachedAt(data)
+	if !infra.IsCacheFresh(ctx, cachedAt, time.Now()) {
+		return fmt.Errorf("%w: %s is older than tolerated", infra.ErrCacheMiss, key)
+	}
+	if err := r.codec.decode(data, dest); err != nil {
+		return fmt.Errorf("failed to unmarshal value of %s: %w", key, err)
+	}
+	return nil
+}
+
+// GetMany retrieves several keys with one MGET, decoding each value into the dest at the same index;
+// values older than ctx tolerates are reported as not cached
+func (r *RedisClient) GetMany(ctx context.Context, keys []string, dests []interface{}) ([]bool, error) {
+	if len(keys) != len(dests) {
+		return nil, fmt.Errorf("got %d keys but %d destinations", len(keys), len(dests))
+	}
+
+	found := make([]bool, len(keys))
+	if len(keys) == 0 {
+		return found, nil
+	}
+
+	values, err := r.client.MGet(ctx, keys...).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get values: %w", err)
+	}
+
+	now := time.Now()
+	for i, value := range values {
+		raw, ok := value.(string)
+		if !ok {
+			continue // Missing keys come back as nil
+		}
+		data, cachedAt := splitCachedAt([]byte(raw))
+		if !infra.IsCacheFresh(ctx, cachedAt, now) {
+			continue
+		}
+		if err := r.codec.decode(data, dests[i]); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal value of %s: %w", keys[i], err)
+		}
+		found[i] = true
+	}
+
+	return found, nil
+}
+
+// cachedAtMagic starts a value stamped by Set with the time it was cached. Neither JSON nor gzip
+// starts with a zero byte, so values cached before stamping still decode, as values of unknown age.
+var cachedAtMagic = []byte{0x00, 'T'}
+
+// stampCachedAt prefixes data with the magic and cachedAt in Unix milliseconds
+func stampCachedAt(data []byte, cachedAt time.Time) []byte {
+	stamped := make([]byte, len(cachedAtMagic)+8, len(cachedAtMagic)+8+len(data))
+	copy(stamped, cachedAtMagic)
+	binary.BigEndian.PutUint64(stamped[len(cachedAtMagic):], uint64(cachedAt.UnixMilli()))
+	return append(stamped, data...)
+}
+
+// splitCachedAt returns the payload of a stored value and when it was cached, zero when it is not
+// stamped
+func splitCachedAt(data []byte) ([]byte, time.Time) {
+	if len(data) < len(cachedAtMagic)+8 || !bytes.HasPrefix(data, cachedAtMagic) {
+		return data, time.Time{}
+	}
+	millis := binary.BigEndian.Uint64(data[len(cachedAtMagic):])
+	return data[len(cachedAtMagic)+8:], time.UnixMilli(int64(millis))
 }
 
 // Delete removes a key
@@ -65,27 +146,63 @@ func (r *RedisClient) Delete(ctx context.Context, key string) error {
 	return r.client.Del(ctx, key).Err()
 }
 
-// HashSet stores a hash field
+// deleteScanCount is how many keys DeleteMatching asks Redis to scan per round trip
+const deleteScanCount = 500
+
+// DeleteMatching removes the keys matching a glob pattern, scanning the keyspace in batches rather
+// than with KEYS, so Redis keeps serving other clients meanwhile
+func (r *RedisClient) DeleteMatching(ctx context.Context, pattern string) (int64, error) {
+	if !IsCachePattern(pattern) {
+		return r.client.Del(ctx, pattern).Result()
+	}
+
+	var deleted int64
+	var cursor uint64
+	for {
+		keys, next, err := r.client.Scan(ctx, cursor, pattern, deleteScanCount).Result()
+		if err != nil {
+			return deleted, fmt.Errorf("failed to scan keys: %w", err)
+		}
+		if len(keys) > 0 {
+			removed, err := r.client.Del(ctx, keys...).Result()
+			if err != nil {
+				return deleted, fmt.Errorf("failed to delete keys: %w", err)
+			}
+			deleted += removed
+		}
+		if next == 0 {
+			return deleted, nil
+		}
+		cursor = next
+	}
+}
+
+// IsCachePattern checks whether a cache key pattern has glob wildcards
+func IsCachePattern(pattern string) bool {
+	return strings.ContainsAny(pattern, "*?[\\")
+}
+
+// HashSet stores a hash field, compressed like Set
 func (r *RedisClient) HashSet(ctx context.Context, key, field string, value interface{}) error {
-	data, err := json.Marshal(value)
+	data, err := r.codec.encode(value)
 	if err != nil {
-		return fmt.Errorf("failed to marshal value: %w", err)
+		return err
 	}
 
 	return r.client.HSet(ctx, key, field, data).Err()
 }
 
-// HashGet retrieves a hash field
+// HashGet retrieves a hash field, returning infra.ErrCacheMiss when the field does not exist
 func (r *RedisClient) HashGet(ctx context.Context, key, field string, dest interface{}) error {
 	data, err := r.client.HGet(ctx, key, field).Bytes()
 	if err != nil {
-		if err == redis.Nil {
-			return nil
+		if errors.Is(err, redis.Nil) {
+			return fmt.Errorf("%w: %s %s", infra.ErrCacheMiss, key, field)
 		}
 		return fmt.Errorf("failed to get hash field: %w", err)
 	}
 
-	return json.Unmarshal(data, dest)
+	return r.codec.decode(data, dest)
 }
 
 // SetNX sets a value if the key doesn't exist (useful for distributed locks)
@@ -98,12 +215,154 @@ func (r *RedisClient) SetNX(ctx context.Context, key string, value interface{},
 	return r.client.SetNX(ctx, key, data, expiration).Result()
 }
 
+// releaseLockScript deletes a lock only while it still holds the caller's token, so a worker whose
+// lock expired cannot release the lock of the worker that took over
+var releaseLockScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0
+`)
+
+// lockTokenTTL is how long a lock's token counter outlives the last acquisition. A counter that
+// expired is seeded again from the server's time, so the tokens still grow.
+const lockTokenTTL = 24 * time.Hour
+
+// lockKeys returns the keys of a lock and of the counter its fencing tokens are drawn from. Both carry
+// the lock's name as hash tag, so Redis Cluster keeps them in the one slot the acquire script needs.
+func lockKeys(key string) []string {
+	tagged := "{" + key + "}"
+	return []string{tagged, tagged + ":token"}
+}
+
+// acquireLockScript takes a lock with SET PX unless it is held, storing the next fencing token as its
+// value. Tokens come from INCR on the lock's counter, so they grow with every acquisition whatever the
+// instances' clocks say. A missing counter is first seeded with the Redis server's time in
+// nanoseconds, keeping new tokens above the clock-based ones stored before, and after the counter
+// is lost or expired. The token is read back with GET because Lua holds integers as doubles, which
+// cannot represent tokens that large exactly.
+var acquireLockScript = redis.NewScript(`
+if redis.call("EXISTS", KEYS[1]) == 1 then
+	return false
+end
+if redis.call("EXISTS", KEYS[2]) == 0 then
+	redis.replicate_commands()
+	local now = redis.call("TIME")
+	redis.call("SET", KEYS[2], now[1] .. string.format("%06d", tonumber(now[2])) .. "000")
+end
+redis.call("INCR", KEYS[2])
+redis.call("PEXPIRE", KEYS[2], ARGV[2])
+local token = redis.call("GET", KEYS[2])
+redis.call("SET", KEYS[1], token, "PX", ARGV[1])
+return token
+`)
+
+// Acquire takes a lock with a script that draws its fencing token from the lock's counter and stores
+// it as the lock's value
+func (r *RedisClient) Acquire(ctx context.Context, key string, ttl time.Duration) (int64, bool, error) {
+	raw, err := acquireLockScript.Run(ctx, r.client, lockKeys(key), ttl.Milliseconds(), lockTokenTTL.Milliseconds()).Text()
+	if errors.Is(err, redis.Nil) {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, fmt.Errorf("failed to acquire lock: %w", err)
+	}
+
+	token, err := strconv.ParseInt(raw, 10, 64)
+	if err != nil {
+		return 0, false, fmt.Errorf("lock token %q is not an integer: %w", raw, err)
+	}
+	return token, true, nil
+}
+
+// Release deletes a lock with a compare-and-delete script when it is still held with token
+func (r *RedisClient) Release(ctx context.Context, key string, token int64) error {
+	deleted, err := releaseLockScript.Run(ctx, r.client, lockKeys(key)[:1], strconv.FormatInt(token, 10)).Int64()
+	if err != nil {
+		return fmt.Errorf("failed to release lock: %w", err)
+	}
+	if deleted == 0 {
+		return fmt.Errorf("%w: %s", infra.ErrLockNotHeld, key)
+	}
+	return nil
+}
+
 // Incr increments a key's value
 func (r *RedisClient) Incr(ctx context.Context, key string) (int64, error) {
 	return r.client.Incr(ctx, key).Result()
 }
 
+// IncrementBy adds delta to a counter, setting ttl on a counter the increment creates
+func (r *RedisClient) IncrementBy(ctx context.Context, key string, delta int64, ttl time.Duration) (int64, error) {
+	pipe := r.client.TxPipeline()
+	value := pipe.IncrBy(ctx, key, delta)
+	pipe.ExpireNX(ctx, key, ttl)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return 0, fmt.Errorf("failed to increment counter: %w", err)
+	}
+	return value.Val(), nil
+}
+
+// GetCounters reads counters in one round trip, 0 for missing keys
+func (r *RedisClient) GetCounters(ctx context.Context, keys []string) ([]int64, error) {
+	counters := make([]int64, len(keys))
+	if len(keys) == 0 {
+		return counters, nil
+	}
+
+	values, err := r.client.MGet(ctx, keys...).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get counters: %w", err)
+	}
+
+	for i, value := range values {
+		raw, ok := value.(string)
+		if !ok {
+			continue
+		}
+		if counters[i], err = strconv.ParseInt(raw, 10, 64); err != nil {
+			return nil, fmt.Errorf("counter %s is not an integer: %w", keys[i], err)
+		}
+	}
+	return counters, nil
+}
+
 // Close closes the Redis connection
 func (r *RedisClient) Close() error {
 	return r.client.Close()
 }
+
+// Publish sends a message to a pub/sub channel
+func (r *RedisClient) Publish(ctx context.Context, channel string, message string) error {
+	return r.client.Publish(ctx, channel, message).Err()
+}
+
+// Subscribe listens on a pub/sub channel and delivers message payloads until ctx is done
+func (r *RedisClient) Subscribe(ctx context.Context, channel string) <-chan string {
+	pubsub := r.client.Subscribe(ctx, channel)
+	messages := make(chan string)
+
+	go func() {
+		defer close(messages)
+		defer pubsub.Close()
+
+		ch := pubsub.Channel()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case msg, ok := <-ch:
+				if !ok {
+					return
+				}
+				select {
+				case messages <- msg.Payload:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return messages
+}
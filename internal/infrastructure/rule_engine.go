@@ -0,0 +1,192 @@
+package infrastructure
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/cel-go/cel"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/infra"
+)
+
+// maxCachedRulePrograms bounds how many compiled expressions are kept; the cache starts over when full
+const maxCachedRulePrograms = 1000
+
+// RuleEngineConfig holds business rule sandbox configuration
+type RuleEngineConfig struct {
+	Timeout   time.Duration // Wall-clock budget of one evaluation
+	CostLimit uint64        // Budget of one evaluation in CEL cost units, bounding loops over large inputs
+}
+
+// CELRuleEngine evaluates business rules written in the Common Expression Language. CEL has no
+// I/O, no unbounded loops and no access to the host, so expressions only see the declared facts.
+type CELRuleEngine struct {
+	env    *cel.Env
+	config RuleEngineConfig
+
+	mu       sync.Mutex
+	programs map[string]compiledRule
+}
+
+// compiledRule is a cached program with the type its expression evaluates to
+type compiledRule struct {
+	program cel.Program
+	output  *cel.Type
+}
+
+// NewCELRuleEngine creates a rule engine whose expressions may refer to the given facts.
+// Numbers compare across int and double, so "amount > 100" works as well as "amount > 100.0".
+func NewCELRuleEngine(config RuleEngineConfig, facts map[string]infra.RuleFactType) (*CELRuleEngine, error) {
+	options := []cel.EnvOption{cel.CrossTypeNumericComparisons(true)}
+	for name, factType := range facts {
+		switch factType {
+		case infra.RuleFactString:
+			options = append(options, cel.Variable(name, cel.StringType))
+		case infra.RuleFactNumber:
+			options = append(options, cel.Variable(name, cel.DoubleType))
+		default:
+			return nil, fmt.Errorf("unsupported type %q of fact %s", factType, name)
+		}
+	}
+
+	env, err := cel.NewEnv(options...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create CEL environment: %w", err)
+	}
+	// Declarations are checked lazily; compile once so e.g. a fact shadowing a builtin fails at startup
+	if _, issues := env.Compile("true"); issues.Err() != nil {
+		return nil, fmt.Errorf("failed to create CEL environment: %w", issues.Err())
+	}
+
+	return &CELRuleEngine{
+		env:      env,
+		config:   config,
+		programs: make(map[string]compiledRule),
+	}, nil
+}
+
+// CheckBool verifies an expression compiles and evaluates to a boolean
+func (e *CELRuleEngine) CheckBool(expression string) error {
+	_, err := e.program(expression, cel.BoolType)
+	return err
+}
+
+// CheckNumber verifies an expression compiles and evaluates to a number
+func (e *CELRuleEngine) CheckNumber(expression string) error {
+	_, err := e.program(expression, cel.DoubleType, cel.IntType, cel.UintType)
+	return err
+}
+
+// EvaluateBool evaluates a boolean expression
+func (e *CELRuleEngine) EvaluateBool(ctx context.Context, expression string, facts map[string]interface{}) (bool, error) {
+	value, err := e.evaluate(ctx, expression, facts, cel.BoolType)
+	if err != nil {
+		return false, err
+	}
+
+	result, ok := value.(bool)
+	if !ok {
+		return false, fmt.Errorf("expression evaluated to %T, expected a boolean", value)
+	}
+	return result, nil
+}
+
+// EvaluateNumber evaluates a numeric expression
+func (e *CELRuleEngine) EvaluateNumber(ctx context.Context, expression string, facts map[string]interface{}) (float64, error) {
+	value, err := e.evaluate(ctx, expression, facts, cel.DoubleType, cel.IntType, cel.UintType)
+	if err != nil {
+		return 0, err
+	}
+
+	switch result := value.(type) {
+	case float64:
+		return result, nil
+	case int64:
+		return float64(result), nil
+	case uint64:
+		return float64(result), nil
+	default:
+		return 0, fmt.Errorf("expression evaluated to %T, expected a number", value)
+	}
+}
+
+// evaluate runs an expression within the engine's time and cost budget
+func (e *CELRuleEngine) evaluate(ctx context.Context, expression string, facts map[string]interface{}, results ...*cel.Type) (interface{}, error) {
+	program, err := e.program(expression, results...)
+	if err != nil {
+		return nil, err
+	}
+
+	if e.config.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, e.config.Timeout)
+		defer cancel()
+	}
+
+	value, _, err := program.ContextEval(ctx, facts)
+	if err != nil {
+		return nil, fmt.Errorf("evaluation failed: %w", err)
+	}
+	return value.Value(), nil
+}
+
+// program returns the compiled expression, checking it yields one of the result types
+func (e *CELRuleEngine) program(expression string, results ...*cel.Type) (cel.Program, error) {
+	e.mu.Lock()
+	compiled, ok := e.programs[expression]
+	e.mu.Unlock()
+	if !ok {
+		var err error
+		if compiled, err = e.compile(expression); err != nil {
+			return nil, err
+		}
+	}
+
+	if !isAnyType(compiled.output, results) {
+		return nil, fmt.Errorf("expression evaluates to %s, expected %s", compiled.output, results[0])
+	}
+	return compiled.program, nil
+}
+
+// compile compiles an expression into a program within the engine's budget and caches it
+func (e *CELRuleEngine) compile(expression string) (compiledRule, error) {
+	ast, issues := e.env.Compile(expression)
+	if issues != nil && issues.Err() != nil {
+		return compiledRule{}, fmt.Errorf("invalid expression: %w", issues.Err())
+	}
+
+	options := []cel.ProgramOption{cel.InterruptCheckFrequency(100)}
+	if e.config.CostLimit > 0 {
+		options = append(options, cel.CostLimit(e.config.CostLimit))
+	}
+
+	program, err := e.env.Program(ast, options...)
+	if err != nil {
+		return compiledRule{}, fmt.Errorf("invalid expression: %w", err)
+	}
+	compiled := compiledRule{program: program, output: ast.OutputType()}
+
+	e.mu.Lock()
+	if len(e.programs) >= maxCachedRulePrograms {
+		e.programs = make(map[string]compiledRule)
+	}
+	e.programs[expression] = compiled
+	e.mu.Unlock()
+
+	return compiled, nil
+}
+
+// isAnyType checks if an expression's output type is one of the expected types; dynamic
+// outputs are accepted and checked when evaluated
+func isAnyType(output *cel.Type, expected []*cel.Type) bool {
+	if output.IsExactType(cel.DynType) {
+		return true
+	}
+	for _, t := range expected {
+		if output.IsExactType(t) {
+			return true
+		}
+	}
+	return false
+}
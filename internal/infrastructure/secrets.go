@@ -0,0 +1,283 @@
+package infrastructure
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/domain/infra"
+)
+
+// Secrets providers
+const (
+	SecretsProviderEnv   = "env"
+	SecretsProviderFile  = "file"
+	SecretsProviderVault = "vault"
+	SecretsProviderAWS   = "aws-secrets-manager"
+)
+
+// SecretsConfig holds secret store configuration
+type SecretsConfig struct {
+	Provider        string        // env, file, vault or aws-secrets-manager
+	RefreshInterval time.Duration // How often loaded secrets are fetched again to pick up rotations; 0 disables it
+	Timeout         time.Duration // Per-attempt timeout of calls to the secret store
+
+	FileDir string // Directory file references are relative to, e.g. a mounted Kubernetes secret
+
+	VaultAddr  string
+	VaultToken string
+	VaultMount string // KV version 2 secrets engine mount
+
+	AWSRegion string
+	AWS       AWSCredentials
+}
+
+// NewSecretsProvider creates the configured secrets provider; remote stores are called through client
+func NewSecretsProvider(config SecretsConfig, client *HTTPClient) (infra.SecretsProvider, error) {
+	switch config.Provider {
+	case SecretsProviderEnv, "":
+		return EnvSecretsProvider{}, nil
+	case SecretsProviderFile:
+		return FileSecretsProvider{Dir: config.FileDir}, nil
+	case SecretsProviderVault:
+		return &VaultSecretsProvider{client: client, addr: strings.TrimRight(config.VaultAddr, "/"), token: config.VaultToken, mount: config.VaultMount}, nil
+	case SecretsProviderAWS:
+		return &AWSSecretsManagerProvider{client: client, region: config.AWSRegion, credentials: config.AWS}, nil
+	}
+	return nil, fmt.Errorf("unknown secrets provider %q", config.Provider)
+}
+
+// splitSecretRef splits "path#key" into the secret's path and the key of the value within it
+func splitSecretRef(ref string) (string, string) {
+	path, key, _ := strings.Cut(ref, "#")
+	return path, key
+}
+
+// EnvSecretsProvider reads a secret from the environment variable its reference names
+type EnvSecretsProvider struct{}
+
+// GetSecret returns the value of the environment variable ref
+func (EnvSecretsProvider) GetSecret(ctx context.Context, ref string) (string, error) {
+	value, ok := os.LookupEnv(ref)
+	if !ok {
+		return "", fmt.Errorf("%s: %w", ref, infra.ErrSecretNotFound)
+	}
+	return value, nil
+}
+
+// FileSecretsProvider reads a secret from a file, as mounted by Docker and Kubernetes secrets or by a
+// CSI driver syncing a cloud secret manager. Mounted files are updated in place on rotation.
+type FileSecretsProvider struct {
+	Dir string
+}
+
+// GetSecret returns the contents of the file ref, relative to the directory, without the trailing newline
+func (p FileSecretsProvider) GetSecret(ctx context.Context, ref string) (string, error) {
+	path := ref
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(p.Dir, path)
+	}
+
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return "", fmt.Errorf("%s: %w", ref, infra.ErrSecretNotFound)
+	}
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimRight(string(data), "\r\n"), nil
+}
+
+// VaultSecretsProvider reads secrets from a HashiCorp Vault KV version 2 secrets engine
+type VaultSecretsProvider struct {
+	client *HTTPClient
+	addr   string
+	token  string
+	mount  string
+}
+
+// GetSecret returns the key of the latest version of a secret; ref is "path#key"
+func (p *VaultSecretsProvider) GetSecret(ctx context.Context, ref string) (string, error) {
+	path, key := splitSecretRef(ref)
+	if path == "" || key == "" {
+		return "", fmt.Errorf("vault secret reference %q must be path#key", ref)
+	}
+
+	resp, err := p.client.Do(ctx, OutboundRequest{
+		Method: http.MethodGet,
+		URL:    p.addr + "/v1/" + url.PathEscape(p.mount) + "/data/" + strings.TrimLeft(path, "/"),
+		Header: http.Header{"X-Vault-Token": []string{p.token}},
+	})
+	if err != nil {
+		return "", err
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		return "", fmt.Errorf("%s: %w", ref, infra.ErrSecretNotFound)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("vault responded with status %d reading %s", resp.StatusCode, path)
+	}
+
+	var body struct {
+		Data struct {
+			Data map[string]interface{} `json:"data"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(resp.Body, &body); err != nil {
+		return "", fmt.Errorf("invalid vault response reading %s: %w", path, err)
+	}
+
+	value, ok := body.Data.Data[key].(string)
+	if !ok {
+		return "", fmt.Errorf("%s: %w", ref, infra.ErrSecretNotFound)
+	}
+	return value, nil
+}
+
+// AWSSecretsManagerProvider reads secrets from AWS Secrets Manager
+type AWSSecretsManagerProvider struct {
+	client      *HTTPClient
+	region      string
+	credentials AWSCredentials
+}
+
+// GetSecret returns the current version of a secret; ref is the secret ID, optionally followed by
+// "#key" to pick a key out of a JSON secret
+func (p *AWSSecretsManagerProvider) GetSecret(ctx context.Context, ref string) (string, error) {
+	secretID, key := splitSecretRef(ref)
+	body, err := json.Marshal(map[string]string{"SecretId": secretID})
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := p.client.Do(ctx, OutboundRequest{
+		Method: http.MethodPost,
+		URL:    "https://secretsmanager." + p.region + ".amazonaws.com/",
+		Header: http.Header{
+			"Content-Type": []string{"application/x-amz-json-1.1"},
+			"X-Amz-Target": []string{"secretsmanager.GetSecretValue"},
+		},
+		Body:   body,
+		Signer: SigV4Signer(p.credentials, p.region, "secretsmanager"),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	var result struct {
+		SecretString string `json:"SecretString"`
+		Type         string `json:"__type"`
+		Message      string `json:"message"`
+	}
+	if err := json.Unmarshal(resp.Body, &result); err != nil {
+		return "", fmt.Errorf("invalid secrets manager response reading %s: %w", secretID, err)
+	}
+	if strings.HasSuffix(result.Type, "ResourceNotFoundException") {
+		return "", fmt.Errorf("%s: %w", ref, infra.ErrSecretNotFound)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("secrets manager responded with status %d reading %s: %s", resp.StatusCode, secretID, result.Message)
+	}
+
+	if key == "" {
+		return result.SecretString, nil
+	}
+	var values map[string]interface{}
+	if err := json.Unmarshal([]byte(result.SecretString), &values); err != nil {
+		return "", fmt.Errorf("secret %s is not a JSON object: %w", secretID, err)
+	}
+	value, ok := values[key].(string)
+	if !ok {
+		return "", fmt.Errorf("%s: %w", ref, infra.ErrSecretNotFound)
+	}
+	return value, nil
+}
+
+// RotatingSecret is a secret loaded from a secret store and kept current by a SecretStore
+type RotatingSecret struct {
+	ref   string
+	value atomic.Pointer[string]
+}
+
+// Value returns the latest value fetched
+func (s *RotatingSecret) Value() string {
+	return *s.value.Load()
+}
+
+// SecretStore loads secrets from a provider and fetches them again periodically, so rotated
+// passwords and keys take effect without a restart
+type SecretStore struct {
+	provider infra.SecretsProvider
+	interval time.Duration
+	logger   infra.Logger
+
+	mu      sync.Mutex
+	secrets []*RotatingSecret
+}
+
+// NewSecretStore creates a secret store refreshing its secrets every interval; 0 never refreshes them
+func NewSecretStore(provider infra.SecretsProvider, interval time.Duration, logger infra.Logger) *SecretStore {
+	return &SecretStore{provider: provider, interval: interval, logger: logger}
+}
+
+// Load fetches a secret and keeps it current from then on
+func (s *SecretStore) Load(ctx context.Context, ref string) (*RotatingSecret, error) {
+	value, err := s.provider.GetSecret(ctx, ref)
+	if err != nil {
+		return nil, err
+	}
+
+	secret := &RotatingSecret{ref: ref}
+	secret.value.Store(&value)
+
+	s.mu.Lock()
+	s.secrets = append(s.secrets, secret)
+	s.mu.Unlock()
+	return secret, nil
+}
+
+// Run refreshes the secrets every interval until ctx is cancelled
+func (s *SecretStore) Run(ctx context.Context) {
+	if s.interval <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.Refresh(ctx)
+		}
+	}
+}
+
+// Refresh fetches every secret again. A secret that cannot be fetched keeps its last value.
+func (s *SecretStore) Refresh(ctx context.Context) {
+	s.mu.Lock()
+	secrets := append([]*RotatingSecret(nil), s.secrets...)
+	s.mu.Unlock()
+
+	for _, secret := range secrets {
+		value, err := s.provider.GetSecret(ctx, secret.ref)
+		if err != nil {
+			s.logger.Warn("Failed to refresh secret", "error", err, "ref", secret.ref)
+			continue
+		}
+		if value != secret.Value() {
+			secret.value.Store(&value)
+			s.logger.Info("Secret rotated", "ref", secret.ref)
+		}
+	}
+}
@@ -0,0 +1,66 @@
+package infrastructure
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+
+	errs "github.com/hydr0g3nz/mini_bank/internal/domain/error"
+	"github.com/jackc/pgx/v5/pgconn"
+	"gorm.io/gorm"
+)
+
+// transientSQLStates are the PostgreSQL error codes of failures that may succeed when retried
+var transientSQLStates = map[string]bool{
+	"40001": true, // serialization_failure
+	"40P01": true, // deadlock_detected
+	"55P03": true, // lock_not_available
+	"53300": true, // too_many_connections
+	"57P01": true, // admin_shutdown
+	"57P02": true, // crash_shutdown
+	"57P03": true, // cannot_connect_now
+}
+
+// RegisterTransientErrors installs GORM callbacks that wrap the errors of statements that may
+// succeed when retried in errs.ErrTransient, keeping the driver error in the chain
+func RegisterTransientErrors(db *gorm.DB) error {
+	wrap := func(tx *gorm.DB) {
+		if tx.Error != nil && !errors.Is(tx.Error, errs.ErrTransient) && IsTransientError(tx.Error) {
+			tx.Error = fmt.Errorf("%w: %w", errs.ErrTransient, tx.Error)
+		}
+	}
+
+	callbacks := db.Callback()
+	registrations := []error{
+		callbacks.Create().After("gorm:create").Register("mini_bank:transient_create", wrap),
+		callbacks.Update().After("gorm:update").Register("mini_bank:transient_update", wrap),
+		callbacks.Delete().After("gorm:delete").Register("mini_bank:transient_delete", wrap),
+		callbacks.Query().After("gorm:query").Register("mini_bank:transient_query", wrap),
+		callbacks.Raw().After("gorm:raw").Register("mini_bank:transient_raw", wrap),
+	}
+	return errors.Join(registrations...)
+}
+
+// IsTransientError checks if a database error is a deadlock, lock timeout, dropped connection or
+// similar failure that may pass when the statement is retried
+func IsTransientError(err error) bool {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		// Class 08: connection exceptions
+		return transientSQLStates[pgErr.Code] || strings.HasPrefix(pgErr.Code, "08")
+	}
+
+	if errors.Is(err, driver.ErrBadConn) || pgconn.SafeToRetry(err) || pgconn.Timeout(err) {
+		return true
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return true
+	}
+
+	// SQLite reports lock contention as "database is locked"
+	return strings.Contains(strings.ToLower(err.Error()), "database is locked")
+}
@@ -0,0 +1,77 @@
+package infrastructure
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/internal/domain/infra"
+)
+
+const (
+	// WebhookSignatureHeader carries "sha256=" + hex HMAC-SHA256 of "<timestamp>.<body>"
+	WebhookSignatureHeader = "X-Webhook-Signature"
+	// WebhookTimestampHeader carries the Unix time the delivery was signed at
+	WebhookTimestampHeader = "X-Webhook-Timestamp"
+)
+
+// WebhookConfig holds webhook delivery configuration
+type WebhookConfig struct {
+	Timeout     time.Duration // Per-delivery HTTP timeout
+	MaxFailures int           // Consecutive failed deliveries before a subscription is disabled
+}
+
+// HTTPWebhookSender implements infra.WebhookSender over HTTP POST
+type HTTPWebhookSender struct {
+	client *HTTPClient
+}
+
+// NewHTTPWebhookSender creates a webhook sender posting through client
+func NewHTTPWebhookSender(client *HTTPClient) infra.WebhookSender {
+	return &HTTPWebhookSender{client: client}
+}
+
+// Send posts the payload signed with the subscription secret
+func (s *HTTPWebhookSender) Send(ctx context.Context, url, secret string, payload []byte) (*infra.WebhookDelivery, error) {
+	resp, err := s.client.Do(ctx, OutboundRequest{
+		Method: http.MethodPost,
+		URL:    url,
+		Header: http.Header{"Content-Type": []string{"application/json"}},
+		Body:   payload,
+		Signer: WebhookSigner(secret),
+	})
+	if err != nil {
+		return &infra.WebhookDelivery{}, fmt.Errorf("webhook delivery failed: %w", err)
+	}
+
+	delivery := &infra.WebhookDelivery{StatusCode: resp.StatusCode}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return delivery, fmt.Errorf("webhook endpoint responded with status %d", resp.StatusCode)
+	}
+
+	return delivery, nil
+}
+
+// WebhookSigner signs deliveries with a subscription secret, timestamped at each attempt
+func WebhookSigner(secret string) RequestSigner {
+	return RequestSignerFunc(func(req *http.Request, body []byte) error {
+		timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+		req.Header.Set(WebhookTimestampHeader, timestamp)
+		req.Header.Set(WebhookSignatureHeader, "sha256="+SignWebhook(secret, timestamp, body))
+		return nil
+	})
+}
+
+// SignWebhook computes the hex HMAC-SHA256 signature of a delivery
+func SignWebhook(secret, timestamp string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(payload)
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,171 @@
+// Package stubserver runs a lightweight mini_bank API for the tests of the services that call it.
+//
+// The stub serves the real account and transaction endpoints: the same controllers, use cases, DTOs
+// and error codes as mini_bank, over an in-memory database and cache instead of PostgreSQL and Redis.
+// Its behavior therefore stays in step with the real API as both change together. The sandbox test
+// accounts are open on every stub, so callers can exercise insufficient funds, slow confirmations and
+// failed screening without setting up data:
+//
+//	server, err := stubserver.Start(stubserver.Config{})
+//	if err != nil {
+//		t.Fatal(err)
+//	}
+//	defer server.Close()
+//
+//	client := mybank.NewClient(server.URL+"/api/v1", server.APIKey)
+package stubserver
+
+import (
+	"context"
+	"fmt"
+	"net/http/httptest"
+	"os"
+	"sync/atomic"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/hydr0g3nz/mini_bank/internal/adapter/controller"
+	"github.com/hydr0g3nz/mini_bank/internal/adapter/repository/gorm/repository"
+	usecase "github.com/hydr0g3nz/mini_bank/internal/application"
+	domainInfra "github.com/hydr0g3nz/mini_bank/internal/domain/infra"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+	infra "github.com/hydr0g3nz/mini_bank/internal/infrastructure"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+const (
+	// DefaultAPIKey is the API key a stub accepts when none is configured
+	DefaultAPIKey = "stub-api-key"
+	// defaultSlowDelay keeps the slow sandbox account slow enough to observe but quick enough for tests
+	defaultSlowDelay = 100 * time.Millisecond
+	// signingTolerance is how old a signed request may be, as with mini_bank's default configuration
+	signingTolerance = 5 * time.Minute
+	// currency is the currency the stub's balances are held in, as in mini_bank's default configuration
+	currency = "THB"
+)
+
+// databases numbers the in-memory databases, so stubs running side by side never share one
+var databases atomic.Int64
+
+// Config holds stub server configuration; the zero value serves the API as mini_bank does by default
+type Config struct {
+	APIKey    string        // Key callers send in the x-api-key header; defaults to DefaultAPIKey
+	Bare      bool          // Return success responses without the envelope, as mini_bank does with RESPONSE_ENVELOPE=false
+	SlowDelay time.Duration // How long transactions of the slow sandbox account take to confirm; defaults to 100ms
+
+	// SigningSecret makes the API key sign the requests that change anything with it, as
+	// REQUEST_SIGNING_KEYS does for mini_bank; empty accepts unsigned requests
+	SigningSecret string
+}
+
+// Server is a running stub of the mini_bank API. URL is the address to call, with the API under /api/v1.
+type Server struct {
+	*httptest.Server
+	APIKey string // Key the stub accepts in the x-api-key header
+	db     *gorm.DB
+}
+
+// Start starts a stub server with an empty database holding only the sandbox test accounts
+func Start(config Config) (*Server, error) {
+	if config.APIKey == "" {
+		config.APIKey = DefaultAPIKey
+	}
+	if config.SlowDelay <= 0 {
+		config.SlowDelay = defaultSlowDelay
+	}
+
+	dsn := fmt.Sprintf("file:stubserver%d?mode=memory&cache=shared", databases.Add(1))
+	db, err := gorm.Open(sqlite.Open(dsn), &gorm.Config{Logger: logger.Default.LogMode(logger.Silent)})
+	if err != nil {
+		return nil, fmt.Errorf("failed to open stub database: %w", err)
+	}
+	server := &Server{APIKey: config.APIKey, db: db}
+
+	router, err := server.router(config)
+	if err != nil {
+		server.closeDB()
+		return nil, err
+	}
+	server.Server = httptest.NewServer(router)
+	return server, nil
+}
+
+// Close shuts the server down and discards its data
+func (s *Server) Close() {
+	s.Server.Close()
+	s.closeDB()
+}
+
+// router wires the real account and transaction controllers to in-memory storage
+func (s *Server) router(config Config) (*gin.Engine, error) {
+	// The shared in-memory database lives as long as one connection to it stays open
+	sqlDB, err := s.db.DB()
+	if err != nil {
+		return nil, err
+	}
+	sqlDB.SetMaxOpenConns(1)
+	if err := infra.AutoMigrate(s.db); err != nil {
+		return nil, fmt.Errorf("failed to migrate stub database: %w", err)
+	}
+	if err := infra.MigrateAccountNameIndex(s.db, vo.AccountNameScopeCustomer); err != nil {
+		return nil, fmt.Errorf("failed to migrate stub database: %w", err)
+	}
+
+	appLogger := infra.NewNopLogger()
+	cache := infra.NewMemoryCache()
+	events := infra.NewInMemoryEventBus(appLogger)
+
+	accountRepo := repository.NewAccountRepository(s.db)
+	transactionRepo := repository.NewTransactionRepository(s.db)
+	productRepo := repository.NewProductRepository(s.db)
+	categoryRepo := repository.NewCategoryRepository(s.db)
+	categoryOverrideRepo := repository.NewCategoryOverrideRepository(s.db)
+	holidayRepo := repository.NewHolidayRepository(s.db)
+
+	sandbox := usecase.NewSandbox(accountRepo, config.SlowDelay, appLogger)
+	if err := sandbox.SeedAccounts(context.Background()); err != nil {
+		return nil, err
+	}
+	reviewPolicy := usecase.NewReviewPolicy(usecase.NewFraudEngine(appLogger, usecase.SandboxScreeningRule{}), 0, 0)
+	valueDating := usecase.NewValueDatingPolicy(usecase.NewHolidayCalendar(holidayRepo, ""), vo.ProcessingWindow{}, nil)
+
+	accountUseCase := usecase.NewAccountUseCase(accountRepo, productRepo, cache, nil, events, vo.AccountNameScopeCustomer, currency, appLogger)
+	transactionUseCase := usecase.NewTransactionUseCase(transactionRepo, accountRepo, repository.NewVirtualAccountRepository(s.db),
+		repository.NewSagaRepository(s.db), repository.NewUnitOfWork(s.db), cache, cache, nil, events, nil, valueDating, reviewPolicy,
+		usecase.NewCategorizer(categoryRepo, categoryOverrideRepo, appLogger), nil,
+		usecase.NewProductPolicy(accountRepo, productRepo, appLogger), nil, nil, nil, nil, sandbox, nil, nil, nil, currency, appLogger)
+
+	// Keep Gin's route listing out of the caller's test output unless they chose a mode
+	if os.Getenv(gin.EnvGinMode) == "" {
+		gin.SetMode(gin.TestMode)
+	}
+	routerConfig := controller.RouterConfig{
+		APIKey:   domainInfra.StaticSecret(config.APIKey),
+		Envelope: !config.Bare,
+		Logger:   appLogger,
+
+		Idempotency: cache,
+	}
+	if config.SigningSecret != "" {
+		routerConfig.RequestSigning = controller.RequestSigning{
+			Keys:      domainInfra.StaticSecret(controller.ServiceSigningKeyID + ":" + config.SigningSecret),
+			Tolerance: signingTolerance,
+			Nonces:    cache,
+		}
+	}
+	router := gin.New()
+	controller.Serve(router, controller.NewRouteRegistry(
+		controller.NewAccountController(accountUseCase, appLogger),
+		controller.NewTransactionController(transactionUseCase, appLogger),
+	), routerConfig)
+	return router, nil
+}
+
+// closeDB closes the last connection to the in-memory database, which drops it
+func (s *Server) closeDB() {
+	if sqlDB, err := s.db.DB(); err == nil {
+		sqlDB.Close()
+	}
+}
@@ -0,0 +1,164 @@
+package stubserver_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/hydr0g3nz/mini_bank/stubserver"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// envelope is a success response with its data left to decode into the endpoint's DTO
+type envelope struct {
+	Message string          `json:"message"`
+	Data    json.RawMessage `json:"data"`
+}
+
+// startStub starts a stub closed when the test ends
+func startStub(t *testing.T, config stubserver.Config) *stubserver.Server {
+	server, err := stubserver.Start(config)
+	require.NoError(t, err)
+	t.Cleanup(server.Close)
+	return server
+}
+
+// call sends a request with the stub's API key and decodes the response data into out, or the error
+// response into out when the call failed; it returns the status code
+func call(t *testing.T, server *stubserver.Server, method, path string, body, out interface{}) int {
+	var payload bytes.Buffer
+	if body != nil {
+		require.NoError(t, json.NewEncoder(&payload).Encode(body))
+	}
+	req, err := http.NewRequest(method, server.URL+"/api/v1"+path, &payload)
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("x-api-key", server.APIKey)
+
+	resp, err := server.Client().Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(out))
+		return resp.StatusCode
+	}
+	var success envelope
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&success))
+	if out != nil {
+		require.NoError(t, json.Unmarshal(success.Data, out))
+	}
+	return resp.StatusCode
+}
+
+// openAccount opens an account holding balance
+func openAccount(t *testing.T, server *stubserver.Server, name string, balance float64) stubserver.AccountResponse {
+	initialBalance := stubserver.NewDecimalStringFromFloat(balance)
+	var account stubserver.AccountResponse
+	status := call(t, server, http.MethodPost, "/accounts", stubserver.AccountRequest{AccountName: name, InitialBalance: &initialBalance}, &account)
+	require.Equal(t, http.StatusCreated, status)
+	return account
+}
+
+// transfer creates a transfer of amount and returns it still pending
+func transfer(t *testing.T, server *stubserver.Server, from, to string, amount float64) stubserver.TransactionResponse {
+	var transaction stubserver.TransactionResponse
+	status := call(t, server, http.MethodPost, "/transactions", stubserver.CreateTransactionRequest{
+		FromAccountID:   &from,
+		ToAccountID:     &to,
+		TransactionType: "TRANSFER",
+		Amount:          stubserver.NewDecimalStringFromFloat(amount),
+	}, &transaction)
+	require.Equal(t, http.StatusCreated, status)
+	return transaction
+}
+
+func TestStub_RequiresAPIKey(t *testing.T) {
+	server := startStub(t, stubserver.Config{APIKey: "consumer-key"})
+
+	resp, err := server.Client().Get(server.URL + "/api/v1/accounts")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	assert.Equal(t, "consumer-key", server.APIKey)
+}
+
+func TestStub_Accounts(t *testing.T) {
+	server := startStub(t, stubserver.Config{})
+	opened := openAccount(t, server, "Savings", 250)
+
+	var account stubserver.AccountResponse
+	status := call(t, server, http.MethodGet, "/accounts/"+opened.ID, nil, &account)
+
+	require.Equal(t, http.StatusOK, status)
+	assert.Equal(t, "Savings", account.AccountName)
+	assert.Equal(t, 250.0, account.Balance)
+	assert.Equal(t, "ACTIVE", account.Status)
+
+	var notFound stubserver.ErrorResponse
+	status = call(t, server, http.MethodGet, "/accounts/2024010112345678", nil, &notFound)
+	assert.Equal(t, http.StatusNotFound, status)
+	assert.Equal(t, "ACCOUNT_NOT_FOUND", notFound.Code)
+
+	var invalid stubserver.ErrorResponse
+	status = call(t, server, http.MethodPost, "/accounts", stubserver.AccountRequest{}, &invalid)
+	assert.Equal(t, http.StatusBadRequest, status)
+	assert.NotEmpty(t, invalid.Code)
+}
+
+func TestStub_Transfer(t *testing.T) {
+	server := startStub(t, stubserver.Config{})
+	from := openAccount(t, server, "From", 100)
+	to := openAccount(t, server, "To", 0)
+	pending := transfer(t, server, from.ID, to.ID, 40)
+
+	var confirmed stubserver.TransactionResponse
+	status := call(t, server, http.MethodPatch, "/transactions/"+pending.ID+"/confirm", nil, &confirmed)
+
+	require.Equal(t, http.StatusOK, status)
+	assert.Equal(t, "COMPLETED", confirmed.Status)
+	var account stubserver.AccountResponse
+	call(t, server, http.MethodGet, "/accounts/"+to.ID, nil, &account)
+	assert.Equal(t, 40.0, account.Balance)
+}
+
+func TestStub_SandboxAccounts(t *testing.T) {
+	server := startStub(t, stubserver.Config{SlowDelay: 50 * time.Millisecond})
+	customer := openAccount(t, server, "Customer", 100)
+
+	t.Run("insufficient funds", func(t *testing.T) {
+		pending := transfer(t, server, stubserver.InsufficientFundsAccountID, customer.ID, 1)
+
+		var failed stubserver.ErrorResponse
+		status := call(t, server, http.MethodPatch, "/transactions/"+pending.ID+"/confirm", nil, &failed)
+
+		assert.Equal(t, http.StatusBadRequest, status)
+		assert.Equal(t, "INSUFFICIENT_BALANCE", failed.Code)
+	})
+
+	t.Run("slow", func(t *testing.T) {
+		pending := transfer(t, server, customer.ID, stubserver.SlowAccountID, 1)
+
+		started := time.Now()
+		var confirmed stubserver.TransactionResponse
+		status := call(t, server, http.MethodPatch, "/transactions/"+pending.ID+"/confirm", nil, &confirmed)
+
+		assert.Equal(t, http.StatusOK, status)
+		assert.Equal(t, "COMPLETED", confirmed.Status)
+		assert.GreaterOrEqual(t, time.Since(started), 50*time.Millisecond)
+	})
+
+	t.Run("fails screening", func(t *testing.T) {
+		pending := transfer(t, server, customer.ID, stubserver.ScreeningFailureAccountID, 1)
+
+		var held stubserver.TransactionResponse
+		status := call(t, server, http.MethodPatch, "/transactions/"+pending.ID+"/confirm", nil, &held)
+
+		assert.Equal(t, http.StatusAccepted, status)
+		assert.Equal(t, "REVIEW", held.Status)
+	})
+}
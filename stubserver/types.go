@@ -0,0 +1,39 @@
+package stubserver
+
+import (
+	"github.com/hydr0g3nz/mini_bank/internal/application/dto"
+	"github.com/hydr0g3nz/mini_bank/internal/domain/vo"
+)
+
+// The request and response bodies of the stubbed endpoints. They are mini_bank's own DTOs, so code
+// built against them fails to compile when the API changes shape rather than drifting from it.
+type (
+	AccountRequest           = dto.AccountRequest
+	AccountResponse          = dto.AccountResponse
+	AccountListResponse      = dto.AccountListResponse
+	CreateTransactionRequest = dto.CreateTransactionRequest
+	TransactionResponse      = dto.TransactionResponse
+	TransactionListResponse  = dto.TransactionListResponse
+	DecimalString            = dto.DecimalString
+	SuccessResponse          = dto.SuccessResponse
+	ErrorResponse            = dto.ErrorResponse
+)
+
+// NewDecimalStringFromFloat creates an amount for a request body
+func NewDecimalStringFromFloat(amount float64) DecimalString {
+	return dto.NewDecimalStringFromFloat(amount)
+}
+
+// The IDs of the sandbox test accounts open on every stub
+var (
+	// InsufficientFundsAccountID is empty, and every debit or transfer out of it fails with INSUFFICIENT_BALANCE
+	InsufficientFundsAccountID = sandboxAccountID(vo.SandboxBehaviorInsufficientFunds)
+	// SlowAccountID takes Config.SlowDelay to confirm every transaction in or out of it
+	SlowAccountID = sandboxAccountID(vo.SandboxBehaviorSlow)
+	// ScreeningFailureAccountID fails screening, so every transaction in or out of it is held for review
+	ScreeningFailureAccountID = sandboxAccountID(vo.SandboxBehaviorScreeningFailure)
+)
+
+func sandboxAccountID(behavior vo.SandboxBehavior) string {
+	return vo.SandboxAccountIDs()[behavior].String()
+}